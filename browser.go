@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os/exec"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/bobbytrapz/gochrome/cdp/target"
 )
 
 // Browser is a single running chrome browser
@@ -21,6 +24,12 @@ type Browser struct {
 	UserAgent string
 	// closed when browser exits
 	exit chan struct{}
+	// closes exit once
+	// chrome exiting, ctx being done and disconnecting may all try
+	closeExit sync.Once
+	// why the browser ended
+	err error
+	em  sync.Mutex
 	// makes sure browser/tabs close cleanly
 	wg sync.WaitGroup
 	// chrome process
@@ -32,6 +41,36 @@ type Browser struct {
 	addr string
 	// mutex so we cannot not add more than one tab at a time
 	newTab sync.Mutex
+	// drive every tab over a single browser websocket
+	// using Target.attachToTarget with flatten
+	// otherwise each tab dials its own websocket
+	Flatten bool
+	// delivers each tab's events in the order chrome sent them
+	// nil runs every handler on its own goroutine
+	EventQueue *EventQueue
+	// structured logs for this browser and its tabs
+	// tab logs carry the tab id
+	// debug level logs every command and the raw messages on the wire
+	// nil logs nothing
+	Logger *slog.Logger
+	// wrap every command sent by this browser's tabs
+	// the first interceptor is the outermost
+	// set before opening tabs
+	Interceptors []Interceptor
+	// caps how many commands each tab sends and how fast
+	// nil sends commands as fast as they are made
+	CommandLimit *CommandLimit
+	// redial a tab's websocket when it drops
+	// only for tabs with their own websocket, not flattened sessions
+	// nil lets a dropped websocket end the tab
+	Reconnect *Reconnect
+	// connects to chrome websockets
+	// DialWebSocket is used if nil
+	Dial func(wsURL string) (Transport, error)
+	// browser connection when using flattened sessions
+	conn *conn
+	// session for the browser target itself
+	browserTab *Tab
 }
 
 // NewBrowser creates a new chrome browser
@@ -111,7 +150,7 @@ func (b *Browser) newRequest(ctx context.Context, host string, method string, ur
 func (b *Browser) performRequest(ctx context.Context, method string, link string) (*http.Response, error) {
 	u, err := url.ParseRequestURI(link)
 	if err != nil {
-		panic("invalid url" + link)
+		return nil, fmt.Errorf("invalid url %q: %w", link, err)
 	}
 
 	req, err := b.newRequest(ctx, u.Host, method, link)
@@ -137,14 +176,14 @@ func (b *Browser) http(ctx context.Context, method string, path string) (res *ht
 		case err := <-ctx.Done():
 			return nil, fmt.Errorf("cancel: %s", err)
 		case <-timeout:
-			Log("timeout")
+			b.logger().Warn("timeout", "path", path)
 			return nil, fmt.Errorf("timeout")
 		default:
 			res, err = b.performRequest(ctx, method, u.String())
 			if err == nil {
 				goto ok
 			} else {
-				Log("%s", err)
+				b.logger().Debug("request failed", "path", path, "err", err)
 			}
 		}
 		time.Sleep(500 * time.Millisecond)
@@ -159,6 +198,10 @@ func (b *Browser) NewTab(ctx context.Context) (*Tab, error) {
 	b.newTab.Lock()
 	defer b.newTab.Unlock()
 
+	if b.Flatten {
+		return b.newFlatTab(ctx)
+	}
+
 	res, err := b.http(ctx, http.MethodPut, "/json/new")
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
@@ -176,7 +219,7 @@ func (b *Browser) NewTab(ctx context.Context) (*Tab, error) {
 		return nil, fmt.Errorf("json.NewDecoder: %w", err)
 	}
 
-	tab, err := b.addTab(tci)
+	tab, err := b.addTab(ctx, tci)
 	if err != nil {
 		return nil, err
 	}
@@ -184,13 +227,85 @@ func (b *Browser) NewTab(ctx context.Context) (*Tab, error) {
 	return tab, nil
 }
 
-func (b *Browser) addTab(tci tabConnectionInfo) (*Tab, error) {
-	tab, err := b.connectTab(tci)
+// open a new page target and attach to it
+func (b *Browser) newFlatTab(ctx context.Context) (*Tab, error) {
+	res, err := b.browserTab.Target().CreateTargetContext(ctx, target.CreateTargetParams{Url: "about:blank"})
+	if err != nil {
+		return nil, fmt.Errorf("Target.createTarget: %w", err)
+	}
+
+	return b.addTab(ctx, tabConnectionInfo{
+		ID:   string(res.TargetId),
+		Type: "page",
+		URL:  "about:blank",
+	})
+}
+
+// AttachTarget starts a session for any target such as an iframe or worker
+// only available when using flattened sessions
+func (b *Browser) AttachTarget(ctx context.Context, targetID target.TargetID) (*Tab, error) {
+	if b.conn == nil {
+		return nil, fmt.Errorf("AttachTarget: browser is not using flattened sessions")
+	}
+	return b.attachTab(ctx, tabConnectionInfo{ID: string(targetID)})
+}
+
+// Target gives a tab for the browser target itself
+// only available when using flattened sessions
+// use it for browser-wide commands such as Target.getTargets
+func (b *Browser) Target() *Tab {
+	return b.browserTab
+}
+
+// connect to the browser websocket for flattened sessions
+func (b *Browser) connectBrowser(ctx context.Context) error {
+	res, err := b.http(ctx, http.MethodGet, "/json/version")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var version struct {
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&version)
+	if err != nil {
+		return fmt.Errorf("json.NewDecoder: %w", err)
+	}
+
+	return b.connectBrowserWebSocket(version.WebSocketDebuggerURL)
+}
+
+func (b *Browser) connectBrowserWebSocket(wsURL string) error {
+	t, err := b.dial(wsURL)
+	if err != nil {
+		return fmt.Errorf("could not connect browser: %w", err)
+	}
+	b.useBrowserConn(b.newConn(t, nil, ""))
+
+	return nil
+}
+
+// use a connection to the browser target for flattened sessions
+func (b *Browser) useBrowserConn(c *conn) {
+	b.conn = c
+	b.browserTab = b.makeTab(c, "", tabConnectionInfo{Type: "browser"})
+	c.addSession(b.browserTab)
+}
+
+func (b *Browser) addTab(ctx context.Context, tci tabConnectionInfo) (*Tab, error) {
+	var tab *Tab
+	var err error
+	if b.Flatten {
+		tab, err = b.attachTab(ctx, tci)
+	} else {
+		tab, err = b.connectTab(tci)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("could not connect tab: %w", err)
 	}
 
-	Log("adding tab:\n%+v", tci)
+	tab.log.Info("adding tab", "type", tci.Type, "url", tci.URL)
 
 	if b.UserAgent != "" {
 		tab.SetUserAgent(b.UserAgent)
@@ -200,22 +315,71 @@ func (b *Browser) addTab(tci tabConnectionInfo) (*Tab, error) {
 }
 
 // PID returns the chrome process id
-func (b *Browser) PID() int {
-	if b.cmd == nil {
-		panic("browser is not open")
+// ErrNotStarted if gochrome did not start chrome
+func (b *Browser) PID() (int, error) {
+	if b.cmd == nil || b.cmd.Process == nil {
+		return 0, ErrNotStarted
+	}
+	return b.cmd.Process.Pid, nil
+}
+
+// Err gives the error that ended the browser
+// such as chrome exiting with an error or the browser connection failing
+// nil while the browser is running or if it closed cleanly
+func (b *Browser) Err() error {
+	b.em.Lock()
+	err := b.err
+	b.em.Unlock()
+	if err != nil {
+		return err
+	}
+	if b.conn != nil {
+		return b.conn.Err()
 	}
-	return b.cmd.Process.Pid
+	return nil
+}
+
+// keep the first error that ended the browser
+func (b *Browser) fail(err error) {
+	b.em.Lock()
+	defer b.em.Unlock()
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// close exit once
+func (b *Browser) exited() {
+	b.closeExit.Do(func() {
+		if b.exit != nil {
+			close(b.exit)
+		}
+	})
 }
 
 // Close the browser.
+// if gochrome did not start chrome we only disconnect
 func (b *Browser) Close() error {
+	if !b.owned() {
+		b.disconnect()
+		return nil
+	}
+
+	if b.browserTab != nil {
+		_, err := b.browserTab.Browser().Close()
+		if err != nil {
+			return fmt.Errorf("Browser.close: %w", err)
+		}
+		return nil
+	}
+
 	tab, err := b.NewTab(context.Background())
 	if err != nil {
 		return fmt.Errorf("Browser.NewTab: %w", err)
 	}
-	_, err = tab.BrowserClose()
+	_, err = tab.Browser().Close()
 	if err != nil {
-		return fmt.Errorf("Tab.BrowserClose: %w", err)
+		return fmt.Errorf("Browser.close: %w", err)
 	}
 	return nil
 }
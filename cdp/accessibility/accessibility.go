@@ -0,0 +1,1521 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package accessibility is the Accessibility domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package accessibility
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Accessibility commands and listens for Accessibility events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Unique accessibility node identifier.
+type AXNodeId string
+
+// Enum of possible property types.
+type AXValueType string
+
+// AXValueType values
+const (
+	AXValueTypeBoolean            AXValueType = "boolean"
+	AXValueTypeTristate           AXValueType = "tristate"
+	AXValueTypeBooleanOrUndefined AXValueType = "booleanOrUndefined"
+	AXValueTypeIdref              AXValueType = "idref"
+	AXValueTypeIdrefList          AXValueType = "idrefList"
+	AXValueTypeInteger            AXValueType = "integer"
+	AXValueTypeNode               AXValueType = "node"
+	AXValueTypeNodeList           AXValueType = "nodeList"
+	AXValueTypeNumber             AXValueType = "number"
+	AXValueTypeString             AXValueType = "string"
+	AXValueTypeComputedString     AXValueType = "computedString"
+	AXValueTypeToken              AXValueType = "token"
+	AXValueTypeTokenList          AXValueType = "tokenList"
+	AXValueTypeDomRelation        AXValueType = "domRelation"
+	AXValueTypeRole               AXValueType = "role"
+	AXValueTypeInternalRole       AXValueType = "internalRole"
+	AXValueTypeValueUndefined     AXValueType = "valueUndefined"
+)
+
+// Values gives every AXValueType
+func (AXValueType) Values() []AXValueType {
+	return []AXValueType{
+		AXValueTypeBoolean,
+		AXValueTypeTristate,
+		AXValueTypeBooleanOrUndefined,
+		AXValueTypeIdref,
+		AXValueTypeIdrefList,
+		AXValueTypeInteger,
+		AXValueTypeNode,
+		AXValueTypeNodeList,
+		AXValueTypeNumber,
+		AXValueTypeString,
+		AXValueTypeComputedString,
+		AXValueTypeToken,
+		AXValueTypeTokenList,
+		AXValueTypeDomRelation,
+		AXValueTypeRole,
+		AXValueTypeInternalRole,
+		AXValueTypeValueUndefined,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AXValueType) Valid() bool {
+	switch v {
+	case AXValueTypeBoolean, AXValueTypeTristate, AXValueTypeBooleanOrUndefined, AXValueTypeIdref, AXValueTypeIdrefList, AXValueTypeInteger, AXValueTypeNode, AXValueTypeNodeList, AXValueTypeNumber, AXValueTypeString, AXValueTypeComputedString, AXValueTypeToken, AXValueTypeTokenList, AXValueTypeDomRelation, AXValueTypeRole, AXValueTypeInternalRole, AXValueTypeValueUndefined:
+		return true
+	}
+	return false
+}
+
+// Enum of possible property sources.
+type AXValueSourceType string
+
+// AXValueSourceType values
+const (
+	AXValueSourceTypeAttribute      AXValueSourceType = "attribute"
+	AXValueSourceTypeImplicit       AXValueSourceType = "implicit"
+	AXValueSourceTypeStyle          AXValueSourceType = "style"
+	AXValueSourceTypeContents       AXValueSourceType = "contents"
+	AXValueSourceTypePlaceholder    AXValueSourceType = "placeholder"
+	AXValueSourceTypeRelatedElement AXValueSourceType = "relatedElement"
+)
+
+// Values gives every AXValueSourceType
+func (AXValueSourceType) Values() []AXValueSourceType {
+	return []AXValueSourceType{
+		AXValueSourceTypeAttribute,
+		AXValueSourceTypeImplicit,
+		AXValueSourceTypeStyle,
+		AXValueSourceTypeContents,
+		AXValueSourceTypePlaceholder,
+		AXValueSourceTypeRelatedElement,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AXValueSourceType) Valid() bool {
+	switch v {
+	case AXValueSourceTypeAttribute, AXValueSourceTypeImplicit, AXValueSourceTypeStyle, AXValueSourceTypeContents, AXValueSourceTypePlaceholder, AXValueSourceTypeRelatedElement:
+		return true
+	}
+	return false
+}
+
+// Enum of possible native property sources (as a subtype of a particular AXValueSourceType).
+type AXValueNativeSourceType string
+
+// AXValueNativeSourceType values
+const (
+	AXValueNativeSourceTypeDescription    AXValueNativeSourceType = "description"
+	AXValueNativeSourceTypeFigcaption     AXValueNativeSourceType = "figcaption"
+	AXValueNativeSourceTypeLabel          AXValueNativeSourceType = "label"
+	AXValueNativeSourceTypeLabelfor       AXValueNativeSourceType = "labelfor"
+	AXValueNativeSourceTypeLabelwrapped   AXValueNativeSourceType = "labelwrapped"
+	AXValueNativeSourceTypeLegend         AXValueNativeSourceType = "legend"
+	AXValueNativeSourceTypeRubyannotation AXValueNativeSourceType = "rubyannotation"
+	AXValueNativeSourceTypeTablecaption   AXValueNativeSourceType = "tablecaption"
+	AXValueNativeSourceTypeTitle          AXValueNativeSourceType = "title"
+	AXValueNativeSourceTypeOther          AXValueNativeSourceType = "other"
+)
+
+// Values gives every AXValueNativeSourceType
+func (AXValueNativeSourceType) Values() []AXValueNativeSourceType {
+	return []AXValueNativeSourceType{
+		AXValueNativeSourceTypeDescription,
+		AXValueNativeSourceTypeFigcaption,
+		AXValueNativeSourceTypeLabel,
+		AXValueNativeSourceTypeLabelfor,
+		AXValueNativeSourceTypeLabelwrapped,
+		AXValueNativeSourceTypeLegend,
+		AXValueNativeSourceTypeRubyannotation,
+		AXValueNativeSourceTypeTablecaption,
+		AXValueNativeSourceTypeTitle,
+		AXValueNativeSourceTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AXValueNativeSourceType) Valid() bool {
+	switch v {
+	case AXValueNativeSourceTypeDescription, AXValueNativeSourceTypeFigcaption, AXValueNativeSourceTypeLabel, AXValueNativeSourceTypeLabelfor, AXValueNativeSourceTypeLabelwrapped, AXValueNativeSourceTypeLegend, AXValueNativeSourceTypeRubyannotation, AXValueNativeSourceTypeTablecaption, AXValueNativeSourceTypeTitle, AXValueNativeSourceTypeOther:
+		return true
+	}
+	return false
+}
+
+// A single source for a computed AX property.
+type AXValueSource struct {
+	/* What type of source this is. */
+	Type AXValueSourceType `json:"type"`
+	/* The value of this property source. */
+	Value *AXValue `json:"value,omitempty"`
+	/* The name of the relevant attribute, if any. */
+	Attribute *string `json:"attribute,omitempty"`
+	/* The value of the relevant attribute, if any. */
+	AttributeValue *AXValue `json:"attributeValue,omitempty"`
+	/* Whether this source is superseded by a higher priority source. */
+	Superseded *bool `json:"superseded,omitempty"`
+	/* The native markup source for this value, e.g. a `<label>` element. */
+	NativeSource *AXValueNativeSourceType `json:"nativeSource,omitempty"`
+	/* The value, such as a node or node list, of the native source. */
+	NativeSourceValue *AXValue `json:"nativeSourceValue,omitempty"`
+	/* Whether the value for this property is invalid. */
+	Invalid *bool `json:"invalid,omitempty"`
+	/* Reason for the value being invalid, if it is. */
+	InvalidReason *string `json:"invalidReason,omitempty"`
+}
+
+// MarshalCDP writes AXValueSource as JSON
+func (v *AXValueSource) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Value != nil {
+		e.Key("value")
+		(*v.Value).MarshalCDP(e)
+	}
+	if v.Attribute != nil {
+		e.Key("attribute")
+		e.String(*v.Attribute)
+	}
+	if v.AttributeValue != nil {
+		e.Key("attributeValue")
+		(*v.AttributeValue).MarshalCDP(e)
+	}
+	if v.Superseded != nil {
+		e.Key("superseded")
+		e.Bool(*v.Superseded)
+	}
+	if v.NativeSource != nil {
+		e.Key("nativeSource")
+		e.String(string(*v.NativeSource))
+	}
+	if v.NativeSourceValue != nil {
+		e.Key("nativeSourceValue")
+		(*v.NativeSourceValue).MarshalCDP(e)
+	}
+	if v.Invalid != nil {
+		e.Key("invalid")
+		e.Bool(*v.Invalid)
+	}
+	if v.InvalidReason != nil {
+		e.Key("invalidReason")
+		e.String(*v.InvalidReason)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AXValueSource) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AXValueSource from JSON
+func (v *AXValueSource) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = AXValueSourceType(d.String())
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(AXValue)
+				(*v.Value).UnmarshalCDP(d)
+			}
+		case "attribute":
+			if d.Null() {
+				v.Attribute = nil
+			} else {
+				v.Attribute = new(string)
+				*v.Attribute = d.String()
+			}
+		case "attributeValue":
+			if d.Null() {
+				v.AttributeValue = nil
+			} else {
+				v.AttributeValue = new(AXValue)
+				(*v.AttributeValue).UnmarshalCDP(d)
+			}
+		case "superseded":
+			if d.Null() {
+				v.Superseded = nil
+			} else {
+				v.Superseded = new(bool)
+				*v.Superseded = d.Bool()
+			}
+		case "nativeSource":
+			if d.Null() {
+				v.NativeSource = nil
+			} else {
+				v.NativeSource = new(AXValueNativeSourceType)
+				*v.NativeSource = AXValueNativeSourceType(d.String())
+			}
+		case "nativeSourceValue":
+			if d.Null() {
+				v.NativeSourceValue = nil
+			} else {
+				v.NativeSourceValue = new(AXValue)
+				(*v.NativeSourceValue).UnmarshalCDP(d)
+			}
+		case "invalid":
+			if d.Null() {
+				v.Invalid = nil
+			} else {
+				v.Invalid = new(bool)
+				*v.Invalid = d.Bool()
+			}
+		case "invalidReason":
+			if d.Null() {
+				v.InvalidReason = nil
+			} else {
+				v.InvalidReason = new(string)
+				*v.InvalidReason = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AXValueSource) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AXRelatedNode struct {
+	/* The BackendNodeId of the related DOM node. */
+	BackendDOMNodeId cdp.DOMBackendNodeId `json:"backendDOMNodeId"`
+	/* The IDRef value provided, if any. */
+	Idref *string `json:"idref,omitempty"`
+	/* The text alternative of this node in the current context. */
+	Text *string `json:"text,omitempty"`
+}
+
+// MarshalCDP writes AXRelatedNode as JSON
+func (v *AXRelatedNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("backendDOMNodeId")
+	e.Int(int(v.BackendDOMNodeId))
+	if v.Idref != nil {
+		e.Key("idref")
+		e.String(*v.Idref)
+	}
+	if v.Text != nil {
+		e.Key("text")
+		e.String(*v.Text)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AXRelatedNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AXRelatedNode from JSON
+func (v *AXRelatedNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backendDOMNodeId":
+			v.BackendDOMNodeId = cdp.DOMBackendNodeId(d.Int())
+		case "idref":
+			if d.Null() {
+				v.Idref = nil
+			} else {
+				v.Idref = new(string)
+				*v.Idref = d.String()
+			}
+		case "text":
+			if d.Null() {
+				v.Text = nil
+			} else {
+				v.Text = new(string)
+				*v.Text = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AXRelatedNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AXProperty struct {
+	/* The name of this property. */
+	Name AXPropertyName `json:"name"`
+	/* The value of this property. */
+	Value AXValue `json:"value"`
+}
+
+// MarshalCDP writes AXProperty as JSON
+func (v *AXProperty) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(string(v.Name))
+	e.Key("value")
+	v.Value.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AXProperty) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AXProperty from JSON
+func (v *AXProperty) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = AXPropertyName(d.String())
+		case "value":
+			v.Value.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AXProperty) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A single computed AX property.
+type AXValue struct {
+	/* The type of this value. */
+	Type AXValueType `json:"type"`
+	/* The computed value of this property. */
+	Value interface{} `json:"value"`
+	/* One or more related nodes, if applicable. */
+	RelatedNodes []AXRelatedNode `json:"relatedNodes"`
+	/* The sources which contributed to the computation of this property. */
+	Sources []AXValueSource `json:"sources"`
+}
+
+// MarshalCDP writes AXValue as JSON
+func (v *AXValue) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Value != nil {
+		e.Key("value")
+		e.Interface(v.Value)
+	}
+	if v.RelatedNodes != nil {
+		e.Key("relatedNodes")
+		e.ArrayStart()
+		for i0 := range v.RelatedNodes {
+			e.Elem()
+			v.RelatedNodes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Sources != nil {
+		e.Key("sources")
+		e.ArrayStart()
+		for i0 := range v.Sources {
+			e.Elem()
+			v.Sources[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AXValue) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AXValue from JSON
+func (v *AXValue) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = AXValueType(d.String())
+		case "value":
+			v.Value = d.Interface()
+		case "relatedNodes":
+			if d.Array() {
+				v.RelatedNodes = make([]AXRelatedNode, 0)
+				for d.More() {
+					var x0 AXRelatedNode
+					x0.UnmarshalCDP(d)
+					v.RelatedNodes = append(v.RelatedNodes, x0)
+				}
+			} else {
+				v.RelatedNodes = nil
+			}
+		case "sources":
+			if d.Array() {
+				v.Sources = make([]AXValueSource, 0)
+				for d.More() {
+					var x0 AXValueSource
+					x0.UnmarshalCDP(d)
+					v.Sources = append(v.Sources, x0)
+				}
+			} else {
+				v.Sources = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AXValue) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Values of AXProperty name:
+// - from 'busy' to 'roledescription': states which apply to every AX node
+// - from 'live' to 'root': attributes which apply to nodes in live regions
+// - from 'autocomplete' to 'valuetext': attributes which apply to widgets
+// - from 'checked' to 'selected': states which apply to widgets
+// - from 'activedescendant' to 'owns' - relationships between elements other than parent/child/sibling.
+type AXPropertyName string
+
+// AXPropertyName values
+const (
+	AXPropertyNameActions          AXPropertyName = "actions"
+	AXPropertyNameBusy             AXPropertyName = "busy"
+	AXPropertyNameDisabled         AXPropertyName = "disabled"
+	AXPropertyNameEditable         AXPropertyName = "editable"
+	AXPropertyNameFocusable        AXPropertyName = "focusable"
+	AXPropertyNameFocused          AXPropertyName = "focused"
+	AXPropertyNameHidden           AXPropertyName = "hidden"
+	AXPropertyNameHiddenRoot       AXPropertyName = "hiddenRoot"
+	AXPropertyNameInvalid          AXPropertyName = "invalid"
+	AXPropertyNameKeyshortcuts     AXPropertyName = "keyshortcuts"
+	AXPropertyNameSettable         AXPropertyName = "settable"
+	AXPropertyNameRoledescription  AXPropertyName = "roledescription"
+	AXPropertyNameLive             AXPropertyName = "live"
+	AXPropertyNameAtomic           AXPropertyName = "atomic"
+	AXPropertyNameRelevant         AXPropertyName = "relevant"
+	AXPropertyNameRoot             AXPropertyName = "root"
+	AXPropertyNameAutocomplete     AXPropertyName = "autocomplete"
+	AXPropertyNameHasPopup         AXPropertyName = "hasPopup"
+	AXPropertyNameLevel            AXPropertyName = "level"
+	AXPropertyNameMultiselectable  AXPropertyName = "multiselectable"
+	AXPropertyNameOrientation      AXPropertyName = "orientation"
+	AXPropertyNameMultiline        AXPropertyName = "multiline"
+	AXPropertyNameReadonly         AXPropertyName = "readonly"
+	AXPropertyNameRequired         AXPropertyName = "required"
+	AXPropertyNameValuemin         AXPropertyName = "valuemin"
+	AXPropertyNameValuemax         AXPropertyName = "valuemax"
+	AXPropertyNameValuetext        AXPropertyName = "valuetext"
+	AXPropertyNameChecked          AXPropertyName = "checked"
+	AXPropertyNameExpanded         AXPropertyName = "expanded"
+	AXPropertyNameModal            AXPropertyName = "modal"
+	AXPropertyNamePressed          AXPropertyName = "pressed"
+	AXPropertyNameSelected         AXPropertyName = "selected"
+	AXPropertyNameActivedescendant AXPropertyName = "activedescendant"
+	AXPropertyNameControls         AXPropertyName = "controls"
+	AXPropertyNameDescribedby      AXPropertyName = "describedby"
+	AXPropertyNameDetails          AXPropertyName = "details"
+	AXPropertyNameErrormessage     AXPropertyName = "errormessage"
+	AXPropertyNameFlowto           AXPropertyName = "flowto"
+	AXPropertyNameLabelledby       AXPropertyName = "labelledby"
+	AXPropertyNameOwns             AXPropertyName = "owns"
+	AXPropertyNameUrl              AXPropertyName = "url"
+)
+
+// Values gives every AXPropertyName
+func (AXPropertyName) Values() []AXPropertyName {
+	return []AXPropertyName{
+		AXPropertyNameActions,
+		AXPropertyNameBusy,
+		AXPropertyNameDisabled,
+		AXPropertyNameEditable,
+		AXPropertyNameFocusable,
+		AXPropertyNameFocused,
+		AXPropertyNameHidden,
+		AXPropertyNameHiddenRoot,
+		AXPropertyNameInvalid,
+		AXPropertyNameKeyshortcuts,
+		AXPropertyNameSettable,
+		AXPropertyNameRoledescription,
+		AXPropertyNameLive,
+		AXPropertyNameAtomic,
+		AXPropertyNameRelevant,
+		AXPropertyNameRoot,
+		AXPropertyNameAutocomplete,
+		AXPropertyNameHasPopup,
+		AXPropertyNameLevel,
+		AXPropertyNameMultiselectable,
+		AXPropertyNameOrientation,
+		AXPropertyNameMultiline,
+		AXPropertyNameReadonly,
+		AXPropertyNameRequired,
+		AXPropertyNameValuemin,
+		AXPropertyNameValuemax,
+		AXPropertyNameValuetext,
+		AXPropertyNameChecked,
+		AXPropertyNameExpanded,
+		AXPropertyNameModal,
+		AXPropertyNamePressed,
+		AXPropertyNameSelected,
+		AXPropertyNameActivedescendant,
+		AXPropertyNameControls,
+		AXPropertyNameDescribedby,
+		AXPropertyNameDetails,
+		AXPropertyNameErrormessage,
+		AXPropertyNameFlowto,
+		AXPropertyNameLabelledby,
+		AXPropertyNameOwns,
+		AXPropertyNameUrl,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AXPropertyName) Valid() bool {
+	switch v {
+	case AXPropertyNameActions, AXPropertyNameBusy, AXPropertyNameDisabled, AXPropertyNameEditable, AXPropertyNameFocusable, AXPropertyNameFocused, AXPropertyNameHidden, AXPropertyNameHiddenRoot, AXPropertyNameInvalid, AXPropertyNameKeyshortcuts, AXPropertyNameSettable, AXPropertyNameRoledescription, AXPropertyNameLive, AXPropertyNameAtomic, AXPropertyNameRelevant, AXPropertyNameRoot, AXPropertyNameAutocomplete, AXPropertyNameHasPopup, AXPropertyNameLevel, AXPropertyNameMultiselectable, AXPropertyNameOrientation, AXPropertyNameMultiline, AXPropertyNameReadonly, AXPropertyNameRequired, AXPropertyNameValuemin, AXPropertyNameValuemax, AXPropertyNameValuetext, AXPropertyNameChecked, AXPropertyNameExpanded, AXPropertyNameModal, AXPropertyNamePressed, AXPropertyNameSelected, AXPropertyNameActivedescendant, AXPropertyNameControls, AXPropertyNameDescribedby, AXPropertyNameDetails, AXPropertyNameErrormessage, AXPropertyNameFlowto, AXPropertyNameLabelledby, AXPropertyNameOwns, AXPropertyNameUrl:
+		return true
+	}
+	return false
+}
+
+// A node in the accessibility tree.
+type AXNode struct {
+	/* Unique identifier for this node. */
+	NodeId AXNodeId `json:"nodeId"`
+	/* Whether this node is ignored for accessibility */
+	Ignored bool `json:"ignored"`
+	/* Collection of reasons why this node is hidden. */
+	IgnoredReasons []AXProperty `json:"ignoredReasons"`
+	/* This `Node`'s role, whether explicit or implicit. */
+	Role *AXValue `json:"role,omitempty"`
+	/* This `Node`'s Chrome raw role. */
+	ChromeRole *AXValue `json:"chromeRole,omitempty"`
+	/* The accessible name for this `Node`. */
+	Name *AXValue `json:"name,omitempty"`
+	/* The accessible description for this `Node`. */
+	Description *AXValue `json:"description,omitempty"`
+	/* The value for this `Node`. */
+	Value *AXValue `json:"value,omitempty"`
+	/* All other properties */
+	Properties []AXProperty `json:"properties"`
+	/* ID for this node's parent. */
+	ParentId *AXNodeId `json:"parentId,omitempty"`
+	/* IDs for each of this node's child nodes. */
+	ChildIds []AXNodeId `json:"childIds"`
+	/* The backend ID for the associated DOM node, if any. */
+	BackendDOMNodeId *cdp.DOMBackendNodeId `json:"backendDOMNodeId,omitempty"`
+	/* The frame ID for the frame associated with this nodes document. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+}
+
+// MarshalCDP writes AXNode as JSON
+func (v *AXNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.String(string(v.NodeId))
+	e.Key("ignored")
+	e.Bool(v.Ignored)
+	if v.IgnoredReasons != nil {
+		e.Key("ignoredReasons")
+		e.ArrayStart()
+		for i0 := range v.IgnoredReasons {
+			e.Elem()
+			v.IgnoredReasons[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Role != nil {
+		e.Key("role")
+		(*v.Role).MarshalCDP(e)
+	}
+	if v.ChromeRole != nil {
+		e.Key("chromeRole")
+		(*v.ChromeRole).MarshalCDP(e)
+	}
+	if v.Name != nil {
+		e.Key("name")
+		(*v.Name).MarshalCDP(e)
+	}
+	if v.Description != nil {
+		e.Key("description")
+		(*v.Description).MarshalCDP(e)
+	}
+	if v.Value != nil {
+		e.Key("value")
+		(*v.Value).MarshalCDP(e)
+	}
+	if v.Properties != nil {
+		e.Key("properties")
+		e.ArrayStart()
+		for i0 := range v.Properties {
+			e.Elem()
+			v.Properties[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.ParentId != nil {
+		e.Key("parentId")
+		e.String(string(*v.ParentId))
+	}
+	if v.ChildIds != nil {
+		e.Key("childIds")
+		e.ArrayStart()
+		for i0 := range v.ChildIds {
+			e.Elem()
+			e.String(string(v.ChildIds[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.BackendDOMNodeId != nil {
+		e.Key("backendDOMNodeId")
+		e.Int(int(*v.BackendDOMNodeId))
+	}
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AXNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AXNode from JSON
+func (v *AXNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = AXNodeId(d.String())
+		case "ignored":
+			v.Ignored = d.Bool()
+		case "ignoredReasons":
+			if d.Array() {
+				v.IgnoredReasons = make([]AXProperty, 0)
+				for d.More() {
+					var x0 AXProperty
+					x0.UnmarshalCDP(d)
+					v.IgnoredReasons = append(v.IgnoredReasons, x0)
+				}
+			} else {
+				v.IgnoredReasons = nil
+			}
+		case "role":
+			if d.Null() {
+				v.Role = nil
+			} else {
+				v.Role = new(AXValue)
+				(*v.Role).UnmarshalCDP(d)
+			}
+		case "chromeRole":
+			if d.Null() {
+				v.ChromeRole = nil
+			} else {
+				v.ChromeRole = new(AXValue)
+				(*v.ChromeRole).UnmarshalCDP(d)
+			}
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(AXValue)
+				(*v.Name).UnmarshalCDP(d)
+			}
+		case "description":
+			if d.Null() {
+				v.Description = nil
+			} else {
+				v.Description = new(AXValue)
+				(*v.Description).UnmarshalCDP(d)
+			}
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(AXValue)
+				(*v.Value).UnmarshalCDP(d)
+			}
+		case "properties":
+			if d.Array() {
+				v.Properties = make([]AXProperty, 0)
+				for d.More() {
+					var x0 AXProperty
+					x0.UnmarshalCDP(d)
+					v.Properties = append(v.Properties, x0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		case "parentId":
+			if d.Null() {
+				v.ParentId = nil
+			} else {
+				v.ParentId = new(AXNodeId)
+				*v.ParentId = AXNodeId(d.String())
+			}
+		case "childIds":
+			if d.Array() {
+				v.ChildIds = make([]AXNodeId, 0)
+				for d.More() {
+					var x0 AXNodeId
+					x0 = AXNodeId(d.String())
+					v.ChildIds = append(v.ChildIds, x0)
+				}
+			} else {
+				v.ChildIds = nil
+			}
+		case "backendDOMNodeId":
+			if d.Null() {
+				v.BackendDOMNodeId = nil
+			} else {
+				v.BackendDOMNodeId = new(cdp.DOMBackendNodeId)
+				*v.BackendDOMNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "frameId":
+			if d.Null() {
+				v.FrameId = nil
+			} else {
+				v.FrameId = new(cdp.PageFrameId)
+				*v.FrameId = cdp.PageFrameId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AXNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables the accessibility domain.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables the accessibility domain which causes `AXNodeId`s to remain consistent between method calls.
+// This turns on accessibility for the page, which can impact performance until accessibility is disabled.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetPartialAXTreeReturns struct {
+
+	/* The `Accessibility.AXNode` for this DOM node, if it exists, plus its ancestors, siblings and
+	children, if requested. */
+	Nodes []AXNode `json:"nodes"`
+}
+
+// UnmarshalCDP reads GetPartialAXTreeReturns from JSON
+func (v *GetPartialAXTreeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]AXNode, 0)
+				for d.More() {
+					var x0 AXNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetPartialAXTreeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetPartialAXTreeParams are the parameters for Accessibility.getPartialAXTree
+// optional parameters are left out when nil
+type GetPartialAXTreeParams struct {
+	/* Identifier of the node to get the partial accessibility tree for. */
+	NodeId *cdp.DOMNodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node to get the partial accessibility tree for. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper to get the partial accessibility tree for. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* Whether to fetch this node's ancestors, siblings and children. Defaults to true. */
+	FetchRelatives *bool `json:"fetchRelatives,omitempty"`
+}
+
+// MarshalCDP writes GetPartialAXTreeParams as JSON
+func (v *GetPartialAXTreeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.FetchRelatives != nil {
+		e.Key("fetchRelatives")
+		e.Bool(*v.FetchRelatives)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetPartialAXTreeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetPartialAXTree(params GetPartialAXTreeParams) (GetPartialAXTreeReturns, error) {
+	return c.GetPartialAXTreeContext(context.Background(), params)
+}
+
+// GetPartialAXTreeContext is GetPartialAXTree with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetPartialAXTreeContext(ctx context.Context, params GetPartialAXTreeParams) (GetPartialAXTreeReturns, error) {
+	var returns_ GetPartialAXTreeReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.getPartialAXTree", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetFullAXTreeReturns struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// UnmarshalCDP reads GetFullAXTreeReturns from JSON
+func (v *GetFullAXTreeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]AXNode, 0)
+				for d.More() {
+					var x0 AXNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetFullAXTreeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetFullAXTreeParams are the parameters for Accessibility.getFullAXTree
+// optional parameters are left out when nil
+type GetFullAXTreeParams struct {
+	/* The maximum depth at which descendants of the root node should be retrieved.
+	If omitted, the full tree is returned. */
+	Depth *int `json:"depth,omitempty"`
+	/* The frame for whose document the AX tree should be retrieved.
+	If omitted, the root frame is used. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+}
+
+// MarshalCDP writes GetFullAXTreeParams as JSON
+func (v *GetFullAXTreeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Depth != nil {
+		e.Key("depth")
+		e.Int(*v.Depth)
+	}
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetFullAXTreeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches the entire accessibility tree for the root Document
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetFullAXTree(params GetFullAXTreeParams) (GetFullAXTreeReturns, error) {
+	return c.GetFullAXTreeContext(context.Background(), params)
+}
+
+// GetFullAXTreeContext is GetFullAXTree with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetFullAXTreeContext(ctx context.Context, params GetFullAXTreeParams) (GetFullAXTreeReturns, error) {
+	var returns_ GetFullAXTreeReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.getFullAXTree", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetRootAXNodeReturns struct {
+	Node AXNode `json:"node"`
+}
+
+// UnmarshalCDP reads GetRootAXNodeReturns from JSON
+func (v *GetRootAXNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "node":
+			v.Node.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetRootAXNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetRootAXNodeParams are the parameters for Accessibility.getRootAXNode
+// optional parameters are left out when nil
+type GetRootAXNodeParams struct {
+	/* The frame in whose document the node resides.
+	If omitted, the root frame is used. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+}
+
+// MarshalCDP writes GetRootAXNodeParams as JSON
+func (v *GetRootAXNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetRootAXNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches the root node.
+// Requires `enable()` to have been called previously.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetRootAXNode(params GetRootAXNodeParams) (GetRootAXNodeReturns, error) {
+	return c.GetRootAXNodeContext(context.Background(), params)
+}
+
+// GetRootAXNodeContext is GetRootAXNode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetRootAXNodeContext(ctx context.Context, params GetRootAXNodeParams) (GetRootAXNodeReturns, error) {
+	var returns_ GetRootAXNodeReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.getRootAXNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetAXNodeAndAncestorsReturns struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// UnmarshalCDP reads GetAXNodeAndAncestorsReturns from JSON
+func (v *GetAXNodeAndAncestorsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]AXNode, 0)
+				for d.More() {
+					var x0 AXNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAXNodeAndAncestorsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAXNodeAndAncestorsParams are the parameters for Accessibility.getAXNodeAndAncestors
+// optional parameters are left out when nil
+type GetAXNodeAndAncestorsParams struct {
+	/* Identifier of the node to get. */
+	NodeId *cdp.DOMNodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node to get. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper to get. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes GetAXNodeAndAncestorsParams as JSON
+func (v *GetAXNodeAndAncestorsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAXNodeAndAncestorsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches a node and all ancestors up to and including the root.
+// Requires `enable()` to have been called previously.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAXNodeAndAncestors(params GetAXNodeAndAncestorsParams) (GetAXNodeAndAncestorsReturns, error) {
+	return c.GetAXNodeAndAncestorsContext(context.Background(), params)
+}
+
+// GetAXNodeAndAncestorsContext is GetAXNodeAndAncestors with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAXNodeAndAncestorsContext(ctx context.Context, params GetAXNodeAndAncestorsParams) (GetAXNodeAndAncestorsReturns, error) {
+	var returns_ GetAXNodeAndAncestorsReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.getAXNodeAndAncestors", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetChildAXNodesReturns struct {
+	Nodes []AXNode `json:"nodes"`
+}
+
+// UnmarshalCDP reads GetChildAXNodesReturns from JSON
+func (v *GetChildAXNodesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]AXNode, 0)
+				for d.More() {
+					var x0 AXNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetChildAXNodesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetChildAXNodesParams are the parameters for Accessibility.getChildAXNodes
+// optional parameters are left out when nil
+type GetChildAXNodesParams struct {
+	Id AXNodeId `json:"id"`
+	/* The frame in whose document the node resides.
+	If omitted, the root frame is used. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+}
+
+// MarshalCDP writes GetChildAXNodesParams as JSON
+func (v *GetChildAXNodesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(string(v.Id))
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetChildAXNodesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches a particular accessibility node by AXNodeId.
+// Requires `enable()` to have been called previously.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetChildAXNodes(params GetChildAXNodesParams) (GetChildAXNodesReturns, error) {
+	return c.GetChildAXNodesContext(context.Background(), params)
+}
+
+// GetChildAXNodesContext is GetChildAXNodes with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetChildAXNodesContext(ctx context.Context, params GetChildAXNodesParams) (GetChildAXNodesReturns, error) {
+	var returns_ GetChildAXNodesReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.getChildAXNodes", &params, &returns_)
+
+	return returns_, err_
+}
+
+type QueryAXTreeReturns struct {
+
+	/* A list of `Accessibility.AXNode` matching the specified attributes,
+	including nodes that are ignored for accessibility. */
+	Nodes []AXNode `json:"nodes"`
+}
+
+// UnmarshalCDP reads QueryAXTreeReturns from JSON
+func (v *QueryAXTreeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]AXNode, 0)
+				for d.More() {
+					var x0 AXNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *QueryAXTreeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// QueryAXTreeParams are the parameters for Accessibility.queryAXTree
+// optional parameters are left out when nil
+type QueryAXTreeParams struct {
+	/* Identifier of the node for the root to query. */
+	NodeId *cdp.DOMNodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node for the root to query. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper for the root to query. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* Find nodes with this computed name. */
+	AccessibleName *string `json:"accessibleName,omitempty"`
+	/* Find nodes with this computed role. */
+	Role *string `json:"role,omitempty"`
+}
+
+// MarshalCDP writes QueryAXTreeParams as JSON
+func (v *QueryAXTreeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.AccessibleName != nil {
+		e.Key("accessibleName")
+		e.String(*v.AccessibleName)
+	}
+	if v.Role != nil {
+		e.Key("role")
+		e.String(*v.Role)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v QueryAXTreeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Query a DOM node's accessibility subtree for accessible name and role.
+// This command computes the name and role for all nodes in the subtree, including those that are
+// ignored for accessibility, and returns those that match the specified name and role. If no DOM
+// node is specified, or the DOM node does not exist, the command returns an error. If neither
+// `accessibleName` or `role` is specified, it returns all the accessibility nodes in the subtree.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) QueryAXTree(params QueryAXTreeParams) (QueryAXTreeReturns, error) {
+	return c.QueryAXTreeContext(context.Background(), params)
+}
+
+// QueryAXTreeContext is QueryAXTree with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) QueryAXTreeContext(ctx context.Context, params QueryAXTreeParams) (QueryAXTreeReturns, error) {
+	var returns_ QueryAXTreeReturns
+
+	err_ := c.caller.Call(ctx, "Accessibility.queryAXTree", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// The loadComplete event mirrors the load complete event sent by the browser to assistive
+// technology when the web page has finished loading.
+//
+// Experimental: this may change or be removed in any chrome release
+type LoadCompleteEvent struct {
+
+	/* New document root node. */
+	Root AXNode `json:"root"`
+}
+
+// UnmarshalCDP reads LoadCompleteEvent from JSON
+func (v *LoadCompleteEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "root":
+			v.Root.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadCompleteEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LoadCompleteHandler func(ev LoadCompleteEvent)
+
+// EventMethod is Accessibility.loadComplete
+func (LoadCompleteEvent) EventMethod() string {
+	return "Accessibility.loadComplete"
+}
+
+// OnLoadComplete calls handler for each Accessibility.loadComplete event
+func (c Client) OnLoadComplete(handler LoadCompleteHandler) (unsubscribe func()) {
+	return c.caller.On("Accessibility.loadComplete", func(ev interface{}) {
+		handler(ev.(LoadCompleteEvent))
+	})
+}
+
+// The nodesUpdated event is sent every time a previously requested node has changed the in tree.
+//
+// Experimental: this may change or be removed in any chrome release
+type NodesUpdatedEvent struct {
+
+	/* Updated node data. */
+	Nodes []AXNode `json:"nodes"`
+}
+
+// UnmarshalCDP reads NodesUpdatedEvent from JSON
+func (v *NodesUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]AXNode, 0)
+				for d.More() {
+					var x0 AXNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodesUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NodesUpdatedHandler func(ev NodesUpdatedEvent)
+
+// EventMethod is Accessibility.nodesUpdated
+func (NodesUpdatedEvent) EventMethod() string {
+	return "Accessibility.nodesUpdated"
+}
+
+// OnNodesUpdated calls handler for each Accessibility.nodesUpdated event
+func (c Client) OnNodesUpdated(handler NodesUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Accessibility.nodesUpdated", func(ev interface{}) {
+		handler(ev.(NodesUpdatedEvent))
+	})
+}
+
+// decoders for Accessibility events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Accessibility.loadComplete": cdp.Decode[LoadCompleteEvent],
+	"Accessibility.nodesUpdated": cdp.Decode[NodesUpdatedEvent],
+}
+
+// DecodeEvent decodes a Accessibility event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
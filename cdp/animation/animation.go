@@ -0,0 +1,1229 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package animation is the Animation domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package animation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Animation commands and listens for Animation events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Animation instance.
+type Animation struct {
+	/* `Animation`'s id. */
+	Id string `json:"id"`
+	/* `Animation`'s name. */
+	Name string `json:"name"`
+	/* `Animation`'s internal paused state. */
+	PausedState bool `json:"pausedState"`
+	/* `Animation`'s play state. */
+	PlayState string `json:"playState"`
+	/* `Animation`'s playback rate. */
+	PlaybackRate float64 `json:"playbackRate"`
+	/* `Animation`'s start time.
+	Milliseconds for time based animations and
+	percentage [0 - 100] for scroll driven animations
+	(i.e. when viewOrScrollTimeline exists). */
+	StartTime float64 `json:"startTime"`
+	/* `Animation`'s current time. */
+	CurrentTime float64 `json:"currentTime"`
+	/* Animation type of `Animation`. */
+	Type AnimationType `json:"type"`
+	/* `Animation`'s source animation node. */
+	Source *AnimationEffect `json:"source,omitempty"`
+	/* A unique ID for `Animation` representing the sources that triggered this CSS
+	animation/transition. */
+	CssId *string `json:"cssId,omitempty"`
+	/* View or scroll timeline */
+	ViewOrScrollTimeline *ViewOrScrollTimeline `json:"viewOrScrollTimeline,omitempty"`
+}
+
+// MarshalCDP writes Animation as JSON
+func (v *Animation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("pausedState")
+	e.Bool(v.PausedState)
+	e.Key("playState")
+	e.String(v.PlayState)
+	e.Key("playbackRate")
+	e.Float(v.PlaybackRate)
+	e.Key("startTime")
+	e.Float(v.StartTime)
+	e.Key("currentTime")
+	e.Float(v.CurrentTime)
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Source != nil {
+		e.Key("source")
+		(*v.Source).MarshalCDP(e)
+	}
+	if v.CssId != nil {
+		e.Key("cssId")
+		e.String(*v.CssId)
+	}
+	if v.ViewOrScrollTimeline != nil {
+		e.Key("viewOrScrollTimeline")
+		(*v.ViewOrScrollTimeline).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Animation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Animation from JSON
+func (v *Animation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		case "name":
+			v.Name = d.String()
+		case "pausedState":
+			v.PausedState = d.Bool()
+		case "playState":
+			v.PlayState = d.String()
+		case "playbackRate":
+			v.PlaybackRate = d.Float()
+		case "startTime":
+			v.StartTime = d.Float()
+		case "currentTime":
+			v.CurrentTime = d.Float()
+		case "type":
+			v.Type = AnimationType(d.String())
+		case "source":
+			if d.Null() {
+				v.Source = nil
+			} else {
+				v.Source = new(AnimationEffect)
+				(*v.Source).UnmarshalCDP(d)
+			}
+		case "cssId":
+			if d.Null() {
+				v.CssId = nil
+			} else {
+				v.CssId = new(string)
+				*v.CssId = d.String()
+			}
+		case "viewOrScrollTimeline":
+			if d.Null() {
+				v.ViewOrScrollTimeline = nil
+			} else {
+				v.ViewOrScrollTimeline = new(ViewOrScrollTimeline)
+				(*v.ViewOrScrollTimeline).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Animation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AnimationType string
+
+// AnimationType values
+const (
+	AnimationTypeCSSTransition AnimationType = "CSSTransition"
+	AnimationTypeCSSAnimation  AnimationType = "CSSAnimation"
+	AnimationTypeWebAnimation  AnimationType = "WebAnimation"
+)
+
+// Values gives every AnimationType
+func (AnimationType) Values() []AnimationType {
+	return []AnimationType{
+		AnimationTypeCSSTransition,
+		AnimationTypeCSSAnimation,
+		AnimationTypeWebAnimation,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AnimationType) Valid() bool {
+	switch v {
+	case AnimationTypeCSSTransition, AnimationTypeCSSAnimation, AnimationTypeWebAnimation:
+		return true
+	}
+	return false
+}
+
+// Timeline instance
+type ViewOrScrollTimeline struct {
+	/* Scroll container node */
+	SourceNodeId *cdp.DOMBackendNodeId `json:"sourceNodeId,omitempty"`
+	/* Represents the starting scroll position of the timeline
+	as a length offset in pixels from scroll origin. */
+	StartOffset *float64 `json:"startOffset,omitempty"`
+	/* Represents the ending scroll position of the timeline
+	as a length offset in pixels from scroll origin. */
+	EndOffset *float64 `json:"endOffset,omitempty"`
+	/* The element whose principal box's visibility in the
+	scrollport defined the progress of the timeline.
+	Does not exist for animations with ScrollTimeline */
+	SubjectNodeId *cdp.DOMBackendNodeId `json:"subjectNodeId,omitempty"`
+	/* Orientation of the scroll */
+	Axis cdp.DOMScrollOrientation `json:"axis"`
+}
+
+// MarshalCDP writes ViewOrScrollTimeline as JSON
+func (v *ViewOrScrollTimeline) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SourceNodeId != nil {
+		e.Key("sourceNodeId")
+		e.Int(int(*v.SourceNodeId))
+	}
+	if v.StartOffset != nil {
+		e.Key("startOffset")
+		e.Float(*v.StartOffset)
+	}
+	if v.EndOffset != nil {
+		e.Key("endOffset")
+		e.Float(*v.EndOffset)
+	}
+	if v.SubjectNodeId != nil {
+		e.Key("subjectNodeId")
+		e.Int(int(*v.SubjectNodeId))
+	}
+	e.Key("axis")
+	e.String(string(v.Axis))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ViewOrScrollTimeline) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ViewOrScrollTimeline from JSON
+func (v *ViewOrScrollTimeline) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sourceNodeId":
+			if d.Null() {
+				v.SourceNodeId = nil
+			} else {
+				v.SourceNodeId = new(cdp.DOMBackendNodeId)
+				*v.SourceNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "startOffset":
+			if d.Null() {
+				v.StartOffset = nil
+			} else {
+				v.StartOffset = new(float64)
+				*v.StartOffset = d.Float()
+			}
+		case "endOffset":
+			if d.Null() {
+				v.EndOffset = nil
+			} else {
+				v.EndOffset = new(float64)
+				*v.EndOffset = d.Float()
+			}
+		case "subjectNodeId":
+			if d.Null() {
+				v.SubjectNodeId = nil
+			} else {
+				v.SubjectNodeId = new(cdp.DOMBackendNodeId)
+				*v.SubjectNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "axis":
+			v.Axis = cdp.DOMScrollOrientation(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ViewOrScrollTimeline) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AnimationEffect instance
+type AnimationEffect struct {
+	/* `AnimationEffect`'s delay. */
+	Delay float64 `json:"delay"`
+	/* `AnimationEffect`'s end delay. */
+	EndDelay float64 `json:"endDelay"`
+	/* `AnimationEffect`'s iteration start. */
+	IterationStart float64 `json:"iterationStart"`
+	/* `AnimationEffect`'s iterations. */
+	Iterations float64 `json:"iterations"`
+	/* `AnimationEffect`'s iteration duration.
+	Milliseconds for time based animations and
+	percentage [0 - 100] for scroll driven animations
+	(i.e. when viewOrScrollTimeline exists). */
+	Duration float64 `json:"duration"`
+	/* `AnimationEffect`'s playback direction. */
+	Direction string `json:"direction"`
+	/* `AnimationEffect`'s fill mode. */
+	Fill string `json:"fill"`
+	/* `AnimationEffect`'s target node. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* `AnimationEffect`'s keyframes. */
+	KeyframesRule *KeyframesRule `json:"keyframesRule,omitempty"`
+	/* `AnimationEffect`'s timing function. */
+	Easing string `json:"easing"`
+}
+
+// MarshalCDP writes AnimationEffect as JSON
+func (v *AnimationEffect) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("delay")
+	e.Float(v.Delay)
+	e.Key("endDelay")
+	e.Float(v.EndDelay)
+	e.Key("iterationStart")
+	e.Float(v.IterationStart)
+	e.Key("iterations")
+	e.Float(v.Iterations)
+	e.Key("duration")
+	e.Float(v.Duration)
+	e.Key("direction")
+	e.String(v.Direction)
+	e.Key("fill")
+	e.String(v.Fill)
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.KeyframesRule != nil {
+		e.Key("keyframesRule")
+		(*v.KeyframesRule).MarshalCDP(e)
+	}
+	e.Key("easing")
+	e.String(v.Easing)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AnimationEffect) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AnimationEffect from JSON
+func (v *AnimationEffect) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "delay":
+			v.Delay = d.Float()
+		case "endDelay":
+			v.EndDelay = d.Float()
+		case "iterationStart":
+			v.IterationStart = d.Float()
+		case "iterations":
+			v.Iterations = d.Float()
+		case "duration":
+			v.Duration = d.Float()
+		case "direction":
+			v.Direction = d.String()
+		case "fill":
+			v.Fill = d.String()
+		case "backendNodeId":
+			if d.Null() {
+				v.BackendNodeId = nil
+			} else {
+				v.BackendNodeId = new(cdp.DOMBackendNodeId)
+				*v.BackendNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "keyframesRule":
+			if d.Null() {
+				v.KeyframesRule = nil
+			} else {
+				v.KeyframesRule = new(KeyframesRule)
+				(*v.KeyframesRule).UnmarshalCDP(d)
+			}
+		case "easing":
+			v.Easing = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AnimationEffect) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Keyframes Rule
+type KeyframesRule struct {
+	/* CSS keyframed animation's name. */
+	Name *string `json:"name,omitempty"`
+	/* List of animation keyframes. */
+	Keyframes []KeyframeStyle `json:"keyframes"`
+}
+
+// MarshalCDP writes KeyframesRule as JSON
+func (v *KeyframesRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	e.Key("keyframes")
+	if v.Keyframes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Keyframes {
+			e.Elem()
+			v.Keyframes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v KeyframesRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads KeyframesRule from JSON
+func (v *KeyframesRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "keyframes":
+			if d.Array() {
+				v.Keyframes = make([]KeyframeStyle, 0)
+				for d.More() {
+					var x0 KeyframeStyle
+					x0.UnmarshalCDP(d)
+					v.Keyframes = append(v.Keyframes, x0)
+				}
+			} else {
+				v.Keyframes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *KeyframesRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Keyframe Style
+type KeyframeStyle struct {
+	/* Keyframe's time offset. */
+	Offset string `json:"offset"`
+	/* `AnimationEffect`'s timing function. */
+	Easing string `json:"easing"`
+}
+
+// MarshalCDP writes KeyframeStyle as JSON
+func (v *KeyframeStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("offset")
+	e.String(v.Offset)
+	e.Key("easing")
+	e.String(v.Easing)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v KeyframeStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads KeyframeStyle from JSON
+func (v *KeyframeStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "offset":
+			v.Offset = d.String()
+		case "easing":
+			v.Easing = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *KeyframeStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables animation domain notifications.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Animation.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables animation domain notifications.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Animation.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetCurrentTimeReturns struct {
+
+	/* Current time of the page. */
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// UnmarshalCDP reads GetCurrentTimeReturns from JSON
+func (v *GetCurrentTimeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "currentTime":
+			v.CurrentTime = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCurrentTimeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetCurrentTimeParams are the parameters for Animation.getCurrentTime
+// optional parameters are left out when nil
+type GetCurrentTimeParams struct {
+	/* Id of animation. */
+	Id string `json:"id"`
+}
+
+// MarshalCDP writes GetCurrentTimeParams as JSON
+func (v *GetCurrentTimeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetCurrentTimeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the current time of the an animation.
+func (c Client) GetCurrentTime(params GetCurrentTimeParams) (GetCurrentTimeReturns, error) {
+	return c.GetCurrentTimeContext(context.Background(), params)
+}
+
+// GetCurrentTimeContext is GetCurrentTime with a context for cancellation and deadlines
+func (c Client) GetCurrentTimeContext(ctx context.Context, params GetCurrentTimeParams) (GetCurrentTimeReturns, error) {
+	var returns_ GetCurrentTimeReturns
+
+	err_ := c.caller.Call(ctx, "Animation.getCurrentTime", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetPlaybackRateReturns struct {
+
+	/* Playback rate for animations on page. */
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// UnmarshalCDP reads GetPlaybackRateReturns from JSON
+func (v *GetPlaybackRateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "playbackRate":
+			v.PlaybackRate = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetPlaybackRateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Gets the playback rate of the document timeline.
+func (c Client) GetPlaybackRate() (GetPlaybackRateReturns, error) {
+	return c.GetPlaybackRateContext(context.Background())
+}
+
+// GetPlaybackRateContext is GetPlaybackRate with a context for cancellation and deadlines
+func (c Client) GetPlaybackRateContext(ctx context.Context) (GetPlaybackRateReturns, error) {
+	var returns_ GetPlaybackRateReturns
+
+	err_ := c.caller.Call(ctx, "Animation.getPlaybackRate", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ReleaseAnimationsReturns struct {
+}
+
+// UnmarshalCDP reads ReleaseAnimationsReturns from JSON
+func (v *ReleaseAnimationsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReleaseAnimationsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReleaseAnimationsParams are the parameters for Animation.releaseAnimations
+// optional parameters are left out when nil
+type ReleaseAnimationsParams struct {
+	/* List of animation ids to seek. */
+	Animations []string `json:"animations"`
+}
+
+// MarshalCDP writes ReleaseAnimationsParams as JSON
+func (v *ReleaseAnimationsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("animations")
+	if v.Animations == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Animations {
+			e.Elem()
+			e.String(v.Animations[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReleaseAnimationsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Releases a set of animations to no longer be manipulated.
+func (c Client) ReleaseAnimations(params ReleaseAnimationsParams) (ReleaseAnimationsReturns, error) {
+	return c.ReleaseAnimationsContext(context.Background(), params)
+}
+
+// ReleaseAnimationsContext is ReleaseAnimations with a context for cancellation and deadlines
+func (c Client) ReleaseAnimationsContext(ctx context.Context, params ReleaseAnimationsParams) (ReleaseAnimationsReturns, error) {
+	var returns_ ReleaseAnimationsReturns
+
+	err_ := c.caller.Call(ctx, "Animation.releaseAnimations", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ResolveAnimationReturns struct {
+
+	/* Corresponding remote object. */
+	RemoteObject cdp.RuntimeRemoteObject `json:"remoteObject"`
+}
+
+// UnmarshalCDP reads ResolveAnimationReturns from JSON
+func (v *ResolveAnimationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "remoteObject":
+			v.RemoteObject.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResolveAnimationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ResolveAnimationParams are the parameters for Animation.resolveAnimation
+// optional parameters are left out when nil
+type ResolveAnimationParams struct {
+	/* Animation id. */
+	AnimationId string `json:"animationId"`
+}
+
+// MarshalCDP writes ResolveAnimationParams as JSON
+func (v *ResolveAnimationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("animationId")
+	e.String(v.AnimationId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResolveAnimationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets the remote object of the Animation.
+func (c Client) ResolveAnimation(params ResolveAnimationParams) (ResolveAnimationReturns, error) {
+	return c.ResolveAnimationContext(context.Background(), params)
+}
+
+// ResolveAnimationContext is ResolveAnimation with a context for cancellation and deadlines
+func (c Client) ResolveAnimationContext(ctx context.Context, params ResolveAnimationParams) (ResolveAnimationReturns, error) {
+	var returns_ ResolveAnimationReturns
+
+	err_ := c.caller.Call(ctx, "Animation.resolveAnimation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SeekAnimationsReturns struct {
+}
+
+// UnmarshalCDP reads SeekAnimationsReturns from JSON
+func (v *SeekAnimationsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SeekAnimationsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SeekAnimationsParams are the parameters for Animation.seekAnimations
+// optional parameters are left out when nil
+type SeekAnimationsParams struct {
+	/* List of animation ids to seek. */
+	Animations []string `json:"animations"`
+	/* Set the current time of each animation. */
+	CurrentTime float64 `json:"currentTime"`
+}
+
+// MarshalCDP writes SeekAnimationsParams as JSON
+func (v *SeekAnimationsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("animations")
+	if v.Animations == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Animations {
+			e.Elem()
+			e.String(v.Animations[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("currentTime")
+	e.Float(v.CurrentTime)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SeekAnimationsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Seek a set of animations to a particular time within each animation.
+func (c Client) SeekAnimations(params SeekAnimationsParams) (SeekAnimationsReturns, error) {
+	return c.SeekAnimationsContext(context.Background(), params)
+}
+
+// SeekAnimationsContext is SeekAnimations with a context for cancellation and deadlines
+func (c Client) SeekAnimationsContext(ctx context.Context, params SeekAnimationsParams) (SeekAnimationsReturns, error) {
+	var returns_ SeekAnimationsReturns
+
+	err_ := c.caller.Call(ctx, "Animation.seekAnimations", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPausedReturns struct {
+}
+
+// UnmarshalCDP reads SetPausedReturns from JSON
+func (v *SetPausedReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPausedReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPausedParams are the parameters for Animation.setPaused
+// optional parameters are left out when nil
+type SetPausedParams struct {
+	/* Animations to set the pause state of. */
+	Animations []string `json:"animations"`
+	/* Paused state to set to. */
+	Paused bool `json:"paused"`
+}
+
+// MarshalCDP writes SetPausedParams as JSON
+func (v *SetPausedParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("animations")
+	if v.Animations == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Animations {
+			e.Elem()
+			e.String(v.Animations[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("paused")
+	e.Bool(v.Paused)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPausedParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets the paused state of a set of animations.
+func (c Client) SetPaused(params SetPausedParams) (SetPausedReturns, error) {
+	return c.SetPausedContext(context.Background(), params)
+}
+
+// SetPausedContext is SetPaused with a context for cancellation and deadlines
+func (c Client) SetPausedContext(ctx context.Context, params SetPausedParams) (SetPausedReturns, error) {
+	var returns_ SetPausedReturns
+
+	err_ := c.caller.Call(ctx, "Animation.setPaused", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPlaybackRateReturns struct {
+}
+
+// UnmarshalCDP reads SetPlaybackRateReturns from JSON
+func (v *SetPlaybackRateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPlaybackRateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPlaybackRateParams are the parameters for Animation.setPlaybackRate
+// optional parameters are left out when nil
+type SetPlaybackRateParams struct {
+	/* Playback rate for animations on page */
+	PlaybackRate float64 `json:"playbackRate"`
+}
+
+// MarshalCDP writes SetPlaybackRateParams as JSON
+func (v *SetPlaybackRateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("playbackRate")
+	e.Float(v.PlaybackRate)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPlaybackRateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets the playback rate of the document timeline.
+func (c Client) SetPlaybackRate(params SetPlaybackRateParams) (SetPlaybackRateReturns, error) {
+	return c.SetPlaybackRateContext(context.Background(), params)
+}
+
+// SetPlaybackRateContext is SetPlaybackRate with a context for cancellation and deadlines
+func (c Client) SetPlaybackRateContext(ctx context.Context, params SetPlaybackRateParams) (SetPlaybackRateReturns, error) {
+	var returns_ SetPlaybackRateReturns
+
+	err_ := c.caller.Call(ctx, "Animation.setPlaybackRate", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetTimingReturns struct {
+}
+
+// UnmarshalCDP reads SetTimingReturns from JSON
+func (v *SetTimingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetTimingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetTimingParams are the parameters for Animation.setTiming
+// optional parameters are left out when nil
+type SetTimingParams struct {
+	/* Animation id. */
+	AnimationId string `json:"animationId"`
+	/* Duration of the animation. */
+	Duration float64 `json:"duration"`
+	/* Delay of the animation. */
+	Delay float64 `json:"delay"`
+}
+
+// MarshalCDP writes SetTimingParams as JSON
+func (v *SetTimingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("animationId")
+	e.String(v.AnimationId)
+	e.Key("duration")
+	e.Float(v.Duration)
+	e.Key("delay")
+	e.Float(v.Delay)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetTimingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets the timing of an animation node.
+func (c Client) SetTiming(params SetTimingParams) (SetTimingReturns, error) {
+	return c.SetTimingContext(context.Background(), params)
+}
+
+// SetTimingContext is SetTiming with a context for cancellation and deadlines
+func (c Client) SetTimingContext(ctx context.Context, params SetTimingParams) (SetTimingReturns, error) {
+	var returns_ SetTimingReturns
+
+	err_ := c.caller.Call(ctx, "Animation.setTiming", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Event for when an animation has been cancelled.
+type AnimationCanceledEvent struct {
+
+	/* Id of the animation that was cancelled. */
+	Id string `json:"id"`
+}
+
+// UnmarshalCDP reads AnimationCanceledEvent from JSON
+func (v *AnimationCanceledEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AnimationCanceledEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AnimationCanceledHandler func(ev AnimationCanceledEvent)
+
+// EventMethod is Animation.animationCanceled
+func (AnimationCanceledEvent) EventMethod() string {
+	return "Animation.animationCanceled"
+}
+
+// OnAnimationCanceled calls handler for each Animation.animationCanceled event
+func (c Client) OnAnimationCanceled(handler AnimationCanceledHandler) (unsubscribe func()) {
+	return c.caller.On("Animation.animationCanceled", func(ev interface{}) {
+		handler(ev.(AnimationCanceledEvent))
+	})
+}
+
+// Event for each animation that has been created.
+type AnimationCreatedEvent struct {
+
+	/* Id of the animation that was created. */
+	Id string `json:"id"`
+}
+
+// UnmarshalCDP reads AnimationCreatedEvent from JSON
+func (v *AnimationCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AnimationCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AnimationCreatedHandler func(ev AnimationCreatedEvent)
+
+// EventMethod is Animation.animationCreated
+func (AnimationCreatedEvent) EventMethod() string {
+	return "Animation.animationCreated"
+}
+
+// OnAnimationCreated calls handler for each Animation.animationCreated event
+func (c Client) OnAnimationCreated(handler AnimationCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Animation.animationCreated", func(ev interface{}) {
+		handler(ev.(AnimationCreatedEvent))
+	})
+}
+
+// Event for animation that has been started.
+type AnimationStartedEvent struct {
+
+	/* Animation that was started. */
+	Animation Animation `json:"animation"`
+}
+
+// UnmarshalCDP reads AnimationStartedEvent from JSON
+func (v *AnimationStartedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "animation":
+			v.Animation.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AnimationStartedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AnimationStartedHandler func(ev AnimationStartedEvent)
+
+// EventMethod is Animation.animationStarted
+func (AnimationStartedEvent) EventMethod() string {
+	return "Animation.animationStarted"
+}
+
+// OnAnimationStarted calls handler for each Animation.animationStarted event
+func (c Client) OnAnimationStarted(handler AnimationStartedHandler) (unsubscribe func()) {
+	return c.caller.On("Animation.animationStarted", func(ev interface{}) {
+		handler(ev.(AnimationStartedEvent))
+	})
+}
+
+// Event for animation that has been updated.
+type AnimationUpdatedEvent struct {
+
+	/* Animation that was updated. */
+	Animation Animation `json:"animation"`
+}
+
+// UnmarshalCDP reads AnimationUpdatedEvent from JSON
+func (v *AnimationUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "animation":
+			v.Animation.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AnimationUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AnimationUpdatedHandler func(ev AnimationUpdatedEvent)
+
+// EventMethod is Animation.animationUpdated
+func (AnimationUpdatedEvent) EventMethod() string {
+	return "Animation.animationUpdated"
+}
+
+// OnAnimationUpdated calls handler for each Animation.animationUpdated event
+func (c Client) OnAnimationUpdated(handler AnimationUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Animation.animationUpdated", func(ev interface{}) {
+		handler(ev.(AnimationUpdatedEvent))
+	})
+}
+
+// decoders for Animation events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Animation.animationCanceled": cdp.Decode[AnimationCanceledEvent],
+	"Animation.animationCreated":  cdp.Decode[AnimationCreatedEvent],
+	"Animation.animationStarted":  cdp.Decode[AnimationStartedEvent],
+	"Animation.animationUpdated":  cdp.Decode[AnimationUpdatedEvent],
+}
+
+// DecodeEvent decodes a Animation event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
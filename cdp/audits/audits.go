@@ -0,0 +1,3850 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package audits is the Audits domain of the chrome devtools protocol
+//
+// Audits domain allows investigation of page violations and possible improvements.
+//
+// Experimental: this may change or be removed in any chrome release
+package audits
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Audits commands and listens for Audits events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Information about a cookie that is affected by an inspector issue.
+type AffectedCookie struct {
+	/* The following three properties uniquely identify a cookie */
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Domain string `json:"domain"`
+}
+
+// MarshalCDP writes AffectedCookie as JSON
+func (v *AffectedCookie) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("path")
+	e.String(v.Path)
+	e.Key("domain")
+	e.String(v.Domain)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AffectedCookie) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AffectedCookie from JSON
+func (v *AffectedCookie) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "path":
+			v.Path = d.String()
+		case "domain":
+			v.Domain = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AffectedCookie) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about a request that is affected by an inspector issue.
+type AffectedRequest struct {
+	/* The unique request id. */
+	RequestId *cdp.NetworkRequestId `json:"requestId,omitempty"`
+	Url       string                `json:"url"`
+}
+
+// MarshalCDP writes AffectedRequest as JSON
+func (v *AffectedRequest) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.RequestId != nil {
+		e.Key("requestId")
+		e.String(string(*v.RequestId))
+	}
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AffectedRequest) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AffectedRequest from JSON
+func (v *AffectedRequest) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			if d.Null() {
+				v.RequestId = nil
+			} else {
+				v.RequestId = new(cdp.NetworkRequestId)
+				*v.RequestId = cdp.NetworkRequestId(d.String())
+			}
+		case "url":
+			v.Url = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AffectedRequest) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the frame affected by an inspector issue.
+type AffectedFrame struct {
+	FrameId cdp.PageFrameId `json:"frameId"`
+}
+
+// MarshalCDP writes AffectedFrame as JSON
+func (v *AffectedFrame) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AffectedFrame) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AffectedFrame from JSON
+func (v *AffectedFrame) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AffectedFrame) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CookieExclusionReason string
+
+// CookieExclusionReason values
+const (
+	CookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax        CookieExclusionReason = "ExcludeSameSiteUnspecifiedTreatedAsLax"
+	CookieExclusionReasonExcludeSameSiteNoneInsecure                   CookieExclusionReason = "ExcludeSameSiteNoneInsecure"
+	CookieExclusionReasonExcludeSameSiteLax                            CookieExclusionReason = "ExcludeSameSiteLax"
+	CookieExclusionReasonExcludeSameSiteStrict                         CookieExclusionReason = "ExcludeSameSiteStrict"
+	CookieExclusionReasonExcludeInvalidSameParty                       CookieExclusionReason = "ExcludeInvalidSameParty"
+	CookieExclusionReasonExcludeSamePartyCrossPartyContext             CookieExclusionReason = "ExcludeSamePartyCrossPartyContext"
+	CookieExclusionReasonExcludeDomainNonASCII                         CookieExclusionReason = "ExcludeDomainNonASCII"
+	CookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet CookieExclusionReason = "ExcludeThirdPartyCookieBlockedInFirstPartySet"
+	CookieExclusionReasonExcludeThirdPartyPhaseout                     CookieExclusionReason = "ExcludeThirdPartyPhaseout"
+	CookieExclusionReasonExcludePortMismatch                           CookieExclusionReason = "ExcludePortMismatch"
+	CookieExclusionReasonExcludeSchemeMismatch                         CookieExclusionReason = "ExcludeSchemeMismatch"
+)
+
+// Values gives every CookieExclusionReason
+func (CookieExclusionReason) Values() []CookieExclusionReason {
+	return []CookieExclusionReason{
+		CookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax,
+		CookieExclusionReasonExcludeSameSiteNoneInsecure,
+		CookieExclusionReasonExcludeSameSiteLax,
+		CookieExclusionReasonExcludeSameSiteStrict,
+		CookieExclusionReasonExcludeInvalidSameParty,
+		CookieExclusionReasonExcludeSamePartyCrossPartyContext,
+		CookieExclusionReasonExcludeDomainNonASCII,
+		CookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet,
+		CookieExclusionReasonExcludeThirdPartyPhaseout,
+		CookieExclusionReasonExcludePortMismatch,
+		CookieExclusionReasonExcludeSchemeMismatch,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CookieExclusionReason) Valid() bool {
+	switch v {
+	case CookieExclusionReasonExcludeSameSiteUnspecifiedTreatedAsLax, CookieExclusionReasonExcludeSameSiteNoneInsecure, CookieExclusionReasonExcludeSameSiteLax, CookieExclusionReasonExcludeSameSiteStrict, CookieExclusionReasonExcludeInvalidSameParty, CookieExclusionReasonExcludeSamePartyCrossPartyContext, CookieExclusionReasonExcludeDomainNonASCII, CookieExclusionReasonExcludeThirdPartyCookieBlockedInFirstPartySet, CookieExclusionReasonExcludeThirdPartyPhaseout, CookieExclusionReasonExcludePortMismatch, CookieExclusionReasonExcludeSchemeMismatch:
+		return true
+	}
+	return false
+}
+
+type CookieWarningReason string
+
+// CookieWarningReason values
+const (
+	CookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext        CookieWarningReason = "WarnSameSiteUnspecifiedCrossSiteContext"
+	CookieWarningReasonWarnSameSiteNoneInsecure                       CookieWarningReason = "WarnSameSiteNoneInsecure"
+	CookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe          CookieWarningReason = "WarnSameSiteUnspecifiedLaxAllowUnsafe"
+	CookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict           CookieWarningReason = "WarnSameSiteStrictLaxDowngradeStrict"
+	CookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict         CookieWarningReason = "WarnSameSiteStrictCrossDowngradeStrict"
+	CookieWarningReasonWarnSameSiteStrictCrossDowngradeLax            CookieWarningReason = "WarnSameSiteStrictCrossDowngradeLax"
+	CookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict            CookieWarningReason = "WarnSameSiteLaxCrossDowngradeStrict"
+	CookieWarningReasonWarnSameSiteLaxCrossDowngradeLax               CookieWarningReason = "WarnSameSiteLaxCrossDowngradeLax"
+	CookieWarningReasonWarnAttributeValueExceedsMaxSize               CookieWarningReason = "WarnAttributeValueExceedsMaxSize"
+	CookieWarningReasonWarnDomainNonASCII                             CookieWarningReason = "WarnDomainNonASCII"
+	CookieWarningReasonWarnThirdPartyPhaseout                         CookieWarningReason = "WarnThirdPartyPhaseout"
+	CookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion CookieWarningReason = "WarnCrossSiteRedirectDowngradeChangesInclusion"
+	CookieWarningReasonWarnDeprecationTrialMetadata                   CookieWarningReason = "WarnDeprecationTrialMetadata"
+	CookieWarningReasonWarnThirdPartyCookieHeuristic                  CookieWarningReason = "WarnThirdPartyCookieHeuristic"
+)
+
+// Values gives every CookieWarningReason
+func (CookieWarningReason) Values() []CookieWarningReason {
+	return []CookieWarningReason{
+		CookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext,
+		CookieWarningReasonWarnSameSiteNoneInsecure,
+		CookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe,
+		CookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict,
+		CookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict,
+		CookieWarningReasonWarnSameSiteStrictCrossDowngradeLax,
+		CookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict,
+		CookieWarningReasonWarnSameSiteLaxCrossDowngradeLax,
+		CookieWarningReasonWarnAttributeValueExceedsMaxSize,
+		CookieWarningReasonWarnDomainNonASCII,
+		CookieWarningReasonWarnThirdPartyPhaseout,
+		CookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion,
+		CookieWarningReasonWarnDeprecationTrialMetadata,
+		CookieWarningReasonWarnThirdPartyCookieHeuristic,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CookieWarningReason) Valid() bool {
+	switch v {
+	case CookieWarningReasonWarnSameSiteUnspecifiedCrossSiteContext, CookieWarningReasonWarnSameSiteNoneInsecure, CookieWarningReasonWarnSameSiteUnspecifiedLaxAllowUnsafe, CookieWarningReasonWarnSameSiteStrictLaxDowngradeStrict, CookieWarningReasonWarnSameSiteStrictCrossDowngradeStrict, CookieWarningReasonWarnSameSiteStrictCrossDowngradeLax, CookieWarningReasonWarnSameSiteLaxCrossDowngradeStrict, CookieWarningReasonWarnSameSiteLaxCrossDowngradeLax, CookieWarningReasonWarnAttributeValueExceedsMaxSize, CookieWarningReasonWarnDomainNonASCII, CookieWarningReasonWarnThirdPartyPhaseout, CookieWarningReasonWarnCrossSiteRedirectDowngradeChangesInclusion, CookieWarningReasonWarnDeprecationTrialMetadata, CookieWarningReasonWarnThirdPartyCookieHeuristic:
+		return true
+	}
+	return false
+}
+
+type CookieOperation string
+
+// CookieOperation values
+const (
+	CookieOperationSetCookie  CookieOperation = "SetCookie"
+	CookieOperationReadCookie CookieOperation = "ReadCookie"
+)
+
+// Values gives every CookieOperation
+func (CookieOperation) Values() []CookieOperation {
+	return []CookieOperation{
+		CookieOperationSetCookie,
+		CookieOperationReadCookie,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CookieOperation) Valid() bool {
+	switch v {
+	case CookieOperationSetCookie, CookieOperationReadCookie:
+		return true
+	}
+	return false
+}
+
+// Represents the category of insight that a cookie issue falls under.
+type InsightType string
+
+// InsightType values
+const (
+	InsightTypeGitHubResource InsightType = "GitHubResource"
+	InsightTypeGracePeriod    InsightType = "GracePeriod"
+	InsightTypeHeuristics     InsightType = "Heuristics"
+)
+
+// Values gives every InsightType
+func (InsightType) Values() []InsightType {
+	return []InsightType{
+		InsightTypeGitHubResource,
+		InsightTypeGracePeriod,
+		InsightTypeHeuristics,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InsightType) Valid() bool {
+	switch v {
+	case InsightTypeGitHubResource, InsightTypeGracePeriod, InsightTypeHeuristics:
+		return true
+	}
+	return false
+}
+
+// Information about the suggested solution to a cookie issue.
+type CookieIssueInsight struct {
+	Type InsightType `json:"type"`
+	/* Link to table entry in third-party cookie migration readiness list. */
+	TableEntryUrl *string `json:"tableEntryUrl,omitempty"`
+}
+
+// MarshalCDP writes CookieIssueInsight as JSON
+func (v *CookieIssueInsight) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.TableEntryUrl != nil {
+		e.Key("tableEntryUrl")
+		e.String(*v.TableEntryUrl)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CookieIssueInsight) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CookieIssueInsight from JSON
+func (v *CookieIssueInsight) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = InsightType(d.String())
+		case "tableEntryUrl":
+			if d.Null() {
+				v.TableEntryUrl = nil
+			} else {
+				v.TableEntryUrl = new(string)
+				*v.TableEntryUrl = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CookieIssueInsight) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// This information is currently necessary, as the front-end has a difficult
+// time finding a specific cookie. With this, we can convey specific error
+// information without the cookie.
+type CookieIssueDetails struct {
+	/* If AffectedCookie is not set then rawCookieLine contains the raw
+	Set-Cookie header string. This hints at a problem where the
+	cookie line is syntactically or semantically malformed in a way
+	that no valid cookie could be created. */
+	Cookie                 *AffectedCookie         `json:"cookie,omitempty"`
+	RawCookieLine          *string                 `json:"rawCookieLine,omitempty"`
+	CookieWarningReasons   []CookieWarningReason   `json:"cookieWarningReasons"`
+	CookieExclusionReasons []CookieExclusionReason `json:"cookieExclusionReasons"`
+	/* Optionally identifies the site-for-cookies and the cookie url, which
+	may be used by the front-end as additional context. */
+	Operation      CookieOperation  `json:"operation"`
+	SiteForCookies *string          `json:"siteForCookies,omitempty"`
+	CookieUrl      *string          `json:"cookieUrl,omitempty"`
+	Request        *AffectedRequest `json:"request,omitempty"`
+	/* The recommended solution to the issue. */
+	Insight *CookieIssueInsight `json:"insight,omitempty"`
+}
+
+// MarshalCDP writes CookieIssueDetails as JSON
+func (v *CookieIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Cookie != nil {
+		e.Key("cookie")
+		(*v.Cookie).MarshalCDP(e)
+	}
+	if v.RawCookieLine != nil {
+		e.Key("rawCookieLine")
+		e.String(*v.RawCookieLine)
+	}
+	e.Key("cookieWarningReasons")
+	if v.CookieWarningReasons == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.CookieWarningReasons {
+			e.Elem()
+			e.String(string(v.CookieWarningReasons[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.Key("cookieExclusionReasons")
+	if v.CookieExclusionReasons == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.CookieExclusionReasons {
+			e.Elem()
+			e.String(string(v.CookieExclusionReasons[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.Key("operation")
+	e.String(string(v.Operation))
+	if v.SiteForCookies != nil {
+		e.Key("siteForCookies")
+		e.String(*v.SiteForCookies)
+	}
+	if v.CookieUrl != nil {
+		e.Key("cookieUrl")
+		e.String(*v.CookieUrl)
+	}
+	if v.Request != nil {
+		e.Key("request")
+		(*v.Request).MarshalCDP(e)
+	}
+	if v.Insight != nil {
+		e.Key("insight")
+		(*v.Insight).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CookieIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CookieIssueDetails from JSON
+func (v *CookieIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cookie":
+			if d.Null() {
+				v.Cookie = nil
+			} else {
+				v.Cookie = new(AffectedCookie)
+				(*v.Cookie).UnmarshalCDP(d)
+			}
+		case "rawCookieLine":
+			if d.Null() {
+				v.RawCookieLine = nil
+			} else {
+				v.RawCookieLine = new(string)
+				*v.RawCookieLine = d.String()
+			}
+		case "cookieWarningReasons":
+			if d.Array() {
+				v.CookieWarningReasons = make([]CookieWarningReason, 0)
+				for d.More() {
+					var x0 CookieWarningReason
+					x0 = CookieWarningReason(d.String())
+					v.CookieWarningReasons = append(v.CookieWarningReasons, x0)
+				}
+			} else {
+				v.CookieWarningReasons = nil
+			}
+		case "cookieExclusionReasons":
+			if d.Array() {
+				v.CookieExclusionReasons = make([]CookieExclusionReason, 0)
+				for d.More() {
+					var x0 CookieExclusionReason
+					x0 = CookieExclusionReason(d.String())
+					v.CookieExclusionReasons = append(v.CookieExclusionReasons, x0)
+				}
+			} else {
+				v.CookieExclusionReasons = nil
+			}
+		case "operation":
+			v.Operation = CookieOperation(d.String())
+		case "siteForCookies":
+			if d.Null() {
+				v.SiteForCookies = nil
+			} else {
+				v.SiteForCookies = new(string)
+				*v.SiteForCookies = d.String()
+			}
+		case "cookieUrl":
+			if d.Null() {
+				v.CookieUrl = nil
+			} else {
+				v.CookieUrl = new(string)
+				*v.CookieUrl = d.String()
+			}
+		case "request":
+			if d.Null() {
+				v.Request = nil
+			} else {
+				v.Request = new(AffectedRequest)
+				(*v.Request).UnmarshalCDP(d)
+			}
+		case "insight":
+			if d.Null() {
+				v.Insight = nil
+			} else {
+				v.Insight = new(CookieIssueInsight)
+				(*v.Insight).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CookieIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type MixedContentResolutionStatus string
+
+// MixedContentResolutionStatus values
+const (
+	MixedContentResolutionStatusMixedContentBlocked               MixedContentResolutionStatus = "MixedContentBlocked"
+	MixedContentResolutionStatusMixedContentAutomaticallyUpgraded MixedContentResolutionStatus = "MixedContentAutomaticallyUpgraded"
+	MixedContentResolutionStatusMixedContentWarning               MixedContentResolutionStatus = "MixedContentWarning"
+)
+
+// Values gives every MixedContentResolutionStatus
+func (MixedContentResolutionStatus) Values() []MixedContentResolutionStatus {
+	return []MixedContentResolutionStatus{
+		MixedContentResolutionStatusMixedContentBlocked,
+		MixedContentResolutionStatusMixedContentAutomaticallyUpgraded,
+		MixedContentResolutionStatusMixedContentWarning,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v MixedContentResolutionStatus) Valid() bool {
+	switch v {
+	case MixedContentResolutionStatusMixedContentBlocked, MixedContentResolutionStatusMixedContentAutomaticallyUpgraded, MixedContentResolutionStatusMixedContentWarning:
+		return true
+	}
+	return false
+}
+
+type MixedContentResourceType string
+
+// MixedContentResourceType values
+const (
+	MixedContentResourceTypeAttributionSrc   MixedContentResourceType = "AttributionSrc"
+	MixedContentResourceTypeAudio            MixedContentResourceType = "Audio"
+	MixedContentResourceTypeBeacon           MixedContentResourceType = "Beacon"
+	MixedContentResourceTypeCSPReport        MixedContentResourceType = "CSPReport"
+	MixedContentResourceTypeDownload         MixedContentResourceType = "Download"
+	MixedContentResourceTypeEventSource      MixedContentResourceType = "EventSource"
+	MixedContentResourceTypeFavicon          MixedContentResourceType = "Favicon"
+	MixedContentResourceTypeFont             MixedContentResourceType = "Font"
+	MixedContentResourceTypeForm             MixedContentResourceType = "Form"
+	MixedContentResourceTypeFrame            MixedContentResourceType = "Frame"
+	MixedContentResourceTypeImage            MixedContentResourceType = "Image"
+	MixedContentResourceTypeImport           MixedContentResourceType = "Import"
+	MixedContentResourceTypeJSON             MixedContentResourceType = "JSON"
+	MixedContentResourceTypeManifest         MixedContentResourceType = "Manifest"
+	MixedContentResourceTypePing             MixedContentResourceType = "Ping"
+	MixedContentResourceTypePluginData       MixedContentResourceType = "PluginData"
+	MixedContentResourceTypePluginResource   MixedContentResourceType = "PluginResource"
+	MixedContentResourceTypePrefetch         MixedContentResourceType = "Prefetch"
+	MixedContentResourceTypeResource         MixedContentResourceType = "Resource"
+	MixedContentResourceTypeScript           MixedContentResourceType = "Script"
+	MixedContentResourceTypeServiceWorker    MixedContentResourceType = "ServiceWorker"
+	MixedContentResourceTypeSharedWorker     MixedContentResourceType = "SharedWorker"
+	MixedContentResourceTypeSpeculationRules MixedContentResourceType = "SpeculationRules"
+	MixedContentResourceTypeStylesheet       MixedContentResourceType = "Stylesheet"
+	MixedContentResourceTypeTrack            MixedContentResourceType = "Track"
+	MixedContentResourceTypeVideo            MixedContentResourceType = "Video"
+	MixedContentResourceTypeWorker           MixedContentResourceType = "Worker"
+	MixedContentResourceTypeXMLHttpRequest   MixedContentResourceType = "XMLHttpRequest"
+	MixedContentResourceTypeXSLT             MixedContentResourceType = "XSLT"
+)
+
+// Values gives every MixedContentResourceType
+func (MixedContentResourceType) Values() []MixedContentResourceType {
+	return []MixedContentResourceType{
+		MixedContentResourceTypeAttributionSrc,
+		MixedContentResourceTypeAudio,
+		MixedContentResourceTypeBeacon,
+		MixedContentResourceTypeCSPReport,
+		MixedContentResourceTypeDownload,
+		MixedContentResourceTypeEventSource,
+		MixedContentResourceTypeFavicon,
+		MixedContentResourceTypeFont,
+		MixedContentResourceTypeForm,
+		MixedContentResourceTypeFrame,
+		MixedContentResourceTypeImage,
+		MixedContentResourceTypeImport,
+		MixedContentResourceTypeJSON,
+		MixedContentResourceTypeManifest,
+		MixedContentResourceTypePing,
+		MixedContentResourceTypePluginData,
+		MixedContentResourceTypePluginResource,
+		MixedContentResourceTypePrefetch,
+		MixedContentResourceTypeResource,
+		MixedContentResourceTypeScript,
+		MixedContentResourceTypeServiceWorker,
+		MixedContentResourceTypeSharedWorker,
+		MixedContentResourceTypeSpeculationRules,
+		MixedContentResourceTypeStylesheet,
+		MixedContentResourceTypeTrack,
+		MixedContentResourceTypeVideo,
+		MixedContentResourceTypeWorker,
+		MixedContentResourceTypeXMLHttpRequest,
+		MixedContentResourceTypeXSLT,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v MixedContentResourceType) Valid() bool {
+	switch v {
+	case MixedContentResourceTypeAttributionSrc, MixedContentResourceTypeAudio, MixedContentResourceTypeBeacon, MixedContentResourceTypeCSPReport, MixedContentResourceTypeDownload, MixedContentResourceTypeEventSource, MixedContentResourceTypeFavicon, MixedContentResourceTypeFont, MixedContentResourceTypeForm, MixedContentResourceTypeFrame, MixedContentResourceTypeImage, MixedContentResourceTypeImport, MixedContentResourceTypeJSON, MixedContentResourceTypeManifest, MixedContentResourceTypePing, MixedContentResourceTypePluginData, MixedContentResourceTypePluginResource, MixedContentResourceTypePrefetch, MixedContentResourceTypeResource, MixedContentResourceTypeScript, MixedContentResourceTypeServiceWorker, MixedContentResourceTypeSharedWorker, MixedContentResourceTypeSpeculationRules, MixedContentResourceTypeStylesheet, MixedContentResourceTypeTrack, MixedContentResourceTypeVideo, MixedContentResourceTypeWorker, MixedContentResourceTypeXMLHttpRequest, MixedContentResourceTypeXSLT:
+		return true
+	}
+	return false
+}
+
+type MixedContentIssueDetails struct {
+	/* The type of resource causing the mixed content issue (css, js, iframe,
+	form,...). Marked as optional because it is mapped to from
+	blink::mojom::RequestContextType, which will be replaced
+	by network::mojom::RequestDestination */
+	ResourceType *MixedContentResourceType `json:"resourceType,omitempty"`
+	/* The way the mixed content issue is being resolved. */
+	ResolutionStatus MixedContentResolutionStatus `json:"resolutionStatus"`
+	/* The unsafe http url causing the mixed content issue. */
+	InsecureURL string `json:"insecureURL"`
+	/* The url responsible for the call to an unsafe url. */
+	MainResourceURL string `json:"mainResourceURL"`
+	/* The mixed content request.
+	Does not always exist (e.g. for unsafe form submission urls). */
+	Request *AffectedRequest `json:"request,omitempty"`
+	/* Optional because not every mixed content issue is necessarily linked to a frame. */
+	Frame *AffectedFrame `json:"frame,omitempty"`
+}
+
+// MarshalCDP writes MixedContentIssueDetails as JSON
+func (v *MixedContentIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ResourceType != nil {
+		e.Key("resourceType")
+		e.String(string(*v.ResourceType))
+	}
+	e.Key("resolutionStatus")
+	e.String(string(v.ResolutionStatus))
+	e.Key("insecureURL")
+	e.String(v.InsecureURL)
+	e.Key("mainResourceURL")
+	e.String(v.MainResourceURL)
+	if v.Request != nil {
+		e.Key("request")
+		(*v.Request).MarshalCDP(e)
+	}
+	if v.Frame != nil {
+		e.Key("frame")
+		(*v.Frame).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v MixedContentIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads MixedContentIssueDetails from JSON
+func (v *MixedContentIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "resourceType":
+			if d.Null() {
+				v.ResourceType = nil
+			} else {
+				v.ResourceType = new(MixedContentResourceType)
+				*v.ResourceType = MixedContentResourceType(d.String())
+			}
+		case "resolutionStatus":
+			v.ResolutionStatus = MixedContentResolutionStatus(d.String())
+		case "insecureURL":
+			v.InsecureURL = d.String()
+		case "mainResourceURL":
+			v.MainResourceURL = d.String()
+		case "request":
+			if d.Null() {
+				v.Request = nil
+			} else {
+				v.Request = new(AffectedRequest)
+				(*v.Request).UnmarshalCDP(d)
+			}
+		case "frame":
+			if d.Null() {
+				v.Frame = nil
+			} else {
+				v.Frame = new(AffectedFrame)
+				(*v.Frame).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MixedContentIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enum indicating the reason a response has been blocked. These reasons are
+// refinements of the net error BLOCKED_BY_RESPONSE.
+type BlockedByResponseReason string
+
+// BlockedByResponseReason values
+const (
+	BlockedByResponseReasonCoepFrameResourceNeedsCoepHeader                        BlockedByResponseReason = "CoepFrameResourceNeedsCoepHeader"
+	BlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage             BlockedByResponseReason = "CoopSandboxedIFrameCannotNavigateToCoopPage"
+	BlockedByResponseReasonCorpNotSameOrigin                                       BlockedByResponseReason = "CorpNotSameOrigin"
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoep"
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByDip"
+	BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedByResponseReason = "CorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip"
+	BlockedByResponseReasonCorpNotSameSite                                         BlockedByResponseReason = "CorpNotSameSite"
+	BlockedByResponseReasonSRIMessageSignatureMismatch                             BlockedByResponseReason = "SRIMessageSignatureMismatch"
+)
+
+// Values gives every BlockedByResponseReason
+func (BlockedByResponseReason) Values() []BlockedByResponseReason {
+	return []BlockedByResponseReason{
+		BlockedByResponseReasonCoepFrameResourceNeedsCoepHeader,
+		BlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage,
+		BlockedByResponseReasonCorpNotSameOrigin,
+		BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep,
+		BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip,
+		BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip,
+		BlockedByResponseReasonCorpNotSameSite,
+		BlockedByResponseReasonSRIMessageSignatureMismatch,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v BlockedByResponseReason) Valid() bool {
+	switch v {
+	case BlockedByResponseReasonCoepFrameResourceNeedsCoepHeader, BlockedByResponseReasonCoopSandboxedIFrameCannotNavigateToCoopPage, BlockedByResponseReasonCorpNotSameOrigin, BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep, BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip, BlockedByResponseReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip, BlockedByResponseReasonCorpNotSameSite, BlockedByResponseReasonSRIMessageSignatureMismatch:
+		return true
+	}
+	return false
+}
+
+// Details for a request that has been blocked with the BLOCKED_BY_RESPONSE
+// code. Currently only used for COEP/COOP, but may be extended to include
+// some CSP errors in the future.
+type BlockedByResponseIssueDetails struct {
+	Request      AffectedRequest         `json:"request"`
+	ParentFrame  *AffectedFrame          `json:"parentFrame,omitempty"`
+	BlockedFrame *AffectedFrame          `json:"blockedFrame,omitempty"`
+	Reason       BlockedByResponseReason `json:"reason"`
+}
+
+// MarshalCDP writes BlockedByResponseIssueDetails as JSON
+func (v *BlockedByResponseIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("request")
+	v.Request.MarshalCDP(e)
+	if v.ParentFrame != nil {
+		e.Key("parentFrame")
+		(*v.ParentFrame).MarshalCDP(e)
+	}
+	if v.BlockedFrame != nil {
+		e.Key("blockedFrame")
+		(*v.BlockedFrame).MarshalCDP(e)
+	}
+	e.Key("reason")
+	e.String(string(v.Reason))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BlockedByResponseIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BlockedByResponseIssueDetails from JSON
+func (v *BlockedByResponseIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		case "parentFrame":
+			if d.Null() {
+				v.ParentFrame = nil
+			} else {
+				v.ParentFrame = new(AffectedFrame)
+				(*v.ParentFrame).UnmarshalCDP(d)
+			}
+		case "blockedFrame":
+			if d.Null() {
+				v.BlockedFrame = nil
+			} else {
+				v.BlockedFrame = new(AffectedFrame)
+				(*v.BlockedFrame).UnmarshalCDP(d)
+			}
+		case "reason":
+			v.Reason = BlockedByResponseReason(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BlockedByResponseIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type HeavyAdResolutionStatus string
+
+// HeavyAdResolutionStatus values
+const (
+	HeavyAdResolutionStatusHeavyAdBlocked HeavyAdResolutionStatus = "HeavyAdBlocked"
+	HeavyAdResolutionStatusHeavyAdWarning HeavyAdResolutionStatus = "HeavyAdWarning"
+)
+
+// Values gives every HeavyAdResolutionStatus
+func (HeavyAdResolutionStatus) Values() []HeavyAdResolutionStatus {
+	return []HeavyAdResolutionStatus{
+		HeavyAdResolutionStatusHeavyAdBlocked,
+		HeavyAdResolutionStatusHeavyAdWarning,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v HeavyAdResolutionStatus) Valid() bool {
+	switch v {
+	case HeavyAdResolutionStatusHeavyAdBlocked, HeavyAdResolutionStatusHeavyAdWarning:
+		return true
+	}
+	return false
+}
+
+type HeavyAdReason string
+
+// HeavyAdReason values
+const (
+	HeavyAdReasonNetworkTotalLimit HeavyAdReason = "NetworkTotalLimit"
+	HeavyAdReasonCpuTotalLimit     HeavyAdReason = "CpuTotalLimit"
+	HeavyAdReasonCpuPeakLimit      HeavyAdReason = "CpuPeakLimit"
+)
+
+// Values gives every HeavyAdReason
+func (HeavyAdReason) Values() []HeavyAdReason {
+	return []HeavyAdReason{
+		HeavyAdReasonNetworkTotalLimit,
+		HeavyAdReasonCpuTotalLimit,
+		HeavyAdReasonCpuPeakLimit,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v HeavyAdReason) Valid() bool {
+	switch v {
+	case HeavyAdReasonNetworkTotalLimit, HeavyAdReasonCpuTotalLimit, HeavyAdReasonCpuPeakLimit:
+		return true
+	}
+	return false
+}
+
+type HeavyAdIssueDetails struct {
+	/* The resolution status, either blocking the content or warning. */
+	Resolution HeavyAdResolutionStatus `json:"resolution"`
+	/* The reason the ad was blocked, total network or cpu or peak cpu. */
+	Reason HeavyAdReason `json:"reason"`
+	/* The frame that was blocked. */
+	Frame AffectedFrame `json:"frame"`
+}
+
+// MarshalCDP writes HeavyAdIssueDetails as JSON
+func (v *HeavyAdIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("resolution")
+	e.String(string(v.Resolution))
+	e.Key("reason")
+	e.String(string(v.Reason))
+	e.Key("frame")
+	v.Frame.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HeavyAdIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads HeavyAdIssueDetails from JSON
+func (v *HeavyAdIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "resolution":
+			v.Resolution = HeavyAdResolutionStatus(d.String())
+		case "reason":
+			v.Reason = HeavyAdReason(d.String())
+		case "frame":
+			v.Frame.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HeavyAdIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContentSecurityPolicyViolationType string
+
+// ContentSecurityPolicyViolationType values
+const (
+	ContentSecurityPolicyViolationTypeKInlineViolation             ContentSecurityPolicyViolationType = "kInlineViolation"
+	ContentSecurityPolicyViolationTypeKEvalViolation               ContentSecurityPolicyViolationType = "kEvalViolation"
+	ContentSecurityPolicyViolationTypeKURLViolation                ContentSecurityPolicyViolationType = "kURLViolation"
+	ContentSecurityPolicyViolationTypeKSRIViolation                ContentSecurityPolicyViolationType = "kSRIViolation"
+	ContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation   ContentSecurityPolicyViolationType = "kTrustedTypesSinkViolation"
+	ContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation ContentSecurityPolicyViolationType = "kTrustedTypesPolicyViolation"
+	ContentSecurityPolicyViolationTypeKWasmEvalViolation           ContentSecurityPolicyViolationType = "kWasmEvalViolation"
+)
+
+// Values gives every ContentSecurityPolicyViolationType
+func (ContentSecurityPolicyViolationType) Values() []ContentSecurityPolicyViolationType {
+	return []ContentSecurityPolicyViolationType{
+		ContentSecurityPolicyViolationTypeKInlineViolation,
+		ContentSecurityPolicyViolationTypeKEvalViolation,
+		ContentSecurityPolicyViolationTypeKURLViolation,
+		ContentSecurityPolicyViolationTypeKSRIViolation,
+		ContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation,
+		ContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation,
+		ContentSecurityPolicyViolationTypeKWasmEvalViolation,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContentSecurityPolicyViolationType) Valid() bool {
+	switch v {
+	case ContentSecurityPolicyViolationTypeKInlineViolation, ContentSecurityPolicyViolationTypeKEvalViolation, ContentSecurityPolicyViolationTypeKURLViolation, ContentSecurityPolicyViolationTypeKSRIViolation, ContentSecurityPolicyViolationTypeKTrustedTypesSinkViolation, ContentSecurityPolicyViolationTypeKTrustedTypesPolicyViolation, ContentSecurityPolicyViolationTypeKWasmEvalViolation:
+		return true
+	}
+	return false
+}
+
+type SourceCodeLocation struct {
+	ScriptId     *cdp.RuntimeScriptId `json:"scriptId,omitempty"`
+	Url          string               `json:"url"`
+	LineNumber   int                  `json:"lineNumber"`
+	ColumnNumber int                  `json:"columnNumber"`
+}
+
+// MarshalCDP writes SourceCodeLocation as JSON
+func (v *SourceCodeLocation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ScriptId != nil {
+		e.Key("scriptId")
+		e.String(string(*v.ScriptId))
+	}
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SourceCodeLocation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SourceCodeLocation from JSON
+func (v *SourceCodeLocation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			if d.Null() {
+				v.ScriptId = nil
+			} else {
+				v.ScriptId = new(cdp.RuntimeScriptId)
+				*v.ScriptId = cdp.RuntimeScriptId(d.String())
+			}
+		case "url":
+			v.Url = d.String()
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SourceCodeLocation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContentSecurityPolicyIssueDetails struct {
+	/* The url not included in allowed sources. */
+	BlockedURL *string `json:"blockedURL,omitempty"`
+	/* Specific directive that is violated, causing the CSP issue. */
+	ViolatedDirective                  string                             `json:"violatedDirective"`
+	IsReportOnly                       bool                               `json:"isReportOnly"`
+	ContentSecurityPolicyViolationType ContentSecurityPolicyViolationType `json:"contentSecurityPolicyViolationType"`
+	FrameAncestor                      *AffectedFrame                     `json:"frameAncestor,omitempty"`
+	SourceCodeLocation                 *SourceCodeLocation                `json:"sourceCodeLocation,omitempty"`
+	ViolatingNodeId                    *cdp.DOMBackendNodeId              `json:"violatingNodeId,omitempty"`
+}
+
+// MarshalCDP writes ContentSecurityPolicyIssueDetails as JSON
+func (v *ContentSecurityPolicyIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BlockedURL != nil {
+		e.Key("blockedURL")
+		e.String(*v.BlockedURL)
+	}
+	e.Key("violatedDirective")
+	e.String(v.ViolatedDirective)
+	e.Key("isReportOnly")
+	e.Bool(v.IsReportOnly)
+	e.Key("contentSecurityPolicyViolationType")
+	e.String(string(v.ContentSecurityPolicyViolationType))
+	if v.FrameAncestor != nil {
+		e.Key("frameAncestor")
+		(*v.FrameAncestor).MarshalCDP(e)
+	}
+	if v.SourceCodeLocation != nil {
+		e.Key("sourceCodeLocation")
+		(*v.SourceCodeLocation).MarshalCDP(e)
+	}
+	if v.ViolatingNodeId != nil {
+		e.Key("violatingNodeId")
+		e.Int(int(*v.ViolatingNodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContentSecurityPolicyIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ContentSecurityPolicyIssueDetails from JSON
+func (v *ContentSecurityPolicyIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "blockedURL":
+			if d.Null() {
+				v.BlockedURL = nil
+			} else {
+				v.BlockedURL = new(string)
+				*v.BlockedURL = d.String()
+			}
+		case "violatedDirective":
+			v.ViolatedDirective = d.String()
+		case "isReportOnly":
+			v.IsReportOnly = d.Bool()
+		case "contentSecurityPolicyViolationType":
+			v.ContentSecurityPolicyViolationType = ContentSecurityPolicyViolationType(d.String())
+		case "frameAncestor":
+			if d.Null() {
+				v.FrameAncestor = nil
+			} else {
+				v.FrameAncestor = new(AffectedFrame)
+				(*v.FrameAncestor).UnmarshalCDP(d)
+			}
+		case "sourceCodeLocation":
+			if d.Null() {
+				v.SourceCodeLocation = nil
+			} else {
+				v.SourceCodeLocation = new(SourceCodeLocation)
+				(*v.SourceCodeLocation).UnmarshalCDP(d)
+			}
+		case "violatingNodeId":
+			if d.Null() {
+				v.ViolatingNodeId = nil
+			} else {
+				v.ViolatingNodeId = new(cdp.DOMBackendNodeId)
+				*v.ViolatingNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContentSecurityPolicyIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SharedArrayBufferIssueType string
+
+// SharedArrayBufferIssueType values
+const (
+	SharedArrayBufferIssueTypeTransferIssue SharedArrayBufferIssueType = "TransferIssue"
+	SharedArrayBufferIssueTypeCreationIssue SharedArrayBufferIssueType = "CreationIssue"
+)
+
+// Values gives every SharedArrayBufferIssueType
+func (SharedArrayBufferIssueType) Values() []SharedArrayBufferIssueType {
+	return []SharedArrayBufferIssueType{
+		SharedArrayBufferIssueTypeTransferIssue,
+		SharedArrayBufferIssueTypeCreationIssue,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SharedArrayBufferIssueType) Valid() bool {
+	switch v {
+	case SharedArrayBufferIssueTypeTransferIssue, SharedArrayBufferIssueTypeCreationIssue:
+		return true
+	}
+	return false
+}
+
+// Details for a issue arising from an SAB being instantiated in, or
+// transferred to a context that is not cross-origin isolated.
+type SharedArrayBufferIssueDetails struct {
+	SourceCodeLocation SourceCodeLocation         `json:"sourceCodeLocation"`
+	IsWarning          bool                       `json:"isWarning"`
+	Type               SharedArrayBufferIssueType `json:"type"`
+}
+
+// MarshalCDP writes SharedArrayBufferIssueDetails as JSON
+func (v *SharedArrayBufferIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sourceCodeLocation")
+	v.SourceCodeLocation.MarshalCDP(e)
+	e.Key("isWarning")
+	e.Bool(v.IsWarning)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedArrayBufferIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedArrayBufferIssueDetails from JSON
+func (v *SharedArrayBufferIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.UnmarshalCDP(d)
+		case "isWarning":
+			v.IsWarning = d.Bool()
+		case "type":
+			v.Type = SharedArrayBufferIssueType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedArrayBufferIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LowTextContrastIssueDetails struct {
+	ViolatingNodeId       cdp.DOMBackendNodeId `json:"violatingNodeId"`
+	ViolatingNodeSelector string               `json:"violatingNodeSelector"`
+	ContrastRatio         float64              `json:"contrastRatio"`
+	ThresholdAA           float64              `json:"thresholdAA"`
+	ThresholdAAA          float64              `json:"thresholdAAA"`
+	FontSize              string               `json:"fontSize"`
+	FontWeight            string               `json:"fontWeight"`
+}
+
+// MarshalCDP writes LowTextContrastIssueDetails as JSON
+func (v *LowTextContrastIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("violatingNodeId")
+	e.Int(int(v.ViolatingNodeId))
+	e.Key("violatingNodeSelector")
+	e.String(v.ViolatingNodeSelector)
+	e.Key("contrastRatio")
+	e.Float(v.ContrastRatio)
+	e.Key("thresholdAA")
+	e.Float(v.ThresholdAA)
+	e.Key("thresholdAAA")
+	e.Float(v.ThresholdAAA)
+	e.Key("fontSize")
+	e.String(v.FontSize)
+	e.Key("fontWeight")
+	e.String(v.FontWeight)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LowTextContrastIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LowTextContrastIssueDetails from JSON
+func (v *LowTextContrastIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "violatingNodeId":
+			v.ViolatingNodeId = cdp.DOMBackendNodeId(d.Int())
+		case "violatingNodeSelector":
+			v.ViolatingNodeSelector = d.String()
+		case "contrastRatio":
+			v.ContrastRatio = d.Float()
+		case "thresholdAA":
+			v.ThresholdAA = d.Float()
+		case "thresholdAAA":
+			v.ThresholdAAA = d.Float()
+		case "fontSize":
+			v.FontSize = d.String()
+		case "fontWeight":
+			v.FontWeight = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LowTextContrastIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Details for a CORS related issue, e.g. a warning or error related to
+// CORS RFC1918 enforcement.
+type CorsIssueDetails struct {
+	CorsErrorStatus        cdp.NetworkCorsErrorStatus      `json:"corsErrorStatus"`
+	IsWarning              bool                            `json:"isWarning"`
+	Request                AffectedRequest                 `json:"request"`
+	Location               *SourceCodeLocation             `json:"location,omitempty"`
+	InitiatorOrigin        *string                         `json:"initiatorOrigin,omitempty"`
+	ResourceIPAddressSpace *cdp.NetworkIPAddressSpace      `json:"resourceIPAddressSpace,omitempty"`
+	ClientSecurityState    *cdp.NetworkClientSecurityState `json:"clientSecurityState,omitempty"`
+}
+
+// MarshalCDP writes CorsIssueDetails as JSON
+func (v *CorsIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("corsErrorStatus")
+	v.CorsErrorStatus.MarshalCDP(e)
+	e.Key("isWarning")
+	e.Bool(v.IsWarning)
+	e.Key("request")
+	v.Request.MarshalCDP(e)
+	if v.Location != nil {
+		e.Key("location")
+		(*v.Location).MarshalCDP(e)
+	}
+	if v.InitiatorOrigin != nil {
+		e.Key("initiatorOrigin")
+		e.String(*v.InitiatorOrigin)
+	}
+	if v.ResourceIPAddressSpace != nil {
+		e.Key("resourceIPAddressSpace")
+		e.String(string(*v.ResourceIPAddressSpace))
+	}
+	if v.ClientSecurityState != nil {
+		e.Key("clientSecurityState")
+		(*v.ClientSecurityState).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CorsIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CorsIssueDetails from JSON
+func (v *CorsIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "corsErrorStatus":
+			v.CorsErrorStatus.UnmarshalCDP(d)
+		case "isWarning":
+			v.IsWarning = d.Bool()
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		case "location":
+			if d.Null() {
+				v.Location = nil
+			} else {
+				v.Location = new(SourceCodeLocation)
+				(*v.Location).UnmarshalCDP(d)
+			}
+		case "initiatorOrigin":
+			if d.Null() {
+				v.InitiatorOrigin = nil
+			} else {
+				v.InitiatorOrigin = new(string)
+				*v.InitiatorOrigin = d.String()
+			}
+		case "resourceIPAddressSpace":
+			if d.Null() {
+				v.ResourceIPAddressSpace = nil
+			} else {
+				v.ResourceIPAddressSpace = new(cdp.NetworkIPAddressSpace)
+				*v.ResourceIPAddressSpace = cdp.NetworkIPAddressSpace(d.String())
+			}
+		case "clientSecurityState":
+			if d.Null() {
+				v.ClientSecurityState = nil
+			} else {
+				v.ClientSecurityState = new(cdp.NetworkClientSecurityState)
+				(*v.ClientSecurityState).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CorsIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributionReportingIssueType string
+
+// AttributionReportingIssueType values
+const (
+	AttributionReportingIssueTypePermissionPolicyDisabled                             AttributionReportingIssueType = "PermissionPolicyDisabled"
+	AttributionReportingIssueTypeUntrustworthyReportingOrigin                         AttributionReportingIssueType = "UntrustworthyReportingOrigin"
+	AttributionReportingIssueTypeInsecureContext                                      AttributionReportingIssueType = "InsecureContext"
+	AttributionReportingIssueTypeInvalidHeader                                        AttributionReportingIssueType = "InvalidHeader"
+	AttributionReportingIssueTypeInvalidRegisterTriggerHeader                         AttributionReportingIssueType = "InvalidRegisterTriggerHeader"
+	AttributionReportingIssueTypeSourceAndTriggerHeaders                              AttributionReportingIssueType = "SourceAndTriggerHeaders"
+	AttributionReportingIssueTypeSourceIgnored                                        AttributionReportingIssueType = "SourceIgnored"
+	AttributionReportingIssueTypeTriggerIgnored                                       AttributionReportingIssueType = "TriggerIgnored"
+	AttributionReportingIssueTypeOsSourceIgnored                                      AttributionReportingIssueType = "OsSourceIgnored"
+	AttributionReportingIssueTypeOsTriggerIgnored                                     AttributionReportingIssueType = "OsTriggerIgnored"
+	AttributionReportingIssueTypeInvalidRegisterOsSourceHeader                        AttributionReportingIssueType = "InvalidRegisterOsSourceHeader"
+	AttributionReportingIssueTypeInvalidRegisterOsTriggerHeader                       AttributionReportingIssueType = "InvalidRegisterOsTriggerHeader"
+	AttributionReportingIssueTypeWebAndOsHeaders                                      AttributionReportingIssueType = "WebAndOsHeaders"
+	AttributionReportingIssueTypeNoWebOrOsSupport                                     AttributionReportingIssueType = "NoWebOrOsSupport"
+	AttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation AttributionReportingIssueType = "NavigationRegistrationWithoutTransientUserActivation"
+	AttributionReportingIssueTypeInvalidInfoHeader                                    AttributionReportingIssueType = "InvalidInfoHeader"
+	AttributionReportingIssueTypeNoRegisterSourceHeader                               AttributionReportingIssueType = "NoRegisterSourceHeader"
+	AttributionReportingIssueTypeNoRegisterTriggerHeader                              AttributionReportingIssueType = "NoRegisterTriggerHeader"
+	AttributionReportingIssueTypeNoRegisterOsSourceHeader                             AttributionReportingIssueType = "NoRegisterOsSourceHeader"
+	AttributionReportingIssueTypeNoRegisterOsTriggerHeader                            AttributionReportingIssueType = "NoRegisterOsTriggerHeader"
+	AttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet          AttributionReportingIssueType = "NavigationRegistrationUniqueScopeAlreadySet"
+)
+
+// Values gives every AttributionReportingIssueType
+func (AttributionReportingIssueType) Values() []AttributionReportingIssueType {
+	return []AttributionReportingIssueType{
+		AttributionReportingIssueTypePermissionPolicyDisabled,
+		AttributionReportingIssueTypeUntrustworthyReportingOrigin,
+		AttributionReportingIssueTypeInsecureContext,
+		AttributionReportingIssueTypeInvalidHeader,
+		AttributionReportingIssueTypeInvalidRegisterTriggerHeader,
+		AttributionReportingIssueTypeSourceAndTriggerHeaders,
+		AttributionReportingIssueTypeSourceIgnored,
+		AttributionReportingIssueTypeTriggerIgnored,
+		AttributionReportingIssueTypeOsSourceIgnored,
+		AttributionReportingIssueTypeOsTriggerIgnored,
+		AttributionReportingIssueTypeInvalidRegisterOsSourceHeader,
+		AttributionReportingIssueTypeInvalidRegisterOsTriggerHeader,
+		AttributionReportingIssueTypeWebAndOsHeaders,
+		AttributionReportingIssueTypeNoWebOrOsSupport,
+		AttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation,
+		AttributionReportingIssueTypeInvalidInfoHeader,
+		AttributionReportingIssueTypeNoRegisterSourceHeader,
+		AttributionReportingIssueTypeNoRegisterTriggerHeader,
+		AttributionReportingIssueTypeNoRegisterOsSourceHeader,
+		AttributionReportingIssueTypeNoRegisterOsTriggerHeader,
+		AttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingIssueType) Valid() bool {
+	switch v {
+	case AttributionReportingIssueTypePermissionPolicyDisabled, AttributionReportingIssueTypeUntrustworthyReportingOrigin, AttributionReportingIssueTypeInsecureContext, AttributionReportingIssueTypeInvalidHeader, AttributionReportingIssueTypeInvalidRegisterTriggerHeader, AttributionReportingIssueTypeSourceAndTriggerHeaders, AttributionReportingIssueTypeSourceIgnored, AttributionReportingIssueTypeTriggerIgnored, AttributionReportingIssueTypeOsSourceIgnored, AttributionReportingIssueTypeOsTriggerIgnored, AttributionReportingIssueTypeInvalidRegisterOsSourceHeader, AttributionReportingIssueTypeInvalidRegisterOsTriggerHeader, AttributionReportingIssueTypeWebAndOsHeaders, AttributionReportingIssueTypeNoWebOrOsSupport, AttributionReportingIssueTypeNavigationRegistrationWithoutTransientUserActivation, AttributionReportingIssueTypeInvalidInfoHeader, AttributionReportingIssueTypeNoRegisterSourceHeader, AttributionReportingIssueTypeNoRegisterTriggerHeader, AttributionReportingIssueTypeNoRegisterOsSourceHeader, AttributionReportingIssueTypeNoRegisterOsTriggerHeader, AttributionReportingIssueTypeNavigationRegistrationUniqueScopeAlreadySet:
+		return true
+	}
+	return false
+}
+
+type SharedDictionaryError string
+
+// SharedDictionaryError values
+const (
+	SharedDictionaryErrorUseErrorCrossOriginNoCorsRequest          SharedDictionaryError = "UseErrorCrossOriginNoCorsRequest"
+	SharedDictionaryErrorUseErrorDictionaryLoadFailure             SharedDictionaryError = "UseErrorDictionaryLoadFailure"
+	SharedDictionaryErrorUseErrorMatchingDictionaryNotUsed         SharedDictionaryError = "UseErrorMatchingDictionaryNotUsed"
+	SharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader SharedDictionaryError = "UseErrorUnexpectedContentDictionaryHeader"
+	SharedDictionaryErrorWriteErrorCossOriginNoCorsRequest         SharedDictionaryError = "WriteErrorCossOriginNoCorsRequest"
+	SharedDictionaryErrorWriteErrorDisallowedBySettings            SharedDictionaryError = "WriteErrorDisallowedBySettings"
+	SharedDictionaryErrorWriteErrorExpiredResponse                 SharedDictionaryError = "WriteErrorExpiredResponse"
+	SharedDictionaryErrorWriteErrorFeatureDisabled                 SharedDictionaryError = "WriteErrorFeatureDisabled"
+	SharedDictionaryErrorWriteErrorInsufficientResources           SharedDictionaryError = "WriteErrorInsufficientResources"
+	SharedDictionaryErrorWriteErrorInvalidMatchField               SharedDictionaryError = "WriteErrorInvalidMatchField"
+	SharedDictionaryErrorWriteErrorInvalidStructuredHeader         SharedDictionaryError = "WriteErrorInvalidStructuredHeader"
+	SharedDictionaryErrorWriteErrorNavigationRequest               SharedDictionaryError = "WriteErrorNavigationRequest"
+	SharedDictionaryErrorWriteErrorNoMatchField                    SharedDictionaryError = "WriteErrorNoMatchField"
+	SharedDictionaryErrorWriteErrorNonListMatchDestField           SharedDictionaryError = "WriteErrorNonListMatchDestField"
+	SharedDictionaryErrorWriteErrorNonSecureContext                SharedDictionaryError = "WriteErrorNonSecureContext"
+	SharedDictionaryErrorWriteErrorNonStringIdField                SharedDictionaryError = "WriteErrorNonStringIdField"
+	SharedDictionaryErrorWriteErrorNonStringInMatchDestList        SharedDictionaryError = "WriteErrorNonStringInMatchDestList"
+	SharedDictionaryErrorWriteErrorNonStringMatchField             SharedDictionaryError = "WriteErrorNonStringMatchField"
+	SharedDictionaryErrorWriteErrorNonTokenTypeField               SharedDictionaryError = "WriteErrorNonTokenTypeField"
+	SharedDictionaryErrorWriteErrorRequestAborted                  SharedDictionaryError = "WriteErrorRequestAborted"
+	SharedDictionaryErrorWriteErrorShuttingDown                    SharedDictionaryError = "WriteErrorShuttingDown"
+	SharedDictionaryErrorWriteErrorTooLongIdField                  SharedDictionaryError = "WriteErrorTooLongIdField"
+	SharedDictionaryErrorWriteErrorUnsupportedType                 SharedDictionaryError = "WriteErrorUnsupportedType"
+)
+
+// Values gives every SharedDictionaryError
+func (SharedDictionaryError) Values() []SharedDictionaryError {
+	return []SharedDictionaryError{
+		SharedDictionaryErrorUseErrorCrossOriginNoCorsRequest,
+		SharedDictionaryErrorUseErrorDictionaryLoadFailure,
+		SharedDictionaryErrorUseErrorMatchingDictionaryNotUsed,
+		SharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader,
+		SharedDictionaryErrorWriteErrorCossOriginNoCorsRequest,
+		SharedDictionaryErrorWriteErrorDisallowedBySettings,
+		SharedDictionaryErrorWriteErrorExpiredResponse,
+		SharedDictionaryErrorWriteErrorFeatureDisabled,
+		SharedDictionaryErrorWriteErrorInsufficientResources,
+		SharedDictionaryErrorWriteErrorInvalidMatchField,
+		SharedDictionaryErrorWriteErrorInvalidStructuredHeader,
+		SharedDictionaryErrorWriteErrorNavigationRequest,
+		SharedDictionaryErrorWriteErrorNoMatchField,
+		SharedDictionaryErrorWriteErrorNonListMatchDestField,
+		SharedDictionaryErrorWriteErrorNonSecureContext,
+		SharedDictionaryErrorWriteErrorNonStringIdField,
+		SharedDictionaryErrorWriteErrorNonStringInMatchDestList,
+		SharedDictionaryErrorWriteErrorNonStringMatchField,
+		SharedDictionaryErrorWriteErrorNonTokenTypeField,
+		SharedDictionaryErrorWriteErrorRequestAborted,
+		SharedDictionaryErrorWriteErrorShuttingDown,
+		SharedDictionaryErrorWriteErrorTooLongIdField,
+		SharedDictionaryErrorWriteErrorUnsupportedType,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SharedDictionaryError) Valid() bool {
+	switch v {
+	case SharedDictionaryErrorUseErrorCrossOriginNoCorsRequest, SharedDictionaryErrorUseErrorDictionaryLoadFailure, SharedDictionaryErrorUseErrorMatchingDictionaryNotUsed, SharedDictionaryErrorUseErrorUnexpectedContentDictionaryHeader, SharedDictionaryErrorWriteErrorCossOriginNoCorsRequest, SharedDictionaryErrorWriteErrorDisallowedBySettings, SharedDictionaryErrorWriteErrorExpiredResponse, SharedDictionaryErrorWriteErrorFeatureDisabled, SharedDictionaryErrorWriteErrorInsufficientResources, SharedDictionaryErrorWriteErrorInvalidMatchField, SharedDictionaryErrorWriteErrorInvalidStructuredHeader, SharedDictionaryErrorWriteErrorNavigationRequest, SharedDictionaryErrorWriteErrorNoMatchField, SharedDictionaryErrorWriteErrorNonListMatchDestField, SharedDictionaryErrorWriteErrorNonSecureContext, SharedDictionaryErrorWriteErrorNonStringIdField, SharedDictionaryErrorWriteErrorNonStringInMatchDestList, SharedDictionaryErrorWriteErrorNonStringMatchField, SharedDictionaryErrorWriteErrorNonTokenTypeField, SharedDictionaryErrorWriteErrorRequestAborted, SharedDictionaryErrorWriteErrorShuttingDown, SharedDictionaryErrorWriteErrorTooLongIdField, SharedDictionaryErrorWriteErrorUnsupportedType:
+		return true
+	}
+	return false
+}
+
+type SRIMessageSignatureError string
+
+// SRIMessageSignatureError values
+const (
+	SRIMessageSignatureErrorMissingSignatureHeader                               SRIMessageSignatureError = "MissingSignatureHeader"
+	SRIMessageSignatureErrorMissingSignatureInputHeader                          SRIMessageSignatureError = "MissingSignatureInputHeader"
+	SRIMessageSignatureErrorInvalidSignatureHeader                               SRIMessageSignatureError = "InvalidSignatureHeader"
+	SRIMessageSignatureErrorInvalidSignatureInputHeader                          SRIMessageSignatureError = "InvalidSignatureInputHeader"
+	SRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence                SRIMessageSignatureError = "SignatureHeaderValueIsNotByteSequence"
+	SRIMessageSignatureErrorSignatureHeaderValueIsParameterized                  SRIMessageSignatureError = "SignatureHeaderValueIsParameterized"
+	SRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength                SRIMessageSignatureError = "SignatureHeaderValueIsIncorrectLength"
+	SRIMessageSignatureErrorSignatureInputHeaderMissingLabel                     SRIMessageSignatureError = "SignatureInputHeaderMissingLabel"
+	SRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList                SRIMessageSignatureError = "SignatureInputHeaderValueNotInnerList"
+	SRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents           SRIMessageSignatureError = "SignatureInputHeaderValueMissingComponents"
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType             SRIMessageSignatureError = "SignatureInputHeaderInvalidComponentType"
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName             SRIMessageSignatureError = "SignatureInputHeaderInvalidComponentName"
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter  SRIMessageSignatureError = "SignatureInputHeaderInvalidHeaderComponentParameter"
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter SRIMessageSignatureError = "SignatureInputHeaderInvalidDerivedComponentParameter"
+	SRIMessageSignatureErrorSignatureInputHeaderKeyIdLength                      SRIMessageSignatureError = "SignatureInputHeaderKeyIdLength"
+	SRIMessageSignatureErrorSignatureInputHeaderInvalidParameter                 SRIMessageSignatureError = "SignatureInputHeaderInvalidParameter"
+	SRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters        SRIMessageSignatureError = "SignatureInputHeaderMissingRequiredParameters"
+	SRIMessageSignatureErrorValidationFailedSignatureExpired                     SRIMessageSignatureError = "ValidationFailedSignatureExpired"
+	SRIMessageSignatureErrorValidationFailedInvalidLength                        SRIMessageSignatureError = "ValidationFailedInvalidLength"
+	SRIMessageSignatureErrorValidationFailedSignatureMismatch                    SRIMessageSignatureError = "ValidationFailedSignatureMismatch"
+	SRIMessageSignatureErrorValidationFailedIntegrityMismatch                    SRIMessageSignatureError = "ValidationFailedIntegrityMismatch"
+)
+
+// Values gives every SRIMessageSignatureError
+func (SRIMessageSignatureError) Values() []SRIMessageSignatureError {
+	return []SRIMessageSignatureError{
+		SRIMessageSignatureErrorMissingSignatureHeader,
+		SRIMessageSignatureErrorMissingSignatureInputHeader,
+		SRIMessageSignatureErrorInvalidSignatureHeader,
+		SRIMessageSignatureErrorInvalidSignatureInputHeader,
+		SRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence,
+		SRIMessageSignatureErrorSignatureHeaderValueIsParameterized,
+		SRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength,
+		SRIMessageSignatureErrorSignatureInputHeaderMissingLabel,
+		SRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList,
+		SRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents,
+		SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType,
+		SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName,
+		SRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter,
+		SRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter,
+		SRIMessageSignatureErrorSignatureInputHeaderKeyIdLength,
+		SRIMessageSignatureErrorSignatureInputHeaderInvalidParameter,
+		SRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters,
+		SRIMessageSignatureErrorValidationFailedSignatureExpired,
+		SRIMessageSignatureErrorValidationFailedInvalidLength,
+		SRIMessageSignatureErrorValidationFailedSignatureMismatch,
+		SRIMessageSignatureErrorValidationFailedIntegrityMismatch,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SRIMessageSignatureError) Valid() bool {
+	switch v {
+	case SRIMessageSignatureErrorMissingSignatureHeader, SRIMessageSignatureErrorMissingSignatureInputHeader, SRIMessageSignatureErrorInvalidSignatureHeader, SRIMessageSignatureErrorInvalidSignatureInputHeader, SRIMessageSignatureErrorSignatureHeaderValueIsNotByteSequence, SRIMessageSignatureErrorSignatureHeaderValueIsParameterized, SRIMessageSignatureErrorSignatureHeaderValueIsIncorrectLength, SRIMessageSignatureErrorSignatureInputHeaderMissingLabel, SRIMessageSignatureErrorSignatureInputHeaderValueNotInnerList, SRIMessageSignatureErrorSignatureInputHeaderValueMissingComponents, SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentType, SRIMessageSignatureErrorSignatureInputHeaderInvalidComponentName, SRIMessageSignatureErrorSignatureInputHeaderInvalidHeaderComponentParameter, SRIMessageSignatureErrorSignatureInputHeaderInvalidDerivedComponentParameter, SRIMessageSignatureErrorSignatureInputHeaderKeyIdLength, SRIMessageSignatureErrorSignatureInputHeaderInvalidParameter, SRIMessageSignatureErrorSignatureInputHeaderMissingRequiredParameters, SRIMessageSignatureErrorValidationFailedSignatureExpired, SRIMessageSignatureErrorValidationFailedInvalidLength, SRIMessageSignatureErrorValidationFailedSignatureMismatch, SRIMessageSignatureErrorValidationFailedIntegrityMismatch:
+		return true
+	}
+	return false
+}
+
+type UnencodedDigestError string
+
+// UnencodedDigestError values
+const (
+	UnencodedDigestErrorMalformedDictionary   UnencodedDigestError = "MalformedDictionary"
+	UnencodedDigestErrorUnknownAlgorithm      UnencodedDigestError = "UnknownAlgorithm"
+	UnencodedDigestErrorIncorrectDigestType   UnencodedDigestError = "IncorrectDigestType"
+	UnencodedDigestErrorIncorrectDigestLength UnencodedDigestError = "IncorrectDigestLength"
+)
+
+// Values gives every UnencodedDigestError
+func (UnencodedDigestError) Values() []UnencodedDigestError {
+	return []UnencodedDigestError{
+		UnencodedDigestErrorMalformedDictionary,
+		UnencodedDigestErrorUnknownAlgorithm,
+		UnencodedDigestErrorIncorrectDigestType,
+		UnencodedDigestErrorIncorrectDigestLength,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v UnencodedDigestError) Valid() bool {
+	switch v {
+	case UnencodedDigestErrorMalformedDictionary, UnencodedDigestErrorUnknownAlgorithm, UnencodedDigestErrorIncorrectDigestType, UnencodedDigestErrorIncorrectDigestLength:
+		return true
+	}
+	return false
+}
+
+// Details for issues around "Attribution Reporting API" usage.
+// Explainer: https://github.com/WICG/attribution-reporting-api
+type AttributionReportingIssueDetails struct {
+	ViolationType    AttributionReportingIssueType `json:"violationType"`
+	Request          *AffectedRequest              `json:"request,omitempty"`
+	ViolatingNodeId  *cdp.DOMBackendNodeId         `json:"violatingNodeId,omitempty"`
+	InvalidParameter *string                       `json:"invalidParameter,omitempty"`
+}
+
+// MarshalCDP writes AttributionReportingIssueDetails as JSON
+func (v *AttributionReportingIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("violationType")
+	e.String(string(v.ViolationType))
+	if v.Request != nil {
+		e.Key("request")
+		(*v.Request).MarshalCDP(e)
+	}
+	if v.ViolatingNodeId != nil {
+		e.Key("violatingNodeId")
+		e.Int(int(*v.ViolatingNodeId))
+	}
+	if v.InvalidParameter != nil {
+		e.Key("invalidParameter")
+		e.String(*v.InvalidParameter)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingIssueDetails from JSON
+func (v *AttributionReportingIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "violationType":
+			v.ViolationType = AttributionReportingIssueType(d.String())
+		case "request":
+			if d.Null() {
+				v.Request = nil
+			} else {
+				v.Request = new(AffectedRequest)
+				(*v.Request).UnmarshalCDP(d)
+			}
+		case "violatingNodeId":
+			if d.Null() {
+				v.ViolatingNodeId = nil
+			} else {
+				v.ViolatingNodeId = new(cdp.DOMBackendNodeId)
+				*v.ViolatingNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "invalidParameter":
+			if d.Null() {
+				v.InvalidParameter = nil
+			} else {
+				v.InvalidParameter = new(string)
+				*v.InvalidParameter = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Details for issues about documents in Quirks Mode
+// or Limited Quirks Mode that affects page layouting.
+type QuirksModeIssueDetails struct {
+	/* If false, it means the document's mode is "quirks"
+	instead of "limited-quirks". */
+	IsLimitedQuirksMode bool                 `json:"isLimitedQuirksMode"`
+	DocumentNodeId      cdp.DOMBackendNodeId `json:"documentNodeId"`
+	Url                 string               `json:"url"`
+	FrameId             cdp.PageFrameId      `json:"frameId"`
+	LoaderId            cdp.NetworkLoaderId  `json:"loaderId"`
+}
+
+// MarshalCDP writes QuirksModeIssueDetails as JSON
+func (v *QuirksModeIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("isLimitedQuirksMode")
+	e.Bool(v.IsLimitedQuirksMode)
+	e.Key("documentNodeId")
+	e.Int(int(v.DocumentNodeId))
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("loaderId")
+	e.String(string(v.LoaderId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v QuirksModeIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads QuirksModeIssueDetails from JSON
+func (v *QuirksModeIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "isLimitedQuirksMode":
+			v.IsLimitedQuirksMode = d.Bool()
+		case "documentNodeId":
+			v.DocumentNodeId = cdp.DOMBackendNodeId(d.Int())
+		case "url":
+			v.Url = d.String()
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *QuirksModeIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Deprecated: this is deprecated in the devtools protocol
+type NavigatorUserAgentIssueDetails struct {
+	Url      string              `json:"url"`
+	Location *SourceCodeLocation `json:"location,omitempty"`
+}
+
+// MarshalCDP writes NavigatorUserAgentIssueDetails as JSON
+func (v *NavigatorUserAgentIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	if v.Location != nil {
+		e.Key("location")
+		(*v.Location).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NavigatorUserAgentIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads NavigatorUserAgentIssueDetails from JSON
+func (v *NavigatorUserAgentIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "location":
+			if d.Null() {
+				v.Location = nil
+			} else {
+				v.Location = new(SourceCodeLocation)
+				(*v.Location).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NavigatorUserAgentIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SharedDictionaryIssueDetails struct {
+	SharedDictionaryError SharedDictionaryError `json:"sharedDictionaryError"`
+	Request               AffectedRequest       `json:"request"`
+}
+
+// MarshalCDP writes SharedDictionaryIssueDetails as JSON
+func (v *SharedDictionaryIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sharedDictionaryError")
+	e.String(string(v.SharedDictionaryError))
+	e.Key("request")
+	v.Request.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedDictionaryIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedDictionaryIssueDetails from JSON
+func (v *SharedDictionaryIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sharedDictionaryError":
+			v.SharedDictionaryError = SharedDictionaryError(d.String())
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedDictionaryIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SRIMessageSignatureIssueDetails struct {
+	Error               SRIMessageSignatureError `json:"error"`
+	SignatureBase       string                   `json:"signatureBase"`
+	IntegrityAssertions []string                 `json:"integrityAssertions"`
+	Request             AffectedRequest          `json:"request"`
+}
+
+// MarshalCDP writes SRIMessageSignatureIssueDetails as JSON
+func (v *SRIMessageSignatureIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("error")
+	e.String(string(v.Error))
+	e.Key("signatureBase")
+	e.String(v.SignatureBase)
+	e.Key("integrityAssertions")
+	if v.IntegrityAssertions == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.IntegrityAssertions {
+			e.Elem()
+			e.String(v.IntegrityAssertions[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("request")
+	v.Request.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SRIMessageSignatureIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SRIMessageSignatureIssueDetails from JSON
+func (v *SRIMessageSignatureIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "error":
+			v.Error = SRIMessageSignatureError(d.String())
+		case "signatureBase":
+			v.SignatureBase = d.String()
+		case "integrityAssertions":
+			if d.Array() {
+				v.IntegrityAssertions = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.IntegrityAssertions = append(v.IntegrityAssertions, x0)
+				}
+			} else {
+				v.IntegrityAssertions = nil
+			}
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SRIMessageSignatureIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type UnencodedDigestIssueDetails struct {
+	Error   UnencodedDigestError `json:"error"`
+	Request AffectedRequest      `json:"request"`
+}
+
+// MarshalCDP writes UnencodedDigestIssueDetails as JSON
+func (v *UnencodedDigestIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("error")
+	e.String(string(v.Error))
+	e.Key("request")
+	v.Request.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UnencodedDigestIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads UnencodedDigestIssueDetails from JSON
+func (v *UnencodedDigestIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "error":
+			v.Error = UnencodedDigestError(d.String())
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UnencodedDigestIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GenericIssueErrorType string
+
+// GenericIssueErrorType values
+const (
+	GenericIssueErrorTypeFormLabelForNameError                                      GenericIssueErrorType = "FormLabelForNameError"
+	GenericIssueErrorTypeFormDuplicateIdForInputError                               GenericIssueErrorType = "FormDuplicateIdForInputError"
+	GenericIssueErrorTypeFormInputWithNoLabelError                                  GenericIssueErrorType = "FormInputWithNoLabelError"
+	GenericIssueErrorTypeFormAutocompleteAttributeEmptyError                        GenericIssueErrorType = "FormAutocompleteAttributeEmptyError"
+	GenericIssueErrorTypeFormEmptyIdAndNameAttributesForInputError                  GenericIssueErrorType = "FormEmptyIdAndNameAttributesForInputError"
+	GenericIssueErrorTypeFormAriaLabelledByToNonExistingId                          GenericIssueErrorType = "FormAriaLabelledByToNonExistingId"
+	GenericIssueErrorTypeFormInputAssignedAutocompleteValueToIdOrNameAttributeError GenericIssueErrorType = "FormInputAssignedAutocompleteValueToIdOrNameAttributeError"
+	GenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput                       GenericIssueErrorType = "FormLabelHasNeitherForNorNestedInput"
+	GenericIssueErrorTypeFormLabelForMatchesNonExistingIdError                      GenericIssueErrorType = "FormLabelForMatchesNonExistingIdError"
+	GenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError     GenericIssueErrorType = "FormInputHasWrongButWellIntendedAutocompleteValueError"
+	GenericIssueErrorTypeResponseWasBlockedByORB                                    GenericIssueErrorType = "ResponseWasBlockedByORB"
+)
+
+// Values gives every GenericIssueErrorType
+func (GenericIssueErrorType) Values() []GenericIssueErrorType {
+	return []GenericIssueErrorType{
+		GenericIssueErrorTypeFormLabelForNameError,
+		GenericIssueErrorTypeFormDuplicateIdForInputError,
+		GenericIssueErrorTypeFormInputWithNoLabelError,
+		GenericIssueErrorTypeFormAutocompleteAttributeEmptyError,
+		GenericIssueErrorTypeFormEmptyIdAndNameAttributesForInputError,
+		GenericIssueErrorTypeFormAriaLabelledByToNonExistingId,
+		GenericIssueErrorTypeFormInputAssignedAutocompleteValueToIdOrNameAttributeError,
+		GenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput,
+		GenericIssueErrorTypeFormLabelForMatchesNonExistingIdError,
+		GenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError,
+		GenericIssueErrorTypeResponseWasBlockedByORB,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v GenericIssueErrorType) Valid() bool {
+	switch v {
+	case GenericIssueErrorTypeFormLabelForNameError, GenericIssueErrorTypeFormDuplicateIdForInputError, GenericIssueErrorTypeFormInputWithNoLabelError, GenericIssueErrorTypeFormAutocompleteAttributeEmptyError, GenericIssueErrorTypeFormEmptyIdAndNameAttributesForInputError, GenericIssueErrorTypeFormAriaLabelledByToNonExistingId, GenericIssueErrorTypeFormInputAssignedAutocompleteValueToIdOrNameAttributeError, GenericIssueErrorTypeFormLabelHasNeitherForNorNestedInput, GenericIssueErrorTypeFormLabelForMatchesNonExistingIdError, GenericIssueErrorTypeFormInputHasWrongButWellIntendedAutocompleteValueError, GenericIssueErrorTypeResponseWasBlockedByORB:
+		return true
+	}
+	return false
+}
+
+// Depending on the concrete errorType, different properties are set.
+type GenericIssueDetails struct {
+	/* Issues with the same errorType are aggregated in the frontend. */
+	ErrorType              GenericIssueErrorType `json:"errorType"`
+	FrameId                *cdp.PageFrameId      `json:"frameId,omitempty"`
+	ViolatingNodeId        *cdp.DOMBackendNodeId `json:"violatingNodeId,omitempty"`
+	ViolatingNodeAttribute *string               `json:"violatingNodeAttribute,omitempty"`
+	Request                *AffectedRequest      `json:"request,omitempty"`
+}
+
+// MarshalCDP writes GenericIssueDetails as JSON
+func (v *GenericIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("errorType")
+	e.String(string(v.ErrorType))
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	if v.ViolatingNodeId != nil {
+		e.Key("violatingNodeId")
+		e.Int(int(*v.ViolatingNodeId))
+	}
+	if v.ViolatingNodeAttribute != nil {
+		e.Key("violatingNodeAttribute")
+		e.String(*v.ViolatingNodeAttribute)
+	}
+	if v.Request != nil {
+		e.Key("request")
+		(*v.Request).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GenericIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads GenericIssueDetails from JSON
+func (v *GenericIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "errorType":
+			v.ErrorType = GenericIssueErrorType(d.String())
+		case "frameId":
+			if d.Null() {
+				v.FrameId = nil
+			} else {
+				v.FrameId = new(cdp.PageFrameId)
+				*v.FrameId = cdp.PageFrameId(d.String())
+			}
+		case "violatingNodeId":
+			if d.Null() {
+				v.ViolatingNodeId = nil
+			} else {
+				v.ViolatingNodeId = new(cdp.DOMBackendNodeId)
+				*v.ViolatingNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "violatingNodeAttribute":
+			if d.Null() {
+				v.ViolatingNodeAttribute = nil
+			} else {
+				v.ViolatingNodeAttribute = new(string)
+				*v.ViolatingNodeAttribute = d.String()
+			}
+		case "request":
+			if d.Null() {
+				v.Request = nil
+			} else {
+				v.Request = new(AffectedRequest)
+				(*v.Request).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GenericIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// This issue tracks information needed to print a deprecation message.
+// https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/frame/third_party/blink/renderer/core/frame/deprecation/README.md
+type DeprecationIssueDetails struct {
+	AffectedFrame      *AffectedFrame     `json:"affectedFrame,omitempty"`
+	SourceCodeLocation SourceCodeLocation `json:"sourceCodeLocation"`
+	/* One of the deprecation names from third_party/blink/renderer/core/frame/deprecation/deprecation.json5 */
+	Type string `json:"type"`
+}
+
+// MarshalCDP writes DeprecationIssueDetails as JSON
+func (v *DeprecationIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.AffectedFrame != nil {
+		e.Key("affectedFrame")
+		(*v.AffectedFrame).MarshalCDP(e)
+	}
+	e.Key("sourceCodeLocation")
+	v.SourceCodeLocation.MarshalCDP(e)
+	e.Key("type")
+	e.String(v.Type)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeprecationIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DeprecationIssueDetails from JSON
+func (v *DeprecationIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "affectedFrame":
+			if d.Null() {
+				v.AffectedFrame = nil
+			} else {
+				v.AffectedFrame = new(AffectedFrame)
+				(*v.AffectedFrame).UnmarshalCDP(d)
+			}
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.UnmarshalCDP(d)
+		case "type":
+			v.Type = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeprecationIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// This issue warns about sites in the redirect chain of a finished navigation
+// that may be flagged as trackers and have their state cleared if they don't
+// receive a user interaction. Note that in this context 'site' means eTLD+1.
+// For example, if the URL `https://example.test:80/bounce` was in the
+// redirect chain, the site reported would be `example.test`.
+type BounceTrackingIssueDetails struct {
+	TrackingSites []string `json:"trackingSites"`
+}
+
+// MarshalCDP writes BounceTrackingIssueDetails as JSON
+func (v *BounceTrackingIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("trackingSites")
+	if v.TrackingSites == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.TrackingSites {
+			e.Elem()
+			e.String(v.TrackingSites[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BounceTrackingIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BounceTrackingIssueDetails from JSON
+func (v *BounceTrackingIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "trackingSites":
+			if d.Array() {
+				v.TrackingSites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.TrackingSites = append(v.TrackingSites, x0)
+				}
+			} else {
+				v.TrackingSites = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BounceTrackingIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// This issue warns about third-party sites that are accessing cookies on the
+// current page, and have been permitted due to having a global metadata grant.
+// Note that in this context 'site' means eTLD+1. For example, if the URL
+// `https://example.test:80/web_page` was accessing cookies, the site reported
+// would be `example.test`.
+type CookieDeprecationMetadataIssueDetails struct {
+	AllowedSites     []string        `json:"allowedSites"`
+	OptOutPercentage float64         `json:"optOutPercentage"`
+	IsOptOutTopLevel bool            `json:"isOptOutTopLevel"`
+	Operation        CookieOperation `json:"operation"`
+}
+
+// MarshalCDP writes CookieDeprecationMetadataIssueDetails as JSON
+func (v *CookieDeprecationMetadataIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("allowedSites")
+	if v.AllowedSites == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AllowedSites {
+			e.Elem()
+			e.String(v.AllowedSites[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("optOutPercentage")
+	e.Float(v.OptOutPercentage)
+	e.Key("isOptOutTopLevel")
+	e.Bool(v.IsOptOutTopLevel)
+	e.Key("operation")
+	e.String(string(v.Operation))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CookieDeprecationMetadataIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CookieDeprecationMetadataIssueDetails from JSON
+func (v *CookieDeprecationMetadataIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "allowedSites":
+			if d.Array() {
+				v.AllowedSites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.AllowedSites = append(v.AllowedSites, x0)
+				}
+			} else {
+				v.AllowedSites = nil
+			}
+		case "optOutPercentage":
+			v.OptOutPercentage = d.Float()
+		case "isOptOutTopLevel":
+			v.IsOptOutTopLevel = d.Bool()
+		case "operation":
+			v.Operation = CookieOperation(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CookieDeprecationMetadataIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ClientHintIssueReason string
+
+// ClientHintIssueReason values
+const (
+	ClientHintIssueReasonMetaTagAllowListInvalidOrigin ClientHintIssueReason = "MetaTagAllowListInvalidOrigin"
+	ClientHintIssueReasonMetaTagModifiedHTML           ClientHintIssueReason = "MetaTagModifiedHTML"
+)
+
+// Values gives every ClientHintIssueReason
+func (ClientHintIssueReason) Values() []ClientHintIssueReason {
+	return []ClientHintIssueReason{
+		ClientHintIssueReasonMetaTagAllowListInvalidOrigin,
+		ClientHintIssueReasonMetaTagModifiedHTML,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ClientHintIssueReason) Valid() bool {
+	switch v {
+	case ClientHintIssueReasonMetaTagAllowListInvalidOrigin, ClientHintIssueReasonMetaTagModifiedHTML:
+		return true
+	}
+	return false
+}
+
+type FederatedAuthRequestIssueDetails struct {
+	FederatedAuthRequestIssueReason FederatedAuthRequestIssueReason `json:"federatedAuthRequestIssueReason"`
+}
+
+// MarshalCDP writes FederatedAuthRequestIssueDetails as JSON
+func (v *FederatedAuthRequestIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("federatedAuthRequestIssueReason")
+	e.String(string(v.FederatedAuthRequestIssueReason))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FederatedAuthRequestIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FederatedAuthRequestIssueDetails from JSON
+func (v *FederatedAuthRequestIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "federatedAuthRequestIssueReason":
+			v.FederatedAuthRequestIssueReason = FederatedAuthRequestIssueReason(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FederatedAuthRequestIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Represents the failure reason when a federated authentication reason fails.
+// Should be updated alongside RequestIdTokenStatus in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom to include
+// all cases except for success.
+type FederatedAuthRequestIssueReason string
+
+// FederatedAuthRequestIssueReason values
+const (
+	FederatedAuthRequestIssueReasonShouldEmbargo                    FederatedAuthRequestIssueReason = "ShouldEmbargo"
+	FederatedAuthRequestIssueReasonTooManyRequests                  FederatedAuthRequestIssueReason = "TooManyRequests"
+	FederatedAuthRequestIssueReasonWellKnownHttpNotFound            FederatedAuthRequestIssueReason = "WellKnownHttpNotFound"
+	FederatedAuthRequestIssueReasonWellKnownNoResponse              FederatedAuthRequestIssueReason = "WellKnownNoResponse"
+	FederatedAuthRequestIssueReasonWellKnownInvalidResponse         FederatedAuthRequestIssueReason = "WellKnownInvalidResponse"
+	FederatedAuthRequestIssueReasonWellKnownListEmpty               FederatedAuthRequestIssueReason = "WellKnownListEmpty"
+	FederatedAuthRequestIssueReasonWellKnownInvalidContentType      FederatedAuthRequestIssueReason = "WellKnownInvalidContentType"
+	FederatedAuthRequestIssueReasonConfigNotInWellKnown             FederatedAuthRequestIssueReason = "ConfigNotInWellKnown"
+	FederatedAuthRequestIssueReasonWellKnownTooBig                  FederatedAuthRequestIssueReason = "WellKnownTooBig"
+	FederatedAuthRequestIssueReasonConfigHttpNotFound               FederatedAuthRequestIssueReason = "ConfigHttpNotFound"
+	FederatedAuthRequestIssueReasonConfigNoResponse                 FederatedAuthRequestIssueReason = "ConfigNoResponse"
+	FederatedAuthRequestIssueReasonConfigInvalidResponse            FederatedAuthRequestIssueReason = "ConfigInvalidResponse"
+	FederatedAuthRequestIssueReasonConfigInvalidContentType         FederatedAuthRequestIssueReason = "ConfigInvalidContentType"
+	FederatedAuthRequestIssueReasonClientMetadataHttpNotFound       FederatedAuthRequestIssueReason = "ClientMetadataHttpNotFound"
+	FederatedAuthRequestIssueReasonClientMetadataNoResponse         FederatedAuthRequestIssueReason = "ClientMetadataNoResponse"
+	FederatedAuthRequestIssueReasonClientMetadataInvalidResponse    FederatedAuthRequestIssueReason = "ClientMetadataInvalidResponse"
+	FederatedAuthRequestIssueReasonClientMetadataInvalidContentType FederatedAuthRequestIssueReason = "ClientMetadataInvalidContentType"
+	FederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy     FederatedAuthRequestIssueReason = "IdpNotPotentiallyTrustworthy"
+	FederatedAuthRequestIssueReasonDisabledInSettings               FederatedAuthRequestIssueReason = "DisabledInSettings"
+	FederatedAuthRequestIssueReasonDisabledInFlags                  FederatedAuthRequestIssueReason = "DisabledInFlags"
+	FederatedAuthRequestIssueReasonErrorFetchingSignin              FederatedAuthRequestIssueReason = "ErrorFetchingSignin"
+	FederatedAuthRequestIssueReasonInvalidSigninResponse            FederatedAuthRequestIssueReason = "InvalidSigninResponse"
+	FederatedAuthRequestIssueReasonAccountsHttpNotFound             FederatedAuthRequestIssueReason = "AccountsHttpNotFound"
+	FederatedAuthRequestIssueReasonAccountsNoResponse               FederatedAuthRequestIssueReason = "AccountsNoResponse"
+	FederatedAuthRequestIssueReasonAccountsInvalidResponse          FederatedAuthRequestIssueReason = "AccountsInvalidResponse"
+	FederatedAuthRequestIssueReasonAccountsListEmpty                FederatedAuthRequestIssueReason = "AccountsListEmpty"
+	FederatedAuthRequestIssueReasonAccountsInvalidContentType       FederatedAuthRequestIssueReason = "AccountsInvalidContentType"
+	FederatedAuthRequestIssueReasonIdTokenHttpNotFound              FederatedAuthRequestIssueReason = "IdTokenHttpNotFound"
+	FederatedAuthRequestIssueReasonIdTokenNoResponse                FederatedAuthRequestIssueReason = "IdTokenNoResponse"
+	FederatedAuthRequestIssueReasonIdTokenInvalidResponse           FederatedAuthRequestIssueReason = "IdTokenInvalidResponse"
+	FederatedAuthRequestIssueReasonIdTokenIdpErrorResponse          FederatedAuthRequestIssueReason = "IdTokenIdpErrorResponse"
+	FederatedAuthRequestIssueReasonIdTokenCrossSiteIdpErrorResponse FederatedAuthRequestIssueReason = "IdTokenCrossSiteIdpErrorResponse"
+	FederatedAuthRequestIssueReasonIdTokenInvalidRequest            FederatedAuthRequestIssueReason = "IdTokenInvalidRequest"
+	FederatedAuthRequestIssueReasonIdTokenInvalidContentType        FederatedAuthRequestIssueReason = "IdTokenInvalidContentType"
+	FederatedAuthRequestIssueReasonErrorIdToken                     FederatedAuthRequestIssueReason = "ErrorIdToken"
+	FederatedAuthRequestIssueReasonCanceled                         FederatedAuthRequestIssueReason = "Canceled"
+	FederatedAuthRequestIssueReasonRpPageNotVisible                 FederatedAuthRequestIssueReason = "RpPageNotVisible"
+	FederatedAuthRequestIssueReasonSilentMediationFailure           FederatedAuthRequestIssueReason = "SilentMediationFailure"
+	FederatedAuthRequestIssueReasonThirdPartyCookiesBlocked         FederatedAuthRequestIssueReason = "ThirdPartyCookiesBlocked"
+	FederatedAuthRequestIssueReasonNotSignedInWithIdp               FederatedAuthRequestIssueReason = "NotSignedInWithIdp"
+	FederatedAuthRequestIssueReasonMissingTransientUserActivation   FederatedAuthRequestIssueReason = "MissingTransientUserActivation"
+	FederatedAuthRequestIssueReasonReplacedByActiveMode             FederatedAuthRequestIssueReason = "ReplacedByActiveMode"
+	FederatedAuthRequestIssueReasonInvalidFieldsSpecified           FederatedAuthRequestIssueReason = "InvalidFieldsSpecified"
+	FederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque       FederatedAuthRequestIssueReason = "RelyingPartyOriginIsOpaque"
+	FederatedAuthRequestIssueReasonTypeNotMatching                  FederatedAuthRequestIssueReason = "TypeNotMatching"
+	FederatedAuthRequestIssueReasonUiDismissedNoEmbargo             FederatedAuthRequestIssueReason = "UiDismissedNoEmbargo"
+	FederatedAuthRequestIssueReasonCorsError                        FederatedAuthRequestIssueReason = "CorsError"
+	FederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform FederatedAuthRequestIssueReason = "SuppressedBySegmentationPlatform"
+)
+
+// Values gives every FederatedAuthRequestIssueReason
+func (FederatedAuthRequestIssueReason) Values() []FederatedAuthRequestIssueReason {
+	return []FederatedAuthRequestIssueReason{
+		FederatedAuthRequestIssueReasonShouldEmbargo,
+		FederatedAuthRequestIssueReasonTooManyRequests,
+		FederatedAuthRequestIssueReasonWellKnownHttpNotFound,
+		FederatedAuthRequestIssueReasonWellKnownNoResponse,
+		FederatedAuthRequestIssueReasonWellKnownInvalidResponse,
+		FederatedAuthRequestIssueReasonWellKnownListEmpty,
+		FederatedAuthRequestIssueReasonWellKnownInvalidContentType,
+		FederatedAuthRequestIssueReasonConfigNotInWellKnown,
+		FederatedAuthRequestIssueReasonWellKnownTooBig,
+		FederatedAuthRequestIssueReasonConfigHttpNotFound,
+		FederatedAuthRequestIssueReasonConfigNoResponse,
+		FederatedAuthRequestIssueReasonConfigInvalidResponse,
+		FederatedAuthRequestIssueReasonConfigInvalidContentType,
+		FederatedAuthRequestIssueReasonClientMetadataHttpNotFound,
+		FederatedAuthRequestIssueReasonClientMetadataNoResponse,
+		FederatedAuthRequestIssueReasonClientMetadataInvalidResponse,
+		FederatedAuthRequestIssueReasonClientMetadataInvalidContentType,
+		FederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy,
+		FederatedAuthRequestIssueReasonDisabledInSettings,
+		FederatedAuthRequestIssueReasonDisabledInFlags,
+		FederatedAuthRequestIssueReasonErrorFetchingSignin,
+		FederatedAuthRequestIssueReasonInvalidSigninResponse,
+		FederatedAuthRequestIssueReasonAccountsHttpNotFound,
+		FederatedAuthRequestIssueReasonAccountsNoResponse,
+		FederatedAuthRequestIssueReasonAccountsInvalidResponse,
+		FederatedAuthRequestIssueReasonAccountsListEmpty,
+		FederatedAuthRequestIssueReasonAccountsInvalidContentType,
+		FederatedAuthRequestIssueReasonIdTokenHttpNotFound,
+		FederatedAuthRequestIssueReasonIdTokenNoResponse,
+		FederatedAuthRequestIssueReasonIdTokenInvalidResponse,
+		FederatedAuthRequestIssueReasonIdTokenIdpErrorResponse,
+		FederatedAuthRequestIssueReasonIdTokenCrossSiteIdpErrorResponse,
+		FederatedAuthRequestIssueReasonIdTokenInvalidRequest,
+		FederatedAuthRequestIssueReasonIdTokenInvalidContentType,
+		FederatedAuthRequestIssueReasonErrorIdToken,
+		FederatedAuthRequestIssueReasonCanceled,
+		FederatedAuthRequestIssueReasonRpPageNotVisible,
+		FederatedAuthRequestIssueReasonSilentMediationFailure,
+		FederatedAuthRequestIssueReasonThirdPartyCookiesBlocked,
+		FederatedAuthRequestIssueReasonNotSignedInWithIdp,
+		FederatedAuthRequestIssueReasonMissingTransientUserActivation,
+		FederatedAuthRequestIssueReasonReplacedByActiveMode,
+		FederatedAuthRequestIssueReasonInvalidFieldsSpecified,
+		FederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque,
+		FederatedAuthRequestIssueReasonTypeNotMatching,
+		FederatedAuthRequestIssueReasonUiDismissedNoEmbargo,
+		FederatedAuthRequestIssueReasonCorsError,
+		FederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v FederatedAuthRequestIssueReason) Valid() bool {
+	switch v {
+	case FederatedAuthRequestIssueReasonShouldEmbargo, FederatedAuthRequestIssueReasonTooManyRequests, FederatedAuthRequestIssueReasonWellKnownHttpNotFound, FederatedAuthRequestIssueReasonWellKnownNoResponse, FederatedAuthRequestIssueReasonWellKnownInvalidResponse, FederatedAuthRequestIssueReasonWellKnownListEmpty, FederatedAuthRequestIssueReasonWellKnownInvalidContentType, FederatedAuthRequestIssueReasonConfigNotInWellKnown, FederatedAuthRequestIssueReasonWellKnownTooBig, FederatedAuthRequestIssueReasonConfigHttpNotFound, FederatedAuthRequestIssueReasonConfigNoResponse, FederatedAuthRequestIssueReasonConfigInvalidResponse, FederatedAuthRequestIssueReasonConfigInvalidContentType, FederatedAuthRequestIssueReasonClientMetadataHttpNotFound, FederatedAuthRequestIssueReasonClientMetadataNoResponse, FederatedAuthRequestIssueReasonClientMetadataInvalidResponse, FederatedAuthRequestIssueReasonClientMetadataInvalidContentType, FederatedAuthRequestIssueReasonIdpNotPotentiallyTrustworthy, FederatedAuthRequestIssueReasonDisabledInSettings, FederatedAuthRequestIssueReasonDisabledInFlags, FederatedAuthRequestIssueReasonErrorFetchingSignin, FederatedAuthRequestIssueReasonInvalidSigninResponse, FederatedAuthRequestIssueReasonAccountsHttpNotFound, FederatedAuthRequestIssueReasonAccountsNoResponse, FederatedAuthRequestIssueReasonAccountsInvalidResponse, FederatedAuthRequestIssueReasonAccountsListEmpty, FederatedAuthRequestIssueReasonAccountsInvalidContentType, FederatedAuthRequestIssueReasonIdTokenHttpNotFound, FederatedAuthRequestIssueReasonIdTokenNoResponse, FederatedAuthRequestIssueReasonIdTokenInvalidResponse, FederatedAuthRequestIssueReasonIdTokenIdpErrorResponse, FederatedAuthRequestIssueReasonIdTokenCrossSiteIdpErrorResponse, FederatedAuthRequestIssueReasonIdTokenInvalidRequest, FederatedAuthRequestIssueReasonIdTokenInvalidContentType, FederatedAuthRequestIssueReasonErrorIdToken, FederatedAuthRequestIssueReasonCanceled, FederatedAuthRequestIssueReasonRpPageNotVisible, FederatedAuthRequestIssueReasonSilentMediationFailure, FederatedAuthRequestIssueReasonThirdPartyCookiesBlocked, FederatedAuthRequestIssueReasonNotSignedInWithIdp, FederatedAuthRequestIssueReasonMissingTransientUserActivation, FederatedAuthRequestIssueReasonReplacedByActiveMode, FederatedAuthRequestIssueReasonInvalidFieldsSpecified, FederatedAuthRequestIssueReasonRelyingPartyOriginIsOpaque, FederatedAuthRequestIssueReasonTypeNotMatching, FederatedAuthRequestIssueReasonUiDismissedNoEmbargo, FederatedAuthRequestIssueReasonCorsError, FederatedAuthRequestIssueReasonSuppressedBySegmentationPlatform:
+		return true
+	}
+	return false
+}
+
+type FederatedAuthUserInfoRequestIssueDetails struct {
+	FederatedAuthUserInfoRequestIssueReason FederatedAuthUserInfoRequestIssueReason `json:"federatedAuthUserInfoRequestIssueReason"`
+}
+
+// MarshalCDP writes FederatedAuthUserInfoRequestIssueDetails as JSON
+func (v *FederatedAuthUserInfoRequestIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("federatedAuthUserInfoRequestIssueReason")
+	e.String(string(v.FederatedAuthUserInfoRequestIssueReason))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FederatedAuthUserInfoRequestIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FederatedAuthUserInfoRequestIssueDetails from JSON
+func (v *FederatedAuthUserInfoRequestIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "federatedAuthUserInfoRequestIssueReason":
+			v.FederatedAuthUserInfoRequestIssueReason = FederatedAuthUserInfoRequestIssueReason(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FederatedAuthUserInfoRequestIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Represents the failure reason when a getUserInfo() call fails.
+// Should be updated alongside FederatedAuthUserInfoRequestResult in
+// third_party/blink/public/mojom/devtools/inspector_issue.mojom.
+type FederatedAuthUserInfoRequestIssueReason string
+
+// FederatedAuthUserInfoRequestIssueReason values
+const (
+	FederatedAuthUserInfoRequestIssueReasonNotSameOrigin                      FederatedAuthUserInfoRequestIssueReason = "NotSameOrigin"
+	FederatedAuthUserInfoRequestIssueReasonNotIframe                          FederatedAuthUserInfoRequestIssueReason = "NotIframe"
+	FederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy          FederatedAuthUserInfoRequestIssueReason = "NotPotentiallyTrustworthy"
+	FederatedAuthUserInfoRequestIssueReasonNoApiPermission                    FederatedAuthUserInfoRequestIssueReason = "NoApiPermission"
+	FederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp                 FederatedAuthUserInfoRequestIssueReason = "NotSignedInWithIdp"
+	FederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission         FederatedAuthUserInfoRequestIssueReason = "NoAccountSharingPermission"
+	FederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown           FederatedAuthUserInfoRequestIssueReason = "InvalidConfigOrWellKnown"
+	FederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse            FederatedAuthUserInfoRequestIssueReason = "InvalidAccountsResponse"
+	FederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts FederatedAuthUserInfoRequestIssueReason = "NoReturningUserFromFetchedAccounts"
+)
+
+// Values gives every FederatedAuthUserInfoRequestIssueReason
+func (FederatedAuthUserInfoRequestIssueReason) Values() []FederatedAuthUserInfoRequestIssueReason {
+	return []FederatedAuthUserInfoRequestIssueReason{
+		FederatedAuthUserInfoRequestIssueReasonNotSameOrigin,
+		FederatedAuthUserInfoRequestIssueReasonNotIframe,
+		FederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy,
+		FederatedAuthUserInfoRequestIssueReasonNoApiPermission,
+		FederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp,
+		FederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission,
+		FederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown,
+		FederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse,
+		FederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v FederatedAuthUserInfoRequestIssueReason) Valid() bool {
+	switch v {
+	case FederatedAuthUserInfoRequestIssueReasonNotSameOrigin, FederatedAuthUserInfoRequestIssueReasonNotIframe, FederatedAuthUserInfoRequestIssueReasonNotPotentiallyTrustworthy, FederatedAuthUserInfoRequestIssueReasonNoApiPermission, FederatedAuthUserInfoRequestIssueReasonNotSignedInWithIdp, FederatedAuthUserInfoRequestIssueReasonNoAccountSharingPermission, FederatedAuthUserInfoRequestIssueReasonInvalidConfigOrWellKnown, FederatedAuthUserInfoRequestIssueReasonInvalidAccountsResponse, FederatedAuthUserInfoRequestIssueReasonNoReturningUserFromFetchedAccounts:
+		return true
+	}
+	return false
+}
+
+// This issue tracks client hints related issues. It's used to deprecate old
+// features, encourage the use of new ones, and provide general guidance.
+type ClientHintIssueDetails struct {
+	SourceCodeLocation    SourceCodeLocation    `json:"sourceCodeLocation"`
+	ClientHintIssueReason ClientHintIssueReason `json:"clientHintIssueReason"`
+}
+
+// MarshalCDP writes ClientHintIssueDetails as JSON
+func (v *ClientHintIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sourceCodeLocation")
+	v.SourceCodeLocation.MarshalCDP(e)
+	e.Key("clientHintIssueReason")
+	e.String(string(v.ClientHintIssueReason))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClientHintIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ClientHintIssueDetails from JSON
+func (v *ClientHintIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.UnmarshalCDP(d)
+		case "clientHintIssueReason":
+			v.ClientHintIssueReason = ClientHintIssueReason(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClientHintIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FailedRequestInfo struct {
+	/* The URL that failed to load. */
+	Url string `json:"url"`
+	/* The failure message for the failed request. */
+	FailureMessage string                `json:"failureMessage"`
+	RequestId      *cdp.NetworkRequestId `json:"requestId,omitempty"`
+}
+
+// MarshalCDP writes FailedRequestInfo as JSON
+func (v *FailedRequestInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("failureMessage")
+	e.String(v.FailureMessage)
+	if v.RequestId != nil {
+		e.Key("requestId")
+		e.String(string(*v.RequestId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FailedRequestInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FailedRequestInfo from JSON
+func (v *FailedRequestInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "failureMessage":
+			v.FailureMessage = d.String()
+		case "requestId":
+			if d.Null() {
+				v.RequestId = nil
+			} else {
+				v.RequestId = new(cdp.NetworkRequestId)
+				*v.RequestId = cdp.NetworkRequestId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FailedRequestInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PartitioningBlobURLInfo string
+
+// PartitioningBlobURLInfo values
+const (
+	PartitioningBlobURLInfoBlockedCrossPartitionFetching PartitioningBlobURLInfo = "BlockedCrossPartitionFetching"
+	PartitioningBlobURLInfoEnforceNoopenerForNavigation  PartitioningBlobURLInfo = "EnforceNoopenerForNavigation"
+)
+
+// Values gives every PartitioningBlobURLInfo
+func (PartitioningBlobURLInfo) Values() []PartitioningBlobURLInfo {
+	return []PartitioningBlobURLInfo{
+		PartitioningBlobURLInfoBlockedCrossPartitionFetching,
+		PartitioningBlobURLInfoEnforceNoopenerForNavigation,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PartitioningBlobURLInfo) Valid() bool {
+	switch v {
+	case PartitioningBlobURLInfoBlockedCrossPartitionFetching, PartitioningBlobURLInfoEnforceNoopenerForNavigation:
+		return true
+	}
+	return false
+}
+
+type PartitioningBlobURLIssueDetails struct {
+	/* The BlobURL that failed to load. */
+	Url string `json:"url"`
+	/* Additional information about the Partitioning Blob URL issue. */
+	PartitioningBlobURLInfo PartitioningBlobURLInfo `json:"partitioningBlobURLInfo"`
+}
+
+// MarshalCDP writes PartitioningBlobURLIssueDetails as JSON
+func (v *PartitioningBlobURLIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("partitioningBlobURLInfo")
+	e.String(string(v.PartitioningBlobURLInfo))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PartitioningBlobURLIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PartitioningBlobURLIssueDetails from JSON
+func (v *PartitioningBlobURLIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "partitioningBlobURLInfo":
+			v.PartitioningBlobURLInfo = PartitioningBlobURLInfo(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PartitioningBlobURLIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ElementAccessibilityIssueReason string
+
+// ElementAccessibilityIssueReason values
+const (
+	ElementAccessibilityIssueReasonDisallowedSelectChild               ElementAccessibilityIssueReason = "DisallowedSelectChild"
+	ElementAccessibilityIssueReasonDisallowedOptGroupChild             ElementAccessibilityIssueReason = "DisallowedOptGroupChild"
+	ElementAccessibilityIssueReasonNonPhrasingContentOptionChild       ElementAccessibilityIssueReason = "NonPhrasingContentOptionChild"
+	ElementAccessibilityIssueReasonInteractiveContentOptionChild       ElementAccessibilityIssueReason = "InteractiveContentOptionChild"
+	ElementAccessibilityIssueReasonInteractiveContentLegendChild       ElementAccessibilityIssueReason = "InteractiveContentLegendChild"
+	ElementAccessibilityIssueReasonInteractiveContentSummaryDescendant ElementAccessibilityIssueReason = "InteractiveContentSummaryDescendant"
+)
+
+// Values gives every ElementAccessibilityIssueReason
+func (ElementAccessibilityIssueReason) Values() []ElementAccessibilityIssueReason {
+	return []ElementAccessibilityIssueReason{
+		ElementAccessibilityIssueReasonDisallowedSelectChild,
+		ElementAccessibilityIssueReasonDisallowedOptGroupChild,
+		ElementAccessibilityIssueReasonNonPhrasingContentOptionChild,
+		ElementAccessibilityIssueReasonInteractiveContentOptionChild,
+		ElementAccessibilityIssueReasonInteractiveContentLegendChild,
+		ElementAccessibilityIssueReasonInteractiveContentSummaryDescendant,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ElementAccessibilityIssueReason) Valid() bool {
+	switch v {
+	case ElementAccessibilityIssueReasonDisallowedSelectChild, ElementAccessibilityIssueReasonDisallowedOptGroupChild, ElementAccessibilityIssueReasonNonPhrasingContentOptionChild, ElementAccessibilityIssueReasonInteractiveContentOptionChild, ElementAccessibilityIssueReasonInteractiveContentLegendChild, ElementAccessibilityIssueReasonInteractiveContentSummaryDescendant:
+		return true
+	}
+	return false
+}
+
+// This issue warns about errors in the select or summary element content model.
+type ElementAccessibilityIssueDetails struct {
+	NodeId                          cdp.DOMBackendNodeId            `json:"nodeId"`
+	ElementAccessibilityIssueReason ElementAccessibilityIssueReason `json:"elementAccessibilityIssueReason"`
+	HasDisallowedAttributes         bool                            `json:"hasDisallowedAttributes"`
+}
+
+// MarshalCDP writes ElementAccessibilityIssueDetails as JSON
+func (v *ElementAccessibilityIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("elementAccessibilityIssueReason")
+	e.String(string(v.ElementAccessibilityIssueReason))
+	e.Key("hasDisallowedAttributes")
+	e.Bool(v.HasDisallowedAttributes)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ElementAccessibilityIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ElementAccessibilityIssueDetails from JSON
+func (v *ElementAccessibilityIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = cdp.DOMBackendNodeId(d.Int())
+		case "elementAccessibilityIssueReason":
+			v.ElementAccessibilityIssueReason = ElementAccessibilityIssueReason(d.String())
+		case "hasDisallowedAttributes":
+			v.HasDisallowedAttributes = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ElementAccessibilityIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StyleSheetLoadingIssueReason string
+
+// StyleSheetLoadingIssueReason values
+const (
+	StyleSheetLoadingIssueReasonLateImportRule StyleSheetLoadingIssueReason = "LateImportRule"
+	StyleSheetLoadingIssueReasonRequestFailed  StyleSheetLoadingIssueReason = "RequestFailed"
+)
+
+// Values gives every StyleSheetLoadingIssueReason
+func (StyleSheetLoadingIssueReason) Values() []StyleSheetLoadingIssueReason {
+	return []StyleSheetLoadingIssueReason{
+		StyleSheetLoadingIssueReasonLateImportRule,
+		StyleSheetLoadingIssueReasonRequestFailed,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StyleSheetLoadingIssueReason) Valid() bool {
+	switch v {
+	case StyleSheetLoadingIssueReasonLateImportRule, StyleSheetLoadingIssueReasonRequestFailed:
+		return true
+	}
+	return false
+}
+
+// This issue warns when a referenced stylesheet couldn't be loaded.
+type StylesheetLoadingIssueDetails struct {
+	/* Source code position that referenced the failing stylesheet. */
+	SourceCodeLocation SourceCodeLocation `json:"sourceCodeLocation"`
+	/* Reason why the stylesheet couldn't be loaded. */
+	StyleSheetLoadingIssueReason StyleSheetLoadingIssueReason `json:"styleSheetLoadingIssueReason"`
+	/* Contains additional info when the failure was due to a request. */
+	FailedRequestInfo *FailedRequestInfo `json:"failedRequestInfo,omitempty"`
+}
+
+// MarshalCDP writes StylesheetLoadingIssueDetails as JSON
+func (v *StylesheetLoadingIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sourceCodeLocation")
+	v.SourceCodeLocation.MarshalCDP(e)
+	e.Key("styleSheetLoadingIssueReason")
+	e.String(string(v.StyleSheetLoadingIssueReason))
+	if v.FailedRequestInfo != nil {
+		e.Key("failedRequestInfo")
+		(*v.FailedRequestInfo).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StylesheetLoadingIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads StylesheetLoadingIssueDetails from JSON
+func (v *StylesheetLoadingIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.UnmarshalCDP(d)
+		case "styleSheetLoadingIssueReason":
+			v.StyleSheetLoadingIssueReason = StyleSheetLoadingIssueReason(d.String())
+		case "failedRequestInfo":
+			if d.Null() {
+				v.FailedRequestInfo = nil
+			} else {
+				v.FailedRequestInfo = new(FailedRequestInfo)
+				(*v.FailedRequestInfo).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StylesheetLoadingIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PropertyRuleIssueReason string
+
+// PropertyRuleIssueReason values
+const (
+	PropertyRuleIssueReasonInvalidSyntax       PropertyRuleIssueReason = "InvalidSyntax"
+	PropertyRuleIssueReasonInvalidInitialValue PropertyRuleIssueReason = "InvalidInitialValue"
+	PropertyRuleIssueReasonInvalidInherits     PropertyRuleIssueReason = "InvalidInherits"
+	PropertyRuleIssueReasonInvalidName         PropertyRuleIssueReason = "InvalidName"
+)
+
+// Values gives every PropertyRuleIssueReason
+func (PropertyRuleIssueReason) Values() []PropertyRuleIssueReason {
+	return []PropertyRuleIssueReason{
+		PropertyRuleIssueReasonInvalidSyntax,
+		PropertyRuleIssueReasonInvalidInitialValue,
+		PropertyRuleIssueReasonInvalidInherits,
+		PropertyRuleIssueReasonInvalidName,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PropertyRuleIssueReason) Valid() bool {
+	switch v {
+	case PropertyRuleIssueReasonInvalidSyntax, PropertyRuleIssueReasonInvalidInitialValue, PropertyRuleIssueReasonInvalidInherits, PropertyRuleIssueReasonInvalidName:
+		return true
+	}
+	return false
+}
+
+// This issue warns about errors in property rules that lead to property
+// registrations being ignored.
+type PropertyRuleIssueDetails struct {
+	/* Source code position of the property rule. */
+	SourceCodeLocation SourceCodeLocation `json:"sourceCodeLocation"`
+	/* Reason why the property rule was discarded. */
+	PropertyRuleIssueReason PropertyRuleIssueReason `json:"propertyRuleIssueReason"`
+	/* The value of the property rule property that failed to parse */
+	PropertyValue *string `json:"propertyValue,omitempty"`
+}
+
+// MarshalCDP writes PropertyRuleIssueDetails as JSON
+func (v *PropertyRuleIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sourceCodeLocation")
+	v.SourceCodeLocation.MarshalCDP(e)
+	e.Key("propertyRuleIssueReason")
+	e.String(string(v.PropertyRuleIssueReason))
+	if v.PropertyValue != nil {
+		e.Key("propertyValue")
+		e.String(*v.PropertyValue)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PropertyRuleIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PropertyRuleIssueDetails from JSON
+func (v *PropertyRuleIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sourceCodeLocation":
+			v.SourceCodeLocation.UnmarshalCDP(d)
+		case "propertyRuleIssueReason":
+			v.PropertyRuleIssueReason = PropertyRuleIssueReason(d.String())
+		case "propertyValue":
+			if d.Null() {
+				v.PropertyValue = nil
+			} else {
+				v.PropertyValue = new(string)
+				*v.PropertyValue = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PropertyRuleIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type UserReidentificationIssueType string
+
+// UserReidentificationIssueType values
+const (
+	UserReidentificationIssueTypeBlockedFrameNavigation UserReidentificationIssueType = "BlockedFrameNavigation"
+	UserReidentificationIssueTypeBlockedSubresource     UserReidentificationIssueType = "BlockedSubresource"
+)
+
+// Values gives every UserReidentificationIssueType
+func (UserReidentificationIssueType) Values() []UserReidentificationIssueType {
+	return []UserReidentificationIssueType{
+		UserReidentificationIssueTypeBlockedFrameNavigation,
+		UserReidentificationIssueTypeBlockedSubresource,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v UserReidentificationIssueType) Valid() bool {
+	switch v {
+	case UserReidentificationIssueTypeBlockedFrameNavigation, UserReidentificationIssueTypeBlockedSubresource:
+		return true
+	}
+	return false
+}
+
+// This issue warns about uses of APIs that may be considered misuse to
+// re-identify users.
+type UserReidentificationIssueDetails struct {
+	Type UserReidentificationIssueType `json:"type"`
+	/* Applies to BlockedFrameNavigation and BlockedSubresource issue types. */
+	Request *AffectedRequest `json:"request,omitempty"`
+}
+
+// MarshalCDP writes UserReidentificationIssueDetails as JSON
+func (v *UserReidentificationIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Request != nil {
+		e.Key("request")
+		(*v.Request).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UserReidentificationIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads UserReidentificationIssueDetails from JSON
+func (v *UserReidentificationIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = UserReidentificationIssueType(d.String())
+		case "request":
+			if d.Null() {
+				v.Request = nil
+			} else {
+				v.Request = new(AffectedRequest)
+				(*v.Request).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UserReidentificationIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A unique identifier for the type of issue. Each type may use one of the
+// optional fields in InspectorIssueDetails to convey more specific
+// information about the kind of issue.
+type InspectorIssueCode string
+
+// InspectorIssueCode values
+const (
+	InspectorIssueCodeCookieIssue                       InspectorIssueCode = "CookieIssue"
+	InspectorIssueCodeMixedContentIssue                 InspectorIssueCode = "MixedContentIssue"
+	InspectorIssueCodeBlockedByResponseIssue            InspectorIssueCode = "BlockedByResponseIssue"
+	InspectorIssueCodeHeavyAdIssue                      InspectorIssueCode = "HeavyAdIssue"
+	InspectorIssueCodeContentSecurityPolicyIssue        InspectorIssueCode = "ContentSecurityPolicyIssue"
+	InspectorIssueCodeSharedArrayBufferIssue            InspectorIssueCode = "SharedArrayBufferIssue"
+	InspectorIssueCodeLowTextContrastIssue              InspectorIssueCode = "LowTextContrastIssue"
+	InspectorIssueCodeCorsIssue                         InspectorIssueCode = "CorsIssue"
+	InspectorIssueCodeAttributionReportingIssue         InspectorIssueCode = "AttributionReportingIssue"
+	InspectorIssueCodeQuirksModeIssue                   InspectorIssueCode = "QuirksModeIssue"
+	InspectorIssueCodePartitioningBlobURLIssue          InspectorIssueCode = "PartitioningBlobURLIssue"
+	InspectorIssueCodeNavigatorUserAgentIssue           InspectorIssueCode = "NavigatorUserAgentIssue"
+	InspectorIssueCodeGenericIssue                      InspectorIssueCode = "GenericIssue"
+	InspectorIssueCodeDeprecationIssue                  InspectorIssueCode = "DeprecationIssue"
+	InspectorIssueCodeClientHintIssue                   InspectorIssueCode = "ClientHintIssue"
+	InspectorIssueCodeFederatedAuthRequestIssue         InspectorIssueCode = "FederatedAuthRequestIssue"
+	InspectorIssueCodeBounceTrackingIssue               InspectorIssueCode = "BounceTrackingIssue"
+	InspectorIssueCodeCookieDeprecationMetadataIssue    InspectorIssueCode = "CookieDeprecationMetadataIssue"
+	InspectorIssueCodeStylesheetLoadingIssue            InspectorIssueCode = "StylesheetLoadingIssue"
+	InspectorIssueCodeFederatedAuthUserInfoRequestIssue InspectorIssueCode = "FederatedAuthUserInfoRequestIssue"
+	InspectorIssueCodePropertyRuleIssue                 InspectorIssueCode = "PropertyRuleIssue"
+	InspectorIssueCodeSharedDictionaryIssue             InspectorIssueCode = "SharedDictionaryIssue"
+	InspectorIssueCodeElementAccessibilityIssue         InspectorIssueCode = "ElementAccessibilityIssue"
+	InspectorIssueCodeSRIMessageSignatureIssue          InspectorIssueCode = "SRIMessageSignatureIssue"
+	InspectorIssueCodeUnencodedDigestIssue              InspectorIssueCode = "UnencodedDigestIssue"
+	InspectorIssueCodeUserReidentificationIssue         InspectorIssueCode = "UserReidentificationIssue"
+)
+
+// Values gives every InspectorIssueCode
+func (InspectorIssueCode) Values() []InspectorIssueCode {
+	return []InspectorIssueCode{
+		InspectorIssueCodeCookieIssue,
+		InspectorIssueCodeMixedContentIssue,
+		InspectorIssueCodeBlockedByResponseIssue,
+		InspectorIssueCodeHeavyAdIssue,
+		InspectorIssueCodeContentSecurityPolicyIssue,
+		InspectorIssueCodeSharedArrayBufferIssue,
+		InspectorIssueCodeLowTextContrastIssue,
+		InspectorIssueCodeCorsIssue,
+		InspectorIssueCodeAttributionReportingIssue,
+		InspectorIssueCodeQuirksModeIssue,
+		InspectorIssueCodePartitioningBlobURLIssue,
+		InspectorIssueCodeNavigatorUserAgentIssue,
+		InspectorIssueCodeGenericIssue,
+		InspectorIssueCodeDeprecationIssue,
+		InspectorIssueCodeClientHintIssue,
+		InspectorIssueCodeFederatedAuthRequestIssue,
+		InspectorIssueCodeBounceTrackingIssue,
+		InspectorIssueCodeCookieDeprecationMetadataIssue,
+		InspectorIssueCodeStylesheetLoadingIssue,
+		InspectorIssueCodeFederatedAuthUserInfoRequestIssue,
+		InspectorIssueCodePropertyRuleIssue,
+		InspectorIssueCodeSharedDictionaryIssue,
+		InspectorIssueCodeElementAccessibilityIssue,
+		InspectorIssueCodeSRIMessageSignatureIssue,
+		InspectorIssueCodeUnencodedDigestIssue,
+		InspectorIssueCodeUserReidentificationIssue,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InspectorIssueCode) Valid() bool {
+	switch v {
+	case InspectorIssueCodeCookieIssue, InspectorIssueCodeMixedContentIssue, InspectorIssueCodeBlockedByResponseIssue, InspectorIssueCodeHeavyAdIssue, InspectorIssueCodeContentSecurityPolicyIssue, InspectorIssueCodeSharedArrayBufferIssue, InspectorIssueCodeLowTextContrastIssue, InspectorIssueCodeCorsIssue, InspectorIssueCodeAttributionReportingIssue, InspectorIssueCodeQuirksModeIssue, InspectorIssueCodePartitioningBlobURLIssue, InspectorIssueCodeNavigatorUserAgentIssue, InspectorIssueCodeGenericIssue, InspectorIssueCodeDeprecationIssue, InspectorIssueCodeClientHintIssue, InspectorIssueCodeFederatedAuthRequestIssue, InspectorIssueCodeBounceTrackingIssue, InspectorIssueCodeCookieDeprecationMetadataIssue, InspectorIssueCodeStylesheetLoadingIssue, InspectorIssueCodeFederatedAuthUserInfoRequestIssue, InspectorIssueCodePropertyRuleIssue, InspectorIssueCodeSharedDictionaryIssue, InspectorIssueCodeElementAccessibilityIssue, InspectorIssueCodeSRIMessageSignatureIssue, InspectorIssueCodeUnencodedDigestIssue, InspectorIssueCodeUserReidentificationIssue:
+		return true
+	}
+	return false
+}
+
+// This struct holds a list of optional fields with additional information
+// specific to the kind of issue. When adding a new issue code, please also
+// add a new optional field to this type.
+type InspectorIssueDetails struct {
+	CookieIssueDetails                *CookieIssueDetails                `json:"cookieIssueDetails,omitempty"`
+	MixedContentIssueDetails          *MixedContentIssueDetails          `json:"mixedContentIssueDetails,omitempty"`
+	BlockedByResponseIssueDetails     *BlockedByResponseIssueDetails     `json:"blockedByResponseIssueDetails,omitempty"`
+	HeavyAdIssueDetails               *HeavyAdIssueDetails               `json:"heavyAdIssueDetails,omitempty"`
+	ContentSecurityPolicyIssueDetails *ContentSecurityPolicyIssueDetails `json:"contentSecurityPolicyIssueDetails,omitempty"`
+	SharedArrayBufferIssueDetails     *SharedArrayBufferIssueDetails     `json:"sharedArrayBufferIssueDetails,omitempty"`
+	LowTextContrastIssueDetails       *LowTextContrastIssueDetails       `json:"lowTextContrastIssueDetails,omitempty"`
+	CorsIssueDetails                  *CorsIssueDetails                  `json:"corsIssueDetails,omitempty"`
+	AttributionReportingIssueDetails  *AttributionReportingIssueDetails  `json:"attributionReportingIssueDetails,omitempty"`
+	QuirksModeIssueDetails            *QuirksModeIssueDetails            `json:"quirksModeIssueDetails,omitempty"`
+	PartitioningBlobURLIssueDetails   *PartitioningBlobURLIssueDetails   `json:"partitioningBlobURLIssueDetails,omitempty"`
+	/* Deprecated: this is deprecated in the devtools protocol */
+	NavigatorUserAgentIssueDetails           *NavigatorUserAgentIssueDetails           `json:"navigatorUserAgentIssueDetails,omitempty"`
+	GenericIssueDetails                      *GenericIssueDetails                      `json:"genericIssueDetails,omitempty"`
+	DeprecationIssueDetails                  *DeprecationIssueDetails                  `json:"deprecationIssueDetails,omitempty"`
+	ClientHintIssueDetails                   *ClientHintIssueDetails                   `json:"clientHintIssueDetails,omitempty"`
+	FederatedAuthRequestIssueDetails         *FederatedAuthRequestIssueDetails         `json:"federatedAuthRequestIssueDetails,omitempty"`
+	BounceTrackingIssueDetails               *BounceTrackingIssueDetails               `json:"bounceTrackingIssueDetails,omitempty"`
+	CookieDeprecationMetadataIssueDetails    *CookieDeprecationMetadataIssueDetails    `json:"cookieDeprecationMetadataIssueDetails,omitempty"`
+	StylesheetLoadingIssueDetails            *StylesheetLoadingIssueDetails            `json:"stylesheetLoadingIssueDetails,omitempty"`
+	PropertyRuleIssueDetails                 *PropertyRuleIssueDetails                 `json:"propertyRuleIssueDetails,omitempty"`
+	FederatedAuthUserInfoRequestIssueDetails *FederatedAuthUserInfoRequestIssueDetails `json:"federatedAuthUserInfoRequestIssueDetails,omitempty"`
+	SharedDictionaryIssueDetails             *SharedDictionaryIssueDetails             `json:"sharedDictionaryIssueDetails,omitempty"`
+	ElementAccessibilityIssueDetails         *ElementAccessibilityIssueDetails         `json:"elementAccessibilityIssueDetails,omitempty"`
+	SriMessageSignatureIssueDetails          *SRIMessageSignatureIssueDetails          `json:"sriMessageSignatureIssueDetails,omitempty"`
+	UnencodedDigestIssueDetails              *UnencodedDigestIssueDetails              `json:"unencodedDigestIssueDetails,omitempty"`
+	UserReidentificationIssueDetails         *UserReidentificationIssueDetails         `json:"userReidentificationIssueDetails,omitempty"`
+}
+
+// MarshalCDP writes InspectorIssueDetails as JSON
+func (v *InspectorIssueDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.CookieIssueDetails != nil {
+		e.Key("cookieIssueDetails")
+		(*v.CookieIssueDetails).MarshalCDP(e)
+	}
+	if v.MixedContentIssueDetails != nil {
+		e.Key("mixedContentIssueDetails")
+		(*v.MixedContentIssueDetails).MarshalCDP(e)
+	}
+	if v.BlockedByResponseIssueDetails != nil {
+		e.Key("blockedByResponseIssueDetails")
+		(*v.BlockedByResponseIssueDetails).MarshalCDP(e)
+	}
+	if v.HeavyAdIssueDetails != nil {
+		e.Key("heavyAdIssueDetails")
+		(*v.HeavyAdIssueDetails).MarshalCDP(e)
+	}
+	if v.ContentSecurityPolicyIssueDetails != nil {
+		e.Key("contentSecurityPolicyIssueDetails")
+		(*v.ContentSecurityPolicyIssueDetails).MarshalCDP(e)
+	}
+	if v.SharedArrayBufferIssueDetails != nil {
+		e.Key("sharedArrayBufferIssueDetails")
+		(*v.SharedArrayBufferIssueDetails).MarshalCDP(e)
+	}
+	if v.LowTextContrastIssueDetails != nil {
+		e.Key("lowTextContrastIssueDetails")
+		(*v.LowTextContrastIssueDetails).MarshalCDP(e)
+	}
+	if v.CorsIssueDetails != nil {
+		e.Key("corsIssueDetails")
+		(*v.CorsIssueDetails).MarshalCDP(e)
+	}
+	if v.AttributionReportingIssueDetails != nil {
+		e.Key("attributionReportingIssueDetails")
+		(*v.AttributionReportingIssueDetails).MarshalCDP(e)
+	}
+	if v.QuirksModeIssueDetails != nil {
+		e.Key("quirksModeIssueDetails")
+		(*v.QuirksModeIssueDetails).MarshalCDP(e)
+	}
+	if v.PartitioningBlobURLIssueDetails != nil {
+		e.Key("partitioningBlobURLIssueDetails")
+		(*v.PartitioningBlobURLIssueDetails).MarshalCDP(e)
+	}
+	if v.NavigatorUserAgentIssueDetails != nil {
+		e.Key("navigatorUserAgentIssueDetails")
+		(*v.NavigatorUserAgentIssueDetails).MarshalCDP(e)
+	}
+	if v.GenericIssueDetails != nil {
+		e.Key("genericIssueDetails")
+		(*v.GenericIssueDetails).MarshalCDP(e)
+	}
+	if v.DeprecationIssueDetails != nil {
+		e.Key("deprecationIssueDetails")
+		(*v.DeprecationIssueDetails).MarshalCDP(e)
+	}
+	if v.ClientHintIssueDetails != nil {
+		e.Key("clientHintIssueDetails")
+		(*v.ClientHintIssueDetails).MarshalCDP(e)
+	}
+	if v.FederatedAuthRequestIssueDetails != nil {
+		e.Key("federatedAuthRequestIssueDetails")
+		(*v.FederatedAuthRequestIssueDetails).MarshalCDP(e)
+	}
+	if v.BounceTrackingIssueDetails != nil {
+		e.Key("bounceTrackingIssueDetails")
+		(*v.BounceTrackingIssueDetails).MarshalCDP(e)
+	}
+	if v.CookieDeprecationMetadataIssueDetails != nil {
+		e.Key("cookieDeprecationMetadataIssueDetails")
+		(*v.CookieDeprecationMetadataIssueDetails).MarshalCDP(e)
+	}
+	if v.StylesheetLoadingIssueDetails != nil {
+		e.Key("stylesheetLoadingIssueDetails")
+		(*v.StylesheetLoadingIssueDetails).MarshalCDP(e)
+	}
+	if v.PropertyRuleIssueDetails != nil {
+		e.Key("propertyRuleIssueDetails")
+		(*v.PropertyRuleIssueDetails).MarshalCDP(e)
+	}
+	if v.FederatedAuthUserInfoRequestIssueDetails != nil {
+		e.Key("federatedAuthUserInfoRequestIssueDetails")
+		(*v.FederatedAuthUserInfoRequestIssueDetails).MarshalCDP(e)
+	}
+	if v.SharedDictionaryIssueDetails != nil {
+		e.Key("sharedDictionaryIssueDetails")
+		(*v.SharedDictionaryIssueDetails).MarshalCDP(e)
+	}
+	if v.ElementAccessibilityIssueDetails != nil {
+		e.Key("elementAccessibilityIssueDetails")
+		(*v.ElementAccessibilityIssueDetails).MarshalCDP(e)
+	}
+	if v.SriMessageSignatureIssueDetails != nil {
+		e.Key("sriMessageSignatureIssueDetails")
+		(*v.SriMessageSignatureIssueDetails).MarshalCDP(e)
+	}
+	if v.UnencodedDigestIssueDetails != nil {
+		e.Key("unencodedDigestIssueDetails")
+		(*v.UnencodedDigestIssueDetails).MarshalCDP(e)
+	}
+	if v.UserReidentificationIssueDetails != nil {
+		e.Key("userReidentificationIssueDetails")
+		(*v.UserReidentificationIssueDetails).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InspectorIssueDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InspectorIssueDetails from JSON
+func (v *InspectorIssueDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cookieIssueDetails":
+			if d.Null() {
+				v.CookieIssueDetails = nil
+			} else {
+				v.CookieIssueDetails = new(CookieIssueDetails)
+				(*v.CookieIssueDetails).UnmarshalCDP(d)
+			}
+		case "mixedContentIssueDetails":
+			if d.Null() {
+				v.MixedContentIssueDetails = nil
+			} else {
+				v.MixedContentIssueDetails = new(MixedContentIssueDetails)
+				(*v.MixedContentIssueDetails).UnmarshalCDP(d)
+			}
+		case "blockedByResponseIssueDetails":
+			if d.Null() {
+				v.BlockedByResponseIssueDetails = nil
+			} else {
+				v.BlockedByResponseIssueDetails = new(BlockedByResponseIssueDetails)
+				(*v.BlockedByResponseIssueDetails).UnmarshalCDP(d)
+			}
+		case "heavyAdIssueDetails":
+			if d.Null() {
+				v.HeavyAdIssueDetails = nil
+			} else {
+				v.HeavyAdIssueDetails = new(HeavyAdIssueDetails)
+				(*v.HeavyAdIssueDetails).UnmarshalCDP(d)
+			}
+		case "contentSecurityPolicyIssueDetails":
+			if d.Null() {
+				v.ContentSecurityPolicyIssueDetails = nil
+			} else {
+				v.ContentSecurityPolicyIssueDetails = new(ContentSecurityPolicyIssueDetails)
+				(*v.ContentSecurityPolicyIssueDetails).UnmarshalCDP(d)
+			}
+		case "sharedArrayBufferIssueDetails":
+			if d.Null() {
+				v.SharedArrayBufferIssueDetails = nil
+			} else {
+				v.SharedArrayBufferIssueDetails = new(SharedArrayBufferIssueDetails)
+				(*v.SharedArrayBufferIssueDetails).UnmarshalCDP(d)
+			}
+		case "lowTextContrastIssueDetails":
+			if d.Null() {
+				v.LowTextContrastIssueDetails = nil
+			} else {
+				v.LowTextContrastIssueDetails = new(LowTextContrastIssueDetails)
+				(*v.LowTextContrastIssueDetails).UnmarshalCDP(d)
+			}
+		case "corsIssueDetails":
+			if d.Null() {
+				v.CorsIssueDetails = nil
+			} else {
+				v.CorsIssueDetails = new(CorsIssueDetails)
+				(*v.CorsIssueDetails).UnmarshalCDP(d)
+			}
+		case "attributionReportingIssueDetails":
+			if d.Null() {
+				v.AttributionReportingIssueDetails = nil
+			} else {
+				v.AttributionReportingIssueDetails = new(AttributionReportingIssueDetails)
+				(*v.AttributionReportingIssueDetails).UnmarshalCDP(d)
+			}
+		case "quirksModeIssueDetails":
+			if d.Null() {
+				v.QuirksModeIssueDetails = nil
+			} else {
+				v.QuirksModeIssueDetails = new(QuirksModeIssueDetails)
+				(*v.QuirksModeIssueDetails).UnmarshalCDP(d)
+			}
+		case "partitioningBlobURLIssueDetails":
+			if d.Null() {
+				v.PartitioningBlobURLIssueDetails = nil
+			} else {
+				v.PartitioningBlobURLIssueDetails = new(PartitioningBlobURLIssueDetails)
+				(*v.PartitioningBlobURLIssueDetails).UnmarshalCDP(d)
+			}
+		case "navigatorUserAgentIssueDetails":
+			if d.Null() {
+				v.NavigatorUserAgentIssueDetails = nil
+			} else {
+				v.NavigatorUserAgentIssueDetails = new(NavigatorUserAgentIssueDetails)
+				(*v.NavigatorUserAgentIssueDetails).UnmarshalCDP(d)
+			}
+		case "genericIssueDetails":
+			if d.Null() {
+				v.GenericIssueDetails = nil
+			} else {
+				v.GenericIssueDetails = new(GenericIssueDetails)
+				(*v.GenericIssueDetails).UnmarshalCDP(d)
+			}
+		case "deprecationIssueDetails":
+			if d.Null() {
+				v.DeprecationIssueDetails = nil
+			} else {
+				v.DeprecationIssueDetails = new(DeprecationIssueDetails)
+				(*v.DeprecationIssueDetails).UnmarshalCDP(d)
+			}
+		case "clientHintIssueDetails":
+			if d.Null() {
+				v.ClientHintIssueDetails = nil
+			} else {
+				v.ClientHintIssueDetails = new(ClientHintIssueDetails)
+				(*v.ClientHintIssueDetails).UnmarshalCDP(d)
+			}
+		case "federatedAuthRequestIssueDetails":
+			if d.Null() {
+				v.FederatedAuthRequestIssueDetails = nil
+			} else {
+				v.FederatedAuthRequestIssueDetails = new(FederatedAuthRequestIssueDetails)
+				(*v.FederatedAuthRequestIssueDetails).UnmarshalCDP(d)
+			}
+		case "bounceTrackingIssueDetails":
+			if d.Null() {
+				v.BounceTrackingIssueDetails = nil
+			} else {
+				v.BounceTrackingIssueDetails = new(BounceTrackingIssueDetails)
+				(*v.BounceTrackingIssueDetails).UnmarshalCDP(d)
+			}
+		case "cookieDeprecationMetadataIssueDetails":
+			if d.Null() {
+				v.CookieDeprecationMetadataIssueDetails = nil
+			} else {
+				v.CookieDeprecationMetadataIssueDetails = new(CookieDeprecationMetadataIssueDetails)
+				(*v.CookieDeprecationMetadataIssueDetails).UnmarshalCDP(d)
+			}
+		case "stylesheetLoadingIssueDetails":
+			if d.Null() {
+				v.StylesheetLoadingIssueDetails = nil
+			} else {
+				v.StylesheetLoadingIssueDetails = new(StylesheetLoadingIssueDetails)
+				(*v.StylesheetLoadingIssueDetails).UnmarshalCDP(d)
+			}
+		case "propertyRuleIssueDetails":
+			if d.Null() {
+				v.PropertyRuleIssueDetails = nil
+			} else {
+				v.PropertyRuleIssueDetails = new(PropertyRuleIssueDetails)
+				(*v.PropertyRuleIssueDetails).UnmarshalCDP(d)
+			}
+		case "federatedAuthUserInfoRequestIssueDetails":
+			if d.Null() {
+				v.FederatedAuthUserInfoRequestIssueDetails = nil
+			} else {
+				v.FederatedAuthUserInfoRequestIssueDetails = new(FederatedAuthUserInfoRequestIssueDetails)
+				(*v.FederatedAuthUserInfoRequestIssueDetails).UnmarshalCDP(d)
+			}
+		case "sharedDictionaryIssueDetails":
+			if d.Null() {
+				v.SharedDictionaryIssueDetails = nil
+			} else {
+				v.SharedDictionaryIssueDetails = new(SharedDictionaryIssueDetails)
+				(*v.SharedDictionaryIssueDetails).UnmarshalCDP(d)
+			}
+		case "elementAccessibilityIssueDetails":
+			if d.Null() {
+				v.ElementAccessibilityIssueDetails = nil
+			} else {
+				v.ElementAccessibilityIssueDetails = new(ElementAccessibilityIssueDetails)
+				(*v.ElementAccessibilityIssueDetails).UnmarshalCDP(d)
+			}
+		case "sriMessageSignatureIssueDetails":
+			if d.Null() {
+				v.SriMessageSignatureIssueDetails = nil
+			} else {
+				v.SriMessageSignatureIssueDetails = new(SRIMessageSignatureIssueDetails)
+				(*v.SriMessageSignatureIssueDetails).UnmarshalCDP(d)
+			}
+		case "unencodedDigestIssueDetails":
+			if d.Null() {
+				v.UnencodedDigestIssueDetails = nil
+			} else {
+				v.UnencodedDigestIssueDetails = new(UnencodedDigestIssueDetails)
+				(*v.UnencodedDigestIssueDetails).UnmarshalCDP(d)
+			}
+		case "userReidentificationIssueDetails":
+			if d.Null() {
+				v.UserReidentificationIssueDetails = nil
+			} else {
+				v.UserReidentificationIssueDetails = new(UserReidentificationIssueDetails)
+				(*v.UserReidentificationIssueDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InspectorIssueDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A unique id for a DevTools inspector issue. Allows other entities (e.g.
+// exceptions, CDP message, console messages, etc.) to reference an issue.
+type IssueId string
+
+// An inspector issue reported from the back-end.
+type InspectorIssue struct {
+	Code    InspectorIssueCode    `json:"code"`
+	Details InspectorIssueDetails `json:"details"`
+	/* A unique id for this issue. May be omitted if no other entity (e.g.
+	exception, CDP message, etc.) is referencing this issue. */
+	IssueId *IssueId `json:"issueId,omitempty"`
+}
+
+// MarshalCDP writes InspectorIssue as JSON
+func (v *InspectorIssue) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("code")
+	e.String(string(v.Code))
+	e.Key("details")
+	v.Details.MarshalCDP(e)
+	if v.IssueId != nil {
+		e.Key("issueId")
+		e.String(string(*v.IssueId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InspectorIssue) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InspectorIssue from JSON
+func (v *InspectorIssue) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "code":
+			v.Code = InspectorIssueCode(d.String())
+		case "details":
+			v.Details.UnmarshalCDP(d)
+		case "issueId":
+			if d.Null() {
+				v.IssueId = nil
+			} else {
+				v.IssueId = new(IssueId)
+				*v.IssueId = IssueId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InspectorIssue) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GetEncodedResponseEncoding string
+
+// GetEncodedResponseEncoding values
+const (
+	GetEncodedResponseEncodingWebp GetEncodedResponseEncoding = "webp"
+	GetEncodedResponseEncodingJpeg GetEncodedResponseEncoding = "jpeg"
+	GetEncodedResponseEncodingPng  GetEncodedResponseEncoding = "png"
+)
+
+// Values gives every GetEncodedResponseEncoding
+func (GetEncodedResponseEncoding) Values() []GetEncodedResponseEncoding {
+	return []GetEncodedResponseEncoding{
+		GetEncodedResponseEncodingWebp,
+		GetEncodedResponseEncodingJpeg,
+		GetEncodedResponseEncodingPng,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v GetEncodedResponseEncoding) Valid() bool {
+	switch v {
+	case GetEncodedResponseEncodingWebp, GetEncodedResponseEncodingJpeg, GetEncodedResponseEncodingPng:
+		return true
+	}
+	return false
+}
+
+type GetEncodedResponseReturns struct {
+
+	/* The encoded body as a base64 string. Omitted if sizeOnly is true. (Encoded as a base64 string when passed over JSON) */
+	Body *string `json:"body,omitempty"`
+
+	/* Size before re-encoding. */
+	OriginalSize int `json:"originalSize"`
+
+	/* Size after re-encoding. */
+	EncodedSize int `json:"encodedSize"`
+}
+
+// UnmarshalCDP reads GetEncodedResponseReturns from JSON
+func (v *GetEncodedResponseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "body":
+			if d.Null() {
+				v.Body = nil
+			} else {
+				v.Body = new(string)
+				*v.Body = d.String()
+			}
+		case "originalSize":
+			v.OriginalSize = d.Int()
+		case "encodedSize":
+			v.EncodedSize = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetEncodedResponseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetEncodedResponseParams are the parameters for Audits.getEncodedResponse
+// optional parameters are left out when nil
+type GetEncodedResponseParams struct {
+	/* Identifier of the network request to get content for. */
+	RequestId cdp.NetworkRequestId `json:"requestId"`
+	/* The encoding to use. */
+	Encoding GetEncodedResponseEncoding `json:"encoding"`
+	/* The quality of the encoding (0-1). (defaults to 1) */
+	Quality *float64 `json:"quality,omitempty"`
+	/* Whether to only return the size information (defaults to false). */
+	SizeOnly *bool `json:"sizeOnly,omitempty"`
+}
+
+// MarshalCDP writes GetEncodedResponseParams as JSON
+func (v *GetEncodedResponseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.Key("encoding")
+	e.String(string(v.Encoding))
+	if v.Quality != nil {
+		e.Key("quality")
+		e.Float(*v.Quality)
+	}
+	if v.SizeOnly != nil {
+		e.Key("sizeOnly")
+		e.Bool(*v.SizeOnly)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetEncodedResponseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the response body and size if it were re-encoded with the specified settings. Only
+// applies to images.
+func (c Client) GetEncodedResponse(params GetEncodedResponseParams) (GetEncodedResponseReturns, error) {
+	return c.GetEncodedResponseContext(context.Background(), params)
+}
+
+// GetEncodedResponseContext is GetEncodedResponse with a context for cancellation and deadlines
+func (c Client) GetEncodedResponseContext(ctx context.Context, params GetEncodedResponseParams) (GetEncodedResponseReturns, error) {
+	var returns_ GetEncodedResponseReturns
+
+	if !params.Encoding.Valid() {
+		return returns_, fmt.Errorf("Audits.getEncodedResponse: invalid encoding %q", params.Encoding)
+	}
+
+	err_ := c.caller.Call(ctx, "Audits.getEncodedResponse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables issues domain, prevents further issues from being reported to the client.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Audits.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables issues domain, sends the issues collected so far to the client by means of the
+// `issueAdded` event.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Audits.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CheckContrastReturns struct {
+}
+
+// UnmarshalCDP reads CheckContrastReturns from JSON
+func (v *CheckContrastReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CheckContrastReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CheckContrastParams are the parameters for Audits.checkContrast
+// optional parameters are left out when nil
+type CheckContrastParams struct {
+	/* Whether to report WCAG AAA level issues. Default is false. */
+	ReportAAA *bool `json:"reportAAA,omitempty"`
+}
+
+// MarshalCDP writes CheckContrastParams as JSON
+func (v *CheckContrastParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ReportAAA != nil {
+		e.Key("reportAAA")
+		e.Bool(*v.ReportAAA)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CheckContrastParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Runs the contrast check for the target page. Found issues are reported
+// using Audits.issueAdded event.
+func (c Client) CheckContrast(params CheckContrastParams) (CheckContrastReturns, error) {
+	return c.CheckContrastContext(context.Background(), params)
+}
+
+// CheckContrastContext is CheckContrast with a context for cancellation and deadlines
+func (c Client) CheckContrastContext(ctx context.Context, params CheckContrastParams) (CheckContrastReturns, error) {
+	var returns_ CheckContrastReturns
+
+	err_ := c.caller.Call(ctx, "Audits.checkContrast", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CheckFormsIssuesReturns struct {
+	FormIssues []GenericIssueDetails `json:"formIssues"`
+}
+
+// UnmarshalCDP reads CheckFormsIssuesReturns from JSON
+func (v *CheckFormsIssuesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "formIssues":
+			if d.Array() {
+				v.FormIssues = make([]GenericIssueDetails, 0)
+				for d.More() {
+					var x0 GenericIssueDetails
+					x0.UnmarshalCDP(d)
+					v.FormIssues = append(v.FormIssues, x0)
+				}
+			} else {
+				v.FormIssues = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CheckFormsIssuesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Runs the form issues check for the target page. Found issues are reported
+// using Audits.issueAdded event.
+func (c Client) CheckFormsIssues() (CheckFormsIssuesReturns, error) {
+	return c.CheckFormsIssuesContext(context.Background())
+}
+
+// CheckFormsIssuesContext is CheckFormsIssues with a context for cancellation and deadlines
+func (c Client) CheckFormsIssuesContext(ctx context.Context) (CheckFormsIssuesReturns, error) {
+	var returns_ CheckFormsIssuesReturns
+
+	err_ := c.caller.Call(ctx, "Audits.checkFormsIssues", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type IssueAddedEvent struct {
+	Issue InspectorIssue `json:"issue"`
+}
+
+// UnmarshalCDP reads IssueAddedEvent from JSON
+func (v *IssueAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "issue":
+			v.Issue.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *IssueAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type IssueAddedHandler func(ev IssueAddedEvent)
+
+// EventMethod is Audits.issueAdded
+func (IssueAddedEvent) EventMethod() string {
+	return "Audits.issueAdded"
+}
+
+// OnIssueAdded calls handler for each Audits.issueAdded event
+func (c Client) OnIssueAdded(handler IssueAddedHandler) (unsubscribe func()) {
+	return c.caller.On("Audits.issueAdded", func(ev interface{}) {
+		handler(ev.(IssueAddedEvent))
+	})
+}
+
+// decoders for Audits events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Audits.issueAdded": cdp.Decode[IssueAddedEvent],
+}
+
+// DecodeEvent decodes a Audits event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,687 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package autofill is the Autofill domain of the chrome devtools protocol
+//
+// Defines commands and events for Autofill.
+//
+// Experimental: this may change or be removed in any chrome release
+package autofill
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Autofill commands and listens for Autofill events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type CreditCard struct {
+	/* 16-digit credit card number. */
+	Number string `json:"number"`
+	/* Name of the credit card owner. */
+	Name string `json:"name"`
+	/* 2-digit expiry month. */
+	ExpiryMonth string `json:"expiryMonth"`
+	/* 4-digit expiry year. */
+	ExpiryYear string `json:"expiryYear"`
+	/* 3-digit card verification code. */
+	Cvc string `json:"cvc"`
+}
+
+// MarshalCDP writes CreditCard as JSON
+func (v *CreditCard) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("number")
+	e.String(v.Number)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("expiryMonth")
+	e.String(v.ExpiryMonth)
+	e.Key("expiryYear")
+	e.String(v.ExpiryYear)
+	e.Key("cvc")
+	e.String(v.Cvc)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CreditCard) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CreditCard from JSON
+func (v *CreditCard) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "number":
+			v.Number = d.String()
+		case "name":
+			v.Name = d.String()
+		case "expiryMonth":
+			v.ExpiryMonth = d.String()
+		case "expiryYear":
+			v.ExpiryYear = d.String()
+		case "cvc":
+			v.Cvc = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CreditCard) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AddressField struct {
+	/* address field name, for example GIVEN_NAME. */
+	Name string `json:"name"`
+	/* address field value, for example Jon Doe. */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes AddressField as JSON
+func (v *AddressField) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddressField) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AddressField from JSON
+func (v *AddressField) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddressField) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A list of address fields.
+type AddressFields struct {
+	Fields []AddressField `json:"fields"`
+}
+
+// MarshalCDP writes AddressFields as JSON
+func (v *AddressFields) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("fields")
+	if v.Fields == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Fields {
+			e.Elem()
+			v.Fields[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddressFields) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AddressFields from JSON
+func (v *AddressFields) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "fields":
+			if d.Array() {
+				v.Fields = make([]AddressField, 0)
+				for d.More() {
+					var x0 AddressField
+					x0.UnmarshalCDP(d)
+					v.Fields = append(v.Fields, x0)
+				}
+			} else {
+				v.Fields = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddressFields) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type Address struct {
+	/* fields and values defining an address. */
+	Fields []AddressField `json:"fields"`
+}
+
+// MarshalCDP writes Address as JSON
+func (v *Address) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("fields")
+	if v.Fields == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Fields {
+			e.Elem()
+			v.Fields[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Address) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Address from JSON
+func (v *Address) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "fields":
+			if d.Array() {
+				v.Fields = make([]AddressField, 0)
+				for d.More() {
+					var x0 AddressField
+					x0.UnmarshalCDP(d)
+					v.Fields = append(v.Fields, x0)
+				}
+			} else {
+				v.Fields = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Address) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Defines how an address can be displayed like in chrome://settings/addresses.
+// Address UI is a two dimensional array, each inner array is an "address information line", and when rendered in a UI surface should be displayed as such.
+// The following address UI for instance:
+// [[{name: "GIVE_NAME", value: "Jon"}, {name: "FAMILY_NAME", value: "Doe"}], [{name: "CITY", value: "Munich"}, {name: "ZIP", value: "81456"}]]
+// should allow the receiver to render:
+// Jon Doe
+// Munich 81456
+type AddressUI struct {
+	/* A two dimension array containing the representation of values from an address profile. */
+	AddressFields []AddressFields `json:"addressFields"`
+}
+
+// MarshalCDP writes AddressUI as JSON
+func (v *AddressUI) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("addressFields")
+	if v.AddressFields == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AddressFields {
+			e.Elem()
+			v.AddressFields[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddressUI) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AddressUI from JSON
+func (v *AddressUI) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "addressFields":
+			if d.Array() {
+				v.AddressFields = make([]AddressFields, 0)
+				for d.More() {
+					var x0 AddressFields
+					x0.UnmarshalCDP(d)
+					v.AddressFields = append(v.AddressFields, x0)
+				}
+			} else {
+				v.AddressFields = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddressUI) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Specified whether a filled field was done so by using the html autocomplete attribute or autofill heuristics.
+type FillingStrategy string
+
+// FillingStrategy values
+const (
+	FillingStrategyAutocompleteAttribute FillingStrategy = "autocompleteAttribute"
+	FillingStrategyAutofillInferred      FillingStrategy = "autofillInferred"
+)
+
+// Values gives every FillingStrategy
+func (FillingStrategy) Values() []FillingStrategy {
+	return []FillingStrategy{
+		FillingStrategyAutocompleteAttribute,
+		FillingStrategyAutofillInferred,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v FillingStrategy) Valid() bool {
+	switch v {
+	case FillingStrategyAutocompleteAttribute, FillingStrategyAutofillInferred:
+		return true
+	}
+	return false
+}
+
+type FilledField struct {
+	/* The type of the field, e.g text, password etc. */
+	HtmlType string `json:"htmlType"`
+	/* the html id */
+	Id string `json:"id"`
+	/* the html name */
+	Name string `json:"name"`
+	/* the field value */
+	Value string `json:"value"`
+	/* The actual field type, e.g FAMILY_NAME */
+	AutofillType string `json:"autofillType"`
+	/* The filling strategy */
+	FillingStrategy FillingStrategy `json:"fillingStrategy"`
+	/* The frame the field belongs to */
+	FrameId cdp.PageFrameId `json:"frameId"`
+	/* The form field's DOM node */
+	FieldId cdp.DOMBackendNodeId `json:"fieldId"`
+}
+
+// MarshalCDP writes FilledField as JSON
+func (v *FilledField) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("htmlType")
+	e.String(v.HtmlType)
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.Key("autofillType")
+	e.String(v.AutofillType)
+	e.Key("fillingStrategy")
+	e.String(string(v.FillingStrategy))
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("fieldId")
+	e.Int(int(v.FieldId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FilledField) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FilledField from JSON
+func (v *FilledField) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "htmlType":
+			v.HtmlType = d.String()
+		case "id":
+			v.Id = d.String()
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		case "autofillType":
+			v.AutofillType = d.String()
+		case "fillingStrategy":
+			v.FillingStrategy = FillingStrategy(d.String())
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "fieldId":
+			v.FieldId = cdp.DOMBackendNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FilledField) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TriggerReturns struct {
+}
+
+// UnmarshalCDP reads TriggerReturns from JSON
+func (v *TriggerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TriggerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TriggerParams are the parameters for Autofill.trigger
+// optional parameters are left out when nil
+type TriggerParams struct {
+	/* Identifies a field that serves as an anchor for autofill. */
+	FieldId cdp.DOMBackendNodeId `json:"fieldId"`
+	/* Identifies the frame that field belongs to. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+	/* Credit card information to fill out the form. Credit card data is not saved. */
+	Card CreditCard `json:"card"`
+}
+
+// MarshalCDP writes TriggerParams as JSON
+func (v *TriggerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("fieldId")
+	e.Int(int(v.FieldId))
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.Key("card")
+	v.Card.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TriggerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Trigger autofill on a form identified by the fieldId.
+// If the field and related form cannot be autofilled, returns an error.
+func (c Client) Trigger(params TriggerParams) (TriggerReturns, error) {
+	return c.TriggerContext(context.Background(), params)
+}
+
+// TriggerContext is Trigger with a context for cancellation and deadlines
+func (c Client) TriggerContext(ctx context.Context, params TriggerParams) (TriggerReturns, error) {
+	var returns_ TriggerReturns
+
+	err_ := c.caller.Call(ctx, "Autofill.trigger", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAddressesReturns struct {
+}
+
+// UnmarshalCDP reads SetAddressesReturns from JSON
+func (v *SetAddressesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAddressesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAddressesParams are the parameters for Autofill.setAddresses
+// optional parameters are left out when nil
+type SetAddressesParams struct {
+	Addresses []Address `json:"addresses"`
+}
+
+// MarshalCDP writes SetAddressesParams as JSON
+func (v *SetAddressesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("addresses")
+	if v.Addresses == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Addresses {
+			e.Elem()
+			v.Addresses[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAddressesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set addresses so that developers can verify their forms implementation.
+func (c Client) SetAddresses(params SetAddressesParams) (SetAddressesReturns, error) {
+	return c.SetAddressesContext(context.Background(), params)
+}
+
+// SetAddressesContext is SetAddresses with a context for cancellation and deadlines
+func (c Client) SetAddressesContext(ctx context.Context, params SetAddressesParams) (SetAddressesReturns, error) {
+	var returns_ SetAddressesReturns
+
+	err_ := c.caller.Call(ctx, "Autofill.setAddresses", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables autofill domain notifications.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Autofill.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables autofill domain notifications.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Autofill.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Emitted when an address form is filled.
+type AddressFormFilledEvent struct {
+
+	/* Information about the fields that were filled */
+	FilledFields []FilledField `json:"filledFields"`
+
+	/* An UI representation of the address used to fill the form.
+	Consists of a 2D array where each child represents an address/profile line. */
+	AddressUi AddressUI `json:"addressUi"`
+}
+
+// UnmarshalCDP reads AddressFormFilledEvent from JSON
+func (v *AddressFormFilledEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "filledFields":
+			if d.Array() {
+				v.FilledFields = make([]FilledField, 0)
+				for d.More() {
+					var x0 FilledField
+					x0.UnmarshalCDP(d)
+					v.FilledFields = append(v.FilledFields, x0)
+				}
+			} else {
+				v.FilledFields = nil
+			}
+		case "addressUi":
+			v.AddressUi.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddressFormFilledEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AddressFormFilledHandler func(ev AddressFormFilledEvent)
+
+// EventMethod is Autofill.addressFormFilled
+func (AddressFormFilledEvent) EventMethod() string {
+	return "Autofill.addressFormFilled"
+}
+
+// OnAddressFormFilled calls handler for each Autofill.addressFormFilled event
+func (c Client) OnAddressFormFilled(handler AddressFormFilledHandler) (unsubscribe func()) {
+	return c.caller.On("Autofill.addressFormFilled", func(ev interface{}) {
+		handler(ev.(AddressFormFilledEvent))
+	})
+}
+
+// decoders for Autofill events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Autofill.addressFormFilled": cdp.Decode[AddressFormFilledEvent],
+}
+
+// DecodeEvent decodes a Autofill event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,542 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package backgroundservice is the BackgroundService domain of the chrome devtools protocol
+//
+// Defines events for background web platform features.
+//
+// Experimental: this may change or be removed in any chrome release
+package backgroundservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends BackgroundService commands and listens for BackgroundService events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// The Background Service that will be associated with the commands/events.
+// Every Background Service operates independently, but they share the same
+// API.
+type ServiceName string
+
+// ServiceName values
+const (
+	ServiceNameBackgroundFetch        ServiceName = "backgroundFetch"
+	ServiceNameBackgroundSync         ServiceName = "backgroundSync"
+	ServiceNamePushMessaging          ServiceName = "pushMessaging"
+	ServiceNameNotifications          ServiceName = "notifications"
+	ServiceNamePaymentHandler         ServiceName = "paymentHandler"
+	ServiceNamePeriodicBackgroundSync ServiceName = "periodicBackgroundSync"
+)
+
+// Values gives every ServiceName
+func (ServiceName) Values() []ServiceName {
+	return []ServiceName{
+		ServiceNameBackgroundFetch,
+		ServiceNameBackgroundSync,
+		ServiceNamePushMessaging,
+		ServiceNameNotifications,
+		ServiceNamePaymentHandler,
+		ServiceNamePeriodicBackgroundSync,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ServiceName) Valid() bool {
+	switch v {
+	case ServiceNameBackgroundFetch, ServiceNameBackgroundSync, ServiceNamePushMessaging, ServiceNameNotifications, ServiceNamePaymentHandler, ServiceNamePeriodicBackgroundSync:
+		return true
+	}
+	return false
+}
+
+// A key-value pair for additional event information to pass along.
+type EventMetadata struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes EventMetadata as JSON
+func (v *EventMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EventMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads EventMetadata from JSON
+func (v *EventMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EventMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BackgroundServiceEvent struct {
+	/* Timestamp of the event (in seconds). */
+	Timestamp cdp.NetworkTimeSinceEpoch `json:"timestamp"`
+	/* The origin this event belongs to. */
+	Origin string `json:"origin"`
+	/* The Service Worker ID that initiated the event. */
+	ServiceWorkerRegistrationId cdp.ServiceWorkerRegistrationID `json:"serviceWorkerRegistrationId"`
+	/* The Background Service this event belongs to. */
+	Service ServiceName `json:"service"`
+	/* A description of the event. */
+	EventName string `json:"eventName"`
+	/* An identifier that groups related events together. */
+	InstanceId string `json:"instanceId"`
+	/* A list of event-specific information. */
+	EventMetadata []EventMetadata `json:"eventMetadata"`
+	/* Storage key this event belongs to. */
+	StorageKey string `json:"storageKey"`
+}
+
+// MarshalCDP writes BackgroundServiceEvent as JSON
+func (v *BackgroundServiceEvent) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("timestamp")
+	e.Float(float64(v.Timestamp))
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("serviceWorkerRegistrationId")
+	e.String(string(v.ServiceWorkerRegistrationId))
+	e.Key("service")
+	e.String(string(v.Service))
+	e.Key("eventName")
+	e.String(v.EventName)
+	e.Key("instanceId")
+	e.String(v.InstanceId)
+	e.Key("eventMetadata")
+	if v.EventMetadata == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.EventMetadata {
+			e.Elem()
+			v.EventMetadata[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BackgroundServiceEvent) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BackgroundServiceEvent from JSON
+func (v *BackgroundServiceEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "origin":
+			v.Origin = d.String()
+		case "serviceWorkerRegistrationId":
+			v.ServiceWorkerRegistrationId = cdp.ServiceWorkerRegistrationID(d.String())
+		case "service":
+			v.Service = ServiceName(d.String())
+		case "eventName":
+			v.EventName = d.String()
+		case "instanceId":
+			v.InstanceId = d.String()
+		case "eventMetadata":
+			if d.Array() {
+				v.EventMetadata = make([]EventMetadata, 0)
+				for d.More() {
+					var x0 EventMetadata
+					x0.UnmarshalCDP(d)
+					v.EventMetadata = append(v.EventMetadata, x0)
+				}
+			} else {
+				v.EventMetadata = nil
+			}
+		case "storageKey":
+			v.StorageKey = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackgroundServiceEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StartObservingReturns struct {
+}
+
+// UnmarshalCDP reads StartObservingReturns from JSON
+func (v *StartObservingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartObservingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartObservingParams are the parameters for BackgroundService.startObserving
+// optional parameters are left out when nil
+type StartObservingParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// MarshalCDP writes StartObservingParams as JSON
+func (v *StartObservingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("service")
+	e.String(string(v.Service))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartObservingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables event updates for the service.
+func (c Client) StartObserving(params StartObservingParams) (StartObservingReturns, error) {
+	return c.StartObservingContext(context.Background(), params)
+}
+
+// StartObservingContext is StartObserving with a context for cancellation and deadlines
+func (c Client) StartObservingContext(ctx context.Context, params StartObservingParams) (StartObservingReturns, error) {
+	var returns_ StartObservingReturns
+
+	if !params.Service.Valid() {
+		return returns_, fmt.Errorf("BackgroundService.startObserving: invalid service %q", params.Service)
+	}
+
+	err_ := c.caller.Call(ctx, "BackgroundService.startObserving", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopObservingReturns struct {
+}
+
+// UnmarshalCDP reads StopObservingReturns from JSON
+func (v *StopObservingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopObservingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StopObservingParams are the parameters for BackgroundService.stopObserving
+// optional parameters are left out when nil
+type StopObservingParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// MarshalCDP writes StopObservingParams as JSON
+func (v *StopObservingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("service")
+	e.String(string(v.Service))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StopObservingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Disables event updates for the service.
+func (c Client) StopObserving(params StopObservingParams) (StopObservingReturns, error) {
+	return c.StopObservingContext(context.Background(), params)
+}
+
+// StopObservingContext is StopObserving with a context for cancellation and deadlines
+func (c Client) StopObservingContext(ctx context.Context, params StopObservingParams) (StopObservingReturns, error) {
+	var returns_ StopObservingReturns
+
+	if !params.Service.Valid() {
+		return returns_, fmt.Errorf("BackgroundService.stopObserving: invalid service %q", params.Service)
+	}
+
+	err_ := c.caller.Call(ctx, "BackgroundService.stopObserving", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetRecordingReturns struct {
+}
+
+// UnmarshalCDP reads SetRecordingReturns from JSON
+func (v *SetRecordingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetRecordingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetRecordingParams are the parameters for BackgroundService.setRecording
+// optional parameters are left out when nil
+type SetRecordingParams struct {
+	ShouldRecord bool        `json:"shouldRecord"`
+	Service      ServiceName `json:"service"`
+}
+
+// MarshalCDP writes SetRecordingParams as JSON
+func (v *SetRecordingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("shouldRecord")
+	e.Bool(v.ShouldRecord)
+	e.Key("service")
+	e.String(string(v.Service))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetRecordingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set the recording state for the service.
+func (c Client) SetRecording(params SetRecordingParams) (SetRecordingReturns, error) {
+	return c.SetRecordingContext(context.Background(), params)
+}
+
+// SetRecordingContext is SetRecording with a context for cancellation and deadlines
+func (c Client) SetRecordingContext(ctx context.Context, params SetRecordingParams) (SetRecordingReturns, error) {
+	var returns_ SetRecordingReturns
+
+	if !params.Service.Valid() {
+		return returns_, fmt.Errorf("BackgroundService.setRecording: invalid service %q", params.Service)
+	}
+
+	err_ := c.caller.Call(ctx, "BackgroundService.setRecording", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearEventsReturns struct {
+}
+
+// UnmarshalCDP reads ClearEventsReturns from JSON
+func (v *ClearEventsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearEventsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearEventsParams are the parameters for BackgroundService.clearEvents
+// optional parameters are left out when nil
+type ClearEventsParams struct {
+	Service ServiceName `json:"service"`
+}
+
+// MarshalCDP writes ClearEventsParams as JSON
+func (v *ClearEventsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("service")
+	e.String(string(v.Service))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearEventsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears all stored data for the service.
+func (c Client) ClearEvents(params ClearEventsParams) (ClearEventsReturns, error) {
+	return c.ClearEventsContext(context.Background(), params)
+}
+
+// ClearEventsContext is ClearEvents with a context for cancellation and deadlines
+func (c Client) ClearEventsContext(ctx context.Context, params ClearEventsParams) (ClearEventsReturns, error) {
+	var returns_ ClearEventsReturns
+
+	if !params.Service.Valid() {
+		return returns_, fmt.Errorf("BackgroundService.clearEvents: invalid service %q", params.Service)
+	}
+
+	err_ := c.caller.Call(ctx, "BackgroundService.clearEvents", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Called when the recording state for the service has been updated.
+type RecordingStateChangedEvent struct {
+	IsRecording bool `json:"isRecording"`
+
+	Service ServiceName `json:"service"`
+}
+
+// UnmarshalCDP reads RecordingStateChangedEvent from JSON
+func (v *RecordingStateChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "isRecording":
+			v.IsRecording = d.Bool()
+		case "service":
+			v.Service = ServiceName(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RecordingStateChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RecordingStateChangedHandler func(ev RecordingStateChangedEvent)
+
+// EventMethod is BackgroundService.recordingStateChanged
+func (RecordingStateChangedEvent) EventMethod() string {
+	return "BackgroundService.recordingStateChanged"
+}
+
+// OnRecordingStateChanged calls handler for each BackgroundService.recordingStateChanged event
+func (c Client) OnRecordingStateChanged(handler RecordingStateChangedHandler) (unsubscribe func()) {
+	return c.caller.On("BackgroundService.recordingStateChanged", func(ev interface{}) {
+		handler(ev.(RecordingStateChangedEvent))
+	})
+}
+
+// Called with all existing backgroundServiceEvents when enabled, and all new
+// events afterwards if enabled and recording.
+type BackgroundServiceEventReceivedEvent struct {
+	BackgroundServiceEvent BackgroundServiceEvent `json:"backgroundServiceEvent"`
+}
+
+// UnmarshalCDP reads BackgroundServiceEventReceivedEvent from JSON
+func (v *BackgroundServiceEventReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backgroundServiceEvent":
+			v.BackgroundServiceEvent.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackgroundServiceEventReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BackgroundServiceEventReceivedHandler func(ev BackgroundServiceEventReceivedEvent)
+
+// EventMethod is BackgroundService.backgroundServiceEventReceived
+func (BackgroundServiceEventReceivedEvent) EventMethod() string {
+	return "BackgroundService.backgroundServiceEventReceived"
+}
+
+// OnBackgroundServiceEventReceived calls handler for each BackgroundService.backgroundServiceEventReceived event
+func (c Client) OnBackgroundServiceEventReceived(handler BackgroundServiceEventReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("BackgroundService.backgroundServiceEventReceived", func(ev interface{}) {
+		handler(ev.(BackgroundServiceEventReceivedEvent))
+	})
+}
+
+// decoders for BackgroundService events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"BackgroundService.recordingStateChanged":          cdp.Decode[RecordingStateChangedEvent],
+	"BackgroundService.backgroundServiceEventReceived": cdp.Decode[BackgroundServiceEventReceivedEvent],
+}
+
+// DecodeEvent decodes a BackgroundService event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,1602 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package bluetoothemulation is the BluetoothEmulation domain of the chrome devtools protocol
+//
+// This domain allows configuring virtual Bluetooth devices to test
+// the web-bluetooth API.
+//
+// Experimental: this may change or be removed in any chrome release
+package bluetoothemulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends BluetoothEmulation commands and listens for BluetoothEmulation events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Indicates the various states of Central.
+type CentralState string
+
+// CentralState values
+const (
+	CentralStateAbsent     CentralState = "absent"
+	CentralStatePoweredOff CentralState = "powered-off"
+	CentralStatePoweredOn  CentralState = "powered-on"
+)
+
+// Values gives every CentralState
+func (CentralState) Values() []CentralState {
+	return []CentralState{
+		CentralStateAbsent,
+		CentralStatePoweredOff,
+		CentralStatePoweredOn,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CentralState) Valid() bool {
+	switch v {
+	case CentralStateAbsent, CentralStatePoweredOff, CentralStatePoweredOn:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of GATT event.
+type GATTOperationType string
+
+// GATTOperationType values
+const (
+	GATTOperationTypeConnection GATTOperationType = "connection"
+	GATTOperationTypeDiscovery  GATTOperationType = "discovery"
+)
+
+// Values gives every GATTOperationType
+func (GATTOperationType) Values() []GATTOperationType {
+	return []GATTOperationType{
+		GATTOperationTypeConnection,
+		GATTOperationTypeDiscovery,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v GATTOperationType) Valid() bool {
+	switch v {
+	case GATTOperationTypeConnection, GATTOperationTypeDiscovery:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of characteristic write.
+type CharacteristicWriteType string
+
+// CharacteristicWriteType values
+const (
+	CharacteristicWriteTypeWriteDefaultDeprecated CharacteristicWriteType = "write-default-deprecated"
+	CharacteristicWriteTypeWriteWithResponse      CharacteristicWriteType = "write-with-response"
+	CharacteristicWriteTypeWriteWithoutResponse   CharacteristicWriteType = "write-without-response"
+)
+
+// Values gives every CharacteristicWriteType
+func (CharacteristicWriteType) Values() []CharacteristicWriteType {
+	return []CharacteristicWriteType{
+		CharacteristicWriteTypeWriteDefaultDeprecated,
+		CharacteristicWriteTypeWriteWithResponse,
+		CharacteristicWriteTypeWriteWithoutResponse,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CharacteristicWriteType) Valid() bool {
+	switch v {
+	case CharacteristicWriteTypeWriteDefaultDeprecated, CharacteristicWriteTypeWriteWithResponse, CharacteristicWriteTypeWriteWithoutResponse:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of characteristic operation.
+type CharacteristicOperationType string
+
+// CharacteristicOperationType values
+const (
+	CharacteristicOperationTypeRead                         CharacteristicOperationType = "read"
+	CharacteristicOperationTypeWrite                        CharacteristicOperationType = "write"
+	CharacteristicOperationTypeSubscribeToNotifications     CharacteristicOperationType = "subscribe-to-notifications"
+	CharacteristicOperationTypeUnsubscribeFromNotifications CharacteristicOperationType = "unsubscribe-from-notifications"
+)
+
+// Values gives every CharacteristicOperationType
+func (CharacteristicOperationType) Values() []CharacteristicOperationType {
+	return []CharacteristicOperationType{
+		CharacteristicOperationTypeRead,
+		CharacteristicOperationTypeWrite,
+		CharacteristicOperationTypeSubscribeToNotifications,
+		CharacteristicOperationTypeUnsubscribeFromNotifications,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CharacteristicOperationType) Valid() bool {
+	switch v {
+	case CharacteristicOperationTypeRead, CharacteristicOperationTypeWrite, CharacteristicOperationTypeSubscribeToNotifications, CharacteristicOperationTypeUnsubscribeFromNotifications:
+		return true
+	}
+	return false
+}
+
+// Indicates the various types of descriptor operation.
+type DescriptorOperationType string
+
+// DescriptorOperationType values
+const (
+	DescriptorOperationTypeRead  DescriptorOperationType = "read"
+	DescriptorOperationTypeWrite DescriptorOperationType = "write"
+)
+
+// Values gives every DescriptorOperationType
+func (DescriptorOperationType) Values() []DescriptorOperationType {
+	return []DescriptorOperationType{
+		DescriptorOperationTypeRead,
+		DescriptorOperationTypeWrite,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DescriptorOperationType) Valid() bool {
+	switch v {
+	case DescriptorOperationTypeRead, DescriptorOperationTypeWrite:
+		return true
+	}
+	return false
+}
+
+// Stores the manufacturer data
+type ManufacturerData struct {
+	/* Company identifier
+	https://bitbucket.org/bluetooth-SIG/public/src/main/assigned_numbers/company_identifiers/company_identifiers.yaml
+	https://usb.org/developers */
+	Key int `json:"key"`
+	/* Manufacturer-specific data (Encoded as a base64 string when passed over JSON) */
+	Data string `json:"data"`
+}
+
+// MarshalCDP writes ManufacturerData as JSON
+func (v *ManufacturerData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	e.Int(v.Key)
+	e.Key("data")
+	e.String(v.Data)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ManufacturerData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ManufacturerData from JSON
+func (v *ManufacturerData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key = d.Int()
+		case "data":
+			v.Data = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ManufacturerData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stores the byte data of the advertisement packet sent by a Bluetooth device.
+type ScanRecord struct {
+	Name  *string  `json:"name,omitempty"`
+	Uuids []string `json:"uuids"`
+	/* Stores the external appearance description of the device. */
+	Appearance *int `json:"appearance,omitempty"`
+	/* Stores the transmission power of a broadcasting device. */
+	TxPower *int `json:"txPower,omitempty"`
+	/* Key is the company identifier and the value is an array of bytes of
+	manufacturer specific data. */
+	ManufacturerData []ManufacturerData `json:"manufacturerData"`
+}
+
+// MarshalCDP writes ScanRecord as JSON
+func (v *ScanRecord) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	if v.Uuids != nil {
+		e.Key("uuids")
+		e.ArrayStart()
+		for i0 := range v.Uuids {
+			e.Elem()
+			e.String(v.Uuids[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.Appearance != nil {
+		e.Key("appearance")
+		e.Int(*v.Appearance)
+	}
+	if v.TxPower != nil {
+		e.Key("txPower")
+		e.Int(*v.TxPower)
+	}
+	if v.ManufacturerData != nil {
+		e.Key("manufacturerData")
+		e.ArrayStart()
+		for i0 := range v.ManufacturerData {
+			e.Elem()
+			v.ManufacturerData[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScanRecord) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScanRecord from JSON
+func (v *ScanRecord) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "uuids":
+			if d.Array() {
+				v.Uuids = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Uuids = append(v.Uuids, x0)
+				}
+			} else {
+				v.Uuids = nil
+			}
+		case "appearance":
+			if d.Null() {
+				v.Appearance = nil
+			} else {
+				v.Appearance = new(int)
+				*v.Appearance = d.Int()
+			}
+		case "txPower":
+			if d.Null() {
+				v.TxPower = nil
+			} else {
+				v.TxPower = new(int)
+				*v.TxPower = d.Int()
+			}
+		case "manufacturerData":
+			if d.Array() {
+				v.ManufacturerData = make([]ManufacturerData, 0)
+				for d.More() {
+					var x0 ManufacturerData
+					x0.UnmarshalCDP(d)
+					v.ManufacturerData = append(v.ManufacturerData, x0)
+				}
+			} else {
+				v.ManufacturerData = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScanRecord) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stores the advertisement packet information that is sent by a Bluetooth device.
+type ScanEntry struct {
+	DeviceAddress string     `json:"deviceAddress"`
+	Rssi          int        `json:"rssi"`
+	ScanRecord    ScanRecord `json:"scanRecord"`
+}
+
+// MarshalCDP writes ScanEntry as JSON
+func (v *ScanEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("deviceAddress")
+	e.String(v.DeviceAddress)
+	e.Key("rssi")
+	e.Int(v.Rssi)
+	e.Key("scanRecord")
+	v.ScanRecord.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScanEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScanEntry from JSON
+func (v *ScanEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "deviceAddress":
+			v.DeviceAddress = d.String()
+		case "rssi":
+			v.Rssi = d.Int()
+		case "scanRecord":
+			v.ScanRecord.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScanEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Describes the properties of a characteristic. This follows Bluetooth Core
+// Specification BT 4.2 Vol 3 Part G 3.3.1. Characteristic Properties.
+type CharacteristicProperties struct {
+	Broadcast                 *bool `json:"broadcast,omitempty"`
+	Read                      *bool `json:"read,omitempty"`
+	WriteWithoutResponse      *bool `json:"writeWithoutResponse,omitempty"`
+	Write                     *bool `json:"write,omitempty"`
+	Notify                    *bool `json:"notify,omitempty"`
+	Indicate                  *bool `json:"indicate,omitempty"`
+	AuthenticatedSignedWrites *bool `json:"authenticatedSignedWrites,omitempty"`
+	ExtendedProperties        *bool `json:"extendedProperties,omitempty"`
+}
+
+// MarshalCDP writes CharacteristicProperties as JSON
+func (v *CharacteristicProperties) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Broadcast != nil {
+		e.Key("broadcast")
+		e.Bool(*v.Broadcast)
+	}
+	if v.Read != nil {
+		e.Key("read")
+		e.Bool(*v.Read)
+	}
+	if v.WriteWithoutResponse != nil {
+		e.Key("writeWithoutResponse")
+		e.Bool(*v.WriteWithoutResponse)
+	}
+	if v.Write != nil {
+		e.Key("write")
+		e.Bool(*v.Write)
+	}
+	if v.Notify != nil {
+		e.Key("notify")
+		e.Bool(*v.Notify)
+	}
+	if v.Indicate != nil {
+		e.Key("indicate")
+		e.Bool(*v.Indicate)
+	}
+	if v.AuthenticatedSignedWrites != nil {
+		e.Key("authenticatedSignedWrites")
+		e.Bool(*v.AuthenticatedSignedWrites)
+	}
+	if v.ExtendedProperties != nil {
+		e.Key("extendedProperties")
+		e.Bool(*v.ExtendedProperties)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CharacteristicProperties) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CharacteristicProperties from JSON
+func (v *CharacteristicProperties) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "broadcast":
+			if d.Null() {
+				v.Broadcast = nil
+			} else {
+				v.Broadcast = new(bool)
+				*v.Broadcast = d.Bool()
+			}
+		case "read":
+			if d.Null() {
+				v.Read = nil
+			} else {
+				v.Read = new(bool)
+				*v.Read = d.Bool()
+			}
+		case "writeWithoutResponse":
+			if d.Null() {
+				v.WriteWithoutResponse = nil
+			} else {
+				v.WriteWithoutResponse = new(bool)
+				*v.WriteWithoutResponse = d.Bool()
+			}
+		case "write":
+			if d.Null() {
+				v.Write = nil
+			} else {
+				v.Write = new(bool)
+				*v.Write = d.Bool()
+			}
+		case "notify":
+			if d.Null() {
+				v.Notify = nil
+			} else {
+				v.Notify = new(bool)
+				*v.Notify = d.Bool()
+			}
+		case "indicate":
+			if d.Null() {
+				v.Indicate = nil
+			} else {
+				v.Indicate = new(bool)
+				*v.Indicate = d.Bool()
+			}
+		case "authenticatedSignedWrites":
+			if d.Null() {
+				v.AuthenticatedSignedWrites = nil
+			} else {
+				v.AuthenticatedSignedWrites = new(bool)
+				*v.AuthenticatedSignedWrites = d.Bool()
+			}
+		case "extendedProperties":
+			if d.Null() {
+				v.ExtendedProperties = nil
+			} else {
+				v.ExtendedProperties = new(bool)
+				*v.ExtendedProperties = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CharacteristicProperties) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for BluetoothEmulation.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* State of the simulated central. */
+	State CentralState `json:"state"`
+	/* If the simulated central supports low-energy. */
+	LeSupported bool `json:"leSupported"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("state")
+	e.String(string(v.State))
+	e.Key("leSupported")
+	e.Bool(v.LeSupported)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable the BluetoothEmulation domain.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	if !params.State.Valid() {
+		return returns_, fmt.Errorf("BluetoothEmulation.enable: invalid state %q", params.State)
+	}
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSimulatedCentralStateReturns struct {
+}
+
+// UnmarshalCDP reads SetSimulatedCentralStateReturns from JSON
+func (v *SetSimulatedCentralStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSimulatedCentralStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSimulatedCentralStateParams are the parameters for BluetoothEmulation.setSimulatedCentralState
+// optional parameters are left out when nil
+type SetSimulatedCentralStateParams struct {
+	/* State of the simulated central. */
+	State CentralState `json:"state"`
+}
+
+// MarshalCDP writes SetSimulatedCentralStateParams as JSON
+func (v *SetSimulatedCentralStateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("state")
+	e.String(string(v.State))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSimulatedCentralStateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set the state of the simulated central.
+func (c Client) SetSimulatedCentralState(params SetSimulatedCentralStateParams) (SetSimulatedCentralStateReturns, error) {
+	return c.SetSimulatedCentralStateContext(context.Background(), params)
+}
+
+// SetSimulatedCentralStateContext is SetSimulatedCentralState with a context for cancellation and deadlines
+func (c Client) SetSimulatedCentralStateContext(ctx context.Context, params SetSimulatedCentralStateParams) (SetSimulatedCentralStateReturns, error) {
+	var returns_ SetSimulatedCentralStateReturns
+
+	if !params.State.Valid() {
+		return returns_, fmt.Errorf("BluetoothEmulation.setSimulatedCentralState: invalid state %q", params.State)
+	}
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.setSimulatedCentralState", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disable the BluetoothEmulation domain.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SimulatePreconnectedPeripheralReturns struct {
+}
+
+// UnmarshalCDP reads SimulatePreconnectedPeripheralReturns from JSON
+func (v *SimulatePreconnectedPeripheralReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulatePreconnectedPeripheralReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulatePreconnectedPeripheralParams are the parameters for BluetoothEmulation.simulatePreconnectedPeripheral
+// optional parameters are left out when nil
+type SimulatePreconnectedPeripheralParams struct {
+	Address           string             `json:"address"`
+	Name              string             `json:"name"`
+	ManufacturerData  []ManufacturerData `json:"manufacturerData"`
+	KnownServiceUuids []string           `json:"knownServiceUuids"`
+}
+
+// MarshalCDP writes SimulatePreconnectedPeripheralParams as JSON
+func (v *SimulatePreconnectedPeripheralParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("address")
+	e.String(v.Address)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("manufacturerData")
+	if v.ManufacturerData == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ManufacturerData {
+			e.Elem()
+			v.ManufacturerData[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("knownServiceUuids")
+	if v.KnownServiceUuids == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.KnownServiceUuids {
+			e.Elem()
+			e.String(v.KnownServiceUuids[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulatePreconnectedPeripheralParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulates a peripheral with |address|, |name| and |knownServiceUuids|
+// that has already been connected to the system.
+func (c Client) SimulatePreconnectedPeripheral(params SimulatePreconnectedPeripheralParams) (SimulatePreconnectedPeripheralReturns, error) {
+	return c.SimulatePreconnectedPeripheralContext(context.Background(), params)
+}
+
+// SimulatePreconnectedPeripheralContext is SimulatePreconnectedPeripheral with a context for cancellation and deadlines
+func (c Client) SimulatePreconnectedPeripheralContext(ctx context.Context, params SimulatePreconnectedPeripheralParams) (SimulatePreconnectedPeripheralReturns, error) {
+	var returns_ SimulatePreconnectedPeripheralReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.simulatePreconnectedPeripheral", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SimulateAdvertisementReturns struct {
+}
+
+// UnmarshalCDP reads SimulateAdvertisementReturns from JSON
+func (v *SimulateAdvertisementReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulateAdvertisementReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulateAdvertisementParams are the parameters for BluetoothEmulation.simulateAdvertisement
+// optional parameters are left out when nil
+type SimulateAdvertisementParams struct {
+	Entry ScanEntry `json:"entry"`
+}
+
+// MarshalCDP writes SimulateAdvertisementParams as JSON
+func (v *SimulateAdvertisementParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("entry")
+	v.Entry.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulateAdvertisementParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulates an advertisement packet described in |entry| being received by
+// the central.
+func (c Client) SimulateAdvertisement(params SimulateAdvertisementParams) (SimulateAdvertisementReturns, error) {
+	return c.SimulateAdvertisementContext(context.Background(), params)
+}
+
+// SimulateAdvertisementContext is SimulateAdvertisement with a context for cancellation and deadlines
+func (c Client) SimulateAdvertisementContext(ctx context.Context, params SimulateAdvertisementParams) (SimulateAdvertisementReturns, error) {
+	var returns_ SimulateAdvertisementReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.simulateAdvertisement", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SimulateGATTOperationResponseReturns struct {
+}
+
+// UnmarshalCDP reads SimulateGATTOperationResponseReturns from JSON
+func (v *SimulateGATTOperationResponseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulateGATTOperationResponseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulateGATTOperationResponseParams are the parameters for BluetoothEmulation.simulateGATTOperationResponse
+// optional parameters are left out when nil
+type SimulateGATTOperationResponseParams struct {
+	Address string            `json:"address"`
+	Type    GATTOperationType `json:"type"`
+	Code    int               `json:"code"`
+}
+
+// MarshalCDP writes SimulateGATTOperationResponseParams as JSON
+func (v *SimulateGATTOperationResponseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("address")
+	e.String(v.Address)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("code")
+	e.Int(v.Code)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulateGATTOperationResponseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulates the response code from the peripheral with |address| for a
+// GATT operation of |type|. The |code| value follows the HCI Error Codes from
+// Bluetooth Core Specification Vol 2 Part D 1.3 List Of Error Codes.
+func (c Client) SimulateGATTOperationResponse(params SimulateGATTOperationResponseParams) (SimulateGATTOperationResponseReturns, error) {
+	return c.SimulateGATTOperationResponseContext(context.Background(), params)
+}
+
+// SimulateGATTOperationResponseContext is SimulateGATTOperationResponse with a context for cancellation and deadlines
+func (c Client) SimulateGATTOperationResponseContext(ctx context.Context, params SimulateGATTOperationResponseParams) (SimulateGATTOperationResponseReturns, error) {
+	var returns_ SimulateGATTOperationResponseReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("BluetoothEmulation.simulateGATTOperationResponse: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.simulateGATTOperationResponse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SimulateCharacteristicOperationResponseReturns struct {
+}
+
+// UnmarshalCDP reads SimulateCharacteristicOperationResponseReturns from JSON
+func (v *SimulateCharacteristicOperationResponseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulateCharacteristicOperationResponseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulateCharacteristicOperationResponseParams are the parameters for BluetoothEmulation.simulateCharacteristicOperationResponse
+// optional parameters are left out when nil
+type SimulateCharacteristicOperationResponseParams struct {
+	CharacteristicId string                      `json:"characteristicId"`
+	Type             CharacteristicOperationType `json:"type"`
+	Code             int                         `json:"code"`
+	Data             *string                     `json:"data,omitempty"`
+}
+
+// MarshalCDP writes SimulateCharacteristicOperationResponseParams as JSON
+func (v *SimulateCharacteristicOperationResponseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("characteristicId")
+	e.String(v.CharacteristicId)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("code")
+	e.Int(v.Code)
+	if v.Data != nil {
+		e.Key("data")
+		e.String(*v.Data)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulateCharacteristicOperationResponseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulates the response from the characteristic with |characteristicId| for a
+// characteristic operation of |type|. The |code| value follows the Error
+// Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response.
+// The |data| is expected to exist when simulating a successful read operation
+// response.
+func (c Client) SimulateCharacteristicOperationResponse(params SimulateCharacteristicOperationResponseParams) (SimulateCharacteristicOperationResponseReturns, error) {
+	return c.SimulateCharacteristicOperationResponseContext(context.Background(), params)
+}
+
+// SimulateCharacteristicOperationResponseContext is SimulateCharacteristicOperationResponse with a context for cancellation and deadlines
+func (c Client) SimulateCharacteristicOperationResponseContext(ctx context.Context, params SimulateCharacteristicOperationResponseParams) (SimulateCharacteristicOperationResponseReturns, error) {
+	var returns_ SimulateCharacteristicOperationResponseReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("BluetoothEmulation.simulateCharacteristicOperationResponse: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.simulateCharacteristicOperationResponse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SimulateDescriptorOperationResponseReturns struct {
+}
+
+// UnmarshalCDP reads SimulateDescriptorOperationResponseReturns from JSON
+func (v *SimulateDescriptorOperationResponseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulateDescriptorOperationResponseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulateDescriptorOperationResponseParams are the parameters for BluetoothEmulation.simulateDescriptorOperationResponse
+// optional parameters are left out when nil
+type SimulateDescriptorOperationResponseParams struct {
+	DescriptorId string                  `json:"descriptorId"`
+	Type         DescriptorOperationType `json:"type"`
+	Code         int                     `json:"code"`
+	Data         *string                 `json:"data,omitempty"`
+}
+
+// MarshalCDP writes SimulateDescriptorOperationResponseParams as JSON
+func (v *SimulateDescriptorOperationResponseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("descriptorId")
+	e.String(v.DescriptorId)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("code")
+	e.Int(v.Code)
+	if v.Data != nil {
+		e.Key("data")
+		e.String(*v.Data)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulateDescriptorOperationResponseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulates the response from the descriptor with |descriptorId| for a
+// descriptor operation of |type|. The |code| value follows the Error
+// Codes from Bluetooth Core Specification Vol 3 Part F 3.4.1.1 Error Response.
+// The |data| is expected to exist when simulating a successful read operation
+// response.
+func (c Client) SimulateDescriptorOperationResponse(params SimulateDescriptorOperationResponseParams) (SimulateDescriptorOperationResponseReturns, error) {
+	return c.SimulateDescriptorOperationResponseContext(context.Background(), params)
+}
+
+// SimulateDescriptorOperationResponseContext is SimulateDescriptorOperationResponse with a context for cancellation and deadlines
+func (c Client) SimulateDescriptorOperationResponseContext(ctx context.Context, params SimulateDescriptorOperationResponseParams) (SimulateDescriptorOperationResponseReturns, error) {
+	var returns_ SimulateDescriptorOperationResponseReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("BluetoothEmulation.simulateDescriptorOperationResponse: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.simulateDescriptorOperationResponse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddServiceReturns struct {
+
+	/* An identifier that uniquely represents this service. */
+	ServiceId string `json:"serviceId"`
+}
+
+// UnmarshalCDP reads AddServiceReturns from JSON
+func (v *AddServiceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "serviceId":
+			v.ServiceId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddServiceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddServiceParams are the parameters for BluetoothEmulation.addService
+// optional parameters are left out when nil
+type AddServiceParams struct {
+	Address     string `json:"address"`
+	ServiceUuid string `json:"serviceUuid"`
+}
+
+// MarshalCDP writes AddServiceParams as JSON
+func (v *AddServiceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("address")
+	e.String(v.Address)
+	e.Key("serviceUuid")
+	e.String(v.ServiceUuid)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddServiceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Adds a service with |serviceUuid| to the peripheral with |address|.
+func (c Client) AddService(params AddServiceParams) (AddServiceReturns, error) {
+	return c.AddServiceContext(context.Background(), params)
+}
+
+// AddServiceContext is AddService with a context for cancellation and deadlines
+func (c Client) AddServiceContext(ctx context.Context, params AddServiceParams) (AddServiceReturns, error) {
+	var returns_ AddServiceReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.addService", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveServiceReturns struct {
+}
+
+// UnmarshalCDP reads RemoveServiceReturns from JSON
+func (v *RemoveServiceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveServiceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveServiceParams are the parameters for BluetoothEmulation.removeService
+// optional parameters are left out when nil
+type RemoveServiceParams struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// MarshalCDP writes RemoveServiceParams as JSON
+func (v *RemoveServiceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("serviceId")
+	e.String(v.ServiceId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveServiceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes the service respresented by |serviceId| from the simulated central.
+func (c Client) RemoveService(params RemoveServiceParams) (RemoveServiceReturns, error) {
+	return c.RemoveServiceContext(context.Background(), params)
+}
+
+// RemoveServiceContext is RemoveService with a context for cancellation and deadlines
+func (c Client) RemoveServiceContext(ctx context.Context, params RemoveServiceParams) (RemoveServiceReturns, error) {
+	var returns_ RemoveServiceReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.removeService", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddCharacteristicReturns struct {
+
+	/* An identifier that uniquely represents this characteristic. */
+	CharacteristicId string `json:"characteristicId"`
+}
+
+// UnmarshalCDP reads AddCharacteristicReturns from JSON
+func (v *AddCharacteristicReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "characteristicId":
+			v.CharacteristicId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddCharacteristicReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddCharacteristicParams are the parameters for BluetoothEmulation.addCharacteristic
+// optional parameters are left out when nil
+type AddCharacteristicParams struct {
+	ServiceId          string                   `json:"serviceId"`
+	CharacteristicUuid string                   `json:"characteristicUuid"`
+	Properties         CharacteristicProperties `json:"properties"`
+}
+
+// MarshalCDP writes AddCharacteristicParams as JSON
+func (v *AddCharacteristicParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("serviceId")
+	e.String(v.ServiceId)
+	e.Key("characteristicUuid")
+	e.String(v.CharacteristicUuid)
+	e.Key("properties")
+	v.Properties.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddCharacteristicParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Adds a characteristic with |characteristicUuid| and |properties| to the
+// service represented by |serviceId|.
+func (c Client) AddCharacteristic(params AddCharacteristicParams) (AddCharacteristicReturns, error) {
+	return c.AddCharacteristicContext(context.Background(), params)
+}
+
+// AddCharacteristicContext is AddCharacteristic with a context for cancellation and deadlines
+func (c Client) AddCharacteristicContext(ctx context.Context, params AddCharacteristicParams) (AddCharacteristicReturns, error) {
+	var returns_ AddCharacteristicReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.addCharacteristic", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveCharacteristicReturns struct {
+}
+
+// UnmarshalCDP reads RemoveCharacteristicReturns from JSON
+func (v *RemoveCharacteristicReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveCharacteristicReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveCharacteristicParams are the parameters for BluetoothEmulation.removeCharacteristic
+// optional parameters are left out when nil
+type RemoveCharacteristicParams struct {
+	CharacteristicId string `json:"characteristicId"`
+}
+
+// MarshalCDP writes RemoveCharacteristicParams as JSON
+func (v *RemoveCharacteristicParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("characteristicId")
+	e.String(v.CharacteristicId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveCharacteristicParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes the characteristic respresented by |characteristicId| from the
+// simulated central.
+func (c Client) RemoveCharacteristic(params RemoveCharacteristicParams) (RemoveCharacteristicReturns, error) {
+	return c.RemoveCharacteristicContext(context.Background(), params)
+}
+
+// RemoveCharacteristicContext is RemoveCharacteristic with a context for cancellation and deadlines
+func (c Client) RemoveCharacteristicContext(ctx context.Context, params RemoveCharacteristicParams) (RemoveCharacteristicReturns, error) {
+	var returns_ RemoveCharacteristicReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.removeCharacteristic", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddDescriptorReturns struct {
+
+	/* An identifier that uniquely represents this descriptor. */
+	DescriptorId string `json:"descriptorId"`
+}
+
+// UnmarshalCDP reads AddDescriptorReturns from JSON
+func (v *AddDescriptorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "descriptorId":
+			v.DescriptorId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddDescriptorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddDescriptorParams are the parameters for BluetoothEmulation.addDescriptor
+// optional parameters are left out when nil
+type AddDescriptorParams struct {
+	CharacteristicId string `json:"characteristicId"`
+	DescriptorUuid   string `json:"descriptorUuid"`
+}
+
+// MarshalCDP writes AddDescriptorParams as JSON
+func (v *AddDescriptorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("characteristicId")
+	e.String(v.CharacteristicId)
+	e.Key("descriptorUuid")
+	e.String(v.DescriptorUuid)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddDescriptorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Adds a descriptor with |descriptorUuid| to the characteristic respresented
+// by |characteristicId|.
+func (c Client) AddDescriptor(params AddDescriptorParams) (AddDescriptorReturns, error) {
+	return c.AddDescriptorContext(context.Background(), params)
+}
+
+// AddDescriptorContext is AddDescriptor with a context for cancellation and deadlines
+func (c Client) AddDescriptorContext(ctx context.Context, params AddDescriptorParams) (AddDescriptorReturns, error) {
+	var returns_ AddDescriptorReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.addDescriptor", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveDescriptorReturns struct {
+}
+
+// UnmarshalCDP reads RemoveDescriptorReturns from JSON
+func (v *RemoveDescriptorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveDescriptorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveDescriptorParams are the parameters for BluetoothEmulation.removeDescriptor
+// optional parameters are left out when nil
+type RemoveDescriptorParams struct {
+	DescriptorId string `json:"descriptorId"`
+}
+
+// MarshalCDP writes RemoveDescriptorParams as JSON
+func (v *RemoveDescriptorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("descriptorId")
+	e.String(v.DescriptorId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveDescriptorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes the descriptor with |descriptorId| from the simulated central.
+func (c Client) RemoveDescriptor(params RemoveDescriptorParams) (RemoveDescriptorReturns, error) {
+	return c.RemoveDescriptorContext(context.Background(), params)
+}
+
+// RemoveDescriptorContext is RemoveDescriptor with a context for cancellation and deadlines
+func (c Client) RemoveDescriptorContext(ctx context.Context, params RemoveDescriptorParams) (RemoveDescriptorReturns, error) {
+	var returns_ RemoveDescriptorReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.removeDescriptor", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SimulateGATTDisconnectionReturns struct {
+}
+
+// UnmarshalCDP reads SimulateGATTDisconnectionReturns from JSON
+func (v *SimulateGATTDisconnectionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulateGATTDisconnectionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulateGATTDisconnectionParams are the parameters for BluetoothEmulation.simulateGATTDisconnection
+// optional parameters are left out when nil
+type SimulateGATTDisconnectionParams struct {
+	Address string `json:"address"`
+}
+
+// MarshalCDP writes SimulateGATTDisconnectionParams as JSON
+func (v *SimulateGATTDisconnectionParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("address")
+	e.String(v.Address)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulateGATTDisconnectionParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulates a GATT disconnection from the peripheral with |address|.
+func (c Client) SimulateGATTDisconnection(params SimulateGATTDisconnectionParams) (SimulateGATTDisconnectionReturns, error) {
+	return c.SimulateGATTDisconnectionContext(context.Background(), params)
+}
+
+// SimulateGATTDisconnectionContext is SimulateGATTDisconnection with a context for cancellation and deadlines
+func (c Client) SimulateGATTDisconnectionContext(ctx context.Context, params SimulateGATTDisconnectionParams) (SimulateGATTDisconnectionReturns, error) {
+	var returns_ SimulateGATTDisconnectionReturns
+
+	err_ := c.caller.Call(ctx, "BluetoothEmulation.simulateGATTDisconnection", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Event for when a GATT operation of |type| to the peripheral with |address|
+// happened.
+type GattOperationReceivedEvent struct {
+	Address string `json:"address"`
+
+	Type GATTOperationType `json:"type"`
+}
+
+// UnmarshalCDP reads GattOperationReceivedEvent from JSON
+func (v *GattOperationReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "address":
+			v.Address = d.String()
+		case "type":
+			v.Type = GATTOperationType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GattOperationReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GattOperationReceivedHandler func(ev GattOperationReceivedEvent)
+
+// EventMethod is BluetoothEmulation.gattOperationReceived
+func (GattOperationReceivedEvent) EventMethod() string {
+	return "BluetoothEmulation.gattOperationReceived"
+}
+
+// OnGattOperationReceived calls handler for each BluetoothEmulation.gattOperationReceived event
+func (c Client) OnGattOperationReceived(handler GattOperationReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("BluetoothEmulation.gattOperationReceived", func(ev interface{}) {
+		handler(ev.(GattOperationReceivedEvent))
+	})
+}
+
+// Event for when a characteristic operation of |type| to the characteristic
+// respresented by |characteristicId| happened. |data| and |writeType| is
+// expected to exist when |type| is write.
+type CharacteristicOperationReceivedEvent struct {
+	CharacteristicId string `json:"characteristicId"`
+
+	Type CharacteristicOperationType `json:"type"`
+
+	Data *string `json:"data,omitempty"`
+
+	WriteType *CharacteristicWriteType `json:"writeType,omitempty"`
+}
+
+// UnmarshalCDP reads CharacteristicOperationReceivedEvent from JSON
+func (v *CharacteristicOperationReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "characteristicId":
+			v.CharacteristicId = d.String()
+		case "type":
+			v.Type = CharacteristicOperationType(d.String())
+		case "data":
+			if d.Null() {
+				v.Data = nil
+			} else {
+				v.Data = new(string)
+				*v.Data = d.String()
+			}
+		case "writeType":
+			if d.Null() {
+				v.WriteType = nil
+			} else {
+				v.WriteType = new(CharacteristicWriteType)
+				*v.WriteType = CharacteristicWriteType(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CharacteristicOperationReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CharacteristicOperationReceivedHandler func(ev CharacteristicOperationReceivedEvent)
+
+// EventMethod is BluetoothEmulation.characteristicOperationReceived
+func (CharacteristicOperationReceivedEvent) EventMethod() string {
+	return "BluetoothEmulation.characteristicOperationReceived"
+}
+
+// OnCharacteristicOperationReceived calls handler for each BluetoothEmulation.characteristicOperationReceived event
+func (c Client) OnCharacteristicOperationReceived(handler CharacteristicOperationReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("BluetoothEmulation.characteristicOperationReceived", func(ev interface{}) {
+		handler(ev.(CharacteristicOperationReceivedEvent))
+	})
+}
+
+// Event for when a descriptor operation of |type| to the descriptor
+// respresented by |descriptorId| happened. |data| is expected to exist when
+// |type| is write.
+type DescriptorOperationReceivedEvent struct {
+	DescriptorId string `json:"descriptorId"`
+
+	Type DescriptorOperationType `json:"type"`
+
+	Data *string `json:"data,omitempty"`
+}
+
+// UnmarshalCDP reads DescriptorOperationReceivedEvent from JSON
+func (v *DescriptorOperationReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "descriptorId":
+			v.DescriptorId = d.String()
+		case "type":
+			v.Type = DescriptorOperationType(d.String())
+		case "data":
+			if d.Null() {
+				v.Data = nil
+			} else {
+				v.Data = new(string)
+				*v.Data = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DescriptorOperationReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DescriptorOperationReceivedHandler func(ev DescriptorOperationReceivedEvent)
+
+// EventMethod is BluetoothEmulation.descriptorOperationReceived
+func (DescriptorOperationReceivedEvent) EventMethod() string {
+	return "BluetoothEmulation.descriptorOperationReceived"
+}
+
+// OnDescriptorOperationReceived calls handler for each BluetoothEmulation.descriptorOperationReceived event
+func (c Client) OnDescriptorOperationReceived(handler DescriptorOperationReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("BluetoothEmulation.descriptorOperationReceived", func(ev interface{}) {
+		handler(ev.(DescriptorOperationReceivedEvent))
+	})
+}
+
+// decoders for BluetoothEmulation events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"BluetoothEmulation.gattOperationReceived":           cdp.Decode[GattOperationReceivedEvent],
+	"BluetoothEmulation.characteristicOperationReceived": cdp.Decode[CharacteristicOperationReceivedEvent],
+	"BluetoothEmulation.descriptorOperationReceived":     cdp.Decode[DescriptorOperationReceivedEvent],
+}
+
+// DecodeEvent decodes a BluetoothEmulation event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,934 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package browser is the Browser domain of the chrome devtools protocol
+//
+// The Browser domain defines methods and events for browser managing.
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Browser commands and listens for Browser events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	BrowserContextID = cdp.BrowserBrowserContextID
+)
+
+// Experimental: this may change or be removed in any chrome release
+type WindowID int
+
+// The state of the browser window.
+//
+// Experimental: this may change or be removed in any chrome release
+type WindowState string
+
+// WindowState values
+const (
+	WindowStateNormal     WindowState = "normal"
+	WindowStateMinimized  WindowState = "minimized"
+	WindowStateMaximized  WindowState = "maximized"
+	WindowStateFullscreen WindowState = "fullscreen"
+)
+
+// Values gives every WindowState
+func (WindowState) Values() []WindowState {
+	return []WindowState{
+		WindowStateNormal,
+		WindowStateMinimized,
+		WindowStateMaximized,
+		WindowStateFullscreen,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v WindowState) Valid() bool {
+	switch v {
+	case WindowStateNormal, WindowStateMinimized, WindowStateMaximized, WindowStateFullscreen:
+		return true
+	}
+	return false
+}
+
+// Browser window bounds information
+//
+// Experimental: this may change or be removed in any chrome release
+type Bounds struct {
+	/* The offset from the left edge of the screen to the window in pixels. */
+	Left *int `json:"left,omitempty"`
+	/* The offset from the top edge of the screen to the window in pixels. */
+	Top *int `json:"top,omitempty"`
+	/* The window width in pixels. */
+	Width *int `json:"width,omitempty"`
+	/* The window height in pixels. */
+	Height *int `json:"height,omitempty"`
+	/* The window state. Default to normal. */
+	WindowState *WindowState `json:"windowState,omitempty"`
+}
+
+// MarshalCDP writes Bounds as JSON
+func (v *Bounds) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Left != nil {
+		e.Key("left")
+		e.Int(*v.Left)
+	}
+	if v.Top != nil {
+		e.Key("top")
+		e.Int(*v.Top)
+	}
+	if v.Width != nil {
+		e.Key("width")
+		e.Int(*v.Width)
+	}
+	if v.Height != nil {
+		e.Key("height")
+		e.Int(*v.Height)
+	}
+	if v.WindowState != nil {
+		e.Key("windowState")
+		e.String(string(*v.WindowState))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Bounds) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Bounds from JSON
+func (v *Bounds) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "left":
+			if d.Null() {
+				v.Left = nil
+			} else {
+				v.Left = new(int)
+				*v.Left = d.Int()
+			}
+		case "top":
+			if d.Null() {
+				v.Top = nil
+			} else {
+				v.Top = new(int)
+				*v.Top = d.Int()
+			}
+		case "width":
+			if d.Null() {
+				v.Width = nil
+			} else {
+				v.Width = new(int)
+				*v.Width = d.Int()
+			}
+		case "height":
+			if d.Null() {
+				v.Height = nil
+			} else {
+				v.Height = new(int)
+				*v.Height = d.Int()
+			}
+		case "windowState":
+			if d.Null() {
+				v.WindowState = nil
+			} else {
+				v.WindowState = new(WindowState)
+				*v.WindowState = WindowState(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Bounds) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PermissionType string
+
+// PermissionType values
+const (
+	PermissionTypeAr                       PermissionType = "ar"
+	PermissionTypeAudioCapture             PermissionType = "audioCapture"
+	PermissionTypeAutomaticFullscreen      PermissionType = "automaticFullscreen"
+	PermissionTypeBackgroundFetch          PermissionType = "backgroundFetch"
+	PermissionTypeBackgroundSync           PermissionType = "backgroundSync"
+	PermissionTypeCameraPanTiltZoom        PermissionType = "cameraPanTiltZoom"
+	PermissionTypeCapturedSurfaceControl   PermissionType = "capturedSurfaceControl"
+	PermissionTypeClipboardReadWrite       PermissionType = "clipboardReadWrite"
+	PermissionTypeClipboardSanitizedWrite  PermissionType = "clipboardSanitizedWrite"
+	PermissionTypeDisplayCapture           PermissionType = "displayCapture"
+	PermissionTypeDurableStorage           PermissionType = "durableStorage"
+	PermissionTypeGeolocation              PermissionType = "geolocation"
+	PermissionTypeHandTracking             PermissionType = "handTracking"
+	PermissionTypeIdleDetection            PermissionType = "idleDetection"
+	PermissionTypeKeyboardLock             PermissionType = "keyboardLock"
+	PermissionTypeLocalFonts               PermissionType = "localFonts"
+	PermissionTypeLocalNetworkAccess       PermissionType = "localNetworkAccess"
+	PermissionTypeMidi                     PermissionType = "midi"
+	PermissionTypeMidiSysex                PermissionType = "midiSysex"
+	PermissionTypeNfc                      PermissionType = "nfc"
+	PermissionTypeNotifications            PermissionType = "notifications"
+	PermissionTypePaymentHandler           PermissionType = "paymentHandler"
+	PermissionTypePeriodicBackgroundSync   PermissionType = "periodicBackgroundSync"
+	PermissionTypePointerLock              PermissionType = "pointerLock"
+	PermissionTypeProtectedMediaIdentifier PermissionType = "protectedMediaIdentifier"
+	PermissionTypeSensors                  PermissionType = "sensors"
+	PermissionTypeSmartCard                PermissionType = "smartCard"
+	PermissionTypeSpeakerSelection         PermissionType = "speakerSelection"
+	PermissionTypeStorageAccess            PermissionType = "storageAccess"
+	PermissionTypeTopLevelStorageAccess    PermissionType = "topLevelStorageAccess"
+	PermissionTypeVideoCapture             PermissionType = "videoCapture"
+	PermissionTypeVr                       PermissionType = "vr"
+	PermissionTypeWakeLockScreen           PermissionType = "wakeLockScreen"
+	PermissionTypeWakeLockSystem           PermissionType = "wakeLockSystem"
+	PermissionTypeWebAppInstallation       PermissionType = "webAppInstallation"
+	PermissionTypeWebPrinting              PermissionType = "webPrinting"
+	PermissionTypeWindowManagement         PermissionType = "windowManagement"
+)
+
+// Values gives every PermissionType
+func (PermissionType) Values() []PermissionType {
+	return []PermissionType{
+		PermissionTypeAr,
+		PermissionTypeAudioCapture,
+		PermissionTypeAutomaticFullscreen,
+		PermissionTypeBackgroundFetch,
+		PermissionTypeBackgroundSync,
+		PermissionTypeCameraPanTiltZoom,
+		PermissionTypeCapturedSurfaceControl,
+		PermissionTypeClipboardReadWrite,
+		PermissionTypeClipboardSanitizedWrite,
+		PermissionTypeDisplayCapture,
+		PermissionTypeDurableStorage,
+		PermissionTypeGeolocation,
+		PermissionTypeHandTracking,
+		PermissionTypeIdleDetection,
+		PermissionTypeKeyboardLock,
+		PermissionTypeLocalFonts,
+		PermissionTypeLocalNetworkAccess,
+		PermissionTypeMidi,
+		PermissionTypeMidiSysex,
+		PermissionTypeNfc,
+		PermissionTypeNotifications,
+		PermissionTypePaymentHandler,
+		PermissionTypePeriodicBackgroundSync,
+		PermissionTypePointerLock,
+		PermissionTypeProtectedMediaIdentifier,
+		PermissionTypeSensors,
+		PermissionTypeSmartCard,
+		PermissionTypeSpeakerSelection,
+		PermissionTypeStorageAccess,
+		PermissionTypeTopLevelStorageAccess,
+		PermissionTypeVideoCapture,
+		PermissionTypeVr,
+		PermissionTypeWakeLockScreen,
+		PermissionTypeWakeLockSystem,
+		PermissionTypeWebAppInstallation,
+		PermissionTypeWebPrinting,
+		PermissionTypeWindowManagement,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PermissionType) Valid() bool {
+	switch v {
+	case PermissionTypeAr, PermissionTypeAudioCapture, PermissionTypeAutomaticFullscreen, PermissionTypeBackgroundFetch, PermissionTypeBackgroundSync, PermissionTypeCameraPanTiltZoom, PermissionTypeCapturedSurfaceControl, PermissionTypeClipboardReadWrite, PermissionTypeClipboardSanitizedWrite, PermissionTypeDisplayCapture, PermissionTypeDurableStorage, PermissionTypeGeolocation, PermissionTypeHandTracking, PermissionTypeIdleDetection, PermissionTypeKeyboardLock, PermissionTypeLocalFonts, PermissionTypeLocalNetworkAccess, PermissionTypeMidi, PermissionTypeMidiSysex, PermissionTypeNfc, PermissionTypeNotifications, PermissionTypePaymentHandler, PermissionTypePeriodicBackgroundSync, PermissionTypePointerLock, PermissionTypeProtectedMediaIdentifier, PermissionTypeSensors, PermissionTypeSmartCard, PermissionTypeSpeakerSelection, PermissionTypeStorageAccess, PermissionTypeTopLevelStorageAccess, PermissionTypeVideoCapture, PermissionTypeVr, PermissionTypeWakeLockScreen, PermissionTypeWakeLockSystem, PermissionTypeWebAppInstallation, PermissionTypeWebPrinting, PermissionTypeWindowManagement:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PermissionSetting string
+
+// PermissionSetting values
+const (
+	PermissionSettingGranted PermissionSetting = "granted"
+	PermissionSettingDenied  PermissionSetting = "denied"
+	PermissionSettingPrompt  PermissionSetting = "prompt"
+)
+
+// Values gives every PermissionSetting
+func (PermissionSetting) Values() []PermissionSetting {
+	return []PermissionSetting{
+		PermissionSettingGranted,
+		PermissionSettingDenied,
+		PermissionSettingPrompt,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PermissionSetting) Valid() bool {
+	switch v {
+	case PermissionSettingGranted, PermissionSettingDenied, PermissionSettingPrompt:
+		return true
+	}
+	return false
+}
+
+// Definition of PermissionDescriptor defined in the Permissions API:
+// https://w3c.github.io/permissions/#dom-permissiondescriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type PermissionDescriptor struct {
+	/* Name of permission.
+	See https://cs.chromium.org/chromium/src/third_party/blink/renderer/modules/permissions/permission_descriptor.idl for valid permission names. */
+	Name string `json:"name"`
+	/* For "midi" permission, may also specify sysex control. */
+	Sysex *bool `json:"sysex,omitempty"`
+	/* For "push" permission, may specify userVisibleOnly.
+	Note that userVisibleOnly = true is the only currently supported type. */
+	UserVisibleOnly *bool `json:"userVisibleOnly,omitempty"`
+	/* For "clipboard" permission, may specify allowWithoutSanitization. */
+	AllowWithoutSanitization *bool `json:"allowWithoutSanitization,omitempty"`
+	/* For "fullscreen" permission, must specify allowWithoutGesture:true. */
+	AllowWithoutGesture *bool `json:"allowWithoutGesture,omitempty"`
+	/* For "camera" permission, may specify panTiltZoom. */
+	PanTiltZoom *bool `json:"panTiltZoom,omitempty"`
+}
+
+// MarshalCDP writes PermissionDescriptor as JSON
+func (v *PermissionDescriptor) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.Sysex != nil {
+		e.Key("sysex")
+		e.Bool(*v.Sysex)
+	}
+	if v.UserVisibleOnly != nil {
+		e.Key("userVisibleOnly")
+		e.Bool(*v.UserVisibleOnly)
+	}
+	if v.AllowWithoutSanitization != nil {
+		e.Key("allowWithoutSanitization")
+		e.Bool(*v.AllowWithoutSanitization)
+	}
+	if v.AllowWithoutGesture != nil {
+		e.Key("allowWithoutGesture")
+		e.Bool(*v.AllowWithoutGesture)
+	}
+	if v.PanTiltZoom != nil {
+		e.Key("panTiltZoom")
+		e.Bool(*v.PanTiltZoom)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PermissionDescriptor) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PermissionDescriptor from JSON
+func (v *PermissionDescriptor) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "sysex":
+			if d.Null() {
+				v.Sysex = nil
+			} else {
+				v.Sysex = new(bool)
+				*v.Sysex = d.Bool()
+			}
+		case "userVisibleOnly":
+			if d.Null() {
+				v.UserVisibleOnly = nil
+			} else {
+				v.UserVisibleOnly = new(bool)
+				*v.UserVisibleOnly = d.Bool()
+			}
+		case "allowWithoutSanitization":
+			if d.Null() {
+				v.AllowWithoutSanitization = nil
+			} else {
+				v.AllowWithoutSanitization = new(bool)
+				*v.AllowWithoutSanitization = d.Bool()
+			}
+		case "allowWithoutGesture":
+			if d.Null() {
+				v.AllowWithoutGesture = nil
+			} else {
+				v.AllowWithoutGesture = new(bool)
+				*v.AllowWithoutGesture = d.Bool()
+			}
+		case "panTiltZoom":
+			if d.Null() {
+				v.PanTiltZoom = nil
+			} else {
+				v.PanTiltZoom = new(bool)
+				*v.PanTiltZoom = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PermissionDescriptor) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Browser command ids used by executeBrowserCommand.
+//
+// Experimental: this may change or be removed in any chrome release
+type BrowserCommandId string
+
+// BrowserCommandId values
+const (
+	BrowserCommandIdOpenTabSearch  BrowserCommandId = "openTabSearch"
+	BrowserCommandIdCloseTabSearch BrowserCommandId = "closeTabSearch"
+	BrowserCommandIdOpenGlic       BrowserCommandId = "openGlic"
+)
+
+// Values gives every BrowserCommandId
+func (BrowserCommandId) Values() []BrowserCommandId {
+	return []BrowserCommandId{
+		BrowserCommandIdOpenTabSearch,
+		BrowserCommandIdCloseTabSearch,
+		BrowserCommandIdOpenGlic,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v BrowserCommandId) Valid() bool {
+	switch v {
+	case BrowserCommandIdOpenTabSearch, BrowserCommandIdCloseTabSearch, BrowserCommandIdOpenGlic:
+		return true
+	}
+	return false
+}
+
+// Chrome histogram bucket.
+//
+// Experimental: this may change or be removed in any chrome release
+type Bucket struct {
+	/* Minimum value (inclusive). */
+	Low int `json:"low"`
+	/* Maximum value (exclusive). */
+	High int `json:"high"`
+	/* Number of samples. */
+	Count int `json:"count"`
+}
+
+// MarshalCDP writes Bucket as JSON
+func (v *Bucket) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("low")
+	e.Int(v.Low)
+	e.Key("high")
+	e.Int(v.High)
+	e.Key("count")
+	e.Int(v.Count)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Bucket) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Bucket from JSON
+func (v *Bucket) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "low":
+			v.Low = d.Int()
+		case "high":
+			v.High = d.Int()
+		case "count":
+			v.Count = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Bucket) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Chrome histogram.
+//
+// Experimental: this may change or be removed in any chrome release
+type Histogram struct {
+	/* Name. */
+	Name string `json:"name"`
+	/* Sum of sample values. */
+	Sum int `json:"sum"`
+	/* Total number of samples. */
+	Count int `json:"count"`
+	/* Buckets. */
+	Buckets []Bucket `json:"buckets"`
+}
+
+// MarshalCDP writes Histogram as JSON
+func (v *Histogram) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("sum")
+	e.Int(v.Sum)
+	e.Key("count")
+	e.Int(v.Count)
+	e.Key("buckets")
+	if v.Buckets == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Buckets {
+			e.Elem()
+			v.Buckets[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Histogram) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Histogram from JSON
+func (v *Histogram) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "sum":
+			v.Sum = d.Int()
+		case "count":
+			v.Count = d.Int()
+		case "buckets":
+			if d.Array() {
+				v.Buckets = make([]Bucket, 0)
+				for d.More() {
+					var x0 Bucket
+					x0.UnmarshalCDP(d)
+					v.Buckets = append(v.Buckets, x0)
+				}
+			} else {
+				v.Buckets = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Histogram) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PrivacySandboxAPI string
+
+// PrivacySandboxAPI values
+const (
+	PrivacySandboxAPIBiddingAndAuctionServices PrivacySandboxAPI = "BiddingAndAuctionServices"
+	PrivacySandboxAPITrustedKeyValue           PrivacySandboxAPI = "TrustedKeyValue"
+)
+
+// Values gives every PrivacySandboxAPI
+func (PrivacySandboxAPI) Values() []PrivacySandboxAPI {
+	return []PrivacySandboxAPI{
+		PrivacySandboxAPIBiddingAndAuctionServices,
+		PrivacySandboxAPITrustedKeyValue,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PrivacySandboxAPI) Valid() bool {
+	switch v {
+	case PrivacySandboxAPIBiddingAndAuctionServices, PrivacySandboxAPITrustedKeyValue:
+		return true
+	}
+	return false
+}
+
+type SetDownloadBehaviorBehavior string
+
+// SetDownloadBehaviorBehavior values
+const (
+	SetDownloadBehaviorBehaviorDeny         SetDownloadBehaviorBehavior = "deny"
+	SetDownloadBehaviorBehaviorAllow        SetDownloadBehaviorBehavior = "allow"
+	SetDownloadBehaviorBehaviorAllowAndName SetDownloadBehaviorBehavior = "allowAndName"
+	SetDownloadBehaviorBehaviorDefault      SetDownloadBehaviorBehavior = "default"
+)
+
+// Values gives every SetDownloadBehaviorBehavior
+func (SetDownloadBehaviorBehavior) Values() []SetDownloadBehaviorBehavior {
+	return []SetDownloadBehaviorBehavior{
+		SetDownloadBehaviorBehaviorDeny,
+		SetDownloadBehaviorBehaviorAllow,
+		SetDownloadBehaviorBehaviorAllowAndName,
+		SetDownloadBehaviorBehaviorDefault,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetDownloadBehaviorBehavior) Valid() bool {
+	switch v {
+	case SetDownloadBehaviorBehaviorDeny, SetDownloadBehaviorBehaviorAllow, SetDownloadBehaviorBehaviorAllowAndName, SetDownloadBehaviorBehaviorDefault:
+		return true
+	}
+	return false
+}
+
+type DownloadProgressState string
+
+// DownloadProgressState values
+const (
+	DownloadProgressStateInProgress DownloadProgressState = "inProgress"
+	DownloadProgressStateCompleted  DownloadProgressState = "completed"
+	DownloadProgressStateCanceled   DownloadProgressState = "canceled"
+)
+
+// Values gives every DownloadProgressState
+func (DownloadProgressState) Values() []DownloadProgressState {
+	return []DownloadProgressState{
+		DownloadProgressStateInProgress,
+		DownloadProgressStateCompleted,
+		DownloadProgressStateCanceled,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DownloadProgressState) Valid() bool {
+	switch v {
+	case DownloadProgressStateInProgress, DownloadProgressStateCompleted, DownloadProgressStateCanceled:
+		return true
+	}
+	return false
+}
+
+type ResetPermissionsReturns struct {
+}
+
+// UnmarshalCDP reads ResetPermissionsReturns from JSON
+func (v *ResetPermissionsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResetPermissionsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ResetPermissionsParams are the parameters for Browser.resetPermissions
+// optional parameters are left out when nil
+type ResetPermissionsParams struct {
+	/* BrowserContext to reset permissions. When omitted, default browser context is used. */
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes ResetPermissionsParams as JSON
+func (v *ResetPermissionsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResetPermissionsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Reset all permission management for all origins.
+func (c Client) ResetPermissions(params ResetPermissionsParams) (ResetPermissionsReturns, error) {
+	return c.ResetPermissionsContext(context.Background(), params)
+}
+
+// ResetPermissionsContext is ResetPermissions with a context for cancellation and deadlines
+func (c Client) ResetPermissionsContext(ctx context.Context, params ResetPermissionsParams) (ResetPermissionsReturns, error) {
+	var returns_ ResetPermissionsReturns
+
+	err_ := c.caller.Call(ctx, "Browser.resetPermissions", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CloseReturns struct {
+}
+
+// UnmarshalCDP reads CloseReturns from JSON
+func (v *CloseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CloseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Close browser gracefully.
+func (c Client) Close() (CloseReturns, error) {
+	return c.CloseContext(context.Background())
+}
+
+// CloseContext is Close with a context for cancellation and deadlines
+func (c Client) CloseContext(ctx context.Context) (CloseReturns, error) {
+	var returns_ CloseReturns
+
+	err_ := c.caller.Call(ctx, "Browser.close", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetVersionReturns struct {
+
+	/* Protocol version. */
+	ProtocolVersion string `json:"protocolVersion"`
+
+	/* Product name. */
+	Product string `json:"product"`
+
+	/* Product revision. */
+	Revision string `json:"revision"`
+
+	/* User-Agent. */
+	UserAgent string `json:"userAgent"`
+
+	/* V8 version. */
+	JsVersion string `json:"jsVersion"`
+}
+
+// UnmarshalCDP reads GetVersionReturns from JSON
+func (v *GetVersionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "protocolVersion":
+			v.ProtocolVersion = d.String()
+		case "product":
+			v.Product = d.String()
+		case "revision":
+			v.Revision = d.String()
+		case "userAgent":
+			v.UserAgent = d.String()
+		case "jsVersion":
+			v.JsVersion = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetVersionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns version information.
+func (c Client) GetVersion() (GetVersionReturns, error) {
+	return c.GetVersionContext(context.Background())
+}
+
+// GetVersionContext is GetVersion with a context for cancellation and deadlines
+func (c Client) GetVersionContext(ctx context.Context) (GetVersionReturns, error) {
+	var returns_ GetVersionReturns
+
+	err_ := c.caller.Call(ctx, "Browser.getVersion", nil, &returns_)
+
+	return returns_, err_
+}
+
+type AddPrivacySandboxEnrollmentOverrideReturns struct {
+}
+
+// UnmarshalCDP reads AddPrivacySandboxEnrollmentOverrideReturns from JSON
+func (v *AddPrivacySandboxEnrollmentOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddPrivacySandboxEnrollmentOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddPrivacySandboxEnrollmentOverrideParams are the parameters for Browser.addPrivacySandboxEnrollmentOverride
+// optional parameters are left out when nil
+type AddPrivacySandboxEnrollmentOverrideParams struct {
+	Url string `json:"url"`
+}
+
+// MarshalCDP writes AddPrivacySandboxEnrollmentOverrideParams as JSON
+func (v *AddPrivacySandboxEnrollmentOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddPrivacySandboxEnrollmentOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Allows a site to use privacy sandbox features that require enrollment
+// without the site actually being enrolled. Only supported on page targets.
+func (c Client) AddPrivacySandboxEnrollmentOverride(params AddPrivacySandboxEnrollmentOverrideParams) (AddPrivacySandboxEnrollmentOverrideReturns, error) {
+	return c.AddPrivacySandboxEnrollmentOverrideContext(context.Background(), params)
+}
+
+// AddPrivacySandboxEnrollmentOverrideContext is AddPrivacySandboxEnrollmentOverride with a context for cancellation and deadlines
+func (c Client) AddPrivacySandboxEnrollmentOverrideContext(ctx context.Context, params AddPrivacySandboxEnrollmentOverrideParams) (AddPrivacySandboxEnrollmentOverrideReturns, error) {
+	var returns_ AddPrivacySandboxEnrollmentOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Browser.addPrivacySandboxEnrollmentOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddPrivacySandboxCoordinatorKeyConfigReturns struct {
+}
+
+// UnmarshalCDP reads AddPrivacySandboxCoordinatorKeyConfigReturns from JSON
+func (v *AddPrivacySandboxCoordinatorKeyConfigReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddPrivacySandboxCoordinatorKeyConfigReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddPrivacySandboxCoordinatorKeyConfigParams are the parameters for Browser.addPrivacySandboxCoordinatorKeyConfig
+// optional parameters are left out when nil
+type AddPrivacySandboxCoordinatorKeyConfigParams struct {
+	Api               PrivacySandboxAPI `json:"api"`
+	CoordinatorOrigin string            `json:"coordinatorOrigin"`
+	KeyConfig         string            `json:"keyConfig"`
+	/* BrowserContext to perform the action in. When omitted, default browser
+	context is used. */
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes AddPrivacySandboxCoordinatorKeyConfigParams as JSON
+func (v *AddPrivacySandboxCoordinatorKeyConfigParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("api")
+	e.String(string(v.Api))
+	e.Key("coordinatorOrigin")
+	e.String(v.CoordinatorOrigin)
+	e.Key("keyConfig")
+	e.String(v.KeyConfig)
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddPrivacySandboxCoordinatorKeyConfigParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Configures encryption keys used with a given privacy sandbox API to talk
+// to a trusted coordinator.  Since this is intended for test automation only,
+// coordinatorOrigin must be a .test domain. No existing coordinator
+// configuration for the origin may exist.
+func (c Client) AddPrivacySandboxCoordinatorKeyConfig(params AddPrivacySandboxCoordinatorKeyConfigParams) (AddPrivacySandboxCoordinatorKeyConfigReturns, error) {
+	return c.AddPrivacySandboxCoordinatorKeyConfigContext(context.Background(), params)
+}
+
+// AddPrivacySandboxCoordinatorKeyConfigContext is AddPrivacySandboxCoordinatorKeyConfig with a context for cancellation and deadlines
+func (c Client) AddPrivacySandboxCoordinatorKeyConfigContext(ctx context.Context, params AddPrivacySandboxCoordinatorKeyConfigParams) (AddPrivacySandboxCoordinatorKeyConfigReturns, error) {
+	var returns_ AddPrivacySandboxCoordinatorKeyConfigReturns
+
+	if !params.Api.Valid() {
+		return returns_, fmt.Errorf("Browser.addPrivacySandboxCoordinatorKeyConfig: invalid api %q", params.Api)
+	}
+
+	err_ := c.caller.Call(ctx, "Browser.addPrivacySandboxCoordinatorKeyConfig", &params, &returns_)
+
+	return returns_, err_
+}
+
+// decoders for Browser events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){}
+
+// DecodeEvent decodes a Browser event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
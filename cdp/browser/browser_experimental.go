@@ -0,0 +1,1144 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package browser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type SetPermissionReturns struct {
+}
+
+// UnmarshalCDP reads SetPermissionReturns from JSON
+func (v *SetPermissionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPermissionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPermissionParams are the parameters for Browser.setPermission
+// optional parameters are left out when nil
+type SetPermissionParams struct {
+	/* Descriptor of permission to override. */
+	Permission PermissionDescriptor `json:"permission"`
+	/* Setting of the permission. */
+	Setting PermissionSetting `json:"setting"`
+	/* Origin the permission applies to, all origins if not specified. */
+	Origin *string `json:"origin,omitempty"`
+	/* Context to override. When omitted, default browser context is used. */
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes SetPermissionParams as JSON
+func (v *SetPermissionParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("permission")
+	v.Permission.MarshalCDP(e)
+	e.Key("setting")
+	e.String(string(v.Setting))
+	if v.Origin != nil {
+		e.Key("origin")
+		e.String(*v.Origin)
+	}
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPermissionParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set permission settings for given origin.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPermission(params SetPermissionParams) (SetPermissionReturns, error) {
+	return c.SetPermissionContext(context.Background(), params)
+}
+
+// SetPermissionContext is SetPermission with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPermissionContext(ctx context.Context, params SetPermissionParams) (SetPermissionReturns, error) {
+	var returns_ SetPermissionReturns
+
+	if !params.Setting.Valid() {
+		return returns_, fmt.Errorf("Browser.setPermission: invalid setting %q", params.Setting)
+	}
+
+	err_ := c.caller.Call(ctx, "Browser.setPermission", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GrantPermissionsReturns struct {
+}
+
+// UnmarshalCDP reads GrantPermissionsReturns from JSON
+func (v *GrantPermissionsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GrantPermissionsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GrantPermissionsParams are the parameters for Browser.grantPermissions
+// optional parameters are left out when nil
+type GrantPermissionsParams struct {
+	Permissions []PermissionType `json:"permissions"`
+	/* Origin the permission applies to, all origins if not specified. */
+	Origin *string `json:"origin,omitempty"`
+	/* BrowserContext to override permissions. When omitted, default browser context is used. */
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes GrantPermissionsParams as JSON
+func (v *GrantPermissionsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("permissions")
+	if v.Permissions == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Permissions {
+			e.Elem()
+			e.String(string(v.Permissions[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.Origin != nil {
+		e.Key("origin")
+		e.String(*v.Origin)
+	}
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GrantPermissionsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Grant specific permissions to the given origin and reject all others.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GrantPermissions(params GrantPermissionsParams) (GrantPermissionsReturns, error) {
+	return c.GrantPermissionsContext(context.Background(), params)
+}
+
+// GrantPermissionsContext is GrantPermissions with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GrantPermissionsContext(ctx context.Context, params GrantPermissionsParams) (GrantPermissionsReturns, error) {
+	var returns_ GrantPermissionsReturns
+
+	err_ := c.caller.Call(ctx, "Browser.grantPermissions", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDownloadBehaviorReturns struct {
+}
+
+// UnmarshalCDP reads SetDownloadBehaviorReturns from JSON
+func (v *SetDownloadBehaviorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDownloadBehaviorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDownloadBehaviorParams are the parameters for Browser.setDownloadBehavior
+// optional parameters are left out when nil
+type SetDownloadBehaviorParams struct {
+	/* Whether to allow all or deny all download requests, or use default Chrome behavior if
+	available (otherwise deny). |allowAndName| allows download and names files according to
+	their download guids. */
+	Behavior SetDownloadBehaviorBehavior `json:"behavior"`
+	/* BrowserContext to set download behavior. When omitted, default browser context is used. */
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+	/* The default path to save downloaded files to. This is required if behavior is set to 'allow'
+	or 'allowAndName'. */
+	DownloadPath *string `json:"downloadPath,omitempty"`
+	/* Whether to emit download events (defaults to false). */
+	EventsEnabled *bool `json:"eventsEnabled,omitempty"`
+}
+
+// MarshalCDP writes SetDownloadBehaviorParams as JSON
+func (v *SetDownloadBehaviorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("behavior")
+	e.String(string(v.Behavior))
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	if v.DownloadPath != nil {
+		e.Key("downloadPath")
+		e.String(*v.DownloadPath)
+	}
+	if v.EventsEnabled != nil {
+		e.Key("eventsEnabled")
+		e.Bool(*v.EventsEnabled)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDownloadBehaviorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set the behavior when downloading a file.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDownloadBehavior(params SetDownloadBehaviorParams) (SetDownloadBehaviorReturns, error) {
+	return c.SetDownloadBehaviorContext(context.Background(), params)
+}
+
+// SetDownloadBehaviorContext is SetDownloadBehavior with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDownloadBehaviorContext(ctx context.Context, params SetDownloadBehaviorParams) (SetDownloadBehaviorReturns, error) {
+	var returns_ SetDownloadBehaviorReturns
+
+	if !params.Behavior.Valid() {
+		return returns_, fmt.Errorf("Browser.setDownloadBehavior: invalid behavior %q", params.Behavior)
+	}
+
+	err_ := c.caller.Call(ctx, "Browser.setDownloadBehavior", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CancelDownloadReturns struct {
+}
+
+// UnmarshalCDP reads CancelDownloadReturns from JSON
+func (v *CancelDownloadReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CancelDownloadReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CancelDownloadParams are the parameters for Browser.cancelDownload
+// optional parameters are left out when nil
+type CancelDownloadParams struct {
+	/* Global unique identifier of the download. */
+	Guid string `json:"guid"`
+	/* BrowserContext to perform the action in. When omitted, default browser context is used. */
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes CancelDownloadParams as JSON
+func (v *CancelDownloadParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("guid")
+	e.String(v.Guid)
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CancelDownloadParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Cancel a download if in progress
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CancelDownload(params CancelDownloadParams) (CancelDownloadReturns, error) {
+	return c.CancelDownloadContext(context.Background(), params)
+}
+
+// CancelDownloadContext is CancelDownload with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CancelDownloadContext(ctx context.Context, params CancelDownloadParams) (CancelDownloadReturns, error) {
+	var returns_ CancelDownloadReturns
+
+	err_ := c.caller.Call(ctx, "Browser.cancelDownload", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CrashReturns struct {
+}
+
+// UnmarshalCDP reads CrashReturns from JSON
+func (v *CrashReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CrashReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Crashes browser on the main thread.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) Crash() (CrashReturns, error) {
+	return c.CrashContext(context.Background())
+}
+
+// CrashContext is Crash with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CrashContext(ctx context.Context) (CrashReturns, error) {
+	var returns_ CrashReturns
+
+	err_ := c.caller.Call(ctx, "Browser.crash", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CrashGpuProcessReturns struct {
+}
+
+// UnmarshalCDP reads CrashGpuProcessReturns from JSON
+func (v *CrashGpuProcessReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CrashGpuProcessReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Crashes GPU process.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CrashGpuProcess() (CrashGpuProcessReturns, error) {
+	return c.CrashGpuProcessContext(context.Background())
+}
+
+// CrashGpuProcessContext is CrashGpuProcess with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CrashGpuProcessContext(ctx context.Context) (CrashGpuProcessReturns, error) {
+	var returns_ CrashGpuProcessReturns
+
+	err_ := c.caller.Call(ctx, "Browser.crashGpuProcess", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetBrowserCommandLineReturns struct {
+
+	/* Commandline parameters */
+	Arguments []string `json:"arguments"`
+}
+
+// UnmarshalCDP reads GetBrowserCommandLineReturns from JSON
+func (v *GetBrowserCommandLineReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "arguments":
+			if d.Array() {
+				v.Arguments = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Arguments = append(v.Arguments, x0)
+				}
+			} else {
+				v.Arguments = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetBrowserCommandLineReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the command line switches for the browser process if, and only if
+// --enable-automation is on the commandline.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetBrowserCommandLine() (GetBrowserCommandLineReturns, error) {
+	return c.GetBrowserCommandLineContext(context.Background())
+}
+
+// GetBrowserCommandLineContext is GetBrowserCommandLine with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetBrowserCommandLineContext(ctx context.Context) (GetBrowserCommandLineReturns, error) {
+	var returns_ GetBrowserCommandLineReturns
+
+	err_ := c.caller.Call(ctx, "Browser.getBrowserCommandLine", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetHistogramsReturns struct {
+
+	/* Histograms. */
+	Histograms []Histogram `json:"histograms"`
+}
+
+// UnmarshalCDP reads GetHistogramsReturns from JSON
+func (v *GetHistogramsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "histograms":
+			if d.Array() {
+				v.Histograms = make([]Histogram, 0)
+				for d.More() {
+					var x0 Histogram
+					x0.UnmarshalCDP(d)
+					v.Histograms = append(v.Histograms, x0)
+				}
+			} else {
+				v.Histograms = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetHistogramsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetHistogramsParams are the parameters for Browser.getHistograms
+// optional parameters are left out when nil
+type GetHistogramsParams struct {
+	/* Requested substring in name. Only histograms which have query as a
+	substring in their name are extracted. An empty or absent query returns
+	all histograms. */
+	Query *string `json:"query,omitempty"`
+	/* If true, retrieve delta since last delta call. */
+	Delta *bool `json:"delta,omitempty"`
+}
+
+// MarshalCDP writes GetHistogramsParams as JSON
+func (v *GetHistogramsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Query != nil {
+		e.Key("query")
+		e.String(*v.Query)
+	}
+	if v.Delta != nil {
+		e.Key("delta")
+		e.Bool(*v.Delta)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetHistogramsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Get Chrome histograms.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetHistograms(params GetHistogramsParams) (GetHistogramsReturns, error) {
+	return c.GetHistogramsContext(context.Background(), params)
+}
+
+// GetHistogramsContext is GetHistograms with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetHistogramsContext(ctx context.Context, params GetHistogramsParams) (GetHistogramsReturns, error) {
+	var returns_ GetHistogramsReturns
+
+	err_ := c.caller.Call(ctx, "Browser.getHistograms", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetHistogramReturns struct {
+
+	/* Histogram. */
+	Histogram Histogram `json:"histogram"`
+}
+
+// UnmarshalCDP reads GetHistogramReturns from JSON
+func (v *GetHistogramReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "histogram":
+			v.Histogram.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetHistogramReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetHistogramParams are the parameters for Browser.getHistogram
+// optional parameters are left out when nil
+type GetHistogramParams struct {
+	/* Requested histogram name. */
+	Name string `json:"name"`
+	/* If true, retrieve delta since last delta call. */
+	Delta *bool `json:"delta,omitempty"`
+}
+
+// MarshalCDP writes GetHistogramParams as JSON
+func (v *GetHistogramParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.Delta != nil {
+		e.Key("delta")
+		e.Bool(*v.Delta)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetHistogramParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Get a Chrome histogram by name.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetHistogram(params GetHistogramParams) (GetHistogramReturns, error) {
+	return c.GetHistogramContext(context.Background(), params)
+}
+
+// GetHistogramContext is GetHistogram with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetHistogramContext(ctx context.Context, params GetHistogramParams) (GetHistogramReturns, error) {
+	var returns_ GetHistogramReturns
+
+	err_ := c.caller.Call(ctx, "Browser.getHistogram", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetWindowBoundsReturns struct {
+
+	/* Bounds information of the window. When window state is 'minimized', the restored window
+	position and size are returned. */
+	Bounds Bounds `json:"bounds"`
+}
+
+// UnmarshalCDP reads GetWindowBoundsReturns from JSON
+func (v *GetWindowBoundsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bounds":
+			v.Bounds.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetWindowBoundsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetWindowBoundsParams are the parameters for Browser.getWindowBounds
+// optional parameters are left out when nil
+type GetWindowBoundsParams struct {
+	/* Browser window id. */
+	WindowId WindowID `json:"windowId"`
+}
+
+// MarshalCDP writes GetWindowBoundsParams as JSON
+func (v *GetWindowBoundsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("windowId")
+	e.Int(int(v.WindowId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetWindowBoundsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Get position and size of the browser window.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetWindowBounds(params GetWindowBoundsParams) (GetWindowBoundsReturns, error) {
+	return c.GetWindowBoundsContext(context.Background(), params)
+}
+
+// GetWindowBoundsContext is GetWindowBounds with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetWindowBoundsContext(ctx context.Context, params GetWindowBoundsParams) (GetWindowBoundsReturns, error) {
+	var returns_ GetWindowBoundsReturns
+
+	err_ := c.caller.Call(ctx, "Browser.getWindowBounds", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetWindowForTargetReturns struct {
+
+	/* Browser window id. */
+	WindowId WindowID `json:"windowId"`
+
+	/* Bounds information of the window. When window state is 'minimized', the restored window
+	position and size are returned. */
+	Bounds Bounds `json:"bounds"`
+}
+
+// UnmarshalCDP reads GetWindowForTargetReturns from JSON
+func (v *GetWindowForTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "windowId":
+			v.WindowId = WindowID(d.Int())
+		case "bounds":
+			v.Bounds.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetWindowForTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetWindowForTargetParams are the parameters for Browser.getWindowForTarget
+// optional parameters are left out when nil
+type GetWindowForTargetParams struct {
+	/* Devtools agent host id. If called as a part of the session, associated targetId is used. */
+	TargetId *cdp.TargetTargetID `json:"targetId,omitempty"`
+}
+
+// MarshalCDP writes GetWindowForTargetParams as JSON
+func (v *GetWindowForTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.TargetId != nil {
+		e.Key("targetId")
+		e.String(string(*v.TargetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetWindowForTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Get the browser window that contains the devtools target.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetWindowForTarget(params GetWindowForTargetParams) (GetWindowForTargetReturns, error) {
+	return c.GetWindowForTargetContext(context.Background(), params)
+}
+
+// GetWindowForTargetContext is GetWindowForTarget with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetWindowForTargetContext(ctx context.Context, params GetWindowForTargetParams) (GetWindowForTargetReturns, error) {
+	var returns_ GetWindowForTargetReturns
+
+	err_ := c.caller.Call(ctx, "Browser.getWindowForTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetWindowBoundsReturns struct {
+}
+
+// UnmarshalCDP reads SetWindowBoundsReturns from JSON
+func (v *SetWindowBoundsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetWindowBoundsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetWindowBoundsParams are the parameters for Browser.setWindowBounds
+// optional parameters are left out when nil
+type SetWindowBoundsParams struct {
+	/* Browser window id. */
+	WindowId WindowID `json:"windowId"`
+	/* New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined
+	with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged. */
+	Bounds Bounds `json:"bounds"`
+}
+
+// MarshalCDP writes SetWindowBoundsParams as JSON
+func (v *SetWindowBoundsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("windowId")
+	e.Int(int(v.WindowId))
+	e.Key("bounds")
+	v.Bounds.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetWindowBoundsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set position and/or size of the browser window.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetWindowBounds(params SetWindowBoundsParams) (SetWindowBoundsReturns, error) {
+	return c.SetWindowBoundsContext(context.Background(), params)
+}
+
+// SetWindowBoundsContext is SetWindowBounds with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetWindowBoundsContext(ctx context.Context, params SetWindowBoundsParams) (SetWindowBoundsReturns, error) {
+	var returns_ SetWindowBoundsReturns
+
+	err_ := c.caller.Call(ctx, "Browser.setWindowBounds", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetContentsSizeReturns struct {
+}
+
+// UnmarshalCDP reads SetContentsSizeReturns from JSON
+func (v *SetContentsSizeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetContentsSizeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetContentsSizeParams are the parameters for Browser.setContentsSize
+// optional parameters are left out when nil
+type SetContentsSizeParams struct {
+	/* Browser window id. */
+	WindowId WindowID `json:"windowId"`
+	/* The window contents width in DIP. Assumes current width if omitted.
+	Must be specified if 'height' is omitted. */
+	Width *int `json:"width,omitempty"`
+	/* The window contents height in DIP. Assumes current height if omitted.
+	Must be specified if 'width' is omitted. */
+	Height *int `json:"height,omitempty"`
+}
+
+// MarshalCDP writes SetContentsSizeParams as JSON
+func (v *SetContentsSizeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("windowId")
+	e.Int(int(v.WindowId))
+	if v.Width != nil {
+		e.Key("width")
+		e.Int(*v.Width)
+	}
+	if v.Height != nil {
+		e.Key("height")
+		e.Int(*v.Height)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetContentsSizeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set size of the browser contents resizing browser window as necessary.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetContentsSize(params SetContentsSizeParams) (SetContentsSizeReturns, error) {
+	return c.SetContentsSizeContext(context.Background(), params)
+}
+
+// SetContentsSizeContext is SetContentsSize with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetContentsSizeContext(ctx context.Context, params SetContentsSizeParams) (SetContentsSizeReturns, error) {
+	var returns_ SetContentsSizeReturns
+
+	err_ := c.caller.Call(ctx, "Browser.setContentsSize", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDockTileReturns struct {
+}
+
+// UnmarshalCDP reads SetDockTileReturns from JSON
+func (v *SetDockTileReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDockTileReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDockTileParams are the parameters for Browser.setDockTile
+// optional parameters are left out when nil
+type SetDockTileParams struct {
+	BadgeLabel *string `json:"badgeLabel,omitempty"`
+	/* Png encoded image. (Encoded as a base64 string when passed over JSON) */
+	Image *string `json:"image,omitempty"`
+}
+
+// MarshalCDP writes SetDockTileParams as JSON
+func (v *SetDockTileParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BadgeLabel != nil {
+		e.Key("badgeLabel")
+		e.String(*v.BadgeLabel)
+	}
+	if v.Image != nil {
+		e.Key("image")
+		e.String(*v.Image)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDockTileParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set dock tile details, platform-specific.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDockTile(params SetDockTileParams) (SetDockTileReturns, error) {
+	return c.SetDockTileContext(context.Background(), params)
+}
+
+// SetDockTileContext is SetDockTile with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDockTileContext(ctx context.Context, params SetDockTileParams) (SetDockTileReturns, error) {
+	var returns_ SetDockTileReturns
+
+	err_ := c.caller.Call(ctx, "Browser.setDockTile", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ExecuteBrowserCommandReturns struct {
+}
+
+// UnmarshalCDP reads ExecuteBrowserCommandReturns from JSON
+func (v *ExecuteBrowserCommandReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExecuteBrowserCommandReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ExecuteBrowserCommandParams are the parameters for Browser.executeBrowserCommand
+// optional parameters are left out when nil
+type ExecuteBrowserCommandParams struct {
+	CommandId BrowserCommandId `json:"commandId"`
+}
+
+// MarshalCDP writes ExecuteBrowserCommandParams as JSON
+func (v *ExecuteBrowserCommandParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("commandId")
+	e.String(string(v.CommandId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ExecuteBrowserCommandParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Invoke custom browser commands used by telemetry.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ExecuteBrowserCommand(params ExecuteBrowserCommandParams) (ExecuteBrowserCommandReturns, error) {
+	return c.ExecuteBrowserCommandContext(context.Background(), params)
+}
+
+// ExecuteBrowserCommandContext is ExecuteBrowserCommand with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ExecuteBrowserCommandContext(ctx context.Context, params ExecuteBrowserCommandParams) (ExecuteBrowserCommandReturns, error) {
+	var returns_ ExecuteBrowserCommandReturns
+
+	if !params.CommandId.Valid() {
+		return returns_, fmt.Errorf("Browser.executeBrowserCommand: invalid commandId %q", params.CommandId)
+	}
+
+	err_ := c.caller.Call(ctx, "Browser.executeBrowserCommand", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fired when page is about to start a download.
+//
+// Experimental: this may change or be removed in any chrome release
+type DownloadWillBeginEvent struct {
+
+	/* Id of the frame that caused the download to begin. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+
+	/* Global unique identifier of the download. */
+	Guid string `json:"guid"`
+
+	/* URL of the resource being downloaded. */
+	Url string `json:"url"`
+
+	/* Suggested file name of the resource (the actual name of the file saved on disk may differ). */
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// UnmarshalCDP reads DownloadWillBeginEvent from JSON
+func (v *DownloadWillBeginEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "guid":
+			v.Guid = d.String()
+		case "url":
+			v.Url = d.String()
+		case "suggestedFilename":
+			v.SuggestedFilename = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DownloadWillBeginEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DownloadWillBeginHandler func(ev DownloadWillBeginEvent)
+
+// EventMethod is Browser.downloadWillBegin
+func (DownloadWillBeginEvent) EventMethod() string {
+	return "Browser.downloadWillBegin"
+}
+
+// OnDownloadWillBegin calls handler for each Browser.downloadWillBegin event
+func (c Client) OnDownloadWillBegin(handler DownloadWillBeginHandler) (unsubscribe func()) {
+	return c.caller.On("Browser.downloadWillBegin", func(ev interface{}) {
+		handler(ev.(DownloadWillBeginEvent))
+	})
+}
+
+// Fired when download makes progress. Last call has |done| == true.
+//
+// Experimental: this may change or be removed in any chrome release
+type DownloadProgressEvent struct {
+
+	/* Global unique identifier of the download. */
+	Guid string `json:"guid"`
+
+	/* Total expected bytes to download. */
+	TotalBytes float64 `json:"totalBytes"`
+
+	/* Total bytes received. */
+	ReceivedBytes float64 `json:"receivedBytes"`
+
+	/* Download status. */
+	State DownloadProgressState `json:"state"`
+
+	/* If download is "completed", provides the path of the downloaded file.
+	Depending on the platform, it is not guaranteed to be set, nor the file
+	is guaranteed to exist.
+
+	Experimental: this may change or be removed in any chrome release */
+	FilePath *string `json:"filePath,omitempty"`
+}
+
+// UnmarshalCDP reads DownloadProgressEvent from JSON
+func (v *DownloadProgressEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "guid":
+			v.Guid = d.String()
+		case "totalBytes":
+			v.TotalBytes = d.Float()
+		case "receivedBytes":
+			v.ReceivedBytes = d.Float()
+		case "state":
+			v.State = DownloadProgressState(d.String())
+		case "filePath":
+			if d.Null() {
+				v.FilePath = nil
+			} else {
+				v.FilePath = new(string)
+				*v.FilePath = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DownloadProgressEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DownloadProgressHandler func(ev DownloadProgressEvent)
+
+// EventMethod is Browser.downloadProgress
+func (DownloadProgressEvent) EventMethod() string {
+	return "Browser.downloadProgress"
+}
+
+// OnDownloadProgress calls handler for each Browser.downloadProgress event
+func (c Client) OnDownloadProgress(handler DownloadProgressHandler) (unsubscribe func()) {
+	return c.caller.On("Browser.downloadProgress", func(ev interface{}) {
+		handler(ev.(DownloadProgressEvent))
+	})
+}
+
+func init() {
+	decoders["Browser.downloadWillBegin"] = cdp.Decode[DownloadWillBeginEvent]
+	decoders["Browser.downloadProgress"] = cdp.Decode[DownloadProgressEvent]
+}
@@ -0,0 +1,699 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package cachestorage is the CacheStorage domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package cachestorage
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends CacheStorage commands and listens for CacheStorage events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Unique identifier of the Cache object.
+type CacheId string
+
+// type of HTTP response cached
+type CachedResponseType string
+
+// CachedResponseType values
+const (
+	CachedResponseTypeBasic          CachedResponseType = "basic"
+	CachedResponseTypeCors           CachedResponseType = "cors"
+	CachedResponseTypeDefault        CachedResponseType = "default"
+	CachedResponseTypeError          CachedResponseType = "error"
+	CachedResponseTypeOpaqueResponse CachedResponseType = "opaqueResponse"
+	CachedResponseTypeOpaqueRedirect CachedResponseType = "opaqueRedirect"
+)
+
+// Values gives every CachedResponseType
+func (CachedResponseType) Values() []CachedResponseType {
+	return []CachedResponseType{
+		CachedResponseTypeBasic,
+		CachedResponseTypeCors,
+		CachedResponseTypeDefault,
+		CachedResponseTypeError,
+		CachedResponseTypeOpaqueResponse,
+		CachedResponseTypeOpaqueRedirect,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CachedResponseType) Valid() bool {
+	switch v {
+	case CachedResponseTypeBasic, CachedResponseTypeCors, CachedResponseTypeDefault, CachedResponseTypeError, CachedResponseTypeOpaqueResponse, CachedResponseTypeOpaqueRedirect:
+		return true
+	}
+	return false
+}
+
+// Data entry.
+type DataEntry struct {
+	/* Request URL. */
+	RequestURL string `json:"requestURL"`
+	/* Request method. */
+	RequestMethod string `json:"requestMethod"`
+	/* Request headers */
+	RequestHeaders []Header `json:"requestHeaders"`
+	/* Number of seconds since epoch. */
+	ResponseTime float64 `json:"responseTime"`
+	/* HTTP response status code. */
+	ResponseStatus int `json:"responseStatus"`
+	/* HTTP response status text. */
+	ResponseStatusText string `json:"responseStatusText"`
+	/* HTTP response type */
+	ResponseType CachedResponseType `json:"responseType"`
+	/* Response headers */
+	ResponseHeaders []Header `json:"responseHeaders"`
+}
+
+// MarshalCDP writes DataEntry as JSON
+func (v *DataEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestURL")
+	e.String(v.RequestURL)
+	e.Key("requestMethod")
+	e.String(v.RequestMethod)
+	e.Key("requestHeaders")
+	if v.RequestHeaders == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.RequestHeaders {
+			e.Elem()
+			v.RequestHeaders[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("responseTime")
+	e.Float(v.ResponseTime)
+	e.Key("responseStatus")
+	e.Int(v.ResponseStatus)
+	e.Key("responseStatusText")
+	e.String(v.ResponseStatusText)
+	e.Key("responseType")
+	e.String(string(v.ResponseType))
+	e.Key("responseHeaders")
+	if v.ResponseHeaders == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			e.Elem()
+			v.ResponseHeaders[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DataEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DataEntry from JSON
+func (v *DataEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestURL":
+			v.RequestURL = d.String()
+		case "requestMethod":
+			v.RequestMethod = d.String()
+		case "requestHeaders":
+			if d.Array() {
+				v.RequestHeaders = make([]Header, 0)
+				for d.More() {
+					var x0 Header
+					x0.UnmarshalCDP(d)
+					v.RequestHeaders = append(v.RequestHeaders, x0)
+				}
+			} else {
+				v.RequestHeaders = nil
+			}
+		case "responseTime":
+			v.ResponseTime = d.Float()
+		case "responseStatus":
+			v.ResponseStatus = d.Int()
+		case "responseStatusText":
+			v.ResponseStatusText = d.String()
+		case "responseType":
+			v.ResponseType = CachedResponseType(d.String())
+		case "responseHeaders":
+			if d.Array() {
+				v.ResponseHeaders = make([]Header, 0)
+				for d.More() {
+					var x0 Header
+					x0.UnmarshalCDP(d)
+					v.ResponseHeaders = append(v.ResponseHeaders, x0)
+				}
+			} else {
+				v.ResponseHeaders = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DataEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Cache identifier.
+type Cache struct {
+	/* An opaque unique id of the cache. */
+	CacheId CacheId `json:"cacheId"`
+	/* Security origin of the cache. */
+	SecurityOrigin string `json:"securityOrigin"`
+	/* Storage key of the cache. */
+	StorageKey string `json:"storageKey"`
+	/* Storage bucket of the cache. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	/* The name of the cache. */
+	CacheName string `json:"cacheName"`
+}
+
+// MarshalCDP writes Cache as JSON
+func (v *Cache) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cacheId")
+	e.String(string(v.CacheId))
+	e.Key("securityOrigin")
+	e.String(v.SecurityOrigin)
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("cacheName")
+	e.String(v.CacheName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Cache) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Cache from JSON
+func (v *Cache) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cacheId":
+			v.CacheId = CacheId(d.String())
+		case "securityOrigin":
+			v.SecurityOrigin = d.String()
+		case "storageKey":
+			v.StorageKey = d.String()
+		case "storageBucket":
+			if d.Null() {
+				v.StorageBucket = nil
+			} else {
+				v.StorageBucket = new(cdp.StorageStorageBucket)
+				(*v.StorageBucket).UnmarshalCDP(d)
+			}
+		case "cacheName":
+			v.CacheName = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Cache) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes Header as JSON
+func (v *Header) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Header) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Header from JSON
+func (v *Header) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Header) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Cached response
+type CachedResponse struct {
+	/* Entry content, base64-encoded. (Encoded as a base64 string when passed over JSON) */
+	Body string `json:"body"`
+}
+
+// MarshalCDP writes CachedResponse as JSON
+func (v *CachedResponse) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("body")
+	e.String(v.Body)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CachedResponse) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CachedResponse from JSON
+func (v *CachedResponse) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "body":
+			v.Body = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CachedResponse) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DeleteCacheReturns struct {
+}
+
+// UnmarshalCDP reads DeleteCacheReturns from JSON
+func (v *DeleteCacheReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteCacheReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteCacheParams are the parameters for CacheStorage.deleteCache
+// optional parameters are left out when nil
+type DeleteCacheParams struct {
+	/* Id of cache for deletion. */
+	CacheId CacheId `json:"cacheId"`
+}
+
+// MarshalCDP writes DeleteCacheParams as JSON
+func (v *DeleteCacheParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cacheId")
+	e.String(string(v.CacheId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteCacheParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes a cache.
+func (c Client) DeleteCache(params DeleteCacheParams) (DeleteCacheReturns, error) {
+	return c.DeleteCacheContext(context.Background(), params)
+}
+
+// DeleteCacheContext is DeleteCache with a context for cancellation and deadlines
+func (c Client) DeleteCacheContext(ctx context.Context, params DeleteCacheParams) (DeleteCacheReturns, error) {
+	var returns_ DeleteCacheReturns
+
+	err_ := c.caller.Call(ctx, "CacheStorage.deleteCache", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteEntryReturns struct {
+}
+
+// UnmarshalCDP reads DeleteEntryReturns from JSON
+func (v *DeleteEntryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteEntryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteEntryParams are the parameters for CacheStorage.deleteEntry
+// optional parameters are left out when nil
+type DeleteEntryParams struct {
+	/* Id of cache where the entry will be deleted. */
+	CacheId CacheId `json:"cacheId"`
+	/* URL spec of the request. */
+	Request string `json:"request"`
+}
+
+// MarshalCDP writes DeleteEntryParams as JSON
+func (v *DeleteEntryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cacheId")
+	e.String(string(v.CacheId))
+	e.Key("request")
+	e.String(v.Request)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteEntryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes a cache entry.
+func (c Client) DeleteEntry(params DeleteEntryParams) (DeleteEntryReturns, error) {
+	return c.DeleteEntryContext(context.Background(), params)
+}
+
+// DeleteEntryContext is DeleteEntry with a context for cancellation and deadlines
+func (c Client) DeleteEntryContext(ctx context.Context, params DeleteEntryParams) (DeleteEntryReturns, error) {
+	var returns_ DeleteEntryReturns
+
+	err_ := c.caller.Call(ctx, "CacheStorage.deleteEntry", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestCacheNamesReturns struct {
+
+	/* Caches for the security origin. */
+	Caches []Cache `json:"caches"`
+}
+
+// UnmarshalCDP reads RequestCacheNamesReturns from JSON
+func (v *RequestCacheNamesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "caches":
+			if d.Array() {
+				v.Caches = make([]Cache, 0)
+				for d.More() {
+					var x0 Cache
+					x0.UnmarshalCDP(d)
+					v.Caches = append(v.Caches, x0)
+				}
+			} else {
+				v.Caches = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestCacheNamesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestCacheNamesParams are the parameters for CacheStorage.requestCacheNames
+// optional parameters are left out when nil
+type RequestCacheNamesParams struct {
+	/* At least and at most one of securityOrigin, storageKey, storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+}
+
+// MarshalCDP writes RequestCacheNamesParams as JSON
+func (v *RequestCacheNamesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestCacheNamesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests cache names.
+func (c Client) RequestCacheNames(params RequestCacheNamesParams) (RequestCacheNamesReturns, error) {
+	return c.RequestCacheNamesContext(context.Background(), params)
+}
+
+// RequestCacheNamesContext is RequestCacheNames with a context for cancellation and deadlines
+func (c Client) RequestCacheNamesContext(ctx context.Context, params RequestCacheNamesParams) (RequestCacheNamesReturns, error) {
+	var returns_ RequestCacheNamesReturns
+
+	err_ := c.caller.Call(ctx, "CacheStorage.requestCacheNames", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestCachedResponseReturns struct {
+
+	/* Response read from the cache. */
+	Response CachedResponse `json:"response"`
+}
+
+// UnmarshalCDP reads RequestCachedResponseReturns from JSON
+func (v *RequestCachedResponseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "response":
+			v.Response.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestCachedResponseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestCachedResponseParams are the parameters for CacheStorage.requestCachedResponse
+// optional parameters are left out when nil
+type RequestCachedResponseParams struct {
+	/* Id of cache that contains the entry. */
+	CacheId CacheId `json:"cacheId"`
+	/* URL spec of the request. */
+	RequestURL string `json:"requestURL"`
+	/* headers of the request. */
+	RequestHeaders []Header `json:"requestHeaders"`
+}
+
+// MarshalCDP writes RequestCachedResponseParams as JSON
+func (v *RequestCachedResponseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cacheId")
+	e.String(string(v.CacheId))
+	e.Key("requestURL")
+	e.String(v.RequestURL)
+	e.Key("requestHeaders")
+	if v.RequestHeaders == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.RequestHeaders {
+			e.Elem()
+			v.RequestHeaders[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestCachedResponseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches cache entry.
+func (c Client) RequestCachedResponse(params RequestCachedResponseParams) (RequestCachedResponseReturns, error) {
+	return c.RequestCachedResponseContext(context.Background(), params)
+}
+
+// RequestCachedResponseContext is RequestCachedResponse with a context for cancellation and deadlines
+func (c Client) RequestCachedResponseContext(ctx context.Context, params RequestCachedResponseParams) (RequestCachedResponseReturns, error) {
+	var returns_ RequestCachedResponseReturns
+
+	err_ := c.caller.Call(ctx, "CacheStorage.requestCachedResponse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestEntriesReturns struct {
+
+	/* Array of object store data entries. */
+	CacheDataEntries []DataEntry `json:"cacheDataEntries"`
+
+	/* Count of returned entries from this storage. If pathFilter is empty, it
+	is the count of all entries from this storage. */
+	ReturnCount float64 `json:"returnCount"`
+}
+
+// UnmarshalCDP reads RequestEntriesReturns from JSON
+func (v *RequestEntriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cacheDataEntries":
+			if d.Array() {
+				v.CacheDataEntries = make([]DataEntry, 0)
+				for d.More() {
+					var x0 DataEntry
+					x0.UnmarshalCDP(d)
+					v.CacheDataEntries = append(v.CacheDataEntries, x0)
+				}
+			} else {
+				v.CacheDataEntries = nil
+			}
+		case "returnCount":
+			v.ReturnCount = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestEntriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestEntriesParams are the parameters for CacheStorage.requestEntries
+// optional parameters are left out when nil
+type RequestEntriesParams struct {
+	/* ID of cache to get entries from. */
+	CacheId CacheId `json:"cacheId"`
+	/* Number of records to skip. */
+	SkipCount *int `json:"skipCount,omitempty"`
+	/* Number of records to fetch. */
+	PageSize *int `json:"pageSize,omitempty"`
+	/* If present, only return the entries containing this substring in the path */
+	PathFilter *string `json:"pathFilter,omitempty"`
+}
+
+// MarshalCDP writes RequestEntriesParams as JSON
+func (v *RequestEntriesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cacheId")
+	e.String(string(v.CacheId))
+	if v.SkipCount != nil {
+		e.Key("skipCount")
+		e.Int(*v.SkipCount)
+	}
+	if v.PageSize != nil {
+		e.Key("pageSize")
+		e.Int(*v.PageSize)
+	}
+	if v.PathFilter != nil {
+		e.Key("pathFilter")
+		e.String(*v.PathFilter)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestEntriesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests data from cache.
+func (c Client) RequestEntries(params RequestEntriesParams) (RequestEntriesReturns, error) {
+	return c.RequestEntriesContext(context.Background(), params)
+}
+
+// RequestEntriesContext is RequestEntries with a context for cancellation and deadlines
+func (c Client) RequestEntriesContext(ctx context.Context, params RequestEntriesParams) (RequestEntriesReturns, error) {
+	var returns_ RequestEntriesReturns
+
+	err_ := c.caller.Call(ctx, "CacheStorage.requestEntries", &params, &returns_)
+
+	return returns_, err_
+}
@@ -0,0 +1,504 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package cast is the Cast domain of the chrome devtools protocol
+//
+// A domain for interacting with Cast, Presentation API, and Remote Playback API
+// functionalities.
+//
+// Experimental: this may change or be removed in any chrome release
+package cast
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Cast commands and listens for Cast events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type Sink struct {
+	Name string `json:"name"`
+	Id   string `json:"id"`
+	/* Text describing the current session. Present only if there is an active
+	session on the sink. */
+	Session *string `json:"session,omitempty"`
+}
+
+// MarshalCDP writes Sink as JSON
+func (v *Sink) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("id")
+	e.String(v.Id)
+	if v.Session != nil {
+		e.Key("session")
+		e.String(*v.Session)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Sink) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Sink from JSON
+func (v *Sink) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "id":
+			v.Id = d.String()
+		case "session":
+			if d.Null() {
+				v.Session = nil
+			} else {
+				v.Session = new(string)
+				*v.Session = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Sink) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for Cast.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	PresentationUrl *string `json:"presentationUrl,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.PresentationUrl != nil {
+		e.Key("presentationUrl")
+		e.String(*v.PresentationUrl)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Starts observing for sinks that can be used for tab mirroring, and if set,
+// sinks compatible with |presentationUrl| as well. When sinks are found, a
+// |sinksUpdated| event is fired.
+// Also starts observing for issue messages. When an issue is added or removed,
+// an |issueUpdated| event is fired.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Cast.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stops observing for sinks and issues.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Cast.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetSinkToUseReturns struct {
+}
+
+// UnmarshalCDP reads SetSinkToUseReturns from JSON
+func (v *SetSinkToUseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSinkToUseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSinkToUseParams are the parameters for Cast.setSinkToUse
+// optional parameters are left out when nil
+type SetSinkToUseParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// MarshalCDP writes SetSinkToUseParams as JSON
+func (v *SetSinkToUseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sinkName")
+	e.String(v.SinkName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSinkToUseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets a sink to be used when the web page requests the browser to choose a
+// sink via Presentation API, Remote Playback API, or Cast SDK.
+func (c Client) SetSinkToUse(params SetSinkToUseParams) (SetSinkToUseReturns, error) {
+	return c.SetSinkToUseContext(context.Background(), params)
+}
+
+// SetSinkToUseContext is SetSinkToUse with a context for cancellation and deadlines
+func (c Client) SetSinkToUseContext(ctx context.Context, params SetSinkToUseParams) (SetSinkToUseReturns, error) {
+	var returns_ SetSinkToUseReturns
+
+	err_ := c.caller.Call(ctx, "Cast.setSinkToUse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartDesktopMirroringReturns struct {
+}
+
+// UnmarshalCDP reads StartDesktopMirroringReturns from JSON
+func (v *StartDesktopMirroringReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartDesktopMirroringReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartDesktopMirroringParams are the parameters for Cast.startDesktopMirroring
+// optional parameters are left out when nil
+type StartDesktopMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// MarshalCDP writes StartDesktopMirroringParams as JSON
+func (v *StartDesktopMirroringParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sinkName")
+	e.String(v.SinkName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartDesktopMirroringParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Starts mirroring the desktop to the sink.
+func (c Client) StartDesktopMirroring(params StartDesktopMirroringParams) (StartDesktopMirroringReturns, error) {
+	return c.StartDesktopMirroringContext(context.Background(), params)
+}
+
+// StartDesktopMirroringContext is StartDesktopMirroring with a context for cancellation and deadlines
+func (c Client) StartDesktopMirroringContext(ctx context.Context, params StartDesktopMirroringParams) (StartDesktopMirroringReturns, error) {
+	var returns_ StartDesktopMirroringReturns
+
+	err_ := c.caller.Call(ctx, "Cast.startDesktopMirroring", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartTabMirroringReturns struct {
+}
+
+// UnmarshalCDP reads StartTabMirroringReturns from JSON
+func (v *StartTabMirroringReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartTabMirroringReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartTabMirroringParams are the parameters for Cast.startTabMirroring
+// optional parameters are left out when nil
+type StartTabMirroringParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// MarshalCDP writes StartTabMirroringParams as JSON
+func (v *StartTabMirroringParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sinkName")
+	e.String(v.SinkName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartTabMirroringParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Starts mirroring the tab to the sink.
+func (c Client) StartTabMirroring(params StartTabMirroringParams) (StartTabMirroringReturns, error) {
+	return c.StartTabMirroringContext(context.Background(), params)
+}
+
+// StartTabMirroringContext is StartTabMirroring with a context for cancellation and deadlines
+func (c Client) StartTabMirroringContext(ctx context.Context, params StartTabMirroringParams) (StartTabMirroringReturns, error) {
+	var returns_ StartTabMirroringReturns
+
+	err_ := c.caller.Call(ctx, "Cast.startTabMirroring", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopCastingReturns struct {
+}
+
+// UnmarshalCDP reads StopCastingReturns from JSON
+func (v *StopCastingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopCastingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StopCastingParams are the parameters for Cast.stopCasting
+// optional parameters are left out when nil
+type StopCastingParams struct {
+	SinkName string `json:"sinkName"`
+}
+
+// MarshalCDP writes StopCastingParams as JSON
+func (v *StopCastingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sinkName")
+	e.String(v.SinkName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StopCastingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Stops the active Cast session on the sink.
+func (c Client) StopCasting(params StopCastingParams) (StopCastingReturns, error) {
+	return c.StopCastingContext(context.Background(), params)
+}
+
+// StopCastingContext is StopCasting with a context for cancellation and deadlines
+func (c Client) StopCastingContext(ctx context.Context, params StopCastingParams) (StopCastingReturns, error) {
+	var returns_ StopCastingReturns
+
+	err_ := c.caller.Call(ctx, "Cast.stopCasting", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// This is fired whenever the list of available sinks changes. A sink is a
+// device or a software surface that you can cast to.
+type SinksUpdatedEvent struct {
+	Sinks []Sink `json:"sinks"`
+}
+
+// UnmarshalCDP reads SinksUpdatedEvent from JSON
+func (v *SinksUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sinks":
+			if d.Array() {
+				v.Sinks = make([]Sink, 0)
+				for d.More() {
+					var x0 Sink
+					x0.UnmarshalCDP(d)
+					v.Sinks = append(v.Sinks, x0)
+				}
+			} else {
+				v.Sinks = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SinksUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SinksUpdatedHandler func(ev SinksUpdatedEvent)
+
+// EventMethod is Cast.sinksUpdated
+func (SinksUpdatedEvent) EventMethod() string {
+	return "Cast.sinksUpdated"
+}
+
+// OnSinksUpdated calls handler for each Cast.sinksUpdated event
+func (c Client) OnSinksUpdated(handler SinksUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Cast.sinksUpdated", func(ev interface{}) {
+		handler(ev.(SinksUpdatedEvent))
+	})
+}
+
+// This is fired whenever the outstanding issue/error message changes.
+// |issueMessage| is empty if there is no issue.
+type IssueUpdatedEvent struct {
+	IssueMessage string `json:"issueMessage"`
+}
+
+// UnmarshalCDP reads IssueUpdatedEvent from JSON
+func (v *IssueUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "issueMessage":
+			v.IssueMessage = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *IssueUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type IssueUpdatedHandler func(ev IssueUpdatedEvent)
+
+// EventMethod is Cast.issueUpdated
+func (IssueUpdatedEvent) EventMethod() string {
+	return "Cast.issueUpdated"
+}
+
+// OnIssueUpdated calls handler for each Cast.issueUpdated event
+func (c Client) OnIssueUpdated(handler IssueUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Cast.issueUpdated", func(ev interface{}) {
+		handler(ev.(IssueUpdatedEvent))
+	})
+}
+
+// decoders for Cast events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Cast.sinksUpdated": cdp.Decode[SinksUpdatedEvent],
+	"Cast.issueUpdated": cdp.Decode[IssueUpdatedEvent],
+}
+
+// DecodeEvent decodes a Cast event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,47 @@
+// Package cdp holds what the generated domain packages share
+//
+// each domain of the chrome devtools protocol has its own package
+// such as cdp/network and cdp/page
+// types used by more than one domain are declared here
+// and given a local name in the domain they come from
+//
+// experimental domains, commands and events are marked in their docs
+// build with -tags gochrome_stable to leave them out
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// Caller sends commands and listens for events for a domain Client
+// *gochrome.Tab is a Caller
+type Caller interface {
+	// Call sends a command and decodes its result into result
+	Call(ctx context.Context, method string, params interface{}, result interface{}) error
+	// On calls handler for each event with the given name
+	On(eventName string, handler EventHandler) (unsubscribe func())
+}
+
+// EventHandler receives an event decoded into its generated type
+// such as page.LoadEventFiredEvent
+type EventHandler func(ev interface{})
+
+// ErrUnknownEvent is given by DecodeEvent for events it does not know
+var ErrUnknownEvent = errors.New("unknown event")
+
+// Decode reads the params of an event into E without reflection
+// used by the domain packages to decode their events
+func Decode[E any, P interface {
+	*E
+	Unmarshaler
+}](params json.RawMessage) (interface{}, error) {
+	var ev E
+	if len(params) == 0 {
+		// events without params may leave them out
+		return ev, nil
+	}
+	err := Unmarshal(params, P(&ev))
+	return ev, err
+}
@@ -0,0 +1,354 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package console is the Console domain of the chrome devtools protocol
+//
+// This domain is deprecated - use Runtime or Log instead.
+//
+// Deprecated: this is deprecated in the devtools protocol
+package console
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Console commands and listens for Console events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Console message.
+type ConsoleMessage struct {
+	/* Message source. */
+	Source ConsoleMessageSource `json:"source"`
+	/* Message severity. */
+	Level ConsoleMessageLevel `json:"level"`
+	/* Message text. */
+	Text string `json:"text"`
+	/* URL of the message origin. */
+	Url *string `json:"url,omitempty"`
+	/* Line number in the resource that generated this message (1-based). */
+	Line *int `json:"line,omitempty"`
+	/* Column number in the resource that generated this message (1-based). */
+	Column *int `json:"column,omitempty"`
+}
+
+// MarshalCDP writes ConsoleMessage as JSON
+func (v *ConsoleMessage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("source")
+	e.String(string(v.Source))
+	e.Key("level")
+	e.String(string(v.Level))
+	e.Key("text")
+	e.String(v.Text)
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Line != nil {
+		e.Key("line")
+		e.Int(*v.Line)
+	}
+	if v.Column != nil {
+		e.Key("column")
+		e.Int(*v.Column)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ConsoleMessage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ConsoleMessage from JSON
+func (v *ConsoleMessage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "source":
+			v.Source = ConsoleMessageSource(d.String())
+		case "level":
+			v.Level = ConsoleMessageLevel(d.String())
+		case "text":
+			v.Text = d.String()
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "line":
+			if d.Null() {
+				v.Line = nil
+			} else {
+				v.Line = new(int)
+				*v.Line = d.Int()
+			}
+		case "column":
+			if d.Null() {
+				v.Column = nil
+			} else {
+				v.Column = new(int)
+				*v.Column = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ConsoleMessage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ConsoleMessageSource string
+
+// ConsoleMessageSource values
+const (
+	ConsoleMessageSourceXml         ConsoleMessageSource = "xml"
+	ConsoleMessageSourceJavascript  ConsoleMessageSource = "javascript"
+	ConsoleMessageSourceNetwork     ConsoleMessageSource = "network"
+	ConsoleMessageSourceConsoleApi  ConsoleMessageSource = "console-api"
+	ConsoleMessageSourceStorage     ConsoleMessageSource = "storage"
+	ConsoleMessageSourceAppcache    ConsoleMessageSource = "appcache"
+	ConsoleMessageSourceRendering   ConsoleMessageSource = "rendering"
+	ConsoleMessageSourceSecurity    ConsoleMessageSource = "security"
+	ConsoleMessageSourceOther       ConsoleMessageSource = "other"
+	ConsoleMessageSourceDeprecation ConsoleMessageSource = "deprecation"
+	ConsoleMessageSourceWorker      ConsoleMessageSource = "worker"
+)
+
+// Values gives every ConsoleMessageSource
+func (ConsoleMessageSource) Values() []ConsoleMessageSource {
+	return []ConsoleMessageSource{
+		ConsoleMessageSourceXml,
+		ConsoleMessageSourceJavascript,
+		ConsoleMessageSourceNetwork,
+		ConsoleMessageSourceConsoleApi,
+		ConsoleMessageSourceStorage,
+		ConsoleMessageSourceAppcache,
+		ConsoleMessageSourceRendering,
+		ConsoleMessageSourceSecurity,
+		ConsoleMessageSourceOther,
+		ConsoleMessageSourceDeprecation,
+		ConsoleMessageSourceWorker,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ConsoleMessageSource) Valid() bool {
+	switch v {
+	case ConsoleMessageSourceXml, ConsoleMessageSourceJavascript, ConsoleMessageSourceNetwork, ConsoleMessageSourceConsoleApi, ConsoleMessageSourceStorage, ConsoleMessageSourceAppcache, ConsoleMessageSourceRendering, ConsoleMessageSourceSecurity, ConsoleMessageSourceOther, ConsoleMessageSourceDeprecation, ConsoleMessageSourceWorker:
+		return true
+	}
+	return false
+}
+
+type ConsoleMessageLevel string
+
+// ConsoleMessageLevel values
+const (
+	ConsoleMessageLevelLog     ConsoleMessageLevel = "log"
+	ConsoleMessageLevelWarning ConsoleMessageLevel = "warning"
+	ConsoleMessageLevelError   ConsoleMessageLevel = "error"
+	ConsoleMessageLevelDebug   ConsoleMessageLevel = "debug"
+	ConsoleMessageLevelInfo    ConsoleMessageLevel = "info"
+)
+
+// Values gives every ConsoleMessageLevel
+func (ConsoleMessageLevel) Values() []ConsoleMessageLevel {
+	return []ConsoleMessageLevel{
+		ConsoleMessageLevelLog,
+		ConsoleMessageLevelWarning,
+		ConsoleMessageLevelError,
+		ConsoleMessageLevelDebug,
+		ConsoleMessageLevelInfo,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ConsoleMessageLevel) Valid() bool {
+	switch v {
+	case ConsoleMessageLevelLog, ConsoleMessageLevelWarning, ConsoleMessageLevelError, ConsoleMessageLevelDebug, ConsoleMessageLevelInfo:
+		return true
+	}
+	return false
+}
+
+type ClearMessagesReturns struct {
+}
+
+// UnmarshalCDP reads ClearMessagesReturns from JSON
+func (v *ClearMessagesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearMessagesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Does nothing.
+func (c Client) ClearMessages() (ClearMessagesReturns, error) {
+	return c.ClearMessagesContext(context.Background())
+}
+
+// ClearMessagesContext is ClearMessages with a context for cancellation and deadlines
+func (c Client) ClearMessagesContext(ctx context.Context) (ClearMessagesReturns, error) {
+	var returns_ ClearMessagesReturns
+
+	err_ := c.caller.Call(ctx, "Console.clearMessages", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables console domain, prevents further console messages from being reported to the client.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Console.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables console domain, sends the messages collected so far to the client by means of the
+// `messageAdded` notification.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Console.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Issued when new console message is added.
+type MessageAddedEvent struct {
+
+	/* Console message that has been added. */
+	Message ConsoleMessage `json:"message"`
+}
+
+// UnmarshalCDP reads MessageAddedEvent from JSON
+func (v *MessageAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "message":
+			v.Message.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MessageAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type MessageAddedHandler func(ev MessageAddedEvent)
+
+// EventMethod is Console.messageAdded
+func (MessageAddedEvent) EventMethod() string {
+	return "Console.messageAdded"
+}
+
+// OnMessageAdded calls handler for each Console.messageAdded event
+func (c Client) OnMessageAdded(handler MessageAddedHandler) (unsubscribe func()) {
+	return c.caller.On("Console.messageAdded", func(ev interface{}) {
+		handler(ev.(MessageAddedEvent))
+	})
+}
+
+// decoders for Console events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Console.messageAdded": cdp.Decode[MessageAddedEvent],
+}
+
+// DecodeEvent decodes a Console event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,6259 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package css is the CSS domain of the chrome devtools protocol
+//
+// This domain exposes CSS read/write operations. All CSS objects (stylesheets, rules, and styles)
+// have an associated `id` used in subsequent operations on the related object. Each object type has
+// a specific `id` structure, and those are not interchangeable between objects of different kinds.
+// CSS objects can be loaded using the `get*ForNode()` calls (which accept a DOM node id). A client
+// can also keep track of stylesheets via the `styleSheetAdded`/`styleSheetRemoved` events and
+// subsequently load the required stylesheet contents using the `getStyleSheet[Text]()` methods.
+//
+// Experimental: this may change or be removed in any chrome release
+package css
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends CSS commands and listens for CSS events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type StyleSheetId string
+
+// Stylesheet type: "injected" for stylesheets injected via extension, "user-agent" for user-agent
+// stylesheets, "inspector" for stylesheets created by the inspector (i.e. those holding the "via
+// inspector" rules), "regular" for regular stylesheets.
+type StyleSheetOrigin string
+
+// StyleSheetOrigin values
+const (
+	StyleSheetOriginInjected  StyleSheetOrigin = "injected"
+	StyleSheetOriginUserAgent StyleSheetOrigin = "user-agent"
+	StyleSheetOriginInspector StyleSheetOrigin = "inspector"
+	StyleSheetOriginRegular   StyleSheetOrigin = "regular"
+)
+
+// Values gives every StyleSheetOrigin
+func (StyleSheetOrigin) Values() []StyleSheetOrigin {
+	return []StyleSheetOrigin{
+		StyleSheetOriginInjected,
+		StyleSheetOriginUserAgent,
+		StyleSheetOriginInspector,
+		StyleSheetOriginRegular,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StyleSheetOrigin) Valid() bool {
+	switch v {
+	case StyleSheetOriginInjected, StyleSheetOriginUserAgent, StyleSheetOriginInspector, StyleSheetOriginRegular:
+		return true
+	}
+	return false
+}
+
+// CSS rule collection for a single pseudo style.
+type PseudoElementMatches struct {
+	/* Pseudo element type. */
+	PseudoType cdp.DOMPseudoType `json:"pseudoType"`
+	/* Pseudo element custom ident. */
+	PseudoIdentifier *string `json:"pseudoIdentifier,omitempty"`
+	/* Matches of CSS rules applicable to the pseudo style. */
+	Matches []RuleMatch `json:"matches"`
+}
+
+// MarshalCDP writes PseudoElementMatches as JSON
+func (v *PseudoElementMatches) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("pseudoType")
+	e.String(string(v.PseudoType))
+	if v.PseudoIdentifier != nil {
+		e.Key("pseudoIdentifier")
+		e.String(*v.PseudoIdentifier)
+	}
+	e.Key("matches")
+	if v.Matches == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Matches {
+			e.Elem()
+			v.Matches[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PseudoElementMatches) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PseudoElementMatches from JSON
+func (v *PseudoElementMatches) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "pseudoType":
+			v.PseudoType = cdp.DOMPseudoType(d.String())
+		case "pseudoIdentifier":
+			if d.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				v.PseudoIdentifier = new(string)
+				*v.PseudoIdentifier = d.String()
+			}
+		case "matches":
+			if d.Array() {
+				v.Matches = make([]RuleMatch, 0)
+				for d.More() {
+					var x0 RuleMatch
+					x0.UnmarshalCDP(d)
+					v.Matches = append(v.Matches, x0)
+				}
+			} else {
+				v.Matches = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PseudoElementMatches) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS style coming from animations with the name of the animation.
+type CSSAnimationStyle struct {
+	/* The name of the animation. */
+	Name *string `json:"name,omitempty"`
+	/* The style coming from the animation. */
+	Style CSSStyle `json:"style"`
+}
+
+// MarshalCDP writes CSSAnimationStyle as JSON
+func (v *CSSAnimationStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSAnimationStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSAnimationStyle from JSON
+func (v *CSSAnimationStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSAnimationStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Inherited CSS rule collection from ancestor node.
+type InheritedStyleEntry struct {
+	/* The ancestor node's inline style, if any, in the style inheritance chain. */
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+	/* Matches of CSS rules matching the ancestor node in the style inheritance chain. */
+	MatchedCSSRules []RuleMatch `json:"matchedCSSRules"`
+}
+
+// MarshalCDP writes InheritedStyleEntry as JSON
+func (v *InheritedStyleEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.InlineStyle != nil {
+		e.Key("inlineStyle")
+		(*v.InlineStyle).MarshalCDP(e)
+	}
+	e.Key("matchedCSSRules")
+	if v.MatchedCSSRules == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.MatchedCSSRules {
+			e.Elem()
+			v.MatchedCSSRules[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InheritedStyleEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InheritedStyleEntry from JSON
+func (v *InheritedStyleEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "inlineStyle":
+			if d.Null() {
+				v.InlineStyle = nil
+			} else {
+				v.InlineStyle = new(CSSStyle)
+				(*v.InlineStyle).UnmarshalCDP(d)
+			}
+		case "matchedCSSRules":
+			if d.Array() {
+				v.MatchedCSSRules = make([]RuleMatch, 0)
+				for d.More() {
+					var x0 RuleMatch
+					x0.UnmarshalCDP(d)
+					v.MatchedCSSRules = append(v.MatchedCSSRules, x0)
+				}
+			} else {
+				v.MatchedCSSRules = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InheritedStyleEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Inherited CSS style collection for animated styles from ancestor node.
+type InheritedAnimatedStyleEntry struct {
+	/* Styles coming from the animations of the ancestor, if any, in the style inheritance chain. */
+	AnimationStyles []CSSAnimationStyle `json:"animationStyles"`
+	/* The style coming from the transitions of the ancestor, if any, in the style inheritance chain. */
+	TransitionsStyle *CSSStyle `json:"transitionsStyle,omitempty"`
+}
+
+// MarshalCDP writes InheritedAnimatedStyleEntry as JSON
+func (v *InheritedAnimatedStyleEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.AnimationStyles != nil {
+		e.Key("animationStyles")
+		e.ArrayStart()
+		for i0 := range v.AnimationStyles {
+			e.Elem()
+			v.AnimationStyles[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.TransitionsStyle != nil {
+		e.Key("transitionsStyle")
+		(*v.TransitionsStyle).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InheritedAnimatedStyleEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InheritedAnimatedStyleEntry from JSON
+func (v *InheritedAnimatedStyleEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "animationStyles":
+			if d.Array() {
+				v.AnimationStyles = make([]CSSAnimationStyle, 0)
+				for d.More() {
+					var x0 CSSAnimationStyle
+					x0.UnmarshalCDP(d)
+					v.AnimationStyles = append(v.AnimationStyles, x0)
+				}
+			} else {
+				v.AnimationStyles = nil
+			}
+		case "transitionsStyle":
+			if d.Null() {
+				v.TransitionsStyle = nil
+			} else {
+				v.TransitionsStyle = new(CSSStyle)
+				(*v.TransitionsStyle).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InheritedAnimatedStyleEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Inherited pseudo element matches from pseudos of an ancestor node.
+type InheritedPseudoElementMatches struct {
+	/* Matches of pseudo styles from the pseudos of an ancestor node. */
+	PseudoElements []PseudoElementMatches `json:"pseudoElements"`
+}
+
+// MarshalCDP writes InheritedPseudoElementMatches as JSON
+func (v *InheritedPseudoElementMatches) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("pseudoElements")
+	if v.PseudoElements == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.PseudoElements {
+			e.Elem()
+			v.PseudoElements[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InheritedPseudoElementMatches) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InheritedPseudoElementMatches from JSON
+func (v *InheritedPseudoElementMatches) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "pseudoElements":
+			if d.Array() {
+				v.PseudoElements = make([]PseudoElementMatches, 0)
+				for d.More() {
+					var x0 PseudoElementMatches
+					x0.UnmarshalCDP(d)
+					v.PseudoElements = append(v.PseudoElements, x0)
+				}
+			} else {
+				v.PseudoElements = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InheritedPseudoElementMatches) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Match data for a CSS rule.
+type RuleMatch struct {
+	/* CSS rule in the match. */
+	Rule CSSRule `json:"rule"`
+	/* Matching selector indices in the rule's selectorList selectors (0-based). */
+	MatchingSelectors []int `json:"matchingSelectors"`
+}
+
+// MarshalCDP writes RuleMatch as JSON
+func (v *RuleMatch) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("rule")
+	v.Rule.MarshalCDP(e)
+	e.Key("matchingSelectors")
+	if v.MatchingSelectors == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.MatchingSelectors {
+			e.Elem()
+			e.Int(v.MatchingSelectors[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuleMatch) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RuleMatch from JSON
+func (v *RuleMatch) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "rule":
+			v.Rule.UnmarshalCDP(d)
+		case "matchingSelectors":
+			if d.Array() {
+				v.MatchingSelectors = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.MatchingSelectors = append(v.MatchingSelectors, x0)
+				}
+			} else {
+				v.MatchingSelectors = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuleMatch) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Data for a simple selector (these are delimited by commas in a selector list).
+type Value struct {
+	/* Value text. */
+	Text string `json:"text"`
+	/* Value range in the underlying resource (if available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Specificity of the selector.
+
+	Experimental: this may change or be removed in any chrome release */
+	Specificity *Specificity `json:"specificity,omitempty"`
+}
+
+// MarshalCDP writes Value as JSON
+func (v *Value) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.Specificity != nil {
+		e.Key("specificity")
+		(*v.Specificity).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Value) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Value from JSON
+func (v *Value) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "specificity":
+			if d.Null() {
+				v.Specificity = nil
+			} else {
+				v.Specificity = new(Specificity)
+				(*v.Specificity).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Value) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Specificity:
+// https://drafts.csswg.org/selectors/#specificity-rules
+//
+// Experimental: this may change or be removed in any chrome release
+type Specificity struct {
+	/* The a component, which represents the number of ID selectors. */
+	A int `json:"a"`
+	/* The b component, which represents the number of class selectors, attributes selectors, and
+	pseudo-classes. */
+	B int `json:"b"`
+	/* The c component, which represents the number of type selectors and pseudo-elements. */
+	C int `json:"c"`
+}
+
+// MarshalCDP writes Specificity as JSON
+func (v *Specificity) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("a")
+	e.Int(v.A)
+	e.Key("b")
+	e.Int(v.B)
+	e.Key("c")
+	e.Int(v.C)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Specificity) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Specificity from JSON
+func (v *Specificity) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "a":
+			v.A = d.Int()
+		case "b":
+			v.B = d.Int()
+		case "c":
+			v.C = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Specificity) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Selector list data.
+type SelectorList struct {
+	/* Selectors in the list. */
+	Selectors []Value `json:"selectors"`
+	/* Rule selector text. */
+	Text string `json:"text"`
+}
+
+// MarshalCDP writes SelectorList as JSON
+func (v *SelectorList) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("selectors")
+	if v.Selectors == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Selectors {
+			e.Elem()
+			v.Selectors[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SelectorList) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SelectorList from JSON
+func (v *SelectorList) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "selectors":
+			if d.Array() {
+				v.Selectors = make([]Value, 0)
+				for d.More() {
+					var x0 Value
+					x0.UnmarshalCDP(d)
+					v.Selectors = append(v.Selectors, x0)
+				}
+			} else {
+				v.Selectors = nil
+			}
+		case "text":
+			v.Text = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SelectorList) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS stylesheet metainformation.
+type CSSStyleSheetHeader struct {
+	/* The stylesheet identifier. */
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	/* Owner frame identifier. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+	/* Stylesheet resource URL. Empty if this is a constructed stylesheet created using
+	new CSSStyleSheet() (but non-empty if this is a constructed stylesheet imported
+	as a CSS module script). */
+	SourceURL string `json:"sourceURL"`
+	/* URL of source map associated with the stylesheet (if any). */
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
+	/* Stylesheet origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Stylesheet title. */
+	Title string `json:"title"`
+	/* The backend id for the owner node of the stylesheet. */
+	OwnerNode *cdp.DOMBackendNodeId `json:"ownerNode,omitempty"`
+	/* Denotes whether the stylesheet is disabled. */
+	Disabled bool `json:"disabled"`
+	/* Whether the sourceURL field value comes from the sourceURL comment. */
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
+	/* Whether this stylesheet is created for STYLE tag by parser. This flag is not set for
+	document.written STYLE tags. */
+	IsInline bool `json:"isInline"`
+	/* Whether this stylesheet is mutable. Inline stylesheets become mutable
+	after they have been modified via CSSOM API.
+	`<link>` element's stylesheets become mutable only if DevTools modifies them.
+	Constructed stylesheets (new CSSStyleSheet()) are mutable immediately after creation. */
+	IsMutable bool `json:"isMutable"`
+	/* True if this stylesheet is created through new CSSStyleSheet() or imported as a
+	CSS module script. */
+	IsConstructed bool `json:"isConstructed"`
+	/* Line offset of the stylesheet within the resource (zero based). */
+	StartLine float64 `json:"startLine"`
+	/* Column offset of the stylesheet within the resource (zero based). */
+	StartColumn float64 `json:"startColumn"`
+	/* Size of the content (in characters). */
+	Length float64 `json:"length"`
+	/* Line offset of the end of the stylesheet within the resource (zero based). */
+	EndLine float64 `json:"endLine"`
+	/* Column offset of the end of the stylesheet within the resource (zero based). */
+	EndColumn float64 `json:"endColumn"`
+	/* If the style sheet was loaded from a network resource, this indicates when the resource failed to load
+
+	Experimental: this may change or be removed in any chrome release */
+	LoadingFailed *bool `json:"loadingFailed,omitempty"`
+}
+
+// MarshalCDP writes CSSStyleSheetHeader as JSON
+func (v *CSSStyleSheetHeader) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("sourceURL")
+	e.String(v.SourceURL)
+	if v.SourceMapURL != nil {
+		e.Key("sourceMapURL")
+		e.String(*v.SourceMapURL)
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("title")
+	e.String(v.Title)
+	if v.OwnerNode != nil {
+		e.Key("ownerNode")
+		e.Int(int(*v.OwnerNode))
+	}
+	e.Key("disabled")
+	e.Bool(v.Disabled)
+	if v.HasSourceURL != nil {
+		e.Key("hasSourceURL")
+		e.Bool(*v.HasSourceURL)
+	}
+	e.Key("isInline")
+	e.Bool(v.IsInline)
+	e.Key("isMutable")
+	e.Bool(v.IsMutable)
+	e.Key("isConstructed")
+	e.Bool(v.IsConstructed)
+	e.Key("startLine")
+	e.Float(v.StartLine)
+	e.Key("startColumn")
+	e.Float(v.StartColumn)
+	e.Key("length")
+	e.Float(v.Length)
+	e.Key("endLine")
+	e.Float(v.EndLine)
+	e.Key("endColumn")
+	e.Float(v.EndColumn)
+	if v.LoadingFailed != nil {
+		e.Key("loadingFailed")
+		e.Bool(*v.LoadingFailed)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSStyleSheetHeader) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSStyleSheetHeader from JSON
+func (v *CSSStyleSheetHeader) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			v.StyleSheetId = StyleSheetId(d.String())
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "sourceURL":
+			v.SourceURL = d.String()
+		case "sourceMapURL":
+			if d.Null() {
+				v.SourceMapURL = nil
+			} else {
+				v.SourceMapURL = new(string)
+				*v.SourceMapURL = d.String()
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "title":
+			v.Title = d.String()
+		case "ownerNode":
+			if d.Null() {
+				v.OwnerNode = nil
+			} else {
+				v.OwnerNode = new(cdp.DOMBackendNodeId)
+				*v.OwnerNode = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "disabled":
+			v.Disabled = d.Bool()
+		case "hasSourceURL":
+			if d.Null() {
+				v.HasSourceURL = nil
+			} else {
+				v.HasSourceURL = new(bool)
+				*v.HasSourceURL = d.Bool()
+			}
+		case "isInline":
+			v.IsInline = d.Bool()
+		case "isMutable":
+			v.IsMutable = d.Bool()
+		case "isConstructed":
+			v.IsConstructed = d.Bool()
+		case "startLine":
+			v.StartLine = d.Float()
+		case "startColumn":
+			v.StartColumn = d.Float()
+		case "length":
+			v.Length = d.Float()
+		case "endLine":
+			v.EndLine = d.Float()
+		case "endColumn":
+			v.EndColumn = d.Float()
+		case "loadingFailed":
+			if d.Null() {
+				v.LoadingFailed = nil
+			} else {
+				v.LoadingFailed = new(bool)
+				*v.LoadingFailed = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSStyleSheetHeader) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS rule representation.
+type CSSRule struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Rule selector data. */
+	SelectorList SelectorList `json:"selectorList"`
+	/* Array of selectors from ancestor style rules, sorted by distance from the current rule.
+
+	Experimental: this may change or be removed in any chrome release */
+	NestingSelectors []string `json:"nestingSelectors"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Associated style declaration. */
+	Style CSSStyle `json:"style"`
+	/* Media list array (for rules involving media queries). The array enumerates media queries
+	starting with the innermost one, going outwards. */
+	Media []CSSMedia `json:"media"`
+	/* Container query list array (for rules involving container queries).
+	The array enumerates container queries starting with the innermost one, going outwards.
+
+	Experimental: this may change or be removed in any chrome release */
+	ContainerQueries []CSSContainerQuery `json:"containerQueries"`
+	/* @supports CSS at-rule array.
+	The array enumerates @supports at-rules starting with the innermost one, going outwards.
+
+	Experimental: this may change or be removed in any chrome release */
+	Supports []CSSSupports `json:"supports"`
+	/* Cascade layer array. Contains the layer hierarchy that this rule belongs to starting
+	with the innermost layer and going outwards.
+
+	Experimental: this may change or be removed in any chrome release */
+	Layers []CSSLayer `json:"layers"`
+	/* @scope CSS at-rule array.
+	The array enumerates @scope at-rules starting with the innermost one, going outwards.
+
+	Experimental: this may change or be removed in any chrome release */
+	Scopes []CSSScope `json:"scopes"`
+	/* The array keeps the types of ancestor CSSRules from the innermost going outwards.
+
+	Experimental: this may change or be removed in any chrome release */
+	RuleTypes []CSSRuleType `json:"ruleTypes"`
+	/* @starting-style CSS at-rule array.
+	The array enumerates @starting-style at-rules starting with the innermost one, going outwards.
+
+	Experimental: this may change or be removed in any chrome release */
+	StartingStyles []CSSStartingStyle `json:"startingStyles"`
+}
+
+// MarshalCDP writes CSSRule as JSON
+func (v *CSSRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("selectorList")
+	v.SelectorList.MarshalCDP(e)
+	if v.NestingSelectors != nil {
+		e.Key("nestingSelectors")
+		e.ArrayStart()
+		for i0 := range v.NestingSelectors {
+			e.Elem()
+			e.String(v.NestingSelectors[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	if v.Media != nil {
+		e.Key("media")
+		e.ArrayStart()
+		for i0 := range v.Media {
+			e.Elem()
+			v.Media[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.ContainerQueries != nil {
+		e.Key("containerQueries")
+		e.ArrayStart()
+		for i0 := range v.ContainerQueries {
+			e.Elem()
+			v.ContainerQueries[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Supports != nil {
+		e.Key("supports")
+		e.ArrayStart()
+		for i0 := range v.Supports {
+			e.Elem()
+			v.Supports[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Layers != nil {
+		e.Key("layers")
+		e.ArrayStart()
+		for i0 := range v.Layers {
+			e.Elem()
+			v.Layers[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Scopes != nil {
+		e.Key("scopes")
+		e.ArrayStart()
+		for i0 := range v.Scopes {
+			e.Elem()
+			v.Scopes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.RuleTypes != nil {
+		e.Key("ruleTypes")
+		e.ArrayStart()
+		for i0 := range v.RuleTypes {
+			e.Elem()
+			e.String(string(v.RuleTypes[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.StartingStyles != nil {
+		e.Key("startingStyles")
+		e.ArrayStart()
+		for i0 := range v.StartingStyles {
+			e.Elem()
+			v.StartingStyles[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSRule from JSON
+func (v *CSSRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "selectorList":
+			v.SelectorList.UnmarshalCDP(d)
+		case "nestingSelectors":
+			if d.Array() {
+				v.NestingSelectors = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.NestingSelectors = append(v.NestingSelectors, x0)
+				}
+			} else {
+				v.NestingSelectors = nil
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		case "media":
+			if d.Array() {
+				v.Media = make([]CSSMedia, 0)
+				for d.More() {
+					var x0 CSSMedia
+					x0.UnmarshalCDP(d)
+					v.Media = append(v.Media, x0)
+				}
+			} else {
+				v.Media = nil
+			}
+		case "containerQueries":
+			if d.Array() {
+				v.ContainerQueries = make([]CSSContainerQuery, 0)
+				for d.More() {
+					var x0 CSSContainerQuery
+					x0.UnmarshalCDP(d)
+					v.ContainerQueries = append(v.ContainerQueries, x0)
+				}
+			} else {
+				v.ContainerQueries = nil
+			}
+		case "supports":
+			if d.Array() {
+				v.Supports = make([]CSSSupports, 0)
+				for d.More() {
+					var x0 CSSSupports
+					x0.UnmarshalCDP(d)
+					v.Supports = append(v.Supports, x0)
+				}
+			} else {
+				v.Supports = nil
+			}
+		case "layers":
+			if d.Array() {
+				v.Layers = make([]CSSLayer, 0)
+				for d.More() {
+					var x0 CSSLayer
+					x0.UnmarshalCDP(d)
+					v.Layers = append(v.Layers, x0)
+				}
+			} else {
+				v.Layers = nil
+			}
+		case "scopes":
+			if d.Array() {
+				v.Scopes = make([]CSSScope, 0)
+				for d.More() {
+					var x0 CSSScope
+					x0.UnmarshalCDP(d)
+					v.Scopes = append(v.Scopes, x0)
+				}
+			} else {
+				v.Scopes = nil
+			}
+		case "ruleTypes":
+			if d.Array() {
+				v.RuleTypes = make([]CSSRuleType, 0)
+				for d.More() {
+					var x0 CSSRuleType
+					x0 = CSSRuleType(d.String())
+					v.RuleTypes = append(v.RuleTypes, x0)
+				}
+			} else {
+				v.RuleTypes = nil
+			}
+		case "startingStyles":
+			if d.Array() {
+				v.StartingStyles = make([]CSSStartingStyle, 0)
+				for d.More() {
+					var x0 CSSStartingStyle
+					x0.UnmarshalCDP(d)
+					v.StartingStyles = append(v.StartingStyles, x0)
+				}
+			} else {
+				v.StartingStyles = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enum indicating the type of a CSS rule, used to represent the order of a style rule's ancestors.
+// This list only contains rule types that are collected during the ancestor rule collection.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSRuleType string
+
+// CSSRuleType values
+const (
+	CSSRuleTypeMediaRule         CSSRuleType = "MediaRule"
+	CSSRuleTypeSupportsRule      CSSRuleType = "SupportsRule"
+	CSSRuleTypeContainerRule     CSSRuleType = "ContainerRule"
+	CSSRuleTypeLayerRule         CSSRuleType = "LayerRule"
+	CSSRuleTypeScopeRule         CSSRuleType = "ScopeRule"
+	CSSRuleTypeStyleRule         CSSRuleType = "StyleRule"
+	CSSRuleTypeStartingStyleRule CSSRuleType = "StartingStyleRule"
+)
+
+// Values gives every CSSRuleType
+func (CSSRuleType) Values() []CSSRuleType {
+	return []CSSRuleType{
+		CSSRuleTypeMediaRule,
+		CSSRuleTypeSupportsRule,
+		CSSRuleTypeContainerRule,
+		CSSRuleTypeLayerRule,
+		CSSRuleTypeScopeRule,
+		CSSRuleTypeStyleRule,
+		CSSRuleTypeStartingStyleRule,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CSSRuleType) Valid() bool {
+	switch v {
+	case CSSRuleTypeMediaRule, CSSRuleTypeSupportsRule, CSSRuleTypeContainerRule, CSSRuleTypeLayerRule, CSSRuleTypeScopeRule, CSSRuleTypeStyleRule, CSSRuleTypeStartingStyleRule:
+		return true
+	}
+	return false
+}
+
+// CSS coverage information.
+type RuleUsage struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	/* Offset of the start of the rule (including selector) from the beginning of the stylesheet. */
+	StartOffset float64 `json:"startOffset"`
+	/* Offset of the end of the rule body from the beginning of the stylesheet. */
+	EndOffset float64 `json:"endOffset"`
+	/* Indicates whether the rule was actually used by some element in the page. */
+	Used bool `json:"used"`
+}
+
+// MarshalCDP writes RuleUsage as JSON
+func (v *RuleUsage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("startOffset")
+	e.Float(v.StartOffset)
+	e.Key("endOffset")
+	e.Float(v.EndOffset)
+	e.Key("used")
+	e.Bool(v.Used)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuleUsage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RuleUsage from JSON
+func (v *RuleUsage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			v.StyleSheetId = StyleSheetId(d.String())
+		case "startOffset":
+			v.StartOffset = d.Float()
+		case "endOffset":
+			v.EndOffset = d.Float()
+		case "used":
+			v.Used = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuleUsage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Text range within a resource. All numbers are zero-based.
+type SourceRange struct {
+	/* Start line of range. */
+	StartLine int `json:"startLine"`
+	/* Start column of range (inclusive). */
+	StartColumn int `json:"startColumn"`
+	/* End line of range */
+	EndLine int `json:"endLine"`
+	/* End column of range (exclusive). */
+	EndColumn int `json:"endColumn"`
+}
+
+// MarshalCDP writes SourceRange as JSON
+func (v *SourceRange) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("startLine")
+	e.Int(v.StartLine)
+	e.Key("startColumn")
+	e.Int(v.StartColumn)
+	e.Key("endLine")
+	e.Int(v.EndLine)
+	e.Key("endColumn")
+	e.Int(v.EndColumn)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SourceRange) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SourceRange from JSON
+func (v *SourceRange) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "startLine":
+			v.StartLine = d.Int()
+		case "startColumn":
+			v.StartColumn = d.Int()
+		case "endLine":
+			v.EndLine = d.Int()
+		case "endColumn":
+			v.EndColumn = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SourceRange) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ShorthandEntry struct {
+	/* Shorthand name. */
+	Name string `json:"name"`
+	/* Shorthand value. */
+	Value string `json:"value"`
+	/* Whether the property has "!important" annotation (implies `false` if absent). */
+	Important *bool `json:"important,omitempty"`
+}
+
+// MarshalCDP writes ShorthandEntry as JSON
+func (v *ShorthandEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	if v.Important != nil {
+		e.Key("important")
+		e.Bool(*v.Important)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ShorthandEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ShorthandEntry from JSON
+func (v *ShorthandEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		case "important":
+			if d.Null() {
+				v.Important = nil
+			} else {
+				v.Important = new(bool)
+				*v.Important = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ShorthandEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CSSComputedStyleProperty struct {
+	/* Computed style property name. */
+	Name string `json:"name"`
+	/* Computed style property value. */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes CSSComputedStyleProperty as JSON
+func (v *CSSComputedStyleProperty) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSComputedStyleProperty) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSComputedStyleProperty from JSON
+func (v *CSSComputedStyleProperty) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSComputedStyleProperty) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS style representation.
+type CSSStyle struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* CSS properties in the style. */
+	CssProperties []CSSProperty `json:"cssProperties"`
+	/* Computed values for all shorthands found in the style. */
+	ShorthandEntries []ShorthandEntry `json:"shorthandEntries"`
+	/* Style declaration text (if available). */
+	CssText *string `json:"cssText,omitempty"`
+	/* Style declaration range in the enclosing stylesheet (if available). */
+	Range *SourceRange `json:"range,omitempty"`
+}
+
+// MarshalCDP writes CSSStyle as JSON
+func (v *CSSStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("cssProperties")
+	if v.CssProperties == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.CssProperties {
+			e.Elem()
+			v.CssProperties[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("shorthandEntries")
+	if v.ShorthandEntries == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ShorthandEntries {
+			e.Elem()
+			v.ShorthandEntries[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.CssText != nil {
+		e.Key("cssText")
+		e.String(*v.CssText)
+	}
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSStyle from JSON
+func (v *CSSStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "cssProperties":
+			if d.Array() {
+				v.CssProperties = make([]CSSProperty, 0)
+				for d.More() {
+					var x0 CSSProperty
+					x0.UnmarshalCDP(d)
+					v.CssProperties = append(v.CssProperties, x0)
+				}
+			} else {
+				v.CssProperties = nil
+			}
+		case "shorthandEntries":
+			if d.Array() {
+				v.ShorthandEntries = make([]ShorthandEntry, 0)
+				for d.More() {
+					var x0 ShorthandEntry
+					x0.UnmarshalCDP(d)
+					v.ShorthandEntries = append(v.ShorthandEntries, x0)
+				}
+			} else {
+				v.ShorthandEntries = nil
+			}
+		case "cssText":
+			if d.Null() {
+				v.CssText = nil
+			} else {
+				v.CssText = new(string)
+				*v.CssText = d.String()
+			}
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS property declaration data.
+type CSSProperty struct {
+	/* The property name. */
+	Name string `json:"name"`
+	/* The property value. */
+	Value string `json:"value"`
+	/* Whether the property has "!important" annotation (implies `false` if absent). */
+	Important *bool `json:"important,omitempty"`
+	/* Whether the property is implicit (implies `false` if absent). */
+	Implicit *bool `json:"implicit,omitempty"`
+	/* The full property text as specified in the style. */
+	Text *string `json:"text,omitempty"`
+	/* Whether the property is understood by the browser (implies `true` if absent). */
+	ParsedOk *bool `json:"parsedOk,omitempty"`
+	/* Whether the property is disabled by the user (present for source-based properties only). */
+	Disabled *bool `json:"disabled,omitempty"`
+	/* The entire property range in the enclosing style declaration (if available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Parsed longhand components of this property if it is a shorthand.
+	This field will be empty if the given property is not a shorthand.
+
+	Experimental: this may change or be removed in any chrome release */
+	LonghandProperties []CSSProperty `json:"longhandProperties"`
+}
+
+// MarshalCDP writes CSSProperty as JSON
+func (v *CSSProperty) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	if v.Important != nil {
+		e.Key("important")
+		e.Bool(*v.Important)
+	}
+	if v.Implicit != nil {
+		e.Key("implicit")
+		e.Bool(*v.Implicit)
+	}
+	if v.Text != nil {
+		e.Key("text")
+		e.String(*v.Text)
+	}
+	if v.ParsedOk != nil {
+		e.Key("parsedOk")
+		e.Bool(*v.ParsedOk)
+	}
+	if v.Disabled != nil {
+		e.Key("disabled")
+		e.Bool(*v.Disabled)
+	}
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.LonghandProperties != nil {
+		e.Key("longhandProperties")
+		e.ArrayStart()
+		for i0 := range v.LonghandProperties {
+			e.Elem()
+			v.LonghandProperties[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSProperty) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSProperty from JSON
+func (v *CSSProperty) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		case "important":
+			if d.Null() {
+				v.Important = nil
+			} else {
+				v.Important = new(bool)
+				*v.Important = d.Bool()
+			}
+		case "implicit":
+			if d.Null() {
+				v.Implicit = nil
+			} else {
+				v.Implicit = new(bool)
+				*v.Implicit = d.Bool()
+			}
+		case "text":
+			if d.Null() {
+				v.Text = nil
+			} else {
+				v.Text = new(string)
+				*v.Text = d.String()
+			}
+		case "parsedOk":
+			if d.Null() {
+				v.ParsedOk = nil
+			} else {
+				v.ParsedOk = new(bool)
+				*v.ParsedOk = d.Bool()
+			}
+		case "disabled":
+			if d.Null() {
+				v.Disabled = nil
+			} else {
+				v.Disabled = new(bool)
+				*v.Disabled = d.Bool()
+			}
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "longhandProperties":
+			if d.Array() {
+				v.LonghandProperties = make([]CSSProperty, 0)
+				for d.More() {
+					var x0 CSSProperty
+					x0.UnmarshalCDP(d)
+					v.LonghandProperties = append(v.LonghandProperties, x0)
+				}
+			} else {
+				v.LonghandProperties = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSProperty) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS media rule descriptor.
+type CSSMedia struct {
+	/* Media query text. */
+	Text string `json:"text"`
+	/* Source of the media query: "mediaRule" if specified by a @media rule, "importRule" if
+	specified by an @import rule, "linkedSheet" if specified by a "media" attribute in a linked
+	stylesheet's LINK tag, "inlineSheet" if specified by a "media" attribute in an inline
+	stylesheet's STYLE tag. */
+	Source CSSMediaSource `json:"source"`
+	/* URL of the document containing the media query description. */
+	SourceURL *string `json:"sourceURL,omitempty"`
+	/* The associated rule (@media or @import) header range in the enclosing stylesheet (if
+	available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Identifier of the stylesheet containing this object (if exists). */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Array of media queries. */
+	MediaList []MediaQuery `json:"mediaList"`
+}
+
+// MarshalCDP writes CSSMedia as JSON
+func (v *CSSMedia) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	e.Key("source")
+	e.String(string(v.Source))
+	if v.SourceURL != nil {
+		e.Key("sourceURL")
+		e.String(*v.SourceURL)
+	}
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	if v.MediaList != nil {
+		e.Key("mediaList")
+		e.ArrayStart()
+		for i0 := range v.MediaList {
+			e.Elem()
+			v.MediaList[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSMedia) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSMedia from JSON
+func (v *CSSMedia) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		case "source":
+			v.Source = CSSMediaSource(d.String())
+		case "sourceURL":
+			if d.Null() {
+				v.SourceURL = nil
+			} else {
+				v.SourceURL = new(string)
+				*v.SourceURL = d.String()
+			}
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "mediaList":
+			if d.Array() {
+				v.MediaList = make([]MediaQuery, 0)
+				for d.More() {
+					var x0 MediaQuery
+					x0.UnmarshalCDP(d)
+					v.MediaList = append(v.MediaList, x0)
+				}
+			} else {
+				v.MediaList = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSMedia) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CSSMediaSource string
+
+// CSSMediaSource values
+const (
+	CSSMediaSourceMediaRule   CSSMediaSource = "mediaRule"
+	CSSMediaSourceImportRule  CSSMediaSource = "importRule"
+	CSSMediaSourceLinkedSheet CSSMediaSource = "linkedSheet"
+	CSSMediaSourceInlineSheet CSSMediaSource = "inlineSheet"
+)
+
+// Values gives every CSSMediaSource
+func (CSSMediaSource) Values() []CSSMediaSource {
+	return []CSSMediaSource{
+		CSSMediaSourceMediaRule,
+		CSSMediaSourceImportRule,
+		CSSMediaSourceLinkedSheet,
+		CSSMediaSourceInlineSheet,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CSSMediaSource) Valid() bool {
+	switch v {
+	case CSSMediaSourceMediaRule, CSSMediaSourceImportRule, CSSMediaSourceLinkedSheet, CSSMediaSourceInlineSheet:
+		return true
+	}
+	return false
+}
+
+// Media query descriptor.
+type MediaQuery struct {
+	/* Array of media query expressions. */
+	Expressions []MediaQueryExpression `json:"expressions"`
+	/* Whether the media query condition is satisfied. */
+	Active bool `json:"active"`
+}
+
+// MarshalCDP writes MediaQuery as JSON
+func (v *MediaQuery) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("expressions")
+	if v.Expressions == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Expressions {
+			e.Elem()
+			v.Expressions[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("active")
+	e.Bool(v.Active)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v MediaQuery) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads MediaQuery from JSON
+func (v *MediaQuery) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "expressions":
+			if d.Array() {
+				v.Expressions = make([]MediaQueryExpression, 0)
+				for d.More() {
+					var x0 MediaQueryExpression
+					x0.UnmarshalCDP(d)
+					v.Expressions = append(v.Expressions, x0)
+				}
+			} else {
+				v.Expressions = nil
+			}
+		case "active":
+			v.Active = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MediaQuery) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Media query expression descriptor.
+type MediaQueryExpression struct {
+	/* Media query expression value. */
+	Value float64 `json:"value"`
+	/* Media query expression units. */
+	Unit string `json:"unit"`
+	/* Media query expression feature. */
+	Feature string `json:"feature"`
+	/* The associated range of the value text in the enclosing stylesheet (if available). */
+	ValueRange *SourceRange `json:"valueRange,omitempty"`
+	/* Computed length of media query expression (if applicable). */
+	ComputedLength *float64 `json:"computedLength,omitempty"`
+}
+
+// MarshalCDP writes MediaQueryExpression as JSON
+func (v *MediaQueryExpression) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("value")
+	e.Float(v.Value)
+	e.Key("unit")
+	e.String(v.Unit)
+	e.Key("feature")
+	e.String(v.Feature)
+	if v.ValueRange != nil {
+		e.Key("valueRange")
+		(*v.ValueRange).MarshalCDP(e)
+	}
+	if v.ComputedLength != nil {
+		e.Key("computedLength")
+		e.Float(*v.ComputedLength)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v MediaQueryExpression) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads MediaQueryExpression from JSON
+func (v *MediaQueryExpression) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			v.Value = d.Float()
+		case "unit":
+			v.Unit = d.String()
+		case "feature":
+			v.Feature = d.String()
+		case "valueRange":
+			if d.Null() {
+				v.ValueRange = nil
+			} else {
+				v.ValueRange = new(SourceRange)
+				(*v.ValueRange).UnmarshalCDP(d)
+			}
+		case "computedLength":
+			if d.Null() {
+				v.ComputedLength = nil
+			} else {
+				v.ComputedLength = new(float64)
+				*v.ComputedLength = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MediaQueryExpression) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS container query rule descriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSContainerQuery struct {
+	/* Container query text. */
+	Text string `json:"text"`
+	/* The associated rule header range in the enclosing stylesheet (if
+	available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Identifier of the stylesheet containing this object (if exists). */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Optional name for the container. */
+	Name *string `json:"name,omitempty"`
+	/* Optional physical axes queried for the container. */
+	PhysicalAxes *cdp.DOMPhysicalAxes `json:"physicalAxes,omitempty"`
+	/* Optional logical axes queried for the container. */
+	LogicalAxes *cdp.DOMLogicalAxes `json:"logicalAxes,omitempty"`
+	/* true if the query contains scroll-state() queries. */
+	QueriesScrollState *bool `json:"queriesScrollState,omitempty"`
+	/* true if the query contains anchored() queries. */
+	QueriesAnchored *bool `json:"queriesAnchored,omitempty"`
+}
+
+// MarshalCDP writes CSSContainerQuery as JSON
+func (v *CSSContainerQuery) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	if v.PhysicalAxes != nil {
+		e.Key("physicalAxes")
+		e.String(string(*v.PhysicalAxes))
+	}
+	if v.LogicalAxes != nil {
+		e.Key("logicalAxes")
+		e.String(string(*v.LogicalAxes))
+	}
+	if v.QueriesScrollState != nil {
+		e.Key("queriesScrollState")
+		e.Bool(*v.QueriesScrollState)
+	}
+	if v.QueriesAnchored != nil {
+		e.Key("queriesAnchored")
+		e.Bool(*v.QueriesAnchored)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSContainerQuery) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSContainerQuery from JSON
+func (v *CSSContainerQuery) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "physicalAxes":
+			if d.Null() {
+				v.PhysicalAxes = nil
+			} else {
+				v.PhysicalAxes = new(cdp.DOMPhysicalAxes)
+				*v.PhysicalAxes = cdp.DOMPhysicalAxes(d.String())
+			}
+		case "logicalAxes":
+			if d.Null() {
+				v.LogicalAxes = nil
+			} else {
+				v.LogicalAxes = new(cdp.DOMLogicalAxes)
+				*v.LogicalAxes = cdp.DOMLogicalAxes(d.String())
+			}
+		case "queriesScrollState":
+			if d.Null() {
+				v.QueriesScrollState = nil
+			} else {
+				v.QueriesScrollState = new(bool)
+				*v.QueriesScrollState = d.Bool()
+			}
+		case "queriesAnchored":
+			if d.Null() {
+				v.QueriesAnchored = nil
+			} else {
+				v.QueriesAnchored = new(bool)
+				*v.QueriesAnchored = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSContainerQuery) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS Supports at-rule descriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSSupports struct {
+	/* Supports rule text. */
+	Text string `json:"text"`
+	/* Whether the supports condition is satisfied. */
+	Active bool `json:"active"`
+	/* The associated rule header range in the enclosing stylesheet (if
+	available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Identifier of the stylesheet containing this object (if exists). */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+}
+
+// MarshalCDP writes CSSSupports as JSON
+func (v *CSSSupports) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	e.Key("active")
+	e.Bool(v.Active)
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSSupports) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSSupports from JSON
+func (v *CSSSupports) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		case "active":
+			v.Active = d.Bool()
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSSupports) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS Scope at-rule descriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSScope struct {
+	/* Scope rule text. */
+	Text string `json:"text"`
+	/* The associated rule header range in the enclosing stylesheet (if
+	available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Identifier of the stylesheet containing this object (if exists). */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+}
+
+// MarshalCDP writes CSSScope as JSON
+func (v *CSSScope) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSScope) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSScope from JSON
+func (v *CSSScope) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSScope) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS Layer at-rule descriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSLayer struct {
+	/* Layer name. */
+	Text string `json:"text"`
+	/* The associated rule header range in the enclosing stylesheet (if
+	available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Identifier of the stylesheet containing this object (if exists). */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+}
+
+// MarshalCDP writes CSSLayer as JSON
+func (v *CSSLayer) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSLayer) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSLayer from JSON
+func (v *CSSLayer) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSLayer) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS Starting Style at-rule descriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSStartingStyle struct {
+	/* The associated rule header range in the enclosing stylesheet (if
+	available). */
+	Range *SourceRange `json:"range,omitempty"`
+	/* Identifier of the stylesheet containing this object (if exists). */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+}
+
+// MarshalCDP writes CSSStartingStyle as JSON
+func (v *CSSStartingStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Range != nil {
+		e.Key("range")
+		(*v.Range).MarshalCDP(e)
+	}
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSStartingStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSStartingStyle from JSON
+func (v *CSSStartingStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "range":
+			if d.Null() {
+				v.Range = nil
+			} else {
+				v.Range = new(SourceRange)
+				(*v.Range).UnmarshalCDP(d)
+			}
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSStartingStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS Layer data.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSSLayerData struct {
+	/* Layer name. */
+	Name string `json:"name"`
+	/* Direct sub-layers */
+	SubLayers []CSSLayerData `json:"subLayers"`
+	/* Layer order. The order determines the order of the layer in the cascade order.
+	A higher number has higher priority in the cascade order. */
+	Order float64 `json:"order"`
+}
+
+// MarshalCDP writes CSSLayerData as JSON
+func (v *CSSLayerData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.SubLayers != nil {
+		e.Key("subLayers")
+		e.ArrayStart()
+		for i0 := range v.SubLayers {
+			e.Elem()
+			v.SubLayers[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("order")
+	e.Float(v.Order)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSLayerData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSLayerData from JSON
+func (v *CSSLayerData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "subLayers":
+			if d.Array() {
+				v.SubLayers = make([]CSSLayerData, 0)
+				for d.More() {
+					var x0 CSSLayerData
+					x0.UnmarshalCDP(d)
+					v.SubLayers = append(v.SubLayers, x0)
+				}
+			} else {
+				v.SubLayers = nil
+			}
+		case "order":
+			v.Order = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSLayerData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about amount of glyphs that were rendered with given font.
+type PlatformFontUsage struct {
+	/* Font's family name reported by platform. */
+	FamilyName string `json:"familyName"`
+	/* Font's PostScript name reported by platform. */
+	PostScriptName string `json:"postScriptName"`
+	/* Indicates if the font was downloaded or resolved locally. */
+	IsCustomFont bool `json:"isCustomFont"`
+	/* Amount of glyphs that were rendered with this font. */
+	GlyphCount float64 `json:"glyphCount"`
+}
+
+// MarshalCDP writes PlatformFontUsage as JSON
+func (v *PlatformFontUsage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("familyName")
+	e.String(v.FamilyName)
+	e.Key("postScriptName")
+	e.String(v.PostScriptName)
+	e.Key("isCustomFont")
+	e.Bool(v.IsCustomFont)
+	e.Key("glyphCount")
+	e.Float(v.GlyphCount)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PlatformFontUsage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PlatformFontUsage from JSON
+func (v *PlatformFontUsage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "familyName":
+			v.FamilyName = d.String()
+		case "postScriptName":
+			v.PostScriptName = d.String()
+		case "isCustomFont":
+			v.IsCustomFont = d.Bool()
+		case "glyphCount":
+			v.GlyphCount = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlatformFontUsage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about font variation axes for variable fonts
+type FontVariationAxis struct {
+	/* The font-variation-setting tag (a.k.a. "axis tag"). */
+	Tag string `json:"tag"`
+	/* Human-readable variation name in the default language (normally, "en"). */
+	Name string `json:"name"`
+	/* The minimum value (inclusive) the font supports for this tag. */
+	MinValue float64 `json:"minValue"`
+	/* The maximum value (inclusive) the font supports for this tag. */
+	MaxValue float64 `json:"maxValue"`
+	/* The default value. */
+	DefaultValue float64 `json:"defaultValue"`
+}
+
+// MarshalCDP writes FontVariationAxis as JSON
+func (v *FontVariationAxis) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("tag")
+	e.String(v.Tag)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("minValue")
+	e.Float(v.MinValue)
+	e.Key("maxValue")
+	e.Float(v.MaxValue)
+	e.Key("defaultValue")
+	e.Float(v.DefaultValue)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FontVariationAxis) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FontVariationAxis from JSON
+func (v *FontVariationAxis) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "tag":
+			v.Tag = d.String()
+		case "name":
+			v.Name = d.String()
+		case "minValue":
+			v.MinValue = d.Float()
+		case "maxValue":
+			v.MaxValue = d.Float()
+		case "defaultValue":
+			v.DefaultValue = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FontVariationAxis) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Properties of a web font: https://www.w3.org/TR/2008/REC-CSS2-20080411/fonts.html#font-descriptions
+// and additional information such as platformFontFamily and fontVariationAxes.
+type FontFace struct {
+	/* The font-family. */
+	FontFamily string `json:"fontFamily"`
+	/* The font-style. */
+	FontStyle string `json:"fontStyle"`
+	/* The font-variant. */
+	FontVariant string `json:"fontVariant"`
+	/* The font-weight. */
+	FontWeight string `json:"fontWeight"`
+	/* The font-stretch. */
+	FontStretch string `json:"fontStretch"`
+	/* The font-display. */
+	FontDisplay string `json:"fontDisplay"`
+	/* The unicode-range. */
+	UnicodeRange string `json:"unicodeRange"`
+	/* The src. */
+	Src string `json:"src"`
+	/* The resolved platform font family */
+	PlatformFontFamily string `json:"platformFontFamily"`
+	/* Available variation settings (a.k.a. "axes"). */
+	FontVariationAxes []FontVariationAxis `json:"fontVariationAxes"`
+}
+
+// MarshalCDP writes FontFace as JSON
+func (v *FontFace) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("fontFamily")
+	e.String(v.FontFamily)
+	e.Key("fontStyle")
+	e.String(v.FontStyle)
+	e.Key("fontVariant")
+	e.String(v.FontVariant)
+	e.Key("fontWeight")
+	e.String(v.FontWeight)
+	e.Key("fontStretch")
+	e.String(v.FontStretch)
+	e.Key("fontDisplay")
+	e.String(v.FontDisplay)
+	e.Key("unicodeRange")
+	e.String(v.UnicodeRange)
+	e.Key("src")
+	e.String(v.Src)
+	e.Key("platformFontFamily")
+	e.String(v.PlatformFontFamily)
+	if v.FontVariationAxes != nil {
+		e.Key("fontVariationAxes")
+		e.ArrayStart()
+		for i0 := range v.FontVariationAxes {
+			e.Elem()
+			v.FontVariationAxes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FontFace) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FontFace from JSON
+func (v *FontFace) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "fontFamily":
+			v.FontFamily = d.String()
+		case "fontStyle":
+			v.FontStyle = d.String()
+		case "fontVariant":
+			v.FontVariant = d.String()
+		case "fontWeight":
+			v.FontWeight = d.String()
+		case "fontStretch":
+			v.FontStretch = d.String()
+		case "fontDisplay":
+			v.FontDisplay = d.String()
+		case "unicodeRange":
+			v.UnicodeRange = d.String()
+		case "src":
+			v.Src = d.String()
+		case "platformFontFamily":
+			v.PlatformFontFamily = d.String()
+		case "fontVariationAxes":
+			if d.Array() {
+				v.FontVariationAxes = make([]FontVariationAxis, 0)
+				for d.More() {
+					var x0 FontVariationAxis
+					x0.UnmarshalCDP(d)
+					v.FontVariationAxes = append(v.FontVariationAxes, x0)
+				}
+			} else {
+				v.FontVariationAxes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FontFace) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS try rule representation.
+type CSSTryRule struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Associated style declaration. */
+	Style CSSStyle `json:"style"`
+}
+
+// MarshalCDP writes CSSTryRule as JSON
+func (v *CSSTryRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSTryRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSTryRule from JSON
+func (v *CSSTryRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSTryRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS @position-try rule representation.
+type CSSPositionTryRule struct {
+	/* The prelude dashed-ident name */
+	Name Value `json:"name"`
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Associated style declaration. */
+	Style  CSSStyle `json:"style"`
+	Active bool     `json:"active"`
+}
+
+// MarshalCDP writes CSSPositionTryRule as JSON
+func (v *CSSPositionTryRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	v.Name.MarshalCDP(e)
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	e.Key("active")
+	e.Bool(v.Active)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSPositionTryRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSPositionTryRule from JSON
+func (v *CSSPositionTryRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name.UnmarshalCDP(d)
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		case "active":
+			v.Active = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSPositionTryRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS keyframes rule representation.
+type CSSKeyframesRule struct {
+	/* Animation name. */
+	AnimationName Value `json:"animationName"`
+	/* List of keyframes. */
+	Keyframes []CSSKeyframeRule `json:"keyframes"`
+}
+
+// MarshalCDP writes CSSKeyframesRule as JSON
+func (v *CSSKeyframesRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("animationName")
+	v.AnimationName.MarshalCDP(e)
+	e.Key("keyframes")
+	if v.Keyframes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Keyframes {
+			e.Elem()
+			v.Keyframes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSKeyframesRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSKeyframesRule from JSON
+func (v *CSSKeyframesRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "animationName":
+			v.AnimationName.UnmarshalCDP(d)
+		case "keyframes":
+			if d.Array() {
+				v.Keyframes = make([]CSSKeyframeRule, 0)
+				for d.More() {
+					var x0 CSSKeyframeRule
+					x0.UnmarshalCDP(d)
+					v.Keyframes = append(v.Keyframes, x0)
+				}
+			} else {
+				v.Keyframes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSKeyframesRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Representation of a custom property registration through CSS.registerProperty
+type CSSPropertyRegistration struct {
+	PropertyName string `json:"propertyName"`
+	InitialValue *Value `json:"initialValue,omitempty"`
+	Inherits     bool   `json:"inherits"`
+	Syntax       string `json:"syntax"`
+}
+
+// MarshalCDP writes CSSPropertyRegistration as JSON
+func (v *CSSPropertyRegistration) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("propertyName")
+	e.String(v.PropertyName)
+	if v.InitialValue != nil {
+		e.Key("initialValue")
+		(*v.InitialValue).MarshalCDP(e)
+	}
+	e.Key("inherits")
+	e.Bool(v.Inherits)
+	e.Key("syntax")
+	e.String(v.Syntax)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSPropertyRegistration) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSPropertyRegistration from JSON
+func (v *CSSPropertyRegistration) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "propertyName":
+			v.PropertyName = d.String()
+		case "initialValue":
+			if d.Null() {
+				v.InitialValue = nil
+			} else {
+				v.InitialValue = new(Value)
+				(*v.InitialValue).UnmarshalCDP(d)
+			}
+		case "inherits":
+			v.Inherits = d.Bool()
+		case "syntax":
+			v.Syntax = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSPropertyRegistration) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS font-palette-values rule representation.
+type CSSFontPaletteValuesRule struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Associated font palette name. */
+	FontPaletteName Value `json:"fontPaletteName"`
+	/* Associated style declaration. */
+	Style CSSStyle `json:"style"`
+}
+
+// MarshalCDP writes CSSFontPaletteValuesRule as JSON
+func (v *CSSFontPaletteValuesRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("fontPaletteName")
+	v.FontPaletteName.MarshalCDP(e)
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSFontPaletteValuesRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSFontPaletteValuesRule from JSON
+func (v *CSSFontPaletteValuesRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "fontPaletteName":
+			v.FontPaletteName.UnmarshalCDP(d)
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSFontPaletteValuesRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS property at-rule representation.
+type CSSPropertyRule struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Associated property name. */
+	PropertyName Value `json:"propertyName"`
+	/* Associated style declaration. */
+	Style CSSStyle `json:"style"`
+}
+
+// MarshalCDP writes CSSPropertyRule as JSON
+func (v *CSSPropertyRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("propertyName")
+	v.PropertyName.MarshalCDP(e)
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSPropertyRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSPropertyRule from JSON
+func (v *CSSPropertyRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "propertyName":
+			v.PropertyName.UnmarshalCDP(d)
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSPropertyRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS function argument representation.
+type CSSFunctionParameter struct {
+	/* The parameter name. */
+	Name string `json:"name"`
+	/* The parameter type. */
+	Type string `json:"type"`
+}
+
+// MarshalCDP writes CSSFunctionParameter as JSON
+func (v *CSSFunctionParameter) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("type")
+	e.String(v.Type)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSFunctionParameter) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSFunctionParameter from JSON
+func (v *CSSFunctionParameter) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "type":
+			v.Type = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSFunctionParameter) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS function conditional block representation.
+type CSSFunctionConditionNode struct {
+	/* Media query for this conditional block. Only one type of condition should be set. */
+	Media *CSSMedia `json:"media,omitempty"`
+	/* Container query for this conditional block. Only one type of condition should be set. */
+	ContainerQueries *CSSContainerQuery `json:"containerQueries,omitempty"`
+	/* @supports CSS at-rule condition. Only one type of condition should be set. */
+	Supports *CSSSupports `json:"supports,omitempty"`
+	/* Block body. */
+	Children []CSSFunctionNode `json:"children"`
+	/* The condition text. */
+	ConditionText string `json:"conditionText"`
+}
+
+// MarshalCDP writes CSSFunctionConditionNode as JSON
+func (v *CSSFunctionConditionNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Media != nil {
+		e.Key("media")
+		(*v.Media).MarshalCDP(e)
+	}
+	if v.ContainerQueries != nil {
+		e.Key("containerQueries")
+		(*v.ContainerQueries).MarshalCDP(e)
+	}
+	if v.Supports != nil {
+		e.Key("supports")
+		(*v.Supports).MarshalCDP(e)
+	}
+	e.Key("children")
+	if v.Children == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Children {
+			e.Elem()
+			v.Children[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("conditionText")
+	e.String(v.ConditionText)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSFunctionConditionNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSFunctionConditionNode from JSON
+func (v *CSSFunctionConditionNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "media":
+			if d.Null() {
+				v.Media = nil
+			} else {
+				v.Media = new(CSSMedia)
+				(*v.Media).UnmarshalCDP(d)
+			}
+		case "containerQueries":
+			if d.Null() {
+				v.ContainerQueries = nil
+			} else {
+				v.ContainerQueries = new(CSSContainerQuery)
+				(*v.ContainerQueries).UnmarshalCDP(d)
+			}
+		case "supports":
+			if d.Null() {
+				v.Supports = nil
+			} else {
+				v.Supports = new(CSSSupports)
+				(*v.Supports).UnmarshalCDP(d)
+			}
+		case "children":
+			if d.Array() {
+				v.Children = make([]CSSFunctionNode, 0)
+				for d.More() {
+					var x0 CSSFunctionNode
+					x0.UnmarshalCDP(d)
+					v.Children = append(v.Children, x0)
+				}
+			} else {
+				v.Children = nil
+			}
+		case "conditionText":
+			v.ConditionText = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSFunctionConditionNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Section of the body of a CSS function rule.
+type CSSFunctionNode struct {
+	/* A conditional block. If set, style should not be set. */
+	Condition *CSSFunctionConditionNode `json:"condition,omitempty"`
+	/* Values set by this node. If set, condition should not be set. */
+	Style *CSSStyle `json:"style,omitempty"`
+}
+
+// MarshalCDP writes CSSFunctionNode as JSON
+func (v *CSSFunctionNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Condition != nil {
+		e.Key("condition")
+		(*v.Condition).MarshalCDP(e)
+	}
+	if v.Style != nil {
+		e.Key("style")
+		(*v.Style).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSFunctionNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSFunctionNode from JSON
+func (v *CSSFunctionNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "condition":
+			if d.Null() {
+				v.Condition = nil
+			} else {
+				v.Condition = new(CSSFunctionConditionNode)
+				(*v.Condition).UnmarshalCDP(d)
+			}
+		case "style":
+			if d.Null() {
+				v.Style = nil
+			} else {
+				v.Style = new(CSSStyle)
+				(*v.Style).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSFunctionNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS function at-rule representation.
+type CSSFunctionRule struct {
+	/* Name of the function. */
+	Name Value `json:"name"`
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* List of parameters. */
+	Parameters []CSSFunctionParameter `json:"parameters"`
+	/* Function body. */
+	Children []CSSFunctionNode `json:"children"`
+}
+
+// MarshalCDP writes CSSFunctionRule as JSON
+func (v *CSSFunctionRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	v.Name.MarshalCDP(e)
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("parameters")
+	if v.Parameters == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Parameters {
+			e.Elem()
+			v.Parameters[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("children")
+	if v.Children == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Children {
+			e.Elem()
+			v.Children[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSFunctionRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSFunctionRule from JSON
+func (v *CSSFunctionRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name.UnmarshalCDP(d)
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "parameters":
+			if d.Array() {
+				v.Parameters = make([]CSSFunctionParameter, 0)
+				for d.More() {
+					var x0 CSSFunctionParameter
+					x0.UnmarshalCDP(d)
+					v.Parameters = append(v.Parameters, x0)
+				}
+			} else {
+				v.Parameters = nil
+			}
+		case "children":
+			if d.Array() {
+				v.Children = make([]CSSFunctionNode, 0)
+				for d.More() {
+					var x0 CSSFunctionNode
+					x0.UnmarshalCDP(d)
+					v.Children = append(v.Children, x0)
+				}
+			} else {
+				v.Children = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSFunctionRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS keyframe rule representation.
+type CSSKeyframeRule struct {
+	/* The css style sheet identifier (absent for user agent stylesheet and user-specified
+	stylesheet rules) this rule came from. */
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
+	/* Parent stylesheet's origin. */
+	Origin StyleSheetOrigin `json:"origin"`
+	/* Associated key text. */
+	KeyText Value `json:"keyText"`
+	/* Associated style declaration. */
+	Style CSSStyle `json:"style"`
+}
+
+// MarshalCDP writes CSSKeyframeRule as JSON
+func (v *CSSKeyframeRule) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.StyleSheetId != nil {
+		e.Key("styleSheetId")
+		e.String(string(*v.StyleSheetId))
+	}
+	e.Key("origin")
+	e.String(string(v.Origin))
+	e.Key("keyText")
+	v.KeyText.MarshalCDP(e)
+	e.Key("style")
+	v.Style.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSKeyframeRule) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSKeyframeRule from JSON
+func (v *CSSKeyframeRule) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			if d.Null() {
+				v.StyleSheetId = nil
+			} else {
+				v.StyleSheetId = new(StyleSheetId)
+				*v.StyleSheetId = StyleSheetId(d.String())
+			}
+		case "origin":
+			v.Origin = StyleSheetOrigin(d.String())
+		case "keyText":
+			v.KeyText.UnmarshalCDP(d)
+		case "style":
+			v.Style.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSKeyframeRule) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A descriptor of operation to mutate style declaration text.
+type StyleDeclarationEdit struct {
+	/* The css style sheet identifier. */
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	/* The range of the style text in the enclosing stylesheet. */
+	Range SourceRange `json:"range"`
+	/* New style text. */
+	Text string `json:"text"`
+}
+
+// MarshalCDP writes StyleDeclarationEdit as JSON
+func (v *StyleDeclarationEdit) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StyleDeclarationEdit) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads StyleDeclarationEdit from JSON
+func (v *StyleDeclarationEdit) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			v.StyleSheetId = StyleSheetId(d.String())
+		case "range":
+			v.Range.UnmarshalCDP(d)
+		case "text":
+			v.Text = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StyleDeclarationEdit) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AddRuleReturns struct {
+
+	/* The newly created rule. */
+	Rule CSSRule `json:"rule"`
+}
+
+// UnmarshalCDP reads AddRuleReturns from JSON
+func (v *AddRuleReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "rule":
+			v.Rule.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddRuleReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddRuleParams are the parameters for CSS.addRule
+// optional parameters are left out when nil
+type AddRuleParams struct {
+	/* The css style sheet identifier where a new rule should be inserted. */
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	/* The text of a new rule. */
+	RuleText string `json:"ruleText"`
+	/* Text position of a new rule in the target style sheet. */
+	Location SourceRange `json:"location"`
+	/* NodeId for the DOM node in whose context custom property declarations for registered properties should be
+	validated. If omitted, declarations in the new rule text can only be validated statically, which may produce
+	incorrect results if the declaration contains a var() for example.
+
+	Experimental: this may change or be removed in any chrome release */
+	NodeForPropertySyntaxValidation *cdp.DOMNodeId `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// MarshalCDP writes AddRuleParams as JSON
+func (v *AddRuleParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("ruleText")
+	e.String(v.RuleText)
+	e.Key("location")
+	v.Location.MarshalCDP(e)
+	if v.NodeForPropertySyntaxValidation != nil {
+		e.Key("nodeForPropertySyntaxValidation")
+		e.Int(int(*v.NodeForPropertySyntaxValidation))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddRuleParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Inserts a new rule with the given `ruleText` in a stylesheet with given `styleSheetId`, at the
+// position specified by `location`.
+func (c Client) AddRule(params AddRuleParams) (AddRuleReturns, error) {
+	return c.AddRuleContext(context.Background(), params)
+}
+
+// AddRuleContext is AddRule with a context for cancellation and deadlines
+func (c Client) AddRuleContext(ctx context.Context, params AddRuleParams) (AddRuleReturns, error) {
+	var returns_ AddRuleReturns
+
+	err_ := c.caller.Call(ctx, "CSS.addRule", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CollectClassNamesReturns struct {
+
+	/* Class name list. */
+	ClassNames []string `json:"classNames"`
+}
+
+// UnmarshalCDP reads CollectClassNamesReturns from JSON
+func (v *CollectClassNamesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "classNames":
+			if d.Array() {
+				v.ClassNames = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.ClassNames = append(v.ClassNames, x0)
+				}
+			} else {
+				v.ClassNames = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CollectClassNamesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CollectClassNamesParams are the parameters for CSS.collectClassNames
+// optional parameters are left out when nil
+type CollectClassNamesParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+}
+
+// MarshalCDP writes CollectClassNamesParams as JSON
+func (v *CollectClassNamesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CollectClassNamesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns all class names from specified stylesheet.
+func (c Client) CollectClassNames(params CollectClassNamesParams) (CollectClassNamesReturns, error) {
+	return c.CollectClassNamesContext(context.Background(), params)
+}
+
+// CollectClassNamesContext is CollectClassNames with a context for cancellation and deadlines
+func (c Client) CollectClassNamesContext(ctx context.Context, params CollectClassNamesParams) (CollectClassNamesReturns, error) {
+	var returns_ CollectClassNamesReturns
+
+	err_ := c.caller.Call(ctx, "CSS.collectClassNames", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CreateStyleSheetReturns struct {
+
+	/* Identifier of the created "via-inspector" stylesheet. */
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+}
+
+// UnmarshalCDP reads CreateStyleSheetReturns from JSON
+func (v *CreateStyleSheetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			v.StyleSheetId = StyleSheetId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CreateStyleSheetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CreateStyleSheetParams are the parameters for CSS.createStyleSheet
+// optional parameters are left out when nil
+type CreateStyleSheetParams struct {
+	/* Identifier of the frame where "via-inspector" stylesheet should be created. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+	/* If true, creates a new stylesheet for every call. If false,
+	returns a stylesheet previously created by a call with force=false
+	for the frame's document if it exists or creates a new stylesheet
+	(default: false). */
+	Force *bool `json:"force,omitempty"`
+}
+
+// MarshalCDP writes CreateStyleSheetParams as JSON
+func (v *CreateStyleSheetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	if v.Force != nil {
+		e.Key("force")
+		e.Bool(*v.Force)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CreateStyleSheetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Creates a new special "via-inspector" stylesheet in the frame with given `frameId`.
+func (c Client) CreateStyleSheet(params CreateStyleSheetParams) (CreateStyleSheetReturns, error) {
+	return c.CreateStyleSheetContext(context.Background(), params)
+}
+
+// CreateStyleSheetContext is CreateStyleSheet with a context for cancellation and deadlines
+func (c Client) CreateStyleSheetContext(ctx context.Context, params CreateStyleSheetParams) (CreateStyleSheetReturns, error) {
+	var returns_ CreateStyleSheetReturns
+
+	err_ := c.caller.Call(ctx, "CSS.createStyleSheet", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables the CSS agent for the given page.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "CSS.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been
+// enabled until the result of this command is received.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "CSS.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ForcePseudoStateReturns struct {
+}
+
+// UnmarshalCDP reads ForcePseudoStateReturns from JSON
+func (v *ForcePseudoStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ForcePseudoStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ForcePseudoStateParams are the parameters for CSS.forcePseudoState
+// optional parameters are left out when nil
+type ForcePseudoStateParams struct {
+	/* The element id for which to force the pseudo state. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+	/* Element pseudo classes to force when computing the element's style. */
+	ForcedPseudoClasses []string `json:"forcedPseudoClasses"`
+}
+
+// MarshalCDP writes ForcePseudoStateParams as JSON
+func (v *ForcePseudoStateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("forcedPseudoClasses")
+	if v.ForcedPseudoClasses == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ForcedPseudoClasses {
+			e.Elem()
+			e.String(v.ForcedPseudoClasses[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ForcePseudoStateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Ensures that the given node will have specified pseudo-classes whenever its style is computed by
+// the browser.
+func (c Client) ForcePseudoState(params ForcePseudoStateParams) (ForcePseudoStateReturns, error) {
+	return c.ForcePseudoStateContext(context.Background(), params)
+}
+
+// ForcePseudoStateContext is ForcePseudoState with a context for cancellation and deadlines
+func (c Client) ForcePseudoStateContext(ctx context.Context, params ForcePseudoStateParams) (ForcePseudoStateReturns, error) {
+	var returns_ ForcePseudoStateReturns
+
+	err_ := c.caller.Call(ctx, "CSS.forcePseudoState", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ForceStartingStyleReturns struct {
+}
+
+// UnmarshalCDP reads ForceStartingStyleReturns from JSON
+func (v *ForceStartingStyleReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ForceStartingStyleReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ForceStartingStyleParams are the parameters for CSS.forceStartingStyle
+// optional parameters are left out when nil
+type ForceStartingStyleParams struct {
+	/* The element id for which to force the starting-style state. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+	/* Boolean indicating if this is on or off. */
+	Forced bool `json:"forced"`
+}
+
+// MarshalCDP writes ForceStartingStyleParams as JSON
+func (v *ForceStartingStyleParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("forced")
+	e.Bool(v.Forced)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ForceStartingStyleParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Ensures that the given node is in its starting-style state.
+func (c Client) ForceStartingStyle(params ForceStartingStyleParams) (ForceStartingStyleReturns, error) {
+	return c.ForceStartingStyleContext(context.Background(), params)
+}
+
+// ForceStartingStyleContext is ForceStartingStyle with a context for cancellation and deadlines
+func (c Client) ForceStartingStyleContext(ctx context.Context, params ForceStartingStyleParams) (ForceStartingStyleReturns, error) {
+	var returns_ ForceStartingStyleReturns
+
+	err_ := c.caller.Call(ctx, "CSS.forceStartingStyle", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetBackgroundColorsReturns struct {
+
+	/* The range of background colors behind this element, if it contains any visible text. If no
+	visible text is present, this will be undefined. In the case of a flat background color,
+	this will consist of simply that color. In the case of a gradient, this will consist of each
+	of the color stops. For anything more complicated, this will be an empty array. Images will
+	be ignored (as if the image had failed to load). */
+	BackgroundColors []string `json:"backgroundColors,omitempty"`
+
+	/* The computed font size for this node, as a CSS computed value string (e.g. '12px'). */
+	ComputedFontSize *string `json:"computedFontSize,omitempty"`
+
+	/* The computed font weight for this node, as a CSS computed value string (e.g. 'normal' or
+	'100'). */
+	ComputedFontWeight *string `json:"computedFontWeight,omitempty"`
+}
+
+// UnmarshalCDP reads GetBackgroundColorsReturns from JSON
+func (v *GetBackgroundColorsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backgroundColors":
+			if d.Array() {
+				v.BackgroundColors = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.BackgroundColors = append(v.BackgroundColors, x0)
+				}
+			} else {
+				v.BackgroundColors = nil
+			}
+		case "computedFontSize":
+			if d.Null() {
+				v.ComputedFontSize = nil
+			} else {
+				v.ComputedFontSize = new(string)
+				*v.ComputedFontSize = d.String()
+			}
+		case "computedFontWeight":
+			if d.Null() {
+				v.ComputedFontWeight = nil
+			} else {
+				v.ComputedFontWeight = new(string)
+				*v.ComputedFontWeight = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetBackgroundColorsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetBackgroundColorsParams are the parameters for CSS.getBackgroundColors
+// optional parameters are left out when nil
+type GetBackgroundColorsParams struct {
+	/* Id of the node to get background colors for. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetBackgroundColorsParams as JSON
+func (v *GetBackgroundColorsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetBackgroundColorsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) GetBackgroundColors(params GetBackgroundColorsParams) (GetBackgroundColorsReturns, error) {
+	return c.GetBackgroundColorsContext(context.Background(), params)
+}
+
+// GetBackgroundColorsContext is GetBackgroundColors with a context for cancellation and deadlines
+func (c Client) GetBackgroundColorsContext(ctx context.Context, params GetBackgroundColorsParams) (GetBackgroundColorsReturns, error) {
+	var returns_ GetBackgroundColorsReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getBackgroundColors", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetComputedStyleForNodeReturns struct {
+
+	/* Computed style for the specified DOM node. */
+	ComputedStyle []CSSComputedStyleProperty `json:"computedStyle"`
+}
+
+// UnmarshalCDP reads GetComputedStyleForNodeReturns from JSON
+func (v *GetComputedStyleForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "computedStyle":
+			if d.Array() {
+				v.ComputedStyle = make([]CSSComputedStyleProperty, 0)
+				for d.More() {
+					var x0 CSSComputedStyleProperty
+					x0.UnmarshalCDP(d)
+					v.ComputedStyle = append(v.ComputedStyle, x0)
+				}
+			} else {
+				v.ComputedStyle = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetComputedStyleForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetComputedStyleForNodeParams are the parameters for CSS.getComputedStyleForNode
+// optional parameters are left out when nil
+type GetComputedStyleForNodeParams struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetComputedStyleForNodeParams as JSON
+func (v *GetComputedStyleForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetComputedStyleForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the computed style for a DOM node identified by `nodeId`.
+func (c Client) GetComputedStyleForNode(params GetComputedStyleForNodeParams) (GetComputedStyleForNodeReturns, error) {
+	return c.GetComputedStyleForNodeContext(context.Background(), params)
+}
+
+// GetComputedStyleForNodeContext is GetComputedStyleForNode with a context for cancellation and deadlines
+func (c Client) GetComputedStyleForNodeContext(ctx context.Context, params GetComputedStyleForNodeParams) (GetComputedStyleForNodeReturns, error) {
+	var returns_ GetComputedStyleForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getComputedStyleForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ResolveValuesReturns struct {
+	Results []string `json:"results"`
+}
+
+// UnmarshalCDP reads ResolveValuesReturns from JSON
+func (v *ResolveValuesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "results":
+			if d.Array() {
+				v.Results = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Results = append(v.Results, x0)
+				}
+			} else {
+				v.Results = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResolveValuesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ResolveValuesParams are the parameters for CSS.resolveValues
+// optional parameters are left out when nil
+type ResolveValuesParams struct {
+	/* Substitution functions (var()/env()/attr()) and cascade-dependent
+	keywords (revert/revert-layer) do not work. */
+	Values []string `json:"values"`
+	/* Id of the node in whose context the expression is evaluated */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+	/* Only longhands and custom property names are accepted. */
+	PropertyName *string `json:"propertyName,omitempty"`
+	/* Pseudo element type, only works for pseudo elements that generate
+	elements in the tree, such as ::before and ::after. */
+	PseudoType *cdp.DOMPseudoType `json:"pseudoType,omitempty"`
+	/* Pseudo element custom ident. */
+	PseudoIdentifier *string `json:"pseudoIdentifier,omitempty"`
+}
+
+// MarshalCDP writes ResolveValuesParams as JSON
+func (v *ResolveValuesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("values")
+	if v.Values == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Values {
+			e.Elem()
+			e.String(v.Values[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	if v.PropertyName != nil {
+		e.Key("propertyName")
+		e.String(*v.PropertyName)
+	}
+	if v.PseudoType != nil {
+		e.Key("pseudoType")
+		e.String(string(*v.PseudoType))
+	}
+	if v.PseudoIdentifier != nil {
+		e.Key("pseudoIdentifier")
+		e.String(*v.PseudoIdentifier)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResolveValuesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Resolve the specified values in the context of the provided element.
+// For example, a value of '1em' is evaluated according to the computed
+// 'font-size' of the element and a value 'calc(1px + 2px)' will be
+// resolved to '3px'.
+// If the `propertyName` was specified the `values` are resolved as if
+// they were property's declaration. If a value cannot be parsed according
+// to the provided property syntax, the value is parsed using combined
+// syntax as if null `propertyName` was provided. If the value cannot be
+// resolved even then, return the provided value without any changes.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ResolveValues(params ResolveValuesParams) (ResolveValuesReturns, error) {
+	return c.ResolveValuesContext(context.Background(), params)
+}
+
+// ResolveValuesContext is ResolveValues with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ResolveValuesContext(ctx context.Context, params ResolveValuesParams) (ResolveValuesReturns, error) {
+	var returns_ ResolveValuesReturns
+
+	if params.PseudoType != nil && !params.PseudoType.Valid() {
+		return returns_, fmt.Errorf("CSS.resolveValues: invalid pseudoType %q", *params.PseudoType)
+	}
+
+	err_ := c.caller.Call(ctx, "CSS.resolveValues", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetLonghandPropertiesReturns struct {
+	LonghandProperties []CSSProperty `json:"longhandProperties"`
+}
+
+// UnmarshalCDP reads GetLonghandPropertiesReturns from JSON
+func (v *GetLonghandPropertiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "longhandProperties":
+			if d.Array() {
+				v.LonghandProperties = make([]CSSProperty, 0)
+				for d.More() {
+					var x0 CSSProperty
+					x0.UnmarshalCDP(d)
+					v.LonghandProperties = append(v.LonghandProperties, x0)
+				}
+			} else {
+				v.LonghandProperties = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetLonghandPropertiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetLonghandPropertiesParams are the parameters for CSS.getLonghandProperties
+// optional parameters are left out when nil
+type GetLonghandPropertiesParams struct {
+	ShorthandName string `json:"shorthandName"`
+	Value         string `json:"value"`
+}
+
+// MarshalCDP writes GetLonghandPropertiesParams as JSON
+func (v *GetLonghandPropertiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("shorthandName")
+	e.String(v.ShorthandName)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetLonghandPropertiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetLonghandProperties(params GetLonghandPropertiesParams) (GetLonghandPropertiesReturns, error) {
+	return c.GetLonghandPropertiesContext(context.Background(), params)
+}
+
+// GetLonghandPropertiesContext is GetLonghandProperties with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetLonghandPropertiesContext(ctx context.Context, params GetLonghandPropertiesParams) (GetLonghandPropertiesReturns, error) {
+	var returns_ GetLonghandPropertiesReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getLonghandProperties", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetInlineStylesForNodeReturns struct {
+
+	/* Inline style for the specified DOM node. */
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+
+	/* Attribute-defined element style (e.g. resulting from "width=20 height=100%"). */
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
+}
+
+// UnmarshalCDP reads GetInlineStylesForNodeReturns from JSON
+func (v *GetInlineStylesForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "inlineStyle":
+			if d.Null() {
+				v.InlineStyle = nil
+			} else {
+				v.InlineStyle = new(CSSStyle)
+				(*v.InlineStyle).UnmarshalCDP(d)
+			}
+		case "attributesStyle":
+			if d.Null() {
+				v.AttributesStyle = nil
+			} else {
+				v.AttributesStyle = new(CSSStyle)
+				(*v.AttributesStyle).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetInlineStylesForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetInlineStylesForNodeParams are the parameters for CSS.getInlineStylesForNode
+// optional parameters are left out when nil
+type GetInlineStylesForNodeParams struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetInlineStylesForNodeParams as JSON
+func (v *GetInlineStylesForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetInlineStylesForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the styles defined inline (explicitly in the "style" attribute and implicitly, using DOM
+// attributes) for a DOM node identified by `nodeId`.
+func (c Client) GetInlineStylesForNode(params GetInlineStylesForNodeParams) (GetInlineStylesForNodeReturns, error) {
+	return c.GetInlineStylesForNodeContext(context.Background(), params)
+}
+
+// GetInlineStylesForNodeContext is GetInlineStylesForNode with a context for cancellation and deadlines
+func (c Client) GetInlineStylesForNodeContext(ctx context.Context, params GetInlineStylesForNodeParams) (GetInlineStylesForNodeReturns, error) {
+	var returns_ GetInlineStylesForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getInlineStylesForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetAnimatedStylesForNodeReturns struct {
+
+	/* Styles coming from animations. */
+	AnimationStyles []CSSAnimationStyle `json:"animationStyles,omitempty"`
+
+	/* Style coming from transitions. */
+	TransitionsStyle *CSSStyle `json:"transitionsStyle,omitempty"`
+
+	/* Inherited style entries for animationsStyle and transitionsStyle from
+	the inheritance chain of the element. */
+	Inherited []InheritedAnimatedStyleEntry `json:"inherited,omitempty"`
+}
+
+// UnmarshalCDP reads GetAnimatedStylesForNodeReturns from JSON
+func (v *GetAnimatedStylesForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "animationStyles":
+			if d.Array() {
+				v.AnimationStyles = make([]CSSAnimationStyle, 0)
+				for d.More() {
+					var x0 CSSAnimationStyle
+					x0.UnmarshalCDP(d)
+					v.AnimationStyles = append(v.AnimationStyles, x0)
+				}
+			} else {
+				v.AnimationStyles = nil
+			}
+		case "transitionsStyle":
+			if d.Null() {
+				v.TransitionsStyle = nil
+			} else {
+				v.TransitionsStyle = new(CSSStyle)
+				(*v.TransitionsStyle).UnmarshalCDP(d)
+			}
+		case "inherited":
+			if d.Array() {
+				v.Inherited = make([]InheritedAnimatedStyleEntry, 0)
+				for d.More() {
+					var x0 InheritedAnimatedStyleEntry
+					x0.UnmarshalCDP(d)
+					v.Inherited = append(v.Inherited, x0)
+				}
+			} else {
+				v.Inherited = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAnimatedStylesForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAnimatedStylesForNodeParams are the parameters for CSS.getAnimatedStylesForNode
+// optional parameters are left out when nil
+type GetAnimatedStylesForNodeParams struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetAnimatedStylesForNodeParams as JSON
+func (v *GetAnimatedStylesForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAnimatedStylesForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the styles coming from animations & transitions
+// including the animation & transition styles coming from inheritance chain.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAnimatedStylesForNode(params GetAnimatedStylesForNodeParams) (GetAnimatedStylesForNodeReturns, error) {
+	return c.GetAnimatedStylesForNodeContext(context.Background(), params)
+}
+
+// GetAnimatedStylesForNodeContext is GetAnimatedStylesForNode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAnimatedStylesForNodeContext(ctx context.Context, params GetAnimatedStylesForNodeParams) (GetAnimatedStylesForNodeReturns, error) {
+	var returns_ GetAnimatedStylesForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getAnimatedStylesForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetMatchedStylesForNodeReturns struct {
+
+	/* Inline style for the specified DOM node. */
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
+
+	/* Attribute-defined element style (e.g. resulting from "width=20 height=100%"). */
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
+
+	/* CSS rules matching this node, from all applicable stylesheets. */
+	MatchedCSSRules []RuleMatch `json:"matchedCSSRules,omitempty"`
+
+	/* Pseudo style matches for this node. */
+	PseudoElements []PseudoElementMatches `json:"pseudoElements,omitempty"`
+
+	/* A chain of inherited styles (from the immediate node parent up to the DOM tree root). */
+	Inherited []InheritedStyleEntry `json:"inherited,omitempty"`
+
+	/* A chain of inherited pseudo element styles (from the immediate node parent up to the DOM tree root). */
+	InheritedPseudoElements []InheritedPseudoElementMatches `json:"inheritedPseudoElements,omitempty"`
+
+	/* A list of CSS keyframed animations matching this node. */
+	CssKeyframesRules []CSSKeyframesRule `json:"cssKeyframesRules,omitempty"`
+
+	/* A list of CSS @position-try rules matching this node, based on the position-try-fallbacks property. */
+	CssPositionTryRules []CSSPositionTryRule `json:"cssPositionTryRules,omitempty"`
+
+	/* Index of the active fallback in the applied position-try-fallback property,
+	will not be set if there is no active position-try fallback. */
+	ActivePositionFallbackIndex *int `json:"activePositionFallbackIndex,omitempty"`
+
+	/* A list of CSS at-property rules matching this node. */
+	CssPropertyRules []CSSPropertyRule `json:"cssPropertyRules,omitempty"`
+
+	/* A list of CSS property registrations matching this node. */
+	CssPropertyRegistrations []CSSPropertyRegistration `json:"cssPropertyRegistrations,omitempty"`
+
+	/* A font-palette-values rule matching this node. */
+	CssFontPaletteValuesRule *CSSFontPaletteValuesRule `json:"cssFontPaletteValuesRule,omitempty"`
+
+	/* Id of the first parent element that does not have display: contents.
+
+	Experimental: this may change or be removed in any chrome release */
+	ParentLayoutNodeId *cdp.DOMNodeId `json:"parentLayoutNodeId,omitempty"`
+
+	/* A list of CSS at-function rules referenced by styles of this node.
+
+	Experimental: this may change or be removed in any chrome release */
+	CssFunctionRules []CSSFunctionRule `json:"cssFunctionRules,omitempty"`
+}
+
+// UnmarshalCDP reads GetMatchedStylesForNodeReturns from JSON
+func (v *GetMatchedStylesForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "inlineStyle":
+			if d.Null() {
+				v.InlineStyle = nil
+			} else {
+				v.InlineStyle = new(CSSStyle)
+				(*v.InlineStyle).UnmarshalCDP(d)
+			}
+		case "attributesStyle":
+			if d.Null() {
+				v.AttributesStyle = nil
+			} else {
+				v.AttributesStyle = new(CSSStyle)
+				(*v.AttributesStyle).UnmarshalCDP(d)
+			}
+		case "matchedCSSRules":
+			if d.Array() {
+				v.MatchedCSSRules = make([]RuleMatch, 0)
+				for d.More() {
+					var x0 RuleMatch
+					x0.UnmarshalCDP(d)
+					v.MatchedCSSRules = append(v.MatchedCSSRules, x0)
+				}
+			} else {
+				v.MatchedCSSRules = nil
+			}
+		case "pseudoElements":
+			if d.Array() {
+				v.PseudoElements = make([]PseudoElementMatches, 0)
+				for d.More() {
+					var x0 PseudoElementMatches
+					x0.UnmarshalCDP(d)
+					v.PseudoElements = append(v.PseudoElements, x0)
+				}
+			} else {
+				v.PseudoElements = nil
+			}
+		case "inherited":
+			if d.Array() {
+				v.Inherited = make([]InheritedStyleEntry, 0)
+				for d.More() {
+					var x0 InheritedStyleEntry
+					x0.UnmarshalCDP(d)
+					v.Inherited = append(v.Inherited, x0)
+				}
+			} else {
+				v.Inherited = nil
+			}
+		case "inheritedPseudoElements":
+			if d.Array() {
+				v.InheritedPseudoElements = make([]InheritedPseudoElementMatches, 0)
+				for d.More() {
+					var x0 InheritedPseudoElementMatches
+					x0.UnmarshalCDP(d)
+					v.InheritedPseudoElements = append(v.InheritedPseudoElements, x0)
+				}
+			} else {
+				v.InheritedPseudoElements = nil
+			}
+		case "cssKeyframesRules":
+			if d.Array() {
+				v.CssKeyframesRules = make([]CSSKeyframesRule, 0)
+				for d.More() {
+					var x0 CSSKeyframesRule
+					x0.UnmarshalCDP(d)
+					v.CssKeyframesRules = append(v.CssKeyframesRules, x0)
+				}
+			} else {
+				v.CssKeyframesRules = nil
+			}
+		case "cssPositionTryRules":
+			if d.Array() {
+				v.CssPositionTryRules = make([]CSSPositionTryRule, 0)
+				for d.More() {
+					var x0 CSSPositionTryRule
+					x0.UnmarshalCDP(d)
+					v.CssPositionTryRules = append(v.CssPositionTryRules, x0)
+				}
+			} else {
+				v.CssPositionTryRules = nil
+			}
+		case "activePositionFallbackIndex":
+			if d.Null() {
+				v.ActivePositionFallbackIndex = nil
+			} else {
+				v.ActivePositionFallbackIndex = new(int)
+				*v.ActivePositionFallbackIndex = d.Int()
+			}
+		case "cssPropertyRules":
+			if d.Array() {
+				v.CssPropertyRules = make([]CSSPropertyRule, 0)
+				for d.More() {
+					var x0 CSSPropertyRule
+					x0.UnmarshalCDP(d)
+					v.CssPropertyRules = append(v.CssPropertyRules, x0)
+				}
+			} else {
+				v.CssPropertyRules = nil
+			}
+		case "cssPropertyRegistrations":
+			if d.Array() {
+				v.CssPropertyRegistrations = make([]CSSPropertyRegistration, 0)
+				for d.More() {
+					var x0 CSSPropertyRegistration
+					x0.UnmarshalCDP(d)
+					v.CssPropertyRegistrations = append(v.CssPropertyRegistrations, x0)
+				}
+			} else {
+				v.CssPropertyRegistrations = nil
+			}
+		case "cssFontPaletteValuesRule":
+			if d.Null() {
+				v.CssFontPaletteValuesRule = nil
+			} else {
+				v.CssFontPaletteValuesRule = new(CSSFontPaletteValuesRule)
+				(*v.CssFontPaletteValuesRule).UnmarshalCDP(d)
+			}
+		case "parentLayoutNodeId":
+			if d.Null() {
+				v.ParentLayoutNodeId = nil
+			} else {
+				v.ParentLayoutNodeId = new(cdp.DOMNodeId)
+				*v.ParentLayoutNodeId = cdp.DOMNodeId(d.Int())
+			}
+		case "cssFunctionRules":
+			if d.Array() {
+				v.CssFunctionRules = make([]CSSFunctionRule, 0)
+				for d.More() {
+					var x0 CSSFunctionRule
+					x0.UnmarshalCDP(d)
+					v.CssFunctionRules = append(v.CssFunctionRules, x0)
+				}
+			} else {
+				v.CssFunctionRules = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetMatchedStylesForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetMatchedStylesForNodeParams are the parameters for CSS.getMatchedStylesForNode
+// optional parameters are left out when nil
+type GetMatchedStylesForNodeParams struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetMatchedStylesForNodeParams as JSON
+func (v *GetMatchedStylesForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetMatchedStylesForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns requested styles for a DOM node identified by `nodeId`.
+func (c Client) GetMatchedStylesForNode(params GetMatchedStylesForNodeParams) (GetMatchedStylesForNodeReturns, error) {
+	return c.GetMatchedStylesForNodeContext(context.Background(), params)
+}
+
+// GetMatchedStylesForNodeContext is GetMatchedStylesForNode with a context for cancellation and deadlines
+func (c Client) GetMatchedStylesForNodeContext(ctx context.Context, params GetMatchedStylesForNodeParams) (GetMatchedStylesForNodeReturns, error) {
+	var returns_ GetMatchedStylesForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getMatchedStylesForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetEnvironmentVariablesReturns struct {
+	EnvironmentVariables map[string]interface{} `json:"environmentVariables"`
+}
+
+// UnmarshalCDP reads GetEnvironmentVariablesReturns from JSON
+func (v *GetEnvironmentVariablesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "environmentVariables":
+			v.EnvironmentVariables = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetEnvironmentVariablesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the values of the default UA-defined environment variables used in env()
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetEnvironmentVariables() (GetEnvironmentVariablesReturns, error) {
+	return c.GetEnvironmentVariablesContext(context.Background())
+}
+
+// GetEnvironmentVariablesContext is GetEnvironmentVariables with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetEnvironmentVariablesContext(ctx context.Context) (GetEnvironmentVariablesReturns, error) {
+	var returns_ GetEnvironmentVariablesReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getEnvironmentVariables", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetMediaQueriesReturns struct {
+	Medias []CSSMedia `json:"medias"`
+}
+
+// UnmarshalCDP reads GetMediaQueriesReturns from JSON
+func (v *GetMediaQueriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "medias":
+			if d.Array() {
+				v.Medias = make([]CSSMedia, 0)
+				for d.More() {
+					var x0 CSSMedia
+					x0.UnmarshalCDP(d)
+					v.Medias = append(v.Medias, x0)
+				}
+			} else {
+				v.Medias = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetMediaQueriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns all media queries parsed by the rendering engine.
+func (c Client) GetMediaQueries() (GetMediaQueriesReturns, error) {
+	return c.GetMediaQueriesContext(context.Background())
+}
+
+// GetMediaQueriesContext is GetMediaQueries with a context for cancellation and deadlines
+func (c Client) GetMediaQueriesContext(ctx context.Context) (GetMediaQueriesReturns, error) {
+	var returns_ GetMediaQueriesReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getMediaQueries", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetPlatformFontsForNodeReturns struct {
+
+	/* Usage statistics for every employed platform font. */
+	Fonts []PlatformFontUsage `json:"fonts"`
+}
+
+// UnmarshalCDP reads GetPlatformFontsForNodeReturns from JSON
+func (v *GetPlatformFontsForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "fonts":
+			if d.Array() {
+				v.Fonts = make([]PlatformFontUsage, 0)
+				for d.More() {
+					var x0 PlatformFontUsage
+					x0.UnmarshalCDP(d)
+					v.Fonts = append(v.Fonts, x0)
+				}
+			} else {
+				v.Fonts = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetPlatformFontsForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetPlatformFontsForNodeParams are the parameters for CSS.getPlatformFontsForNode
+// optional parameters are left out when nil
+type GetPlatformFontsForNodeParams struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetPlatformFontsForNodeParams as JSON
+func (v *GetPlatformFontsForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetPlatformFontsForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests information about platform fonts which we used to render child TextNodes in the given
+// node.
+func (c Client) GetPlatformFontsForNode(params GetPlatformFontsForNodeParams) (GetPlatformFontsForNodeReturns, error) {
+	return c.GetPlatformFontsForNodeContext(context.Background(), params)
+}
+
+// GetPlatformFontsForNodeContext is GetPlatformFontsForNode with a context for cancellation and deadlines
+func (c Client) GetPlatformFontsForNodeContext(ctx context.Context, params GetPlatformFontsForNodeParams) (GetPlatformFontsForNodeReturns, error) {
+	var returns_ GetPlatformFontsForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getPlatformFontsForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetStyleSheetTextReturns struct {
+
+	/* The stylesheet text. */
+	Text string `json:"text"`
+}
+
+// UnmarshalCDP reads GetStyleSheetTextReturns from JSON
+func (v *GetStyleSheetTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "text":
+			v.Text = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetStyleSheetTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetStyleSheetTextParams are the parameters for CSS.getStyleSheetText
+// optional parameters are left out when nil
+type GetStyleSheetTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+}
+
+// MarshalCDP writes GetStyleSheetTextParams as JSON
+func (v *GetStyleSheetTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetStyleSheetTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the current textual content for a stylesheet.
+func (c Client) GetStyleSheetText(params GetStyleSheetTextParams) (GetStyleSheetTextReturns, error) {
+	return c.GetStyleSheetTextContext(context.Background(), params)
+}
+
+// GetStyleSheetTextContext is GetStyleSheetText with a context for cancellation and deadlines
+func (c Client) GetStyleSheetTextContext(ctx context.Context, params GetStyleSheetTextParams) (GetStyleSheetTextReturns, error) {
+	var returns_ GetStyleSheetTextReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getStyleSheetText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetLayersForNodeReturns struct {
+	RootLayer CSSLayerData `json:"rootLayer"`
+}
+
+// UnmarshalCDP reads GetLayersForNodeReturns from JSON
+func (v *GetLayersForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "rootLayer":
+			v.RootLayer.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetLayersForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetLayersForNodeParams are the parameters for CSS.getLayersForNode
+// optional parameters are left out when nil
+type GetLayersForNodeParams struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetLayersForNodeParams as JSON
+func (v *GetLayersForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetLayersForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns all layers parsed by the rendering engine for the tree scope of a node.
+// Given a DOM element identified by nodeId, getLayersForNode returns the root
+// layer for the nearest ancestor document or shadow root. The layer root contains
+// the full layer tree for the tree scope and their ordering.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetLayersForNode(params GetLayersForNodeParams) (GetLayersForNodeReturns, error) {
+	return c.GetLayersForNodeContext(context.Background(), params)
+}
+
+// GetLayersForNodeContext is GetLayersForNode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetLayersForNodeContext(ctx context.Context, params GetLayersForNodeParams) (GetLayersForNodeReturns, error) {
+	var returns_ GetLayersForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getLayersForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetLocationForSelectorReturns struct {
+	Ranges []SourceRange `json:"ranges"`
+}
+
+// UnmarshalCDP reads GetLocationForSelectorReturns from JSON
+func (v *GetLocationForSelectorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "ranges":
+			if d.Array() {
+				v.Ranges = make([]SourceRange, 0)
+				for d.More() {
+					var x0 SourceRange
+					x0.UnmarshalCDP(d)
+					v.Ranges = append(v.Ranges, x0)
+				}
+			} else {
+				v.Ranges = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetLocationForSelectorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetLocationForSelectorParams are the parameters for CSS.getLocationForSelector
+// optional parameters are left out when nil
+type GetLocationForSelectorParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	SelectorText string       `json:"selectorText"`
+}
+
+// MarshalCDP writes GetLocationForSelectorParams as JSON
+func (v *GetLocationForSelectorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("selectorText")
+	e.String(v.SelectorText)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetLocationForSelectorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Given a CSS selector text and a style sheet ID, getLocationForSelector
+// returns an array of locations of the CSS selector in the style sheet.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetLocationForSelector(params GetLocationForSelectorParams) (GetLocationForSelectorReturns, error) {
+	return c.GetLocationForSelectorContext(context.Background(), params)
+}
+
+// GetLocationForSelectorContext is GetLocationForSelector with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetLocationForSelectorContext(ctx context.Context, params GetLocationForSelectorParams) (GetLocationForSelectorReturns, error) {
+	var returns_ GetLocationForSelectorReturns
+
+	err_ := c.caller.Call(ctx, "CSS.getLocationForSelector", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TrackComputedStyleUpdatesForNodeReturns struct {
+}
+
+// UnmarshalCDP reads TrackComputedStyleUpdatesForNodeReturns from JSON
+func (v *TrackComputedStyleUpdatesForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrackComputedStyleUpdatesForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TrackComputedStyleUpdatesForNodeParams are the parameters for CSS.trackComputedStyleUpdatesForNode
+// optional parameters are left out when nil
+type TrackComputedStyleUpdatesForNodeParams struct {
+	NodeId *cdp.DOMNodeId `json:"nodeId,omitempty"`
+}
+
+// MarshalCDP writes TrackComputedStyleUpdatesForNodeParams as JSON
+func (v *TrackComputedStyleUpdatesForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrackComputedStyleUpdatesForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Starts tracking the given node for the computed style updates
+// and whenever the computed style is updated for node, it queues
+// a `computedStyleUpdated` event with throttling.
+// There can only be 1 node tracked for computed style updates
+// so passing a new node id removes tracking from the previous node.
+// Pass `undefined` to disable tracking.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TrackComputedStyleUpdatesForNode(params TrackComputedStyleUpdatesForNodeParams) (TrackComputedStyleUpdatesForNodeReturns, error) {
+	return c.TrackComputedStyleUpdatesForNodeContext(context.Background(), params)
+}
+
+// TrackComputedStyleUpdatesForNodeContext is TrackComputedStyleUpdatesForNode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TrackComputedStyleUpdatesForNodeContext(ctx context.Context, params TrackComputedStyleUpdatesForNodeParams) (TrackComputedStyleUpdatesForNodeReturns, error) {
+	var returns_ TrackComputedStyleUpdatesForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.trackComputedStyleUpdatesForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TrackComputedStyleUpdatesReturns struct {
+}
+
+// UnmarshalCDP reads TrackComputedStyleUpdatesReturns from JSON
+func (v *TrackComputedStyleUpdatesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrackComputedStyleUpdatesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TrackComputedStyleUpdatesParams are the parameters for CSS.trackComputedStyleUpdates
+// optional parameters are left out when nil
+type TrackComputedStyleUpdatesParams struct {
+	PropertiesToTrack []CSSComputedStyleProperty `json:"propertiesToTrack"`
+}
+
+// MarshalCDP writes TrackComputedStyleUpdatesParams as JSON
+func (v *TrackComputedStyleUpdatesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("propertiesToTrack")
+	if v.PropertiesToTrack == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.PropertiesToTrack {
+			e.Elem()
+			v.PropertiesToTrack[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrackComputedStyleUpdatesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Starts tracking the given computed styles for updates. The specified array of properties
+// replaces the one previously specified. Pass empty array to disable tracking.
+// Use takeComputedStyleUpdates to retrieve the list of nodes that had properties modified.
+// The changes to computed style properties are only tracked for nodes pushed to the front-end
+// by the DOM agent. If no changes to the tracked properties occur after the node has been pushed
+// to the front-end, no updates will be issued for the node.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TrackComputedStyleUpdates(params TrackComputedStyleUpdatesParams) (TrackComputedStyleUpdatesReturns, error) {
+	return c.TrackComputedStyleUpdatesContext(context.Background(), params)
+}
+
+// TrackComputedStyleUpdatesContext is TrackComputedStyleUpdates with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TrackComputedStyleUpdatesContext(ctx context.Context, params TrackComputedStyleUpdatesParams) (TrackComputedStyleUpdatesReturns, error) {
+	var returns_ TrackComputedStyleUpdatesReturns
+
+	err_ := c.caller.Call(ctx, "CSS.trackComputedStyleUpdates", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TakeComputedStyleUpdatesReturns struct {
+
+	/* The list of node Ids that have their tracked computed styles updated. */
+	NodeIds []cdp.DOMNodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads TakeComputedStyleUpdatesReturns from JSON
+func (v *TakeComputedStyleUpdatesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]cdp.DOMNodeId, 0)
+				for d.More() {
+					var x0 cdp.DOMNodeId
+					x0 = cdp.DOMNodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TakeComputedStyleUpdatesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Polls the next batch of computed style updates.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TakeComputedStyleUpdates() (TakeComputedStyleUpdatesReturns, error) {
+	return c.TakeComputedStyleUpdatesContext(context.Background())
+}
+
+// TakeComputedStyleUpdatesContext is TakeComputedStyleUpdates with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TakeComputedStyleUpdatesContext(ctx context.Context) (TakeComputedStyleUpdatesReturns, error) {
+	var returns_ TakeComputedStyleUpdatesReturns
+
+	err_ := c.caller.Call(ctx, "CSS.takeComputedStyleUpdates", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetEffectivePropertyValueForNodeReturns struct {
+}
+
+// UnmarshalCDP reads SetEffectivePropertyValueForNodeReturns from JSON
+func (v *SetEffectivePropertyValueForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetEffectivePropertyValueForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetEffectivePropertyValueForNodeParams are the parameters for CSS.setEffectivePropertyValueForNode
+// optional parameters are left out when nil
+type SetEffectivePropertyValueForNodeParams struct {
+	/* The element id for which to set property. */
+	NodeId       cdp.DOMNodeId `json:"nodeId"`
+	PropertyName string        `json:"propertyName"`
+	Value        string        `json:"value"`
+}
+
+// MarshalCDP writes SetEffectivePropertyValueForNodeParams as JSON
+func (v *SetEffectivePropertyValueForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("propertyName")
+	e.String(v.PropertyName)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetEffectivePropertyValueForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Find a rule with the given active property for the given node and set the new value for this
+// property
+func (c Client) SetEffectivePropertyValueForNode(params SetEffectivePropertyValueForNodeParams) (SetEffectivePropertyValueForNodeReturns, error) {
+	return c.SetEffectivePropertyValueForNodeContext(context.Background(), params)
+}
+
+// SetEffectivePropertyValueForNodeContext is SetEffectivePropertyValueForNode with a context for cancellation and deadlines
+func (c Client) SetEffectivePropertyValueForNodeContext(ctx context.Context, params SetEffectivePropertyValueForNodeParams) (SetEffectivePropertyValueForNodeReturns, error) {
+	var returns_ SetEffectivePropertyValueForNodeReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setEffectivePropertyValueForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPropertyRulePropertyNameReturns struct {
+
+	/* The resulting key text after modification. */
+	PropertyName Value `json:"propertyName"`
+}
+
+// UnmarshalCDP reads SetPropertyRulePropertyNameReturns from JSON
+func (v *SetPropertyRulePropertyNameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "propertyName":
+			v.PropertyName.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPropertyRulePropertyNameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPropertyRulePropertyNameParams are the parameters for CSS.setPropertyRulePropertyName
+// optional parameters are left out when nil
+type SetPropertyRulePropertyNameParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	PropertyName string       `json:"propertyName"`
+}
+
+// MarshalCDP writes SetPropertyRulePropertyNameParams as JSON
+func (v *SetPropertyRulePropertyNameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("propertyName")
+	e.String(v.PropertyName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPropertyRulePropertyNameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the property rule property name.
+func (c Client) SetPropertyRulePropertyName(params SetPropertyRulePropertyNameParams) (SetPropertyRulePropertyNameReturns, error) {
+	return c.SetPropertyRulePropertyNameContext(context.Background(), params)
+}
+
+// SetPropertyRulePropertyNameContext is SetPropertyRulePropertyName with a context for cancellation and deadlines
+func (c Client) SetPropertyRulePropertyNameContext(ctx context.Context, params SetPropertyRulePropertyNameParams) (SetPropertyRulePropertyNameReturns, error) {
+	var returns_ SetPropertyRulePropertyNameReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setPropertyRulePropertyName", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetKeyframeKeyReturns struct {
+
+	/* The resulting key text after modification. */
+	KeyText Value `json:"keyText"`
+}
+
+// UnmarshalCDP reads SetKeyframeKeyReturns from JSON
+func (v *SetKeyframeKeyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "keyText":
+			v.KeyText.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetKeyframeKeyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetKeyframeKeyParams are the parameters for CSS.setKeyframeKey
+// optional parameters are left out when nil
+type SetKeyframeKeyParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	KeyText      string       `json:"keyText"`
+}
+
+// MarshalCDP writes SetKeyframeKeyParams as JSON
+func (v *SetKeyframeKeyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("keyText")
+	e.String(v.KeyText)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetKeyframeKeyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the keyframe rule key text.
+func (c Client) SetKeyframeKey(params SetKeyframeKeyParams) (SetKeyframeKeyReturns, error) {
+	return c.SetKeyframeKeyContext(context.Background(), params)
+}
+
+// SetKeyframeKeyContext is SetKeyframeKey with a context for cancellation and deadlines
+func (c Client) SetKeyframeKeyContext(ctx context.Context, params SetKeyframeKeyParams) (SetKeyframeKeyReturns, error) {
+	var returns_ SetKeyframeKeyReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setKeyframeKey", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetMediaTextReturns struct {
+
+	/* The resulting CSS media rule after modification. */
+	Media CSSMedia `json:"media"`
+}
+
+// UnmarshalCDP reads SetMediaTextReturns from JSON
+func (v *SetMediaTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "media":
+			v.Media.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetMediaTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetMediaTextParams are the parameters for CSS.setMediaText
+// optional parameters are left out when nil
+type SetMediaTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// MarshalCDP writes SetMediaTextParams as JSON
+func (v *SetMediaTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetMediaTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the rule selector.
+func (c Client) SetMediaText(params SetMediaTextParams) (SetMediaTextReturns, error) {
+	return c.SetMediaTextContext(context.Background(), params)
+}
+
+// SetMediaTextContext is SetMediaText with a context for cancellation and deadlines
+func (c Client) SetMediaTextContext(ctx context.Context, params SetMediaTextParams) (SetMediaTextReturns, error) {
+	var returns_ SetMediaTextReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setMediaText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetContainerQueryTextReturns struct {
+
+	/* The resulting CSS container query rule after modification. */
+	ContainerQuery CSSContainerQuery `json:"containerQuery"`
+}
+
+// UnmarshalCDP reads SetContainerQueryTextReturns from JSON
+func (v *SetContainerQueryTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "containerQuery":
+			v.ContainerQuery.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetContainerQueryTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetContainerQueryTextParams are the parameters for CSS.setContainerQueryText
+// optional parameters are left out when nil
+type SetContainerQueryTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// MarshalCDP writes SetContainerQueryTextParams as JSON
+func (v *SetContainerQueryTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetContainerQueryTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the expression of a container query.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetContainerQueryText(params SetContainerQueryTextParams) (SetContainerQueryTextReturns, error) {
+	return c.SetContainerQueryTextContext(context.Background(), params)
+}
+
+// SetContainerQueryTextContext is SetContainerQueryText with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetContainerQueryTextContext(ctx context.Context, params SetContainerQueryTextParams) (SetContainerQueryTextReturns, error) {
+	var returns_ SetContainerQueryTextReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setContainerQueryText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSupportsTextReturns struct {
+
+	/* The resulting CSS Supports rule after modification. */
+	Supports CSSSupports `json:"supports"`
+}
+
+// UnmarshalCDP reads SetSupportsTextReturns from JSON
+func (v *SetSupportsTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "supports":
+			v.Supports.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSupportsTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSupportsTextParams are the parameters for CSS.setSupportsText
+// optional parameters are left out when nil
+type SetSupportsTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// MarshalCDP writes SetSupportsTextParams as JSON
+func (v *SetSupportsTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSupportsTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the expression of a supports at-rule.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSupportsText(params SetSupportsTextParams) (SetSupportsTextReturns, error) {
+	return c.SetSupportsTextContext(context.Background(), params)
+}
+
+// SetSupportsTextContext is SetSupportsText with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSupportsTextContext(ctx context.Context, params SetSupportsTextParams) (SetSupportsTextReturns, error) {
+	var returns_ SetSupportsTextReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setSupportsText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetScopeTextReturns struct {
+
+	/* The resulting CSS Scope rule after modification. */
+	Scope CSSScope `json:"scope"`
+}
+
+// UnmarshalCDP reads SetScopeTextReturns from JSON
+func (v *SetScopeTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scope":
+			v.Scope.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetScopeTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetScopeTextParams are the parameters for CSS.setScopeText
+// optional parameters are left out when nil
+type SetScopeTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Text         string       `json:"text"`
+}
+
+// MarshalCDP writes SetScopeTextParams as JSON
+func (v *SetScopeTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetScopeTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the expression of a scope at-rule.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetScopeText(params SetScopeTextParams) (SetScopeTextReturns, error) {
+	return c.SetScopeTextContext(context.Background(), params)
+}
+
+// SetScopeTextContext is SetScopeText with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetScopeTextContext(ctx context.Context, params SetScopeTextParams) (SetScopeTextReturns, error) {
+	var returns_ SetScopeTextReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setScopeText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetRuleSelectorReturns struct {
+
+	/* The resulting selector list after modification. */
+	SelectorList SelectorList `json:"selectorList"`
+}
+
+// UnmarshalCDP reads SetRuleSelectorReturns from JSON
+func (v *SetRuleSelectorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "selectorList":
+			v.SelectorList.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetRuleSelectorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetRuleSelectorParams are the parameters for CSS.setRuleSelector
+// optional parameters are left out when nil
+type SetRuleSelectorParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Range        SourceRange  `json:"range"`
+	Selector     string       `json:"selector"`
+}
+
+// MarshalCDP writes SetRuleSelectorParams as JSON
+func (v *SetRuleSelectorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("range")
+	v.Range.MarshalCDP(e)
+	e.Key("selector")
+	e.String(v.Selector)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetRuleSelectorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Modifies the rule selector.
+func (c Client) SetRuleSelector(params SetRuleSelectorParams) (SetRuleSelectorReturns, error) {
+	return c.SetRuleSelectorContext(context.Background(), params)
+}
+
+// SetRuleSelectorContext is SetRuleSelector with a context for cancellation and deadlines
+func (c Client) SetRuleSelectorContext(ctx context.Context, params SetRuleSelectorParams) (SetRuleSelectorReturns, error) {
+	var returns_ SetRuleSelectorReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setRuleSelector", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetStyleSheetTextReturns struct {
+
+	/* URL of source map associated with script (if any). */
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
+}
+
+// UnmarshalCDP reads SetStyleSheetTextReturns from JSON
+func (v *SetStyleSheetTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sourceMapURL":
+			if d.Null() {
+				v.SourceMapURL = nil
+			} else {
+				v.SourceMapURL = new(string)
+				*v.SourceMapURL = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetStyleSheetTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetStyleSheetTextParams are the parameters for CSS.setStyleSheetText
+// optional parameters are left out when nil
+type SetStyleSheetTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+	Text         string       `json:"text"`
+}
+
+// MarshalCDP writes SetStyleSheetTextParams as JSON
+func (v *SetStyleSheetTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("styleSheetId")
+	e.String(string(v.StyleSheetId))
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetStyleSheetTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets the new stylesheet text.
+func (c Client) SetStyleSheetText(params SetStyleSheetTextParams) (SetStyleSheetTextReturns, error) {
+	return c.SetStyleSheetTextContext(context.Background(), params)
+}
+
+// SetStyleSheetTextContext is SetStyleSheetText with a context for cancellation and deadlines
+func (c Client) SetStyleSheetTextContext(ctx context.Context, params SetStyleSheetTextParams) (SetStyleSheetTextReturns, error) {
+	var returns_ SetStyleSheetTextReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setStyleSheetText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetStyleTextsReturns struct {
+
+	/* The resulting styles after modification. */
+	Styles []CSSStyle `json:"styles"`
+}
+
+// UnmarshalCDP reads SetStyleTextsReturns from JSON
+func (v *SetStyleTextsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styles":
+			if d.Array() {
+				v.Styles = make([]CSSStyle, 0)
+				for d.More() {
+					var x0 CSSStyle
+					x0.UnmarshalCDP(d)
+					v.Styles = append(v.Styles, x0)
+				}
+			} else {
+				v.Styles = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetStyleTextsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetStyleTextsParams are the parameters for CSS.setStyleTexts
+// optional parameters are left out when nil
+type SetStyleTextsParams struct {
+	Edits []StyleDeclarationEdit `json:"edits"`
+	/* NodeId for the DOM node in whose context custom property declarations for registered properties should be
+	validated. If omitted, declarations in the new rule text can only be validated statically, which may produce
+	incorrect results if the declaration contains a var() for example.
+
+	Experimental: this may change or be removed in any chrome release */
+	NodeForPropertySyntaxValidation *cdp.DOMNodeId `json:"nodeForPropertySyntaxValidation,omitempty"`
+}
+
+// MarshalCDP writes SetStyleTextsParams as JSON
+func (v *SetStyleTextsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("edits")
+	if v.Edits == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Edits {
+			e.Elem()
+			v.Edits[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.NodeForPropertySyntaxValidation != nil {
+		e.Key("nodeForPropertySyntaxValidation")
+		e.Int(int(*v.NodeForPropertySyntaxValidation))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetStyleTextsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Applies specified style edits one after another in the given order.
+func (c Client) SetStyleTexts(params SetStyleTextsParams) (SetStyleTextsReturns, error) {
+	return c.SetStyleTextsContext(context.Background(), params)
+}
+
+// SetStyleTextsContext is SetStyleTexts with a context for cancellation and deadlines
+func (c Client) SetStyleTextsContext(ctx context.Context, params SetStyleTextsParams) (SetStyleTextsReturns, error) {
+	var returns_ SetStyleTextsReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setStyleTexts", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartRuleUsageTrackingReturns struct {
+}
+
+// UnmarshalCDP reads StartRuleUsageTrackingReturns from JSON
+func (v *StartRuleUsageTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartRuleUsageTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables the selector recording.
+func (c Client) StartRuleUsageTracking() (StartRuleUsageTrackingReturns, error) {
+	return c.StartRuleUsageTrackingContext(context.Background())
+}
+
+// StartRuleUsageTrackingContext is StartRuleUsageTracking with a context for cancellation and deadlines
+func (c Client) StartRuleUsageTrackingContext(ctx context.Context) (StartRuleUsageTrackingReturns, error) {
+	var returns_ StartRuleUsageTrackingReturns
+
+	err_ := c.caller.Call(ctx, "CSS.startRuleUsageTracking", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StopRuleUsageTrackingReturns struct {
+	RuleUsage []RuleUsage `json:"ruleUsage"`
+}
+
+// UnmarshalCDP reads StopRuleUsageTrackingReturns from JSON
+func (v *StopRuleUsageTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "ruleUsage":
+			if d.Array() {
+				v.RuleUsage = make([]RuleUsage, 0)
+				for d.More() {
+					var x0 RuleUsage
+					x0.UnmarshalCDP(d)
+					v.RuleUsage = append(v.RuleUsage, x0)
+				}
+			} else {
+				v.RuleUsage = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopRuleUsageTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stop tracking rule usage and return the list of rules that were used since last call to
+// `takeCoverageDelta` (or since start of coverage instrumentation).
+func (c Client) StopRuleUsageTracking() (StopRuleUsageTrackingReturns, error) {
+	return c.StopRuleUsageTrackingContext(context.Background())
+}
+
+// StopRuleUsageTrackingContext is StopRuleUsageTracking with a context for cancellation and deadlines
+func (c Client) StopRuleUsageTrackingContext(ctx context.Context) (StopRuleUsageTrackingReturns, error) {
+	var returns_ StopRuleUsageTrackingReturns
+
+	err_ := c.caller.Call(ctx, "CSS.stopRuleUsageTracking", nil, &returns_)
+
+	return returns_, err_
+}
+
+type TakeCoverageDeltaReturns struct {
+	Coverage []RuleUsage `json:"coverage"`
+
+	/* Monotonically increasing time, in seconds. */
+	Timestamp float64 `json:"timestamp"`
+}
+
+// UnmarshalCDP reads TakeCoverageDeltaReturns from JSON
+func (v *TakeCoverageDeltaReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "coverage":
+			if d.Array() {
+				v.Coverage = make([]RuleUsage, 0)
+				for d.More() {
+					var x0 RuleUsage
+					x0.UnmarshalCDP(d)
+					v.Coverage = append(v.Coverage, x0)
+				}
+			} else {
+				v.Coverage = nil
+			}
+		case "timestamp":
+			v.Timestamp = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TakeCoverageDeltaReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Obtain list of rules that became used since last call to this method (or since start of coverage
+// instrumentation).
+func (c Client) TakeCoverageDelta() (TakeCoverageDeltaReturns, error) {
+	return c.TakeCoverageDeltaContext(context.Background())
+}
+
+// TakeCoverageDeltaContext is TakeCoverageDelta with a context for cancellation and deadlines
+func (c Client) TakeCoverageDeltaContext(ctx context.Context) (TakeCoverageDeltaReturns, error) {
+	var returns_ TakeCoverageDeltaReturns
+
+	err_ := c.caller.Call(ctx, "CSS.takeCoverageDelta", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetLocalFontsEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetLocalFontsEnabledReturns from JSON
+func (v *SetLocalFontsEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetLocalFontsEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetLocalFontsEnabledParams are the parameters for CSS.setLocalFontsEnabled
+// optional parameters are left out when nil
+type SetLocalFontsEnabledParams struct {
+	/* Whether rendering of local fonts is enabled. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetLocalFontsEnabledParams as JSON
+func (v *SetLocalFontsEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetLocalFontsEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables/disables rendering of local CSS fonts (enabled by default).
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetLocalFontsEnabled(params SetLocalFontsEnabledParams) (SetLocalFontsEnabledReturns, error) {
+	return c.SetLocalFontsEnabledContext(context.Background(), params)
+}
+
+// SetLocalFontsEnabledContext is SetLocalFontsEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetLocalFontsEnabledContext(ctx context.Context, params SetLocalFontsEnabledParams) (SetLocalFontsEnabledReturns, error) {
+	var returns_ SetLocalFontsEnabledReturns
+
+	err_ := c.caller.Call(ctx, "CSS.setLocalFontsEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fires whenever a web font is updated.  A non-empty font parameter indicates a successfully loaded
+// web font.
+type FontsUpdatedEvent struct {
+
+	/* The web font that has loaded. */
+	Font *FontFace `json:"font,omitempty"`
+}
+
+// UnmarshalCDP reads FontsUpdatedEvent from JSON
+func (v *FontsUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "font":
+			if d.Null() {
+				v.Font = nil
+			} else {
+				v.Font = new(FontFace)
+				(*v.Font).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FontsUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FontsUpdatedHandler func(ev FontsUpdatedEvent)
+
+// EventMethod is CSS.fontsUpdated
+func (FontsUpdatedEvent) EventMethod() string {
+	return "CSS.fontsUpdated"
+}
+
+// OnFontsUpdated calls handler for each CSS.fontsUpdated event
+func (c Client) OnFontsUpdated(handler FontsUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("CSS.fontsUpdated", func(ev interface{}) {
+		handler(ev.(FontsUpdatedEvent))
+	})
+}
+
+// Fires whenever a MediaQuery result changes (for example, after a browser window has been
+// resized.) The current implementation considers only viewport-dependent media features.
+type MediaQueryResultChangedEvent struct {
+}
+
+// UnmarshalCDP reads MediaQueryResultChangedEvent from JSON
+func (v *MediaQueryResultChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MediaQueryResultChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type MediaQueryResultChangedHandler func(ev MediaQueryResultChangedEvent)
+
+// EventMethod is CSS.mediaQueryResultChanged
+func (MediaQueryResultChangedEvent) EventMethod() string {
+	return "CSS.mediaQueryResultChanged"
+}
+
+// OnMediaQueryResultChanged calls handler for each CSS.mediaQueryResultChanged event
+func (c Client) OnMediaQueryResultChanged(handler MediaQueryResultChangedHandler) (unsubscribe func()) {
+	return c.caller.On("CSS.mediaQueryResultChanged", func(ev interface{}) {
+		handler(ev.(MediaQueryResultChangedEvent))
+	})
+}
+
+// Fired whenever an active document stylesheet is added.
+type StyleSheetAddedEvent struct {
+
+	/* Added stylesheet metainfo. */
+	Header CSSStyleSheetHeader `json:"header"`
+}
+
+// UnmarshalCDP reads StyleSheetAddedEvent from JSON
+func (v *StyleSheetAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "header":
+			v.Header.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StyleSheetAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StyleSheetAddedHandler func(ev StyleSheetAddedEvent)
+
+// EventMethod is CSS.styleSheetAdded
+func (StyleSheetAddedEvent) EventMethod() string {
+	return "CSS.styleSheetAdded"
+}
+
+// OnStyleSheetAdded calls handler for each CSS.styleSheetAdded event
+func (c Client) OnStyleSheetAdded(handler StyleSheetAddedHandler) (unsubscribe func()) {
+	return c.caller.On("CSS.styleSheetAdded", func(ev interface{}) {
+		handler(ev.(StyleSheetAddedEvent))
+	})
+}
+
+// Fired whenever a stylesheet is changed as a result of the client operation.
+type StyleSheetChangedEvent struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+}
+
+// UnmarshalCDP reads StyleSheetChangedEvent from JSON
+func (v *StyleSheetChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			v.StyleSheetId = StyleSheetId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StyleSheetChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StyleSheetChangedHandler func(ev StyleSheetChangedEvent)
+
+// EventMethod is CSS.styleSheetChanged
+func (StyleSheetChangedEvent) EventMethod() string {
+	return "CSS.styleSheetChanged"
+}
+
+// OnStyleSheetChanged calls handler for each CSS.styleSheetChanged event
+func (c Client) OnStyleSheetChanged(handler StyleSheetChangedHandler) (unsubscribe func()) {
+	return c.caller.On("CSS.styleSheetChanged", func(ev interface{}) {
+		handler(ev.(StyleSheetChangedEvent))
+	})
+}
+
+// Fired whenever an active document stylesheet is removed.
+type StyleSheetRemovedEvent struct {
+
+	/* Identifier of the removed stylesheet. */
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+}
+
+// UnmarshalCDP reads StyleSheetRemovedEvent from JSON
+func (v *StyleSheetRemovedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "styleSheetId":
+			v.StyleSheetId = StyleSheetId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StyleSheetRemovedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StyleSheetRemovedHandler func(ev StyleSheetRemovedEvent)
+
+// EventMethod is CSS.styleSheetRemoved
+func (StyleSheetRemovedEvent) EventMethod() string {
+	return "CSS.styleSheetRemoved"
+}
+
+// OnStyleSheetRemoved calls handler for each CSS.styleSheetRemoved event
+func (c Client) OnStyleSheetRemoved(handler StyleSheetRemovedHandler) (unsubscribe func()) {
+	return c.caller.On("CSS.styleSheetRemoved", func(ev interface{}) {
+		handler(ev.(StyleSheetRemovedEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ComputedStyleUpdatedEvent struct {
+
+	/* The node id that has updated computed styles. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads ComputedStyleUpdatedEvent from JSON
+func (v *ComputedStyleUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ComputedStyleUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ComputedStyleUpdatedHandler func(ev ComputedStyleUpdatedEvent)
+
+// EventMethod is CSS.computedStyleUpdated
+func (ComputedStyleUpdatedEvent) EventMethod() string {
+	return "CSS.computedStyleUpdated"
+}
+
+// OnComputedStyleUpdated calls handler for each CSS.computedStyleUpdated event
+func (c Client) OnComputedStyleUpdated(handler ComputedStyleUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("CSS.computedStyleUpdated", func(ev interface{}) {
+		handler(ev.(ComputedStyleUpdatedEvent))
+	})
+}
+
+// decoders for CSS events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"CSS.fontsUpdated":            cdp.Decode[FontsUpdatedEvent],
+	"CSS.mediaQueryResultChanged": cdp.Decode[MediaQueryResultChangedEvent],
+	"CSS.styleSheetAdded":         cdp.Decode[StyleSheetAddedEvent],
+	"CSS.styleSheetChanged":       cdp.Decode[StyleSheetChangedEvent],
+	"CSS.styleSheetRemoved":       cdp.Decode[StyleSheetRemovedEvent],
+	"CSS.computedStyleUpdated":    cdp.Decode[ComputedStyleUpdatedEvent],
+}
+
+// DecodeEvent decodes a CSS event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,3237 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package debugger is the Debugger domain of the chrome devtools protocol
+//
+// Debugger domain exposes JavaScript debugging capabilities. It allows setting and removing
+// breakpoints, stepping through execution, exploring stack traces, etc.
+package debugger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Debugger commands and listens for Debugger events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	Location    = cdp.DebuggerLocation
+	SearchMatch = cdp.DebuggerSearchMatch
+)
+
+// Breakpoint identifier.
+type BreakpointId string
+
+// Call frame identifier.
+type CallFrameId string
+
+// Location in the source code.
+//
+// Experimental: this may change or be removed in any chrome release
+type ScriptPosition struct {
+	LineNumber   int `json:"lineNumber"`
+	ColumnNumber int `json:"columnNumber"`
+}
+
+// MarshalCDP writes ScriptPosition as JSON
+func (v *ScriptPosition) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScriptPosition) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScriptPosition from JSON
+func (v *ScriptPosition) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScriptPosition) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Location range within one script.
+//
+// Experimental: this may change or be removed in any chrome release
+type LocationRange struct {
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+	Start    ScriptPosition      `json:"start"`
+	End      ScriptPosition      `json:"end"`
+}
+
+// MarshalCDP writes LocationRange as JSON
+func (v *LocationRange) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("start")
+	v.Start.MarshalCDP(e)
+	e.Key("end")
+	v.End.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LocationRange) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LocationRange from JSON
+func (v *LocationRange) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = cdp.RuntimeScriptId(d.String())
+		case "start":
+			v.Start.UnmarshalCDP(d)
+		case "end":
+			v.End.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LocationRange) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// JavaScript call frame. Array of call frames form the call stack.
+type CallFrame struct {
+	/* Call frame identifier. This identifier is only valid while the virtual machine is paused. */
+	CallFrameId CallFrameId `json:"callFrameId"`
+	/* Name of the JavaScript function called on this call frame. */
+	FunctionName string `json:"functionName"`
+	/* Location in the source code. */
+	FunctionLocation *Location `json:"functionLocation,omitempty"`
+	/* Location in the source code. */
+	Location Location `json:"location"`
+	/* JavaScript script name or url.
+	Deprecated in favor of using the `location.scriptId` to resolve the URL via a previously
+	sent `Debugger.scriptParsed` event.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Url string `json:"url"`
+	/* Scope chain for this call frame. */
+	ScopeChain []Scope `json:"scopeChain"`
+	/* `this` object for this call frame. */
+	This cdp.RuntimeRemoteObject `json:"this"`
+	/* The value being returned, if the function is at return point. */
+	ReturnValue *cdp.RuntimeRemoteObject `json:"returnValue,omitempty"`
+	/* Valid only while the VM is paused and indicates whether this frame
+	can be restarted or not. Note that a `true` value here does not
+	guarantee that Debugger#restartFrame with this CallFrameId will be
+	successful, but it is very likely.
+
+	Experimental: this may change or be removed in any chrome release */
+	CanBeRestarted *bool `json:"canBeRestarted,omitempty"`
+}
+
+// MarshalCDP writes CallFrame as JSON
+func (v *CallFrame) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("callFrameId")
+	e.String(string(v.CallFrameId))
+	e.Key("functionName")
+	e.String(v.FunctionName)
+	if v.FunctionLocation != nil {
+		e.Key("functionLocation")
+		(*v.FunctionLocation).MarshalCDP(e)
+	}
+	e.Key("location")
+	v.Location.MarshalCDP(e)
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("scopeChain")
+	if v.ScopeChain == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ScopeChain {
+			e.Elem()
+			v.ScopeChain[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("this")
+	v.This.MarshalCDP(e)
+	if v.ReturnValue != nil {
+		e.Key("returnValue")
+		(*v.ReturnValue).MarshalCDP(e)
+	}
+	if v.CanBeRestarted != nil {
+		e.Key("canBeRestarted")
+		e.Bool(*v.CanBeRestarted)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CallFrame) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CallFrame from JSON
+func (v *CallFrame) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "callFrameId":
+			v.CallFrameId = CallFrameId(d.String())
+		case "functionName":
+			v.FunctionName = d.String()
+		case "functionLocation":
+			if d.Null() {
+				v.FunctionLocation = nil
+			} else {
+				v.FunctionLocation = new(Location)
+				(*v.FunctionLocation).UnmarshalCDP(d)
+			}
+		case "location":
+			v.Location.UnmarshalCDP(d)
+		case "url":
+			v.Url = d.String()
+		case "scopeChain":
+			if d.Array() {
+				v.ScopeChain = make([]Scope, 0)
+				for d.More() {
+					var x0 Scope
+					x0.UnmarshalCDP(d)
+					v.ScopeChain = append(v.ScopeChain, x0)
+				}
+			} else {
+				v.ScopeChain = nil
+			}
+		case "this":
+			v.This.UnmarshalCDP(d)
+		case "returnValue":
+			if d.Null() {
+				v.ReturnValue = nil
+			} else {
+				v.ReturnValue = new(cdp.RuntimeRemoteObject)
+				(*v.ReturnValue).UnmarshalCDP(d)
+			}
+		case "canBeRestarted":
+			if d.Null() {
+				v.CanBeRestarted = nil
+			} else {
+				v.CanBeRestarted = new(bool)
+				*v.CanBeRestarted = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CallFrame) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Scope description.
+type Scope struct {
+	/* Scope type. */
+	Type ScopeType `json:"type"`
+	/* Object representing the scope. For `global` and `with` scopes it represents the actual
+	object; for the rest of the scopes, it is artificial transient object enumerating scope
+	variables as its properties. */
+	Object cdp.RuntimeRemoteObject `json:"object"`
+	Name   *string                 `json:"name,omitempty"`
+	/* Location in the source code where scope starts */
+	StartLocation *Location `json:"startLocation,omitempty"`
+	/* Location in the source code where scope ends */
+	EndLocation *Location `json:"endLocation,omitempty"`
+}
+
+// MarshalCDP writes Scope as JSON
+func (v *Scope) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("object")
+	v.Object.MarshalCDP(e)
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	if v.StartLocation != nil {
+		e.Key("startLocation")
+		(*v.StartLocation).MarshalCDP(e)
+	}
+	if v.EndLocation != nil {
+		e.Key("endLocation")
+		(*v.EndLocation).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Scope) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Scope from JSON
+func (v *Scope) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = ScopeType(d.String())
+		case "object":
+			v.Object.UnmarshalCDP(d)
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "startLocation":
+			if d.Null() {
+				v.StartLocation = nil
+			} else {
+				v.StartLocation = new(Location)
+				(*v.StartLocation).UnmarshalCDP(d)
+			}
+		case "endLocation":
+			if d.Null() {
+				v.EndLocation = nil
+			} else {
+				v.EndLocation = new(Location)
+				(*v.EndLocation).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Scope) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScopeType string
+
+// ScopeType values
+const (
+	ScopeTypeGlobal              ScopeType = "global"
+	ScopeTypeLocal               ScopeType = "local"
+	ScopeTypeWith                ScopeType = "with"
+	ScopeTypeClosure             ScopeType = "closure"
+	ScopeTypeCatch               ScopeType = "catch"
+	ScopeTypeBlock               ScopeType = "block"
+	ScopeTypeScript              ScopeType = "script"
+	ScopeTypeEval                ScopeType = "eval"
+	ScopeTypeModule              ScopeType = "module"
+	ScopeTypeWasmExpressionStack ScopeType = "wasm-expression-stack"
+)
+
+// Values gives every ScopeType
+func (ScopeType) Values() []ScopeType {
+	return []ScopeType{
+		ScopeTypeGlobal,
+		ScopeTypeLocal,
+		ScopeTypeWith,
+		ScopeTypeClosure,
+		ScopeTypeCatch,
+		ScopeTypeBlock,
+		ScopeTypeScript,
+		ScopeTypeEval,
+		ScopeTypeModule,
+		ScopeTypeWasmExpressionStack,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ScopeType) Valid() bool {
+	switch v {
+	case ScopeTypeGlobal, ScopeTypeLocal, ScopeTypeWith, ScopeTypeClosure, ScopeTypeCatch, ScopeTypeBlock, ScopeTypeScript, ScopeTypeEval, ScopeTypeModule, ScopeTypeWasmExpressionStack:
+		return true
+	}
+	return false
+}
+
+type BreakLocation struct {
+	/* Script identifier as reported in the `Debugger.scriptParsed`. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+	/* Line number in the script (0-based). */
+	LineNumber int `json:"lineNumber"`
+	/* Column number in the script (0-based). */
+	ColumnNumber *int               `json:"columnNumber,omitempty"`
+	Type         *BreakLocationType `json:"type,omitempty"`
+}
+
+// MarshalCDP writes BreakLocation as JSON
+func (v *BreakLocation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	if v.ColumnNumber != nil {
+		e.Key("columnNumber")
+		e.Int(*v.ColumnNumber)
+	}
+	if v.Type != nil {
+		e.Key("type")
+		e.String(string(*v.Type))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BreakLocation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BreakLocation from JSON
+func (v *BreakLocation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = cdp.RuntimeScriptId(d.String())
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			if d.Null() {
+				v.ColumnNumber = nil
+			} else {
+				v.ColumnNumber = new(int)
+				*v.ColumnNumber = d.Int()
+			}
+		case "type":
+			if d.Null() {
+				v.Type = nil
+			} else {
+				v.Type = new(BreakLocationType)
+				*v.Type = BreakLocationType(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BreakLocation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BreakLocationType string
+
+// BreakLocationType values
+const (
+	BreakLocationTypeDebuggerStatement BreakLocationType = "debuggerStatement"
+	BreakLocationTypeCall              BreakLocationType = "call"
+	BreakLocationTypeReturn            BreakLocationType = "return"
+)
+
+// Values gives every BreakLocationType
+func (BreakLocationType) Values() []BreakLocationType {
+	return []BreakLocationType{
+		BreakLocationTypeDebuggerStatement,
+		BreakLocationTypeCall,
+		BreakLocationTypeReturn,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v BreakLocationType) Valid() bool {
+	switch v {
+	case BreakLocationTypeDebuggerStatement, BreakLocationTypeCall, BreakLocationTypeReturn:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type WasmDisassemblyChunk struct {
+	/* The next chunk of disassembled lines. */
+	Lines []string `json:"lines"`
+	/* The bytecode offsets describing the start of each line. */
+	BytecodeOffsets []int `json:"bytecodeOffsets"`
+}
+
+// MarshalCDP writes WasmDisassemblyChunk as JSON
+func (v *WasmDisassemblyChunk) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("lines")
+	if v.Lines == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Lines {
+			e.Elem()
+			e.String(v.Lines[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("bytecodeOffsets")
+	if v.BytecodeOffsets == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.BytecodeOffsets {
+			e.Elem()
+			e.Int(v.BytecodeOffsets[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v WasmDisassemblyChunk) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads WasmDisassemblyChunk from JSON
+func (v *WasmDisassemblyChunk) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "lines":
+			if d.Array() {
+				v.Lines = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Lines = append(v.Lines, x0)
+				}
+			} else {
+				v.Lines = nil
+			}
+		case "bytecodeOffsets":
+			if d.Array() {
+				v.BytecodeOffsets = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.BytecodeOffsets = append(v.BytecodeOffsets, x0)
+				}
+			} else {
+				v.BytecodeOffsets = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WasmDisassemblyChunk) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enum of possible script languages.
+type ScriptLanguage string
+
+// ScriptLanguage values
+const (
+	ScriptLanguageJavaScript  ScriptLanguage = "JavaScript"
+	ScriptLanguageWebAssembly ScriptLanguage = "WebAssembly"
+)
+
+// Values gives every ScriptLanguage
+func (ScriptLanguage) Values() []ScriptLanguage {
+	return []ScriptLanguage{
+		ScriptLanguageJavaScript,
+		ScriptLanguageWebAssembly,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ScriptLanguage) Valid() bool {
+	switch v {
+	case ScriptLanguageJavaScript, ScriptLanguageWebAssembly:
+		return true
+	}
+	return false
+}
+
+// Debug symbols available for a wasm script.
+type DebugSymbols struct {
+	/* Type of the debug symbols. */
+	Type DebugSymbolsType `json:"type"`
+	/* URL of the external symbol source. */
+	ExternalURL *string `json:"externalURL,omitempty"`
+}
+
+// MarshalCDP writes DebugSymbols as JSON
+func (v *DebugSymbols) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.ExternalURL != nil {
+		e.Key("externalURL")
+		e.String(*v.ExternalURL)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DebugSymbols) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DebugSymbols from JSON
+func (v *DebugSymbols) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = DebugSymbolsType(d.String())
+		case "externalURL":
+			if d.Null() {
+				v.ExternalURL = nil
+			} else {
+				v.ExternalURL = new(string)
+				*v.ExternalURL = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DebugSymbols) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DebugSymbolsType string
+
+// DebugSymbolsType values
+const (
+	DebugSymbolsTypeSourceMap     DebugSymbolsType = "SourceMap"
+	DebugSymbolsTypeEmbeddedDWARF DebugSymbolsType = "EmbeddedDWARF"
+	DebugSymbolsTypeExternalDWARF DebugSymbolsType = "ExternalDWARF"
+)
+
+// Values gives every DebugSymbolsType
+func (DebugSymbolsType) Values() []DebugSymbolsType {
+	return []DebugSymbolsType{
+		DebugSymbolsTypeSourceMap,
+		DebugSymbolsTypeEmbeddedDWARF,
+		DebugSymbolsTypeExternalDWARF,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DebugSymbolsType) Valid() bool {
+	switch v {
+	case DebugSymbolsTypeSourceMap, DebugSymbolsTypeEmbeddedDWARF, DebugSymbolsTypeExternalDWARF:
+		return true
+	}
+	return false
+}
+
+type ResolvedBreakpoint struct {
+	/* Breakpoint unique identifier. */
+	BreakpointId BreakpointId `json:"breakpointId"`
+	/* Actual breakpoint location. */
+	Location Location `json:"location"`
+}
+
+// MarshalCDP writes ResolvedBreakpoint as JSON
+func (v *ResolvedBreakpoint) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("breakpointId")
+	e.String(string(v.BreakpointId))
+	e.Key("location")
+	v.Location.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResolvedBreakpoint) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ResolvedBreakpoint from JSON
+func (v *ResolvedBreakpoint) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "breakpointId":
+			v.BreakpointId = BreakpointId(d.String())
+		case "location":
+			v.Location.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResolvedBreakpoint) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContinueToLocationTargetCallFrames string
+
+// ContinueToLocationTargetCallFrames values
+const (
+	ContinueToLocationTargetCallFramesAny     ContinueToLocationTargetCallFrames = "any"
+	ContinueToLocationTargetCallFramesCurrent ContinueToLocationTargetCallFrames = "current"
+)
+
+// Values gives every ContinueToLocationTargetCallFrames
+func (ContinueToLocationTargetCallFrames) Values() []ContinueToLocationTargetCallFrames {
+	return []ContinueToLocationTargetCallFrames{
+		ContinueToLocationTargetCallFramesAny,
+		ContinueToLocationTargetCallFramesCurrent,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContinueToLocationTargetCallFrames) Valid() bool {
+	switch v {
+	case ContinueToLocationTargetCallFramesAny, ContinueToLocationTargetCallFramesCurrent:
+		return true
+	}
+	return false
+}
+
+type RestartFrameMode string
+
+// RestartFrameMode values
+const (
+	RestartFrameModeStepInto RestartFrameMode = "StepInto"
+)
+
+// Values gives every RestartFrameMode
+func (RestartFrameMode) Values() []RestartFrameMode {
+	return []RestartFrameMode{
+		RestartFrameModeStepInto,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RestartFrameMode) Valid() bool {
+	switch v {
+	case RestartFrameModeStepInto:
+		return true
+	}
+	return false
+}
+
+type SetInstrumentationBreakpointInstrumentation string
+
+// SetInstrumentationBreakpointInstrumentation values
+const (
+	SetInstrumentationBreakpointInstrumentationBeforeScriptExecution              SetInstrumentationBreakpointInstrumentation = "beforeScriptExecution"
+	SetInstrumentationBreakpointInstrumentationBeforeScriptWithSourceMapExecution SetInstrumentationBreakpointInstrumentation = "beforeScriptWithSourceMapExecution"
+)
+
+// Values gives every SetInstrumentationBreakpointInstrumentation
+func (SetInstrumentationBreakpointInstrumentation) Values() []SetInstrumentationBreakpointInstrumentation {
+	return []SetInstrumentationBreakpointInstrumentation{
+		SetInstrumentationBreakpointInstrumentationBeforeScriptExecution,
+		SetInstrumentationBreakpointInstrumentationBeforeScriptWithSourceMapExecution,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetInstrumentationBreakpointInstrumentation) Valid() bool {
+	switch v {
+	case SetInstrumentationBreakpointInstrumentationBeforeScriptExecution, SetInstrumentationBreakpointInstrumentationBeforeScriptWithSourceMapExecution:
+		return true
+	}
+	return false
+}
+
+type SetPauseOnExceptionsState string
+
+// SetPauseOnExceptionsState values
+const (
+	SetPauseOnExceptionsStateNone     SetPauseOnExceptionsState = "none"
+	SetPauseOnExceptionsStateCaught   SetPauseOnExceptionsState = "caught"
+	SetPauseOnExceptionsStateUncaught SetPauseOnExceptionsState = "uncaught"
+	SetPauseOnExceptionsStateAll      SetPauseOnExceptionsState = "all"
+)
+
+// Values gives every SetPauseOnExceptionsState
+func (SetPauseOnExceptionsState) Values() []SetPauseOnExceptionsState {
+	return []SetPauseOnExceptionsState{
+		SetPauseOnExceptionsStateNone,
+		SetPauseOnExceptionsStateCaught,
+		SetPauseOnExceptionsStateUncaught,
+		SetPauseOnExceptionsStateAll,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetPauseOnExceptionsState) Valid() bool {
+	switch v {
+	case SetPauseOnExceptionsStateNone, SetPauseOnExceptionsStateCaught, SetPauseOnExceptionsStateUncaught, SetPauseOnExceptionsStateAll:
+		return true
+	}
+	return false
+}
+
+type SetScriptSourceStatus string
+
+// SetScriptSourceStatus values
+const (
+	SetScriptSourceStatusOk                              SetScriptSourceStatus = "Ok"
+	SetScriptSourceStatusCompileError                    SetScriptSourceStatus = "CompileError"
+	SetScriptSourceStatusBlockedByActiveGenerator        SetScriptSourceStatus = "BlockedByActiveGenerator"
+	SetScriptSourceStatusBlockedByActiveFunction         SetScriptSourceStatus = "BlockedByActiveFunction"
+	SetScriptSourceStatusBlockedByTopLevelEsModuleChange SetScriptSourceStatus = "BlockedByTopLevelEsModuleChange"
+)
+
+// Values gives every SetScriptSourceStatus
+func (SetScriptSourceStatus) Values() []SetScriptSourceStatus {
+	return []SetScriptSourceStatus{
+		SetScriptSourceStatusOk,
+		SetScriptSourceStatusCompileError,
+		SetScriptSourceStatusBlockedByActiveGenerator,
+		SetScriptSourceStatusBlockedByActiveFunction,
+		SetScriptSourceStatusBlockedByTopLevelEsModuleChange,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetScriptSourceStatus) Valid() bool {
+	switch v {
+	case SetScriptSourceStatusOk, SetScriptSourceStatusCompileError, SetScriptSourceStatusBlockedByActiveGenerator, SetScriptSourceStatusBlockedByActiveFunction, SetScriptSourceStatusBlockedByTopLevelEsModuleChange:
+		return true
+	}
+	return false
+}
+
+type PausedReason string
+
+// PausedReason values
+const (
+	PausedReasonAmbiguous        PausedReason = "ambiguous"
+	PausedReasonAssert           PausedReason = "assert"
+	PausedReasonCSPViolation     PausedReason = "CSPViolation"
+	PausedReasonDebugCommand     PausedReason = "debugCommand"
+	PausedReasonDOM              PausedReason = "DOM"
+	PausedReasonEventListener    PausedReason = "EventListener"
+	PausedReasonException        PausedReason = "exception"
+	PausedReasonInstrumentation  PausedReason = "instrumentation"
+	PausedReasonOOM              PausedReason = "OOM"
+	PausedReasonOther            PausedReason = "other"
+	PausedReasonPromiseRejection PausedReason = "promiseRejection"
+	PausedReasonXHR              PausedReason = "XHR"
+	PausedReasonStep             PausedReason = "step"
+)
+
+// Values gives every PausedReason
+func (PausedReason) Values() []PausedReason {
+	return []PausedReason{
+		PausedReasonAmbiguous,
+		PausedReasonAssert,
+		PausedReasonCSPViolation,
+		PausedReasonDebugCommand,
+		PausedReasonDOM,
+		PausedReasonEventListener,
+		PausedReasonException,
+		PausedReasonInstrumentation,
+		PausedReasonOOM,
+		PausedReasonOther,
+		PausedReasonPromiseRejection,
+		PausedReasonXHR,
+		PausedReasonStep,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PausedReason) Valid() bool {
+	switch v {
+	case PausedReasonAmbiguous, PausedReasonAssert, PausedReasonCSPViolation, PausedReasonDebugCommand, PausedReasonDOM, PausedReasonEventListener, PausedReasonException, PausedReasonInstrumentation, PausedReasonOOM, PausedReasonOther, PausedReasonPromiseRejection, PausedReasonXHR, PausedReasonStep:
+		return true
+	}
+	return false
+}
+
+type ContinueToLocationReturns struct {
+}
+
+// UnmarshalCDP reads ContinueToLocationReturns from JSON
+func (v *ContinueToLocationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContinueToLocationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ContinueToLocationParams are the parameters for Debugger.continueToLocation
+// optional parameters are left out when nil
+type ContinueToLocationParams struct {
+	/* Location to continue to. */
+	Location         Location                            `json:"location"`
+	TargetCallFrames *ContinueToLocationTargetCallFrames `json:"targetCallFrames,omitempty"`
+}
+
+// MarshalCDP writes ContinueToLocationParams as JSON
+func (v *ContinueToLocationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("location")
+	v.Location.MarshalCDP(e)
+	if v.TargetCallFrames != nil {
+		e.Key("targetCallFrames")
+		e.String(string(*v.TargetCallFrames))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContinueToLocationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Continues execution until specific location is reached.
+func (c Client) ContinueToLocation(params ContinueToLocationParams) (ContinueToLocationReturns, error) {
+	return c.ContinueToLocationContext(context.Background(), params)
+}
+
+// ContinueToLocationContext is ContinueToLocation with a context for cancellation and deadlines
+func (c Client) ContinueToLocationContext(ctx context.Context, params ContinueToLocationParams) (ContinueToLocationReturns, error) {
+	var returns_ ContinueToLocationReturns
+
+	if params.TargetCallFrames != nil && !params.TargetCallFrames.Valid() {
+		return returns_, fmt.Errorf("Debugger.continueToLocation: invalid targetCallFrames %q", *params.TargetCallFrames)
+	}
+
+	err_ := c.caller.Call(ctx, "Debugger.continueToLocation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables debugger for given page.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+
+	/* Unique identifier of the debugger.
+
+	Experimental: this may change or be removed in any chrome release */
+	DebuggerId cdp.RuntimeUniqueDebuggerId `json:"debuggerId"`
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "debuggerId":
+			v.DebuggerId = cdp.RuntimeUniqueDebuggerId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for Debugger.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* The maximum size in bytes of collected scripts (not referenced by other heap objects)
+	the debugger can hold. Puts no limit if parameter is omitted.
+
+	Experimental: this may change or be removed in any chrome release */
+	MaxScriptsCacheSize *float64 `json:"maxScriptsCacheSize,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.MaxScriptsCacheSize != nil {
+		e.Key("maxScriptsCacheSize")
+		e.Float(*v.MaxScriptsCacheSize)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables debugger for the given page. Clients should not assume that the debugging has been
+// enabled until the result for this command is received.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type EvaluateOnCallFrameReturns struct {
+
+	/* Object wrapper for the evaluation result. */
+	Result cdp.RuntimeRemoteObject `json:"result"`
+
+	/* Exception details. */
+	ExceptionDetails *cdp.RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads EvaluateOnCallFrameReturns from JSON
+func (v *EvaluateOnCallFrameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result.UnmarshalCDP(d)
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(cdp.RuntimeExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EvaluateOnCallFrameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EvaluateOnCallFrameParams are the parameters for Debugger.evaluateOnCallFrame
+// optional parameters are left out when nil
+type EvaluateOnCallFrameParams struct {
+	/* Call frame identifier to evaluate on. */
+	CallFrameId CallFrameId `json:"callFrameId"`
+	/* Expression to evaluate. */
+	Expression string `json:"expression"`
+	/* String object group name to put result into (allows rapid releasing resulting object handles
+	using `releaseObjectGroup`). */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+	/* Specifies whether command line API should be available to the evaluated expression, defaults
+	to false. */
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	/* In silent mode exceptions thrown during evaluation are not reported and do not pause
+	execution. Overrides `setPauseOnException` state. */
+	Silent *bool `json:"silent,omitempty"`
+	/* Whether the result is expected to be a JSON object that should be sent by value. */
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	/* Whether preview should be generated for the result.
+
+	Experimental: this may change or be removed in any chrome release */
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	/* Whether to throw an exception if side effect cannot be ruled out during evaluation. */
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	/* Terminate execution after timing out (number of milliseconds).
+
+	Experimental: this may change or be removed in any chrome release */
+	Timeout *cdp.RuntimeTimeDelta `json:"timeout,omitempty"`
+}
+
+// MarshalCDP writes EvaluateOnCallFrameParams as JSON
+func (v *EvaluateOnCallFrameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("callFrameId")
+	e.String(string(v.CallFrameId))
+	e.Key("expression")
+	e.String(v.Expression)
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		e.Key("includeCommandLineAPI")
+		e.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.Silent != nil {
+		e.Key("silent")
+		e.Bool(*v.Silent)
+	}
+	if v.ReturnByValue != nil {
+		e.Key("returnByValue")
+		e.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		e.Key("generatePreview")
+		e.Bool(*v.GeneratePreview)
+	}
+	if v.ThrowOnSideEffect != nil {
+		e.Key("throwOnSideEffect")
+		e.Bool(*v.ThrowOnSideEffect)
+	}
+	if v.Timeout != nil {
+		e.Key("timeout")
+		e.Float(float64(*v.Timeout))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EvaluateOnCallFrameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Evaluates expression on a given call frame.
+func (c Client) EvaluateOnCallFrame(params EvaluateOnCallFrameParams) (EvaluateOnCallFrameReturns, error) {
+	return c.EvaluateOnCallFrameContext(context.Background(), params)
+}
+
+// EvaluateOnCallFrameContext is EvaluateOnCallFrame with a context for cancellation and deadlines
+func (c Client) EvaluateOnCallFrameContext(ctx context.Context, params EvaluateOnCallFrameParams) (EvaluateOnCallFrameReturns, error) {
+	var returns_ EvaluateOnCallFrameReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.evaluateOnCallFrame", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetPossibleBreakpointsReturns struct {
+
+	/* List of the possible breakpoint locations. */
+	Locations []BreakLocation `json:"locations"`
+}
+
+// UnmarshalCDP reads GetPossibleBreakpointsReturns from JSON
+func (v *GetPossibleBreakpointsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "locations":
+			if d.Array() {
+				v.Locations = make([]BreakLocation, 0)
+				for d.More() {
+					var x0 BreakLocation
+					x0.UnmarshalCDP(d)
+					v.Locations = append(v.Locations, x0)
+				}
+			} else {
+				v.Locations = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetPossibleBreakpointsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetPossibleBreakpointsParams are the parameters for Debugger.getPossibleBreakpoints
+// optional parameters are left out when nil
+type GetPossibleBreakpointsParams struct {
+	/* Start of range to search possible breakpoint locations in. */
+	Start Location `json:"start"`
+	/* End of range to search possible breakpoint locations in (excluding). When not specified, end
+	of scripts is used as end of range. */
+	End *Location `json:"end,omitempty"`
+	/* Only consider locations which are in the same (non-nested) function as start. */
+	RestrictToFunction *bool `json:"restrictToFunction,omitempty"`
+}
+
+// MarshalCDP writes GetPossibleBreakpointsParams as JSON
+func (v *GetPossibleBreakpointsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("start")
+	v.Start.MarshalCDP(e)
+	if v.End != nil {
+		e.Key("end")
+		(*v.End).MarshalCDP(e)
+	}
+	if v.RestrictToFunction != nil {
+		e.Key("restrictToFunction")
+		e.Bool(*v.RestrictToFunction)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetPossibleBreakpointsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns possible locations for breakpoint. scriptId in start and end range locations should be
+// the same.
+func (c Client) GetPossibleBreakpoints(params GetPossibleBreakpointsParams) (GetPossibleBreakpointsReturns, error) {
+	return c.GetPossibleBreakpointsContext(context.Background(), params)
+}
+
+// GetPossibleBreakpointsContext is GetPossibleBreakpoints with a context for cancellation and deadlines
+func (c Client) GetPossibleBreakpointsContext(ctx context.Context, params GetPossibleBreakpointsParams) (GetPossibleBreakpointsReturns, error) {
+	var returns_ GetPossibleBreakpointsReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.getPossibleBreakpoints", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetScriptSourceReturns struct {
+
+	/* Script source (empty in case of Wasm bytecode). */
+	ScriptSource string `json:"scriptSource"`
+
+	/* Wasm bytecode. (Encoded as a base64 string when passed over JSON) */
+	Bytecode *string `json:"bytecode,omitempty"`
+}
+
+// UnmarshalCDP reads GetScriptSourceReturns from JSON
+func (v *GetScriptSourceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptSource":
+			v.ScriptSource = d.String()
+		case "bytecode":
+			if d.Null() {
+				v.Bytecode = nil
+			} else {
+				v.Bytecode = new(string)
+				*v.Bytecode = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetScriptSourceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetScriptSourceParams are the parameters for Debugger.getScriptSource
+// optional parameters are left out when nil
+type GetScriptSourceParams struct {
+	/* Id of the script to get source for. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+}
+
+// MarshalCDP writes GetScriptSourceParams as JSON
+func (v *GetScriptSourceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetScriptSourceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns source for the script with given id.
+func (c Client) GetScriptSource(params GetScriptSourceParams) (GetScriptSourceReturns, error) {
+	return c.GetScriptSourceContext(context.Background(), params)
+}
+
+// GetScriptSourceContext is GetScriptSource with a context for cancellation and deadlines
+func (c Client) GetScriptSourceContext(ctx context.Context, params GetScriptSourceParams) (GetScriptSourceReturns, error) {
+	var returns_ GetScriptSourceReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.getScriptSource", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetWasmBytecodeReturns struct {
+
+	/* Script source. (Encoded as a base64 string when passed over JSON) */
+	Bytecode string `json:"bytecode"`
+}
+
+// UnmarshalCDP reads GetWasmBytecodeReturns from JSON
+func (v *GetWasmBytecodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bytecode":
+			v.Bytecode = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetWasmBytecodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetWasmBytecodeParams are the parameters for Debugger.getWasmBytecode
+// optional parameters are left out when nil
+type GetWasmBytecodeParams struct {
+	/* Id of the Wasm script to get source for. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+}
+
+// MarshalCDP writes GetWasmBytecodeParams as JSON
+func (v *GetWasmBytecodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetWasmBytecodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// This command is deprecated. Use getScriptSource instead.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetWasmBytecode(params GetWasmBytecodeParams) (GetWasmBytecodeReturns, error) {
+	return c.GetWasmBytecodeContext(context.Background(), params)
+}
+
+// GetWasmBytecodeContext is GetWasmBytecode with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetWasmBytecodeContext(ctx context.Context, params GetWasmBytecodeParams) (GetWasmBytecodeReturns, error) {
+	var returns_ GetWasmBytecodeReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.getWasmBytecode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type PauseReturns struct {
+}
+
+// UnmarshalCDP reads PauseReturns from JSON
+func (v *PauseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PauseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stops on the next JavaScript statement.
+func (c Client) Pause() (PauseReturns, error) {
+	return c.PauseContext(context.Background())
+}
+
+// PauseContext is Pause with a context for cancellation and deadlines
+func (c Client) PauseContext(ctx context.Context) (PauseReturns, error) {
+	var returns_ PauseReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.pause", nil, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads RemoveBreakpointReturns from JSON
+func (v *RemoveBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveBreakpointParams are the parameters for Debugger.removeBreakpoint
+// optional parameters are left out when nil
+type RemoveBreakpointParams struct {
+	BreakpointId BreakpointId `json:"breakpointId"`
+}
+
+// MarshalCDP writes RemoveBreakpointParams as JSON
+func (v *RemoveBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("breakpointId")
+	e.String(string(v.BreakpointId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes JavaScript breakpoint.
+func (c Client) RemoveBreakpoint(params RemoveBreakpointParams) (RemoveBreakpointReturns, error) {
+	return c.RemoveBreakpointContext(context.Background(), params)
+}
+
+// RemoveBreakpointContext is RemoveBreakpoint with a context for cancellation and deadlines
+func (c Client) RemoveBreakpointContext(ctx context.Context, params RemoveBreakpointParams) (RemoveBreakpointReturns, error) {
+	var returns_ RemoveBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.removeBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RestartFrameReturns struct {
+
+	/* New stack trace.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	CallFrames []CallFrame `json:"callFrames"`
+
+	/* Async stack trace, if any.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	AsyncStackTrace *cdp.RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+
+	/* Async stack trace, if any.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	AsyncStackTraceId *cdp.RuntimeStackTraceId `json:"asyncStackTraceId,omitempty"`
+}
+
+// UnmarshalCDP reads RestartFrameReturns from JSON
+func (v *RestartFrameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "callFrames":
+			if d.Array() {
+				v.CallFrames = make([]CallFrame, 0)
+				for d.More() {
+					var x0 CallFrame
+					x0.UnmarshalCDP(d)
+					v.CallFrames = append(v.CallFrames, x0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "asyncStackTrace":
+			if d.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				v.AsyncStackTrace = new(cdp.RuntimeStackTrace)
+				(*v.AsyncStackTrace).UnmarshalCDP(d)
+			}
+		case "asyncStackTraceId":
+			if d.Null() {
+				v.AsyncStackTraceId = nil
+			} else {
+				v.AsyncStackTraceId = new(cdp.RuntimeStackTraceId)
+				(*v.AsyncStackTraceId).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RestartFrameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RestartFrameParams are the parameters for Debugger.restartFrame
+// optional parameters are left out when nil
+type RestartFrameParams struct {
+	/* Call frame identifier to evaluate on. */
+	CallFrameId CallFrameId `json:"callFrameId"`
+	/* The `mode` parameter must be present and set to 'StepInto', otherwise
+	`restartFrame` will error out.
+
+	Experimental: this may change or be removed in any chrome release */
+	Mode *RestartFrameMode `json:"mode,omitempty"`
+}
+
+// MarshalCDP writes RestartFrameParams as JSON
+func (v *RestartFrameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("callFrameId")
+	e.String(string(v.CallFrameId))
+	if v.Mode != nil {
+		e.Key("mode")
+		e.String(string(*v.Mode))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RestartFrameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Restarts particular call frame from the beginning. The old, deprecated
+// behavior of `restartFrame` is to stay paused and allow further CDP commands
+// after a restart was scheduled. This can cause problems with restarting, so
+// we now continue execution immediatly after it has been scheduled until we
+// reach the beginning of the restarted frame.
+//
+// To stay back-wards compatible, `restartFrame` now expects a `mode`
+// parameter to be present. If the `mode` parameter is missing, `restartFrame`
+// errors out.
+//
+// The various return values are deprecated and `callFrames` is always empty.
+// Use the call frames from the `Debugger#paused` events instead, that fires
+// once V8 pauses at the beginning of the restarted function.
+func (c Client) RestartFrame(params RestartFrameParams) (RestartFrameReturns, error) {
+	return c.RestartFrameContext(context.Background(), params)
+}
+
+// RestartFrameContext is RestartFrame with a context for cancellation and deadlines
+func (c Client) RestartFrameContext(ctx context.Context, params RestartFrameParams) (RestartFrameReturns, error) {
+	var returns_ RestartFrameReturns
+
+	if params.Mode != nil && !params.Mode.Valid() {
+		return returns_, fmt.Errorf("Debugger.restartFrame: invalid mode %q", *params.Mode)
+	}
+
+	err_ := c.caller.Call(ctx, "Debugger.restartFrame", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ResumeReturns struct {
+}
+
+// UnmarshalCDP reads ResumeReturns from JSON
+func (v *ResumeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResumeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ResumeParams are the parameters for Debugger.resume
+// optional parameters are left out when nil
+type ResumeParams struct {
+	/* Set to true to terminate execution upon resuming execution. In contrast
+	to Runtime.terminateExecution, this will allows to execute further
+	JavaScript (i.e. via evaluation) until execution of the paused code
+	is actually resumed, at which point termination is triggered.
+	If execution is currently not paused, this parameter has no effect. */
+	TerminateOnResume *bool `json:"terminateOnResume,omitempty"`
+}
+
+// MarshalCDP writes ResumeParams as JSON
+func (v *ResumeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.TerminateOnResume != nil {
+		e.Key("terminateOnResume")
+		e.Bool(*v.TerminateOnResume)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResumeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Resumes JavaScript execution.
+func (c Client) Resume(params ResumeParams) (ResumeReturns, error) {
+	return c.ResumeContext(context.Background(), params)
+}
+
+// ResumeContext is Resume with a context for cancellation and deadlines
+func (c Client) ResumeContext(ctx context.Context, params ResumeParams) (ResumeReturns, error) {
+	var returns_ ResumeReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.resume", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SearchInContentReturns struct {
+
+	/* List of search matches. */
+	Result []SearchMatch `json:"result"`
+}
+
+// UnmarshalCDP reads SearchInContentReturns from JSON
+func (v *SearchInContentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			if d.Array() {
+				v.Result = make([]SearchMatch, 0)
+				for d.More() {
+					var x0 SearchMatch
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SearchInContentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SearchInContentParams are the parameters for Debugger.searchInContent
+// optional parameters are left out when nil
+type SearchInContentParams struct {
+	/* Id of the script to search in. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+	/* String to search for. */
+	Query string `json:"query"`
+	/* If true, search is case sensitive. */
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	/* If true, treats string parameter as regex. */
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// MarshalCDP writes SearchInContentParams as JSON
+func (v *SearchInContentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("query")
+	e.String(v.Query)
+	if v.CaseSensitive != nil {
+		e.Key("caseSensitive")
+		e.Bool(*v.CaseSensitive)
+	}
+	if v.IsRegex != nil {
+		e.Key("isRegex")
+		e.Bool(*v.IsRegex)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SearchInContentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Searches for given string in script content.
+func (c Client) SearchInContent(params SearchInContentParams) (SearchInContentReturns, error) {
+	return c.SearchInContentContext(context.Background(), params)
+}
+
+// SearchInContentContext is SearchInContent with a context for cancellation and deadlines
+func (c Client) SearchInContentContext(ctx context.Context, params SearchInContentParams) (SearchInContentReturns, error) {
+	var returns_ SearchInContentReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.searchInContent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAsyncCallStackDepthReturns struct {
+}
+
+// UnmarshalCDP reads SetAsyncCallStackDepthReturns from JSON
+func (v *SetAsyncCallStackDepthReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAsyncCallStackDepthReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAsyncCallStackDepthParams are the parameters for Debugger.setAsyncCallStackDepth
+// optional parameters are left out when nil
+type SetAsyncCallStackDepthParams struct {
+	/* Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async
+	call stacks (default). */
+	MaxDepth int `json:"maxDepth"`
+}
+
+// MarshalCDP writes SetAsyncCallStackDepthParams as JSON
+func (v *SetAsyncCallStackDepthParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("maxDepth")
+	e.Int(v.MaxDepth)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAsyncCallStackDepthParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables or disables async call stacks tracking.
+func (c Client) SetAsyncCallStackDepth(params SetAsyncCallStackDepthParams) (SetAsyncCallStackDepthReturns, error) {
+	return c.SetAsyncCallStackDepthContext(context.Background(), params)
+}
+
+// SetAsyncCallStackDepthContext is SetAsyncCallStackDepth with a context for cancellation and deadlines
+func (c Client) SetAsyncCallStackDepthContext(ctx context.Context, params SetAsyncCallStackDepthParams) (SetAsyncCallStackDepthReturns, error) {
+	var returns_ SetAsyncCallStackDepthReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setAsyncCallStackDepth", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBreakpointReturns struct {
+
+	/* Id of the created breakpoint for further reference. */
+	BreakpointId BreakpointId `json:"breakpointId"`
+
+	/* Location this breakpoint resolved into. */
+	ActualLocation Location `json:"actualLocation"`
+}
+
+// UnmarshalCDP reads SetBreakpointReturns from JSON
+func (v *SetBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "breakpointId":
+			v.BreakpointId = BreakpointId(d.String())
+		case "actualLocation":
+			v.ActualLocation.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBreakpointParams are the parameters for Debugger.setBreakpoint
+// optional parameters are left out when nil
+type SetBreakpointParams struct {
+	/* Location to set breakpoint in. */
+	Location Location `json:"location"`
+	/* Expression to use as a breakpoint condition. When specified, debugger will only stop on the
+	breakpoint if this expression evaluates to true. */
+	Condition *string `json:"condition,omitempty"`
+}
+
+// MarshalCDP writes SetBreakpointParams as JSON
+func (v *SetBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("location")
+	v.Location.MarshalCDP(e)
+	if v.Condition != nil {
+		e.Key("condition")
+		e.String(*v.Condition)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets JavaScript breakpoint at a given location.
+func (c Client) SetBreakpoint(params SetBreakpointParams) (SetBreakpointReturns, error) {
+	return c.SetBreakpointContext(context.Background(), params)
+}
+
+// SetBreakpointContext is SetBreakpoint with a context for cancellation and deadlines
+func (c Client) SetBreakpointContext(ctx context.Context, params SetBreakpointParams) (SetBreakpointReturns, error) {
+	var returns_ SetBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetInstrumentationBreakpointReturns struct {
+
+	/* Id of the created breakpoint for further reference. */
+	BreakpointId BreakpointId `json:"breakpointId"`
+}
+
+// UnmarshalCDP reads SetInstrumentationBreakpointReturns from JSON
+func (v *SetInstrumentationBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "breakpointId":
+			v.BreakpointId = BreakpointId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInstrumentationBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInstrumentationBreakpointParams are the parameters for Debugger.setInstrumentationBreakpoint
+// optional parameters are left out when nil
+type SetInstrumentationBreakpointParams struct {
+	/* Instrumentation name. */
+	Instrumentation SetInstrumentationBreakpointInstrumentation `json:"instrumentation"`
+}
+
+// MarshalCDP writes SetInstrumentationBreakpointParams as JSON
+func (v *SetInstrumentationBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("instrumentation")
+	e.String(string(v.Instrumentation))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInstrumentationBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets instrumentation breakpoint.
+func (c Client) SetInstrumentationBreakpoint(params SetInstrumentationBreakpointParams) (SetInstrumentationBreakpointReturns, error) {
+	return c.SetInstrumentationBreakpointContext(context.Background(), params)
+}
+
+// SetInstrumentationBreakpointContext is SetInstrumentationBreakpoint with a context for cancellation and deadlines
+func (c Client) SetInstrumentationBreakpointContext(ctx context.Context, params SetInstrumentationBreakpointParams) (SetInstrumentationBreakpointReturns, error) {
+	var returns_ SetInstrumentationBreakpointReturns
+
+	if !params.Instrumentation.Valid() {
+		return returns_, fmt.Errorf("Debugger.setInstrumentationBreakpoint: invalid instrumentation %q", params.Instrumentation)
+	}
+
+	err_ := c.caller.Call(ctx, "Debugger.setInstrumentationBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBreakpointByUrlReturns struct {
+
+	/* Id of the created breakpoint for further reference. */
+	BreakpointId BreakpointId `json:"breakpointId"`
+
+	/* List of the locations this breakpoint resolved into upon addition. */
+	Locations []Location `json:"locations"`
+}
+
+// UnmarshalCDP reads SetBreakpointByUrlReturns from JSON
+func (v *SetBreakpointByUrlReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "breakpointId":
+			v.BreakpointId = BreakpointId(d.String())
+		case "locations":
+			if d.Array() {
+				v.Locations = make([]Location, 0)
+				for d.More() {
+					var x0 Location
+					x0.UnmarshalCDP(d)
+					v.Locations = append(v.Locations, x0)
+				}
+			} else {
+				v.Locations = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBreakpointByUrlReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBreakpointByUrlParams are the parameters for Debugger.setBreakpointByUrl
+// optional parameters are left out when nil
+type SetBreakpointByUrlParams struct {
+	/* Line number to set breakpoint at. */
+	LineNumber int `json:"lineNumber"`
+	/* URL of the resources to set breakpoint on. */
+	Url *string `json:"url,omitempty"`
+	/* Regex pattern for the URLs of the resources to set breakpoints on. Either `url` or
+	`urlRegex` must be specified. */
+	UrlRegex *string `json:"urlRegex,omitempty"`
+	/* Script hash of the resources to set breakpoint on. */
+	ScriptHash *string `json:"scriptHash,omitempty"`
+	/* Offset in the line to set breakpoint at. */
+	ColumnNumber *int `json:"columnNumber,omitempty"`
+	/* Expression to use as a breakpoint condition. When specified, debugger will only stop on the
+	breakpoint if this expression evaluates to true. */
+	Condition *string `json:"condition,omitempty"`
+}
+
+// MarshalCDP writes SetBreakpointByUrlParams as JSON
+func (v *SetBreakpointByUrlParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.UrlRegex != nil {
+		e.Key("urlRegex")
+		e.String(*v.UrlRegex)
+	}
+	if v.ScriptHash != nil {
+		e.Key("scriptHash")
+		e.String(*v.ScriptHash)
+	}
+	if v.ColumnNumber != nil {
+		e.Key("columnNumber")
+		e.Int(*v.ColumnNumber)
+	}
+	if v.Condition != nil {
+		e.Key("condition")
+		e.String(*v.Condition)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBreakpointByUrlParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this
+// command is issued, all existing parsed scripts will have breakpoints resolved and returned in
+// `locations` property. Further matching script parsing will result in subsequent
+// `breakpointResolved` events issued. This logical breakpoint will survive page reloads.
+func (c Client) SetBreakpointByUrl(params SetBreakpointByUrlParams) (SetBreakpointByUrlReturns, error) {
+	return c.SetBreakpointByUrlContext(context.Background(), params)
+}
+
+// SetBreakpointByUrlContext is SetBreakpointByUrl with a context for cancellation and deadlines
+func (c Client) SetBreakpointByUrlContext(ctx context.Context, params SetBreakpointByUrlParams) (SetBreakpointByUrlReturns, error) {
+	var returns_ SetBreakpointByUrlReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBreakpointByUrl", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBreakpointsActiveReturns struct {
+}
+
+// UnmarshalCDP reads SetBreakpointsActiveReturns from JSON
+func (v *SetBreakpointsActiveReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBreakpointsActiveReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBreakpointsActiveParams are the parameters for Debugger.setBreakpointsActive
+// optional parameters are left out when nil
+type SetBreakpointsActiveParams struct {
+	/* New value for breakpoints active state. */
+	Active bool `json:"active"`
+}
+
+// MarshalCDP writes SetBreakpointsActiveParams as JSON
+func (v *SetBreakpointsActiveParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("active")
+	e.Bool(v.Active)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBreakpointsActiveParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Activates / deactivates all breakpoints on the page.
+func (c Client) SetBreakpointsActive(params SetBreakpointsActiveParams) (SetBreakpointsActiveReturns, error) {
+	return c.SetBreakpointsActiveContext(context.Background(), params)
+}
+
+// SetBreakpointsActiveContext is SetBreakpointsActive with a context for cancellation and deadlines
+func (c Client) SetBreakpointsActiveContext(ctx context.Context, params SetBreakpointsActiveParams) (SetBreakpointsActiveReturns, error) {
+	var returns_ SetBreakpointsActiveReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBreakpointsActive", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPauseOnExceptionsReturns struct {
+}
+
+// UnmarshalCDP reads SetPauseOnExceptionsReturns from JSON
+func (v *SetPauseOnExceptionsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPauseOnExceptionsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPauseOnExceptionsParams are the parameters for Debugger.setPauseOnExceptions
+// optional parameters are left out when nil
+type SetPauseOnExceptionsParams struct {
+	/* Pause on exceptions mode. */
+	State SetPauseOnExceptionsState `json:"state"`
+}
+
+// MarshalCDP writes SetPauseOnExceptionsParams as JSON
+func (v *SetPauseOnExceptionsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("state")
+	e.String(string(v.State))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPauseOnExceptionsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions,
+// or caught exceptions, no exceptions. Initial pause on exceptions state is `none`.
+func (c Client) SetPauseOnExceptions(params SetPauseOnExceptionsParams) (SetPauseOnExceptionsReturns, error) {
+	return c.SetPauseOnExceptionsContext(context.Background(), params)
+}
+
+// SetPauseOnExceptionsContext is SetPauseOnExceptions with a context for cancellation and deadlines
+func (c Client) SetPauseOnExceptionsContext(ctx context.Context, params SetPauseOnExceptionsParams) (SetPauseOnExceptionsReturns, error) {
+	var returns_ SetPauseOnExceptionsReturns
+
+	if !params.State.Valid() {
+		return returns_, fmt.Errorf("Debugger.setPauseOnExceptions: invalid state %q", params.State)
+	}
+
+	err_ := c.caller.Call(ctx, "Debugger.setPauseOnExceptions", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetScriptSourceReturns struct {
+
+	/* New stack trace in case editing has happened while VM was stopped.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	CallFrames []CallFrame `json:"callFrames,omitempty"`
+
+	/* Whether current call stack  was modified after applying the changes.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	StackChanged *bool `json:"stackChanged,omitempty"`
+
+	/* Async stack trace, if any.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	AsyncStackTrace *cdp.RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+
+	/* Async stack trace, if any.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	AsyncStackTraceId *cdp.RuntimeStackTraceId `json:"asyncStackTraceId,omitempty"`
+
+	/* Whether the operation was successful or not. Only `Ok` denotes a
+	successful live edit while the other enum variants denote why
+	the live edit failed.
+
+	Experimental: this may change or be removed in any chrome release */
+	Status SetScriptSourceStatus `json:"status"`
+
+	/* Exception details if any. Only present when `status` is `CompileError`. */
+	ExceptionDetails *cdp.RuntimeExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads SetScriptSourceReturns from JSON
+func (v *SetScriptSourceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "callFrames":
+			if d.Array() {
+				v.CallFrames = make([]CallFrame, 0)
+				for d.More() {
+					var x0 CallFrame
+					x0.UnmarshalCDP(d)
+					v.CallFrames = append(v.CallFrames, x0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "stackChanged":
+			if d.Null() {
+				v.StackChanged = nil
+			} else {
+				v.StackChanged = new(bool)
+				*v.StackChanged = d.Bool()
+			}
+		case "asyncStackTrace":
+			if d.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				v.AsyncStackTrace = new(cdp.RuntimeStackTrace)
+				(*v.AsyncStackTrace).UnmarshalCDP(d)
+			}
+		case "asyncStackTraceId":
+			if d.Null() {
+				v.AsyncStackTraceId = nil
+			} else {
+				v.AsyncStackTraceId = new(cdp.RuntimeStackTraceId)
+				(*v.AsyncStackTraceId).UnmarshalCDP(d)
+			}
+		case "status":
+			v.Status = SetScriptSourceStatus(d.String())
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(cdp.RuntimeExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetScriptSourceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetScriptSourceParams are the parameters for Debugger.setScriptSource
+// optional parameters are left out when nil
+type SetScriptSourceParams struct {
+	/* Id of the script to edit. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+	/* New content of the script. */
+	ScriptSource string `json:"scriptSource"`
+	/* If true the change will not actually be applied. Dry run may be used to get result
+	description without actually modifying the code. */
+	DryRun *bool `json:"dryRun,omitempty"`
+	/* If true, then `scriptSource` is allowed to change the function on top of the stack
+	as long as the top-most stack frame is the only activation of that function.
+
+	Experimental: this may change or be removed in any chrome release */
+	AllowTopFrameEditing *bool `json:"allowTopFrameEditing,omitempty"`
+}
+
+// MarshalCDP writes SetScriptSourceParams as JSON
+func (v *SetScriptSourceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("scriptSource")
+	e.String(v.ScriptSource)
+	if v.DryRun != nil {
+		e.Key("dryRun")
+		e.Bool(*v.DryRun)
+	}
+	if v.AllowTopFrameEditing != nil {
+		e.Key("allowTopFrameEditing")
+		e.Bool(*v.AllowTopFrameEditing)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetScriptSourceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Edits JavaScript source live.
+//
+// In general, functions that are currently on the stack can not be edited with
+// a single exception: If the edited function is the top-most stack frame and
+// that is the only activation of that function on the stack. In this case
+// the live edit will be successful and a `Debugger.restartFrame` for the
+// top-most function is automatically triggered.
+func (c Client) SetScriptSource(params SetScriptSourceParams) (SetScriptSourceReturns, error) {
+	return c.SetScriptSourceContext(context.Background(), params)
+}
+
+// SetScriptSourceContext is SetScriptSource with a context for cancellation and deadlines
+func (c Client) SetScriptSourceContext(ctx context.Context, params SetScriptSourceParams) (SetScriptSourceReturns, error) {
+	var returns_ SetScriptSourceReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setScriptSource", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSkipAllPausesReturns struct {
+}
+
+// UnmarshalCDP reads SetSkipAllPausesReturns from JSON
+func (v *SetSkipAllPausesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSkipAllPausesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSkipAllPausesParams are the parameters for Debugger.setSkipAllPauses
+// optional parameters are left out when nil
+type SetSkipAllPausesParams struct {
+	/* New value for skip pauses state. */
+	Skip bool `json:"skip"`
+}
+
+// MarshalCDP writes SetSkipAllPausesParams as JSON
+func (v *SetSkipAllPausesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("skip")
+	e.Bool(v.Skip)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSkipAllPausesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
+func (c Client) SetSkipAllPauses(params SetSkipAllPausesParams) (SetSkipAllPausesReturns, error) {
+	return c.SetSkipAllPausesContext(context.Background(), params)
+}
+
+// SetSkipAllPausesContext is SetSkipAllPauses with a context for cancellation and deadlines
+func (c Client) SetSkipAllPausesContext(ctx context.Context, params SetSkipAllPausesParams) (SetSkipAllPausesReturns, error) {
+	var returns_ SetSkipAllPausesReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setSkipAllPauses", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetVariableValueReturns struct {
+}
+
+// UnmarshalCDP reads SetVariableValueReturns from JSON
+func (v *SetVariableValueReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetVariableValueReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetVariableValueParams are the parameters for Debugger.setVariableValue
+// optional parameters are left out when nil
+type SetVariableValueParams struct {
+	/* 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch'
+	scope types are allowed. Other scopes could be manipulated manually. */
+	ScopeNumber int `json:"scopeNumber"`
+	/* Variable name. */
+	VariableName string `json:"variableName"`
+	/* New variable value. */
+	NewValue cdp.RuntimeCallArgument `json:"newValue"`
+	/* Id of callframe that holds variable. */
+	CallFrameId CallFrameId `json:"callFrameId"`
+}
+
+// MarshalCDP writes SetVariableValueParams as JSON
+func (v *SetVariableValueParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scopeNumber")
+	e.Int(v.ScopeNumber)
+	e.Key("variableName")
+	e.String(v.VariableName)
+	e.Key("newValue")
+	v.NewValue.MarshalCDP(e)
+	e.Key("callFrameId")
+	e.String(string(v.CallFrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetVariableValueParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Changes value of variable in a callframe. Object-based scopes are not supported and must be
+// mutated manually.
+func (c Client) SetVariableValue(params SetVariableValueParams) (SetVariableValueReturns, error) {
+	return c.SetVariableValueContext(context.Background(), params)
+}
+
+// SetVariableValueContext is SetVariableValue with a context for cancellation and deadlines
+func (c Client) SetVariableValueContext(ctx context.Context, params SetVariableValueParams) (SetVariableValueReturns, error) {
+	var returns_ SetVariableValueReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setVariableValue", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StepIntoReturns struct {
+}
+
+// UnmarshalCDP reads StepIntoReturns from JSON
+func (v *StepIntoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StepIntoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StepIntoParams are the parameters for Debugger.stepInto
+// optional parameters are left out when nil
+type StepIntoParams struct {
+	/* Debugger will pause on the execution of the first async task which was scheduled
+	before next pause.
+
+	Experimental: this may change or be removed in any chrome release */
+	BreakOnAsyncCall *bool `json:"breakOnAsyncCall,omitempty"`
+	/* The skipList specifies location ranges that should be skipped on step into.
+
+	Experimental: this may change or be removed in any chrome release */
+	SkipList []LocationRange `json:"skipList"`
+}
+
+// MarshalCDP writes StepIntoParams as JSON
+func (v *StepIntoParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BreakOnAsyncCall != nil {
+		e.Key("breakOnAsyncCall")
+		e.Bool(*v.BreakOnAsyncCall)
+	}
+	if v.SkipList != nil {
+		e.Key("skipList")
+		e.ArrayStart()
+		for i0 := range v.SkipList {
+			e.Elem()
+			v.SkipList[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StepIntoParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Steps into the function call.
+func (c Client) StepInto(params StepIntoParams) (StepIntoReturns, error) {
+	return c.StepIntoContext(context.Background(), params)
+}
+
+// StepIntoContext is StepInto with a context for cancellation and deadlines
+func (c Client) StepIntoContext(ctx context.Context, params StepIntoParams) (StepIntoReturns, error) {
+	var returns_ StepIntoReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.stepInto", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StepOutReturns struct {
+}
+
+// UnmarshalCDP reads StepOutReturns from JSON
+func (v *StepOutReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StepOutReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Steps out of the function call.
+func (c Client) StepOut() (StepOutReturns, error) {
+	return c.StepOutContext(context.Background())
+}
+
+// StepOutContext is StepOut with a context for cancellation and deadlines
+func (c Client) StepOutContext(ctx context.Context) (StepOutReturns, error) {
+	var returns_ StepOutReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.stepOut", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StepOverReturns struct {
+}
+
+// UnmarshalCDP reads StepOverReturns from JSON
+func (v *StepOverReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StepOverReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StepOverParams are the parameters for Debugger.stepOver
+// optional parameters are left out when nil
+type StepOverParams struct {
+	/* The skipList specifies location ranges that should be skipped on step over.
+
+	Experimental: this may change or be removed in any chrome release */
+	SkipList []LocationRange `json:"skipList"`
+}
+
+// MarshalCDP writes StepOverParams as JSON
+func (v *StepOverParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SkipList != nil {
+		e.Key("skipList")
+		e.ArrayStart()
+		for i0 := range v.SkipList {
+			e.Elem()
+			v.SkipList[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StepOverParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Steps over the statement.
+func (c Client) StepOver(params StepOverParams) (StepOverReturns, error) {
+	return c.StepOverContext(context.Background(), params)
+}
+
+// StepOverContext is StepOver with a context for cancellation and deadlines
+func (c Client) StepOverContext(ctx context.Context, params StepOverParams) (StepOverReturns, error) {
+	var returns_ StepOverReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.stepOver", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fired when breakpoint is resolved to an actual script and location.
+// Deprecated in favor of `resolvedBreakpoints` in the `scriptParsed` event.
+//
+// Deprecated: this is deprecated in the devtools protocol
+type BreakpointResolvedEvent struct {
+
+	/* Breakpoint unique identifier. */
+	BreakpointId BreakpointId `json:"breakpointId"`
+
+	/* Actual breakpoint location. */
+	Location Location `json:"location"`
+}
+
+// UnmarshalCDP reads BreakpointResolvedEvent from JSON
+func (v *BreakpointResolvedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "breakpointId":
+			v.BreakpointId = BreakpointId(d.String())
+		case "location":
+			v.Location.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BreakpointResolvedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BreakpointResolvedHandler func(ev BreakpointResolvedEvent)
+
+// EventMethod is Debugger.breakpointResolved
+func (BreakpointResolvedEvent) EventMethod() string {
+	return "Debugger.breakpointResolved"
+}
+
+// OnBreakpointResolved calls handler for each Debugger.breakpointResolved event
+func (c Client) OnBreakpointResolved(handler BreakpointResolvedHandler) (unsubscribe func()) {
+	return c.caller.On("Debugger.breakpointResolved", func(ev interface{}) {
+		handler(ev.(BreakpointResolvedEvent))
+	})
+}
+
+// Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.
+type PausedEvent struct {
+
+	/* Call stack the virtual machine stopped on. */
+	CallFrames []CallFrame `json:"callFrames"`
+
+	/* Pause reason. */
+	Reason PausedReason `json:"reason"`
+
+	/* Object containing break-specific auxiliary properties. */
+	Data map[string]interface{} `json:"data,omitempty"`
+
+	/* Hit breakpoints IDs */
+	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
+
+	/* Async stack trace, if any. */
+	AsyncStackTrace *cdp.RuntimeStackTrace `json:"asyncStackTrace,omitempty"`
+
+	/* Async stack trace, if any.
+
+	Experimental: this may change or be removed in any chrome release */
+	AsyncStackTraceId *cdp.RuntimeStackTraceId `json:"asyncStackTraceId,omitempty"`
+
+	/* Never present, will be removed.
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	AsyncCallStackTraceId *cdp.RuntimeStackTraceId `json:"asyncCallStackTraceId,omitempty"`
+}
+
+// UnmarshalCDP reads PausedEvent from JSON
+func (v *PausedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "callFrames":
+			if d.Array() {
+				v.CallFrames = make([]CallFrame, 0)
+				for d.More() {
+					var x0 CallFrame
+					x0.UnmarshalCDP(d)
+					v.CallFrames = append(v.CallFrames, x0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "reason":
+			v.Reason = PausedReason(d.String())
+		case "data":
+			v.Data = d.Map()
+		case "hitBreakpoints":
+			if d.Array() {
+				v.HitBreakpoints = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.HitBreakpoints = append(v.HitBreakpoints, x0)
+				}
+			} else {
+				v.HitBreakpoints = nil
+			}
+		case "asyncStackTrace":
+			if d.Null() {
+				v.AsyncStackTrace = nil
+			} else {
+				v.AsyncStackTrace = new(cdp.RuntimeStackTrace)
+				(*v.AsyncStackTrace).UnmarshalCDP(d)
+			}
+		case "asyncStackTraceId":
+			if d.Null() {
+				v.AsyncStackTraceId = nil
+			} else {
+				v.AsyncStackTraceId = new(cdp.RuntimeStackTraceId)
+				(*v.AsyncStackTraceId).UnmarshalCDP(d)
+			}
+		case "asyncCallStackTraceId":
+			if d.Null() {
+				v.AsyncCallStackTraceId = nil
+			} else {
+				v.AsyncCallStackTraceId = new(cdp.RuntimeStackTraceId)
+				(*v.AsyncCallStackTraceId).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PausedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PausedHandler func(ev PausedEvent)
+
+// EventMethod is Debugger.paused
+func (PausedEvent) EventMethod() string {
+	return "Debugger.paused"
+}
+
+// OnPaused calls handler for each Debugger.paused event
+func (c Client) OnPaused(handler PausedHandler) (unsubscribe func()) {
+	return c.caller.On("Debugger.paused", func(ev interface{}) {
+		handler(ev.(PausedEvent))
+	})
+}
+
+// Fired when the virtual machine resumed execution.
+type ResumedEvent struct {
+}
+
+// UnmarshalCDP reads ResumedEvent from JSON
+func (v *ResumedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResumedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ResumedHandler func(ev ResumedEvent)
+
+// EventMethod is Debugger.resumed
+func (ResumedEvent) EventMethod() string {
+	return "Debugger.resumed"
+}
+
+// OnResumed calls handler for each Debugger.resumed event
+func (c Client) OnResumed(handler ResumedHandler) (unsubscribe func()) {
+	return c.caller.On("Debugger.resumed", func(ev interface{}) {
+		handler(ev.(ResumedEvent))
+	})
+}
+
+// Fired when virtual machine fails to parse the script.
+type ScriptFailedToParseEvent struct {
+
+	/* Identifier of the script parsed. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+
+	/* URL or name of the script parsed (if any). */
+	Url string `json:"url"`
+
+	/* Line offset of the script within the resource with given URL (for script tags). */
+	StartLine int `json:"startLine"`
+
+	/* Column offset of the script within the resource with given URL. */
+	StartColumn int `json:"startColumn"`
+
+	/* Last line of the script. */
+	EndLine int `json:"endLine"`
+
+	/* Length of the last line of the script. */
+	EndColumn int `json:"endColumn"`
+
+	/* Specifies script creation context. */
+	ExecutionContextId cdp.RuntimeExecutionContextId `json:"executionContextId"`
+
+	/* Content hash of the script, SHA-256. */
+	Hash string `json:"hash"`
+
+	/* For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment. */
+	BuildId string `json:"buildId"`
+
+	/* Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string} */
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
+
+	/* URL of source map associated with script (if any). */
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
+
+	/* True, if this script has sourceURL. */
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
+
+	/* True, if this script is ES6 module. */
+	IsModule *bool `json:"isModule,omitempty"`
+
+	/* This script length. */
+	Length *int `json:"length,omitempty"`
+
+	/* JavaScript top stack frame of where the script parsed event was triggered if available.
+
+	Experimental: this may change or be removed in any chrome release */
+	StackTrace *cdp.RuntimeStackTrace `json:"stackTrace,omitempty"`
+
+	/* If the scriptLanguage is WebAssembly, the code section offset in the module.
+
+	Experimental: this may change or be removed in any chrome release */
+	CodeOffset *int `json:"codeOffset,omitempty"`
+
+	/* The language of the script.
+
+	Experimental: this may change or be removed in any chrome release */
+	ScriptLanguage *ScriptLanguage `json:"scriptLanguage,omitempty"`
+
+	/* The name the embedder supplied for this script.
+
+	Experimental: this may change or be removed in any chrome release */
+	EmbedderName *string `json:"embedderName,omitempty"`
+}
+
+// UnmarshalCDP reads ScriptFailedToParseEvent from JSON
+func (v *ScriptFailedToParseEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = cdp.RuntimeScriptId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "startLine":
+			v.StartLine = d.Int()
+		case "startColumn":
+			v.StartColumn = d.Int()
+		case "endLine":
+			v.EndLine = d.Int()
+		case "endColumn":
+			v.EndColumn = d.Int()
+		case "executionContextId":
+			v.ExecutionContextId = cdp.RuntimeExecutionContextId(d.Int())
+		case "hash":
+			v.Hash = d.String()
+		case "buildId":
+			v.BuildId = d.String()
+		case "executionContextAuxData":
+			v.ExecutionContextAuxData = d.Map()
+		case "sourceMapURL":
+			if d.Null() {
+				v.SourceMapURL = nil
+			} else {
+				v.SourceMapURL = new(string)
+				*v.SourceMapURL = d.String()
+			}
+		case "hasSourceURL":
+			if d.Null() {
+				v.HasSourceURL = nil
+			} else {
+				v.HasSourceURL = new(bool)
+				*v.HasSourceURL = d.Bool()
+			}
+		case "isModule":
+			if d.Null() {
+				v.IsModule = nil
+			} else {
+				v.IsModule = new(bool)
+				*v.IsModule = d.Bool()
+			}
+		case "length":
+			if d.Null() {
+				v.Length = nil
+			} else {
+				v.Length = new(int)
+				*v.Length = d.Int()
+			}
+		case "stackTrace":
+			if d.Null() {
+				v.StackTrace = nil
+			} else {
+				v.StackTrace = new(cdp.RuntimeStackTrace)
+				(*v.StackTrace).UnmarshalCDP(d)
+			}
+		case "codeOffset":
+			if d.Null() {
+				v.CodeOffset = nil
+			} else {
+				v.CodeOffset = new(int)
+				*v.CodeOffset = d.Int()
+			}
+		case "scriptLanguage":
+			if d.Null() {
+				v.ScriptLanguage = nil
+			} else {
+				v.ScriptLanguage = new(ScriptLanguage)
+				*v.ScriptLanguage = ScriptLanguage(d.String())
+			}
+		case "embedderName":
+			if d.Null() {
+				v.EmbedderName = nil
+			} else {
+				v.EmbedderName = new(string)
+				*v.EmbedderName = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScriptFailedToParseEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScriptFailedToParseHandler func(ev ScriptFailedToParseEvent)
+
+// EventMethod is Debugger.scriptFailedToParse
+func (ScriptFailedToParseEvent) EventMethod() string {
+	return "Debugger.scriptFailedToParse"
+}
+
+// OnScriptFailedToParse calls handler for each Debugger.scriptFailedToParse event
+func (c Client) OnScriptFailedToParse(handler ScriptFailedToParseHandler) (unsubscribe func()) {
+	return c.caller.On("Debugger.scriptFailedToParse", func(ev interface{}) {
+		handler(ev.(ScriptFailedToParseEvent))
+	})
+}
+
+// Fired when virtual machine parses script. This event is also fired for all known and uncollected
+// scripts upon enabling debugger.
+type ScriptParsedEvent struct {
+
+	/* Identifier of the script parsed. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+
+	/* URL or name of the script parsed (if any). */
+	Url string `json:"url"`
+
+	/* Line offset of the script within the resource with given URL (for script tags). */
+	StartLine int `json:"startLine"`
+
+	/* Column offset of the script within the resource with given URL. */
+	StartColumn int `json:"startColumn"`
+
+	/* Last line of the script. */
+	EndLine int `json:"endLine"`
+
+	/* Length of the last line of the script. */
+	EndColumn int `json:"endColumn"`
+
+	/* Specifies script creation context. */
+	ExecutionContextId cdp.RuntimeExecutionContextId `json:"executionContextId"`
+
+	/* Content hash of the script, SHA-256. */
+	Hash string `json:"hash"`
+
+	/* For Wasm modules, the content of the `build_id` custom section. For JavaScript the `debugId` magic comment. */
+	BuildId string `json:"buildId"`
+
+	/* Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string} */
+	ExecutionContextAuxData map[string]interface{} `json:"executionContextAuxData,omitempty"`
+
+	/* True, if this script is generated as a result of the live edit operation.
+
+	Experimental: this may change or be removed in any chrome release */
+	IsLiveEdit *bool `json:"isLiveEdit,omitempty"`
+
+	/* URL of source map associated with script (if any). */
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
+
+	/* True, if this script has sourceURL. */
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
+
+	/* True, if this script is ES6 module. */
+	IsModule *bool `json:"isModule,omitempty"`
+
+	/* This script length. */
+	Length *int `json:"length,omitempty"`
+
+	/* JavaScript top stack frame of where the script parsed event was triggered if available.
+
+	Experimental: this may change or be removed in any chrome release */
+	StackTrace *cdp.RuntimeStackTrace `json:"stackTrace,omitempty"`
+
+	/* If the scriptLanguage is WebAssembly, the code section offset in the module.
+
+	Experimental: this may change or be removed in any chrome release */
+	CodeOffset *int `json:"codeOffset,omitempty"`
+
+	/* The language of the script.
+
+	Experimental: this may change or be removed in any chrome release */
+	ScriptLanguage *ScriptLanguage `json:"scriptLanguage,omitempty"`
+
+	/* If the scriptLanguage is WebAssembly, the source of debug symbols for the module.
+
+	Experimental: this may change or be removed in any chrome release */
+	DebugSymbols []DebugSymbols `json:"debugSymbols,omitempty"`
+
+	/* The name the embedder supplied for this script.
+
+	Experimental: this may change or be removed in any chrome release */
+	EmbedderName *string `json:"embedderName,omitempty"`
+
+	/* The list of set breakpoints in this script if calls to `setBreakpointByUrl`
+	matches this script's URL or hash. Clients that use this list can ignore the
+	`breakpointResolved` event. They are equivalent.
+
+	Experimental: this may change or be removed in any chrome release */
+	ResolvedBreakpoints []ResolvedBreakpoint `json:"resolvedBreakpoints,omitempty"`
+}
+
+// UnmarshalCDP reads ScriptParsedEvent from JSON
+func (v *ScriptParsedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = cdp.RuntimeScriptId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "startLine":
+			v.StartLine = d.Int()
+		case "startColumn":
+			v.StartColumn = d.Int()
+		case "endLine":
+			v.EndLine = d.Int()
+		case "endColumn":
+			v.EndColumn = d.Int()
+		case "executionContextId":
+			v.ExecutionContextId = cdp.RuntimeExecutionContextId(d.Int())
+		case "hash":
+			v.Hash = d.String()
+		case "buildId":
+			v.BuildId = d.String()
+		case "executionContextAuxData":
+			v.ExecutionContextAuxData = d.Map()
+		case "isLiveEdit":
+			if d.Null() {
+				v.IsLiveEdit = nil
+			} else {
+				v.IsLiveEdit = new(bool)
+				*v.IsLiveEdit = d.Bool()
+			}
+		case "sourceMapURL":
+			if d.Null() {
+				v.SourceMapURL = nil
+			} else {
+				v.SourceMapURL = new(string)
+				*v.SourceMapURL = d.String()
+			}
+		case "hasSourceURL":
+			if d.Null() {
+				v.HasSourceURL = nil
+			} else {
+				v.HasSourceURL = new(bool)
+				*v.HasSourceURL = d.Bool()
+			}
+		case "isModule":
+			if d.Null() {
+				v.IsModule = nil
+			} else {
+				v.IsModule = new(bool)
+				*v.IsModule = d.Bool()
+			}
+		case "length":
+			if d.Null() {
+				v.Length = nil
+			} else {
+				v.Length = new(int)
+				*v.Length = d.Int()
+			}
+		case "stackTrace":
+			if d.Null() {
+				v.StackTrace = nil
+			} else {
+				v.StackTrace = new(cdp.RuntimeStackTrace)
+				(*v.StackTrace).UnmarshalCDP(d)
+			}
+		case "codeOffset":
+			if d.Null() {
+				v.CodeOffset = nil
+			} else {
+				v.CodeOffset = new(int)
+				*v.CodeOffset = d.Int()
+			}
+		case "scriptLanguage":
+			if d.Null() {
+				v.ScriptLanguage = nil
+			} else {
+				v.ScriptLanguage = new(ScriptLanguage)
+				*v.ScriptLanguage = ScriptLanguage(d.String())
+			}
+		case "debugSymbols":
+			if d.Array() {
+				v.DebugSymbols = make([]DebugSymbols, 0)
+				for d.More() {
+					var x0 DebugSymbols
+					x0.UnmarshalCDP(d)
+					v.DebugSymbols = append(v.DebugSymbols, x0)
+				}
+			} else {
+				v.DebugSymbols = nil
+			}
+		case "embedderName":
+			if d.Null() {
+				v.EmbedderName = nil
+			} else {
+				v.EmbedderName = new(string)
+				*v.EmbedderName = d.String()
+			}
+		case "resolvedBreakpoints":
+			if d.Array() {
+				v.ResolvedBreakpoints = make([]ResolvedBreakpoint, 0)
+				for d.More() {
+					var x0 ResolvedBreakpoint
+					x0.UnmarshalCDP(d)
+					v.ResolvedBreakpoints = append(v.ResolvedBreakpoints, x0)
+				}
+			} else {
+				v.ResolvedBreakpoints = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScriptParsedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScriptParsedHandler func(ev ScriptParsedEvent)
+
+// EventMethod is Debugger.scriptParsed
+func (ScriptParsedEvent) EventMethod() string {
+	return "Debugger.scriptParsed"
+}
+
+// OnScriptParsed calls handler for each Debugger.scriptParsed event
+func (c Client) OnScriptParsed(handler ScriptParsedHandler) (unsubscribe func()) {
+	return c.caller.On("Debugger.scriptParsed", func(ev interface{}) {
+		handler(ev.(ScriptParsedEvent))
+	})
+}
+
+// decoders for Debugger events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Debugger.breakpointResolved":  cdp.Decode[BreakpointResolvedEvent],
+	"Debugger.paused":              cdp.Decode[PausedEvent],
+	"Debugger.resumed":             cdp.Decode[ResumedEvent],
+	"Debugger.scriptFailedToParse": cdp.Decode[ScriptFailedToParseEvent],
+	"Debugger.scriptParsed":        cdp.Decode[ScriptParsedEvent],
+}
+
+// DecodeEvent decodes a Debugger event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,645 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package debugger
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type DisassembleWasmModuleReturns struct {
+
+	/* For large modules, return a stream from which additional chunks of
+	disassembly can be read successively. */
+	StreamId *string `json:"streamId,omitempty"`
+
+	/* The total number of lines in the disassembly text. */
+	TotalNumberOfLines int `json:"totalNumberOfLines"`
+
+	/* The offsets of all function bodies, in the format [start1, end1,
+	start2, end2, ...] where all ends are exclusive. */
+	FunctionBodyOffsets []int `json:"functionBodyOffsets"`
+
+	/* The first chunk of disassembly. */
+	Chunk WasmDisassemblyChunk `json:"chunk"`
+}
+
+// UnmarshalCDP reads DisassembleWasmModuleReturns from JSON
+func (v *DisassembleWasmModuleReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "streamId":
+			if d.Null() {
+				v.StreamId = nil
+			} else {
+				v.StreamId = new(string)
+				*v.StreamId = d.String()
+			}
+		case "totalNumberOfLines":
+			v.TotalNumberOfLines = d.Int()
+		case "functionBodyOffsets":
+			if d.Array() {
+				v.FunctionBodyOffsets = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.FunctionBodyOffsets = append(v.FunctionBodyOffsets, x0)
+				}
+			} else {
+				v.FunctionBodyOffsets = nil
+			}
+		case "chunk":
+			v.Chunk.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisassembleWasmModuleReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DisassembleWasmModuleParams are the parameters for Debugger.disassembleWasmModule
+// optional parameters are left out when nil
+type DisassembleWasmModuleParams struct {
+	/* Id of the script to disassemble */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+}
+
+// MarshalCDP writes DisassembleWasmModuleParams as JSON
+func (v *DisassembleWasmModuleParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DisassembleWasmModuleParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DisassembleWasmModule(params DisassembleWasmModuleParams) (DisassembleWasmModuleReturns, error) {
+	return c.DisassembleWasmModuleContext(context.Background(), params)
+}
+
+// DisassembleWasmModuleContext is DisassembleWasmModule with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DisassembleWasmModuleContext(ctx context.Context, params DisassembleWasmModuleParams) (DisassembleWasmModuleReturns, error) {
+	var returns_ DisassembleWasmModuleReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.disassembleWasmModule", &params, &returns_)
+
+	return returns_, err_
+}
+
+type NextWasmDisassemblyChunkReturns struct {
+
+	/* The next chunk of disassembly. */
+	Chunk WasmDisassemblyChunk `json:"chunk"`
+}
+
+// UnmarshalCDP reads NextWasmDisassemblyChunkReturns from JSON
+func (v *NextWasmDisassemblyChunkReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "chunk":
+			v.Chunk.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NextWasmDisassemblyChunkReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// NextWasmDisassemblyChunkParams are the parameters for Debugger.nextWasmDisassemblyChunk
+// optional parameters are left out when nil
+type NextWasmDisassemblyChunkParams struct {
+	StreamId string `json:"streamId"`
+}
+
+// MarshalCDP writes NextWasmDisassemblyChunkParams as JSON
+func (v *NextWasmDisassemblyChunkParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("streamId")
+	e.String(v.StreamId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NextWasmDisassemblyChunkParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Disassemble the next chunk of lines for the module corresponding to the
+// stream. If disassembly is complete, this API will invalidate the streamId
+// and return an empty chunk. Any subsequent calls for the now invalid stream
+// will return errors.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) NextWasmDisassemblyChunk(params NextWasmDisassemblyChunkParams) (NextWasmDisassemblyChunkReturns, error) {
+	return c.NextWasmDisassemblyChunkContext(context.Background(), params)
+}
+
+// NextWasmDisassemblyChunkContext is NextWasmDisassemblyChunk with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) NextWasmDisassemblyChunkContext(ctx context.Context, params NextWasmDisassemblyChunkParams) (NextWasmDisassemblyChunkReturns, error) {
+	var returns_ NextWasmDisassemblyChunkReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.nextWasmDisassemblyChunk", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetStackTraceReturns struct {
+	StackTrace cdp.RuntimeStackTrace `json:"stackTrace"`
+}
+
+// UnmarshalCDP reads GetStackTraceReturns from JSON
+func (v *GetStackTraceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "stackTrace":
+			v.StackTrace.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetStackTraceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetStackTraceParams are the parameters for Debugger.getStackTrace
+// optional parameters are left out when nil
+type GetStackTraceParams struct {
+	StackTraceId cdp.RuntimeStackTraceId `json:"stackTraceId"`
+}
+
+// MarshalCDP writes GetStackTraceParams as JSON
+func (v *GetStackTraceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("stackTraceId")
+	v.StackTraceId.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetStackTraceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns stack trace with given `stackTraceId`.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetStackTrace(params GetStackTraceParams) (GetStackTraceReturns, error) {
+	return c.GetStackTraceContext(context.Background(), params)
+}
+
+// GetStackTraceContext is GetStackTrace with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetStackTraceContext(ctx context.Context, params GetStackTraceParams) (GetStackTraceReturns, error) {
+	var returns_ GetStackTraceReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.getStackTrace", &params, &returns_)
+
+	return returns_, err_
+}
+
+type PauseOnAsyncCallReturns struct {
+}
+
+// UnmarshalCDP reads PauseOnAsyncCallReturns from JSON
+func (v *PauseOnAsyncCallReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PauseOnAsyncCallReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// PauseOnAsyncCallParams are the parameters for Debugger.pauseOnAsyncCall
+// optional parameters are left out when nil
+type PauseOnAsyncCallParams struct {
+	/* Debugger will pause when async call with given stack trace is started. */
+	ParentStackTraceId cdp.RuntimeStackTraceId `json:"parentStackTraceId"`
+}
+
+// MarshalCDP writes PauseOnAsyncCallParams as JSON
+func (v *PauseOnAsyncCallParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("parentStackTraceId")
+	v.ParentStackTraceId.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PauseOnAsyncCallParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) PauseOnAsyncCall(params PauseOnAsyncCallParams) (PauseOnAsyncCallReturns, error) {
+	return c.PauseOnAsyncCallContext(context.Background(), params)
+}
+
+// PauseOnAsyncCallContext is PauseOnAsyncCall with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) PauseOnAsyncCallContext(ctx context.Context, params PauseOnAsyncCallParams) (PauseOnAsyncCallReturns, error) {
+	var returns_ PauseOnAsyncCallReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.pauseOnAsyncCall", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBlackboxExecutionContextsReturns struct {
+}
+
+// UnmarshalCDP reads SetBlackboxExecutionContextsReturns from JSON
+func (v *SetBlackboxExecutionContextsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBlackboxExecutionContextsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBlackboxExecutionContextsParams are the parameters for Debugger.setBlackboxExecutionContexts
+// optional parameters are left out when nil
+type SetBlackboxExecutionContextsParams struct {
+	/* Array of execution context unique ids for the debugger to ignore. */
+	UniqueIds []string `json:"uniqueIds"`
+}
+
+// MarshalCDP writes SetBlackboxExecutionContextsParams as JSON
+func (v *SetBlackboxExecutionContextsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("uniqueIds")
+	if v.UniqueIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.UniqueIds {
+			e.Elem()
+			e.String(v.UniqueIds[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBlackboxExecutionContextsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Replace previous blackbox execution contexts with passed ones. Forces backend to skip
+// stepping/pausing in scripts in these execution contexts. VM will try to leave blackboxed script by
+// performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlackboxExecutionContexts(params SetBlackboxExecutionContextsParams) (SetBlackboxExecutionContextsReturns, error) {
+	return c.SetBlackboxExecutionContextsContext(context.Background(), params)
+}
+
+// SetBlackboxExecutionContextsContext is SetBlackboxExecutionContexts with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlackboxExecutionContextsContext(ctx context.Context, params SetBlackboxExecutionContextsParams) (SetBlackboxExecutionContextsReturns, error) {
+	var returns_ SetBlackboxExecutionContextsReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBlackboxExecutionContexts", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBlackboxPatternsReturns struct {
+}
+
+// UnmarshalCDP reads SetBlackboxPatternsReturns from JSON
+func (v *SetBlackboxPatternsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBlackboxPatternsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBlackboxPatternsParams are the parameters for Debugger.setBlackboxPatterns
+// optional parameters are left out when nil
+type SetBlackboxPatternsParams struct {
+	/* Array of regexps that will be used to check script url for blackbox state. */
+	Patterns []string `json:"patterns"`
+	/* If true, also ignore scripts with no source url. */
+	SkipAnonymous *bool `json:"skipAnonymous,omitempty"`
+}
+
+// MarshalCDP writes SetBlackboxPatternsParams as JSON
+func (v *SetBlackboxPatternsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("patterns")
+	if v.Patterns == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Patterns {
+			e.Elem()
+			e.String(v.Patterns[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.SkipAnonymous != nil {
+		e.Key("skipAnonymous")
+		e.Bool(*v.SkipAnonymous)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBlackboxPatternsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in
+// scripts with url matching one of the patterns. VM will try to leave blackboxed script by
+// performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlackboxPatterns(params SetBlackboxPatternsParams) (SetBlackboxPatternsReturns, error) {
+	return c.SetBlackboxPatternsContext(context.Background(), params)
+}
+
+// SetBlackboxPatternsContext is SetBlackboxPatterns with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlackboxPatternsContext(ctx context.Context, params SetBlackboxPatternsParams) (SetBlackboxPatternsReturns, error) {
+	var returns_ SetBlackboxPatternsReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBlackboxPatterns", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBlackboxedRangesReturns struct {
+}
+
+// UnmarshalCDP reads SetBlackboxedRangesReturns from JSON
+func (v *SetBlackboxedRangesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBlackboxedRangesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBlackboxedRangesParams are the parameters for Debugger.setBlackboxedRanges
+// optional parameters are left out when nil
+type SetBlackboxedRangesParams struct {
+	/* Id of the script. */
+	ScriptId  cdp.RuntimeScriptId `json:"scriptId"`
+	Positions []ScriptPosition    `json:"positions"`
+}
+
+// MarshalCDP writes SetBlackboxedRangesParams as JSON
+func (v *SetBlackboxedRangesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("positions")
+	if v.Positions == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Positions {
+			e.Elem()
+			v.Positions[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBlackboxedRangesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted
+// scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful.
+// Positions array contains positions where blackbox state is changed. First interval isn't
+// blackboxed. Array should be sorted.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlackboxedRanges(params SetBlackboxedRangesParams) (SetBlackboxedRangesReturns, error) {
+	return c.SetBlackboxedRangesContext(context.Background(), params)
+}
+
+// SetBlackboxedRangesContext is SetBlackboxedRanges with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlackboxedRangesContext(ctx context.Context, params SetBlackboxedRangesParams) (SetBlackboxedRangesReturns, error) {
+	var returns_ SetBlackboxedRangesReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBlackboxedRanges", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBreakpointOnFunctionCallReturns struct {
+
+	/* Id of the created breakpoint for further reference. */
+	BreakpointId BreakpointId `json:"breakpointId"`
+}
+
+// UnmarshalCDP reads SetBreakpointOnFunctionCallReturns from JSON
+func (v *SetBreakpointOnFunctionCallReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "breakpointId":
+			v.BreakpointId = BreakpointId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBreakpointOnFunctionCallReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBreakpointOnFunctionCallParams are the parameters for Debugger.setBreakpointOnFunctionCall
+// optional parameters are left out when nil
+type SetBreakpointOnFunctionCallParams struct {
+	/* Function object id. */
+	ObjectId cdp.RuntimeRemoteObjectId `json:"objectId"`
+	/* Expression to use as a breakpoint condition. When specified, debugger will
+	stop on the breakpoint if this expression evaluates to true. */
+	Condition *string `json:"condition,omitempty"`
+}
+
+// MarshalCDP writes SetBreakpointOnFunctionCallParams as JSON
+func (v *SetBreakpointOnFunctionCallParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	if v.Condition != nil {
+		e.Key("condition")
+		e.String(*v.Condition)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBreakpointOnFunctionCallParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets JavaScript breakpoint before each call to the given function.
+// If another function was created from the same source as a given one,
+// calling it will also trigger the breakpoint.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBreakpointOnFunctionCall(params SetBreakpointOnFunctionCallParams) (SetBreakpointOnFunctionCallReturns, error) {
+	return c.SetBreakpointOnFunctionCallContext(context.Background(), params)
+}
+
+// SetBreakpointOnFunctionCallContext is SetBreakpointOnFunctionCall with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBreakpointOnFunctionCallContext(ctx context.Context, params SetBreakpointOnFunctionCallParams) (SetBreakpointOnFunctionCallReturns, error) {
+	var returns_ SetBreakpointOnFunctionCallReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setBreakpointOnFunctionCall", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetReturnValueReturns struct {
+}
+
+// UnmarshalCDP reads SetReturnValueReturns from JSON
+func (v *SetReturnValueReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetReturnValueReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetReturnValueParams are the parameters for Debugger.setReturnValue
+// optional parameters are left out when nil
+type SetReturnValueParams struct {
+	/* New return value. */
+	NewValue cdp.RuntimeCallArgument `json:"newValue"`
+}
+
+// MarshalCDP writes SetReturnValueParams as JSON
+func (v *SetReturnValueParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("newValue")
+	v.NewValue.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetReturnValueParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Changes return value in top frame. Available only at return break position.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetReturnValue(params SetReturnValueParams) (SetReturnValueReturns, error) {
+	return c.SetReturnValueContext(context.Background(), params)
+}
+
+// SetReturnValueContext is SetReturnValue with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetReturnValueContext(ctx context.Context, params SetReturnValueParams) (SetReturnValueReturns, error) {
+	var returns_ SetReturnValueReturns
+
+	err_ := c.caller.Call(ctx, "Debugger.setReturnValue", &params, &returns_)
+
+	return returns_, err_
+}
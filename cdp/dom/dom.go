@@ -0,0 +1,3309 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package dom is the DOM domain of the chrome devtools protocol
+//
+// This domain exposes DOM read/write operations. Each DOM Node is represented with its mirror object
+// that has an `id`. This `id` can be used to get additional information on the Node, resolve it into
+// the JavaScript object wrapper, etc. It is important that client receives DOM events only for the
+// nodes that are known to the client. Backend keeps track of the nodes that were sent to the client
+// and never sends the same node twice. It is client's responsibility to collect information about
+// the nodes that were sent to the client. Note that `iframe` owner elements will return
+// corresponding document elements as their child nodes.
+package dom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends DOM commands and listens for DOM events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	NodeId            = cdp.DOMNodeId
+	BackendNodeId     = cdp.DOMBackendNodeId
+	PseudoType        = cdp.DOMPseudoType
+	ShadowRootType    = cdp.DOMShadowRootType
+	PhysicalAxes      = cdp.DOMPhysicalAxes
+	LogicalAxes       = cdp.DOMLogicalAxes
+	ScrollOrientation = cdp.DOMScrollOrientation
+	RGBA              = cdp.DOMRGBA
+	Quad              = cdp.DOMQuad
+	Rect              = cdp.DOMRect
+)
+
+// PseudoType values
+const (
+	PseudoTypeFirstLine                   = cdp.DOMPseudoTypeFirstLine
+	PseudoTypeFirstLetter                 = cdp.DOMPseudoTypeFirstLetter
+	PseudoTypeCheckmark                   = cdp.DOMPseudoTypeCheckmark
+	PseudoTypeBefore                      = cdp.DOMPseudoTypeBefore
+	PseudoTypeAfter                       = cdp.DOMPseudoTypeAfter
+	PseudoTypePickerIcon                  = cdp.DOMPseudoTypePickerIcon
+	PseudoTypeMarker                      = cdp.DOMPseudoTypeMarker
+	PseudoTypeBackdrop                    = cdp.DOMPseudoTypeBackdrop
+	PseudoTypeColumn                      = cdp.DOMPseudoTypeColumn
+	PseudoTypeSelection                   = cdp.DOMPseudoTypeSelection
+	PseudoTypeSearchText                  = cdp.DOMPseudoTypeSearchText
+	PseudoTypeTargetText                  = cdp.DOMPseudoTypeTargetText
+	PseudoTypeSpellingError               = cdp.DOMPseudoTypeSpellingError
+	PseudoTypeGrammarError                = cdp.DOMPseudoTypeGrammarError
+	PseudoTypeHighlight                   = cdp.DOMPseudoTypeHighlight
+	PseudoTypeFirstLineInherited          = cdp.DOMPseudoTypeFirstLineInherited
+	PseudoTypeScrollMarker                = cdp.DOMPseudoTypeScrollMarker
+	PseudoTypeScrollMarkerGroup           = cdp.DOMPseudoTypeScrollMarkerGroup
+	PseudoTypeScrollButton                = cdp.DOMPseudoTypeScrollButton
+	PseudoTypeScrollbar                   = cdp.DOMPseudoTypeScrollbar
+	PseudoTypeScrollbarThumb              = cdp.DOMPseudoTypeScrollbarThumb
+	PseudoTypeScrollbarButton             = cdp.DOMPseudoTypeScrollbarButton
+	PseudoTypeScrollbarTrack              = cdp.DOMPseudoTypeScrollbarTrack
+	PseudoTypeScrollbarTrackPiece         = cdp.DOMPseudoTypeScrollbarTrackPiece
+	PseudoTypeScrollbarCorner             = cdp.DOMPseudoTypeScrollbarCorner
+	PseudoTypeResizer                     = cdp.DOMPseudoTypeResizer
+	PseudoTypeInputListButton             = cdp.DOMPseudoTypeInputListButton
+	PseudoTypeViewTransition              = cdp.DOMPseudoTypeViewTransition
+	PseudoTypeViewTransitionGroup         = cdp.DOMPseudoTypeViewTransitionGroup
+	PseudoTypeViewTransitionImagePair     = cdp.DOMPseudoTypeViewTransitionImagePair
+	PseudoTypeViewTransitionGroupChildren = cdp.DOMPseudoTypeViewTransitionGroupChildren
+	PseudoTypeViewTransitionOld           = cdp.DOMPseudoTypeViewTransitionOld
+	PseudoTypeViewTransitionNew           = cdp.DOMPseudoTypeViewTransitionNew
+	PseudoTypePlaceholder                 = cdp.DOMPseudoTypePlaceholder
+	PseudoTypeFileSelectorButton          = cdp.DOMPseudoTypeFileSelectorButton
+	PseudoTypeDetailsContent              = cdp.DOMPseudoTypeDetailsContent
+	PseudoTypePicker                      = cdp.DOMPseudoTypePicker
+	PseudoTypePermissionIcon              = cdp.DOMPseudoTypePermissionIcon
+)
+
+// ShadowRootType values
+const (
+	ShadowRootTypeUserAgent = cdp.DOMShadowRootTypeUserAgent
+	ShadowRootTypeOpen      = cdp.DOMShadowRootTypeOpen
+	ShadowRootTypeClosed    = cdp.DOMShadowRootTypeClosed
+)
+
+// PhysicalAxes values
+const (
+	PhysicalAxesHorizontal = cdp.DOMPhysicalAxesHorizontal
+	PhysicalAxesVertical   = cdp.DOMPhysicalAxesVertical
+	PhysicalAxesBoth       = cdp.DOMPhysicalAxesBoth
+)
+
+// LogicalAxes values
+const (
+	LogicalAxesInline = cdp.DOMLogicalAxesInline
+	LogicalAxesBlock  = cdp.DOMLogicalAxesBlock
+	LogicalAxesBoth   = cdp.DOMLogicalAxesBoth
+)
+
+// ScrollOrientation values
+const (
+	ScrollOrientationHorizontal = cdp.DOMScrollOrientationHorizontal
+	ScrollOrientationVertical   = cdp.DOMScrollOrientationVertical
+)
+
+// Backend node with a friendly name.
+type BackendNode struct {
+	/* `Node`'s nodeType. */
+	NodeType int `json:"nodeType"`
+	/* `Node`'s nodeName. */
+	NodeName      string        `json:"nodeName"`
+	BackendNodeId BackendNodeId `json:"backendNodeId"`
+}
+
+// MarshalCDP writes BackendNode as JSON
+func (v *BackendNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeType")
+	e.Int(v.NodeType)
+	e.Key("nodeName")
+	e.String(v.NodeName)
+	e.Key("backendNodeId")
+	e.Int(int(v.BackendNodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BackendNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BackendNode from JSON
+func (v *BackendNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeType":
+			v.NodeType = d.Int()
+		case "nodeName":
+			v.NodeName = d.String()
+		case "backendNodeId":
+			v.BackendNodeId = BackendNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackendNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Document compatibility mode.
+type CompatibilityMode string
+
+// CompatibilityMode values
+const (
+	CompatibilityModeQuirksMode        CompatibilityMode = "QuirksMode"
+	CompatibilityModeLimitedQuirksMode CompatibilityMode = "LimitedQuirksMode"
+	CompatibilityModeNoQuirksMode      CompatibilityMode = "NoQuirksMode"
+)
+
+// Values gives every CompatibilityMode
+func (CompatibilityMode) Values() []CompatibilityMode {
+	return []CompatibilityMode{
+		CompatibilityModeQuirksMode,
+		CompatibilityModeLimitedQuirksMode,
+		CompatibilityModeNoQuirksMode,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CompatibilityMode) Valid() bool {
+	switch v {
+	case CompatibilityModeQuirksMode, CompatibilityModeLimitedQuirksMode, CompatibilityModeNoQuirksMode:
+		return true
+	}
+	return false
+}
+
+// DOM interaction is implemented in terms of mirror objects that represent the actual DOM nodes.
+// DOMNode is a base node mirror type.
+type Node struct {
+	/* Node identifier that is passed into the rest of the DOM messages as the `nodeId`. Backend
+	will only push node with given `id` once. It is aware of all requested nodes and will only
+	fire DOM events for nodes known to the client. */
+	NodeId NodeId `json:"nodeId"`
+	/* The id of the parent node if any. */
+	ParentId *NodeId `json:"parentId,omitempty"`
+	/* The BackendNodeId for this node. */
+	BackendNodeId BackendNodeId `json:"backendNodeId"`
+	/* `Node`'s nodeType. */
+	NodeType int `json:"nodeType"`
+	/* `Node`'s nodeName. */
+	NodeName string `json:"nodeName"`
+	/* `Node`'s localName. */
+	LocalName string `json:"localName"`
+	/* `Node`'s nodeValue. */
+	NodeValue string `json:"nodeValue"`
+	/* Child count for `Container` nodes. */
+	ChildNodeCount *int `json:"childNodeCount,omitempty"`
+	/* Child nodes of this node when requested with children. */
+	Children []Node `json:"children"`
+	/* Attributes of the `Element` node in the form of flat array `[name1, value1, name2, value2]`. */
+	Attributes []string `json:"attributes"`
+	/* Document URL that `Document` or `FrameOwner` node points to. */
+	DocumentURL *string `json:"documentURL,omitempty"`
+	/* Base URL that `Document` or `FrameOwner` node uses for URL completion. */
+	BaseURL *string `json:"baseURL,omitempty"`
+	/* `DocumentType`'s publicId. */
+	PublicId *string `json:"publicId,omitempty"`
+	/* `DocumentType`'s systemId. */
+	SystemId *string `json:"systemId,omitempty"`
+	/* `DocumentType`'s internalSubset. */
+	InternalSubset *string `json:"internalSubset,omitempty"`
+	/* `Document`'s XML version in case of XML documents. */
+	XmlVersion *string `json:"xmlVersion,omitempty"`
+	/* `Attr`'s name. */
+	Name *string `json:"name,omitempty"`
+	/* `Attr`'s value. */
+	Value *string `json:"value,omitempty"`
+	/* Pseudo element type for this node. */
+	PseudoType *PseudoType `json:"pseudoType,omitempty"`
+	/* Pseudo element identifier for this node. Only present if there is a
+	valid pseudoType. */
+	PseudoIdentifier *string `json:"pseudoIdentifier,omitempty"`
+	/* Shadow root type. */
+	ShadowRootType *ShadowRootType `json:"shadowRootType,omitempty"`
+	/* Frame ID for frame owner elements. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+	/* Content document for frame owner elements. */
+	ContentDocument *Node `json:"contentDocument,omitempty"`
+	/* Shadow root list for given element host. */
+	ShadowRoots []Node `json:"shadowRoots"`
+	/* Content document fragment for template elements. */
+	TemplateContent *Node `json:"templateContent,omitempty"`
+	/* Pseudo elements associated with this node. */
+	PseudoElements []Node `json:"pseudoElements"`
+	/* Deprecated, as the HTML Imports API has been removed (crbug.com/937746).
+	This property used to return the imported document for the HTMLImport links.
+	The property is always undefined now.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	ImportedDocument *Node `json:"importedDocument,omitempty"`
+	/* Distributed nodes for given insertion point. */
+	DistributedNodes []BackendNode `json:"distributedNodes"`
+	/* Whether the node is SVG. */
+	IsSVG             *bool              `json:"isSVG,omitempty"`
+	CompatibilityMode *CompatibilityMode `json:"compatibilityMode,omitempty"`
+	AssignedSlot      *BackendNode       `json:"assignedSlot,omitempty"`
+	/* Experimental: this may change or be removed in any chrome release */
+	IsScrollable *bool `json:"isScrollable,omitempty"`
+}
+
+// MarshalCDP writes Node as JSON
+func (v *Node) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	if v.ParentId != nil {
+		e.Key("parentId")
+		e.Int(int(*v.ParentId))
+	}
+	e.Key("backendNodeId")
+	e.Int(int(v.BackendNodeId))
+	e.Key("nodeType")
+	e.Int(v.NodeType)
+	e.Key("nodeName")
+	e.String(v.NodeName)
+	e.Key("localName")
+	e.String(v.LocalName)
+	e.Key("nodeValue")
+	e.String(v.NodeValue)
+	if v.ChildNodeCount != nil {
+		e.Key("childNodeCount")
+		e.Int(*v.ChildNodeCount)
+	}
+	if v.Children != nil {
+		e.Key("children")
+		e.ArrayStart()
+		for i0 := range v.Children {
+			e.Elem()
+			v.Children[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Attributes != nil {
+		e.Key("attributes")
+		e.ArrayStart()
+		for i0 := range v.Attributes {
+			e.Elem()
+			e.String(v.Attributes[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.DocumentURL != nil {
+		e.Key("documentURL")
+		e.String(*v.DocumentURL)
+	}
+	if v.BaseURL != nil {
+		e.Key("baseURL")
+		e.String(*v.BaseURL)
+	}
+	if v.PublicId != nil {
+		e.Key("publicId")
+		e.String(*v.PublicId)
+	}
+	if v.SystemId != nil {
+		e.Key("systemId")
+		e.String(*v.SystemId)
+	}
+	if v.InternalSubset != nil {
+		e.Key("internalSubset")
+		e.String(*v.InternalSubset)
+	}
+	if v.XmlVersion != nil {
+		e.Key("xmlVersion")
+		e.String(*v.XmlVersion)
+	}
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	if v.Value != nil {
+		e.Key("value")
+		e.String(*v.Value)
+	}
+	if v.PseudoType != nil {
+		e.Key("pseudoType")
+		e.String(string(*v.PseudoType))
+	}
+	if v.PseudoIdentifier != nil {
+		e.Key("pseudoIdentifier")
+		e.String(*v.PseudoIdentifier)
+	}
+	if v.ShadowRootType != nil {
+		e.Key("shadowRootType")
+		e.String(string(*v.ShadowRootType))
+	}
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	if v.ContentDocument != nil {
+		e.Key("contentDocument")
+		(*v.ContentDocument).MarshalCDP(e)
+	}
+	if v.ShadowRoots != nil {
+		e.Key("shadowRoots")
+		e.ArrayStart()
+		for i0 := range v.ShadowRoots {
+			e.Elem()
+			v.ShadowRoots[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.TemplateContent != nil {
+		e.Key("templateContent")
+		(*v.TemplateContent).MarshalCDP(e)
+	}
+	if v.PseudoElements != nil {
+		e.Key("pseudoElements")
+		e.ArrayStart()
+		for i0 := range v.PseudoElements {
+			e.Elem()
+			v.PseudoElements[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.ImportedDocument != nil {
+		e.Key("importedDocument")
+		(*v.ImportedDocument).MarshalCDP(e)
+	}
+	if v.DistributedNodes != nil {
+		e.Key("distributedNodes")
+		e.ArrayStart()
+		for i0 := range v.DistributedNodes {
+			e.Elem()
+			v.DistributedNodes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.IsSVG != nil {
+		e.Key("isSVG")
+		e.Bool(*v.IsSVG)
+	}
+	if v.CompatibilityMode != nil {
+		e.Key("compatibilityMode")
+		e.String(string(*v.CompatibilityMode))
+	}
+	if v.AssignedSlot != nil {
+		e.Key("assignedSlot")
+		(*v.AssignedSlot).MarshalCDP(e)
+	}
+	if v.IsScrollable != nil {
+		e.Key("isScrollable")
+		e.Bool(*v.IsScrollable)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Node) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Node from JSON
+func (v *Node) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		case "parentId":
+			if d.Null() {
+				v.ParentId = nil
+			} else {
+				v.ParentId = new(NodeId)
+				*v.ParentId = NodeId(d.Int())
+			}
+		case "backendNodeId":
+			v.BackendNodeId = BackendNodeId(d.Int())
+		case "nodeType":
+			v.NodeType = d.Int()
+		case "nodeName":
+			v.NodeName = d.String()
+		case "localName":
+			v.LocalName = d.String()
+		case "nodeValue":
+			v.NodeValue = d.String()
+		case "childNodeCount":
+			if d.Null() {
+				v.ChildNodeCount = nil
+			} else {
+				v.ChildNodeCount = new(int)
+				*v.ChildNodeCount = d.Int()
+			}
+		case "children":
+			if d.Array() {
+				v.Children = make([]Node, 0)
+				for d.More() {
+					var x0 Node
+					x0.UnmarshalCDP(d)
+					v.Children = append(v.Children, x0)
+				}
+			} else {
+				v.Children = nil
+			}
+		case "attributes":
+			if d.Array() {
+				v.Attributes = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Attributes = append(v.Attributes, x0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		case "documentURL":
+			if d.Null() {
+				v.DocumentURL = nil
+			} else {
+				v.DocumentURL = new(string)
+				*v.DocumentURL = d.String()
+			}
+		case "baseURL":
+			if d.Null() {
+				v.BaseURL = nil
+			} else {
+				v.BaseURL = new(string)
+				*v.BaseURL = d.String()
+			}
+		case "publicId":
+			if d.Null() {
+				v.PublicId = nil
+			} else {
+				v.PublicId = new(string)
+				*v.PublicId = d.String()
+			}
+		case "systemId":
+			if d.Null() {
+				v.SystemId = nil
+			} else {
+				v.SystemId = new(string)
+				*v.SystemId = d.String()
+			}
+		case "internalSubset":
+			if d.Null() {
+				v.InternalSubset = nil
+			} else {
+				v.InternalSubset = new(string)
+				*v.InternalSubset = d.String()
+			}
+		case "xmlVersion":
+			if d.Null() {
+				v.XmlVersion = nil
+			} else {
+				v.XmlVersion = new(string)
+				*v.XmlVersion = d.String()
+			}
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(string)
+				*v.Value = d.String()
+			}
+		case "pseudoType":
+			if d.Null() {
+				v.PseudoType = nil
+			} else {
+				v.PseudoType = new(PseudoType)
+				*v.PseudoType = PseudoType(d.String())
+			}
+		case "pseudoIdentifier":
+			if d.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				v.PseudoIdentifier = new(string)
+				*v.PseudoIdentifier = d.String()
+			}
+		case "shadowRootType":
+			if d.Null() {
+				v.ShadowRootType = nil
+			} else {
+				v.ShadowRootType = new(ShadowRootType)
+				*v.ShadowRootType = ShadowRootType(d.String())
+			}
+		case "frameId":
+			if d.Null() {
+				v.FrameId = nil
+			} else {
+				v.FrameId = new(cdp.PageFrameId)
+				*v.FrameId = cdp.PageFrameId(d.String())
+			}
+		case "contentDocument":
+			if d.Null() {
+				v.ContentDocument = nil
+			} else {
+				v.ContentDocument = new(Node)
+				(*v.ContentDocument).UnmarshalCDP(d)
+			}
+		case "shadowRoots":
+			if d.Array() {
+				v.ShadowRoots = make([]Node, 0)
+				for d.More() {
+					var x0 Node
+					x0.UnmarshalCDP(d)
+					v.ShadowRoots = append(v.ShadowRoots, x0)
+				}
+			} else {
+				v.ShadowRoots = nil
+			}
+		case "templateContent":
+			if d.Null() {
+				v.TemplateContent = nil
+			} else {
+				v.TemplateContent = new(Node)
+				(*v.TemplateContent).UnmarshalCDP(d)
+			}
+		case "pseudoElements":
+			if d.Array() {
+				v.PseudoElements = make([]Node, 0)
+				for d.More() {
+					var x0 Node
+					x0.UnmarshalCDP(d)
+					v.PseudoElements = append(v.PseudoElements, x0)
+				}
+			} else {
+				v.PseudoElements = nil
+			}
+		case "importedDocument":
+			if d.Null() {
+				v.ImportedDocument = nil
+			} else {
+				v.ImportedDocument = new(Node)
+				(*v.ImportedDocument).UnmarshalCDP(d)
+			}
+		case "distributedNodes":
+			if d.Array() {
+				v.DistributedNodes = make([]BackendNode, 0)
+				for d.More() {
+					var x0 BackendNode
+					x0.UnmarshalCDP(d)
+					v.DistributedNodes = append(v.DistributedNodes, x0)
+				}
+			} else {
+				v.DistributedNodes = nil
+			}
+		case "isSVG":
+			if d.Null() {
+				v.IsSVG = nil
+			} else {
+				v.IsSVG = new(bool)
+				*v.IsSVG = d.Bool()
+			}
+		case "compatibilityMode":
+			if d.Null() {
+				v.CompatibilityMode = nil
+			} else {
+				v.CompatibilityMode = new(CompatibilityMode)
+				*v.CompatibilityMode = CompatibilityMode(d.String())
+			}
+		case "assignedSlot":
+			if d.Null() {
+				v.AssignedSlot = nil
+			} else {
+				v.AssignedSlot = new(BackendNode)
+				(*v.AssignedSlot).UnmarshalCDP(d)
+			}
+		case "isScrollable":
+			if d.Null() {
+				v.IsScrollable = nil
+			} else {
+				v.IsScrollable = new(bool)
+				*v.IsScrollable = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Node) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A structure to hold the top-level node of a detached tree and an array of its retained descendants.
+type DetachedElementInfo struct {
+	TreeNode        Node     `json:"treeNode"`
+	RetainedNodeIds []NodeId `json:"retainedNodeIds"`
+}
+
+// MarshalCDP writes DetachedElementInfo as JSON
+func (v *DetachedElementInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("treeNode")
+	v.TreeNode.MarshalCDP(e)
+	e.Key("retainedNodeIds")
+	if v.RetainedNodeIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.RetainedNodeIds {
+			e.Elem()
+			e.Int(int(v.RetainedNodeIds[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DetachedElementInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DetachedElementInfo from JSON
+func (v *DetachedElementInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "treeNode":
+			v.TreeNode.UnmarshalCDP(d)
+		case "retainedNodeIds":
+			if d.Array() {
+				v.RetainedNodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.RetainedNodeIds = append(v.RetainedNodeIds, x0)
+				}
+			} else {
+				v.RetainedNodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DetachedElementInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Box model.
+type BoxModel struct {
+	/* Content box */
+	Content Quad `json:"content"`
+	/* Padding box */
+	Padding Quad `json:"padding"`
+	/* Border box */
+	Border Quad `json:"border"`
+	/* Margin box */
+	Margin Quad `json:"margin"`
+	/* Node width */
+	Width int `json:"width"`
+	/* Node height */
+	Height int `json:"height"`
+	/* Shape outside coordinates */
+	ShapeOutside *ShapeOutsideInfo `json:"shapeOutside,omitempty"`
+}
+
+// MarshalCDP writes BoxModel as JSON
+func (v *BoxModel) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("content")
+	if v.Content == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Content {
+			e.Elem()
+			e.Float(v.Content[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("padding")
+	if v.Padding == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Padding {
+			e.Elem()
+			e.Float(v.Padding[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("border")
+	if v.Border == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Border {
+			e.Elem()
+			e.Float(v.Border[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("margin")
+	if v.Margin == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Margin {
+			e.Elem()
+			e.Float(v.Margin[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("width")
+	e.Int(v.Width)
+	e.Key("height")
+	e.Int(v.Height)
+	if v.ShapeOutside != nil {
+		e.Key("shapeOutside")
+		(*v.ShapeOutside).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BoxModel) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BoxModel from JSON
+func (v *BoxModel) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "content":
+			if d.Array() {
+				v.Content = make(Quad, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.Content = append(v.Content, x0)
+				}
+			} else {
+				v.Content = nil
+			}
+		case "padding":
+			if d.Array() {
+				v.Padding = make(Quad, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.Padding = append(v.Padding, x0)
+				}
+			} else {
+				v.Padding = nil
+			}
+		case "border":
+			if d.Array() {
+				v.Border = make(Quad, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.Border = append(v.Border, x0)
+				}
+			} else {
+				v.Border = nil
+			}
+		case "margin":
+			if d.Array() {
+				v.Margin = make(Quad, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.Margin = append(v.Margin, x0)
+				}
+			} else {
+				v.Margin = nil
+			}
+		case "width":
+			v.Width = d.Int()
+		case "height":
+			v.Height = d.Int()
+		case "shapeOutside":
+			if d.Null() {
+				v.ShapeOutside = nil
+			} else {
+				v.ShapeOutside = new(ShapeOutsideInfo)
+				(*v.ShapeOutside).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BoxModel) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CSS Shape Outside details.
+type ShapeOutsideInfo struct {
+	/* Shape bounds */
+	Bounds Quad `json:"bounds"`
+	/* Shape coordinate details */
+	Shape []interface{} `json:"shape"`
+	/* Margin shape bounds */
+	MarginShape []interface{} `json:"marginShape"`
+}
+
+// MarshalCDP writes ShapeOutsideInfo as JSON
+func (v *ShapeOutsideInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("bounds")
+	if v.Bounds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Bounds {
+			e.Elem()
+			e.Float(v.Bounds[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("shape")
+	if v.Shape == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Shape {
+			e.Elem()
+			e.Interface(v.Shape[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("marginShape")
+	if v.MarginShape == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.MarginShape {
+			e.Elem()
+			e.Interface(v.MarginShape[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ShapeOutsideInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ShapeOutsideInfo from JSON
+func (v *ShapeOutsideInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bounds":
+			if d.Array() {
+				v.Bounds = make(Quad, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.Bounds = append(v.Bounds, x0)
+				}
+			} else {
+				v.Bounds = nil
+			}
+		case "shape":
+			if d.Array() {
+				v.Shape = make([]interface{}, 0)
+				for d.More() {
+					var x0 interface{}
+					x0 = d.Interface()
+					v.Shape = append(v.Shape, x0)
+				}
+			} else {
+				v.Shape = nil
+			}
+		case "marginShape":
+			if d.Array() {
+				v.MarginShape = make([]interface{}, 0)
+				for d.More() {
+					var x0 interface{}
+					x0 = d.Interface()
+					v.MarginShape = append(v.MarginShape, x0)
+				}
+			} else {
+				v.MarginShape = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ShapeOutsideInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CSSComputedStyleProperty struct {
+	/* Computed style property name. */
+	Name string `json:"name"`
+	/* Computed style property value. */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes CSSComputedStyleProperty as JSON
+func (v *CSSComputedStyleProperty) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CSSComputedStyleProperty) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CSSComputedStyleProperty from JSON
+func (v *CSSComputedStyleProperty) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CSSComputedStyleProperty) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableIncludeWhitespace string
+
+// EnableIncludeWhitespace values
+const (
+	EnableIncludeWhitespaceNone EnableIncludeWhitespace = "none"
+	EnableIncludeWhitespaceAll  EnableIncludeWhitespace = "all"
+)
+
+// Values gives every EnableIncludeWhitespace
+func (EnableIncludeWhitespace) Values() []EnableIncludeWhitespace {
+	return []EnableIncludeWhitespace{
+		EnableIncludeWhitespaceNone,
+		EnableIncludeWhitespaceAll,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v EnableIncludeWhitespace) Valid() bool {
+	switch v {
+	case EnableIncludeWhitespaceNone, EnableIncludeWhitespaceAll:
+		return true
+	}
+	return false
+}
+
+type GetElementByRelationRelation string
+
+// GetElementByRelationRelation values
+const (
+	GetElementByRelationRelationPopoverTarget  GetElementByRelationRelation = "PopoverTarget"
+	GetElementByRelationRelationInterestTarget GetElementByRelationRelation = "InterestTarget"
+	GetElementByRelationRelationCommandFor     GetElementByRelationRelation = "CommandFor"
+)
+
+// Values gives every GetElementByRelationRelation
+func (GetElementByRelationRelation) Values() []GetElementByRelationRelation {
+	return []GetElementByRelationRelation{
+		GetElementByRelationRelationPopoverTarget,
+		GetElementByRelationRelationInterestTarget,
+		GetElementByRelationRelationCommandFor,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v GetElementByRelationRelation) Valid() bool {
+	switch v {
+	case GetElementByRelationRelationPopoverTarget, GetElementByRelationRelationInterestTarget, GetElementByRelationRelationCommandFor:
+		return true
+	}
+	return false
+}
+
+type DescribeNodeReturns struct {
+
+	/* Node description. */
+	Node Node `json:"node"`
+}
+
+// UnmarshalCDP reads DescribeNodeReturns from JSON
+func (v *DescribeNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "node":
+			v.Node.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DescribeNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DescribeNodeParams are the parameters for DOM.describeNode
+// optional parameters are left out when nil
+type DescribeNodeParams struct {
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	entire subtree or provide an integer larger than 0. */
+	Depth *int `json:"depth,omitempty"`
+	/* Whether or not iframes and shadow roots should be traversed when returning the subtree
+	(default is false). */
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// MarshalCDP writes DescribeNodeParams as JSON
+func (v *DescribeNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.Depth != nil {
+		e.Key("depth")
+		e.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		e.Key("pierce")
+		e.Bool(*v.Pierce)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DescribeNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Describes node given its id, does not require domain to be enabled. Does not start tracking any
+// objects, can be used for automation.
+func (c Client) DescribeNode(params DescribeNodeParams) (DescribeNodeReturns, error) {
+	return c.DescribeNodeContext(context.Background(), params)
+}
+
+// DescribeNodeContext is DescribeNode with a context for cancellation and deadlines
+func (c Client) DescribeNodeContext(ctx context.Context, params DescribeNodeParams) (DescribeNodeReturns, error) {
+	var returns_ DescribeNodeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.describeNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ScrollIntoViewIfNeededReturns struct {
+}
+
+// UnmarshalCDP reads ScrollIntoViewIfNeededReturns from JSON
+func (v *ScrollIntoViewIfNeededReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScrollIntoViewIfNeededReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ScrollIntoViewIfNeededParams are the parameters for DOM.scrollIntoViewIfNeeded
+// optional parameters are left out when nil
+type ScrollIntoViewIfNeededParams struct {
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* The rect to be scrolled into view, relative to the node's border box, in CSS pixels.
+	When omitted, center of the node will be used, similar to Element.scrollIntoView. */
+	Rect *Rect `json:"rect,omitempty"`
+}
+
+// MarshalCDP writes ScrollIntoViewIfNeededParams as JSON
+func (v *ScrollIntoViewIfNeededParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.Rect != nil {
+		e.Key("rect")
+		(*v.Rect).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScrollIntoViewIfNeededParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Scrolls the specified rect of the given node into view if not already visible.
+// Note: exactly one between nodeId, backendNodeId and objectId should be passed
+// to identify the node.
+func (c Client) ScrollIntoViewIfNeeded(params ScrollIntoViewIfNeededParams) (ScrollIntoViewIfNeededReturns, error) {
+	return c.ScrollIntoViewIfNeededContext(context.Background(), params)
+}
+
+// ScrollIntoViewIfNeededContext is ScrollIntoViewIfNeeded with a context for cancellation and deadlines
+func (c Client) ScrollIntoViewIfNeededContext(ctx context.Context, params ScrollIntoViewIfNeededParams) (ScrollIntoViewIfNeededReturns, error) {
+	var returns_ ScrollIntoViewIfNeededReturns
+
+	err_ := c.caller.Call(ctx, "DOM.scrollIntoViewIfNeeded", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables DOM agent for the given page.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "DOM.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for DOM.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* Whether to include whitespaces in the children array of returned Nodes.
+
+	Experimental: this may change or be removed in any chrome release */
+	IncludeWhitespace *EnableIncludeWhitespace `json:"includeWhitespace,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.IncludeWhitespace != nil {
+		e.Key("includeWhitespace")
+		e.String(string(*v.IncludeWhitespace))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables DOM agent for the given page.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	if params.IncludeWhitespace != nil && !params.IncludeWhitespace.Valid() {
+		return returns_, fmt.Errorf("DOM.enable: invalid includeWhitespace %q", *params.IncludeWhitespace)
+	}
+
+	err_ := c.caller.Call(ctx, "DOM.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type FocusReturns struct {
+}
+
+// UnmarshalCDP reads FocusReturns from JSON
+func (v *FocusReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FocusReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// FocusParams are the parameters for DOM.focus
+// optional parameters are left out when nil
+type FocusParams struct {
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes FocusParams as JSON
+func (v *FocusParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FocusParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Focuses the given element.
+func (c Client) Focus(params FocusParams) (FocusReturns, error) {
+	return c.FocusContext(context.Background(), params)
+}
+
+// FocusContext is Focus with a context for cancellation and deadlines
+func (c Client) FocusContext(ctx context.Context, params FocusParams) (FocusReturns, error) {
+	var returns_ FocusReturns
+
+	err_ := c.caller.Call(ctx, "DOM.focus", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetAttributesReturns struct {
+
+	/* An interleaved array of node attribute names and values. */
+	Attributes []string `json:"attributes"`
+}
+
+// UnmarshalCDP reads GetAttributesReturns from JSON
+func (v *GetAttributesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "attributes":
+			if d.Array() {
+				v.Attributes = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Attributes = append(v.Attributes, x0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAttributesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAttributesParams are the parameters for DOM.getAttributes
+// optional parameters are left out when nil
+type GetAttributesParams struct {
+	/* Id of the node to retrieve attributes for. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetAttributesParams as JSON
+func (v *GetAttributesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAttributesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns attributes for the specified node.
+func (c Client) GetAttributes(params GetAttributesParams) (GetAttributesReturns, error) {
+	return c.GetAttributesContext(context.Background(), params)
+}
+
+// GetAttributesContext is GetAttributes with a context for cancellation and deadlines
+func (c Client) GetAttributesContext(ctx context.Context, params GetAttributesParams) (GetAttributesReturns, error) {
+	var returns_ GetAttributesReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getAttributes", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetBoxModelReturns struct {
+
+	/* Box model for the node. */
+	Model BoxModel `json:"model"`
+}
+
+// UnmarshalCDP reads GetBoxModelReturns from JSON
+func (v *GetBoxModelReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "model":
+			v.Model.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetBoxModelReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetBoxModelParams are the parameters for DOM.getBoxModel
+// optional parameters are left out when nil
+type GetBoxModelParams struct {
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes GetBoxModelParams as JSON
+func (v *GetBoxModelParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetBoxModelParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns boxes for the given node.
+func (c Client) GetBoxModel(params GetBoxModelParams) (GetBoxModelReturns, error) {
+	return c.GetBoxModelContext(context.Background(), params)
+}
+
+// GetBoxModelContext is GetBoxModel with a context for cancellation and deadlines
+func (c Client) GetBoxModelContext(ctx context.Context, params GetBoxModelParams) (GetBoxModelReturns, error) {
+	var returns_ GetBoxModelReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getBoxModel", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetDocumentReturns struct {
+
+	/* Resulting node. */
+	Root Node `json:"root"`
+}
+
+// UnmarshalCDP reads GetDocumentReturns from JSON
+func (v *GetDocumentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "root":
+			v.Root.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetDocumentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetDocumentParams are the parameters for DOM.getDocument
+// optional parameters are left out when nil
+type GetDocumentParams struct {
+	/* The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	entire subtree or provide an integer larger than 0. */
+	Depth *int `json:"depth,omitempty"`
+	/* Whether or not iframes and shadow roots should be traversed when returning the subtree
+	(default is false). */
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// MarshalCDP writes GetDocumentParams as JSON
+func (v *GetDocumentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Depth != nil {
+		e.Key("depth")
+		e.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		e.Key("pierce")
+		e.Bool(*v.Pierce)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetDocumentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the root DOM node (and optionally the subtree) to the caller.
+// Implicitly enables the DOM domain events for the current target.
+func (c Client) GetDocument(params GetDocumentParams) (GetDocumentReturns, error) {
+	return c.GetDocumentContext(context.Background(), params)
+}
+
+// GetDocumentContext is GetDocument with a context for cancellation and deadlines
+func (c Client) GetDocumentContext(ctx context.Context, params GetDocumentParams) (GetDocumentReturns, error) {
+	var returns_ GetDocumentReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getDocument", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetFlattenedDocumentReturns struct {
+
+	/* Resulting node. */
+	Nodes []Node `json:"nodes"`
+}
+
+// UnmarshalCDP reads GetFlattenedDocumentReturns from JSON
+func (v *GetFlattenedDocumentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]Node, 0)
+				for d.More() {
+					var x0 Node
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetFlattenedDocumentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetFlattenedDocumentParams are the parameters for DOM.getFlattenedDocument
+// optional parameters are left out when nil
+type GetFlattenedDocumentParams struct {
+	/* The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	entire subtree or provide an integer larger than 0. */
+	Depth *int `json:"depth,omitempty"`
+	/* Whether or not iframes and shadow roots should be traversed when returning the subtree
+	(default is false). */
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// MarshalCDP writes GetFlattenedDocumentParams as JSON
+func (v *GetFlattenedDocumentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Depth != nil {
+		e.Key("depth")
+		e.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		e.Key("pierce")
+		e.Bool(*v.Pierce)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetFlattenedDocumentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the root DOM node (and optionally the subtree) to the caller.
+// Deprecated, as it is not designed to work well with the rest of the DOM agent.
+// Use DOMSnapshot.captureSnapshot instead.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetFlattenedDocument(params GetFlattenedDocumentParams) (GetFlattenedDocumentReturns, error) {
+	return c.GetFlattenedDocumentContext(context.Background(), params)
+}
+
+// GetFlattenedDocumentContext is GetFlattenedDocument with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetFlattenedDocumentContext(ctx context.Context, params GetFlattenedDocumentParams) (GetFlattenedDocumentReturns, error) {
+	var returns_ GetFlattenedDocumentReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getFlattenedDocument", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetNodeForLocationReturns struct {
+
+	/* Resulting node. */
+	BackendNodeId BackendNodeId `json:"backendNodeId"`
+
+	/* Frame this node belongs to. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+
+	/* Id of the node at given coordinates, only when enabled and requested document. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+}
+
+// UnmarshalCDP reads GetNodeForLocationReturns from JSON
+func (v *GetNodeForLocationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backendNodeId":
+			v.BackendNodeId = BackendNodeId(d.Int())
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "nodeId":
+			if d.Null() {
+				v.NodeId = nil
+			} else {
+				v.NodeId = new(NodeId)
+				*v.NodeId = NodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetNodeForLocationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetNodeForLocationParams are the parameters for DOM.getNodeForLocation
+// optional parameters are left out when nil
+type GetNodeForLocationParams struct {
+	/* X coordinate. */
+	X int `json:"x"`
+	/* Y coordinate. */
+	Y int `json:"y"`
+	/* False to skip to the nearest non-UA shadow root ancestor (default: false). */
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+	/* Whether to ignore pointer-events: none on elements and hit test them. */
+	IgnorePointerEventsNone *bool `json:"ignorePointerEventsNone,omitempty"`
+}
+
+// MarshalCDP writes GetNodeForLocationParams as JSON
+func (v *GetNodeForLocationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Int(v.X)
+	e.Key("y")
+	e.Int(v.Y)
+	if v.IncludeUserAgentShadowDOM != nil {
+		e.Key("includeUserAgentShadowDOM")
+		e.Bool(*v.IncludeUserAgentShadowDOM)
+	}
+	if v.IgnorePointerEventsNone != nil {
+		e.Key("ignorePointerEventsNone")
+		e.Bool(*v.IgnorePointerEventsNone)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetNodeForLocationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns node id at given location. Depending on whether DOM domain is enabled, nodeId is
+// either returned or not.
+func (c Client) GetNodeForLocation(params GetNodeForLocationParams) (GetNodeForLocationReturns, error) {
+	return c.GetNodeForLocationContext(context.Background(), params)
+}
+
+// GetNodeForLocationContext is GetNodeForLocation with a context for cancellation and deadlines
+func (c Client) GetNodeForLocationContext(ctx context.Context, params GetNodeForLocationParams) (GetNodeForLocationReturns, error) {
+	var returns_ GetNodeForLocationReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getNodeForLocation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetOuterHTMLReturns struct {
+
+	/* Outer HTML markup. */
+	OuterHTML string `json:"outerHTML"`
+}
+
+// UnmarshalCDP reads GetOuterHTMLReturns from JSON
+func (v *GetOuterHTMLReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "outerHTML":
+			v.OuterHTML = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetOuterHTMLReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetOuterHTMLParams are the parameters for DOM.getOuterHTML
+// optional parameters are left out when nil
+type GetOuterHTMLParams struct {
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* Include all shadow roots. Equals to false if not specified.
+
+	Experimental: this may change or be removed in any chrome release */
+	IncludeShadowDOM *bool `json:"includeShadowDOM,omitempty"`
+}
+
+// MarshalCDP writes GetOuterHTMLParams as JSON
+func (v *GetOuterHTMLParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.IncludeShadowDOM != nil {
+		e.Key("includeShadowDOM")
+		e.Bool(*v.IncludeShadowDOM)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetOuterHTMLParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns node's HTML markup.
+func (c Client) GetOuterHTML(params GetOuterHTMLParams) (GetOuterHTMLReturns, error) {
+	return c.GetOuterHTMLContext(context.Background(), params)
+}
+
+// GetOuterHTMLContext is GetOuterHTML with a context for cancellation and deadlines
+func (c Client) GetOuterHTMLContext(ctx context.Context, params GetOuterHTMLParams) (GetOuterHTMLReturns, error) {
+	var returns_ GetOuterHTMLReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getOuterHTML", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HideHighlightReturns struct {
+}
+
+// UnmarshalCDP reads HideHighlightReturns from JSON
+func (v *HideHighlightReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HideHighlightReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Hides any highlight.
+func (c Client) HideHighlight() (HideHighlightReturns, error) {
+	return c.HideHighlightContext(context.Background())
+}
+
+// HideHighlightContext is HideHighlight with a context for cancellation and deadlines
+func (c Client) HideHighlightContext(ctx context.Context) (HideHighlightReturns, error) {
+	var returns_ HideHighlightReturns
+
+	err_ := c.caller.Call(ctx, "DOM.hideHighlight", nil, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightNodeReturns struct {
+}
+
+// UnmarshalCDP reads HighlightNodeReturns from JSON
+func (v *HighlightNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Highlights DOM node.
+func (c Client) HighlightNode() (HighlightNodeReturns, error) {
+	return c.HighlightNodeContext(context.Background())
+}
+
+// HighlightNodeContext is HighlightNode with a context for cancellation and deadlines
+func (c Client) HighlightNodeContext(ctx context.Context) (HighlightNodeReturns, error) {
+	var returns_ HighlightNodeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.highlightNode", nil, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightRectReturns struct {
+}
+
+// UnmarshalCDP reads HighlightRectReturns from JSON
+func (v *HighlightRectReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightRectReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Highlights given rectangle.
+func (c Client) HighlightRect() (HighlightRectReturns, error) {
+	return c.HighlightRectContext(context.Background())
+}
+
+// HighlightRectContext is HighlightRect with a context for cancellation and deadlines
+func (c Client) HighlightRectContext(ctx context.Context) (HighlightRectReturns, error) {
+	var returns_ HighlightRectReturns
+
+	err_ := c.caller.Call(ctx, "DOM.highlightRect", nil, &returns_)
+
+	return returns_, err_
+}
+
+type MoveToReturns struct {
+
+	/* New id of the moved node. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads MoveToReturns from JSON
+func (v *MoveToReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MoveToReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// MoveToParams are the parameters for DOM.moveTo
+// optional parameters are left out when nil
+type MoveToParams struct {
+	/* Id of the node to move. */
+	NodeId NodeId `json:"nodeId"`
+	/* Id of the element to drop the moved node into. */
+	TargetNodeId NodeId `json:"targetNodeId"`
+	/* Drop node before this one (if absent, the moved node becomes the last child of
+	`targetNodeId`). */
+	InsertBeforeNodeId *NodeId `json:"insertBeforeNodeId,omitempty"`
+}
+
+// MarshalCDP writes MoveToParams as JSON
+func (v *MoveToParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("targetNodeId")
+	e.Int(int(v.TargetNodeId))
+	if v.InsertBeforeNodeId != nil {
+		e.Key("insertBeforeNodeId")
+		e.Int(int(*v.InsertBeforeNodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v MoveToParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Moves node into the new container, places it before the given anchor.
+func (c Client) MoveTo(params MoveToParams) (MoveToReturns, error) {
+	return c.MoveToContext(context.Background(), params)
+}
+
+// MoveToContext is MoveTo with a context for cancellation and deadlines
+func (c Client) MoveToContext(ctx context.Context, params MoveToParams) (MoveToReturns, error) {
+	var returns_ MoveToReturns
+
+	err_ := c.caller.Call(ctx, "DOM.moveTo", &params, &returns_)
+
+	return returns_, err_
+}
+
+type QuerySelectorReturns struct {
+
+	/* Query selector result. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads QuerySelectorReturns from JSON
+func (v *QuerySelectorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *QuerySelectorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// QuerySelectorParams are the parameters for DOM.querySelector
+// optional parameters are left out when nil
+type QuerySelectorParams struct {
+	/* Id of the node to query upon. */
+	NodeId NodeId `json:"nodeId"`
+	/* Selector string. */
+	Selector string `json:"selector"`
+}
+
+// MarshalCDP writes QuerySelectorParams as JSON
+func (v *QuerySelectorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("selector")
+	e.String(v.Selector)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v QuerySelectorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Executes `querySelector` on a given node.
+func (c Client) QuerySelector(params QuerySelectorParams) (QuerySelectorReturns, error) {
+	return c.QuerySelectorContext(context.Background(), params)
+}
+
+// QuerySelectorContext is QuerySelector with a context for cancellation and deadlines
+func (c Client) QuerySelectorContext(ctx context.Context, params QuerySelectorParams) (QuerySelectorReturns, error) {
+	var returns_ QuerySelectorReturns
+
+	err_ := c.caller.Call(ctx, "DOM.querySelector", &params, &returns_)
+
+	return returns_, err_
+}
+
+type QuerySelectorAllReturns struct {
+
+	/* Query selector result. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads QuerySelectorAllReturns from JSON
+func (v *QuerySelectorAllReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *QuerySelectorAllReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// QuerySelectorAllParams are the parameters for DOM.querySelectorAll
+// optional parameters are left out when nil
+type QuerySelectorAllParams struct {
+	/* Id of the node to query upon. */
+	NodeId NodeId `json:"nodeId"`
+	/* Selector string. */
+	Selector string `json:"selector"`
+}
+
+// MarshalCDP writes QuerySelectorAllParams as JSON
+func (v *QuerySelectorAllParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("selector")
+	e.String(v.Selector)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v QuerySelectorAllParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Executes `querySelectorAll` on a given node.
+func (c Client) QuerySelectorAll(params QuerySelectorAllParams) (QuerySelectorAllReturns, error) {
+	return c.QuerySelectorAllContext(context.Background(), params)
+}
+
+// QuerySelectorAllContext is QuerySelectorAll with a context for cancellation and deadlines
+func (c Client) QuerySelectorAllContext(ctx context.Context, params QuerySelectorAllParams) (QuerySelectorAllReturns, error) {
+	var returns_ QuerySelectorAllReturns
+
+	err_ := c.caller.Call(ctx, "DOM.querySelectorAll", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveAttributeReturns struct {
+}
+
+// UnmarshalCDP reads RemoveAttributeReturns from JSON
+func (v *RemoveAttributeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveAttributeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveAttributeParams are the parameters for DOM.removeAttribute
+// optional parameters are left out when nil
+type RemoveAttributeParams struct {
+	/* Id of the element to remove attribute from. */
+	NodeId NodeId `json:"nodeId"`
+	/* Name of the attribute to remove. */
+	Name string `json:"name"`
+}
+
+// MarshalCDP writes RemoveAttributeParams as JSON
+func (v *RemoveAttributeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("name")
+	e.String(v.Name)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveAttributeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes attribute with given name from an element with given id.
+func (c Client) RemoveAttribute(params RemoveAttributeParams) (RemoveAttributeReturns, error) {
+	return c.RemoveAttributeContext(context.Background(), params)
+}
+
+// RemoveAttributeContext is RemoveAttribute with a context for cancellation and deadlines
+func (c Client) RemoveAttributeContext(ctx context.Context, params RemoveAttributeParams) (RemoveAttributeReturns, error) {
+	var returns_ RemoveAttributeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.removeAttribute", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveNodeReturns struct {
+}
+
+// UnmarshalCDP reads RemoveNodeReturns from JSON
+func (v *RemoveNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveNodeParams are the parameters for DOM.removeNode
+// optional parameters are left out when nil
+type RemoveNodeParams struct {
+	/* Id of the node to remove. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes RemoveNodeParams as JSON
+func (v *RemoveNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes node with given id.
+func (c Client) RemoveNode(params RemoveNodeParams) (RemoveNodeReturns, error) {
+	return c.RemoveNodeContext(context.Background(), params)
+}
+
+// RemoveNodeContext is RemoveNode with a context for cancellation and deadlines
+func (c Client) RemoveNodeContext(ctx context.Context, params RemoveNodeParams) (RemoveNodeReturns, error) {
+	var returns_ RemoveNodeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.removeNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestChildNodesReturns struct {
+}
+
+// UnmarshalCDP reads RequestChildNodesReturns from JSON
+func (v *RequestChildNodesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestChildNodesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestChildNodesParams are the parameters for DOM.requestChildNodes
+// optional parameters are left out when nil
+type RequestChildNodesParams struct {
+	/* Id of the node to get children for. */
+	NodeId NodeId `json:"nodeId"`
+	/* The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the
+	entire subtree or provide an integer larger than 0. */
+	Depth *int `json:"depth,omitempty"`
+	/* Whether or not iframes and shadow roots should be traversed when returning the sub-tree
+	(default is false). */
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// MarshalCDP writes RequestChildNodesParams as JSON
+func (v *RequestChildNodesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	if v.Depth != nil {
+		e.Key("depth")
+		e.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		e.Key("pierce")
+		e.Bool(*v.Pierce)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestChildNodesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that children of the node with given id are returned to the caller in form of
+// `setChildNodes` events where not only immediate children are retrieved, but all children down to
+// the specified depth.
+func (c Client) RequestChildNodes(params RequestChildNodesParams) (RequestChildNodesReturns, error) {
+	return c.RequestChildNodesContext(context.Background(), params)
+}
+
+// RequestChildNodesContext is RequestChildNodes with a context for cancellation and deadlines
+func (c Client) RequestChildNodesContext(ctx context.Context, params RequestChildNodesParams) (RequestChildNodesReturns, error) {
+	var returns_ RequestChildNodesReturns
+
+	err_ := c.caller.Call(ctx, "DOM.requestChildNodes", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestNodeReturns struct {
+
+	/* Node id for given object. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads RequestNodeReturns from JSON
+func (v *RequestNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestNodeParams are the parameters for DOM.requestNode
+// optional parameters are left out when nil
+type RequestNodeParams struct {
+	/* JavaScript object id to convert into node. */
+	ObjectId cdp.RuntimeRemoteObjectId `json:"objectId"`
+}
+
+// MarshalCDP writes RequestNodeParams as JSON
+func (v *RequestNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that the node is sent to the caller given the JavaScript node object reference. All
+// nodes that form the path from the node to the root are also sent to the client as a series of
+// `setChildNodes` notifications.
+func (c Client) RequestNode(params RequestNodeParams) (RequestNodeReturns, error) {
+	return c.RequestNodeContext(context.Background(), params)
+}
+
+// RequestNodeContext is RequestNode with a context for cancellation and deadlines
+func (c Client) RequestNodeContext(ctx context.Context, params RequestNodeParams) (RequestNodeReturns, error) {
+	var returns_ RequestNodeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.requestNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ResolveNodeReturns struct {
+
+	/* JavaScript object wrapper for given node. */
+	Object cdp.RuntimeRemoteObject `json:"object"`
+}
+
+// UnmarshalCDP reads ResolveNodeReturns from JSON
+func (v *ResolveNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "object":
+			v.Object.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResolveNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ResolveNodeParams are the parameters for DOM.resolveNode
+// optional parameters are left out when nil
+type ResolveNodeParams struct {
+	/* Id of the node to resolve. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Backend identifier of the node to resolve. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* Symbolic group name that can be used to release multiple objects. */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+	/* Execution context in which to resolve the node. */
+	ExecutionContextId *cdp.RuntimeExecutionContextId `json:"executionContextId,omitempty"`
+}
+
+// MarshalCDP writes ResolveNodeParams as JSON
+func (v *ResolveNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResolveNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Resolves the JavaScript node object for a given NodeId or BackendNodeId.
+func (c Client) ResolveNode(params ResolveNodeParams) (ResolveNodeReturns, error) {
+	return c.ResolveNodeContext(context.Background(), params)
+}
+
+// ResolveNodeContext is ResolveNode with a context for cancellation and deadlines
+func (c Client) ResolveNodeContext(ctx context.Context, params ResolveNodeParams) (ResolveNodeReturns, error) {
+	var returns_ ResolveNodeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.resolveNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAttributeValueReturns struct {
+}
+
+// UnmarshalCDP reads SetAttributeValueReturns from JSON
+func (v *SetAttributeValueReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAttributeValueReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAttributeValueParams are the parameters for DOM.setAttributeValue
+// optional parameters are left out when nil
+type SetAttributeValueParams struct {
+	/* Id of the element to set attribute for. */
+	NodeId NodeId `json:"nodeId"`
+	/* Attribute name. */
+	Name string `json:"name"`
+	/* Attribute value. */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes SetAttributeValueParams as JSON
+func (v *SetAttributeValueParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAttributeValueParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets attribute for an element with given id.
+func (c Client) SetAttributeValue(params SetAttributeValueParams) (SetAttributeValueReturns, error) {
+	return c.SetAttributeValueContext(context.Background(), params)
+}
+
+// SetAttributeValueContext is SetAttributeValue with a context for cancellation and deadlines
+func (c Client) SetAttributeValueContext(ctx context.Context, params SetAttributeValueParams) (SetAttributeValueReturns, error) {
+	var returns_ SetAttributeValueReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setAttributeValue", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAttributesAsTextReturns struct {
+}
+
+// UnmarshalCDP reads SetAttributesAsTextReturns from JSON
+func (v *SetAttributesAsTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAttributesAsTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAttributesAsTextParams are the parameters for DOM.setAttributesAsText
+// optional parameters are left out when nil
+type SetAttributesAsTextParams struct {
+	/* Id of the element to set attributes for. */
+	NodeId NodeId `json:"nodeId"`
+	/* Text with a number of attributes. Will parse this text using HTML parser. */
+	Text string `json:"text"`
+	/* Attribute name to replace with new attributes derived from text in case text parsed
+	successfully. */
+	Name *string `json:"name,omitempty"`
+}
+
+// MarshalCDP writes SetAttributesAsTextParams as JSON
+func (v *SetAttributesAsTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("text")
+	e.String(v.Text)
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAttributesAsTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets attributes on element with given id. This method is useful when user edits some existing
+// attribute value and types in several attribute name/value pairs.
+func (c Client) SetAttributesAsText(params SetAttributesAsTextParams) (SetAttributesAsTextReturns, error) {
+	return c.SetAttributesAsTextContext(context.Background(), params)
+}
+
+// SetAttributesAsTextContext is SetAttributesAsText with a context for cancellation and deadlines
+func (c Client) SetAttributesAsTextContext(ctx context.Context, params SetAttributesAsTextParams) (SetAttributesAsTextReturns, error) {
+	var returns_ SetAttributesAsTextReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setAttributesAsText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetFileInputFilesReturns struct {
+}
+
+// UnmarshalCDP reads SetFileInputFilesReturns from JSON
+func (v *SetFileInputFilesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetFileInputFilesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetFileInputFilesParams are the parameters for DOM.setFileInputFiles
+// optional parameters are left out when nil
+type SetFileInputFilesParams struct {
+	/* Array of file paths to set. */
+	Files []string `json:"files"`
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes SetFileInputFilesParams as JSON
+func (v *SetFileInputFilesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("files")
+	if v.Files == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Files {
+			e.Elem()
+			e.String(v.Files[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetFileInputFilesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets files for the given file input element.
+func (c Client) SetFileInputFiles(params SetFileInputFilesParams) (SetFileInputFilesReturns, error) {
+	return c.SetFileInputFilesContext(context.Background(), params)
+}
+
+// SetFileInputFilesContext is SetFileInputFiles with a context for cancellation and deadlines
+func (c Client) SetFileInputFilesContext(ctx context.Context, params SetFileInputFilesParams) (SetFileInputFilesReturns, error) {
+	var returns_ SetFileInputFilesReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setFileInputFiles", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetNodeNameReturns struct {
+
+	/* New node's id. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads SetNodeNameReturns from JSON
+func (v *SetNodeNameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetNodeNameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetNodeNameParams are the parameters for DOM.setNodeName
+// optional parameters are left out when nil
+type SetNodeNameParams struct {
+	/* Id of the node to set name for. */
+	NodeId NodeId `json:"nodeId"`
+	/* New node's name. */
+	Name string `json:"name"`
+}
+
+// MarshalCDP writes SetNodeNameParams as JSON
+func (v *SetNodeNameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("name")
+	e.String(v.Name)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetNodeNameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets node name for a node with given id.
+func (c Client) SetNodeName(params SetNodeNameParams) (SetNodeNameReturns, error) {
+	return c.SetNodeNameContext(context.Background(), params)
+}
+
+// SetNodeNameContext is SetNodeName with a context for cancellation and deadlines
+func (c Client) SetNodeNameContext(ctx context.Context, params SetNodeNameParams) (SetNodeNameReturns, error) {
+	var returns_ SetNodeNameReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setNodeName", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetNodeValueReturns struct {
+}
+
+// UnmarshalCDP reads SetNodeValueReturns from JSON
+func (v *SetNodeValueReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetNodeValueReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetNodeValueParams are the parameters for DOM.setNodeValue
+// optional parameters are left out when nil
+type SetNodeValueParams struct {
+	/* Id of the node to set value for. */
+	NodeId NodeId `json:"nodeId"`
+	/* New node's value. */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes SetNodeValueParams as JSON
+func (v *SetNodeValueParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetNodeValueParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets node value for a node with given id.
+func (c Client) SetNodeValue(params SetNodeValueParams) (SetNodeValueReturns, error) {
+	return c.SetNodeValueContext(context.Background(), params)
+}
+
+// SetNodeValueContext is SetNodeValue with a context for cancellation and deadlines
+func (c Client) SetNodeValueContext(ctx context.Context, params SetNodeValueParams) (SetNodeValueReturns, error) {
+	var returns_ SetNodeValueReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setNodeValue", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetOuterHTMLReturns struct {
+}
+
+// UnmarshalCDP reads SetOuterHTMLReturns from JSON
+func (v *SetOuterHTMLReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetOuterHTMLReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetOuterHTMLParams are the parameters for DOM.setOuterHTML
+// optional parameters are left out when nil
+type SetOuterHTMLParams struct {
+	/* Id of the node to set markup for. */
+	NodeId NodeId `json:"nodeId"`
+	/* Outer HTML markup to set. */
+	OuterHTML string `json:"outerHTML"`
+}
+
+// MarshalCDP writes SetOuterHTMLParams as JSON
+func (v *SetOuterHTMLParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("outerHTML")
+	e.String(v.OuterHTML)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetOuterHTMLParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets node HTML markup, returns new node id.
+func (c Client) SetOuterHTML(params SetOuterHTMLParams) (SetOuterHTMLReturns, error) {
+	return c.SetOuterHTMLContext(context.Background(), params)
+}
+
+// SetOuterHTMLContext is SetOuterHTML with a context for cancellation and deadlines
+func (c Client) SetOuterHTMLContext(ctx context.Context, params SetOuterHTMLParams) (SetOuterHTMLReturns, error) {
+	var returns_ SetOuterHTMLReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setOuterHTML", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fired when `Element`'s attribute is modified.
+type AttributeModifiedEvent struct {
+
+	/* Id of the node that has changed. */
+	NodeId NodeId `json:"nodeId"`
+
+	/* Attribute name. */
+	Name string `json:"name"`
+
+	/* Attribute value. */
+	Value string `json:"value"`
+}
+
+// UnmarshalCDP reads AttributeModifiedEvent from JSON
+func (v *AttributeModifiedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributeModifiedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributeModifiedHandler func(ev AttributeModifiedEvent)
+
+// EventMethod is DOM.attributeModified
+func (AttributeModifiedEvent) EventMethod() string {
+	return "DOM.attributeModified"
+}
+
+// OnAttributeModified calls handler for each DOM.attributeModified event
+func (c Client) OnAttributeModified(handler AttributeModifiedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.attributeModified", func(ev interface{}) {
+		handler(ev.(AttributeModifiedEvent))
+	})
+}
+
+// Fired when `Element`'s attribute is removed.
+type AttributeRemovedEvent struct {
+
+	/* Id of the node that has changed. */
+	NodeId NodeId `json:"nodeId"`
+
+	/* A ttribute name. */
+	Name string `json:"name"`
+}
+
+// UnmarshalCDP reads AttributeRemovedEvent from JSON
+func (v *AttributeRemovedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		case "name":
+			v.Name = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributeRemovedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributeRemovedHandler func(ev AttributeRemovedEvent)
+
+// EventMethod is DOM.attributeRemoved
+func (AttributeRemovedEvent) EventMethod() string {
+	return "DOM.attributeRemoved"
+}
+
+// OnAttributeRemoved calls handler for each DOM.attributeRemoved event
+func (c Client) OnAttributeRemoved(handler AttributeRemovedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.attributeRemoved", func(ev interface{}) {
+		handler(ev.(AttributeRemovedEvent))
+	})
+}
+
+// Mirrors `DOMCharacterDataModified` event.
+type CharacterDataModifiedEvent struct {
+
+	/* Id of the node that has changed. */
+	NodeId NodeId `json:"nodeId"`
+
+	/* New text value. */
+	CharacterData string `json:"characterData"`
+}
+
+// UnmarshalCDP reads CharacterDataModifiedEvent from JSON
+func (v *CharacterDataModifiedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		case "characterData":
+			v.CharacterData = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CharacterDataModifiedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CharacterDataModifiedHandler func(ev CharacterDataModifiedEvent)
+
+// EventMethod is DOM.characterDataModified
+func (CharacterDataModifiedEvent) EventMethod() string {
+	return "DOM.characterDataModified"
+}
+
+// OnCharacterDataModified calls handler for each DOM.characterDataModified event
+func (c Client) OnCharacterDataModified(handler CharacterDataModifiedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.characterDataModified", func(ev interface{}) {
+		handler(ev.(CharacterDataModifiedEvent))
+	})
+}
+
+// Fired when `Container`'s child node count has changed.
+type ChildNodeCountUpdatedEvent struct {
+
+	/* Id of the node that has changed. */
+	NodeId NodeId `json:"nodeId"`
+
+	/* New node count. */
+	ChildNodeCount int `json:"childNodeCount"`
+}
+
+// UnmarshalCDP reads ChildNodeCountUpdatedEvent from JSON
+func (v *ChildNodeCountUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		case "childNodeCount":
+			v.ChildNodeCount = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ChildNodeCountUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ChildNodeCountUpdatedHandler func(ev ChildNodeCountUpdatedEvent)
+
+// EventMethod is DOM.childNodeCountUpdated
+func (ChildNodeCountUpdatedEvent) EventMethod() string {
+	return "DOM.childNodeCountUpdated"
+}
+
+// OnChildNodeCountUpdated calls handler for each DOM.childNodeCountUpdated event
+func (c Client) OnChildNodeCountUpdated(handler ChildNodeCountUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.childNodeCountUpdated", func(ev interface{}) {
+		handler(ev.(ChildNodeCountUpdatedEvent))
+	})
+}
+
+// Mirrors `DOMNodeInserted` event.
+type ChildNodeInsertedEvent struct {
+
+	/* Id of the node that has changed. */
+	ParentNodeId NodeId `json:"parentNodeId"`
+
+	/* Id of the previous sibling. */
+	PreviousNodeId NodeId `json:"previousNodeId"`
+
+	/* Inserted node data. */
+	Node Node `json:"node"`
+}
+
+// UnmarshalCDP reads ChildNodeInsertedEvent from JSON
+func (v *ChildNodeInsertedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentNodeId":
+			v.ParentNodeId = NodeId(d.Int())
+		case "previousNodeId":
+			v.PreviousNodeId = NodeId(d.Int())
+		case "node":
+			v.Node.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ChildNodeInsertedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ChildNodeInsertedHandler func(ev ChildNodeInsertedEvent)
+
+// EventMethod is DOM.childNodeInserted
+func (ChildNodeInsertedEvent) EventMethod() string {
+	return "DOM.childNodeInserted"
+}
+
+// OnChildNodeInserted calls handler for each DOM.childNodeInserted event
+func (c Client) OnChildNodeInserted(handler ChildNodeInsertedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.childNodeInserted", func(ev interface{}) {
+		handler(ev.(ChildNodeInsertedEvent))
+	})
+}
+
+// Mirrors `DOMNodeRemoved` event.
+type ChildNodeRemovedEvent struct {
+
+	/* Parent id. */
+	ParentNodeId NodeId `json:"parentNodeId"`
+
+	/* Id of the node that has been removed. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads ChildNodeRemovedEvent from JSON
+func (v *ChildNodeRemovedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentNodeId":
+			v.ParentNodeId = NodeId(d.Int())
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ChildNodeRemovedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ChildNodeRemovedHandler func(ev ChildNodeRemovedEvent)
+
+// EventMethod is DOM.childNodeRemoved
+func (ChildNodeRemovedEvent) EventMethod() string {
+	return "DOM.childNodeRemoved"
+}
+
+// OnChildNodeRemoved calls handler for each DOM.childNodeRemoved event
+func (c Client) OnChildNodeRemoved(handler ChildNodeRemovedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.childNodeRemoved", func(ev interface{}) {
+		handler(ev.(ChildNodeRemovedEvent))
+	})
+}
+
+// Fired when `Document` has been totally updated. Node ids are no longer valid.
+type DocumentUpdatedEvent struct {
+}
+
+// UnmarshalCDP reads DocumentUpdatedEvent from JSON
+func (v *DocumentUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DocumentUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DocumentUpdatedHandler func(ev DocumentUpdatedEvent)
+
+// EventMethod is DOM.documentUpdated
+func (DocumentUpdatedEvent) EventMethod() string {
+	return "DOM.documentUpdated"
+}
+
+// OnDocumentUpdated calls handler for each DOM.documentUpdated event
+func (c Client) OnDocumentUpdated(handler DocumentUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.documentUpdated", func(ev interface{}) {
+		handler(ev.(DocumentUpdatedEvent))
+	})
+}
+
+// Fired when backend wants to provide client with the missing DOM structure. This happens upon
+// most of the calls requesting node ids.
+type SetChildNodesEvent struct {
+
+	/* Parent node id to populate with children. */
+	ParentId NodeId `json:"parentId"`
+
+	/* Child nodes array. */
+	Nodes []Node `json:"nodes"`
+}
+
+// UnmarshalCDP reads SetChildNodesEvent from JSON
+func (v *SetChildNodesEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentId":
+			v.ParentId = NodeId(d.Int())
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]Node, 0)
+				for d.More() {
+					var x0 Node
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetChildNodesEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SetChildNodesHandler func(ev SetChildNodesEvent)
+
+// EventMethod is DOM.setChildNodes
+func (SetChildNodesEvent) EventMethod() string {
+	return "DOM.setChildNodes"
+}
+
+// OnSetChildNodes calls handler for each DOM.setChildNodes event
+func (c Client) OnSetChildNodes(handler SetChildNodesHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.setChildNodes", func(ev interface{}) {
+		handler(ev.(SetChildNodesEvent))
+	})
+}
+
+// decoders for DOM events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"DOM.attributeModified":     cdp.Decode[AttributeModifiedEvent],
+	"DOM.attributeRemoved":      cdp.Decode[AttributeRemovedEvent],
+	"DOM.characterDataModified": cdp.Decode[CharacterDataModifiedEvent],
+	"DOM.childNodeCountUpdated": cdp.Decode[ChildNodeCountUpdatedEvent],
+	"DOM.childNodeInserted":     cdp.Decode[ChildNodeInsertedEvent],
+	"DOM.childNodeRemoved":      cdp.Decode[ChildNodeRemovedEvent],
+	"DOM.documentUpdated":       cdp.Decode[DocumentUpdatedEvent],
+	"DOM.setChildNodes":         cdp.Decode[SetChildNodesEvent],
+}
+
+// DecodeEvent decodes a DOM event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,2134 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package dom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type CollectClassNamesFromSubtreeReturns struct {
+
+	/* Class name list. */
+	ClassNames []string `json:"classNames"`
+}
+
+// UnmarshalCDP reads CollectClassNamesFromSubtreeReturns from JSON
+func (v *CollectClassNamesFromSubtreeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "classNames":
+			if d.Array() {
+				v.ClassNames = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.ClassNames = append(v.ClassNames, x0)
+				}
+			} else {
+				v.ClassNames = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CollectClassNamesFromSubtreeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CollectClassNamesFromSubtreeParams are the parameters for DOM.collectClassNamesFromSubtree
+// optional parameters are left out when nil
+type CollectClassNamesFromSubtreeParams struct {
+	/* Id of the node to collect class names. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes CollectClassNamesFromSubtreeParams as JSON
+func (v *CollectClassNamesFromSubtreeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CollectClassNamesFromSubtreeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Collects class names for the node with given id and all of it's child nodes.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CollectClassNamesFromSubtree(params CollectClassNamesFromSubtreeParams) (CollectClassNamesFromSubtreeReturns, error) {
+	return c.CollectClassNamesFromSubtreeContext(context.Background(), params)
+}
+
+// CollectClassNamesFromSubtreeContext is CollectClassNamesFromSubtree with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CollectClassNamesFromSubtreeContext(ctx context.Context, params CollectClassNamesFromSubtreeParams) (CollectClassNamesFromSubtreeReturns, error) {
+	var returns_ CollectClassNamesFromSubtreeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.collectClassNamesFromSubtree", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CopyToReturns struct {
+
+	/* Id of the node clone. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads CopyToReturns from JSON
+func (v *CopyToReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CopyToReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CopyToParams are the parameters for DOM.copyTo
+// optional parameters are left out when nil
+type CopyToParams struct {
+	/* Id of the node to copy. */
+	NodeId NodeId `json:"nodeId"`
+	/* Id of the element to drop the copy into. */
+	TargetNodeId NodeId `json:"targetNodeId"`
+	/* Drop the copy before this node (if absent, the copy becomes the last child of
+	`targetNodeId`). */
+	InsertBeforeNodeId *NodeId `json:"insertBeforeNodeId,omitempty"`
+}
+
+// MarshalCDP writes CopyToParams as JSON
+func (v *CopyToParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("targetNodeId")
+	e.Int(int(v.TargetNodeId))
+	if v.InsertBeforeNodeId != nil {
+		e.Key("insertBeforeNodeId")
+		e.Int(int(*v.InsertBeforeNodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CopyToParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Creates a deep copy of the specified node and places it into the target container before the
+// given anchor.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CopyTo(params CopyToParams) (CopyToReturns, error) {
+	return c.CopyToContext(context.Background(), params)
+}
+
+// CopyToContext is CopyTo with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CopyToContext(ctx context.Context, params CopyToParams) (CopyToReturns, error) {
+	var returns_ CopyToReturns
+
+	err_ := c.caller.Call(ctx, "DOM.copyTo", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DiscardSearchResultsReturns struct {
+}
+
+// UnmarshalCDP reads DiscardSearchResultsReturns from JSON
+func (v *DiscardSearchResultsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DiscardSearchResultsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DiscardSearchResultsParams are the parameters for DOM.discardSearchResults
+// optional parameters are left out when nil
+type DiscardSearchResultsParams struct {
+	/* Unique search session identifier. */
+	SearchId string `json:"searchId"`
+}
+
+// MarshalCDP writes DiscardSearchResultsParams as JSON
+func (v *DiscardSearchResultsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("searchId")
+	e.String(v.SearchId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DiscardSearchResultsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Discards search results from the session with the given id. `getSearchResults` should no longer
+// be called for that search.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DiscardSearchResults(params DiscardSearchResultsParams) (DiscardSearchResultsReturns, error) {
+	return c.DiscardSearchResultsContext(context.Background(), params)
+}
+
+// DiscardSearchResultsContext is DiscardSearchResults with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DiscardSearchResultsContext(ctx context.Context, params DiscardSearchResultsParams) (DiscardSearchResultsReturns, error) {
+	var returns_ DiscardSearchResultsReturns
+
+	err_ := c.caller.Call(ctx, "DOM.discardSearchResults", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetContentQuadsReturns struct {
+
+	/* Quads that describe node layout relative to viewport. */
+	Quads []Quad `json:"quads"`
+}
+
+// UnmarshalCDP reads GetContentQuadsReturns from JSON
+func (v *GetContentQuadsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "quads":
+			if d.Array() {
+				v.Quads = make([]Quad, 0)
+				for d.More() {
+					var x0 Quad
+					if d.Array() {
+						x0 = make(Quad, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Quads = append(v.Quads, x0)
+				}
+			} else {
+				v.Quads = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetContentQuadsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetContentQuadsParams are the parameters for DOM.getContentQuads
+// optional parameters are left out when nil
+type GetContentQuadsParams struct {
+	/* Identifier of the node. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node. */
+	BackendNodeId *BackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node wrapper. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes GetContentQuadsParams as JSON
+func (v *GetContentQuadsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetContentQuadsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns quads that describe node position on the page. This method
+// might return multiple quads for inline nodes.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetContentQuads(params GetContentQuadsParams) (GetContentQuadsReturns, error) {
+	return c.GetContentQuadsContext(context.Background(), params)
+}
+
+// GetContentQuadsContext is GetContentQuads with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetContentQuadsContext(ctx context.Context, params GetContentQuadsParams) (GetContentQuadsReturns, error) {
+	var returns_ GetContentQuadsReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getContentQuads", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetNodesForSubtreeByStyleReturns struct {
+
+	/* Resulting nodes. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads GetNodesForSubtreeByStyleReturns from JSON
+func (v *GetNodesForSubtreeByStyleReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetNodesForSubtreeByStyleReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetNodesForSubtreeByStyleParams are the parameters for DOM.getNodesForSubtreeByStyle
+// optional parameters are left out when nil
+type GetNodesForSubtreeByStyleParams struct {
+	/* Node ID pointing to the root of a subtree. */
+	NodeId NodeId `json:"nodeId"`
+	/* The style to filter nodes by (includes nodes if any of properties matches). */
+	ComputedStyles []CSSComputedStyleProperty `json:"computedStyles"`
+	/* Whether or not iframes and shadow roots in the same target should be traversed when returning the
+	results (default is false). */
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// MarshalCDP writes GetNodesForSubtreeByStyleParams as JSON
+func (v *GetNodesForSubtreeByStyleParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("computedStyles")
+	if v.ComputedStyles == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ComputedStyles {
+			e.Elem()
+			v.ComputedStyles[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Pierce != nil {
+		e.Key("pierce")
+		e.Bool(*v.Pierce)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetNodesForSubtreeByStyleParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Finds nodes with a given computed style in a subtree.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetNodesForSubtreeByStyle(params GetNodesForSubtreeByStyleParams) (GetNodesForSubtreeByStyleReturns, error) {
+	return c.GetNodesForSubtreeByStyleContext(context.Background(), params)
+}
+
+// GetNodesForSubtreeByStyleContext is GetNodesForSubtreeByStyle with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetNodesForSubtreeByStyleContext(ctx context.Context, params GetNodesForSubtreeByStyleParams) (GetNodesForSubtreeByStyleReturns, error) {
+	var returns_ GetNodesForSubtreeByStyleReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getNodesForSubtreeByStyle", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetRelayoutBoundaryReturns struct {
+
+	/* Relayout boundary node id for the given node. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads GetRelayoutBoundaryReturns from JSON
+func (v *GetRelayoutBoundaryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetRelayoutBoundaryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetRelayoutBoundaryParams are the parameters for DOM.getRelayoutBoundary
+// optional parameters are left out when nil
+type GetRelayoutBoundaryParams struct {
+	/* Id of the node. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetRelayoutBoundaryParams as JSON
+func (v *GetRelayoutBoundaryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetRelayoutBoundaryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the id of the nearest ancestor that is a relayout boundary.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetRelayoutBoundary(params GetRelayoutBoundaryParams) (GetRelayoutBoundaryReturns, error) {
+	return c.GetRelayoutBoundaryContext(context.Background(), params)
+}
+
+// GetRelayoutBoundaryContext is GetRelayoutBoundary with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetRelayoutBoundaryContext(ctx context.Context, params GetRelayoutBoundaryParams) (GetRelayoutBoundaryReturns, error) {
+	var returns_ GetRelayoutBoundaryReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getRelayoutBoundary", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetSearchResultsReturns struct {
+
+	/* Ids of the search result nodes. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads GetSearchResultsReturns from JSON
+func (v *GetSearchResultsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSearchResultsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetSearchResultsParams are the parameters for DOM.getSearchResults
+// optional parameters are left out when nil
+type GetSearchResultsParams struct {
+	/* Unique search session identifier. */
+	SearchId string `json:"searchId"`
+	/* Start index of the search result to be returned. */
+	FromIndex int `json:"fromIndex"`
+	/* End index of the search result to be returned. */
+	ToIndex int `json:"toIndex"`
+}
+
+// MarshalCDP writes GetSearchResultsParams as JSON
+func (v *GetSearchResultsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("searchId")
+	e.String(v.SearchId)
+	e.Key("fromIndex")
+	e.Int(v.FromIndex)
+	e.Key("toIndex")
+	e.Int(v.ToIndex)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetSearchResultsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns search results from given `fromIndex` to given `toIndex` from the search with the given
+// identifier.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSearchResults(params GetSearchResultsParams) (GetSearchResultsReturns, error) {
+	return c.GetSearchResultsContext(context.Background(), params)
+}
+
+// GetSearchResultsContext is GetSearchResults with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSearchResultsContext(ctx context.Context, params GetSearchResultsParams) (GetSearchResultsReturns, error) {
+	var returns_ GetSearchResultsReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getSearchResults", &params, &returns_)
+
+	return returns_, err_
+}
+
+type MarkUndoableStateReturns struct {
+}
+
+// UnmarshalCDP reads MarkUndoableStateReturns from JSON
+func (v *MarkUndoableStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MarkUndoableStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Marks last undoable state.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) MarkUndoableState() (MarkUndoableStateReturns, error) {
+	return c.MarkUndoableStateContext(context.Background())
+}
+
+// MarkUndoableStateContext is MarkUndoableState with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) MarkUndoableStateContext(ctx context.Context) (MarkUndoableStateReturns, error) {
+	var returns_ MarkUndoableStateReturns
+
+	err_ := c.caller.Call(ctx, "DOM.markUndoableState", nil, &returns_)
+
+	return returns_, err_
+}
+
+type PerformSearchReturns struct {
+
+	/* Unique search session identifier. */
+	SearchId string `json:"searchId"`
+
+	/* Number of search results. */
+	ResultCount int `json:"resultCount"`
+}
+
+// UnmarshalCDP reads PerformSearchReturns from JSON
+func (v *PerformSearchReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "searchId":
+			v.SearchId = d.String()
+		case "resultCount":
+			v.ResultCount = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PerformSearchReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// PerformSearchParams are the parameters for DOM.performSearch
+// optional parameters are left out when nil
+type PerformSearchParams struct {
+	/* Plain text or query selector or XPath search query. */
+	Query string `json:"query"`
+	/* True to search in user agent shadow DOM. */
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+}
+
+// MarshalCDP writes PerformSearchParams as JSON
+func (v *PerformSearchParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("query")
+	e.String(v.Query)
+	if v.IncludeUserAgentShadowDOM != nil {
+		e.Key("includeUserAgentShadowDOM")
+		e.Bool(*v.IncludeUserAgentShadowDOM)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PerformSearchParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Searches for a given string in the DOM tree. Use `getSearchResults` to access search results or
+// `cancelSearch` to end this search session.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) PerformSearch(params PerformSearchParams) (PerformSearchReturns, error) {
+	return c.PerformSearchContext(context.Background(), params)
+}
+
+// PerformSearchContext is PerformSearch with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) PerformSearchContext(ctx context.Context, params PerformSearchParams) (PerformSearchReturns, error) {
+	var returns_ PerformSearchReturns
+
+	err_ := c.caller.Call(ctx, "DOM.performSearch", &params, &returns_)
+
+	return returns_, err_
+}
+
+type PushNodeByPathToFrontendReturns struct {
+
+	/* Id of the node for given path. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads PushNodeByPathToFrontendReturns from JSON
+func (v *PushNodeByPathToFrontendReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PushNodeByPathToFrontendReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// PushNodeByPathToFrontendParams are the parameters for DOM.pushNodeByPathToFrontend
+// optional parameters are left out when nil
+type PushNodeByPathToFrontendParams struct {
+	/* Path to node in the proprietary format. */
+	Path string `json:"path"`
+}
+
+// MarshalCDP writes PushNodeByPathToFrontendParams as JSON
+func (v *PushNodeByPathToFrontendParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("path")
+	e.String(v.Path)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PushNodeByPathToFrontendParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that the node is sent to the caller given its path. // FIXME, use XPath
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) PushNodeByPathToFrontend(params PushNodeByPathToFrontendParams) (PushNodeByPathToFrontendReturns, error) {
+	return c.PushNodeByPathToFrontendContext(context.Background(), params)
+}
+
+// PushNodeByPathToFrontendContext is PushNodeByPathToFrontend with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) PushNodeByPathToFrontendContext(ctx context.Context, params PushNodeByPathToFrontendParams) (PushNodeByPathToFrontendReturns, error) {
+	var returns_ PushNodeByPathToFrontendReturns
+
+	err_ := c.caller.Call(ctx, "DOM.pushNodeByPathToFrontend", &params, &returns_)
+
+	return returns_, err_
+}
+
+type PushNodesByBackendIdsToFrontendReturns struct {
+
+	/* The array of ids of pushed nodes that correspond to the backend ids specified in
+	backendNodeIds. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads PushNodesByBackendIdsToFrontendReturns from JSON
+func (v *PushNodesByBackendIdsToFrontendReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PushNodesByBackendIdsToFrontendReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// PushNodesByBackendIdsToFrontendParams are the parameters for DOM.pushNodesByBackendIdsToFrontend
+// optional parameters are left out when nil
+type PushNodesByBackendIdsToFrontendParams struct {
+	/* The array of backend node ids. */
+	BackendNodeIds []BackendNodeId `json:"backendNodeIds"`
+}
+
+// MarshalCDP writes PushNodesByBackendIdsToFrontendParams as JSON
+func (v *PushNodesByBackendIdsToFrontendParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("backendNodeIds")
+	if v.BackendNodeIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.BackendNodeIds {
+			e.Elem()
+			e.Int(int(v.BackendNodeIds[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PushNodesByBackendIdsToFrontendParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that a batch of nodes is sent to the caller given their backend node ids.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) PushNodesByBackendIdsToFrontend(params PushNodesByBackendIdsToFrontendParams) (PushNodesByBackendIdsToFrontendReturns, error) {
+	return c.PushNodesByBackendIdsToFrontendContext(context.Background(), params)
+}
+
+// PushNodesByBackendIdsToFrontendContext is PushNodesByBackendIdsToFrontend with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) PushNodesByBackendIdsToFrontendContext(ctx context.Context, params PushNodesByBackendIdsToFrontendParams) (PushNodesByBackendIdsToFrontendReturns, error) {
+	var returns_ PushNodesByBackendIdsToFrontendReturns
+
+	err_ := c.caller.Call(ctx, "DOM.pushNodesByBackendIdsToFrontend", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetTopLayerElementsReturns struct {
+
+	/* NodeIds of top layer elements */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads GetTopLayerElementsReturns from JSON
+func (v *GetTopLayerElementsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetTopLayerElementsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns NodeIds of current top layer elements.
+// Top layer is rendered closest to the user within a viewport, therefore its elements always
+// appear on top of all other content.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetTopLayerElements() (GetTopLayerElementsReturns, error) {
+	return c.GetTopLayerElementsContext(context.Background())
+}
+
+// GetTopLayerElementsContext is GetTopLayerElements with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetTopLayerElementsContext(ctx context.Context) (GetTopLayerElementsReturns, error) {
+	var returns_ GetTopLayerElementsReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getTopLayerElements", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetElementByRelationReturns struct {
+
+	/* NodeId of the element matching the queried relation. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads GetElementByRelationReturns from JSON
+func (v *GetElementByRelationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetElementByRelationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetElementByRelationParams are the parameters for DOM.getElementByRelation
+// optional parameters are left out when nil
+type GetElementByRelationParams struct {
+	/* Id of the node from which to query the relation. */
+	NodeId NodeId `json:"nodeId"`
+	/* Type of relation to get. */
+	Relation GetElementByRelationRelation `json:"relation"`
+}
+
+// MarshalCDP writes GetElementByRelationParams as JSON
+func (v *GetElementByRelationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("relation")
+	e.String(string(v.Relation))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetElementByRelationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the NodeId of the matched element according to certain relations.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetElementByRelation(params GetElementByRelationParams) (GetElementByRelationReturns, error) {
+	return c.GetElementByRelationContext(context.Background(), params)
+}
+
+// GetElementByRelationContext is GetElementByRelation with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetElementByRelationContext(ctx context.Context, params GetElementByRelationParams) (GetElementByRelationReturns, error) {
+	var returns_ GetElementByRelationReturns
+
+	if !params.Relation.Valid() {
+		return returns_, fmt.Errorf("DOM.getElementByRelation: invalid relation %q", params.Relation)
+	}
+
+	err_ := c.caller.Call(ctx, "DOM.getElementByRelation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RedoReturns struct {
+}
+
+// UnmarshalCDP reads RedoReturns from JSON
+func (v *RedoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RedoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Re-does the last undone action.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) Redo() (RedoReturns, error) {
+	return c.RedoContext(context.Background())
+}
+
+// RedoContext is Redo with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RedoContext(ctx context.Context) (RedoReturns, error) {
+	var returns_ RedoReturns
+
+	err_ := c.caller.Call(ctx, "DOM.redo", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetNodeStackTracesEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetNodeStackTracesEnabledReturns from JSON
+func (v *SetNodeStackTracesEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetNodeStackTracesEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetNodeStackTracesEnabledParams are the parameters for DOM.setNodeStackTracesEnabled
+// optional parameters are left out when nil
+type SetNodeStackTracesEnabledParams struct {
+	/* Enable or disable. */
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes SetNodeStackTracesEnabledParams as JSON
+func (v *SetNodeStackTracesEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetNodeStackTracesEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets if stack traces should be captured for Nodes. See `Node.getNodeStackTraces`. Default is disabled.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetNodeStackTracesEnabled(params SetNodeStackTracesEnabledParams) (SetNodeStackTracesEnabledReturns, error) {
+	return c.SetNodeStackTracesEnabledContext(context.Background(), params)
+}
+
+// SetNodeStackTracesEnabledContext is SetNodeStackTracesEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetNodeStackTracesEnabledContext(ctx context.Context, params SetNodeStackTracesEnabledParams) (SetNodeStackTracesEnabledReturns, error) {
+	var returns_ SetNodeStackTracesEnabledReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setNodeStackTracesEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetNodeStackTracesReturns struct {
+
+	/* Creation stack trace, if available. */
+	Creation *cdp.RuntimeStackTrace `json:"creation,omitempty"`
+}
+
+// UnmarshalCDP reads GetNodeStackTracesReturns from JSON
+func (v *GetNodeStackTracesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "creation":
+			if d.Null() {
+				v.Creation = nil
+			} else {
+				v.Creation = new(cdp.RuntimeStackTrace)
+				(*v.Creation).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetNodeStackTracesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetNodeStackTracesParams are the parameters for DOM.getNodeStackTraces
+// optional parameters are left out when nil
+type GetNodeStackTracesParams struct {
+	/* Id of the node to get stack traces for. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetNodeStackTracesParams as JSON
+func (v *GetNodeStackTracesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetNodeStackTracesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets stack traces associated with a Node. As of now, only provides stack trace for Node creation.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetNodeStackTraces(params GetNodeStackTracesParams) (GetNodeStackTracesReturns, error) {
+	return c.GetNodeStackTracesContext(context.Background(), params)
+}
+
+// GetNodeStackTracesContext is GetNodeStackTraces with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetNodeStackTracesContext(ctx context.Context, params GetNodeStackTracesParams) (GetNodeStackTracesReturns, error) {
+	var returns_ GetNodeStackTracesReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getNodeStackTraces", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetFileInfoReturns struct {
+	Path string `json:"path"`
+}
+
+// UnmarshalCDP reads GetFileInfoReturns from JSON
+func (v *GetFileInfoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "path":
+			v.Path = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetFileInfoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetFileInfoParams are the parameters for DOM.getFileInfo
+// optional parameters are left out when nil
+type GetFileInfoParams struct {
+	/* JavaScript object id of the node wrapper. */
+	ObjectId cdp.RuntimeRemoteObjectId `json:"objectId"`
+}
+
+// MarshalCDP writes GetFileInfoParams as JSON
+func (v *GetFileInfoParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetFileInfoParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns file information for the given
+// File wrapper.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetFileInfo(params GetFileInfoParams) (GetFileInfoReturns, error) {
+	return c.GetFileInfoContext(context.Background(), params)
+}
+
+// GetFileInfoContext is GetFileInfo with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetFileInfoContext(ctx context.Context, params GetFileInfoParams) (GetFileInfoReturns, error) {
+	var returns_ GetFileInfoReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getFileInfo", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetDetachedDomNodesReturns struct {
+
+	/* The list of detached nodes */
+	DetachedNodes []DetachedElementInfo `json:"detachedNodes"`
+}
+
+// UnmarshalCDP reads GetDetachedDomNodesReturns from JSON
+func (v *GetDetachedDomNodesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "detachedNodes":
+			if d.Array() {
+				v.DetachedNodes = make([]DetachedElementInfo, 0)
+				for d.More() {
+					var x0 DetachedElementInfo
+					x0.UnmarshalCDP(d)
+					v.DetachedNodes = append(v.DetachedNodes, x0)
+				}
+			} else {
+				v.DetachedNodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetDetachedDomNodesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns list of detached nodes
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetDetachedDomNodes() (GetDetachedDomNodesReturns, error) {
+	return c.GetDetachedDomNodesContext(context.Background())
+}
+
+// GetDetachedDomNodesContext is GetDetachedDomNodes with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetDetachedDomNodesContext(ctx context.Context) (GetDetachedDomNodesReturns, error) {
+	var returns_ GetDetachedDomNodesReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getDetachedDomNodes", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetInspectedNodeReturns struct {
+}
+
+// UnmarshalCDP reads SetInspectedNodeReturns from JSON
+func (v *SetInspectedNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInspectedNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInspectedNodeParams are the parameters for DOM.setInspectedNode
+// optional parameters are left out when nil
+type SetInspectedNodeParams struct {
+	/* DOM node id to be accessible by means of $x command line API. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes SetInspectedNodeParams as JSON
+func (v *SetInspectedNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInspectedNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables console to refer to the node with given id via $x (see Command Line API for more details
+// $x functions).
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInspectedNode(params SetInspectedNodeParams) (SetInspectedNodeReturns, error) {
+	return c.SetInspectedNodeContext(context.Background(), params)
+}
+
+// SetInspectedNodeContext is SetInspectedNode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInspectedNodeContext(ctx context.Context, params SetInspectedNodeParams) (SetInspectedNodeReturns, error) {
+	var returns_ SetInspectedNodeReturns
+
+	err_ := c.caller.Call(ctx, "DOM.setInspectedNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UndoReturns struct {
+}
+
+// UnmarshalCDP reads UndoReturns from JSON
+func (v *UndoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UndoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Undoes the last performed action.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) Undo() (UndoReturns, error) {
+	return c.UndoContext(context.Background())
+}
+
+// UndoContext is Undo with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) UndoContext(ctx context.Context) (UndoReturns, error) {
+	var returns_ UndoReturns
+
+	err_ := c.caller.Call(ctx, "DOM.undo", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetFrameOwnerReturns struct {
+
+	/* Resulting node. */
+	BackendNodeId BackendNodeId `json:"backendNodeId"`
+
+	/* Id of the node at given coordinates, only when enabled and requested document. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+}
+
+// UnmarshalCDP reads GetFrameOwnerReturns from JSON
+func (v *GetFrameOwnerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backendNodeId":
+			v.BackendNodeId = BackendNodeId(d.Int())
+		case "nodeId":
+			if d.Null() {
+				v.NodeId = nil
+			} else {
+				v.NodeId = new(NodeId)
+				*v.NodeId = NodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetFrameOwnerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetFrameOwnerParams are the parameters for DOM.getFrameOwner
+// optional parameters are left out when nil
+type GetFrameOwnerParams struct {
+	FrameId cdp.PageFrameId `json:"frameId"`
+}
+
+// MarshalCDP writes GetFrameOwnerParams as JSON
+func (v *GetFrameOwnerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetFrameOwnerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns iframe node that owns iframe with the given domain.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetFrameOwner(params GetFrameOwnerParams) (GetFrameOwnerReturns, error) {
+	return c.GetFrameOwnerContext(context.Background(), params)
+}
+
+// GetFrameOwnerContext is GetFrameOwner with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetFrameOwnerContext(ctx context.Context, params GetFrameOwnerParams) (GetFrameOwnerReturns, error) {
+	var returns_ GetFrameOwnerReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getFrameOwner", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetContainerForNodeReturns struct {
+
+	/* The container node for the given node, or null if not found. */
+	NodeId *NodeId `json:"nodeId,omitempty"`
+}
+
+// UnmarshalCDP reads GetContainerForNodeReturns from JSON
+func (v *GetContainerForNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			if d.Null() {
+				v.NodeId = nil
+			} else {
+				v.NodeId = new(NodeId)
+				*v.NodeId = NodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetContainerForNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetContainerForNodeParams are the parameters for DOM.getContainerForNode
+// optional parameters are left out when nil
+type GetContainerForNodeParams struct {
+	NodeId             NodeId        `json:"nodeId"`
+	ContainerName      *string       `json:"containerName,omitempty"`
+	PhysicalAxes       *PhysicalAxes `json:"physicalAxes,omitempty"`
+	LogicalAxes        *LogicalAxes  `json:"logicalAxes,omitempty"`
+	QueriesScrollState *bool         `json:"queriesScrollState,omitempty"`
+	QueriesAnchored    *bool         `json:"queriesAnchored,omitempty"`
+}
+
+// MarshalCDP writes GetContainerForNodeParams as JSON
+func (v *GetContainerForNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	if v.ContainerName != nil {
+		e.Key("containerName")
+		e.String(*v.ContainerName)
+	}
+	if v.PhysicalAxes != nil {
+		e.Key("physicalAxes")
+		e.String(string(*v.PhysicalAxes))
+	}
+	if v.LogicalAxes != nil {
+		e.Key("logicalAxes")
+		e.String(string(*v.LogicalAxes))
+	}
+	if v.QueriesScrollState != nil {
+		e.Key("queriesScrollState")
+		e.Bool(*v.QueriesScrollState)
+	}
+	if v.QueriesAnchored != nil {
+		e.Key("queriesAnchored")
+		e.Bool(*v.QueriesAnchored)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetContainerForNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the query container of the given node based on container query
+// conditions: containerName, physical and logical axes, and whether it queries
+// scroll-state or anchored elements. If no axes are provided and
+// queriesScrollState is false, the style container is returned, which is the
+// direct parent or the closest element with a matching container-name.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetContainerForNode(params GetContainerForNodeParams) (GetContainerForNodeReturns, error) {
+	return c.GetContainerForNodeContext(context.Background(), params)
+}
+
+// GetContainerForNodeContext is GetContainerForNode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetContainerForNodeContext(ctx context.Context, params GetContainerForNodeParams) (GetContainerForNodeReturns, error) {
+	var returns_ GetContainerForNodeReturns
+
+	if params.PhysicalAxes != nil && !params.PhysicalAxes.Valid() {
+		return returns_, fmt.Errorf("DOM.getContainerForNode: invalid physicalAxes %q", *params.PhysicalAxes)
+	}
+
+	if params.LogicalAxes != nil && !params.LogicalAxes.Valid() {
+		return returns_, fmt.Errorf("DOM.getContainerForNode: invalid logicalAxes %q", *params.LogicalAxes)
+	}
+
+	err_ := c.caller.Call(ctx, "DOM.getContainerForNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetQueryingDescendantsForContainerReturns struct {
+
+	/* Descendant nodes with container queries against the given container. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads GetQueryingDescendantsForContainerReturns from JSON
+func (v *GetQueryingDescendantsForContainerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetQueryingDescendantsForContainerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetQueryingDescendantsForContainerParams are the parameters for DOM.getQueryingDescendantsForContainer
+// optional parameters are left out when nil
+type GetQueryingDescendantsForContainerParams struct {
+	/* Id of the container node to find querying descendants from. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetQueryingDescendantsForContainerParams as JSON
+func (v *GetQueryingDescendantsForContainerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetQueryingDescendantsForContainerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the descendants of a container query container that have
+// container queries against this container.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetQueryingDescendantsForContainer(params GetQueryingDescendantsForContainerParams) (GetQueryingDescendantsForContainerReturns, error) {
+	return c.GetQueryingDescendantsForContainerContext(context.Background(), params)
+}
+
+// GetQueryingDescendantsForContainerContext is GetQueryingDescendantsForContainer with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetQueryingDescendantsForContainerContext(ctx context.Context, params GetQueryingDescendantsForContainerParams) (GetQueryingDescendantsForContainerReturns, error) {
+	var returns_ GetQueryingDescendantsForContainerReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getQueryingDescendantsForContainer", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetAnchorElementReturns struct {
+
+	/* The anchor element of the given anchor query. */
+	NodeId NodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads GetAnchorElementReturns from JSON
+func (v *GetAnchorElementReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAnchorElementReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAnchorElementParams are the parameters for DOM.getAnchorElement
+// optional parameters are left out when nil
+type GetAnchorElementParams struct {
+	/* Id of the positioned element from which to find the anchor. */
+	NodeId NodeId `json:"nodeId"`
+	/* An optional anchor specifier, as defined in
+	https://www.w3.org/TR/css-anchor-position-1/#anchor-specifier.
+	If not provided, it will return the implicit anchor element for
+	the given positioned element. */
+	AnchorSpecifier *string `json:"anchorSpecifier,omitempty"`
+}
+
+// MarshalCDP writes GetAnchorElementParams as JSON
+func (v *GetAnchorElementParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	if v.AnchorSpecifier != nil {
+		e.Key("anchorSpecifier")
+		e.String(*v.AnchorSpecifier)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAnchorElementParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the target anchor element of the given anchor query according to
+// https://www.w3.org/TR/css-anchor-position-1/#target.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAnchorElement(params GetAnchorElementParams) (GetAnchorElementReturns, error) {
+	return c.GetAnchorElementContext(context.Background(), params)
+}
+
+// GetAnchorElementContext is GetAnchorElement with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAnchorElementContext(ctx context.Context, params GetAnchorElementParams) (GetAnchorElementReturns, error) {
+	var returns_ GetAnchorElementReturns
+
+	err_ := c.caller.Call(ctx, "DOM.getAnchorElement", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ForceShowPopoverReturns struct {
+
+	/* List of popovers that were closed in order to respect popover stacking order. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads ForceShowPopoverReturns from JSON
+func (v *ForceShowPopoverReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ForceShowPopoverReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ForceShowPopoverParams are the parameters for DOM.forceShowPopover
+// optional parameters are left out when nil
+type ForceShowPopoverParams struct {
+	/* Id of the popover HTMLElement */
+	NodeId NodeId `json:"nodeId"`
+	/* If true, opens the popover and keeps it open. If false, closes the
+	popover if it was previously force-opened. */
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes ForceShowPopoverParams as JSON
+func (v *ForceShowPopoverParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ForceShowPopoverParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// When enabling, this API force-opens the popover identified by nodeId
+// and keeps it open until disabled.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ForceShowPopover(params ForceShowPopoverParams) (ForceShowPopoverReturns, error) {
+	return c.ForceShowPopoverContext(context.Background(), params)
+}
+
+// ForceShowPopoverContext is ForceShowPopover with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ForceShowPopoverContext(ctx context.Context, params ForceShowPopoverParams) (ForceShowPopoverReturns, error) {
+	var returns_ ForceShowPopoverReturns
+
+	err_ := c.caller.Call(ctx, "DOM.forceShowPopover", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Called when distribution is changed.
+//
+// Experimental: this may change or be removed in any chrome release
+type DistributedNodesUpdatedEvent struct {
+
+	/* Insertion point where distributed nodes were updated. */
+	InsertionPointId NodeId `json:"insertionPointId"`
+
+	/* Distributed nodes for given insertion point. */
+	DistributedNodes []BackendNode `json:"distributedNodes"`
+}
+
+// UnmarshalCDP reads DistributedNodesUpdatedEvent from JSON
+func (v *DistributedNodesUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "insertionPointId":
+			v.InsertionPointId = NodeId(d.Int())
+		case "distributedNodes":
+			if d.Array() {
+				v.DistributedNodes = make([]BackendNode, 0)
+				for d.More() {
+					var x0 BackendNode
+					x0.UnmarshalCDP(d)
+					v.DistributedNodes = append(v.DistributedNodes, x0)
+				}
+			} else {
+				v.DistributedNodes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DistributedNodesUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DistributedNodesUpdatedHandler func(ev DistributedNodesUpdatedEvent)
+
+// EventMethod is DOM.distributedNodesUpdated
+func (DistributedNodesUpdatedEvent) EventMethod() string {
+	return "DOM.distributedNodesUpdated"
+}
+
+// OnDistributedNodesUpdated calls handler for each DOM.distributedNodesUpdated event
+func (c Client) OnDistributedNodesUpdated(handler DistributedNodesUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.distributedNodesUpdated", func(ev interface{}) {
+		handler(ev.(DistributedNodesUpdatedEvent))
+	})
+}
+
+// Fired when `Element`'s inline style is modified via a CSS property modification.
+//
+// Experimental: this may change or be removed in any chrome release
+type InlineStyleInvalidatedEvent struct {
+
+	/* Ids of the nodes for which the inline styles have been invalidated. */
+	NodeIds []NodeId `json:"nodeIds"`
+}
+
+// UnmarshalCDP reads InlineStyleInvalidatedEvent from JSON
+func (v *InlineStyleInvalidatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]NodeId, 0)
+				for d.More() {
+					var x0 NodeId
+					x0 = NodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InlineStyleInvalidatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InlineStyleInvalidatedHandler func(ev InlineStyleInvalidatedEvent)
+
+// EventMethod is DOM.inlineStyleInvalidated
+func (InlineStyleInvalidatedEvent) EventMethod() string {
+	return "DOM.inlineStyleInvalidated"
+}
+
+// OnInlineStyleInvalidated calls handler for each DOM.inlineStyleInvalidated event
+func (c Client) OnInlineStyleInvalidated(handler InlineStyleInvalidatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.inlineStyleInvalidated", func(ev interface{}) {
+		handler(ev.(InlineStyleInvalidatedEvent))
+	})
+}
+
+// Called when a pseudo element is added to an element.
+//
+// Experimental: this may change or be removed in any chrome release
+type PseudoElementAddedEvent struct {
+
+	/* Pseudo element's parent element id. */
+	ParentId NodeId `json:"parentId"`
+
+	/* The added pseudo element. */
+	PseudoElement Node `json:"pseudoElement"`
+}
+
+// UnmarshalCDP reads PseudoElementAddedEvent from JSON
+func (v *PseudoElementAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentId":
+			v.ParentId = NodeId(d.Int())
+		case "pseudoElement":
+			v.PseudoElement.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PseudoElementAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PseudoElementAddedHandler func(ev PseudoElementAddedEvent)
+
+// EventMethod is DOM.pseudoElementAdded
+func (PseudoElementAddedEvent) EventMethod() string {
+	return "DOM.pseudoElementAdded"
+}
+
+// OnPseudoElementAdded calls handler for each DOM.pseudoElementAdded event
+func (c Client) OnPseudoElementAdded(handler PseudoElementAddedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.pseudoElementAdded", func(ev interface{}) {
+		handler(ev.(PseudoElementAddedEvent))
+	})
+}
+
+// Called when top layer elements are changed.
+//
+// Experimental: this may change or be removed in any chrome release
+type TopLayerElementsUpdatedEvent struct {
+}
+
+// UnmarshalCDP reads TopLayerElementsUpdatedEvent from JSON
+func (v *TopLayerElementsUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TopLayerElementsUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TopLayerElementsUpdatedHandler func(ev TopLayerElementsUpdatedEvent)
+
+// EventMethod is DOM.topLayerElementsUpdated
+func (TopLayerElementsUpdatedEvent) EventMethod() string {
+	return "DOM.topLayerElementsUpdated"
+}
+
+// OnTopLayerElementsUpdated calls handler for each DOM.topLayerElementsUpdated event
+func (c Client) OnTopLayerElementsUpdated(handler TopLayerElementsUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.topLayerElementsUpdated", func(ev interface{}) {
+		handler(ev.(TopLayerElementsUpdatedEvent))
+	})
+}
+
+// Fired when a node's scrollability state changes.
+//
+// Experimental: this may change or be removed in any chrome release
+type ScrollableFlagUpdatedEvent struct {
+
+	/* The id of the node. */
+	NodeId NodeId `json:"nodeId"`
+
+	/* If the node is scrollable. */
+	IsScrollable bool `json:"isScrollable"`
+}
+
+// UnmarshalCDP reads ScrollableFlagUpdatedEvent from JSON
+func (v *ScrollableFlagUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = NodeId(d.Int())
+		case "isScrollable":
+			v.IsScrollable = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScrollableFlagUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScrollableFlagUpdatedHandler func(ev ScrollableFlagUpdatedEvent)
+
+// EventMethod is DOM.scrollableFlagUpdated
+func (ScrollableFlagUpdatedEvent) EventMethod() string {
+	return "DOM.scrollableFlagUpdated"
+}
+
+// OnScrollableFlagUpdated calls handler for each DOM.scrollableFlagUpdated event
+func (c Client) OnScrollableFlagUpdated(handler ScrollableFlagUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.scrollableFlagUpdated", func(ev interface{}) {
+		handler(ev.(ScrollableFlagUpdatedEvent))
+	})
+}
+
+// Called when a pseudo element is removed from an element.
+//
+// Experimental: this may change or be removed in any chrome release
+type PseudoElementRemovedEvent struct {
+
+	/* Pseudo element's parent element id. */
+	ParentId NodeId `json:"parentId"`
+
+	/* The removed pseudo element id. */
+	PseudoElementId NodeId `json:"pseudoElementId"`
+}
+
+// UnmarshalCDP reads PseudoElementRemovedEvent from JSON
+func (v *PseudoElementRemovedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentId":
+			v.ParentId = NodeId(d.Int())
+		case "pseudoElementId":
+			v.PseudoElementId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PseudoElementRemovedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PseudoElementRemovedHandler func(ev PseudoElementRemovedEvent)
+
+// EventMethod is DOM.pseudoElementRemoved
+func (PseudoElementRemovedEvent) EventMethod() string {
+	return "DOM.pseudoElementRemoved"
+}
+
+// OnPseudoElementRemoved calls handler for each DOM.pseudoElementRemoved event
+func (c Client) OnPseudoElementRemoved(handler PseudoElementRemovedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.pseudoElementRemoved", func(ev interface{}) {
+		handler(ev.(PseudoElementRemovedEvent))
+	})
+}
+
+// Called when shadow root is popped from the element.
+//
+// Experimental: this may change or be removed in any chrome release
+type ShadowRootPoppedEvent struct {
+
+	/* Host element id. */
+	HostId NodeId `json:"hostId"`
+
+	/* Shadow root id. */
+	RootId NodeId `json:"rootId"`
+}
+
+// UnmarshalCDP reads ShadowRootPoppedEvent from JSON
+func (v *ShadowRootPoppedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "hostId":
+			v.HostId = NodeId(d.Int())
+		case "rootId":
+			v.RootId = NodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ShadowRootPoppedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ShadowRootPoppedHandler func(ev ShadowRootPoppedEvent)
+
+// EventMethod is DOM.shadowRootPopped
+func (ShadowRootPoppedEvent) EventMethod() string {
+	return "DOM.shadowRootPopped"
+}
+
+// OnShadowRootPopped calls handler for each DOM.shadowRootPopped event
+func (c Client) OnShadowRootPopped(handler ShadowRootPoppedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.shadowRootPopped", func(ev interface{}) {
+		handler(ev.(ShadowRootPoppedEvent))
+	})
+}
+
+// Called when shadow root is pushed into the element.
+//
+// Experimental: this may change or be removed in any chrome release
+type ShadowRootPushedEvent struct {
+
+	/* Host element id. */
+	HostId NodeId `json:"hostId"`
+
+	/* Shadow root. */
+	Root Node `json:"root"`
+}
+
+// UnmarshalCDP reads ShadowRootPushedEvent from JSON
+func (v *ShadowRootPushedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "hostId":
+			v.HostId = NodeId(d.Int())
+		case "root":
+			v.Root.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ShadowRootPushedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ShadowRootPushedHandler func(ev ShadowRootPushedEvent)
+
+// EventMethod is DOM.shadowRootPushed
+func (ShadowRootPushedEvent) EventMethod() string {
+	return "DOM.shadowRootPushed"
+}
+
+// OnShadowRootPushed calls handler for each DOM.shadowRootPushed event
+func (c Client) OnShadowRootPushed(handler ShadowRootPushedHandler) (unsubscribe func()) {
+	return c.caller.On("DOM.shadowRootPushed", func(ev interface{}) {
+		handler(ev.(ShadowRootPushedEvent))
+	})
+}
+
+func init() {
+	decoders["DOM.distributedNodesUpdated"] = cdp.Decode[DistributedNodesUpdatedEvent]
+	decoders["DOM.inlineStyleInvalidated"] = cdp.Decode[InlineStyleInvalidatedEvent]
+	decoders["DOM.pseudoElementAdded"] = cdp.Decode[PseudoElementAddedEvent]
+	decoders["DOM.topLayerElementsUpdated"] = cdp.Decode[TopLayerElementsUpdatedEvent]
+	decoders["DOM.scrollableFlagUpdated"] = cdp.Decode[ScrollableFlagUpdatedEvent]
+	decoders["DOM.pseudoElementRemoved"] = cdp.Decode[PseudoElementRemovedEvent]
+	decoders["DOM.shadowRootPopped"] = cdp.Decode[ShadowRootPoppedEvent]
+	decoders["DOM.shadowRootPushed"] = cdp.Decode[ShadowRootPushedEvent]
+}
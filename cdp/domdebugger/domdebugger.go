@@ -0,0 +1,533 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package domdebugger is the DOMDebugger domain of the chrome devtools protocol
+//
+// DOM debugging allows setting breakpoints on particular DOM operations and events. JavaScript
+// execution will stop on these operations as if there was a regular breakpoint set.
+package domdebugger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends DOMDebugger commands and listens for DOMDebugger events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	EventListener = cdp.DOMDebuggerEventListener
+)
+
+// DOM breakpoint type.
+type DOMBreakpointType string
+
+// DOMBreakpointType values
+const (
+	DOMBreakpointTypeSubtreeModified   DOMBreakpointType = "subtree-modified"
+	DOMBreakpointTypeAttributeModified DOMBreakpointType = "attribute-modified"
+	DOMBreakpointTypeNodeRemoved       DOMBreakpointType = "node-removed"
+)
+
+// Values gives every DOMBreakpointType
+func (DOMBreakpointType) Values() []DOMBreakpointType {
+	return []DOMBreakpointType{
+		DOMBreakpointTypeSubtreeModified,
+		DOMBreakpointTypeAttributeModified,
+		DOMBreakpointTypeNodeRemoved,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DOMBreakpointType) Valid() bool {
+	switch v {
+	case DOMBreakpointTypeSubtreeModified, DOMBreakpointTypeAttributeModified, DOMBreakpointTypeNodeRemoved:
+		return true
+	}
+	return false
+}
+
+// CSP Violation type.
+//
+// Experimental: this may change or be removed in any chrome release
+type CSPViolationType string
+
+// CSPViolationType values
+const (
+	CSPViolationTypeTrustedtypeSinkViolation   CSPViolationType = "trustedtype-sink-violation"
+	CSPViolationTypeTrustedtypePolicyViolation CSPViolationType = "trustedtype-policy-violation"
+)
+
+// Values gives every CSPViolationType
+func (CSPViolationType) Values() []CSPViolationType {
+	return []CSPViolationType{
+		CSPViolationTypeTrustedtypeSinkViolation,
+		CSPViolationTypeTrustedtypePolicyViolation,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CSPViolationType) Valid() bool {
+	switch v {
+	case CSPViolationTypeTrustedtypeSinkViolation, CSPViolationTypeTrustedtypePolicyViolation:
+		return true
+	}
+	return false
+}
+
+type GetEventListenersReturns struct {
+
+	/* Array of relevant listeners. */
+	Listeners []EventListener `json:"listeners"`
+}
+
+// UnmarshalCDP reads GetEventListenersReturns from JSON
+func (v *GetEventListenersReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "listeners":
+			if d.Array() {
+				v.Listeners = make([]EventListener, 0)
+				for d.More() {
+					var x0 EventListener
+					x0.UnmarshalCDP(d)
+					v.Listeners = append(v.Listeners, x0)
+				}
+			} else {
+				v.Listeners = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetEventListenersReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetEventListenersParams are the parameters for DOMDebugger.getEventListeners
+// optional parameters are left out when nil
+type GetEventListenersParams struct {
+	/* Identifier of the object to return listeners for. */
+	ObjectId cdp.RuntimeRemoteObjectId `json:"objectId"`
+	/* The maximum depth at which Node children should be retrieved, defaults to 1. Use -1 for the
+	entire subtree or provide an integer larger than 0. */
+	Depth *int `json:"depth,omitempty"`
+	/* Whether or not iframes and shadow roots should be traversed when returning the subtree
+	(default is false). Reports listeners for all contexts if pierce is enabled. */
+	Pierce *bool `json:"pierce,omitempty"`
+}
+
+// MarshalCDP writes GetEventListenersParams as JSON
+func (v *GetEventListenersParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	if v.Depth != nil {
+		e.Key("depth")
+		e.Int(*v.Depth)
+	}
+	if v.Pierce != nil {
+		e.Key("pierce")
+		e.Bool(*v.Pierce)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetEventListenersParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns event listeners of the given object.
+func (c Client) GetEventListeners(params GetEventListenersParams) (GetEventListenersReturns, error) {
+	return c.GetEventListenersContext(context.Background(), params)
+}
+
+// GetEventListenersContext is GetEventListeners with a context for cancellation and deadlines
+func (c Client) GetEventListenersContext(ctx context.Context, params GetEventListenersParams) (GetEventListenersReturns, error) {
+	var returns_ GetEventListenersReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.getEventListeners", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveDOMBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads RemoveDOMBreakpointReturns from JSON
+func (v *RemoveDOMBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveDOMBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveDOMBreakpointParams are the parameters for DOMDebugger.removeDOMBreakpoint
+// optional parameters are left out when nil
+type RemoveDOMBreakpointParams struct {
+	/* Identifier of the node to remove breakpoint from. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+	/* Type of the breakpoint to remove. */
+	Type DOMBreakpointType `json:"type"`
+}
+
+// MarshalCDP writes RemoveDOMBreakpointParams as JSON
+func (v *RemoveDOMBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveDOMBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes DOM breakpoint that was set using `setDOMBreakpoint`.
+func (c Client) RemoveDOMBreakpoint(params RemoveDOMBreakpointParams) (RemoveDOMBreakpointReturns, error) {
+	return c.RemoveDOMBreakpointContext(context.Background(), params)
+}
+
+// RemoveDOMBreakpointContext is RemoveDOMBreakpoint with a context for cancellation and deadlines
+func (c Client) RemoveDOMBreakpointContext(ctx context.Context, params RemoveDOMBreakpointParams) (RemoveDOMBreakpointReturns, error) {
+	var returns_ RemoveDOMBreakpointReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("DOMDebugger.removeDOMBreakpoint: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.removeDOMBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveEventListenerBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads RemoveEventListenerBreakpointReturns from JSON
+func (v *RemoveEventListenerBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveEventListenerBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveEventListenerBreakpointParams are the parameters for DOMDebugger.removeEventListenerBreakpoint
+// optional parameters are left out when nil
+type RemoveEventListenerBreakpointParams struct {
+	/* Event name. */
+	EventName string `json:"eventName"`
+	/* EventTarget interface name.
+
+	Experimental: this may change or be removed in any chrome release */
+	TargetName *string `json:"targetName,omitempty"`
+}
+
+// MarshalCDP writes RemoveEventListenerBreakpointParams as JSON
+func (v *RemoveEventListenerBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventName")
+	e.String(v.EventName)
+	if v.TargetName != nil {
+		e.Key("targetName")
+		e.String(*v.TargetName)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveEventListenerBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes breakpoint on particular DOM event.
+func (c Client) RemoveEventListenerBreakpoint(params RemoveEventListenerBreakpointParams) (RemoveEventListenerBreakpointReturns, error) {
+	return c.RemoveEventListenerBreakpointContext(context.Background(), params)
+}
+
+// RemoveEventListenerBreakpointContext is RemoveEventListenerBreakpoint with a context for cancellation and deadlines
+func (c Client) RemoveEventListenerBreakpointContext(ctx context.Context, params RemoveEventListenerBreakpointParams) (RemoveEventListenerBreakpointReturns, error) {
+	var returns_ RemoveEventListenerBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.removeEventListenerBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveXHRBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads RemoveXHRBreakpointReturns from JSON
+func (v *RemoveXHRBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveXHRBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveXHRBreakpointParams are the parameters for DOMDebugger.removeXHRBreakpoint
+// optional parameters are left out when nil
+type RemoveXHRBreakpointParams struct {
+	/* Resource URL substring. */
+	Url string `json:"url"`
+}
+
+// MarshalCDP writes RemoveXHRBreakpointParams as JSON
+func (v *RemoveXHRBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveXHRBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes breakpoint from XMLHttpRequest.
+func (c Client) RemoveXHRBreakpoint(params RemoveXHRBreakpointParams) (RemoveXHRBreakpointReturns, error) {
+	return c.RemoveXHRBreakpointContext(context.Background(), params)
+}
+
+// RemoveXHRBreakpointContext is RemoveXHRBreakpoint with a context for cancellation and deadlines
+func (c Client) RemoveXHRBreakpointContext(ctx context.Context, params RemoveXHRBreakpointParams) (RemoveXHRBreakpointReturns, error) {
+	var returns_ RemoveXHRBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.removeXHRBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDOMBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads SetDOMBreakpointReturns from JSON
+func (v *SetDOMBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDOMBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDOMBreakpointParams are the parameters for DOMDebugger.setDOMBreakpoint
+// optional parameters are left out when nil
+type SetDOMBreakpointParams struct {
+	/* Identifier of the node to set breakpoint on. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+	/* Type of the operation to stop upon. */
+	Type DOMBreakpointType `json:"type"`
+}
+
+// MarshalCDP writes SetDOMBreakpointParams as JSON
+func (v *SetDOMBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDOMBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets breakpoint on particular operation with DOM.
+func (c Client) SetDOMBreakpoint(params SetDOMBreakpointParams) (SetDOMBreakpointReturns, error) {
+	return c.SetDOMBreakpointContext(context.Background(), params)
+}
+
+// SetDOMBreakpointContext is SetDOMBreakpoint with a context for cancellation and deadlines
+func (c Client) SetDOMBreakpointContext(ctx context.Context, params SetDOMBreakpointParams) (SetDOMBreakpointReturns, error) {
+	var returns_ SetDOMBreakpointReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("DOMDebugger.setDOMBreakpoint: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.setDOMBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetEventListenerBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads SetEventListenerBreakpointReturns from JSON
+func (v *SetEventListenerBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetEventListenerBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetEventListenerBreakpointParams are the parameters for DOMDebugger.setEventListenerBreakpoint
+// optional parameters are left out when nil
+type SetEventListenerBreakpointParams struct {
+	/* DOM Event name to stop on (any DOM event will do). */
+	EventName string `json:"eventName"`
+	/* EventTarget interface name to stop on. If equal to `"*"` or not provided, will stop on any
+	EventTarget.
+
+	Experimental: this may change or be removed in any chrome release */
+	TargetName *string `json:"targetName,omitempty"`
+}
+
+// MarshalCDP writes SetEventListenerBreakpointParams as JSON
+func (v *SetEventListenerBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventName")
+	e.String(v.EventName)
+	if v.TargetName != nil {
+		e.Key("targetName")
+		e.String(*v.TargetName)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetEventListenerBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets breakpoint on particular DOM event.
+func (c Client) SetEventListenerBreakpoint(params SetEventListenerBreakpointParams) (SetEventListenerBreakpointReturns, error) {
+	return c.SetEventListenerBreakpointContext(context.Background(), params)
+}
+
+// SetEventListenerBreakpointContext is SetEventListenerBreakpoint with a context for cancellation and deadlines
+func (c Client) SetEventListenerBreakpointContext(ctx context.Context, params SetEventListenerBreakpointParams) (SetEventListenerBreakpointReturns, error) {
+	var returns_ SetEventListenerBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.setEventListenerBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetXHRBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads SetXHRBreakpointReturns from JSON
+func (v *SetXHRBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetXHRBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetXHRBreakpointParams are the parameters for DOMDebugger.setXHRBreakpoint
+// optional parameters are left out when nil
+type SetXHRBreakpointParams struct {
+	/* Resource URL substring. All XHRs having this substring in the URL will get stopped upon. */
+	Url string `json:"url"`
+}
+
+// MarshalCDP writes SetXHRBreakpointParams as JSON
+func (v *SetXHRBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetXHRBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets breakpoint on XMLHttpRequest.
+func (c Client) SetXHRBreakpoint(params SetXHRBreakpointParams) (SetXHRBreakpointReturns, error) {
+	return c.SetXHRBreakpointContext(context.Background(), params)
+}
+
+// SetXHRBreakpointContext is SetXHRBreakpoint with a context for cancellation and deadlines
+func (c Client) SetXHRBreakpointContext(ctx context.Context, params SetXHRBreakpointParams) (SetXHRBreakpointReturns, error) {
+	var returns_ SetXHRBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.setXHRBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
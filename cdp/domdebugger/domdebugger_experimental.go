@@ -0,0 +1,206 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package domdebugger
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type RemoveInstrumentationBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads RemoveInstrumentationBreakpointReturns from JSON
+func (v *RemoveInstrumentationBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveInstrumentationBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveInstrumentationBreakpointParams are the parameters for DOMDebugger.removeInstrumentationBreakpoint
+// optional parameters are left out when nil
+type RemoveInstrumentationBreakpointParams struct {
+	/* Instrumentation name to stop on. */
+	EventName string `json:"eventName"`
+}
+
+// MarshalCDP writes RemoveInstrumentationBreakpointParams as JSON
+func (v *RemoveInstrumentationBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventName")
+	e.String(v.EventName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveInstrumentationBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes breakpoint on particular native event.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) RemoveInstrumentationBreakpoint(params RemoveInstrumentationBreakpointParams) (RemoveInstrumentationBreakpointReturns, error) {
+	return c.RemoveInstrumentationBreakpointContext(context.Background(), params)
+}
+
+// RemoveInstrumentationBreakpointContext is RemoveInstrumentationBreakpoint with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) RemoveInstrumentationBreakpointContext(ctx context.Context, params RemoveInstrumentationBreakpointParams) (RemoveInstrumentationBreakpointReturns, error) {
+	var returns_ RemoveInstrumentationBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.removeInstrumentationBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBreakOnCSPViolationReturns struct {
+}
+
+// UnmarshalCDP reads SetBreakOnCSPViolationReturns from JSON
+func (v *SetBreakOnCSPViolationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBreakOnCSPViolationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBreakOnCSPViolationParams are the parameters for DOMDebugger.setBreakOnCSPViolation
+// optional parameters are left out when nil
+type SetBreakOnCSPViolationParams struct {
+	/* CSP Violations to stop upon. */
+	ViolationTypes []CSPViolationType `json:"violationTypes"`
+}
+
+// MarshalCDP writes SetBreakOnCSPViolationParams as JSON
+func (v *SetBreakOnCSPViolationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("violationTypes")
+	if v.ViolationTypes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ViolationTypes {
+			e.Elem()
+			e.String(string(v.ViolationTypes[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBreakOnCSPViolationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets breakpoint on particular CSP violations.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBreakOnCSPViolation(params SetBreakOnCSPViolationParams) (SetBreakOnCSPViolationReturns, error) {
+	return c.SetBreakOnCSPViolationContext(context.Background(), params)
+}
+
+// SetBreakOnCSPViolationContext is SetBreakOnCSPViolation with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBreakOnCSPViolationContext(ctx context.Context, params SetBreakOnCSPViolationParams) (SetBreakOnCSPViolationReturns, error) {
+	var returns_ SetBreakOnCSPViolationReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.setBreakOnCSPViolation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetInstrumentationBreakpointReturns struct {
+}
+
+// UnmarshalCDP reads SetInstrumentationBreakpointReturns from JSON
+func (v *SetInstrumentationBreakpointReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInstrumentationBreakpointReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInstrumentationBreakpointParams are the parameters for DOMDebugger.setInstrumentationBreakpoint
+// optional parameters are left out when nil
+type SetInstrumentationBreakpointParams struct {
+	/* Instrumentation name to stop on. */
+	EventName string `json:"eventName"`
+}
+
+// MarshalCDP writes SetInstrumentationBreakpointParams as JSON
+func (v *SetInstrumentationBreakpointParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventName")
+	e.String(v.EventName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInstrumentationBreakpointParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets breakpoint on particular native event.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetInstrumentationBreakpoint(params SetInstrumentationBreakpointParams) (SetInstrumentationBreakpointReturns, error) {
+	return c.SetInstrumentationBreakpointContext(context.Background(), params)
+}
+
+// SetInstrumentationBreakpointContext is SetInstrumentationBreakpoint with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetInstrumentationBreakpointContext(ctx context.Context, params SetInstrumentationBreakpointParams) (SetInstrumentationBreakpointReturns, error) {
+	var returns_ SetInstrumentationBreakpointReturns
+
+	err_ := c.caller.Call(ctx, "DOMDebugger.setInstrumentationBreakpoint", &params, &returns_)
+
+	return returns_, err_
+}
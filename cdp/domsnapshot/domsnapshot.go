@@ -0,0 +1,2256 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package domsnapshot is the DOMSnapshot domain of the chrome devtools protocol
+//
+// This domain facilitates obtaining document snapshots with DOM, layout, and style information.
+//
+// Experimental: this may change or be removed in any chrome release
+package domsnapshot
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends DOMSnapshot commands and listens for DOMSnapshot events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// A Node in the DOM tree.
+type DOMNode struct {
+	/* `Node`'s nodeType. */
+	NodeType int `json:"nodeType"`
+	/* `Node`'s nodeName. */
+	NodeName string `json:"nodeName"`
+	/* `Node`'s nodeValue. */
+	NodeValue string `json:"nodeValue"`
+	/* Only set for textarea elements, contains the text value. */
+	TextValue *string `json:"textValue,omitempty"`
+	/* Only set for input elements, contains the input's associated text value. */
+	InputValue *string `json:"inputValue,omitempty"`
+	/* Only set for radio and checkbox input elements, indicates if the element has been checked */
+	InputChecked *bool `json:"inputChecked,omitempty"`
+	/* Only set for option elements, indicates if the element has been selected */
+	OptionSelected *bool `json:"optionSelected,omitempty"`
+	/* `Node`'s id, corresponds to DOM.Node.backendNodeId. */
+	BackendNodeId cdp.DOMBackendNodeId `json:"backendNodeId"`
+	/* The indexes of the node's child nodes in the `domNodes` array returned by `getSnapshot`, if
+	any. */
+	ChildNodeIndexes []int `json:"childNodeIndexes"`
+	/* Attributes of an `Element` node. */
+	Attributes []NameValue `json:"attributes"`
+	/* Indexes of pseudo elements associated with this node in the `domNodes` array returned by
+	`getSnapshot`, if any. */
+	PseudoElementIndexes []int `json:"pseudoElementIndexes"`
+	/* The index of the node's related layout tree node in the `layoutTreeNodes` array returned by
+	`getSnapshot`, if any. */
+	LayoutNodeIndex *int `json:"layoutNodeIndex,omitempty"`
+	/* Document URL that `Document` or `FrameOwner` node points to. */
+	DocumentURL *string `json:"documentURL,omitempty"`
+	/* Base URL that `Document` or `FrameOwner` node uses for URL completion. */
+	BaseURL *string `json:"baseURL,omitempty"`
+	/* Only set for documents, contains the document's content language. */
+	ContentLanguage *string `json:"contentLanguage,omitempty"`
+	/* Only set for documents, contains the document's character set encoding. */
+	DocumentEncoding *string `json:"documentEncoding,omitempty"`
+	/* `DocumentType` node's publicId. */
+	PublicId *string `json:"publicId,omitempty"`
+	/* `DocumentType` node's systemId. */
+	SystemId *string `json:"systemId,omitempty"`
+	/* Frame ID for frame owner elements and also for the document node. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+	/* The index of a frame owner element's content document in the `domNodes` array returned by
+	`getSnapshot`, if any. */
+	ContentDocumentIndex *int `json:"contentDocumentIndex,omitempty"`
+	/* Type of a pseudo element node. */
+	PseudoType *cdp.DOMPseudoType `json:"pseudoType,omitempty"`
+	/* Shadow root type. */
+	ShadowRootType *cdp.DOMShadowRootType `json:"shadowRootType,omitempty"`
+	/* Whether this DOM node responds to mouse clicks. This includes nodes that have had click
+	event listeners attached via JavaScript as well as anchor tags that naturally navigate when
+	clicked. */
+	IsClickable *bool `json:"isClickable,omitempty"`
+	/* Details of the node's event listeners, if any. */
+	EventListeners []cdp.DOMDebuggerEventListener `json:"eventListeners"`
+	/* The selected url for nodes with a srcset attribute. */
+	CurrentSourceURL *string `json:"currentSourceURL,omitempty"`
+	/* The url of the script (if any) that generates this node. */
+	OriginURL *string `json:"originURL,omitempty"`
+	/* Scroll offsets, set when this node is a Document. */
+	ScrollOffsetX *float64 `json:"scrollOffsetX,omitempty"`
+	ScrollOffsetY *float64 `json:"scrollOffsetY,omitempty"`
+}
+
+// MarshalCDP writes DOMNode as JSON
+func (v *DOMNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeType")
+	e.Int(v.NodeType)
+	e.Key("nodeName")
+	e.String(v.NodeName)
+	e.Key("nodeValue")
+	e.String(v.NodeValue)
+	if v.TextValue != nil {
+		e.Key("textValue")
+		e.String(*v.TextValue)
+	}
+	if v.InputValue != nil {
+		e.Key("inputValue")
+		e.String(*v.InputValue)
+	}
+	if v.InputChecked != nil {
+		e.Key("inputChecked")
+		e.Bool(*v.InputChecked)
+	}
+	if v.OptionSelected != nil {
+		e.Key("optionSelected")
+		e.Bool(*v.OptionSelected)
+	}
+	e.Key("backendNodeId")
+	e.Int(int(v.BackendNodeId))
+	if v.ChildNodeIndexes != nil {
+		e.Key("childNodeIndexes")
+		e.ArrayStart()
+		for i0 := range v.ChildNodeIndexes {
+			e.Elem()
+			e.Int(v.ChildNodeIndexes[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.Attributes != nil {
+		e.Key("attributes")
+		e.ArrayStart()
+		for i0 := range v.Attributes {
+			e.Elem()
+			v.Attributes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.PseudoElementIndexes != nil {
+		e.Key("pseudoElementIndexes")
+		e.ArrayStart()
+		for i0 := range v.PseudoElementIndexes {
+			e.Elem()
+			e.Int(v.PseudoElementIndexes[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.LayoutNodeIndex != nil {
+		e.Key("layoutNodeIndex")
+		e.Int(*v.LayoutNodeIndex)
+	}
+	if v.DocumentURL != nil {
+		e.Key("documentURL")
+		e.String(*v.DocumentURL)
+	}
+	if v.BaseURL != nil {
+		e.Key("baseURL")
+		e.String(*v.BaseURL)
+	}
+	if v.ContentLanguage != nil {
+		e.Key("contentLanguage")
+		e.String(*v.ContentLanguage)
+	}
+	if v.DocumentEncoding != nil {
+		e.Key("documentEncoding")
+		e.String(*v.DocumentEncoding)
+	}
+	if v.PublicId != nil {
+		e.Key("publicId")
+		e.String(*v.PublicId)
+	}
+	if v.SystemId != nil {
+		e.Key("systemId")
+		e.String(*v.SystemId)
+	}
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	if v.ContentDocumentIndex != nil {
+		e.Key("contentDocumentIndex")
+		e.Int(*v.ContentDocumentIndex)
+	}
+	if v.PseudoType != nil {
+		e.Key("pseudoType")
+		e.String(string(*v.PseudoType))
+	}
+	if v.ShadowRootType != nil {
+		e.Key("shadowRootType")
+		e.String(string(*v.ShadowRootType))
+	}
+	if v.IsClickable != nil {
+		e.Key("isClickable")
+		e.Bool(*v.IsClickable)
+	}
+	if v.EventListeners != nil {
+		e.Key("eventListeners")
+		e.ArrayStart()
+		for i0 := range v.EventListeners {
+			e.Elem()
+			v.EventListeners[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.CurrentSourceURL != nil {
+		e.Key("currentSourceURL")
+		e.String(*v.CurrentSourceURL)
+	}
+	if v.OriginURL != nil {
+		e.Key("originURL")
+		e.String(*v.OriginURL)
+	}
+	if v.ScrollOffsetX != nil {
+		e.Key("scrollOffsetX")
+		e.Float(*v.ScrollOffsetX)
+	}
+	if v.ScrollOffsetY != nil {
+		e.Key("scrollOffsetY")
+		e.Float(*v.ScrollOffsetY)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DOMNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DOMNode from JSON
+func (v *DOMNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeType":
+			v.NodeType = d.Int()
+		case "nodeName":
+			v.NodeName = d.String()
+		case "nodeValue":
+			v.NodeValue = d.String()
+		case "textValue":
+			if d.Null() {
+				v.TextValue = nil
+			} else {
+				v.TextValue = new(string)
+				*v.TextValue = d.String()
+			}
+		case "inputValue":
+			if d.Null() {
+				v.InputValue = nil
+			} else {
+				v.InputValue = new(string)
+				*v.InputValue = d.String()
+			}
+		case "inputChecked":
+			if d.Null() {
+				v.InputChecked = nil
+			} else {
+				v.InputChecked = new(bool)
+				*v.InputChecked = d.Bool()
+			}
+		case "optionSelected":
+			if d.Null() {
+				v.OptionSelected = nil
+			} else {
+				v.OptionSelected = new(bool)
+				*v.OptionSelected = d.Bool()
+			}
+		case "backendNodeId":
+			v.BackendNodeId = cdp.DOMBackendNodeId(d.Int())
+		case "childNodeIndexes":
+			if d.Array() {
+				v.ChildNodeIndexes = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.ChildNodeIndexes = append(v.ChildNodeIndexes, x0)
+				}
+			} else {
+				v.ChildNodeIndexes = nil
+			}
+		case "attributes":
+			if d.Array() {
+				v.Attributes = make([]NameValue, 0)
+				for d.More() {
+					var x0 NameValue
+					x0.UnmarshalCDP(d)
+					v.Attributes = append(v.Attributes, x0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		case "pseudoElementIndexes":
+			if d.Array() {
+				v.PseudoElementIndexes = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.PseudoElementIndexes = append(v.PseudoElementIndexes, x0)
+				}
+			} else {
+				v.PseudoElementIndexes = nil
+			}
+		case "layoutNodeIndex":
+			if d.Null() {
+				v.LayoutNodeIndex = nil
+			} else {
+				v.LayoutNodeIndex = new(int)
+				*v.LayoutNodeIndex = d.Int()
+			}
+		case "documentURL":
+			if d.Null() {
+				v.DocumentURL = nil
+			} else {
+				v.DocumentURL = new(string)
+				*v.DocumentURL = d.String()
+			}
+		case "baseURL":
+			if d.Null() {
+				v.BaseURL = nil
+			} else {
+				v.BaseURL = new(string)
+				*v.BaseURL = d.String()
+			}
+		case "contentLanguage":
+			if d.Null() {
+				v.ContentLanguage = nil
+			} else {
+				v.ContentLanguage = new(string)
+				*v.ContentLanguage = d.String()
+			}
+		case "documentEncoding":
+			if d.Null() {
+				v.DocumentEncoding = nil
+			} else {
+				v.DocumentEncoding = new(string)
+				*v.DocumentEncoding = d.String()
+			}
+		case "publicId":
+			if d.Null() {
+				v.PublicId = nil
+			} else {
+				v.PublicId = new(string)
+				*v.PublicId = d.String()
+			}
+		case "systemId":
+			if d.Null() {
+				v.SystemId = nil
+			} else {
+				v.SystemId = new(string)
+				*v.SystemId = d.String()
+			}
+		case "frameId":
+			if d.Null() {
+				v.FrameId = nil
+			} else {
+				v.FrameId = new(cdp.PageFrameId)
+				*v.FrameId = cdp.PageFrameId(d.String())
+			}
+		case "contentDocumentIndex":
+			if d.Null() {
+				v.ContentDocumentIndex = nil
+			} else {
+				v.ContentDocumentIndex = new(int)
+				*v.ContentDocumentIndex = d.Int()
+			}
+		case "pseudoType":
+			if d.Null() {
+				v.PseudoType = nil
+			} else {
+				v.PseudoType = new(cdp.DOMPseudoType)
+				*v.PseudoType = cdp.DOMPseudoType(d.String())
+			}
+		case "shadowRootType":
+			if d.Null() {
+				v.ShadowRootType = nil
+			} else {
+				v.ShadowRootType = new(cdp.DOMShadowRootType)
+				*v.ShadowRootType = cdp.DOMShadowRootType(d.String())
+			}
+		case "isClickable":
+			if d.Null() {
+				v.IsClickable = nil
+			} else {
+				v.IsClickable = new(bool)
+				*v.IsClickable = d.Bool()
+			}
+		case "eventListeners":
+			if d.Array() {
+				v.EventListeners = make([]cdp.DOMDebuggerEventListener, 0)
+				for d.More() {
+					var x0 cdp.DOMDebuggerEventListener
+					x0.UnmarshalCDP(d)
+					v.EventListeners = append(v.EventListeners, x0)
+				}
+			} else {
+				v.EventListeners = nil
+			}
+		case "currentSourceURL":
+			if d.Null() {
+				v.CurrentSourceURL = nil
+			} else {
+				v.CurrentSourceURL = new(string)
+				*v.CurrentSourceURL = d.String()
+			}
+		case "originURL":
+			if d.Null() {
+				v.OriginURL = nil
+			} else {
+				v.OriginURL = new(string)
+				*v.OriginURL = d.String()
+			}
+		case "scrollOffsetX":
+			if d.Null() {
+				v.ScrollOffsetX = nil
+			} else {
+				v.ScrollOffsetX = new(float64)
+				*v.ScrollOffsetX = d.Float()
+			}
+		case "scrollOffsetY":
+			if d.Null() {
+				v.ScrollOffsetY = nil
+			} else {
+				v.ScrollOffsetY = new(float64)
+				*v.ScrollOffsetY = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DOMNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Details of post layout rendered text positions. The exact layout should not be regarded as
+// stable and may change between versions.
+type InlineTextBox struct {
+	/* The bounding box in document coordinates. Note that scroll offset of the document is ignored. */
+	BoundingBox cdp.DOMRect `json:"boundingBox"`
+	/* The starting index in characters, for this post layout textbox substring. Characters that
+	would be represented as a surrogate pair in UTF-16 have length 2. */
+	StartCharacterIndex int `json:"startCharacterIndex"`
+	/* The number of characters in this post layout textbox substring. Characters that would be
+	represented as a surrogate pair in UTF-16 have length 2. */
+	NumCharacters int `json:"numCharacters"`
+}
+
+// MarshalCDP writes InlineTextBox as JSON
+func (v *InlineTextBox) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("boundingBox")
+	v.BoundingBox.MarshalCDP(e)
+	e.Key("startCharacterIndex")
+	e.Int(v.StartCharacterIndex)
+	e.Key("numCharacters")
+	e.Int(v.NumCharacters)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InlineTextBox) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InlineTextBox from JSON
+func (v *InlineTextBox) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "boundingBox":
+			v.BoundingBox.UnmarshalCDP(d)
+		case "startCharacterIndex":
+			v.StartCharacterIndex = d.Int()
+		case "numCharacters":
+			v.NumCharacters = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InlineTextBox) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Details of an element in the DOM tree with a LayoutObject.
+type LayoutTreeNode struct {
+	/* The index of the related DOM node in the `domNodes` array returned by `getSnapshot`. */
+	DomNodeIndex int `json:"domNodeIndex"`
+	/* The bounding box in document coordinates. Note that scroll offset of the document is ignored. */
+	BoundingBox cdp.DOMRect `json:"boundingBox"`
+	/* Contents of the LayoutText, if any. */
+	LayoutText *string `json:"layoutText,omitempty"`
+	/* The post-layout inline text nodes, if any. */
+	InlineTextNodes []InlineTextBox `json:"inlineTextNodes"`
+	/* Index into the `computedStyles` array returned by `getSnapshot`. */
+	StyleIndex *int `json:"styleIndex,omitempty"`
+	/* Global paint order index, which is determined by the stacking order of the nodes. Nodes
+	that are painted together will have the same index. Only provided if includePaintOrder in
+	getSnapshot was true. */
+	PaintOrder *int `json:"paintOrder,omitempty"`
+	/* Set to true to indicate the element begins a new stacking context. */
+	IsStackingContext *bool `json:"isStackingContext,omitempty"`
+}
+
+// MarshalCDP writes LayoutTreeNode as JSON
+func (v *LayoutTreeNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("domNodeIndex")
+	e.Int(v.DomNodeIndex)
+	e.Key("boundingBox")
+	v.BoundingBox.MarshalCDP(e)
+	if v.LayoutText != nil {
+		e.Key("layoutText")
+		e.String(*v.LayoutText)
+	}
+	if v.InlineTextNodes != nil {
+		e.Key("inlineTextNodes")
+		e.ArrayStart()
+		for i0 := range v.InlineTextNodes {
+			e.Elem()
+			v.InlineTextNodes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.StyleIndex != nil {
+		e.Key("styleIndex")
+		e.Int(*v.StyleIndex)
+	}
+	if v.PaintOrder != nil {
+		e.Key("paintOrder")
+		e.Int(*v.PaintOrder)
+	}
+	if v.IsStackingContext != nil {
+		e.Key("isStackingContext")
+		e.Bool(*v.IsStackingContext)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LayoutTreeNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LayoutTreeNode from JSON
+func (v *LayoutTreeNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "domNodeIndex":
+			v.DomNodeIndex = d.Int()
+		case "boundingBox":
+			v.BoundingBox.UnmarshalCDP(d)
+		case "layoutText":
+			if d.Null() {
+				v.LayoutText = nil
+			} else {
+				v.LayoutText = new(string)
+				*v.LayoutText = d.String()
+			}
+		case "inlineTextNodes":
+			if d.Array() {
+				v.InlineTextNodes = make([]InlineTextBox, 0)
+				for d.More() {
+					var x0 InlineTextBox
+					x0.UnmarshalCDP(d)
+					v.InlineTextNodes = append(v.InlineTextNodes, x0)
+				}
+			} else {
+				v.InlineTextNodes = nil
+			}
+		case "styleIndex":
+			if d.Null() {
+				v.StyleIndex = nil
+			} else {
+				v.StyleIndex = new(int)
+				*v.StyleIndex = d.Int()
+			}
+		case "paintOrder":
+			if d.Null() {
+				v.PaintOrder = nil
+			} else {
+				v.PaintOrder = new(int)
+				*v.PaintOrder = d.Int()
+			}
+		case "isStackingContext":
+			if d.Null() {
+				v.IsStackingContext = nil
+			} else {
+				v.IsStackingContext = new(bool)
+				*v.IsStackingContext = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayoutTreeNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A subset of the full ComputedStyle as defined by the request whitelist.
+type ComputedStyle struct {
+	/* Name/value pairs of computed style properties. */
+	Properties []NameValue `json:"properties"`
+}
+
+// MarshalCDP writes ComputedStyle as JSON
+func (v *ComputedStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("properties")
+	if v.Properties == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Properties {
+			e.Elem()
+			v.Properties[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ComputedStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ComputedStyle from JSON
+func (v *ComputedStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "properties":
+			if d.Array() {
+				v.Properties = make([]NameValue, 0)
+				for d.More() {
+					var x0 NameValue
+					x0.UnmarshalCDP(d)
+					v.Properties = append(v.Properties, x0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ComputedStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A name/value pair.
+type NameValue struct {
+	/* Attribute/property name. */
+	Name string `json:"name"`
+	/* Attribute/property value. */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes NameValue as JSON
+func (v *NameValue) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NameValue) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads NameValue from JSON
+func (v *NameValue) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NameValue) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Index of the string in the strings table.
+type StringIndex int
+
+// Index of the string in the strings table.
+type ArrayOfStrings []StringIndex
+
+// Data that is only present on rare nodes.
+type RareStringData struct {
+	Index []int         `json:"index"`
+	Value []StringIndex `json:"value"`
+}
+
+// MarshalCDP writes RareStringData as JSON
+func (v *RareStringData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("index")
+	if v.Index == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Index {
+			e.Elem()
+			e.Int(v.Index[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("value")
+	if v.Value == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Value {
+			e.Elem()
+			e.Int(int(v.Value[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RareStringData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RareStringData from JSON
+func (v *RareStringData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "index":
+			if d.Array() {
+				v.Index = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Index = append(v.Index, x0)
+				}
+			} else {
+				v.Index = nil
+			}
+		case "value":
+			if d.Array() {
+				v.Value = make([]StringIndex, 0)
+				for d.More() {
+					var x0 StringIndex
+					x0 = StringIndex(d.Int())
+					v.Value = append(v.Value, x0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RareStringData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RareBooleanData struct {
+	Index []int `json:"index"`
+}
+
+// MarshalCDP writes RareBooleanData as JSON
+func (v *RareBooleanData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("index")
+	if v.Index == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Index {
+			e.Elem()
+			e.Int(v.Index[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RareBooleanData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RareBooleanData from JSON
+func (v *RareBooleanData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "index":
+			if d.Array() {
+				v.Index = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Index = append(v.Index, x0)
+				}
+			} else {
+				v.Index = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RareBooleanData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RareIntegerData struct {
+	Index []int `json:"index"`
+	Value []int `json:"value"`
+}
+
+// MarshalCDP writes RareIntegerData as JSON
+func (v *RareIntegerData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("index")
+	if v.Index == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Index {
+			e.Elem()
+			e.Int(v.Index[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("value")
+	if v.Value == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Value {
+			e.Elem()
+			e.Int(v.Value[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RareIntegerData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RareIntegerData from JSON
+func (v *RareIntegerData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "index":
+			if d.Array() {
+				v.Index = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Index = append(v.Index, x0)
+				}
+			} else {
+				v.Index = nil
+			}
+		case "value":
+			if d.Array() {
+				v.Value = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Value = append(v.Value, x0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RareIntegerData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type Rectangle []float64
+
+// Document snapshot.
+type DocumentSnapshot struct {
+	/* Document URL that `Document` or `FrameOwner` node points to. */
+	DocumentURL StringIndex `json:"documentURL"`
+	/* Document title. */
+	Title StringIndex `json:"title"`
+	/* Base URL that `Document` or `FrameOwner` node uses for URL completion. */
+	BaseURL StringIndex `json:"baseURL"`
+	/* Contains the document's content language. */
+	ContentLanguage StringIndex `json:"contentLanguage"`
+	/* Contains the document's character set encoding. */
+	EncodingName StringIndex `json:"encodingName"`
+	/* `DocumentType` node's publicId. */
+	PublicId StringIndex `json:"publicId"`
+	/* `DocumentType` node's systemId. */
+	SystemId StringIndex `json:"systemId"`
+	/* Frame ID for frame owner elements and also for the document node. */
+	FrameId StringIndex `json:"frameId"`
+	/* A table with dom nodes. */
+	Nodes NodeTreeSnapshot `json:"nodes"`
+	/* The nodes in the layout tree. */
+	Layout LayoutTreeSnapshot `json:"layout"`
+	/* The post-layout inline text nodes. */
+	TextBoxes TextBoxSnapshot `json:"textBoxes"`
+	/* Horizontal scroll offset. */
+	ScrollOffsetX *float64 `json:"scrollOffsetX,omitempty"`
+	/* Vertical scroll offset. */
+	ScrollOffsetY *float64 `json:"scrollOffsetY,omitempty"`
+	/* Document content width. */
+	ContentWidth *float64 `json:"contentWidth,omitempty"`
+	/* Document content height. */
+	ContentHeight *float64 `json:"contentHeight,omitempty"`
+}
+
+// MarshalCDP writes DocumentSnapshot as JSON
+func (v *DocumentSnapshot) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("documentURL")
+	e.Int(int(v.DocumentURL))
+	e.Key("title")
+	e.Int(int(v.Title))
+	e.Key("baseURL")
+	e.Int(int(v.BaseURL))
+	e.Key("contentLanguage")
+	e.Int(int(v.ContentLanguage))
+	e.Key("encodingName")
+	e.Int(int(v.EncodingName))
+	e.Key("publicId")
+	e.Int(int(v.PublicId))
+	e.Key("systemId")
+	e.Int(int(v.SystemId))
+	e.Key("frameId")
+	e.Int(int(v.FrameId))
+	e.Key("nodes")
+	v.Nodes.MarshalCDP(e)
+	e.Key("layout")
+	v.Layout.MarshalCDP(e)
+	e.Key("textBoxes")
+	v.TextBoxes.MarshalCDP(e)
+	if v.ScrollOffsetX != nil {
+		e.Key("scrollOffsetX")
+		e.Float(*v.ScrollOffsetX)
+	}
+	if v.ScrollOffsetY != nil {
+		e.Key("scrollOffsetY")
+		e.Float(*v.ScrollOffsetY)
+	}
+	if v.ContentWidth != nil {
+		e.Key("contentWidth")
+		e.Float(*v.ContentWidth)
+	}
+	if v.ContentHeight != nil {
+		e.Key("contentHeight")
+		e.Float(*v.ContentHeight)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DocumentSnapshot) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DocumentSnapshot from JSON
+func (v *DocumentSnapshot) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "documentURL":
+			v.DocumentURL = StringIndex(d.Int())
+		case "title":
+			v.Title = StringIndex(d.Int())
+		case "baseURL":
+			v.BaseURL = StringIndex(d.Int())
+		case "contentLanguage":
+			v.ContentLanguage = StringIndex(d.Int())
+		case "encodingName":
+			v.EncodingName = StringIndex(d.Int())
+		case "publicId":
+			v.PublicId = StringIndex(d.Int())
+		case "systemId":
+			v.SystemId = StringIndex(d.Int())
+		case "frameId":
+			v.FrameId = StringIndex(d.Int())
+		case "nodes":
+			v.Nodes.UnmarshalCDP(d)
+		case "layout":
+			v.Layout.UnmarshalCDP(d)
+		case "textBoxes":
+			v.TextBoxes.UnmarshalCDP(d)
+		case "scrollOffsetX":
+			if d.Null() {
+				v.ScrollOffsetX = nil
+			} else {
+				v.ScrollOffsetX = new(float64)
+				*v.ScrollOffsetX = d.Float()
+			}
+		case "scrollOffsetY":
+			if d.Null() {
+				v.ScrollOffsetY = nil
+			} else {
+				v.ScrollOffsetY = new(float64)
+				*v.ScrollOffsetY = d.Float()
+			}
+		case "contentWidth":
+			if d.Null() {
+				v.ContentWidth = nil
+			} else {
+				v.ContentWidth = new(float64)
+				*v.ContentWidth = d.Float()
+			}
+		case "contentHeight":
+			if d.Null() {
+				v.ContentHeight = nil
+			} else {
+				v.ContentHeight = new(float64)
+				*v.ContentHeight = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DocumentSnapshot) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Table containing nodes.
+type NodeTreeSnapshot struct {
+	/* Parent node index. */
+	ParentIndex []int `json:"parentIndex"`
+	/* `Node`'s nodeType. */
+	NodeType []int `json:"nodeType"`
+	/* Type of the shadow root the `Node` is in. String values are equal to the `ShadowRootType` enum. */
+	ShadowRootType *RareStringData `json:"shadowRootType,omitempty"`
+	/* `Node`'s nodeName. */
+	NodeName []StringIndex `json:"nodeName"`
+	/* `Node`'s nodeValue. */
+	NodeValue []StringIndex `json:"nodeValue"`
+	/* `Node`'s id, corresponds to DOM.Node.backendNodeId. */
+	BackendNodeId []cdp.DOMBackendNodeId `json:"backendNodeId"`
+	/* Attributes of an `Element` node. Flatten name, value pairs. */
+	Attributes []ArrayOfStrings `json:"attributes"`
+	/* Only set for textarea elements, contains the text value. */
+	TextValue *RareStringData `json:"textValue,omitempty"`
+	/* Only set for input elements, contains the input's associated text value. */
+	InputValue *RareStringData `json:"inputValue,omitempty"`
+	/* Only set for radio and checkbox input elements, indicates if the element has been checked */
+	InputChecked *RareBooleanData `json:"inputChecked,omitempty"`
+	/* Only set for option elements, indicates if the element has been selected */
+	OptionSelected *RareBooleanData `json:"optionSelected,omitempty"`
+	/* The index of the document in the list of the snapshot documents. */
+	ContentDocumentIndex *RareIntegerData `json:"contentDocumentIndex,omitempty"`
+	/* Type of a pseudo element node. */
+	PseudoType *RareStringData `json:"pseudoType,omitempty"`
+	/* Pseudo element identifier for this node. Only present if there is a
+	valid pseudoType. */
+	PseudoIdentifier *RareStringData `json:"pseudoIdentifier,omitempty"`
+	/* Whether this DOM node responds to mouse clicks. This includes nodes that have had click
+	event listeners attached via JavaScript as well as anchor tags that naturally navigate when
+	clicked. */
+	IsClickable *RareBooleanData `json:"isClickable,omitempty"`
+	/* The selected url for nodes with a srcset attribute. */
+	CurrentSourceURL *RareStringData `json:"currentSourceURL,omitempty"`
+	/* The url of the script (if any) that generates this node. */
+	OriginURL *RareStringData `json:"originURL,omitempty"`
+}
+
+// MarshalCDP writes NodeTreeSnapshot as JSON
+func (v *NodeTreeSnapshot) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ParentIndex != nil {
+		e.Key("parentIndex")
+		e.ArrayStart()
+		for i0 := range v.ParentIndex {
+			e.Elem()
+			e.Int(v.ParentIndex[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.NodeType != nil {
+		e.Key("nodeType")
+		e.ArrayStart()
+		for i0 := range v.NodeType {
+			e.Elem()
+			e.Int(v.NodeType[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.ShadowRootType != nil {
+		e.Key("shadowRootType")
+		(*v.ShadowRootType).MarshalCDP(e)
+	}
+	if v.NodeName != nil {
+		e.Key("nodeName")
+		e.ArrayStart()
+		for i0 := range v.NodeName {
+			e.Elem()
+			e.Int(int(v.NodeName[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.NodeValue != nil {
+		e.Key("nodeValue")
+		e.ArrayStart()
+		for i0 := range v.NodeValue {
+			e.Elem()
+			e.Int(int(v.NodeValue[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.ArrayStart()
+		for i0 := range v.BackendNodeId {
+			e.Elem()
+			e.Int(int(v.BackendNodeId[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.Attributes != nil {
+		e.Key("attributes")
+		e.ArrayStart()
+		for i0 := range v.Attributes {
+			e.Elem()
+			if v.Attributes[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.Attributes[i0] {
+					e.Elem()
+					e.Int(int(v.Attributes[i0][i1]))
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	if v.TextValue != nil {
+		e.Key("textValue")
+		(*v.TextValue).MarshalCDP(e)
+	}
+	if v.InputValue != nil {
+		e.Key("inputValue")
+		(*v.InputValue).MarshalCDP(e)
+	}
+	if v.InputChecked != nil {
+		e.Key("inputChecked")
+		(*v.InputChecked).MarshalCDP(e)
+	}
+	if v.OptionSelected != nil {
+		e.Key("optionSelected")
+		(*v.OptionSelected).MarshalCDP(e)
+	}
+	if v.ContentDocumentIndex != nil {
+		e.Key("contentDocumentIndex")
+		(*v.ContentDocumentIndex).MarshalCDP(e)
+	}
+	if v.PseudoType != nil {
+		e.Key("pseudoType")
+		(*v.PseudoType).MarshalCDP(e)
+	}
+	if v.PseudoIdentifier != nil {
+		e.Key("pseudoIdentifier")
+		(*v.PseudoIdentifier).MarshalCDP(e)
+	}
+	if v.IsClickable != nil {
+		e.Key("isClickable")
+		(*v.IsClickable).MarshalCDP(e)
+	}
+	if v.CurrentSourceURL != nil {
+		e.Key("currentSourceURL")
+		(*v.CurrentSourceURL).MarshalCDP(e)
+	}
+	if v.OriginURL != nil {
+		e.Key("originURL")
+		(*v.OriginURL).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NodeTreeSnapshot) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads NodeTreeSnapshot from JSON
+func (v *NodeTreeSnapshot) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentIndex":
+			if d.Array() {
+				v.ParentIndex = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.ParentIndex = append(v.ParentIndex, x0)
+				}
+			} else {
+				v.ParentIndex = nil
+			}
+		case "nodeType":
+			if d.Array() {
+				v.NodeType = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.NodeType = append(v.NodeType, x0)
+				}
+			} else {
+				v.NodeType = nil
+			}
+		case "shadowRootType":
+			if d.Null() {
+				v.ShadowRootType = nil
+			} else {
+				v.ShadowRootType = new(RareStringData)
+				(*v.ShadowRootType).UnmarshalCDP(d)
+			}
+		case "nodeName":
+			if d.Array() {
+				v.NodeName = make([]StringIndex, 0)
+				for d.More() {
+					var x0 StringIndex
+					x0 = StringIndex(d.Int())
+					v.NodeName = append(v.NodeName, x0)
+				}
+			} else {
+				v.NodeName = nil
+			}
+		case "nodeValue":
+			if d.Array() {
+				v.NodeValue = make([]StringIndex, 0)
+				for d.More() {
+					var x0 StringIndex
+					x0 = StringIndex(d.Int())
+					v.NodeValue = append(v.NodeValue, x0)
+				}
+			} else {
+				v.NodeValue = nil
+			}
+		case "backendNodeId":
+			if d.Array() {
+				v.BackendNodeId = make([]cdp.DOMBackendNodeId, 0)
+				for d.More() {
+					var x0 cdp.DOMBackendNodeId
+					x0 = cdp.DOMBackendNodeId(d.Int())
+					v.BackendNodeId = append(v.BackendNodeId, x0)
+				}
+			} else {
+				v.BackendNodeId = nil
+			}
+		case "attributes":
+			if d.Array() {
+				v.Attributes = make([]ArrayOfStrings, 0)
+				for d.More() {
+					var x0 ArrayOfStrings
+					if d.Array() {
+						x0 = make(ArrayOfStrings, 0)
+						for d.More() {
+							var x1 StringIndex
+							x1 = StringIndex(d.Int())
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Attributes = append(v.Attributes, x0)
+				}
+			} else {
+				v.Attributes = nil
+			}
+		case "textValue":
+			if d.Null() {
+				v.TextValue = nil
+			} else {
+				v.TextValue = new(RareStringData)
+				(*v.TextValue).UnmarshalCDP(d)
+			}
+		case "inputValue":
+			if d.Null() {
+				v.InputValue = nil
+			} else {
+				v.InputValue = new(RareStringData)
+				(*v.InputValue).UnmarshalCDP(d)
+			}
+		case "inputChecked":
+			if d.Null() {
+				v.InputChecked = nil
+			} else {
+				v.InputChecked = new(RareBooleanData)
+				(*v.InputChecked).UnmarshalCDP(d)
+			}
+		case "optionSelected":
+			if d.Null() {
+				v.OptionSelected = nil
+			} else {
+				v.OptionSelected = new(RareBooleanData)
+				(*v.OptionSelected).UnmarshalCDP(d)
+			}
+		case "contentDocumentIndex":
+			if d.Null() {
+				v.ContentDocumentIndex = nil
+			} else {
+				v.ContentDocumentIndex = new(RareIntegerData)
+				(*v.ContentDocumentIndex).UnmarshalCDP(d)
+			}
+		case "pseudoType":
+			if d.Null() {
+				v.PseudoType = nil
+			} else {
+				v.PseudoType = new(RareStringData)
+				(*v.PseudoType).UnmarshalCDP(d)
+			}
+		case "pseudoIdentifier":
+			if d.Null() {
+				v.PseudoIdentifier = nil
+			} else {
+				v.PseudoIdentifier = new(RareStringData)
+				(*v.PseudoIdentifier).UnmarshalCDP(d)
+			}
+		case "isClickable":
+			if d.Null() {
+				v.IsClickable = nil
+			} else {
+				v.IsClickable = new(RareBooleanData)
+				(*v.IsClickable).UnmarshalCDP(d)
+			}
+		case "currentSourceURL":
+			if d.Null() {
+				v.CurrentSourceURL = nil
+			} else {
+				v.CurrentSourceURL = new(RareStringData)
+				(*v.CurrentSourceURL).UnmarshalCDP(d)
+			}
+		case "originURL":
+			if d.Null() {
+				v.OriginURL = nil
+			} else {
+				v.OriginURL = new(RareStringData)
+				(*v.OriginURL).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodeTreeSnapshot) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Table of details of an element in the DOM tree with a LayoutObject.
+type LayoutTreeSnapshot struct {
+	/* Index of the corresponding node in the `NodeTreeSnapshot` array returned by `captureSnapshot`. */
+	NodeIndex []int `json:"nodeIndex"`
+	/* Array of indexes specifying computed style strings, filtered according to the `computedStyles` parameter passed to `captureSnapshot`. */
+	Styles []ArrayOfStrings `json:"styles"`
+	/* The absolute position bounding box. */
+	Bounds []Rectangle `json:"bounds"`
+	/* Contents of the LayoutText, if any. */
+	Text []StringIndex `json:"text"`
+	/* Stacking context information. */
+	StackingContexts RareBooleanData `json:"stackingContexts"`
+	/* Global paint order index, which is determined by the stacking order of the nodes. Nodes
+	that are painted together will have the same index. Only provided if includePaintOrder in
+	captureSnapshot was true. */
+	PaintOrders []int `json:"paintOrders"`
+	/* The offset rect of nodes. Only available when includeDOMRects is set to true */
+	OffsetRects []Rectangle `json:"offsetRects"`
+	/* The scroll rect of nodes. Only available when includeDOMRects is set to true */
+	ScrollRects []Rectangle `json:"scrollRects"`
+	/* The client rect of nodes. Only available when includeDOMRects is set to true */
+	ClientRects []Rectangle `json:"clientRects"`
+	/* The list of background colors that are blended with colors of overlapping elements.
+
+	Experimental: this may change or be removed in any chrome release */
+	BlendedBackgroundColors []StringIndex `json:"blendedBackgroundColors"`
+	/* The list of computed text opacities.
+
+	Experimental: this may change or be removed in any chrome release */
+	TextColorOpacities []float64 `json:"textColorOpacities"`
+}
+
+// MarshalCDP writes LayoutTreeSnapshot as JSON
+func (v *LayoutTreeSnapshot) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeIndex")
+	if v.NodeIndex == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NodeIndex {
+			e.Elem()
+			e.Int(v.NodeIndex[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("styles")
+	if v.Styles == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Styles {
+			e.Elem()
+			if v.Styles[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.Styles[i0] {
+					e.Elem()
+					e.Int(int(v.Styles[i0][i1]))
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	e.Key("bounds")
+	if v.Bounds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Bounds {
+			e.Elem()
+			if v.Bounds[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.Bounds[i0] {
+					e.Elem()
+					e.Float(v.Bounds[i0][i1])
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	e.Key("text")
+	if v.Text == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Text {
+			e.Elem()
+			e.Int(int(v.Text[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.Key("stackingContexts")
+	v.StackingContexts.MarshalCDP(e)
+	if v.PaintOrders != nil {
+		e.Key("paintOrders")
+		e.ArrayStart()
+		for i0 := range v.PaintOrders {
+			e.Elem()
+			e.Int(v.PaintOrders[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.OffsetRects != nil {
+		e.Key("offsetRects")
+		e.ArrayStart()
+		for i0 := range v.OffsetRects {
+			e.Elem()
+			if v.OffsetRects[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.OffsetRects[i0] {
+					e.Elem()
+					e.Float(v.OffsetRects[i0][i1])
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	if v.ScrollRects != nil {
+		e.Key("scrollRects")
+		e.ArrayStart()
+		for i0 := range v.ScrollRects {
+			e.Elem()
+			if v.ScrollRects[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.ScrollRects[i0] {
+					e.Elem()
+					e.Float(v.ScrollRects[i0][i1])
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	if v.ClientRects != nil {
+		e.Key("clientRects")
+		e.ArrayStart()
+		for i0 := range v.ClientRects {
+			e.Elem()
+			if v.ClientRects[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.ClientRects[i0] {
+					e.Elem()
+					e.Float(v.ClientRects[i0][i1])
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	if v.BlendedBackgroundColors != nil {
+		e.Key("blendedBackgroundColors")
+		e.ArrayStart()
+		for i0 := range v.BlendedBackgroundColors {
+			e.Elem()
+			e.Int(int(v.BlendedBackgroundColors[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.TextColorOpacities != nil {
+		e.Key("textColorOpacities")
+		e.ArrayStart()
+		for i0 := range v.TextColorOpacities {
+			e.Elem()
+			e.Float(v.TextColorOpacities[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LayoutTreeSnapshot) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LayoutTreeSnapshot from JSON
+func (v *LayoutTreeSnapshot) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeIndex":
+			if d.Array() {
+				v.NodeIndex = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.NodeIndex = append(v.NodeIndex, x0)
+				}
+			} else {
+				v.NodeIndex = nil
+			}
+		case "styles":
+			if d.Array() {
+				v.Styles = make([]ArrayOfStrings, 0)
+				for d.More() {
+					var x0 ArrayOfStrings
+					if d.Array() {
+						x0 = make(ArrayOfStrings, 0)
+						for d.More() {
+							var x1 StringIndex
+							x1 = StringIndex(d.Int())
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Styles = append(v.Styles, x0)
+				}
+			} else {
+				v.Styles = nil
+			}
+		case "bounds":
+			if d.Array() {
+				v.Bounds = make([]Rectangle, 0)
+				for d.More() {
+					var x0 Rectangle
+					if d.Array() {
+						x0 = make(Rectangle, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Bounds = append(v.Bounds, x0)
+				}
+			} else {
+				v.Bounds = nil
+			}
+		case "text":
+			if d.Array() {
+				v.Text = make([]StringIndex, 0)
+				for d.More() {
+					var x0 StringIndex
+					x0 = StringIndex(d.Int())
+					v.Text = append(v.Text, x0)
+				}
+			} else {
+				v.Text = nil
+			}
+		case "stackingContexts":
+			v.StackingContexts.UnmarshalCDP(d)
+		case "paintOrders":
+			if d.Array() {
+				v.PaintOrders = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.PaintOrders = append(v.PaintOrders, x0)
+				}
+			} else {
+				v.PaintOrders = nil
+			}
+		case "offsetRects":
+			if d.Array() {
+				v.OffsetRects = make([]Rectangle, 0)
+				for d.More() {
+					var x0 Rectangle
+					if d.Array() {
+						x0 = make(Rectangle, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.OffsetRects = append(v.OffsetRects, x0)
+				}
+			} else {
+				v.OffsetRects = nil
+			}
+		case "scrollRects":
+			if d.Array() {
+				v.ScrollRects = make([]Rectangle, 0)
+				for d.More() {
+					var x0 Rectangle
+					if d.Array() {
+						x0 = make(Rectangle, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.ScrollRects = append(v.ScrollRects, x0)
+				}
+			} else {
+				v.ScrollRects = nil
+			}
+		case "clientRects":
+			if d.Array() {
+				v.ClientRects = make([]Rectangle, 0)
+				for d.More() {
+					var x0 Rectangle
+					if d.Array() {
+						x0 = make(Rectangle, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.ClientRects = append(v.ClientRects, x0)
+				}
+			} else {
+				v.ClientRects = nil
+			}
+		case "blendedBackgroundColors":
+			if d.Array() {
+				v.BlendedBackgroundColors = make([]StringIndex, 0)
+				for d.More() {
+					var x0 StringIndex
+					x0 = StringIndex(d.Int())
+					v.BlendedBackgroundColors = append(v.BlendedBackgroundColors, x0)
+				}
+			} else {
+				v.BlendedBackgroundColors = nil
+			}
+		case "textColorOpacities":
+			if d.Array() {
+				v.TextColorOpacities = make([]float64, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.TextColorOpacities = append(v.TextColorOpacities, x0)
+				}
+			} else {
+				v.TextColorOpacities = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayoutTreeSnapshot) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Table of details of the post layout rendered text positions. The exact layout should not be regarded as
+// stable and may change between versions.
+type TextBoxSnapshot struct {
+	/* Index of the layout tree node that owns this box collection. */
+	LayoutIndex []int `json:"layoutIndex"`
+	/* The absolute position bounding box. */
+	Bounds []Rectangle `json:"bounds"`
+	/* The starting index in characters, for this post layout textbox substring. Characters that
+	would be represented as a surrogate pair in UTF-16 have length 2. */
+	Start []int `json:"start"`
+	/* The number of characters in this post layout textbox substring. Characters that would be
+	represented as a surrogate pair in UTF-16 have length 2. */
+	Length []int `json:"length"`
+}
+
+// MarshalCDP writes TextBoxSnapshot as JSON
+func (v *TextBoxSnapshot) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("layoutIndex")
+	if v.LayoutIndex == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.LayoutIndex {
+			e.Elem()
+			e.Int(v.LayoutIndex[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("bounds")
+	if v.Bounds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Bounds {
+			e.Elem()
+			if v.Bounds[i0] == nil {
+				e.Null()
+			} else {
+				e.ArrayStart()
+				for i1 := range v.Bounds[i0] {
+					e.Elem()
+					e.Float(v.Bounds[i0][i1])
+				}
+				e.ArrayEnd()
+			}
+		}
+		e.ArrayEnd()
+	}
+	e.Key("start")
+	if v.Start == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Start {
+			e.Elem()
+			e.Int(v.Start[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("length")
+	if v.Length == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Length {
+			e.Elem()
+			e.Int(v.Length[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TextBoxSnapshot) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads TextBoxSnapshot from JSON
+func (v *TextBoxSnapshot) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "layoutIndex":
+			if d.Array() {
+				v.LayoutIndex = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.LayoutIndex = append(v.LayoutIndex, x0)
+				}
+			} else {
+				v.LayoutIndex = nil
+			}
+		case "bounds":
+			if d.Array() {
+				v.Bounds = make([]Rectangle, 0)
+				for d.More() {
+					var x0 Rectangle
+					if d.Array() {
+						x0 = make(Rectangle, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Bounds = append(v.Bounds, x0)
+				}
+			} else {
+				v.Bounds = nil
+			}
+		case "start":
+			if d.Array() {
+				v.Start = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Start = append(v.Start, x0)
+				}
+			} else {
+				v.Start = nil
+			}
+		case "length":
+			if d.Array() {
+				v.Length = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Length = append(v.Length, x0)
+				}
+			} else {
+				v.Length = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TextBoxSnapshot) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables DOM snapshot agent for the given page.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "DOMSnapshot.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables DOM snapshot agent for the given page.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "DOMSnapshot.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetSnapshotReturns struct {
+
+	/* The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. */
+	DomNodes []DOMNode `json:"domNodes"`
+
+	/* The nodes in the layout tree. */
+	LayoutTreeNodes []LayoutTreeNode `json:"layoutTreeNodes"`
+
+	/* Whitelisted ComputedStyle properties for each node in the layout tree. */
+	ComputedStyles []ComputedStyle `json:"computedStyles"`
+}
+
+// UnmarshalCDP reads GetSnapshotReturns from JSON
+func (v *GetSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "domNodes":
+			if d.Array() {
+				v.DomNodes = make([]DOMNode, 0)
+				for d.More() {
+					var x0 DOMNode
+					x0.UnmarshalCDP(d)
+					v.DomNodes = append(v.DomNodes, x0)
+				}
+			} else {
+				v.DomNodes = nil
+			}
+		case "layoutTreeNodes":
+			if d.Array() {
+				v.LayoutTreeNodes = make([]LayoutTreeNode, 0)
+				for d.More() {
+					var x0 LayoutTreeNode
+					x0.UnmarshalCDP(d)
+					v.LayoutTreeNodes = append(v.LayoutTreeNodes, x0)
+				}
+			} else {
+				v.LayoutTreeNodes = nil
+			}
+		case "computedStyles":
+			if d.Array() {
+				v.ComputedStyles = make([]ComputedStyle, 0)
+				for d.More() {
+					var x0 ComputedStyle
+					x0.UnmarshalCDP(d)
+					v.ComputedStyles = append(v.ComputedStyles, x0)
+				}
+			} else {
+				v.ComputedStyles = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetSnapshotParams are the parameters for DOMSnapshot.getSnapshot
+// optional parameters are left out when nil
+type GetSnapshotParams struct {
+	/* Whitelist of computed styles to return. */
+	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+	/* Whether or not to retrieve details of DOM listeners (default false). */
+	IncludeEventListeners *bool `json:"includeEventListeners,omitempty"`
+	/* Whether to determine and include the paint order index of LayoutTreeNodes (default false). */
+	IncludePaintOrder *bool `json:"includePaintOrder,omitempty"`
+	/* Whether to include UA shadow tree in the snapshot (default false). */
+	IncludeUserAgentShadowTree *bool `json:"includeUserAgentShadowTree,omitempty"`
+}
+
+// MarshalCDP writes GetSnapshotParams as JSON
+func (v *GetSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("computedStyleWhitelist")
+	if v.ComputedStyleWhitelist == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ComputedStyleWhitelist {
+			e.Elem()
+			e.String(v.ComputedStyleWhitelist[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.IncludeEventListeners != nil {
+		e.Key("includeEventListeners")
+		e.Bool(*v.IncludeEventListeners)
+	}
+	if v.IncludePaintOrder != nil {
+		e.Key("includePaintOrder")
+		e.Bool(*v.IncludePaintOrder)
+	}
+	if v.IncludeUserAgentShadowTree != nil {
+		e.Key("includeUserAgentShadowTree")
+		e.Bool(*v.IncludeUserAgentShadowTree)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a document snapshot, including the full DOM tree of the root node (including iframes,
+// template contents, and imported documents) in a flattened array, as well as layout and
+// white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is
+// flattened.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetSnapshot(params GetSnapshotParams) (GetSnapshotReturns, error) {
+	return c.GetSnapshotContext(context.Background(), params)
+}
+
+// GetSnapshotContext is GetSnapshot with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetSnapshotContext(ctx context.Context, params GetSnapshotParams) (GetSnapshotReturns, error) {
+	var returns_ GetSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "DOMSnapshot.getSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CaptureSnapshotReturns struct {
+
+	/* The nodes in the DOM tree. The DOMNode at index 0 corresponds to the root document. */
+	Documents []DocumentSnapshot `json:"documents"`
+
+	/* Shared string table that all string properties refer to with indexes. */
+	Strings []string `json:"strings"`
+}
+
+// UnmarshalCDP reads CaptureSnapshotReturns from JSON
+func (v *CaptureSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "documents":
+			if d.Array() {
+				v.Documents = make([]DocumentSnapshot, 0)
+				for d.More() {
+					var x0 DocumentSnapshot
+					x0.UnmarshalCDP(d)
+					v.Documents = append(v.Documents, x0)
+				}
+			} else {
+				v.Documents = nil
+			}
+		case "strings":
+			if d.Array() {
+				v.Strings = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Strings = append(v.Strings, x0)
+				}
+			} else {
+				v.Strings = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CaptureSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CaptureSnapshotParams are the parameters for DOMSnapshot.captureSnapshot
+// optional parameters are left out when nil
+type CaptureSnapshotParams struct {
+	/* Whitelist of computed styles to return. */
+	ComputedStyles []string `json:"computedStyles"`
+	/* Whether to include layout object paint orders into the snapshot. */
+	IncludePaintOrder *bool `json:"includePaintOrder,omitempty"`
+	/* Whether to include DOM rectangles (offsetRects, clientRects, scrollRects) into the snapshot */
+	IncludeDOMRects *bool `json:"includeDOMRects,omitempty"`
+	/* Whether to include blended background colors in the snapshot (default: false).
+	Blended background color is achieved by blending background colors of all elements
+	that overlap with the current element.
+
+	Experimental: this may change or be removed in any chrome release */
+	IncludeBlendedBackgroundColors *bool `json:"includeBlendedBackgroundColors,omitempty"`
+	/* Whether to include text color opacity in the snapshot (default: false).
+	An element might have the opacity property set that affects the text color of the element.
+	The final text color opacity is computed based on the opacity of all overlapping elements.
+
+	Experimental: this may change or be removed in any chrome release */
+	IncludeTextColorOpacities *bool `json:"includeTextColorOpacities,omitempty"`
+}
+
+// MarshalCDP writes CaptureSnapshotParams as JSON
+func (v *CaptureSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("computedStyles")
+	if v.ComputedStyles == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ComputedStyles {
+			e.Elem()
+			e.String(v.ComputedStyles[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.IncludePaintOrder != nil {
+		e.Key("includePaintOrder")
+		e.Bool(*v.IncludePaintOrder)
+	}
+	if v.IncludeDOMRects != nil {
+		e.Key("includeDOMRects")
+		e.Bool(*v.IncludeDOMRects)
+	}
+	if v.IncludeBlendedBackgroundColors != nil {
+		e.Key("includeBlendedBackgroundColors")
+		e.Bool(*v.IncludeBlendedBackgroundColors)
+	}
+	if v.IncludeTextColorOpacities != nil {
+		e.Key("includeTextColorOpacities")
+		e.Bool(*v.IncludeTextColorOpacities)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CaptureSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a document snapshot, including the full DOM tree of the root node (including iframes,
+// template contents, and imported documents) in a flattened array, as well as layout and
+// white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is
+// flattened.
+func (c Client) CaptureSnapshot(params CaptureSnapshotParams) (CaptureSnapshotReturns, error) {
+	return c.CaptureSnapshotContext(context.Background(), params)
+}
+
+// CaptureSnapshotContext is CaptureSnapshot with a context for cancellation and deadlines
+func (c Client) CaptureSnapshotContext(ctx context.Context, params CaptureSnapshotParams) (CaptureSnapshotReturns, error) {
+	var returns_ CaptureSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "DOMSnapshot.captureSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
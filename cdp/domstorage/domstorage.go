@@ -0,0 +1,606 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package domstorage is the DOMStorage domain of the chrome devtools protocol
+//
+// Query and modify DOM storage.
+//
+// Experimental: this may change or be removed in any chrome release
+package domstorage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends DOMStorage commands and listens for DOMStorage events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type SerializedStorageKey string
+
+// DOM Storage identifier.
+type StorageId struct {
+	/* Security origin for the storage. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Represents a key by which DOM Storage keys its CachedStorageAreas */
+	StorageKey *SerializedStorageKey `json:"storageKey,omitempty"`
+	/* Whether the storage is local storage (not session storage). */
+	IsLocalStorage bool `json:"isLocalStorage"`
+}
+
+// MarshalCDP writes StorageId as JSON
+func (v *StorageId) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(string(*v.StorageKey))
+	}
+	e.Key("isLocalStorage")
+	e.Bool(v.IsLocalStorage)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StorageId) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads StorageId from JSON
+func (v *StorageId) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "securityOrigin":
+			if d.Null() {
+				v.SecurityOrigin = nil
+			} else {
+				v.SecurityOrigin = new(string)
+				*v.SecurityOrigin = d.String()
+			}
+		case "storageKey":
+			if d.Null() {
+				v.StorageKey = nil
+			} else {
+				v.StorageKey = new(SerializedStorageKey)
+				*v.StorageKey = SerializedStorageKey(d.String())
+			}
+		case "isLocalStorage":
+			v.IsLocalStorage = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StorageId) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DOM Storage item.
+type Item []string
+
+type ClearReturns struct {
+}
+
+// UnmarshalCDP reads ClearReturns from JSON
+func (v *ClearReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearParams are the parameters for DOMStorage.clear
+// optional parameters are left out when nil
+type ClearParams struct {
+	StorageId StorageId `json:"storageId"`
+}
+
+// MarshalCDP writes ClearParams as JSON
+func (v *ClearParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageId")
+	v.StorageId.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) Clear(params ClearParams) (ClearReturns, error) {
+	return c.ClearContext(context.Background(), params)
+}
+
+// ClearContext is Clear with a context for cancellation and deadlines
+func (c Client) ClearContext(ctx context.Context, params ClearParams) (ClearReturns, error) {
+	var returns_ ClearReturns
+
+	err_ := c.caller.Call(ctx, "DOMStorage.clear", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables storage tracking, prevents storage events from being sent to the client.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "DOMStorage.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables storage tracking, storage events will now be delivered to the client.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "DOMStorage.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetDOMStorageItemsReturns struct {
+	Entries []Item `json:"entries"`
+}
+
+// UnmarshalCDP reads GetDOMStorageItemsReturns from JSON
+func (v *GetDOMStorageItemsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "entries":
+			if d.Array() {
+				v.Entries = make([]Item, 0)
+				for d.More() {
+					var x0 Item
+					if d.Array() {
+						x0 = make(Item, 0)
+						for d.More() {
+							var x1 string
+							x1 = d.String()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Entries = append(v.Entries, x0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetDOMStorageItemsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetDOMStorageItemsParams are the parameters for DOMStorage.getDOMStorageItems
+// optional parameters are left out when nil
+type GetDOMStorageItemsParams struct {
+	StorageId StorageId `json:"storageId"`
+}
+
+// MarshalCDP writes GetDOMStorageItemsParams as JSON
+func (v *GetDOMStorageItemsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageId")
+	v.StorageId.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetDOMStorageItemsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) GetDOMStorageItems(params GetDOMStorageItemsParams) (GetDOMStorageItemsReturns, error) {
+	return c.GetDOMStorageItemsContext(context.Background(), params)
+}
+
+// GetDOMStorageItemsContext is GetDOMStorageItems with a context for cancellation and deadlines
+func (c Client) GetDOMStorageItemsContext(ctx context.Context, params GetDOMStorageItemsParams) (GetDOMStorageItemsReturns, error) {
+	var returns_ GetDOMStorageItemsReturns
+
+	err_ := c.caller.Call(ctx, "DOMStorage.getDOMStorageItems", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveDOMStorageItemReturns struct {
+}
+
+// UnmarshalCDP reads RemoveDOMStorageItemReturns from JSON
+func (v *RemoveDOMStorageItemReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveDOMStorageItemReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveDOMStorageItemParams are the parameters for DOMStorage.removeDOMStorageItem
+// optional parameters are left out when nil
+type RemoveDOMStorageItemParams struct {
+	StorageId StorageId `json:"storageId"`
+	Key       string    `json:"key"`
+}
+
+// MarshalCDP writes RemoveDOMStorageItemParams as JSON
+func (v *RemoveDOMStorageItemParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageId")
+	v.StorageId.MarshalCDP(e)
+	e.Key("key")
+	e.String(v.Key)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveDOMStorageItemParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) RemoveDOMStorageItem(params RemoveDOMStorageItemParams) (RemoveDOMStorageItemReturns, error) {
+	return c.RemoveDOMStorageItemContext(context.Background(), params)
+}
+
+// RemoveDOMStorageItemContext is RemoveDOMStorageItem with a context for cancellation and deadlines
+func (c Client) RemoveDOMStorageItemContext(ctx context.Context, params RemoveDOMStorageItemParams) (RemoveDOMStorageItemReturns, error) {
+	var returns_ RemoveDOMStorageItemReturns
+
+	err_ := c.caller.Call(ctx, "DOMStorage.removeDOMStorageItem", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDOMStorageItemReturns struct {
+}
+
+// UnmarshalCDP reads SetDOMStorageItemReturns from JSON
+func (v *SetDOMStorageItemReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDOMStorageItemReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDOMStorageItemParams are the parameters for DOMStorage.setDOMStorageItem
+// optional parameters are left out when nil
+type SetDOMStorageItemParams struct {
+	StorageId StorageId `json:"storageId"`
+	Key       string    `json:"key"`
+	Value     string    `json:"value"`
+}
+
+// MarshalCDP writes SetDOMStorageItemParams as JSON
+func (v *SetDOMStorageItemParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageId")
+	v.StorageId.MarshalCDP(e)
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDOMStorageItemParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetDOMStorageItem(params SetDOMStorageItemParams) (SetDOMStorageItemReturns, error) {
+	return c.SetDOMStorageItemContext(context.Background(), params)
+}
+
+// SetDOMStorageItemContext is SetDOMStorageItem with a context for cancellation and deadlines
+func (c Client) SetDOMStorageItemContext(ctx context.Context, params SetDOMStorageItemParams) (SetDOMStorageItemReturns, error) {
+	var returns_ SetDOMStorageItemReturns
+
+	err_ := c.caller.Call(ctx, "DOMStorage.setDOMStorageItem", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type DomStorageItemAddedEvent struct {
+	StorageId StorageId `json:"storageId"`
+
+	Key string `json:"key"`
+
+	NewValue string `json:"newValue"`
+}
+
+// UnmarshalCDP reads DomStorageItemAddedEvent from JSON
+func (v *DomStorageItemAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageId":
+			v.StorageId.UnmarshalCDP(d)
+		case "key":
+			v.Key = d.String()
+		case "newValue":
+			v.NewValue = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DomStorageItemAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DomStorageItemAddedHandler func(ev DomStorageItemAddedEvent)
+
+// EventMethod is DOMStorage.domStorageItemAdded
+func (DomStorageItemAddedEvent) EventMethod() string {
+	return "DOMStorage.domStorageItemAdded"
+}
+
+// OnDomStorageItemAdded calls handler for each DOMStorage.domStorageItemAdded event
+func (c Client) OnDomStorageItemAdded(handler DomStorageItemAddedHandler) (unsubscribe func()) {
+	return c.caller.On("DOMStorage.domStorageItemAdded", func(ev interface{}) {
+		handler(ev.(DomStorageItemAddedEvent))
+	})
+}
+
+type DomStorageItemRemovedEvent struct {
+	StorageId StorageId `json:"storageId"`
+
+	Key string `json:"key"`
+}
+
+// UnmarshalCDP reads DomStorageItemRemovedEvent from JSON
+func (v *DomStorageItemRemovedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageId":
+			v.StorageId.UnmarshalCDP(d)
+		case "key":
+			v.Key = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DomStorageItemRemovedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DomStorageItemRemovedHandler func(ev DomStorageItemRemovedEvent)
+
+// EventMethod is DOMStorage.domStorageItemRemoved
+func (DomStorageItemRemovedEvent) EventMethod() string {
+	return "DOMStorage.domStorageItemRemoved"
+}
+
+// OnDomStorageItemRemoved calls handler for each DOMStorage.domStorageItemRemoved event
+func (c Client) OnDomStorageItemRemoved(handler DomStorageItemRemovedHandler) (unsubscribe func()) {
+	return c.caller.On("DOMStorage.domStorageItemRemoved", func(ev interface{}) {
+		handler(ev.(DomStorageItemRemovedEvent))
+	})
+}
+
+type DomStorageItemUpdatedEvent struct {
+	StorageId StorageId `json:"storageId"`
+
+	Key string `json:"key"`
+
+	OldValue string `json:"oldValue"`
+
+	NewValue string `json:"newValue"`
+}
+
+// UnmarshalCDP reads DomStorageItemUpdatedEvent from JSON
+func (v *DomStorageItemUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageId":
+			v.StorageId.UnmarshalCDP(d)
+		case "key":
+			v.Key = d.String()
+		case "oldValue":
+			v.OldValue = d.String()
+		case "newValue":
+			v.NewValue = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DomStorageItemUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DomStorageItemUpdatedHandler func(ev DomStorageItemUpdatedEvent)
+
+// EventMethod is DOMStorage.domStorageItemUpdated
+func (DomStorageItemUpdatedEvent) EventMethod() string {
+	return "DOMStorage.domStorageItemUpdated"
+}
+
+// OnDomStorageItemUpdated calls handler for each DOMStorage.domStorageItemUpdated event
+func (c Client) OnDomStorageItemUpdated(handler DomStorageItemUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("DOMStorage.domStorageItemUpdated", func(ev interface{}) {
+		handler(ev.(DomStorageItemUpdatedEvent))
+	})
+}
+
+type DomStorageItemsClearedEvent struct {
+	StorageId StorageId `json:"storageId"`
+}
+
+// UnmarshalCDP reads DomStorageItemsClearedEvent from JSON
+func (v *DomStorageItemsClearedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageId":
+			v.StorageId.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DomStorageItemsClearedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DomStorageItemsClearedHandler func(ev DomStorageItemsClearedEvent)
+
+// EventMethod is DOMStorage.domStorageItemsCleared
+func (DomStorageItemsClearedEvent) EventMethod() string {
+	return "DOMStorage.domStorageItemsCleared"
+}
+
+// OnDomStorageItemsCleared calls handler for each DOMStorage.domStorageItemsCleared event
+func (c Client) OnDomStorageItemsCleared(handler DomStorageItemsClearedHandler) (unsubscribe func()) {
+	return c.caller.On("DOMStorage.domStorageItemsCleared", func(ev interface{}) {
+		handler(ev.(DomStorageItemsClearedEvent))
+	})
+}
+
+// decoders for DOMStorage events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"DOMStorage.domStorageItemAdded":    cdp.Decode[DomStorageItemAddedEvent],
+	"DOMStorage.domStorageItemRemoved":  cdp.Decode[DomStorageItemRemovedEvent],
+	"DOMStorage.domStorageItemUpdated":  cdp.Decode[DomStorageItemUpdatedEvent],
+	"DOMStorage.domStorageItemsCleared": cdp.Decode[DomStorageItemsClearedEvent],
+}
+
+// DecodeEvent decodes a DOMStorage event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
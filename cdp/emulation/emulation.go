@@ -0,0 +1,1918 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package emulation is the Emulation domain of the chrome devtools protocol
+//
+// This domain emulates different environments for the page.
+package emulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Emulation commands and listens for Emulation events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	ScreenOrientation     = cdp.EmulationScreenOrientation
+	ScreenOrientationType = cdp.EmulationScreenOrientationType
+	UserAgentBrandVersion = cdp.EmulationUserAgentBrandVersion
+	UserAgentMetadata     = cdp.EmulationUserAgentMetadata
+)
+
+// ScreenOrientationType values
+const (
+	ScreenOrientationTypePortraitPrimary    = cdp.EmulationScreenOrientationTypePortraitPrimary
+	ScreenOrientationTypePortraitSecondary  = cdp.EmulationScreenOrientationTypePortraitSecondary
+	ScreenOrientationTypeLandscapePrimary   = cdp.EmulationScreenOrientationTypeLandscapePrimary
+	ScreenOrientationTypeLandscapeSecondary = cdp.EmulationScreenOrientationTypeLandscapeSecondary
+)
+
+// Experimental: this may change or be removed in any chrome release
+type SafeAreaInsets struct {
+	/* Overrides safe-area-inset-top. */
+	Top *int `json:"top,omitempty"`
+	/* Overrides safe-area-max-inset-top. */
+	TopMax *int `json:"topMax,omitempty"`
+	/* Overrides safe-area-inset-left. */
+	Left *int `json:"left,omitempty"`
+	/* Overrides safe-area-max-inset-left. */
+	LeftMax *int `json:"leftMax,omitempty"`
+	/* Overrides safe-area-inset-bottom. */
+	Bottom *int `json:"bottom,omitempty"`
+	/* Overrides safe-area-max-inset-bottom. */
+	BottomMax *int `json:"bottomMax,omitempty"`
+	/* Overrides safe-area-inset-right. */
+	Right *int `json:"right,omitempty"`
+	/* Overrides safe-area-max-inset-right. */
+	RightMax *int `json:"rightMax,omitempty"`
+}
+
+// MarshalCDP writes SafeAreaInsets as JSON
+func (v *SafeAreaInsets) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Top != nil {
+		e.Key("top")
+		e.Int(*v.Top)
+	}
+	if v.TopMax != nil {
+		e.Key("topMax")
+		e.Int(*v.TopMax)
+	}
+	if v.Left != nil {
+		e.Key("left")
+		e.Int(*v.Left)
+	}
+	if v.LeftMax != nil {
+		e.Key("leftMax")
+		e.Int(*v.LeftMax)
+	}
+	if v.Bottom != nil {
+		e.Key("bottom")
+		e.Int(*v.Bottom)
+	}
+	if v.BottomMax != nil {
+		e.Key("bottomMax")
+		e.Int(*v.BottomMax)
+	}
+	if v.Right != nil {
+		e.Key("right")
+		e.Int(*v.Right)
+	}
+	if v.RightMax != nil {
+		e.Key("rightMax")
+		e.Int(*v.RightMax)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SafeAreaInsets) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SafeAreaInsets from JSON
+func (v *SafeAreaInsets) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "top":
+			if d.Null() {
+				v.Top = nil
+			} else {
+				v.Top = new(int)
+				*v.Top = d.Int()
+			}
+		case "topMax":
+			if d.Null() {
+				v.TopMax = nil
+			} else {
+				v.TopMax = new(int)
+				*v.TopMax = d.Int()
+			}
+		case "left":
+			if d.Null() {
+				v.Left = nil
+			} else {
+				v.Left = new(int)
+				*v.Left = d.Int()
+			}
+		case "leftMax":
+			if d.Null() {
+				v.LeftMax = nil
+			} else {
+				v.LeftMax = new(int)
+				*v.LeftMax = d.Int()
+			}
+		case "bottom":
+			if d.Null() {
+				v.Bottom = nil
+			} else {
+				v.Bottom = new(int)
+				*v.Bottom = d.Int()
+			}
+		case "bottomMax":
+			if d.Null() {
+				v.BottomMax = nil
+			} else {
+				v.BottomMax = new(int)
+				*v.BottomMax = d.Int()
+			}
+		case "right":
+			if d.Null() {
+				v.Right = nil
+			} else {
+				v.Right = new(int)
+				*v.Right = d.Int()
+			}
+		case "rightMax":
+			if d.Null() {
+				v.RightMax = nil
+			} else {
+				v.RightMax = new(int)
+				*v.RightMax = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SafeAreaInsets) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DisplayFeature struct {
+	/* Orientation of a display feature in relation to screen */
+	Orientation DisplayFeatureOrientation `json:"orientation"`
+	/* The offset from the screen origin in either the x (for vertical
+	orientation) or y (for horizontal orientation) direction. */
+	Offset int `json:"offset"`
+	/* A display feature may mask content such that it is not physically
+	displayed - this length along with the offset describes this area.
+	A display feature that only splits content will have a 0 mask_length. */
+	MaskLength int `json:"maskLength"`
+}
+
+// MarshalCDP writes DisplayFeature as JSON
+func (v *DisplayFeature) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("orientation")
+	e.String(string(v.Orientation))
+	e.Key("offset")
+	e.Int(v.Offset)
+	e.Key("maskLength")
+	e.Int(v.MaskLength)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DisplayFeature) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DisplayFeature from JSON
+func (v *DisplayFeature) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "orientation":
+			v.Orientation = DisplayFeatureOrientation(d.String())
+		case "offset":
+			v.Offset = d.Int()
+		case "maskLength":
+			v.MaskLength = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisplayFeature) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DisplayFeatureOrientation string
+
+// DisplayFeatureOrientation values
+const (
+	DisplayFeatureOrientationVertical   DisplayFeatureOrientation = "vertical"
+	DisplayFeatureOrientationHorizontal DisplayFeatureOrientation = "horizontal"
+)
+
+// Values gives every DisplayFeatureOrientation
+func (DisplayFeatureOrientation) Values() []DisplayFeatureOrientation {
+	return []DisplayFeatureOrientation{
+		DisplayFeatureOrientationVertical,
+		DisplayFeatureOrientationHorizontal,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DisplayFeatureOrientation) Valid() bool {
+	switch v {
+	case DisplayFeatureOrientationVertical, DisplayFeatureOrientationHorizontal:
+		return true
+	}
+	return false
+}
+
+type DevicePosture struct {
+	/* Current posture of the device */
+	Type DevicePostureType `json:"type"`
+}
+
+// MarshalCDP writes DevicePosture as JSON
+func (v *DevicePosture) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DevicePosture) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DevicePosture from JSON
+func (v *DevicePosture) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = DevicePostureType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DevicePosture) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DevicePostureType string
+
+// DevicePostureType values
+const (
+	DevicePostureTypeContinuous DevicePostureType = "continuous"
+	DevicePostureTypeFolded     DevicePostureType = "folded"
+)
+
+// Values gives every DevicePostureType
+func (DevicePostureType) Values() []DevicePostureType {
+	return []DevicePostureType{
+		DevicePostureTypeContinuous,
+		DevicePostureTypeFolded,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DevicePostureType) Valid() bool {
+	switch v {
+	case DevicePostureTypeContinuous, DevicePostureTypeFolded:
+		return true
+	}
+	return false
+}
+
+type MediaFeature struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes MediaFeature as JSON
+func (v *MediaFeature) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v MediaFeature) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads MediaFeature from JSON
+func (v *MediaFeature) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MediaFeature) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// advance: If the scheduler runs out of immediate work, the virtual time base may fast forward to
+// allow the next delayed task (if any) to run; pause: The virtual time base may not advance;
+// pauseIfNetworkFetchesPending: The virtual time base may not advance if there are any pending
+// resource fetches.
+//
+// Experimental: this may change or be removed in any chrome release
+type VirtualTimePolicy string
+
+// VirtualTimePolicy values
+const (
+	VirtualTimePolicyAdvance                      VirtualTimePolicy = "advance"
+	VirtualTimePolicyPause                        VirtualTimePolicy = "pause"
+	VirtualTimePolicyPauseIfNetworkFetchesPending VirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// Values gives every VirtualTimePolicy
+func (VirtualTimePolicy) Values() []VirtualTimePolicy {
+	return []VirtualTimePolicy{
+		VirtualTimePolicyAdvance,
+		VirtualTimePolicyPause,
+		VirtualTimePolicyPauseIfNetworkFetchesPending,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v VirtualTimePolicy) Valid() bool {
+	switch v {
+	case VirtualTimePolicyAdvance, VirtualTimePolicyPause, VirtualTimePolicyPauseIfNetworkFetchesPending:
+		return true
+	}
+	return false
+}
+
+// Used to specify sensor types to emulate.
+// See https://w3c.github.io/sensors/#automation for more information.
+//
+// Experimental: this may change or be removed in any chrome release
+type SensorType string
+
+// SensorType values
+const (
+	SensorTypeAbsoluteOrientation SensorType = "absolute-orientation"
+	SensorTypeAccelerometer       SensorType = "accelerometer"
+	SensorTypeAmbientLight        SensorType = "ambient-light"
+	SensorTypeGravity             SensorType = "gravity"
+	SensorTypeGyroscope           SensorType = "gyroscope"
+	SensorTypeLinearAcceleration  SensorType = "linear-acceleration"
+	SensorTypeMagnetometer        SensorType = "magnetometer"
+	SensorTypeRelativeOrientation SensorType = "relative-orientation"
+)
+
+// Values gives every SensorType
+func (SensorType) Values() []SensorType {
+	return []SensorType{
+		SensorTypeAbsoluteOrientation,
+		SensorTypeAccelerometer,
+		SensorTypeAmbientLight,
+		SensorTypeGravity,
+		SensorTypeGyroscope,
+		SensorTypeLinearAcceleration,
+		SensorTypeMagnetometer,
+		SensorTypeRelativeOrientation,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SensorType) Valid() bool {
+	switch v {
+	case SensorTypeAbsoluteOrientation, SensorTypeAccelerometer, SensorTypeAmbientLight, SensorTypeGravity, SensorTypeGyroscope, SensorTypeLinearAcceleration, SensorTypeMagnetometer, SensorTypeRelativeOrientation:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SensorMetadata struct {
+	Available        *bool    `json:"available,omitempty"`
+	MinimumFrequency *float64 `json:"minimumFrequency,omitempty"`
+	MaximumFrequency *float64 `json:"maximumFrequency,omitempty"`
+}
+
+// MarshalCDP writes SensorMetadata as JSON
+func (v *SensorMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Available != nil {
+		e.Key("available")
+		e.Bool(*v.Available)
+	}
+	if v.MinimumFrequency != nil {
+		e.Key("minimumFrequency")
+		e.Float(*v.MinimumFrequency)
+	}
+	if v.MaximumFrequency != nil {
+		e.Key("maximumFrequency")
+		e.Float(*v.MaximumFrequency)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SensorMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SensorMetadata from JSON
+func (v *SensorMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "available":
+			if d.Null() {
+				v.Available = nil
+			} else {
+				v.Available = new(bool)
+				*v.Available = d.Bool()
+			}
+		case "minimumFrequency":
+			if d.Null() {
+				v.MinimumFrequency = nil
+			} else {
+				v.MinimumFrequency = new(float64)
+				*v.MinimumFrequency = d.Float()
+			}
+		case "maximumFrequency":
+			if d.Null() {
+				v.MaximumFrequency = nil
+			} else {
+				v.MaximumFrequency = new(float64)
+				*v.MaximumFrequency = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SensorMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SensorReadingSingle struct {
+	Value float64 `json:"value"`
+}
+
+// MarshalCDP writes SensorReadingSingle as JSON
+func (v *SensorReadingSingle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("value")
+	e.Float(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SensorReadingSingle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SensorReadingSingle from JSON
+func (v *SensorReadingSingle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			v.Value = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SensorReadingSingle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SensorReadingXYZ struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// MarshalCDP writes SensorReadingXYZ as JSON
+func (v *SensorReadingXYZ) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("z")
+	e.Float(v.Z)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SensorReadingXYZ) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SensorReadingXYZ from JSON
+func (v *SensorReadingXYZ) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			v.X = d.Float()
+		case "y":
+			v.Y = d.Float()
+		case "z":
+			v.Z = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SensorReadingXYZ) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SensorReadingQuaternion struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+	W float64 `json:"w"`
+}
+
+// MarshalCDP writes SensorReadingQuaternion as JSON
+func (v *SensorReadingQuaternion) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("z")
+	e.Float(v.Z)
+	e.Key("w")
+	e.Float(v.W)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SensorReadingQuaternion) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SensorReadingQuaternion from JSON
+func (v *SensorReadingQuaternion) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			v.X = d.Float()
+		case "y":
+			v.Y = d.Float()
+		case "z":
+			v.Z = d.Float()
+		case "w":
+			v.W = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SensorReadingQuaternion) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SensorReading struct {
+	Single     *SensorReadingSingle     `json:"single,omitempty"`
+	Xyz        *SensorReadingXYZ        `json:"xyz,omitempty"`
+	Quaternion *SensorReadingQuaternion `json:"quaternion,omitempty"`
+}
+
+// MarshalCDP writes SensorReading as JSON
+func (v *SensorReading) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Single != nil {
+		e.Key("single")
+		(*v.Single).MarshalCDP(e)
+	}
+	if v.Xyz != nil {
+		e.Key("xyz")
+		(*v.Xyz).MarshalCDP(e)
+	}
+	if v.Quaternion != nil {
+		e.Key("quaternion")
+		(*v.Quaternion).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SensorReading) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SensorReading from JSON
+func (v *SensorReading) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "single":
+			if d.Null() {
+				v.Single = nil
+			} else {
+				v.Single = new(SensorReadingSingle)
+				(*v.Single).UnmarshalCDP(d)
+			}
+		case "xyz":
+			if d.Null() {
+				v.Xyz = nil
+			} else {
+				v.Xyz = new(SensorReadingXYZ)
+				(*v.Xyz).UnmarshalCDP(d)
+			}
+		case "quaternion":
+			if d.Null() {
+				v.Quaternion = nil
+			} else {
+				v.Quaternion = new(SensorReadingQuaternion)
+				(*v.Quaternion).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SensorReading) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PressureSource string
+
+// PressureSource values
+const (
+	PressureSourceCpu PressureSource = "cpu"
+)
+
+// Values gives every PressureSource
+func (PressureSource) Values() []PressureSource {
+	return []PressureSource{
+		PressureSourceCpu,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PressureSource) Valid() bool {
+	switch v {
+	case PressureSourceCpu:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PressureState string
+
+// PressureState values
+const (
+	PressureStateNominal  PressureState = "nominal"
+	PressureStateFair     PressureState = "fair"
+	PressureStateSerious  PressureState = "serious"
+	PressureStateCritical PressureState = "critical"
+)
+
+// Values gives every PressureState
+func (PressureState) Values() []PressureState {
+	return []PressureState{
+		PressureStateNominal,
+		PressureStateFair,
+		PressureStateSerious,
+		PressureStateCritical,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PressureState) Valid() bool {
+	switch v {
+	case PressureStateNominal, PressureStateFair, PressureStateSerious, PressureStateCritical:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PressureMetadata struct {
+	Available *bool `json:"available,omitempty"`
+}
+
+// MarshalCDP writes PressureMetadata as JSON
+func (v *PressureMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Available != nil {
+		e.Key("available")
+		e.Bool(*v.Available)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PressureMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PressureMetadata from JSON
+func (v *PressureMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "available":
+			if d.Null() {
+				v.Available = nil
+			} else {
+				v.Available = new(bool)
+				*v.Available = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PressureMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enum of image types that can be disabled.
+//
+// Experimental: this may change or be removed in any chrome release
+type DisabledImageType string
+
+// DisabledImageType values
+const (
+	DisabledImageTypeAvif DisabledImageType = "avif"
+	DisabledImageTypeWebp DisabledImageType = "webp"
+)
+
+// Values gives every DisabledImageType
+func (DisabledImageType) Values() []DisabledImageType {
+	return []DisabledImageType{
+		DisabledImageTypeAvif,
+		DisabledImageTypeWebp,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DisabledImageType) Valid() bool {
+	switch v {
+	case DisabledImageTypeAvif, DisabledImageTypeWebp:
+		return true
+	}
+	return false
+}
+
+type SetEmitTouchEventsForMouseConfiguration string
+
+// SetEmitTouchEventsForMouseConfiguration values
+const (
+	SetEmitTouchEventsForMouseConfigurationMobile  SetEmitTouchEventsForMouseConfiguration = "mobile"
+	SetEmitTouchEventsForMouseConfigurationDesktop SetEmitTouchEventsForMouseConfiguration = "desktop"
+)
+
+// Values gives every SetEmitTouchEventsForMouseConfiguration
+func (SetEmitTouchEventsForMouseConfiguration) Values() []SetEmitTouchEventsForMouseConfiguration {
+	return []SetEmitTouchEventsForMouseConfiguration{
+		SetEmitTouchEventsForMouseConfigurationMobile,
+		SetEmitTouchEventsForMouseConfigurationDesktop,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetEmitTouchEventsForMouseConfiguration) Valid() bool {
+	switch v {
+	case SetEmitTouchEventsForMouseConfigurationMobile, SetEmitTouchEventsForMouseConfigurationDesktop:
+		return true
+	}
+	return false
+}
+
+type SetEmulatedVisionDeficiencyType string
+
+// SetEmulatedVisionDeficiencyType values
+const (
+	SetEmulatedVisionDeficiencyTypeNone            SetEmulatedVisionDeficiencyType = "none"
+	SetEmulatedVisionDeficiencyTypeBlurredVision   SetEmulatedVisionDeficiencyType = "blurredVision"
+	SetEmulatedVisionDeficiencyTypeReducedContrast SetEmulatedVisionDeficiencyType = "reducedContrast"
+	SetEmulatedVisionDeficiencyTypeAchromatopsia   SetEmulatedVisionDeficiencyType = "achromatopsia"
+	SetEmulatedVisionDeficiencyTypeDeuteranopia    SetEmulatedVisionDeficiencyType = "deuteranopia"
+	SetEmulatedVisionDeficiencyTypeProtanopia      SetEmulatedVisionDeficiencyType = "protanopia"
+	SetEmulatedVisionDeficiencyTypeTritanopia      SetEmulatedVisionDeficiencyType = "tritanopia"
+)
+
+// Values gives every SetEmulatedVisionDeficiencyType
+func (SetEmulatedVisionDeficiencyType) Values() []SetEmulatedVisionDeficiencyType {
+	return []SetEmulatedVisionDeficiencyType{
+		SetEmulatedVisionDeficiencyTypeNone,
+		SetEmulatedVisionDeficiencyTypeBlurredVision,
+		SetEmulatedVisionDeficiencyTypeReducedContrast,
+		SetEmulatedVisionDeficiencyTypeAchromatopsia,
+		SetEmulatedVisionDeficiencyTypeDeuteranopia,
+		SetEmulatedVisionDeficiencyTypeProtanopia,
+		SetEmulatedVisionDeficiencyTypeTritanopia,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetEmulatedVisionDeficiencyType) Valid() bool {
+	switch v {
+	case SetEmulatedVisionDeficiencyTypeNone, SetEmulatedVisionDeficiencyTypeBlurredVision, SetEmulatedVisionDeficiencyTypeReducedContrast, SetEmulatedVisionDeficiencyTypeAchromatopsia, SetEmulatedVisionDeficiencyTypeDeuteranopia, SetEmulatedVisionDeficiencyTypeProtanopia, SetEmulatedVisionDeficiencyTypeTritanopia:
+		return true
+	}
+	return false
+}
+
+type CanEmulateReturns struct {
+
+	/* True if emulation is supported. */
+	Result bool `json:"result"`
+}
+
+// UnmarshalCDP reads CanEmulateReturns from JSON
+func (v *CanEmulateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CanEmulateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Tells whether emulation is supported.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanEmulate() (CanEmulateReturns, error) {
+	return c.CanEmulateContext(context.Background())
+}
+
+// CanEmulateContext is CanEmulate with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanEmulateContext(ctx context.Context) (CanEmulateReturns, error) {
+	var returns_ CanEmulateReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.canEmulate", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDeviceMetricsOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearDeviceMetricsOverrideReturns from JSON
+func (v *ClearDeviceMetricsOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDeviceMetricsOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the overridden device metrics.
+func (c Client) ClearDeviceMetricsOverride() (ClearDeviceMetricsOverrideReturns, error) {
+	return c.ClearDeviceMetricsOverrideContext(context.Background())
+}
+
+// ClearDeviceMetricsOverrideContext is ClearDeviceMetricsOverride with a context for cancellation and deadlines
+func (c Client) ClearDeviceMetricsOverrideContext(ctx context.Context) (ClearDeviceMetricsOverrideReturns, error) {
+	var returns_ ClearDeviceMetricsOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.clearDeviceMetricsOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ClearGeolocationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearGeolocationOverrideReturns from JSON
+func (v *ClearGeolocationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearGeolocationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the overridden Geolocation Position and Error.
+func (c Client) ClearGeolocationOverride() (ClearGeolocationOverrideReturns, error) {
+	return c.ClearGeolocationOverrideContext(context.Background())
+}
+
+// ClearGeolocationOverrideContext is ClearGeolocationOverride with a context for cancellation and deadlines
+func (c Client) ClearGeolocationOverrideContext(ctx context.Context) (ClearGeolocationOverrideReturns, error) {
+	var returns_ ClearGeolocationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.clearGeolocationOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetCPUThrottlingRateReturns struct {
+}
+
+// UnmarshalCDP reads SetCPUThrottlingRateReturns from JSON
+func (v *SetCPUThrottlingRateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCPUThrottlingRateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCPUThrottlingRateParams are the parameters for Emulation.setCPUThrottlingRate
+// optional parameters are left out when nil
+type SetCPUThrottlingRateParams struct {
+	/* Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc). */
+	Rate float64 `json:"rate"`
+}
+
+// MarshalCDP writes SetCPUThrottlingRateParams as JSON
+func (v *SetCPUThrottlingRateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("rate")
+	e.Float(v.Rate)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCPUThrottlingRateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables CPU throttling to emulate slow CPUs.
+func (c Client) SetCPUThrottlingRate(params SetCPUThrottlingRateParams) (SetCPUThrottlingRateReturns, error) {
+	return c.SetCPUThrottlingRateContext(context.Background(), params)
+}
+
+// SetCPUThrottlingRateContext is SetCPUThrottlingRate with a context for cancellation and deadlines
+func (c Client) SetCPUThrottlingRateContext(ctx context.Context, params SetCPUThrottlingRateParams) (SetCPUThrottlingRateReturns, error) {
+	var returns_ SetCPUThrottlingRateReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setCPUThrottlingRate", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDefaultBackgroundColorOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDefaultBackgroundColorOverrideReturns from JSON
+func (v *SetDefaultBackgroundColorOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDefaultBackgroundColorOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDefaultBackgroundColorOverrideParams are the parameters for Emulation.setDefaultBackgroundColorOverride
+// optional parameters are left out when nil
+type SetDefaultBackgroundColorOverrideParams struct {
+	/* RGBA of the default background color. If not specified, any existing override will be
+	cleared. */
+	Color *cdp.DOMRGBA `json:"color,omitempty"`
+}
+
+// MarshalCDP writes SetDefaultBackgroundColorOverrideParams as JSON
+func (v *SetDefaultBackgroundColorOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Color != nil {
+		e.Key("color")
+		(*v.Color).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDefaultBackgroundColorOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets or clears an override of the default background color of the frame. This override is used
+// if the content does not specify one.
+func (c Client) SetDefaultBackgroundColorOverride(params SetDefaultBackgroundColorOverrideParams) (SetDefaultBackgroundColorOverrideReturns, error) {
+	return c.SetDefaultBackgroundColorOverrideContext(context.Background(), params)
+}
+
+// SetDefaultBackgroundColorOverrideContext is SetDefaultBackgroundColorOverride with a context for cancellation and deadlines
+func (c Client) SetDefaultBackgroundColorOverrideContext(ctx context.Context, params SetDefaultBackgroundColorOverrideParams) (SetDefaultBackgroundColorOverrideReturns, error) {
+	var returns_ SetDefaultBackgroundColorOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDefaultBackgroundColorOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDeviceMetricsOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDeviceMetricsOverrideReturns from JSON
+func (v *SetDeviceMetricsOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDeviceMetricsOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDeviceMetricsOverrideParams are the parameters for Emulation.setDeviceMetricsOverride
+// optional parameters are left out when nil
+type SetDeviceMetricsOverrideParams struct {
+	/* Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override. */
+	Width int `json:"width"`
+	/* Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override. */
+	Height int `json:"height"`
+	/* Overriding device scale factor value. 0 disables the override. */
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	/* Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text
+	autosizing and more. */
+	Mobile bool `json:"mobile"`
+	/* Scale to apply to resulting view image.
+
+	Experimental: this may change or be removed in any chrome release */
+	Scale *float64 `json:"scale,omitempty"`
+	/* Overriding screen width value in pixels (minimum 0, maximum 10000000).
+
+	Experimental: this may change or be removed in any chrome release */
+	ScreenWidth *int `json:"screenWidth,omitempty"`
+	/* Overriding screen height value in pixels (minimum 0, maximum 10000000).
+
+	Experimental: this may change or be removed in any chrome release */
+	ScreenHeight *int `json:"screenHeight,omitempty"`
+	/* Overriding view X position on screen in pixels (minimum 0, maximum 10000000).
+
+	Experimental: this may change or be removed in any chrome release */
+	PositionX *int `json:"positionX,omitempty"`
+	/* Overriding view Y position on screen in pixels (minimum 0, maximum 10000000).
+
+	Experimental: this may change or be removed in any chrome release */
+	PositionY *int `json:"positionY,omitempty"`
+	/* Do not set visible view size, rely upon explicit setVisibleSize call.
+
+	Experimental: this may change or be removed in any chrome release */
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+	/* Screen orientation override. */
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+	/* If set, the visible area of the page will be overridden to this viewport. This viewport
+	change is not observed by the page, e.g. viewport-relative elements do not change positions.
+
+	Experimental: this may change or be removed in any chrome release */
+	Viewport *cdp.PageViewport `json:"viewport,omitempty"`
+	/* If set, the display feature of a multi-segment screen. If not set, multi-segment support
+	is turned-off.
+	Deprecated, use Emulation.setDisplayFeaturesOverride.
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	DisplayFeature *DisplayFeature `json:"displayFeature,omitempty"`
+	/* If set, the posture of a foldable device. If not set the posture is set
+	to continuous.
+	Deprecated, use Emulation.setDevicePostureOverride.
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	DevicePosture *DevicePosture `json:"devicePosture,omitempty"`
+}
+
+// MarshalCDP writes SetDeviceMetricsOverrideParams as JSON
+func (v *SetDeviceMetricsOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("width")
+	e.Int(v.Width)
+	e.Key("height")
+	e.Int(v.Height)
+	e.Key("deviceScaleFactor")
+	e.Float(v.DeviceScaleFactor)
+	e.Key("mobile")
+	e.Bool(v.Mobile)
+	if v.Scale != nil {
+		e.Key("scale")
+		e.Float(*v.Scale)
+	}
+	if v.ScreenWidth != nil {
+		e.Key("screenWidth")
+		e.Int(*v.ScreenWidth)
+	}
+	if v.ScreenHeight != nil {
+		e.Key("screenHeight")
+		e.Int(*v.ScreenHeight)
+	}
+	if v.PositionX != nil {
+		e.Key("positionX")
+		e.Int(*v.PositionX)
+	}
+	if v.PositionY != nil {
+		e.Key("positionY")
+		e.Int(*v.PositionY)
+	}
+	if v.DontSetVisibleSize != nil {
+		e.Key("dontSetVisibleSize")
+		e.Bool(*v.DontSetVisibleSize)
+	}
+	if v.ScreenOrientation != nil {
+		e.Key("screenOrientation")
+		(*v.ScreenOrientation).MarshalCDP(e)
+	}
+	if v.Viewport != nil {
+		e.Key("viewport")
+		(*v.Viewport).MarshalCDP(e)
+	}
+	if v.DisplayFeature != nil {
+		e.Key("displayFeature")
+		(*v.DisplayFeature).MarshalCDP(e)
+	}
+	if v.DevicePosture != nil {
+		e.Key("devicePosture")
+		(*v.DevicePosture).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDeviceMetricsOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+func (c Client) SetDeviceMetricsOverride(params SetDeviceMetricsOverrideParams) (SetDeviceMetricsOverrideReturns, error) {
+	return c.SetDeviceMetricsOverrideContext(context.Background(), params)
+}
+
+// SetDeviceMetricsOverrideContext is SetDeviceMetricsOverride with a context for cancellation and deadlines
+func (c Client) SetDeviceMetricsOverrideContext(ctx context.Context, params SetDeviceMetricsOverrideParams) (SetDeviceMetricsOverrideReturns, error) {
+	var returns_ SetDeviceMetricsOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDeviceMetricsOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetEmulatedMediaReturns struct {
+}
+
+// UnmarshalCDP reads SetEmulatedMediaReturns from JSON
+func (v *SetEmulatedMediaReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetEmulatedMediaReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetEmulatedMediaParams are the parameters for Emulation.setEmulatedMedia
+// optional parameters are left out when nil
+type SetEmulatedMediaParams struct {
+	/* Media type to emulate. Empty string disables the override. */
+	Media *string `json:"media,omitempty"`
+	/* Media features to emulate. */
+	Features []MediaFeature `json:"features"`
+}
+
+// MarshalCDP writes SetEmulatedMediaParams as JSON
+func (v *SetEmulatedMediaParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Media != nil {
+		e.Key("media")
+		e.String(*v.Media)
+	}
+	if v.Features != nil {
+		e.Key("features")
+		e.ArrayStart()
+		for i0 := range v.Features {
+			e.Elem()
+			v.Features[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetEmulatedMediaParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Emulates the given media type or media feature for CSS media queries.
+func (c Client) SetEmulatedMedia(params SetEmulatedMediaParams) (SetEmulatedMediaReturns, error) {
+	return c.SetEmulatedMediaContext(context.Background(), params)
+}
+
+// SetEmulatedMediaContext is SetEmulatedMedia with a context for cancellation and deadlines
+func (c Client) SetEmulatedMediaContext(ctx context.Context, params SetEmulatedMediaParams) (SetEmulatedMediaReturns, error) {
+	var returns_ SetEmulatedMediaReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setEmulatedMedia", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetEmulatedVisionDeficiencyReturns struct {
+}
+
+// UnmarshalCDP reads SetEmulatedVisionDeficiencyReturns from JSON
+func (v *SetEmulatedVisionDeficiencyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetEmulatedVisionDeficiencyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetEmulatedVisionDeficiencyParams are the parameters for Emulation.setEmulatedVisionDeficiency
+// optional parameters are left out when nil
+type SetEmulatedVisionDeficiencyParams struct {
+	/* Vision deficiency to emulate. Order: best-effort emulations come first, followed by any
+	physiologically accurate emulations for medically recognized color vision deficiencies. */
+	Type SetEmulatedVisionDeficiencyType `json:"type"`
+}
+
+// MarshalCDP writes SetEmulatedVisionDeficiencyParams as JSON
+func (v *SetEmulatedVisionDeficiencyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetEmulatedVisionDeficiencyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Emulates the given vision deficiency.
+func (c Client) SetEmulatedVisionDeficiency(params SetEmulatedVisionDeficiencyParams) (SetEmulatedVisionDeficiencyReturns, error) {
+	return c.SetEmulatedVisionDeficiencyContext(context.Background(), params)
+}
+
+// SetEmulatedVisionDeficiencyContext is SetEmulatedVisionDeficiency with a context for cancellation and deadlines
+func (c Client) SetEmulatedVisionDeficiencyContext(ctx context.Context, params SetEmulatedVisionDeficiencyParams) (SetEmulatedVisionDeficiencyReturns, error) {
+	var returns_ SetEmulatedVisionDeficiencyReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Emulation.setEmulatedVisionDeficiency: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setEmulatedVisionDeficiency", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetEmulatedOSTextScaleReturns struct {
+}
+
+// UnmarshalCDP reads SetEmulatedOSTextScaleReturns from JSON
+func (v *SetEmulatedOSTextScaleReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetEmulatedOSTextScaleReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetEmulatedOSTextScaleParams are the parameters for Emulation.setEmulatedOSTextScale
+// optional parameters are left out when nil
+type SetEmulatedOSTextScaleParams struct {
+	Scale *float64 `json:"scale,omitempty"`
+}
+
+// MarshalCDP writes SetEmulatedOSTextScaleParams as JSON
+func (v *SetEmulatedOSTextScaleParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Scale != nil {
+		e.Key("scale")
+		e.Float(*v.Scale)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetEmulatedOSTextScaleParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Emulates the given OS text scale.
+func (c Client) SetEmulatedOSTextScale(params SetEmulatedOSTextScaleParams) (SetEmulatedOSTextScaleReturns, error) {
+	return c.SetEmulatedOSTextScaleContext(context.Background(), params)
+}
+
+// SetEmulatedOSTextScaleContext is SetEmulatedOSTextScale with a context for cancellation and deadlines
+func (c Client) SetEmulatedOSTextScaleContext(ctx context.Context, params SetEmulatedOSTextScaleParams) (SetEmulatedOSTextScaleReturns, error) {
+	var returns_ SetEmulatedOSTextScaleReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setEmulatedOSTextScale", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetGeolocationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetGeolocationOverrideReturns from JSON
+func (v *SetGeolocationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetGeolocationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetGeolocationOverrideParams are the parameters for Emulation.setGeolocationOverride
+// optional parameters are left out when nil
+type SetGeolocationOverrideParams struct {
+	/* Mock latitude */
+	Latitude *float64 `json:"latitude,omitempty"`
+	/* Mock longitude */
+	Longitude *float64 `json:"longitude,omitempty"`
+	/* Mock accuracy */
+	Accuracy *float64 `json:"accuracy,omitempty"`
+	/* Mock altitude */
+	Altitude *float64 `json:"altitude,omitempty"`
+	/* Mock altitudeAccuracy */
+	AltitudeAccuracy *float64 `json:"altitudeAccuracy,omitempty"`
+	/* Mock heading */
+	Heading *float64 `json:"heading,omitempty"`
+	/* Mock speed */
+	Speed *float64 `json:"speed,omitempty"`
+}
+
+// MarshalCDP writes SetGeolocationOverrideParams as JSON
+func (v *SetGeolocationOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Latitude != nil {
+		e.Key("latitude")
+		e.Float(*v.Latitude)
+	}
+	if v.Longitude != nil {
+		e.Key("longitude")
+		e.Float(*v.Longitude)
+	}
+	if v.Accuracy != nil {
+		e.Key("accuracy")
+		e.Float(*v.Accuracy)
+	}
+	if v.Altitude != nil {
+		e.Key("altitude")
+		e.Float(*v.Altitude)
+	}
+	if v.AltitudeAccuracy != nil {
+		e.Key("altitudeAccuracy")
+		e.Float(*v.AltitudeAccuracy)
+	}
+	if v.Heading != nil {
+		e.Key("heading")
+		e.Float(*v.Heading)
+	}
+	if v.Speed != nil {
+		e.Key("speed")
+		e.Float(*v.Speed)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetGeolocationOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the Geolocation Position or Error. Omitting latitude, longitude or
+// accuracy emulates position unavailable.
+func (c Client) SetGeolocationOverride(params SetGeolocationOverrideParams) (SetGeolocationOverrideReturns, error) {
+	return c.SetGeolocationOverrideContext(context.Background(), params)
+}
+
+// SetGeolocationOverrideContext is SetGeolocationOverride with a context for cancellation and deadlines
+func (c Client) SetGeolocationOverrideContext(ctx context.Context, params SetGeolocationOverrideParams) (SetGeolocationOverrideReturns, error) {
+	var returns_ SetGeolocationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setGeolocationOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetIdleOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetIdleOverrideReturns from JSON
+func (v *SetIdleOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetIdleOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetIdleOverrideParams are the parameters for Emulation.setIdleOverride
+// optional parameters are left out when nil
+type SetIdleOverrideParams struct {
+	/* Mock isUserActive */
+	IsUserActive bool `json:"isUserActive"`
+	/* Mock isScreenUnlocked */
+	IsScreenUnlocked bool `json:"isScreenUnlocked"`
+}
+
+// MarshalCDP writes SetIdleOverrideParams as JSON
+func (v *SetIdleOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("isUserActive")
+	e.Bool(v.IsUserActive)
+	e.Key("isScreenUnlocked")
+	e.Bool(v.IsScreenUnlocked)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetIdleOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the Idle state.
+func (c Client) SetIdleOverride(params SetIdleOverrideParams) (SetIdleOverrideReturns, error) {
+	return c.SetIdleOverrideContext(context.Background(), params)
+}
+
+// SetIdleOverrideContext is SetIdleOverride with a context for cancellation and deadlines
+func (c Client) SetIdleOverrideContext(ctx context.Context, params SetIdleOverrideParams) (SetIdleOverrideReturns, error) {
+	var returns_ SetIdleOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setIdleOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearIdleOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearIdleOverrideReturns from JSON
+func (v *ClearIdleOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearIdleOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears Idle state overrides.
+func (c Client) ClearIdleOverride() (ClearIdleOverrideReturns, error) {
+	return c.ClearIdleOverrideContext(context.Background())
+}
+
+// ClearIdleOverrideContext is ClearIdleOverride with a context for cancellation and deadlines
+func (c Client) ClearIdleOverrideContext(ctx context.Context) (ClearIdleOverrideReturns, error) {
+	var returns_ ClearIdleOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.clearIdleOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetScriptExecutionDisabledReturns struct {
+}
+
+// UnmarshalCDP reads SetScriptExecutionDisabledReturns from JSON
+func (v *SetScriptExecutionDisabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetScriptExecutionDisabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetScriptExecutionDisabledParams are the parameters for Emulation.setScriptExecutionDisabled
+// optional parameters are left out when nil
+type SetScriptExecutionDisabledParams struct {
+	/* Whether script execution should be disabled in the page. */
+	Value bool `json:"value"`
+}
+
+// MarshalCDP writes SetScriptExecutionDisabledParams as JSON
+func (v *SetScriptExecutionDisabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("value")
+	e.Bool(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetScriptExecutionDisabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Switches script execution in the page.
+func (c Client) SetScriptExecutionDisabled(params SetScriptExecutionDisabledParams) (SetScriptExecutionDisabledReturns, error) {
+	return c.SetScriptExecutionDisabledContext(context.Background(), params)
+}
+
+// SetScriptExecutionDisabledContext is SetScriptExecutionDisabled with a context for cancellation and deadlines
+func (c Client) SetScriptExecutionDisabledContext(ctx context.Context, params SetScriptExecutionDisabledParams) (SetScriptExecutionDisabledReturns, error) {
+	var returns_ SetScriptExecutionDisabledReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setScriptExecutionDisabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetTouchEmulationEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetTouchEmulationEnabledReturns from JSON
+func (v *SetTouchEmulationEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetTouchEmulationEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetTouchEmulationEnabledParams are the parameters for Emulation.setTouchEmulationEnabled
+// optional parameters are left out when nil
+type SetTouchEmulationEnabledParams struct {
+	/* Whether the touch event emulation should be enabled. */
+	Enabled bool `json:"enabled"`
+	/* Maximum touch points supported. Defaults to one. */
+	MaxTouchPoints *int `json:"maxTouchPoints,omitempty"`
+}
+
+// MarshalCDP writes SetTouchEmulationEnabledParams as JSON
+func (v *SetTouchEmulationEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	if v.MaxTouchPoints != nil {
+		e.Key("maxTouchPoints")
+		e.Int(*v.MaxTouchPoints)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetTouchEmulationEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables touch on platforms which do not support them.
+func (c Client) SetTouchEmulationEnabled(params SetTouchEmulationEnabledParams) (SetTouchEmulationEnabledReturns, error) {
+	return c.SetTouchEmulationEnabledContext(context.Background(), params)
+}
+
+// SetTouchEmulationEnabledContext is SetTouchEmulationEnabled with a context for cancellation and deadlines
+func (c Client) SetTouchEmulationEnabledContext(ctx context.Context, params SetTouchEmulationEnabledParams) (SetTouchEmulationEnabledReturns, error) {
+	var returns_ SetTouchEmulationEnabledReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setTouchEmulationEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetTimezoneOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetTimezoneOverrideReturns from JSON
+func (v *SetTimezoneOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetTimezoneOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetTimezoneOverrideParams are the parameters for Emulation.setTimezoneOverride
+// optional parameters are left out when nil
+type SetTimezoneOverrideParams struct {
+	/* The timezone identifier. List of supported timezones:
+	https://source.chromium.org/chromium/chromium/deps/icu.git/+/faee8bc70570192d82d2978a71e2a615788597d1:source/data/misc/metaZones.txt
+	If empty, disables the override and restores default host system timezone. */
+	TimezoneId string `json:"timezoneId"`
+}
+
+// MarshalCDP writes SetTimezoneOverrideParams as JSON
+func (v *SetTimezoneOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("timezoneId")
+	e.String(v.TimezoneId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetTimezoneOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides default host system timezone with the specified one.
+func (c Client) SetTimezoneOverride(params SetTimezoneOverrideParams) (SetTimezoneOverrideReturns, error) {
+	return c.SetTimezoneOverrideContext(context.Background(), params)
+}
+
+// SetTimezoneOverrideContext is SetTimezoneOverride with a context for cancellation and deadlines
+func (c Client) SetTimezoneOverrideContext(ctx context.Context, params SetTimezoneOverrideParams) (SetTimezoneOverrideReturns, error) {
+	var returns_ SetTimezoneOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setTimezoneOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetUserAgentOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetUserAgentOverrideReturns from JSON
+func (v *SetUserAgentOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetUserAgentOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetUserAgentOverrideParams are the parameters for Emulation.setUserAgentOverride
+// optional parameters are left out when nil
+type SetUserAgentOverrideParams struct {
+	/* User agent to use. */
+	UserAgent string `json:"userAgent"`
+	/* Browser language to emulate. */
+	AcceptLanguage *string `json:"acceptLanguage,omitempty"`
+	/* The platform navigator.platform should return. */
+	Platform *string `json:"platform,omitempty"`
+	/* To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+
+	Experimental: this may change or be removed in any chrome release */
+	UserAgentMetadata *UserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// MarshalCDP writes SetUserAgentOverrideParams as JSON
+func (v *SetUserAgentOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("userAgent")
+	e.String(v.UserAgent)
+	if v.AcceptLanguage != nil {
+		e.Key("acceptLanguage")
+		e.String(*v.AcceptLanguage)
+	}
+	if v.Platform != nil {
+		e.Key("platform")
+		e.String(*v.Platform)
+	}
+	if v.UserAgentMetadata != nil {
+		e.Key("userAgentMetadata")
+		(*v.UserAgentMetadata).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetUserAgentOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Allows overriding user agent with the given string.
+// `userAgentMetadata` must be set for Client Hint headers to be sent.
+func (c Client) SetUserAgentOverride(params SetUserAgentOverrideParams) (SetUserAgentOverrideReturns, error) {
+	return c.SetUserAgentOverrideContext(context.Background(), params)
+}
+
+// SetUserAgentOverrideContext is SetUserAgentOverride with a context for cancellation and deadlines
+func (c Client) SetUserAgentOverrideContext(ctx context.Context, params SetUserAgentOverrideParams) (SetUserAgentOverrideReturns, error) {
+	var returns_ SetUserAgentOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setUserAgentOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+// decoders for Emulation events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){}
+
+// DecodeEvent decodes a Emulation event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
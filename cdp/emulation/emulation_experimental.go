@@ -0,0 +1,1737 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package emulation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type ResetPageScaleFactorReturns struct {
+}
+
+// UnmarshalCDP reads ResetPageScaleFactorReturns from JSON
+func (v *ResetPageScaleFactorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResetPageScaleFactorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Requests that page scale factor is reset to initial values.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ResetPageScaleFactor() (ResetPageScaleFactorReturns, error) {
+	return c.ResetPageScaleFactorContext(context.Background())
+}
+
+// ResetPageScaleFactorContext is ResetPageScaleFactor with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ResetPageScaleFactorContext(ctx context.Context) (ResetPageScaleFactorReturns, error) {
+	var returns_ ResetPageScaleFactorReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.resetPageScaleFactor", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetFocusEmulationEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetFocusEmulationEnabledReturns from JSON
+func (v *SetFocusEmulationEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetFocusEmulationEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetFocusEmulationEnabledParams are the parameters for Emulation.setFocusEmulationEnabled
+// optional parameters are left out when nil
+type SetFocusEmulationEnabledParams struct {
+	/* Whether to enable to disable focus emulation. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetFocusEmulationEnabledParams as JSON
+func (v *SetFocusEmulationEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetFocusEmulationEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables or disables simulating a focused and active page.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetFocusEmulationEnabled(params SetFocusEmulationEnabledParams) (SetFocusEmulationEnabledReturns, error) {
+	return c.SetFocusEmulationEnabledContext(context.Background(), params)
+}
+
+// SetFocusEmulationEnabledContext is SetFocusEmulationEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetFocusEmulationEnabledContext(ctx context.Context, params SetFocusEmulationEnabledParams) (SetFocusEmulationEnabledReturns, error) {
+	var returns_ SetFocusEmulationEnabledReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setFocusEmulationEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAutoDarkModeOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetAutoDarkModeOverrideReturns from JSON
+func (v *SetAutoDarkModeOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAutoDarkModeOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAutoDarkModeOverrideParams are the parameters for Emulation.setAutoDarkModeOverride
+// optional parameters are left out when nil
+type SetAutoDarkModeOverrideParams struct {
+	/* Whether to enable or disable automatic dark mode.
+	If not specified, any existing override will be cleared. */
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// MarshalCDP writes SetAutoDarkModeOverrideParams as JSON
+func (v *SetAutoDarkModeOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Enabled != nil {
+		e.Key("enabled")
+		e.Bool(*v.Enabled)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAutoDarkModeOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Automatically render all web contents using a dark theme.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAutoDarkModeOverride(params SetAutoDarkModeOverrideParams) (SetAutoDarkModeOverrideReturns, error) {
+	return c.SetAutoDarkModeOverrideContext(context.Background(), params)
+}
+
+// SetAutoDarkModeOverrideContext is SetAutoDarkModeOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAutoDarkModeOverrideContext(ctx context.Context, params SetAutoDarkModeOverrideParams) (SetAutoDarkModeOverrideReturns, error) {
+	var returns_ SetAutoDarkModeOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setAutoDarkModeOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSafeAreaInsetsOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetSafeAreaInsetsOverrideReturns from JSON
+func (v *SetSafeAreaInsetsOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSafeAreaInsetsOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSafeAreaInsetsOverrideParams are the parameters for Emulation.setSafeAreaInsetsOverride
+// optional parameters are left out when nil
+type SetSafeAreaInsetsOverrideParams struct {
+	Insets SafeAreaInsets `json:"insets"`
+}
+
+// MarshalCDP writes SetSafeAreaInsetsOverrideParams as JSON
+func (v *SetSafeAreaInsetsOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("insets")
+	v.Insets.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSafeAreaInsetsOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the values for env(safe-area-inset-*) and env(safe-area-max-inset-*). Unset values will cause the
+// respective variables to be undefined, even if previously overridden.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSafeAreaInsetsOverride(params SetSafeAreaInsetsOverrideParams) (SetSafeAreaInsetsOverrideReturns, error) {
+	return c.SetSafeAreaInsetsOverrideContext(context.Background(), params)
+}
+
+// SetSafeAreaInsetsOverrideContext is SetSafeAreaInsetsOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSafeAreaInsetsOverrideContext(ctx context.Context, params SetSafeAreaInsetsOverrideParams) (SetSafeAreaInsetsOverrideReturns, error) {
+	var returns_ SetSafeAreaInsetsOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setSafeAreaInsetsOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDevicePostureOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDevicePostureOverrideReturns from JSON
+func (v *SetDevicePostureOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDevicePostureOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDevicePostureOverrideParams are the parameters for Emulation.setDevicePostureOverride
+// optional parameters are left out when nil
+type SetDevicePostureOverrideParams struct {
+	Posture DevicePosture `json:"posture"`
+}
+
+// MarshalCDP writes SetDevicePostureOverrideParams as JSON
+func (v *SetDevicePostureOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("posture")
+	v.Posture.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDevicePostureOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Start reporting the given posture value to the Device Posture API.
+// This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDevicePostureOverride(params SetDevicePostureOverrideParams) (SetDevicePostureOverrideReturns, error) {
+	return c.SetDevicePostureOverrideContext(context.Background(), params)
+}
+
+// SetDevicePostureOverrideContext is SetDevicePostureOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDevicePostureOverrideContext(ctx context.Context, params SetDevicePostureOverrideParams) (SetDevicePostureOverrideReturns, error) {
+	var returns_ SetDevicePostureOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDevicePostureOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDevicePostureOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearDevicePostureOverrideReturns from JSON
+func (v *ClearDevicePostureOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDevicePostureOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears a device posture override set with either setDeviceMetricsOverride()
+// or setDevicePostureOverride() and starts using posture information from the
+// platform again.
+// Does nothing if no override is set.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearDevicePostureOverride() (ClearDevicePostureOverrideReturns, error) {
+	return c.ClearDevicePostureOverrideContext(context.Background())
+}
+
+// ClearDevicePostureOverrideContext is ClearDevicePostureOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearDevicePostureOverrideContext(ctx context.Context) (ClearDevicePostureOverrideReturns, error) {
+	var returns_ ClearDevicePostureOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.clearDevicePostureOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetDisplayFeaturesOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDisplayFeaturesOverrideReturns from JSON
+func (v *SetDisplayFeaturesOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDisplayFeaturesOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDisplayFeaturesOverrideParams are the parameters for Emulation.setDisplayFeaturesOverride
+// optional parameters are left out when nil
+type SetDisplayFeaturesOverrideParams struct {
+	Features []DisplayFeature `json:"features"`
+}
+
+// MarshalCDP writes SetDisplayFeaturesOverrideParams as JSON
+func (v *SetDisplayFeaturesOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("features")
+	if v.Features == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Features {
+			e.Elem()
+			v.Features[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDisplayFeaturesOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Start using the given display features to pupulate the Viewport Segments API.
+// This override can also be set in setDeviceMetricsOverride().
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDisplayFeaturesOverride(params SetDisplayFeaturesOverrideParams) (SetDisplayFeaturesOverrideReturns, error) {
+	return c.SetDisplayFeaturesOverrideContext(context.Background(), params)
+}
+
+// SetDisplayFeaturesOverrideContext is SetDisplayFeaturesOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDisplayFeaturesOverrideContext(ctx context.Context, params SetDisplayFeaturesOverrideParams) (SetDisplayFeaturesOverrideReturns, error) {
+	var returns_ SetDisplayFeaturesOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDisplayFeaturesOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDisplayFeaturesOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearDisplayFeaturesOverrideReturns from JSON
+func (v *ClearDisplayFeaturesOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDisplayFeaturesOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the display features override set with either setDeviceMetricsOverride()
+// or setDisplayFeaturesOverride() and starts using display features from the
+// platform again.
+// Does nothing if no override is set.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearDisplayFeaturesOverride() (ClearDisplayFeaturesOverrideReturns, error) {
+	return c.ClearDisplayFeaturesOverrideContext(context.Background())
+}
+
+// ClearDisplayFeaturesOverrideContext is ClearDisplayFeaturesOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearDisplayFeaturesOverrideContext(ctx context.Context) (ClearDisplayFeaturesOverrideReturns, error) {
+	var returns_ ClearDisplayFeaturesOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.clearDisplayFeaturesOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetScrollbarsHiddenReturns struct {
+}
+
+// UnmarshalCDP reads SetScrollbarsHiddenReturns from JSON
+func (v *SetScrollbarsHiddenReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetScrollbarsHiddenReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetScrollbarsHiddenParams are the parameters for Emulation.setScrollbarsHidden
+// optional parameters are left out when nil
+type SetScrollbarsHiddenParams struct {
+	/* Whether scrollbars should be always hidden. */
+	Hidden bool `json:"hidden"`
+}
+
+// MarshalCDP writes SetScrollbarsHiddenParams as JSON
+func (v *SetScrollbarsHiddenParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("hidden")
+	e.Bool(v.Hidden)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetScrollbarsHiddenParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetScrollbarsHidden(params SetScrollbarsHiddenParams) (SetScrollbarsHiddenReturns, error) {
+	return c.SetScrollbarsHiddenContext(context.Background(), params)
+}
+
+// SetScrollbarsHiddenContext is SetScrollbarsHidden with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetScrollbarsHiddenContext(ctx context.Context, params SetScrollbarsHiddenParams) (SetScrollbarsHiddenReturns, error) {
+	var returns_ SetScrollbarsHiddenReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setScrollbarsHidden", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDocumentCookieDisabledReturns struct {
+}
+
+// UnmarshalCDP reads SetDocumentCookieDisabledReturns from JSON
+func (v *SetDocumentCookieDisabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDocumentCookieDisabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDocumentCookieDisabledParams are the parameters for Emulation.setDocumentCookieDisabled
+// optional parameters are left out when nil
+type SetDocumentCookieDisabledParams struct {
+	/* Whether document.coookie API should be disabled. */
+	Disabled bool `json:"disabled"`
+}
+
+// MarshalCDP writes SetDocumentCookieDisabledParams as JSON
+func (v *SetDocumentCookieDisabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("disabled")
+	e.Bool(v.Disabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDocumentCookieDisabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDocumentCookieDisabled(params SetDocumentCookieDisabledParams) (SetDocumentCookieDisabledReturns, error) {
+	return c.SetDocumentCookieDisabledContext(context.Background(), params)
+}
+
+// SetDocumentCookieDisabledContext is SetDocumentCookieDisabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDocumentCookieDisabledContext(ctx context.Context, params SetDocumentCookieDisabledParams) (SetDocumentCookieDisabledReturns, error) {
+	var returns_ SetDocumentCookieDisabledReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDocumentCookieDisabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetEmitTouchEventsForMouseReturns struct {
+}
+
+// UnmarshalCDP reads SetEmitTouchEventsForMouseReturns from JSON
+func (v *SetEmitTouchEventsForMouseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetEmitTouchEventsForMouseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetEmitTouchEventsForMouseParams are the parameters for Emulation.setEmitTouchEventsForMouse
+// optional parameters are left out when nil
+type SetEmitTouchEventsForMouseParams struct {
+	/* Whether touch emulation based on mouse input should be enabled. */
+	Enabled bool `json:"enabled"`
+	/* Touch/gesture events configuration. Default: current platform. */
+	Configuration *SetEmitTouchEventsForMouseConfiguration `json:"configuration,omitempty"`
+}
+
+// MarshalCDP writes SetEmitTouchEventsForMouseParams as JSON
+func (v *SetEmitTouchEventsForMouseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	if v.Configuration != nil {
+		e.Key("configuration")
+		e.String(string(*v.Configuration))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetEmitTouchEventsForMouseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetEmitTouchEventsForMouse(params SetEmitTouchEventsForMouseParams) (SetEmitTouchEventsForMouseReturns, error) {
+	return c.SetEmitTouchEventsForMouseContext(context.Background(), params)
+}
+
+// SetEmitTouchEventsForMouseContext is SetEmitTouchEventsForMouse with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetEmitTouchEventsForMouseContext(ctx context.Context, params SetEmitTouchEventsForMouseParams) (SetEmitTouchEventsForMouseReturns, error) {
+	var returns_ SetEmitTouchEventsForMouseReturns
+
+	if params.Configuration != nil && !params.Configuration.Valid() {
+		return returns_, fmt.Errorf("Emulation.setEmitTouchEventsForMouse: invalid configuration %q", *params.Configuration)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setEmitTouchEventsForMouse", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetOverriddenSensorInformationReturns struct {
+	RequestedSamplingFrequency float64 `json:"requestedSamplingFrequency"`
+}
+
+// UnmarshalCDP reads GetOverriddenSensorInformationReturns from JSON
+func (v *GetOverriddenSensorInformationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestedSamplingFrequency":
+			v.RequestedSamplingFrequency = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetOverriddenSensorInformationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetOverriddenSensorInformationParams are the parameters for Emulation.getOverriddenSensorInformation
+// optional parameters are left out when nil
+type GetOverriddenSensorInformationParams struct {
+	Type SensorType `json:"type"`
+}
+
+// MarshalCDP writes GetOverriddenSensorInformationParams as JSON
+func (v *GetOverriddenSensorInformationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetOverriddenSensorInformationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetOverriddenSensorInformation(params GetOverriddenSensorInformationParams) (GetOverriddenSensorInformationReturns, error) {
+	return c.GetOverriddenSensorInformationContext(context.Background(), params)
+}
+
+// GetOverriddenSensorInformationContext is GetOverriddenSensorInformation with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetOverriddenSensorInformationContext(ctx context.Context, params GetOverriddenSensorInformationParams) (GetOverriddenSensorInformationReturns, error) {
+	var returns_ GetOverriddenSensorInformationReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Emulation.getOverriddenSensorInformation: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.getOverriddenSensorInformation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSensorOverrideEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetSensorOverrideEnabledReturns from JSON
+func (v *SetSensorOverrideEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSensorOverrideEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSensorOverrideEnabledParams are the parameters for Emulation.setSensorOverrideEnabled
+// optional parameters are left out when nil
+type SetSensorOverrideEnabledParams struct {
+	Enabled  bool            `json:"enabled"`
+	Type     SensorType      `json:"type"`
+	Metadata *SensorMetadata `json:"metadata,omitempty"`
+}
+
+// MarshalCDP writes SetSensorOverrideEnabledParams as JSON
+func (v *SetSensorOverrideEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Metadata != nil {
+		e.Key("metadata")
+		(*v.Metadata).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSensorOverrideEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides a platform sensor of a given type. If |enabled| is true, calls to
+// Sensor.start() will use a virtual sensor as backend rather than fetching
+// data from a real hardware sensor. Otherwise, existing virtual
+// sensor-backend Sensor objects will fire an error event and new calls to
+// Sensor.start() will attempt to use a real sensor instead.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSensorOverrideEnabled(params SetSensorOverrideEnabledParams) (SetSensorOverrideEnabledReturns, error) {
+	return c.SetSensorOverrideEnabledContext(context.Background(), params)
+}
+
+// SetSensorOverrideEnabledContext is SetSensorOverrideEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSensorOverrideEnabledContext(ctx context.Context, params SetSensorOverrideEnabledParams) (SetSensorOverrideEnabledReturns, error) {
+	var returns_ SetSensorOverrideEnabledReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Emulation.setSensorOverrideEnabled: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setSensorOverrideEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSensorOverrideReadingsReturns struct {
+}
+
+// UnmarshalCDP reads SetSensorOverrideReadingsReturns from JSON
+func (v *SetSensorOverrideReadingsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSensorOverrideReadingsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSensorOverrideReadingsParams are the parameters for Emulation.setSensorOverrideReadings
+// optional parameters are left out when nil
+type SetSensorOverrideReadingsParams struct {
+	Type    SensorType    `json:"type"`
+	Reading SensorReading `json:"reading"`
+}
+
+// MarshalCDP writes SetSensorOverrideReadingsParams as JSON
+func (v *SetSensorOverrideReadingsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("reading")
+	v.Reading.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSensorOverrideReadingsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Updates the sensor readings reported by a sensor type previously overridden
+// by setSensorOverrideEnabled.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSensorOverrideReadings(params SetSensorOverrideReadingsParams) (SetSensorOverrideReadingsReturns, error) {
+	return c.SetSensorOverrideReadingsContext(context.Background(), params)
+}
+
+// SetSensorOverrideReadingsContext is SetSensorOverrideReadings with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSensorOverrideReadingsContext(ctx context.Context, params SetSensorOverrideReadingsParams) (SetSensorOverrideReadingsReturns, error) {
+	var returns_ SetSensorOverrideReadingsReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Emulation.setSensorOverrideReadings: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setSensorOverrideReadings", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPressureSourceOverrideEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetPressureSourceOverrideEnabledReturns from JSON
+func (v *SetPressureSourceOverrideEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPressureSourceOverrideEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPressureSourceOverrideEnabledParams are the parameters for Emulation.setPressureSourceOverrideEnabled
+// optional parameters are left out when nil
+type SetPressureSourceOverrideEnabledParams struct {
+	Enabled  bool              `json:"enabled"`
+	Source   PressureSource    `json:"source"`
+	Metadata *PressureMetadata `json:"metadata,omitempty"`
+}
+
+// MarshalCDP writes SetPressureSourceOverrideEnabledParams as JSON
+func (v *SetPressureSourceOverrideEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.Key("source")
+	e.String(string(v.Source))
+	if v.Metadata != nil {
+		e.Key("metadata")
+		(*v.Metadata).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPressureSourceOverrideEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides a pressure source of a given type, as used by the Compute
+// Pressure API, so that updates to PressureObserver.observe() are provided
+// via setPressureStateOverride instead of being retrieved from
+// platform-provided telemetry data.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPressureSourceOverrideEnabled(params SetPressureSourceOverrideEnabledParams) (SetPressureSourceOverrideEnabledReturns, error) {
+	return c.SetPressureSourceOverrideEnabledContext(context.Background(), params)
+}
+
+// SetPressureSourceOverrideEnabledContext is SetPressureSourceOverrideEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPressureSourceOverrideEnabledContext(ctx context.Context, params SetPressureSourceOverrideEnabledParams) (SetPressureSourceOverrideEnabledReturns, error) {
+	var returns_ SetPressureSourceOverrideEnabledReturns
+
+	if !params.Source.Valid() {
+		return returns_, fmt.Errorf("Emulation.setPressureSourceOverrideEnabled: invalid source %q", params.Source)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setPressureSourceOverrideEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPressureStateOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetPressureStateOverrideReturns from JSON
+func (v *SetPressureStateOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPressureStateOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPressureStateOverrideParams are the parameters for Emulation.setPressureStateOverride
+// optional parameters are left out when nil
+type SetPressureStateOverrideParams struct {
+	Source PressureSource `json:"source"`
+	State  PressureState  `json:"state"`
+}
+
+// MarshalCDP writes SetPressureStateOverrideParams as JSON
+func (v *SetPressureStateOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("source")
+	e.String(string(v.Source))
+	e.Key("state")
+	e.String(string(v.State))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPressureStateOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// TODO: OBSOLETE: To remove when setPressureDataOverride is merged.
+// Provides a given pressure state that will be processed and eventually be
+// delivered to PressureObserver users. |source| must have been previously
+// overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPressureStateOverride(params SetPressureStateOverrideParams) (SetPressureStateOverrideReturns, error) {
+	return c.SetPressureStateOverrideContext(context.Background(), params)
+}
+
+// SetPressureStateOverrideContext is SetPressureStateOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPressureStateOverrideContext(ctx context.Context, params SetPressureStateOverrideParams) (SetPressureStateOverrideReturns, error) {
+	var returns_ SetPressureStateOverrideReturns
+
+	if !params.Source.Valid() {
+		return returns_, fmt.Errorf("Emulation.setPressureStateOverride: invalid source %q", params.Source)
+	}
+
+	if !params.State.Valid() {
+		return returns_, fmt.Errorf("Emulation.setPressureStateOverride: invalid state %q", params.State)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setPressureStateOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPressureDataOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetPressureDataOverrideReturns from JSON
+func (v *SetPressureDataOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPressureDataOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPressureDataOverrideParams are the parameters for Emulation.setPressureDataOverride
+// optional parameters are left out when nil
+type SetPressureDataOverrideParams struct {
+	Source                  PressureSource `json:"source"`
+	State                   PressureState  `json:"state"`
+	OwnContributionEstimate *float64       `json:"ownContributionEstimate,omitempty"`
+}
+
+// MarshalCDP writes SetPressureDataOverrideParams as JSON
+func (v *SetPressureDataOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("source")
+	e.String(string(v.Source))
+	e.Key("state")
+	e.String(string(v.State))
+	if v.OwnContributionEstimate != nil {
+		e.Key("ownContributionEstimate")
+		e.Float(*v.OwnContributionEstimate)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPressureDataOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Provides a given pressure data set that will be processed and eventually be
+// delivered to PressureObserver users. |source| must have been previously
+// overridden by setPressureSourceOverrideEnabled.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPressureDataOverride(params SetPressureDataOverrideParams) (SetPressureDataOverrideReturns, error) {
+	return c.SetPressureDataOverrideContext(context.Background(), params)
+}
+
+// SetPressureDataOverrideContext is SetPressureDataOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPressureDataOverrideContext(ctx context.Context, params SetPressureDataOverrideParams) (SetPressureDataOverrideReturns, error) {
+	var returns_ SetPressureDataOverrideReturns
+
+	if !params.Source.Valid() {
+		return returns_, fmt.Errorf("Emulation.setPressureDataOverride: invalid source %q", params.Source)
+	}
+
+	if !params.State.Valid() {
+		return returns_, fmt.Errorf("Emulation.setPressureDataOverride: invalid state %q", params.State)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setPressureDataOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetNavigatorOverridesReturns struct {
+}
+
+// UnmarshalCDP reads SetNavigatorOverridesReturns from JSON
+func (v *SetNavigatorOverridesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetNavigatorOverridesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetNavigatorOverridesParams are the parameters for Emulation.setNavigatorOverrides
+// optional parameters are left out when nil
+type SetNavigatorOverridesParams struct {
+	/* The platform navigator.platform should return. */
+	Platform string `json:"platform"`
+}
+
+// MarshalCDP writes SetNavigatorOverridesParams as JSON
+func (v *SetNavigatorOverridesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("platform")
+	e.String(v.Platform)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetNavigatorOverridesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides value returned by the javascript navigator object.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetNavigatorOverrides(params SetNavigatorOverridesParams) (SetNavigatorOverridesReturns, error) {
+	return c.SetNavigatorOverridesContext(context.Background(), params)
+}
+
+// SetNavigatorOverridesContext is SetNavigatorOverrides with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetNavigatorOverridesContext(ctx context.Context, params SetNavigatorOverridesParams) (SetNavigatorOverridesReturns, error) {
+	var returns_ SetNavigatorOverridesReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setNavigatorOverrides", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPageScaleFactorReturns struct {
+}
+
+// UnmarshalCDP reads SetPageScaleFactorReturns from JSON
+func (v *SetPageScaleFactorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPageScaleFactorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPageScaleFactorParams are the parameters for Emulation.setPageScaleFactor
+// optional parameters are left out when nil
+type SetPageScaleFactorParams struct {
+	/* Page scale factor. */
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+}
+
+// MarshalCDP writes SetPageScaleFactorParams as JSON
+func (v *SetPageScaleFactorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("pageScaleFactor")
+	e.Float(v.PageScaleFactor)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPageScaleFactorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets a specified page scale factor.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPageScaleFactor(params SetPageScaleFactorParams) (SetPageScaleFactorReturns, error) {
+	return c.SetPageScaleFactorContext(context.Background(), params)
+}
+
+// SetPageScaleFactorContext is SetPageScaleFactor with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPageScaleFactorContext(ctx context.Context, params SetPageScaleFactorParams) (SetPageScaleFactorReturns, error) {
+	var returns_ SetPageScaleFactorReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setPageScaleFactor", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetVirtualTimePolicyReturns struct {
+
+	/* Absolute timestamp at which virtual time was first enabled (up time in milliseconds). */
+	VirtualTimeTicksBase float64 `json:"virtualTimeTicksBase"`
+}
+
+// UnmarshalCDP reads SetVirtualTimePolicyReturns from JSON
+func (v *SetVirtualTimePolicyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "virtualTimeTicksBase":
+			v.VirtualTimeTicksBase = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetVirtualTimePolicyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetVirtualTimePolicyParams are the parameters for Emulation.setVirtualTimePolicy
+// optional parameters are left out when nil
+type SetVirtualTimePolicyParams struct {
+	Policy VirtualTimePolicy `json:"policy"`
+	/* If set, after this many virtual milliseconds have elapsed virtual time will be paused and a
+	virtualTimeBudgetExpired event is sent. */
+	Budget *float64 `json:"budget,omitempty"`
+	/* If set this specifies the maximum number of tasks that can be run before virtual is forced
+	forwards to prevent deadlock. */
+	MaxVirtualTimeTaskStarvationCount *int `json:"maxVirtualTimeTaskStarvationCount,omitempty"`
+	/* If set, base::Time::Now will be overridden to initially return this value. */
+	InitialVirtualTime *cdp.NetworkTimeSinceEpoch `json:"initialVirtualTime,omitempty"`
+}
+
+// MarshalCDP writes SetVirtualTimePolicyParams as JSON
+func (v *SetVirtualTimePolicyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("policy")
+	e.String(string(v.Policy))
+	if v.Budget != nil {
+		e.Key("budget")
+		e.Float(*v.Budget)
+	}
+	if v.MaxVirtualTimeTaskStarvationCount != nil {
+		e.Key("maxVirtualTimeTaskStarvationCount")
+		e.Int(*v.MaxVirtualTimeTaskStarvationCount)
+	}
+	if v.InitialVirtualTime != nil {
+		e.Key("initialVirtualTime")
+		e.Float(float64(*v.InitialVirtualTime))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetVirtualTimePolicyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets
+// the current virtual time policy.  Note this supersedes any previous time budget.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetVirtualTimePolicy(params SetVirtualTimePolicyParams) (SetVirtualTimePolicyReturns, error) {
+	return c.SetVirtualTimePolicyContext(context.Background(), params)
+}
+
+// SetVirtualTimePolicyContext is SetVirtualTimePolicy with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetVirtualTimePolicyContext(ctx context.Context, params SetVirtualTimePolicyParams) (SetVirtualTimePolicyReturns, error) {
+	var returns_ SetVirtualTimePolicyReturns
+
+	if !params.Policy.Valid() {
+		return returns_, fmt.Errorf("Emulation.setVirtualTimePolicy: invalid policy %q", params.Policy)
+	}
+
+	err_ := c.caller.Call(ctx, "Emulation.setVirtualTimePolicy", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetLocaleOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetLocaleOverrideReturns from JSON
+func (v *SetLocaleOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetLocaleOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetLocaleOverrideParams are the parameters for Emulation.setLocaleOverride
+// optional parameters are left out when nil
+type SetLocaleOverrideParams struct {
+	/* ICU style C locale (e.g. "en_US"). If not specified or empty, disables the override and
+	restores default host system locale. */
+	Locale *string `json:"locale,omitempty"`
+}
+
+// MarshalCDP writes SetLocaleOverrideParams as JSON
+func (v *SetLocaleOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Locale != nil {
+		e.Key("locale")
+		e.String(*v.Locale)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetLocaleOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides default host system locale with the specified one.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetLocaleOverride(params SetLocaleOverrideParams) (SetLocaleOverrideReturns, error) {
+	return c.SetLocaleOverrideContext(context.Background(), params)
+}
+
+// SetLocaleOverrideContext is SetLocaleOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetLocaleOverrideContext(ctx context.Context, params SetLocaleOverrideParams) (SetLocaleOverrideReturns, error) {
+	var returns_ SetLocaleOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setLocaleOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetVisibleSizeReturns struct {
+}
+
+// UnmarshalCDP reads SetVisibleSizeReturns from JSON
+func (v *SetVisibleSizeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetVisibleSizeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetVisibleSizeParams are the parameters for Emulation.setVisibleSize
+// optional parameters are left out when nil
+type SetVisibleSizeParams struct {
+	/* Frame width (DIP). */
+	Width int `json:"width"`
+	/* Frame height (DIP). */
+	Height int `json:"height"`
+}
+
+// MarshalCDP writes SetVisibleSizeParams as JSON
+func (v *SetVisibleSizeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("width")
+	e.Int(v.Width)
+	e.Key("height")
+	e.Int(v.Height)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetVisibleSizeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Resizes the frame/viewport of the page. Note that this does not affect the frame's container
+// (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported
+// on Android.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetVisibleSize(params SetVisibleSizeParams) (SetVisibleSizeReturns, error) {
+	return c.SetVisibleSizeContext(context.Background(), params)
+}
+
+// SetVisibleSizeContext is SetVisibleSize with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetVisibleSizeContext(ctx context.Context, params SetVisibleSizeParams) (SetVisibleSizeReturns, error) {
+	var returns_ SetVisibleSizeReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setVisibleSize", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDisabledImageTypesReturns struct {
+}
+
+// UnmarshalCDP reads SetDisabledImageTypesReturns from JSON
+func (v *SetDisabledImageTypesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDisabledImageTypesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDisabledImageTypesParams are the parameters for Emulation.setDisabledImageTypes
+// optional parameters are left out when nil
+type SetDisabledImageTypesParams struct {
+	/* Image types to disable. */
+	ImageTypes []DisabledImageType `json:"imageTypes"`
+}
+
+// MarshalCDP writes SetDisabledImageTypesParams as JSON
+func (v *SetDisabledImageTypesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("imageTypes")
+	if v.ImageTypes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ImageTypes {
+			e.Elem()
+			e.String(string(v.ImageTypes[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDisabledImageTypesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDisabledImageTypes(params SetDisabledImageTypesParams) (SetDisabledImageTypesReturns, error) {
+	return c.SetDisabledImageTypesContext(context.Background(), params)
+}
+
+// SetDisabledImageTypesContext is SetDisabledImageTypes with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDisabledImageTypesContext(ctx context.Context, params SetDisabledImageTypesParams) (SetDisabledImageTypesReturns, error) {
+	var returns_ SetDisabledImageTypesReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDisabledImageTypes", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDataSaverOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDataSaverOverrideReturns from JSON
+func (v *SetDataSaverOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDataSaverOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDataSaverOverrideParams are the parameters for Emulation.setDataSaverOverride
+// optional parameters are left out when nil
+type SetDataSaverOverrideParams struct {
+	/* Override value. Omitting the parameter disables the override. */
+	DataSaverEnabled *bool `json:"dataSaverEnabled,omitempty"`
+}
+
+// MarshalCDP writes SetDataSaverOverrideParams as JSON
+func (v *SetDataSaverOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.DataSaverEnabled != nil {
+		e.Key("dataSaverEnabled")
+		e.Bool(*v.DataSaverEnabled)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDataSaverOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Override the value of navigator.connection.saveData
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDataSaverOverride(params SetDataSaverOverrideParams) (SetDataSaverOverrideReturns, error) {
+	return c.SetDataSaverOverrideContext(context.Background(), params)
+}
+
+// SetDataSaverOverrideContext is SetDataSaverOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetDataSaverOverrideContext(ctx context.Context, params SetDataSaverOverrideParams) (SetDataSaverOverrideReturns, error) {
+	var returns_ SetDataSaverOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setDataSaverOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetHardwareConcurrencyOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetHardwareConcurrencyOverrideReturns from JSON
+func (v *SetHardwareConcurrencyOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetHardwareConcurrencyOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetHardwareConcurrencyOverrideParams are the parameters for Emulation.setHardwareConcurrencyOverride
+// optional parameters are left out when nil
+type SetHardwareConcurrencyOverrideParams struct {
+	/* Hardware concurrency to report */
+	HardwareConcurrency int `json:"hardwareConcurrency"`
+}
+
+// MarshalCDP writes SetHardwareConcurrencyOverrideParams as JSON
+func (v *SetHardwareConcurrencyOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("hardwareConcurrency")
+	e.Int(v.HardwareConcurrency)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetHardwareConcurrencyOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetHardwareConcurrencyOverride(params SetHardwareConcurrencyOverrideParams) (SetHardwareConcurrencyOverrideReturns, error) {
+	return c.SetHardwareConcurrencyOverrideContext(context.Background(), params)
+}
+
+// SetHardwareConcurrencyOverrideContext is SetHardwareConcurrencyOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetHardwareConcurrencyOverrideContext(ctx context.Context, params SetHardwareConcurrencyOverrideParams) (SetHardwareConcurrencyOverrideReturns, error) {
+	var returns_ SetHardwareConcurrencyOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setHardwareConcurrencyOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAutomationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetAutomationOverrideReturns from JSON
+func (v *SetAutomationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAutomationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAutomationOverrideParams are the parameters for Emulation.setAutomationOverride
+// optional parameters are left out when nil
+type SetAutomationOverrideParams struct {
+	/* Whether the override should be enabled. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetAutomationOverrideParams as JSON
+func (v *SetAutomationOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAutomationOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Allows overriding the automation flag.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAutomationOverride(params SetAutomationOverrideParams) (SetAutomationOverrideReturns, error) {
+	return c.SetAutomationOverrideContext(context.Background(), params)
+}
+
+// SetAutomationOverrideContext is SetAutomationOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAutomationOverrideContext(ctx context.Context, params SetAutomationOverrideParams) (SetAutomationOverrideReturns, error) {
+	var returns_ SetAutomationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setAutomationOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSmallViewportHeightDifferenceOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetSmallViewportHeightDifferenceOverrideReturns from JSON
+func (v *SetSmallViewportHeightDifferenceOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSmallViewportHeightDifferenceOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSmallViewportHeightDifferenceOverrideParams are the parameters for Emulation.setSmallViewportHeightDifferenceOverride
+// optional parameters are left out when nil
+type SetSmallViewportHeightDifferenceOverrideParams struct {
+	/* This will cause an element of size 100svh to be `difference` pixels smaller than an element
+	of size 100lvh. */
+	Difference int `json:"difference"`
+}
+
+// MarshalCDP writes SetSmallViewportHeightDifferenceOverrideParams as JSON
+func (v *SetSmallViewportHeightDifferenceOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("difference")
+	e.Int(v.Difference)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSmallViewportHeightDifferenceOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Allows overriding the difference between the small and large viewport sizes, which determine the
+// value of the `svh` and `lvh` unit, respectively. Only supported for top-level frames.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSmallViewportHeightDifferenceOverride(params SetSmallViewportHeightDifferenceOverrideParams) (SetSmallViewportHeightDifferenceOverrideReturns, error) {
+	return c.SetSmallViewportHeightDifferenceOverrideContext(context.Background(), params)
+}
+
+// SetSmallViewportHeightDifferenceOverrideContext is SetSmallViewportHeightDifferenceOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSmallViewportHeightDifferenceOverrideContext(ctx context.Context, params SetSmallViewportHeightDifferenceOverrideParams) (SetSmallViewportHeightDifferenceOverrideReturns, error) {
+	var returns_ SetSmallViewportHeightDifferenceOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Emulation.setSmallViewportHeightDifferenceOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Notification sent after the virtual time budget for the current VirtualTimePolicy has run out.
+//
+// Experimental: this may change or be removed in any chrome release
+type VirtualTimeBudgetExpiredEvent struct {
+}
+
+// UnmarshalCDP reads VirtualTimeBudgetExpiredEvent from JSON
+func (v *VirtualTimeBudgetExpiredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VirtualTimeBudgetExpiredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type VirtualTimeBudgetExpiredHandler func(ev VirtualTimeBudgetExpiredEvent)
+
+// EventMethod is Emulation.virtualTimeBudgetExpired
+func (VirtualTimeBudgetExpiredEvent) EventMethod() string {
+	return "Emulation.virtualTimeBudgetExpired"
+}
+
+// OnVirtualTimeBudgetExpired calls handler for each Emulation.virtualTimeBudgetExpired event
+func (c Client) OnVirtualTimeBudgetExpired(handler VirtualTimeBudgetExpiredHandler) (unsubscribe func()) {
+	return c.caller.On("Emulation.virtualTimeBudgetExpired", func(ev interface{}) {
+		handler(ev.(VirtualTimeBudgetExpiredEvent))
+	})
+}
+
+func init() {
+	decoders["Emulation.virtualTimeBudgetExpired"] = cdp.Decode[VirtualTimeBudgetExpiredEvent]
+}
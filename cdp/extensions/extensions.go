@@ -0,0 +1,465 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package extensions is the Extensions domain of the chrome devtools protocol
+//
+// Defines commands and events for browser extensions.
+//
+// Experimental: this may change or be removed in any chrome release
+package extensions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Extensions commands and listens for Extensions events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Storage areas.
+type StorageArea string
+
+// StorageArea values
+const (
+	StorageAreaSession StorageArea = "session"
+	StorageAreaLocal   StorageArea = "local"
+	StorageAreaSync    StorageArea = "sync"
+	StorageAreaManaged StorageArea = "managed"
+)
+
+// Values gives every StorageArea
+func (StorageArea) Values() []StorageArea {
+	return []StorageArea{
+		StorageAreaSession,
+		StorageAreaLocal,
+		StorageAreaSync,
+		StorageAreaManaged,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StorageArea) Valid() bool {
+	switch v {
+	case StorageAreaSession, StorageAreaLocal, StorageAreaSync, StorageAreaManaged:
+		return true
+	}
+	return false
+}
+
+type LoadUnpackedReturns struct {
+
+	/* Extension id. */
+	Id string `json:"id"`
+}
+
+// UnmarshalCDP reads LoadUnpackedReturns from JSON
+func (v *LoadUnpackedReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadUnpackedReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// LoadUnpackedParams are the parameters for Extensions.loadUnpacked
+// optional parameters are left out when nil
+type LoadUnpackedParams struct {
+	/* Absolute file path. */
+	Path string `json:"path"`
+}
+
+// MarshalCDP writes LoadUnpackedParams as JSON
+func (v *LoadUnpackedParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("path")
+	e.String(v.Path)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LoadUnpackedParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Installs an unpacked extension from the filesystem similar to
+// --load-extension CLI flags. Returns extension ID once the extension
+// has been installed. Available if the client is connected using the
+// --remote-debugging-pipe flag and the --enable-unsafe-extension-debugging
+// flag is set.
+func (c Client) LoadUnpacked(params LoadUnpackedParams) (LoadUnpackedReturns, error) {
+	return c.LoadUnpackedContext(context.Background(), params)
+}
+
+// LoadUnpackedContext is LoadUnpacked with a context for cancellation and deadlines
+func (c Client) LoadUnpackedContext(ctx context.Context, params LoadUnpackedParams) (LoadUnpackedReturns, error) {
+	var returns_ LoadUnpackedReturns
+
+	err_ := c.caller.Call(ctx, "Extensions.loadUnpacked", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UninstallReturns struct {
+}
+
+// UnmarshalCDP reads UninstallReturns from JSON
+func (v *UninstallReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UninstallReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UninstallParams are the parameters for Extensions.uninstall
+// optional parameters are left out when nil
+type UninstallParams struct {
+	/* Extension id. */
+	Id string `json:"id"`
+}
+
+// MarshalCDP writes UninstallParams as JSON
+func (v *UninstallParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UninstallParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Uninstalls an unpacked extension (others not supported) from the profile.
+// Available if the client is connected using the --remote-debugging-pipe flag
+// and the --enable-unsafe-extension-debugging.
+func (c Client) Uninstall(params UninstallParams) (UninstallReturns, error) {
+	return c.UninstallContext(context.Background(), params)
+}
+
+// UninstallContext is Uninstall with a context for cancellation and deadlines
+func (c Client) UninstallContext(ctx context.Context, params UninstallParams) (UninstallReturns, error) {
+	var returns_ UninstallReturns
+
+	err_ := c.caller.Call(ctx, "Extensions.uninstall", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetStorageItemsReturns struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// UnmarshalCDP reads GetStorageItemsReturns from JSON
+func (v *GetStorageItemsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetStorageItemsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetStorageItemsParams are the parameters for Extensions.getStorageItems
+// optional parameters are left out when nil
+type GetStorageItemsParams struct {
+	/* ID of extension. */
+	Id string `json:"id"`
+	/* StorageArea to retrieve data from. */
+	StorageArea StorageArea `json:"storageArea"`
+	/* Keys to retrieve. */
+	Keys []string `json:"keys"`
+}
+
+// MarshalCDP writes GetStorageItemsParams as JSON
+func (v *GetStorageItemsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("storageArea")
+	e.String(string(v.StorageArea))
+	if v.Keys != nil {
+		e.Key("keys")
+		e.ArrayStart()
+		for i0 := range v.Keys {
+			e.Elem()
+			e.String(v.Keys[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetStorageItemsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets data from extension storage in the given `storageArea`. If `keys` is
+// specified, these are used to filter the result.
+func (c Client) GetStorageItems(params GetStorageItemsParams) (GetStorageItemsReturns, error) {
+	return c.GetStorageItemsContext(context.Background(), params)
+}
+
+// GetStorageItemsContext is GetStorageItems with a context for cancellation and deadlines
+func (c Client) GetStorageItemsContext(ctx context.Context, params GetStorageItemsParams) (GetStorageItemsReturns, error) {
+	var returns_ GetStorageItemsReturns
+
+	if !params.StorageArea.Valid() {
+		return returns_, fmt.Errorf("Extensions.getStorageItems: invalid storageArea %q", params.StorageArea)
+	}
+
+	err_ := c.caller.Call(ctx, "Extensions.getStorageItems", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveStorageItemsReturns struct {
+}
+
+// UnmarshalCDP reads RemoveStorageItemsReturns from JSON
+func (v *RemoveStorageItemsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveStorageItemsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveStorageItemsParams are the parameters for Extensions.removeStorageItems
+// optional parameters are left out when nil
+type RemoveStorageItemsParams struct {
+	/* ID of extension. */
+	Id string `json:"id"`
+	/* StorageArea to remove data from. */
+	StorageArea StorageArea `json:"storageArea"`
+	/* Keys to remove. */
+	Keys []string `json:"keys"`
+}
+
+// MarshalCDP writes RemoveStorageItemsParams as JSON
+func (v *RemoveStorageItemsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("storageArea")
+	e.String(string(v.StorageArea))
+	e.Key("keys")
+	if v.Keys == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Keys {
+			e.Elem()
+			e.String(v.Keys[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveStorageItemsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes `keys` from extension storage in the given `storageArea`.
+func (c Client) RemoveStorageItems(params RemoveStorageItemsParams) (RemoveStorageItemsReturns, error) {
+	return c.RemoveStorageItemsContext(context.Background(), params)
+}
+
+// RemoveStorageItemsContext is RemoveStorageItems with a context for cancellation and deadlines
+func (c Client) RemoveStorageItemsContext(ctx context.Context, params RemoveStorageItemsParams) (RemoveStorageItemsReturns, error) {
+	var returns_ RemoveStorageItemsReturns
+
+	if !params.StorageArea.Valid() {
+		return returns_, fmt.Errorf("Extensions.removeStorageItems: invalid storageArea %q", params.StorageArea)
+	}
+
+	err_ := c.caller.Call(ctx, "Extensions.removeStorageItems", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearStorageItemsReturns struct {
+}
+
+// UnmarshalCDP reads ClearStorageItemsReturns from JSON
+func (v *ClearStorageItemsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearStorageItemsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearStorageItemsParams are the parameters for Extensions.clearStorageItems
+// optional parameters are left out when nil
+type ClearStorageItemsParams struct {
+	/* ID of extension. */
+	Id string `json:"id"`
+	/* StorageArea to remove data from. */
+	StorageArea StorageArea `json:"storageArea"`
+}
+
+// MarshalCDP writes ClearStorageItemsParams as JSON
+func (v *ClearStorageItemsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("storageArea")
+	e.String(string(v.StorageArea))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearStorageItemsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears extension storage in the given `storageArea`.
+func (c Client) ClearStorageItems(params ClearStorageItemsParams) (ClearStorageItemsReturns, error) {
+	return c.ClearStorageItemsContext(context.Background(), params)
+}
+
+// ClearStorageItemsContext is ClearStorageItems with a context for cancellation and deadlines
+func (c Client) ClearStorageItemsContext(ctx context.Context, params ClearStorageItemsParams) (ClearStorageItemsReturns, error) {
+	var returns_ ClearStorageItemsReturns
+
+	if !params.StorageArea.Valid() {
+		return returns_, fmt.Errorf("Extensions.clearStorageItems: invalid storageArea %q", params.StorageArea)
+	}
+
+	err_ := c.caller.Call(ctx, "Extensions.clearStorageItems", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetStorageItemsReturns struct {
+}
+
+// UnmarshalCDP reads SetStorageItemsReturns from JSON
+func (v *SetStorageItemsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetStorageItemsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetStorageItemsParams are the parameters for Extensions.setStorageItems
+// optional parameters are left out when nil
+type SetStorageItemsParams struct {
+	/* ID of extension. */
+	Id string `json:"id"`
+	/* StorageArea to set data in. */
+	StorageArea StorageArea `json:"storageArea"`
+	/* Values to set. */
+	Values map[string]interface{} `json:"values"`
+}
+
+// MarshalCDP writes SetStorageItemsParams as JSON
+func (v *SetStorageItemsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("storageArea")
+	e.String(string(v.StorageArea))
+	e.Key("values")
+	e.Map(v.Values)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetStorageItemsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets `values` in extension storage in the given `storageArea`. The provided `values`
+// will be merged with existing values in the storage area.
+func (c Client) SetStorageItems(params SetStorageItemsParams) (SetStorageItemsReturns, error) {
+	return c.SetStorageItemsContext(context.Background(), params)
+}
+
+// SetStorageItemsContext is SetStorageItems with a context for cancellation and deadlines
+func (c Client) SetStorageItemsContext(ctx context.Context, params SetStorageItemsParams) (SetStorageItemsReturns, error) {
+	var returns_ SetStorageItemsReturns
+
+	if !params.StorageArea.Valid() {
+		return returns_, fmt.Errorf("Extensions.setStorageItems: invalid storageArea %q", params.StorageArea)
+	}
+
+	err_ := c.caller.Call(ctx, "Extensions.setStorageItems", &params, &returns_)
+
+	return returns_, err_
+}
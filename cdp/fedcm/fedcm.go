@@ -0,0 +1,731 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package fedcm is the FedCm domain of the chrome devtools protocol
+//
+// This domain allows interacting with the FedCM dialog.
+//
+// Experimental: this may change or be removed in any chrome release
+package fedcm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends FedCm commands and listens for FedCm events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Whether this is a sign-up or sign-in action for this account, i.e.
+// whether this account has ever been used to sign in to this RP before.
+type LoginState string
+
+// LoginState values
+const (
+	LoginStateSignIn LoginState = "SignIn"
+	LoginStateSignUp LoginState = "SignUp"
+)
+
+// Values gives every LoginState
+func (LoginState) Values() []LoginState {
+	return []LoginState{
+		LoginStateSignIn,
+		LoginStateSignUp,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v LoginState) Valid() bool {
+	switch v {
+	case LoginStateSignIn, LoginStateSignUp:
+		return true
+	}
+	return false
+}
+
+// The types of FedCM dialogs.
+type DialogType string
+
+// DialogType values
+const (
+	DialogTypeAccountChooser  DialogType = "AccountChooser"
+	DialogTypeAutoReauthn     DialogType = "AutoReauthn"
+	DialogTypeConfirmIdpLogin DialogType = "ConfirmIdpLogin"
+	DialogTypeError           DialogType = "Error"
+)
+
+// Values gives every DialogType
+func (DialogType) Values() []DialogType {
+	return []DialogType{
+		DialogTypeAccountChooser,
+		DialogTypeAutoReauthn,
+		DialogTypeConfirmIdpLogin,
+		DialogTypeError,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DialogType) Valid() bool {
+	switch v {
+	case DialogTypeAccountChooser, DialogTypeAutoReauthn, DialogTypeConfirmIdpLogin, DialogTypeError:
+		return true
+	}
+	return false
+}
+
+// The buttons on the FedCM dialog.
+type DialogButton string
+
+// DialogButton values
+const (
+	DialogButtonConfirmIdpLoginContinue DialogButton = "ConfirmIdpLoginContinue"
+	DialogButtonErrorGotIt              DialogButton = "ErrorGotIt"
+	DialogButtonErrorMoreDetails        DialogButton = "ErrorMoreDetails"
+)
+
+// Values gives every DialogButton
+func (DialogButton) Values() []DialogButton {
+	return []DialogButton{
+		DialogButtonConfirmIdpLoginContinue,
+		DialogButtonErrorGotIt,
+		DialogButtonErrorMoreDetails,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DialogButton) Valid() bool {
+	switch v {
+	case DialogButtonConfirmIdpLoginContinue, DialogButtonErrorGotIt, DialogButtonErrorMoreDetails:
+		return true
+	}
+	return false
+}
+
+// The URLs that each account has
+type AccountUrlType string
+
+// AccountUrlType values
+const (
+	AccountUrlTypeTermsOfService AccountUrlType = "TermsOfService"
+	AccountUrlTypePrivacyPolicy  AccountUrlType = "PrivacyPolicy"
+)
+
+// Values gives every AccountUrlType
+func (AccountUrlType) Values() []AccountUrlType {
+	return []AccountUrlType{
+		AccountUrlTypeTermsOfService,
+		AccountUrlTypePrivacyPolicy,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AccountUrlType) Valid() bool {
+	switch v {
+	case AccountUrlTypeTermsOfService, AccountUrlTypePrivacyPolicy:
+		return true
+	}
+	return false
+}
+
+// Corresponds to IdentityRequestAccount
+type Account struct {
+	AccountId    string     `json:"accountId"`
+	Email        string     `json:"email"`
+	Name         string     `json:"name"`
+	GivenName    string     `json:"givenName"`
+	PictureUrl   string     `json:"pictureUrl"`
+	IdpConfigUrl string     `json:"idpConfigUrl"`
+	IdpLoginUrl  string     `json:"idpLoginUrl"`
+	LoginState   LoginState `json:"loginState"`
+	/* These two are only set if the loginState is signUp */
+	TermsOfServiceUrl *string `json:"termsOfServiceUrl,omitempty"`
+	PrivacyPolicyUrl  *string `json:"privacyPolicyUrl,omitempty"`
+}
+
+// MarshalCDP writes Account as JSON
+func (v *Account) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("accountId")
+	e.String(v.AccountId)
+	e.Key("email")
+	e.String(v.Email)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("givenName")
+	e.String(v.GivenName)
+	e.Key("pictureUrl")
+	e.String(v.PictureUrl)
+	e.Key("idpConfigUrl")
+	e.String(v.IdpConfigUrl)
+	e.Key("idpLoginUrl")
+	e.String(v.IdpLoginUrl)
+	e.Key("loginState")
+	e.String(string(v.LoginState))
+	if v.TermsOfServiceUrl != nil {
+		e.Key("termsOfServiceUrl")
+		e.String(*v.TermsOfServiceUrl)
+	}
+	if v.PrivacyPolicyUrl != nil {
+		e.Key("privacyPolicyUrl")
+		e.String(*v.PrivacyPolicyUrl)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Account) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Account from JSON
+func (v *Account) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "accountId":
+			v.AccountId = d.String()
+		case "email":
+			v.Email = d.String()
+		case "name":
+			v.Name = d.String()
+		case "givenName":
+			v.GivenName = d.String()
+		case "pictureUrl":
+			v.PictureUrl = d.String()
+		case "idpConfigUrl":
+			v.IdpConfigUrl = d.String()
+		case "idpLoginUrl":
+			v.IdpLoginUrl = d.String()
+		case "loginState":
+			v.LoginState = LoginState(d.String())
+		case "termsOfServiceUrl":
+			if d.Null() {
+				v.TermsOfServiceUrl = nil
+			} else {
+				v.TermsOfServiceUrl = new(string)
+				*v.TermsOfServiceUrl = d.String()
+			}
+		case "privacyPolicyUrl":
+			if d.Null() {
+				v.PrivacyPolicyUrl = nil
+			} else {
+				v.PrivacyPolicyUrl = new(string)
+				*v.PrivacyPolicyUrl = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Account) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for FedCm.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* Allows callers to disable the promise rejection delay that would
+	normally happen, if this is unimportant to what's being tested.
+	(step 4 of https://fedidcg.github.io/FedCM/#browser-api-rp-sign-in) */
+	DisableRejectionDelay *bool `json:"disableRejectionDelay,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.DisableRejectionDelay != nil {
+		e.Key("disableRejectionDelay")
+		e.Bool(*v.DisableRejectionDelay)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "FedCm.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "FedCm.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SelectAccountReturns struct {
+}
+
+// UnmarshalCDP reads SelectAccountReturns from JSON
+func (v *SelectAccountReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SelectAccountReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SelectAccountParams are the parameters for FedCm.selectAccount
+// optional parameters are left out when nil
+type SelectAccountParams struct {
+	DialogId     string `json:"dialogId"`
+	AccountIndex int    `json:"accountIndex"`
+}
+
+// MarshalCDP writes SelectAccountParams as JSON
+func (v *SelectAccountParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("dialogId")
+	e.String(v.DialogId)
+	e.Key("accountIndex")
+	e.Int(v.AccountIndex)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SelectAccountParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SelectAccount(params SelectAccountParams) (SelectAccountReturns, error) {
+	return c.SelectAccountContext(context.Background(), params)
+}
+
+// SelectAccountContext is SelectAccount with a context for cancellation and deadlines
+func (c Client) SelectAccountContext(ctx context.Context, params SelectAccountParams) (SelectAccountReturns, error) {
+	var returns_ SelectAccountReturns
+
+	err_ := c.caller.Call(ctx, "FedCm.selectAccount", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClickDialogButtonReturns struct {
+}
+
+// UnmarshalCDP reads ClickDialogButtonReturns from JSON
+func (v *ClickDialogButtonReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClickDialogButtonReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClickDialogButtonParams are the parameters for FedCm.clickDialogButton
+// optional parameters are left out when nil
+type ClickDialogButtonParams struct {
+	DialogId     string       `json:"dialogId"`
+	DialogButton DialogButton `json:"dialogButton"`
+}
+
+// MarshalCDP writes ClickDialogButtonParams as JSON
+func (v *ClickDialogButtonParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("dialogId")
+	e.String(v.DialogId)
+	e.Key("dialogButton")
+	e.String(string(v.DialogButton))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClickDialogButtonParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) ClickDialogButton(params ClickDialogButtonParams) (ClickDialogButtonReturns, error) {
+	return c.ClickDialogButtonContext(context.Background(), params)
+}
+
+// ClickDialogButtonContext is ClickDialogButton with a context for cancellation and deadlines
+func (c Client) ClickDialogButtonContext(ctx context.Context, params ClickDialogButtonParams) (ClickDialogButtonReturns, error) {
+	var returns_ ClickDialogButtonReturns
+
+	if !params.DialogButton.Valid() {
+		return returns_, fmt.Errorf("FedCm.clickDialogButton: invalid dialogButton %q", params.DialogButton)
+	}
+
+	err_ := c.caller.Call(ctx, "FedCm.clickDialogButton", &params, &returns_)
+
+	return returns_, err_
+}
+
+type OpenUrlReturns struct {
+}
+
+// UnmarshalCDP reads OpenUrlReturns from JSON
+func (v *OpenUrlReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OpenUrlReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// OpenUrlParams are the parameters for FedCm.openUrl
+// optional parameters are left out when nil
+type OpenUrlParams struct {
+	DialogId       string         `json:"dialogId"`
+	AccountIndex   int            `json:"accountIndex"`
+	AccountUrlType AccountUrlType `json:"accountUrlType"`
+}
+
+// MarshalCDP writes OpenUrlParams as JSON
+func (v *OpenUrlParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("dialogId")
+	e.String(v.DialogId)
+	e.Key("accountIndex")
+	e.Int(v.AccountIndex)
+	e.Key("accountUrlType")
+	e.String(string(v.AccountUrlType))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OpenUrlParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) OpenUrl(params OpenUrlParams) (OpenUrlReturns, error) {
+	return c.OpenUrlContext(context.Background(), params)
+}
+
+// OpenUrlContext is OpenUrl with a context for cancellation and deadlines
+func (c Client) OpenUrlContext(ctx context.Context, params OpenUrlParams) (OpenUrlReturns, error) {
+	var returns_ OpenUrlReturns
+
+	if !params.AccountUrlType.Valid() {
+		return returns_, fmt.Errorf("FedCm.openUrl: invalid accountUrlType %q", params.AccountUrlType)
+	}
+
+	err_ := c.caller.Call(ctx, "FedCm.openUrl", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DismissDialogReturns struct {
+}
+
+// UnmarshalCDP reads DismissDialogReturns from JSON
+func (v *DismissDialogReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DismissDialogReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DismissDialogParams are the parameters for FedCm.dismissDialog
+// optional parameters are left out when nil
+type DismissDialogParams struct {
+	DialogId        string `json:"dialogId"`
+	TriggerCooldown *bool  `json:"triggerCooldown,omitempty"`
+}
+
+// MarshalCDP writes DismissDialogParams as JSON
+func (v *DismissDialogParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("dialogId")
+	e.String(v.DialogId)
+	if v.TriggerCooldown != nil {
+		e.Key("triggerCooldown")
+		e.Bool(*v.TriggerCooldown)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DismissDialogParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) DismissDialog(params DismissDialogParams) (DismissDialogReturns, error) {
+	return c.DismissDialogContext(context.Background(), params)
+}
+
+// DismissDialogContext is DismissDialog with a context for cancellation and deadlines
+func (c Client) DismissDialogContext(ctx context.Context, params DismissDialogParams) (DismissDialogReturns, error) {
+	var returns_ DismissDialogReturns
+
+	err_ := c.caller.Call(ctx, "FedCm.dismissDialog", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ResetCooldownReturns struct {
+}
+
+// UnmarshalCDP reads ResetCooldownReturns from JSON
+func (v *ResetCooldownReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResetCooldownReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Resets the cooldown time, if any, to allow the next FedCM call to show
+// a dialog even if one was recently dismissed by the user.
+func (c Client) ResetCooldown() (ResetCooldownReturns, error) {
+	return c.ResetCooldownContext(context.Background())
+}
+
+// ResetCooldownContext is ResetCooldown with a context for cancellation and deadlines
+func (c Client) ResetCooldownContext(ctx context.Context) (ResetCooldownReturns, error) {
+	var returns_ ResetCooldownReturns
+
+	err_ := c.caller.Call(ctx, "FedCm.resetCooldown", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type DialogShownEvent struct {
+	DialogId string `json:"dialogId"`
+
+	DialogType DialogType `json:"dialogType"`
+
+	Accounts []Account `json:"accounts"`
+
+	/* These exist primarily so that the caller can verify the
+	RP context was used appropriately. */
+	Title string `json:"title"`
+
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// UnmarshalCDP reads DialogShownEvent from JSON
+func (v *DialogShownEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "dialogId":
+			v.DialogId = d.String()
+		case "dialogType":
+			v.DialogType = DialogType(d.String())
+		case "accounts":
+			if d.Array() {
+				v.Accounts = make([]Account, 0)
+				for d.More() {
+					var x0 Account
+					x0.UnmarshalCDP(d)
+					v.Accounts = append(v.Accounts, x0)
+				}
+			} else {
+				v.Accounts = nil
+			}
+		case "title":
+			v.Title = d.String()
+		case "subtitle":
+			if d.Null() {
+				v.Subtitle = nil
+			} else {
+				v.Subtitle = new(string)
+				*v.Subtitle = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DialogShownEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DialogShownHandler func(ev DialogShownEvent)
+
+// EventMethod is FedCm.dialogShown
+func (DialogShownEvent) EventMethod() string {
+	return "FedCm.dialogShown"
+}
+
+// OnDialogShown calls handler for each FedCm.dialogShown event
+func (c Client) OnDialogShown(handler DialogShownHandler) (unsubscribe func()) {
+	return c.caller.On("FedCm.dialogShown", func(ev interface{}) {
+		handler(ev.(DialogShownEvent))
+	})
+}
+
+// Triggered when a dialog is closed, either by user action, JS abort,
+// or a command below.
+type DialogClosedEvent struct {
+	DialogId string `json:"dialogId"`
+}
+
+// UnmarshalCDP reads DialogClosedEvent from JSON
+func (v *DialogClosedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "dialogId":
+			v.DialogId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DialogClosedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DialogClosedHandler func(ev DialogClosedEvent)
+
+// EventMethod is FedCm.dialogClosed
+func (DialogClosedEvent) EventMethod() string {
+	return "FedCm.dialogClosed"
+}
+
+// OnDialogClosed calls handler for each FedCm.dialogClosed event
+func (c Client) OnDialogClosed(handler DialogClosedHandler) (unsubscribe func()) {
+	return c.caller.On("FedCm.dialogClosed", func(ev interface{}) {
+		handler(ev.(DialogClosedEvent))
+	})
+}
+
+// decoders for FedCm events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"FedCm.dialogShown":  cdp.Decode[DialogShownEvent],
+	"FedCm.dialogClosed": cdp.Decode[DialogClosedEvent],
+}
+
+// DecodeEvent decodes a FedCm event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,1131 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package fetch is the Fetch domain of the chrome devtools protocol
+//
+// A domain for letting clients substitute browser's network layer with client code.
+package fetch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Fetch commands and listens for Fetch events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Unique request identifier.
+// Note that this does not identify individual HTTP requests that are part of
+// a network request.
+type RequestId string
+
+// Stages of the request to handle. Request will intercept before the request is
+// sent. Response will intercept after the response is received (but before response
+// body is received).
+type RequestStage string
+
+// RequestStage values
+const (
+	RequestStageRequest  RequestStage = "Request"
+	RequestStageResponse RequestStage = "Response"
+)
+
+// Values gives every RequestStage
+func (RequestStage) Values() []RequestStage {
+	return []RequestStage{
+		RequestStageRequest,
+		RequestStageResponse,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RequestStage) Valid() bool {
+	switch v {
+	case RequestStageRequest, RequestStageResponse:
+		return true
+	}
+	return false
+}
+
+type RequestPattern struct {
+	/* Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is
+	backslash. Omitting is equivalent to `"*"`. */
+	UrlPattern *string `json:"urlPattern,omitempty"`
+	/* If set, only requests for matching resource types will be intercepted. */
+	ResourceType *cdp.NetworkResourceType `json:"resourceType,omitempty"`
+	/* Stage at which to begin intercepting requests. Default is Request. */
+	RequestStage *RequestStage `json:"requestStage,omitempty"`
+}
+
+// MarshalCDP writes RequestPattern as JSON
+func (v *RequestPattern) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.UrlPattern != nil {
+		e.Key("urlPattern")
+		e.String(*v.UrlPattern)
+	}
+	if v.ResourceType != nil {
+		e.Key("resourceType")
+		e.String(string(*v.ResourceType))
+	}
+	if v.RequestStage != nil {
+		e.Key("requestStage")
+		e.String(string(*v.RequestStage))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestPattern) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RequestPattern from JSON
+func (v *RequestPattern) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "urlPattern":
+			if d.Null() {
+				v.UrlPattern = nil
+			} else {
+				v.UrlPattern = new(string)
+				*v.UrlPattern = d.String()
+			}
+		case "resourceType":
+			if d.Null() {
+				v.ResourceType = nil
+			} else {
+				v.ResourceType = new(cdp.NetworkResourceType)
+				*v.ResourceType = cdp.NetworkResourceType(d.String())
+			}
+		case "requestStage":
+			if d.Null() {
+				v.RequestStage = nil
+			} else {
+				v.RequestStage = new(RequestStage)
+				*v.RequestStage = RequestStage(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestPattern) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Response HTTP header entry
+type HeaderEntry struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes HeaderEntry as JSON
+func (v *HeaderEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HeaderEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads HeaderEntry from JSON
+func (v *HeaderEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HeaderEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Authorization challenge for HTTP status code 401 or 407.
+type AuthChallenge struct {
+	/* Source of the authentication challenge. */
+	Source *AuthChallengeSource `json:"source,omitempty"`
+	/* Origin of the challenger. */
+	Origin string `json:"origin"`
+	/* The authentication scheme used, such as basic or digest */
+	Scheme string `json:"scheme"`
+	/* The realm of the challenge. May be empty. */
+	Realm string `json:"realm"`
+}
+
+// MarshalCDP writes AuthChallenge as JSON
+func (v *AuthChallenge) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Source != nil {
+		e.Key("source")
+		e.String(string(*v.Source))
+	}
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("scheme")
+	e.String(v.Scheme)
+	e.Key("realm")
+	e.String(v.Realm)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AuthChallenge) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AuthChallenge from JSON
+func (v *AuthChallenge) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "source":
+			if d.Null() {
+				v.Source = nil
+			} else {
+				v.Source = new(AuthChallengeSource)
+				*v.Source = AuthChallengeSource(d.String())
+			}
+		case "origin":
+			v.Origin = d.String()
+		case "scheme":
+			v.Scheme = d.String()
+		case "realm":
+			v.Realm = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AuthChallenge) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AuthChallengeSource string
+
+// AuthChallengeSource values
+const (
+	AuthChallengeSourceServer AuthChallengeSource = "Server"
+	AuthChallengeSourceProxy  AuthChallengeSource = "Proxy"
+)
+
+// Values gives every AuthChallengeSource
+func (AuthChallengeSource) Values() []AuthChallengeSource {
+	return []AuthChallengeSource{
+		AuthChallengeSourceServer,
+		AuthChallengeSourceProxy,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AuthChallengeSource) Valid() bool {
+	switch v {
+	case AuthChallengeSourceServer, AuthChallengeSourceProxy:
+		return true
+	}
+	return false
+}
+
+// Response to an AuthChallenge.
+type AuthChallengeResponse struct {
+	/* The decision on what to do in response to the authorization challenge.  Default means
+	deferring to the default behavior of the net stack, which will likely either the Cancel
+	authentication or display a popup dialog box. */
+	Response AuthChallengeResponseResponse `json:"response"`
+	/* The username to provide, possibly empty. Should only be set if response is
+	ProvideCredentials. */
+	Username *string `json:"username,omitempty"`
+	/* The password to provide, possibly empty. Should only be set if response is
+	ProvideCredentials. */
+	Password *string `json:"password,omitempty"`
+}
+
+// MarshalCDP writes AuthChallengeResponse as JSON
+func (v *AuthChallengeResponse) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("response")
+	e.String(string(v.Response))
+	if v.Username != nil {
+		e.Key("username")
+		e.String(*v.Username)
+	}
+	if v.Password != nil {
+		e.Key("password")
+		e.String(*v.Password)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AuthChallengeResponse) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AuthChallengeResponse from JSON
+func (v *AuthChallengeResponse) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "response":
+			v.Response = AuthChallengeResponseResponse(d.String())
+		case "username":
+			if d.Null() {
+				v.Username = nil
+			} else {
+				v.Username = new(string)
+				*v.Username = d.String()
+			}
+		case "password":
+			if d.Null() {
+				v.Password = nil
+			} else {
+				v.Password = new(string)
+				*v.Password = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AuthChallengeResponse) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AuthChallengeResponseResponse string
+
+// AuthChallengeResponseResponse values
+const (
+	AuthChallengeResponseResponseDefault            AuthChallengeResponseResponse = "Default"
+	AuthChallengeResponseResponseCancelAuth         AuthChallengeResponseResponse = "CancelAuth"
+	AuthChallengeResponseResponseProvideCredentials AuthChallengeResponseResponse = "ProvideCredentials"
+)
+
+// Values gives every AuthChallengeResponseResponse
+func (AuthChallengeResponseResponse) Values() []AuthChallengeResponseResponse {
+	return []AuthChallengeResponseResponse{
+		AuthChallengeResponseResponseDefault,
+		AuthChallengeResponseResponseCancelAuth,
+		AuthChallengeResponseResponseProvideCredentials,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AuthChallengeResponseResponse) Valid() bool {
+	switch v {
+	case AuthChallengeResponseResponseDefault, AuthChallengeResponseResponseCancelAuth, AuthChallengeResponseResponseProvideCredentials:
+		return true
+	}
+	return false
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables the fetch domain.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for Fetch.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* If specified, only requests matching any of these patterns will produce
+	fetchRequested event and will be paused until clients response. If not set,
+	all requests will be affected. */
+	Patterns []RequestPattern `json:"patterns"`
+	/* If true, authRequired events will be issued and requests will be paused
+	expecting a call to continueWithAuth. */
+	HandleAuthRequests *bool `json:"handleAuthRequests,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Patterns != nil {
+		e.Key("patterns")
+		e.ArrayStart()
+		for i0 := range v.Patterns {
+			e.Elem()
+			v.Patterns[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.HandleAuthRequests != nil {
+		e.Key("handleAuthRequests")
+		e.Bool(*v.HandleAuthRequests)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables issuing of requestPaused events. A request will be paused until client
+// calls one of failRequest, fulfillRequest or continueRequest/continueWithAuth.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type FailRequestReturns struct {
+}
+
+// UnmarshalCDP reads FailRequestReturns from JSON
+func (v *FailRequestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FailRequestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// FailRequestParams are the parameters for Fetch.failRequest
+// optional parameters are left out when nil
+type FailRequestParams struct {
+	/* An id the client received in requestPaused event. */
+	RequestId RequestId `json:"requestId"`
+	/* Causes the request to fail with the given reason. */
+	ErrorReason cdp.NetworkErrorReason `json:"errorReason"`
+}
+
+// MarshalCDP writes FailRequestParams as JSON
+func (v *FailRequestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.Key("errorReason")
+	e.String(string(v.ErrorReason))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FailRequestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Causes the request to fail with specified reason.
+func (c Client) FailRequest(params FailRequestParams) (FailRequestReturns, error) {
+	return c.FailRequestContext(context.Background(), params)
+}
+
+// FailRequestContext is FailRequest with a context for cancellation and deadlines
+func (c Client) FailRequestContext(ctx context.Context, params FailRequestParams) (FailRequestReturns, error) {
+	var returns_ FailRequestReturns
+
+	if !params.ErrorReason.Valid() {
+		return returns_, fmt.Errorf("Fetch.failRequest: invalid errorReason %q", params.ErrorReason)
+	}
+
+	err_ := c.caller.Call(ctx, "Fetch.failRequest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type FulfillRequestReturns struct {
+}
+
+// UnmarshalCDP reads FulfillRequestReturns from JSON
+func (v *FulfillRequestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FulfillRequestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// FulfillRequestParams are the parameters for Fetch.fulfillRequest
+// optional parameters are left out when nil
+type FulfillRequestParams struct {
+	/* An id the client received in requestPaused event. */
+	RequestId RequestId `json:"requestId"`
+	/* An HTTP response code. */
+	ResponseCode int `json:"responseCode"`
+	/* Response headers. */
+	ResponseHeaders []HeaderEntry `json:"responseHeaders"`
+	/* Alternative way of specifying response headers as a \0-separated
+	series of name: value pairs. Prefer the above method unless you
+	need to represent some non-UTF8 values that can't be transmitted
+	over the protocol as text. (Encoded as a base64 string when passed over JSON) */
+	BinaryResponseHeaders *string `json:"binaryResponseHeaders,omitempty"`
+	/* A response body. If absent, original response body will be used if
+	the request is intercepted at the response stage and empty body
+	will be used if the request is intercepted at the request stage. (Encoded as a base64 string when passed over JSON) */
+	Body *string `json:"body,omitempty"`
+	/* A textual representation of responseCode.
+	If absent, a standard phrase matching responseCode is used. */
+	ResponsePhrase *string `json:"responsePhrase,omitempty"`
+}
+
+// MarshalCDP writes FulfillRequestParams as JSON
+func (v *FulfillRequestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.Key("responseCode")
+	e.Int(v.ResponseCode)
+	if v.ResponseHeaders != nil {
+		e.Key("responseHeaders")
+		e.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			e.Elem()
+			v.ResponseHeaders[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.BinaryResponseHeaders != nil {
+		e.Key("binaryResponseHeaders")
+		e.String(*v.BinaryResponseHeaders)
+	}
+	if v.Body != nil {
+		e.Key("body")
+		e.String(*v.Body)
+	}
+	if v.ResponsePhrase != nil {
+		e.Key("responsePhrase")
+		e.String(*v.ResponsePhrase)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FulfillRequestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Provides response to the request.
+func (c Client) FulfillRequest(params FulfillRequestParams) (FulfillRequestReturns, error) {
+	return c.FulfillRequestContext(context.Background(), params)
+}
+
+// FulfillRequestContext is FulfillRequest with a context for cancellation and deadlines
+func (c Client) FulfillRequestContext(ctx context.Context, params FulfillRequestParams) (FulfillRequestReturns, error) {
+	var returns_ FulfillRequestReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.fulfillRequest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ContinueRequestReturns struct {
+}
+
+// UnmarshalCDP reads ContinueRequestReturns from JSON
+func (v *ContinueRequestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContinueRequestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ContinueRequestParams are the parameters for Fetch.continueRequest
+// optional parameters are left out when nil
+type ContinueRequestParams struct {
+	/* An id the client received in requestPaused event. */
+	RequestId RequestId `json:"requestId"`
+	/* If set, the request url will be modified in a way that's not observable by page. */
+	Url *string `json:"url,omitempty"`
+	/* If set, the request method is overridden. */
+	Method *string `json:"method,omitempty"`
+	/* If set, overrides the post data in the request. (Encoded as a base64 string when passed over JSON) */
+	PostData *string `json:"postData,omitempty"`
+	/* If set, overrides the request headers. Note that the overrides do not
+	extend to subsequent redirect hops, if a redirect happens. Another override
+	may be applied to a different request produced by a redirect. */
+	Headers []HeaderEntry `json:"headers"`
+	/* If set, overrides response interception behavior for this request.
+
+	Experimental: this may change or be removed in any chrome release */
+	InterceptResponse *bool `json:"interceptResponse,omitempty"`
+}
+
+// MarshalCDP writes ContinueRequestParams as JSON
+func (v *ContinueRequestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Method != nil {
+		e.Key("method")
+		e.String(*v.Method)
+	}
+	if v.PostData != nil {
+		e.Key("postData")
+		e.String(*v.PostData)
+	}
+	if v.Headers != nil {
+		e.Key("headers")
+		e.ArrayStart()
+		for i0 := range v.Headers {
+			e.Elem()
+			v.Headers[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.InterceptResponse != nil {
+		e.Key("interceptResponse")
+		e.Bool(*v.InterceptResponse)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContinueRequestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Continues the request, optionally modifying some of its parameters.
+func (c Client) ContinueRequest(params ContinueRequestParams) (ContinueRequestReturns, error) {
+	return c.ContinueRequestContext(context.Background(), params)
+}
+
+// ContinueRequestContext is ContinueRequest with a context for cancellation and deadlines
+func (c Client) ContinueRequestContext(ctx context.Context, params ContinueRequestParams) (ContinueRequestReturns, error) {
+	var returns_ ContinueRequestReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.continueRequest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ContinueWithAuthReturns struct {
+}
+
+// UnmarshalCDP reads ContinueWithAuthReturns from JSON
+func (v *ContinueWithAuthReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContinueWithAuthReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ContinueWithAuthParams are the parameters for Fetch.continueWithAuth
+// optional parameters are left out when nil
+type ContinueWithAuthParams struct {
+	/* An id the client received in authRequired event. */
+	RequestId RequestId `json:"requestId"`
+	/* Response to  with an authChallenge. */
+	AuthChallengeResponse AuthChallengeResponse `json:"authChallengeResponse"`
+}
+
+// MarshalCDP writes ContinueWithAuthParams as JSON
+func (v *ContinueWithAuthParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.Key("authChallengeResponse")
+	v.AuthChallengeResponse.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContinueWithAuthParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Continues a request supplying authChallengeResponse following authRequired event.
+func (c Client) ContinueWithAuth(params ContinueWithAuthParams) (ContinueWithAuthReturns, error) {
+	return c.ContinueWithAuthContext(context.Background(), params)
+}
+
+// ContinueWithAuthContext is ContinueWithAuth with a context for cancellation and deadlines
+func (c Client) ContinueWithAuthContext(ctx context.Context, params ContinueWithAuthParams) (ContinueWithAuthReturns, error) {
+	var returns_ ContinueWithAuthReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.continueWithAuth", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetResponseBodyReturns struct {
+
+	/* Response body. */
+	Body string `json:"body"`
+
+	/* True, if content was sent as base64. */
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// UnmarshalCDP reads GetResponseBodyReturns from JSON
+func (v *GetResponseBodyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "body":
+			v.Body = d.String()
+		case "base64Encoded":
+			v.Base64Encoded = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetResponseBodyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetResponseBodyParams are the parameters for Fetch.getResponseBody
+// optional parameters are left out when nil
+type GetResponseBodyParams struct {
+	/* Identifier for the intercepted request to get body for. */
+	RequestId RequestId `json:"requestId"`
+}
+
+// MarshalCDP writes GetResponseBodyParams as JSON
+func (v *GetResponseBodyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetResponseBodyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Causes the body of the response to be received from the server and
+// returned as a single string. May only be issued for a request that
+// is paused in the Response stage and is mutually exclusive with
+// takeResponseBodyForInterceptionAsStream. Calling other methods that
+// affect the request or disabling fetch domain before body is received
+// results in an undefined behavior.
+// Note that the response body is not available for redirects. Requests
+// paused in the _redirect received_ state may be differentiated by
+// `responseCode` and presence of `location` response header, see
+// comments to `requestPaused` for details.
+func (c Client) GetResponseBody(params GetResponseBodyParams) (GetResponseBodyReturns, error) {
+	return c.GetResponseBodyContext(context.Background(), params)
+}
+
+// GetResponseBodyContext is GetResponseBody with a context for cancellation and deadlines
+func (c Client) GetResponseBodyContext(ctx context.Context, params GetResponseBodyParams) (GetResponseBodyReturns, error) {
+	var returns_ GetResponseBodyReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.getResponseBody", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TakeResponseBodyAsStreamReturns struct {
+	Stream cdp.IOStreamHandle `json:"stream"`
+}
+
+// UnmarshalCDP reads TakeResponseBodyAsStreamReturns from JSON
+func (v *TakeResponseBodyAsStreamReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "stream":
+			v.Stream = cdp.IOStreamHandle(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TakeResponseBodyAsStreamReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TakeResponseBodyAsStreamParams are the parameters for Fetch.takeResponseBodyAsStream
+// optional parameters are left out when nil
+type TakeResponseBodyAsStreamParams struct {
+	RequestId RequestId `json:"requestId"`
+}
+
+// MarshalCDP writes TakeResponseBodyAsStreamParams as JSON
+func (v *TakeResponseBodyAsStreamParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TakeResponseBodyAsStreamParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a handle to the stream representing the response body.
+// The request must be paused in the HeadersReceived stage.
+// Note that after this command the request can't be continued
+// as is -- client either needs to cancel it or to provide the
+// response body.
+// The stream only supports sequential read, IO.read will fail if the position
+// is specified.
+// This method is mutually exclusive with getResponseBody.
+// Calling other methods that affect the request or disabling fetch
+// domain before body is received results in an undefined behavior.
+func (c Client) TakeResponseBodyAsStream(params TakeResponseBodyAsStreamParams) (TakeResponseBodyAsStreamReturns, error) {
+	return c.TakeResponseBodyAsStreamContext(context.Background(), params)
+}
+
+// TakeResponseBodyAsStreamContext is TakeResponseBodyAsStream with a context for cancellation and deadlines
+func (c Client) TakeResponseBodyAsStreamContext(ctx context.Context, params TakeResponseBodyAsStreamParams) (TakeResponseBodyAsStreamReturns, error) {
+	var returns_ TakeResponseBodyAsStreamReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.takeResponseBodyAsStream", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Issued when the domain is enabled and the request URL matches the
+// specified filter. The request is paused until the client responds
+// with one of continueRequest, failRequest or fulfillRequest.
+// The stage of the request can be determined by presence of responseErrorReason
+// and responseStatusCode -- the request is at the response stage if either
+// of these fields is present and in the request stage otherwise.
+// Redirect responses and subsequent requests are reported similarly to regular
+// responses and requests. Redirect responses may be distinguished by the value
+// of `responseStatusCode` (which is one of 301, 302, 303, 307, 308) along with
+// presence of the `location` header. Requests resulting from a redirect will
+// have `redirectedRequestId` field set.
+type RequestPausedEvent struct {
+
+	/* Each request the page makes will have a unique id. */
+	RequestId RequestId `json:"requestId"`
+
+	/* The details of the request. */
+	Request cdp.NetworkRequest `json:"request"`
+
+	/* The id of the frame that initiated the request. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+
+	/* How the requested resource will be used. */
+	ResourceType cdp.NetworkResourceType `json:"resourceType"`
+
+	/* Response error if intercepted at response stage. */
+	ResponseErrorReason *cdp.NetworkErrorReason `json:"responseErrorReason,omitempty"`
+
+	/* Response code if intercepted at response stage. */
+	ResponseStatusCode *int `json:"responseStatusCode,omitempty"`
+
+	/* Response status text if intercepted at response stage. */
+	ResponseStatusText *string `json:"responseStatusText,omitempty"`
+
+	/* Response headers if intercepted at the response stage. */
+	ResponseHeaders []HeaderEntry `json:"responseHeaders,omitempty"`
+
+	/* If the intercepted request had a corresponding Network.requestWillBeSent event fired for it,
+	then this networkId will be the same as the requestId present in the requestWillBeSent event. */
+	NetworkId *cdp.NetworkRequestId `json:"networkId,omitempty"`
+
+	/* If the request is due to a redirect response from the server, the id of the request that
+	has caused the redirect.
+
+	Experimental: this may change or be removed in any chrome release */
+	RedirectedRequestId *RequestId `json:"redirectedRequestId,omitempty"`
+}
+
+// UnmarshalCDP reads RequestPausedEvent from JSON
+func (v *RequestPausedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "resourceType":
+			v.ResourceType = cdp.NetworkResourceType(d.String())
+		case "responseErrorReason":
+			if d.Null() {
+				v.ResponseErrorReason = nil
+			} else {
+				v.ResponseErrorReason = new(cdp.NetworkErrorReason)
+				*v.ResponseErrorReason = cdp.NetworkErrorReason(d.String())
+			}
+		case "responseStatusCode":
+			if d.Null() {
+				v.ResponseStatusCode = nil
+			} else {
+				v.ResponseStatusCode = new(int)
+				*v.ResponseStatusCode = d.Int()
+			}
+		case "responseStatusText":
+			if d.Null() {
+				v.ResponseStatusText = nil
+			} else {
+				v.ResponseStatusText = new(string)
+				*v.ResponseStatusText = d.String()
+			}
+		case "responseHeaders":
+			if d.Array() {
+				v.ResponseHeaders = make([]HeaderEntry, 0)
+				for d.More() {
+					var x0 HeaderEntry
+					x0.UnmarshalCDP(d)
+					v.ResponseHeaders = append(v.ResponseHeaders, x0)
+				}
+			} else {
+				v.ResponseHeaders = nil
+			}
+		case "networkId":
+			if d.Null() {
+				v.NetworkId = nil
+			} else {
+				v.NetworkId = new(cdp.NetworkRequestId)
+				*v.NetworkId = cdp.NetworkRequestId(d.String())
+			}
+		case "redirectedRequestId":
+			if d.Null() {
+				v.RedirectedRequestId = nil
+			} else {
+				v.RedirectedRequestId = new(RequestId)
+				*v.RedirectedRequestId = RequestId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestPausedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RequestPausedHandler func(ev RequestPausedEvent)
+
+// EventMethod is Fetch.requestPaused
+func (RequestPausedEvent) EventMethod() string {
+	return "Fetch.requestPaused"
+}
+
+// OnRequestPaused calls handler for each Fetch.requestPaused event
+func (c Client) OnRequestPaused(handler RequestPausedHandler) (unsubscribe func()) {
+	return c.caller.On("Fetch.requestPaused", func(ev interface{}) {
+		handler(ev.(RequestPausedEvent))
+	})
+}
+
+// Issued when the domain is enabled with handleAuthRequests set to true.
+// The request is paused until client responds with continueWithAuth.
+type AuthRequiredEvent struct {
+
+	/* Each request the page makes will have a unique id. */
+	RequestId RequestId `json:"requestId"`
+
+	/* The details of the request. */
+	Request cdp.NetworkRequest `json:"request"`
+
+	/* The id of the frame that initiated the request. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+
+	/* How the requested resource will be used. */
+	ResourceType cdp.NetworkResourceType `json:"resourceType"`
+
+	/* Details of the Authorization Challenge encountered.
+	If this is set, client should respond with continueRequest that
+	contains AuthChallengeResponse. */
+	AuthChallenge AuthChallenge `json:"authChallenge"`
+}
+
+// UnmarshalCDP reads AuthRequiredEvent from JSON
+func (v *AuthRequiredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "resourceType":
+			v.ResourceType = cdp.NetworkResourceType(d.String())
+		case "authChallenge":
+			v.AuthChallenge.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AuthRequiredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AuthRequiredHandler func(ev AuthRequiredEvent)
+
+// EventMethod is Fetch.authRequired
+func (AuthRequiredEvent) EventMethod() string {
+	return "Fetch.authRequired"
+}
+
+// OnAuthRequired calls handler for each Fetch.authRequired event
+func (c Client) OnAuthRequired(handler AuthRequiredHandler) (unsubscribe func()) {
+	return c.caller.On("Fetch.authRequired", func(ev interface{}) {
+		handler(ev.(AuthRequiredEvent))
+	})
+}
+
+// decoders for Fetch events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Fetch.requestPaused": cdp.Decode[RequestPausedEvent],
+	"Fetch.authRequired":  cdp.Decode[AuthRequiredEvent],
+}
+
+// DecodeEvent decodes a Fetch event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
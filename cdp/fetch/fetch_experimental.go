@@ -0,0 +1,106 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package fetch
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type ContinueResponseReturns struct {
+}
+
+// UnmarshalCDP reads ContinueResponseReturns from JSON
+func (v *ContinueResponseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContinueResponseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ContinueResponseParams are the parameters for Fetch.continueResponse
+// optional parameters are left out when nil
+type ContinueResponseParams struct {
+	/* An id the client received in requestPaused event. */
+	RequestId RequestId `json:"requestId"`
+	/* An HTTP response code. If absent, original response code will be used. */
+	ResponseCode *int `json:"responseCode,omitempty"`
+	/* A textual representation of responseCode.
+	If absent, a standard phrase matching responseCode is used. */
+	ResponsePhrase *string `json:"responsePhrase,omitempty"`
+	/* Response headers. If absent, original response headers will be used. */
+	ResponseHeaders []HeaderEntry `json:"responseHeaders"`
+	/* Alternative way of specifying response headers as a \0-separated
+	series of name: value pairs. Prefer the above method unless you
+	need to represent some non-UTF8 values that can't be transmitted
+	over the protocol as text. (Encoded as a base64 string when passed over JSON) */
+	BinaryResponseHeaders *string `json:"binaryResponseHeaders,omitempty"`
+}
+
+// MarshalCDP writes ContinueResponseParams as JSON
+func (v *ContinueResponseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	if v.ResponseCode != nil {
+		e.Key("responseCode")
+		e.Int(*v.ResponseCode)
+	}
+	if v.ResponsePhrase != nil {
+		e.Key("responsePhrase")
+		e.String(*v.ResponsePhrase)
+	}
+	if v.ResponseHeaders != nil {
+		e.Key("responseHeaders")
+		e.ArrayStart()
+		for i0 := range v.ResponseHeaders {
+			e.Elem()
+			v.ResponseHeaders[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.BinaryResponseHeaders != nil {
+		e.Key("binaryResponseHeaders")
+		e.String(*v.BinaryResponseHeaders)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContinueResponseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Continues loading of the paused response, optionally modifying the
+// response headers. If either responseCode or headers are modified, all of them
+// must be present.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ContinueResponse(params ContinueResponseParams) (ContinueResponseReturns, error) {
+	return c.ContinueResponseContext(context.Background(), params)
+}
+
+// ContinueResponseContext is ContinueResponse with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ContinueResponseContext(ctx context.Context, params ContinueResponseParams) (ContinueResponseReturns, error) {
+	var returns_ ContinueResponseReturns
+
+	err_ := c.caller.Call(ctx, "Fetch.continueResponse", &params, &returns_)
+
+	return returns_, err_
+}
@@ -0,0 +1,297 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package filesystem is the FileSystem domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package filesystem
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends FileSystem commands and listens for FileSystem events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type File struct {
+	Name string `json:"name"`
+	/* Timestamp */
+	LastModified cdp.NetworkTimeSinceEpoch `json:"lastModified"`
+	/* Size in bytes */
+	Size float64 `json:"size"`
+	Type string  `json:"type"`
+}
+
+// MarshalCDP writes File as JSON
+func (v *File) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("lastModified")
+	e.Float(float64(v.LastModified))
+	e.Key("size")
+	e.Float(v.Size)
+	e.Key("type")
+	e.String(v.Type)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v File) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads File from JSON
+func (v *File) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "lastModified":
+			v.LastModified = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "size":
+			v.Size = d.Float()
+		case "type":
+			v.Type = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *File) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type Directory struct {
+	Name              string   `json:"name"`
+	NestedDirectories []string `json:"nestedDirectories"`
+	/* Files that are directly nested under this directory. */
+	NestedFiles []File `json:"nestedFiles"`
+}
+
+// MarshalCDP writes Directory as JSON
+func (v *Directory) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("nestedDirectories")
+	if v.NestedDirectories == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NestedDirectories {
+			e.Elem()
+			e.String(v.NestedDirectories[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("nestedFiles")
+	if v.NestedFiles == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NestedFiles {
+			e.Elem()
+			v.NestedFiles[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Directory) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Directory from JSON
+func (v *Directory) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "nestedDirectories":
+			if d.Array() {
+				v.NestedDirectories = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.NestedDirectories = append(v.NestedDirectories, x0)
+				}
+			} else {
+				v.NestedDirectories = nil
+			}
+		case "nestedFiles":
+			if d.Array() {
+				v.NestedFiles = make([]File, 0)
+				for d.More() {
+					var x0 File
+					x0.UnmarshalCDP(d)
+					v.NestedFiles = append(v.NestedFiles, x0)
+				}
+			} else {
+				v.NestedFiles = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Directory) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BucketFileSystemLocator struct {
+	/* Storage key */
+	StorageKey cdp.StorageSerializedStorageKey `json:"storageKey"`
+	/* Bucket name. Not passing a `bucketName` will retrieve the default Bucket. (https://developer.mozilla.org/en-US/docs/Web/API/Storage_API#storage_buckets) */
+	BucketName *string `json:"bucketName,omitempty"`
+	/* Path to the directory using each path component as an array item. */
+	PathComponents []string `json:"pathComponents"`
+}
+
+// MarshalCDP writes BucketFileSystemLocator as JSON
+func (v *BucketFileSystemLocator) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(string(v.StorageKey))
+	if v.BucketName != nil {
+		e.Key("bucketName")
+		e.String(*v.BucketName)
+	}
+	e.Key("pathComponents")
+	if v.PathComponents == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.PathComponents {
+			e.Elem()
+			e.String(v.PathComponents[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BucketFileSystemLocator) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BucketFileSystemLocator from JSON
+func (v *BucketFileSystemLocator) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageKey":
+			v.StorageKey = cdp.StorageSerializedStorageKey(d.String())
+		case "bucketName":
+			if d.Null() {
+				v.BucketName = nil
+			} else {
+				v.BucketName = new(string)
+				*v.BucketName = d.String()
+			}
+		case "pathComponents":
+			if d.Array() {
+				v.PathComponents = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.PathComponents = append(v.PathComponents, x0)
+				}
+			} else {
+				v.PathComponents = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BucketFileSystemLocator) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GetDirectoryReturns struct {
+
+	/* Returns the directory object at the path. */
+	Directory Directory `json:"directory"`
+}
+
+// UnmarshalCDP reads GetDirectoryReturns from JSON
+func (v *GetDirectoryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "directory":
+			v.Directory.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetDirectoryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetDirectoryParams are the parameters for FileSystem.getDirectory
+// optional parameters are left out when nil
+type GetDirectoryParams struct {
+	BucketFileSystemLocator BucketFileSystemLocator `json:"bucketFileSystemLocator"`
+}
+
+// MarshalCDP writes GetDirectoryParams as JSON
+func (v *GetDirectoryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("bucketFileSystemLocator")
+	v.BucketFileSystemLocator.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetDirectoryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) GetDirectory(params GetDirectoryParams) (GetDirectoryReturns, error) {
+	return c.GetDirectoryContext(context.Background(), params)
+}
+
+// GetDirectoryContext is GetDirectory with a context for cancellation and deadlines
+func (c Client) GetDirectoryContext(ctx context.Context, params GetDirectoryParams) (GetDirectoryReturns, error) {
+	var returns_ GetDirectoryReturns
+
+	err_ := c.caller.Call(ctx, "FileSystem.getDirectory", &params, &returns_)
+
+	return returns_, err_
+}
@@ -0,0 +1,305 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package headlessexperimental is the HeadlessExperimental domain of the chrome devtools protocol
+//
+// This domain provides experimental commands only supported in headless mode.
+//
+// Experimental: this may change or be removed in any chrome release
+package headlessexperimental
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends HeadlessExperimental commands and listens for HeadlessExperimental events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Encoding options for a screenshot.
+type ScreenshotParams struct {
+	/* Image compression format (defaults to png). */
+	Format *ScreenshotParamsFormat `json:"format,omitempty"`
+	/* Compression quality from range [0..100] (jpeg and webp only). */
+	Quality *int `json:"quality,omitempty"`
+	/* Optimize image encoding for speed, not for resulting size (defaults to false) */
+	OptimizeForSpeed *bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// MarshalCDP writes ScreenshotParams as JSON
+func (v *ScreenshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Format != nil {
+		e.Key("format")
+		e.String(string(*v.Format))
+	}
+	if v.Quality != nil {
+		e.Key("quality")
+		e.Int(*v.Quality)
+	}
+	if v.OptimizeForSpeed != nil {
+		e.Key("optimizeForSpeed")
+		e.Bool(*v.OptimizeForSpeed)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScreenshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScreenshotParams from JSON
+func (v *ScreenshotParams) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "format":
+			if d.Null() {
+				v.Format = nil
+			} else {
+				v.Format = new(ScreenshotParamsFormat)
+				*v.Format = ScreenshotParamsFormat(d.String())
+			}
+		case "quality":
+			if d.Null() {
+				v.Quality = nil
+			} else {
+				v.Quality = new(int)
+				*v.Quality = d.Int()
+			}
+		case "optimizeForSpeed":
+			if d.Null() {
+				v.OptimizeForSpeed = nil
+			} else {
+				v.OptimizeForSpeed = new(bool)
+				*v.OptimizeForSpeed = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScreenshotParams) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScreenshotParamsFormat string
+
+// ScreenshotParamsFormat values
+const (
+	ScreenshotParamsFormatJpeg ScreenshotParamsFormat = "jpeg"
+	ScreenshotParamsFormatPng  ScreenshotParamsFormat = "png"
+	ScreenshotParamsFormatWebp ScreenshotParamsFormat = "webp"
+)
+
+// Values gives every ScreenshotParamsFormat
+func (ScreenshotParamsFormat) Values() []ScreenshotParamsFormat {
+	return []ScreenshotParamsFormat{
+		ScreenshotParamsFormatJpeg,
+		ScreenshotParamsFormatPng,
+		ScreenshotParamsFormatWebp,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ScreenshotParamsFormat) Valid() bool {
+	switch v {
+	case ScreenshotParamsFormatJpeg, ScreenshotParamsFormatPng, ScreenshotParamsFormatWebp:
+		return true
+	}
+	return false
+}
+
+type BeginFrameReturns struct {
+
+	/* Whether the BeginFrame resulted in damage and, thus, a new frame was committed to the
+	display. Reported for diagnostic uses, may be removed in the future. */
+	HasDamage bool `json:"hasDamage"`
+
+	/* Base64-encoded image data of the screenshot, if one was requested and successfully taken. (Encoded as a base64 string when passed over JSON) */
+	ScreenshotData *string `json:"screenshotData,omitempty"`
+}
+
+// UnmarshalCDP reads BeginFrameReturns from JSON
+func (v *BeginFrameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "hasDamage":
+			v.HasDamage = d.Bool()
+		case "screenshotData":
+			if d.Null() {
+				v.ScreenshotData = nil
+			} else {
+				v.ScreenshotData = new(string)
+				*v.ScreenshotData = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BeginFrameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// BeginFrameParams are the parameters for HeadlessExperimental.beginFrame
+// optional parameters are left out when nil
+type BeginFrameParams struct {
+	/* Timestamp of this BeginFrame in Renderer TimeTicks (milliseconds of uptime). If not set,
+	the current time will be used. */
+	FrameTimeTicks *float64 `json:"frameTimeTicks,omitempty"`
+	/* The interval between BeginFrames that is reported to the compositor, in milliseconds.
+	Defaults to a 60 frames/second interval, i.e. about 16.666 milliseconds. */
+	Interval *float64 `json:"interval,omitempty"`
+	/* Whether updates should not be committed and drawn onto the display. False by default. If
+	true, only side effects of the BeginFrame will be run, such as layout and animations, but
+	any visual updates may not be visible on the display or in screenshots. */
+	NoDisplayUpdates *bool `json:"noDisplayUpdates,omitempty"`
+	/* If set, a screenshot of the frame will be captured and returned in the response. Otherwise,
+	no screenshot will be captured. Note that capturing a screenshot can fail, for example,
+	during renderer initialization. In such a case, no screenshot data will be returned. */
+	Screenshot *ScreenshotParams `json:"screenshot,omitempty"`
+}
+
+// MarshalCDP writes BeginFrameParams as JSON
+func (v *BeginFrameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.FrameTimeTicks != nil {
+		e.Key("frameTimeTicks")
+		e.Float(*v.FrameTimeTicks)
+	}
+	if v.Interval != nil {
+		e.Key("interval")
+		e.Float(*v.Interval)
+	}
+	if v.NoDisplayUpdates != nil {
+		e.Key("noDisplayUpdates")
+		e.Bool(*v.NoDisplayUpdates)
+	}
+	if v.Screenshot != nil {
+		e.Key("screenshot")
+		(*v.Screenshot).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BeginFrameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sends a BeginFrame to the target and returns when the frame was completed. Optionally captures a
+// screenshot from the resulting frame. Requires that the target was created with enabled
+// BeginFrameControl. Designed for use with --run-all-compositor-stages-before-draw, see also
+// https://goo.gle/chrome-headless-rendering for more background.
+func (c Client) BeginFrame(params BeginFrameParams) (BeginFrameReturns, error) {
+	return c.BeginFrameContext(context.Background(), params)
+}
+
+// BeginFrameContext is BeginFrame with a context for cancellation and deadlines
+func (c Client) BeginFrameContext(ctx context.Context, params BeginFrameParams) (BeginFrameReturns, error) {
+	var returns_ BeginFrameReturns
+
+	err_ := c.caller.Call(ctx, "HeadlessExperimental.beginFrame", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables headless events for the target.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "HeadlessExperimental.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables headless events for the target.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "HeadlessExperimental.enable", nil, &returns_)
+
+	return returns_, err_
+}
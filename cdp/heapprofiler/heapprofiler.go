@@ -0,0 +1,1112 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package heapprofiler is the HeapProfiler domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package heapprofiler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends HeapProfiler commands and listens for HeapProfiler events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Heap snapshot object id.
+type HeapSnapshotObjectId string
+
+// Sampling Heap Profile node. Holds callsite information, allocation statistics and child nodes.
+type SamplingHeapProfileNode struct {
+	/* Function location. */
+	CallFrame cdp.RuntimeCallFrame `json:"callFrame"`
+	/* Allocations size in bytes for the node excluding children. */
+	SelfSize float64 `json:"selfSize"`
+	/* Node id. Ids are unique across all profiles collected between startSampling and stopSampling. */
+	Id int `json:"id"`
+	/* Child nodes. */
+	Children []SamplingHeapProfileNode `json:"children"`
+}
+
+// MarshalCDP writes SamplingHeapProfileNode as JSON
+func (v *SamplingHeapProfileNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("callFrame")
+	v.CallFrame.MarshalCDP(e)
+	e.Key("selfSize")
+	e.Float(v.SelfSize)
+	e.Key("id")
+	e.Int(v.Id)
+	e.Key("children")
+	if v.Children == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Children {
+			e.Elem()
+			v.Children[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SamplingHeapProfileNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SamplingHeapProfileNode from JSON
+func (v *SamplingHeapProfileNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "callFrame":
+			v.CallFrame.UnmarshalCDP(d)
+		case "selfSize":
+			v.SelfSize = d.Float()
+		case "id":
+			v.Id = d.Int()
+		case "children":
+			if d.Array() {
+				v.Children = make([]SamplingHeapProfileNode, 0)
+				for d.More() {
+					var x0 SamplingHeapProfileNode
+					x0.UnmarshalCDP(d)
+					v.Children = append(v.Children, x0)
+				}
+			} else {
+				v.Children = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SamplingHeapProfileNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A single sample from a sampling profile.
+type SamplingHeapProfileSample struct {
+	/* Allocation size in bytes attributed to the sample. */
+	Size float64 `json:"size"`
+	/* Id of the corresponding profile tree node. */
+	NodeId int `json:"nodeId"`
+	/* Time-ordered sample ordinal number. It is unique across all profiles retrieved
+	between startSampling and stopSampling. */
+	Ordinal float64 `json:"ordinal"`
+}
+
+// MarshalCDP writes SamplingHeapProfileSample as JSON
+func (v *SamplingHeapProfileSample) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("size")
+	e.Float(v.Size)
+	e.Key("nodeId")
+	e.Int(v.NodeId)
+	e.Key("ordinal")
+	e.Float(v.Ordinal)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SamplingHeapProfileSample) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SamplingHeapProfileSample from JSON
+func (v *SamplingHeapProfileSample) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "size":
+			v.Size = d.Float()
+		case "nodeId":
+			v.NodeId = d.Int()
+		case "ordinal":
+			v.Ordinal = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SamplingHeapProfileSample) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Sampling profile.
+type SamplingHeapProfile struct {
+	Head    SamplingHeapProfileNode     `json:"head"`
+	Samples []SamplingHeapProfileSample `json:"samples"`
+}
+
+// MarshalCDP writes SamplingHeapProfile as JSON
+func (v *SamplingHeapProfile) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("head")
+	v.Head.MarshalCDP(e)
+	e.Key("samples")
+	if v.Samples == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Samples {
+			e.Elem()
+			v.Samples[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SamplingHeapProfile) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SamplingHeapProfile from JSON
+func (v *SamplingHeapProfile) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "head":
+			v.Head.UnmarshalCDP(d)
+		case "samples":
+			if d.Array() {
+				v.Samples = make([]SamplingHeapProfileSample, 0)
+				for d.More() {
+					var x0 SamplingHeapProfileSample
+					x0.UnmarshalCDP(d)
+					v.Samples = append(v.Samples, x0)
+				}
+			} else {
+				v.Samples = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SamplingHeapProfile) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AddInspectedHeapObjectReturns struct {
+}
+
+// UnmarshalCDP reads AddInspectedHeapObjectReturns from JSON
+func (v *AddInspectedHeapObjectReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddInspectedHeapObjectReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddInspectedHeapObjectParams are the parameters for HeapProfiler.addInspectedHeapObject
+// optional parameters are left out when nil
+type AddInspectedHeapObjectParams struct {
+	/* Heap snapshot object id to be accessible by means of $x command line API. */
+	HeapObjectId HeapSnapshotObjectId `json:"heapObjectId"`
+}
+
+// MarshalCDP writes AddInspectedHeapObjectParams as JSON
+func (v *AddInspectedHeapObjectParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("heapObjectId")
+	e.String(string(v.HeapObjectId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddInspectedHeapObjectParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables console to refer to the node with given id via $x (see Command Line API for more details
+// $x functions).
+func (c Client) AddInspectedHeapObject(params AddInspectedHeapObjectParams) (AddInspectedHeapObjectReturns, error) {
+	return c.AddInspectedHeapObjectContext(context.Background(), params)
+}
+
+// AddInspectedHeapObjectContext is AddInspectedHeapObject with a context for cancellation and deadlines
+func (c Client) AddInspectedHeapObjectContext(ctx context.Context, params AddInspectedHeapObjectParams) (AddInspectedHeapObjectReturns, error) {
+	var returns_ AddInspectedHeapObjectReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.addInspectedHeapObject", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CollectGarbageReturns struct {
+}
+
+// UnmarshalCDP reads CollectGarbageReturns from JSON
+func (v *CollectGarbageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CollectGarbageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) CollectGarbage() (CollectGarbageReturns, error) {
+	return c.CollectGarbageContext(context.Background())
+}
+
+// CollectGarbageContext is CollectGarbage with a context for cancellation and deadlines
+func (c Client) CollectGarbageContext(ctx context.Context) (CollectGarbageReturns, error) {
+	var returns_ CollectGarbageReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.collectGarbage", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetHeapObjectIdReturns struct {
+
+	/* Id of the heap snapshot object corresponding to the passed remote object id. */
+	HeapSnapshotObjectId HeapSnapshotObjectId `json:"heapSnapshotObjectId"`
+}
+
+// UnmarshalCDP reads GetHeapObjectIdReturns from JSON
+func (v *GetHeapObjectIdReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "heapSnapshotObjectId":
+			v.HeapSnapshotObjectId = HeapSnapshotObjectId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetHeapObjectIdReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetHeapObjectIdParams are the parameters for HeapProfiler.getHeapObjectId
+// optional parameters are left out when nil
+type GetHeapObjectIdParams struct {
+	/* Identifier of the object to get heap object id for. */
+	ObjectId cdp.RuntimeRemoteObjectId `json:"objectId"`
+}
+
+// MarshalCDP writes GetHeapObjectIdParams as JSON
+func (v *GetHeapObjectIdParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetHeapObjectIdParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) GetHeapObjectId(params GetHeapObjectIdParams) (GetHeapObjectIdReturns, error) {
+	return c.GetHeapObjectIdContext(context.Background(), params)
+}
+
+// GetHeapObjectIdContext is GetHeapObjectId with a context for cancellation and deadlines
+func (c Client) GetHeapObjectIdContext(ctx context.Context, params GetHeapObjectIdParams) (GetHeapObjectIdReturns, error) {
+	var returns_ GetHeapObjectIdReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.getHeapObjectId", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetObjectByHeapObjectIdReturns struct {
+
+	/* Evaluation result. */
+	Result cdp.RuntimeRemoteObject `json:"result"`
+}
+
+// UnmarshalCDP reads GetObjectByHeapObjectIdReturns from JSON
+func (v *GetObjectByHeapObjectIdReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetObjectByHeapObjectIdReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetObjectByHeapObjectIdParams are the parameters for HeapProfiler.getObjectByHeapObjectId
+// optional parameters are left out when nil
+type GetObjectByHeapObjectIdParams struct {
+	ObjectId HeapSnapshotObjectId `json:"objectId"`
+	/* Symbolic group name that can be used to release multiple objects. */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+}
+
+// MarshalCDP writes GetObjectByHeapObjectIdParams as JSON
+func (v *GetObjectByHeapObjectIdParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetObjectByHeapObjectIdParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) GetObjectByHeapObjectId(params GetObjectByHeapObjectIdParams) (GetObjectByHeapObjectIdReturns, error) {
+	return c.GetObjectByHeapObjectIdContext(context.Background(), params)
+}
+
+// GetObjectByHeapObjectIdContext is GetObjectByHeapObjectId with a context for cancellation and deadlines
+func (c Client) GetObjectByHeapObjectIdContext(ctx context.Context, params GetObjectByHeapObjectIdParams) (GetObjectByHeapObjectIdReturns, error) {
+	var returns_ GetObjectByHeapObjectIdReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.getObjectByHeapObjectId", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetSamplingProfileReturns struct {
+
+	/* Return the sampling profile being collected. */
+	Profile SamplingHeapProfile `json:"profile"`
+}
+
+// UnmarshalCDP reads GetSamplingProfileReturns from JSON
+func (v *GetSamplingProfileReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSamplingProfileReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) GetSamplingProfile() (GetSamplingProfileReturns, error) {
+	return c.GetSamplingProfileContext(context.Background())
+}
+
+// GetSamplingProfileContext is GetSamplingProfile with a context for cancellation and deadlines
+func (c Client) GetSamplingProfileContext(ctx context.Context) (GetSamplingProfileReturns, error) {
+	var returns_ GetSamplingProfileReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.getSamplingProfile", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StartSamplingReturns struct {
+}
+
+// UnmarshalCDP reads StartSamplingReturns from JSON
+func (v *StartSamplingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartSamplingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartSamplingParams are the parameters for HeapProfiler.startSampling
+// optional parameters are left out when nil
+type StartSamplingParams struct {
+	/* Average sample interval in bytes. Poisson distribution is used for the intervals. The
+	default value is 32768 bytes. */
+	SamplingInterval *float64 `json:"samplingInterval,omitempty"`
+	/* By default, the sampling heap profiler reports only objects which are
+	still alive when the profile is returned via getSamplingProfile or
+	stopSampling, which is useful for determining what functions contribute
+	the most to steady-state memory usage. This flag instructs the sampling
+	heap profiler to also include information about objects discarded by
+	major GC, which will show which functions cause large temporary memory
+	usage or long GC pauses. */
+	IncludeObjectsCollectedByMajorGC *bool `json:"includeObjectsCollectedByMajorGC,omitempty"`
+	/* By default, the sampling heap profiler reports only objects which are
+	still alive when the profile is returned via getSamplingProfile or
+	stopSampling, which is useful for determining what functions contribute
+	the most to steady-state memory usage. This flag instructs the sampling
+	heap profiler to also include information about objects discarded by
+	minor GC, which is useful when tuning a latency-sensitive application
+	for minimal GC activity. */
+	IncludeObjectsCollectedByMinorGC *bool `json:"includeObjectsCollectedByMinorGC,omitempty"`
+}
+
+// MarshalCDP writes StartSamplingParams as JSON
+func (v *StartSamplingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SamplingInterval != nil {
+		e.Key("samplingInterval")
+		e.Float(*v.SamplingInterval)
+	}
+	if v.IncludeObjectsCollectedByMajorGC != nil {
+		e.Key("includeObjectsCollectedByMajorGC")
+		e.Bool(*v.IncludeObjectsCollectedByMajorGC)
+	}
+	if v.IncludeObjectsCollectedByMinorGC != nil {
+		e.Key("includeObjectsCollectedByMinorGC")
+		e.Bool(*v.IncludeObjectsCollectedByMinorGC)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartSamplingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) StartSampling(params StartSamplingParams) (StartSamplingReturns, error) {
+	return c.StartSamplingContext(context.Background(), params)
+}
+
+// StartSamplingContext is StartSampling with a context for cancellation and deadlines
+func (c Client) StartSamplingContext(ctx context.Context, params StartSamplingParams) (StartSamplingReturns, error) {
+	var returns_ StartSamplingReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.startSampling", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartTrackingHeapObjectsReturns struct {
+}
+
+// UnmarshalCDP reads StartTrackingHeapObjectsReturns from JSON
+func (v *StartTrackingHeapObjectsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartTrackingHeapObjectsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartTrackingHeapObjectsParams are the parameters for HeapProfiler.startTrackingHeapObjects
+// optional parameters are left out when nil
+type StartTrackingHeapObjectsParams struct {
+	TrackAllocations *bool `json:"trackAllocations,omitempty"`
+}
+
+// MarshalCDP writes StartTrackingHeapObjectsParams as JSON
+func (v *StartTrackingHeapObjectsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.TrackAllocations != nil {
+		e.Key("trackAllocations")
+		e.Bool(*v.TrackAllocations)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartTrackingHeapObjectsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) StartTrackingHeapObjects(params StartTrackingHeapObjectsParams) (StartTrackingHeapObjectsReturns, error) {
+	return c.StartTrackingHeapObjectsContext(context.Background(), params)
+}
+
+// StartTrackingHeapObjectsContext is StartTrackingHeapObjects with a context for cancellation and deadlines
+func (c Client) StartTrackingHeapObjectsContext(ctx context.Context, params StartTrackingHeapObjectsParams) (StartTrackingHeapObjectsReturns, error) {
+	var returns_ StartTrackingHeapObjectsReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.startTrackingHeapObjects", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopSamplingReturns struct {
+
+	/* Recorded sampling heap profile. */
+	Profile SamplingHeapProfile `json:"profile"`
+}
+
+// UnmarshalCDP reads StopSamplingReturns from JSON
+func (v *StopSamplingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopSamplingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) StopSampling() (StopSamplingReturns, error) {
+	return c.StopSamplingContext(context.Background())
+}
+
+// StopSamplingContext is StopSampling with a context for cancellation and deadlines
+func (c Client) StopSamplingContext(ctx context.Context) (StopSamplingReturns, error) {
+	var returns_ StopSamplingReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.stopSampling", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StopTrackingHeapObjectsReturns struct {
+}
+
+// UnmarshalCDP reads StopTrackingHeapObjectsReturns from JSON
+func (v *StopTrackingHeapObjectsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopTrackingHeapObjectsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StopTrackingHeapObjectsParams are the parameters for HeapProfiler.stopTrackingHeapObjects
+// optional parameters are left out when nil
+type StopTrackingHeapObjectsParams struct {
+	/* If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken
+	when the tracking is stopped. */
+	ReportProgress *bool `json:"reportProgress,omitempty"`
+	/* Deprecated in favor of `exposeInternals`.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	TreatGlobalObjectsAsRoots *bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	/* If true, numerical values are included in the snapshot */
+	CaptureNumericValue *bool `json:"captureNumericValue,omitempty"`
+	/* If true, exposes internals of the snapshot.
+
+	Experimental: this may change or be removed in any chrome release */
+	ExposeInternals *bool `json:"exposeInternals,omitempty"`
+}
+
+// MarshalCDP writes StopTrackingHeapObjectsParams as JSON
+func (v *StopTrackingHeapObjectsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ReportProgress != nil {
+		e.Key("reportProgress")
+		e.Bool(*v.ReportProgress)
+	}
+	if v.TreatGlobalObjectsAsRoots != nil {
+		e.Key("treatGlobalObjectsAsRoots")
+		e.Bool(*v.TreatGlobalObjectsAsRoots)
+	}
+	if v.CaptureNumericValue != nil {
+		e.Key("captureNumericValue")
+		e.Bool(*v.CaptureNumericValue)
+	}
+	if v.ExposeInternals != nil {
+		e.Key("exposeInternals")
+		e.Bool(*v.ExposeInternals)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StopTrackingHeapObjectsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) StopTrackingHeapObjects(params StopTrackingHeapObjectsParams) (StopTrackingHeapObjectsReturns, error) {
+	return c.StopTrackingHeapObjectsContext(context.Background(), params)
+}
+
+// StopTrackingHeapObjectsContext is StopTrackingHeapObjects with a context for cancellation and deadlines
+func (c Client) StopTrackingHeapObjectsContext(ctx context.Context, params StopTrackingHeapObjectsParams) (StopTrackingHeapObjectsReturns, error) {
+	var returns_ StopTrackingHeapObjectsReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.stopTrackingHeapObjects", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TakeHeapSnapshotReturns struct {
+}
+
+// UnmarshalCDP reads TakeHeapSnapshotReturns from JSON
+func (v *TakeHeapSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TakeHeapSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TakeHeapSnapshotParams are the parameters for HeapProfiler.takeHeapSnapshot
+// optional parameters are left out when nil
+type TakeHeapSnapshotParams struct {
+	/* If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken. */
+	ReportProgress *bool `json:"reportProgress,omitempty"`
+	/* If true, a raw snapshot without artificial roots will be generated.
+	Deprecated in favor of `exposeInternals`.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	TreatGlobalObjectsAsRoots *bool `json:"treatGlobalObjectsAsRoots,omitempty"`
+	/* If true, numerical values are included in the snapshot */
+	CaptureNumericValue *bool `json:"captureNumericValue,omitempty"`
+	/* If true, exposes internals of the snapshot.
+
+	Experimental: this may change or be removed in any chrome release */
+	ExposeInternals *bool `json:"exposeInternals,omitempty"`
+}
+
+// MarshalCDP writes TakeHeapSnapshotParams as JSON
+func (v *TakeHeapSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ReportProgress != nil {
+		e.Key("reportProgress")
+		e.Bool(*v.ReportProgress)
+	}
+	if v.TreatGlobalObjectsAsRoots != nil {
+		e.Key("treatGlobalObjectsAsRoots")
+		e.Bool(*v.TreatGlobalObjectsAsRoots)
+	}
+	if v.CaptureNumericValue != nil {
+		e.Key("captureNumericValue")
+		e.Bool(*v.CaptureNumericValue)
+	}
+	if v.ExposeInternals != nil {
+		e.Key("exposeInternals")
+		e.Bool(*v.ExposeInternals)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TakeHeapSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) TakeHeapSnapshot(params TakeHeapSnapshotParams) (TakeHeapSnapshotReturns, error) {
+	return c.TakeHeapSnapshotContext(context.Background(), params)
+}
+
+// TakeHeapSnapshotContext is TakeHeapSnapshot with a context for cancellation and deadlines
+func (c Client) TakeHeapSnapshotContext(ctx context.Context, params TakeHeapSnapshotParams) (TakeHeapSnapshotReturns, error) {
+	var returns_ TakeHeapSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "HeapProfiler.takeHeapSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type AddHeapSnapshotChunkEvent struct {
+	Chunk string `json:"chunk"`
+}
+
+// UnmarshalCDP reads AddHeapSnapshotChunkEvent from JSON
+func (v *AddHeapSnapshotChunkEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "chunk":
+			v.Chunk = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddHeapSnapshotChunkEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AddHeapSnapshotChunkHandler func(ev AddHeapSnapshotChunkEvent)
+
+// EventMethod is HeapProfiler.addHeapSnapshotChunk
+func (AddHeapSnapshotChunkEvent) EventMethod() string {
+	return "HeapProfiler.addHeapSnapshotChunk"
+}
+
+// OnAddHeapSnapshotChunk calls handler for each HeapProfiler.addHeapSnapshotChunk event
+func (c Client) OnAddHeapSnapshotChunk(handler AddHeapSnapshotChunkHandler) (unsubscribe func()) {
+	return c.caller.On("HeapProfiler.addHeapSnapshotChunk", func(ev interface{}) {
+		handler(ev.(AddHeapSnapshotChunkEvent))
+	})
+}
+
+// If heap objects tracking has been started then backend may send update for one or more fragments
+type HeapStatsUpdateEvent struct {
+
+	/* An array of triplets. Each triplet describes a fragment. The first integer is the fragment
+	index, the second integer is a total count of objects for the fragment, the third integer is
+	a total size of the objects for the fragment. */
+	StatsUpdate []int `json:"statsUpdate"`
+}
+
+// UnmarshalCDP reads HeapStatsUpdateEvent from JSON
+func (v *HeapStatsUpdateEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "statsUpdate":
+			if d.Array() {
+				v.StatsUpdate = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.StatsUpdate = append(v.StatsUpdate, x0)
+				}
+			} else {
+				v.StatsUpdate = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HeapStatsUpdateEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type HeapStatsUpdateHandler func(ev HeapStatsUpdateEvent)
+
+// EventMethod is HeapProfiler.heapStatsUpdate
+func (HeapStatsUpdateEvent) EventMethod() string {
+	return "HeapProfiler.heapStatsUpdate"
+}
+
+// OnHeapStatsUpdate calls handler for each HeapProfiler.heapStatsUpdate event
+func (c Client) OnHeapStatsUpdate(handler HeapStatsUpdateHandler) (unsubscribe func()) {
+	return c.caller.On("HeapProfiler.heapStatsUpdate", func(ev interface{}) {
+		handler(ev.(HeapStatsUpdateEvent))
+	})
+}
+
+// If heap objects tracking has been started then backend regularly sends a current value for last
+// seen object id and corresponding timestamp. If the were changes in the heap since last event
+// then one or more heapStatsUpdate events will be sent before a new lastSeenObjectId event.
+type LastSeenObjectIdEvent struct {
+	LastSeenObjectId int `json:"lastSeenObjectId"`
+
+	Timestamp float64 `json:"timestamp"`
+}
+
+// UnmarshalCDP reads LastSeenObjectIdEvent from JSON
+func (v *LastSeenObjectIdEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "lastSeenObjectId":
+			v.LastSeenObjectId = d.Int()
+		case "timestamp":
+			v.Timestamp = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LastSeenObjectIdEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LastSeenObjectIdHandler func(ev LastSeenObjectIdEvent)
+
+// EventMethod is HeapProfiler.lastSeenObjectId
+func (LastSeenObjectIdEvent) EventMethod() string {
+	return "HeapProfiler.lastSeenObjectId"
+}
+
+// OnLastSeenObjectId calls handler for each HeapProfiler.lastSeenObjectId event
+func (c Client) OnLastSeenObjectId(handler LastSeenObjectIdHandler) (unsubscribe func()) {
+	return c.caller.On("HeapProfiler.lastSeenObjectId", func(ev interface{}) {
+		handler(ev.(LastSeenObjectIdEvent))
+	})
+}
+
+type ReportHeapSnapshotProgressEvent struct {
+	Done int `json:"done"`
+
+	Total int `json:"total"`
+
+	Finished *bool `json:"finished,omitempty"`
+}
+
+// UnmarshalCDP reads ReportHeapSnapshotProgressEvent from JSON
+func (v *ReportHeapSnapshotProgressEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "done":
+			v.Done = d.Int()
+		case "total":
+			v.Total = d.Int()
+		case "finished":
+			if d.Null() {
+				v.Finished = nil
+			} else {
+				v.Finished = new(bool)
+				*v.Finished = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReportHeapSnapshotProgressEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ReportHeapSnapshotProgressHandler func(ev ReportHeapSnapshotProgressEvent)
+
+// EventMethod is HeapProfiler.reportHeapSnapshotProgress
+func (ReportHeapSnapshotProgressEvent) EventMethod() string {
+	return "HeapProfiler.reportHeapSnapshotProgress"
+}
+
+// OnReportHeapSnapshotProgress calls handler for each HeapProfiler.reportHeapSnapshotProgress event
+func (c Client) OnReportHeapSnapshotProgress(handler ReportHeapSnapshotProgressHandler) (unsubscribe func()) {
+	return c.caller.On("HeapProfiler.reportHeapSnapshotProgress", func(ev interface{}) {
+		handler(ev.(ReportHeapSnapshotProgressEvent))
+	})
+}
+
+type ResetProfilesEvent struct {
+}
+
+// UnmarshalCDP reads ResetProfilesEvent from JSON
+func (v *ResetProfilesEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResetProfilesEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ResetProfilesHandler func(ev ResetProfilesEvent)
+
+// EventMethod is HeapProfiler.resetProfiles
+func (ResetProfilesEvent) EventMethod() string {
+	return "HeapProfiler.resetProfiles"
+}
+
+// OnResetProfiles calls handler for each HeapProfiler.resetProfiles event
+func (c Client) OnResetProfiles(handler ResetProfilesHandler) (unsubscribe func()) {
+	return c.caller.On("HeapProfiler.resetProfiles", func(ev interface{}) {
+		handler(ev.(ResetProfilesEvent))
+	})
+}
+
+// decoders for HeapProfiler events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"HeapProfiler.addHeapSnapshotChunk":       cdp.Decode[AddHeapSnapshotChunkEvent],
+	"HeapProfiler.heapStatsUpdate":            cdp.Decode[HeapStatsUpdateEvent],
+	"HeapProfiler.lastSeenObjectId":           cdp.Decode[LastSeenObjectIdEvent],
+	"HeapProfiler.reportHeapSnapshotProgress": cdp.Decode[ReportHeapSnapshotProgressEvent],
+	"HeapProfiler.resetProfiles":              cdp.Decode[ResetProfilesEvent],
+}
+
+// DecodeEvent decodes a HeapProfiler event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
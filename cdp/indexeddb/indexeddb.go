@@ -0,0 +1,1250 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package indexeddb is the IndexedDB domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package indexeddb
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends IndexedDB commands and listens for IndexedDB events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Database with an array of object stores.
+type DatabaseWithObjectStores struct {
+	/* Database name. */
+	Name string `json:"name"`
+	/* Database version (type is not 'integer', as the standard
+	requires the version number to be 'unsigned long long') */
+	Version float64 `json:"version"`
+	/* Object stores in this database. */
+	ObjectStores []ObjectStore `json:"objectStores"`
+}
+
+// MarshalCDP writes DatabaseWithObjectStores as JSON
+func (v *DatabaseWithObjectStores) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("version")
+	e.Float(v.Version)
+	e.Key("objectStores")
+	if v.ObjectStores == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ObjectStores {
+			e.Elem()
+			v.ObjectStores[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DatabaseWithObjectStores) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DatabaseWithObjectStores from JSON
+func (v *DatabaseWithObjectStores) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "version":
+			v.Version = d.Float()
+		case "objectStores":
+			if d.Array() {
+				v.ObjectStores = make([]ObjectStore, 0)
+				for d.More() {
+					var x0 ObjectStore
+					x0.UnmarshalCDP(d)
+					v.ObjectStores = append(v.ObjectStores, x0)
+				}
+			} else {
+				v.ObjectStores = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DatabaseWithObjectStores) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Object store.
+type ObjectStore struct {
+	/* Object store name. */
+	Name string `json:"name"`
+	/* Object store key path. */
+	KeyPath KeyPath `json:"keyPath"`
+	/* If true, object store has auto increment flag set. */
+	AutoIncrement bool `json:"autoIncrement"`
+	/* Indexes in this object store. */
+	Indexes []ObjectStoreIndex `json:"indexes"`
+}
+
+// MarshalCDP writes ObjectStore as JSON
+func (v *ObjectStore) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("keyPath")
+	v.KeyPath.MarshalCDP(e)
+	e.Key("autoIncrement")
+	e.Bool(v.AutoIncrement)
+	e.Key("indexes")
+	if v.Indexes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Indexes {
+			e.Elem()
+			v.Indexes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ObjectStore) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ObjectStore from JSON
+func (v *ObjectStore) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "keyPath":
+			v.KeyPath.UnmarshalCDP(d)
+		case "autoIncrement":
+			v.AutoIncrement = d.Bool()
+		case "indexes":
+			if d.Array() {
+				v.Indexes = make([]ObjectStoreIndex, 0)
+				for d.More() {
+					var x0 ObjectStoreIndex
+					x0.UnmarshalCDP(d)
+					v.Indexes = append(v.Indexes, x0)
+				}
+			} else {
+				v.Indexes = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ObjectStore) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Object store index.
+type ObjectStoreIndex struct {
+	/* Index name. */
+	Name string `json:"name"`
+	/* Index key path. */
+	KeyPath KeyPath `json:"keyPath"`
+	/* If true, index is unique. */
+	Unique bool `json:"unique"`
+	/* If true, index allows multiple entries for a key. */
+	MultiEntry bool `json:"multiEntry"`
+}
+
+// MarshalCDP writes ObjectStoreIndex as JSON
+func (v *ObjectStoreIndex) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("keyPath")
+	v.KeyPath.MarshalCDP(e)
+	e.Key("unique")
+	e.Bool(v.Unique)
+	e.Key("multiEntry")
+	e.Bool(v.MultiEntry)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ObjectStoreIndex) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ObjectStoreIndex from JSON
+func (v *ObjectStoreIndex) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "keyPath":
+			v.KeyPath.UnmarshalCDP(d)
+		case "unique":
+			v.Unique = d.Bool()
+		case "multiEntry":
+			v.MultiEntry = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ObjectStoreIndex) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Key.
+type Key struct {
+	/* Key type. */
+	Type KeyType `json:"type"`
+	/* Number value. */
+	Number *float64 `json:"number,omitempty"`
+	/* String value. */
+	String *string `json:"string,omitempty"`
+	/* Date value. */
+	Date *float64 `json:"date,omitempty"`
+	/* Array value. */
+	Array []Key `json:"array"`
+}
+
+// MarshalCDP writes Key as JSON
+func (v *Key) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Number != nil {
+		e.Key("number")
+		e.Float(*v.Number)
+	}
+	if v.String != nil {
+		e.Key("string")
+		e.String(*v.String)
+	}
+	if v.Date != nil {
+		e.Key("date")
+		e.Float(*v.Date)
+	}
+	if v.Array != nil {
+		e.Key("array")
+		e.ArrayStart()
+		for i0 := range v.Array {
+			e.Elem()
+			v.Array[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Key) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Key from JSON
+func (v *Key) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = KeyType(d.String())
+		case "number":
+			if d.Null() {
+				v.Number = nil
+			} else {
+				v.Number = new(float64)
+				*v.Number = d.Float()
+			}
+		case "string":
+			if d.Null() {
+				v.String = nil
+			} else {
+				v.String = new(string)
+				*v.String = d.String()
+			}
+		case "date":
+			if d.Null() {
+				v.Date = nil
+			} else {
+				v.Date = new(float64)
+				*v.Date = d.Float()
+			}
+		case "array":
+			if d.Array() {
+				v.Array = make([]Key, 0)
+				for d.More() {
+					var x0 Key
+					x0.UnmarshalCDP(d)
+					v.Array = append(v.Array, x0)
+				}
+			} else {
+				v.Array = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Key) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type KeyType string
+
+// KeyType values
+const (
+	KeyTypeNumber KeyType = "number"
+	KeyTypeString KeyType = "string"
+	KeyTypeDate   KeyType = "date"
+	KeyTypeArray  KeyType = "array"
+)
+
+// Values gives every KeyType
+func (KeyType) Values() []KeyType {
+	return []KeyType{
+		KeyTypeNumber,
+		KeyTypeString,
+		KeyTypeDate,
+		KeyTypeArray,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v KeyType) Valid() bool {
+	switch v {
+	case KeyTypeNumber, KeyTypeString, KeyTypeDate, KeyTypeArray:
+		return true
+	}
+	return false
+}
+
+// Key range.
+type KeyRange struct {
+	/* Lower bound. */
+	Lower *Key `json:"lower,omitempty"`
+	/* Upper bound. */
+	Upper *Key `json:"upper,omitempty"`
+	/* If true lower bound is open. */
+	LowerOpen bool `json:"lowerOpen"`
+	/* If true upper bound is open. */
+	UpperOpen bool `json:"upperOpen"`
+}
+
+// MarshalCDP writes KeyRange as JSON
+func (v *KeyRange) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Lower != nil {
+		e.Key("lower")
+		(*v.Lower).MarshalCDP(e)
+	}
+	if v.Upper != nil {
+		e.Key("upper")
+		(*v.Upper).MarshalCDP(e)
+	}
+	e.Key("lowerOpen")
+	e.Bool(v.LowerOpen)
+	e.Key("upperOpen")
+	e.Bool(v.UpperOpen)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v KeyRange) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads KeyRange from JSON
+func (v *KeyRange) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "lower":
+			if d.Null() {
+				v.Lower = nil
+			} else {
+				v.Lower = new(Key)
+				(*v.Lower).UnmarshalCDP(d)
+			}
+		case "upper":
+			if d.Null() {
+				v.Upper = nil
+			} else {
+				v.Upper = new(Key)
+				(*v.Upper).UnmarshalCDP(d)
+			}
+		case "lowerOpen":
+			v.LowerOpen = d.Bool()
+		case "upperOpen":
+			v.UpperOpen = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *KeyRange) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Data entry.
+type DataEntry struct {
+	/* Key object. */
+	Key cdp.RuntimeRemoteObject `json:"key"`
+	/* Primary key object. */
+	PrimaryKey cdp.RuntimeRemoteObject `json:"primaryKey"`
+	/* Value object. */
+	Value cdp.RuntimeRemoteObject `json:"value"`
+}
+
+// MarshalCDP writes DataEntry as JSON
+func (v *DataEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	v.Key.MarshalCDP(e)
+	e.Key("primaryKey")
+	v.PrimaryKey.MarshalCDP(e)
+	e.Key("value")
+	v.Value.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DataEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DataEntry from JSON
+func (v *DataEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key.UnmarshalCDP(d)
+		case "primaryKey":
+			v.PrimaryKey.UnmarshalCDP(d)
+		case "value":
+			v.Value.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DataEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Key path.
+type KeyPath struct {
+	/* Key path type. */
+	Type KeyPathType `json:"type"`
+	/* String value. */
+	String *string `json:"string,omitempty"`
+	/* Array value. */
+	Array []string `json:"array"`
+}
+
+// MarshalCDP writes KeyPath as JSON
+func (v *KeyPath) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.String != nil {
+		e.Key("string")
+		e.String(*v.String)
+	}
+	if v.Array != nil {
+		e.Key("array")
+		e.ArrayStart()
+		for i0 := range v.Array {
+			e.Elem()
+			e.String(v.Array[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v KeyPath) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads KeyPath from JSON
+func (v *KeyPath) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = KeyPathType(d.String())
+		case "string":
+			if d.Null() {
+				v.String = nil
+			} else {
+				v.String = new(string)
+				*v.String = d.String()
+			}
+		case "array":
+			if d.Array() {
+				v.Array = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Array = append(v.Array, x0)
+				}
+			} else {
+				v.Array = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *KeyPath) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type KeyPathType string
+
+// KeyPathType values
+const (
+	KeyPathTypeNull   KeyPathType = "null"
+	KeyPathTypeString KeyPathType = "string"
+	KeyPathTypeArray  KeyPathType = "array"
+)
+
+// Values gives every KeyPathType
+func (KeyPathType) Values() []KeyPathType {
+	return []KeyPathType{
+		KeyPathTypeNull,
+		KeyPathTypeString,
+		KeyPathTypeArray,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v KeyPathType) Valid() bool {
+	switch v {
+	case KeyPathTypeNull, KeyPathTypeString, KeyPathTypeArray:
+		return true
+	}
+	return false
+}
+
+type ClearObjectStoreReturns struct {
+}
+
+// UnmarshalCDP reads ClearObjectStoreReturns from JSON
+func (v *ClearObjectStoreReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearObjectStoreReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearObjectStoreParams are the parameters for IndexedDB.clearObjectStore
+// optional parameters are left out when nil
+type ClearObjectStoreParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	/* Database name. */
+	DatabaseName string `json:"databaseName"`
+	/* Object store name. */
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// MarshalCDP writes ClearObjectStoreParams as JSON
+func (v *ClearObjectStoreParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("databaseName")
+	e.String(v.DatabaseName)
+	e.Key("objectStoreName")
+	e.String(v.ObjectStoreName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearObjectStoreParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears all entries from an object store.
+func (c Client) ClearObjectStore(params ClearObjectStoreParams) (ClearObjectStoreReturns, error) {
+	return c.ClearObjectStoreContext(context.Background(), params)
+}
+
+// ClearObjectStoreContext is ClearObjectStore with a context for cancellation and deadlines
+func (c Client) ClearObjectStoreContext(ctx context.Context, params ClearObjectStoreParams) (ClearObjectStoreReturns, error) {
+	var returns_ ClearObjectStoreReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.clearObjectStore", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteDatabaseReturns struct {
+}
+
+// UnmarshalCDP reads DeleteDatabaseReturns from JSON
+func (v *DeleteDatabaseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteDatabaseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteDatabaseParams are the parameters for IndexedDB.deleteDatabase
+// optional parameters are left out when nil
+type DeleteDatabaseParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	/* Database name. */
+	DatabaseName string `json:"databaseName"`
+}
+
+// MarshalCDP writes DeleteDatabaseParams as JSON
+func (v *DeleteDatabaseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("databaseName")
+	e.String(v.DatabaseName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteDatabaseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes a database.
+func (c Client) DeleteDatabase(params DeleteDatabaseParams) (DeleteDatabaseReturns, error) {
+	return c.DeleteDatabaseContext(context.Background(), params)
+}
+
+// DeleteDatabaseContext is DeleteDatabase with a context for cancellation and deadlines
+func (c Client) DeleteDatabaseContext(ctx context.Context, params DeleteDatabaseParams) (DeleteDatabaseReturns, error) {
+	var returns_ DeleteDatabaseReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.deleteDatabase", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteObjectStoreEntriesReturns struct {
+}
+
+// UnmarshalCDP reads DeleteObjectStoreEntriesReturns from JSON
+func (v *DeleteObjectStoreEntriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteObjectStoreEntriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteObjectStoreEntriesParams are the parameters for IndexedDB.deleteObjectStoreEntries
+// optional parameters are left out when nil
+type DeleteObjectStoreEntriesParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket   *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	DatabaseName    string                    `json:"databaseName"`
+	ObjectStoreName string                    `json:"objectStoreName"`
+	/* Range of entry keys to delete */
+	KeyRange KeyRange `json:"keyRange"`
+}
+
+// MarshalCDP writes DeleteObjectStoreEntriesParams as JSON
+func (v *DeleteObjectStoreEntriesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("databaseName")
+	e.String(v.DatabaseName)
+	e.Key("objectStoreName")
+	e.String(v.ObjectStoreName)
+	e.Key("keyRange")
+	v.KeyRange.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteObjectStoreEntriesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Delete a range of entries from an object store
+func (c Client) DeleteObjectStoreEntries(params DeleteObjectStoreEntriesParams) (DeleteObjectStoreEntriesReturns, error) {
+	return c.DeleteObjectStoreEntriesContext(context.Background(), params)
+}
+
+// DeleteObjectStoreEntriesContext is DeleteObjectStoreEntries with a context for cancellation and deadlines
+func (c Client) DeleteObjectStoreEntriesContext(ctx context.Context, params DeleteObjectStoreEntriesParams) (DeleteObjectStoreEntriesReturns, error) {
+	var returns_ DeleteObjectStoreEntriesReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.deleteObjectStoreEntries", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables events from backend.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables events from backend.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type RequestDataReturns struct {
+
+	/* Array of object store data entries. */
+	ObjectStoreDataEntries []DataEntry `json:"objectStoreDataEntries"`
+
+	/* If true, there are more entries to fetch in the given range. */
+	HasMore bool `json:"hasMore"`
+}
+
+// UnmarshalCDP reads RequestDataReturns from JSON
+func (v *RequestDataReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "objectStoreDataEntries":
+			if d.Array() {
+				v.ObjectStoreDataEntries = make([]DataEntry, 0)
+				for d.More() {
+					var x0 DataEntry
+					x0.UnmarshalCDP(d)
+					v.ObjectStoreDataEntries = append(v.ObjectStoreDataEntries, x0)
+				}
+			} else {
+				v.ObjectStoreDataEntries = nil
+			}
+		case "hasMore":
+			v.HasMore = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestDataReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestDataParams are the parameters for IndexedDB.requestData
+// optional parameters are left out when nil
+type RequestDataParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	/* Database name. */
+	DatabaseName string `json:"databaseName"`
+	/* Object store name. */
+	ObjectStoreName string `json:"objectStoreName"`
+	/* Index name, empty string for object store data requests. */
+	IndexName string `json:"indexName"`
+	/* Number of records to skip. */
+	SkipCount int `json:"skipCount"`
+	/* Number of records to fetch. */
+	PageSize int `json:"pageSize"`
+	/* Key range. */
+	KeyRange *KeyRange `json:"keyRange,omitempty"`
+}
+
+// MarshalCDP writes RequestDataParams as JSON
+func (v *RequestDataParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("databaseName")
+	e.String(v.DatabaseName)
+	e.Key("objectStoreName")
+	e.String(v.ObjectStoreName)
+	e.Key("indexName")
+	e.String(v.IndexName)
+	e.Key("skipCount")
+	e.Int(v.SkipCount)
+	e.Key("pageSize")
+	e.Int(v.PageSize)
+	if v.KeyRange != nil {
+		e.Key("keyRange")
+		(*v.KeyRange).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestDataParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests data from object store or index.
+func (c Client) RequestData(params RequestDataParams) (RequestDataReturns, error) {
+	return c.RequestDataContext(context.Background(), params)
+}
+
+// RequestDataContext is RequestData with a context for cancellation and deadlines
+func (c Client) RequestDataContext(ctx context.Context, params RequestDataParams) (RequestDataReturns, error) {
+	var returns_ RequestDataReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.requestData", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetMetadataReturns struct {
+
+	/* the entries count */
+	EntriesCount float64 `json:"entriesCount"`
+
+	/* the current value of key generator, to become the next inserted
+	key into the object store. Valid if objectStore.autoIncrement
+	is true. */
+	KeyGeneratorValue float64 `json:"keyGeneratorValue"`
+}
+
+// UnmarshalCDP reads GetMetadataReturns from JSON
+func (v *GetMetadataReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "entriesCount":
+			v.EntriesCount = d.Float()
+		case "keyGeneratorValue":
+			v.KeyGeneratorValue = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetMetadataReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetMetadataParams are the parameters for IndexedDB.getMetadata
+// optional parameters are left out when nil
+type GetMetadataParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	/* Database name. */
+	DatabaseName string `json:"databaseName"`
+	/* Object store name. */
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// MarshalCDP writes GetMetadataParams as JSON
+func (v *GetMetadataParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("databaseName")
+	e.String(v.DatabaseName)
+	e.Key("objectStoreName")
+	e.String(v.ObjectStoreName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetMetadataParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets metadata of an object store.
+func (c Client) GetMetadata(params GetMetadataParams) (GetMetadataReturns, error) {
+	return c.GetMetadataContext(context.Background(), params)
+}
+
+// GetMetadataContext is GetMetadata with a context for cancellation and deadlines
+func (c Client) GetMetadataContext(ctx context.Context, params GetMetadataParams) (GetMetadataReturns, error) {
+	var returns_ GetMetadataReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.getMetadata", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestDatabaseReturns struct {
+
+	/* Database with an array of object stores. */
+	DatabaseWithObjectStores DatabaseWithObjectStores `json:"databaseWithObjectStores"`
+}
+
+// UnmarshalCDP reads RequestDatabaseReturns from JSON
+func (v *RequestDatabaseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "databaseWithObjectStores":
+			v.DatabaseWithObjectStores.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestDatabaseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestDatabaseParams are the parameters for IndexedDB.requestDatabase
+// optional parameters are left out when nil
+type RequestDatabaseParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+	/* Database name. */
+	DatabaseName string `json:"databaseName"`
+}
+
+// MarshalCDP writes RequestDatabaseParams as JSON
+func (v *RequestDatabaseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.Key("databaseName")
+	e.String(v.DatabaseName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestDatabaseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests database with given name in given frame.
+func (c Client) RequestDatabase(params RequestDatabaseParams) (RequestDatabaseReturns, error) {
+	return c.RequestDatabaseContext(context.Background(), params)
+}
+
+// RequestDatabaseContext is RequestDatabase with a context for cancellation and deadlines
+func (c Client) RequestDatabaseContext(ctx context.Context, params RequestDatabaseParams) (RequestDatabaseReturns, error) {
+	var returns_ RequestDatabaseReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.requestDatabase", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestDatabaseNamesReturns struct {
+
+	/* Database names for origin. */
+	DatabaseNames []string `json:"databaseNames"`
+}
+
+// UnmarshalCDP reads RequestDatabaseNamesReturns from JSON
+func (v *RequestDatabaseNamesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "databaseNames":
+			if d.Array() {
+				v.DatabaseNames = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.DatabaseNames = append(v.DatabaseNames, x0)
+				}
+			} else {
+				v.DatabaseNames = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestDatabaseNamesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestDatabaseNamesParams are the parameters for IndexedDB.requestDatabaseNames
+// optional parameters are left out when nil
+type RequestDatabaseNamesParams struct {
+	/* At least and at most one of securityOrigin, storageKey, or storageBucket must be specified.
+	Security origin. */
+	SecurityOrigin *string `json:"securityOrigin,omitempty"`
+	/* Storage key. */
+	StorageKey *string `json:"storageKey,omitempty"`
+	/* Storage bucket. If not specified, it uses the default bucket. */
+	StorageBucket *cdp.StorageStorageBucket `json:"storageBucket,omitempty"`
+}
+
+// MarshalCDP writes RequestDatabaseNamesParams as JSON
+func (v *RequestDatabaseNamesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SecurityOrigin != nil {
+		e.Key("securityOrigin")
+		e.String(*v.SecurityOrigin)
+	}
+	if v.StorageKey != nil {
+		e.Key("storageKey")
+		e.String(*v.StorageKey)
+	}
+	if v.StorageBucket != nil {
+		e.Key("storageBucket")
+		(*v.StorageBucket).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestDatabaseNamesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests database names for given security origin.
+func (c Client) RequestDatabaseNames(params RequestDatabaseNamesParams) (RequestDatabaseNamesReturns, error) {
+	return c.RequestDatabaseNamesContext(context.Background(), params)
+}
+
+// RequestDatabaseNamesContext is RequestDatabaseNames with a context for cancellation and deadlines
+func (c Client) RequestDatabaseNamesContext(ctx context.Context, params RequestDatabaseNamesParams) (RequestDatabaseNamesReturns, error) {
+	var returns_ RequestDatabaseNamesReturns
+
+	err_ := c.caller.Call(ctx, "IndexedDB.requestDatabaseNames", &params, &returns_)
+
+	return returns_, err_
+}
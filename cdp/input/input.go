@@ -0,0 +1,1090 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package input is the Input domain of the chrome devtools protocol
+package input
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Input commands and listens for Input events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type TouchPoint struct {
+	/* X coordinate of the event relative to the main frame's viewport in CSS pixels. */
+	X float64 `json:"x"`
+	/* Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to
+	the top of the viewport and Y increases as it proceeds towards the bottom of the viewport. */
+	Y float64 `json:"y"`
+	/* X radius of the touch area (default: 1.0). */
+	RadiusX *float64 `json:"radiusX,omitempty"`
+	/* Y radius of the touch area (default: 1.0). */
+	RadiusY *float64 `json:"radiusY,omitempty"`
+	/* Rotation angle (default: 0.0). */
+	RotationAngle *float64 `json:"rotationAngle,omitempty"`
+	/* Force (default: 1.0). */
+	Force *float64 `json:"force,omitempty"`
+	/* The normalized tangential pressure, which has a range of [-1,1] (default: 0).
+
+	Experimental: this may change or be removed in any chrome release */
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+	/* The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0) */
+	TiltX *float64 `json:"tiltX,omitempty"`
+	/* The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0). */
+	TiltY *float64 `json:"tiltY,omitempty"`
+	/* The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).
+
+	Experimental: this may change or be removed in any chrome release */
+	Twist *int `json:"twist,omitempty"`
+	/* Identifier used to track touch sources between events, must be unique within an event. */
+	Id *float64 `json:"id,omitempty"`
+}
+
+// MarshalCDP writes TouchPoint as JSON
+func (v *TouchPoint) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	if v.RadiusX != nil {
+		e.Key("radiusX")
+		e.Float(*v.RadiusX)
+	}
+	if v.RadiusY != nil {
+		e.Key("radiusY")
+		e.Float(*v.RadiusY)
+	}
+	if v.RotationAngle != nil {
+		e.Key("rotationAngle")
+		e.Float(*v.RotationAngle)
+	}
+	if v.Force != nil {
+		e.Key("force")
+		e.Float(*v.Force)
+	}
+	if v.TangentialPressure != nil {
+		e.Key("tangentialPressure")
+		e.Float(*v.TangentialPressure)
+	}
+	if v.TiltX != nil {
+		e.Key("tiltX")
+		e.Float(*v.TiltX)
+	}
+	if v.TiltY != nil {
+		e.Key("tiltY")
+		e.Float(*v.TiltY)
+	}
+	if v.Twist != nil {
+		e.Key("twist")
+		e.Int(*v.Twist)
+	}
+	if v.Id != nil {
+		e.Key("id")
+		e.Float(*v.Id)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TouchPoint) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads TouchPoint from JSON
+func (v *TouchPoint) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			v.X = d.Float()
+		case "y":
+			v.Y = d.Float()
+		case "radiusX":
+			if d.Null() {
+				v.RadiusX = nil
+			} else {
+				v.RadiusX = new(float64)
+				*v.RadiusX = d.Float()
+			}
+		case "radiusY":
+			if d.Null() {
+				v.RadiusY = nil
+			} else {
+				v.RadiusY = new(float64)
+				*v.RadiusY = d.Float()
+			}
+		case "rotationAngle":
+			if d.Null() {
+				v.RotationAngle = nil
+			} else {
+				v.RotationAngle = new(float64)
+				*v.RotationAngle = d.Float()
+			}
+		case "force":
+			if d.Null() {
+				v.Force = nil
+			} else {
+				v.Force = new(float64)
+				*v.Force = d.Float()
+			}
+		case "tangentialPressure":
+			if d.Null() {
+				v.TangentialPressure = nil
+			} else {
+				v.TangentialPressure = new(float64)
+				*v.TangentialPressure = d.Float()
+			}
+		case "tiltX":
+			if d.Null() {
+				v.TiltX = nil
+			} else {
+				v.TiltX = new(float64)
+				*v.TiltX = d.Float()
+			}
+		case "tiltY":
+			if d.Null() {
+				v.TiltY = nil
+			} else {
+				v.TiltY = new(float64)
+				*v.TiltY = d.Float()
+			}
+		case "twist":
+			if d.Null() {
+				v.Twist = nil
+			} else {
+				v.Twist = new(int)
+				*v.Twist = d.Int()
+			}
+		case "id":
+			if d.Null() {
+				v.Id = nil
+			} else {
+				v.Id = new(float64)
+				*v.Id = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TouchPoint) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type GestureSourceType string
+
+// GestureSourceType values
+const (
+	GestureSourceTypeDefault GestureSourceType = "default"
+	GestureSourceTypeTouch   GestureSourceType = "touch"
+	GestureSourceTypeMouse   GestureSourceType = "mouse"
+)
+
+// Values gives every GestureSourceType
+func (GestureSourceType) Values() []GestureSourceType {
+	return []GestureSourceType{
+		GestureSourceTypeDefault,
+		GestureSourceTypeTouch,
+		GestureSourceTypeMouse,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v GestureSourceType) Valid() bool {
+	switch v {
+	case GestureSourceTypeDefault, GestureSourceTypeTouch, GestureSourceTypeMouse:
+		return true
+	}
+	return false
+}
+
+type MouseButton string
+
+// MouseButton values
+const (
+	MouseButtonNone    MouseButton = "none"
+	MouseButtonLeft    MouseButton = "left"
+	MouseButtonMiddle  MouseButton = "middle"
+	MouseButtonRight   MouseButton = "right"
+	MouseButtonBack    MouseButton = "back"
+	MouseButtonForward MouseButton = "forward"
+)
+
+// Values gives every MouseButton
+func (MouseButton) Values() []MouseButton {
+	return []MouseButton{
+		MouseButtonNone,
+		MouseButtonLeft,
+		MouseButtonMiddle,
+		MouseButtonRight,
+		MouseButtonBack,
+		MouseButtonForward,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v MouseButton) Valid() bool {
+	switch v {
+	case MouseButtonNone, MouseButtonLeft, MouseButtonMiddle, MouseButtonRight, MouseButtonBack, MouseButtonForward:
+		return true
+	}
+	return false
+}
+
+// UTC time in seconds, counted from January 1, 1970.
+type TimeSinceEpoch float64
+
+// Experimental: this may change or be removed in any chrome release
+type DragDataItem struct {
+	/* Mime type of the dragged data. */
+	MimeType string `json:"mimeType"`
+	/* Depending of the value of `mimeType`, it contains the dragged link,
+	text, HTML markup or any other data. */
+	Data string `json:"data"`
+	/* Title associated with a link. Only valid when `mimeType` == "text/uri-list". */
+	Title *string `json:"title,omitempty"`
+	/* Stores the base URL for the contained markup. Only valid when `mimeType`
+	== "text/html". */
+	BaseURL *string `json:"baseURL,omitempty"`
+}
+
+// MarshalCDP writes DragDataItem as JSON
+func (v *DragDataItem) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("mimeType")
+	e.String(v.MimeType)
+	e.Key("data")
+	e.String(v.Data)
+	if v.Title != nil {
+		e.Key("title")
+		e.String(*v.Title)
+	}
+	if v.BaseURL != nil {
+		e.Key("baseURL")
+		e.String(*v.BaseURL)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DragDataItem) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DragDataItem from JSON
+func (v *DragDataItem) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "mimeType":
+			v.MimeType = d.String()
+		case "data":
+			v.Data = d.String()
+		case "title":
+			if d.Null() {
+				v.Title = nil
+			} else {
+				v.Title = new(string)
+				*v.Title = d.String()
+			}
+		case "baseURL":
+			if d.Null() {
+				v.BaseURL = nil
+			} else {
+				v.BaseURL = new(string)
+				*v.BaseURL = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DragDataItem) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type DragData struct {
+	Items []DragDataItem `json:"items"`
+	/* List of filenames that should be included when dropping */
+	Files []string `json:"files"`
+	/* Bit field representing allowed drag operations. Copy = 1, Link = 2, Move = 16 */
+	DragOperationsMask int `json:"dragOperationsMask"`
+}
+
+// MarshalCDP writes DragData as JSON
+func (v *DragData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("items")
+	if v.Items == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Items {
+			e.Elem()
+			v.Items[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Files != nil {
+		e.Key("files")
+		e.ArrayStart()
+		for i0 := range v.Files {
+			e.Elem()
+			e.String(v.Files[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("dragOperationsMask")
+	e.Int(v.DragOperationsMask)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DragData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DragData from JSON
+func (v *DragData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "items":
+			if d.Array() {
+				v.Items = make([]DragDataItem, 0)
+				for d.More() {
+					var x0 DragDataItem
+					x0.UnmarshalCDP(d)
+					v.Items = append(v.Items, x0)
+				}
+			} else {
+				v.Items = nil
+			}
+		case "files":
+			if d.Array() {
+				v.Files = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Files = append(v.Files, x0)
+				}
+			} else {
+				v.Files = nil
+			}
+		case "dragOperationsMask":
+			v.DragOperationsMask = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DragData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DispatchDragEventType string
+
+// DispatchDragEventType values
+const (
+	DispatchDragEventTypeDragEnter  DispatchDragEventType = "dragEnter"
+	DispatchDragEventTypeDragOver   DispatchDragEventType = "dragOver"
+	DispatchDragEventTypeDrop       DispatchDragEventType = "drop"
+	DispatchDragEventTypeDragCancel DispatchDragEventType = "dragCancel"
+)
+
+// Values gives every DispatchDragEventType
+func (DispatchDragEventType) Values() []DispatchDragEventType {
+	return []DispatchDragEventType{
+		DispatchDragEventTypeDragEnter,
+		DispatchDragEventTypeDragOver,
+		DispatchDragEventTypeDrop,
+		DispatchDragEventTypeDragCancel,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DispatchDragEventType) Valid() bool {
+	switch v {
+	case DispatchDragEventTypeDragEnter, DispatchDragEventTypeDragOver, DispatchDragEventTypeDrop, DispatchDragEventTypeDragCancel:
+		return true
+	}
+	return false
+}
+
+type DispatchKeyEventType string
+
+// DispatchKeyEventType values
+const (
+	DispatchKeyEventTypeKeyDown    DispatchKeyEventType = "keyDown"
+	DispatchKeyEventTypeKeyUp      DispatchKeyEventType = "keyUp"
+	DispatchKeyEventTypeRawKeyDown DispatchKeyEventType = "rawKeyDown"
+	DispatchKeyEventTypeChar       DispatchKeyEventType = "char"
+)
+
+// Values gives every DispatchKeyEventType
+func (DispatchKeyEventType) Values() []DispatchKeyEventType {
+	return []DispatchKeyEventType{
+		DispatchKeyEventTypeKeyDown,
+		DispatchKeyEventTypeKeyUp,
+		DispatchKeyEventTypeRawKeyDown,
+		DispatchKeyEventTypeChar,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DispatchKeyEventType) Valid() bool {
+	switch v {
+	case DispatchKeyEventTypeKeyDown, DispatchKeyEventTypeKeyUp, DispatchKeyEventTypeRawKeyDown, DispatchKeyEventTypeChar:
+		return true
+	}
+	return false
+}
+
+type DispatchMouseEventType string
+
+// DispatchMouseEventType values
+const (
+	DispatchMouseEventTypeMousePressed  DispatchMouseEventType = "mousePressed"
+	DispatchMouseEventTypeMouseReleased DispatchMouseEventType = "mouseReleased"
+	DispatchMouseEventTypeMouseMoved    DispatchMouseEventType = "mouseMoved"
+	DispatchMouseEventTypeMouseWheel    DispatchMouseEventType = "mouseWheel"
+)
+
+// Values gives every DispatchMouseEventType
+func (DispatchMouseEventType) Values() []DispatchMouseEventType {
+	return []DispatchMouseEventType{
+		DispatchMouseEventTypeMousePressed,
+		DispatchMouseEventTypeMouseReleased,
+		DispatchMouseEventTypeMouseMoved,
+		DispatchMouseEventTypeMouseWheel,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DispatchMouseEventType) Valid() bool {
+	switch v {
+	case DispatchMouseEventTypeMousePressed, DispatchMouseEventTypeMouseReleased, DispatchMouseEventTypeMouseMoved, DispatchMouseEventTypeMouseWheel:
+		return true
+	}
+	return false
+}
+
+type DispatchMouseEventPointerType string
+
+// DispatchMouseEventPointerType values
+const (
+	DispatchMouseEventPointerTypeMouse DispatchMouseEventPointerType = "mouse"
+	DispatchMouseEventPointerTypePen   DispatchMouseEventPointerType = "pen"
+)
+
+// Values gives every DispatchMouseEventPointerType
+func (DispatchMouseEventPointerType) Values() []DispatchMouseEventPointerType {
+	return []DispatchMouseEventPointerType{
+		DispatchMouseEventPointerTypeMouse,
+		DispatchMouseEventPointerTypePen,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DispatchMouseEventPointerType) Valid() bool {
+	switch v {
+	case DispatchMouseEventPointerTypeMouse, DispatchMouseEventPointerTypePen:
+		return true
+	}
+	return false
+}
+
+type DispatchTouchEventType string
+
+// DispatchTouchEventType values
+const (
+	DispatchTouchEventTypeTouchStart  DispatchTouchEventType = "touchStart"
+	DispatchTouchEventTypeTouchEnd    DispatchTouchEventType = "touchEnd"
+	DispatchTouchEventTypeTouchMove   DispatchTouchEventType = "touchMove"
+	DispatchTouchEventTypeTouchCancel DispatchTouchEventType = "touchCancel"
+)
+
+// Values gives every DispatchTouchEventType
+func (DispatchTouchEventType) Values() []DispatchTouchEventType {
+	return []DispatchTouchEventType{
+		DispatchTouchEventTypeTouchStart,
+		DispatchTouchEventTypeTouchEnd,
+		DispatchTouchEventTypeTouchMove,
+		DispatchTouchEventTypeTouchCancel,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DispatchTouchEventType) Valid() bool {
+	switch v {
+	case DispatchTouchEventTypeTouchStart, DispatchTouchEventTypeTouchEnd, DispatchTouchEventTypeTouchMove, DispatchTouchEventTypeTouchCancel:
+		return true
+	}
+	return false
+}
+
+type EmulateTouchFromMouseEventType string
+
+// EmulateTouchFromMouseEventType values
+const (
+	EmulateTouchFromMouseEventTypeMousePressed  EmulateTouchFromMouseEventType = "mousePressed"
+	EmulateTouchFromMouseEventTypeMouseReleased EmulateTouchFromMouseEventType = "mouseReleased"
+	EmulateTouchFromMouseEventTypeMouseMoved    EmulateTouchFromMouseEventType = "mouseMoved"
+	EmulateTouchFromMouseEventTypeMouseWheel    EmulateTouchFromMouseEventType = "mouseWheel"
+)
+
+// Values gives every EmulateTouchFromMouseEventType
+func (EmulateTouchFromMouseEventType) Values() []EmulateTouchFromMouseEventType {
+	return []EmulateTouchFromMouseEventType{
+		EmulateTouchFromMouseEventTypeMousePressed,
+		EmulateTouchFromMouseEventTypeMouseReleased,
+		EmulateTouchFromMouseEventTypeMouseMoved,
+		EmulateTouchFromMouseEventTypeMouseWheel,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v EmulateTouchFromMouseEventType) Valid() bool {
+	switch v {
+	case EmulateTouchFromMouseEventTypeMousePressed, EmulateTouchFromMouseEventTypeMouseReleased, EmulateTouchFromMouseEventTypeMouseMoved, EmulateTouchFromMouseEventTypeMouseWheel:
+		return true
+	}
+	return false
+}
+
+type DispatchKeyEventReturns struct {
+}
+
+// UnmarshalCDP reads DispatchKeyEventReturns from JSON
+func (v *DispatchKeyEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DispatchKeyEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DispatchKeyEventParams are the parameters for Input.dispatchKeyEvent
+// optional parameters are left out when nil
+type DispatchKeyEventParams struct {
+	/* Type of the key event. */
+	Type DispatchKeyEventType `json:"type"`
+	/* Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	(default: 0). */
+	Modifiers *int `json:"modifiers,omitempty"`
+	/* Time at which the event occurred. */
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+	/* Text as generated by processing a virtual key code with a keyboard layout. Not needed for
+	for `keyUp` and `rawKeyDown` events (default: "") */
+	Text *string `json:"text,omitempty"`
+	/* Text that would have been generated by the keyboard if no modifiers were pressed (except for
+	shift). Useful for shortcut (accelerator) key handling (default: ""). */
+	UnmodifiedText *string `json:"unmodifiedText,omitempty"`
+	/* Unique key identifier (e.g., 'U+0041') (default: ""). */
+	KeyIdentifier *string `json:"keyIdentifier,omitempty"`
+	/* Unique DOM defined string value for each physical key (e.g., 'KeyA') (default: ""). */
+	Code *string `json:"code,omitempty"`
+	/* Unique DOM defined string value describing the meaning of the key in the context of active
+	modifiers, keyboard layout, etc (e.g., 'AltGr') (default: ""). */
+	Key *string `json:"key,omitempty"`
+	/* Windows virtual key code (default: 0). */
+	WindowsVirtualKeyCode *int `json:"windowsVirtualKeyCode,omitempty"`
+	/* Native virtual key code (default: 0). */
+	NativeVirtualKeyCode *int `json:"nativeVirtualKeyCode,omitempty"`
+	/* Whether the event was generated from auto repeat (default: false). */
+	AutoRepeat *bool `json:"autoRepeat,omitempty"`
+	/* Whether the event was generated from the keypad (default: false). */
+	IsKeypad *bool `json:"isKeypad,omitempty"`
+	/* Whether the event was a system key event (default: false). */
+	IsSystemKey *bool `json:"isSystemKey,omitempty"`
+	/* Whether the event was from the left or right side of the keyboard. 1=Left, 2=Right (default:
+	0). */
+	Location *int `json:"location,omitempty"`
+	/* Editing commands to send with the key event (e.g., 'selectAll') (default: []).
+	These are related to but not equal the command names used in `document.execCommand` and NSStandardKeyBindingResponding.
+	See https://source.chromium.org/chromium/chromium/src/+/main:third_party/blink/renderer/core/editing/commands/editor_command_names.h for valid command names.
+
+	Experimental: this may change or be removed in any chrome release */
+	Commands []string `json:"commands"`
+}
+
+// MarshalCDP writes DispatchKeyEventParams as JSON
+func (v *DispatchKeyEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Modifiers != nil {
+		e.Key("modifiers")
+		e.Int(*v.Modifiers)
+	}
+	if v.Timestamp != nil {
+		e.Key("timestamp")
+		e.Float(float64(*v.Timestamp))
+	}
+	if v.Text != nil {
+		e.Key("text")
+		e.String(*v.Text)
+	}
+	if v.UnmodifiedText != nil {
+		e.Key("unmodifiedText")
+		e.String(*v.UnmodifiedText)
+	}
+	if v.KeyIdentifier != nil {
+		e.Key("keyIdentifier")
+		e.String(*v.KeyIdentifier)
+	}
+	if v.Code != nil {
+		e.Key("code")
+		e.String(*v.Code)
+	}
+	if v.Key != nil {
+		e.Key("key")
+		e.String(*v.Key)
+	}
+	if v.WindowsVirtualKeyCode != nil {
+		e.Key("windowsVirtualKeyCode")
+		e.Int(*v.WindowsVirtualKeyCode)
+	}
+	if v.NativeVirtualKeyCode != nil {
+		e.Key("nativeVirtualKeyCode")
+		e.Int(*v.NativeVirtualKeyCode)
+	}
+	if v.AutoRepeat != nil {
+		e.Key("autoRepeat")
+		e.Bool(*v.AutoRepeat)
+	}
+	if v.IsKeypad != nil {
+		e.Key("isKeypad")
+		e.Bool(*v.IsKeypad)
+	}
+	if v.IsSystemKey != nil {
+		e.Key("isSystemKey")
+		e.Bool(*v.IsSystemKey)
+	}
+	if v.Location != nil {
+		e.Key("location")
+		e.Int(*v.Location)
+	}
+	if v.Commands != nil {
+		e.Key("commands")
+		e.ArrayStart()
+		for i0 := range v.Commands {
+			e.Elem()
+			e.String(v.Commands[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DispatchKeyEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Dispatches a key event to the page.
+func (c Client) DispatchKeyEvent(params DispatchKeyEventParams) (DispatchKeyEventReturns, error) {
+	return c.DispatchKeyEventContext(context.Background(), params)
+}
+
+// DispatchKeyEventContext is DispatchKeyEvent with a context for cancellation and deadlines
+func (c Client) DispatchKeyEventContext(ctx context.Context, params DispatchKeyEventParams) (DispatchKeyEventReturns, error) {
+	var returns_ DispatchKeyEventReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Input.dispatchKeyEvent: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.dispatchKeyEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DispatchMouseEventReturns struct {
+}
+
+// UnmarshalCDP reads DispatchMouseEventReturns from JSON
+func (v *DispatchMouseEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DispatchMouseEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DispatchMouseEventParams are the parameters for Input.dispatchMouseEvent
+// optional parameters are left out when nil
+type DispatchMouseEventParams struct {
+	/* Type of the mouse event. */
+	Type DispatchMouseEventType `json:"type"`
+	/* X coordinate of the event relative to the main frame's viewport in CSS pixels. */
+	X float64 `json:"x"`
+	/* Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to
+	the top of the viewport and Y increases as it proceeds towards the bottom of the viewport. */
+	Y float64 `json:"y"`
+	/* Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	(default: 0). */
+	Modifiers *int `json:"modifiers,omitempty"`
+	/* Time at which the event occurred. */
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+	/* Mouse button (default: "none"). */
+	Button *MouseButton `json:"button,omitempty"`
+	/* A number indicating which buttons are pressed on the mouse when a mouse event is triggered.
+	Left=1, Right=2, Middle=4, Back=8, Forward=16, None=0. */
+	Buttons *int `json:"buttons,omitempty"`
+	/* Number of times the mouse button was clicked (default: 0). */
+	ClickCount *int `json:"clickCount,omitempty"`
+	/* The normalized pressure, which has a range of [0,1] (default: 0).
+
+	Experimental: this may change or be removed in any chrome release */
+	Force *float64 `json:"force,omitempty"`
+	/* The normalized tangential pressure, which has a range of [-1,1] (default: 0).
+
+	Experimental: this may change or be removed in any chrome release */
+	TangentialPressure *float64 `json:"tangentialPressure,omitempty"`
+	/* The plane angle between the Y-Z plane and the plane containing both the stylus axis and the Y axis, in degrees of the range [-90,90], a positive tiltX is to the right (default: 0). */
+	TiltX *float64 `json:"tiltX,omitempty"`
+	/* The plane angle between the X-Z plane and the plane containing both the stylus axis and the X axis, in degrees of the range [-90,90], a positive tiltY is towards the user (default: 0). */
+	TiltY *float64 `json:"tiltY,omitempty"`
+	/* The clockwise rotation of a pen stylus around its own major axis, in degrees in the range [0,359] (default: 0).
+
+	Experimental: this may change or be removed in any chrome release */
+	Twist *int `json:"twist,omitempty"`
+	/* X delta in CSS pixels for mouse wheel event (default: 0). */
+	DeltaX *float64 `json:"deltaX,omitempty"`
+	/* Y delta in CSS pixels for mouse wheel event (default: 0). */
+	DeltaY *float64 `json:"deltaY,omitempty"`
+	/* Pointer type (default: "mouse"). */
+	PointerType *DispatchMouseEventPointerType `json:"pointerType,omitempty"`
+}
+
+// MarshalCDP writes DispatchMouseEventParams as JSON
+func (v *DispatchMouseEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	if v.Modifiers != nil {
+		e.Key("modifiers")
+		e.Int(*v.Modifiers)
+	}
+	if v.Timestamp != nil {
+		e.Key("timestamp")
+		e.Float(float64(*v.Timestamp))
+	}
+	if v.Button != nil {
+		e.Key("button")
+		e.String(string(*v.Button))
+	}
+	if v.Buttons != nil {
+		e.Key("buttons")
+		e.Int(*v.Buttons)
+	}
+	if v.ClickCount != nil {
+		e.Key("clickCount")
+		e.Int(*v.ClickCount)
+	}
+	if v.Force != nil {
+		e.Key("force")
+		e.Float(*v.Force)
+	}
+	if v.TangentialPressure != nil {
+		e.Key("tangentialPressure")
+		e.Float(*v.TangentialPressure)
+	}
+	if v.TiltX != nil {
+		e.Key("tiltX")
+		e.Float(*v.TiltX)
+	}
+	if v.TiltY != nil {
+		e.Key("tiltY")
+		e.Float(*v.TiltY)
+	}
+	if v.Twist != nil {
+		e.Key("twist")
+		e.Int(*v.Twist)
+	}
+	if v.DeltaX != nil {
+		e.Key("deltaX")
+		e.Float(*v.DeltaX)
+	}
+	if v.DeltaY != nil {
+		e.Key("deltaY")
+		e.Float(*v.DeltaY)
+	}
+	if v.PointerType != nil {
+		e.Key("pointerType")
+		e.String(string(*v.PointerType))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DispatchMouseEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Dispatches a mouse event to the page.
+func (c Client) DispatchMouseEvent(params DispatchMouseEventParams) (DispatchMouseEventReturns, error) {
+	return c.DispatchMouseEventContext(context.Background(), params)
+}
+
+// DispatchMouseEventContext is DispatchMouseEvent with a context for cancellation and deadlines
+func (c Client) DispatchMouseEventContext(ctx context.Context, params DispatchMouseEventParams) (DispatchMouseEventReturns, error) {
+	var returns_ DispatchMouseEventReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Input.dispatchMouseEvent: invalid type %q", params.Type)
+	}
+
+	if params.Button != nil && !params.Button.Valid() {
+		return returns_, fmt.Errorf("Input.dispatchMouseEvent: invalid button %q", *params.Button)
+	}
+
+	if params.PointerType != nil && !params.PointerType.Valid() {
+		return returns_, fmt.Errorf("Input.dispatchMouseEvent: invalid pointerType %q", *params.PointerType)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.dispatchMouseEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DispatchTouchEventReturns struct {
+}
+
+// UnmarshalCDP reads DispatchTouchEventReturns from JSON
+func (v *DispatchTouchEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DispatchTouchEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DispatchTouchEventParams are the parameters for Input.dispatchTouchEvent
+// optional parameters are left out when nil
+type DispatchTouchEventParams struct {
+	/* Type of the touch event. TouchEnd and TouchCancel must not contain any touch points, while
+	TouchStart and TouchMove must contains at least one. */
+	Type DispatchTouchEventType `json:"type"`
+	/* Active touch points on the touch device. One event per any changed point (compared to
+	previous touch event in a sequence) is generated, emulating pressing/moving/releasing points
+	one by one. */
+	TouchPoints []TouchPoint `json:"touchPoints"`
+	/* Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	(default: 0). */
+	Modifiers *int `json:"modifiers,omitempty"`
+	/* Time at which the event occurred. */
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// MarshalCDP writes DispatchTouchEventParams as JSON
+func (v *DispatchTouchEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("touchPoints")
+	if v.TouchPoints == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.TouchPoints {
+			e.Elem()
+			v.TouchPoints[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Modifiers != nil {
+		e.Key("modifiers")
+		e.Int(*v.Modifiers)
+	}
+	if v.Timestamp != nil {
+		e.Key("timestamp")
+		e.Float(float64(*v.Timestamp))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DispatchTouchEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Dispatches a touch event to the page.
+func (c Client) DispatchTouchEvent(params DispatchTouchEventParams) (DispatchTouchEventReturns, error) {
+	return c.DispatchTouchEventContext(context.Background(), params)
+}
+
+// DispatchTouchEventContext is DispatchTouchEvent with a context for cancellation and deadlines
+func (c Client) DispatchTouchEventContext(ctx context.Context, params DispatchTouchEventParams) (DispatchTouchEventReturns, error) {
+	var returns_ DispatchTouchEventReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Input.dispatchTouchEvent: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.dispatchTouchEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CancelDraggingReturns struct {
+}
+
+// UnmarshalCDP reads CancelDraggingReturns from JSON
+func (v *CancelDraggingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CancelDraggingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Cancels any active dragging in the page.
+func (c Client) CancelDragging() (CancelDraggingReturns, error) {
+	return c.CancelDraggingContext(context.Background())
+}
+
+// CancelDraggingContext is CancelDragging with a context for cancellation and deadlines
+func (c Client) CancelDraggingContext(ctx context.Context) (CancelDraggingReturns, error) {
+	var returns_ CancelDraggingReturns
+
+	err_ := c.caller.Call(ctx, "Input.cancelDragging", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetIgnoreInputEventsReturns struct {
+}
+
+// UnmarshalCDP reads SetIgnoreInputEventsReturns from JSON
+func (v *SetIgnoreInputEventsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetIgnoreInputEventsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetIgnoreInputEventsParams are the parameters for Input.setIgnoreInputEvents
+// optional parameters are left out when nil
+type SetIgnoreInputEventsParams struct {
+	/* Ignores input events processing when set to true. */
+	Ignore bool `json:"ignore"`
+}
+
+// MarshalCDP writes SetIgnoreInputEventsParams as JSON
+func (v *SetIgnoreInputEventsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ignore")
+	e.Bool(v.Ignore)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetIgnoreInputEventsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Ignores input events (useful while auditing page).
+func (c Client) SetIgnoreInputEvents(params SetIgnoreInputEventsParams) (SetIgnoreInputEventsReturns, error) {
+	return c.SetIgnoreInputEventsContext(context.Background(), params)
+}
+
+// SetIgnoreInputEventsContext is SetIgnoreInputEvents with a context for cancellation and deadlines
+func (c Client) SetIgnoreInputEventsContext(ctx context.Context, params SetIgnoreInputEventsParams) (SetIgnoreInputEventsReturns, error) {
+	var returns_ SetIgnoreInputEventsReturns
+
+	err_ := c.caller.Call(ctx, "Input.setIgnoreInputEvents", &params, &returns_)
+
+	return returns_, err_
+}
+
+// decoders for Input events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){}
+
+// DecodeEvent decodes a Input event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
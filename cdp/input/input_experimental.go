@@ -0,0 +1,753 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package input
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type DispatchDragEventReturns struct {
+}
+
+// UnmarshalCDP reads DispatchDragEventReturns from JSON
+func (v *DispatchDragEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DispatchDragEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DispatchDragEventParams are the parameters for Input.dispatchDragEvent
+// optional parameters are left out when nil
+type DispatchDragEventParams struct {
+	/* Type of the drag event. */
+	Type DispatchDragEventType `json:"type"`
+	/* X coordinate of the event relative to the main frame's viewport in CSS pixels. */
+	X float64 `json:"x"`
+	/* Y coordinate of the event relative to the main frame's viewport in CSS pixels. 0 refers to
+	the top of the viewport and Y increases as it proceeds towards the bottom of the viewport. */
+	Y    float64  `json:"y"`
+	Data DragData `json:"data"`
+	/* Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	(default: 0). */
+	Modifiers *int `json:"modifiers,omitempty"`
+}
+
+// MarshalCDP writes DispatchDragEventParams as JSON
+func (v *DispatchDragEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("data")
+	v.Data.MarshalCDP(e)
+	if v.Modifiers != nil {
+		e.Key("modifiers")
+		e.Int(*v.Modifiers)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DispatchDragEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Dispatches a drag event into the page.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DispatchDragEvent(params DispatchDragEventParams) (DispatchDragEventReturns, error) {
+	return c.DispatchDragEventContext(context.Background(), params)
+}
+
+// DispatchDragEventContext is DispatchDragEvent with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DispatchDragEventContext(ctx context.Context, params DispatchDragEventParams) (DispatchDragEventReturns, error) {
+	var returns_ DispatchDragEventReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Input.dispatchDragEvent: invalid type %q", params.Type)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.dispatchDragEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type InsertTextReturns struct {
+}
+
+// UnmarshalCDP reads InsertTextReturns from JSON
+func (v *InsertTextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InsertTextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// InsertTextParams are the parameters for Input.insertText
+// optional parameters are left out when nil
+type InsertTextParams struct {
+	/* The text to insert. */
+	Text string `json:"text"`
+}
+
+// MarshalCDP writes InsertTextParams as JSON
+func (v *InsertTextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InsertTextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// This method emulates inserting text that doesn't come from a key press,
+// for example an emoji keyboard or an IME.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) InsertText(params InsertTextParams) (InsertTextReturns, error) {
+	return c.InsertTextContext(context.Background(), params)
+}
+
+// InsertTextContext is InsertText with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) InsertTextContext(ctx context.Context, params InsertTextParams) (InsertTextReturns, error) {
+	var returns_ InsertTextReturns
+
+	err_ := c.caller.Call(ctx, "Input.insertText", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ImeSetCompositionReturns struct {
+}
+
+// UnmarshalCDP reads ImeSetCompositionReturns from JSON
+func (v *ImeSetCompositionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ImeSetCompositionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ImeSetCompositionParams are the parameters for Input.imeSetComposition
+// optional parameters are left out when nil
+type ImeSetCompositionParams struct {
+	/* The text to insert */
+	Text string `json:"text"`
+	/* selection start */
+	SelectionStart int `json:"selectionStart"`
+	/* selection end */
+	SelectionEnd int `json:"selectionEnd"`
+	/* replacement start */
+	ReplacementStart *int `json:"replacementStart,omitempty"`
+	/* replacement end */
+	ReplacementEnd *int `json:"replacementEnd,omitempty"`
+}
+
+// MarshalCDP writes ImeSetCompositionParams as JSON
+func (v *ImeSetCompositionParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("text")
+	e.String(v.Text)
+	e.Key("selectionStart")
+	e.Int(v.SelectionStart)
+	e.Key("selectionEnd")
+	e.Int(v.SelectionEnd)
+	if v.ReplacementStart != nil {
+		e.Key("replacementStart")
+		e.Int(*v.ReplacementStart)
+	}
+	if v.ReplacementEnd != nil {
+		e.Key("replacementEnd")
+		e.Int(*v.ReplacementEnd)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ImeSetCompositionParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// This method sets the current candidate text for IME.
+// Use imeCommitComposition to commit the final text.
+// Use imeSetComposition with empty string as text to cancel composition.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ImeSetComposition(params ImeSetCompositionParams) (ImeSetCompositionReturns, error) {
+	return c.ImeSetCompositionContext(context.Background(), params)
+}
+
+// ImeSetCompositionContext is ImeSetComposition with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ImeSetCompositionContext(ctx context.Context, params ImeSetCompositionParams) (ImeSetCompositionReturns, error) {
+	var returns_ ImeSetCompositionReturns
+
+	err_ := c.caller.Call(ctx, "Input.imeSetComposition", &params, &returns_)
+
+	return returns_, err_
+}
+
+type EmulateTouchFromMouseEventReturns struct {
+}
+
+// UnmarshalCDP reads EmulateTouchFromMouseEventReturns from JSON
+func (v *EmulateTouchFromMouseEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EmulateTouchFromMouseEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EmulateTouchFromMouseEventParams are the parameters for Input.emulateTouchFromMouseEvent
+// optional parameters are left out when nil
+type EmulateTouchFromMouseEventParams struct {
+	/* Type of the mouse event. */
+	Type EmulateTouchFromMouseEventType `json:"type"`
+	/* X coordinate of the mouse pointer in DIP. */
+	X int `json:"x"`
+	/* Y coordinate of the mouse pointer in DIP. */
+	Y int `json:"y"`
+	/* Mouse button. Only "none", "left", "right" are supported. */
+	Button MouseButton `json:"button"`
+	/* Time at which the event occurred (default: current time). */
+	Timestamp *TimeSinceEpoch `json:"timestamp,omitempty"`
+	/* X delta in DIP for mouse wheel event (default: 0). */
+	DeltaX *float64 `json:"deltaX,omitempty"`
+	/* Y delta in DIP for mouse wheel event (default: 0). */
+	DeltaY *float64 `json:"deltaY,omitempty"`
+	/* Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8
+	(default: 0). */
+	Modifiers *int `json:"modifiers,omitempty"`
+	/* Number of times the mouse button was clicked (default: 0). */
+	ClickCount *int `json:"clickCount,omitempty"`
+}
+
+// MarshalCDP writes EmulateTouchFromMouseEventParams as JSON
+func (v *EmulateTouchFromMouseEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("x")
+	e.Int(v.X)
+	e.Key("y")
+	e.Int(v.Y)
+	e.Key("button")
+	e.String(string(v.Button))
+	if v.Timestamp != nil {
+		e.Key("timestamp")
+		e.Float(float64(*v.Timestamp))
+	}
+	if v.DeltaX != nil {
+		e.Key("deltaX")
+		e.Float(*v.DeltaX)
+	}
+	if v.DeltaY != nil {
+		e.Key("deltaY")
+		e.Float(*v.DeltaY)
+	}
+	if v.Modifiers != nil {
+		e.Key("modifiers")
+		e.Int(*v.Modifiers)
+	}
+	if v.ClickCount != nil {
+		e.Key("clickCount")
+		e.Int(*v.ClickCount)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EmulateTouchFromMouseEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Emulates touch event from the mouse event parameters.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) EmulateTouchFromMouseEvent(params EmulateTouchFromMouseEventParams) (EmulateTouchFromMouseEventReturns, error) {
+	return c.EmulateTouchFromMouseEventContext(context.Background(), params)
+}
+
+// EmulateTouchFromMouseEventContext is EmulateTouchFromMouseEvent with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) EmulateTouchFromMouseEventContext(ctx context.Context, params EmulateTouchFromMouseEventParams) (EmulateTouchFromMouseEventReturns, error) {
+	var returns_ EmulateTouchFromMouseEventReturns
+
+	if !params.Type.Valid() {
+		return returns_, fmt.Errorf("Input.emulateTouchFromMouseEvent: invalid type %q", params.Type)
+	}
+
+	if !params.Button.Valid() {
+		return returns_, fmt.Errorf("Input.emulateTouchFromMouseEvent: invalid button %q", params.Button)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.emulateTouchFromMouseEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetInterceptDragsReturns struct {
+}
+
+// UnmarshalCDP reads SetInterceptDragsReturns from JSON
+func (v *SetInterceptDragsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInterceptDragsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInterceptDragsParams are the parameters for Input.setInterceptDrags
+// optional parameters are left out when nil
+type SetInterceptDragsParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetInterceptDragsParams as JSON
+func (v *SetInterceptDragsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInterceptDragsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Prevents default drag and drop behavior and instead emits `Input.dragIntercepted` events.
+// Drag and drop behavior can be directly controlled via `Input.dispatchDragEvent`.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInterceptDrags(params SetInterceptDragsParams) (SetInterceptDragsReturns, error) {
+	return c.SetInterceptDragsContext(context.Background(), params)
+}
+
+// SetInterceptDragsContext is SetInterceptDrags with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInterceptDragsContext(ctx context.Context, params SetInterceptDragsParams) (SetInterceptDragsReturns, error) {
+	var returns_ SetInterceptDragsReturns
+
+	err_ := c.caller.Call(ctx, "Input.setInterceptDrags", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SynthesizePinchGestureReturns struct {
+}
+
+// UnmarshalCDP reads SynthesizePinchGestureReturns from JSON
+func (v *SynthesizePinchGestureReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SynthesizePinchGestureReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SynthesizePinchGestureParams are the parameters for Input.synthesizePinchGesture
+// optional parameters are left out when nil
+type SynthesizePinchGestureParams struct {
+	/* X coordinate of the start of the gesture in CSS pixels. */
+	X float64 `json:"x"`
+	/* Y coordinate of the start of the gesture in CSS pixels. */
+	Y float64 `json:"y"`
+	/* Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out). */
+	ScaleFactor float64 `json:"scaleFactor"`
+	/* Relative pointer speed in pixels per second (default: 800). */
+	RelativeSpeed *int `json:"relativeSpeed,omitempty"`
+	/* Which type of input events to be generated (default: 'default', which queries the platform
+	for the preferred input type). */
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// MarshalCDP writes SynthesizePinchGestureParams as JSON
+func (v *SynthesizePinchGestureParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("scaleFactor")
+	e.Float(v.ScaleFactor)
+	if v.RelativeSpeed != nil {
+		e.Key("relativeSpeed")
+		e.Int(*v.RelativeSpeed)
+	}
+	if v.GestureSourceType != nil {
+		e.Key("gestureSourceType")
+		e.String(string(*v.GestureSourceType))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SynthesizePinchGestureParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Synthesizes a pinch gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SynthesizePinchGesture(params SynthesizePinchGestureParams) (SynthesizePinchGestureReturns, error) {
+	return c.SynthesizePinchGestureContext(context.Background(), params)
+}
+
+// SynthesizePinchGestureContext is SynthesizePinchGesture with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SynthesizePinchGestureContext(ctx context.Context, params SynthesizePinchGestureParams) (SynthesizePinchGestureReturns, error) {
+	var returns_ SynthesizePinchGestureReturns
+
+	if params.GestureSourceType != nil && !params.GestureSourceType.Valid() {
+		return returns_, fmt.Errorf("Input.synthesizePinchGesture: invalid gestureSourceType %q", *params.GestureSourceType)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.synthesizePinchGesture", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SynthesizeScrollGestureReturns struct {
+}
+
+// UnmarshalCDP reads SynthesizeScrollGestureReturns from JSON
+func (v *SynthesizeScrollGestureReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SynthesizeScrollGestureReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SynthesizeScrollGestureParams are the parameters for Input.synthesizeScrollGesture
+// optional parameters are left out when nil
+type SynthesizeScrollGestureParams struct {
+	/* X coordinate of the start of the gesture in CSS pixels. */
+	X float64 `json:"x"`
+	/* Y coordinate of the start of the gesture in CSS pixels. */
+	Y float64 `json:"y"`
+	/* The distance to scroll along the X axis (positive to scroll left). */
+	XDistance *float64 `json:"xDistance,omitempty"`
+	/* The distance to scroll along the Y axis (positive to scroll up). */
+	YDistance *float64 `json:"yDistance,omitempty"`
+	/* The number of additional pixels to scroll back along the X axis, in addition to the given
+	distance. */
+	XOverscroll *float64 `json:"xOverscroll,omitempty"`
+	/* The number of additional pixels to scroll back along the Y axis, in addition to the given
+	distance. */
+	YOverscroll *float64 `json:"yOverscroll,omitempty"`
+	/* Prevent fling (default: true). */
+	PreventFling *bool `json:"preventFling,omitempty"`
+	/* Swipe speed in pixels per second (default: 800). */
+	Speed *int `json:"speed,omitempty"`
+	/* Which type of input events to be generated (default: 'default', which queries the platform
+	for the preferred input type). */
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
+	/* The number of times to repeat the gesture (default: 0). */
+	RepeatCount *int `json:"repeatCount,omitempty"`
+	/* The number of milliseconds delay between each repeat. (default: 250). */
+	RepeatDelayMs *int `json:"repeatDelayMs,omitempty"`
+	/* The name of the interaction markers to generate, if not empty (default: ""). */
+	InteractionMarkerName *string `json:"interactionMarkerName,omitempty"`
+}
+
+// MarshalCDP writes SynthesizeScrollGestureParams as JSON
+func (v *SynthesizeScrollGestureParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	if v.XDistance != nil {
+		e.Key("xDistance")
+		e.Float(*v.XDistance)
+	}
+	if v.YDistance != nil {
+		e.Key("yDistance")
+		e.Float(*v.YDistance)
+	}
+	if v.XOverscroll != nil {
+		e.Key("xOverscroll")
+		e.Float(*v.XOverscroll)
+	}
+	if v.YOverscroll != nil {
+		e.Key("yOverscroll")
+		e.Float(*v.YOverscroll)
+	}
+	if v.PreventFling != nil {
+		e.Key("preventFling")
+		e.Bool(*v.PreventFling)
+	}
+	if v.Speed != nil {
+		e.Key("speed")
+		e.Int(*v.Speed)
+	}
+	if v.GestureSourceType != nil {
+		e.Key("gestureSourceType")
+		e.String(string(*v.GestureSourceType))
+	}
+	if v.RepeatCount != nil {
+		e.Key("repeatCount")
+		e.Int(*v.RepeatCount)
+	}
+	if v.RepeatDelayMs != nil {
+		e.Key("repeatDelayMs")
+		e.Int(*v.RepeatDelayMs)
+	}
+	if v.InteractionMarkerName != nil {
+		e.Key("interactionMarkerName")
+		e.String(*v.InteractionMarkerName)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SynthesizeScrollGestureParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Synthesizes a scroll gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SynthesizeScrollGesture(params SynthesizeScrollGestureParams) (SynthesizeScrollGestureReturns, error) {
+	return c.SynthesizeScrollGestureContext(context.Background(), params)
+}
+
+// SynthesizeScrollGestureContext is SynthesizeScrollGesture with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SynthesizeScrollGestureContext(ctx context.Context, params SynthesizeScrollGestureParams) (SynthesizeScrollGestureReturns, error) {
+	var returns_ SynthesizeScrollGestureReturns
+
+	if params.GestureSourceType != nil && !params.GestureSourceType.Valid() {
+		return returns_, fmt.Errorf("Input.synthesizeScrollGesture: invalid gestureSourceType %q", *params.GestureSourceType)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.synthesizeScrollGesture", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SynthesizeTapGestureReturns struct {
+}
+
+// UnmarshalCDP reads SynthesizeTapGestureReturns from JSON
+func (v *SynthesizeTapGestureReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SynthesizeTapGestureReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SynthesizeTapGestureParams are the parameters for Input.synthesizeTapGesture
+// optional parameters are left out when nil
+type SynthesizeTapGestureParams struct {
+	/* X coordinate of the start of the gesture in CSS pixels. */
+	X float64 `json:"x"`
+	/* Y coordinate of the start of the gesture in CSS pixels. */
+	Y float64 `json:"y"`
+	/* Duration between touchdown and touchup events in ms (default: 50). */
+	Duration *int `json:"duration,omitempty"`
+	/* Number of times to perform the tap (e.g. 2 for double tap, default: 1). */
+	TapCount *int `json:"tapCount,omitempty"`
+	/* Which type of input events to be generated (default: 'default', which queries the platform
+	for the preferred input type). */
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
+}
+
+// MarshalCDP writes SynthesizeTapGestureParams as JSON
+func (v *SynthesizeTapGestureParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	if v.Duration != nil {
+		e.Key("duration")
+		e.Int(*v.Duration)
+	}
+	if v.TapCount != nil {
+		e.Key("tapCount")
+		e.Int(*v.TapCount)
+	}
+	if v.GestureSourceType != nil {
+		e.Key("gestureSourceType")
+		e.String(string(*v.GestureSourceType))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SynthesizeTapGestureParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Synthesizes a tap gesture over a time period by issuing appropriate touch events.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SynthesizeTapGesture(params SynthesizeTapGestureParams) (SynthesizeTapGestureReturns, error) {
+	return c.SynthesizeTapGestureContext(context.Background(), params)
+}
+
+// SynthesizeTapGestureContext is SynthesizeTapGesture with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SynthesizeTapGestureContext(ctx context.Context, params SynthesizeTapGestureParams) (SynthesizeTapGestureReturns, error) {
+	var returns_ SynthesizeTapGestureReturns
+
+	if params.GestureSourceType != nil && !params.GestureSourceType.Valid() {
+		return returns_, fmt.Errorf("Input.synthesizeTapGesture: invalid gestureSourceType %q", *params.GestureSourceType)
+	}
+
+	err_ := c.caller.Call(ctx, "Input.synthesizeTapGesture", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Emitted only when `Input.setInterceptDrags` is enabled. Use this data with `Input.dispatchDragEvent` to
+// restore normal drag and drop behavior.
+//
+// Experimental: this may change or be removed in any chrome release
+type DragInterceptedEvent struct {
+	Data DragData `json:"data"`
+}
+
+// UnmarshalCDP reads DragInterceptedEvent from JSON
+func (v *DragInterceptedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DragInterceptedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DragInterceptedHandler func(ev DragInterceptedEvent)
+
+// EventMethod is Input.dragIntercepted
+func (DragInterceptedEvent) EventMethod() string {
+	return "Input.dragIntercepted"
+}
+
+// OnDragIntercepted calls handler for each Input.dragIntercepted event
+func (c Client) OnDragIntercepted(handler DragInterceptedHandler) (unsubscribe func()) {
+	return c.caller.On("Input.dragIntercepted", func(ev interface{}) {
+		handler(ev.(DragInterceptedEvent))
+	})
+}
+
+func init() {
+	decoders["Input.dragIntercepted"] = cdp.Decode[DragInterceptedEvent]
+}
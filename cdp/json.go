@@ -0,0 +1,730 @@
+package cdp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Marshaler is implemented by generated types that are sent to chrome
+// it writes JSON without reflection
+type Marshaler interface {
+	MarshalCDP(e *Encoder)
+}
+
+// Unmarshaler is implemented by generated types that come from chrome
+// it reads JSON without reflection
+type Unmarshaler interface {
+	UnmarshalCDP(d *Decoder)
+}
+
+// Marshal encodes v with MarshalCDP if it has one
+// otherwise with encoding/json
+func Marshal(v interface{}) ([]byte, error) {
+	var e Encoder
+	e.Value(v)
+	return e.Bytes(), e.Err()
+}
+
+// Unmarshal decodes data into v with UnmarshalCDP if it has one
+// otherwise with encoding/json
+func Unmarshal(data []byte, v interface{}) error {
+	u, ok := v.(Unmarshaler)
+	if !ok {
+		return json.Unmarshal(data, v)
+	}
+	d := NewDecoder(data)
+	u.UnmarshalCDP(d)
+	return d.End()
+}
+
+// Encoder appends JSON to a buffer
+// the first error is kept and reported by Err
+type Encoder struct {
+	buf []byte
+	err error
+}
+
+// Bytes gives what has been written
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// Err gives the first error
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Reset empties the buffer so it can be used again
+func (e *Encoder) Reset() {
+	e.buf = e.buf[:0]
+	e.err = nil
+}
+
+// a comma goes before every key or element but the first
+func (e *Encoder) comma() {
+	if n := len(e.buf); n > 0 && e.buf[n-1] != '{' && e.buf[n-1] != '[' {
+		e.buf = append(e.buf, ',')
+	}
+}
+
+func (e *Encoder) ObjectStart() {
+	e.buf = append(e.buf, '{')
+}
+
+func (e *Encoder) ObjectEnd() {
+	e.buf = append(e.buf, '}')
+}
+
+func (e *Encoder) ArrayStart() {
+	e.buf = append(e.buf, '[')
+}
+
+func (e *Encoder) ArrayEnd() {
+	e.buf = append(e.buf, ']')
+}
+
+// Key starts an object member
+func (e *Encoder) Key(key string) {
+	e.comma()
+	e.String(key)
+	e.buf = append(e.buf, ':')
+}
+
+// Elem starts an array element
+func (e *Encoder) Elem() {
+	e.comma()
+}
+
+func (e *Encoder) Null() {
+	e.buf = append(e.buf, "null"...)
+}
+
+func (e *Encoder) Bool(v bool) {
+	e.buf = strconv.AppendBool(e.buf, v)
+}
+
+func (e *Encoder) Int(v int) {
+	e.buf = strconv.AppendInt(e.buf, int64(v), 10)
+}
+
+// Float is written the way encoding/json writes it
+func (e *Encoder) Float(v float64) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		e.fail(fmt.Errorf("cdp: unsupported value: %v", v))
+		e.Null()
+		return
+	}
+	format := byte('f')
+	if abs := math.Abs(v); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	start := len(e.buf)
+	e.buf = strconv.AppendFloat(e.buf, v, format, -1, 64)
+	if format == 'e' {
+		// 1e-07 is 1e-7
+		if n := len(e.buf) - start; n >= 4 && e.buf[len(e.buf)-4] == 'e' && e.buf[len(e.buf)-3] == '-' && e.buf[len(e.buf)-2] == '0' {
+			e.buf[len(e.buf)-2] = e.buf[len(e.buf)-1]
+			e.buf = e.buf[:len(e.buf)-1]
+		}
+	}
+}
+
+const hex = "0123456789abcdef"
+
+// String is quoted and escaped
+// invalid UTF-8 becomes U+FFFD
+func (e *Encoder) String(s string) {
+	e.buf = append(e.buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' {
+				i++
+				continue
+			}
+			e.buf = append(e.buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				e.buf = append(e.buf, '\\', c)
+			case '\n':
+				e.buf = append(e.buf, '\\', 'n')
+			case '\r':
+				e.buf = append(e.buf, '\\', 'r')
+			case '\t':
+				e.buf = append(e.buf, '\\', 't')
+			case '\b':
+				e.buf = append(e.buf, '\\', 'b')
+			case '\f':
+				e.buf = append(e.buf, '\\', 'f')
+			default:
+				e.buf = append(e.buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			e.buf = append(e.buf, s[start:i]...)
+			e.buf = utf8.AppendRune(e.buf, utf8.RuneError)
+			i += size
+			start = i
+			continue
+		}
+		// line and paragraph separators break javascript
+		if r == '\u2028' || r == '\u2029' {
+			e.buf = append(e.buf, s[start:i]...)
+			e.buf = append(e.buf, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	e.buf = append(e.buf, s[start:]...)
+	e.buf = append(e.buf, '"')
+}
+
+// Raw writes JSON that is already encoded
+// empty is written as null
+func (e *Encoder) Raw(v json.RawMessage) {
+	if len(v) == 0 {
+		e.Null()
+		return
+	}
+	e.buf = append(e.buf, v...)
+}
+
+// Value writes v with MarshalCDP if it has one
+// otherwise with encoding/json
+func (e *Encoder) Value(v interface{}) {
+	if m, ok := v.(Marshaler); ok {
+		m.MarshalCDP(e)
+		return
+	}
+	e.Any(v)
+}
+
+// Any writes v with encoding/json
+// used for values the protocol does not describe
+func (e *Encoder) Any(v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		e.fail(fmt.Errorf("cdp: %w", err))
+		e.Null()
+		return
+	}
+	e.buf = append(e.buf, data...)
+}
+
+// Interface writes the values encoding/json gives for interface{}
+// anything else is written with Any
+func (e *Encoder) Interface(v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		e.Null()
+	case string:
+		e.String(v)
+	case float64:
+		e.Float(v)
+	case bool:
+		e.Bool(v)
+	case int:
+		e.Int(v)
+	case []interface{}:
+		if v == nil {
+			e.Null()
+			return
+		}
+		e.ArrayStart()
+		for _, x := range v {
+			e.Elem()
+			e.Interface(x)
+		}
+		e.ArrayEnd()
+	case map[string]interface{}:
+		e.Map(v)
+	default:
+		e.Any(v)
+	}
+}
+
+// Map writes an object with its keys sorted like encoding/json
+func (e *Encoder) Map(m map[string]interface{}) {
+	if m == nil {
+		e.Null()
+		return
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	e.ObjectStart()
+	for _, k := range keys {
+		e.Key(k)
+		e.Interface(m[k])
+	}
+	e.ObjectEnd()
+}
+
+func (e *Encoder) fail(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+// Decoder reads JSON from a buffer
+// the first error is kept and reported by Err
+// after an error every read gives a zero value
+//
+//	if !d.Object() {
+//		return
+//	}
+//	for d.More() {
+//		switch string(d.Key()) {
+//		case "name":
+//			v.Name = d.String()
+//		default:
+//			d.Skip()
+//		}
+//	}
+type Decoder struct {
+	data []byte
+	pos  int
+	err  error
+	// no comma is needed before the next key or element
+	first bool
+}
+
+// NewDecoder reads data
+// slices given by Key and Raw point into data
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// Err gives the first error
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+// End reports the first error
+// or anything but whitespace left after the value
+func (d *Decoder) End() error {
+	if d.err != nil {
+		return d.err
+	}
+	d.space()
+	if d.pos < len(d.data) {
+		d.fail("unexpected data after value")
+	}
+	return d.err
+}
+
+// ErrSyntax is wrapped by every error from a Decoder
+var ErrSyntax = errors.New("cdp: invalid json")
+
+func (d *Decoder) fail(msg string) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w: %s at offset %d", ErrSyntax, msg, d.pos)
+	}
+	d.pos = len(d.data)
+}
+
+func (d *Decoder) space() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\n', '\r':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+// next byte after whitespace
+// 0 at the end
+func (d *Decoder) peek() byte {
+	d.space()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+	return 0
+}
+
+func (d *Decoder) literal(lit string) bool {
+	if len(d.data)-d.pos >= len(lit) && string(d.data[d.pos:d.pos+len(lit)]) == lit {
+		d.pos += len(lit)
+		return true
+	}
+	return false
+}
+
+// Null reads null if it is next
+func (d *Decoder) Null() bool {
+	if d.peek() == 'n' && d.literal("null") {
+		return true
+	}
+	return false
+}
+
+// Object starts reading an object
+// false for null or an error
+func (d *Decoder) Object() bool {
+	return d.open('{')
+}
+
+// Array starts reading an array
+// false for null or an error
+func (d *Decoder) Array() bool {
+	return d.open('[')
+}
+
+func (d *Decoder) open(c byte) bool {
+	if d.Null() {
+		return false
+	}
+	if d.peek() != c {
+		d.fail(fmt.Sprintf("expected %q", c))
+		return false
+	}
+	d.pos++
+	d.first = true
+	return true
+}
+
+// More is true while the object or array has another key or element
+func (d *Decoder) More() bool {
+	c := d.peek()
+	if c == '}' || c == ']' {
+		d.pos++
+		d.first = false
+		return false
+	}
+	if !d.first {
+		if c != ',' {
+			d.fail("expected ',' or end")
+			return false
+		}
+		d.pos++
+	}
+	d.first = false
+	return d.err == nil
+}
+
+// Key reads an object key and the colon after it
+func (d *Decoder) Key() []byte {
+	key := d.str()
+	if d.peek() != ':' {
+		d.fail("expected ':'")
+		return nil
+	}
+	d.pos++
+	return key
+}
+
+// String reads a string
+// null is ""
+func (d *Decoder) String() string {
+	if d.Null() {
+		return ""
+	}
+	return string(d.str())
+}
+
+// read a string without copying unless it has escapes
+func (d *Decoder) str() []byte {
+	if d.peek() != '"' {
+		d.fail("expected string")
+		return nil
+	}
+	d.pos++
+	start := d.pos
+	for d.pos < len(d.data) {
+		switch c := d.data[d.pos]; {
+		case c == '"':
+			s := d.data[start:d.pos]
+			d.pos++
+			return s
+		case c == '\\':
+			return d.unescape(start)
+		case c < 0x20:
+			d.fail("control character in string")
+			return nil
+		default:
+			d.pos++
+		}
+	}
+	d.fail("unterminated string")
+	return nil
+}
+
+// finish a string with escapes from d.pos
+func (d *Decoder) unescape(start int) []byte {
+	s := make([]byte, d.pos-start, d.pos-start+16)
+	copy(s, d.data[start:d.pos])
+	for d.pos < len(d.data) {
+		c := d.data[d.pos]
+		switch {
+		case c == '"':
+			d.pos++
+			return s
+		case c < 0x20:
+			d.fail("control character in string")
+			return nil
+		case c != '\\':
+			s = append(s, c)
+			d.pos++
+			continue
+		}
+
+		d.pos++
+		if d.pos >= len(d.data) {
+			break
+		}
+		c = d.data[d.pos]
+		d.pos++
+		switch c {
+		case '"', '\\', '/':
+			s = append(s, c)
+		case 'b':
+			s = append(s, '\b')
+		case 'f':
+			s = append(s, '\f')
+		case 'n':
+			s = append(s, '\n')
+		case 'r':
+			s = append(s, '\r')
+		case 't':
+			s = append(s, '\t')
+		case 'u':
+			r := d.hex4()
+			if utf16.IsSurrogate(r) {
+				// the second half should follow
+				r2 := rune(-1)
+				if d.literal(`\u`) {
+					r2 = d.hex4()
+				}
+				if pair := utf16.DecodeRune(r, r2); pair != utf8.RuneError || r2 < 0 {
+					r = pair
+				} else {
+					// not a pair so both stand alone
+					s = utf8.AppendRune(s, utf8.RuneError)
+					r = r2
+				}
+			}
+			s = utf8.AppendRune(s, r)
+		default:
+			d.fail("invalid escape")
+			return nil
+		}
+	}
+	d.fail("unterminated string")
+	return nil
+}
+
+func (d *Decoder) hex4() rune {
+	if len(d.data)-d.pos < 4 {
+		d.fail("invalid unicode escape")
+		return utf8.RuneError
+	}
+	var r rune
+	for _, c := range d.data[d.pos : d.pos+4] {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			d.fail("invalid unicode escape")
+			return utf8.RuneError
+		}
+		r = r<<4 | rune(c)
+	}
+	d.pos += 4
+	return r
+}
+
+// Bool reads true or false
+// null is false
+func (d *Decoder) Bool() bool {
+	switch {
+	case d.Null():
+	case d.peek() == 't' && d.literal("true"):
+		return true
+	case d.peek() == 'f' && d.literal("false"):
+	default:
+		d.fail("expected boolean")
+	}
+	return false
+}
+
+// Int reads a number without a fraction or exponent
+// null is 0
+func (d *Decoder) Int() int {
+	if d.Null() {
+		return 0
+	}
+	num := d.number()
+	if num == nil {
+		return 0
+	}
+	n, err := strconv.ParseInt(string(num), 10, 0)
+	if err != nil {
+		d.fail(fmt.Sprintf("cannot read number %s as int", num))
+		return 0
+	}
+	return int(n)
+}
+
+// Float reads a number
+// null is 0
+func (d *Decoder) Float() float64 {
+	if d.Null() {
+		return 0
+	}
+	num := d.number()
+	if num == nil {
+		return 0
+	}
+	f, err := strconv.ParseFloat(string(num), 64)
+	if err != nil {
+		d.fail(fmt.Sprintf("cannot read number %s", num))
+		return 0
+	}
+	return f
+}
+
+// the bytes of a number
+func (d *Decoder) number() []byte {
+	d.space()
+	start := d.pos
+	if d.pos < len(d.data) && d.data[d.pos] == '-' {
+		d.pos++
+	}
+	digits := func() int {
+		n := 0
+		for d.pos < len(d.data) && '0' <= d.data[d.pos] && d.data[d.pos] <= '9' {
+			d.pos++
+			n++
+		}
+		return n
+	}
+	if digits() == 0 {
+		d.fail("expected number")
+		return nil
+	}
+	if d.pos < len(d.data) && d.data[d.pos] == '.' {
+		d.pos++
+		if digits() == 0 {
+			d.fail("expected digit")
+			return nil
+		}
+	}
+	if d.pos < len(d.data) && (d.data[d.pos] == 'e' || d.data[d.pos] == 'E') {
+		d.pos++
+		if d.pos < len(d.data) && (d.data[d.pos] == '+' || d.data[d.pos] == '-') {
+			d.pos++
+		}
+		if digits() == 0 {
+			d.fail("expected digit")
+			return nil
+		}
+	}
+	return d.data[start:d.pos]
+}
+
+// Skip reads past the next value
+func (d *Decoder) Skip() {
+	switch c := d.peek(); {
+	case c == '{':
+		d.Object()
+		for d.More() {
+			d.Key()
+			d.Skip()
+		}
+	case c == '[':
+		d.Array()
+		for d.More() {
+			d.Skip()
+		}
+	case c == '"':
+		d.str()
+	case c == 't' || c == 'f' || c == 'n':
+		d.Bool()
+	case c == '-' || ('0' <= c && c <= '9'):
+		d.number()
+	default:
+		d.fail("expected value")
+	}
+}
+
+// Raw reads the next value as it is
+func (d *Decoder) Raw() json.RawMessage {
+	d.space()
+	start := d.pos
+	d.Skip()
+	if d.err != nil {
+		return nil
+	}
+	return d.data[start:d.pos]
+}
+
+// Interface reads the next value the way encoding/json
+// reads into interface{}
+func (d *Decoder) Interface() interface{} {
+	switch c := d.peek(); {
+	case c == '{':
+		return d.Map()
+	case c == '[':
+		d.Array()
+		v := make([]interface{}, 0)
+		for d.More() {
+			v = append(v, d.Interface())
+		}
+		return v
+	case c == '"':
+		return d.String()
+	case c == 'n':
+		d.Null()
+		return nil
+	case c == 't' || c == 'f':
+		return d.Bool()
+	}
+	return d.Float()
+}
+
+// Map reads an object
+// null is nil
+func (d *Decoder) Map() map[string]interface{} {
+	if !d.Object() {
+		return nil
+	}
+	m := make(map[string]interface{})
+	for d.More() {
+		k := string(d.Key())
+		m[k] = d.Interface()
+	}
+	return m
+}
+
+// Any reads the next value into v with encoding/json
+// used for values the protocol does not describe
+func (d *Decoder) Any(v interface{}) {
+	raw := d.Raw()
+	if raw == nil {
+		return
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		d.fail(err.Error())
+	}
+}
@@ -0,0 +1,244 @@
+package cdp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// encoding/json without html escapes
+func stdMarshal(t *testing.T, v interface{}) string {
+	t.Helper()
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	return string(bytes.TrimSuffix(b.Bytes(), []byte("\n")))
+}
+
+func TestEncoderMatchesEncodingJSON(t *testing.T) {
+	strings := []string{
+		"",
+		"plain",
+		`quote " backslash \ slash /`,
+		"control \x00 \x01 \x1f \b \f \n \r \t",
+		"<html> & 'single'",
+		"café 日本 \U0001F600",
+		"separators    ",
+		"invalid \xff \xc3 utf-8",
+	}
+	for _, s := range strings {
+		var e Encoder
+		e.String(s)
+		if got, want := string(e.Bytes()), stdMarshal(t, s); got != want {
+			t.Errorf("String(%q) = %s, expected %s", s, got, want)
+		}
+	}
+
+	floats := []float64{0, 1, -1, 0.5, 1.0 / 3, 1e-6, 1e-7, 123456789, 1e20, 1e21, 1.5e300, -2.5e-10, math.MaxFloat64, math.SmallestNonzeroFloat64}
+	for _, f := range floats {
+		var e Encoder
+		e.Float(f)
+		if got, want := string(e.Bytes()), stdMarshal(t, f); got != want {
+			t.Errorf("Float(%v) = %s, expected %s", f, got, want)
+		}
+	}
+
+	v := map[string]interface{}{
+		"b": []interface{}{1.5, "x", nil, true, map[string]interface{}{}},
+		"a": map[string]interface{}{"z": false, "y": []interface{}{}},
+	}
+	var e Encoder
+	e.Interface(v)
+	if got, want := string(e.Bytes()), stdMarshal(t, v); got != want {
+		t.Errorf("Interface = %s, expected %s", got, want)
+	}
+}
+
+func TestEncoderCommas(t *testing.T) {
+	var e Encoder
+	e.ObjectStart()
+	e.Key("a")
+	e.ArrayStart()
+	for i := 0; i < 3; i++ {
+		e.Elem()
+		e.Int(i)
+	}
+	e.ArrayEnd()
+	e.Key("b")
+	e.ObjectStart()
+	e.ObjectEnd()
+	e.Key("c")
+	e.Raw(nil)
+	e.ObjectEnd()
+	if got := string(e.Bytes()); got != `{"a":[0,1,2],"b":{},"c":null}` {
+		t.Errorf("unexpected json: %s", got)
+	}
+
+	e.Reset()
+	e.Float(math.NaN())
+	if e.Err() == nil {
+		t.Error("expected an error for NaN")
+	}
+}
+
+func TestDecoderMatchesEncodingJSON(t *testing.T) {
+	docs := []string{
+		`null`,
+		`true`,
+		` false `,
+		`0`,
+		`-12.5e+3`,
+		`1E-7`,
+		`""`,
+		`"esc \" \\ \/ \b \f \n \r \t é 日"`,
+		`"pair 😀"`,
+		`"lone \ud83d end"`,
+		`"lone \ud83dA"`,
+		`"raw caf` + "é" + `"`,
+		`[]`,
+		`{}`,
+		`[1, "two", [3], {"four": 4}, null]`,
+		"{\"a\" :\n\t{\"b\": [true, false, null]}, \"c\": \"d\"}",
+	}
+	for _, doc := range docs {
+		var want interface{}
+		if err := json.Unmarshal([]byte(doc), &want); err != nil {
+			t.Fatalf("%s: %v", doc, err)
+		}
+		d := NewDecoder([]byte(doc))
+		got := d.Interface()
+		if err := d.End(); err != nil {
+			t.Errorf("%s: %v", doc, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %#v, expected %#v", doc, got, want)
+		}
+	}
+}
+
+func TestDecoderSkipAndRaw(t *testing.T) {
+	doc := `{"skip": {"a": [1, {"b": "}"}], "c": "]"}, "keep": [1, 2], "n": 3}`
+	d := NewDecoder([]byte(doc))
+	var raw json.RawMessage
+	var n int
+	if !d.Object() {
+		t.Fatal(d.Err())
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "keep":
+			raw = d.Raw()
+		case "n":
+			n = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+	if err := d.End(); err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `[1, 2]` || n != 3 {
+		t.Errorf("unexpected raw %s and n %d", raw, n)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	docs := []string{
+		``,
+		`{`,
+		`{"a" 1}`,
+		`{"a": 1,}`,
+		`[1 2]`,
+		`"unterminated`,
+		"\"control \x01\"",
+		`"bad \x"`,
+		`"bad \u12"`,
+		`tru`,
+		`-`,
+		`1.`,
+		`1e`,
+		`{} {}`,
+		`@`,
+	}
+	for _, doc := range docs {
+		d := NewDecoder([]byte(doc))
+		d.Interface()
+		err := d.End()
+		if !errors.Is(err, ErrSyntax) {
+			t.Errorf("%q: expected ErrSyntax, got %v", doc, err)
+		}
+		if json.Valid([]byte(doc)) {
+			t.Errorf("%q: encoding/json thinks this is valid", doc)
+		}
+	}
+
+	// a float is not an int
+	d := NewDecoder([]byte(`1.5`))
+	d.Int()
+	if err := d.End(); !errors.Is(err, ErrSyntax) {
+		t.Errorf("expected ErrSyntax, got %v", err)
+	}
+}
+
+type point struct {
+	X, Y int
+}
+
+func (p *point) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Int(p.X)
+	e.Key("y")
+	e.Int(p.Y)
+	e.ObjectEnd()
+}
+
+func (p *point) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			p.X = d.Int()
+		case "y":
+			p.Y = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+func TestMarshalUsesMethods(t *testing.T) {
+	data, err := Marshal(&point{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"x":1,"y":2}` {
+		t.Errorf("unexpected json: %s", data)
+	}
+
+	var p point
+	if err := Unmarshal([]byte(`{"y": 4, "z": [5], "x": 3}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if p != (point{3, 4}) {
+		t.Errorf("unexpected point: %+v", p)
+	}
+	if err := Unmarshal([]byte(`{"x": "3"}`), &p); !errors.Is(err, ErrSyntax) {
+		t.Errorf("expected ErrSyntax, got %v", err)
+	}
+
+	// anything else uses encoding/json
+	data, err = Marshal(map[string]int{"a": 1})
+	if err != nil || string(data) != `{"a":1}` {
+		t.Errorf("unexpected json: %s %v", data, err)
+	}
+}
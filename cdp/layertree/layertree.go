@@ -0,0 +1,1131 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package layertree is the LayerTree domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package layertree
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends LayerTree commands and listens for LayerTree events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Unique Layer identifier.
+type LayerId string
+
+// Unique snapshot identifier.
+type SnapshotId string
+
+// Rectangle where scrolling happens on the main thread.
+type ScrollRect struct {
+	/* Rectangle itself. */
+	Rect cdp.DOMRect `json:"rect"`
+	/* Reason for rectangle to force scrolling on the main thread */
+	Type ScrollRectType `json:"type"`
+}
+
+// MarshalCDP writes ScrollRect as JSON
+func (v *ScrollRect) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("rect")
+	v.Rect.MarshalCDP(e)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScrollRect) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScrollRect from JSON
+func (v *ScrollRect) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "rect":
+			v.Rect.UnmarshalCDP(d)
+		case "type":
+			v.Type = ScrollRectType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScrollRect) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScrollRectType string
+
+// ScrollRectType values
+const (
+	ScrollRectTypeRepaintsOnScroll  ScrollRectType = "RepaintsOnScroll"
+	ScrollRectTypeTouchEventHandler ScrollRectType = "TouchEventHandler"
+	ScrollRectTypeWheelEventHandler ScrollRectType = "WheelEventHandler"
+)
+
+// Values gives every ScrollRectType
+func (ScrollRectType) Values() []ScrollRectType {
+	return []ScrollRectType{
+		ScrollRectTypeRepaintsOnScroll,
+		ScrollRectTypeTouchEventHandler,
+		ScrollRectTypeWheelEventHandler,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ScrollRectType) Valid() bool {
+	switch v {
+	case ScrollRectTypeRepaintsOnScroll, ScrollRectTypeTouchEventHandler, ScrollRectTypeWheelEventHandler:
+		return true
+	}
+	return false
+}
+
+// Sticky position constraints.
+type StickyPositionConstraint struct {
+	/* Layout rectangle of the sticky element before being shifted */
+	StickyBoxRect cdp.DOMRect `json:"stickyBoxRect"`
+	/* Layout rectangle of the containing block of the sticky element */
+	ContainingBlockRect cdp.DOMRect `json:"containingBlockRect"`
+	/* The nearest sticky layer that shifts the sticky box */
+	NearestLayerShiftingStickyBox *LayerId `json:"nearestLayerShiftingStickyBox,omitempty"`
+	/* The nearest sticky layer that shifts the containing block */
+	NearestLayerShiftingContainingBlock *LayerId `json:"nearestLayerShiftingContainingBlock,omitempty"`
+}
+
+// MarshalCDP writes StickyPositionConstraint as JSON
+func (v *StickyPositionConstraint) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("stickyBoxRect")
+	v.StickyBoxRect.MarshalCDP(e)
+	e.Key("containingBlockRect")
+	v.ContainingBlockRect.MarshalCDP(e)
+	if v.NearestLayerShiftingStickyBox != nil {
+		e.Key("nearestLayerShiftingStickyBox")
+		e.String(string(*v.NearestLayerShiftingStickyBox))
+	}
+	if v.NearestLayerShiftingContainingBlock != nil {
+		e.Key("nearestLayerShiftingContainingBlock")
+		e.String(string(*v.NearestLayerShiftingContainingBlock))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StickyPositionConstraint) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads StickyPositionConstraint from JSON
+func (v *StickyPositionConstraint) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "stickyBoxRect":
+			v.StickyBoxRect.UnmarshalCDP(d)
+		case "containingBlockRect":
+			v.ContainingBlockRect.UnmarshalCDP(d)
+		case "nearestLayerShiftingStickyBox":
+			if d.Null() {
+				v.NearestLayerShiftingStickyBox = nil
+			} else {
+				v.NearestLayerShiftingStickyBox = new(LayerId)
+				*v.NearestLayerShiftingStickyBox = LayerId(d.String())
+			}
+		case "nearestLayerShiftingContainingBlock":
+			if d.Null() {
+				v.NearestLayerShiftingContainingBlock = nil
+			} else {
+				v.NearestLayerShiftingContainingBlock = new(LayerId)
+				*v.NearestLayerShiftingContainingBlock = LayerId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StickyPositionConstraint) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Serialized fragment of layer picture along with its offset within the layer.
+type PictureTile struct {
+	/* Offset from owning layer left boundary */
+	X float64 `json:"x"`
+	/* Offset from owning layer top boundary */
+	Y float64 `json:"y"`
+	/* Base64-encoded snapshot data. (Encoded as a base64 string when passed over JSON) */
+	Picture string `json:"picture"`
+}
+
+// MarshalCDP writes PictureTile as JSON
+func (v *PictureTile) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("picture")
+	e.String(v.Picture)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PictureTile) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PictureTile from JSON
+func (v *PictureTile) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			v.X = d.Float()
+		case "y":
+			v.Y = d.Float()
+		case "picture":
+			v.Picture = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PictureTile) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about a compositing layer.
+type Layer struct {
+	/* The unique id for this layer. */
+	LayerId LayerId `json:"layerId"`
+	/* The id of parent (not present for root). */
+	ParentLayerId *LayerId `json:"parentLayerId,omitempty"`
+	/* The backend id for the node associated with this layer. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* Offset from parent layer, X coordinate. */
+	OffsetX float64 `json:"offsetX"`
+	/* Offset from parent layer, Y coordinate. */
+	OffsetY float64 `json:"offsetY"`
+	/* Layer width. */
+	Width float64 `json:"width"`
+	/* Layer height. */
+	Height float64 `json:"height"`
+	/* Transformation matrix for layer, default is identity matrix */
+	Transform []float64 `json:"transform"`
+	/* Transform anchor point X, absent if no transform specified */
+	AnchorX *float64 `json:"anchorX,omitempty"`
+	/* Transform anchor point Y, absent if no transform specified */
+	AnchorY *float64 `json:"anchorY,omitempty"`
+	/* Transform anchor point Z, absent if no transform specified */
+	AnchorZ *float64 `json:"anchorZ,omitempty"`
+	/* Indicates how many time this layer has painted. */
+	PaintCount int `json:"paintCount"`
+	/* Indicates whether this layer hosts any content, rather than being used for
+	transform/scrolling purposes only. */
+	DrawsContent bool `json:"drawsContent"`
+	/* Set if layer is not visible. */
+	Invisible *bool `json:"invisible,omitempty"`
+	/* Rectangles scrolling on main thread only. */
+	ScrollRects []ScrollRect `json:"scrollRects"`
+	/* Sticky position constraint information */
+	StickyPositionConstraint *StickyPositionConstraint `json:"stickyPositionConstraint,omitempty"`
+}
+
+// MarshalCDP writes Layer as JSON
+func (v *Layer) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("layerId")
+	e.String(string(v.LayerId))
+	if v.ParentLayerId != nil {
+		e.Key("parentLayerId")
+		e.String(string(*v.ParentLayerId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	e.Key("offsetX")
+	e.Float(v.OffsetX)
+	e.Key("offsetY")
+	e.Float(v.OffsetY)
+	e.Key("width")
+	e.Float(v.Width)
+	e.Key("height")
+	e.Float(v.Height)
+	if v.Transform != nil {
+		e.Key("transform")
+		e.ArrayStart()
+		for i0 := range v.Transform {
+			e.Elem()
+			e.Float(v.Transform[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.AnchorX != nil {
+		e.Key("anchorX")
+		e.Float(*v.AnchorX)
+	}
+	if v.AnchorY != nil {
+		e.Key("anchorY")
+		e.Float(*v.AnchorY)
+	}
+	if v.AnchorZ != nil {
+		e.Key("anchorZ")
+		e.Float(*v.AnchorZ)
+	}
+	e.Key("paintCount")
+	e.Int(v.PaintCount)
+	e.Key("drawsContent")
+	e.Bool(v.DrawsContent)
+	if v.Invisible != nil {
+		e.Key("invisible")
+		e.Bool(*v.Invisible)
+	}
+	if v.ScrollRects != nil {
+		e.Key("scrollRects")
+		e.ArrayStart()
+		for i0 := range v.ScrollRects {
+			e.Elem()
+			v.ScrollRects[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.StickyPositionConstraint != nil {
+		e.Key("stickyPositionConstraint")
+		(*v.StickyPositionConstraint).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Layer) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Layer from JSON
+func (v *Layer) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "layerId":
+			v.LayerId = LayerId(d.String())
+		case "parentLayerId":
+			if d.Null() {
+				v.ParentLayerId = nil
+			} else {
+				v.ParentLayerId = new(LayerId)
+				*v.ParentLayerId = LayerId(d.String())
+			}
+		case "backendNodeId":
+			if d.Null() {
+				v.BackendNodeId = nil
+			} else {
+				v.BackendNodeId = new(cdp.DOMBackendNodeId)
+				*v.BackendNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "offsetX":
+			v.OffsetX = d.Float()
+		case "offsetY":
+			v.OffsetY = d.Float()
+		case "width":
+			v.Width = d.Float()
+		case "height":
+			v.Height = d.Float()
+		case "transform":
+			if d.Array() {
+				v.Transform = make([]float64, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.Transform = append(v.Transform, x0)
+				}
+			} else {
+				v.Transform = nil
+			}
+		case "anchorX":
+			if d.Null() {
+				v.AnchorX = nil
+			} else {
+				v.AnchorX = new(float64)
+				*v.AnchorX = d.Float()
+			}
+		case "anchorY":
+			if d.Null() {
+				v.AnchorY = nil
+			} else {
+				v.AnchorY = new(float64)
+				*v.AnchorY = d.Float()
+			}
+		case "anchorZ":
+			if d.Null() {
+				v.AnchorZ = nil
+			} else {
+				v.AnchorZ = new(float64)
+				*v.AnchorZ = d.Float()
+			}
+		case "paintCount":
+			v.PaintCount = d.Int()
+		case "drawsContent":
+			v.DrawsContent = d.Bool()
+		case "invisible":
+			if d.Null() {
+				v.Invisible = nil
+			} else {
+				v.Invisible = new(bool)
+				*v.Invisible = d.Bool()
+			}
+		case "scrollRects":
+			if d.Array() {
+				v.ScrollRects = make([]ScrollRect, 0)
+				for d.More() {
+					var x0 ScrollRect
+					x0.UnmarshalCDP(d)
+					v.ScrollRects = append(v.ScrollRects, x0)
+				}
+			} else {
+				v.ScrollRects = nil
+			}
+		case "stickyPositionConstraint":
+			if d.Null() {
+				v.StickyPositionConstraint = nil
+			} else {
+				v.StickyPositionConstraint = new(StickyPositionConstraint)
+				(*v.StickyPositionConstraint).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Layer) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Array of timings, one per paint step.
+type PaintProfile []float64
+
+type CompositingReasonsReturns struct {
+
+	/* A list of strings specifying reasons for the given layer to become composited. */
+	CompositingReasons []string `json:"compositingReasons"`
+
+	/* A list of strings specifying reason IDs for the given layer to become composited. */
+	CompositingReasonIds []string `json:"compositingReasonIds"`
+}
+
+// UnmarshalCDP reads CompositingReasonsReturns from JSON
+func (v *CompositingReasonsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "compositingReasons":
+			if d.Array() {
+				v.CompositingReasons = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.CompositingReasons = append(v.CompositingReasons, x0)
+				}
+			} else {
+				v.CompositingReasons = nil
+			}
+		case "compositingReasonIds":
+			if d.Array() {
+				v.CompositingReasonIds = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.CompositingReasonIds = append(v.CompositingReasonIds, x0)
+				}
+			} else {
+				v.CompositingReasonIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CompositingReasonsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CompositingReasonsParams are the parameters for LayerTree.compositingReasons
+// optional parameters are left out when nil
+type CompositingReasonsParams struct {
+	/* The id of the layer for which we want to get the reasons it was composited. */
+	LayerId LayerId `json:"layerId"`
+}
+
+// MarshalCDP writes CompositingReasonsParams as JSON
+func (v *CompositingReasonsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("layerId")
+	e.String(string(v.LayerId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CompositingReasonsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Provides the reasons why the given layer was composited.
+func (c Client) CompositingReasons(params CompositingReasonsParams) (CompositingReasonsReturns, error) {
+	return c.CompositingReasonsContext(context.Background(), params)
+}
+
+// CompositingReasonsContext is CompositingReasons with a context for cancellation and deadlines
+func (c Client) CompositingReasonsContext(ctx context.Context, params CompositingReasonsParams) (CompositingReasonsReturns, error) {
+	var returns_ CompositingReasonsReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.compositingReasons", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables compositing tree inspection.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables compositing tree inspection.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type LoadSnapshotReturns struct {
+
+	/* The id of the snapshot. */
+	SnapshotId SnapshotId `json:"snapshotId"`
+}
+
+// UnmarshalCDP reads LoadSnapshotReturns from JSON
+func (v *LoadSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "snapshotId":
+			v.SnapshotId = SnapshotId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// LoadSnapshotParams are the parameters for LayerTree.loadSnapshot
+// optional parameters are left out when nil
+type LoadSnapshotParams struct {
+	/* An array of tiles composing the snapshot. */
+	Tiles []PictureTile `json:"tiles"`
+}
+
+// MarshalCDP writes LoadSnapshotParams as JSON
+func (v *LoadSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("tiles")
+	if v.Tiles == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Tiles {
+			e.Elem()
+			v.Tiles[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LoadSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the snapshot identifier.
+func (c Client) LoadSnapshot(params LoadSnapshotParams) (LoadSnapshotReturns, error) {
+	return c.LoadSnapshotContext(context.Background(), params)
+}
+
+// LoadSnapshotContext is LoadSnapshot with a context for cancellation and deadlines
+func (c Client) LoadSnapshotContext(ctx context.Context, params LoadSnapshotParams) (LoadSnapshotReturns, error) {
+	var returns_ LoadSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.loadSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type MakeSnapshotReturns struct {
+
+	/* The id of the layer snapshot. */
+	SnapshotId SnapshotId `json:"snapshotId"`
+}
+
+// UnmarshalCDP reads MakeSnapshotReturns from JSON
+func (v *MakeSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "snapshotId":
+			v.SnapshotId = SnapshotId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *MakeSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// MakeSnapshotParams are the parameters for LayerTree.makeSnapshot
+// optional parameters are left out when nil
+type MakeSnapshotParams struct {
+	/* The id of the layer. */
+	LayerId LayerId `json:"layerId"`
+}
+
+// MarshalCDP writes MakeSnapshotParams as JSON
+func (v *MakeSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("layerId")
+	e.String(string(v.LayerId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v MakeSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the layer snapshot identifier.
+func (c Client) MakeSnapshot(params MakeSnapshotParams) (MakeSnapshotReturns, error) {
+	return c.MakeSnapshotContext(context.Background(), params)
+}
+
+// MakeSnapshotContext is MakeSnapshot with a context for cancellation and deadlines
+func (c Client) MakeSnapshotContext(ctx context.Context, params MakeSnapshotParams) (MakeSnapshotReturns, error) {
+	var returns_ MakeSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.makeSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ProfileSnapshotReturns struct {
+
+	/* The array of paint profiles, one per run. */
+	Timings []PaintProfile `json:"timings"`
+}
+
+// UnmarshalCDP reads ProfileSnapshotReturns from JSON
+func (v *ProfileSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timings":
+			if d.Array() {
+				v.Timings = make([]PaintProfile, 0)
+				for d.More() {
+					var x0 PaintProfile
+					if d.Array() {
+						x0 = make(PaintProfile, 0)
+						for d.More() {
+							var x1 float64
+							x1 = d.Float()
+							x0 = append(x0, x1)
+						}
+					} else {
+						x0 = nil
+					}
+					v.Timings = append(v.Timings, x0)
+				}
+			} else {
+				v.Timings = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ProfileSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ProfileSnapshotParams are the parameters for LayerTree.profileSnapshot
+// optional parameters are left out when nil
+type ProfileSnapshotParams struct {
+	/* The id of the layer snapshot. */
+	SnapshotId SnapshotId `json:"snapshotId"`
+	/* The maximum number of times to replay the snapshot (1, if not specified). */
+	MinRepeatCount *int `json:"minRepeatCount,omitempty"`
+	/* The minimum duration (in seconds) to replay the snapshot. */
+	MinDuration *float64 `json:"minDuration,omitempty"`
+	/* The clip rectangle to apply when replaying the snapshot. */
+	ClipRect *cdp.DOMRect `json:"clipRect,omitempty"`
+}
+
+// MarshalCDP writes ProfileSnapshotParams as JSON
+func (v *ProfileSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("snapshotId")
+	e.String(string(v.SnapshotId))
+	if v.MinRepeatCount != nil {
+		e.Key("minRepeatCount")
+		e.Int(*v.MinRepeatCount)
+	}
+	if v.MinDuration != nil {
+		e.Key("minDuration")
+		e.Float(*v.MinDuration)
+	}
+	if v.ClipRect != nil {
+		e.Key("clipRect")
+		(*v.ClipRect).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ProfileSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) ProfileSnapshot(params ProfileSnapshotParams) (ProfileSnapshotReturns, error) {
+	return c.ProfileSnapshotContext(context.Background(), params)
+}
+
+// ProfileSnapshotContext is ProfileSnapshot with a context for cancellation and deadlines
+func (c Client) ProfileSnapshotContext(ctx context.Context, params ProfileSnapshotParams) (ProfileSnapshotReturns, error) {
+	var returns_ ProfileSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.profileSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ReleaseSnapshotReturns struct {
+}
+
+// UnmarshalCDP reads ReleaseSnapshotReturns from JSON
+func (v *ReleaseSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReleaseSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReleaseSnapshotParams are the parameters for LayerTree.releaseSnapshot
+// optional parameters are left out when nil
+type ReleaseSnapshotParams struct {
+	/* The id of the layer snapshot. */
+	SnapshotId SnapshotId `json:"snapshotId"`
+}
+
+// MarshalCDP writes ReleaseSnapshotParams as JSON
+func (v *ReleaseSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("snapshotId")
+	e.String(string(v.SnapshotId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReleaseSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Releases layer snapshot captured by the back-end.
+func (c Client) ReleaseSnapshot(params ReleaseSnapshotParams) (ReleaseSnapshotReturns, error) {
+	return c.ReleaseSnapshotContext(context.Background(), params)
+}
+
+// ReleaseSnapshotContext is ReleaseSnapshot with a context for cancellation and deadlines
+func (c Client) ReleaseSnapshotContext(ctx context.Context, params ReleaseSnapshotParams) (ReleaseSnapshotReturns, error) {
+	var returns_ ReleaseSnapshotReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.releaseSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ReplaySnapshotReturns struct {
+
+	/* A data: URL for resulting image. */
+	DataURL string `json:"dataURL"`
+}
+
+// UnmarshalCDP reads ReplaySnapshotReturns from JSON
+func (v *ReplaySnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "dataURL":
+			v.DataURL = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReplaySnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReplaySnapshotParams are the parameters for LayerTree.replaySnapshot
+// optional parameters are left out when nil
+type ReplaySnapshotParams struct {
+	/* The id of the layer snapshot. */
+	SnapshotId SnapshotId `json:"snapshotId"`
+	/* The first step to replay from (replay from the very start if not specified). */
+	FromStep *int `json:"fromStep,omitempty"`
+	/* The last step to replay to (replay till the end if not specified). */
+	ToStep *int `json:"toStep,omitempty"`
+	/* The scale to apply while replaying (defaults to 1). */
+	Scale *float64 `json:"scale,omitempty"`
+}
+
+// MarshalCDP writes ReplaySnapshotParams as JSON
+func (v *ReplaySnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("snapshotId")
+	e.String(string(v.SnapshotId))
+	if v.FromStep != nil {
+		e.Key("fromStep")
+		e.Int(*v.FromStep)
+	}
+	if v.ToStep != nil {
+		e.Key("toStep")
+		e.Int(*v.ToStep)
+	}
+	if v.Scale != nil {
+		e.Key("scale")
+		e.Float(*v.Scale)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReplaySnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Replays the layer snapshot and returns the resulting bitmap.
+func (c Client) ReplaySnapshot(params ReplaySnapshotParams) (ReplaySnapshotReturns, error) {
+	return c.ReplaySnapshotContext(context.Background(), params)
+}
+
+// ReplaySnapshotContext is ReplaySnapshot with a context for cancellation and deadlines
+func (c Client) ReplaySnapshotContext(ctx context.Context, params ReplaySnapshotParams) (ReplaySnapshotReturns, error) {
+	var returns_ ReplaySnapshotReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.replaySnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SnapshotCommandLogReturns struct {
+
+	/* The array of canvas function calls. */
+	CommandLog []map[string]interface{} `json:"commandLog"`
+}
+
+// UnmarshalCDP reads SnapshotCommandLogReturns from JSON
+func (v *SnapshotCommandLogReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "commandLog":
+			if d.Array() {
+				v.CommandLog = make([]map[string]interface{}, 0)
+				for d.More() {
+					var x0 map[string]interface{}
+					x0 = d.Map()
+					v.CommandLog = append(v.CommandLog, x0)
+				}
+			} else {
+				v.CommandLog = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SnapshotCommandLogReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SnapshotCommandLogParams are the parameters for LayerTree.snapshotCommandLog
+// optional parameters are left out when nil
+type SnapshotCommandLogParams struct {
+	/* The id of the layer snapshot. */
+	SnapshotId SnapshotId `json:"snapshotId"`
+}
+
+// MarshalCDP writes SnapshotCommandLogParams as JSON
+func (v *SnapshotCommandLogParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("snapshotId")
+	e.String(string(v.SnapshotId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SnapshotCommandLogParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Replays the layer snapshot and returns canvas log.
+func (c Client) SnapshotCommandLog(params SnapshotCommandLogParams) (SnapshotCommandLogReturns, error) {
+	return c.SnapshotCommandLogContext(context.Background(), params)
+}
+
+// SnapshotCommandLogContext is SnapshotCommandLog with a context for cancellation and deadlines
+func (c Client) SnapshotCommandLogContext(ctx context.Context, params SnapshotCommandLogParams) (SnapshotCommandLogReturns, error) {
+	var returns_ SnapshotCommandLogReturns
+
+	err_ := c.caller.Call(ctx, "LayerTree.snapshotCommandLog", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type LayerPaintedEvent struct {
+
+	/* The id of the painted layer. */
+	LayerId LayerId `json:"layerId"`
+
+	/* Clip rectangle. */
+	Clip cdp.DOMRect `json:"clip"`
+}
+
+// UnmarshalCDP reads LayerPaintedEvent from JSON
+func (v *LayerPaintedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "layerId":
+			v.LayerId = LayerId(d.String())
+		case "clip":
+			v.Clip.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayerPaintedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LayerPaintedHandler func(ev LayerPaintedEvent)
+
+// EventMethod is LayerTree.layerPainted
+func (LayerPaintedEvent) EventMethod() string {
+	return "LayerTree.layerPainted"
+}
+
+// OnLayerPainted calls handler for each LayerTree.layerPainted event
+func (c Client) OnLayerPainted(handler LayerPaintedHandler) (unsubscribe func()) {
+	return c.caller.On("LayerTree.layerPainted", func(ev interface{}) {
+		handler(ev.(LayerPaintedEvent))
+	})
+}
+
+type LayerTreeDidChangeEvent struct {
+
+	/* Layer tree, absent if not in the compositing mode. */
+	Layers []Layer `json:"layers,omitempty"`
+}
+
+// UnmarshalCDP reads LayerTreeDidChangeEvent from JSON
+func (v *LayerTreeDidChangeEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "layers":
+			if d.Array() {
+				v.Layers = make([]Layer, 0)
+				for d.More() {
+					var x0 Layer
+					x0.UnmarshalCDP(d)
+					v.Layers = append(v.Layers, x0)
+				}
+			} else {
+				v.Layers = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayerTreeDidChangeEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LayerTreeDidChangeHandler func(ev LayerTreeDidChangeEvent)
+
+// EventMethod is LayerTree.layerTreeDidChange
+func (LayerTreeDidChangeEvent) EventMethod() string {
+	return "LayerTree.layerTreeDidChange"
+}
+
+// OnLayerTreeDidChange calls handler for each LayerTree.layerTreeDidChange event
+func (c Client) OnLayerTreeDidChange(handler LayerTreeDidChangeHandler) (unsubscribe func()) {
+	return c.caller.On("LayerTree.layerTreeDidChange", func(ev interface{}) {
+		handler(ev.(LayerTreeDidChangeEvent))
+	})
+}
+
+// decoders for LayerTree events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"LayerTree.layerPainted":       cdp.Decode[LayerPaintedEvent],
+	"LayerTree.layerTreeDidChange": cdp.Decode[LayerTreeDidChangeEvent],
+}
+
+// DecodeEvent decodes a LayerTree event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,622 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package log is the Log domain of the chrome devtools protocol
+//
+// Provides access to log entries.
+package log
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Log commands and listens for Log events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Log entry.
+type LogEntry struct {
+	/* Log entry source. */
+	Source LogEntrySource `json:"source"`
+	/* Log entry severity. */
+	Level LogEntryLevel `json:"level"`
+	/* Logged text. */
+	Text     string            `json:"text"`
+	Category *LogEntryCategory `json:"category,omitempty"`
+	/* Timestamp when this entry was added. */
+	Timestamp cdp.RuntimeTimestamp `json:"timestamp"`
+	/* URL of the resource if known. */
+	Url *string `json:"url,omitempty"`
+	/* Line number in the resource. */
+	LineNumber *int `json:"lineNumber,omitempty"`
+	/* JavaScript stack trace. */
+	StackTrace *cdp.RuntimeStackTrace `json:"stackTrace,omitempty"`
+	/* Identifier of the network request associated with this entry. */
+	NetworkRequestId *cdp.NetworkRequestId `json:"networkRequestId,omitempty"`
+	/* Identifier of the worker associated with this entry. */
+	WorkerId *string `json:"workerId,omitempty"`
+	/* Call arguments. */
+	Args []cdp.RuntimeRemoteObject `json:"args"`
+}
+
+// MarshalCDP writes LogEntry as JSON
+func (v *LogEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("source")
+	e.String(string(v.Source))
+	e.Key("level")
+	e.String(string(v.Level))
+	e.Key("text")
+	e.String(v.Text)
+	if v.Category != nil {
+		e.Key("category")
+		e.String(string(*v.Category))
+	}
+	e.Key("timestamp")
+	e.Float(float64(v.Timestamp))
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.LineNumber != nil {
+		e.Key("lineNumber")
+		e.Int(*v.LineNumber)
+	}
+	if v.StackTrace != nil {
+		e.Key("stackTrace")
+		(*v.StackTrace).MarshalCDP(e)
+	}
+	if v.NetworkRequestId != nil {
+		e.Key("networkRequestId")
+		e.String(string(*v.NetworkRequestId))
+	}
+	if v.WorkerId != nil {
+		e.Key("workerId")
+		e.String(*v.WorkerId)
+	}
+	if v.Args != nil {
+		e.Key("args")
+		e.ArrayStart()
+		for i0 := range v.Args {
+			e.Elem()
+			v.Args[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LogEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LogEntry from JSON
+func (v *LogEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "source":
+			v.Source = LogEntrySource(d.String())
+		case "level":
+			v.Level = LogEntryLevel(d.String())
+		case "text":
+			v.Text = d.String()
+		case "category":
+			if d.Null() {
+				v.Category = nil
+			} else {
+				v.Category = new(LogEntryCategory)
+				*v.Category = LogEntryCategory(d.String())
+			}
+		case "timestamp":
+			v.Timestamp = cdp.RuntimeTimestamp(d.Float())
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "lineNumber":
+			if d.Null() {
+				v.LineNumber = nil
+			} else {
+				v.LineNumber = new(int)
+				*v.LineNumber = d.Int()
+			}
+		case "stackTrace":
+			if d.Null() {
+				v.StackTrace = nil
+			} else {
+				v.StackTrace = new(cdp.RuntimeStackTrace)
+				(*v.StackTrace).UnmarshalCDP(d)
+			}
+		case "networkRequestId":
+			if d.Null() {
+				v.NetworkRequestId = nil
+			} else {
+				v.NetworkRequestId = new(cdp.NetworkRequestId)
+				*v.NetworkRequestId = cdp.NetworkRequestId(d.String())
+			}
+		case "workerId":
+			if d.Null() {
+				v.WorkerId = nil
+			} else {
+				v.WorkerId = new(string)
+				*v.WorkerId = d.String()
+			}
+		case "args":
+			if d.Array() {
+				v.Args = make([]cdp.RuntimeRemoteObject, 0)
+				for d.More() {
+					var x0 cdp.RuntimeRemoteObject
+					x0.UnmarshalCDP(d)
+					v.Args = append(v.Args, x0)
+				}
+			} else {
+				v.Args = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LogEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LogEntrySource string
+
+// LogEntrySource values
+const (
+	LogEntrySourceXml            LogEntrySource = "xml"
+	LogEntrySourceJavascript     LogEntrySource = "javascript"
+	LogEntrySourceNetwork        LogEntrySource = "network"
+	LogEntrySourceStorage        LogEntrySource = "storage"
+	LogEntrySourceAppcache       LogEntrySource = "appcache"
+	LogEntrySourceRendering      LogEntrySource = "rendering"
+	LogEntrySourceSecurity       LogEntrySource = "security"
+	LogEntrySourceDeprecation    LogEntrySource = "deprecation"
+	LogEntrySourceWorker         LogEntrySource = "worker"
+	LogEntrySourceViolation      LogEntrySource = "violation"
+	LogEntrySourceIntervention   LogEntrySource = "intervention"
+	LogEntrySourceRecommendation LogEntrySource = "recommendation"
+	LogEntrySourceOther          LogEntrySource = "other"
+)
+
+// Values gives every LogEntrySource
+func (LogEntrySource) Values() []LogEntrySource {
+	return []LogEntrySource{
+		LogEntrySourceXml,
+		LogEntrySourceJavascript,
+		LogEntrySourceNetwork,
+		LogEntrySourceStorage,
+		LogEntrySourceAppcache,
+		LogEntrySourceRendering,
+		LogEntrySourceSecurity,
+		LogEntrySourceDeprecation,
+		LogEntrySourceWorker,
+		LogEntrySourceViolation,
+		LogEntrySourceIntervention,
+		LogEntrySourceRecommendation,
+		LogEntrySourceOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v LogEntrySource) Valid() bool {
+	switch v {
+	case LogEntrySourceXml, LogEntrySourceJavascript, LogEntrySourceNetwork, LogEntrySourceStorage, LogEntrySourceAppcache, LogEntrySourceRendering, LogEntrySourceSecurity, LogEntrySourceDeprecation, LogEntrySourceWorker, LogEntrySourceViolation, LogEntrySourceIntervention, LogEntrySourceRecommendation, LogEntrySourceOther:
+		return true
+	}
+	return false
+}
+
+type LogEntryLevel string
+
+// LogEntryLevel values
+const (
+	LogEntryLevelVerbose LogEntryLevel = "verbose"
+	LogEntryLevelInfo    LogEntryLevel = "info"
+	LogEntryLevelWarning LogEntryLevel = "warning"
+	LogEntryLevelError   LogEntryLevel = "error"
+)
+
+// Values gives every LogEntryLevel
+func (LogEntryLevel) Values() []LogEntryLevel {
+	return []LogEntryLevel{
+		LogEntryLevelVerbose,
+		LogEntryLevelInfo,
+		LogEntryLevelWarning,
+		LogEntryLevelError,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v LogEntryLevel) Valid() bool {
+	switch v {
+	case LogEntryLevelVerbose, LogEntryLevelInfo, LogEntryLevelWarning, LogEntryLevelError:
+		return true
+	}
+	return false
+}
+
+type LogEntryCategory string
+
+// LogEntryCategory values
+const (
+	LogEntryCategoryCors LogEntryCategory = "cors"
+)
+
+// Values gives every LogEntryCategory
+func (LogEntryCategory) Values() []LogEntryCategory {
+	return []LogEntryCategory{
+		LogEntryCategoryCors,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v LogEntryCategory) Valid() bool {
+	switch v {
+	case LogEntryCategoryCors:
+		return true
+	}
+	return false
+}
+
+// Violation configuration setting.
+type ViolationSetting struct {
+	/* Violation type. */
+	Name ViolationSettingName `json:"name"`
+	/* Time threshold to trigger upon. */
+	Threshold float64 `json:"threshold"`
+}
+
+// MarshalCDP writes ViolationSetting as JSON
+func (v *ViolationSetting) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(string(v.Name))
+	e.Key("threshold")
+	e.Float(v.Threshold)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ViolationSetting) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ViolationSetting from JSON
+func (v *ViolationSetting) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = ViolationSettingName(d.String())
+		case "threshold":
+			v.Threshold = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ViolationSetting) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ViolationSettingName string
+
+// ViolationSettingName values
+const (
+	ViolationSettingNameLongTask          ViolationSettingName = "longTask"
+	ViolationSettingNameLongLayout        ViolationSettingName = "longLayout"
+	ViolationSettingNameBlockedEvent      ViolationSettingName = "blockedEvent"
+	ViolationSettingNameBlockedParser     ViolationSettingName = "blockedParser"
+	ViolationSettingNameDiscouragedAPIUse ViolationSettingName = "discouragedAPIUse"
+	ViolationSettingNameHandler           ViolationSettingName = "handler"
+	ViolationSettingNameRecurringHandler  ViolationSettingName = "recurringHandler"
+)
+
+// Values gives every ViolationSettingName
+func (ViolationSettingName) Values() []ViolationSettingName {
+	return []ViolationSettingName{
+		ViolationSettingNameLongTask,
+		ViolationSettingNameLongLayout,
+		ViolationSettingNameBlockedEvent,
+		ViolationSettingNameBlockedParser,
+		ViolationSettingNameDiscouragedAPIUse,
+		ViolationSettingNameHandler,
+		ViolationSettingNameRecurringHandler,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ViolationSettingName) Valid() bool {
+	switch v {
+	case ViolationSettingNameLongTask, ViolationSettingNameLongLayout, ViolationSettingNameBlockedEvent, ViolationSettingNameBlockedParser, ViolationSettingNameDiscouragedAPIUse, ViolationSettingNameHandler, ViolationSettingNameRecurringHandler:
+		return true
+	}
+	return false
+}
+
+type ClearReturns struct {
+}
+
+// UnmarshalCDP reads ClearReturns from JSON
+func (v *ClearReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the log.
+func (c Client) Clear() (ClearReturns, error) {
+	return c.ClearContext(context.Background())
+}
+
+// ClearContext is Clear with a context for cancellation and deadlines
+func (c Client) ClearContext(ctx context.Context) (ClearReturns, error) {
+	var returns_ ClearReturns
+
+	err_ := c.caller.Call(ctx, "Log.clear", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables log domain, prevents further log entries from being reported to the client.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Log.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables log domain, sends the entries collected so far to the client by means of the
+// `entryAdded` notification.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Log.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StartViolationsReportReturns struct {
+}
+
+// UnmarshalCDP reads StartViolationsReportReturns from JSON
+func (v *StartViolationsReportReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartViolationsReportReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartViolationsReportParams are the parameters for Log.startViolationsReport
+// optional parameters are left out when nil
+type StartViolationsReportParams struct {
+	/* Configuration for violations. */
+	Config []ViolationSetting `json:"config"`
+}
+
+// MarshalCDP writes StartViolationsReportParams as JSON
+func (v *StartViolationsReportParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("config")
+	if v.Config == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Config {
+			e.Elem()
+			v.Config[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartViolationsReportParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// start violation reporting.
+func (c Client) StartViolationsReport(params StartViolationsReportParams) (StartViolationsReportReturns, error) {
+	return c.StartViolationsReportContext(context.Background(), params)
+}
+
+// StartViolationsReportContext is StartViolationsReport with a context for cancellation and deadlines
+func (c Client) StartViolationsReportContext(ctx context.Context, params StartViolationsReportParams) (StartViolationsReportReturns, error) {
+	var returns_ StartViolationsReportReturns
+
+	err_ := c.caller.Call(ctx, "Log.startViolationsReport", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopViolationsReportReturns struct {
+}
+
+// UnmarshalCDP reads StopViolationsReportReturns from JSON
+func (v *StopViolationsReportReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopViolationsReportReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stop violation reporting.
+func (c Client) StopViolationsReport() (StopViolationsReportReturns, error) {
+	return c.StopViolationsReportContext(context.Background())
+}
+
+// StopViolationsReportContext is StopViolationsReport with a context for cancellation and deadlines
+func (c Client) StopViolationsReportContext(ctx context.Context) (StopViolationsReportReturns, error) {
+	var returns_ StopViolationsReportReturns
+
+	err_ := c.caller.Call(ctx, "Log.stopViolationsReport", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Issued when new message was logged.
+type EntryAddedEvent struct {
+
+	/* The entry. */
+	Entry LogEntry `json:"entry"`
+}
+
+// UnmarshalCDP reads EntryAddedEvent from JSON
+func (v *EntryAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "entry":
+			v.Entry.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EntryAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EntryAddedHandler func(ev EntryAddedEvent)
+
+// EventMethod is Log.entryAdded
+func (EntryAddedEvent) EventMethod() string {
+	return "Log.entryAdded"
+}
+
+// OnEntryAdded calls handler for each Log.entryAdded event
+func (c Client) OnEntryAdded(handler EntryAddedHandler) (unsubscribe func()) {
+	return c.caller.On("Log.entryAdded", func(ev interface{}) {
+		handler(ev.(EntryAddedEvent))
+	})
+}
+
+// decoders for Log events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Log.entryAdded": cdp.Decode[EntryAddedEvent],
+}
+
+// DecodeEvent decodes a Log event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
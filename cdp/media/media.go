@@ -0,0 +1,696 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package media is the Media domain of the chrome devtools protocol
+//
+// # This domain allows detailed inspection of media elements
+//
+// Experimental: this may change or be removed in any chrome release
+package media
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Media commands and listens for Media events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Players will get an ID that is unique within the agent context.
+type PlayerId string
+
+type Timestamp float64
+
+// Have one type per entry in MediaLogRecord::Type
+// Corresponds to kMessage
+type PlayerMessage struct {
+	/* Keep in sync with MediaLogMessageLevel
+	We are currently keeping the message level 'error' separate from the
+	PlayerError type because right now they represent different things,
+	this one being a DVLOG(ERROR) style log message that gets printed
+	based on what log level is selected in the UI, and the other is a
+	representation of a media::PipelineStatus object. Soon however we're
+	going to be moving away from using PipelineStatus for errors and
+	introducing a new error type which should hopefully let us integrate
+	the error log level into the PlayerError type. */
+	Level   PlayerMessageLevel `json:"level"`
+	Message string             `json:"message"`
+}
+
+// MarshalCDP writes PlayerMessage as JSON
+func (v *PlayerMessage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("level")
+	e.String(string(v.Level))
+	e.Key("message")
+	e.String(v.Message)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PlayerMessage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PlayerMessage from JSON
+func (v *PlayerMessage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "level":
+			v.Level = PlayerMessageLevel(d.String())
+		case "message":
+			v.Message = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerMessage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PlayerMessageLevel string
+
+// PlayerMessageLevel values
+const (
+	PlayerMessageLevelError   PlayerMessageLevel = "error"
+	PlayerMessageLevelWarning PlayerMessageLevel = "warning"
+	PlayerMessageLevelInfo    PlayerMessageLevel = "info"
+	PlayerMessageLevelDebug   PlayerMessageLevel = "debug"
+)
+
+// Values gives every PlayerMessageLevel
+func (PlayerMessageLevel) Values() []PlayerMessageLevel {
+	return []PlayerMessageLevel{
+		PlayerMessageLevelError,
+		PlayerMessageLevelWarning,
+		PlayerMessageLevelInfo,
+		PlayerMessageLevelDebug,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PlayerMessageLevel) Valid() bool {
+	switch v {
+	case PlayerMessageLevelError, PlayerMessageLevelWarning, PlayerMessageLevelInfo, PlayerMessageLevelDebug:
+		return true
+	}
+	return false
+}
+
+// Corresponds to kMediaPropertyChange
+type PlayerProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes PlayerProperty as JSON
+func (v *PlayerProperty) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PlayerProperty) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PlayerProperty from JSON
+func (v *PlayerProperty) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerProperty) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Corresponds to kMediaEventTriggered
+type PlayerEvent struct {
+	Timestamp Timestamp `json:"timestamp"`
+	Value     string    `json:"value"`
+}
+
+// MarshalCDP writes PlayerEvent as JSON
+func (v *PlayerEvent) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("timestamp")
+	e.Float(float64(v.Timestamp))
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PlayerEvent) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PlayerEvent from JSON
+func (v *PlayerEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = Timestamp(d.Float())
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Represents logged source line numbers reported in an error.
+// NOTE: file and line are from chromium c++ implementation code, not js.
+type PlayerErrorSourceLocation struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// MarshalCDP writes PlayerErrorSourceLocation as JSON
+func (v *PlayerErrorSourceLocation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("file")
+	e.String(v.File)
+	e.Key("line")
+	e.Int(v.Line)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PlayerErrorSourceLocation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PlayerErrorSourceLocation from JSON
+func (v *PlayerErrorSourceLocation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "file":
+			v.File = d.String()
+		case "line":
+			v.Line = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerErrorSourceLocation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Corresponds to kMediaError
+type PlayerError struct {
+	ErrorType string `json:"errorType"`
+	/* Code is the numeric enum entry for a specific set of error codes, such
+	as PipelineStatusCodes in media/base/pipeline_status.h */
+	Code int `json:"code"`
+	/* A trace of where this error was caused / where it passed through. */
+	Stack []PlayerErrorSourceLocation `json:"stack"`
+	/* Errors potentially have a root cause error, ie, a DecoderError might be
+	caused by an WindowsError */
+	Cause []PlayerError `json:"cause"`
+	/* Extra data attached to an error, such as an HRESULT, Video Codec, etc. */
+	Data map[string]interface{} `json:"data"`
+}
+
+// MarshalCDP writes PlayerError as JSON
+func (v *PlayerError) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("errorType")
+	e.String(v.ErrorType)
+	e.Key("code")
+	e.Int(v.Code)
+	e.Key("stack")
+	if v.Stack == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Stack {
+			e.Elem()
+			v.Stack[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("cause")
+	if v.Cause == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Cause {
+			e.Elem()
+			v.Cause[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("data")
+	e.Map(v.Data)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PlayerError) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PlayerError from JSON
+func (v *PlayerError) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "errorType":
+			v.ErrorType = d.String()
+		case "code":
+			v.Code = d.Int()
+		case "stack":
+			if d.Array() {
+				v.Stack = make([]PlayerErrorSourceLocation, 0)
+				for d.More() {
+					var x0 PlayerErrorSourceLocation
+					x0.UnmarshalCDP(d)
+					v.Stack = append(v.Stack, x0)
+				}
+			} else {
+				v.Stack = nil
+			}
+		case "cause":
+			if d.Array() {
+				v.Cause = make([]PlayerError, 0)
+				for d.More() {
+					var x0 PlayerError
+					x0.UnmarshalCDP(d)
+					v.Cause = append(v.Cause, x0)
+				}
+			} else {
+				v.Cause = nil
+			}
+		case "data":
+			v.Data = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerError) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables the Media domain
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Media.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables the Media domain.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Media.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// This can be called multiple times, and can be used to set / override /
+// remove player properties. A null propValue indicates removal.
+type PlayerPropertiesChangedEvent struct {
+	PlayerId PlayerId `json:"playerId"`
+
+	Properties []PlayerProperty `json:"properties"`
+}
+
+// UnmarshalCDP reads PlayerPropertiesChangedEvent from JSON
+func (v *PlayerPropertiesChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "playerId":
+			v.PlayerId = PlayerId(d.String())
+		case "properties":
+			if d.Array() {
+				v.Properties = make([]PlayerProperty, 0)
+				for d.More() {
+					var x0 PlayerProperty
+					x0.UnmarshalCDP(d)
+					v.Properties = append(v.Properties, x0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerPropertiesChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PlayerPropertiesChangedHandler func(ev PlayerPropertiesChangedEvent)
+
+// EventMethod is Media.playerPropertiesChanged
+func (PlayerPropertiesChangedEvent) EventMethod() string {
+	return "Media.playerPropertiesChanged"
+}
+
+// OnPlayerPropertiesChanged calls handler for each Media.playerPropertiesChanged event
+func (c Client) OnPlayerPropertiesChanged(handler PlayerPropertiesChangedHandler) (unsubscribe func()) {
+	return c.caller.On("Media.playerPropertiesChanged", func(ev interface{}) {
+		handler(ev.(PlayerPropertiesChangedEvent))
+	})
+}
+
+// Send events as a list, allowing them to be batched on the browser for less
+// congestion. If batched, events must ALWAYS be in chronological order.
+type PlayerEventsAddedEvent struct {
+	PlayerId PlayerId `json:"playerId"`
+
+	Events []PlayerEvent `json:"events"`
+}
+
+// UnmarshalCDP reads PlayerEventsAddedEvent from JSON
+func (v *PlayerEventsAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "playerId":
+			v.PlayerId = PlayerId(d.String())
+		case "events":
+			if d.Array() {
+				v.Events = make([]PlayerEvent, 0)
+				for d.More() {
+					var x0 PlayerEvent
+					x0.UnmarshalCDP(d)
+					v.Events = append(v.Events, x0)
+				}
+			} else {
+				v.Events = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerEventsAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PlayerEventsAddedHandler func(ev PlayerEventsAddedEvent)
+
+// EventMethod is Media.playerEventsAdded
+func (PlayerEventsAddedEvent) EventMethod() string {
+	return "Media.playerEventsAdded"
+}
+
+// OnPlayerEventsAdded calls handler for each Media.playerEventsAdded event
+func (c Client) OnPlayerEventsAdded(handler PlayerEventsAddedHandler) (unsubscribe func()) {
+	return c.caller.On("Media.playerEventsAdded", func(ev interface{}) {
+		handler(ev.(PlayerEventsAddedEvent))
+	})
+}
+
+// Send a list of any messages that need to be delivered.
+type PlayerMessagesLoggedEvent struct {
+	PlayerId PlayerId `json:"playerId"`
+
+	Messages []PlayerMessage `json:"messages"`
+}
+
+// UnmarshalCDP reads PlayerMessagesLoggedEvent from JSON
+func (v *PlayerMessagesLoggedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "playerId":
+			v.PlayerId = PlayerId(d.String())
+		case "messages":
+			if d.Array() {
+				v.Messages = make([]PlayerMessage, 0)
+				for d.More() {
+					var x0 PlayerMessage
+					x0.UnmarshalCDP(d)
+					v.Messages = append(v.Messages, x0)
+				}
+			} else {
+				v.Messages = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerMessagesLoggedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PlayerMessagesLoggedHandler func(ev PlayerMessagesLoggedEvent)
+
+// EventMethod is Media.playerMessagesLogged
+func (PlayerMessagesLoggedEvent) EventMethod() string {
+	return "Media.playerMessagesLogged"
+}
+
+// OnPlayerMessagesLogged calls handler for each Media.playerMessagesLogged event
+func (c Client) OnPlayerMessagesLogged(handler PlayerMessagesLoggedHandler) (unsubscribe func()) {
+	return c.caller.On("Media.playerMessagesLogged", func(ev interface{}) {
+		handler(ev.(PlayerMessagesLoggedEvent))
+	})
+}
+
+// Send a list of any errors that need to be delivered.
+type PlayerErrorsRaisedEvent struct {
+	PlayerId PlayerId `json:"playerId"`
+
+	Errors []PlayerError `json:"errors"`
+}
+
+// UnmarshalCDP reads PlayerErrorsRaisedEvent from JSON
+func (v *PlayerErrorsRaisedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "playerId":
+			v.PlayerId = PlayerId(d.String())
+		case "errors":
+			if d.Array() {
+				v.Errors = make([]PlayerError, 0)
+				for d.More() {
+					var x0 PlayerError
+					x0.UnmarshalCDP(d)
+					v.Errors = append(v.Errors, x0)
+				}
+			} else {
+				v.Errors = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayerErrorsRaisedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PlayerErrorsRaisedHandler func(ev PlayerErrorsRaisedEvent)
+
+// EventMethod is Media.playerErrorsRaised
+func (PlayerErrorsRaisedEvent) EventMethod() string {
+	return "Media.playerErrorsRaised"
+}
+
+// OnPlayerErrorsRaised calls handler for each Media.playerErrorsRaised event
+func (c Client) OnPlayerErrorsRaised(handler PlayerErrorsRaisedHandler) (unsubscribe func()) {
+	return c.caller.On("Media.playerErrorsRaised", func(ev interface{}) {
+		handler(ev.(PlayerErrorsRaisedEvent))
+	})
+}
+
+// Called whenever a player is created, or when a new agent joins and receives
+// a list of active players. If an agent is restored, it will receive the full
+// list of player ids and all events again.
+type PlayersCreatedEvent struct {
+	Players []PlayerId `json:"players"`
+}
+
+// UnmarshalCDP reads PlayersCreatedEvent from JSON
+func (v *PlayersCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "players":
+			if d.Array() {
+				v.Players = make([]PlayerId, 0)
+				for d.More() {
+					var x0 PlayerId
+					x0 = PlayerId(d.String())
+					v.Players = append(v.Players, x0)
+				}
+			} else {
+				v.Players = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PlayersCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PlayersCreatedHandler func(ev PlayersCreatedEvent)
+
+// EventMethod is Media.playersCreated
+func (PlayersCreatedEvent) EventMethod() string {
+	return "Media.playersCreated"
+}
+
+// OnPlayersCreated calls handler for each Media.playersCreated event
+func (c Client) OnPlayersCreated(handler PlayersCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Media.playersCreated", func(ev interface{}) {
+		handler(ev.(PlayersCreatedEvent))
+	})
+}
+
+// decoders for Media events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Media.playerPropertiesChanged": cdp.Decode[PlayerPropertiesChangedEvent],
+	"Media.playerEventsAdded":       cdp.Decode[PlayerEventsAddedEvent],
+	"Media.playerMessagesLogged":    cdp.Decode[PlayerMessagesLoggedEvent],
+	"Media.playerErrorsRaised":      cdp.Decode[PlayerErrorsRaisedEvent],
+	"Media.playersCreated":          cdp.Decode[PlayersCreatedEvent],
+}
+
+// DecodeEvent decodes a Media event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,800 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package memory is the Memory domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Memory commands and listens for Memory events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Memory pressure level.
+type PressureLevel string
+
+// PressureLevel values
+const (
+	PressureLevelModerate PressureLevel = "moderate"
+	PressureLevelCritical PressureLevel = "critical"
+)
+
+// Values gives every PressureLevel
+func (PressureLevel) Values() []PressureLevel {
+	return []PressureLevel{
+		PressureLevelModerate,
+		PressureLevelCritical,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PressureLevel) Valid() bool {
+	switch v {
+	case PressureLevelModerate, PressureLevelCritical:
+		return true
+	}
+	return false
+}
+
+// Heap profile sample.
+type SamplingProfileNode struct {
+	/* Size of the sampled allocation. */
+	Size float64 `json:"size"`
+	/* Total bytes attributed to this sample. */
+	Total float64 `json:"total"`
+	/* Execution stack at the point of allocation. */
+	Stack []string `json:"stack"`
+}
+
+// MarshalCDP writes SamplingProfileNode as JSON
+func (v *SamplingProfileNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("size")
+	e.Float(v.Size)
+	e.Key("total")
+	e.Float(v.Total)
+	e.Key("stack")
+	if v.Stack == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Stack {
+			e.Elem()
+			e.String(v.Stack[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SamplingProfileNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SamplingProfileNode from JSON
+func (v *SamplingProfileNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "size":
+			v.Size = d.Float()
+		case "total":
+			v.Total = d.Float()
+		case "stack":
+			if d.Array() {
+				v.Stack = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Stack = append(v.Stack, x0)
+				}
+			} else {
+				v.Stack = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SamplingProfileNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Array of heap profile samples.
+type SamplingProfile struct {
+	Samples []SamplingProfileNode `json:"samples"`
+	Modules []Module              `json:"modules"`
+}
+
+// MarshalCDP writes SamplingProfile as JSON
+func (v *SamplingProfile) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("samples")
+	if v.Samples == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Samples {
+			e.Elem()
+			v.Samples[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("modules")
+	if v.Modules == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Modules {
+			e.Elem()
+			v.Modules[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SamplingProfile) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SamplingProfile from JSON
+func (v *SamplingProfile) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "samples":
+			if d.Array() {
+				v.Samples = make([]SamplingProfileNode, 0)
+				for d.More() {
+					var x0 SamplingProfileNode
+					x0.UnmarshalCDP(d)
+					v.Samples = append(v.Samples, x0)
+				}
+			} else {
+				v.Samples = nil
+			}
+		case "modules":
+			if d.Array() {
+				v.Modules = make([]Module, 0)
+				for d.More() {
+					var x0 Module
+					x0.UnmarshalCDP(d)
+					v.Modules = append(v.Modules, x0)
+				}
+			} else {
+				v.Modules = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SamplingProfile) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Executable module information
+type Module struct {
+	/* Name of the module. */
+	Name string `json:"name"`
+	/* UUID of the module. */
+	Uuid string `json:"uuid"`
+	/* Base address where the module is loaded into memory. Encoded as a decimal
+	or hexadecimal (0x prefixed) string. */
+	BaseAddress string `json:"baseAddress"`
+	/* Size of the module in bytes. */
+	Size float64 `json:"size"`
+}
+
+// MarshalCDP writes Module as JSON
+func (v *Module) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("uuid")
+	e.String(v.Uuid)
+	e.Key("baseAddress")
+	e.String(v.BaseAddress)
+	e.Key("size")
+	e.Float(v.Size)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Module) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Module from JSON
+func (v *Module) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "uuid":
+			v.Uuid = d.String()
+		case "baseAddress":
+			v.BaseAddress = d.String()
+		case "size":
+			v.Size = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Module) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DOM object counter data.
+type DOMCounter struct {
+	/* Object name. Note: object names should be presumed volatile and clients should not expect
+	the returned names to be consistent across runs. */
+	Name string `json:"name"`
+	/* Object count. */
+	Count int `json:"count"`
+}
+
+// MarshalCDP writes DOMCounter as JSON
+func (v *DOMCounter) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("count")
+	e.Int(v.Count)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DOMCounter) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DOMCounter from JSON
+func (v *DOMCounter) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "count":
+			v.Count = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DOMCounter) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GetDOMCountersReturns struct {
+	Documents int `json:"documents"`
+
+	Nodes int `json:"nodes"`
+
+	JsEventListeners int `json:"jsEventListeners"`
+}
+
+// UnmarshalCDP reads GetDOMCountersReturns from JSON
+func (v *GetDOMCountersReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "documents":
+			v.Documents = d.Int()
+		case "nodes":
+			v.Nodes = d.Int()
+		case "jsEventListeners":
+			v.JsEventListeners = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetDOMCountersReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Retruns current DOM object counters.
+func (c Client) GetDOMCounters() (GetDOMCountersReturns, error) {
+	return c.GetDOMCountersContext(context.Background())
+}
+
+// GetDOMCountersContext is GetDOMCounters with a context for cancellation and deadlines
+func (c Client) GetDOMCountersContext(ctx context.Context) (GetDOMCountersReturns, error) {
+	var returns_ GetDOMCountersReturns
+
+	err_ := c.caller.Call(ctx, "Memory.getDOMCounters", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetDOMCountersForLeakDetectionReturns struct {
+
+	/* DOM object counters. */
+	Counters []DOMCounter `json:"counters"`
+}
+
+// UnmarshalCDP reads GetDOMCountersForLeakDetectionReturns from JSON
+func (v *GetDOMCountersForLeakDetectionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "counters":
+			if d.Array() {
+				v.Counters = make([]DOMCounter, 0)
+				for d.More() {
+					var x0 DOMCounter
+					x0.UnmarshalCDP(d)
+					v.Counters = append(v.Counters, x0)
+				}
+			} else {
+				v.Counters = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetDOMCountersForLeakDetectionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Retruns DOM object counters after preparing renderer for leak detection.
+func (c Client) GetDOMCountersForLeakDetection() (GetDOMCountersForLeakDetectionReturns, error) {
+	return c.GetDOMCountersForLeakDetectionContext(context.Background())
+}
+
+// GetDOMCountersForLeakDetectionContext is GetDOMCountersForLeakDetection with a context for cancellation and deadlines
+func (c Client) GetDOMCountersForLeakDetectionContext(ctx context.Context) (GetDOMCountersForLeakDetectionReturns, error) {
+	var returns_ GetDOMCountersForLeakDetectionReturns
+
+	err_ := c.caller.Call(ctx, "Memory.getDOMCountersForLeakDetection", nil, &returns_)
+
+	return returns_, err_
+}
+
+type PrepareForLeakDetectionReturns struct {
+}
+
+// UnmarshalCDP reads PrepareForLeakDetectionReturns from JSON
+func (v *PrepareForLeakDetectionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PrepareForLeakDetectionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Prepares for leak detection by terminating workers, stopping spellcheckers,
+// dropping non-essential internal caches, running garbage collections, etc.
+func (c Client) PrepareForLeakDetection() (PrepareForLeakDetectionReturns, error) {
+	return c.PrepareForLeakDetectionContext(context.Background())
+}
+
+// PrepareForLeakDetectionContext is PrepareForLeakDetection with a context for cancellation and deadlines
+func (c Client) PrepareForLeakDetectionContext(ctx context.Context) (PrepareForLeakDetectionReturns, error) {
+	var returns_ PrepareForLeakDetectionReturns
+
+	err_ := c.caller.Call(ctx, "Memory.prepareForLeakDetection", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ForciblyPurgeJavaScriptMemoryReturns struct {
+}
+
+// UnmarshalCDP reads ForciblyPurgeJavaScriptMemoryReturns from JSON
+func (v *ForciblyPurgeJavaScriptMemoryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ForciblyPurgeJavaScriptMemoryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Simulate OomIntervention by purging V8 memory.
+func (c Client) ForciblyPurgeJavaScriptMemory() (ForciblyPurgeJavaScriptMemoryReturns, error) {
+	return c.ForciblyPurgeJavaScriptMemoryContext(context.Background())
+}
+
+// ForciblyPurgeJavaScriptMemoryContext is ForciblyPurgeJavaScriptMemory with a context for cancellation and deadlines
+func (c Client) ForciblyPurgeJavaScriptMemoryContext(ctx context.Context) (ForciblyPurgeJavaScriptMemoryReturns, error) {
+	var returns_ ForciblyPurgeJavaScriptMemoryReturns
+
+	err_ := c.caller.Call(ctx, "Memory.forciblyPurgeJavaScriptMemory", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetPressureNotificationsSuppressedReturns struct {
+}
+
+// UnmarshalCDP reads SetPressureNotificationsSuppressedReturns from JSON
+func (v *SetPressureNotificationsSuppressedReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPressureNotificationsSuppressedReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPressureNotificationsSuppressedParams are the parameters for Memory.setPressureNotificationsSuppressed
+// optional parameters are left out when nil
+type SetPressureNotificationsSuppressedParams struct {
+	/* If true, memory pressure notifications will be suppressed. */
+	Suppressed bool `json:"suppressed"`
+}
+
+// MarshalCDP writes SetPressureNotificationsSuppressedParams as JSON
+func (v *SetPressureNotificationsSuppressedParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("suppressed")
+	e.Bool(v.Suppressed)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPressureNotificationsSuppressedParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable/disable suppressing memory pressure notifications in all processes.
+func (c Client) SetPressureNotificationsSuppressed(params SetPressureNotificationsSuppressedParams) (SetPressureNotificationsSuppressedReturns, error) {
+	return c.SetPressureNotificationsSuppressedContext(context.Background(), params)
+}
+
+// SetPressureNotificationsSuppressedContext is SetPressureNotificationsSuppressed with a context for cancellation and deadlines
+func (c Client) SetPressureNotificationsSuppressedContext(ctx context.Context, params SetPressureNotificationsSuppressedParams) (SetPressureNotificationsSuppressedReturns, error) {
+	var returns_ SetPressureNotificationsSuppressedReturns
+
+	err_ := c.caller.Call(ctx, "Memory.setPressureNotificationsSuppressed", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SimulatePressureNotificationReturns struct {
+}
+
+// UnmarshalCDP reads SimulatePressureNotificationReturns from JSON
+func (v *SimulatePressureNotificationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SimulatePressureNotificationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SimulatePressureNotificationParams are the parameters for Memory.simulatePressureNotification
+// optional parameters are left out when nil
+type SimulatePressureNotificationParams struct {
+	/* Memory pressure level of the notification. */
+	Level PressureLevel `json:"level"`
+}
+
+// MarshalCDP writes SimulatePressureNotificationParams as JSON
+func (v *SimulatePressureNotificationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("level")
+	e.String(string(v.Level))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SimulatePressureNotificationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Simulate a memory pressure notification in all processes.
+func (c Client) SimulatePressureNotification(params SimulatePressureNotificationParams) (SimulatePressureNotificationReturns, error) {
+	return c.SimulatePressureNotificationContext(context.Background(), params)
+}
+
+// SimulatePressureNotificationContext is SimulatePressureNotification with a context for cancellation and deadlines
+func (c Client) SimulatePressureNotificationContext(ctx context.Context, params SimulatePressureNotificationParams) (SimulatePressureNotificationReturns, error) {
+	var returns_ SimulatePressureNotificationReturns
+
+	if !params.Level.Valid() {
+		return returns_, fmt.Errorf("Memory.simulatePressureNotification: invalid level %q", params.Level)
+	}
+
+	err_ := c.caller.Call(ctx, "Memory.simulatePressureNotification", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartSamplingReturns struct {
+}
+
+// UnmarshalCDP reads StartSamplingReturns from JSON
+func (v *StartSamplingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartSamplingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartSamplingParams are the parameters for Memory.startSampling
+// optional parameters are left out when nil
+type StartSamplingParams struct {
+	/* Average number of bytes between samples. */
+	SamplingInterval *int `json:"samplingInterval,omitempty"`
+	/* Do not randomize intervals between samples. */
+	SuppressRandomness *bool `json:"suppressRandomness,omitempty"`
+}
+
+// MarshalCDP writes StartSamplingParams as JSON
+func (v *StartSamplingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SamplingInterval != nil {
+		e.Key("samplingInterval")
+		e.Int(*v.SamplingInterval)
+	}
+	if v.SuppressRandomness != nil {
+		e.Key("suppressRandomness")
+		e.Bool(*v.SuppressRandomness)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartSamplingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Start collecting native memory profile.
+func (c Client) StartSampling(params StartSamplingParams) (StartSamplingReturns, error) {
+	return c.StartSamplingContext(context.Background(), params)
+}
+
+// StartSamplingContext is StartSampling with a context for cancellation and deadlines
+func (c Client) StartSamplingContext(ctx context.Context, params StartSamplingParams) (StartSamplingReturns, error) {
+	var returns_ StartSamplingReturns
+
+	err_ := c.caller.Call(ctx, "Memory.startSampling", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopSamplingReturns struct {
+}
+
+// UnmarshalCDP reads StopSamplingReturns from JSON
+func (v *StopSamplingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopSamplingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stop collecting native memory profile.
+func (c Client) StopSampling() (StopSamplingReturns, error) {
+	return c.StopSamplingContext(context.Background())
+}
+
+// StopSamplingContext is StopSampling with a context for cancellation and deadlines
+func (c Client) StopSamplingContext(ctx context.Context) (StopSamplingReturns, error) {
+	var returns_ StopSamplingReturns
+
+	err_ := c.caller.Call(ctx, "Memory.stopSampling", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetAllTimeSamplingProfileReturns struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// UnmarshalCDP reads GetAllTimeSamplingProfileReturns from JSON
+func (v *GetAllTimeSamplingProfileReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAllTimeSamplingProfileReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Retrieve native memory allocations profile
+// collected since renderer process startup.
+func (c Client) GetAllTimeSamplingProfile() (GetAllTimeSamplingProfileReturns, error) {
+	return c.GetAllTimeSamplingProfileContext(context.Background())
+}
+
+// GetAllTimeSamplingProfileContext is GetAllTimeSamplingProfile with a context for cancellation and deadlines
+func (c Client) GetAllTimeSamplingProfileContext(ctx context.Context) (GetAllTimeSamplingProfileReturns, error) {
+	var returns_ GetAllTimeSamplingProfileReturns
+
+	err_ := c.caller.Call(ctx, "Memory.getAllTimeSamplingProfile", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetBrowserSamplingProfileReturns struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// UnmarshalCDP reads GetBrowserSamplingProfileReturns from JSON
+func (v *GetBrowserSamplingProfileReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetBrowserSamplingProfileReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Retrieve native memory allocations profile
+// collected since browser process startup.
+func (c Client) GetBrowserSamplingProfile() (GetBrowserSamplingProfileReturns, error) {
+	return c.GetBrowserSamplingProfileContext(context.Background())
+}
+
+// GetBrowserSamplingProfileContext is GetBrowserSamplingProfile with a context for cancellation and deadlines
+func (c Client) GetBrowserSamplingProfileContext(ctx context.Context) (GetBrowserSamplingProfileReturns, error) {
+	var returns_ GetBrowserSamplingProfileReturns
+
+	err_ := c.caller.Call(ctx, "Memory.getBrowserSamplingProfile", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetSamplingProfileReturns struct {
+	Profile SamplingProfile `json:"profile"`
+}
+
+// UnmarshalCDP reads GetSamplingProfileReturns from JSON
+func (v *GetSamplingProfileReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSamplingProfileReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Retrieve native memory allocations profile collected since last
+// `startSampling` call.
+func (c Client) GetSamplingProfile() (GetSamplingProfileReturns, error) {
+	return c.GetSamplingProfileContext(context.Background())
+}
+
+// GetSamplingProfileContext is GetSamplingProfile with a context for cancellation and deadlines
+func (c Client) GetSamplingProfileContext(ctx context.Context) (GetSamplingProfileReturns, error) {
+	var returns_ GetSamplingProfileReturns
+
+	err_ := c.caller.Call(ctx, "Memory.getSamplingProfile", nil, &returns_)
+
+	return returns_, err_
+}
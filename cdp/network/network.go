@@ -0,0 +1,6134 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package network is the Network domain of the chrome devtools protocol
+//
+// Network domain allows tracking network activities of the page. It exposes information about http,
+// file, data and other requests and responses, their headers, bodies, timing, etc.
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Network commands and listens for Network events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	ResourceType                  = cdp.NetworkResourceType
+	LoaderId                      = cdp.NetworkLoaderId
+	RequestId                     = cdp.NetworkRequestId
+	ErrorReason                   = cdp.NetworkErrorReason
+	TimeSinceEpoch                = cdp.NetworkTimeSinceEpoch
+	MonotonicTime                 = cdp.NetworkMonotonicTime
+	Headers                       = cdp.NetworkHeaders
+	CookieSameSite                = cdp.NetworkCookieSameSite
+	CookiePriority                = cdp.NetworkCookiePriority
+	CookieSourceScheme            = cdp.NetworkCookieSourceScheme
+	ResourcePriority              = cdp.NetworkResourcePriority
+	PostDataEntry                 = cdp.NetworkPostDataEntry
+	Request                       = cdp.NetworkRequest
+	RequestReferrerPolicy         = cdp.NetworkRequestReferrerPolicy
+	CorsError                     = cdp.NetworkCorsError
+	CorsErrorStatus               = cdp.NetworkCorsErrorStatus
+	TrustTokenParams              = cdp.NetworkTrustTokenParams
+	TrustTokenParamsRefreshPolicy = cdp.NetworkTrustTokenParamsRefreshPolicy
+	TrustTokenOperationType       = cdp.NetworkTrustTokenOperationType
+	CookiePartitionKey            = cdp.NetworkCookiePartitionKey
+	Cookie                        = cdp.NetworkCookie
+	CookieParam                   = cdp.NetworkCookieParam
+	PrivateNetworkRequestPolicy   = cdp.NetworkPrivateNetworkRequestPolicy
+	IPAddressSpace                = cdp.NetworkIPAddressSpace
+	ClientSecurityState           = cdp.NetworkClientSecurityState
+)
+
+// ResourceType values
+const (
+	ResourceTypeDocument           = cdp.NetworkResourceTypeDocument
+	ResourceTypeStylesheet         = cdp.NetworkResourceTypeStylesheet
+	ResourceTypeImage              = cdp.NetworkResourceTypeImage
+	ResourceTypeMedia              = cdp.NetworkResourceTypeMedia
+	ResourceTypeFont               = cdp.NetworkResourceTypeFont
+	ResourceTypeScript             = cdp.NetworkResourceTypeScript
+	ResourceTypeTextTrack          = cdp.NetworkResourceTypeTextTrack
+	ResourceTypeXHR                = cdp.NetworkResourceTypeXHR
+	ResourceTypeFetch              = cdp.NetworkResourceTypeFetch
+	ResourceTypePrefetch           = cdp.NetworkResourceTypePrefetch
+	ResourceTypeEventSource        = cdp.NetworkResourceTypeEventSource
+	ResourceTypeWebSocket          = cdp.NetworkResourceTypeWebSocket
+	ResourceTypeManifest           = cdp.NetworkResourceTypeManifest
+	ResourceTypeSignedExchange     = cdp.NetworkResourceTypeSignedExchange
+	ResourceTypePing               = cdp.NetworkResourceTypePing
+	ResourceTypeCSPViolationReport = cdp.NetworkResourceTypeCSPViolationReport
+	ResourceTypePreflight          = cdp.NetworkResourceTypePreflight
+	ResourceTypeFedCM              = cdp.NetworkResourceTypeFedCM
+	ResourceTypeOther              = cdp.NetworkResourceTypeOther
+)
+
+// ErrorReason values
+const (
+	ErrorReasonFailed               = cdp.NetworkErrorReasonFailed
+	ErrorReasonAborted              = cdp.NetworkErrorReasonAborted
+	ErrorReasonTimedOut             = cdp.NetworkErrorReasonTimedOut
+	ErrorReasonAccessDenied         = cdp.NetworkErrorReasonAccessDenied
+	ErrorReasonConnectionClosed     = cdp.NetworkErrorReasonConnectionClosed
+	ErrorReasonConnectionReset      = cdp.NetworkErrorReasonConnectionReset
+	ErrorReasonConnectionRefused    = cdp.NetworkErrorReasonConnectionRefused
+	ErrorReasonConnectionAborted    = cdp.NetworkErrorReasonConnectionAborted
+	ErrorReasonConnectionFailed     = cdp.NetworkErrorReasonConnectionFailed
+	ErrorReasonNameNotResolved      = cdp.NetworkErrorReasonNameNotResolved
+	ErrorReasonInternetDisconnected = cdp.NetworkErrorReasonInternetDisconnected
+	ErrorReasonAddressUnreachable   = cdp.NetworkErrorReasonAddressUnreachable
+	ErrorReasonBlockedByClient      = cdp.NetworkErrorReasonBlockedByClient
+	ErrorReasonBlockedByResponse    = cdp.NetworkErrorReasonBlockedByResponse
+)
+
+// CookieSameSite values
+const (
+	CookieSameSiteStrict = cdp.NetworkCookieSameSiteStrict
+	CookieSameSiteLax    = cdp.NetworkCookieSameSiteLax
+	CookieSameSiteNone   = cdp.NetworkCookieSameSiteNone
+)
+
+// CookiePriority values
+const (
+	CookiePriorityLow    = cdp.NetworkCookiePriorityLow
+	CookiePriorityMedium = cdp.NetworkCookiePriorityMedium
+	CookiePriorityHigh   = cdp.NetworkCookiePriorityHigh
+)
+
+// CookieSourceScheme values
+const (
+	CookieSourceSchemeUnset     = cdp.NetworkCookieSourceSchemeUnset
+	CookieSourceSchemeNonSecure = cdp.NetworkCookieSourceSchemeNonSecure
+	CookieSourceSchemeSecure    = cdp.NetworkCookieSourceSchemeSecure
+)
+
+// ResourcePriority values
+const (
+	ResourcePriorityVeryLow  = cdp.NetworkResourcePriorityVeryLow
+	ResourcePriorityLow      = cdp.NetworkResourcePriorityLow
+	ResourcePriorityMedium   = cdp.NetworkResourcePriorityMedium
+	ResourcePriorityHigh     = cdp.NetworkResourcePriorityHigh
+	ResourcePriorityVeryHigh = cdp.NetworkResourcePriorityVeryHigh
+)
+
+// RequestReferrerPolicy values
+const (
+	RequestReferrerPolicyUnsafeUrl                   = cdp.NetworkRequestReferrerPolicyUnsafeUrl
+	RequestReferrerPolicyNoReferrerWhenDowngrade     = cdp.NetworkRequestReferrerPolicyNoReferrerWhenDowngrade
+	RequestReferrerPolicyNoReferrer                  = cdp.NetworkRequestReferrerPolicyNoReferrer
+	RequestReferrerPolicyOrigin                      = cdp.NetworkRequestReferrerPolicyOrigin
+	RequestReferrerPolicyOriginWhenCrossOrigin       = cdp.NetworkRequestReferrerPolicyOriginWhenCrossOrigin
+	RequestReferrerPolicySameOrigin                  = cdp.NetworkRequestReferrerPolicySameOrigin
+	RequestReferrerPolicyStrictOrigin                = cdp.NetworkRequestReferrerPolicyStrictOrigin
+	RequestReferrerPolicyStrictOriginWhenCrossOrigin = cdp.NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin
+)
+
+// CorsError values
+const (
+	CorsErrorDisallowedByMode                          = cdp.NetworkCorsErrorDisallowedByMode
+	CorsErrorInvalidResponse                           = cdp.NetworkCorsErrorInvalidResponse
+	CorsErrorWildcardOriginNotAllowed                  = cdp.NetworkCorsErrorWildcardOriginNotAllowed
+	CorsErrorMissingAllowOriginHeader                  = cdp.NetworkCorsErrorMissingAllowOriginHeader
+	CorsErrorMultipleAllowOriginValues                 = cdp.NetworkCorsErrorMultipleAllowOriginValues
+	CorsErrorInvalidAllowOriginValue                   = cdp.NetworkCorsErrorInvalidAllowOriginValue
+	CorsErrorAllowOriginMismatch                       = cdp.NetworkCorsErrorAllowOriginMismatch
+	CorsErrorInvalidAllowCredentials                   = cdp.NetworkCorsErrorInvalidAllowCredentials
+	CorsErrorCorsDisabledScheme                        = cdp.NetworkCorsErrorCorsDisabledScheme
+	CorsErrorPreflightInvalidStatus                    = cdp.NetworkCorsErrorPreflightInvalidStatus
+	CorsErrorPreflightDisallowedRedirect               = cdp.NetworkCorsErrorPreflightDisallowedRedirect
+	CorsErrorPreflightWildcardOriginNotAllowed         = cdp.NetworkCorsErrorPreflightWildcardOriginNotAllowed
+	CorsErrorPreflightMissingAllowOriginHeader         = cdp.NetworkCorsErrorPreflightMissingAllowOriginHeader
+	CorsErrorPreflightMultipleAllowOriginValues        = cdp.NetworkCorsErrorPreflightMultipleAllowOriginValues
+	CorsErrorPreflightInvalidAllowOriginValue          = cdp.NetworkCorsErrorPreflightInvalidAllowOriginValue
+	CorsErrorPreflightAllowOriginMismatch              = cdp.NetworkCorsErrorPreflightAllowOriginMismatch
+	CorsErrorPreflightInvalidAllowCredentials          = cdp.NetworkCorsErrorPreflightInvalidAllowCredentials
+	CorsErrorPreflightMissingAllowExternal             = cdp.NetworkCorsErrorPreflightMissingAllowExternal
+	CorsErrorPreflightInvalidAllowExternal             = cdp.NetworkCorsErrorPreflightInvalidAllowExternal
+	CorsErrorPreflightMissingAllowPrivateNetwork       = cdp.NetworkCorsErrorPreflightMissingAllowPrivateNetwork
+	CorsErrorPreflightInvalidAllowPrivateNetwork       = cdp.NetworkCorsErrorPreflightInvalidAllowPrivateNetwork
+	CorsErrorInvalidAllowMethodsPreflightResponse      = cdp.NetworkCorsErrorInvalidAllowMethodsPreflightResponse
+	CorsErrorInvalidAllowHeadersPreflightResponse      = cdp.NetworkCorsErrorInvalidAllowHeadersPreflightResponse
+	CorsErrorMethodDisallowedByPreflightResponse       = cdp.NetworkCorsErrorMethodDisallowedByPreflightResponse
+	CorsErrorHeaderDisallowedByPreflightResponse       = cdp.NetworkCorsErrorHeaderDisallowedByPreflightResponse
+	CorsErrorRedirectContainsCredentials               = cdp.NetworkCorsErrorRedirectContainsCredentials
+	CorsErrorInsecurePrivateNetwork                    = cdp.NetworkCorsErrorInsecurePrivateNetwork
+	CorsErrorInvalidPrivateNetworkAccess               = cdp.NetworkCorsErrorInvalidPrivateNetworkAccess
+	CorsErrorUnexpectedPrivateNetworkAccess            = cdp.NetworkCorsErrorUnexpectedPrivateNetworkAccess
+	CorsErrorNoCorsRedirectModeNotFollow               = cdp.NetworkCorsErrorNoCorsRedirectModeNotFollow
+	CorsErrorPreflightMissingPrivateNetworkAccessId    = cdp.NetworkCorsErrorPreflightMissingPrivateNetworkAccessId
+	CorsErrorPreflightMissingPrivateNetworkAccessName  = cdp.NetworkCorsErrorPreflightMissingPrivateNetworkAccessName
+	CorsErrorPrivateNetworkAccessPermissionUnavailable = cdp.NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable
+	CorsErrorPrivateNetworkAccessPermissionDenied      = cdp.NetworkCorsErrorPrivateNetworkAccessPermissionDenied
+	CorsErrorLocalNetworkAccessPermissionDenied        = cdp.NetworkCorsErrorLocalNetworkAccessPermissionDenied
+)
+
+// TrustTokenParamsRefreshPolicy values
+const (
+	TrustTokenParamsRefreshPolicyUseCached = cdp.NetworkTrustTokenParamsRefreshPolicyUseCached
+	TrustTokenParamsRefreshPolicyRefresh   = cdp.NetworkTrustTokenParamsRefreshPolicyRefresh
+)
+
+// TrustTokenOperationType values
+const (
+	TrustTokenOperationTypeIssuance   = cdp.NetworkTrustTokenOperationTypeIssuance
+	TrustTokenOperationTypeRedemption = cdp.NetworkTrustTokenOperationTypeRedemption
+	TrustTokenOperationTypeSigning    = cdp.NetworkTrustTokenOperationTypeSigning
+)
+
+// PrivateNetworkRequestPolicy values
+const (
+	PrivateNetworkRequestPolicyAllow                          = cdp.NetworkPrivateNetworkRequestPolicyAllow
+	PrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate = cdp.NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate
+	PrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  = cdp.NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate
+	PrivateNetworkRequestPolicyPreflightBlock                 = cdp.NetworkPrivateNetworkRequestPolicyPreflightBlock
+	PrivateNetworkRequestPolicyPreflightWarn                  = cdp.NetworkPrivateNetworkRequestPolicyPreflightWarn
+	PrivateNetworkRequestPolicyPermissionBlock                = cdp.NetworkPrivateNetworkRequestPolicyPermissionBlock
+	PrivateNetworkRequestPolicyPermissionWarn                 = cdp.NetworkPrivateNetworkRequestPolicyPermissionWarn
+)
+
+// IPAddressSpace values
+const (
+	IPAddressSpaceLoopback = cdp.NetworkIPAddressSpaceLoopback
+	IPAddressSpaceLocal    = cdp.NetworkIPAddressSpaceLocal
+	IPAddressSpacePublic   = cdp.NetworkIPAddressSpacePublic
+	IPAddressSpaceUnknown  = cdp.NetworkIPAddressSpaceUnknown
+)
+
+// Unique intercepted request identifier.
+type InterceptionId string
+
+// The underlying connection technology that the browser is supposedly using.
+type ConnectionType string
+
+// ConnectionType values
+const (
+	ConnectionTypeNone       ConnectionType = "none"
+	ConnectionTypeCellular2g ConnectionType = "cellular2g"
+	ConnectionTypeCellular3g ConnectionType = "cellular3g"
+	ConnectionTypeCellular4g ConnectionType = "cellular4g"
+	ConnectionTypeBluetooth  ConnectionType = "bluetooth"
+	ConnectionTypeEthernet   ConnectionType = "ethernet"
+	ConnectionTypeWifi       ConnectionType = "wifi"
+	ConnectionTypeWimax      ConnectionType = "wimax"
+	ConnectionTypeOther      ConnectionType = "other"
+)
+
+// Values gives every ConnectionType
+func (ConnectionType) Values() []ConnectionType {
+	return []ConnectionType{
+		ConnectionTypeNone,
+		ConnectionTypeCellular2g,
+		ConnectionTypeCellular3g,
+		ConnectionTypeCellular4g,
+		ConnectionTypeBluetooth,
+		ConnectionTypeEthernet,
+		ConnectionTypeWifi,
+		ConnectionTypeWimax,
+		ConnectionTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ConnectionType) Valid() bool {
+	switch v {
+	case ConnectionTypeNone, ConnectionTypeCellular2g, ConnectionTypeCellular3g, ConnectionTypeCellular4g, ConnectionTypeBluetooth, ConnectionTypeEthernet, ConnectionTypeWifi, ConnectionTypeWimax, ConnectionTypeOther:
+		return true
+	}
+	return false
+}
+
+// Timing information for the request.
+type ResourceTiming struct {
+	/* Timing's requestTime is a baseline in seconds, while the other numbers are ticks in
+	milliseconds relatively to this requestTime. */
+	RequestTime float64 `json:"requestTime"`
+	/* Started resolving proxy. */
+	ProxyStart float64 `json:"proxyStart"`
+	/* Finished resolving proxy. */
+	ProxyEnd float64 `json:"proxyEnd"`
+	/* Started DNS address resolve. */
+	DnsStart float64 `json:"dnsStart"`
+	/* Finished DNS address resolve. */
+	DnsEnd float64 `json:"dnsEnd"`
+	/* Started connecting to the remote host. */
+	ConnectStart float64 `json:"connectStart"`
+	/* Connected to the remote host. */
+	ConnectEnd float64 `json:"connectEnd"`
+	/* Started SSL handshake. */
+	SslStart float64 `json:"sslStart"`
+	/* Finished SSL handshake. */
+	SslEnd float64 `json:"sslEnd"`
+	/* Started running ServiceWorker.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorkerStart float64 `json:"workerStart"`
+	/* Finished Starting ServiceWorker.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorkerReady float64 `json:"workerReady"`
+	/* Started fetch event.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorkerFetchStart float64 `json:"workerFetchStart"`
+	/* Settled fetch event respondWith promise.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorkerRespondWithSettled float64 `json:"workerRespondWithSettled"`
+	/* Started ServiceWorker static routing source evaluation.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorkerRouterEvaluationStart *float64 `json:"workerRouterEvaluationStart,omitempty"`
+	/* Started cache lookup when the source was evaluated to `cache`.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorkerCacheLookupStart *float64 `json:"workerCacheLookupStart,omitempty"`
+	/* Started sending request. */
+	SendStart float64 `json:"sendStart"`
+	/* Finished sending request. */
+	SendEnd float64 `json:"sendEnd"`
+	/* Time the server started pushing request.
+
+	Experimental: this may change or be removed in any chrome release */
+	PushStart float64 `json:"pushStart"`
+	/* Time the server finished pushing request.
+
+	Experimental: this may change or be removed in any chrome release */
+	PushEnd float64 `json:"pushEnd"`
+	/* Started receiving response headers.
+
+	Experimental: this may change or be removed in any chrome release */
+	ReceiveHeadersStart float64 `json:"receiveHeadersStart"`
+	/* Finished receiving response headers. */
+	ReceiveHeadersEnd float64 `json:"receiveHeadersEnd"`
+}
+
+// MarshalCDP writes ResourceTiming as JSON
+func (v *ResourceTiming) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestTime")
+	e.Float(v.RequestTime)
+	e.Key("proxyStart")
+	e.Float(v.ProxyStart)
+	e.Key("proxyEnd")
+	e.Float(v.ProxyEnd)
+	e.Key("dnsStart")
+	e.Float(v.DnsStart)
+	e.Key("dnsEnd")
+	e.Float(v.DnsEnd)
+	e.Key("connectStart")
+	e.Float(v.ConnectStart)
+	e.Key("connectEnd")
+	e.Float(v.ConnectEnd)
+	e.Key("sslStart")
+	e.Float(v.SslStart)
+	e.Key("sslEnd")
+	e.Float(v.SslEnd)
+	e.Key("workerStart")
+	e.Float(v.WorkerStart)
+	e.Key("workerReady")
+	e.Float(v.WorkerReady)
+	e.Key("workerFetchStart")
+	e.Float(v.WorkerFetchStart)
+	e.Key("workerRespondWithSettled")
+	e.Float(v.WorkerRespondWithSettled)
+	if v.WorkerRouterEvaluationStart != nil {
+		e.Key("workerRouterEvaluationStart")
+		e.Float(*v.WorkerRouterEvaluationStart)
+	}
+	if v.WorkerCacheLookupStart != nil {
+		e.Key("workerCacheLookupStart")
+		e.Float(*v.WorkerCacheLookupStart)
+	}
+	e.Key("sendStart")
+	e.Float(v.SendStart)
+	e.Key("sendEnd")
+	e.Float(v.SendEnd)
+	e.Key("pushStart")
+	e.Float(v.PushStart)
+	e.Key("pushEnd")
+	e.Float(v.PushEnd)
+	e.Key("receiveHeadersStart")
+	e.Float(v.ReceiveHeadersStart)
+	e.Key("receiveHeadersEnd")
+	e.Float(v.ReceiveHeadersEnd)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResourceTiming) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ResourceTiming from JSON
+func (v *ResourceTiming) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestTime":
+			v.RequestTime = d.Float()
+		case "proxyStart":
+			v.ProxyStart = d.Float()
+		case "proxyEnd":
+			v.ProxyEnd = d.Float()
+		case "dnsStart":
+			v.DnsStart = d.Float()
+		case "dnsEnd":
+			v.DnsEnd = d.Float()
+		case "connectStart":
+			v.ConnectStart = d.Float()
+		case "connectEnd":
+			v.ConnectEnd = d.Float()
+		case "sslStart":
+			v.SslStart = d.Float()
+		case "sslEnd":
+			v.SslEnd = d.Float()
+		case "workerStart":
+			v.WorkerStart = d.Float()
+		case "workerReady":
+			v.WorkerReady = d.Float()
+		case "workerFetchStart":
+			v.WorkerFetchStart = d.Float()
+		case "workerRespondWithSettled":
+			v.WorkerRespondWithSettled = d.Float()
+		case "workerRouterEvaluationStart":
+			if d.Null() {
+				v.WorkerRouterEvaluationStart = nil
+			} else {
+				v.WorkerRouterEvaluationStart = new(float64)
+				*v.WorkerRouterEvaluationStart = d.Float()
+			}
+		case "workerCacheLookupStart":
+			if d.Null() {
+				v.WorkerCacheLookupStart = nil
+			} else {
+				v.WorkerCacheLookupStart = new(float64)
+				*v.WorkerCacheLookupStart = d.Float()
+			}
+		case "sendStart":
+			v.SendStart = d.Float()
+		case "sendEnd":
+			v.SendEnd = d.Float()
+		case "pushStart":
+			v.PushStart = d.Float()
+		case "pushEnd":
+			v.PushEnd = d.Float()
+		case "receiveHeadersStart":
+			v.ReceiveHeadersStart = d.Float()
+		case "receiveHeadersEnd":
+			v.ReceiveHeadersEnd = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResourceTiming) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Details of a signed certificate timestamp (SCT).
+type SignedCertificateTimestamp struct {
+	/* Validation status. */
+	Status string `json:"status"`
+	/* Origin. */
+	Origin string `json:"origin"`
+	/* Log name / description. */
+	LogDescription string `json:"logDescription"`
+	/* Log ID. */
+	LogId string `json:"logId"`
+	/* Issuance date. Unlike TimeSinceEpoch, this contains the number of
+	milliseconds since January 1, 1970, UTC, not the number of seconds. */
+	Timestamp float64 `json:"timestamp"`
+	/* Hash algorithm. */
+	HashAlgorithm string `json:"hashAlgorithm"`
+	/* Signature algorithm. */
+	SignatureAlgorithm string `json:"signatureAlgorithm"`
+	/* Signature data. */
+	SignatureData string `json:"signatureData"`
+}
+
+// MarshalCDP writes SignedCertificateTimestamp as JSON
+func (v *SignedCertificateTimestamp) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("status")
+	e.String(v.Status)
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("logDescription")
+	e.String(v.LogDescription)
+	e.Key("logId")
+	e.String(v.LogId)
+	e.Key("timestamp")
+	e.Float(v.Timestamp)
+	e.Key("hashAlgorithm")
+	e.String(v.HashAlgorithm)
+	e.Key("signatureAlgorithm")
+	e.String(v.SignatureAlgorithm)
+	e.Key("signatureData")
+	e.String(v.SignatureData)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SignedCertificateTimestamp) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SignedCertificateTimestamp from JSON
+func (v *SignedCertificateTimestamp) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "status":
+			v.Status = d.String()
+		case "origin":
+			v.Origin = d.String()
+		case "logDescription":
+			v.LogDescription = d.String()
+		case "logId":
+			v.LogId = d.String()
+		case "timestamp":
+			v.Timestamp = d.Float()
+		case "hashAlgorithm":
+			v.HashAlgorithm = d.String()
+		case "signatureAlgorithm":
+			v.SignatureAlgorithm = d.String()
+		case "signatureData":
+			v.SignatureData = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SignedCertificateTimestamp) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Security details about a request.
+type SecurityDetails struct {
+	/* Protocol name (e.g. "TLS 1.2" or "QUIC"). */
+	Protocol string `json:"protocol"`
+	/* Key Exchange used by the connection, or the empty string if not applicable. */
+	KeyExchange string `json:"keyExchange"`
+	/* (EC)DH group used by the connection, if applicable. */
+	KeyExchangeGroup *string `json:"keyExchangeGroup,omitempty"`
+	/* Cipher name. */
+	Cipher string `json:"cipher"`
+	/* TLS MAC. Note that AEAD ciphers do not have separate MACs. */
+	Mac *string `json:"mac,omitempty"`
+	/* Certificate ID value. */
+	CertificateId cdp.SecurityCertificateId `json:"certificateId"`
+	/* Certificate subject name. */
+	SubjectName string `json:"subjectName"`
+	/* Subject Alternative Name (SAN) DNS names and IP addresses. */
+	SanList []string `json:"sanList"`
+	/* Name of the issuing CA. */
+	Issuer string `json:"issuer"`
+	/* Certificate valid from date. */
+	ValidFrom TimeSinceEpoch `json:"validFrom"`
+	/* Certificate valid to (expiration) date */
+	ValidTo TimeSinceEpoch `json:"validTo"`
+	/* List of signed certificate timestamps (SCTs). */
+	SignedCertificateTimestampList []SignedCertificateTimestamp `json:"signedCertificateTimestampList"`
+	/* Whether the request complied with Certificate Transparency policy */
+	CertificateTransparencyCompliance CertificateTransparencyCompliance `json:"certificateTransparencyCompliance"`
+	/* The signature algorithm used by the server in the TLS server signature,
+	represented as a TLS SignatureScheme code point. Omitted if not
+	applicable or not known. */
+	ServerSignatureAlgorithm *int `json:"serverSignatureAlgorithm,omitempty"`
+	/* Whether the connection used Encrypted ClientHello */
+	EncryptedClientHello bool `json:"encryptedClientHello"`
+}
+
+// MarshalCDP writes SecurityDetails as JSON
+func (v *SecurityDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("protocol")
+	e.String(v.Protocol)
+	e.Key("keyExchange")
+	e.String(v.KeyExchange)
+	if v.KeyExchangeGroup != nil {
+		e.Key("keyExchangeGroup")
+		e.String(*v.KeyExchangeGroup)
+	}
+	e.Key("cipher")
+	e.String(v.Cipher)
+	if v.Mac != nil {
+		e.Key("mac")
+		e.String(*v.Mac)
+	}
+	e.Key("certificateId")
+	e.Int(int(v.CertificateId))
+	e.Key("subjectName")
+	e.String(v.SubjectName)
+	e.Key("sanList")
+	if v.SanList == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.SanList {
+			e.Elem()
+			e.String(v.SanList[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("issuer")
+	e.String(v.Issuer)
+	e.Key("validFrom")
+	e.Float(float64(v.ValidFrom))
+	e.Key("validTo")
+	e.Float(float64(v.ValidTo))
+	e.Key("signedCertificateTimestampList")
+	if v.SignedCertificateTimestampList == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.SignedCertificateTimestampList {
+			e.Elem()
+			v.SignedCertificateTimestampList[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("certificateTransparencyCompliance")
+	e.String(string(v.CertificateTransparencyCompliance))
+	if v.ServerSignatureAlgorithm != nil {
+		e.Key("serverSignatureAlgorithm")
+		e.Int(*v.ServerSignatureAlgorithm)
+	}
+	e.Key("encryptedClientHello")
+	e.Bool(v.EncryptedClientHello)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SecurityDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SecurityDetails from JSON
+func (v *SecurityDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "protocol":
+			v.Protocol = d.String()
+		case "keyExchange":
+			v.KeyExchange = d.String()
+		case "keyExchangeGroup":
+			if d.Null() {
+				v.KeyExchangeGroup = nil
+			} else {
+				v.KeyExchangeGroup = new(string)
+				*v.KeyExchangeGroup = d.String()
+			}
+		case "cipher":
+			v.Cipher = d.String()
+		case "mac":
+			if d.Null() {
+				v.Mac = nil
+			} else {
+				v.Mac = new(string)
+				*v.Mac = d.String()
+			}
+		case "certificateId":
+			v.CertificateId = cdp.SecurityCertificateId(d.Int())
+		case "subjectName":
+			v.SubjectName = d.String()
+		case "sanList":
+			if d.Array() {
+				v.SanList = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.SanList = append(v.SanList, x0)
+				}
+			} else {
+				v.SanList = nil
+			}
+		case "issuer":
+			v.Issuer = d.String()
+		case "validFrom":
+			v.ValidFrom = TimeSinceEpoch(d.Float())
+		case "validTo":
+			v.ValidTo = TimeSinceEpoch(d.Float())
+		case "signedCertificateTimestampList":
+			if d.Array() {
+				v.SignedCertificateTimestampList = make([]SignedCertificateTimestamp, 0)
+				for d.More() {
+					var x0 SignedCertificateTimestamp
+					x0.UnmarshalCDP(d)
+					v.SignedCertificateTimestampList = append(v.SignedCertificateTimestampList, x0)
+				}
+			} else {
+				v.SignedCertificateTimestampList = nil
+			}
+		case "certificateTransparencyCompliance":
+			v.CertificateTransparencyCompliance = CertificateTransparencyCompliance(d.String())
+		case "serverSignatureAlgorithm":
+			if d.Null() {
+				v.ServerSignatureAlgorithm = nil
+			} else {
+				v.ServerSignatureAlgorithm = new(int)
+				*v.ServerSignatureAlgorithm = d.Int()
+			}
+		case "encryptedClientHello":
+			v.EncryptedClientHello = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SecurityDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Whether the request complied with Certificate Transparency policy.
+type CertificateTransparencyCompliance string
+
+// CertificateTransparencyCompliance values
+const (
+	CertificateTransparencyComplianceUnknown      CertificateTransparencyCompliance = "unknown"
+	CertificateTransparencyComplianceNotCompliant CertificateTransparencyCompliance = "not-compliant"
+	CertificateTransparencyComplianceCompliant    CertificateTransparencyCompliance = "compliant"
+)
+
+// Values gives every CertificateTransparencyCompliance
+func (CertificateTransparencyCompliance) Values() []CertificateTransparencyCompliance {
+	return []CertificateTransparencyCompliance{
+		CertificateTransparencyComplianceUnknown,
+		CertificateTransparencyComplianceNotCompliant,
+		CertificateTransparencyComplianceCompliant,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CertificateTransparencyCompliance) Valid() bool {
+	switch v {
+	case CertificateTransparencyComplianceUnknown, CertificateTransparencyComplianceNotCompliant, CertificateTransparencyComplianceCompliant:
+		return true
+	}
+	return false
+}
+
+// The reason why request was blocked.
+type BlockedReason string
+
+// BlockedReason values
+const (
+	BlockedReasonOther                                                   BlockedReason = "other"
+	BlockedReasonCsp                                                     BlockedReason = "csp"
+	BlockedReasonMixedContent                                            BlockedReason = "mixed-content"
+	BlockedReasonOrigin                                                  BlockedReason = "origin"
+	BlockedReasonInspector                                               BlockedReason = "inspector"
+	BlockedReasonIntegrity                                               BlockedReason = "integrity"
+	BlockedReasonSubresourceFilter                                       BlockedReason = "subresource-filter"
+	BlockedReasonContentType                                             BlockedReason = "content-type"
+	BlockedReasonCoepFrameResourceNeedsCoepHeader                        BlockedReason = "coep-frame-resource-needs-coep-header"
+	BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             BlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	BlockedReasonCorpNotSameOrigin                                       BlockedReason = "corp-not-same-origin"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	BlockedReasonCorpNotSameSite                                         BlockedReason = "corp-not-same-site"
+	BlockedReasonSriMessageSignatureMismatch                             BlockedReason = "sri-message-signature-mismatch"
+)
+
+// Values gives every BlockedReason
+func (BlockedReason) Values() []BlockedReason {
+	return []BlockedReason{
+		BlockedReasonOther,
+		BlockedReasonCsp,
+		BlockedReasonMixedContent,
+		BlockedReasonOrigin,
+		BlockedReasonInspector,
+		BlockedReasonIntegrity,
+		BlockedReasonSubresourceFilter,
+		BlockedReasonContentType,
+		BlockedReasonCoepFrameResourceNeedsCoepHeader,
+		BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage,
+		BlockedReasonCorpNotSameOrigin,
+		BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep,
+		BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip,
+		BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip,
+		BlockedReasonCorpNotSameSite,
+		BlockedReasonSriMessageSignatureMismatch,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v BlockedReason) Valid() bool {
+	switch v {
+	case BlockedReasonOther, BlockedReasonCsp, BlockedReasonMixedContent, BlockedReasonOrigin, BlockedReasonInspector, BlockedReasonIntegrity, BlockedReasonSubresourceFilter, BlockedReasonContentType, BlockedReasonCoepFrameResourceNeedsCoepHeader, BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage, BlockedReasonCorpNotSameOrigin, BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep, BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip, BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip, BlockedReasonCorpNotSameSite, BlockedReasonSriMessageSignatureMismatch:
+		return true
+	}
+	return false
+}
+
+// Source of serviceworker response.
+type ServiceWorkerResponseSource string
+
+// ServiceWorkerResponseSource values
+const (
+	ServiceWorkerResponseSourceCacheStorage ServiceWorkerResponseSource = "cache-storage"
+	ServiceWorkerResponseSourceHttpCache    ServiceWorkerResponseSource = "http-cache"
+	ServiceWorkerResponseSourceFallbackCode ServiceWorkerResponseSource = "fallback-code"
+	ServiceWorkerResponseSourceNetwork      ServiceWorkerResponseSource = "network"
+)
+
+// Values gives every ServiceWorkerResponseSource
+func (ServiceWorkerResponseSource) Values() []ServiceWorkerResponseSource {
+	return []ServiceWorkerResponseSource{
+		ServiceWorkerResponseSourceCacheStorage,
+		ServiceWorkerResponseSourceHttpCache,
+		ServiceWorkerResponseSourceFallbackCode,
+		ServiceWorkerResponseSourceNetwork,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ServiceWorkerResponseSource) Valid() bool {
+	switch v {
+	case ServiceWorkerResponseSourceCacheStorage, ServiceWorkerResponseSourceHttpCache, ServiceWorkerResponseSourceFallbackCode, ServiceWorkerResponseSourceNetwork:
+		return true
+	}
+	return false
+}
+
+// The reason why Chrome uses a specific transport protocol for HTTP semantics.
+//
+// Experimental: this may change or be removed in any chrome release
+type AlternateProtocolUsage string
+
+// AlternateProtocolUsage values
+const (
+	AlternateProtocolUsageAlternativeJobWonWithoutRace AlternateProtocolUsage = "alternativeJobWonWithoutRace"
+	AlternateProtocolUsageAlternativeJobWonRace        AlternateProtocolUsage = "alternativeJobWonRace"
+	AlternateProtocolUsageMainJobWonRace               AlternateProtocolUsage = "mainJobWonRace"
+	AlternateProtocolUsageMappingMissing               AlternateProtocolUsage = "mappingMissing"
+	AlternateProtocolUsageBroken                       AlternateProtocolUsage = "broken"
+	AlternateProtocolUsageDnsAlpnH3JobWonWithoutRace   AlternateProtocolUsage = "dnsAlpnH3JobWonWithoutRace"
+	AlternateProtocolUsageDnsAlpnH3JobWonRace          AlternateProtocolUsage = "dnsAlpnH3JobWonRace"
+	AlternateProtocolUsageUnspecifiedReason            AlternateProtocolUsage = "unspecifiedReason"
+)
+
+// Values gives every AlternateProtocolUsage
+func (AlternateProtocolUsage) Values() []AlternateProtocolUsage {
+	return []AlternateProtocolUsage{
+		AlternateProtocolUsageAlternativeJobWonWithoutRace,
+		AlternateProtocolUsageAlternativeJobWonRace,
+		AlternateProtocolUsageMainJobWonRace,
+		AlternateProtocolUsageMappingMissing,
+		AlternateProtocolUsageBroken,
+		AlternateProtocolUsageDnsAlpnH3JobWonWithoutRace,
+		AlternateProtocolUsageDnsAlpnH3JobWonRace,
+		AlternateProtocolUsageUnspecifiedReason,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AlternateProtocolUsage) Valid() bool {
+	switch v {
+	case AlternateProtocolUsageAlternativeJobWonWithoutRace, AlternateProtocolUsageAlternativeJobWonRace, AlternateProtocolUsageMainJobWonRace, AlternateProtocolUsageMappingMissing, AlternateProtocolUsageBroken, AlternateProtocolUsageDnsAlpnH3JobWonWithoutRace, AlternateProtocolUsageDnsAlpnH3JobWonRace, AlternateProtocolUsageUnspecifiedReason:
+		return true
+	}
+	return false
+}
+
+// Source of service worker router.
+type ServiceWorkerRouterSource string
+
+// ServiceWorkerRouterSource values
+const (
+	ServiceWorkerRouterSourceNetwork                    ServiceWorkerRouterSource = "network"
+	ServiceWorkerRouterSourceCache                      ServiceWorkerRouterSource = "cache"
+	ServiceWorkerRouterSourceFetchEvent                 ServiceWorkerRouterSource = "fetch-event"
+	ServiceWorkerRouterSourceRaceNetworkAndFetchHandler ServiceWorkerRouterSource = "race-network-and-fetch-handler"
+	ServiceWorkerRouterSourceRaceNetworkAndCache        ServiceWorkerRouterSource = "race-network-and-cache"
+)
+
+// Values gives every ServiceWorkerRouterSource
+func (ServiceWorkerRouterSource) Values() []ServiceWorkerRouterSource {
+	return []ServiceWorkerRouterSource{
+		ServiceWorkerRouterSourceNetwork,
+		ServiceWorkerRouterSourceCache,
+		ServiceWorkerRouterSourceFetchEvent,
+		ServiceWorkerRouterSourceRaceNetworkAndFetchHandler,
+		ServiceWorkerRouterSourceRaceNetworkAndCache,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ServiceWorkerRouterSource) Valid() bool {
+	switch v {
+	case ServiceWorkerRouterSourceNetwork, ServiceWorkerRouterSourceCache, ServiceWorkerRouterSourceFetchEvent, ServiceWorkerRouterSourceRaceNetworkAndFetchHandler, ServiceWorkerRouterSourceRaceNetworkAndCache:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ServiceWorkerRouterInfo struct {
+	/* ID of the rule matched. If there is a matched rule, this field will
+	be set, otherwiser no value will be set. */
+	RuleIdMatched *int `json:"ruleIdMatched,omitempty"`
+	/* The router source of the matched rule. If there is a matched rule, this
+	field will be set, otherwise no value will be set. */
+	MatchedSourceType *ServiceWorkerRouterSource `json:"matchedSourceType,omitempty"`
+	/* The actual router source used. */
+	ActualSourceType *ServiceWorkerRouterSource `json:"actualSourceType,omitempty"`
+}
+
+// MarshalCDP writes ServiceWorkerRouterInfo as JSON
+func (v *ServiceWorkerRouterInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.RuleIdMatched != nil {
+		e.Key("ruleIdMatched")
+		e.Int(*v.RuleIdMatched)
+	}
+	if v.MatchedSourceType != nil {
+		e.Key("matchedSourceType")
+		e.String(string(*v.MatchedSourceType))
+	}
+	if v.ActualSourceType != nil {
+		e.Key("actualSourceType")
+		e.String(string(*v.ActualSourceType))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ServiceWorkerRouterInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ServiceWorkerRouterInfo from JSON
+func (v *ServiceWorkerRouterInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "ruleIdMatched":
+			if d.Null() {
+				v.RuleIdMatched = nil
+			} else {
+				v.RuleIdMatched = new(int)
+				*v.RuleIdMatched = d.Int()
+			}
+		case "matchedSourceType":
+			if d.Null() {
+				v.MatchedSourceType = nil
+			} else {
+				v.MatchedSourceType = new(ServiceWorkerRouterSource)
+				*v.MatchedSourceType = ServiceWorkerRouterSource(d.String())
+			}
+		case "actualSourceType":
+			if d.Null() {
+				v.ActualSourceType = nil
+			} else {
+				v.ActualSourceType = new(ServiceWorkerRouterSource)
+				*v.ActualSourceType = ServiceWorkerRouterSource(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ServiceWorkerRouterInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HTTP response data.
+type Response struct {
+	/* Response URL. This URL can be different from CachedResource.url in case of redirect. */
+	Url string `json:"url"`
+	/* HTTP response status code. */
+	Status int `json:"status"`
+	/* HTTP response status text. */
+	StatusText string `json:"statusText"`
+	/* HTTP response headers. */
+	Headers Headers `json:"headers"`
+	/* HTTP response headers text. This has been replaced by the headers in Network.responseReceivedExtraInfo.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	HeadersText *string `json:"headersText,omitempty"`
+	/* Resource mimeType as determined by the browser. */
+	MimeType string `json:"mimeType"`
+	/* Resource charset as determined by the browser (if applicable). */
+	Charset string `json:"charset"`
+	/* Refined HTTP request headers that were actually transmitted over the network. */
+	RequestHeaders Headers `json:"requestHeaders"`
+	/* HTTP request headers text. This has been replaced by the headers in Network.requestWillBeSentExtraInfo.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	RequestHeadersText *string `json:"requestHeadersText,omitempty"`
+	/* Specifies whether physical connection was actually reused for this request. */
+	ConnectionReused bool `json:"connectionReused"`
+	/* Physical connection id that was actually used for this request. */
+	ConnectionId float64 `json:"connectionId"`
+	/* Remote IP address. */
+	RemoteIPAddress *string `json:"remoteIPAddress,omitempty"`
+	/* Remote port. */
+	RemotePort *int `json:"remotePort,omitempty"`
+	/* Specifies that the request was served from the disk cache. */
+	FromDiskCache *bool `json:"fromDiskCache,omitempty"`
+	/* Specifies that the request was served from the ServiceWorker. */
+	FromServiceWorker *bool `json:"fromServiceWorker,omitempty"`
+	/* Specifies that the request was served from the prefetch cache. */
+	FromPrefetchCache *bool `json:"fromPrefetchCache,omitempty"`
+	/* Specifies that the request was served from the prefetch cache. */
+	FromEarlyHints *bool `json:"fromEarlyHints,omitempty"`
+	/* Information about how ServiceWorker Static Router API was used. If this
+	field is set with `matchedSourceType` field, a matching rule is found.
+	If this field is set without `matchedSource`, no matching rule is found.
+	Otherwise, the API is not used.
+
+	Experimental: this may change or be removed in any chrome release */
+	ServiceWorkerRouterInfo *ServiceWorkerRouterInfo `json:"serviceWorkerRouterInfo,omitempty"`
+	/* Total number of bytes received for this request so far. */
+	EncodedDataLength float64 `json:"encodedDataLength"`
+	/* Timing information for the given request. */
+	Timing *ResourceTiming `json:"timing,omitempty"`
+	/* Response source of response from ServiceWorker. */
+	ServiceWorkerResponseSource *ServiceWorkerResponseSource `json:"serviceWorkerResponseSource,omitempty"`
+	/* The time at which the returned response was generated. */
+	ResponseTime *TimeSinceEpoch `json:"responseTime,omitempty"`
+	/* Cache Storage Cache Name. */
+	CacheStorageCacheName *string `json:"cacheStorageCacheName,omitempty"`
+	/* Protocol used to fetch this request. */
+	Protocol *string `json:"protocol,omitempty"`
+	/* The reason why Chrome uses a specific transport protocol for HTTP semantics.
+
+	Experimental: this may change or be removed in any chrome release */
+	AlternateProtocolUsage *AlternateProtocolUsage `json:"alternateProtocolUsage,omitempty"`
+	/* Security state of the request resource. */
+	SecurityState cdp.SecuritySecurityState `json:"securityState"`
+	/* Security details for the request. */
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+	/* Indicates whether the request was sent through IP Protection proxies. If
+	set to true, the request used the IP Protection privacy feature.
+
+	Experimental: this may change or be removed in any chrome release */
+	IsIpProtectionUsed *bool `json:"isIpProtectionUsed,omitempty"`
+}
+
+// MarshalCDP writes Response as JSON
+func (v *Response) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("status")
+	e.Int(v.Status)
+	e.Key("statusText")
+	e.String(v.StatusText)
+	e.Key("headers")
+	e.Map(map[string]interface{}(v.Headers))
+	if v.HeadersText != nil {
+		e.Key("headersText")
+		e.String(*v.HeadersText)
+	}
+	e.Key("mimeType")
+	e.String(v.MimeType)
+	e.Key("charset")
+	e.String(v.Charset)
+	if v.RequestHeaders != nil {
+		e.Key("requestHeaders")
+		e.Map(map[string]interface{}(v.RequestHeaders))
+	}
+	if v.RequestHeadersText != nil {
+		e.Key("requestHeadersText")
+		e.String(*v.RequestHeadersText)
+	}
+	e.Key("connectionReused")
+	e.Bool(v.ConnectionReused)
+	e.Key("connectionId")
+	e.Float(v.ConnectionId)
+	if v.RemoteIPAddress != nil {
+		e.Key("remoteIPAddress")
+		e.String(*v.RemoteIPAddress)
+	}
+	if v.RemotePort != nil {
+		e.Key("remotePort")
+		e.Int(*v.RemotePort)
+	}
+	if v.FromDiskCache != nil {
+		e.Key("fromDiskCache")
+		e.Bool(*v.FromDiskCache)
+	}
+	if v.FromServiceWorker != nil {
+		e.Key("fromServiceWorker")
+		e.Bool(*v.FromServiceWorker)
+	}
+	if v.FromPrefetchCache != nil {
+		e.Key("fromPrefetchCache")
+		e.Bool(*v.FromPrefetchCache)
+	}
+	if v.FromEarlyHints != nil {
+		e.Key("fromEarlyHints")
+		e.Bool(*v.FromEarlyHints)
+	}
+	if v.ServiceWorkerRouterInfo != nil {
+		e.Key("serviceWorkerRouterInfo")
+		(*v.ServiceWorkerRouterInfo).MarshalCDP(e)
+	}
+	e.Key("encodedDataLength")
+	e.Float(v.EncodedDataLength)
+	if v.Timing != nil {
+		e.Key("timing")
+		(*v.Timing).MarshalCDP(e)
+	}
+	if v.ServiceWorkerResponseSource != nil {
+		e.Key("serviceWorkerResponseSource")
+		e.String(string(*v.ServiceWorkerResponseSource))
+	}
+	if v.ResponseTime != nil {
+		e.Key("responseTime")
+		e.Float(float64(*v.ResponseTime))
+	}
+	if v.CacheStorageCacheName != nil {
+		e.Key("cacheStorageCacheName")
+		e.String(*v.CacheStorageCacheName)
+	}
+	if v.Protocol != nil {
+		e.Key("protocol")
+		e.String(*v.Protocol)
+	}
+	if v.AlternateProtocolUsage != nil {
+		e.Key("alternateProtocolUsage")
+		e.String(string(*v.AlternateProtocolUsage))
+	}
+	e.Key("securityState")
+	e.String(string(v.SecurityState))
+	if v.SecurityDetails != nil {
+		e.Key("securityDetails")
+		(*v.SecurityDetails).MarshalCDP(e)
+	}
+	if v.IsIpProtectionUsed != nil {
+		e.Key("isIpProtectionUsed")
+		e.Bool(*v.IsIpProtectionUsed)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Response) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Response from JSON
+func (v *Response) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "status":
+			v.Status = d.Int()
+		case "statusText":
+			v.StatusText = d.String()
+		case "headers":
+			v.Headers = Headers(d.Map())
+		case "headersText":
+			if d.Null() {
+				v.HeadersText = nil
+			} else {
+				v.HeadersText = new(string)
+				*v.HeadersText = d.String()
+			}
+		case "mimeType":
+			v.MimeType = d.String()
+		case "charset":
+			v.Charset = d.String()
+		case "requestHeaders":
+			v.RequestHeaders = Headers(d.Map())
+		case "requestHeadersText":
+			if d.Null() {
+				v.RequestHeadersText = nil
+			} else {
+				v.RequestHeadersText = new(string)
+				*v.RequestHeadersText = d.String()
+			}
+		case "connectionReused":
+			v.ConnectionReused = d.Bool()
+		case "connectionId":
+			v.ConnectionId = d.Float()
+		case "remoteIPAddress":
+			if d.Null() {
+				v.RemoteIPAddress = nil
+			} else {
+				v.RemoteIPAddress = new(string)
+				*v.RemoteIPAddress = d.String()
+			}
+		case "remotePort":
+			if d.Null() {
+				v.RemotePort = nil
+			} else {
+				v.RemotePort = new(int)
+				*v.RemotePort = d.Int()
+			}
+		case "fromDiskCache":
+			if d.Null() {
+				v.FromDiskCache = nil
+			} else {
+				v.FromDiskCache = new(bool)
+				*v.FromDiskCache = d.Bool()
+			}
+		case "fromServiceWorker":
+			if d.Null() {
+				v.FromServiceWorker = nil
+			} else {
+				v.FromServiceWorker = new(bool)
+				*v.FromServiceWorker = d.Bool()
+			}
+		case "fromPrefetchCache":
+			if d.Null() {
+				v.FromPrefetchCache = nil
+			} else {
+				v.FromPrefetchCache = new(bool)
+				*v.FromPrefetchCache = d.Bool()
+			}
+		case "fromEarlyHints":
+			if d.Null() {
+				v.FromEarlyHints = nil
+			} else {
+				v.FromEarlyHints = new(bool)
+				*v.FromEarlyHints = d.Bool()
+			}
+		case "serviceWorkerRouterInfo":
+			if d.Null() {
+				v.ServiceWorkerRouterInfo = nil
+			} else {
+				v.ServiceWorkerRouterInfo = new(ServiceWorkerRouterInfo)
+				(*v.ServiceWorkerRouterInfo).UnmarshalCDP(d)
+			}
+		case "encodedDataLength":
+			v.EncodedDataLength = d.Float()
+		case "timing":
+			if d.Null() {
+				v.Timing = nil
+			} else {
+				v.Timing = new(ResourceTiming)
+				(*v.Timing).UnmarshalCDP(d)
+			}
+		case "serviceWorkerResponseSource":
+			if d.Null() {
+				v.ServiceWorkerResponseSource = nil
+			} else {
+				v.ServiceWorkerResponseSource = new(ServiceWorkerResponseSource)
+				*v.ServiceWorkerResponseSource = ServiceWorkerResponseSource(d.String())
+			}
+		case "responseTime":
+			if d.Null() {
+				v.ResponseTime = nil
+			} else {
+				v.ResponseTime = new(TimeSinceEpoch)
+				*v.ResponseTime = TimeSinceEpoch(d.Float())
+			}
+		case "cacheStorageCacheName":
+			if d.Null() {
+				v.CacheStorageCacheName = nil
+			} else {
+				v.CacheStorageCacheName = new(string)
+				*v.CacheStorageCacheName = d.String()
+			}
+		case "protocol":
+			if d.Null() {
+				v.Protocol = nil
+			} else {
+				v.Protocol = new(string)
+				*v.Protocol = d.String()
+			}
+		case "alternateProtocolUsage":
+			if d.Null() {
+				v.AlternateProtocolUsage = nil
+			} else {
+				v.AlternateProtocolUsage = new(AlternateProtocolUsage)
+				*v.AlternateProtocolUsage = AlternateProtocolUsage(d.String())
+			}
+		case "securityState":
+			v.SecurityState = cdp.SecuritySecurityState(d.String())
+		case "securityDetails":
+			if d.Null() {
+				v.SecurityDetails = nil
+			} else {
+				v.SecurityDetails = new(SecurityDetails)
+				(*v.SecurityDetails).UnmarshalCDP(d)
+			}
+		case "isIpProtectionUsed":
+			if d.Null() {
+				v.IsIpProtectionUsed = nil
+			} else {
+				v.IsIpProtectionUsed = new(bool)
+				*v.IsIpProtectionUsed = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Response) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// WebSocket request data.
+type WebSocketRequest struct {
+	/* HTTP request headers. */
+	Headers Headers `json:"headers"`
+}
+
+// MarshalCDP writes WebSocketRequest as JSON
+func (v *WebSocketRequest) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("headers")
+	e.Map(map[string]interface{}(v.Headers))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v WebSocketRequest) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads WebSocketRequest from JSON
+func (v *WebSocketRequest) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "headers":
+			v.Headers = Headers(d.Map())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketRequest) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// WebSocket response data.
+type WebSocketResponse struct {
+	/* HTTP response status code. */
+	Status int `json:"status"`
+	/* HTTP response status text. */
+	StatusText string `json:"statusText"`
+	/* HTTP response headers. */
+	Headers Headers `json:"headers"`
+	/* HTTP response headers text. */
+	HeadersText *string `json:"headersText,omitempty"`
+	/* HTTP request headers. */
+	RequestHeaders Headers `json:"requestHeaders"`
+	/* HTTP request headers text. */
+	RequestHeadersText *string `json:"requestHeadersText,omitempty"`
+}
+
+// MarshalCDP writes WebSocketResponse as JSON
+func (v *WebSocketResponse) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("status")
+	e.Int(v.Status)
+	e.Key("statusText")
+	e.String(v.StatusText)
+	e.Key("headers")
+	e.Map(map[string]interface{}(v.Headers))
+	if v.HeadersText != nil {
+		e.Key("headersText")
+		e.String(*v.HeadersText)
+	}
+	if v.RequestHeaders != nil {
+		e.Key("requestHeaders")
+		e.Map(map[string]interface{}(v.RequestHeaders))
+	}
+	if v.RequestHeadersText != nil {
+		e.Key("requestHeadersText")
+		e.String(*v.RequestHeadersText)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v WebSocketResponse) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads WebSocketResponse from JSON
+func (v *WebSocketResponse) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "status":
+			v.Status = d.Int()
+		case "statusText":
+			v.StatusText = d.String()
+		case "headers":
+			v.Headers = Headers(d.Map())
+		case "headersText":
+			if d.Null() {
+				v.HeadersText = nil
+			} else {
+				v.HeadersText = new(string)
+				*v.HeadersText = d.String()
+			}
+		case "requestHeaders":
+			v.RequestHeaders = Headers(d.Map())
+		case "requestHeadersText":
+			if d.Null() {
+				v.RequestHeadersText = nil
+			} else {
+				v.RequestHeadersText = new(string)
+				*v.RequestHeadersText = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketResponse) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// WebSocket message data. This represents an entire WebSocket message, not just a fragmented frame as the name suggests.
+type WebSocketFrame struct {
+	/* WebSocket message opcode. */
+	Opcode float64 `json:"opcode"`
+	/* WebSocket message mask. */
+	Mask bool `json:"mask"`
+	/* WebSocket message payload data.
+	If the opcode is 1, this is a text message and payloadData is a UTF-8 string.
+	If the opcode isn't 1, then payloadData is a base64 encoded string representing binary data. */
+	PayloadData string `json:"payloadData"`
+}
+
+// MarshalCDP writes WebSocketFrame as JSON
+func (v *WebSocketFrame) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("opcode")
+	e.Float(v.Opcode)
+	e.Key("mask")
+	e.Bool(v.Mask)
+	e.Key("payloadData")
+	e.String(v.PayloadData)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v WebSocketFrame) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads WebSocketFrame from JSON
+func (v *WebSocketFrame) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "opcode":
+			v.Opcode = d.Float()
+		case "mask":
+			v.Mask = d.Bool()
+		case "payloadData":
+			v.PayloadData = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketFrame) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the cached resource.
+type CachedResource struct {
+	/* Resource URL. This is the url of the original network request. */
+	Url string `json:"url"`
+	/* Type of this resource. */
+	Type ResourceType `json:"type"`
+	/* Cached response data. */
+	Response *Response `json:"response,omitempty"`
+	/* Cached response body size. */
+	BodySize float64 `json:"bodySize"`
+}
+
+// MarshalCDP writes CachedResource as JSON
+func (v *CachedResource) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Response != nil {
+		e.Key("response")
+		(*v.Response).MarshalCDP(e)
+	}
+	e.Key("bodySize")
+	e.Float(v.BodySize)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CachedResource) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CachedResource from JSON
+func (v *CachedResource) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "type":
+			v.Type = ResourceType(d.String())
+		case "response":
+			if d.Null() {
+				v.Response = nil
+			} else {
+				v.Response = new(Response)
+				(*v.Response).UnmarshalCDP(d)
+			}
+		case "bodySize":
+			v.BodySize = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CachedResource) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the request initiator.
+type Initiator struct {
+	/* Type of this initiator. */
+	Type InitiatorType `json:"type"`
+	/* Initiator JavaScript stack trace, set for Script only.
+	Requires the Debugger domain to be enabled. */
+	Stack *cdp.RuntimeStackTrace `json:"stack,omitempty"`
+	/* Initiator URL, set for Parser type or for Script type (when script is importing module) or for SignedExchange type. */
+	Url *string `json:"url,omitempty"`
+	/* Initiator line number, set for Parser type or for Script type (when script is importing
+	module) (0-based). */
+	LineNumber *float64 `json:"lineNumber,omitempty"`
+	/* Initiator column number, set for Parser type or for Script type (when script is importing
+	module) (0-based). */
+	ColumnNumber *float64 `json:"columnNumber,omitempty"`
+	/* Set if another request triggered this request (e.g. preflight). */
+	RequestId *RequestId `json:"requestId,omitempty"`
+}
+
+// MarshalCDP writes Initiator as JSON
+func (v *Initiator) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Stack != nil {
+		e.Key("stack")
+		(*v.Stack).MarshalCDP(e)
+	}
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.LineNumber != nil {
+		e.Key("lineNumber")
+		e.Float(*v.LineNumber)
+	}
+	if v.ColumnNumber != nil {
+		e.Key("columnNumber")
+		e.Float(*v.ColumnNumber)
+	}
+	if v.RequestId != nil {
+		e.Key("requestId")
+		e.String(string(*v.RequestId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Initiator) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Initiator from JSON
+func (v *Initiator) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = InitiatorType(d.String())
+		case "stack":
+			if d.Null() {
+				v.Stack = nil
+			} else {
+				v.Stack = new(cdp.RuntimeStackTrace)
+				(*v.Stack).UnmarshalCDP(d)
+			}
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "lineNumber":
+			if d.Null() {
+				v.LineNumber = nil
+			} else {
+				v.LineNumber = new(float64)
+				*v.LineNumber = d.Float()
+			}
+		case "columnNumber":
+			if d.Null() {
+				v.ColumnNumber = nil
+			} else {
+				v.ColumnNumber = new(float64)
+				*v.ColumnNumber = d.Float()
+			}
+		case "requestId":
+			if d.Null() {
+				v.RequestId = nil
+			} else {
+				v.RequestId = new(RequestId)
+				*v.RequestId = RequestId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Initiator) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InitiatorType string
+
+// InitiatorType values
+const (
+	InitiatorTypeParser         InitiatorType = "parser"
+	InitiatorTypeScript         InitiatorType = "script"
+	InitiatorTypePreload        InitiatorType = "preload"
+	InitiatorTypeSignedExchange InitiatorType = "SignedExchange"
+	InitiatorTypePreflight      InitiatorType = "preflight"
+	InitiatorTypeOther          InitiatorType = "other"
+)
+
+// Values gives every InitiatorType
+func (InitiatorType) Values() []InitiatorType {
+	return []InitiatorType{
+		InitiatorTypeParser,
+		InitiatorTypeScript,
+		InitiatorTypePreload,
+		InitiatorTypeSignedExchange,
+		InitiatorTypePreflight,
+		InitiatorTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InitiatorType) Valid() bool {
+	switch v {
+	case InitiatorTypeParser, InitiatorTypeScript, InitiatorTypePreload, InitiatorTypeSignedExchange, InitiatorTypePreflight, InitiatorTypeOther:
+		return true
+	}
+	return false
+}
+
+// Types of reasons why a cookie may not be stored from a response.
+//
+// Experimental: this may change or be removed in any chrome release
+type SetCookieBlockedReason string
+
+// SetCookieBlockedReason values
+const (
+	SetCookieBlockedReasonSecureOnly                               SetCookieBlockedReason = "SecureOnly"
+	SetCookieBlockedReasonSameSiteStrict                           SetCookieBlockedReason = "SameSiteStrict"
+	SetCookieBlockedReasonSameSiteLax                              SetCookieBlockedReason = "SameSiteLax"
+	SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          SetCookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	SetCookieBlockedReasonSameSiteNoneInsecure                     SetCookieBlockedReason = "SameSiteNoneInsecure"
+	SetCookieBlockedReasonUserPreferences                          SetCookieBlockedReason = "UserPreferences"
+	SetCookieBlockedReasonThirdPartyPhaseout                       SetCookieBlockedReason = "ThirdPartyPhaseout"
+	SetCookieBlockedReasonThirdPartyBlockedInFirstPartySet         SetCookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	SetCookieBlockedReasonSyntaxError                              SetCookieBlockedReason = "SyntaxError"
+	SetCookieBlockedReasonSchemeNotSupported                       SetCookieBlockedReason = "SchemeNotSupported"
+	SetCookieBlockedReasonOverwriteSecure                          SetCookieBlockedReason = "OverwriteSecure"
+	SetCookieBlockedReasonInvalidDomain                            SetCookieBlockedReason = "InvalidDomain"
+	SetCookieBlockedReasonInvalidPrefix                            SetCookieBlockedReason = "InvalidPrefix"
+	SetCookieBlockedReasonUnknownError                             SetCookieBlockedReason = "UnknownError"
+	SetCookieBlockedReasonSchemefulSameSiteStrict                  SetCookieBlockedReason = "SchemefulSameSiteStrict"
+	SetCookieBlockedReasonSchemefulSameSiteLax                     SetCookieBlockedReason = "SchemefulSameSiteLax"
+	SetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax SetCookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	SetCookieBlockedReasonSamePartyFromCrossPartyContext           SetCookieBlockedReason = "SamePartyFromCrossPartyContext"
+	SetCookieBlockedReasonSamePartyConflictsWithOtherAttributes    SetCookieBlockedReason = "SamePartyConflictsWithOtherAttributes"
+	SetCookieBlockedReasonNameValuePairExceedsMaxSize              SetCookieBlockedReason = "NameValuePairExceedsMaxSize"
+	SetCookieBlockedReasonDisallowedCharacter                      SetCookieBlockedReason = "DisallowedCharacter"
+	SetCookieBlockedReasonNoCookieContent                          SetCookieBlockedReason = "NoCookieContent"
+)
+
+// Values gives every SetCookieBlockedReason
+func (SetCookieBlockedReason) Values() []SetCookieBlockedReason {
+	return []SetCookieBlockedReason{
+		SetCookieBlockedReasonSecureOnly,
+		SetCookieBlockedReasonSameSiteStrict,
+		SetCookieBlockedReasonSameSiteLax,
+		SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax,
+		SetCookieBlockedReasonSameSiteNoneInsecure,
+		SetCookieBlockedReasonUserPreferences,
+		SetCookieBlockedReasonThirdPartyPhaseout,
+		SetCookieBlockedReasonThirdPartyBlockedInFirstPartySet,
+		SetCookieBlockedReasonSyntaxError,
+		SetCookieBlockedReasonSchemeNotSupported,
+		SetCookieBlockedReasonOverwriteSecure,
+		SetCookieBlockedReasonInvalidDomain,
+		SetCookieBlockedReasonInvalidPrefix,
+		SetCookieBlockedReasonUnknownError,
+		SetCookieBlockedReasonSchemefulSameSiteStrict,
+		SetCookieBlockedReasonSchemefulSameSiteLax,
+		SetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax,
+		SetCookieBlockedReasonSamePartyFromCrossPartyContext,
+		SetCookieBlockedReasonSamePartyConflictsWithOtherAttributes,
+		SetCookieBlockedReasonNameValuePairExceedsMaxSize,
+		SetCookieBlockedReasonDisallowedCharacter,
+		SetCookieBlockedReasonNoCookieContent,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetCookieBlockedReason) Valid() bool {
+	switch v {
+	case SetCookieBlockedReasonSecureOnly, SetCookieBlockedReasonSameSiteStrict, SetCookieBlockedReasonSameSiteLax, SetCookieBlockedReasonSameSiteUnspecifiedTreatedAsLax, SetCookieBlockedReasonSameSiteNoneInsecure, SetCookieBlockedReasonUserPreferences, SetCookieBlockedReasonThirdPartyPhaseout, SetCookieBlockedReasonThirdPartyBlockedInFirstPartySet, SetCookieBlockedReasonSyntaxError, SetCookieBlockedReasonSchemeNotSupported, SetCookieBlockedReasonOverwriteSecure, SetCookieBlockedReasonInvalidDomain, SetCookieBlockedReasonInvalidPrefix, SetCookieBlockedReasonUnknownError, SetCookieBlockedReasonSchemefulSameSiteStrict, SetCookieBlockedReasonSchemefulSameSiteLax, SetCookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax, SetCookieBlockedReasonSamePartyFromCrossPartyContext, SetCookieBlockedReasonSamePartyConflictsWithOtherAttributes, SetCookieBlockedReasonNameValuePairExceedsMaxSize, SetCookieBlockedReasonDisallowedCharacter, SetCookieBlockedReasonNoCookieContent:
+		return true
+	}
+	return false
+}
+
+// Types of reasons why a cookie may not be sent with a request.
+//
+// Experimental: this may change or be removed in any chrome release
+type CookieBlockedReason string
+
+// CookieBlockedReason values
+const (
+	CookieBlockedReasonSecureOnly                               CookieBlockedReason = "SecureOnly"
+	CookieBlockedReasonNotOnPath                                CookieBlockedReason = "NotOnPath"
+	CookieBlockedReasonDomainMismatch                           CookieBlockedReason = "DomainMismatch"
+	CookieBlockedReasonSameSiteStrict                           CookieBlockedReason = "SameSiteStrict"
+	CookieBlockedReasonSameSiteLax                              CookieBlockedReason = "SameSiteLax"
+	CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax          CookieBlockedReason = "SameSiteUnspecifiedTreatedAsLax"
+	CookieBlockedReasonSameSiteNoneInsecure                     CookieBlockedReason = "SameSiteNoneInsecure"
+	CookieBlockedReasonUserPreferences                          CookieBlockedReason = "UserPreferences"
+	CookieBlockedReasonThirdPartyPhaseout                       CookieBlockedReason = "ThirdPartyPhaseout"
+	CookieBlockedReasonThirdPartyBlockedInFirstPartySet         CookieBlockedReason = "ThirdPartyBlockedInFirstPartySet"
+	CookieBlockedReasonUnknownError                             CookieBlockedReason = "UnknownError"
+	CookieBlockedReasonSchemefulSameSiteStrict                  CookieBlockedReason = "SchemefulSameSiteStrict"
+	CookieBlockedReasonSchemefulSameSiteLax                     CookieBlockedReason = "SchemefulSameSiteLax"
+	CookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax CookieBlockedReason = "SchemefulSameSiteUnspecifiedTreatedAsLax"
+	CookieBlockedReasonSamePartyFromCrossPartyContext           CookieBlockedReason = "SamePartyFromCrossPartyContext"
+	CookieBlockedReasonNameValuePairExceedsMaxSize              CookieBlockedReason = "NameValuePairExceedsMaxSize"
+	CookieBlockedReasonPortMismatch                             CookieBlockedReason = "PortMismatch"
+	CookieBlockedReasonSchemeMismatch                           CookieBlockedReason = "SchemeMismatch"
+	CookieBlockedReasonAnonymousContext                         CookieBlockedReason = "AnonymousContext"
+)
+
+// Values gives every CookieBlockedReason
+func (CookieBlockedReason) Values() []CookieBlockedReason {
+	return []CookieBlockedReason{
+		CookieBlockedReasonSecureOnly,
+		CookieBlockedReasonNotOnPath,
+		CookieBlockedReasonDomainMismatch,
+		CookieBlockedReasonSameSiteStrict,
+		CookieBlockedReasonSameSiteLax,
+		CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax,
+		CookieBlockedReasonSameSiteNoneInsecure,
+		CookieBlockedReasonUserPreferences,
+		CookieBlockedReasonThirdPartyPhaseout,
+		CookieBlockedReasonThirdPartyBlockedInFirstPartySet,
+		CookieBlockedReasonUnknownError,
+		CookieBlockedReasonSchemefulSameSiteStrict,
+		CookieBlockedReasonSchemefulSameSiteLax,
+		CookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax,
+		CookieBlockedReasonSamePartyFromCrossPartyContext,
+		CookieBlockedReasonNameValuePairExceedsMaxSize,
+		CookieBlockedReasonPortMismatch,
+		CookieBlockedReasonSchemeMismatch,
+		CookieBlockedReasonAnonymousContext,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CookieBlockedReason) Valid() bool {
+	switch v {
+	case CookieBlockedReasonSecureOnly, CookieBlockedReasonNotOnPath, CookieBlockedReasonDomainMismatch, CookieBlockedReasonSameSiteStrict, CookieBlockedReasonSameSiteLax, CookieBlockedReasonSameSiteUnspecifiedTreatedAsLax, CookieBlockedReasonSameSiteNoneInsecure, CookieBlockedReasonUserPreferences, CookieBlockedReasonThirdPartyPhaseout, CookieBlockedReasonThirdPartyBlockedInFirstPartySet, CookieBlockedReasonUnknownError, CookieBlockedReasonSchemefulSameSiteStrict, CookieBlockedReasonSchemefulSameSiteLax, CookieBlockedReasonSchemefulSameSiteUnspecifiedTreatedAsLax, CookieBlockedReasonSamePartyFromCrossPartyContext, CookieBlockedReasonNameValuePairExceedsMaxSize, CookieBlockedReasonPortMismatch, CookieBlockedReasonSchemeMismatch, CookieBlockedReasonAnonymousContext:
+		return true
+	}
+	return false
+}
+
+// Types of reasons why a cookie should have been blocked by 3PCD but is exempted for the request.
+//
+// Experimental: this may change or be removed in any chrome release
+type CookieExemptionReason string
+
+// CookieExemptionReason values
+const (
+	CookieExemptionReasonNone                         CookieExemptionReason = "None"
+	CookieExemptionReasonUserSetting                  CookieExemptionReason = "UserSetting"
+	CookieExemptionReasonTPCDMetadata                 CookieExemptionReason = "TPCDMetadata"
+	CookieExemptionReasonTPCDDeprecationTrial         CookieExemptionReason = "TPCDDeprecationTrial"
+	CookieExemptionReasonTopLevelTPCDDeprecationTrial CookieExemptionReason = "TopLevelTPCDDeprecationTrial"
+	CookieExemptionReasonTPCDHeuristics               CookieExemptionReason = "TPCDHeuristics"
+	CookieExemptionReasonEnterprisePolicy             CookieExemptionReason = "EnterprisePolicy"
+	CookieExemptionReasonStorageAccess                CookieExemptionReason = "StorageAccess"
+	CookieExemptionReasonTopLevelStorageAccess        CookieExemptionReason = "TopLevelStorageAccess"
+	CookieExemptionReasonScheme                       CookieExemptionReason = "Scheme"
+	CookieExemptionReasonSameSiteNoneCookiesInSandbox CookieExemptionReason = "SameSiteNoneCookiesInSandbox"
+)
+
+// Values gives every CookieExemptionReason
+func (CookieExemptionReason) Values() []CookieExemptionReason {
+	return []CookieExemptionReason{
+		CookieExemptionReasonNone,
+		CookieExemptionReasonUserSetting,
+		CookieExemptionReasonTPCDMetadata,
+		CookieExemptionReasonTPCDDeprecationTrial,
+		CookieExemptionReasonTopLevelTPCDDeprecationTrial,
+		CookieExemptionReasonTPCDHeuristics,
+		CookieExemptionReasonEnterprisePolicy,
+		CookieExemptionReasonStorageAccess,
+		CookieExemptionReasonTopLevelStorageAccess,
+		CookieExemptionReasonScheme,
+		CookieExemptionReasonSameSiteNoneCookiesInSandbox,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CookieExemptionReason) Valid() bool {
+	switch v {
+	case CookieExemptionReasonNone, CookieExemptionReasonUserSetting, CookieExemptionReasonTPCDMetadata, CookieExemptionReasonTPCDDeprecationTrial, CookieExemptionReasonTopLevelTPCDDeprecationTrial, CookieExemptionReasonTPCDHeuristics, CookieExemptionReasonEnterprisePolicy, CookieExemptionReasonStorageAccess, CookieExemptionReasonTopLevelStorageAccess, CookieExemptionReasonScheme, CookieExemptionReasonSameSiteNoneCookiesInSandbox:
+		return true
+	}
+	return false
+}
+
+// A cookie which was not stored from a response with the corresponding reason.
+//
+// Experimental: this may change or be removed in any chrome release
+type BlockedSetCookieWithReason struct {
+	/* The reason(s) this cookie was blocked. */
+	BlockedReasons []SetCookieBlockedReason `json:"blockedReasons"`
+	/* The string representing this individual cookie as it would appear in the header.
+	This is not the entire "cookie" or "set-cookie" header which could have multiple cookies. */
+	CookieLine string `json:"cookieLine"`
+	/* The cookie object which represents the cookie which was not stored. It is optional because
+	sometimes complete cookie information is not available, such as in the case of parsing
+	errors. */
+	Cookie *Cookie `json:"cookie,omitempty"`
+}
+
+// MarshalCDP writes BlockedSetCookieWithReason as JSON
+func (v *BlockedSetCookieWithReason) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("blockedReasons")
+	if v.BlockedReasons == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.BlockedReasons {
+			e.Elem()
+			e.String(string(v.BlockedReasons[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.Key("cookieLine")
+	e.String(v.CookieLine)
+	if v.Cookie != nil {
+		e.Key("cookie")
+		(*v.Cookie).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BlockedSetCookieWithReason) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BlockedSetCookieWithReason from JSON
+func (v *BlockedSetCookieWithReason) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "blockedReasons":
+			if d.Array() {
+				v.BlockedReasons = make([]SetCookieBlockedReason, 0)
+				for d.More() {
+					var x0 SetCookieBlockedReason
+					x0 = SetCookieBlockedReason(d.String())
+					v.BlockedReasons = append(v.BlockedReasons, x0)
+				}
+			} else {
+				v.BlockedReasons = nil
+			}
+		case "cookieLine":
+			v.CookieLine = d.String()
+		case "cookie":
+			if d.Null() {
+				v.Cookie = nil
+			} else {
+				v.Cookie = new(Cookie)
+				(*v.Cookie).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BlockedSetCookieWithReason) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A cookie should have been blocked by 3PCD but is exempted and stored from a response with the
+// corresponding reason. A cookie could only have at most one exemption reason.
+//
+// Experimental: this may change or be removed in any chrome release
+type ExemptedSetCookieWithReason struct {
+	/* The reason the cookie was exempted. */
+	ExemptionReason CookieExemptionReason `json:"exemptionReason"`
+	/* The string representing this individual cookie as it would appear in the header. */
+	CookieLine string `json:"cookieLine"`
+	/* The cookie object representing the cookie. */
+	Cookie Cookie `json:"cookie"`
+}
+
+// MarshalCDP writes ExemptedSetCookieWithReason as JSON
+func (v *ExemptedSetCookieWithReason) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("exemptionReason")
+	e.String(string(v.ExemptionReason))
+	e.Key("cookieLine")
+	e.String(v.CookieLine)
+	e.Key("cookie")
+	v.Cookie.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ExemptedSetCookieWithReason) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ExemptedSetCookieWithReason from JSON
+func (v *ExemptedSetCookieWithReason) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "exemptionReason":
+			v.ExemptionReason = CookieExemptionReason(d.String())
+		case "cookieLine":
+			v.CookieLine = d.String()
+		case "cookie":
+			v.Cookie.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExemptedSetCookieWithReason) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A cookie associated with the request which may or may not be sent with it.
+// Includes the cookies itself and reasons for blocking or exemption.
+//
+// Experimental: this may change or be removed in any chrome release
+type AssociatedCookie struct {
+	/* The cookie object representing the cookie which was not sent. */
+	Cookie Cookie `json:"cookie"`
+	/* The reason(s) the cookie was blocked. If empty means the cookie is included. */
+	BlockedReasons []CookieBlockedReason `json:"blockedReasons"`
+	/* The reason the cookie should have been blocked by 3PCD but is exempted. A cookie could
+	only have at most one exemption reason. */
+	ExemptionReason *CookieExemptionReason `json:"exemptionReason,omitempty"`
+}
+
+// MarshalCDP writes AssociatedCookie as JSON
+func (v *AssociatedCookie) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cookie")
+	v.Cookie.MarshalCDP(e)
+	e.Key("blockedReasons")
+	if v.BlockedReasons == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.BlockedReasons {
+			e.Elem()
+			e.String(string(v.BlockedReasons[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.ExemptionReason != nil {
+		e.Key("exemptionReason")
+		e.String(string(*v.ExemptionReason))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AssociatedCookie) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AssociatedCookie from JSON
+func (v *AssociatedCookie) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cookie":
+			v.Cookie.UnmarshalCDP(d)
+		case "blockedReasons":
+			if d.Array() {
+				v.BlockedReasons = make([]CookieBlockedReason, 0)
+				for d.More() {
+					var x0 CookieBlockedReason
+					x0 = CookieBlockedReason(d.String())
+					v.BlockedReasons = append(v.BlockedReasons, x0)
+				}
+			} else {
+				v.BlockedReasons = nil
+			}
+		case "exemptionReason":
+			if d.Null() {
+				v.ExemptionReason = nil
+			} else {
+				v.ExemptionReason = new(CookieExemptionReason)
+				*v.ExemptionReason = CookieExemptionReason(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AssociatedCookie) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Authorization challenge for HTTP status code 401 or 407.
+//
+// Experimental: this may change or be removed in any chrome release
+type AuthChallenge struct {
+	/* Source of the authentication challenge. */
+	Source *AuthChallengeSource `json:"source,omitempty"`
+	/* Origin of the challenger. */
+	Origin string `json:"origin"`
+	/* The authentication scheme used, such as basic or digest */
+	Scheme string `json:"scheme"`
+	/* The realm of the challenge. May be empty. */
+	Realm string `json:"realm"`
+}
+
+// MarshalCDP writes AuthChallenge as JSON
+func (v *AuthChallenge) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Source != nil {
+		e.Key("source")
+		e.String(string(*v.Source))
+	}
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("scheme")
+	e.String(v.Scheme)
+	e.Key("realm")
+	e.String(v.Realm)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AuthChallenge) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AuthChallenge from JSON
+func (v *AuthChallenge) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "source":
+			if d.Null() {
+				v.Source = nil
+			} else {
+				v.Source = new(AuthChallengeSource)
+				*v.Source = AuthChallengeSource(d.String())
+			}
+		case "origin":
+			v.Origin = d.String()
+		case "scheme":
+			v.Scheme = d.String()
+		case "realm":
+			v.Realm = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AuthChallenge) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AuthChallengeSource string
+
+// AuthChallengeSource values
+const (
+	AuthChallengeSourceServer AuthChallengeSource = "Server"
+	AuthChallengeSourceProxy  AuthChallengeSource = "Proxy"
+)
+
+// Values gives every AuthChallengeSource
+func (AuthChallengeSource) Values() []AuthChallengeSource {
+	return []AuthChallengeSource{
+		AuthChallengeSourceServer,
+		AuthChallengeSourceProxy,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AuthChallengeSource) Valid() bool {
+	switch v {
+	case AuthChallengeSourceServer, AuthChallengeSourceProxy:
+		return true
+	}
+	return false
+}
+
+// Response to an AuthChallenge.
+//
+// Experimental: this may change or be removed in any chrome release
+type AuthChallengeResponse struct {
+	/* The decision on what to do in response to the authorization challenge.  Default means
+	deferring to the default behavior of the net stack, which will likely either the Cancel
+	authentication or display a popup dialog box. */
+	Response AuthChallengeResponseResponse `json:"response"`
+	/* The username to provide, possibly empty. Should only be set if response is
+	ProvideCredentials. */
+	Username *string `json:"username,omitempty"`
+	/* The password to provide, possibly empty. Should only be set if response is
+	ProvideCredentials. */
+	Password *string `json:"password,omitempty"`
+}
+
+// MarshalCDP writes AuthChallengeResponse as JSON
+func (v *AuthChallengeResponse) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("response")
+	e.String(string(v.Response))
+	if v.Username != nil {
+		e.Key("username")
+		e.String(*v.Username)
+	}
+	if v.Password != nil {
+		e.Key("password")
+		e.String(*v.Password)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AuthChallengeResponse) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AuthChallengeResponse from JSON
+func (v *AuthChallengeResponse) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "response":
+			v.Response = AuthChallengeResponseResponse(d.String())
+		case "username":
+			if d.Null() {
+				v.Username = nil
+			} else {
+				v.Username = new(string)
+				*v.Username = d.String()
+			}
+		case "password":
+			if d.Null() {
+				v.Password = nil
+			} else {
+				v.Password = new(string)
+				*v.Password = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AuthChallengeResponse) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AuthChallengeResponseResponse string
+
+// AuthChallengeResponseResponse values
+const (
+	AuthChallengeResponseResponseDefault            AuthChallengeResponseResponse = "Default"
+	AuthChallengeResponseResponseCancelAuth         AuthChallengeResponseResponse = "CancelAuth"
+	AuthChallengeResponseResponseProvideCredentials AuthChallengeResponseResponse = "ProvideCredentials"
+)
+
+// Values gives every AuthChallengeResponseResponse
+func (AuthChallengeResponseResponse) Values() []AuthChallengeResponseResponse {
+	return []AuthChallengeResponseResponse{
+		AuthChallengeResponseResponseDefault,
+		AuthChallengeResponseResponseCancelAuth,
+		AuthChallengeResponseResponseProvideCredentials,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AuthChallengeResponseResponse) Valid() bool {
+	switch v {
+	case AuthChallengeResponseResponseDefault, AuthChallengeResponseResponseCancelAuth, AuthChallengeResponseResponseProvideCredentials:
+		return true
+	}
+	return false
+}
+
+// Stages of the interception to begin intercepting. Request will intercept before the request is
+// sent. Response will intercept after the response is received.
+//
+// Experimental: this may change or be removed in any chrome release
+type InterceptionStage string
+
+// InterceptionStage values
+const (
+	InterceptionStageRequest         InterceptionStage = "Request"
+	InterceptionStageHeadersReceived InterceptionStage = "HeadersReceived"
+)
+
+// Values gives every InterceptionStage
+func (InterceptionStage) Values() []InterceptionStage {
+	return []InterceptionStage{
+		InterceptionStageRequest,
+		InterceptionStageHeadersReceived,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InterceptionStage) Valid() bool {
+	switch v {
+	case InterceptionStageRequest, InterceptionStageHeadersReceived:
+		return true
+	}
+	return false
+}
+
+// Request pattern for interception.
+//
+// Experimental: this may change or be removed in any chrome release
+type RequestPattern struct {
+	/* Wildcards (`'*'` -> zero or more, `'?'` -> exactly one) are allowed. Escape character is
+	backslash. Omitting is equivalent to `"*"`. */
+	UrlPattern *string `json:"urlPattern,omitempty"`
+	/* If set, only requests for matching resource types will be intercepted. */
+	ResourceType *ResourceType `json:"resourceType,omitempty"`
+	/* Stage at which to begin intercepting requests. Default is Request. */
+	InterceptionStage *InterceptionStage `json:"interceptionStage,omitempty"`
+}
+
+// MarshalCDP writes RequestPattern as JSON
+func (v *RequestPattern) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.UrlPattern != nil {
+		e.Key("urlPattern")
+		e.String(*v.UrlPattern)
+	}
+	if v.ResourceType != nil {
+		e.Key("resourceType")
+		e.String(string(*v.ResourceType))
+	}
+	if v.InterceptionStage != nil {
+		e.Key("interceptionStage")
+		e.String(string(*v.InterceptionStage))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestPattern) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RequestPattern from JSON
+func (v *RequestPattern) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "urlPattern":
+			if d.Null() {
+				v.UrlPattern = nil
+			} else {
+				v.UrlPattern = new(string)
+				*v.UrlPattern = d.String()
+			}
+		case "resourceType":
+			if d.Null() {
+				v.ResourceType = nil
+			} else {
+				v.ResourceType = new(ResourceType)
+				*v.ResourceType = ResourceType(d.String())
+			}
+		case "interceptionStage":
+			if d.Null() {
+				v.InterceptionStage = nil
+			} else {
+				v.InterceptionStage = new(InterceptionStage)
+				*v.InterceptionStage = InterceptionStage(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestPattern) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about a signed exchange signature.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#rfc.section.3.1
+//
+// Experimental: this may change or be removed in any chrome release
+type SignedExchangeSignature struct {
+	/* Signed exchange signature label. */
+	Label string `json:"label"`
+	/* The hex string of signed exchange signature. */
+	Signature string `json:"signature"`
+	/* Signed exchange signature integrity. */
+	Integrity string `json:"integrity"`
+	/* Signed exchange signature cert Url. */
+	CertUrl *string `json:"certUrl,omitempty"`
+	/* The hex string of signed exchange signature cert sha256. */
+	CertSha256 *string `json:"certSha256,omitempty"`
+	/* Signed exchange signature validity Url. */
+	ValidityUrl string `json:"validityUrl"`
+	/* Signed exchange signature date. */
+	Date int `json:"date"`
+	/* Signed exchange signature expires. */
+	Expires int `json:"expires"`
+	/* The encoded certificates. */
+	Certificates []string `json:"certificates"`
+}
+
+// MarshalCDP writes SignedExchangeSignature as JSON
+func (v *SignedExchangeSignature) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("label")
+	e.String(v.Label)
+	e.Key("signature")
+	e.String(v.Signature)
+	e.Key("integrity")
+	e.String(v.Integrity)
+	if v.CertUrl != nil {
+		e.Key("certUrl")
+		e.String(*v.CertUrl)
+	}
+	if v.CertSha256 != nil {
+		e.Key("certSha256")
+		e.String(*v.CertSha256)
+	}
+	e.Key("validityUrl")
+	e.String(v.ValidityUrl)
+	e.Key("date")
+	e.Int(v.Date)
+	e.Key("expires")
+	e.Int(v.Expires)
+	if v.Certificates != nil {
+		e.Key("certificates")
+		e.ArrayStart()
+		for i0 := range v.Certificates {
+			e.Elem()
+			e.String(v.Certificates[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SignedExchangeSignature) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SignedExchangeSignature from JSON
+func (v *SignedExchangeSignature) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "label":
+			v.Label = d.String()
+		case "signature":
+			v.Signature = d.String()
+		case "integrity":
+			v.Integrity = d.String()
+		case "certUrl":
+			if d.Null() {
+				v.CertUrl = nil
+			} else {
+				v.CertUrl = new(string)
+				*v.CertUrl = d.String()
+			}
+		case "certSha256":
+			if d.Null() {
+				v.CertSha256 = nil
+			} else {
+				v.CertSha256 = new(string)
+				*v.CertSha256 = d.String()
+			}
+		case "validityUrl":
+			v.ValidityUrl = d.String()
+		case "date":
+			v.Date = d.Int()
+		case "expires":
+			v.Expires = d.Int()
+		case "certificates":
+			if d.Array() {
+				v.Certificates = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Certificates = append(v.Certificates, x0)
+				}
+			} else {
+				v.Certificates = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SignedExchangeSignature) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about a signed exchange header.
+// https://wicg.github.io/webpackage/draft-yasskin-httpbis-origin-signed-exchanges-impl.html#cbor-representation
+//
+// Experimental: this may change or be removed in any chrome release
+type SignedExchangeHeader struct {
+	/* Signed exchange request URL. */
+	RequestUrl string `json:"requestUrl"`
+	/* Signed exchange response code. */
+	ResponseCode int `json:"responseCode"`
+	/* Signed exchange response headers. */
+	ResponseHeaders Headers `json:"responseHeaders"`
+	/* Signed exchange response signature. */
+	Signatures []SignedExchangeSignature `json:"signatures"`
+	/* Signed exchange header integrity hash in the form of `sha256-<base64-hash-value>`. */
+	HeaderIntegrity string `json:"headerIntegrity"`
+}
+
+// MarshalCDP writes SignedExchangeHeader as JSON
+func (v *SignedExchangeHeader) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestUrl")
+	e.String(v.RequestUrl)
+	e.Key("responseCode")
+	e.Int(v.ResponseCode)
+	e.Key("responseHeaders")
+	e.Map(map[string]interface{}(v.ResponseHeaders))
+	e.Key("signatures")
+	if v.Signatures == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Signatures {
+			e.Elem()
+			v.Signatures[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("headerIntegrity")
+	e.String(v.HeaderIntegrity)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SignedExchangeHeader) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SignedExchangeHeader from JSON
+func (v *SignedExchangeHeader) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestUrl":
+			v.RequestUrl = d.String()
+		case "responseCode":
+			v.ResponseCode = d.Int()
+		case "responseHeaders":
+			v.ResponseHeaders = Headers(d.Map())
+		case "signatures":
+			if d.Array() {
+				v.Signatures = make([]SignedExchangeSignature, 0)
+				for d.More() {
+					var x0 SignedExchangeSignature
+					x0.UnmarshalCDP(d)
+					v.Signatures = append(v.Signatures, x0)
+				}
+			} else {
+				v.Signatures = nil
+			}
+		case "headerIntegrity":
+			v.HeaderIntegrity = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SignedExchangeHeader) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Field type for a signed exchange related error.
+//
+// Experimental: this may change or be removed in any chrome release
+type SignedExchangeErrorField string
+
+// SignedExchangeErrorField values
+const (
+	SignedExchangeErrorFieldSignatureSig         SignedExchangeErrorField = "signatureSig"
+	SignedExchangeErrorFieldSignatureIntegrity   SignedExchangeErrorField = "signatureIntegrity"
+	SignedExchangeErrorFieldSignatureCertUrl     SignedExchangeErrorField = "signatureCertUrl"
+	SignedExchangeErrorFieldSignatureCertSha256  SignedExchangeErrorField = "signatureCertSha256"
+	SignedExchangeErrorFieldSignatureValidityUrl SignedExchangeErrorField = "signatureValidityUrl"
+	SignedExchangeErrorFieldSignatureTimestamps  SignedExchangeErrorField = "signatureTimestamps"
+)
+
+// Values gives every SignedExchangeErrorField
+func (SignedExchangeErrorField) Values() []SignedExchangeErrorField {
+	return []SignedExchangeErrorField{
+		SignedExchangeErrorFieldSignatureSig,
+		SignedExchangeErrorFieldSignatureIntegrity,
+		SignedExchangeErrorFieldSignatureCertUrl,
+		SignedExchangeErrorFieldSignatureCertSha256,
+		SignedExchangeErrorFieldSignatureValidityUrl,
+		SignedExchangeErrorFieldSignatureTimestamps,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SignedExchangeErrorField) Valid() bool {
+	switch v {
+	case SignedExchangeErrorFieldSignatureSig, SignedExchangeErrorFieldSignatureIntegrity, SignedExchangeErrorFieldSignatureCertUrl, SignedExchangeErrorFieldSignatureCertSha256, SignedExchangeErrorFieldSignatureValidityUrl, SignedExchangeErrorFieldSignatureTimestamps:
+		return true
+	}
+	return false
+}
+
+// Information about a signed exchange response.
+//
+// Experimental: this may change or be removed in any chrome release
+type SignedExchangeError struct {
+	/* Error message. */
+	Message string `json:"message"`
+	/* The index of the signature which caused the error. */
+	SignatureIndex *int `json:"signatureIndex,omitempty"`
+	/* The field which caused the error. */
+	ErrorField *SignedExchangeErrorField `json:"errorField,omitempty"`
+}
+
+// MarshalCDP writes SignedExchangeError as JSON
+func (v *SignedExchangeError) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("message")
+	e.String(v.Message)
+	if v.SignatureIndex != nil {
+		e.Key("signatureIndex")
+		e.Int(*v.SignatureIndex)
+	}
+	if v.ErrorField != nil {
+		e.Key("errorField")
+		e.String(string(*v.ErrorField))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SignedExchangeError) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SignedExchangeError from JSON
+func (v *SignedExchangeError) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "message":
+			v.Message = d.String()
+		case "signatureIndex":
+			if d.Null() {
+				v.SignatureIndex = nil
+			} else {
+				v.SignatureIndex = new(int)
+				*v.SignatureIndex = d.Int()
+			}
+		case "errorField":
+			if d.Null() {
+				v.ErrorField = nil
+			} else {
+				v.ErrorField = new(SignedExchangeErrorField)
+				*v.ErrorField = SignedExchangeErrorField(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SignedExchangeError) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about a signed exchange response.
+//
+// Experimental: this may change or be removed in any chrome release
+type SignedExchangeInfo struct {
+	/* The outer response of signed HTTP exchange which was received from network. */
+	OuterResponse Response `json:"outerResponse"`
+	/* Whether network response for the signed exchange was accompanied by
+	extra headers. */
+	HasExtraInfo bool `json:"hasExtraInfo"`
+	/* Information about the signed exchange header. */
+	Header *SignedExchangeHeader `json:"header,omitempty"`
+	/* Security details for the signed exchange header. */
+	SecurityDetails *SecurityDetails `json:"securityDetails,omitempty"`
+	/* Errors occurred while handling the signed exchange. */
+	Errors []SignedExchangeError `json:"errors"`
+}
+
+// MarshalCDP writes SignedExchangeInfo as JSON
+func (v *SignedExchangeInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("outerResponse")
+	v.OuterResponse.MarshalCDP(e)
+	e.Key("hasExtraInfo")
+	e.Bool(v.HasExtraInfo)
+	if v.Header != nil {
+		e.Key("header")
+		(*v.Header).MarshalCDP(e)
+	}
+	if v.SecurityDetails != nil {
+		e.Key("securityDetails")
+		(*v.SecurityDetails).MarshalCDP(e)
+	}
+	if v.Errors != nil {
+		e.Key("errors")
+		e.ArrayStart()
+		for i0 := range v.Errors {
+			e.Elem()
+			v.Errors[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SignedExchangeInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SignedExchangeInfo from JSON
+func (v *SignedExchangeInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "outerResponse":
+			v.OuterResponse.UnmarshalCDP(d)
+		case "hasExtraInfo":
+			v.HasExtraInfo = d.Bool()
+		case "header":
+			if d.Null() {
+				v.Header = nil
+			} else {
+				v.Header = new(SignedExchangeHeader)
+				(*v.Header).UnmarshalCDP(d)
+			}
+		case "securityDetails":
+			if d.Null() {
+				v.SecurityDetails = nil
+			} else {
+				v.SecurityDetails = new(SecurityDetails)
+				(*v.SecurityDetails).UnmarshalCDP(d)
+			}
+		case "errors":
+			if d.Array() {
+				v.Errors = make([]SignedExchangeError, 0)
+				for d.More() {
+					var x0 SignedExchangeError
+					x0.UnmarshalCDP(d)
+					v.Errors = append(v.Errors, x0)
+				}
+			} else {
+				v.Errors = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SignedExchangeInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// List of content encodings supported by the backend.
+//
+// Experimental: this may change or be removed in any chrome release
+type ContentEncoding string
+
+// ContentEncoding values
+const (
+	ContentEncodingDeflate ContentEncoding = "deflate"
+	ContentEncodingGzip    ContentEncoding = "gzip"
+	ContentEncodingBr      ContentEncoding = "br"
+	ContentEncodingZstd    ContentEncoding = "zstd"
+)
+
+// Values gives every ContentEncoding
+func (ContentEncoding) Values() []ContentEncoding {
+	return []ContentEncoding{
+		ContentEncodingDeflate,
+		ContentEncodingGzip,
+		ContentEncodingBr,
+		ContentEncodingZstd,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContentEncoding) Valid() bool {
+	switch v {
+	case ContentEncodingDeflate, ContentEncodingGzip, ContentEncodingBr, ContentEncodingZstd:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type DirectSocketDnsQueryType string
+
+// DirectSocketDnsQueryType values
+const (
+	DirectSocketDnsQueryTypeIpv4 DirectSocketDnsQueryType = "ipv4"
+	DirectSocketDnsQueryTypeIpv6 DirectSocketDnsQueryType = "ipv6"
+)
+
+// Values gives every DirectSocketDnsQueryType
+func (DirectSocketDnsQueryType) Values() []DirectSocketDnsQueryType {
+	return []DirectSocketDnsQueryType{
+		DirectSocketDnsQueryTypeIpv4,
+		DirectSocketDnsQueryTypeIpv6,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DirectSocketDnsQueryType) Valid() bool {
+	switch v {
+	case DirectSocketDnsQueryTypeIpv4, DirectSocketDnsQueryTypeIpv6:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketOptions struct {
+	/* TCP_NODELAY option */
+	NoDelay bool `json:"noDelay"`
+	/* Expected to be unsigned integer. */
+	KeepAliveDelay *float64 `json:"keepAliveDelay,omitempty"`
+	/* Expected to be unsigned integer. */
+	SendBufferSize *float64 `json:"sendBufferSize,omitempty"`
+	/* Expected to be unsigned integer. */
+	ReceiveBufferSize *float64                  `json:"receiveBufferSize,omitempty"`
+	DnsQueryType      *DirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+}
+
+// MarshalCDP writes DirectTCPSocketOptions as JSON
+func (v *DirectTCPSocketOptions) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("noDelay")
+	e.Bool(v.NoDelay)
+	if v.KeepAliveDelay != nil {
+		e.Key("keepAliveDelay")
+		e.Float(*v.KeepAliveDelay)
+	}
+	if v.SendBufferSize != nil {
+		e.Key("sendBufferSize")
+		e.Float(*v.SendBufferSize)
+	}
+	if v.ReceiveBufferSize != nil {
+		e.Key("receiveBufferSize")
+		e.Float(*v.ReceiveBufferSize)
+	}
+	if v.DnsQueryType != nil {
+		e.Key("dnsQueryType")
+		e.String(string(*v.DnsQueryType))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DirectTCPSocketOptions) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DirectTCPSocketOptions from JSON
+func (v *DirectTCPSocketOptions) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "noDelay":
+			v.NoDelay = d.Bool()
+		case "keepAliveDelay":
+			if d.Null() {
+				v.KeepAliveDelay = nil
+			} else {
+				v.KeepAliveDelay = new(float64)
+				*v.KeepAliveDelay = d.Float()
+			}
+		case "sendBufferSize":
+			if d.Null() {
+				v.SendBufferSize = nil
+			} else {
+				v.SendBufferSize = new(float64)
+				*v.SendBufferSize = d.Float()
+			}
+		case "receiveBufferSize":
+			if d.Null() {
+				v.ReceiveBufferSize = nil
+			} else {
+				v.ReceiveBufferSize = new(float64)
+				*v.ReceiveBufferSize = d.Float()
+			}
+		case "dnsQueryType":
+			if d.Null() {
+				v.DnsQueryType = nil
+			} else {
+				v.DnsQueryType = new(DirectSocketDnsQueryType)
+				*v.DnsQueryType = DirectSocketDnsQueryType(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketOptions) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketOptions struct {
+	RemoteAddr *string `json:"remoteAddr,omitempty"`
+	/* Unsigned int 16. */
+	RemotePort *int    `json:"remotePort,omitempty"`
+	LocalAddr  *string `json:"localAddr,omitempty"`
+	/* Unsigned int 16. */
+	LocalPort    *int                      `json:"localPort,omitempty"`
+	DnsQueryType *DirectSocketDnsQueryType `json:"dnsQueryType,omitempty"`
+	/* Expected to be unsigned integer. */
+	SendBufferSize *float64 `json:"sendBufferSize,omitempty"`
+	/* Expected to be unsigned integer. */
+	ReceiveBufferSize *float64 `json:"receiveBufferSize,omitempty"`
+}
+
+// MarshalCDP writes DirectUDPSocketOptions as JSON
+func (v *DirectUDPSocketOptions) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.RemoteAddr != nil {
+		e.Key("remoteAddr")
+		e.String(*v.RemoteAddr)
+	}
+	if v.RemotePort != nil {
+		e.Key("remotePort")
+		e.Int(*v.RemotePort)
+	}
+	if v.LocalAddr != nil {
+		e.Key("localAddr")
+		e.String(*v.LocalAddr)
+	}
+	if v.LocalPort != nil {
+		e.Key("localPort")
+		e.Int(*v.LocalPort)
+	}
+	if v.DnsQueryType != nil {
+		e.Key("dnsQueryType")
+		e.String(string(*v.DnsQueryType))
+	}
+	if v.SendBufferSize != nil {
+		e.Key("sendBufferSize")
+		e.Float(*v.SendBufferSize)
+	}
+	if v.ReceiveBufferSize != nil {
+		e.Key("receiveBufferSize")
+		e.Float(*v.ReceiveBufferSize)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DirectUDPSocketOptions) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DirectUDPSocketOptions from JSON
+func (v *DirectUDPSocketOptions) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "remoteAddr":
+			if d.Null() {
+				v.RemoteAddr = nil
+			} else {
+				v.RemoteAddr = new(string)
+				*v.RemoteAddr = d.String()
+			}
+		case "remotePort":
+			if d.Null() {
+				v.RemotePort = nil
+			} else {
+				v.RemotePort = new(int)
+				*v.RemotePort = d.Int()
+			}
+		case "localAddr":
+			if d.Null() {
+				v.LocalAddr = nil
+			} else {
+				v.LocalAddr = new(string)
+				*v.LocalAddr = d.String()
+			}
+		case "localPort":
+			if d.Null() {
+				v.LocalPort = nil
+			} else {
+				v.LocalPort = new(int)
+				*v.LocalPort = d.Int()
+			}
+		case "dnsQueryType":
+			if d.Null() {
+				v.DnsQueryType = nil
+			} else {
+				v.DnsQueryType = new(DirectSocketDnsQueryType)
+				*v.DnsQueryType = DirectSocketDnsQueryType(d.String())
+			}
+		case "sendBufferSize":
+			if d.Null() {
+				v.SendBufferSize = nil
+			} else {
+				v.SendBufferSize = new(float64)
+				*v.SendBufferSize = d.Float()
+			}
+		case "receiveBufferSize":
+			if d.Null() {
+				v.ReceiveBufferSize = nil
+			} else {
+				v.ReceiveBufferSize = new(float64)
+				*v.ReceiveBufferSize = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketOptions) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPMessage struct {
+	Data string `json:"data"`
+	/* Null for connected mode. */
+	RemoteAddr *string `json:"remoteAddr,omitempty"`
+	/* Null for connected mode.
+	Expected to be unsigned integer. */
+	RemotePort *int `json:"remotePort,omitempty"`
+}
+
+// MarshalCDP writes DirectUDPMessage as JSON
+func (v *DirectUDPMessage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("data")
+	e.String(v.Data)
+	if v.RemoteAddr != nil {
+		e.Key("remoteAddr")
+		e.String(*v.RemoteAddr)
+	}
+	if v.RemotePort != nil {
+		e.Key("remotePort")
+		e.Int(*v.RemotePort)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DirectUDPMessage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads DirectUDPMessage from JSON
+func (v *DirectUDPMessage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = d.String()
+		case "remoteAddr":
+			if d.Null() {
+				v.RemoteAddr = nil
+			} else {
+				v.RemoteAddr = new(string)
+				*v.RemoteAddr = d.String()
+			}
+		case "remotePort":
+			if d.Null() {
+				v.RemotePort = nil
+			} else {
+				v.RemotePort = new(int)
+				*v.RemotePort = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPMessage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ConnectTiming struct {
+	/* Timing's requestTime is a baseline in seconds, while the other numbers are ticks in
+	milliseconds relatively to this requestTime. Matches ResourceTiming's requestTime for
+	the same request (but not for redirected requests). */
+	RequestTime float64 `json:"requestTime"`
+}
+
+// MarshalCDP writes ConnectTiming as JSON
+func (v *ConnectTiming) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestTime")
+	e.Float(v.RequestTime)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ConnectTiming) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ConnectTiming from JSON
+func (v *ConnectTiming) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestTime":
+			v.RequestTime = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ConnectTiming) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type CrossOriginOpenerPolicyValue string
+
+// CrossOriginOpenerPolicyValue values
+const (
+	CrossOriginOpenerPolicyValueSameOrigin                 CrossOriginOpenerPolicyValue = "SameOrigin"
+	CrossOriginOpenerPolicyValueSameOriginAllowPopups      CrossOriginOpenerPolicyValue = "SameOriginAllowPopups"
+	CrossOriginOpenerPolicyValueRestrictProperties         CrossOriginOpenerPolicyValue = "RestrictProperties"
+	CrossOriginOpenerPolicyValueUnsafeNone                 CrossOriginOpenerPolicyValue = "UnsafeNone"
+	CrossOriginOpenerPolicyValueSameOriginPlusCoep         CrossOriginOpenerPolicyValue = "SameOriginPlusCoep"
+	CrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep CrossOriginOpenerPolicyValue = "RestrictPropertiesPlusCoep"
+	CrossOriginOpenerPolicyValueNoopenerAllowPopups        CrossOriginOpenerPolicyValue = "NoopenerAllowPopups"
+)
+
+// Values gives every CrossOriginOpenerPolicyValue
+func (CrossOriginOpenerPolicyValue) Values() []CrossOriginOpenerPolicyValue {
+	return []CrossOriginOpenerPolicyValue{
+		CrossOriginOpenerPolicyValueSameOrigin,
+		CrossOriginOpenerPolicyValueSameOriginAllowPopups,
+		CrossOriginOpenerPolicyValueRestrictProperties,
+		CrossOriginOpenerPolicyValueUnsafeNone,
+		CrossOriginOpenerPolicyValueSameOriginPlusCoep,
+		CrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep,
+		CrossOriginOpenerPolicyValueNoopenerAllowPopups,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CrossOriginOpenerPolicyValue) Valid() bool {
+	switch v {
+	case CrossOriginOpenerPolicyValueSameOrigin, CrossOriginOpenerPolicyValueSameOriginAllowPopups, CrossOriginOpenerPolicyValueRestrictProperties, CrossOriginOpenerPolicyValueUnsafeNone, CrossOriginOpenerPolicyValueSameOriginPlusCoep, CrossOriginOpenerPolicyValueRestrictPropertiesPlusCoep, CrossOriginOpenerPolicyValueNoopenerAllowPopups:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type CrossOriginOpenerPolicyStatus struct {
+	Value                       CrossOriginOpenerPolicyValue `json:"value"`
+	ReportOnlyValue             CrossOriginOpenerPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           *string                      `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint *string                      `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// MarshalCDP writes CrossOriginOpenerPolicyStatus as JSON
+func (v *CrossOriginOpenerPolicyStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("value")
+	e.String(string(v.Value))
+	e.Key("reportOnlyValue")
+	e.String(string(v.ReportOnlyValue))
+	if v.ReportingEndpoint != nil {
+		e.Key("reportingEndpoint")
+		e.String(*v.ReportingEndpoint)
+	}
+	if v.ReportOnlyReportingEndpoint != nil {
+		e.Key("reportOnlyReportingEndpoint")
+		e.String(*v.ReportOnlyReportingEndpoint)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CrossOriginOpenerPolicyStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CrossOriginOpenerPolicyStatus from JSON
+func (v *CrossOriginOpenerPolicyStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			v.Value = CrossOriginOpenerPolicyValue(d.String())
+		case "reportOnlyValue":
+			v.ReportOnlyValue = CrossOriginOpenerPolicyValue(d.String())
+		case "reportingEndpoint":
+			if d.Null() {
+				v.ReportingEndpoint = nil
+			} else {
+				v.ReportingEndpoint = new(string)
+				*v.ReportingEndpoint = d.String()
+			}
+		case "reportOnlyReportingEndpoint":
+			if d.Null() {
+				v.ReportOnlyReportingEndpoint = nil
+			} else {
+				v.ReportOnlyReportingEndpoint = new(string)
+				*v.ReportOnlyReportingEndpoint = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CrossOriginOpenerPolicyStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type CrossOriginEmbedderPolicyValue string
+
+// CrossOriginEmbedderPolicyValue values
+const (
+	CrossOriginEmbedderPolicyValueNone           CrossOriginEmbedderPolicyValue = "None"
+	CrossOriginEmbedderPolicyValueCredentialless CrossOriginEmbedderPolicyValue = "Credentialless"
+	CrossOriginEmbedderPolicyValueRequireCorp    CrossOriginEmbedderPolicyValue = "RequireCorp"
+)
+
+// Values gives every CrossOriginEmbedderPolicyValue
+func (CrossOriginEmbedderPolicyValue) Values() []CrossOriginEmbedderPolicyValue {
+	return []CrossOriginEmbedderPolicyValue{
+		CrossOriginEmbedderPolicyValueNone,
+		CrossOriginEmbedderPolicyValueCredentialless,
+		CrossOriginEmbedderPolicyValueRequireCorp,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CrossOriginEmbedderPolicyValue) Valid() bool {
+	switch v {
+	case CrossOriginEmbedderPolicyValueNone, CrossOriginEmbedderPolicyValueCredentialless, CrossOriginEmbedderPolicyValueRequireCorp:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type CrossOriginEmbedderPolicyStatus struct {
+	Value                       CrossOriginEmbedderPolicyValue `json:"value"`
+	ReportOnlyValue             CrossOriginEmbedderPolicyValue `json:"reportOnlyValue"`
+	ReportingEndpoint           *string                        `json:"reportingEndpoint,omitempty"`
+	ReportOnlyReportingEndpoint *string                        `json:"reportOnlyReportingEndpoint,omitempty"`
+}
+
+// MarshalCDP writes CrossOriginEmbedderPolicyStatus as JSON
+func (v *CrossOriginEmbedderPolicyStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("value")
+	e.String(string(v.Value))
+	e.Key("reportOnlyValue")
+	e.String(string(v.ReportOnlyValue))
+	if v.ReportingEndpoint != nil {
+		e.Key("reportingEndpoint")
+		e.String(*v.ReportingEndpoint)
+	}
+	if v.ReportOnlyReportingEndpoint != nil {
+		e.Key("reportOnlyReportingEndpoint")
+		e.String(*v.ReportOnlyReportingEndpoint)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CrossOriginEmbedderPolicyStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CrossOriginEmbedderPolicyStatus from JSON
+func (v *CrossOriginEmbedderPolicyStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			v.Value = CrossOriginEmbedderPolicyValue(d.String())
+		case "reportOnlyValue":
+			v.ReportOnlyValue = CrossOriginEmbedderPolicyValue(d.String())
+		case "reportingEndpoint":
+			if d.Null() {
+				v.ReportingEndpoint = nil
+			} else {
+				v.ReportingEndpoint = new(string)
+				*v.ReportingEndpoint = d.String()
+			}
+		case "reportOnlyReportingEndpoint":
+			if d.Null() {
+				v.ReportOnlyReportingEndpoint = nil
+			} else {
+				v.ReportOnlyReportingEndpoint = new(string)
+				*v.ReportOnlyReportingEndpoint = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CrossOriginEmbedderPolicyStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ContentSecurityPolicySource string
+
+// ContentSecurityPolicySource values
+const (
+	ContentSecurityPolicySourceHTTP ContentSecurityPolicySource = "HTTP"
+	ContentSecurityPolicySourceMeta ContentSecurityPolicySource = "Meta"
+)
+
+// Values gives every ContentSecurityPolicySource
+func (ContentSecurityPolicySource) Values() []ContentSecurityPolicySource {
+	return []ContentSecurityPolicySource{
+		ContentSecurityPolicySourceHTTP,
+		ContentSecurityPolicySourceMeta,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContentSecurityPolicySource) Valid() bool {
+	switch v {
+	case ContentSecurityPolicySourceHTTP, ContentSecurityPolicySourceMeta:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ContentSecurityPolicyStatus struct {
+	EffectiveDirectives string                      `json:"effectiveDirectives"`
+	IsEnforced          bool                        `json:"isEnforced"`
+	Source              ContentSecurityPolicySource `json:"source"`
+}
+
+// MarshalCDP writes ContentSecurityPolicyStatus as JSON
+func (v *ContentSecurityPolicyStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("effectiveDirectives")
+	e.String(v.EffectiveDirectives)
+	e.Key("isEnforced")
+	e.Bool(v.IsEnforced)
+	e.Key("source")
+	e.String(string(v.Source))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContentSecurityPolicyStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ContentSecurityPolicyStatus from JSON
+func (v *ContentSecurityPolicyStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "effectiveDirectives":
+			v.EffectiveDirectives = d.String()
+		case "isEnforced":
+			v.IsEnforced = d.Bool()
+		case "source":
+			v.Source = ContentSecurityPolicySource(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContentSecurityPolicyStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SecurityIsolationStatus struct {
+	Coop *CrossOriginOpenerPolicyStatus   `json:"coop,omitempty"`
+	Coep *CrossOriginEmbedderPolicyStatus `json:"coep,omitempty"`
+	Csp  []ContentSecurityPolicyStatus    `json:"csp"`
+}
+
+// MarshalCDP writes SecurityIsolationStatus as JSON
+func (v *SecurityIsolationStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Coop != nil {
+		e.Key("coop")
+		(*v.Coop).MarshalCDP(e)
+	}
+	if v.Coep != nil {
+		e.Key("coep")
+		(*v.Coep).MarshalCDP(e)
+	}
+	if v.Csp != nil {
+		e.Key("csp")
+		e.ArrayStart()
+		for i0 := range v.Csp {
+			e.Elem()
+			v.Csp[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SecurityIsolationStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SecurityIsolationStatus from JSON
+func (v *SecurityIsolationStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "coop":
+			if d.Null() {
+				v.Coop = nil
+			} else {
+				v.Coop = new(CrossOriginOpenerPolicyStatus)
+				(*v.Coop).UnmarshalCDP(d)
+			}
+		case "coep":
+			if d.Null() {
+				v.Coep = nil
+			} else {
+				v.Coep = new(CrossOriginEmbedderPolicyStatus)
+				(*v.Coep).UnmarshalCDP(d)
+			}
+		case "csp":
+			if d.Array() {
+				v.Csp = make([]ContentSecurityPolicyStatus, 0)
+				for d.More() {
+					var x0 ContentSecurityPolicyStatus
+					x0.UnmarshalCDP(d)
+					v.Csp = append(v.Csp, x0)
+				}
+			} else {
+				v.Csp = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SecurityIsolationStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The status of a Reporting API report.
+//
+// Experimental: this may change or be removed in any chrome release
+type ReportStatus string
+
+// ReportStatus values
+const (
+	ReportStatusQueued           ReportStatus = "Queued"
+	ReportStatusPending          ReportStatus = "Pending"
+	ReportStatusMarkedForRemoval ReportStatus = "MarkedForRemoval"
+	ReportStatusSuccess          ReportStatus = "Success"
+)
+
+// Values gives every ReportStatus
+func (ReportStatus) Values() []ReportStatus {
+	return []ReportStatus{
+		ReportStatusQueued,
+		ReportStatusPending,
+		ReportStatusMarkedForRemoval,
+		ReportStatusSuccess,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ReportStatus) Valid() bool {
+	switch v {
+	case ReportStatusQueued, ReportStatusPending, ReportStatusMarkedForRemoval, ReportStatusSuccess:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ReportId string
+
+// An object representing a report generated by the Reporting API.
+//
+// Experimental: this may change or be removed in any chrome release
+type ReportingApiReport struct {
+	Id ReportId `json:"id"`
+	/* The URL of the document that triggered the report. */
+	InitiatorUrl string `json:"initiatorUrl"`
+	/* The name of the endpoint group that should be used to deliver the report. */
+	Destination string `json:"destination"`
+	/* The type of the report (specifies the set of data that is contained in the report body). */
+	Type string `json:"type"`
+	/* When the report was generated. */
+	Timestamp TimeSinceEpoch `json:"timestamp"`
+	/* How many uploads deep the related request was. */
+	Depth int `json:"depth"`
+	/* The number of delivery attempts made so far, not including an active attempt. */
+	CompletedAttempts int                    `json:"completedAttempts"`
+	Body              map[string]interface{} `json:"body"`
+	Status            ReportStatus           `json:"status"`
+}
+
+// MarshalCDP writes ReportingApiReport as JSON
+func (v *ReportingApiReport) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(string(v.Id))
+	e.Key("initiatorUrl")
+	e.String(v.InitiatorUrl)
+	e.Key("destination")
+	e.String(v.Destination)
+	e.Key("type")
+	e.String(v.Type)
+	e.Key("timestamp")
+	e.Float(float64(v.Timestamp))
+	e.Key("depth")
+	e.Int(v.Depth)
+	e.Key("completedAttempts")
+	e.Int(v.CompletedAttempts)
+	e.Key("body")
+	e.Map(v.Body)
+	e.Key("status")
+	e.String(string(v.Status))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReportingApiReport) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ReportingApiReport from JSON
+func (v *ReportingApiReport) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = ReportId(d.String())
+		case "initiatorUrl":
+			v.InitiatorUrl = d.String()
+		case "destination":
+			v.Destination = d.String()
+		case "type":
+			v.Type = d.String()
+		case "timestamp":
+			v.Timestamp = TimeSinceEpoch(d.Float())
+		case "depth":
+			v.Depth = d.Int()
+		case "completedAttempts":
+			v.CompletedAttempts = d.Int()
+		case "body":
+			v.Body = d.Map()
+		case "status":
+			v.Status = ReportStatus(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReportingApiReport) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ReportingApiEndpoint struct {
+	/* The URL of the endpoint to which reports may be delivered. */
+	Url string `json:"url"`
+	/* Name of the endpoint group. */
+	GroupName string `json:"groupName"`
+}
+
+// MarshalCDP writes ReportingApiEndpoint as JSON
+func (v *ReportingApiEndpoint) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("groupName")
+	e.String(v.GroupName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReportingApiEndpoint) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ReportingApiEndpoint from JSON
+func (v *ReportingApiEndpoint) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "groupName":
+			v.GroupName = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReportingApiEndpoint) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// An object providing the result of a network resource load.
+//
+// Experimental: this may change or be removed in any chrome release
+type LoadNetworkResourcePageResult struct {
+	Success bool `json:"success"`
+	/* Optional values used for error reporting. */
+	NetError       *float64 `json:"netError,omitempty"`
+	NetErrorName   *string  `json:"netErrorName,omitempty"`
+	HttpStatusCode *float64 `json:"httpStatusCode,omitempty"`
+	/* If successful, one of the following two fields holds the result. */
+	Stream *cdp.IOStreamHandle `json:"stream,omitempty"`
+	/* Response headers. */
+	Headers Headers `json:"headers"`
+}
+
+// MarshalCDP writes LoadNetworkResourcePageResult as JSON
+func (v *LoadNetworkResourcePageResult) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("success")
+	e.Bool(v.Success)
+	if v.NetError != nil {
+		e.Key("netError")
+		e.Float(*v.NetError)
+	}
+	if v.NetErrorName != nil {
+		e.Key("netErrorName")
+		e.String(*v.NetErrorName)
+	}
+	if v.HttpStatusCode != nil {
+		e.Key("httpStatusCode")
+		e.Float(*v.HttpStatusCode)
+	}
+	if v.Stream != nil {
+		e.Key("stream")
+		e.String(string(*v.Stream))
+	}
+	if v.Headers != nil {
+		e.Key("headers")
+		e.Map(map[string]interface{}(v.Headers))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LoadNetworkResourcePageResult) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LoadNetworkResourcePageResult from JSON
+func (v *LoadNetworkResourcePageResult) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "success":
+			v.Success = d.Bool()
+		case "netError":
+			if d.Null() {
+				v.NetError = nil
+			} else {
+				v.NetError = new(float64)
+				*v.NetError = d.Float()
+			}
+		case "netErrorName":
+			if d.Null() {
+				v.NetErrorName = nil
+			} else {
+				v.NetErrorName = new(string)
+				*v.NetErrorName = d.String()
+			}
+		case "httpStatusCode":
+			if d.Null() {
+				v.HttpStatusCode = nil
+			} else {
+				v.HttpStatusCode = new(float64)
+				*v.HttpStatusCode = d.Float()
+			}
+		case "stream":
+			if d.Null() {
+				v.Stream = nil
+			} else {
+				v.Stream = new(cdp.IOStreamHandle)
+				*v.Stream = cdp.IOStreamHandle(d.String())
+			}
+		case "headers":
+			v.Headers = Headers(d.Map())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadNetworkResourcePageResult) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// An options object that may be extended later to better support CORS,
+// CORB and streaming.
+//
+// Experimental: this may change or be removed in any chrome release
+type LoadNetworkResourceOptions struct {
+	DisableCache       bool `json:"disableCache"`
+	IncludeCredentials bool `json:"includeCredentials"`
+}
+
+// MarshalCDP writes LoadNetworkResourceOptions as JSON
+func (v *LoadNetworkResourceOptions) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("disableCache")
+	e.Bool(v.DisableCache)
+	e.Key("includeCredentials")
+	e.Bool(v.IncludeCredentials)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LoadNetworkResourceOptions) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LoadNetworkResourceOptions from JSON
+func (v *LoadNetworkResourceOptions) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "disableCache":
+			v.DisableCache = d.Bool()
+		case "includeCredentials":
+			v.IncludeCredentials = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadNetworkResourceOptions) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TrustTokenOperationDoneStatus string
+
+// TrustTokenOperationDoneStatus values
+const (
+	TrustTokenOperationDoneStatusOk                 TrustTokenOperationDoneStatus = "Ok"
+	TrustTokenOperationDoneStatusInvalidArgument    TrustTokenOperationDoneStatus = "InvalidArgument"
+	TrustTokenOperationDoneStatusMissingIssuerKeys  TrustTokenOperationDoneStatus = "MissingIssuerKeys"
+	TrustTokenOperationDoneStatusFailedPrecondition TrustTokenOperationDoneStatus = "FailedPrecondition"
+	TrustTokenOperationDoneStatusResourceExhausted  TrustTokenOperationDoneStatus = "ResourceExhausted"
+	TrustTokenOperationDoneStatusAlreadyExists      TrustTokenOperationDoneStatus = "AlreadyExists"
+	TrustTokenOperationDoneStatusResourceLimited    TrustTokenOperationDoneStatus = "ResourceLimited"
+	TrustTokenOperationDoneStatusUnauthorized       TrustTokenOperationDoneStatus = "Unauthorized"
+	TrustTokenOperationDoneStatusBadResponse        TrustTokenOperationDoneStatus = "BadResponse"
+	TrustTokenOperationDoneStatusInternalError      TrustTokenOperationDoneStatus = "InternalError"
+	TrustTokenOperationDoneStatusUnknownError       TrustTokenOperationDoneStatus = "UnknownError"
+	TrustTokenOperationDoneStatusFulfilledLocally   TrustTokenOperationDoneStatus = "FulfilledLocally"
+	TrustTokenOperationDoneStatusSiteIssuerLimit    TrustTokenOperationDoneStatus = "SiteIssuerLimit"
+)
+
+// Values gives every TrustTokenOperationDoneStatus
+func (TrustTokenOperationDoneStatus) Values() []TrustTokenOperationDoneStatus {
+	return []TrustTokenOperationDoneStatus{
+		TrustTokenOperationDoneStatusOk,
+		TrustTokenOperationDoneStatusInvalidArgument,
+		TrustTokenOperationDoneStatusMissingIssuerKeys,
+		TrustTokenOperationDoneStatusFailedPrecondition,
+		TrustTokenOperationDoneStatusResourceExhausted,
+		TrustTokenOperationDoneStatusAlreadyExists,
+		TrustTokenOperationDoneStatusResourceLimited,
+		TrustTokenOperationDoneStatusUnauthorized,
+		TrustTokenOperationDoneStatusBadResponse,
+		TrustTokenOperationDoneStatusInternalError,
+		TrustTokenOperationDoneStatusUnknownError,
+		TrustTokenOperationDoneStatusFulfilledLocally,
+		TrustTokenOperationDoneStatusSiteIssuerLimit,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v TrustTokenOperationDoneStatus) Valid() bool {
+	switch v {
+	case TrustTokenOperationDoneStatusOk, TrustTokenOperationDoneStatusInvalidArgument, TrustTokenOperationDoneStatusMissingIssuerKeys, TrustTokenOperationDoneStatusFailedPrecondition, TrustTokenOperationDoneStatusResourceExhausted, TrustTokenOperationDoneStatusAlreadyExists, TrustTokenOperationDoneStatusResourceLimited, TrustTokenOperationDoneStatusUnauthorized, TrustTokenOperationDoneStatusBadResponse, TrustTokenOperationDoneStatusInternalError, TrustTokenOperationDoneStatusUnknownError, TrustTokenOperationDoneStatusFulfilledLocally, TrustTokenOperationDoneStatusSiteIssuerLimit:
+		return true
+	}
+	return false
+}
+
+type CanClearBrowserCacheReturns struct {
+
+	/* True if browser cache can be cleared. */
+	Result bool `json:"result"`
+}
+
+// UnmarshalCDP reads CanClearBrowserCacheReturns from JSON
+func (v *CanClearBrowserCacheReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CanClearBrowserCacheReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Tells whether clearing browser cache is supported.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanClearBrowserCache() (CanClearBrowserCacheReturns, error) {
+	return c.CanClearBrowserCacheContext(context.Background())
+}
+
+// CanClearBrowserCacheContext is CanClearBrowserCache with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanClearBrowserCacheContext(ctx context.Context) (CanClearBrowserCacheReturns, error) {
+	var returns_ CanClearBrowserCacheReturns
+
+	err_ := c.caller.Call(ctx, "Network.canClearBrowserCache", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CanClearBrowserCookiesReturns struct {
+
+	/* True if browser cookies can be cleared. */
+	Result bool `json:"result"`
+}
+
+// UnmarshalCDP reads CanClearBrowserCookiesReturns from JSON
+func (v *CanClearBrowserCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CanClearBrowserCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Tells whether clearing browser cookies is supported.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanClearBrowserCookies() (CanClearBrowserCookiesReturns, error) {
+	return c.CanClearBrowserCookiesContext(context.Background())
+}
+
+// CanClearBrowserCookiesContext is CanClearBrowserCookies with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanClearBrowserCookiesContext(ctx context.Context) (CanClearBrowserCookiesReturns, error) {
+	var returns_ CanClearBrowserCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Network.canClearBrowserCookies", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CanEmulateNetworkConditionsReturns struct {
+
+	/* True if emulation of network conditions is supported. */
+	Result bool `json:"result"`
+}
+
+// UnmarshalCDP reads CanEmulateNetworkConditionsReturns from JSON
+func (v *CanEmulateNetworkConditionsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CanEmulateNetworkConditionsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Tells whether emulation of network conditions is supported.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanEmulateNetworkConditions() (CanEmulateNetworkConditionsReturns, error) {
+	return c.CanEmulateNetworkConditionsContext(context.Background())
+}
+
+// CanEmulateNetworkConditionsContext is CanEmulateNetworkConditions with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) CanEmulateNetworkConditionsContext(ctx context.Context) (CanEmulateNetworkConditionsReturns, error) {
+	var returns_ CanEmulateNetworkConditionsReturns
+
+	err_ := c.caller.Call(ctx, "Network.canEmulateNetworkConditions", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ClearBrowserCacheReturns struct {
+}
+
+// UnmarshalCDP reads ClearBrowserCacheReturns from JSON
+func (v *ClearBrowserCacheReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearBrowserCacheReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears browser cache.
+func (c Client) ClearBrowserCache() (ClearBrowserCacheReturns, error) {
+	return c.ClearBrowserCacheContext(context.Background())
+}
+
+// ClearBrowserCacheContext is ClearBrowserCache with a context for cancellation and deadlines
+func (c Client) ClearBrowserCacheContext(ctx context.Context) (ClearBrowserCacheReturns, error) {
+	var returns_ ClearBrowserCacheReturns
+
+	err_ := c.caller.Call(ctx, "Network.clearBrowserCache", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ClearBrowserCookiesReturns struct {
+}
+
+// UnmarshalCDP reads ClearBrowserCookiesReturns from JSON
+func (v *ClearBrowserCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearBrowserCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears browser cookies.
+func (c Client) ClearBrowserCookies() (ClearBrowserCookiesReturns, error) {
+	return c.ClearBrowserCookiesContext(context.Background())
+}
+
+// ClearBrowserCookiesContext is ClearBrowserCookies with a context for cancellation and deadlines
+func (c Client) ClearBrowserCookiesContext(ctx context.Context) (ClearBrowserCookiesReturns, error) {
+	var returns_ ClearBrowserCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Network.clearBrowserCookies", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteCookiesReturns struct {
+}
+
+// UnmarshalCDP reads DeleteCookiesReturns from JSON
+func (v *DeleteCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteCookiesParams are the parameters for Network.deleteCookies
+// optional parameters are left out when nil
+type DeleteCookiesParams struct {
+	/* Name of the cookies to remove. */
+	Name string `json:"name"`
+	/* If specified, deletes all the cookies with the given name where domain and path match
+	provided URL. */
+	Url *string `json:"url,omitempty"`
+	/* If specified, deletes only cookies with the exact domain. */
+	Domain *string `json:"domain,omitempty"`
+	/* If specified, deletes only cookies with the exact path. */
+	Path *string `json:"path,omitempty"`
+	/* If specified, deletes only cookies with the the given name and partitionKey where
+	all partition key attributes match the cookie partition key attribute.
+
+	Experimental: this may change or be removed in any chrome release */
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// MarshalCDP writes DeleteCookiesParams as JSON
+func (v *DeleteCookiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Domain != nil {
+		e.Key("domain")
+		e.String(*v.Domain)
+	}
+	if v.Path != nil {
+		e.Key("path")
+		e.String(*v.Path)
+	}
+	if v.PartitionKey != nil {
+		e.Key("partitionKey")
+		(*v.PartitionKey).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteCookiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes browser cookies with matching name and url or domain/path/partitionKey pair.
+func (c Client) DeleteCookies(params DeleteCookiesParams) (DeleteCookiesReturns, error) {
+	return c.DeleteCookiesContext(context.Background(), params)
+}
+
+// DeleteCookiesContext is DeleteCookies with a context for cancellation and deadlines
+func (c Client) DeleteCookiesContext(ctx context.Context, params DeleteCookiesParams) (DeleteCookiesReturns, error) {
+	var returns_ DeleteCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Network.deleteCookies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables network tracking, prevents network events from being sent to the client.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Network.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EmulateNetworkConditionsReturns struct {
+}
+
+// UnmarshalCDP reads EmulateNetworkConditionsReturns from JSON
+func (v *EmulateNetworkConditionsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EmulateNetworkConditionsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EmulateNetworkConditionsParams are the parameters for Network.emulateNetworkConditions
+// optional parameters are left out when nil
+type EmulateNetworkConditionsParams struct {
+	/* True to emulate internet disconnection. */
+	Offline bool `json:"offline"`
+	/* Minimum latency from request sent to response headers received (ms). */
+	Latency float64 `json:"latency"`
+	/* Maximal aggregated download throughput (bytes/sec). -1 disables download throttling. */
+	DownloadThroughput float64 `json:"downloadThroughput"`
+	/* Maximal aggregated upload throughput (bytes/sec).  -1 disables upload throttling. */
+	UploadThroughput float64 `json:"uploadThroughput"`
+	/* Connection type if known. */
+	ConnectionType *ConnectionType `json:"connectionType,omitempty"`
+	/* WebRTC packet loss (percent, 0-100). 0 disables packet loss emulation, 100 drops all the packets.
+
+	Experimental: this may change or be removed in any chrome release */
+	PacketLoss *float64 `json:"packetLoss,omitempty"`
+	/* WebRTC packet queue length (packet). 0 removes any queue length limitations.
+
+	Experimental: this may change or be removed in any chrome release */
+	PacketQueueLength *int `json:"packetQueueLength,omitempty"`
+	/* WebRTC packetReordering feature.
+
+	Experimental: this may change or be removed in any chrome release */
+	PacketReordering *bool `json:"packetReordering,omitempty"`
+}
+
+// MarshalCDP writes EmulateNetworkConditionsParams as JSON
+func (v *EmulateNetworkConditionsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("offline")
+	e.Bool(v.Offline)
+	e.Key("latency")
+	e.Float(v.Latency)
+	e.Key("downloadThroughput")
+	e.Float(v.DownloadThroughput)
+	e.Key("uploadThroughput")
+	e.Float(v.UploadThroughput)
+	if v.ConnectionType != nil {
+		e.Key("connectionType")
+		e.String(string(*v.ConnectionType))
+	}
+	if v.PacketLoss != nil {
+		e.Key("packetLoss")
+		e.Float(*v.PacketLoss)
+	}
+	if v.PacketQueueLength != nil {
+		e.Key("packetQueueLength")
+		e.Int(*v.PacketQueueLength)
+	}
+	if v.PacketReordering != nil {
+		e.Key("packetReordering")
+		e.Bool(*v.PacketReordering)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EmulateNetworkConditionsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Activates emulation of network conditions.
+func (c Client) EmulateNetworkConditions(params EmulateNetworkConditionsParams) (EmulateNetworkConditionsReturns, error) {
+	return c.EmulateNetworkConditionsContext(context.Background(), params)
+}
+
+// EmulateNetworkConditionsContext is EmulateNetworkConditions with a context for cancellation and deadlines
+func (c Client) EmulateNetworkConditionsContext(ctx context.Context, params EmulateNetworkConditionsParams) (EmulateNetworkConditionsReturns, error) {
+	var returns_ EmulateNetworkConditionsReturns
+
+	if params.ConnectionType != nil && !params.ConnectionType.Valid() {
+		return returns_, fmt.Errorf("Network.emulateNetworkConditions: invalid connectionType %q", *params.ConnectionType)
+	}
+
+	err_ := c.caller.Call(ctx, "Network.emulateNetworkConditions", &params, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for Network.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* Buffer size in bytes to use when preserving network payloads (XHRs, etc).
+
+	Experimental: this may change or be removed in any chrome release */
+	MaxTotalBufferSize *int `json:"maxTotalBufferSize,omitempty"`
+	/* Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc).
+
+	Experimental: this may change or be removed in any chrome release */
+	MaxResourceBufferSize *int `json:"maxResourceBufferSize,omitempty"`
+	/* Longest post body size (in bytes) that would be included in requestWillBeSent notification */
+	MaxPostDataSize *int `json:"maxPostDataSize,omitempty"`
+	/* Whether DirectSocket chunk send/receive events should be reported.
+
+	Experimental: this may change or be removed in any chrome release */
+	ReportDirectSocketTraffic *bool `json:"reportDirectSocketTraffic,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.MaxTotalBufferSize != nil {
+		e.Key("maxTotalBufferSize")
+		e.Int(*v.MaxTotalBufferSize)
+	}
+	if v.MaxResourceBufferSize != nil {
+		e.Key("maxResourceBufferSize")
+		e.Int(*v.MaxResourceBufferSize)
+	}
+	if v.MaxPostDataSize != nil {
+		e.Key("maxPostDataSize")
+		e.Int(*v.MaxPostDataSize)
+	}
+	if v.ReportDirectSocketTraffic != nil {
+		e.Key("reportDirectSocketTraffic")
+		e.Bool(*v.ReportDirectSocketTraffic)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables network tracking, network events will now be delivered to the client.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Network.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetAllCookiesReturns struct {
+
+	/* Array of cookie objects. */
+	Cookies []Cookie `json:"cookies"`
+}
+
+// UnmarshalCDP reads GetAllCookiesReturns from JSON
+func (v *GetAllCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cookies":
+			if d.Array() {
+				v.Cookies = make([]Cookie, 0)
+				for d.More() {
+					var x0 Cookie
+					x0.UnmarshalCDP(d)
+					v.Cookies = append(v.Cookies, x0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAllCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns all browser cookies. Depending on the backend support, will return detailed cookie
+// information in the `cookies` field.
+// Deprecated. Use Storage.getCookies instead.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetAllCookies() (GetAllCookiesReturns, error) {
+	return c.GetAllCookiesContext(context.Background())
+}
+
+// GetAllCookiesContext is GetAllCookies with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetAllCookiesContext(ctx context.Context) (GetAllCookiesReturns, error) {
+	var returns_ GetAllCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Network.getAllCookies", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetCookiesReturns struct {
+
+	/* Array of cookie objects. */
+	Cookies []Cookie `json:"cookies"`
+}
+
+// UnmarshalCDP reads GetCookiesReturns from JSON
+func (v *GetCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cookies":
+			if d.Array() {
+				v.Cookies = make([]Cookie, 0)
+				for d.More() {
+					var x0 Cookie
+					x0.UnmarshalCDP(d)
+					v.Cookies = append(v.Cookies, x0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetCookiesParams are the parameters for Network.getCookies
+// optional parameters are left out when nil
+type GetCookiesParams struct {
+	/* The list of URLs for which applicable cookies will be fetched.
+	If not specified, it's assumed to be set to the list containing
+	the URLs of the page and all of its subframes. */
+	Urls []string `json:"urls"`
+}
+
+// MarshalCDP writes GetCookiesParams as JSON
+func (v *GetCookiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Urls != nil {
+		e.Key("urls")
+		e.ArrayStart()
+		for i0 := range v.Urls {
+			e.Elem()
+			e.String(v.Urls[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetCookiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns all browser cookies for the current URL. Depending on the backend support, will return
+// detailed cookie information in the `cookies` field.
+func (c Client) GetCookies(params GetCookiesParams) (GetCookiesReturns, error) {
+	return c.GetCookiesContext(context.Background(), params)
+}
+
+// GetCookiesContext is GetCookies with a context for cancellation and deadlines
+func (c Client) GetCookiesContext(ctx context.Context, params GetCookiesParams) (GetCookiesReturns, error) {
+	var returns_ GetCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Network.getCookies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetResponseBodyReturns struct {
+
+	/* Response body. */
+	Body string `json:"body"`
+
+	/* True, if content was sent as base64. */
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// UnmarshalCDP reads GetResponseBodyReturns from JSON
+func (v *GetResponseBodyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "body":
+			v.Body = d.String()
+		case "base64Encoded":
+			v.Base64Encoded = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetResponseBodyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetResponseBodyParams are the parameters for Network.getResponseBody
+// optional parameters are left out when nil
+type GetResponseBodyParams struct {
+	/* Identifier of the network request to get content for. */
+	RequestId RequestId `json:"requestId"`
+}
+
+// MarshalCDP writes GetResponseBodyParams as JSON
+func (v *GetResponseBodyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetResponseBodyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns content served for the given request.
+func (c Client) GetResponseBody(params GetResponseBodyParams) (GetResponseBodyReturns, error) {
+	return c.GetResponseBodyContext(context.Background(), params)
+}
+
+// GetResponseBodyContext is GetResponseBody with a context for cancellation and deadlines
+func (c Client) GetResponseBodyContext(ctx context.Context, params GetResponseBodyParams) (GetResponseBodyReturns, error) {
+	var returns_ GetResponseBodyReturns
+
+	err_ := c.caller.Call(ctx, "Network.getResponseBody", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetRequestPostDataReturns struct {
+
+	/* Request body string, omitting files from multipart requests */
+	PostData string `json:"postData"`
+}
+
+// UnmarshalCDP reads GetRequestPostDataReturns from JSON
+func (v *GetRequestPostDataReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "postData":
+			v.PostData = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetRequestPostDataReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetRequestPostDataParams are the parameters for Network.getRequestPostData
+// optional parameters are left out when nil
+type GetRequestPostDataParams struct {
+	/* Identifier of the network request to get content for. */
+	RequestId RequestId `json:"requestId"`
+}
+
+// MarshalCDP writes GetRequestPostDataParams as JSON
+func (v *GetRequestPostDataParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetRequestPostDataParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns post data sent with the request. Returns an error when no data was sent with the request.
+func (c Client) GetRequestPostData(params GetRequestPostDataParams) (GetRequestPostDataReturns, error) {
+	return c.GetRequestPostDataContext(context.Background(), params)
+}
+
+// GetRequestPostDataContext is GetRequestPostData with a context for cancellation and deadlines
+func (c Client) GetRequestPostDataContext(ctx context.Context, params GetRequestPostDataParams) (GetRequestPostDataReturns, error) {
+	var returns_ GetRequestPostDataReturns
+
+	err_ := c.caller.Call(ctx, "Network.getRequestPostData", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBypassServiceWorkerReturns struct {
+}
+
+// UnmarshalCDP reads SetBypassServiceWorkerReturns from JSON
+func (v *SetBypassServiceWorkerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBypassServiceWorkerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBypassServiceWorkerParams are the parameters for Network.setBypassServiceWorker
+// optional parameters are left out when nil
+type SetBypassServiceWorkerParams struct {
+	/* Bypass service worker and load from network. */
+	Bypass bool `json:"bypass"`
+}
+
+// MarshalCDP writes SetBypassServiceWorkerParams as JSON
+func (v *SetBypassServiceWorkerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("bypass")
+	e.Bool(v.Bypass)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBypassServiceWorkerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Toggles ignoring of service worker for each request.
+func (c Client) SetBypassServiceWorker(params SetBypassServiceWorkerParams) (SetBypassServiceWorkerReturns, error) {
+	return c.SetBypassServiceWorkerContext(context.Background(), params)
+}
+
+// SetBypassServiceWorkerContext is SetBypassServiceWorker with a context for cancellation and deadlines
+func (c Client) SetBypassServiceWorkerContext(ctx context.Context, params SetBypassServiceWorkerParams) (SetBypassServiceWorkerReturns, error) {
+	var returns_ SetBypassServiceWorkerReturns
+
+	err_ := c.caller.Call(ctx, "Network.setBypassServiceWorker", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetCacheDisabledReturns struct {
+}
+
+// UnmarshalCDP reads SetCacheDisabledReturns from JSON
+func (v *SetCacheDisabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCacheDisabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCacheDisabledParams are the parameters for Network.setCacheDisabled
+// optional parameters are left out when nil
+type SetCacheDisabledParams struct {
+	/* Cache disabled state. */
+	CacheDisabled bool `json:"cacheDisabled"`
+}
+
+// MarshalCDP writes SetCacheDisabledParams as JSON
+func (v *SetCacheDisabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cacheDisabled")
+	e.Bool(v.CacheDisabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCacheDisabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Toggles ignoring cache for each request. If `true`, cache will not be used.
+func (c Client) SetCacheDisabled(params SetCacheDisabledParams) (SetCacheDisabledReturns, error) {
+	return c.SetCacheDisabledContext(context.Background(), params)
+}
+
+// SetCacheDisabledContext is SetCacheDisabled with a context for cancellation and deadlines
+func (c Client) SetCacheDisabledContext(ctx context.Context, params SetCacheDisabledParams) (SetCacheDisabledReturns, error) {
+	var returns_ SetCacheDisabledReturns
+
+	err_ := c.caller.Call(ctx, "Network.setCacheDisabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetCookieReturns struct {
+
+	/* Always set to true. If an error occurs, the response indicates protocol error.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Success bool `json:"success"`
+}
+
+// UnmarshalCDP reads SetCookieReturns from JSON
+func (v *SetCookieReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "success":
+			v.Success = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCookieReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCookieParams are the parameters for Network.setCookie
+// optional parameters are left out when nil
+type SetCookieParams struct {
+	/* Cookie name. */
+	Name string `json:"name"`
+	/* Cookie value. */
+	Value string `json:"value"`
+	/* The request-URI to associate with the setting of the cookie. This value can affect the
+	default domain, path, source port, and source scheme values of the created cookie. */
+	Url *string `json:"url,omitempty"`
+	/* Cookie domain. */
+	Domain *string `json:"domain,omitempty"`
+	/* Cookie path. */
+	Path *string `json:"path,omitempty"`
+	/* True if cookie is secure. */
+	Secure *bool `json:"secure,omitempty"`
+	/* True if cookie is http-only. */
+	HttpOnly *bool `json:"httpOnly,omitempty"`
+	/* Cookie SameSite type. */
+	SameSite *CookieSameSite `json:"sameSite,omitempty"`
+	/* Cookie expiration date, session cookie if not set */
+	Expires *TimeSinceEpoch `json:"expires,omitempty"`
+	/* Cookie Priority type.
+
+	Experimental: this may change or be removed in any chrome release */
+	Priority *CookiePriority `json:"priority,omitempty"`
+	/* True if cookie is SameParty.
+
+	Experimental: this may change or be removed in any chrome release */
+	SameParty *bool `json:"sameParty,omitempty"`
+	/* Cookie source scheme type.
+
+	Experimental: this may change or be removed in any chrome release */
+	SourceScheme *CookieSourceScheme `json:"sourceScheme,omitempty"`
+	/* Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	This is a temporary ability and it will be removed in the future.
+
+	Experimental: this may change or be removed in any chrome release */
+	SourcePort *int `json:"sourcePort,omitempty"`
+	/* Cookie partition key. If not set, the cookie will be set as not partitioned.
+
+	Experimental: this may change or be removed in any chrome release */
+	PartitionKey *CookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// MarshalCDP writes SetCookieParams as JSON
+func (v *SetCookieParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Domain != nil {
+		e.Key("domain")
+		e.String(*v.Domain)
+	}
+	if v.Path != nil {
+		e.Key("path")
+		e.String(*v.Path)
+	}
+	if v.Secure != nil {
+		e.Key("secure")
+		e.Bool(*v.Secure)
+	}
+	if v.HttpOnly != nil {
+		e.Key("httpOnly")
+		e.Bool(*v.HttpOnly)
+	}
+	if v.SameSite != nil {
+		e.Key("sameSite")
+		e.String(string(*v.SameSite))
+	}
+	if v.Expires != nil {
+		e.Key("expires")
+		e.Float(float64(*v.Expires))
+	}
+	if v.Priority != nil {
+		e.Key("priority")
+		e.String(string(*v.Priority))
+	}
+	if v.SameParty != nil {
+		e.Key("sameParty")
+		e.Bool(*v.SameParty)
+	}
+	if v.SourceScheme != nil {
+		e.Key("sourceScheme")
+		e.String(string(*v.SourceScheme))
+	}
+	if v.SourcePort != nil {
+		e.Key("sourcePort")
+		e.Int(*v.SourcePort)
+	}
+	if v.PartitionKey != nil {
+		e.Key("partitionKey")
+		(*v.PartitionKey).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCookieParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist.
+func (c Client) SetCookie(params SetCookieParams) (SetCookieReturns, error) {
+	return c.SetCookieContext(context.Background(), params)
+}
+
+// SetCookieContext is SetCookie with a context for cancellation and deadlines
+func (c Client) SetCookieContext(ctx context.Context, params SetCookieParams) (SetCookieReturns, error) {
+	var returns_ SetCookieReturns
+
+	if params.SameSite != nil && !params.SameSite.Valid() {
+		return returns_, fmt.Errorf("Network.setCookie: invalid sameSite %q", *params.SameSite)
+	}
+
+	if params.Priority != nil && !params.Priority.Valid() {
+		return returns_, fmt.Errorf("Network.setCookie: invalid priority %q", *params.Priority)
+	}
+
+	if params.SourceScheme != nil && !params.SourceScheme.Valid() {
+		return returns_, fmt.Errorf("Network.setCookie: invalid sourceScheme %q", *params.SourceScheme)
+	}
+
+	err_ := c.caller.Call(ctx, "Network.setCookie", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetCookiesReturns struct {
+}
+
+// UnmarshalCDP reads SetCookiesReturns from JSON
+func (v *SetCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCookiesParams are the parameters for Network.setCookies
+// optional parameters are left out when nil
+type SetCookiesParams struct {
+	/* Cookies to be set. */
+	Cookies []CookieParam `json:"cookies"`
+}
+
+// MarshalCDP writes SetCookiesParams as JSON
+func (v *SetCookiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cookies")
+	if v.Cookies == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Cookies {
+			e.Elem()
+			v.Cookies[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCookiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets given cookies.
+func (c Client) SetCookies(params SetCookiesParams) (SetCookiesReturns, error) {
+	return c.SetCookiesContext(context.Background(), params)
+}
+
+// SetCookiesContext is SetCookies with a context for cancellation and deadlines
+func (c Client) SetCookiesContext(ctx context.Context, params SetCookiesParams) (SetCookiesReturns, error) {
+	var returns_ SetCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Network.setCookies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetExtraHTTPHeadersReturns struct {
+}
+
+// UnmarshalCDP reads SetExtraHTTPHeadersReturns from JSON
+func (v *SetExtraHTTPHeadersReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetExtraHTTPHeadersReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetExtraHTTPHeadersParams are the parameters for Network.setExtraHTTPHeaders
+// optional parameters are left out when nil
+type SetExtraHTTPHeadersParams struct {
+	/* Map with extra HTTP headers. */
+	Headers Headers `json:"headers"`
+}
+
+// MarshalCDP writes SetExtraHTTPHeadersParams as JSON
+func (v *SetExtraHTTPHeadersParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("headers")
+	e.Map(map[string]interface{}(v.Headers))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetExtraHTTPHeadersParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Specifies whether to always send extra HTTP headers with the requests from this page.
+func (c Client) SetExtraHTTPHeaders(params SetExtraHTTPHeadersParams) (SetExtraHTTPHeadersReturns, error) {
+	return c.SetExtraHTTPHeadersContext(context.Background(), params)
+}
+
+// SetExtraHTTPHeadersContext is SetExtraHTTPHeaders with a context for cancellation and deadlines
+func (c Client) SetExtraHTTPHeadersContext(ctx context.Context, params SetExtraHTTPHeadersParams) (SetExtraHTTPHeadersReturns, error) {
+	var returns_ SetExtraHTTPHeadersReturns
+
+	err_ := c.caller.Call(ctx, "Network.setExtraHTTPHeaders", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetUserAgentOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetUserAgentOverrideReturns from JSON
+func (v *SetUserAgentOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetUserAgentOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetUserAgentOverrideParams are the parameters for Network.setUserAgentOverride
+// optional parameters are left out when nil
+type SetUserAgentOverrideParams struct {
+	/* User agent to use. */
+	UserAgent string `json:"userAgent"`
+	/* Browser language to emulate. */
+	AcceptLanguage *string `json:"acceptLanguage,omitempty"`
+	/* The platform navigator.platform should return. */
+	Platform *string `json:"platform,omitempty"`
+	/* To be sent in Sec-CH-UA-* headers and returned in navigator.userAgentData
+
+	Experimental: this may change or be removed in any chrome release */
+	UserAgentMetadata *cdp.EmulationUserAgentMetadata `json:"userAgentMetadata,omitempty"`
+}
+
+// MarshalCDP writes SetUserAgentOverrideParams as JSON
+func (v *SetUserAgentOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("userAgent")
+	e.String(v.UserAgent)
+	if v.AcceptLanguage != nil {
+		e.Key("acceptLanguage")
+		e.String(*v.AcceptLanguage)
+	}
+	if v.Platform != nil {
+		e.Key("platform")
+		e.String(*v.Platform)
+	}
+	if v.UserAgentMetadata != nil {
+		e.Key("userAgentMetadata")
+		(*v.UserAgentMetadata).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetUserAgentOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Allows overriding user agent with the given string.
+func (c Client) SetUserAgentOverride(params SetUserAgentOverrideParams) (SetUserAgentOverrideReturns, error) {
+	return c.SetUserAgentOverrideContext(context.Background(), params)
+}
+
+// SetUserAgentOverrideContext is SetUserAgentOverride with a context for cancellation and deadlines
+func (c Client) SetUserAgentOverrideContext(ctx context.Context, params SetUserAgentOverrideParams) (SetUserAgentOverrideReturns, error) {
+	var returns_ SetUserAgentOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Network.setUserAgentOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fired when data chunk was received over the network.
+type DataReceivedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Data chunk length. */
+	DataLength int `json:"dataLength"`
+
+	/* Actual bytes received (might be less than dataLength for compressed encodings). */
+	EncodedDataLength int `json:"encodedDataLength"`
+
+	/* Data that was received. (Encoded as a base64 string when passed over JSON)
+
+	Experimental: this may change or be removed in any chrome release */
+	Data *string `json:"data,omitempty"`
+}
+
+// UnmarshalCDP reads DataReceivedEvent from JSON
+func (v *DataReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "dataLength":
+			v.DataLength = d.Int()
+		case "encodedDataLength":
+			v.EncodedDataLength = d.Int()
+		case "data":
+			if d.Null() {
+				v.Data = nil
+			} else {
+				v.Data = new(string)
+				*v.Data = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DataReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DataReceivedHandler func(ev DataReceivedEvent)
+
+// EventMethod is Network.dataReceived
+func (DataReceivedEvent) EventMethod() string {
+	return "Network.dataReceived"
+}
+
+// OnDataReceived calls handler for each Network.dataReceived event
+func (c Client) OnDataReceived(handler DataReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.dataReceived", func(ev interface{}) {
+		handler(ev.(DataReceivedEvent))
+	})
+}
+
+// Fired when EventSource message is received.
+type EventSourceMessageReceivedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Message type. */
+	EventName string `json:"eventName"`
+
+	/* Message identifier. */
+	EventId string `json:"eventId"`
+
+	/* Message content. */
+	Data string `json:"data"`
+}
+
+// UnmarshalCDP reads EventSourceMessageReceivedEvent from JSON
+func (v *EventSourceMessageReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "eventName":
+			v.EventName = d.String()
+		case "eventId":
+			v.EventId = d.String()
+		case "data":
+			v.Data = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EventSourceMessageReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EventSourceMessageReceivedHandler func(ev EventSourceMessageReceivedEvent)
+
+// EventMethod is Network.eventSourceMessageReceived
+func (EventSourceMessageReceivedEvent) EventMethod() string {
+	return "Network.eventSourceMessageReceived"
+}
+
+// OnEventSourceMessageReceived calls handler for each Network.eventSourceMessageReceived event
+func (c Client) OnEventSourceMessageReceived(handler EventSourceMessageReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.eventSourceMessageReceived", func(ev interface{}) {
+		handler(ev.(EventSourceMessageReceivedEvent))
+	})
+}
+
+// Fired when HTTP request has failed to load.
+type LoadingFailedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Resource type. */
+	Type ResourceType `json:"type"`
+
+	/* Error message. List of network errors: https://cs.chromium.org/chromium/src/net/base/net_error_list.h */
+	ErrorText string `json:"errorText"`
+
+	/* True if loading was canceled. */
+	Canceled *bool `json:"canceled,omitempty"`
+
+	/* The reason why loading was blocked, if any. */
+	BlockedReason *BlockedReason `json:"blockedReason,omitempty"`
+
+	/* The reason why loading was blocked by CORS, if any. */
+	CorsErrorStatus *CorsErrorStatus `json:"corsErrorStatus,omitempty"`
+}
+
+// UnmarshalCDP reads LoadingFailedEvent from JSON
+func (v *LoadingFailedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "type":
+			v.Type = ResourceType(d.String())
+		case "errorText":
+			v.ErrorText = d.String()
+		case "canceled":
+			if d.Null() {
+				v.Canceled = nil
+			} else {
+				v.Canceled = new(bool)
+				*v.Canceled = d.Bool()
+			}
+		case "blockedReason":
+			if d.Null() {
+				v.BlockedReason = nil
+			} else {
+				v.BlockedReason = new(BlockedReason)
+				*v.BlockedReason = BlockedReason(d.String())
+			}
+		case "corsErrorStatus":
+			if d.Null() {
+				v.CorsErrorStatus = nil
+			} else {
+				v.CorsErrorStatus = new(CorsErrorStatus)
+				(*v.CorsErrorStatus).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadingFailedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LoadingFailedHandler func(ev LoadingFailedEvent)
+
+// EventMethod is Network.loadingFailed
+func (LoadingFailedEvent) EventMethod() string {
+	return "Network.loadingFailed"
+}
+
+// OnLoadingFailed calls handler for each Network.loadingFailed event
+func (c Client) OnLoadingFailed(handler LoadingFailedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.loadingFailed", func(ev interface{}) {
+		handler(ev.(LoadingFailedEvent))
+	})
+}
+
+// Fired when HTTP request has finished loading.
+type LoadingFinishedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Total number of bytes received for this request. */
+	EncodedDataLength float64 `json:"encodedDataLength"`
+}
+
+// UnmarshalCDP reads LoadingFinishedEvent from JSON
+func (v *LoadingFinishedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "encodedDataLength":
+			v.EncodedDataLength = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadingFinishedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LoadingFinishedHandler func(ev LoadingFinishedEvent)
+
+// EventMethod is Network.loadingFinished
+func (LoadingFinishedEvent) EventMethod() string {
+	return "Network.loadingFinished"
+}
+
+// OnLoadingFinished calls handler for each Network.loadingFinished event
+func (c Client) OnLoadingFinished(handler LoadingFinishedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.loadingFinished", func(ev interface{}) {
+		handler(ev.(LoadingFinishedEvent))
+	})
+}
+
+// Fired if request ended up loading from cache.
+type RequestServedFromCacheEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+}
+
+// UnmarshalCDP reads RequestServedFromCacheEvent from JSON
+func (v *RequestServedFromCacheEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestServedFromCacheEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RequestServedFromCacheHandler func(ev RequestServedFromCacheEvent)
+
+// EventMethod is Network.requestServedFromCache
+func (RequestServedFromCacheEvent) EventMethod() string {
+	return "Network.requestServedFromCache"
+}
+
+// OnRequestServedFromCache calls handler for each Network.requestServedFromCache event
+func (c Client) OnRequestServedFromCache(handler RequestServedFromCacheHandler) (unsubscribe func()) {
+	return c.caller.On("Network.requestServedFromCache", func(ev interface{}) {
+		handler(ev.(RequestServedFromCacheEvent))
+	})
+}
+
+// Fired when page is about to send HTTP request.
+type RequestWillBeSentEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Loader identifier. Empty string if the request is fetched from worker. */
+	LoaderId LoaderId `json:"loaderId"`
+
+	/* URL of the document this request is loaded for. */
+	DocumentURL string `json:"documentURL"`
+
+	/* Request data. */
+	Request Request `json:"request"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Timestamp. */
+	WallTime TimeSinceEpoch `json:"wallTime"`
+
+	/* Request initiator. */
+	Initiator Initiator `json:"initiator"`
+
+	/* In the case that redirectResponse is populated, this flag indicates whether
+	requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be or were emitted
+	for the request which was just redirected.
+
+	Experimental: this may change or be removed in any chrome release */
+	RedirectHasExtraInfo bool `json:"redirectHasExtraInfo"`
+
+	/* Redirect response data. */
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
+
+	/* Type of this resource. */
+	Type *ResourceType `json:"type,omitempty"`
+
+	/* Frame identifier. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+
+	/* Whether the request is initiated by a user gesture. Defaults to false. */
+	HasUserGesture *bool `json:"hasUserGesture,omitempty"`
+}
+
+// UnmarshalCDP reads RequestWillBeSentEvent from JSON
+func (v *RequestWillBeSentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "loaderId":
+			v.LoaderId = LoaderId(d.String())
+		case "documentURL":
+			v.DocumentURL = d.String()
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "wallTime":
+			v.WallTime = TimeSinceEpoch(d.Float())
+		case "initiator":
+			v.Initiator.UnmarshalCDP(d)
+		case "redirectHasExtraInfo":
+			v.RedirectHasExtraInfo = d.Bool()
+		case "redirectResponse":
+			if d.Null() {
+				v.RedirectResponse = nil
+			} else {
+				v.RedirectResponse = new(Response)
+				(*v.RedirectResponse).UnmarshalCDP(d)
+			}
+		case "type":
+			if d.Null() {
+				v.Type = nil
+			} else {
+				v.Type = new(ResourceType)
+				*v.Type = ResourceType(d.String())
+			}
+		case "frameId":
+			if d.Null() {
+				v.FrameId = nil
+			} else {
+				v.FrameId = new(cdp.PageFrameId)
+				*v.FrameId = cdp.PageFrameId(d.String())
+			}
+		case "hasUserGesture":
+			if d.Null() {
+				v.HasUserGesture = nil
+			} else {
+				v.HasUserGesture = new(bool)
+				*v.HasUserGesture = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestWillBeSentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RequestWillBeSentHandler func(ev RequestWillBeSentEvent)
+
+// EventMethod is Network.requestWillBeSent
+func (RequestWillBeSentEvent) EventMethod() string {
+	return "Network.requestWillBeSent"
+}
+
+// OnRequestWillBeSent calls handler for each Network.requestWillBeSent event
+func (c Client) OnRequestWillBeSent(handler RequestWillBeSentHandler) (unsubscribe func()) {
+	return c.caller.On("Network.requestWillBeSent", func(ev interface{}) {
+		handler(ev.(RequestWillBeSentEvent))
+	})
+}
+
+// Fired when HTTP response is available.
+type ResponseReceivedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Loader identifier. Empty string if the request is fetched from worker. */
+	LoaderId LoaderId `json:"loaderId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Resource type. */
+	Type ResourceType `json:"type"`
+
+	/* Response data. */
+	Response Response `json:"response"`
+
+	/* Indicates whether requestWillBeSentExtraInfo and responseReceivedExtraInfo events will be
+	or were emitted for this request.
+
+	Experimental: this may change or be removed in any chrome release */
+	HasExtraInfo bool `json:"hasExtraInfo"`
+
+	/* Frame identifier. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+}
+
+// UnmarshalCDP reads ResponseReceivedEvent from JSON
+func (v *ResponseReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "loaderId":
+			v.LoaderId = LoaderId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "type":
+			v.Type = ResourceType(d.String())
+		case "response":
+			v.Response.UnmarshalCDP(d)
+		case "hasExtraInfo":
+			v.HasExtraInfo = d.Bool()
+		case "frameId":
+			if d.Null() {
+				v.FrameId = nil
+			} else {
+				v.FrameId = new(cdp.PageFrameId)
+				*v.FrameId = cdp.PageFrameId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResponseReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ResponseReceivedHandler func(ev ResponseReceivedEvent)
+
+// EventMethod is Network.responseReceived
+func (ResponseReceivedEvent) EventMethod() string {
+	return "Network.responseReceived"
+}
+
+// OnResponseReceived calls handler for each Network.responseReceived event
+func (c Client) OnResponseReceived(handler ResponseReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.responseReceived", func(ev interface{}) {
+		handler(ev.(ResponseReceivedEvent))
+	})
+}
+
+// Fired when WebSocket is closed.
+type WebSocketClosedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads WebSocketClosedEvent from JSON
+func (v *WebSocketClosedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketClosedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketClosedHandler func(ev WebSocketClosedEvent)
+
+// EventMethod is Network.webSocketClosed
+func (WebSocketClosedEvent) EventMethod() string {
+	return "Network.webSocketClosed"
+}
+
+// OnWebSocketClosed calls handler for each Network.webSocketClosed event
+func (c Client) OnWebSocketClosed(handler WebSocketClosedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketClosed", func(ev interface{}) {
+		handler(ev.(WebSocketClosedEvent))
+	})
+}
+
+// Fired upon WebSocket creation.
+type WebSocketCreatedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* WebSocket request URL. */
+	Url string `json:"url"`
+
+	/* Request initiator. */
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// UnmarshalCDP reads WebSocketCreatedEvent from JSON
+func (v *WebSocketCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "initiator":
+			if d.Null() {
+				v.Initiator = nil
+			} else {
+				v.Initiator = new(Initiator)
+				(*v.Initiator).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketCreatedHandler func(ev WebSocketCreatedEvent)
+
+// EventMethod is Network.webSocketCreated
+func (WebSocketCreatedEvent) EventMethod() string {
+	return "Network.webSocketCreated"
+}
+
+// OnWebSocketCreated calls handler for each Network.webSocketCreated event
+func (c Client) OnWebSocketCreated(handler WebSocketCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketCreated", func(ev interface{}) {
+		handler(ev.(WebSocketCreatedEvent))
+	})
+}
+
+// Fired when WebSocket message error occurs.
+type WebSocketFrameErrorEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* WebSocket error message. */
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// UnmarshalCDP reads WebSocketFrameErrorEvent from JSON
+func (v *WebSocketFrameErrorEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "errorMessage":
+			v.ErrorMessage = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketFrameErrorEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketFrameErrorHandler func(ev WebSocketFrameErrorEvent)
+
+// EventMethod is Network.webSocketFrameError
+func (WebSocketFrameErrorEvent) EventMethod() string {
+	return "Network.webSocketFrameError"
+}
+
+// OnWebSocketFrameError calls handler for each Network.webSocketFrameError event
+func (c Client) OnWebSocketFrameError(handler WebSocketFrameErrorHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketFrameError", func(ev interface{}) {
+		handler(ev.(WebSocketFrameErrorEvent))
+	})
+}
+
+// Fired when WebSocket message is received.
+type WebSocketFrameReceivedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* WebSocket response data. */
+	Response WebSocketFrame `json:"response"`
+}
+
+// UnmarshalCDP reads WebSocketFrameReceivedEvent from JSON
+func (v *WebSocketFrameReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "response":
+			v.Response.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketFrameReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketFrameReceivedHandler func(ev WebSocketFrameReceivedEvent)
+
+// EventMethod is Network.webSocketFrameReceived
+func (WebSocketFrameReceivedEvent) EventMethod() string {
+	return "Network.webSocketFrameReceived"
+}
+
+// OnWebSocketFrameReceived calls handler for each Network.webSocketFrameReceived event
+func (c Client) OnWebSocketFrameReceived(handler WebSocketFrameReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketFrameReceived", func(ev interface{}) {
+		handler(ev.(WebSocketFrameReceivedEvent))
+	})
+}
+
+// Fired when WebSocket message is sent.
+type WebSocketFrameSentEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* WebSocket response data. */
+	Response WebSocketFrame `json:"response"`
+}
+
+// UnmarshalCDP reads WebSocketFrameSentEvent from JSON
+func (v *WebSocketFrameSentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "response":
+			v.Response.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketFrameSentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketFrameSentHandler func(ev WebSocketFrameSentEvent)
+
+// EventMethod is Network.webSocketFrameSent
+func (WebSocketFrameSentEvent) EventMethod() string {
+	return "Network.webSocketFrameSent"
+}
+
+// OnWebSocketFrameSent calls handler for each Network.webSocketFrameSent event
+func (c Client) OnWebSocketFrameSent(handler WebSocketFrameSentHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketFrameSent", func(ev interface{}) {
+		handler(ev.(WebSocketFrameSentEvent))
+	})
+}
+
+// Fired when WebSocket handshake response becomes available.
+type WebSocketHandshakeResponseReceivedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* WebSocket response data. */
+	Response WebSocketResponse `json:"response"`
+}
+
+// UnmarshalCDP reads WebSocketHandshakeResponseReceivedEvent from JSON
+func (v *WebSocketHandshakeResponseReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "response":
+			v.Response.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketHandshakeResponseReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketHandshakeResponseReceivedHandler func(ev WebSocketHandshakeResponseReceivedEvent)
+
+// EventMethod is Network.webSocketHandshakeResponseReceived
+func (WebSocketHandshakeResponseReceivedEvent) EventMethod() string {
+	return "Network.webSocketHandshakeResponseReceived"
+}
+
+// OnWebSocketHandshakeResponseReceived calls handler for each Network.webSocketHandshakeResponseReceived event
+func (c Client) OnWebSocketHandshakeResponseReceived(handler WebSocketHandshakeResponseReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketHandshakeResponseReceived", func(ev interface{}) {
+		handler(ev.(WebSocketHandshakeResponseReceivedEvent))
+	})
+}
+
+// Fired when WebSocket is about to initiate handshake.
+type WebSocketWillSendHandshakeRequestEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* UTC Timestamp. */
+	WallTime TimeSinceEpoch `json:"wallTime"`
+
+	/* WebSocket request data. */
+	Request WebSocketRequest `json:"request"`
+}
+
+// UnmarshalCDP reads WebSocketWillSendHandshakeRequestEvent from JSON
+func (v *WebSocketWillSendHandshakeRequestEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "wallTime":
+			v.WallTime = TimeSinceEpoch(d.Float())
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebSocketWillSendHandshakeRequestEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebSocketWillSendHandshakeRequestHandler func(ev WebSocketWillSendHandshakeRequestEvent)
+
+// EventMethod is Network.webSocketWillSendHandshakeRequest
+func (WebSocketWillSendHandshakeRequestEvent) EventMethod() string {
+	return "Network.webSocketWillSendHandshakeRequest"
+}
+
+// OnWebSocketWillSendHandshakeRequest calls handler for each Network.webSocketWillSendHandshakeRequest event
+func (c Client) OnWebSocketWillSendHandshakeRequest(handler WebSocketWillSendHandshakeRequestHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webSocketWillSendHandshakeRequest", func(ev interface{}) {
+		handler(ev.(WebSocketWillSendHandshakeRequestEvent))
+	})
+}
+
+// Fired upon WebTransport creation.
+type WebTransportCreatedEvent struct {
+
+	/* WebTransport identifier. */
+	TransportId RequestId `json:"transportId"`
+
+	/* WebTransport request URL. */
+	Url string `json:"url"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	/* Request initiator. */
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// UnmarshalCDP reads WebTransportCreatedEvent from JSON
+func (v *WebTransportCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "transportId":
+			v.TransportId = RequestId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "initiator":
+			if d.Null() {
+				v.Initiator = nil
+			} else {
+				v.Initiator = new(Initiator)
+				(*v.Initiator).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebTransportCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebTransportCreatedHandler func(ev WebTransportCreatedEvent)
+
+// EventMethod is Network.webTransportCreated
+func (WebTransportCreatedEvent) EventMethod() string {
+	return "Network.webTransportCreated"
+}
+
+// OnWebTransportCreated calls handler for each Network.webTransportCreated event
+func (c Client) OnWebTransportCreated(handler WebTransportCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webTransportCreated", func(ev interface{}) {
+		handler(ev.(WebTransportCreatedEvent))
+	})
+}
+
+// Fired when WebTransport handshake is finished.
+type WebTransportConnectionEstablishedEvent struct {
+
+	/* WebTransport identifier. */
+	TransportId RequestId `json:"transportId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads WebTransportConnectionEstablishedEvent from JSON
+func (v *WebTransportConnectionEstablishedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "transportId":
+			v.TransportId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebTransportConnectionEstablishedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebTransportConnectionEstablishedHandler func(ev WebTransportConnectionEstablishedEvent)
+
+// EventMethod is Network.webTransportConnectionEstablished
+func (WebTransportConnectionEstablishedEvent) EventMethod() string {
+	return "Network.webTransportConnectionEstablished"
+}
+
+// OnWebTransportConnectionEstablished calls handler for each Network.webTransportConnectionEstablished event
+func (c Client) OnWebTransportConnectionEstablished(handler WebTransportConnectionEstablishedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webTransportConnectionEstablished", func(ev interface{}) {
+		handler(ev.(WebTransportConnectionEstablishedEvent))
+	})
+}
+
+// Fired when WebTransport is disposed.
+type WebTransportClosedEvent struct {
+
+	/* WebTransport identifier. */
+	TransportId RequestId `json:"transportId"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads WebTransportClosedEvent from JSON
+func (v *WebTransportClosedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "transportId":
+			v.TransportId = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebTransportClosedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WebTransportClosedHandler func(ev WebTransportClosedEvent)
+
+// EventMethod is Network.webTransportClosed
+func (WebTransportClosedEvent) EventMethod() string {
+	return "Network.webTransportClosed"
+}
+
+// OnWebTransportClosed calls handler for each Network.webTransportClosed event
+func (c Client) OnWebTransportClosed(handler WebTransportClosedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.webTransportClosed", func(ev interface{}) {
+		handler(ev.(WebTransportClosedEvent))
+	})
+}
+
+// decoders for Network events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Network.dataReceived":                       cdp.Decode[DataReceivedEvent],
+	"Network.eventSourceMessageReceived":         cdp.Decode[EventSourceMessageReceivedEvent],
+	"Network.loadingFailed":                      cdp.Decode[LoadingFailedEvent],
+	"Network.loadingFinished":                    cdp.Decode[LoadingFinishedEvent],
+	"Network.requestServedFromCache":             cdp.Decode[RequestServedFromCacheEvent],
+	"Network.requestWillBeSent":                  cdp.Decode[RequestWillBeSentEvent],
+	"Network.responseReceived":                   cdp.Decode[ResponseReceivedEvent],
+	"Network.webSocketClosed":                    cdp.Decode[WebSocketClosedEvent],
+	"Network.webSocketCreated":                   cdp.Decode[WebSocketCreatedEvent],
+	"Network.webSocketFrameError":                cdp.Decode[WebSocketFrameErrorEvent],
+	"Network.webSocketFrameReceived":             cdp.Decode[WebSocketFrameReceivedEvent],
+	"Network.webSocketFrameSent":                 cdp.Decode[WebSocketFrameSentEvent],
+	"Network.webSocketHandshakeResponseReceived": cdp.Decode[WebSocketHandshakeResponseReceivedEvent],
+	"Network.webSocketWillSendHandshakeRequest":  cdp.Decode[WebSocketWillSendHandshakeRequestEvent],
+	"Network.webTransportCreated":                cdp.Decode[WebTransportCreatedEvent],
+	"Network.webTransportConnectionEstablished":  cdp.Decode[WebTransportConnectionEstablishedEvent],
+	"Network.webTransportClosed":                 cdp.Decode[WebTransportClosedEvent],
+}
+
+// DecodeEvent decodes a Network event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,2819 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type SetAcceptedEncodingsReturns struct {
+}
+
+// UnmarshalCDP reads SetAcceptedEncodingsReturns from JSON
+func (v *SetAcceptedEncodingsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAcceptedEncodingsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAcceptedEncodingsParams are the parameters for Network.setAcceptedEncodings
+// optional parameters are left out when nil
+type SetAcceptedEncodingsParams struct {
+	/* List of accepted content encodings. */
+	Encodings []ContentEncoding `json:"encodings"`
+}
+
+// MarshalCDP writes SetAcceptedEncodingsParams as JSON
+func (v *SetAcceptedEncodingsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("encodings")
+	if v.Encodings == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Encodings {
+			e.Elem()
+			e.String(string(v.Encodings[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAcceptedEncodingsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets a list of content encodings that will be accepted. Empty list means no encoding is accepted.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAcceptedEncodings(params SetAcceptedEncodingsParams) (SetAcceptedEncodingsReturns, error) {
+	return c.SetAcceptedEncodingsContext(context.Background(), params)
+}
+
+// SetAcceptedEncodingsContext is SetAcceptedEncodings with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAcceptedEncodingsContext(ctx context.Context, params SetAcceptedEncodingsParams) (SetAcceptedEncodingsReturns, error) {
+	var returns_ SetAcceptedEncodingsReturns
+
+	err_ := c.caller.Call(ctx, "Network.setAcceptedEncodings", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearAcceptedEncodingsOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearAcceptedEncodingsOverrideReturns from JSON
+func (v *ClearAcceptedEncodingsOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearAcceptedEncodingsOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears accepted encodings set by setAcceptedEncodings
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearAcceptedEncodingsOverride() (ClearAcceptedEncodingsOverrideReturns, error) {
+	return c.ClearAcceptedEncodingsOverrideContext(context.Background())
+}
+
+// ClearAcceptedEncodingsOverrideContext is ClearAcceptedEncodingsOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearAcceptedEncodingsOverrideContext(ctx context.Context) (ClearAcceptedEncodingsOverrideReturns, error) {
+	var returns_ ClearAcceptedEncodingsOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Network.clearAcceptedEncodingsOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ContinueInterceptedRequestReturns struct {
+}
+
+// UnmarshalCDP reads ContinueInterceptedRequestReturns from JSON
+func (v *ContinueInterceptedRequestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContinueInterceptedRequestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ContinueInterceptedRequestParams are the parameters for Network.continueInterceptedRequest
+// optional parameters are left out when nil
+type ContinueInterceptedRequestParams struct {
+	InterceptionId InterceptionId `json:"interceptionId"`
+	/* If set this causes the request to fail with the given reason. Passing `Aborted` for requests
+	marked with `isNavigationRequest` also cancels the navigation. Must not be set in response
+	to an authChallenge. */
+	ErrorReason *ErrorReason `json:"errorReason,omitempty"`
+	/* If set the requests completes using with the provided base64 encoded raw response, including
+	HTTP status line and headers etc... Must not be set in response to an authChallenge. (Encoded as a base64 string when passed over JSON) */
+	RawResponse *string `json:"rawResponse,omitempty"`
+	/* If set the request url will be modified in a way that's not observable by page. Must not be
+	set in response to an authChallenge. */
+	Url *string `json:"url,omitempty"`
+	/* If set this allows the request method to be overridden. Must not be set in response to an
+	authChallenge. */
+	Method *string `json:"method,omitempty"`
+	/* If set this allows postData to be set. Must not be set in response to an authChallenge. */
+	PostData *string `json:"postData,omitempty"`
+	/* If set this allows the request headers to be changed. Must not be set in response to an
+	authChallenge. */
+	Headers Headers `json:"headers"`
+	/* Response to a requestIntercepted with an authChallenge. Must not be set otherwise. */
+	AuthChallengeResponse *AuthChallengeResponse `json:"authChallengeResponse,omitempty"`
+}
+
+// MarshalCDP writes ContinueInterceptedRequestParams as JSON
+func (v *ContinueInterceptedRequestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("interceptionId")
+	e.String(string(v.InterceptionId))
+	if v.ErrorReason != nil {
+		e.Key("errorReason")
+		e.String(string(*v.ErrorReason))
+	}
+	if v.RawResponse != nil {
+		e.Key("rawResponse")
+		e.String(*v.RawResponse)
+	}
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Method != nil {
+		e.Key("method")
+		e.String(*v.Method)
+	}
+	if v.PostData != nil {
+		e.Key("postData")
+		e.String(*v.PostData)
+	}
+	if v.Headers != nil {
+		e.Key("headers")
+		e.Map(map[string]interface{}(v.Headers))
+	}
+	if v.AuthChallengeResponse != nil {
+		e.Key("authChallengeResponse")
+		(*v.AuthChallengeResponse).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContinueInterceptedRequestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Response to Network.requestIntercepted which either modifies the request to continue with any
+// modifications, or blocks it, or completes it with the provided response bytes. If a network
+// fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted
+// event will be sent with the same InterceptionId.
+// Deprecated, use Fetch.continueRequest, Fetch.fulfillRequest and Fetch.failRequest instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ContinueInterceptedRequest(params ContinueInterceptedRequestParams) (ContinueInterceptedRequestReturns, error) {
+	return c.ContinueInterceptedRequestContext(context.Background(), params)
+}
+
+// ContinueInterceptedRequestContext is ContinueInterceptedRequest with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ContinueInterceptedRequestContext(ctx context.Context, params ContinueInterceptedRequestParams) (ContinueInterceptedRequestReturns, error) {
+	var returns_ ContinueInterceptedRequestReturns
+
+	if params.ErrorReason != nil && !params.ErrorReason.Valid() {
+		return returns_, fmt.Errorf("Network.continueInterceptedRequest: invalid errorReason %q", *params.ErrorReason)
+	}
+
+	err_ := c.caller.Call(ctx, "Network.continueInterceptedRequest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetCertificateReturns struct {
+	TableNames []string `json:"tableNames"`
+}
+
+// UnmarshalCDP reads GetCertificateReturns from JSON
+func (v *GetCertificateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "tableNames":
+			if d.Array() {
+				v.TableNames = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.TableNames = append(v.TableNames, x0)
+				}
+			} else {
+				v.TableNames = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCertificateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetCertificateParams are the parameters for Network.getCertificate
+// optional parameters are left out when nil
+type GetCertificateParams struct {
+	/* Origin to get certificate for. */
+	Origin string `json:"origin"`
+}
+
+// MarshalCDP writes GetCertificateParams as JSON
+func (v *GetCertificateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetCertificateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the DER-encoded certificate.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetCertificate(params GetCertificateParams) (GetCertificateReturns, error) {
+	return c.GetCertificateContext(context.Background(), params)
+}
+
+// GetCertificateContext is GetCertificate with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetCertificateContext(ctx context.Context, params GetCertificateParams) (GetCertificateReturns, error) {
+	var returns_ GetCertificateReturns
+
+	err_ := c.caller.Call(ctx, "Network.getCertificate", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetResponseBodyForInterceptionReturns struct {
+
+	/* Response body. */
+	Body string `json:"body"`
+
+	/* True, if content was sent as base64. */
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// UnmarshalCDP reads GetResponseBodyForInterceptionReturns from JSON
+func (v *GetResponseBodyForInterceptionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "body":
+			v.Body = d.String()
+		case "base64Encoded":
+			v.Base64Encoded = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetResponseBodyForInterceptionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetResponseBodyForInterceptionParams are the parameters for Network.getResponseBodyForInterception
+// optional parameters are left out when nil
+type GetResponseBodyForInterceptionParams struct {
+	/* Identifier for the intercepted request to get body for. */
+	InterceptionId InterceptionId `json:"interceptionId"`
+}
+
+// MarshalCDP writes GetResponseBodyForInterceptionParams as JSON
+func (v *GetResponseBodyForInterceptionParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("interceptionId")
+	e.String(string(v.InterceptionId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetResponseBodyForInterceptionParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns content served for the given currently intercepted request.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetResponseBodyForInterception(params GetResponseBodyForInterceptionParams) (GetResponseBodyForInterceptionReturns, error) {
+	return c.GetResponseBodyForInterceptionContext(context.Background(), params)
+}
+
+// GetResponseBodyForInterceptionContext is GetResponseBodyForInterception with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetResponseBodyForInterceptionContext(ctx context.Context, params GetResponseBodyForInterceptionParams) (GetResponseBodyForInterceptionReturns, error) {
+	var returns_ GetResponseBodyForInterceptionReturns
+
+	err_ := c.caller.Call(ctx, "Network.getResponseBodyForInterception", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TakeResponseBodyForInterceptionAsStreamReturns struct {
+	Stream cdp.IOStreamHandle `json:"stream"`
+}
+
+// UnmarshalCDP reads TakeResponseBodyForInterceptionAsStreamReturns from JSON
+func (v *TakeResponseBodyForInterceptionAsStreamReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "stream":
+			v.Stream = cdp.IOStreamHandle(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TakeResponseBodyForInterceptionAsStreamReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TakeResponseBodyForInterceptionAsStreamParams are the parameters for Network.takeResponseBodyForInterceptionAsStream
+// optional parameters are left out when nil
+type TakeResponseBodyForInterceptionAsStreamParams struct {
+	InterceptionId InterceptionId `json:"interceptionId"`
+}
+
+// MarshalCDP writes TakeResponseBodyForInterceptionAsStreamParams as JSON
+func (v *TakeResponseBodyForInterceptionAsStreamParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("interceptionId")
+	e.String(string(v.InterceptionId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TakeResponseBodyForInterceptionAsStreamParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a handle to the stream representing the response body. Note that after this command,
+// the intercepted request can't be continued as is -- you either need to cancel it or to provide
+// the response body. The stream only supports sequential read, IO.read will fail if the position
+// is specified.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TakeResponseBodyForInterceptionAsStream(params TakeResponseBodyForInterceptionAsStreamParams) (TakeResponseBodyForInterceptionAsStreamReturns, error) {
+	return c.TakeResponseBodyForInterceptionAsStreamContext(context.Background(), params)
+}
+
+// TakeResponseBodyForInterceptionAsStreamContext is TakeResponseBodyForInterceptionAsStream with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TakeResponseBodyForInterceptionAsStreamContext(ctx context.Context, params TakeResponseBodyForInterceptionAsStreamParams) (TakeResponseBodyForInterceptionAsStreamReturns, error) {
+	var returns_ TakeResponseBodyForInterceptionAsStreamReturns
+
+	err_ := c.caller.Call(ctx, "Network.takeResponseBodyForInterceptionAsStream", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ReplayXHRReturns struct {
+}
+
+// UnmarshalCDP reads ReplayXHRReturns from JSON
+func (v *ReplayXHRReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReplayXHRReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReplayXHRParams are the parameters for Network.replayXHR
+// optional parameters are left out when nil
+type ReplayXHRParams struct {
+	/* Identifier of XHR to replay. */
+	RequestId RequestId `json:"requestId"`
+}
+
+// MarshalCDP writes ReplayXHRParams as JSON
+func (v *ReplayXHRParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReplayXHRParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// This method sends a new XMLHttpRequest which is identical to the original one. The following
+// parameters should be identical: method, url, async, request body, extra headers, withCredentials
+// attribute, user, password.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ReplayXHR(params ReplayXHRParams) (ReplayXHRReturns, error) {
+	return c.ReplayXHRContext(context.Background(), params)
+}
+
+// ReplayXHRContext is ReplayXHR with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ReplayXHRContext(ctx context.Context, params ReplayXHRParams) (ReplayXHRReturns, error) {
+	var returns_ ReplayXHRReturns
+
+	err_ := c.caller.Call(ctx, "Network.replayXHR", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SearchInResponseBodyReturns struct {
+
+	/* List of search matches. */
+	Result []cdp.DebuggerSearchMatch `json:"result"`
+}
+
+// UnmarshalCDP reads SearchInResponseBodyReturns from JSON
+func (v *SearchInResponseBodyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			if d.Array() {
+				v.Result = make([]cdp.DebuggerSearchMatch, 0)
+				for d.More() {
+					var x0 cdp.DebuggerSearchMatch
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SearchInResponseBodyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SearchInResponseBodyParams are the parameters for Network.searchInResponseBody
+// optional parameters are left out when nil
+type SearchInResponseBodyParams struct {
+	/* Identifier of the network response to search. */
+	RequestId RequestId `json:"requestId"`
+	/* String to search for. */
+	Query string `json:"query"`
+	/* If true, search is case sensitive. */
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	/* If true, treats string parameter as regex. */
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// MarshalCDP writes SearchInResponseBodyParams as JSON
+func (v *SearchInResponseBodyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.Key("query")
+	e.String(v.Query)
+	if v.CaseSensitive != nil {
+		e.Key("caseSensitive")
+		e.Bool(*v.CaseSensitive)
+	}
+	if v.IsRegex != nil {
+		e.Key("isRegex")
+		e.Bool(*v.IsRegex)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SearchInResponseBodyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Searches for given string in response content.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SearchInResponseBody(params SearchInResponseBodyParams) (SearchInResponseBodyReturns, error) {
+	return c.SearchInResponseBodyContext(context.Background(), params)
+}
+
+// SearchInResponseBodyContext is SearchInResponseBody with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SearchInResponseBodyContext(ctx context.Context, params SearchInResponseBodyParams) (SearchInResponseBodyReturns, error) {
+	var returns_ SearchInResponseBodyReturns
+
+	err_ := c.caller.Call(ctx, "Network.searchInResponseBody", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBlockedURLsReturns struct {
+}
+
+// UnmarshalCDP reads SetBlockedURLsReturns from JSON
+func (v *SetBlockedURLsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBlockedURLsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBlockedURLsParams are the parameters for Network.setBlockedURLs
+// optional parameters are left out when nil
+type SetBlockedURLsParams struct {
+	/* URL patterns to block. Wildcards ('*') are allowed. */
+	Urls []string `json:"urls"`
+}
+
+// MarshalCDP writes SetBlockedURLsParams as JSON
+func (v *SetBlockedURLsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("urls")
+	if v.Urls == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Urls {
+			e.Elem()
+			e.String(v.Urls[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBlockedURLsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Blocks URLs from loading.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlockedURLs(params SetBlockedURLsParams) (SetBlockedURLsReturns, error) {
+	return c.SetBlockedURLsContext(context.Background(), params)
+}
+
+// SetBlockedURLsContext is SetBlockedURLs with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetBlockedURLsContext(ctx context.Context, params SetBlockedURLsParams) (SetBlockedURLsReturns, error) {
+	var returns_ SetBlockedURLsReturns
+
+	err_ := c.caller.Call(ctx, "Network.setBlockedURLs", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAttachDebugStackReturns struct {
+}
+
+// UnmarshalCDP reads SetAttachDebugStackReturns from JSON
+func (v *SetAttachDebugStackReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAttachDebugStackReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAttachDebugStackParams are the parameters for Network.setAttachDebugStack
+// optional parameters are left out when nil
+type SetAttachDebugStackParams struct {
+	/* Whether to attach a page script stack for debugging purpose. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetAttachDebugStackParams as JSON
+func (v *SetAttachDebugStackParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAttachDebugStackParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Specifies whether to attach a page script stack id in requests
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAttachDebugStack(params SetAttachDebugStackParams) (SetAttachDebugStackReturns, error) {
+	return c.SetAttachDebugStackContext(context.Background(), params)
+}
+
+// SetAttachDebugStackContext is SetAttachDebugStack with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAttachDebugStackContext(ctx context.Context, params SetAttachDebugStackParams) (SetAttachDebugStackReturns, error) {
+	var returns_ SetAttachDebugStackReturns
+
+	err_ := c.caller.Call(ctx, "Network.setAttachDebugStack", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetRequestInterceptionReturns struct {
+}
+
+// UnmarshalCDP reads SetRequestInterceptionReturns from JSON
+func (v *SetRequestInterceptionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetRequestInterceptionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetRequestInterceptionParams are the parameters for Network.setRequestInterception
+// optional parameters are left out when nil
+type SetRequestInterceptionParams struct {
+	/* Requests matching any of these patterns will be forwarded and wait for the corresponding
+	continueInterceptedRequest call. */
+	Patterns []RequestPattern `json:"patterns"`
+}
+
+// MarshalCDP writes SetRequestInterceptionParams as JSON
+func (v *SetRequestInterceptionParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("patterns")
+	if v.Patterns == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Patterns {
+			e.Elem()
+			v.Patterns[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetRequestInterceptionParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets the requests to intercept that match the provided patterns and optionally resource types.
+// Deprecated, please use Fetch.enable instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetRequestInterception(params SetRequestInterceptionParams) (SetRequestInterceptionReturns, error) {
+	return c.SetRequestInterceptionContext(context.Background(), params)
+}
+
+// SetRequestInterceptionContext is SetRequestInterception with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetRequestInterceptionContext(ctx context.Context, params SetRequestInterceptionParams) (SetRequestInterceptionReturns, error) {
+	var returns_ SetRequestInterceptionReturns
+
+	err_ := c.caller.Call(ctx, "Network.setRequestInterception", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StreamResourceContentReturns struct {
+
+	/* Data that has been buffered until streaming is enabled. (Encoded as a base64 string when passed over JSON) */
+	BufferedData string `json:"bufferedData"`
+}
+
+// UnmarshalCDP reads StreamResourceContentReturns from JSON
+func (v *StreamResourceContentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bufferedData":
+			v.BufferedData = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StreamResourceContentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StreamResourceContentParams are the parameters for Network.streamResourceContent
+// optional parameters are left out when nil
+type StreamResourceContentParams struct {
+	/* Identifier of the request to stream. */
+	RequestId RequestId `json:"requestId"`
+}
+
+// MarshalCDP writes StreamResourceContentParams as JSON
+func (v *StreamResourceContentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("requestId")
+	e.String(string(v.RequestId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StreamResourceContentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables streaming of the response for the given requestId.
+// If enabled, the dataReceived event contains the data that was received during streaming.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) StreamResourceContent(params StreamResourceContentParams) (StreamResourceContentReturns, error) {
+	return c.StreamResourceContentContext(context.Background(), params)
+}
+
+// StreamResourceContentContext is StreamResourceContent with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) StreamResourceContentContext(ctx context.Context, params StreamResourceContentParams) (StreamResourceContentReturns, error) {
+	var returns_ StreamResourceContentReturns
+
+	err_ := c.caller.Call(ctx, "Network.streamResourceContent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetSecurityIsolationStatusReturns struct {
+	Status SecurityIsolationStatus `json:"status"`
+}
+
+// UnmarshalCDP reads GetSecurityIsolationStatusReturns from JSON
+func (v *GetSecurityIsolationStatusReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "status":
+			v.Status.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSecurityIsolationStatusReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetSecurityIsolationStatusParams are the parameters for Network.getSecurityIsolationStatus
+// optional parameters are left out when nil
+type GetSecurityIsolationStatusParams struct {
+	/* If no frameId is provided, the status of the target is provided. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+}
+
+// MarshalCDP writes GetSecurityIsolationStatusParams as JSON
+func (v *GetSecurityIsolationStatusParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetSecurityIsolationStatusParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns information about the COEP/COOP isolation status.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSecurityIsolationStatus(params GetSecurityIsolationStatusParams) (GetSecurityIsolationStatusReturns, error) {
+	return c.GetSecurityIsolationStatusContext(context.Background(), params)
+}
+
+// GetSecurityIsolationStatusContext is GetSecurityIsolationStatus with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSecurityIsolationStatusContext(ctx context.Context, params GetSecurityIsolationStatusParams) (GetSecurityIsolationStatusReturns, error) {
+	var returns_ GetSecurityIsolationStatusReturns
+
+	err_ := c.caller.Call(ctx, "Network.getSecurityIsolationStatus", &params, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReportingApiReturns struct {
+}
+
+// UnmarshalCDP reads EnableReportingApiReturns from JSON
+func (v *EnableReportingApiReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReportingApiReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableReportingApiParams are the parameters for Network.enableReportingApi
+// optional parameters are left out when nil
+type EnableReportingApiParams struct {
+	/* Whether to enable or disable events for the Reporting API */
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes EnableReportingApiParams as JSON
+func (v *EnableReportingApiParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableReportingApiParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables tracking for the Reporting API, events generated by the Reporting API will now be delivered to the client.
+// Enabling triggers 'reportingApiReportAdded' for all existing reports.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) EnableReportingApi(params EnableReportingApiParams) (EnableReportingApiReturns, error) {
+	return c.EnableReportingApiContext(context.Background(), params)
+}
+
+// EnableReportingApiContext is EnableReportingApi with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) EnableReportingApiContext(ctx context.Context, params EnableReportingApiParams) (EnableReportingApiReturns, error) {
+	var returns_ EnableReportingApiReturns
+
+	err_ := c.caller.Call(ctx, "Network.enableReportingApi", &params, &returns_)
+
+	return returns_, err_
+}
+
+type LoadNetworkResourceReturns struct {
+	Resource LoadNetworkResourcePageResult `json:"resource"`
+}
+
+// UnmarshalCDP reads LoadNetworkResourceReturns from JSON
+func (v *LoadNetworkResourceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "resource":
+			v.Resource.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadNetworkResourceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// LoadNetworkResourceParams are the parameters for Network.loadNetworkResource
+// optional parameters are left out when nil
+type LoadNetworkResourceParams struct {
+	/* Frame id to get the resource for. Mandatory for frame targets, and
+	should be omitted for worker targets. */
+	FrameId *cdp.PageFrameId `json:"frameId,omitempty"`
+	/* URL of the resource to get content for. */
+	Url string `json:"url"`
+	/* Options for the request. */
+	Options LoadNetworkResourceOptions `json:"options"`
+}
+
+// MarshalCDP writes LoadNetworkResourceParams as JSON
+func (v *LoadNetworkResourceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("options")
+	v.Options.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LoadNetworkResourceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetches the resource and returns the content.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) LoadNetworkResource(params LoadNetworkResourceParams) (LoadNetworkResourceReturns, error) {
+	return c.LoadNetworkResourceContext(context.Background(), params)
+}
+
+// LoadNetworkResourceContext is LoadNetworkResource with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) LoadNetworkResourceContext(ctx context.Context, params LoadNetworkResourceParams) (LoadNetworkResourceReturns, error) {
+	var returns_ LoadNetworkResourceReturns
+
+	err_ := c.caller.Call(ctx, "Network.loadNetworkResource", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetCookieControlsReturns struct {
+}
+
+// UnmarshalCDP reads SetCookieControlsReturns from JSON
+func (v *SetCookieControlsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCookieControlsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCookieControlsParams are the parameters for Network.setCookieControls
+// optional parameters are left out when nil
+type SetCookieControlsParams struct {
+	/* Whether 3pc restriction is enabled. */
+	EnableThirdPartyCookieRestriction bool `json:"enableThirdPartyCookieRestriction"`
+	/* Whether 3pc grace period exception should be enabled; false by default. */
+	DisableThirdPartyCookieMetadata bool `json:"disableThirdPartyCookieMetadata"`
+	/* Whether 3pc heuristics exceptions should be enabled; false by default. */
+	DisableThirdPartyCookieHeuristics bool `json:"disableThirdPartyCookieHeuristics"`
+}
+
+// MarshalCDP writes SetCookieControlsParams as JSON
+func (v *SetCookieControlsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enableThirdPartyCookieRestriction")
+	e.Bool(v.EnableThirdPartyCookieRestriction)
+	e.Key("disableThirdPartyCookieMetadata")
+	e.Bool(v.DisableThirdPartyCookieMetadata)
+	e.Key("disableThirdPartyCookieHeuristics")
+	e.Bool(v.DisableThirdPartyCookieHeuristics)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCookieControlsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets Controls for third-party cookie access
+// Page reload is required before the new cookie behavior will be observed
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetCookieControls(params SetCookieControlsParams) (SetCookieControlsReturns, error) {
+	return c.SetCookieControlsContext(context.Background(), params)
+}
+
+// SetCookieControlsContext is SetCookieControls with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetCookieControlsContext(ctx context.Context, params SetCookieControlsParams) (SetCookieControlsReturns, error) {
+	var returns_ SetCookieControlsReturns
+
+	err_ := c.caller.Call(ctx, "Network.setCookieControls", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Details of an intercepted HTTP request, which must be either allowed, blocked, modified or
+// mocked.
+// Deprecated, use Fetch.requestPaused instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+type RequestInterceptedEvent struct {
+
+	/* Each request the page makes will have a unique id, however if any redirects are encountered
+	while processing that fetch, they will be reported with the same id as the original fetch.
+	Likewise if HTTP authentication is needed then the same fetch id will be used. */
+	InterceptionId InterceptionId `json:"interceptionId"`
+
+	Request Request `json:"request"`
+
+	/* The id of the frame that initiated the request. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+
+	/* How the requested resource will be used. */
+	ResourceType ResourceType `json:"resourceType"`
+
+	/* Whether this is a navigation request, which can abort the navigation completely. */
+	IsNavigationRequest bool `json:"isNavigationRequest"`
+
+	/* Set if the request is a navigation that will result in a download.
+	Only present after response is received from the server (i.e. HeadersReceived stage). */
+	IsDownload *bool `json:"isDownload,omitempty"`
+
+	/* Redirect location, only sent if a redirect was intercepted. */
+	RedirectUrl *string `json:"redirectUrl,omitempty"`
+
+	/* Details of the Authorization Challenge encountered. If this is set then
+	continueInterceptedRequest must contain an authChallengeResponse. */
+	AuthChallenge *AuthChallenge `json:"authChallenge,omitempty"`
+
+	/* Response error if intercepted at response stage or if redirect occurred while intercepting
+	request. */
+	ResponseErrorReason *ErrorReason `json:"responseErrorReason,omitempty"`
+
+	/* Response code if intercepted at response stage or if redirect occurred while intercepting
+	request or auth retry occurred. */
+	ResponseStatusCode *int `json:"responseStatusCode,omitempty"`
+
+	/* Response headers if intercepted at the response stage or if redirect occurred while
+	intercepting request or auth retry occurred. */
+	ResponseHeaders Headers `json:"responseHeaders,omitempty"`
+
+	/* If the intercepted request had a corresponding requestWillBeSent event fired for it, then
+	this requestId will be the same as the requestId present in the requestWillBeSent event. */
+	RequestId *RequestId `json:"requestId,omitempty"`
+}
+
+// UnmarshalCDP reads RequestInterceptedEvent from JSON
+func (v *RequestInterceptedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "interceptionId":
+			v.InterceptionId = InterceptionId(d.String())
+		case "request":
+			v.Request.UnmarshalCDP(d)
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "resourceType":
+			v.ResourceType = ResourceType(d.String())
+		case "isNavigationRequest":
+			v.IsNavigationRequest = d.Bool()
+		case "isDownload":
+			if d.Null() {
+				v.IsDownload = nil
+			} else {
+				v.IsDownload = new(bool)
+				*v.IsDownload = d.Bool()
+			}
+		case "redirectUrl":
+			if d.Null() {
+				v.RedirectUrl = nil
+			} else {
+				v.RedirectUrl = new(string)
+				*v.RedirectUrl = d.String()
+			}
+		case "authChallenge":
+			if d.Null() {
+				v.AuthChallenge = nil
+			} else {
+				v.AuthChallenge = new(AuthChallenge)
+				(*v.AuthChallenge).UnmarshalCDP(d)
+			}
+		case "responseErrorReason":
+			if d.Null() {
+				v.ResponseErrorReason = nil
+			} else {
+				v.ResponseErrorReason = new(ErrorReason)
+				*v.ResponseErrorReason = ErrorReason(d.String())
+			}
+		case "responseStatusCode":
+			if d.Null() {
+				v.ResponseStatusCode = nil
+			} else {
+				v.ResponseStatusCode = new(int)
+				*v.ResponseStatusCode = d.Int()
+			}
+		case "responseHeaders":
+			v.ResponseHeaders = Headers(d.Map())
+		case "requestId":
+			if d.Null() {
+				v.RequestId = nil
+			} else {
+				v.RequestId = new(RequestId)
+				*v.RequestId = RequestId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestInterceptedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RequestInterceptedHandler func(ev RequestInterceptedEvent)
+
+// EventMethod is Network.requestIntercepted
+func (RequestInterceptedEvent) EventMethod() string {
+	return "Network.requestIntercepted"
+}
+
+// OnRequestIntercepted calls handler for each Network.requestIntercepted event
+func (c Client) OnRequestIntercepted(handler RequestInterceptedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.requestIntercepted", func(ev interface{}) {
+		handler(ev.(RequestInterceptedEvent))
+	})
+}
+
+// Fired when resource loading priority is changed
+//
+// Experimental: this may change or be removed in any chrome release
+type ResourceChangedPriorityEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* New priority */
+	NewPriority ResourcePriority `json:"newPriority"`
+
+	/* Timestamp. */
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads ResourceChangedPriorityEvent from JSON
+func (v *ResourceChangedPriorityEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "newPriority":
+			v.NewPriority = ResourcePriority(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResourceChangedPriorityEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ResourceChangedPriorityHandler func(ev ResourceChangedPriorityEvent)
+
+// EventMethod is Network.resourceChangedPriority
+func (ResourceChangedPriorityEvent) EventMethod() string {
+	return "Network.resourceChangedPriority"
+}
+
+// OnResourceChangedPriority calls handler for each Network.resourceChangedPriority event
+func (c Client) OnResourceChangedPriority(handler ResourceChangedPriorityHandler) (unsubscribe func()) {
+	return c.caller.On("Network.resourceChangedPriority", func(ev interface{}) {
+		handler(ev.(ResourceChangedPriorityEvent))
+	})
+}
+
+// Fired when a signed exchange was received over the network
+//
+// Experimental: this may change or be removed in any chrome release
+type SignedExchangeReceivedEvent struct {
+
+	/* Request identifier. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Information about the signed exchange response. */
+	Info SignedExchangeInfo `json:"info"`
+}
+
+// UnmarshalCDP reads SignedExchangeReceivedEvent from JSON
+func (v *SignedExchangeReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "info":
+			v.Info.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SignedExchangeReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SignedExchangeReceivedHandler func(ev SignedExchangeReceivedEvent)
+
+// EventMethod is Network.signedExchangeReceived
+func (SignedExchangeReceivedEvent) EventMethod() string {
+	return "Network.signedExchangeReceived"
+}
+
+// OnSignedExchangeReceived calls handler for each Network.signedExchangeReceived event
+func (c Client) OnSignedExchangeReceived(handler SignedExchangeReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.signedExchangeReceived", func(ev interface{}) {
+		handler(ev.(SignedExchangeReceivedEvent))
+	})
+}
+
+// Fired upon direct_socket.TCPSocket creation.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketCreatedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	RemoteAddr string `json:"remoteAddr"`
+
+	/* Unsigned int 16. */
+	RemotePort int `json:"remotePort"`
+
+	Options DirectTCPSocketOptions `json:"options"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// UnmarshalCDP reads DirectTCPSocketCreatedEvent from JSON
+func (v *DirectTCPSocketCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "remoteAddr":
+			v.RemoteAddr = d.String()
+		case "remotePort":
+			v.RemotePort = d.Int()
+		case "options":
+			v.Options.UnmarshalCDP(d)
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "initiator":
+			if d.Null() {
+				v.Initiator = nil
+			} else {
+				v.Initiator = new(Initiator)
+				(*v.Initiator).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectTCPSocketCreatedHandler func(ev DirectTCPSocketCreatedEvent)
+
+// EventMethod is Network.directTCPSocketCreated
+func (DirectTCPSocketCreatedEvent) EventMethod() string {
+	return "Network.directTCPSocketCreated"
+}
+
+// OnDirectTCPSocketCreated calls handler for each Network.directTCPSocketCreated event
+func (c Client) OnDirectTCPSocketCreated(handler DirectTCPSocketCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directTCPSocketCreated", func(ev interface{}) {
+		handler(ev.(DirectTCPSocketCreatedEvent))
+	})
+}
+
+// Fired when direct_socket.TCPSocket connection is opened.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketOpenedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	RemoteAddr string `json:"remoteAddr"`
+
+	/* Expected to be unsigned integer. */
+	RemotePort int `json:"remotePort"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	LocalAddr *string `json:"localAddr,omitempty"`
+
+	/* Expected to be unsigned integer. */
+	LocalPort *int `json:"localPort,omitempty"`
+}
+
+// UnmarshalCDP reads DirectTCPSocketOpenedEvent from JSON
+func (v *DirectTCPSocketOpenedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "remoteAddr":
+			v.RemoteAddr = d.String()
+		case "remotePort":
+			v.RemotePort = d.Int()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "localAddr":
+			if d.Null() {
+				v.LocalAddr = nil
+			} else {
+				v.LocalAddr = new(string)
+				*v.LocalAddr = d.String()
+			}
+		case "localPort":
+			if d.Null() {
+				v.LocalPort = nil
+			} else {
+				v.LocalPort = new(int)
+				*v.LocalPort = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketOpenedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectTCPSocketOpenedHandler func(ev DirectTCPSocketOpenedEvent)
+
+// EventMethod is Network.directTCPSocketOpened
+func (DirectTCPSocketOpenedEvent) EventMethod() string {
+	return "Network.directTCPSocketOpened"
+}
+
+// OnDirectTCPSocketOpened calls handler for each Network.directTCPSocketOpened event
+func (c Client) OnDirectTCPSocketOpened(handler DirectTCPSocketOpenedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directTCPSocketOpened", func(ev interface{}) {
+		handler(ev.(DirectTCPSocketOpenedEvent))
+	})
+}
+
+// Fired when direct_socket.TCPSocket is aborted.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketAbortedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	ErrorMessage string `json:"errorMessage"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectTCPSocketAbortedEvent from JSON
+func (v *DirectTCPSocketAbortedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "errorMessage":
+			v.ErrorMessage = d.String()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketAbortedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectTCPSocketAbortedHandler func(ev DirectTCPSocketAbortedEvent)
+
+// EventMethod is Network.directTCPSocketAborted
+func (DirectTCPSocketAbortedEvent) EventMethod() string {
+	return "Network.directTCPSocketAborted"
+}
+
+// OnDirectTCPSocketAborted calls handler for each Network.directTCPSocketAborted event
+func (c Client) OnDirectTCPSocketAborted(handler DirectTCPSocketAbortedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directTCPSocketAborted", func(ev interface{}) {
+		handler(ev.(DirectTCPSocketAbortedEvent))
+	})
+}
+
+// Fired when direct_socket.TCPSocket is closed.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketClosedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectTCPSocketClosedEvent from JSON
+func (v *DirectTCPSocketClosedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketClosedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectTCPSocketClosedHandler func(ev DirectTCPSocketClosedEvent)
+
+// EventMethod is Network.directTCPSocketClosed
+func (DirectTCPSocketClosedEvent) EventMethod() string {
+	return "Network.directTCPSocketClosed"
+}
+
+// OnDirectTCPSocketClosed calls handler for each Network.directTCPSocketClosed event
+func (c Client) OnDirectTCPSocketClosed(handler DirectTCPSocketClosedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directTCPSocketClosed", func(ev interface{}) {
+		handler(ev.(DirectTCPSocketClosedEvent))
+	})
+}
+
+// Fired when data is sent to tcp direct socket stream.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketChunkSentEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Data string `json:"data"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectTCPSocketChunkSentEvent from JSON
+func (v *DirectTCPSocketChunkSentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "data":
+			v.Data = d.String()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketChunkSentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectTCPSocketChunkSentHandler func(ev DirectTCPSocketChunkSentEvent)
+
+// EventMethod is Network.directTCPSocketChunkSent
+func (DirectTCPSocketChunkSentEvent) EventMethod() string {
+	return "Network.directTCPSocketChunkSent"
+}
+
+// OnDirectTCPSocketChunkSent calls handler for each Network.directTCPSocketChunkSent event
+func (c Client) OnDirectTCPSocketChunkSent(handler DirectTCPSocketChunkSentHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directTCPSocketChunkSent", func(ev interface{}) {
+		handler(ev.(DirectTCPSocketChunkSentEvent))
+	})
+}
+
+// Fired when data is received from tcp direct socket stream.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectTCPSocketChunkReceivedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Data string `json:"data"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectTCPSocketChunkReceivedEvent from JSON
+func (v *DirectTCPSocketChunkReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "data":
+			v.Data = d.String()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectTCPSocketChunkReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectTCPSocketChunkReceivedHandler func(ev DirectTCPSocketChunkReceivedEvent)
+
+// EventMethod is Network.directTCPSocketChunkReceived
+func (DirectTCPSocketChunkReceivedEvent) EventMethod() string {
+	return "Network.directTCPSocketChunkReceived"
+}
+
+// OnDirectTCPSocketChunkReceived calls handler for each Network.directTCPSocketChunkReceived event
+func (c Client) OnDirectTCPSocketChunkReceived(handler DirectTCPSocketChunkReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directTCPSocketChunkReceived", func(ev interface{}) {
+		handler(ev.(DirectTCPSocketChunkReceivedEvent))
+	})
+}
+
+// Fired upon direct_socket.UDPSocket creation.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketCreatedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Options DirectUDPSocketOptions `json:"options"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// UnmarshalCDP reads DirectUDPSocketCreatedEvent from JSON
+func (v *DirectUDPSocketCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "options":
+			v.Options.UnmarshalCDP(d)
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "initiator":
+			if d.Null() {
+				v.Initiator = nil
+			} else {
+				v.Initiator = new(Initiator)
+				(*v.Initiator).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectUDPSocketCreatedHandler func(ev DirectUDPSocketCreatedEvent)
+
+// EventMethod is Network.directUDPSocketCreated
+func (DirectUDPSocketCreatedEvent) EventMethod() string {
+	return "Network.directUDPSocketCreated"
+}
+
+// OnDirectUDPSocketCreated calls handler for each Network.directUDPSocketCreated event
+func (c Client) OnDirectUDPSocketCreated(handler DirectUDPSocketCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directUDPSocketCreated", func(ev interface{}) {
+		handler(ev.(DirectUDPSocketCreatedEvent))
+	})
+}
+
+// Fired when direct_socket.UDPSocket connection is opened.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketOpenedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	LocalAddr string `json:"localAddr"`
+
+	/* Expected to be unsigned integer. */
+	LocalPort int `json:"localPort"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+
+	RemoteAddr *string `json:"remoteAddr,omitempty"`
+
+	/* Expected to be unsigned integer. */
+	RemotePort *int `json:"remotePort,omitempty"`
+}
+
+// UnmarshalCDP reads DirectUDPSocketOpenedEvent from JSON
+func (v *DirectUDPSocketOpenedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "localAddr":
+			v.LocalAddr = d.String()
+		case "localPort":
+			v.LocalPort = d.Int()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		case "remoteAddr":
+			if d.Null() {
+				v.RemoteAddr = nil
+			} else {
+				v.RemoteAddr = new(string)
+				*v.RemoteAddr = d.String()
+			}
+		case "remotePort":
+			if d.Null() {
+				v.RemotePort = nil
+			} else {
+				v.RemotePort = new(int)
+				*v.RemotePort = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketOpenedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectUDPSocketOpenedHandler func(ev DirectUDPSocketOpenedEvent)
+
+// EventMethod is Network.directUDPSocketOpened
+func (DirectUDPSocketOpenedEvent) EventMethod() string {
+	return "Network.directUDPSocketOpened"
+}
+
+// OnDirectUDPSocketOpened calls handler for each Network.directUDPSocketOpened event
+func (c Client) OnDirectUDPSocketOpened(handler DirectUDPSocketOpenedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directUDPSocketOpened", func(ev interface{}) {
+		handler(ev.(DirectUDPSocketOpenedEvent))
+	})
+}
+
+// Fired when direct_socket.UDPSocket is aborted.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketAbortedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	ErrorMessage string `json:"errorMessage"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectUDPSocketAbortedEvent from JSON
+func (v *DirectUDPSocketAbortedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "errorMessage":
+			v.ErrorMessage = d.String()
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketAbortedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectUDPSocketAbortedHandler func(ev DirectUDPSocketAbortedEvent)
+
+// EventMethod is Network.directUDPSocketAborted
+func (DirectUDPSocketAbortedEvent) EventMethod() string {
+	return "Network.directUDPSocketAborted"
+}
+
+// OnDirectUDPSocketAborted calls handler for each Network.directUDPSocketAborted event
+func (c Client) OnDirectUDPSocketAborted(handler DirectUDPSocketAbortedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directUDPSocketAborted", func(ev interface{}) {
+		handler(ev.(DirectUDPSocketAbortedEvent))
+	})
+}
+
+// Fired when direct_socket.UDPSocket is closed.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketClosedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectUDPSocketClosedEvent from JSON
+func (v *DirectUDPSocketClosedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketClosedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectUDPSocketClosedHandler func(ev DirectUDPSocketClosedEvent)
+
+// EventMethod is Network.directUDPSocketClosed
+func (DirectUDPSocketClosedEvent) EventMethod() string {
+	return "Network.directUDPSocketClosed"
+}
+
+// OnDirectUDPSocketClosed calls handler for each Network.directUDPSocketClosed event
+func (c Client) OnDirectUDPSocketClosed(handler DirectUDPSocketClosedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directUDPSocketClosed", func(ev interface{}) {
+		handler(ev.(DirectUDPSocketClosedEvent))
+	})
+}
+
+// Fired when message is sent to udp direct socket stream.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketChunkSentEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Message DirectUDPMessage `json:"message"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectUDPSocketChunkSentEvent from JSON
+func (v *DirectUDPSocketChunkSentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "message":
+			v.Message.UnmarshalCDP(d)
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketChunkSentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectUDPSocketChunkSentHandler func(ev DirectUDPSocketChunkSentEvent)
+
+// EventMethod is Network.directUDPSocketChunkSent
+func (DirectUDPSocketChunkSentEvent) EventMethod() string {
+	return "Network.directUDPSocketChunkSent"
+}
+
+// OnDirectUDPSocketChunkSent calls handler for each Network.directUDPSocketChunkSent event
+func (c Client) OnDirectUDPSocketChunkSent(handler DirectUDPSocketChunkSentHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directUDPSocketChunkSent", func(ev interface{}) {
+		handler(ev.(DirectUDPSocketChunkSentEvent))
+	})
+}
+
+// Fired when message is received from udp direct socket stream.
+//
+// Experimental: this may change or be removed in any chrome release
+type DirectUDPSocketChunkReceivedEvent struct {
+	Identifier RequestId `json:"identifier"`
+
+	Message DirectUDPMessage `json:"message"`
+
+	Timestamp MonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DirectUDPSocketChunkReceivedEvent from JSON
+func (v *DirectUDPSocketChunkReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = RequestId(d.String())
+		case "message":
+			v.Message.UnmarshalCDP(d)
+		case "timestamp":
+			v.Timestamp = MonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DirectUDPSocketChunkReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DirectUDPSocketChunkReceivedHandler func(ev DirectUDPSocketChunkReceivedEvent)
+
+// EventMethod is Network.directUDPSocketChunkReceived
+func (DirectUDPSocketChunkReceivedEvent) EventMethod() string {
+	return "Network.directUDPSocketChunkReceived"
+}
+
+// OnDirectUDPSocketChunkReceived calls handler for each Network.directUDPSocketChunkReceived event
+func (c Client) OnDirectUDPSocketChunkReceived(handler DirectUDPSocketChunkReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.directUDPSocketChunkReceived", func(ev interface{}) {
+		handler(ev.(DirectUDPSocketChunkReceivedEvent))
+	})
+}
+
+// Fired when additional information about a requestWillBeSent event is available from the
+// network stack. Not every requestWillBeSent event will have an additional
+// requestWillBeSentExtraInfo fired for it, and there is no guarantee whether requestWillBeSent
+// or requestWillBeSentExtraInfo will be fired first for the same request.
+//
+// Experimental: this may change or be removed in any chrome release
+type RequestWillBeSentExtraInfoEvent struct {
+
+	/* Request identifier. Used to match this information to an existing requestWillBeSent event. */
+	RequestId RequestId `json:"requestId"`
+
+	/* A list of cookies potentially associated to the requested URL. This includes both cookies sent with
+	the request and the ones not sent; the latter are distinguished by having blockedReasons field set. */
+	AssociatedCookies []AssociatedCookie `json:"associatedCookies"`
+
+	/* Raw request headers as they will be sent over the wire. */
+	Headers Headers `json:"headers"`
+
+	/* Connection timing information for the request.
+
+	Experimental: this may change or be removed in any chrome release */
+	ConnectTiming ConnectTiming `json:"connectTiming"`
+
+	/* The client security state set for the request. */
+	ClientSecurityState *ClientSecurityState `json:"clientSecurityState,omitempty"`
+
+	/* Whether the site has partitioned cookies stored in a partition different than the current one. */
+	SiteHasCookieInOtherPartition *bool `json:"siteHasCookieInOtherPartition,omitempty"`
+}
+
+// UnmarshalCDP reads RequestWillBeSentExtraInfoEvent from JSON
+func (v *RequestWillBeSentExtraInfoEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "associatedCookies":
+			if d.Array() {
+				v.AssociatedCookies = make([]AssociatedCookie, 0)
+				for d.More() {
+					var x0 AssociatedCookie
+					x0.UnmarshalCDP(d)
+					v.AssociatedCookies = append(v.AssociatedCookies, x0)
+				}
+			} else {
+				v.AssociatedCookies = nil
+			}
+		case "headers":
+			v.Headers = Headers(d.Map())
+		case "connectTiming":
+			v.ConnectTiming.UnmarshalCDP(d)
+		case "clientSecurityState":
+			if d.Null() {
+				v.ClientSecurityState = nil
+			} else {
+				v.ClientSecurityState = new(ClientSecurityState)
+				(*v.ClientSecurityState).UnmarshalCDP(d)
+			}
+		case "siteHasCookieInOtherPartition":
+			if d.Null() {
+				v.SiteHasCookieInOtherPartition = nil
+			} else {
+				v.SiteHasCookieInOtherPartition = new(bool)
+				*v.SiteHasCookieInOtherPartition = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestWillBeSentExtraInfoEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RequestWillBeSentExtraInfoHandler func(ev RequestWillBeSentExtraInfoEvent)
+
+// EventMethod is Network.requestWillBeSentExtraInfo
+func (RequestWillBeSentExtraInfoEvent) EventMethod() string {
+	return "Network.requestWillBeSentExtraInfo"
+}
+
+// OnRequestWillBeSentExtraInfo calls handler for each Network.requestWillBeSentExtraInfo event
+func (c Client) OnRequestWillBeSentExtraInfo(handler RequestWillBeSentExtraInfoHandler) (unsubscribe func()) {
+	return c.caller.On("Network.requestWillBeSentExtraInfo", func(ev interface{}) {
+		handler(ev.(RequestWillBeSentExtraInfoEvent))
+	})
+}
+
+// Fired when additional information about a responseReceived event is available from the network
+// stack. Not every responseReceived event will have an additional responseReceivedExtraInfo for
+// it, and responseReceivedExtraInfo may be fired before or after responseReceived.
+//
+// Experimental: this may change or be removed in any chrome release
+type ResponseReceivedExtraInfoEvent struct {
+
+	/* Request identifier. Used to match this information to another responseReceived event. */
+	RequestId RequestId `json:"requestId"`
+
+	/* A list of cookies which were not stored from the response along with the corresponding
+	reasons for blocking. The cookies here may not be valid due to syntax errors, which
+	are represented by the invalid cookie line string instead of a proper cookie. */
+	BlockedCookies []BlockedSetCookieWithReason `json:"blockedCookies"`
+
+	/* Raw response headers as they were received over the wire.
+	Duplicate headers in the response are represented as a single key with their values
+	concatentated using `\n` as the separator.
+	See also `headersText` that contains verbatim text for HTTP/1.*. */
+	Headers Headers `json:"headers"`
+
+	/* The IP address space of the resource. The address space can only be determined once the transport
+	established the connection, so we can't send it in `requestWillBeSentExtraInfo`. */
+	ResourceIPAddressSpace IPAddressSpace `json:"resourceIPAddressSpace"`
+
+	/* The status code of the response. This is useful in cases the request failed and no responseReceived
+	event is triggered, which is the case for, e.g., CORS errors. This is also the correct status code
+	for cached requests, where the status in responseReceived is a 200 and this will be 304. */
+	StatusCode int `json:"statusCode"`
+
+	/* Raw response header text as it was received over the wire. The raw text may not always be
+	available, such as in the case of HTTP/2 or QUIC. */
+	HeadersText *string `json:"headersText,omitempty"`
+
+	/* The cookie partition key that will be used to store partitioned cookies set in this response.
+	Only sent when partitioned cookies are enabled.
+
+	Experimental: this may change or be removed in any chrome release */
+	CookiePartitionKey *CookiePartitionKey `json:"cookiePartitionKey,omitempty"`
+
+	/* True if partitioned cookies are enabled, but the partition key is not serializable to string. */
+	CookiePartitionKeyOpaque *bool `json:"cookiePartitionKeyOpaque,omitempty"`
+
+	/* A list of cookies which should have been blocked by 3PCD but are exempted and stored from
+	the response with the corresponding reason. */
+	ExemptedCookies []ExemptedSetCookieWithReason `json:"exemptedCookies,omitempty"`
+}
+
+// UnmarshalCDP reads ResponseReceivedExtraInfoEvent from JSON
+func (v *ResponseReceivedExtraInfoEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "blockedCookies":
+			if d.Array() {
+				v.BlockedCookies = make([]BlockedSetCookieWithReason, 0)
+				for d.More() {
+					var x0 BlockedSetCookieWithReason
+					x0.UnmarshalCDP(d)
+					v.BlockedCookies = append(v.BlockedCookies, x0)
+				}
+			} else {
+				v.BlockedCookies = nil
+			}
+		case "headers":
+			v.Headers = Headers(d.Map())
+		case "resourceIPAddressSpace":
+			v.ResourceIPAddressSpace = IPAddressSpace(d.String())
+		case "statusCode":
+			v.StatusCode = d.Int()
+		case "headersText":
+			if d.Null() {
+				v.HeadersText = nil
+			} else {
+				v.HeadersText = new(string)
+				*v.HeadersText = d.String()
+			}
+		case "cookiePartitionKey":
+			if d.Null() {
+				v.CookiePartitionKey = nil
+			} else {
+				v.CookiePartitionKey = new(CookiePartitionKey)
+				(*v.CookiePartitionKey).UnmarshalCDP(d)
+			}
+		case "cookiePartitionKeyOpaque":
+			if d.Null() {
+				v.CookiePartitionKeyOpaque = nil
+			} else {
+				v.CookiePartitionKeyOpaque = new(bool)
+				*v.CookiePartitionKeyOpaque = d.Bool()
+			}
+		case "exemptedCookies":
+			if d.Array() {
+				v.ExemptedCookies = make([]ExemptedSetCookieWithReason, 0)
+				for d.More() {
+					var x0 ExemptedSetCookieWithReason
+					x0.UnmarshalCDP(d)
+					v.ExemptedCookies = append(v.ExemptedCookies, x0)
+				}
+			} else {
+				v.ExemptedCookies = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResponseReceivedExtraInfoEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ResponseReceivedExtraInfoHandler func(ev ResponseReceivedExtraInfoEvent)
+
+// EventMethod is Network.responseReceivedExtraInfo
+func (ResponseReceivedExtraInfoEvent) EventMethod() string {
+	return "Network.responseReceivedExtraInfo"
+}
+
+// OnResponseReceivedExtraInfo calls handler for each Network.responseReceivedExtraInfo event
+func (c Client) OnResponseReceivedExtraInfo(handler ResponseReceivedExtraInfoHandler) (unsubscribe func()) {
+	return c.caller.On("Network.responseReceivedExtraInfo", func(ev interface{}) {
+		handler(ev.(ResponseReceivedExtraInfoEvent))
+	})
+}
+
+// Fired when 103 Early Hints headers is received in addition to the common response.
+// Not every responseReceived event will have an responseReceivedEarlyHints fired.
+// Only one responseReceivedEarlyHints may be fired for eached responseReceived event.
+//
+// Experimental: this may change or be removed in any chrome release
+type ResponseReceivedEarlyHintsEvent struct {
+
+	/* Request identifier. Used to match this information to another responseReceived event. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Raw response headers as they were received over the wire.
+	Duplicate headers in the response are represented as a single key with their values
+	concatentated using `\n` as the separator.
+	See also `headersText` that contains verbatim text for HTTP/1.*. */
+	Headers Headers `json:"headers"`
+}
+
+// UnmarshalCDP reads ResponseReceivedEarlyHintsEvent from JSON
+func (v *ResponseReceivedEarlyHintsEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "headers":
+			v.Headers = Headers(d.Map())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResponseReceivedEarlyHintsEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ResponseReceivedEarlyHintsHandler func(ev ResponseReceivedEarlyHintsEvent)
+
+// EventMethod is Network.responseReceivedEarlyHints
+func (ResponseReceivedEarlyHintsEvent) EventMethod() string {
+	return "Network.responseReceivedEarlyHints"
+}
+
+// OnResponseReceivedEarlyHints calls handler for each Network.responseReceivedEarlyHints event
+func (c Client) OnResponseReceivedEarlyHints(handler ResponseReceivedEarlyHintsHandler) (unsubscribe func()) {
+	return c.caller.On("Network.responseReceivedEarlyHints", func(ev interface{}) {
+		handler(ev.(ResponseReceivedEarlyHintsEvent))
+	})
+}
+
+// Fired exactly once for each Trust Token operation. Depending on
+// the type of the operation and whether the operation succeeded or
+// failed, the event is fired before the corresponding request was sent
+// or after the response was received.
+//
+// Experimental: this may change or be removed in any chrome release
+type TrustTokenOperationDoneEvent struct {
+
+	/* Detailed success or error status of the operation.
+	'AlreadyExists' also signifies a successful operation, as the result
+	of the operation already exists und thus, the operation was abort
+	preemptively (e.g. a cache hit). */
+	Status TrustTokenOperationDoneStatus `json:"status"`
+
+	Type TrustTokenOperationType `json:"type"`
+
+	RequestId RequestId `json:"requestId"`
+
+	/* Top level origin. The context in which the operation was attempted. */
+	TopLevelOrigin *string `json:"topLevelOrigin,omitempty"`
+
+	/* Origin of the issuer in case of a "Issuance" or "Redemption" operation. */
+	IssuerOrigin *string `json:"issuerOrigin,omitempty"`
+
+	/* The number of obtained Trust Tokens on a successful "Issuance" operation. */
+	IssuedTokenCount *int `json:"issuedTokenCount,omitempty"`
+}
+
+// UnmarshalCDP reads TrustTokenOperationDoneEvent from JSON
+func (v *TrustTokenOperationDoneEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "status":
+			v.Status = TrustTokenOperationDoneStatus(d.String())
+		case "type":
+			v.Type = TrustTokenOperationType(d.String())
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "topLevelOrigin":
+			if d.Null() {
+				v.TopLevelOrigin = nil
+			} else {
+				v.TopLevelOrigin = new(string)
+				*v.TopLevelOrigin = d.String()
+			}
+		case "issuerOrigin":
+			if d.Null() {
+				v.IssuerOrigin = nil
+			} else {
+				v.IssuerOrigin = new(string)
+				*v.IssuerOrigin = d.String()
+			}
+		case "issuedTokenCount":
+			if d.Null() {
+				v.IssuedTokenCount = nil
+			} else {
+				v.IssuedTokenCount = new(int)
+				*v.IssuedTokenCount = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrustTokenOperationDoneEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TrustTokenOperationDoneHandler func(ev TrustTokenOperationDoneEvent)
+
+// EventMethod is Network.trustTokenOperationDone
+func (TrustTokenOperationDoneEvent) EventMethod() string {
+	return "Network.trustTokenOperationDone"
+}
+
+// OnTrustTokenOperationDone calls handler for each Network.trustTokenOperationDone event
+func (c Client) OnTrustTokenOperationDone(handler TrustTokenOperationDoneHandler) (unsubscribe func()) {
+	return c.caller.On("Network.trustTokenOperationDone", func(ev interface{}) {
+		handler(ev.(TrustTokenOperationDoneEvent))
+	})
+}
+
+// Fired once security policy has been updated.
+//
+// Experimental: this may change or be removed in any chrome release
+type PolicyUpdatedEvent struct {
+}
+
+// UnmarshalCDP reads PolicyUpdatedEvent from JSON
+func (v *PolicyUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PolicyUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PolicyUpdatedHandler func(ev PolicyUpdatedEvent)
+
+// EventMethod is Network.policyUpdated
+func (PolicyUpdatedEvent) EventMethod() string {
+	return "Network.policyUpdated"
+}
+
+// OnPolicyUpdated calls handler for each Network.policyUpdated event
+func (c Client) OnPolicyUpdated(handler PolicyUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.policyUpdated", func(ev interface{}) {
+		handler(ev.(PolicyUpdatedEvent))
+	})
+}
+
+// Fired once when parsing the .wbn file has succeeded.
+// The event contains the information about the web bundle contents.
+//
+// Experimental: this may change or be removed in any chrome release
+type SubresourceWebBundleMetadataReceivedEvent struct {
+
+	/* Request identifier. Used to match this information to another event. */
+	RequestId RequestId `json:"requestId"`
+
+	/* A list of URLs of resources in the subresource Web Bundle. */
+	Urls []string `json:"urls"`
+}
+
+// UnmarshalCDP reads SubresourceWebBundleMetadataReceivedEvent from JSON
+func (v *SubresourceWebBundleMetadataReceivedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "urls":
+			if d.Array() {
+				v.Urls = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Urls = append(v.Urls, x0)
+				}
+			} else {
+				v.Urls = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SubresourceWebBundleMetadataReceivedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SubresourceWebBundleMetadataReceivedHandler func(ev SubresourceWebBundleMetadataReceivedEvent)
+
+// EventMethod is Network.subresourceWebBundleMetadataReceived
+func (SubresourceWebBundleMetadataReceivedEvent) EventMethod() string {
+	return "Network.subresourceWebBundleMetadataReceived"
+}
+
+// OnSubresourceWebBundleMetadataReceived calls handler for each Network.subresourceWebBundleMetadataReceived event
+func (c Client) OnSubresourceWebBundleMetadataReceived(handler SubresourceWebBundleMetadataReceivedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.subresourceWebBundleMetadataReceived", func(ev interface{}) {
+		handler(ev.(SubresourceWebBundleMetadataReceivedEvent))
+	})
+}
+
+// Fired once when parsing the .wbn file has failed.
+//
+// Experimental: this may change or be removed in any chrome release
+type SubresourceWebBundleMetadataErrorEvent struct {
+
+	/* Request identifier. Used to match this information to another event. */
+	RequestId RequestId `json:"requestId"`
+
+	/* Error message */
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// UnmarshalCDP reads SubresourceWebBundleMetadataErrorEvent from JSON
+func (v *SubresourceWebBundleMetadataErrorEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "requestId":
+			v.RequestId = RequestId(d.String())
+		case "errorMessage":
+			v.ErrorMessage = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SubresourceWebBundleMetadataErrorEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SubresourceWebBundleMetadataErrorHandler func(ev SubresourceWebBundleMetadataErrorEvent)
+
+// EventMethod is Network.subresourceWebBundleMetadataError
+func (SubresourceWebBundleMetadataErrorEvent) EventMethod() string {
+	return "Network.subresourceWebBundleMetadataError"
+}
+
+// OnSubresourceWebBundleMetadataError calls handler for each Network.subresourceWebBundleMetadataError event
+func (c Client) OnSubresourceWebBundleMetadataError(handler SubresourceWebBundleMetadataErrorHandler) (unsubscribe func()) {
+	return c.caller.On("Network.subresourceWebBundleMetadataError", func(ev interface{}) {
+		handler(ev.(SubresourceWebBundleMetadataErrorEvent))
+	})
+}
+
+// Fired when handling requests for resources within a .wbn file.
+// Note: this will only be fired for resources that are requested by the webpage.
+//
+// Experimental: this may change or be removed in any chrome release
+type SubresourceWebBundleInnerResponseParsedEvent struct {
+
+	/* Request identifier of the subresource request */
+	InnerRequestId RequestId `json:"innerRequestId"`
+
+	/* URL of the subresource resource. */
+	InnerRequestURL string `json:"innerRequestURL"`
+
+	/* Bundle request identifier. Used to match this information to another event.
+	This made be absent in case when the instrumentation was enabled only
+	after webbundle was parsed. */
+	BundleRequestId *RequestId `json:"bundleRequestId,omitempty"`
+}
+
+// UnmarshalCDP reads SubresourceWebBundleInnerResponseParsedEvent from JSON
+func (v *SubresourceWebBundleInnerResponseParsedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "innerRequestId":
+			v.InnerRequestId = RequestId(d.String())
+		case "innerRequestURL":
+			v.InnerRequestURL = d.String()
+		case "bundleRequestId":
+			if d.Null() {
+				v.BundleRequestId = nil
+			} else {
+				v.BundleRequestId = new(RequestId)
+				*v.BundleRequestId = RequestId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SubresourceWebBundleInnerResponseParsedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SubresourceWebBundleInnerResponseParsedHandler func(ev SubresourceWebBundleInnerResponseParsedEvent)
+
+// EventMethod is Network.subresourceWebBundleInnerResponseParsed
+func (SubresourceWebBundleInnerResponseParsedEvent) EventMethod() string {
+	return "Network.subresourceWebBundleInnerResponseParsed"
+}
+
+// OnSubresourceWebBundleInnerResponseParsed calls handler for each Network.subresourceWebBundleInnerResponseParsed event
+func (c Client) OnSubresourceWebBundleInnerResponseParsed(handler SubresourceWebBundleInnerResponseParsedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.subresourceWebBundleInnerResponseParsed", func(ev interface{}) {
+		handler(ev.(SubresourceWebBundleInnerResponseParsedEvent))
+	})
+}
+
+// Fired when request for resources within a .wbn file failed.
+//
+// Experimental: this may change or be removed in any chrome release
+type SubresourceWebBundleInnerResponseErrorEvent struct {
+
+	/* Request identifier of the subresource request */
+	InnerRequestId RequestId `json:"innerRequestId"`
+
+	/* URL of the subresource resource. */
+	InnerRequestURL string `json:"innerRequestURL"`
+
+	/* Error message */
+	ErrorMessage string `json:"errorMessage"`
+
+	/* Bundle request identifier. Used to match this information to another event.
+	This made be absent in case when the instrumentation was enabled only
+	after webbundle was parsed. */
+	BundleRequestId *RequestId `json:"bundleRequestId,omitempty"`
+}
+
+// UnmarshalCDP reads SubresourceWebBundleInnerResponseErrorEvent from JSON
+func (v *SubresourceWebBundleInnerResponseErrorEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "innerRequestId":
+			v.InnerRequestId = RequestId(d.String())
+		case "innerRequestURL":
+			v.InnerRequestURL = d.String()
+		case "errorMessage":
+			v.ErrorMessage = d.String()
+		case "bundleRequestId":
+			if d.Null() {
+				v.BundleRequestId = nil
+			} else {
+				v.BundleRequestId = new(RequestId)
+				*v.BundleRequestId = RequestId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SubresourceWebBundleInnerResponseErrorEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SubresourceWebBundleInnerResponseErrorHandler func(ev SubresourceWebBundleInnerResponseErrorEvent)
+
+// EventMethod is Network.subresourceWebBundleInnerResponseError
+func (SubresourceWebBundleInnerResponseErrorEvent) EventMethod() string {
+	return "Network.subresourceWebBundleInnerResponseError"
+}
+
+// OnSubresourceWebBundleInnerResponseError calls handler for each Network.subresourceWebBundleInnerResponseError event
+func (c Client) OnSubresourceWebBundleInnerResponseError(handler SubresourceWebBundleInnerResponseErrorHandler) (unsubscribe func()) {
+	return c.caller.On("Network.subresourceWebBundleInnerResponseError", func(ev interface{}) {
+		handler(ev.(SubresourceWebBundleInnerResponseErrorEvent))
+	})
+}
+
+// Is sent whenever a new report is added.
+// And after 'enableReportingApi' for all existing reports.
+//
+// Experimental: this may change or be removed in any chrome release
+type ReportingApiReportAddedEvent struct {
+	Report ReportingApiReport `json:"report"`
+}
+
+// UnmarshalCDP reads ReportingApiReportAddedEvent from JSON
+func (v *ReportingApiReportAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "report":
+			v.Report.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReportingApiReportAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ReportingApiReportAddedHandler func(ev ReportingApiReportAddedEvent)
+
+// EventMethod is Network.reportingApiReportAdded
+func (ReportingApiReportAddedEvent) EventMethod() string {
+	return "Network.reportingApiReportAdded"
+}
+
+// OnReportingApiReportAdded calls handler for each Network.reportingApiReportAdded event
+func (c Client) OnReportingApiReportAdded(handler ReportingApiReportAddedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.reportingApiReportAdded", func(ev interface{}) {
+		handler(ev.(ReportingApiReportAddedEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ReportingApiReportUpdatedEvent struct {
+	Report ReportingApiReport `json:"report"`
+}
+
+// UnmarshalCDP reads ReportingApiReportUpdatedEvent from JSON
+func (v *ReportingApiReportUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "report":
+			v.Report.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReportingApiReportUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ReportingApiReportUpdatedHandler func(ev ReportingApiReportUpdatedEvent)
+
+// EventMethod is Network.reportingApiReportUpdated
+func (ReportingApiReportUpdatedEvent) EventMethod() string {
+	return "Network.reportingApiReportUpdated"
+}
+
+// OnReportingApiReportUpdated calls handler for each Network.reportingApiReportUpdated event
+func (c Client) OnReportingApiReportUpdated(handler ReportingApiReportUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Network.reportingApiReportUpdated", func(ev interface{}) {
+		handler(ev.(ReportingApiReportUpdatedEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ReportingApiEndpointsChangedForOriginEvent struct {
+
+	/* Origin of the document(s) which configured the endpoints. */
+	Origin string `json:"origin"`
+
+	Endpoints []ReportingApiEndpoint `json:"endpoints"`
+}
+
+// UnmarshalCDP reads ReportingApiEndpointsChangedForOriginEvent from JSON
+func (v *ReportingApiEndpointsChangedForOriginEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "endpoints":
+			if d.Array() {
+				v.Endpoints = make([]ReportingApiEndpoint, 0)
+				for d.More() {
+					var x0 ReportingApiEndpoint
+					x0.UnmarshalCDP(d)
+					v.Endpoints = append(v.Endpoints, x0)
+				}
+			} else {
+				v.Endpoints = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReportingApiEndpointsChangedForOriginEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ReportingApiEndpointsChangedForOriginHandler func(ev ReportingApiEndpointsChangedForOriginEvent)
+
+// EventMethod is Network.reportingApiEndpointsChangedForOrigin
+func (ReportingApiEndpointsChangedForOriginEvent) EventMethod() string {
+	return "Network.reportingApiEndpointsChangedForOrigin"
+}
+
+// OnReportingApiEndpointsChangedForOrigin calls handler for each Network.reportingApiEndpointsChangedForOrigin event
+func (c Client) OnReportingApiEndpointsChangedForOrigin(handler ReportingApiEndpointsChangedForOriginHandler) (unsubscribe func()) {
+	return c.caller.On("Network.reportingApiEndpointsChangedForOrigin", func(ev interface{}) {
+		handler(ev.(ReportingApiEndpointsChangedForOriginEvent))
+	})
+}
+
+func init() {
+	decoders["Network.requestIntercepted"] = cdp.Decode[RequestInterceptedEvent]
+	decoders["Network.resourceChangedPriority"] = cdp.Decode[ResourceChangedPriorityEvent]
+	decoders["Network.signedExchangeReceived"] = cdp.Decode[SignedExchangeReceivedEvent]
+	decoders["Network.directTCPSocketCreated"] = cdp.Decode[DirectTCPSocketCreatedEvent]
+	decoders["Network.directTCPSocketOpened"] = cdp.Decode[DirectTCPSocketOpenedEvent]
+	decoders["Network.directTCPSocketAborted"] = cdp.Decode[DirectTCPSocketAbortedEvent]
+	decoders["Network.directTCPSocketClosed"] = cdp.Decode[DirectTCPSocketClosedEvent]
+	decoders["Network.directTCPSocketChunkSent"] = cdp.Decode[DirectTCPSocketChunkSentEvent]
+	decoders["Network.directTCPSocketChunkReceived"] = cdp.Decode[DirectTCPSocketChunkReceivedEvent]
+	decoders["Network.directUDPSocketCreated"] = cdp.Decode[DirectUDPSocketCreatedEvent]
+	decoders["Network.directUDPSocketOpened"] = cdp.Decode[DirectUDPSocketOpenedEvent]
+	decoders["Network.directUDPSocketAborted"] = cdp.Decode[DirectUDPSocketAbortedEvent]
+	decoders["Network.directUDPSocketClosed"] = cdp.Decode[DirectUDPSocketClosedEvent]
+	decoders["Network.directUDPSocketChunkSent"] = cdp.Decode[DirectUDPSocketChunkSentEvent]
+	decoders["Network.directUDPSocketChunkReceived"] = cdp.Decode[DirectUDPSocketChunkReceivedEvent]
+	decoders["Network.requestWillBeSentExtraInfo"] = cdp.Decode[RequestWillBeSentExtraInfoEvent]
+	decoders["Network.responseReceivedExtraInfo"] = cdp.Decode[ResponseReceivedExtraInfoEvent]
+	decoders["Network.responseReceivedEarlyHints"] = cdp.Decode[ResponseReceivedEarlyHintsEvent]
+	decoders["Network.trustTokenOperationDone"] = cdp.Decode[TrustTokenOperationDoneEvent]
+	decoders["Network.policyUpdated"] = cdp.Decode[PolicyUpdatedEvent]
+	decoders["Network.subresourceWebBundleMetadataReceived"] = cdp.Decode[SubresourceWebBundleMetadataReceivedEvent]
+	decoders["Network.subresourceWebBundleMetadataError"] = cdp.Decode[SubresourceWebBundleMetadataErrorEvent]
+	decoders["Network.subresourceWebBundleInnerResponseParsed"] = cdp.Decode[SubresourceWebBundleInnerResponseParsedEvent]
+	decoders["Network.subresourceWebBundleInnerResponseError"] = cdp.Decode[SubresourceWebBundleInnerResponseErrorEvent]
+	decoders["Network.reportingApiReportAdded"] = cdp.Decode[ReportingApiReportAddedEvent]
+	decoders["Network.reportingApiReportUpdated"] = cdp.Decode[ReportingApiReportUpdatedEvent]
+	decoders["Network.reportingApiEndpointsChangedForOrigin"] = cdp.Decode[ReportingApiEndpointsChangedForOriginEvent]
+}
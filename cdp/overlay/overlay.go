@@ -0,0 +1,3577 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package overlay is the Overlay domain of the chrome devtools protocol
+//
+// This domain provides various functionality related to drawing atop the inspected page.
+//
+// Experimental: this may change or be removed in any chrome release
+package overlay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Overlay commands and listens for Overlay events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Configuration data for drawing the source order of an elements children.
+type SourceOrderConfig struct {
+	/* the color to outline the given element in. */
+	ParentOutlineColor cdp.DOMRGBA `json:"parentOutlineColor"`
+	/* the color to outline the child elements in. */
+	ChildOutlineColor cdp.DOMRGBA `json:"childOutlineColor"`
+}
+
+// MarshalCDP writes SourceOrderConfig as JSON
+func (v *SourceOrderConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("parentOutlineColor")
+	v.ParentOutlineColor.MarshalCDP(e)
+	e.Key("childOutlineColor")
+	v.ChildOutlineColor.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SourceOrderConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SourceOrderConfig from JSON
+func (v *SourceOrderConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "parentOutlineColor":
+			v.ParentOutlineColor.UnmarshalCDP(d)
+		case "childOutlineColor":
+			v.ChildOutlineColor.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SourceOrderConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Configuration data for the highlighting of Grid elements.
+type GridHighlightConfig struct {
+	/* Whether the extension lines from grid cells to the rulers should be shown (default: false). */
+	ShowGridExtensionLines *bool `json:"showGridExtensionLines,omitempty"`
+	/* Show Positive line number labels (default: false). */
+	ShowPositiveLineNumbers *bool `json:"showPositiveLineNumbers,omitempty"`
+	/* Show Negative line number labels (default: false). */
+	ShowNegativeLineNumbers *bool `json:"showNegativeLineNumbers,omitempty"`
+	/* Show area name labels (default: false). */
+	ShowAreaNames *bool `json:"showAreaNames,omitempty"`
+	/* Show line name labels (default: false). */
+	ShowLineNames *bool `json:"showLineNames,omitempty"`
+	/* Show track size labels (default: false). */
+	ShowTrackSizes *bool `json:"showTrackSizes,omitempty"`
+	/* The grid container border highlight color (default: transparent). */
+	GridBorderColor *cdp.DOMRGBA `json:"gridBorderColor,omitempty"`
+	/* The cell border color (default: transparent). Deprecated, please use rowLineColor and columnLineColor instead.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	CellBorderColor *cdp.DOMRGBA `json:"cellBorderColor,omitempty"`
+	/* The row line color (default: transparent). */
+	RowLineColor *cdp.DOMRGBA `json:"rowLineColor,omitempty"`
+	/* The column line color (default: transparent). */
+	ColumnLineColor *cdp.DOMRGBA `json:"columnLineColor,omitempty"`
+	/* Whether the grid border is dashed (default: false). */
+	GridBorderDash *bool `json:"gridBorderDash,omitempty"`
+	/* Whether the cell border is dashed (default: false). Deprecated, please us rowLineDash and columnLineDash instead.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	CellBorderDash *bool `json:"cellBorderDash,omitempty"`
+	/* Whether row lines are dashed (default: false). */
+	RowLineDash *bool `json:"rowLineDash,omitempty"`
+	/* Whether column lines are dashed (default: false). */
+	ColumnLineDash *bool `json:"columnLineDash,omitempty"`
+	/* The row gap highlight fill color (default: transparent). */
+	RowGapColor *cdp.DOMRGBA `json:"rowGapColor,omitempty"`
+	/* The row gap hatching fill color (default: transparent). */
+	RowHatchColor *cdp.DOMRGBA `json:"rowHatchColor,omitempty"`
+	/* The column gap highlight fill color (default: transparent). */
+	ColumnGapColor *cdp.DOMRGBA `json:"columnGapColor,omitempty"`
+	/* The column gap hatching fill color (default: transparent). */
+	ColumnHatchColor *cdp.DOMRGBA `json:"columnHatchColor,omitempty"`
+	/* The named grid areas border color (Default: transparent). */
+	AreaBorderColor *cdp.DOMRGBA `json:"areaBorderColor,omitempty"`
+	/* The grid container background color (Default: transparent). */
+	GridBackgroundColor *cdp.DOMRGBA `json:"gridBackgroundColor,omitempty"`
+}
+
+// MarshalCDP writes GridHighlightConfig as JSON
+func (v *GridHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ShowGridExtensionLines != nil {
+		e.Key("showGridExtensionLines")
+		e.Bool(*v.ShowGridExtensionLines)
+	}
+	if v.ShowPositiveLineNumbers != nil {
+		e.Key("showPositiveLineNumbers")
+		e.Bool(*v.ShowPositiveLineNumbers)
+	}
+	if v.ShowNegativeLineNumbers != nil {
+		e.Key("showNegativeLineNumbers")
+		e.Bool(*v.ShowNegativeLineNumbers)
+	}
+	if v.ShowAreaNames != nil {
+		e.Key("showAreaNames")
+		e.Bool(*v.ShowAreaNames)
+	}
+	if v.ShowLineNames != nil {
+		e.Key("showLineNames")
+		e.Bool(*v.ShowLineNames)
+	}
+	if v.ShowTrackSizes != nil {
+		e.Key("showTrackSizes")
+		e.Bool(*v.ShowTrackSizes)
+	}
+	if v.GridBorderColor != nil {
+		e.Key("gridBorderColor")
+		(*v.GridBorderColor).MarshalCDP(e)
+	}
+	if v.CellBorderColor != nil {
+		e.Key("cellBorderColor")
+		(*v.CellBorderColor).MarshalCDP(e)
+	}
+	if v.RowLineColor != nil {
+		e.Key("rowLineColor")
+		(*v.RowLineColor).MarshalCDP(e)
+	}
+	if v.ColumnLineColor != nil {
+		e.Key("columnLineColor")
+		(*v.ColumnLineColor).MarshalCDP(e)
+	}
+	if v.GridBorderDash != nil {
+		e.Key("gridBorderDash")
+		e.Bool(*v.GridBorderDash)
+	}
+	if v.CellBorderDash != nil {
+		e.Key("cellBorderDash")
+		e.Bool(*v.CellBorderDash)
+	}
+	if v.RowLineDash != nil {
+		e.Key("rowLineDash")
+		e.Bool(*v.RowLineDash)
+	}
+	if v.ColumnLineDash != nil {
+		e.Key("columnLineDash")
+		e.Bool(*v.ColumnLineDash)
+	}
+	if v.RowGapColor != nil {
+		e.Key("rowGapColor")
+		(*v.RowGapColor).MarshalCDP(e)
+	}
+	if v.RowHatchColor != nil {
+		e.Key("rowHatchColor")
+		(*v.RowHatchColor).MarshalCDP(e)
+	}
+	if v.ColumnGapColor != nil {
+		e.Key("columnGapColor")
+		(*v.ColumnGapColor).MarshalCDP(e)
+	}
+	if v.ColumnHatchColor != nil {
+		e.Key("columnHatchColor")
+		(*v.ColumnHatchColor).MarshalCDP(e)
+	}
+	if v.AreaBorderColor != nil {
+		e.Key("areaBorderColor")
+		(*v.AreaBorderColor).MarshalCDP(e)
+	}
+	if v.GridBackgroundColor != nil {
+		e.Key("gridBackgroundColor")
+		(*v.GridBackgroundColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GridHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads GridHighlightConfig from JSON
+func (v *GridHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "showGridExtensionLines":
+			if d.Null() {
+				v.ShowGridExtensionLines = nil
+			} else {
+				v.ShowGridExtensionLines = new(bool)
+				*v.ShowGridExtensionLines = d.Bool()
+			}
+		case "showPositiveLineNumbers":
+			if d.Null() {
+				v.ShowPositiveLineNumbers = nil
+			} else {
+				v.ShowPositiveLineNumbers = new(bool)
+				*v.ShowPositiveLineNumbers = d.Bool()
+			}
+		case "showNegativeLineNumbers":
+			if d.Null() {
+				v.ShowNegativeLineNumbers = nil
+			} else {
+				v.ShowNegativeLineNumbers = new(bool)
+				*v.ShowNegativeLineNumbers = d.Bool()
+			}
+		case "showAreaNames":
+			if d.Null() {
+				v.ShowAreaNames = nil
+			} else {
+				v.ShowAreaNames = new(bool)
+				*v.ShowAreaNames = d.Bool()
+			}
+		case "showLineNames":
+			if d.Null() {
+				v.ShowLineNames = nil
+			} else {
+				v.ShowLineNames = new(bool)
+				*v.ShowLineNames = d.Bool()
+			}
+		case "showTrackSizes":
+			if d.Null() {
+				v.ShowTrackSizes = nil
+			} else {
+				v.ShowTrackSizes = new(bool)
+				*v.ShowTrackSizes = d.Bool()
+			}
+		case "gridBorderColor":
+			if d.Null() {
+				v.GridBorderColor = nil
+			} else {
+				v.GridBorderColor = new(cdp.DOMRGBA)
+				(*v.GridBorderColor).UnmarshalCDP(d)
+			}
+		case "cellBorderColor":
+			if d.Null() {
+				v.CellBorderColor = nil
+			} else {
+				v.CellBorderColor = new(cdp.DOMRGBA)
+				(*v.CellBorderColor).UnmarshalCDP(d)
+			}
+		case "rowLineColor":
+			if d.Null() {
+				v.RowLineColor = nil
+			} else {
+				v.RowLineColor = new(cdp.DOMRGBA)
+				(*v.RowLineColor).UnmarshalCDP(d)
+			}
+		case "columnLineColor":
+			if d.Null() {
+				v.ColumnLineColor = nil
+			} else {
+				v.ColumnLineColor = new(cdp.DOMRGBA)
+				(*v.ColumnLineColor).UnmarshalCDP(d)
+			}
+		case "gridBorderDash":
+			if d.Null() {
+				v.GridBorderDash = nil
+			} else {
+				v.GridBorderDash = new(bool)
+				*v.GridBorderDash = d.Bool()
+			}
+		case "cellBorderDash":
+			if d.Null() {
+				v.CellBorderDash = nil
+			} else {
+				v.CellBorderDash = new(bool)
+				*v.CellBorderDash = d.Bool()
+			}
+		case "rowLineDash":
+			if d.Null() {
+				v.RowLineDash = nil
+			} else {
+				v.RowLineDash = new(bool)
+				*v.RowLineDash = d.Bool()
+			}
+		case "columnLineDash":
+			if d.Null() {
+				v.ColumnLineDash = nil
+			} else {
+				v.ColumnLineDash = new(bool)
+				*v.ColumnLineDash = d.Bool()
+			}
+		case "rowGapColor":
+			if d.Null() {
+				v.RowGapColor = nil
+			} else {
+				v.RowGapColor = new(cdp.DOMRGBA)
+				(*v.RowGapColor).UnmarshalCDP(d)
+			}
+		case "rowHatchColor":
+			if d.Null() {
+				v.RowHatchColor = nil
+			} else {
+				v.RowHatchColor = new(cdp.DOMRGBA)
+				(*v.RowHatchColor).UnmarshalCDP(d)
+			}
+		case "columnGapColor":
+			if d.Null() {
+				v.ColumnGapColor = nil
+			} else {
+				v.ColumnGapColor = new(cdp.DOMRGBA)
+				(*v.ColumnGapColor).UnmarshalCDP(d)
+			}
+		case "columnHatchColor":
+			if d.Null() {
+				v.ColumnHatchColor = nil
+			} else {
+				v.ColumnHatchColor = new(cdp.DOMRGBA)
+				(*v.ColumnHatchColor).UnmarshalCDP(d)
+			}
+		case "areaBorderColor":
+			if d.Null() {
+				v.AreaBorderColor = nil
+			} else {
+				v.AreaBorderColor = new(cdp.DOMRGBA)
+				(*v.AreaBorderColor).UnmarshalCDP(d)
+			}
+		case "gridBackgroundColor":
+			if d.Null() {
+				v.GridBackgroundColor = nil
+			} else {
+				v.GridBackgroundColor = new(cdp.DOMRGBA)
+				(*v.GridBackgroundColor).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GridHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Configuration data for the highlighting of Flex container elements.
+type FlexContainerHighlightConfig struct {
+	/* The style of the container border */
+	ContainerBorder *LineStyle `json:"containerBorder,omitempty"`
+	/* The style of the separator between lines */
+	LineSeparator *LineStyle `json:"lineSeparator,omitempty"`
+	/* The style of the separator between items */
+	ItemSeparator *LineStyle `json:"itemSeparator,omitempty"`
+	/* Style of content-distribution space on the main axis (justify-content). */
+	MainDistributedSpace *BoxStyle `json:"mainDistributedSpace,omitempty"`
+	/* Style of content-distribution space on the cross axis (align-content). */
+	CrossDistributedSpace *BoxStyle `json:"crossDistributedSpace,omitempty"`
+	/* Style of empty space caused by row gaps (gap/row-gap). */
+	RowGapSpace *BoxStyle `json:"rowGapSpace,omitempty"`
+	/* Style of empty space caused by columns gaps (gap/column-gap). */
+	ColumnGapSpace *BoxStyle `json:"columnGapSpace,omitempty"`
+	/* Style of the self-alignment line (align-items). */
+	CrossAlignment *LineStyle `json:"crossAlignment,omitempty"`
+}
+
+// MarshalCDP writes FlexContainerHighlightConfig as JSON
+func (v *FlexContainerHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ContainerBorder != nil {
+		e.Key("containerBorder")
+		(*v.ContainerBorder).MarshalCDP(e)
+	}
+	if v.LineSeparator != nil {
+		e.Key("lineSeparator")
+		(*v.LineSeparator).MarshalCDP(e)
+	}
+	if v.ItemSeparator != nil {
+		e.Key("itemSeparator")
+		(*v.ItemSeparator).MarshalCDP(e)
+	}
+	if v.MainDistributedSpace != nil {
+		e.Key("mainDistributedSpace")
+		(*v.MainDistributedSpace).MarshalCDP(e)
+	}
+	if v.CrossDistributedSpace != nil {
+		e.Key("crossDistributedSpace")
+		(*v.CrossDistributedSpace).MarshalCDP(e)
+	}
+	if v.RowGapSpace != nil {
+		e.Key("rowGapSpace")
+		(*v.RowGapSpace).MarshalCDP(e)
+	}
+	if v.ColumnGapSpace != nil {
+		e.Key("columnGapSpace")
+		(*v.ColumnGapSpace).MarshalCDP(e)
+	}
+	if v.CrossAlignment != nil {
+		e.Key("crossAlignment")
+		(*v.CrossAlignment).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FlexContainerHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FlexContainerHighlightConfig from JSON
+func (v *FlexContainerHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "containerBorder":
+			if d.Null() {
+				v.ContainerBorder = nil
+			} else {
+				v.ContainerBorder = new(LineStyle)
+				(*v.ContainerBorder).UnmarshalCDP(d)
+			}
+		case "lineSeparator":
+			if d.Null() {
+				v.LineSeparator = nil
+			} else {
+				v.LineSeparator = new(LineStyle)
+				(*v.LineSeparator).UnmarshalCDP(d)
+			}
+		case "itemSeparator":
+			if d.Null() {
+				v.ItemSeparator = nil
+			} else {
+				v.ItemSeparator = new(LineStyle)
+				(*v.ItemSeparator).UnmarshalCDP(d)
+			}
+		case "mainDistributedSpace":
+			if d.Null() {
+				v.MainDistributedSpace = nil
+			} else {
+				v.MainDistributedSpace = new(BoxStyle)
+				(*v.MainDistributedSpace).UnmarshalCDP(d)
+			}
+		case "crossDistributedSpace":
+			if d.Null() {
+				v.CrossDistributedSpace = nil
+			} else {
+				v.CrossDistributedSpace = new(BoxStyle)
+				(*v.CrossDistributedSpace).UnmarshalCDP(d)
+			}
+		case "rowGapSpace":
+			if d.Null() {
+				v.RowGapSpace = nil
+			} else {
+				v.RowGapSpace = new(BoxStyle)
+				(*v.RowGapSpace).UnmarshalCDP(d)
+			}
+		case "columnGapSpace":
+			if d.Null() {
+				v.ColumnGapSpace = nil
+			} else {
+				v.ColumnGapSpace = new(BoxStyle)
+				(*v.ColumnGapSpace).UnmarshalCDP(d)
+			}
+		case "crossAlignment":
+			if d.Null() {
+				v.CrossAlignment = nil
+			} else {
+				v.CrossAlignment = new(LineStyle)
+				(*v.CrossAlignment).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FlexContainerHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Configuration data for the highlighting of Flex item elements.
+type FlexItemHighlightConfig struct {
+	/* Style of the box representing the item's base size */
+	BaseSizeBox *BoxStyle `json:"baseSizeBox,omitempty"`
+	/* Style of the border around the box representing the item's base size */
+	BaseSizeBorder *LineStyle `json:"baseSizeBorder,omitempty"`
+	/* Style of the arrow representing if the item grew or shrank */
+	FlexibilityArrow *LineStyle `json:"flexibilityArrow,omitempty"`
+}
+
+// MarshalCDP writes FlexItemHighlightConfig as JSON
+func (v *FlexItemHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BaseSizeBox != nil {
+		e.Key("baseSizeBox")
+		(*v.BaseSizeBox).MarshalCDP(e)
+	}
+	if v.BaseSizeBorder != nil {
+		e.Key("baseSizeBorder")
+		(*v.BaseSizeBorder).MarshalCDP(e)
+	}
+	if v.FlexibilityArrow != nil {
+		e.Key("flexibilityArrow")
+		(*v.FlexibilityArrow).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FlexItemHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FlexItemHighlightConfig from JSON
+func (v *FlexItemHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "baseSizeBox":
+			if d.Null() {
+				v.BaseSizeBox = nil
+			} else {
+				v.BaseSizeBox = new(BoxStyle)
+				(*v.BaseSizeBox).UnmarshalCDP(d)
+			}
+		case "baseSizeBorder":
+			if d.Null() {
+				v.BaseSizeBorder = nil
+			} else {
+				v.BaseSizeBorder = new(LineStyle)
+				(*v.BaseSizeBorder).UnmarshalCDP(d)
+			}
+		case "flexibilityArrow":
+			if d.Null() {
+				v.FlexibilityArrow = nil
+			} else {
+				v.FlexibilityArrow = new(LineStyle)
+				(*v.FlexibilityArrow).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FlexItemHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Style information for drawing a line.
+type LineStyle struct {
+	/* The color of the line (default: transparent) */
+	Color *cdp.DOMRGBA `json:"color,omitempty"`
+	/* The line pattern (default: solid) */
+	Pattern *LineStylePattern `json:"pattern,omitempty"`
+}
+
+// MarshalCDP writes LineStyle as JSON
+func (v *LineStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Color != nil {
+		e.Key("color")
+		(*v.Color).MarshalCDP(e)
+	}
+	if v.Pattern != nil {
+		e.Key("pattern")
+		e.String(string(*v.Pattern))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LineStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LineStyle from JSON
+func (v *LineStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "color":
+			if d.Null() {
+				v.Color = nil
+			} else {
+				v.Color = new(cdp.DOMRGBA)
+				(*v.Color).UnmarshalCDP(d)
+			}
+		case "pattern":
+			if d.Null() {
+				v.Pattern = nil
+			} else {
+				v.Pattern = new(LineStylePattern)
+				*v.Pattern = LineStylePattern(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LineStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LineStylePattern string
+
+// LineStylePattern values
+const (
+	LineStylePatternDashed LineStylePattern = "dashed"
+	LineStylePatternDotted LineStylePattern = "dotted"
+)
+
+// Values gives every LineStylePattern
+func (LineStylePattern) Values() []LineStylePattern {
+	return []LineStylePattern{
+		LineStylePatternDashed,
+		LineStylePatternDotted,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v LineStylePattern) Valid() bool {
+	switch v {
+	case LineStylePatternDashed, LineStylePatternDotted:
+		return true
+	}
+	return false
+}
+
+// Style information for drawing a box.
+type BoxStyle struct {
+	/* The background color for the box (default: transparent) */
+	FillColor *cdp.DOMRGBA `json:"fillColor,omitempty"`
+	/* The hatching color for the box (default: transparent) */
+	HatchColor *cdp.DOMRGBA `json:"hatchColor,omitempty"`
+}
+
+// MarshalCDP writes BoxStyle as JSON
+func (v *BoxStyle) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.FillColor != nil {
+		e.Key("fillColor")
+		(*v.FillColor).MarshalCDP(e)
+	}
+	if v.HatchColor != nil {
+		e.Key("hatchColor")
+		(*v.HatchColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BoxStyle) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BoxStyle from JSON
+func (v *BoxStyle) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "fillColor":
+			if d.Null() {
+				v.FillColor = nil
+			} else {
+				v.FillColor = new(cdp.DOMRGBA)
+				(*v.FillColor).UnmarshalCDP(d)
+			}
+		case "hatchColor":
+			if d.Null() {
+				v.HatchColor = nil
+			} else {
+				v.HatchColor = new(cdp.DOMRGBA)
+				(*v.HatchColor).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BoxStyle) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContrastAlgorithm string
+
+// ContrastAlgorithm values
+const (
+	ContrastAlgorithmAa   ContrastAlgorithm = "aa"
+	ContrastAlgorithmAaa  ContrastAlgorithm = "aaa"
+	ContrastAlgorithmApca ContrastAlgorithm = "apca"
+)
+
+// Values gives every ContrastAlgorithm
+func (ContrastAlgorithm) Values() []ContrastAlgorithm {
+	return []ContrastAlgorithm{
+		ContrastAlgorithmAa,
+		ContrastAlgorithmAaa,
+		ContrastAlgorithmApca,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContrastAlgorithm) Valid() bool {
+	switch v {
+	case ContrastAlgorithmAa, ContrastAlgorithmAaa, ContrastAlgorithmApca:
+		return true
+	}
+	return false
+}
+
+// Configuration data for the highlighting of page elements.
+type HighlightConfig struct {
+	/* Whether the node info tooltip should be shown (default: false). */
+	ShowInfo *bool `json:"showInfo,omitempty"`
+	/* Whether the node styles in the tooltip (default: false). */
+	ShowStyles *bool `json:"showStyles,omitempty"`
+	/* Whether the rulers should be shown (default: false). */
+	ShowRulers *bool `json:"showRulers,omitempty"`
+	/* Whether the a11y info should be shown (default: true). */
+	ShowAccessibilityInfo *bool `json:"showAccessibilityInfo,omitempty"`
+	/* Whether the extension lines from node to the rulers should be shown (default: false). */
+	ShowExtensionLines *bool `json:"showExtensionLines,omitempty"`
+	/* The content box highlight fill color (default: transparent). */
+	ContentColor *cdp.DOMRGBA `json:"contentColor,omitempty"`
+	/* The padding highlight fill color (default: transparent). */
+	PaddingColor *cdp.DOMRGBA `json:"paddingColor,omitempty"`
+	/* The border highlight fill color (default: transparent). */
+	BorderColor *cdp.DOMRGBA `json:"borderColor,omitempty"`
+	/* The margin highlight fill color (default: transparent). */
+	MarginColor *cdp.DOMRGBA `json:"marginColor,omitempty"`
+	/* The event target element highlight fill color (default: transparent). */
+	EventTargetColor *cdp.DOMRGBA `json:"eventTargetColor,omitempty"`
+	/* The shape outside fill color (default: transparent). */
+	ShapeColor *cdp.DOMRGBA `json:"shapeColor,omitempty"`
+	/* The shape margin fill color (default: transparent). */
+	ShapeMarginColor *cdp.DOMRGBA `json:"shapeMarginColor,omitempty"`
+	/* The grid layout color (default: transparent). */
+	CssGridColor *cdp.DOMRGBA `json:"cssGridColor,omitempty"`
+	/* The color format used to format color styles (default: hex). */
+	ColorFormat *ColorFormat `json:"colorFormat,omitempty"`
+	/* The grid layout highlight configuration (default: all transparent). */
+	GridHighlightConfig *GridHighlightConfig `json:"gridHighlightConfig,omitempty"`
+	/* The flex container highlight configuration (default: all transparent). */
+	FlexContainerHighlightConfig *FlexContainerHighlightConfig `json:"flexContainerHighlightConfig,omitempty"`
+	/* The flex item highlight configuration (default: all transparent). */
+	FlexItemHighlightConfig *FlexItemHighlightConfig `json:"flexItemHighlightConfig,omitempty"`
+	/* The contrast algorithm to use for the contrast ratio (default: aa). */
+	ContrastAlgorithm *ContrastAlgorithm `json:"contrastAlgorithm,omitempty"`
+	/* The container query container highlight configuration (default: all transparent). */
+	ContainerQueryContainerHighlightConfig *ContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig,omitempty"`
+}
+
+// MarshalCDP writes HighlightConfig as JSON
+func (v *HighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ShowInfo != nil {
+		e.Key("showInfo")
+		e.Bool(*v.ShowInfo)
+	}
+	if v.ShowStyles != nil {
+		e.Key("showStyles")
+		e.Bool(*v.ShowStyles)
+	}
+	if v.ShowRulers != nil {
+		e.Key("showRulers")
+		e.Bool(*v.ShowRulers)
+	}
+	if v.ShowAccessibilityInfo != nil {
+		e.Key("showAccessibilityInfo")
+		e.Bool(*v.ShowAccessibilityInfo)
+	}
+	if v.ShowExtensionLines != nil {
+		e.Key("showExtensionLines")
+		e.Bool(*v.ShowExtensionLines)
+	}
+	if v.ContentColor != nil {
+		e.Key("contentColor")
+		(*v.ContentColor).MarshalCDP(e)
+	}
+	if v.PaddingColor != nil {
+		e.Key("paddingColor")
+		(*v.PaddingColor).MarshalCDP(e)
+	}
+	if v.BorderColor != nil {
+		e.Key("borderColor")
+		(*v.BorderColor).MarshalCDP(e)
+	}
+	if v.MarginColor != nil {
+		e.Key("marginColor")
+		(*v.MarginColor).MarshalCDP(e)
+	}
+	if v.EventTargetColor != nil {
+		e.Key("eventTargetColor")
+		(*v.EventTargetColor).MarshalCDP(e)
+	}
+	if v.ShapeColor != nil {
+		e.Key("shapeColor")
+		(*v.ShapeColor).MarshalCDP(e)
+	}
+	if v.ShapeMarginColor != nil {
+		e.Key("shapeMarginColor")
+		(*v.ShapeMarginColor).MarshalCDP(e)
+	}
+	if v.CssGridColor != nil {
+		e.Key("cssGridColor")
+		(*v.CssGridColor).MarshalCDP(e)
+	}
+	if v.ColorFormat != nil {
+		e.Key("colorFormat")
+		e.String(string(*v.ColorFormat))
+	}
+	if v.GridHighlightConfig != nil {
+		e.Key("gridHighlightConfig")
+		(*v.GridHighlightConfig).MarshalCDP(e)
+	}
+	if v.FlexContainerHighlightConfig != nil {
+		e.Key("flexContainerHighlightConfig")
+		(*v.FlexContainerHighlightConfig).MarshalCDP(e)
+	}
+	if v.FlexItemHighlightConfig != nil {
+		e.Key("flexItemHighlightConfig")
+		(*v.FlexItemHighlightConfig).MarshalCDP(e)
+	}
+	if v.ContrastAlgorithm != nil {
+		e.Key("contrastAlgorithm")
+		e.String(string(*v.ContrastAlgorithm))
+	}
+	if v.ContainerQueryContainerHighlightConfig != nil {
+		e.Key("containerQueryContainerHighlightConfig")
+		(*v.ContainerQueryContainerHighlightConfig).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads HighlightConfig from JSON
+func (v *HighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "showInfo":
+			if d.Null() {
+				v.ShowInfo = nil
+			} else {
+				v.ShowInfo = new(bool)
+				*v.ShowInfo = d.Bool()
+			}
+		case "showStyles":
+			if d.Null() {
+				v.ShowStyles = nil
+			} else {
+				v.ShowStyles = new(bool)
+				*v.ShowStyles = d.Bool()
+			}
+		case "showRulers":
+			if d.Null() {
+				v.ShowRulers = nil
+			} else {
+				v.ShowRulers = new(bool)
+				*v.ShowRulers = d.Bool()
+			}
+		case "showAccessibilityInfo":
+			if d.Null() {
+				v.ShowAccessibilityInfo = nil
+			} else {
+				v.ShowAccessibilityInfo = new(bool)
+				*v.ShowAccessibilityInfo = d.Bool()
+			}
+		case "showExtensionLines":
+			if d.Null() {
+				v.ShowExtensionLines = nil
+			} else {
+				v.ShowExtensionLines = new(bool)
+				*v.ShowExtensionLines = d.Bool()
+			}
+		case "contentColor":
+			if d.Null() {
+				v.ContentColor = nil
+			} else {
+				v.ContentColor = new(cdp.DOMRGBA)
+				(*v.ContentColor).UnmarshalCDP(d)
+			}
+		case "paddingColor":
+			if d.Null() {
+				v.PaddingColor = nil
+			} else {
+				v.PaddingColor = new(cdp.DOMRGBA)
+				(*v.PaddingColor).UnmarshalCDP(d)
+			}
+		case "borderColor":
+			if d.Null() {
+				v.BorderColor = nil
+			} else {
+				v.BorderColor = new(cdp.DOMRGBA)
+				(*v.BorderColor).UnmarshalCDP(d)
+			}
+		case "marginColor":
+			if d.Null() {
+				v.MarginColor = nil
+			} else {
+				v.MarginColor = new(cdp.DOMRGBA)
+				(*v.MarginColor).UnmarshalCDP(d)
+			}
+		case "eventTargetColor":
+			if d.Null() {
+				v.EventTargetColor = nil
+			} else {
+				v.EventTargetColor = new(cdp.DOMRGBA)
+				(*v.EventTargetColor).UnmarshalCDP(d)
+			}
+		case "shapeColor":
+			if d.Null() {
+				v.ShapeColor = nil
+			} else {
+				v.ShapeColor = new(cdp.DOMRGBA)
+				(*v.ShapeColor).UnmarshalCDP(d)
+			}
+		case "shapeMarginColor":
+			if d.Null() {
+				v.ShapeMarginColor = nil
+			} else {
+				v.ShapeMarginColor = new(cdp.DOMRGBA)
+				(*v.ShapeMarginColor).UnmarshalCDP(d)
+			}
+		case "cssGridColor":
+			if d.Null() {
+				v.CssGridColor = nil
+			} else {
+				v.CssGridColor = new(cdp.DOMRGBA)
+				(*v.CssGridColor).UnmarshalCDP(d)
+			}
+		case "colorFormat":
+			if d.Null() {
+				v.ColorFormat = nil
+			} else {
+				v.ColorFormat = new(ColorFormat)
+				*v.ColorFormat = ColorFormat(d.String())
+			}
+		case "gridHighlightConfig":
+			if d.Null() {
+				v.GridHighlightConfig = nil
+			} else {
+				v.GridHighlightConfig = new(GridHighlightConfig)
+				(*v.GridHighlightConfig).UnmarshalCDP(d)
+			}
+		case "flexContainerHighlightConfig":
+			if d.Null() {
+				v.FlexContainerHighlightConfig = nil
+			} else {
+				v.FlexContainerHighlightConfig = new(FlexContainerHighlightConfig)
+				(*v.FlexContainerHighlightConfig).UnmarshalCDP(d)
+			}
+		case "flexItemHighlightConfig":
+			if d.Null() {
+				v.FlexItemHighlightConfig = nil
+			} else {
+				v.FlexItemHighlightConfig = new(FlexItemHighlightConfig)
+				(*v.FlexItemHighlightConfig).UnmarshalCDP(d)
+			}
+		case "contrastAlgorithm":
+			if d.Null() {
+				v.ContrastAlgorithm = nil
+			} else {
+				v.ContrastAlgorithm = new(ContrastAlgorithm)
+				*v.ContrastAlgorithm = ContrastAlgorithm(d.String())
+			}
+		case "containerQueryContainerHighlightConfig":
+			if d.Null() {
+				v.ContainerQueryContainerHighlightConfig = nil
+			} else {
+				v.ContainerQueryContainerHighlightConfig = new(ContainerQueryContainerHighlightConfig)
+				(*v.ContainerQueryContainerHighlightConfig).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ColorFormat string
+
+// ColorFormat values
+const (
+	ColorFormatRgb ColorFormat = "rgb"
+	ColorFormatHsl ColorFormat = "hsl"
+	ColorFormatHwb ColorFormat = "hwb"
+	ColorFormatHex ColorFormat = "hex"
+)
+
+// Values gives every ColorFormat
+func (ColorFormat) Values() []ColorFormat {
+	return []ColorFormat{
+		ColorFormatRgb,
+		ColorFormatHsl,
+		ColorFormatHwb,
+		ColorFormatHex,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ColorFormat) Valid() bool {
+	switch v {
+	case ColorFormatRgb, ColorFormatHsl, ColorFormatHwb, ColorFormatHex:
+		return true
+	}
+	return false
+}
+
+// Configurations for Persistent Grid Highlight
+type GridNodeHighlightConfig struct {
+	/* A descriptor for the highlight appearance. */
+	GridHighlightConfig GridHighlightConfig `json:"gridHighlightConfig"`
+	/* Identifier of the node to highlight. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GridNodeHighlightConfig as JSON
+func (v *GridNodeHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("gridHighlightConfig")
+	v.GridHighlightConfig.MarshalCDP(e)
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GridNodeHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads GridNodeHighlightConfig from JSON
+func (v *GridNodeHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "gridHighlightConfig":
+			v.GridHighlightConfig.UnmarshalCDP(d)
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GridNodeHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FlexNodeHighlightConfig struct {
+	/* A descriptor for the highlight appearance of flex containers. */
+	FlexContainerHighlightConfig FlexContainerHighlightConfig `json:"flexContainerHighlightConfig"`
+	/* Identifier of the node to highlight. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes FlexNodeHighlightConfig as JSON
+func (v *FlexNodeHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("flexContainerHighlightConfig")
+	v.FlexContainerHighlightConfig.MarshalCDP(e)
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FlexNodeHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FlexNodeHighlightConfig from JSON
+func (v *FlexNodeHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "flexContainerHighlightConfig":
+			v.FlexContainerHighlightConfig.UnmarshalCDP(d)
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FlexNodeHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScrollSnapContainerHighlightConfig struct {
+	/* The style of the snapport border (default: transparent) */
+	SnapportBorder *LineStyle `json:"snapportBorder,omitempty"`
+	/* The style of the snap area border (default: transparent) */
+	SnapAreaBorder *LineStyle `json:"snapAreaBorder,omitempty"`
+	/* The margin highlight fill color (default: transparent). */
+	ScrollMarginColor *cdp.DOMRGBA `json:"scrollMarginColor,omitempty"`
+	/* The padding highlight fill color (default: transparent). */
+	ScrollPaddingColor *cdp.DOMRGBA `json:"scrollPaddingColor,omitempty"`
+}
+
+// MarshalCDP writes ScrollSnapContainerHighlightConfig as JSON
+func (v *ScrollSnapContainerHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SnapportBorder != nil {
+		e.Key("snapportBorder")
+		(*v.SnapportBorder).MarshalCDP(e)
+	}
+	if v.SnapAreaBorder != nil {
+		e.Key("snapAreaBorder")
+		(*v.SnapAreaBorder).MarshalCDP(e)
+	}
+	if v.ScrollMarginColor != nil {
+		e.Key("scrollMarginColor")
+		(*v.ScrollMarginColor).MarshalCDP(e)
+	}
+	if v.ScrollPaddingColor != nil {
+		e.Key("scrollPaddingColor")
+		(*v.ScrollPaddingColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScrollSnapContainerHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScrollSnapContainerHighlightConfig from JSON
+func (v *ScrollSnapContainerHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "snapportBorder":
+			if d.Null() {
+				v.SnapportBorder = nil
+			} else {
+				v.SnapportBorder = new(LineStyle)
+				(*v.SnapportBorder).UnmarshalCDP(d)
+			}
+		case "snapAreaBorder":
+			if d.Null() {
+				v.SnapAreaBorder = nil
+			} else {
+				v.SnapAreaBorder = new(LineStyle)
+				(*v.SnapAreaBorder).UnmarshalCDP(d)
+			}
+		case "scrollMarginColor":
+			if d.Null() {
+				v.ScrollMarginColor = nil
+			} else {
+				v.ScrollMarginColor = new(cdp.DOMRGBA)
+				(*v.ScrollMarginColor).UnmarshalCDP(d)
+			}
+		case "scrollPaddingColor":
+			if d.Null() {
+				v.ScrollPaddingColor = nil
+			} else {
+				v.ScrollPaddingColor = new(cdp.DOMRGBA)
+				(*v.ScrollPaddingColor).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScrollSnapContainerHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScrollSnapHighlightConfig struct {
+	/* A descriptor for the highlight appearance of scroll snap containers. */
+	ScrollSnapContainerHighlightConfig ScrollSnapContainerHighlightConfig `json:"scrollSnapContainerHighlightConfig"`
+	/* Identifier of the node to highlight. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes ScrollSnapHighlightConfig as JSON
+func (v *ScrollSnapHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scrollSnapContainerHighlightConfig")
+	v.ScrollSnapContainerHighlightConfig.MarshalCDP(e)
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScrollSnapHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScrollSnapHighlightConfig from JSON
+func (v *ScrollSnapHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scrollSnapContainerHighlightConfig":
+			v.ScrollSnapContainerHighlightConfig.UnmarshalCDP(d)
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScrollSnapHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Configuration for dual screen hinge
+type HingeConfig struct {
+	/* A rectangle represent hinge */
+	Rect cdp.DOMRect `json:"rect"`
+	/* The content box highlight fill color (default: a dark color). */
+	ContentColor *cdp.DOMRGBA `json:"contentColor,omitempty"`
+	/* The content box highlight outline color (default: transparent). */
+	OutlineColor *cdp.DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// MarshalCDP writes HingeConfig as JSON
+func (v *HingeConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("rect")
+	v.Rect.MarshalCDP(e)
+	if v.ContentColor != nil {
+		e.Key("contentColor")
+		(*v.ContentColor).MarshalCDP(e)
+	}
+	if v.OutlineColor != nil {
+		e.Key("outlineColor")
+		(*v.OutlineColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HingeConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads HingeConfig from JSON
+func (v *HingeConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "rect":
+			v.Rect.UnmarshalCDP(d)
+		case "contentColor":
+			if d.Null() {
+				v.ContentColor = nil
+			} else {
+				v.ContentColor = new(cdp.DOMRGBA)
+				(*v.ContentColor).UnmarshalCDP(d)
+			}
+		case "outlineColor":
+			if d.Null() {
+				v.OutlineColor = nil
+			} else {
+				v.OutlineColor = new(cdp.DOMRGBA)
+				(*v.OutlineColor).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HingeConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Configuration for Window Controls Overlay
+type WindowControlsOverlayConfig struct {
+	/* Whether the title bar CSS should be shown when emulating the Window Controls Overlay. */
+	ShowCSS bool `json:"showCSS"`
+	/* Selected platforms to show the overlay. */
+	SelectedPlatform string `json:"selectedPlatform"`
+	/* The theme color defined in app manifest. */
+	ThemeColor string `json:"themeColor"`
+}
+
+// MarshalCDP writes WindowControlsOverlayConfig as JSON
+func (v *WindowControlsOverlayConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("showCSS")
+	e.Bool(v.ShowCSS)
+	e.Key("selectedPlatform")
+	e.String(v.SelectedPlatform)
+	e.Key("themeColor")
+	e.String(v.ThemeColor)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v WindowControlsOverlayConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads WindowControlsOverlayConfig from JSON
+func (v *WindowControlsOverlayConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "showCSS":
+			v.ShowCSS = d.Bool()
+		case "selectedPlatform":
+			v.SelectedPlatform = d.String()
+		case "themeColor":
+			v.ThemeColor = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WindowControlsOverlayConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContainerQueryHighlightConfig struct {
+	/* A descriptor for the highlight appearance of container query containers. */
+	ContainerQueryContainerHighlightConfig ContainerQueryContainerHighlightConfig `json:"containerQueryContainerHighlightConfig"`
+	/* Identifier of the container node to highlight. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes ContainerQueryHighlightConfig as JSON
+func (v *ContainerQueryHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("containerQueryContainerHighlightConfig")
+	v.ContainerQueryContainerHighlightConfig.MarshalCDP(e)
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContainerQueryHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ContainerQueryHighlightConfig from JSON
+func (v *ContainerQueryHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "containerQueryContainerHighlightConfig":
+			v.ContainerQueryContainerHighlightConfig.UnmarshalCDP(d)
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContainerQueryHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContainerQueryContainerHighlightConfig struct {
+	/* The style of the container border. */
+	ContainerBorder *LineStyle `json:"containerBorder,omitempty"`
+	/* The style of the descendants' borders. */
+	DescendantBorder *LineStyle `json:"descendantBorder,omitempty"`
+}
+
+// MarshalCDP writes ContainerQueryContainerHighlightConfig as JSON
+func (v *ContainerQueryContainerHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ContainerBorder != nil {
+		e.Key("containerBorder")
+		(*v.ContainerBorder).MarshalCDP(e)
+	}
+	if v.DescendantBorder != nil {
+		e.Key("descendantBorder")
+		(*v.DescendantBorder).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContainerQueryContainerHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ContainerQueryContainerHighlightConfig from JSON
+func (v *ContainerQueryContainerHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "containerBorder":
+			if d.Null() {
+				v.ContainerBorder = nil
+			} else {
+				v.ContainerBorder = new(LineStyle)
+				(*v.ContainerBorder).UnmarshalCDP(d)
+			}
+		case "descendantBorder":
+			if d.Null() {
+				v.DescendantBorder = nil
+			} else {
+				v.DescendantBorder = new(LineStyle)
+				(*v.DescendantBorder).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContainerQueryContainerHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type IsolatedElementHighlightConfig struct {
+	/* A descriptor for the highlight appearance of an element in isolation mode. */
+	IsolationModeHighlightConfig IsolationModeHighlightConfig `json:"isolationModeHighlightConfig"`
+	/* Identifier of the isolated element to highlight. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes IsolatedElementHighlightConfig as JSON
+func (v *IsolatedElementHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("isolationModeHighlightConfig")
+	v.IsolationModeHighlightConfig.MarshalCDP(e)
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v IsolatedElementHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads IsolatedElementHighlightConfig from JSON
+func (v *IsolatedElementHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "isolationModeHighlightConfig":
+			v.IsolationModeHighlightConfig.UnmarshalCDP(d)
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *IsolatedElementHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type IsolationModeHighlightConfig struct {
+	/* The fill color of the resizers (default: transparent). */
+	ResizerColor *cdp.DOMRGBA `json:"resizerColor,omitempty"`
+	/* The fill color for resizer handles (default: transparent). */
+	ResizerHandleColor *cdp.DOMRGBA `json:"resizerHandleColor,omitempty"`
+	/* The fill color for the mask covering non-isolated elements (default: transparent). */
+	MaskColor *cdp.DOMRGBA `json:"maskColor,omitempty"`
+}
+
+// MarshalCDP writes IsolationModeHighlightConfig as JSON
+func (v *IsolationModeHighlightConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ResizerColor != nil {
+		e.Key("resizerColor")
+		(*v.ResizerColor).MarshalCDP(e)
+	}
+	if v.ResizerHandleColor != nil {
+		e.Key("resizerHandleColor")
+		(*v.ResizerHandleColor).MarshalCDP(e)
+	}
+	if v.MaskColor != nil {
+		e.Key("maskColor")
+		(*v.MaskColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v IsolationModeHighlightConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads IsolationModeHighlightConfig from JSON
+func (v *IsolationModeHighlightConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "resizerColor":
+			if d.Null() {
+				v.ResizerColor = nil
+			} else {
+				v.ResizerColor = new(cdp.DOMRGBA)
+				(*v.ResizerColor).UnmarshalCDP(d)
+			}
+		case "resizerHandleColor":
+			if d.Null() {
+				v.ResizerHandleColor = nil
+			} else {
+				v.ResizerHandleColor = new(cdp.DOMRGBA)
+				(*v.ResizerHandleColor).UnmarshalCDP(d)
+			}
+		case "maskColor":
+			if d.Null() {
+				v.MaskColor = nil
+			} else {
+				v.MaskColor = new(cdp.DOMRGBA)
+				(*v.MaskColor).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *IsolationModeHighlightConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InspectMode string
+
+// InspectMode values
+const (
+	InspectModeSearchForNode         InspectMode = "searchForNode"
+	InspectModeSearchForUAShadowDOM  InspectMode = "searchForUAShadowDOM"
+	InspectModeCaptureAreaScreenshot InspectMode = "captureAreaScreenshot"
+	InspectModeNone                  InspectMode = "none"
+)
+
+// Values gives every InspectMode
+func (InspectMode) Values() []InspectMode {
+	return []InspectMode{
+		InspectModeSearchForNode,
+		InspectModeSearchForUAShadowDOM,
+		InspectModeCaptureAreaScreenshot,
+		InspectModeNone,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InspectMode) Valid() bool {
+	switch v {
+	case InspectModeSearchForNode, InspectModeSearchForUAShadowDOM, InspectModeCaptureAreaScreenshot, InspectModeNone:
+		return true
+	}
+	return false
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables domain notifications.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables domain notifications.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetHighlightObjectForTestReturns struct {
+
+	/* Highlight data for the node. */
+	Highlight map[string]interface{} `json:"highlight"`
+}
+
+// UnmarshalCDP reads GetHighlightObjectForTestReturns from JSON
+func (v *GetHighlightObjectForTestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "highlight":
+			v.Highlight = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetHighlightObjectForTestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetHighlightObjectForTestParams are the parameters for Overlay.getHighlightObjectForTest
+// optional parameters are left out when nil
+type GetHighlightObjectForTestParams struct {
+	/* Id of the node to get highlight object for. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+	/* Whether to include distance info. */
+	IncludeDistance *bool `json:"includeDistance,omitempty"`
+	/* Whether to include style info. */
+	IncludeStyle *bool `json:"includeStyle,omitempty"`
+	/* The color format to get config with (default: hex). */
+	ColorFormat *ColorFormat `json:"colorFormat,omitempty"`
+	/* Whether to show accessibility info (default: true). */
+	ShowAccessibilityInfo *bool `json:"showAccessibilityInfo,omitempty"`
+}
+
+// MarshalCDP writes GetHighlightObjectForTestParams as JSON
+func (v *GetHighlightObjectForTestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	if v.IncludeDistance != nil {
+		e.Key("includeDistance")
+		e.Bool(*v.IncludeDistance)
+	}
+	if v.IncludeStyle != nil {
+		e.Key("includeStyle")
+		e.Bool(*v.IncludeStyle)
+	}
+	if v.ColorFormat != nil {
+		e.Key("colorFormat")
+		e.String(string(*v.ColorFormat))
+	}
+	if v.ShowAccessibilityInfo != nil {
+		e.Key("showAccessibilityInfo")
+		e.Bool(*v.ShowAccessibilityInfo)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetHighlightObjectForTestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// For testing.
+func (c Client) GetHighlightObjectForTest(params GetHighlightObjectForTestParams) (GetHighlightObjectForTestReturns, error) {
+	return c.GetHighlightObjectForTestContext(context.Background(), params)
+}
+
+// GetHighlightObjectForTestContext is GetHighlightObjectForTest with a context for cancellation and deadlines
+func (c Client) GetHighlightObjectForTestContext(ctx context.Context, params GetHighlightObjectForTestParams) (GetHighlightObjectForTestReturns, error) {
+	var returns_ GetHighlightObjectForTestReturns
+
+	if params.ColorFormat != nil && !params.ColorFormat.Valid() {
+		return returns_, fmt.Errorf("Overlay.getHighlightObjectForTest: invalid colorFormat %q", *params.ColorFormat)
+	}
+
+	err_ := c.caller.Call(ctx, "Overlay.getHighlightObjectForTest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetGridHighlightObjectsForTestReturns struct {
+
+	/* Grid Highlight data for the node ids provided. */
+	Highlights map[string]interface{} `json:"highlights"`
+}
+
+// UnmarshalCDP reads GetGridHighlightObjectsForTestReturns from JSON
+func (v *GetGridHighlightObjectsForTestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "highlights":
+			v.Highlights = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetGridHighlightObjectsForTestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetGridHighlightObjectsForTestParams are the parameters for Overlay.getGridHighlightObjectsForTest
+// optional parameters are left out when nil
+type GetGridHighlightObjectsForTestParams struct {
+	/* Ids of the node to get highlight object for. */
+	NodeIds []cdp.DOMNodeId `json:"nodeIds"`
+}
+
+// MarshalCDP writes GetGridHighlightObjectsForTestParams as JSON
+func (v *GetGridHighlightObjectsForTestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeIds")
+	if v.NodeIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NodeIds {
+			e.Elem()
+			e.Int(int(v.NodeIds[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetGridHighlightObjectsForTestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// For Persistent Grid testing.
+func (c Client) GetGridHighlightObjectsForTest(params GetGridHighlightObjectsForTestParams) (GetGridHighlightObjectsForTestReturns, error) {
+	return c.GetGridHighlightObjectsForTestContext(context.Background(), params)
+}
+
+// GetGridHighlightObjectsForTestContext is GetGridHighlightObjectsForTest with a context for cancellation and deadlines
+func (c Client) GetGridHighlightObjectsForTestContext(ctx context.Context, params GetGridHighlightObjectsForTestParams) (GetGridHighlightObjectsForTestReturns, error) {
+	var returns_ GetGridHighlightObjectsForTestReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.getGridHighlightObjectsForTest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetSourceOrderHighlightObjectForTestReturns struct {
+
+	/* Source order highlight data for the node id provided. */
+	Highlight map[string]interface{} `json:"highlight"`
+}
+
+// UnmarshalCDP reads GetSourceOrderHighlightObjectForTestReturns from JSON
+func (v *GetSourceOrderHighlightObjectForTestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "highlight":
+			v.Highlight = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSourceOrderHighlightObjectForTestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetSourceOrderHighlightObjectForTestParams are the parameters for Overlay.getSourceOrderHighlightObjectForTest
+// optional parameters are left out when nil
+type GetSourceOrderHighlightObjectForTestParams struct {
+	/* Id of the node to highlight. */
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// MarshalCDP writes GetSourceOrderHighlightObjectForTestParams as JSON
+func (v *GetSourceOrderHighlightObjectForTestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.Int(int(v.NodeId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetSourceOrderHighlightObjectForTestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// For Source Order Viewer testing.
+func (c Client) GetSourceOrderHighlightObjectForTest(params GetSourceOrderHighlightObjectForTestParams) (GetSourceOrderHighlightObjectForTestReturns, error) {
+	return c.GetSourceOrderHighlightObjectForTestContext(context.Background(), params)
+}
+
+// GetSourceOrderHighlightObjectForTestContext is GetSourceOrderHighlightObjectForTest with a context for cancellation and deadlines
+func (c Client) GetSourceOrderHighlightObjectForTestContext(ctx context.Context, params GetSourceOrderHighlightObjectForTestParams) (GetSourceOrderHighlightObjectForTestReturns, error) {
+	var returns_ GetSourceOrderHighlightObjectForTestReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.getSourceOrderHighlightObjectForTest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HideHighlightReturns struct {
+}
+
+// UnmarshalCDP reads HideHighlightReturns from JSON
+func (v *HideHighlightReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HideHighlightReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Hides any highlight.
+func (c Client) HideHighlight() (HideHighlightReturns, error) {
+	return c.HideHighlightContext(context.Background())
+}
+
+// HideHighlightContext is HideHighlight with a context for cancellation and deadlines
+func (c Client) HideHighlightContext(ctx context.Context) (HideHighlightReturns, error) {
+	var returns_ HideHighlightReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.hideHighlight", nil, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightFrameReturns struct {
+}
+
+// UnmarshalCDP reads HighlightFrameReturns from JSON
+func (v *HighlightFrameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightFrameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HighlightFrameParams are the parameters for Overlay.highlightFrame
+// optional parameters are left out when nil
+type HighlightFrameParams struct {
+	/* Identifier of the frame to highlight. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+	/* The content box highlight fill color (default: transparent). */
+	ContentColor *cdp.DOMRGBA `json:"contentColor,omitempty"`
+	/* The content box highlight outline color (default: transparent). */
+	ContentOutlineColor *cdp.DOMRGBA `json:"contentOutlineColor,omitempty"`
+}
+
+// MarshalCDP writes HighlightFrameParams as JSON
+func (v *HighlightFrameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	if v.ContentColor != nil {
+		e.Key("contentColor")
+		(*v.ContentColor).MarshalCDP(e)
+	}
+	if v.ContentOutlineColor != nil {
+		e.Key("contentOutlineColor")
+		(*v.ContentOutlineColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HighlightFrameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlights owner element of the frame with given id.
+// Deprecated: Doesn't work reliably and cannot be fixed due to process
+// separation (the owner node might be in a different process). Determine
+// the owner node in the client and use highlightNode.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) HighlightFrame(params HighlightFrameParams) (HighlightFrameReturns, error) {
+	return c.HighlightFrameContext(context.Background(), params)
+}
+
+// HighlightFrameContext is HighlightFrame with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) HighlightFrameContext(ctx context.Context, params HighlightFrameParams) (HighlightFrameReturns, error) {
+	var returns_ HighlightFrameReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.highlightFrame", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightNodeReturns struct {
+}
+
+// UnmarshalCDP reads HighlightNodeReturns from JSON
+func (v *HighlightNodeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightNodeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HighlightNodeParams are the parameters for Overlay.highlightNode
+// optional parameters are left out when nil
+type HighlightNodeParams struct {
+	/* A descriptor for the highlight appearance. */
+	HighlightConfig HighlightConfig `json:"highlightConfig"`
+	/* Identifier of the node to highlight. */
+	NodeId *cdp.DOMNodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node to highlight. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node to be highlighted. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* Selectors to highlight relevant nodes. */
+	Selector *string `json:"selector,omitempty"`
+}
+
+// MarshalCDP writes HighlightNodeParams as JSON
+func (v *HighlightNodeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("highlightConfig")
+	v.HighlightConfig.MarshalCDP(e)
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.Selector != nil {
+		e.Key("selector")
+		e.String(*v.Selector)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HighlightNodeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or
+// objectId must be specified.
+func (c Client) HighlightNode(params HighlightNodeParams) (HighlightNodeReturns, error) {
+	return c.HighlightNodeContext(context.Background(), params)
+}
+
+// HighlightNodeContext is HighlightNode with a context for cancellation and deadlines
+func (c Client) HighlightNodeContext(ctx context.Context, params HighlightNodeParams) (HighlightNodeReturns, error) {
+	var returns_ HighlightNodeReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.highlightNode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightQuadReturns struct {
+}
+
+// UnmarshalCDP reads HighlightQuadReturns from JSON
+func (v *HighlightQuadReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightQuadReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HighlightQuadParams are the parameters for Overlay.highlightQuad
+// optional parameters are left out when nil
+type HighlightQuadParams struct {
+	/* Quad to highlight */
+	Quad cdp.DOMQuad `json:"quad"`
+	/* The highlight fill color (default: transparent). */
+	Color *cdp.DOMRGBA `json:"color,omitempty"`
+	/* The highlight outline color (default: transparent). */
+	OutlineColor *cdp.DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// MarshalCDP writes HighlightQuadParams as JSON
+func (v *HighlightQuadParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("quad")
+	if v.Quad == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Quad {
+			e.Elem()
+			e.Float(v.Quad[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.Color != nil {
+		e.Key("color")
+		(*v.Color).MarshalCDP(e)
+	}
+	if v.OutlineColor != nil {
+		e.Key("outlineColor")
+		(*v.OutlineColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HighlightQuadParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlights given quad. Coordinates are absolute with respect to the main frame viewport.
+func (c Client) HighlightQuad(params HighlightQuadParams) (HighlightQuadReturns, error) {
+	return c.HighlightQuadContext(context.Background(), params)
+}
+
+// HighlightQuadContext is HighlightQuad with a context for cancellation and deadlines
+func (c Client) HighlightQuadContext(ctx context.Context, params HighlightQuadParams) (HighlightQuadReturns, error) {
+	var returns_ HighlightQuadReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.highlightQuad", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightRectReturns struct {
+}
+
+// UnmarshalCDP reads HighlightRectReturns from JSON
+func (v *HighlightRectReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightRectReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HighlightRectParams are the parameters for Overlay.highlightRect
+// optional parameters are left out when nil
+type HighlightRectParams struct {
+	/* X coordinate */
+	X int `json:"x"`
+	/* Y coordinate */
+	Y int `json:"y"`
+	/* Rectangle width */
+	Width int `json:"width"`
+	/* Rectangle height */
+	Height int `json:"height"`
+	/* The highlight fill color (default: transparent). */
+	Color *cdp.DOMRGBA `json:"color,omitempty"`
+	/* The highlight outline color (default: transparent). */
+	OutlineColor *cdp.DOMRGBA `json:"outlineColor,omitempty"`
+}
+
+// MarshalCDP writes HighlightRectParams as JSON
+func (v *HighlightRectParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Int(v.X)
+	e.Key("y")
+	e.Int(v.Y)
+	e.Key("width")
+	e.Int(v.Width)
+	e.Key("height")
+	e.Int(v.Height)
+	if v.Color != nil {
+		e.Key("color")
+		(*v.Color).MarshalCDP(e)
+	}
+	if v.OutlineColor != nil {
+		e.Key("outlineColor")
+		(*v.OutlineColor).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HighlightRectParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.
+func (c Client) HighlightRect(params HighlightRectParams) (HighlightRectReturns, error) {
+	return c.HighlightRectContext(context.Background(), params)
+}
+
+// HighlightRectContext is HighlightRect with a context for cancellation and deadlines
+func (c Client) HighlightRectContext(ctx context.Context, params HighlightRectParams) (HighlightRectReturns, error) {
+	var returns_ HighlightRectReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.highlightRect", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HighlightSourceOrderReturns struct {
+}
+
+// UnmarshalCDP reads HighlightSourceOrderReturns from JSON
+func (v *HighlightSourceOrderReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HighlightSourceOrderReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HighlightSourceOrderParams are the parameters for Overlay.highlightSourceOrder
+// optional parameters are left out when nil
+type HighlightSourceOrderParams struct {
+	/* A descriptor for the appearance of the overlay drawing. */
+	SourceOrderConfig SourceOrderConfig `json:"sourceOrderConfig"`
+	/* Identifier of the node to highlight. */
+	NodeId *cdp.DOMNodeId `json:"nodeId,omitempty"`
+	/* Identifier of the backend node to highlight. */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	/* JavaScript object id of the node to be highlighted. */
+	ObjectId *cdp.RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes HighlightSourceOrderParams as JSON
+func (v *HighlightSourceOrderParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sourceOrderConfig")
+	v.SourceOrderConfig.MarshalCDP(e)
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HighlightSourceOrderParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlights the source order of the children of the DOM node with given id or with the given
+// JavaScript object wrapper. Either nodeId or objectId must be specified.
+func (c Client) HighlightSourceOrder(params HighlightSourceOrderParams) (HighlightSourceOrderReturns, error) {
+	return c.HighlightSourceOrderContext(context.Background(), params)
+}
+
+// HighlightSourceOrderContext is HighlightSourceOrder with a context for cancellation and deadlines
+func (c Client) HighlightSourceOrderContext(ctx context.Context, params HighlightSourceOrderParams) (HighlightSourceOrderReturns, error) {
+	var returns_ HighlightSourceOrderReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.highlightSourceOrder", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetInspectModeReturns struct {
+}
+
+// UnmarshalCDP reads SetInspectModeReturns from JSON
+func (v *SetInspectModeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInspectModeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInspectModeParams are the parameters for Overlay.setInspectMode
+// optional parameters are left out when nil
+type SetInspectModeParams struct {
+	/* Set an inspection mode. */
+	Mode InspectMode `json:"mode"`
+	/* A descriptor for the highlight appearance of hovered-over nodes. May be omitted if `enabled
+	== false`. */
+	HighlightConfig *HighlightConfig `json:"highlightConfig,omitempty"`
+}
+
+// MarshalCDP writes SetInspectModeParams as JSON
+func (v *SetInspectModeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("mode")
+	e.String(string(v.Mode))
+	if v.HighlightConfig != nil {
+		e.Key("highlightConfig")
+		(*v.HighlightConfig).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInspectModeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted.
+// Backend then generates 'inspectNodeRequested' event upon element selection.
+func (c Client) SetInspectMode(params SetInspectModeParams) (SetInspectModeReturns, error) {
+	return c.SetInspectModeContext(context.Background(), params)
+}
+
+// SetInspectModeContext is SetInspectMode with a context for cancellation and deadlines
+func (c Client) SetInspectModeContext(ctx context.Context, params SetInspectModeParams) (SetInspectModeReturns, error) {
+	var returns_ SetInspectModeReturns
+
+	if !params.Mode.Valid() {
+		return returns_, fmt.Errorf("Overlay.setInspectMode: invalid mode %q", params.Mode)
+	}
+
+	err_ := c.caller.Call(ctx, "Overlay.setInspectMode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowAdHighlightsReturns struct {
+}
+
+// UnmarshalCDP reads SetShowAdHighlightsReturns from JSON
+func (v *SetShowAdHighlightsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowAdHighlightsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowAdHighlightsParams are the parameters for Overlay.setShowAdHighlights
+// optional parameters are left out when nil
+type SetShowAdHighlightsParams struct {
+	/* True for showing ad highlights */
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowAdHighlightsParams as JSON
+func (v *SetShowAdHighlightsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowAdHighlightsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlights owner element of all frames detected to be ads.
+func (c Client) SetShowAdHighlights(params SetShowAdHighlightsParams) (SetShowAdHighlightsReturns, error) {
+	return c.SetShowAdHighlightsContext(context.Background(), params)
+}
+
+// SetShowAdHighlightsContext is SetShowAdHighlights with a context for cancellation and deadlines
+func (c Client) SetShowAdHighlightsContext(ctx context.Context, params SetShowAdHighlightsParams) (SetShowAdHighlightsReturns, error) {
+	var returns_ SetShowAdHighlightsReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowAdHighlights", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetPausedInDebuggerMessageReturns struct {
+}
+
+// UnmarshalCDP reads SetPausedInDebuggerMessageReturns from JSON
+func (v *SetPausedInDebuggerMessageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPausedInDebuggerMessageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPausedInDebuggerMessageParams are the parameters for Overlay.setPausedInDebuggerMessage
+// optional parameters are left out when nil
+type SetPausedInDebuggerMessageParams struct {
+	/* The message to display, also triggers resume and step over controls. */
+	Message *string `json:"message,omitempty"`
+}
+
+// MarshalCDP writes SetPausedInDebuggerMessageParams as JSON
+func (v *SetPausedInDebuggerMessageParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Message != nil {
+		e.Key("message")
+		e.String(*v.Message)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPausedInDebuggerMessageParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetPausedInDebuggerMessage(params SetPausedInDebuggerMessageParams) (SetPausedInDebuggerMessageReturns, error) {
+	return c.SetPausedInDebuggerMessageContext(context.Background(), params)
+}
+
+// SetPausedInDebuggerMessageContext is SetPausedInDebuggerMessage with a context for cancellation and deadlines
+func (c Client) SetPausedInDebuggerMessageContext(ctx context.Context, params SetPausedInDebuggerMessageParams) (SetPausedInDebuggerMessageReturns, error) {
+	var returns_ SetPausedInDebuggerMessageReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setPausedInDebuggerMessage", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowDebugBordersReturns struct {
+}
+
+// UnmarshalCDP reads SetShowDebugBordersReturns from JSON
+func (v *SetShowDebugBordersReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowDebugBordersReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowDebugBordersParams are the parameters for Overlay.setShowDebugBorders
+// optional parameters are left out when nil
+type SetShowDebugBordersParams struct {
+	/* True for showing debug borders */
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowDebugBordersParams as JSON
+func (v *SetShowDebugBordersParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowDebugBordersParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that backend shows debug borders on layers
+func (c Client) SetShowDebugBorders(params SetShowDebugBordersParams) (SetShowDebugBordersReturns, error) {
+	return c.SetShowDebugBordersContext(context.Background(), params)
+}
+
+// SetShowDebugBordersContext is SetShowDebugBorders with a context for cancellation and deadlines
+func (c Client) SetShowDebugBordersContext(ctx context.Context, params SetShowDebugBordersParams) (SetShowDebugBordersReturns, error) {
+	var returns_ SetShowDebugBordersReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowDebugBorders", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowFPSCounterReturns struct {
+}
+
+// UnmarshalCDP reads SetShowFPSCounterReturns from JSON
+func (v *SetShowFPSCounterReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowFPSCounterReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowFPSCounterParams are the parameters for Overlay.setShowFPSCounter
+// optional parameters are left out when nil
+type SetShowFPSCounterParams struct {
+	/* True for showing the FPS counter */
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowFPSCounterParams as JSON
+func (v *SetShowFPSCounterParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowFPSCounterParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that backend shows the FPS counter
+func (c Client) SetShowFPSCounter(params SetShowFPSCounterParams) (SetShowFPSCounterReturns, error) {
+	return c.SetShowFPSCounterContext(context.Background(), params)
+}
+
+// SetShowFPSCounterContext is SetShowFPSCounter with a context for cancellation and deadlines
+func (c Client) SetShowFPSCounterContext(ctx context.Context, params SetShowFPSCounterParams) (SetShowFPSCounterReturns, error) {
+	var returns_ SetShowFPSCounterReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowFPSCounter", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowGridOverlaysReturns struct {
+}
+
+// UnmarshalCDP reads SetShowGridOverlaysReturns from JSON
+func (v *SetShowGridOverlaysReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowGridOverlaysReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowGridOverlaysParams are the parameters for Overlay.setShowGridOverlays
+// optional parameters are left out when nil
+type SetShowGridOverlaysParams struct {
+	/* An array of node identifiers and descriptors for the highlight appearance. */
+	GridNodeHighlightConfigs []GridNodeHighlightConfig `json:"gridNodeHighlightConfigs"`
+}
+
+// MarshalCDP writes SetShowGridOverlaysParams as JSON
+func (v *SetShowGridOverlaysParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("gridNodeHighlightConfigs")
+	if v.GridNodeHighlightConfigs == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.GridNodeHighlightConfigs {
+			e.Elem()
+			v.GridNodeHighlightConfigs[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowGridOverlaysParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Highlight multiple elements with the CSS Grid overlay.
+func (c Client) SetShowGridOverlays(params SetShowGridOverlaysParams) (SetShowGridOverlaysReturns, error) {
+	return c.SetShowGridOverlaysContext(context.Background(), params)
+}
+
+// SetShowGridOverlaysContext is SetShowGridOverlays with a context for cancellation and deadlines
+func (c Client) SetShowGridOverlaysContext(ctx context.Context, params SetShowGridOverlaysParams) (SetShowGridOverlaysReturns, error) {
+	var returns_ SetShowGridOverlaysReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowGridOverlays", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowFlexOverlaysReturns struct {
+}
+
+// UnmarshalCDP reads SetShowFlexOverlaysReturns from JSON
+func (v *SetShowFlexOverlaysReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowFlexOverlaysReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowFlexOverlaysParams are the parameters for Overlay.setShowFlexOverlays
+// optional parameters are left out when nil
+type SetShowFlexOverlaysParams struct {
+	/* An array of node identifiers and descriptors for the highlight appearance. */
+	FlexNodeHighlightConfigs []FlexNodeHighlightConfig `json:"flexNodeHighlightConfigs"`
+}
+
+// MarshalCDP writes SetShowFlexOverlaysParams as JSON
+func (v *SetShowFlexOverlaysParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("flexNodeHighlightConfigs")
+	if v.FlexNodeHighlightConfigs == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.FlexNodeHighlightConfigs {
+			e.Elem()
+			v.FlexNodeHighlightConfigs[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowFlexOverlaysParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetShowFlexOverlays(params SetShowFlexOverlaysParams) (SetShowFlexOverlaysReturns, error) {
+	return c.SetShowFlexOverlaysContext(context.Background(), params)
+}
+
+// SetShowFlexOverlaysContext is SetShowFlexOverlays with a context for cancellation and deadlines
+func (c Client) SetShowFlexOverlaysContext(ctx context.Context, params SetShowFlexOverlaysParams) (SetShowFlexOverlaysReturns, error) {
+	var returns_ SetShowFlexOverlaysReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowFlexOverlays", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowScrollSnapOverlaysReturns struct {
+}
+
+// UnmarshalCDP reads SetShowScrollSnapOverlaysReturns from JSON
+func (v *SetShowScrollSnapOverlaysReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowScrollSnapOverlaysReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowScrollSnapOverlaysParams are the parameters for Overlay.setShowScrollSnapOverlays
+// optional parameters are left out when nil
+type SetShowScrollSnapOverlaysParams struct {
+	/* An array of node identifiers and descriptors for the highlight appearance. */
+	ScrollSnapHighlightConfigs []ScrollSnapHighlightConfig `json:"scrollSnapHighlightConfigs"`
+}
+
+// MarshalCDP writes SetShowScrollSnapOverlaysParams as JSON
+func (v *SetShowScrollSnapOverlaysParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scrollSnapHighlightConfigs")
+	if v.ScrollSnapHighlightConfigs == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ScrollSnapHighlightConfigs {
+			e.Elem()
+			v.ScrollSnapHighlightConfigs[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowScrollSnapOverlaysParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetShowScrollSnapOverlays(params SetShowScrollSnapOverlaysParams) (SetShowScrollSnapOverlaysReturns, error) {
+	return c.SetShowScrollSnapOverlaysContext(context.Background(), params)
+}
+
+// SetShowScrollSnapOverlaysContext is SetShowScrollSnapOverlays with a context for cancellation and deadlines
+func (c Client) SetShowScrollSnapOverlaysContext(ctx context.Context, params SetShowScrollSnapOverlaysParams) (SetShowScrollSnapOverlaysReturns, error) {
+	var returns_ SetShowScrollSnapOverlaysReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowScrollSnapOverlays", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowContainerQueryOverlaysReturns struct {
+}
+
+// UnmarshalCDP reads SetShowContainerQueryOverlaysReturns from JSON
+func (v *SetShowContainerQueryOverlaysReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowContainerQueryOverlaysReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowContainerQueryOverlaysParams are the parameters for Overlay.setShowContainerQueryOverlays
+// optional parameters are left out when nil
+type SetShowContainerQueryOverlaysParams struct {
+	/* An array of node identifiers and descriptors for the highlight appearance. */
+	ContainerQueryHighlightConfigs []ContainerQueryHighlightConfig `json:"containerQueryHighlightConfigs"`
+}
+
+// MarshalCDP writes SetShowContainerQueryOverlaysParams as JSON
+func (v *SetShowContainerQueryOverlaysParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("containerQueryHighlightConfigs")
+	if v.ContainerQueryHighlightConfigs == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ContainerQueryHighlightConfigs {
+			e.Elem()
+			v.ContainerQueryHighlightConfigs[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowContainerQueryOverlaysParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetShowContainerQueryOverlays(params SetShowContainerQueryOverlaysParams) (SetShowContainerQueryOverlaysReturns, error) {
+	return c.SetShowContainerQueryOverlaysContext(context.Background(), params)
+}
+
+// SetShowContainerQueryOverlaysContext is SetShowContainerQueryOverlays with a context for cancellation and deadlines
+func (c Client) SetShowContainerQueryOverlaysContext(ctx context.Context, params SetShowContainerQueryOverlaysParams) (SetShowContainerQueryOverlaysReturns, error) {
+	var returns_ SetShowContainerQueryOverlaysReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowContainerQueryOverlays", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowPaintRectsReturns struct {
+}
+
+// UnmarshalCDP reads SetShowPaintRectsReturns from JSON
+func (v *SetShowPaintRectsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowPaintRectsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowPaintRectsParams are the parameters for Overlay.setShowPaintRects
+// optional parameters are left out when nil
+type SetShowPaintRectsParams struct {
+	/* True for showing paint rectangles */
+	Result bool `json:"result"`
+}
+
+// MarshalCDP writes SetShowPaintRectsParams as JSON
+func (v *SetShowPaintRectsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("result")
+	e.Bool(v.Result)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowPaintRectsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that backend shows paint rectangles
+func (c Client) SetShowPaintRects(params SetShowPaintRectsParams) (SetShowPaintRectsReturns, error) {
+	return c.SetShowPaintRectsContext(context.Background(), params)
+}
+
+// SetShowPaintRectsContext is SetShowPaintRects with a context for cancellation and deadlines
+func (c Client) SetShowPaintRectsContext(ctx context.Context, params SetShowPaintRectsParams) (SetShowPaintRectsReturns, error) {
+	var returns_ SetShowPaintRectsReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowPaintRects", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowLayoutShiftRegionsReturns struct {
+}
+
+// UnmarshalCDP reads SetShowLayoutShiftRegionsReturns from JSON
+func (v *SetShowLayoutShiftRegionsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowLayoutShiftRegionsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowLayoutShiftRegionsParams are the parameters for Overlay.setShowLayoutShiftRegions
+// optional parameters are left out when nil
+type SetShowLayoutShiftRegionsParams struct {
+	/* True for showing layout shift regions */
+	Result bool `json:"result"`
+}
+
+// MarshalCDP writes SetShowLayoutShiftRegionsParams as JSON
+func (v *SetShowLayoutShiftRegionsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("result")
+	e.Bool(v.Result)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowLayoutShiftRegionsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that backend shows layout shift regions
+func (c Client) SetShowLayoutShiftRegions(params SetShowLayoutShiftRegionsParams) (SetShowLayoutShiftRegionsReturns, error) {
+	return c.SetShowLayoutShiftRegionsContext(context.Background(), params)
+}
+
+// SetShowLayoutShiftRegionsContext is SetShowLayoutShiftRegions with a context for cancellation and deadlines
+func (c Client) SetShowLayoutShiftRegionsContext(ctx context.Context, params SetShowLayoutShiftRegionsParams) (SetShowLayoutShiftRegionsReturns, error) {
+	var returns_ SetShowLayoutShiftRegionsReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowLayoutShiftRegions", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowScrollBottleneckRectsReturns struct {
+}
+
+// UnmarshalCDP reads SetShowScrollBottleneckRectsReturns from JSON
+func (v *SetShowScrollBottleneckRectsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowScrollBottleneckRectsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowScrollBottleneckRectsParams are the parameters for Overlay.setShowScrollBottleneckRects
+// optional parameters are left out when nil
+type SetShowScrollBottleneckRectsParams struct {
+	/* True for showing scroll bottleneck rects */
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowScrollBottleneckRectsParams as JSON
+func (v *SetShowScrollBottleneckRectsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowScrollBottleneckRectsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests that backend shows scroll bottleneck rects
+func (c Client) SetShowScrollBottleneckRects(params SetShowScrollBottleneckRectsParams) (SetShowScrollBottleneckRectsReturns, error) {
+	return c.SetShowScrollBottleneckRectsContext(context.Background(), params)
+}
+
+// SetShowScrollBottleneckRectsContext is SetShowScrollBottleneckRects with a context for cancellation and deadlines
+func (c Client) SetShowScrollBottleneckRectsContext(ctx context.Context, params SetShowScrollBottleneckRectsParams) (SetShowScrollBottleneckRectsReturns, error) {
+	var returns_ SetShowScrollBottleneckRectsReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowScrollBottleneckRects", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowHitTestBordersReturns struct {
+}
+
+// UnmarshalCDP reads SetShowHitTestBordersReturns from JSON
+func (v *SetShowHitTestBordersReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowHitTestBordersReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowHitTestBordersParams are the parameters for Overlay.setShowHitTestBorders
+// optional parameters are left out when nil
+type SetShowHitTestBordersParams struct {
+	/* True for showing hit-test borders */
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowHitTestBordersParams as JSON
+func (v *SetShowHitTestBordersParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowHitTestBordersParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deprecated, no longer has any effect.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetShowHitTestBorders(params SetShowHitTestBordersParams) (SetShowHitTestBordersReturns, error) {
+	return c.SetShowHitTestBordersContext(context.Background(), params)
+}
+
+// SetShowHitTestBordersContext is SetShowHitTestBorders with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetShowHitTestBordersContext(ctx context.Context, params SetShowHitTestBordersParams) (SetShowHitTestBordersReturns, error) {
+	var returns_ SetShowHitTestBordersReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowHitTestBorders", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowWebVitalsReturns struct {
+}
+
+// UnmarshalCDP reads SetShowWebVitalsReturns from JSON
+func (v *SetShowWebVitalsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowWebVitalsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowWebVitalsParams are the parameters for Overlay.setShowWebVitals
+// optional parameters are left out when nil
+type SetShowWebVitalsParams struct {
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowWebVitalsParams as JSON
+func (v *SetShowWebVitalsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowWebVitalsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deprecated, no longer has any effect.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetShowWebVitals(params SetShowWebVitalsParams) (SetShowWebVitalsReturns, error) {
+	return c.SetShowWebVitalsContext(context.Background(), params)
+}
+
+// SetShowWebVitalsContext is SetShowWebVitals with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetShowWebVitalsContext(ctx context.Context, params SetShowWebVitalsParams) (SetShowWebVitalsReturns, error) {
+	var returns_ SetShowWebVitalsReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowWebVitals", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowViewportSizeOnResizeReturns struct {
+}
+
+// UnmarshalCDP reads SetShowViewportSizeOnResizeReturns from JSON
+func (v *SetShowViewportSizeOnResizeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowViewportSizeOnResizeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowViewportSizeOnResizeParams are the parameters for Overlay.setShowViewportSizeOnResize
+// optional parameters are left out when nil
+type SetShowViewportSizeOnResizeParams struct {
+	/* Whether to paint size or not. */
+	Show bool `json:"show"`
+}
+
+// MarshalCDP writes SetShowViewportSizeOnResizeParams as JSON
+func (v *SetShowViewportSizeOnResizeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("show")
+	e.Bool(v.Show)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowViewportSizeOnResizeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Paints viewport size upon main frame resize.
+func (c Client) SetShowViewportSizeOnResize(params SetShowViewportSizeOnResizeParams) (SetShowViewportSizeOnResizeReturns, error) {
+	return c.SetShowViewportSizeOnResizeContext(context.Background(), params)
+}
+
+// SetShowViewportSizeOnResizeContext is SetShowViewportSizeOnResize with a context for cancellation and deadlines
+func (c Client) SetShowViewportSizeOnResizeContext(ctx context.Context, params SetShowViewportSizeOnResizeParams) (SetShowViewportSizeOnResizeReturns, error) {
+	var returns_ SetShowViewportSizeOnResizeReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowViewportSizeOnResize", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowHingeReturns struct {
+}
+
+// UnmarshalCDP reads SetShowHingeReturns from JSON
+func (v *SetShowHingeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowHingeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowHingeParams are the parameters for Overlay.setShowHinge
+// optional parameters are left out when nil
+type SetShowHingeParams struct {
+	/* hinge data, null means hideHinge */
+	HingeConfig *HingeConfig `json:"hingeConfig,omitempty"`
+}
+
+// MarshalCDP writes SetShowHingeParams as JSON
+func (v *SetShowHingeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.HingeConfig != nil {
+		e.Key("hingeConfig")
+		(*v.HingeConfig).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowHingeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Add a dual screen device hinge
+func (c Client) SetShowHinge(params SetShowHingeParams) (SetShowHingeReturns, error) {
+	return c.SetShowHingeContext(context.Background(), params)
+}
+
+// SetShowHingeContext is SetShowHinge with a context for cancellation and deadlines
+func (c Client) SetShowHingeContext(ctx context.Context, params SetShowHingeParams) (SetShowHingeReturns, error) {
+	var returns_ SetShowHingeReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowHinge", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowIsolatedElementsReturns struct {
+}
+
+// UnmarshalCDP reads SetShowIsolatedElementsReturns from JSON
+func (v *SetShowIsolatedElementsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowIsolatedElementsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowIsolatedElementsParams are the parameters for Overlay.setShowIsolatedElements
+// optional parameters are left out when nil
+type SetShowIsolatedElementsParams struct {
+	/* An array of node identifiers and descriptors for the highlight appearance. */
+	IsolatedElementHighlightConfigs []IsolatedElementHighlightConfig `json:"isolatedElementHighlightConfigs"`
+}
+
+// MarshalCDP writes SetShowIsolatedElementsParams as JSON
+func (v *SetShowIsolatedElementsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("isolatedElementHighlightConfigs")
+	if v.IsolatedElementHighlightConfigs == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.IsolatedElementHighlightConfigs {
+			e.Elem()
+			v.IsolatedElementHighlightConfigs[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowIsolatedElementsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Show elements in isolation mode with overlays.
+func (c Client) SetShowIsolatedElements(params SetShowIsolatedElementsParams) (SetShowIsolatedElementsReturns, error) {
+	return c.SetShowIsolatedElementsContext(context.Background(), params)
+}
+
+// SetShowIsolatedElementsContext is SetShowIsolatedElements with a context for cancellation and deadlines
+func (c Client) SetShowIsolatedElementsContext(ctx context.Context, params SetShowIsolatedElementsParams) (SetShowIsolatedElementsReturns, error) {
+	var returns_ SetShowIsolatedElementsReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowIsolatedElements", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetShowWindowControlsOverlayReturns struct {
+}
+
+// UnmarshalCDP reads SetShowWindowControlsOverlayReturns from JSON
+func (v *SetShowWindowControlsOverlayReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetShowWindowControlsOverlayReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetShowWindowControlsOverlayParams are the parameters for Overlay.setShowWindowControlsOverlay
+// optional parameters are left out when nil
+type SetShowWindowControlsOverlayParams struct {
+	/* Window Controls Overlay data, null means hide Window Controls Overlay */
+	WindowControlsOverlayConfig *WindowControlsOverlayConfig `json:"windowControlsOverlayConfig,omitempty"`
+}
+
+// MarshalCDP writes SetShowWindowControlsOverlayParams as JSON
+func (v *SetShowWindowControlsOverlayParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.WindowControlsOverlayConfig != nil {
+		e.Key("windowControlsOverlayConfig")
+		(*v.WindowControlsOverlayConfig).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetShowWindowControlsOverlayParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Show Window Controls Overlay for PWA
+func (c Client) SetShowWindowControlsOverlay(params SetShowWindowControlsOverlayParams) (SetShowWindowControlsOverlayReturns, error) {
+	return c.SetShowWindowControlsOverlayContext(context.Background(), params)
+}
+
+// SetShowWindowControlsOverlayContext is SetShowWindowControlsOverlay with a context for cancellation and deadlines
+func (c Client) SetShowWindowControlsOverlayContext(ctx context.Context, params SetShowWindowControlsOverlayParams) (SetShowWindowControlsOverlayReturns, error) {
+	var returns_ SetShowWindowControlsOverlayReturns
+
+	err_ := c.caller.Call(ctx, "Overlay.setShowWindowControlsOverlay", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fired when the node should be inspected. This happens after call to `setInspectMode` or when
+// user manually inspects an element.
+type InspectNodeRequestedEvent struct {
+
+	/* Id of the node to inspect. */
+	BackendNodeId cdp.DOMBackendNodeId `json:"backendNodeId"`
+}
+
+// UnmarshalCDP reads InspectNodeRequestedEvent from JSON
+func (v *InspectNodeRequestedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backendNodeId":
+			v.BackendNodeId = cdp.DOMBackendNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InspectNodeRequestedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InspectNodeRequestedHandler func(ev InspectNodeRequestedEvent)
+
+// EventMethod is Overlay.inspectNodeRequested
+func (InspectNodeRequestedEvent) EventMethod() string {
+	return "Overlay.inspectNodeRequested"
+}
+
+// OnInspectNodeRequested calls handler for each Overlay.inspectNodeRequested event
+func (c Client) OnInspectNodeRequested(handler InspectNodeRequestedHandler) (unsubscribe func()) {
+	return c.caller.On("Overlay.inspectNodeRequested", func(ev interface{}) {
+		handler(ev.(InspectNodeRequestedEvent))
+	})
+}
+
+// Fired when the node should be highlighted. This happens after call to `setInspectMode`.
+type NodeHighlightRequestedEvent struct {
+	NodeId cdp.DOMNodeId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads NodeHighlightRequestedEvent from JSON
+func (v *NodeHighlightRequestedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = cdp.DOMNodeId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodeHighlightRequestedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NodeHighlightRequestedHandler func(ev NodeHighlightRequestedEvent)
+
+// EventMethod is Overlay.nodeHighlightRequested
+func (NodeHighlightRequestedEvent) EventMethod() string {
+	return "Overlay.nodeHighlightRequested"
+}
+
+// OnNodeHighlightRequested calls handler for each Overlay.nodeHighlightRequested event
+func (c Client) OnNodeHighlightRequested(handler NodeHighlightRequestedHandler) (unsubscribe func()) {
+	return c.caller.On("Overlay.nodeHighlightRequested", func(ev interface{}) {
+		handler(ev.(NodeHighlightRequestedEvent))
+	})
+}
+
+// Fired when user asks to capture screenshot of some area on the page.
+type ScreenshotRequestedEvent struct {
+
+	/* Viewport to capture, in device independent pixels (dip). */
+	Viewport cdp.PageViewport `json:"viewport"`
+}
+
+// UnmarshalCDP reads ScreenshotRequestedEvent from JSON
+func (v *ScreenshotRequestedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "viewport":
+			v.Viewport.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScreenshotRequestedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScreenshotRequestedHandler func(ev ScreenshotRequestedEvent)
+
+// EventMethod is Overlay.screenshotRequested
+func (ScreenshotRequestedEvent) EventMethod() string {
+	return "Overlay.screenshotRequested"
+}
+
+// OnScreenshotRequested calls handler for each Overlay.screenshotRequested event
+func (c Client) OnScreenshotRequested(handler ScreenshotRequestedHandler) (unsubscribe func()) {
+	return c.caller.On("Overlay.screenshotRequested", func(ev interface{}) {
+		handler(ev.(ScreenshotRequestedEvent))
+	})
+}
+
+// Fired when user cancels the inspect mode.
+type InspectModeCanceledEvent struct {
+}
+
+// UnmarshalCDP reads InspectModeCanceledEvent from JSON
+func (v *InspectModeCanceledEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InspectModeCanceledEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InspectModeCanceledHandler func(ev InspectModeCanceledEvent)
+
+// EventMethod is Overlay.inspectModeCanceled
+func (InspectModeCanceledEvent) EventMethod() string {
+	return "Overlay.inspectModeCanceled"
+}
+
+// OnInspectModeCanceled calls handler for each Overlay.inspectModeCanceled event
+func (c Client) OnInspectModeCanceled(handler InspectModeCanceledHandler) (unsubscribe func()) {
+	return c.caller.On("Overlay.inspectModeCanceled", func(ev interface{}) {
+		handler(ev.(InspectModeCanceledEvent))
+	})
+}
+
+// decoders for Overlay events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Overlay.inspectNodeRequested":   cdp.Decode[InspectNodeRequestedEvent],
+	"Overlay.nodeHighlightRequested": cdp.Decode[NodeHighlightRequestedEvent],
+	"Overlay.screenshotRequested":    cdp.Decode[ScreenshotRequestedEvent],
+	"Overlay.inspectModeCanceled":    cdp.Decode[InspectModeCanceledEvent],
+}
+
+// DecodeEvent decodes a Overlay event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,6915 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package page is the Page domain of the chrome devtools protocol
+//
+// Actions and events related to the inspected page belong to the page domain.
+package page
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Page commands and listens for Page events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	FrameId  = cdp.PageFrameId
+	Viewport = cdp.PageViewport
+)
+
+// Indicates whether a frame has been identified as an ad.
+//
+// Experimental: this may change or be removed in any chrome release
+type AdFrameType string
+
+// AdFrameType values
+const (
+	AdFrameTypeNone  AdFrameType = "none"
+	AdFrameTypeChild AdFrameType = "child"
+	AdFrameTypeRoot  AdFrameType = "root"
+)
+
+// Values gives every AdFrameType
+func (AdFrameType) Values() []AdFrameType {
+	return []AdFrameType{
+		AdFrameTypeNone,
+		AdFrameTypeChild,
+		AdFrameTypeRoot,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AdFrameType) Valid() bool {
+	switch v {
+	case AdFrameTypeNone, AdFrameTypeChild, AdFrameTypeRoot:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AdFrameExplanation string
+
+// AdFrameExplanation values
+const (
+	AdFrameExplanationParentIsAd          AdFrameExplanation = "ParentIsAd"
+	AdFrameExplanationCreatedByAdScript   AdFrameExplanation = "CreatedByAdScript"
+	AdFrameExplanationMatchedBlockingRule AdFrameExplanation = "MatchedBlockingRule"
+)
+
+// Values gives every AdFrameExplanation
+func (AdFrameExplanation) Values() []AdFrameExplanation {
+	return []AdFrameExplanation{
+		AdFrameExplanationParentIsAd,
+		AdFrameExplanationCreatedByAdScript,
+		AdFrameExplanationMatchedBlockingRule,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AdFrameExplanation) Valid() bool {
+	switch v {
+	case AdFrameExplanationParentIsAd, AdFrameExplanationCreatedByAdScript, AdFrameExplanationMatchedBlockingRule:
+		return true
+	}
+	return false
+}
+
+// Indicates whether a frame has been identified as an ad and why.
+//
+// Experimental: this may change or be removed in any chrome release
+type AdFrameStatus struct {
+	AdFrameType  AdFrameType          `json:"adFrameType"`
+	Explanations []AdFrameExplanation `json:"explanations"`
+}
+
+// MarshalCDP writes AdFrameStatus as JSON
+func (v *AdFrameStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("adFrameType")
+	e.String(string(v.AdFrameType))
+	if v.Explanations != nil {
+		e.Key("explanations")
+		e.ArrayStart()
+		for i0 := range v.Explanations {
+			e.Elem()
+			e.String(string(v.Explanations[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AdFrameStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AdFrameStatus from JSON
+func (v *AdFrameStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "adFrameType":
+			v.AdFrameType = AdFrameType(d.String())
+		case "explanations":
+			if d.Array() {
+				v.Explanations = make([]AdFrameExplanation, 0)
+				for d.More() {
+					var x0 AdFrameExplanation
+					x0 = AdFrameExplanation(d.String())
+					v.Explanations = append(v.Explanations, x0)
+				}
+			} else {
+				v.Explanations = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AdFrameStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Identifies the script which caused a script or frame to be labelled as an
+// ad.
+//
+// Experimental: this may change or be removed in any chrome release
+type AdScriptId struct {
+	/* Script Id of the script which caused a script or frame to be labelled as
+	an ad. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+	/* Id of scriptId's debugger. */
+	DebuggerId cdp.RuntimeUniqueDebuggerId `json:"debuggerId"`
+}
+
+// MarshalCDP writes AdScriptId as JSON
+func (v *AdScriptId) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("debuggerId")
+	e.String(string(v.DebuggerId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AdScriptId) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AdScriptId from JSON
+func (v *AdScriptId) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = cdp.RuntimeScriptId(d.String())
+		case "debuggerId":
+			v.DebuggerId = cdp.RuntimeUniqueDebuggerId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AdScriptId) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Encapsulates the script ancestry and the root script filterlist rule that
+// caused the frame to be labelled as an ad. Only created when `ancestryChain`
+// is not empty.
+//
+// Experimental: this may change or be removed in any chrome release
+type AdScriptAncestry struct {
+	/* A chain of `AdScriptId`s representing the ancestry of an ad script that
+	led to the creation of a frame. The chain is ordered from the script
+	itself (lower level) up to its root ancestor that was flagged by
+	filterlist. */
+	AncestryChain []AdScriptId `json:"ancestryChain"`
+	/* The filterlist rule that caused the root (last) script in
+	`ancestryChain` to be ad-tagged. Only populated if the rule is
+	available. */
+	RootScriptFilterlistRule *string `json:"rootScriptFilterlistRule,omitempty"`
+}
+
+// MarshalCDP writes AdScriptAncestry as JSON
+func (v *AdScriptAncestry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ancestryChain")
+	if v.AncestryChain == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AncestryChain {
+			e.Elem()
+			v.AncestryChain[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.RootScriptFilterlistRule != nil {
+		e.Key("rootScriptFilterlistRule")
+		e.String(*v.RootScriptFilterlistRule)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AdScriptAncestry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AdScriptAncestry from JSON
+func (v *AdScriptAncestry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "ancestryChain":
+			if d.Array() {
+				v.AncestryChain = make([]AdScriptId, 0)
+				for d.More() {
+					var x0 AdScriptId
+					x0.UnmarshalCDP(d)
+					v.AncestryChain = append(v.AncestryChain, x0)
+				}
+			} else {
+				v.AncestryChain = nil
+			}
+		case "rootScriptFilterlistRule":
+			if d.Null() {
+				v.RootScriptFilterlistRule = nil
+			} else {
+				v.RootScriptFilterlistRule = new(string)
+				*v.RootScriptFilterlistRule = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AdScriptAncestry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Indicates whether the frame is a secure context and why it is the case.
+//
+// Experimental: this may change or be removed in any chrome release
+type SecureContextType string
+
+// SecureContextType values
+const (
+	SecureContextTypeSecure           SecureContextType = "Secure"
+	SecureContextTypeSecureLocalhost  SecureContextType = "SecureLocalhost"
+	SecureContextTypeInsecureScheme   SecureContextType = "InsecureScheme"
+	SecureContextTypeInsecureAncestor SecureContextType = "InsecureAncestor"
+)
+
+// Values gives every SecureContextType
+func (SecureContextType) Values() []SecureContextType {
+	return []SecureContextType{
+		SecureContextTypeSecure,
+		SecureContextTypeSecureLocalhost,
+		SecureContextTypeInsecureScheme,
+		SecureContextTypeInsecureAncestor,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SecureContextType) Valid() bool {
+	switch v {
+	case SecureContextTypeSecure, SecureContextTypeSecureLocalhost, SecureContextTypeInsecureScheme, SecureContextTypeInsecureAncestor:
+		return true
+	}
+	return false
+}
+
+// Indicates whether the frame is cross-origin isolated and why it is the case.
+//
+// Experimental: this may change or be removed in any chrome release
+type CrossOriginIsolatedContextType string
+
+// CrossOriginIsolatedContextType values
+const (
+	CrossOriginIsolatedContextTypeIsolated                   CrossOriginIsolatedContextType = "Isolated"
+	CrossOriginIsolatedContextTypeNotIsolated                CrossOriginIsolatedContextType = "NotIsolated"
+	CrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled CrossOriginIsolatedContextType = "NotIsolatedFeatureDisabled"
+)
+
+// Values gives every CrossOriginIsolatedContextType
+func (CrossOriginIsolatedContextType) Values() []CrossOriginIsolatedContextType {
+	return []CrossOriginIsolatedContextType{
+		CrossOriginIsolatedContextTypeIsolated,
+		CrossOriginIsolatedContextTypeNotIsolated,
+		CrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CrossOriginIsolatedContextType) Valid() bool {
+	switch v {
+	case CrossOriginIsolatedContextTypeIsolated, CrossOriginIsolatedContextTypeNotIsolated, CrossOriginIsolatedContextTypeNotIsolatedFeatureDisabled:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type GatedAPIFeatures string
+
+// GatedAPIFeatures values
+const (
+	GatedAPIFeaturesSharedArrayBuffers                GatedAPIFeatures = "SharedArrayBuffers"
+	GatedAPIFeaturesSharedArrayBuffersTransferAllowed GatedAPIFeatures = "SharedArrayBuffersTransferAllowed"
+	GatedAPIFeaturesPerformanceMeasureMemory          GatedAPIFeatures = "PerformanceMeasureMemory"
+	GatedAPIFeaturesPerformanceProfile                GatedAPIFeatures = "PerformanceProfile"
+)
+
+// Values gives every GatedAPIFeatures
+func (GatedAPIFeatures) Values() []GatedAPIFeatures {
+	return []GatedAPIFeatures{
+		GatedAPIFeaturesSharedArrayBuffers,
+		GatedAPIFeaturesSharedArrayBuffersTransferAllowed,
+		GatedAPIFeaturesPerformanceMeasureMemory,
+		GatedAPIFeaturesPerformanceProfile,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v GatedAPIFeatures) Valid() bool {
+	switch v {
+	case GatedAPIFeaturesSharedArrayBuffers, GatedAPIFeaturesSharedArrayBuffersTransferAllowed, GatedAPIFeaturesPerformanceMeasureMemory, GatedAPIFeaturesPerformanceProfile:
+		return true
+	}
+	return false
+}
+
+// All Permissions Policy features. This enum should match the one defined
+// in services/network/public/cpp/permissions_policy/permissions_policy_features.json5.
+// LINT.IfChange(PermissionsPolicyFeature)
+//
+// Experimental: this may change or be removed in any chrome release
+type PermissionsPolicyFeature string
+
+// PermissionsPolicyFeature values
+const (
+	PermissionsPolicyFeatureAccelerometer                  PermissionsPolicyFeature = "accelerometer"
+	PermissionsPolicyFeatureAllScreensCapture              PermissionsPolicyFeature = "all-screens-capture"
+	PermissionsPolicyFeatureAmbientLightSensor             PermissionsPolicyFeature = "ambient-light-sensor"
+	PermissionsPolicyFeatureAriaNotify                     PermissionsPolicyFeature = "aria-notify"
+	PermissionsPolicyFeatureAttributionReporting           PermissionsPolicyFeature = "attribution-reporting"
+	PermissionsPolicyFeatureAutoplay                       PermissionsPolicyFeature = "autoplay"
+	PermissionsPolicyFeatureBluetooth                      PermissionsPolicyFeature = "bluetooth"
+	PermissionsPolicyFeatureBrowsingTopics                 PermissionsPolicyFeature = "browsing-topics"
+	PermissionsPolicyFeatureCamera                         PermissionsPolicyFeature = "camera"
+	PermissionsPolicyFeatureCapturedSurfaceControl         PermissionsPolicyFeature = "captured-surface-control"
+	PermissionsPolicyFeatureChDpr                          PermissionsPolicyFeature = "ch-dpr"
+	PermissionsPolicyFeatureChDeviceMemory                 PermissionsPolicyFeature = "ch-device-memory"
+	PermissionsPolicyFeatureChDownlink                     PermissionsPolicyFeature = "ch-downlink"
+	PermissionsPolicyFeatureChEct                          PermissionsPolicyFeature = "ch-ect"
+	PermissionsPolicyFeatureChPrefersColorScheme           PermissionsPolicyFeature = "ch-prefers-color-scheme"
+	PermissionsPolicyFeatureChPrefersReducedMotion         PermissionsPolicyFeature = "ch-prefers-reduced-motion"
+	PermissionsPolicyFeatureChPrefersReducedTransparency   PermissionsPolicyFeature = "ch-prefers-reduced-transparency"
+	PermissionsPolicyFeatureChRtt                          PermissionsPolicyFeature = "ch-rtt"
+	PermissionsPolicyFeatureChSaveData                     PermissionsPolicyFeature = "ch-save-data"
+	PermissionsPolicyFeatureChUa                           PermissionsPolicyFeature = "ch-ua"
+	PermissionsPolicyFeatureChUaArch                       PermissionsPolicyFeature = "ch-ua-arch"
+	PermissionsPolicyFeatureChUaBitness                    PermissionsPolicyFeature = "ch-ua-bitness"
+	PermissionsPolicyFeatureChUaHighEntropyValues          PermissionsPolicyFeature = "ch-ua-high-entropy-values"
+	PermissionsPolicyFeatureChUaPlatform                   PermissionsPolicyFeature = "ch-ua-platform"
+	PermissionsPolicyFeatureChUaModel                      PermissionsPolicyFeature = "ch-ua-model"
+	PermissionsPolicyFeatureChUaMobile                     PermissionsPolicyFeature = "ch-ua-mobile"
+	PermissionsPolicyFeatureChUaFormFactors                PermissionsPolicyFeature = "ch-ua-form-factors"
+	PermissionsPolicyFeatureChUaFullVersion                PermissionsPolicyFeature = "ch-ua-full-version"
+	PermissionsPolicyFeatureChUaFullVersionList            PermissionsPolicyFeature = "ch-ua-full-version-list"
+	PermissionsPolicyFeatureChUaPlatformVersion            PermissionsPolicyFeature = "ch-ua-platform-version"
+	PermissionsPolicyFeatureChUaWow64                      PermissionsPolicyFeature = "ch-ua-wow64"
+	PermissionsPolicyFeatureChViewportHeight               PermissionsPolicyFeature = "ch-viewport-height"
+	PermissionsPolicyFeatureChViewportWidth                PermissionsPolicyFeature = "ch-viewport-width"
+	PermissionsPolicyFeatureChWidth                        PermissionsPolicyFeature = "ch-width"
+	PermissionsPolicyFeatureClipboardRead                  PermissionsPolicyFeature = "clipboard-read"
+	PermissionsPolicyFeatureClipboardWrite                 PermissionsPolicyFeature = "clipboard-write"
+	PermissionsPolicyFeatureComputePressure                PermissionsPolicyFeature = "compute-pressure"
+	PermissionsPolicyFeatureControlledFrame                PermissionsPolicyFeature = "controlled-frame"
+	PermissionsPolicyFeatureCrossOriginIsolated            PermissionsPolicyFeature = "cross-origin-isolated"
+	PermissionsPolicyFeatureDeferredFetch                  PermissionsPolicyFeature = "deferred-fetch"
+	PermissionsPolicyFeatureDeferredFetchMinimal           PermissionsPolicyFeature = "deferred-fetch-minimal"
+	PermissionsPolicyFeatureDeviceAttributes               PermissionsPolicyFeature = "device-attributes"
+	PermissionsPolicyFeatureDigitalCredentialsGet          PermissionsPolicyFeature = "digital-credentials-get"
+	PermissionsPolicyFeatureDirectSockets                  PermissionsPolicyFeature = "direct-sockets"
+	PermissionsPolicyFeatureDirectSocketsPrivate           PermissionsPolicyFeature = "direct-sockets-private"
+	PermissionsPolicyFeatureDisplayCapture                 PermissionsPolicyFeature = "display-capture"
+	PermissionsPolicyFeatureDocumentDomain                 PermissionsPolicyFeature = "document-domain"
+	PermissionsPolicyFeatureEncryptedMedia                 PermissionsPolicyFeature = "encrypted-media"
+	PermissionsPolicyFeatureExecutionWhileOutOfViewport    PermissionsPolicyFeature = "execution-while-out-of-viewport"
+	PermissionsPolicyFeatureExecutionWhileNotRendered      PermissionsPolicyFeature = "execution-while-not-rendered"
+	PermissionsPolicyFeatureFencedUnpartitionedStorageRead PermissionsPolicyFeature = "fenced-unpartitioned-storage-read"
+	PermissionsPolicyFeatureFocusWithoutUserActivation     PermissionsPolicyFeature = "focus-without-user-activation"
+	PermissionsPolicyFeatureFullscreen                     PermissionsPolicyFeature = "fullscreen"
+	PermissionsPolicyFeatureFrobulate                      PermissionsPolicyFeature = "frobulate"
+	PermissionsPolicyFeatureGamepad                        PermissionsPolicyFeature = "gamepad"
+	PermissionsPolicyFeatureGeolocation                    PermissionsPolicyFeature = "geolocation"
+	PermissionsPolicyFeatureGyroscope                      PermissionsPolicyFeature = "gyroscope"
+	PermissionsPolicyFeatureHid                            PermissionsPolicyFeature = "hid"
+	PermissionsPolicyFeatureIdentityCredentialsGet         PermissionsPolicyFeature = "identity-credentials-get"
+	PermissionsPolicyFeatureIdleDetection                  PermissionsPolicyFeature = "idle-detection"
+	PermissionsPolicyFeatureInterestCohort                 PermissionsPolicyFeature = "interest-cohort"
+	PermissionsPolicyFeatureJoinAdInterestGroup            PermissionsPolicyFeature = "join-ad-interest-group"
+	PermissionsPolicyFeatureKeyboardMap                    PermissionsPolicyFeature = "keyboard-map"
+	PermissionsPolicyFeatureLanguageDetector               PermissionsPolicyFeature = "language-detector"
+	PermissionsPolicyFeatureLanguageModel                  PermissionsPolicyFeature = "language-model"
+	PermissionsPolicyFeatureLocalFonts                     PermissionsPolicyFeature = "local-fonts"
+	PermissionsPolicyFeatureLocalNetworkAccess             PermissionsPolicyFeature = "local-network-access"
+	PermissionsPolicyFeatureMagnetometer                   PermissionsPolicyFeature = "magnetometer"
+	PermissionsPolicyFeatureMediaPlaybackWhileNotVisible   PermissionsPolicyFeature = "media-playback-while-not-visible"
+	PermissionsPolicyFeatureMicrophone                     PermissionsPolicyFeature = "microphone"
+	PermissionsPolicyFeatureMidi                           PermissionsPolicyFeature = "midi"
+	PermissionsPolicyFeatureOnDeviceSpeechRecognition      PermissionsPolicyFeature = "on-device-speech-recognition"
+	PermissionsPolicyFeatureOtpCredentials                 PermissionsPolicyFeature = "otp-credentials"
+	PermissionsPolicyFeaturePayment                        PermissionsPolicyFeature = "payment"
+	PermissionsPolicyFeaturePictureInPicture               PermissionsPolicyFeature = "picture-in-picture"
+	PermissionsPolicyFeaturePopins                         PermissionsPolicyFeature = "popins"
+	PermissionsPolicyFeaturePrivateAggregation             PermissionsPolicyFeature = "private-aggregation"
+	PermissionsPolicyFeaturePrivateStateTokenIssuance      PermissionsPolicyFeature = "private-state-token-issuance"
+	PermissionsPolicyFeaturePrivateStateTokenRedemption    PermissionsPolicyFeature = "private-state-token-redemption"
+	PermissionsPolicyFeaturePublickeyCredentialsCreate     PermissionsPolicyFeature = "publickey-credentials-create"
+	PermissionsPolicyFeaturePublickeyCredentialsGet        PermissionsPolicyFeature = "publickey-credentials-get"
+	PermissionsPolicyFeatureRecordAdAuctionEvents          PermissionsPolicyFeature = "record-ad-auction-events"
+	PermissionsPolicyFeatureRewriter                       PermissionsPolicyFeature = "rewriter"
+	PermissionsPolicyFeatureRunAdAuction                   PermissionsPolicyFeature = "run-ad-auction"
+	PermissionsPolicyFeatureScreenWakeLock                 PermissionsPolicyFeature = "screen-wake-lock"
+	PermissionsPolicyFeatureSerial                         PermissionsPolicyFeature = "serial"
+	PermissionsPolicyFeatureSharedAutofill                 PermissionsPolicyFeature = "shared-autofill"
+	PermissionsPolicyFeatureSharedStorage                  PermissionsPolicyFeature = "shared-storage"
+	PermissionsPolicyFeatureSharedStorageSelectUrl         PermissionsPolicyFeature = "shared-storage-select-url"
+	PermissionsPolicyFeatureSmartCard                      PermissionsPolicyFeature = "smart-card"
+	PermissionsPolicyFeatureSpeakerSelection               PermissionsPolicyFeature = "speaker-selection"
+	PermissionsPolicyFeatureStorageAccess                  PermissionsPolicyFeature = "storage-access"
+	PermissionsPolicyFeatureSubApps                        PermissionsPolicyFeature = "sub-apps"
+	PermissionsPolicyFeatureSummarizer                     PermissionsPolicyFeature = "summarizer"
+	PermissionsPolicyFeatureSyncXhr                        PermissionsPolicyFeature = "sync-xhr"
+	PermissionsPolicyFeatureTranslator                     PermissionsPolicyFeature = "translator"
+	PermissionsPolicyFeatureUnload                         PermissionsPolicyFeature = "unload"
+	PermissionsPolicyFeatureUsb                            PermissionsPolicyFeature = "usb"
+	PermissionsPolicyFeatureUsbUnrestricted                PermissionsPolicyFeature = "usb-unrestricted"
+	PermissionsPolicyFeatureVerticalScroll                 PermissionsPolicyFeature = "vertical-scroll"
+	PermissionsPolicyFeatureWebAppInstallation             PermissionsPolicyFeature = "web-app-installation"
+	PermissionsPolicyFeatureWebPrinting                    PermissionsPolicyFeature = "web-printing"
+	PermissionsPolicyFeatureWebShare                       PermissionsPolicyFeature = "web-share"
+	PermissionsPolicyFeatureWindowManagement               PermissionsPolicyFeature = "window-management"
+	PermissionsPolicyFeatureWriter                         PermissionsPolicyFeature = "writer"
+	PermissionsPolicyFeatureXrSpatialTracking              PermissionsPolicyFeature = "xr-spatial-tracking"
+)
+
+// Values gives every PermissionsPolicyFeature
+func (PermissionsPolicyFeature) Values() []PermissionsPolicyFeature {
+	return []PermissionsPolicyFeature{
+		PermissionsPolicyFeatureAccelerometer,
+		PermissionsPolicyFeatureAllScreensCapture,
+		PermissionsPolicyFeatureAmbientLightSensor,
+		PermissionsPolicyFeatureAriaNotify,
+		PermissionsPolicyFeatureAttributionReporting,
+		PermissionsPolicyFeatureAutoplay,
+		PermissionsPolicyFeatureBluetooth,
+		PermissionsPolicyFeatureBrowsingTopics,
+		PermissionsPolicyFeatureCamera,
+		PermissionsPolicyFeatureCapturedSurfaceControl,
+		PermissionsPolicyFeatureChDpr,
+		PermissionsPolicyFeatureChDeviceMemory,
+		PermissionsPolicyFeatureChDownlink,
+		PermissionsPolicyFeatureChEct,
+		PermissionsPolicyFeatureChPrefersColorScheme,
+		PermissionsPolicyFeatureChPrefersReducedMotion,
+		PermissionsPolicyFeatureChPrefersReducedTransparency,
+		PermissionsPolicyFeatureChRtt,
+		PermissionsPolicyFeatureChSaveData,
+		PermissionsPolicyFeatureChUa,
+		PermissionsPolicyFeatureChUaArch,
+		PermissionsPolicyFeatureChUaBitness,
+		PermissionsPolicyFeatureChUaHighEntropyValues,
+		PermissionsPolicyFeatureChUaPlatform,
+		PermissionsPolicyFeatureChUaModel,
+		PermissionsPolicyFeatureChUaMobile,
+		PermissionsPolicyFeatureChUaFormFactors,
+		PermissionsPolicyFeatureChUaFullVersion,
+		PermissionsPolicyFeatureChUaFullVersionList,
+		PermissionsPolicyFeatureChUaPlatformVersion,
+		PermissionsPolicyFeatureChUaWow64,
+		PermissionsPolicyFeatureChViewportHeight,
+		PermissionsPolicyFeatureChViewportWidth,
+		PermissionsPolicyFeatureChWidth,
+		PermissionsPolicyFeatureClipboardRead,
+		PermissionsPolicyFeatureClipboardWrite,
+		PermissionsPolicyFeatureComputePressure,
+		PermissionsPolicyFeatureControlledFrame,
+		PermissionsPolicyFeatureCrossOriginIsolated,
+		PermissionsPolicyFeatureDeferredFetch,
+		PermissionsPolicyFeatureDeferredFetchMinimal,
+		PermissionsPolicyFeatureDeviceAttributes,
+		PermissionsPolicyFeatureDigitalCredentialsGet,
+		PermissionsPolicyFeatureDirectSockets,
+		PermissionsPolicyFeatureDirectSocketsPrivate,
+		PermissionsPolicyFeatureDisplayCapture,
+		PermissionsPolicyFeatureDocumentDomain,
+		PermissionsPolicyFeatureEncryptedMedia,
+		PermissionsPolicyFeatureExecutionWhileOutOfViewport,
+		PermissionsPolicyFeatureExecutionWhileNotRendered,
+		PermissionsPolicyFeatureFencedUnpartitionedStorageRead,
+		PermissionsPolicyFeatureFocusWithoutUserActivation,
+		PermissionsPolicyFeatureFullscreen,
+		PermissionsPolicyFeatureFrobulate,
+		PermissionsPolicyFeatureGamepad,
+		PermissionsPolicyFeatureGeolocation,
+		PermissionsPolicyFeatureGyroscope,
+		PermissionsPolicyFeatureHid,
+		PermissionsPolicyFeatureIdentityCredentialsGet,
+		PermissionsPolicyFeatureIdleDetection,
+		PermissionsPolicyFeatureInterestCohort,
+		PermissionsPolicyFeatureJoinAdInterestGroup,
+		PermissionsPolicyFeatureKeyboardMap,
+		PermissionsPolicyFeatureLanguageDetector,
+		PermissionsPolicyFeatureLanguageModel,
+		PermissionsPolicyFeatureLocalFonts,
+		PermissionsPolicyFeatureLocalNetworkAccess,
+		PermissionsPolicyFeatureMagnetometer,
+		PermissionsPolicyFeatureMediaPlaybackWhileNotVisible,
+		PermissionsPolicyFeatureMicrophone,
+		PermissionsPolicyFeatureMidi,
+		PermissionsPolicyFeatureOnDeviceSpeechRecognition,
+		PermissionsPolicyFeatureOtpCredentials,
+		PermissionsPolicyFeaturePayment,
+		PermissionsPolicyFeaturePictureInPicture,
+		PermissionsPolicyFeaturePopins,
+		PermissionsPolicyFeaturePrivateAggregation,
+		PermissionsPolicyFeaturePrivateStateTokenIssuance,
+		PermissionsPolicyFeaturePrivateStateTokenRedemption,
+		PermissionsPolicyFeaturePublickeyCredentialsCreate,
+		PermissionsPolicyFeaturePublickeyCredentialsGet,
+		PermissionsPolicyFeatureRecordAdAuctionEvents,
+		PermissionsPolicyFeatureRewriter,
+		PermissionsPolicyFeatureRunAdAuction,
+		PermissionsPolicyFeatureScreenWakeLock,
+		PermissionsPolicyFeatureSerial,
+		PermissionsPolicyFeatureSharedAutofill,
+		PermissionsPolicyFeatureSharedStorage,
+		PermissionsPolicyFeatureSharedStorageSelectUrl,
+		PermissionsPolicyFeatureSmartCard,
+		PermissionsPolicyFeatureSpeakerSelection,
+		PermissionsPolicyFeatureStorageAccess,
+		PermissionsPolicyFeatureSubApps,
+		PermissionsPolicyFeatureSummarizer,
+		PermissionsPolicyFeatureSyncXhr,
+		PermissionsPolicyFeatureTranslator,
+		PermissionsPolicyFeatureUnload,
+		PermissionsPolicyFeatureUsb,
+		PermissionsPolicyFeatureUsbUnrestricted,
+		PermissionsPolicyFeatureVerticalScroll,
+		PermissionsPolicyFeatureWebAppInstallation,
+		PermissionsPolicyFeatureWebPrinting,
+		PermissionsPolicyFeatureWebShare,
+		PermissionsPolicyFeatureWindowManagement,
+		PermissionsPolicyFeatureWriter,
+		PermissionsPolicyFeatureXrSpatialTracking,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PermissionsPolicyFeature) Valid() bool {
+	switch v {
+	case PermissionsPolicyFeatureAccelerometer, PermissionsPolicyFeatureAllScreensCapture, PermissionsPolicyFeatureAmbientLightSensor, PermissionsPolicyFeatureAriaNotify, PermissionsPolicyFeatureAttributionReporting, PermissionsPolicyFeatureAutoplay, PermissionsPolicyFeatureBluetooth, PermissionsPolicyFeatureBrowsingTopics, PermissionsPolicyFeatureCamera, PermissionsPolicyFeatureCapturedSurfaceControl, PermissionsPolicyFeatureChDpr, PermissionsPolicyFeatureChDeviceMemory, PermissionsPolicyFeatureChDownlink, PermissionsPolicyFeatureChEct, PermissionsPolicyFeatureChPrefersColorScheme, PermissionsPolicyFeatureChPrefersReducedMotion, PermissionsPolicyFeatureChPrefersReducedTransparency, PermissionsPolicyFeatureChRtt, PermissionsPolicyFeatureChSaveData, PermissionsPolicyFeatureChUa, PermissionsPolicyFeatureChUaArch, PermissionsPolicyFeatureChUaBitness, PermissionsPolicyFeatureChUaHighEntropyValues, PermissionsPolicyFeatureChUaPlatform, PermissionsPolicyFeatureChUaModel, PermissionsPolicyFeatureChUaMobile, PermissionsPolicyFeatureChUaFormFactors, PermissionsPolicyFeatureChUaFullVersion, PermissionsPolicyFeatureChUaFullVersionList, PermissionsPolicyFeatureChUaPlatformVersion, PermissionsPolicyFeatureChUaWow64, PermissionsPolicyFeatureChViewportHeight, PermissionsPolicyFeatureChViewportWidth, PermissionsPolicyFeatureChWidth, PermissionsPolicyFeatureClipboardRead, PermissionsPolicyFeatureClipboardWrite, PermissionsPolicyFeatureComputePressure, PermissionsPolicyFeatureControlledFrame, PermissionsPolicyFeatureCrossOriginIsolated, PermissionsPolicyFeatureDeferredFetch, PermissionsPolicyFeatureDeferredFetchMinimal, PermissionsPolicyFeatureDeviceAttributes, PermissionsPolicyFeatureDigitalCredentialsGet, PermissionsPolicyFeatureDirectSockets, PermissionsPolicyFeatureDirectSocketsPrivate, PermissionsPolicyFeatureDisplayCapture, PermissionsPolicyFeatureDocumentDomain, PermissionsPolicyFeatureEncryptedMedia, PermissionsPolicyFeatureExecutionWhileOutOfViewport, PermissionsPolicyFeatureExecutionWhileNotRendered, PermissionsPolicyFeatureFencedUnpartitionedStorageRead, PermissionsPolicyFeatureFocusWithoutUserActivation, PermissionsPolicyFeatureFullscreen, PermissionsPolicyFeatureFrobulate, PermissionsPolicyFeatureGamepad, PermissionsPolicyFeatureGeolocation, PermissionsPolicyFeatureGyroscope, PermissionsPolicyFeatureHid, PermissionsPolicyFeatureIdentityCredentialsGet, PermissionsPolicyFeatureIdleDetection, PermissionsPolicyFeatureInterestCohort, PermissionsPolicyFeatureJoinAdInterestGroup, PermissionsPolicyFeatureKeyboardMap, PermissionsPolicyFeatureLanguageDetector, PermissionsPolicyFeatureLanguageModel, PermissionsPolicyFeatureLocalFonts, PermissionsPolicyFeatureLocalNetworkAccess, PermissionsPolicyFeatureMagnetometer, PermissionsPolicyFeatureMediaPlaybackWhileNotVisible, PermissionsPolicyFeatureMicrophone, PermissionsPolicyFeatureMidi, PermissionsPolicyFeatureOnDeviceSpeechRecognition, PermissionsPolicyFeatureOtpCredentials, PermissionsPolicyFeaturePayment, PermissionsPolicyFeaturePictureInPicture, PermissionsPolicyFeaturePopins, PermissionsPolicyFeaturePrivateAggregation, PermissionsPolicyFeaturePrivateStateTokenIssuance, PermissionsPolicyFeaturePrivateStateTokenRedemption, PermissionsPolicyFeaturePublickeyCredentialsCreate, PermissionsPolicyFeaturePublickeyCredentialsGet, PermissionsPolicyFeatureRecordAdAuctionEvents, PermissionsPolicyFeatureRewriter, PermissionsPolicyFeatureRunAdAuction, PermissionsPolicyFeatureScreenWakeLock, PermissionsPolicyFeatureSerial, PermissionsPolicyFeatureSharedAutofill, PermissionsPolicyFeatureSharedStorage, PermissionsPolicyFeatureSharedStorageSelectUrl, PermissionsPolicyFeatureSmartCard, PermissionsPolicyFeatureSpeakerSelection, PermissionsPolicyFeatureStorageAccess, PermissionsPolicyFeatureSubApps, PermissionsPolicyFeatureSummarizer, PermissionsPolicyFeatureSyncXhr, PermissionsPolicyFeatureTranslator, PermissionsPolicyFeatureUnload, PermissionsPolicyFeatureUsb, PermissionsPolicyFeatureUsbUnrestricted, PermissionsPolicyFeatureVerticalScroll, PermissionsPolicyFeatureWebAppInstallation, PermissionsPolicyFeatureWebPrinting, PermissionsPolicyFeatureWebShare, PermissionsPolicyFeatureWindowManagement, PermissionsPolicyFeatureWriter, PermissionsPolicyFeatureXrSpatialTracking:
+		return true
+	}
+	return false
+}
+
+// Reason for a permissions policy feature to be disabled.
+//
+// Experimental: this may change or be removed in any chrome release
+type PermissionsPolicyBlockReason string
+
+// PermissionsPolicyBlockReason values
+const (
+	PermissionsPolicyBlockReasonHeader            PermissionsPolicyBlockReason = "Header"
+	PermissionsPolicyBlockReasonIframeAttribute   PermissionsPolicyBlockReason = "IframeAttribute"
+	PermissionsPolicyBlockReasonInFencedFrameTree PermissionsPolicyBlockReason = "InFencedFrameTree"
+	PermissionsPolicyBlockReasonInIsolatedApp     PermissionsPolicyBlockReason = "InIsolatedApp"
+)
+
+// Values gives every PermissionsPolicyBlockReason
+func (PermissionsPolicyBlockReason) Values() []PermissionsPolicyBlockReason {
+	return []PermissionsPolicyBlockReason{
+		PermissionsPolicyBlockReasonHeader,
+		PermissionsPolicyBlockReasonIframeAttribute,
+		PermissionsPolicyBlockReasonInFencedFrameTree,
+		PermissionsPolicyBlockReasonInIsolatedApp,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PermissionsPolicyBlockReason) Valid() bool {
+	switch v {
+	case PermissionsPolicyBlockReasonHeader, PermissionsPolicyBlockReasonIframeAttribute, PermissionsPolicyBlockReasonInFencedFrameTree, PermissionsPolicyBlockReasonInIsolatedApp:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PermissionsPolicyBlockLocator struct {
+	FrameId     FrameId                      `json:"frameId"`
+	BlockReason PermissionsPolicyBlockReason `json:"blockReason"`
+}
+
+// MarshalCDP writes PermissionsPolicyBlockLocator as JSON
+func (v *PermissionsPolicyBlockLocator) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("blockReason")
+	e.String(string(v.BlockReason))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PermissionsPolicyBlockLocator) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PermissionsPolicyBlockLocator from JSON
+func (v *PermissionsPolicyBlockLocator) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "blockReason":
+			v.BlockReason = PermissionsPolicyBlockReason(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PermissionsPolicyBlockLocator) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type PermissionsPolicyFeatureState struct {
+	Feature PermissionsPolicyFeature       `json:"feature"`
+	Allowed bool                           `json:"allowed"`
+	Locator *PermissionsPolicyBlockLocator `json:"locator,omitempty"`
+}
+
+// MarshalCDP writes PermissionsPolicyFeatureState as JSON
+func (v *PermissionsPolicyFeatureState) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("feature")
+	e.String(string(v.Feature))
+	e.Key("allowed")
+	e.Bool(v.Allowed)
+	if v.Locator != nil {
+		e.Key("locator")
+		(*v.Locator).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PermissionsPolicyFeatureState) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PermissionsPolicyFeatureState from JSON
+func (v *PermissionsPolicyFeatureState) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "feature":
+			v.Feature = PermissionsPolicyFeature(d.String())
+		case "allowed":
+			v.Allowed = d.Bool()
+		case "locator":
+			if d.Null() {
+				v.Locator = nil
+			} else {
+				v.Locator = new(PermissionsPolicyBlockLocator)
+				(*v.Locator).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PermissionsPolicyFeatureState) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Origin Trial(https://www.chromium.org/blink/origin-trials) support.
+// Status for an Origin Trial token.
+//
+// Experimental: this may change or be removed in any chrome release
+type OriginTrialTokenStatus string
+
+// OriginTrialTokenStatus values
+const (
+	OriginTrialTokenStatusSuccess                OriginTrialTokenStatus = "Success"
+	OriginTrialTokenStatusNotSupported           OriginTrialTokenStatus = "NotSupported"
+	OriginTrialTokenStatusInsecure               OriginTrialTokenStatus = "Insecure"
+	OriginTrialTokenStatusExpired                OriginTrialTokenStatus = "Expired"
+	OriginTrialTokenStatusWrongOrigin            OriginTrialTokenStatus = "WrongOrigin"
+	OriginTrialTokenStatusInvalidSignature       OriginTrialTokenStatus = "InvalidSignature"
+	OriginTrialTokenStatusMalformed              OriginTrialTokenStatus = "Malformed"
+	OriginTrialTokenStatusWrongVersion           OriginTrialTokenStatus = "WrongVersion"
+	OriginTrialTokenStatusFeatureDisabled        OriginTrialTokenStatus = "FeatureDisabled"
+	OriginTrialTokenStatusTokenDisabled          OriginTrialTokenStatus = "TokenDisabled"
+	OriginTrialTokenStatusFeatureDisabledForUser OriginTrialTokenStatus = "FeatureDisabledForUser"
+	OriginTrialTokenStatusUnknownTrial           OriginTrialTokenStatus = "UnknownTrial"
+)
+
+// Values gives every OriginTrialTokenStatus
+func (OriginTrialTokenStatus) Values() []OriginTrialTokenStatus {
+	return []OriginTrialTokenStatus{
+		OriginTrialTokenStatusSuccess,
+		OriginTrialTokenStatusNotSupported,
+		OriginTrialTokenStatusInsecure,
+		OriginTrialTokenStatusExpired,
+		OriginTrialTokenStatusWrongOrigin,
+		OriginTrialTokenStatusInvalidSignature,
+		OriginTrialTokenStatusMalformed,
+		OriginTrialTokenStatusWrongVersion,
+		OriginTrialTokenStatusFeatureDisabled,
+		OriginTrialTokenStatusTokenDisabled,
+		OriginTrialTokenStatusFeatureDisabledForUser,
+		OriginTrialTokenStatusUnknownTrial,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v OriginTrialTokenStatus) Valid() bool {
+	switch v {
+	case OriginTrialTokenStatusSuccess, OriginTrialTokenStatusNotSupported, OriginTrialTokenStatusInsecure, OriginTrialTokenStatusExpired, OriginTrialTokenStatusWrongOrigin, OriginTrialTokenStatusInvalidSignature, OriginTrialTokenStatusMalformed, OriginTrialTokenStatusWrongVersion, OriginTrialTokenStatusFeatureDisabled, OriginTrialTokenStatusTokenDisabled, OriginTrialTokenStatusFeatureDisabledForUser, OriginTrialTokenStatusUnknownTrial:
+		return true
+	}
+	return false
+}
+
+// Status for an Origin Trial.
+//
+// Experimental: this may change or be removed in any chrome release
+type OriginTrialStatus string
+
+// OriginTrialStatus values
+const (
+	OriginTrialStatusEnabled               OriginTrialStatus = "Enabled"
+	OriginTrialStatusValidTokenNotProvided OriginTrialStatus = "ValidTokenNotProvided"
+	OriginTrialStatusOSNotSupported        OriginTrialStatus = "OSNotSupported"
+	OriginTrialStatusTrialNotAllowed       OriginTrialStatus = "TrialNotAllowed"
+)
+
+// Values gives every OriginTrialStatus
+func (OriginTrialStatus) Values() []OriginTrialStatus {
+	return []OriginTrialStatus{
+		OriginTrialStatusEnabled,
+		OriginTrialStatusValidTokenNotProvided,
+		OriginTrialStatusOSNotSupported,
+		OriginTrialStatusTrialNotAllowed,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v OriginTrialStatus) Valid() bool {
+	switch v {
+	case OriginTrialStatusEnabled, OriginTrialStatusValidTokenNotProvided, OriginTrialStatusOSNotSupported, OriginTrialStatusTrialNotAllowed:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type OriginTrialUsageRestriction string
+
+// OriginTrialUsageRestriction values
+const (
+	OriginTrialUsageRestrictionNone   OriginTrialUsageRestriction = "None"
+	OriginTrialUsageRestrictionSubset OriginTrialUsageRestriction = "Subset"
+)
+
+// Values gives every OriginTrialUsageRestriction
+func (OriginTrialUsageRestriction) Values() []OriginTrialUsageRestriction {
+	return []OriginTrialUsageRestriction{
+		OriginTrialUsageRestrictionNone,
+		OriginTrialUsageRestrictionSubset,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v OriginTrialUsageRestriction) Valid() bool {
+	switch v {
+	case OriginTrialUsageRestrictionNone, OriginTrialUsageRestrictionSubset:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type OriginTrialToken struct {
+	Origin           string                      `json:"origin"`
+	MatchSubDomains  bool                        `json:"matchSubDomains"`
+	TrialName        string                      `json:"trialName"`
+	ExpiryTime       cdp.NetworkTimeSinceEpoch   `json:"expiryTime"`
+	IsThirdParty     bool                        `json:"isThirdParty"`
+	UsageRestriction OriginTrialUsageRestriction `json:"usageRestriction"`
+}
+
+// MarshalCDP writes OriginTrialToken as JSON
+func (v *OriginTrialToken) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("matchSubDomains")
+	e.Bool(v.MatchSubDomains)
+	e.Key("trialName")
+	e.String(v.TrialName)
+	e.Key("expiryTime")
+	e.Float(float64(v.ExpiryTime))
+	e.Key("isThirdParty")
+	e.Bool(v.IsThirdParty)
+	e.Key("usageRestriction")
+	e.String(string(v.UsageRestriction))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OriginTrialToken) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads OriginTrialToken from JSON
+func (v *OriginTrialToken) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "matchSubDomains":
+			v.MatchSubDomains = d.Bool()
+		case "trialName":
+			v.TrialName = d.String()
+		case "expiryTime":
+			v.ExpiryTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "isThirdParty":
+			v.IsThirdParty = d.Bool()
+		case "usageRestriction":
+			v.UsageRestriction = OriginTrialUsageRestriction(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OriginTrialToken) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type OriginTrialTokenWithStatus struct {
+	RawTokenText string `json:"rawTokenText"`
+	/* `parsedToken` is present only when the token is extractable and
+	parsable. */
+	ParsedToken *OriginTrialToken      `json:"parsedToken,omitempty"`
+	Status      OriginTrialTokenStatus `json:"status"`
+}
+
+// MarshalCDP writes OriginTrialTokenWithStatus as JSON
+func (v *OriginTrialTokenWithStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("rawTokenText")
+	e.String(v.RawTokenText)
+	if v.ParsedToken != nil {
+		e.Key("parsedToken")
+		(*v.ParsedToken).MarshalCDP(e)
+	}
+	e.Key("status")
+	e.String(string(v.Status))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OriginTrialTokenWithStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads OriginTrialTokenWithStatus from JSON
+func (v *OriginTrialTokenWithStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "rawTokenText":
+			v.RawTokenText = d.String()
+		case "parsedToken":
+			if d.Null() {
+				v.ParsedToken = nil
+			} else {
+				v.ParsedToken = new(OriginTrialToken)
+				(*v.ParsedToken).UnmarshalCDP(d)
+			}
+		case "status":
+			v.Status = OriginTrialTokenStatus(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OriginTrialTokenWithStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type OriginTrial struct {
+	TrialName        string                       `json:"trialName"`
+	Status           OriginTrialStatus            `json:"status"`
+	TokensWithStatus []OriginTrialTokenWithStatus `json:"tokensWithStatus"`
+}
+
+// MarshalCDP writes OriginTrial as JSON
+func (v *OriginTrial) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("trialName")
+	e.String(v.TrialName)
+	e.Key("status")
+	e.String(string(v.Status))
+	e.Key("tokensWithStatus")
+	if v.TokensWithStatus == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.TokensWithStatus {
+			e.Elem()
+			v.TokensWithStatus[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OriginTrial) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads OriginTrial from JSON
+func (v *OriginTrial) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "trialName":
+			v.TrialName = d.String()
+		case "status":
+			v.Status = OriginTrialStatus(d.String())
+		case "tokensWithStatus":
+			if d.Array() {
+				v.TokensWithStatus = make([]OriginTrialTokenWithStatus, 0)
+				for d.More() {
+					var x0 OriginTrialTokenWithStatus
+					x0.UnmarshalCDP(d)
+					v.TokensWithStatus = append(v.TokensWithStatus, x0)
+				}
+			} else {
+				v.TokensWithStatus = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OriginTrial) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Additional information about the frame document's security origin.
+//
+// Experimental: this may change or be removed in any chrome release
+type SecurityOriginDetails struct {
+	/* Indicates whether the frame document's security origin is one
+	of the local hostnames (e.g. "localhost") or IP addresses (IPv4
+	127.0.0.0/8 or IPv6 ::1). */
+	IsLocalhost bool `json:"isLocalhost"`
+}
+
+// MarshalCDP writes SecurityOriginDetails as JSON
+func (v *SecurityOriginDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("isLocalhost")
+	e.Bool(v.IsLocalhost)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SecurityOriginDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SecurityOriginDetails from JSON
+func (v *SecurityOriginDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "isLocalhost":
+			v.IsLocalhost = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SecurityOriginDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the Frame on the page.
+type Frame struct {
+	/* Frame unique identifier. */
+	Id FrameId `json:"id"`
+	/* Parent frame identifier. */
+	ParentId *FrameId `json:"parentId,omitempty"`
+	/* Identifier of the loader associated with this frame. */
+	LoaderId cdp.NetworkLoaderId `json:"loaderId"`
+	/* Frame's name as specified in the tag. */
+	Name *string `json:"name,omitempty"`
+	/* Frame document's URL without fragment. */
+	Url string `json:"url"`
+	/* Frame document's URL fragment including the '#'.
+
+	Experimental: this may change or be removed in any chrome release */
+	UrlFragment *string `json:"urlFragment,omitempty"`
+	/* Frame document's registered domain, taking the public suffixes list into account.
+	Extracted from the Frame's url.
+	Example URLs: http://www.google.com/file.html -> "google.com"
+	              http://a.b.co.uk/file.html      -> "b.co.uk"
+
+	Experimental: this may change or be removed in any chrome release */
+	DomainAndRegistry string `json:"domainAndRegistry"`
+	/* Frame document's security origin. */
+	SecurityOrigin string `json:"securityOrigin"`
+	/* Additional details about the frame document's security origin.
+
+	Experimental: this may change or be removed in any chrome release */
+	SecurityOriginDetails *SecurityOriginDetails `json:"securityOriginDetails,omitempty"`
+	/* Frame document's mimeType as determined by the browser. */
+	MimeType string `json:"mimeType"`
+	/* If the frame failed to load, this contains the URL that could not be loaded. Note that unlike url above, this URL may contain a fragment.
+
+	Experimental: this may change or be removed in any chrome release */
+	UnreachableUrl *string `json:"unreachableUrl,omitempty"`
+	/* Indicates whether this frame was tagged as an ad and why.
+
+	Experimental: this may change or be removed in any chrome release */
+	AdFrameStatus *AdFrameStatus `json:"adFrameStatus,omitempty"`
+	/* Indicates whether the main document is a secure context and explains why that is the case.
+
+	Experimental: this may change or be removed in any chrome release */
+	SecureContextType SecureContextType `json:"secureContextType"`
+	/* Indicates whether this is a cross origin isolated context.
+
+	Experimental: this may change or be removed in any chrome release */
+	CrossOriginIsolatedContextType CrossOriginIsolatedContextType `json:"crossOriginIsolatedContextType"`
+	/* Indicated which gated APIs / features are available.
+
+	Experimental: this may change or be removed in any chrome release */
+	GatedAPIFeatures []GatedAPIFeatures `json:"gatedAPIFeatures"`
+}
+
+// MarshalCDP writes Frame as JSON
+func (v *Frame) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(string(v.Id))
+	if v.ParentId != nil {
+		e.Key("parentId")
+		e.String(string(*v.ParentId))
+	}
+	e.Key("loaderId")
+	e.String(string(v.LoaderId))
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	e.Key("url")
+	e.String(v.Url)
+	if v.UrlFragment != nil {
+		e.Key("urlFragment")
+		e.String(*v.UrlFragment)
+	}
+	e.Key("domainAndRegistry")
+	e.String(v.DomainAndRegistry)
+	e.Key("securityOrigin")
+	e.String(v.SecurityOrigin)
+	if v.SecurityOriginDetails != nil {
+		e.Key("securityOriginDetails")
+		(*v.SecurityOriginDetails).MarshalCDP(e)
+	}
+	e.Key("mimeType")
+	e.String(v.MimeType)
+	if v.UnreachableUrl != nil {
+		e.Key("unreachableUrl")
+		e.String(*v.UnreachableUrl)
+	}
+	if v.AdFrameStatus != nil {
+		e.Key("adFrameStatus")
+		(*v.AdFrameStatus).MarshalCDP(e)
+	}
+	e.Key("secureContextType")
+	e.String(string(v.SecureContextType))
+	e.Key("crossOriginIsolatedContextType")
+	e.String(string(v.CrossOriginIsolatedContextType))
+	e.Key("gatedAPIFeatures")
+	if v.GatedAPIFeatures == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.GatedAPIFeatures {
+			e.Elem()
+			e.String(string(v.GatedAPIFeatures[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Frame) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Frame from JSON
+func (v *Frame) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = FrameId(d.String())
+		case "parentId":
+			if d.Null() {
+				v.ParentId = nil
+			} else {
+				v.ParentId = new(FrameId)
+				*v.ParentId = FrameId(d.String())
+			}
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "url":
+			v.Url = d.String()
+		case "urlFragment":
+			if d.Null() {
+				v.UrlFragment = nil
+			} else {
+				v.UrlFragment = new(string)
+				*v.UrlFragment = d.String()
+			}
+		case "domainAndRegistry":
+			v.DomainAndRegistry = d.String()
+		case "securityOrigin":
+			v.SecurityOrigin = d.String()
+		case "securityOriginDetails":
+			if d.Null() {
+				v.SecurityOriginDetails = nil
+			} else {
+				v.SecurityOriginDetails = new(SecurityOriginDetails)
+				(*v.SecurityOriginDetails).UnmarshalCDP(d)
+			}
+		case "mimeType":
+			v.MimeType = d.String()
+		case "unreachableUrl":
+			if d.Null() {
+				v.UnreachableUrl = nil
+			} else {
+				v.UnreachableUrl = new(string)
+				*v.UnreachableUrl = d.String()
+			}
+		case "adFrameStatus":
+			if d.Null() {
+				v.AdFrameStatus = nil
+			} else {
+				v.AdFrameStatus = new(AdFrameStatus)
+				(*v.AdFrameStatus).UnmarshalCDP(d)
+			}
+		case "secureContextType":
+			v.SecureContextType = SecureContextType(d.String())
+		case "crossOriginIsolatedContextType":
+			v.CrossOriginIsolatedContextType = CrossOriginIsolatedContextType(d.String())
+		case "gatedAPIFeatures":
+			if d.Array() {
+				v.GatedAPIFeatures = make([]GatedAPIFeatures, 0)
+				for d.More() {
+					var x0 GatedAPIFeatures
+					x0 = GatedAPIFeatures(d.String())
+					v.GatedAPIFeatures = append(v.GatedAPIFeatures, x0)
+				}
+			} else {
+				v.GatedAPIFeatures = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Frame) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the Resource on the page.
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameResource struct {
+	/* Resource URL. */
+	Url string `json:"url"`
+	/* Type of this resource. */
+	Type cdp.NetworkResourceType `json:"type"`
+	/* Resource mimeType as determined by the browser. */
+	MimeType string `json:"mimeType"`
+	/* last-modified timestamp as reported by server. */
+	LastModified *cdp.NetworkTimeSinceEpoch `json:"lastModified,omitempty"`
+	/* Resource content size. */
+	ContentSize *float64 `json:"contentSize,omitempty"`
+	/* True if the resource failed to load. */
+	Failed *bool `json:"failed,omitempty"`
+	/* True if the resource was canceled during loading. */
+	Canceled *bool `json:"canceled,omitempty"`
+}
+
+// MarshalCDP writes FrameResource as JSON
+func (v *FrameResource) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("mimeType")
+	e.String(v.MimeType)
+	if v.LastModified != nil {
+		e.Key("lastModified")
+		e.Float(float64(*v.LastModified))
+	}
+	if v.ContentSize != nil {
+		e.Key("contentSize")
+		e.Float(*v.ContentSize)
+	}
+	if v.Failed != nil {
+		e.Key("failed")
+		e.Bool(*v.Failed)
+	}
+	if v.Canceled != nil {
+		e.Key("canceled")
+		e.Bool(*v.Canceled)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FrameResource) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FrameResource from JSON
+func (v *FrameResource) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "type":
+			v.Type = cdp.NetworkResourceType(d.String())
+		case "mimeType":
+			v.MimeType = d.String()
+		case "lastModified":
+			if d.Null() {
+				v.LastModified = nil
+			} else {
+				v.LastModified = new(cdp.NetworkTimeSinceEpoch)
+				*v.LastModified = cdp.NetworkTimeSinceEpoch(d.Float())
+			}
+		case "contentSize":
+			if d.Null() {
+				v.ContentSize = nil
+			} else {
+				v.ContentSize = new(float64)
+				*v.ContentSize = d.Float()
+			}
+		case "failed":
+			if d.Null() {
+				v.Failed = nil
+			} else {
+				v.Failed = new(bool)
+				*v.Failed = d.Bool()
+			}
+		case "canceled":
+			if d.Null() {
+				v.Canceled = nil
+			} else {
+				v.Canceled = new(bool)
+				*v.Canceled = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameResource) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the Frame hierarchy along with their cached resources.
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameResourceTree struct {
+	/* Frame information for this tree item. */
+	Frame Frame `json:"frame"`
+	/* Child frames. */
+	ChildFrames []FrameResourceTree `json:"childFrames"`
+	/* Information about frame resources. */
+	Resources []FrameResource `json:"resources"`
+}
+
+// MarshalCDP writes FrameResourceTree as JSON
+func (v *FrameResourceTree) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frame")
+	v.Frame.MarshalCDP(e)
+	if v.ChildFrames != nil {
+		e.Key("childFrames")
+		e.ArrayStart()
+		for i0 := range v.ChildFrames {
+			e.Elem()
+			v.ChildFrames[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("resources")
+	if v.Resources == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Resources {
+			e.Elem()
+			v.Resources[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FrameResourceTree) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FrameResourceTree from JSON
+func (v *FrameResourceTree) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frame":
+			v.Frame.UnmarshalCDP(d)
+		case "childFrames":
+			if d.Array() {
+				v.ChildFrames = make([]FrameResourceTree, 0)
+				for d.More() {
+					var x0 FrameResourceTree
+					x0.UnmarshalCDP(d)
+					v.ChildFrames = append(v.ChildFrames, x0)
+				}
+			} else {
+				v.ChildFrames = nil
+			}
+		case "resources":
+			if d.Array() {
+				v.Resources = make([]FrameResource, 0)
+				for d.More() {
+					var x0 FrameResource
+					x0.UnmarshalCDP(d)
+					v.Resources = append(v.Resources, x0)
+				}
+			} else {
+				v.Resources = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameResourceTree) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about the Frame hierarchy.
+type FrameTree struct {
+	/* Frame information for this tree item. */
+	Frame Frame `json:"frame"`
+	/* Child frames. */
+	ChildFrames []FrameTree `json:"childFrames"`
+}
+
+// MarshalCDP writes FrameTree as JSON
+func (v *FrameTree) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frame")
+	v.Frame.MarshalCDP(e)
+	if v.ChildFrames != nil {
+		e.Key("childFrames")
+		e.ArrayStart()
+		for i0 := range v.ChildFrames {
+			e.Elem()
+			v.ChildFrames[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FrameTree) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FrameTree from JSON
+func (v *FrameTree) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frame":
+			v.Frame.UnmarshalCDP(d)
+		case "childFrames":
+			if d.Array() {
+				v.ChildFrames = make([]FrameTree, 0)
+				for d.More() {
+					var x0 FrameTree
+					x0.UnmarshalCDP(d)
+					v.ChildFrames = append(v.ChildFrames, x0)
+				}
+			} else {
+				v.ChildFrames = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameTree) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Unique script identifier.
+type ScriptIdentifier string
+
+// Transition type.
+type TransitionType string
+
+// TransitionType values
+const (
+	TransitionTypeLink             TransitionType = "link"
+	TransitionTypeTyped            TransitionType = "typed"
+	TransitionTypeAddressBar       TransitionType = "address_bar"
+	TransitionTypeAutoBookmark     TransitionType = "auto_bookmark"
+	TransitionTypeAutoSubframe     TransitionType = "auto_subframe"
+	TransitionTypeManualSubframe   TransitionType = "manual_subframe"
+	TransitionTypeGenerated        TransitionType = "generated"
+	TransitionTypeAutoToplevel     TransitionType = "auto_toplevel"
+	TransitionTypeFormSubmit       TransitionType = "form_submit"
+	TransitionTypeReload           TransitionType = "reload"
+	TransitionTypeKeyword          TransitionType = "keyword"
+	TransitionTypeKeywordGenerated TransitionType = "keyword_generated"
+	TransitionTypeOther            TransitionType = "other"
+)
+
+// Values gives every TransitionType
+func (TransitionType) Values() []TransitionType {
+	return []TransitionType{
+		TransitionTypeLink,
+		TransitionTypeTyped,
+		TransitionTypeAddressBar,
+		TransitionTypeAutoBookmark,
+		TransitionTypeAutoSubframe,
+		TransitionTypeManualSubframe,
+		TransitionTypeGenerated,
+		TransitionTypeAutoToplevel,
+		TransitionTypeFormSubmit,
+		TransitionTypeReload,
+		TransitionTypeKeyword,
+		TransitionTypeKeywordGenerated,
+		TransitionTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v TransitionType) Valid() bool {
+	switch v {
+	case TransitionTypeLink, TransitionTypeTyped, TransitionTypeAddressBar, TransitionTypeAutoBookmark, TransitionTypeAutoSubframe, TransitionTypeManualSubframe, TransitionTypeGenerated, TransitionTypeAutoToplevel, TransitionTypeFormSubmit, TransitionTypeReload, TransitionTypeKeyword, TransitionTypeKeywordGenerated, TransitionTypeOther:
+		return true
+	}
+	return false
+}
+
+// Navigation history entry.
+type NavigationEntry struct {
+	/* Unique id of the navigation history entry. */
+	Id int `json:"id"`
+	/* URL of the navigation history entry. */
+	Url string `json:"url"`
+	/* URL that the user typed in the url bar. */
+	UserTypedURL string `json:"userTypedURL"`
+	/* Title of the navigation history entry. */
+	Title string `json:"title"`
+	/* Transition type. */
+	TransitionType TransitionType `json:"transitionType"`
+}
+
+// MarshalCDP writes NavigationEntry as JSON
+func (v *NavigationEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.Int(v.Id)
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("userTypedURL")
+	e.String(v.UserTypedURL)
+	e.Key("title")
+	e.String(v.Title)
+	e.Key("transitionType")
+	e.String(string(v.TransitionType))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NavigationEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads NavigationEntry from JSON
+func (v *NavigationEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.Int()
+		case "url":
+			v.Url = d.String()
+		case "userTypedURL":
+			v.UserTypedURL = d.String()
+		case "title":
+			v.Title = d.String()
+		case "transitionType":
+			v.TransitionType = TransitionType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NavigationEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Screencast frame metadata.
+//
+// Experimental: this may change or be removed in any chrome release
+type ScreencastFrameMetadata struct {
+	/* Top offset in DIP. */
+	OffsetTop float64 `json:"offsetTop"`
+	/* Page scale factor. */
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+	/* Device screen width in DIP. */
+	DeviceWidth float64 `json:"deviceWidth"`
+	/* Device screen height in DIP. */
+	DeviceHeight float64 `json:"deviceHeight"`
+	/* Position of horizontal scroll in CSS pixels. */
+	ScrollOffsetX float64 `json:"scrollOffsetX"`
+	/* Position of vertical scroll in CSS pixels. */
+	ScrollOffsetY float64 `json:"scrollOffsetY"`
+	/* Frame swap timestamp. */
+	Timestamp *cdp.NetworkTimeSinceEpoch `json:"timestamp,omitempty"`
+}
+
+// MarshalCDP writes ScreencastFrameMetadata as JSON
+func (v *ScreencastFrameMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("offsetTop")
+	e.Float(v.OffsetTop)
+	e.Key("pageScaleFactor")
+	e.Float(v.PageScaleFactor)
+	e.Key("deviceWidth")
+	e.Float(v.DeviceWidth)
+	e.Key("deviceHeight")
+	e.Float(v.DeviceHeight)
+	e.Key("scrollOffsetX")
+	e.Float(v.ScrollOffsetX)
+	e.Key("scrollOffsetY")
+	e.Float(v.ScrollOffsetY)
+	if v.Timestamp != nil {
+		e.Key("timestamp")
+		e.Float(float64(*v.Timestamp))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScreencastFrameMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScreencastFrameMetadata from JSON
+func (v *ScreencastFrameMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "offsetTop":
+			v.OffsetTop = d.Float()
+		case "pageScaleFactor":
+			v.PageScaleFactor = d.Float()
+		case "deviceWidth":
+			v.DeviceWidth = d.Float()
+		case "deviceHeight":
+			v.DeviceHeight = d.Float()
+		case "scrollOffsetX":
+			v.ScrollOffsetX = d.Float()
+		case "scrollOffsetY":
+			v.ScrollOffsetY = d.Float()
+		case "timestamp":
+			if d.Null() {
+				v.Timestamp = nil
+			} else {
+				v.Timestamp = new(cdp.NetworkTimeSinceEpoch)
+				*v.Timestamp = cdp.NetworkTimeSinceEpoch(d.Float())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScreencastFrameMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Javascript dialog type.
+type DialogType string
+
+// DialogType values
+const (
+	DialogTypeAlert        DialogType = "alert"
+	DialogTypeConfirm      DialogType = "confirm"
+	DialogTypePrompt       DialogType = "prompt"
+	DialogTypeBeforeunload DialogType = "beforeunload"
+)
+
+// Values gives every DialogType
+func (DialogType) Values() []DialogType {
+	return []DialogType{
+		DialogTypeAlert,
+		DialogTypeConfirm,
+		DialogTypePrompt,
+		DialogTypeBeforeunload,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DialogType) Valid() bool {
+	switch v {
+	case DialogTypeAlert, DialogTypeConfirm, DialogTypePrompt, DialogTypeBeforeunload:
+		return true
+	}
+	return false
+}
+
+// Error while paring app manifest.
+type AppManifestError struct {
+	/* Error message. */
+	Message string `json:"message"`
+	/* If critical, this is a non-recoverable parse error. */
+	Critical int `json:"critical"`
+	/* Error line. */
+	Line int `json:"line"`
+	/* Error column. */
+	Column int `json:"column"`
+}
+
+// MarshalCDP writes AppManifestError as JSON
+func (v *AppManifestError) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("message")
+	e.String(v.Message)
+	e.Key("critical")
+	e.Int(v.Critical)
+	e.Key("line")
+	e.Int(v.Line)
+	e.Key("column")
+	e.Int(v.Column)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AppManifestError) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AppManifestError from JSON
+func (v *AppManifestError) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "message":
+			v.Message = d.String()
+		case "critical":
+			v.Critical = d.Int()
+		case "line":
+			v.Line = d.Int()
+		case "column":
+			v.Column = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AppManifestError) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Parsed app manifest properties.
+//
+// Experimental: this may change or be removed in any chrome release
+type AppManifestParsedProperties struct {
+	/* Computed scope value */
+	Scope string `json:"scope"`
+}
+
+// MarshalCDP writes AppManifestParsedProperties as JSON
+func (v *AppManifestParsedProperties) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scope")
+	e.String(v.Scope)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AppManifestParsedProperties) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AppManifestParsedProperties from JSON
+func (v *AppManifestParsedProperties) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scope":
+			v.Scope = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AppManifestParsedProperties) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Layout viewport position and dimensions.
+type LayoutViewport struct {
+	/* Horizontal offset relative to the document (CSS pixels). */
+	PageX int `json:"pageX"`
+	/* Vertical offset relative to the document (CSS pixels). */
+	PageY int `json:"pageY"`
+	/* Width (CSS pixels), excludes scrollbar if present. */
+	ClientWidth int `json:"clientWidth"`
+	/* Height (CSS pixels), excludes scrollbar if present. */
+	ClientHeight int `json:"clientHeight"`
+}
+
+// MarshalCDP writes LayoutViewport as JSON
+func (v *LayoutViewport) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("pageX")
+	e.Int(v.PageX)
+	e.Key("pageY")
+	e.Int(v.PageY)
+	e.Key("clientWidth")
+	e.Int(v.ClientWidth)
+	e.Key("clientHeight")
+	e.Int(v.ClientHeight)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LayoutViewport) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LayoutViewport from JSON
+func (v *LayoutViewport) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "pageX":
+			v.PageX = d.Int()
+		case "pageY":
+			v.PageY = d.Int()
+		case "clientWidth":
+			v.ClientWidth = d.Int()
+		case "clientHeight":
+			v.ClientHeight = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayoutViewport) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Visual viewport position, dimensions, and scale.
+type VisualViewport struct {
+	/* Horizontal offset relative to the layout viewport (CSS pixels). */
+	OffsetX float64 `json:"offsetX"`
+	/* Vertical offset relative to the layout viewport (CSS pixels). */
+	OffsetY float64 `json:"offsetY"`
+	/* Horizontal offset relative to the document (CSS pixels). */
+	PageX float64 `json:"pageX"`
+	/* Vertical offset relative to the document (CSS pixels). */
+	PageY float64 `json:"pageY"`
+	/* Width (CSS pixels), excludes scrollbar if present. */
+	ClientWidth float64 `json:"clientWidth"`
+	/* Height (CSS pixels), excludes scrollbar if present. */
+	ClientHeight float64 `json:"clientHeight"`
+	/* Scale relative to the ideal viewport (size at width=device-width). */
+	Scale float64 `json:"scale"`
+	/* Page zoom factor (CSS to device independent pixels ratio). */
+	Zoom *float64 `json:"zoom,omitempty"`
+}
+
+// MarshalCDP writes VisualViewport as JSON
+func (v *VisualViewport) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("offsetX")
+	e.Float(v.OffsetX)
+	e.Key("offsetY")
+	e.Float(v.OffsetY)
+	e.Key("pageX")
+	e.Float(v.PageX)
+	e.Key("pageY")
+	e.Float(v.PageY)
+	e.Key("clientWidth")
+	e.Float(v.ClientWidth)
+	e.Key("clientHeight")
+	e.Float(v.ClientHeight)
+	e.Key("scale")
+	e.Float(v.Scale)
+	if v.Zoom != nil {
+		e.Key("zoom")
+		e.Float(*v.Zoom)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v VisualViewport) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads VisualViewport from JSON
+func (v *VisualViewport) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "offsetX":
+			v.OffsetX = d.Float()
+		case "offsetY":
+			v.OffsetY = d.Float()
+		case "pageX":
+			v.PageX = d.Float()
+		case "pageY":
+			v.PageY = d.Float()
+		case "clientWidth":
+			v.ClientWidth = d.Float()
+		case "clientHeight":
+			v.ClientHeight = d.Float()
+		case "scale":
+			v.Scale = d.Float()
+		case "zoom":
+			if d.Null() {
+				v.Zoom = nil
+			} else {
+				v.Zoom = new(float64)
+				*v.Zoom = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VisualViewport) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Generic font families collection.
+//
+// Experimental: this may change or be removed in any chrome release
+type FontFamilies struct {
+	/* The standard font-family. */
+	Standard *string `json:"standard,omitempty"`
+	/* The fixed font-family. */
+	Fixed *string `json:"fixed,omitempty"`
+	/* The serif font-family. */
+	Serif *string `json:"serif,omitempty"`
+	/* The sansSerif font-family. */
+	SansSerif *string `json:"sansSerif,omitempty"`
+	/* The cursive font-family. */
+	Cursive *string `json:"cursive,omitempty"`
+	/* The fantasy font-family. */
+	Fantasy *string `json:"fantasy,omitempty"`
+	/* The math font-family. */
+	Math *string `json:"math,omitempty"`
+}
+
+// MarshalCDP writes FontFamilies as JSON
+func (v *FontFamilies) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Standard != nil {
+		e.Key("standard")
+		e.String(*v.Standard)
+	}
+	if v.Fixed != nil {
+		e.Key("fixed")
+		e.String(*v.Fixed)
+	}
+	if v.Serif != nil {
+		e.Key("serif")
+		e.String(*v.Serif)
+	}
+	if v.SansSerif != nil {
+		e.Key("sansSerif")
+		e.String(*v.SansSerif)
+	}
+	if v.Cursive != nil {
+		e.Key("cursive")
+		e.String(*v.Cursive)
+	}
+	if v.Fantasy != nil {
+		e.Key("fantasy")
+		e.String(*v.Fantasy)
+	}
+	if v.Math != nil {
+		e.Key("math")
+		e.String(*v.Math)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FontFamilies) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FontFamilies from JSON
+func (v *FontFamilies) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "standard":
+			if d.Null() {
+				v.Standard = nil
+			} else {
+				v.Standard = new(string)
+				*v.Standard = d.String()
+			}
+		case "fixed":
+			if d.Null() {
+				v.Fixed = nil
+			} else {
+				v.Fixed = new(string)
+				*v.Fixed = d.String()
+			}
+		case "serif":
+			if d.Null() {
+				v.Serif = nil
+			} else {
+				v.Serif = new(string)
+				*v.Serif = d.String()
+			}
+		case "sansSerif":
+			if d.Null() {
+				v.SansSerif = nil
+			} else {
+				v.SansSerif = new(string)
+				*v.SansSerif = d.String()
+			}
+		case "cursive":
+			if d.Null() {
+				v.Cursive = nil
+			} else {
+				v.Cursive = new(string)
+				*v.Cursive = d.String()
+			}
+		case "fantasy":
+			if d.Null() {
+				v.Fantasy = nil
+			} else {
+				v.Fantasy = new(string)
+				*v.Fantasy = d.String()
+			}
+		case "math":
+			if d.Null() {
+				v.Math = nil
+			} else {
+				v.Math = new(string)
+				*v.Math = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FontFamilies) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Font families collection for a script.
+//
+// Experimental: this may change or be removed in any chrome release
+type ScriptFontFamilies struct {
+	/* Name of the script which these font families are defined for. */
+	Script string `json:"script"`
+	/* Generic font families collection for the script. */
+	FontFamilies FontFamilies `json:"fontFamilies"`
+}
+
+// MarshalCDP writes ScriptFontFamilies as JSON
+func (v *ScriptFontFamilies) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("script")
+	e.String(v.Script)
+	e.Key("fontFamilies")
+	v.FontFamilies.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScriptFontFamilies) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScriptFontFamilies from JSON
+func (v *ScriptFontFamilies) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "script":
+			v.Script = d.String()
+		case "fontFamilies":
+			v.FontFamilies.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScriptFontFamilies) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Default font sizes.
+//
+// Experimental: this may change or be removed in any chrome release
+type FontSizes struct {
+	/* Default standard font size. */
+	Standard *int `json:"standard,omitempty"`
+	/* Default fixed font size. */
+	Fixed *int `json:"fixed,omitempty"`
+}
+
+// MarshalCDP writes FontSizes as JSON
+func (v *FontSizes) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Standard != nil {
+		e.Key("standard")
+		e.Int(*v.Standard)
+	}
+	if v.Fixed != nil {
+		e.Key("fixed")
+		e.Int(*v.Fixed)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FontSizes) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FontSizes from JSON
+func (v *FontSizes) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "standard":
+			if d.Null() {
+				v.Standard = nil
+			} else {
+				v.Standard = new(int)
+				*v.Standard = d.Int()
+			}
+		case "fixed":
+			if d.Null() {
+				v.Fixed = nil
+			} else {
+				v.Fixed = new(int)
+				*v.Fixed = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FontSizes) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ClientNavigationReason string
+
+// ClientNavigationReason values
+const (
+	ClientNavigationReasonAnchorClick            ClientNavigationReason = "anchorClick"
+	ClientNavigationReasonFormSubmissionGet      ClientNavigationReason = "formSubmissionGet"
+	ClientNavigationReasonFormSubmissionPost     ClientNavigationReason = "formSubmissionPost"
+	ClientNavigationReasonHttpHeaderRefresh      ClientNavigationReason = "httpHeaderRefresh"
+	ClientNavigationReasonInitialFrameNavigation ClientNavigationReason = "initialFrameNavigation"
+	ClientNavigationReasonMetaTagRefresh         ClientNavigationReason = "metaTagRefresh"
+	ClientNavigationReasonOther                  ClientNavigationReason = "other"
+	ClientNavigationReasonPageBlockInterstitial  ClientNavigationReason = "pageBlockInterstitial"
+	ClientNavigationReasonReload                 ClientNavigationReason = "reload"
+	ClientNavigationReasonScriptInitiated        ClientNavigationReason = "scriptInitiated"
+)
+
+// Values gives every ClientNavigationReason
+func (ClientNavigationReason) Values() []ClientNavigationReason {
+	return []ClientNavigationReason{
+		ClientNavigationReasonAnchorClick,
+		ClientNavigationReasonFormSubmissionGet,
+		ClientNavigationReasonFormSubmissionPost,
+		ClientNavigationReasonHttpHeaderRefresh,
+		ClientNavigationReasonInitialFrameNavigation,
+		ClientNavigationReasonMetaTagRefresh,
+		ClientNavigationReasonOther,
+		ClientNavigationReasonPageBlockInterstitial,
+		ClientNavigationReasonReload,
+		ClientNavigationReasonScriptInitiated,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ClientNavigationReason) Valid() bool {
+	switch v {
+	case ClientNavigationReasonAnchorClick, ClientNavigationReasonFormSubmissionGet, ClientNavigationReasonFormSubmissionPost, ClientNavigationReasonHttpHeaderRefresh, ClientNavigationReasonInitialFrameNavigation, ClientNavigationReasonMetaTagRefresh, ClientNavigationReasonOther, ClientNavigationReasonPageBlockInterstitial, ClientNavigationReasonReload, ClientNavigationReasonScriptInitiated:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ClientNavigationDisposition string
+
+// ClientNavigationDisposition values
+const (
+	ClientNavigationDispositionCurrentTab ClientNavigationDisposition = "currentTab"
+	ClientNavigationDispositionNewTab     ClientNavigationDisposition = "newTab"
+	ClientNavigationDispositionNewWindow  ClientNavigationDisposition = "newWindow"
+	ClientNavigationDispositionDownload   ClientNavigationDisposition = "download"
+)
+
+// Values gives every ClientNavigationDisposition
+func (ClientNavigationDisposition) Values() []ClientNavigationDisposition {
+	return []ClientNavigationDisposition{
+		ClientNavigationDispositionCurrentTab,
+		ClientNavigationDispositionNewTab,
+		ClientNavigationDispositionNewWindow,
+		ClientNavigationDispositionDownload,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ClientNavigationDisposition) Valid() bool {
+	switch v {
+	case ClientNavigationDispositionCurrentTab, ClientNavigationDispositionNewTab, ClientNavigationDispositionNewWindow, ClientNavigationDispositionDownload:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type InstallabilityErrorArgument struct {
+	/* Argument name (e.g. name:'minimum-icon-size-in-pixels'). */
+	Name string `json:"name"`
+	/* Argument value (e.g. value:'64'). */
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes InstallabilityErrorArgument as JSON
+func (v *InstallabilityErrorArgument) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InstallabilityErrorArgument) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InstallabilityErrorArgument from JSON
+func (v *InstallabilityErrorArgument) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InstallabilityErrorArgument) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The installability error
+//
+// Experimental: this may change or be removed in any chrome release
+type InstallabilityError struct {
+	/* The error id (e.g. 'manifest-missing-suitable-icon'). */
+	ErrorId string `json:"errorId"`
+	/* The list of error arguments (e.g. {name:'minimum-icon-size-in-pixels', value:'64'}). */
+	ErrorArguments []InstallabilityErrorArgument `json:"errorArguments"`
+}
+
+// MarshalCDP writes InstallabilityError as JSON
+func (v *InstallabilityError) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("errorId")
+	e.String(v.ErrorId)
+	e.Key("errorArguments")
+	if v.ErrorArguments == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ErrorArguments {
+			e.Elem()
+			v.ErrorArguments[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InstallabilityError) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InstallabilityError from JSON
+func (v *InstallabilityError) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "errorId":
+			v.ErrorId = d.String()
+		case "errorArguments":
+			if d.Array() {
+				v.ErrorArguments = make([]InstallabilityErrorArgument, 0)
+				for d.More() {
+					var x0 InstallabilityErrorArgument
+					x0.UnmarshalCDP(d)
+					v.ErrorArguments = append(v.ErrorArguments, x0)
+				}
+			} else {
+				v.ErrorArguments = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InstallabilityError) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The referring-policy used for the navigation.
+//
+// Experimental: this may change or be removed in any chrome release
+type ReferrerPolicy string
+
+// ReferrerPolicy values
+const (
+	ReferrerPolicyNoReferrer                  ReferrerPolicy = "noReferrer"
+	ReferrerPolicyNoReferrerWhenDowngrade     ReferrerPolicy = "noReferrerWhenDowngrade"
+	ReferrerPolicyOrigin                      ReferrerPolicy = "origin"
+	ReferrerPolicyOriginWhenCrossOrigin       ReferrerPolicy = "originWhenCrossOrigin"
+	ReferrerPolicySameOrigin                  ReferrerPolicy = "sameOrigin"
+	ReferrerPolicyStrictOrigin                ReferrerPolicy = "strictOrigin"
+	ReferrerPolicyStrictOriginWhenCrossOrigin ReferrerPolicy = "strictOriginWhenCrossOrigin"
+	ReferrerPolicyUnsafeUrl                   ReferrerPolicy = "unsafeUrl"
+)
+
+// Values gives every ReferrerPolicy
+func (ReferrerPolicy) Values() []ReferrerPolicy {
+	return []ReferrerPolicy{
+		ReferrerPolicyNoReferrer,
+		ReferrerPolicyNoReferrerWhenDowngrade,
+		ReferrerPolicyOrigin,
+		ReferrerPolicyOriginWhenCrossOrigin,
+		ReferrerPolicySameOrigin,
+		ReferrerPolicyStrictOrigin,
+		ReferrerPolicyStrictOriginWhenCrossOrigin,
+		ReferrerPolicyUnsafeUrl,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ReferrerPolicy) Valid() bool {
+	switch v {
+	case ReferrerPolicyNoReferrer, ReferrerPolicyNoReferrerWhenDowngrade, ReferrerPolicyOrigin, ReferrerPolicyOriginWhenCrossOrigin, ReferrerPolicySameOrigin, ReferrerPolicyStrictOrigin, ReferrerPolicyStrictOriginWhenCrossOrigin, ReferrerPolicyUnsafeUrl:
+		return true
+	}
+	return false
+}
+
+// Per-script compilation cache parameters for `Page.produceCompilationCache`
+//
+// Experimental: this may change or be removed in any chrome release
+type CompilationCacheParams struct {
+	/* The URL of the script to produce a compilation cache entry for. */
+	Url string `json:"url"`
+	/* A hint to the backend whether eager compilation is recommended.
+	(the actual compilation mode used is upon backend discretion). */
+	Eager *bool `json:"eager,omitempty"`
+}
+
+// MarshalCDP writes CompilationCacheParams as JSON
+func (v *CompilationCacheParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	if v.Eager != nil {
+		e.Key("eager")
+		e.Bool(*v.Eager)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CompilationCacheParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CompilationCacheParams from JSON
+func (v *CompilationCacheParams) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "eager":
+			if d.Null() {
+				v.Eager = nil
+			} else {
+				v.Eager = new(bool)
+				*v.Eager = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CompilationCacheParams) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type FileFilter struct {
+	Name    *string  `json:"name,omitempty"`
+	Accepts []string `json:"accepts"`
+}
+
+// MarshalCDP writes FileFilter as JSON
+func (v *FileFilter) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	if v.Accepts != nil {
+		e.Key("accepts")
+		e.ArrayStart()
+		for i0 := range v.Accepts {
+			e.Elem()
+			e.String(v.Accepts[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FileFilter) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FileFilter from JSON
+func (v *FileFilter) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "accepts":
+			if d.Array() {
+				v.Accepts = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Accepts = append(v.Accepts, x0)
+				}
+			} else {
+				v.Accepts = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FileFilter) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type FileHandler struct {
+	Action string          `json:"action"`
+	Name   string          `json:"name"`
+	Icons  []ImageResource `json:"icons"`
+	/* Mimic a map, name is the key, accepts is the value. */
+	Accepts []FileFilter `json:"accepts"`
+	/* Won't repeat the enums, using string for easy comparison. Same as the
+	other enums below. */
+	LaunchType string `json:"launchType"`
+}
+
+// MarshalCDP writes FileHandler as JSON
+func (v *FileHandler) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("action")
+	e.String(v.Action)
+	e.Key("name")
+	e.String(v.Name)
+	if v.Icons != nil {
+		e.Key("icons")
+		e.ArrayStart()
+		for i0 := range v.Icons {
+			e.Elem()
+			v.Icons[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Accepts != nil {
+		e.Key("accepts")
+		e.ArrayStart()
+		for i0 := range v.Accepts {
+			e.Elem()
+			v.Accepts[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("launchType")
+	e.String(v.LaunchType)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FileHandler) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FileHandler from JSON
+func (v *FileHandler) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "action":
+			v.Action = d.String()
+		case "name":
+			v.Name = d.String()
+		case "icons":
+			if d.Array() {
+				v.Icons = make([]ImageResource, 0)
+				for d.More() {
+					var x0 ImageResource
+					x0.UnmarshalCDP(d)
+					v.Icons = append(v.Icons, x0)
+				}
+			} else {
+				v.Icons = nil
+			}
+		case "accepts":
+			if d.Array() {
+				v.Accepts = make([]FileFilter, 0)
+				for d.More() {
+					var x0 FileFilter
+					x0.UnmarshalCDP(d)
+					v.Accepts = append(v.Accepts, x0)
+				}
+			} else {
+				v.Accepts = nil
+			}
+		case "launchType":
+			v.LaunchType = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FileHandler) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The image definition used in both icon and screenshot.
+//
+// Experimental: this may change or be removed in any chrome release
+type ImageResource struct {
+	/* The src field in the definition, but changing to url in favor of
+	consistency. */
+	Url   string  `json:"url"`
+	Sizes *string `json:"sizes,omitempty"`
+	Type  *string `json:"type,omitempty"`
+}
+
+// MarshalCDP writes ImageResource as JSON
+func (v *ImageResource) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	if v.Sizes != nil {
+		e.Key("sizes")
+		e.String(*v.Sizes)
+	}
+	if v.Type != nil {
+		e.Key("type")
+		e.String(*v.Type)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ImageResource) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ImageResource from JSON
+func (v *ImageResource) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "sizes":
+			if d.Null() {
+				v.Sizes = nil
+			} else {
+				v.Sizes = new(string)
+				*v.Sizes = d.String()
+			}
+		case "type":
+			if d.Null() {
+				v.Type = nil
+			} else {
+				v.Type = new(string)
+				*v.Type = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ImageResource) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type LaunchHandler struct {
+	ClientMode string `json:"clientMode"`
+}
+
+// MarshalCDP writes LaunchHandler as JSON
+func (v *LaunchHandler) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("clientMode")
+	e.String(v.ClientMode)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LaunchHandler) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LaunchHandler from JSON
+func (v *LaunchHandler) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "clientMode":
+			v.ClientMode = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LaunchHandler) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ProtocolHandler struct {
+	Protocol string `json:"protocol"`
+	Url      string `json:"url"`
+}
+
+// MarshalCDP writes ProtocolHandler as JSON
+func (v *ProtocolHandler) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("protocol")
+	e.String(v.Protocol)
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ProtocolHandler) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ProtocolHandler from JSON
+func (v *ProtocolHandler) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "protocol":
+			v.Protocol = d.String()
+		case "url":
+			v.Url = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ProtocolHandler) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type RelatedApplication struct {
+	Id  *string `json:"id,omitempty"`
+	Url string  `json:"url"`
+}
+
+// MarshalCDP writes RelatedApplication as JSON
+func (v *RelatedApplication) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Id != nil {
+		e.Key("id")
+		e.String(*v.Id)
+	}
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RelatedApplication) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RelatedApplication from JSON
+func (v *RelatedApplication) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			if d.Null() {
+				v.Id = nil
+			} else {
+				v.Id = new(string)
+				*v.Id = d.String()
+			}
+		case "url":
+			v.Url = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RelatedApplication) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ScopeExtension struct {
+	/* Instead of using tuple, this field always returns the serialized string
+	for easy understanding and comparison. */
+	Origin            string `json:"origin"`
+	HasOriginWildcard bool   `json:"hasOriginWildcard"`
+}
+
+// MarshalCDP writes ScopeExtension as JSON
+func (v *ScopeExtension) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("hasOriginWildcard")
+	e.Bool(v.HasOriginWildcard)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScopeExtension) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScopeExtension from JSON
+func (v *ScopeExtension) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "hasOriginWildcard":
+			v.HasOriginWildcard = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScopeExtension) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type Screenshot struct {
+	Image      ImageResource `json:"image"`
+	FormFactor string        `json:"formFactor"`
+	Label      *string       `json:"label,omitempty"`
+}
+
+// MarshalCDP writes Screenshot as JSON
+func (v *Screenshot) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("image")
+	v.Image.MarshalCDP(e)
+	e.Key("formFactor")
+	e.String(v.FormFactor)
+	if v.Label != nil {
+		e.Key("label")
+		e.String(*v.Label)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Screenshot) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Screenshot from JSON
+func (v *Screenshot) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "image":
+			v.Image.UnmarshalCDP(d)
+		case "formFactor":
+			v.FormFactor = d.String()
+		case "label":
+			if d.Null() {
+				v.Label = nil
+			} else {
+				v.Label = new(string)
+				*v.Label = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Screenshot) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type ShareTarget struct {
+	Action  string `json:"action"`
+	Method  string `json:"method"`
+	Enctype string `json:"enctype"`
+	/* Embed the ShareTargetParams */
+	Title *string      `json:"title,omitempty"`
+	Text  *string      `json:"text,omitempty"`
+	Url   *string      `json:"url,omitempty"`
+	Files []FileFilter `json:"files"`
+}
+
+// MarshalCDP writes ShareTarget as JSON
+func (v *ShareTarget) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("action")
+	e.String(v.Action)
+	e.Key("method")
+	e.String(v.Method)
+	e.Key("enctype")
+	e.String(v.Enctype)
+	if v.Title != nil {
+		e.Key("title")
+		e.String(*v.Title)
+	}
+	if v.Text != nil {
+		e.Key("text")
+		e.String(*v.Text)
+	}
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Files != nil {
+		e.Key("files")
+		e.ArrayStart()
+		for i0 := range v.Files {
+			e.Elem()
+			v.Files[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ShareTarget) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ShareTarget from JSON
+func (v *ShareTarget) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "action":
+			v.Action = d.String()
+		case "method":
+			v.Method = d.String()
+		case "enctype":
+			v.Enctype = d.String()
+		case "title":
+			if d.Null() {
+				v.Title = nil
+			} else {
+				v.Title = new(string)
+				*v.Title = d.String()
+			}
+		case "text":
+			if d.Null() {
+				v.Text = nil
+			} else {
+				v.Text = new(string)
+				*v.Text = d.String()
+			}
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "files":
+			if d.Array() {
+				v.Files = make([]FileFilter, 0)
+				for d.More() {
+					var x0 FileFilter
+					x0.UnmarshalCDP(d)
+					v.Files = append(v.Files, x0)
+				}
+			} else {
+				v.Files = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ShareTarget) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type Shortcut struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+// MarshalCDP writes Shortcut as JSON
+func (v *Shortcut) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Shortcut) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Shortcut from JSON
+func (v *Shortcut) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "url":
+			v.Url = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Shortcut) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type WebAppManifest struct {
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	/* The extra description provided by the manifest. */
+	Description *string `json:"description,omitempty"`
+	Dir         *string `json:"dir,omitempty"`
+	Display     *string `json:"display,omitempty"`
+	/* The overrided display mode controlled by the user. */
+	DisplayOverrides []string `json:"displayOverrides"`
+	/* The handlers to open files. */
+	FileHandlers []FileHandler   `json:"fileHandlers"`
+	Icons        []ImageResource `json:"icons"`
+	Id           *string         `json:"id,omitempty"`
+	Lang         *string         `json:"lang,omitempty"`
+	/* TODO(crbug.com/1231886): This field is non-standard and part of a Chrome
+	experiment. See:
+	https://github.com/WICG/web-app-launch/blob/main/launch_handler.md */
+	LaunchHandler             *LaunchHandler `json:"launchHandler,omitempty"`
+	Name                      *string        `json:"name,omitempty"`
+	Orientation               *string        `json:"orientation,omitempty"`
+	PreferRelatedApplications *bool          `json:"preferRelatedApplications,omitempty"`
+	/* The handlers to open protocols. */
+	ProtocolHandlers    []ProtocolHandler    `json:"protocolHandlers"`
+	RelatedApplications []RelatedApplication `json:"relatedApplications"`
+	Scope               *string              `json:"scope,omitempty"`
+	/* Non-standard, see
+	https://github.com/WICG/manifest-incubations/blob/gh-pages/scope_extensions-explainer.md */
+	ScopeExtensions []ScopeExtension `json:"scopeExtensions"`
+	/* The screenshots used by chromium. */
+	Screenshots []Screenshot `json:"screenshots"`
+	ShareTarget *ShareTarget `json:"shareTarget,omitempty"`
+	ShortName   *string      `json:"shortName,omitempty"`
+	Shortcuts   []Shortcut   `json:"shortcuts"`
+	StartUrl    *string      `json:"startUrl,omitempty"`
+	ThemeColor  *string      `json:"themeColor,omitempty"`
+}
+
+// MarshalCDP writes WebAppManifest as JSON
+func (v *WebAppManifest) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BackgroundColor != nil {
+		e.Key("backgroundColor")
+		e.String(*v.BackgroundColor)
+	}
+	if v.Description != nil {
+		e.Key("description")
+		e.String(*v.Description)
+	}
+	if v.Dir != nil {
+		e.Key("dir")
+		e.String(*v.Dir)
+	}
+	if v.Display != nil {
+		e.Key("display")
+		e.String(*v.Display)
+	}
+	if v.DisplayOverrides != nil {
+		e.Key("displayOverrides")
+		e.ArrayStart()
+		for i0 := range v.DisplayOverrides {
+			e.Elem()
+			e.String(v.DisplayOverrides[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.FileHandlers != nil {
+		e.Key("fileHandlers")
+		e.ArrayStart()
+		for i0 := range v.FileHandlers {
+			e.Elem()
+			v.FileHandlers[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Icons != nil {
+		e.Key("icons")
+		e.ArrayStart()
+		for i0 := range v.Icons {
+			e.Elem()
+			v.Icons[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Id != nil {
+		e.Key("id")
+		e.String(*v.Id)
+	}
+	if v.Lang != nil {
+		e.Key("lang")
+		e.String(*v.Lang)
+	}
+	if v.LaunchHandler != nil {
+		e.Key("launchHandler")
+		(*v.LaunchHandler).MarshalCDP(e)
+	}
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	if v.Orientation != nil {
+		e.Key("orientation")
+		e.String(*v.Orientation)
+	}
+	if v.PreferRelatedApplications != nil {
+		e.Key("preferRelatedApplications")
+		e.Bool(*v.PreferRelatedApplications)
+	}
+	if v.ProtocolHandlers != nil {
+		e.Key("protocolHandlers")
+		e.ArrayStart()
+		for i0 := range v.ProtocolHandlers {
+			e.Elem()
+			v.ProtocolHandlers[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.RelatedApplications != nil {
+		e.Key("relatedApplications")
+		e.ArrayStart()
+		for i0 := range v.RelatedApplications {
+			e.Elem()
+			v.RelatedApplications[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Scope != nil {
+		e.Key("scope")
+		e.String(*v.Scope)
+	}
+	if v.ScopeExtensions != nil {
+		e.Key("scopeExtensions")
+		e.ArrayStart()
+		for i0 := range v.ScopeExtensions {
+			e.Elem()
+			v.ScopeExtensions[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Screenshots != nil {
+		e.Key("screenshots")
+		e.ArrayStart()
+		for i0 := range v.Screenshots {
+			e.Elem()
+			v.Screenshots[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.ShareTarget != nil {
+		e.Key("shareTarget")
+		(*v.ShareTarget).MarshalCDP(e)
+	}
+	if v.ShortName != nil {
+		e.Key("shortName")
+		e.String(*v.ShortName)
+	}
+	if v.Shortcuts != nil {
+		e.Key("shortcuts")
+		e.ArrayStart()
+		for i0 := range v.Shortcuts {
+			e.Elem()
+			v.Shortcuts[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.StartUrl != nil {
+		e.Key("startUrl")
+		e.String(*v.StartUrl)
+	}
+	if v.ThemeColor != nil {
+		e.Key("themeColor")
+		e.String(*v.ThemeColor)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v WebAppManifest) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads WebAppManifest from JSON
+func (v *WebAppManifest) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "backgroundColor":
+			if d.Null() {
+				v.BackgroundColor = nil
+			} else {
+				v.BackgroundColor = new(string)
+				*v.BackgroundColor = d.String()
+			}
+		case "description":
+			if d.Null() {
+				v.Description = nil
+			} else {
+				v.Description = new(string)
+				*v.Description = d.String()
+			}
+		case "dir":
+			if d.Null() {
+				v.Dir = nil
+			} else {
+				v.Dir = new(string)
+				*v.Dir = d.String()
+			}
+		case "display":
+			if d.Null() {
+				v.Display = nil
+			} else {
+				v.Display = new(string)
+				*v.Display = d.String()
+			}
+		case "displayOverrides":
+			if d.Array() {
+				v.DisplayOverrides = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.DisplayOverrides = append(v.DisplayOverrides, x0)
+				}
+			} else {
+				v.DisplayOverrides = nil
+			}
+		case "fileHandlers":
+			if d.Array() {
+				v.FileHandlers = make([]FileHandler, 0)
+				for d.More() {
+					var x0 FileHandler
+					x0.UnmarshalCDP(d)
+					v.FileHandlers = append(v.FileHandlers, x0)
+				}
+			} else {
+				v.FileHandlers = nil
+			}
+		case "icons":
+			if d.Array() {
+				v.Icons = make([]ImageResource, 0)
+				for d.More() {
+					var x0 ImageResource
+					x0.UnmarshalCDP(d)
+					v.Icons = append(v.Icons, x0)
+				}
+			} else {
+				v.Icons = nil
+			}
+		case "id":
+			if d.Null() {
+				v.Id = nil
+			} else {
+				v.Id = new(string)
+				*v.Id = d.String()
+			}
+		case "lang":
+			if d.Null() {
+				v.Lang = nil
+			} else {
+				v.Lang = new(string)
+				*v.Lang = d.String()
+			}
+		case "launchHandler":
+			if d.Null() {
+				v.LaunchHandler = nil
+			} else {
+				v.LaunchHandler = new(LaunchHandler)
+				(*v.LaunchHandler).UnmarshalCDP(d)
+			}
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "orientation":
+			if d.Null() {
+				v.Orientation = nil
+			} else {
+				v.Orientation = new(string)
+				*v.Orientation = d.String()
+			}
+		case "preferRelatedApplications":
+			if d.Null() {
+				v.PreferRelatedApplications = nil
+			} else {
+				v.PreferRelatedApplications = new(bool)
+				*v.PreferRelatedApplications = d.Bool()
+			}
+		case "protocolHandlers":
+			if d.Array() {
+				v.ProtocolHandlers = make([]ProtocolHandler, 0)
+				for d.More() {
+					var x0 ProtocolHandler
+					x0.UnmarshalCDP(d)
+					v.ProtocolHandlers = append(v.ProtocolHandlers, x0)
+				}
+			} else {
+				v.ProtocolHandlers = nil
+			}
+		case "relatedApplications":
+			if d.Array() {
+				v.RelatedApplications = make([]RelatedApplication, 0)
+				for d.More() {
+					var x0 RelatedApplication
+					x0.UnmarshalCDP(d)
+					v.RelatedApplications = append(v.RelatedApplications, x0)
+				}
+			} else {
+				v.RelatedApplications = nil
+			}
+		case "scope":
+			if d.Null() {
+				v.Scope = nil
+			} else {
+				v.Scope = new(string)
+				*v.Scope = d.String()
+			}
+		case "scopeExtensions":
+			if d.Array() {
+				v.ScopeExtensions = make([]ScopeExtension, 0)
+				for d.More() {
+					var x0 ScopeExtension
+					x0.UnmarshalCDP(d)
+					v.ScopeExtensions = append(v.ScopeExtensions, x0)
+				}
+			} else {
+				v.ScopeExtensions = nil
+			}
+		case "screenshots":
+			if d.Array() {
+				v.Screenshots = make([]Screenshot, 0)
+				for d.More() {
+					var x0 Screenshot
+					x0.UnmarshalCDP(d)
+					v.Screenshots = append(v.Screenshots, x0)
+				}
+			} else {
+				v.Screenshots = nil
+			}
+		case "shareTarget":
+			if d.Null() {
+				v.ShareTarget = nil
+			} else {
+				v.ShareTarget = new(ShareTarget)
+				(*v.ShareTarget).UnmarshalCDP(d)
+			}
+		case "shortName":
+			if d.Null() {
+				v.ShortName = nil
+			} else {
+				v.ShortName = new(string)
+				*v.ShortName = d.String()
+			}
+		case "shortcuts":
+			if d.Array() {
+				v.Shortcuts = make([]Shortcut, 0)
+				for d.More() {
+					var x0 Shortcut
+					x0.UnmarshalCDP(d)
+					v.Shortcuts = append(v.Shortcuts, x0)
+				}
+			} else {
+				v.Shortcuts = nil
+			}
+		case "startUrl":
+			if d.Null() {
+				v.StartUrl = nil
+			} else {
+				v.StartUrl = new(string)
+				*v.StartUrl = d.String()
+			}
+		case "themeColor":
+			if d.Null() {
+				v.ThemeColor = nil
+			} else {
+				v.ThemeColor = new(string)
+				*v.ThemeColor = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WebAppManifest) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The type of a frameNavigated event.
+//
+// Experimental: this may change or be removed in any chrome release
+type NavigationType string
+
+// NavigationType values
+const (
+	NavigationTypeNavigation              NavigationType = "Navigation"
+	NavigationTypeBackForwardCacheRestore NavigationType = "BackForwardCacheRestore"
+)
+
+// Values gives every NavigationType
+func (NavigationType) Values() []NavigationType {
+	return []NavigationType{
+		NavigationTypeNavigation,
+		NavigationTypeBackForwardCacheRestore,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NavigationType) Valid() bool {
+	switch v {
+	case NavigationTypeNavigation, NavigationTypeBackForwardCacheRestore:
+		return true
+	}
+	return false
+}
+
+// List of not restored reasons for back-forward cache.
+//
+// Experimental: this may change or be removed in any chrome release
+type BackForwardCacheNotRestoredReason string
+
+// BackForwardCacheNotRestoredReason values
+const (
+	BackForwardCacheNotRestoredReasonNotPrimaryMainFrame                                      BackForwardCacheNotRestoredReason = "NotPrimaryMainFrame"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabled                                 BackForwardCacheNotRestoredReason = "BackForwardCacheDisabled"
+	BackForwardCacheNotRestoredReasonRelatedActiveContentsExist                               BackForwardCacheNotRestoredReason = "RelatedActiveContentsExist"
+	BackForwardCacheNotRestoredReasonHTTPStatusNotOK                                          BackForwardCacheNotRestoredReason = "HTTPStatusNotOK"
+	BackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS                                     BackForwardCacheNotRestoredReason = "SchemeNotHTTPOrHTTPS"
+	BackForwardCacheNotRestoredReasonLoading                                                  BackForwardCacheNotRestoredReason = "Loading"
+	BackForwardCacheNotRestoredReasonWasGrantedMediaAccess                                    BackForwardCacheNotRestoredReason = "WasGrantedMediaAccess"
+	BackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled                          BackForwardCacheNotRestoredReason = "DisableForRenderFrameHostCalled"
+	BackForwardCacheNotRestoredReasonDomainNotAllowed                                         BackForwardCacheNotRestoredReason = "DomainNotAllowed"
+	BackForwardCacheNotRestoredReasonHTTPMethodNotGET                                         BackForwardCacheNotRestoredReason = "HTTPMethodNotGET"
+	BackForwardCacheNotRestoredReasonSubframeIsNavigating                                     BackForwardCacheNotRestoredReason = "SubframeIsNavigating"
+	BackForwardCacheNotRestoredReasonTimeout                                                  BackForwardCacheNotRestoredReason = "Timeout"
+	BackForwardCacheNotRestoredReasonCacheLimit                                               BackForwardCacheNotRestoredReason = "CacheLimit"
+	BackForwardCacheNotRestoredReasonJavaScriptExecution                                      BackForwardCacheNotRestoredReason = "JavaScriptExecution"
+	BackForwardCacheNotRestoredReasonRendererProcessKilled                                    BackForwardCacheNotRestoredReason = "RendererProcessKilled"
+	BackForwardCacheNotRestoredReasonRendererProcessCrashed                                   BackForwardCacheNotRestoredReason = "RendererProcessCrashed"
+	BackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed                              BackForwardCacheNotRestoredReason = "SchedulerTrackedFeatureUsed"
+	BackForwardCacheNotRestoredReasonConflictingBrowsingInstance                              BackForwardCacheNotRestoredReason = "ConflictingBrowsingInstance"
+	BackForwardCacheNotRestoredReasonCacheFlushed                                             BackForwardCacheNotRestoredReason = "CacheFlushed"
+	BackForwardCacheNotRestoredReasonServiceWorkerVersionActivation                           BackForwardCacheNotRestoredReason = "ServiceWorkerVersionActivation"
+	BackForwardCacheNotRestoredReasonSessionRestored                                          BackForwardCacheNotRestoredReason = "SessionRestored"
+	BackForwardCacheNotRestoredReasonServiceWorkerPostMessage                                 BackForwardCacheNotRestoredReason = "ServiceWorkerPostMessage"
+	BackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded      BackForwardCacheNotRestoredReason = "EnteredBackForwardCacheBeforeServiceWorkerHostAdded"
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite                            BackForwardCacheNotRestoredReason = "RenderFrameHostReused_SameSite"
+	BackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite                           BackForwardCacheNotRestoredReason = "RenderFrameHostReused_CrossSite"
+	BackForwardCacheNotRestoredReasonServiceWorkerClaim                                       BackForwardCacheNotRestoredReason = "ServiceWorkerClaim"
+	BackForwardCacheNotRestoredReasonIgnoreEventAndEvict                                      BackForwardCacheNotRestoredReason = "IgnoreEventAndEvict"
+	BackForwardCacheNotRestoredReasonHaveInnerContents                                        BackForwardCacheNotRestoredReason = "HaveInnerContents"
+	BackForwardCacheNotRestoredReasonTimeoutPuttingInCache                                    BackForwardCacheNotRestoredReason = "TimeoutPuttingInCache"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory                      BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByLowMemory"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine                    BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledByCommandLine"
+	BackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer             BackForwardCacheNotRestoredReason = "NetworkRequestDatapipeDrainedAsBytesConsumer"
+	BackForwardCacheNotRestoredReasonNetworkRequestRedirected                                 BackForwardCacheNotRestoredReason = "NetworkRequestRedirected"
+	BackForwardCacheNotRestoredReasonNetworkRequestTimeout                                    BackForwardCacheNotRestoredReason = "NetworkRequestTimeout"
+	BackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit                                BackForwardCacheNotRestoredReason = "NetworkExceedsBufferLimit"
+	BackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring                        BackForwardCacheNotRestoredReason = "NavigationCancelledWhileRestoring"
+	BackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry                             BackForwardCacheNotRestoredReason = "NotMostRecentNavigationEntry"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender                     BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForPrerender"
+	BackForwardCacheNotRestoredReasonUserAgentOverrideDiffers                                 BackForwardCacheNotRestoredReason = "UserAgentOverrideDiffers"
+	BackForwardCacheNotRestoredReasonForegroundCacheLimit                                     BackForwardCacheNotRestoredReason = "ForegroundCacheLimit"
+	BackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped                               BackForwardCacheNotRestoredReason = "BrowsingInstanceNotSwapped"
+	BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate                      BackForwardCacheNotRestoredReason = "BackForwardCacheDisabledForDelegate"
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame                           BackForwardCacheNotRestoredReason = "UnloadHandlerExistsInMainFrame"
+	BackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame                            BackForwardCacheNotRestoredReason = "UnloadHandlerExistsInSubFrame"
+	BackForwardCacheNotRestoredReasonServiceWorkerUnregistration                              BackForwardCacheNotRestoredReason = "ServiceWorkerUnregistration"
+	BackForwardCacheNotRestoredReasonCacheControlNoStore                                      BackForwardCacheNotRestoredReason = "CacheControlNoStore"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified                        BackForwardCacheNotRestoredReason = "CacheControlNoStoreCookieModified"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified                BackForwardCacheNotRestoredReason = "CacheControlNoStoreHTTPOnlyCookieModified"
+	BackForwardCacheNotRestoredReasonNoResponseHead                                           BackForwardCacheNotRestoredReason = "NoResponseHead"
+	BackForwardCacheNotRestoredReasonUnknown                                                  BackForwardCacheNotRestoredReason = "Unknown"
+	BackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857             BackForwardCacheNotRestoredReason = "ActivationNavigationsDisallowedForBug1234857"
+	BackForwardCacheNotRestoredReasonErrorDocument                                            BackForwardCacheNotRestoredReason = "ErrorDocument"
+	BackForwardCacheNotRestoredReasonFencedFramesEmbedder                                     BackForwardCacheNotRestoredReason = "FencedFramesEmbedder"
+	BackForwardCacheNotRestoredReasonCookieDisabled                                           BackForwardCacheNotRestoredReason = "CookieDisabled"
+	BackForwardCacheNotRestoredReasonHTTPAuthRequired                                         BackForwardCacheNotRestoredReason = "HTTPAuthRequired"
+	BackForwardCacheNotRestoredReasonCookieFlushed                                            BackForwardCacheNotRestoredReason = "CookieFlushed"
+	BackForwardCacheNotRestoredReasonBroadcastChannelOnMessage                                BackForwardCacheNotRestoredReason = "BroadcastChannelOnMessage"
+	BackForwardCacheNotRestoredReasonWebViewSettingsChanged                                   BackForwardCacheNotRestoredReason = "WebViewSettingsChanged"
+	BackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged                           BackForwardCacheNotRestoredReason = "WebViewJavaScriptObjectChanged"
+	BackForwardCacheNotRestoredReasonWebViewMessageListenerInjected                           BackForwardCacheNotRestoredReason = "WebViewMessageListenerInjected"
+	BackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged                      BackForwardCacheNotRestoredReason = "WebViewSafeBrowsingAllowlistChanged"
+	BackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged                    BackForwardCacheNotRestoredReason = "WebViewDocumentStartJavascriptChanged"
+	BackForwardCacheNotRestoredReasonWebSocket                                                BackForwardCacheNotRestoredReason = "WebSocket"
+	BackForwardCacheNotRestoredReasonWebTransport                                             BackForwardCacheNotRestoredReason = "WebTransport"
+	BackForwardCacheNotRestoredReasonWebRTC                                                   BackForwardCacheNotRestoredReason = "WebRTC"
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore                       BackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoStore"
+	BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache                       BackForwardCacheNotRestoredReason = "MainResourceHasCacheControlNoCache"
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore                        BackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoStore"
+	BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache                        BackForwardCacheNotRestoredReason = "SubresourceHasCacheControlNoCache"
+	BackForwardCacheNotRestoredReasonContainsPlugins                                          BackForwardCacheNotRestoredReason = "ContainsPlugins"
+	BackForwardCacheNotRestoredReasonDocumentLoaded                                           BackForwardCacheNotRestoredReason = "DocumentLoaded"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers                          BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestOthers"
+	BackForwardCacheNotRestoredReasonRequestedMIDIPermission                                  BackForwardCacheNotRestoredReason = "RequestedMIDIPermission"
+	BackForwardCacheNotRestoredReasonRequestedAudioCapturePermission                          BackForwardCacheNotRestoredReason = "RequestedAudioCapturePermission"
+	BackForwardCacheNotRestoredReasonRequestedVideoCapturePermission                          BackForwardCacheNotRestoredReason = "RequestedVideoCapturePermission"
+	BackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors                  BackForwardCacheNotRestoredReason = "RequestedBackForwardCacheBlockedSensors"
+	BackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission                        BackForwardCacheNotRestoredReason = "RequestedBackgroundWorkPermission"
+	BackForwardCacheNotRestoredReasonBroadcastChannel                                         BackForwardCacheNotRestoredReason = "BroadcastChannel"
+	BackForwardCacheNotRestoredReasonWebXR                                                    BackForwardCacheNotRestoredReason = "WebXR"
+	BackForwardCacheNotRestoredReasonSharedWorker                                             BackForwardCacheNotRestoredReason = "SharedWorker"
+	BackForwardCacheNotRestoredReasonSharedWorkerMessage                                      BackForwardCacheNotRestoredReason = "SharedWorkerMessage"
+	BackForwardCacheNotRestoredReasonWebLocks                                                 BackForwardCacheNotRestoredReason = "WebLocks"
+	BackForwardCacheNotRestoredReasonWebHID                                                   BackForwardCacheNotRestoredReason = "WebHID"
+	BackForwardCacheNotRestoredReasonWebShare                                                 BackForwardCacheNotRestoredReason = "WebShare"
+	BackForwardCacheNotRestoredReasonRequestedStorageAccessGrant                              BackForwardCacheNotRestoredReason = "RequestedStorageAccessGrant"
+	BackForwardCacheNotRestoredReasonWebNfc                                                   BackForwardCacheNotRestoredReason = "WebNfc"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch                           BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestFetch"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR                             BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestXHR"
+	BackForwardCacheNotRestoredReasonAppBanner                                                BackForwardCacheNotRestoredReason = "AppBanner"
+	BackForwardCacheNotRestoredReasonPrinting                                                 BackForwardCacheNotRestoredReason = "Printing"
+	BackForwardCacheNotRestoredReasonWebDatabase                                              BackForwardCacheNotRestoredReason = "WebDatabase"
+	BackForwardCacheNotRestoredReasonPictureInPicture                                         BackForwardCacheNotRestoredReason = "PictureInPicture"
+	BackForwardCacheNotRestoredReasonSpeechRecognizer                                         BackForwardCacheNotRestoredReason = "SpeechRecognizer"
+	BackForwardCacheNotRestoredReasonIdleManager                                              BackForwardCacheNotRestoredReason = "IdleManager"
+	BackForwardCacheNotRestoredReasonPaymentManager                                           BackForwardCacheNotRestoredReason = "PaymentManager"
+	BackForwardCacheNotRestoredReasonSpeechSynthesis                                          BackForwardCacheNotRestoredReason = "SpeechSynthesis"
+	BackForwardCacheNotRestoredReasonKeyboardLock                                             BackForwardCacheNotRestoredReason = "KeyboardLock"
+	BackForwardCacheNotRestoredReasonWebOTPService                                            BackForwardCacheNotRestoredReason = "WebOTPService"
+	BackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket                    BackForwardCacheNotRestoredReason = "OutstandingNetworkRequestDirectSocket"
+	BackForwardCacheNotRestoredReasonInjectedJavascript                                       BackForwardCacheNotRestoredReason = "InjectedJavascript"
+	BackForwardCacheNotRestoredReasonInjectedStyleSheet                                       BackForwardCacheNotRestoredReason = "InjectedStyleSheet"
+	BackForwardCacheNotRestoredReasonKeepaliveRequest                                         BackForwardCacheNotRestoredReason = "KeepaliveRequest"
+	BackForwardCacheNotRestoredReasonIndexedDBEvent                                           BackForwardCacheNotRestoredReason = "IndexedDBEvent"
+	BackForwardCacheNotRestoredReasonDummy                                                    BackForwardCacheNotRestoredReason = "Dummy"
+	BackForwardCacheNotRestoredReasonJsNetworkRequestReceivedCacheControlNoStoreResource      BackForwardCacheNotRestoredReason = "JsNetworkRequestReceivedCacheControlNoStoreResource"
+	BackForwardCacheNotRestoredReasonWebRTCSticky                                             BackForwardCacheNotRestoredReason = "WebRTCSticky"
+	BackForwardCacheNotRestoredReasonWebTransportSticky                                       BackForwardCacheNotRestoredReason = "WebTransportSticky"
+	BackForwardCacheNotRestoredReasonWebSocketSticky                                          BackForwardCacheNotRestoredReason = "WebSocketSticky"
+	BackForwardCacheNotRestoredReasonSmartCard                                                BackForwardCacheNotRestoredReason = "SmartCard"
+	BackForwardCacheNotRestoredReasonLiveMediaStreamTrack                                     BackForwardCacheNotRestoredReason = "LiveMediaStreamTrack"
+	BackForwardCacheNotRestoredReasonUnloadHandler                                            BackForwardCacheNotRestoredReason = "UnloadHandler"
+	BackForwardCacheNotRestoredReasonParserAborted                                            BackForwardCacheNotRestoredReason = "ParserAborted"
+	BackForwardCacheNotRestoredReasonContentSecurityHandler                                   BackForwardCacheNotRestoredReason = "ContentSecurityHandler"
+	BackForwardCacheNotRestoredReasonContentWebAuthenticationAPI                              BackForwardCacheNotRestoredReason = "ContentWebAuthenticationAPI"
+	BackForwardCacheNotRestoredReasonContentFileChooser                                       BackForwardCacheNotRestoredReason = "ContentFileChooser"
+	BackForwardCacheNotRestoredReasonContentSerial                                            BackForwardCacheNotRestoredReason = "ContentSerial"
+	BackForwardCacheNotRestoredReasonContentFileSystemAccess                                  BackForwardCacheNotRestoredReason = "ContentFileSystemAccess"
+	BackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost                        BackForwardCacheNotRestoredReason = "ContentMediaDevicesDispatcherHost"
+	BackForwardCacheNotRestoredReasonContentWebBluetooth                                      BackForwardCacheNotRestoredReason = "ContentWebBluetooth"
+	BackForwardCacheNotRestoredReasonContentWebUSB                                            BackForwardCacheNotRestoredReason = "ContentWebUSB"
+	BackForwardCacheNotRestoredReasonContentMediaSessionService                               BackForwardCacheNotRestoredReason = "ContentMediaSessionService"
+	BackForwardCacheNotRestoredReasonContentScreenReader                                      BackForwardCacheNotRestoredReason = "ContentScreenReader"
+	BackForwardCacheNotRestoredReasonContentDiscarded                                         BackForwardCacheNotRestoredReason = "ContentDiscarded"
+	BackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper                            BackForwardCacheNotRestoredReason = "EmbedderPopupBlockerTabHelper"
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker                BackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingTriggeredPopupBlocker"
+	BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails                        BackForwardCacheNotRestoredReason = "EmbedderSafeBrowsingThreatDetails"
+	BackForwardCacheNotRestoredReasonEmbedderAppBannerManager                                 BackForwardCacheNotRestoredReason = "EmbedderAppBannerManager"
+	BackForwardCacheNotRestoredReasonEmbedderDomDistillerViewerSource                         BackForwardCacheNotRestoredReason = "EmbedderDomDistillerViewerSource"
+	BackForwardCacheNotRestoredReasonEmbedderDomDistillerSelfDeletingRequestDelegate          BackForwardCacheNotRestoredReason = "EmbedderDomDistillerSelfDeletingRequestDelegate"
+	BackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper                         BackForwardCacheNotRestoredReason = "EmbedderOomInterventionTabHelper"
+	BackForwardCacheNotRestoredReasonEmbedderOfflinePage                                      BackForwardCacheNotRestoredReason = "EmbedderOfflinePage"
+	BackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager BackForwardCacheNotRestoredReason = "EmbedderChromePasswordManagerClientBindCredentialManager"
+	BackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager                         BackForwardCacheNotRestoredReason = "EmbedderPermissionRequestManager"
+	BackForwardCacheNotRestoredReasonEmbedderModalDialog                                      BackForwardCacheNotRestoredReason = "EmbedderModalDialog"
+	BackForwardCacheNotRestoredReasonEmbedderExtensions                                       BackForwardCacheNotRestoredReason = "EmbedderExtensions"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessaging                               BackForwardCacheNotRestoredReason = "EmbedderExtensionMessaging"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort                    BackForwardCacheNotRestoredReason = "EmbedderExtensionMessagingForOpenPort"
+	BackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame                BackForwardCacheNotRestoredReason = "EmbedderExtensionSentMessageToCachedFrame"
+	BackForwardCacheNotRestoredReasonRequestedByWebViewClient                                 BackForwardCacheNotRestoredReason = "RequestedByWebViewClient"
+	BackForwardCacheNotRestoredReasonPostMessageByWebViewClient                               BackForwardCacheNotRestoredReason = "PostMessageByWebViewClient"
+	BackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated          BackForwardCacheNotRestoredReason = "CacheControlNoStoreDeviceBoundSessionTerminated"
+	BackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure                 BackForwardCacheNotRestoredReason = "CacheLimitPrunedOnModerateMemoryPressure"
+	BackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure                 BackForwardCacheNotRestoredReason = "CacheLimitPrunedOnCriticalMemoryPressure"
+)
+
+// Values gives every BackForwardCacheNotRestoredReason
+func (BackForwardCacheNotRestoredReason) Values() []BackForwardCacheNotRestoredReason {
+	return []BackForwardCacheNotRestoredReason{
+		BackForwardCacheNotRestoredReasonNotPrimaryMainFrame,
+		BackForwardCacheNotRestoredReasonBackForwardCacheDisabled,
+		BackForwardCacheNotRestoredReasonRelatedActiveContentsExist,
+		BackForwardCacheNotRestoredReasonHTTPStatusNotOK,
+		BackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS,
+		BackForwardCacheNotRestoredReasonLoading,
+		BackForwardCacheNotRestoredReasonWasGrantedMediaAccess,
+		BackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled,
+		BackForwardCacheNotRestoredReasonDomainNotAllowed,
+		BackForwardCacheNotRestoredReasonHTTPMethodNotGET,
+		BackForwardCacheNotRestoredReasonSubframeIsNavigating,
+		BackForwardCacheNotRestoredReasonTimeout,
+		BackForwardCacheNotRestoredReasonCacheLimit,
+		BackForwardCacheNotRestoredReasonJavaScriptExecution,
+		BackForwardCacheNotRestoredReasonRendererProcessKilled,
+		BackForwardCacheNotRestoredReasonRendererProcessCrashed,
+		BackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed,
+		BackForwardCacheNotRestoredReasonConflictingBrowsingInstance,
+		BackForwardCacheNotRestoredReasonCacheFlushed,
+		BackForwardCacheNotRestoredReasonServiceWorkerVersionActivation,
+		BackForwardCacheNotRestoredReasonSessionRestored,
+		BackForwardCacheNotRestoredReasonServiceWorkerPostMessage,
+		BackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded,
+		BackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite,
+		BackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite,
+		BackForwardCacheNotRestoredReasonServiceWorkerClaim,
+		BackForwardCacheNotRestoredReasonIgnoreEventAndEvict,
+		BackForwardCacheNotRestoredReasonHaveInnerContents,
+		BackForwardCacheNotRestoredReasonTimeoutPuttingInCache,
+		BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory,
+		BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine,
+		BackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer,
+		BackForwardCacheNotRestoredReasonNetworkRequestRedirected,
+		BackForwardCacheNotRestoredReasonNetworkRequestTimeout,
+		BackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit,
+		BackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring,
+		BackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry,
+		BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender,
+		BackForwardCacheNotRestoredReasonUserAgentOverrideDiffers,
+		BackForwardCacheNotRestoredReasonForegroundCacheLimit,
+		BackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped,
+		BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate,
+		BackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame,
+		BackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame,
+		BackForwardCacheNotRestoredReasonServiceWorkerUnregistration,
+		BackForwardCacheNotRestoredReasonCacheControlNoStore,
+		BackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified,
+		BackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified,
+		BackForwardCacheNotRestoredReasonNoResponseHead,
+		BackForwardCacheNotRestoredReasonUnknown,
+		BackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857,
+		BackForwardCacheNotRestoredReasonErrorDocument,
+		BackForwardCacheNotRestoredReasonFencedFramesEmbedder,
+		BackForwardCacheNotRestoredReasonCookieDisabled,
+		BackForwardCacheNotRestoredReasonHTTPAuthRequired,
+		BackForwardCacheNotRestoredReasonCookieFlushed,
+		BackForwardCacheNotRestoredReasonBroadcastChannelOnMessage,
+		BackForwardCacheNotRestoredReasonWebViewSettingsChanged,
+		BackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged,
+		BackForwardCacheNotRestoredReasonWebViewMessageListenerInjected,
+		BackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged,
+		BackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged,
+		BackForwardCacheNotRestoredReasonWebSocket,
+		BackForwardCacheNotRestoredReasonWebTransport,
+		BackForwardCacheNotRestoredReasonWebRTC,
+		BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore,
+		BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache,
+		BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore,
+		BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache,
+		BackForwardCacheNotRestoredReasonContainsPlugins,
+		BackForwardCacheNotRestoredReasonDocumentLoaded,
+		BackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers,
+		BackForwardCacheNotRestoredReasonRequestedMIDIPermission,
+		BackForwardCacheNotRestoredReasonRequestedAudioCapturePermission,
+		BackForwardCacheNotRestoredReasonRequestedVideoCapturePermission,
+		BackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors,
+		BackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission,
+		BackForwardCacheNotRestoredReasonBroadcastChannel,
+		BackForwardCacheNotRestoredReasonWebXR,
+		BackForwardCacheNotRestoredReasonSharedWorker,
+		BackForwardCacheNotRestoredReasonSharedWorkerMessage,
+		BackForwardCacheNotRestoredReasonWebLocks,
+		BackForwardCacheNotRestoredReasonWebHID,
+		BackForwardCacheNotRestoredReasonWebShare,
+		BackForwardCacheNotRestoredReasonRequestedStorageAccessGrant,
+		BackForwardCacheNotRestoredReasonWebNfc,
+		BackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch,
+		BackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR,
+		BackForwardCacheNotRestoredReasonAppBanner,
+		BackForwardCacheNotRestoredReasonPrinting,
+		BackForwardCacheNotRestoredReasonWebDatabase,
+		BackForwardCacheNotRestoredReasonPictureInPicture,
+		BackForwardCacheNotRestoredReasonSpeechRecognizer,
+		BackForwardCacheNotRestoredReasonIdleManager,
+		BackForwardCacheNotRestoredReasonPaymentManager,
+		BackForwardCacheNotRestoredReasonSpeechSynthesis,
+		BackForwardCacheNotRestoredReasonKeyboardLock,
+		BackForwardCacheNotRestoredReasonWebOTPService,
+		BackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket,
+		BackForwardCacheNotRestoredReasonInjectedJavascript,
+		BackForwardCacheNotRestoredReasonInjectedStyleSheet,
+		BackForwardCacheNotRestoredReasonKeepaliveRequest,
+		BackForwardCacheNotRestoredReasonIndexedDBEvent,
+		BackForwardCacheNotRestoredReasonDummy,
+		BackForwardCacheNotRestoredReasonJsNetworkRequestReceivedCacheControlNoStoreResource,
+		BackForwardCacheNotRestoredReasonWebRTCSticky,
+		BackForwardCacheNotRestoredReasonWebTransportSticky,
+		BackForwardCacheNotRestoredReasonWebSocketSticky,
+		BackForwardCacheNotRestoredReasonSmartCard,
+		BackForwardCacheNotRestoredReasonLiveMediaStreamTrack,
+		BackForwardCacheNotRestoredReasonUnloadHandler,
+		BackForwardCacheNotRestoredReasonParserAborted,
+		BackForwardCacheNotRestoredReasonContentSecurityHandler,
+		BackForwardCacheNotRestoredReasonContentWebAuthenticationAPI,
+		BackForwardCacheNotRestoredReasonContentFileChooser,
+		BackForwardCacheNotRestoredReasonContentSerial,
+		BackForwardCacheNotRestoredReasonContentFileSystemAccess,
+		BackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost,
+		BackForwardCacheNotRestoredReasonContentWebBluetooth,
+		BackForwardCacheNotRestoredReasonContentWebUSB,
+		BackForwardCacheNotRestoredReasonContentMediaSessionService,
+		BackForwardCacheNotRestoredReasonContentScreenReader,
+		BackForwardCacheNotRestoredReasonContentDiscarded,
+		BackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper,
+		BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker,
+		BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails,
+		BackForwardCacheNotRestoredReasonEmbedderAppBannerManager,
+		BackForwardCacheNotRestoredReasonEmbedderDomDistillerViewerSource,
+		BackForwardCacheNotRestoredReasonEmbedderDomDistillerSelfDeletingRequestDelegate,
+		BackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper,
+		BackForwardCacheNotRestoredReasonEmbedderOfflinePage,
+		BackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager,
+		BackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager,
+		BackForwardCacheNotRestoredReasonEmbedderModalDialog,
+		BackForwardCacheNotRestoredReasonEmbedderExtensions,
+		BackForwardCacheNotRestoredReasonEmbedderExtensionMessaging,
+		BackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort,
+		BackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame,
+		BackForwardCacheNotRestoredReasonRequestedByWebViewClient,
+		BackForwardCacheNotRestoredReasonPostMessageByWebViewClient,
+		BackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated,
+		BackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure,
+		BackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v BackForwardCacheNotRestoredReason) Valid() bool {
+	switch v {
+	case BackForwardCacheNotRestoredReasonNotPrimaryMainFrame, BackForwardCacheNotRestoredReasonBackForwardCacheDisabled, BackForwardCacheNotRestoredReasonRelatedActiveContentsExist, BackForwardCacheNotRestoredReasonHTTPStatusNotOK, BackForwardCacheNotRestoredReasonSchemeNotHTTPOrHTTPS, BackForwardCacheNotRestoredReasonLoading, BackForwardCacheNotRestoredReasonWasGrantedMediaAccess, BackForwardCacheNotRestoredReasonDisableForRenderFrameHostCalled, BackForwardCacheNotRestoredReasonDomainNotAllowed, BackForwardCacheNotRestoredReasonHTTPMethodNotGET, BackForwardCacheNotRestoredReasonSubframeIsNavigating, BackForwardCacheNotRestoredReasonTimeout, BackForwardCacheNotRestoredReasonCacheLimit, BackForwardCacheNotRestoredReasonJavaScriptExecution, BackForwardCacheNotRestoredReasonRendererProcessKilled, BackForwardCacheNotRestoredReasonRendererProcessCrashed, BackForwardCacheNotRestoredReasonSchedulerTrackedFeatureUsed, BackForwardCacheNotRestoredReasonConflictingBrowsingInstance, BackForwardCacheNotRestoredReasonCacheFlushed, BackForwardCacheNotRestoredReasonServiceWorkerVersionActivation, BackForwardCacheNotRestoredReasonSessionRestored, BackForwardCacheNotRestoredReasonServiceWorkerPostMessage, BackForwardCacheNotRestoredReasonEnteredBackForwardCacheBeforeServiceWorkerHostAdded, BackForwardCacheNotRestoredReasonRenderFrameHostReusedSameSite, BackForwardCacheNotRestoredReasonRenderFrameHostReusedCrossSite, BackForwardCacheNotRestoredReasonServiceWorkerClaim, BackForwardCacheNotRestoredReasonIgnoreEventAndEvict, BackForwardCacheNotRestoredReasonHaveInnerContents, BackForwardCacheNotRestoredReasonTimeoutPuttingInCache, BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByLowMemory, BackForwardCacheNotRestoredReasonBackForwardCacheDisabledByCommandLine, BackForwardCacheNotRestoredReasonNetworkRequestDatapipeDrainedAsBytesConsumer, BackForwardCacheNotRestoredReasonNetworkRequestRedirected, BackForwardCacheNotRestoredReasonNetworkRequestTimeout, BackForwardCacheNotRestoredReasonNetworkExceedsBufferLimit, BackForwardCacheNotRestoredReasonNavigationCancelledWhileRestoring, BackForwardCacheNotRestoredReasonNotMostRecentNavigationEntry, BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForPrerender, BackForwardCacheNotRestoredReasonUserAgentOverrideDiffers, BackForwardCacheNotRestoredReasonForegroundCacheLimit, BackForwardCacheNotRestoredReasonBrowsingInstanceNotSwapped, BackForwardCacheNotRestoredReasonBackForwardCacheDisabledForDelegate, BackForwardCacheNotRestoredReasonUnloadHandlerExistsInMainFrame, BackForwardCacheNotRestoredReasonUnloadHandlerExistsInSubFrame, BackForwardCacheNotRestoredReasonServiceWorkerUnregistration, BackForwardCacheNotRestoredReasonCacheControlNoStore, BackForwardCacheNotRestoredReasonCacheControlNoStoreCookieModified, BackForwardCacheNotRestoredReasonCacheControlNoStoreHTTPOnlyCookieModified, BackForwardCacheNotRestoredReasonNoResponseHead, BackForwardCacheNotRestoredReasonUnknown, BackForwardCacheNotRestoredReasonActivationNavigationsDisallowedForBug1234857, BackForwardCacheNotRestoredReasonErrorDocument, BackForwardCacheNotRestoredReasonFencedFramesEmbedder, BackForwardCacheNotRestoredReasonCookieDisabled, BackForwardCacheNotRestoredReasonHTTPAuthRequired, BackForwardCacheNotRestoredReasonCookieFlushed, BackForwardCacheNotRestoredReasonBroadcastChannelOnMessage, BackForwardCacheNotRestoredReasonWebViewSettingsChanged, BackForwardCacheNotRestoredReasonWebViewJavaScriptObjectChanged, BackForwardCacheNotRestoredReasonWebViewMessageListenerInjected, BackForwardCacheNotRestoredReasonWebViewSafeBrowsingAllowlistChanged, BackForwardCacheNotRestoredReasonWebViewDocumentStartJavascriptChanged, BackForwardCacheNotRestoredReasonWebSocket, BackForwardCacheNotRestoredReasonWebTransport, BackForwardCacheNotRestoredReasonWebRTC, BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoStore, BackForwardCacheNotRestoredReasonMainResourceHasCacheControlNoCache, BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoStore, BackForwardCacheNotRestoredReasonSubresourceHasCacheControlNoCache, BackForwardCacheNotRestoredReasonContainsPlugins, BackForwardCacheNotRestoredReasonDocumentLoaded, BackForwardCacheNotRestoredReasonOutstandingNetworkRequestOthers, BackForwardCacheNotRestoredReasonRequestedMIDIPermission, BackForwardCacheNotRestoredReasonRequestedAudioCapturePermission, BackForwardCacheNotRestoredReasonRequestedVideoCapturePermission, BackForwardCacheNotRestoredReasonRequestedBackForwardCacheBlockedSensors, BackForwardCacheNotRestoredReasonRequestedBackgroundWorkPermission, BackForwardCacheNotRestoredReasonBroadcastChannel, BackForwardCacheNotRestoredReasonWebXR, BackForwardCacheNotRestoredReasonSharedWorker, BackForwardCacheNotRestoredReasonSharedWorkerMessage, BackForwardCacheNotRestoredReasonWebLocks, BackForwardCacheNotRestoredReasonWebHID, BackForwardCacheNotRestoredReasonWebShare, BackForwardCacheNotRestoredReasonRequestedStorageAccessGrant, BackForwardCacheNotRestoredReasonWebNfc, BackForwardCacheNotRestoredReasonOutstandingNetworkRequestFetch, BackForwardCacheNotRestoredReasonOutstandingNetworkRequestXHR, BackForwardCacheNotRestoredReasonAppBanner, BackForwardCacheNotRestoredReasonPrinting, BackForwardCacheNotRestoredReasonWebDatabase, BackForwardCacheNotRestoredReasonPictureInPicture, BackForwardCacheNotRestoredReasonSpeechRecognizer, BackForwardCacheNotRestoredReasonIdleManager, BackForwardCacheNotRestoredReasonPaymentManager, BackForwardCacheNotRestoredReasonSpeechSynthesis, BackForwardCacheNotRestoredReasonKeyboardLock, BackForwardCacheNotRestoredReasonWebOTPService, BackForwardCacheNotRestoredReasonOutstandingNetworkRequestDirectSocket, BackForwardCacheNotRestoredReasonInjectedJavascript, BackForwardCacheNotRestoredReasonInjectedStyleSheet, BackForwardCacheNotRestoredReasonKeepaliveRequest, BackForwardCacheNotRestoredReasonIndexedDBEvent, BackForwardCacheNotRestoredReasonDummy, BackForwardCacheNotRestoredReasonJsNetworkRequestReceivedCacheControlNoStoreResource, BackForwardCacheNotRestoredReasonWebRTCSticky, BackForwardCacheNotRestoredReasonWebTransportSticky, BackForwardCacheNotRestoredReasonWebSocketSticky, BackForwardCacheNotRestoredReasonSmartCard, BackForwardCacheNotRestoredReasonLiveMediaStreamTrack, BackForwardCacheNotRestoredReasonUnloadHandler, BackForwardCacheNotRestoredReasonParserAborted, BackForwardCacheNotRestoredReasonContentSecurityHandler, BackForwardCacheNotRestoredReasonContentWebAuthenticationAPI, BackForwardCacheNotRestoredReasonContentFileChooser, BackForwardCacheNotRestoredReasonContentSerial, BackForwardCacheNotRestoredReasonContentFileSystemAccess, BackForwardCacheNotRestoredReasonContentMediaDevicesDispatcherHost, BackForwardCacheNotRestoredReasonContentWebBluetooth, BackForwardCacheNotRestoredReasonContentWebUSB, BackForwardCacheNotRestoredReasonContentMediaSessionService, BackForwardCacheNotRestoredReasonContentScreenReader, BackForwardCacheNotRestoredReasonContentDiscarded, BackForwardCacheNotRestoredReasonEmbedderPopupBlockerTabHelper, BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingTriggeredPopupBlocker, BackForwardCacheNotRestoredReasonEmbedderSafeBrowsingThreatDetails, BackForwardCacheNotRestoredReasonEmbedderAppBannerManager, BackForwardCacheNotRestoredReasonEmbedderDomDistillerViewerSource, BackForwardCacheNotRestoredReasonEmbedderDomDistillerSelfDeletingRequestDelegate, BackForwardCacheNotRestoredReasonEmbedderOomInterventionTabHelper, BackForwardCacheNotRestoredReasonEmbedderOfflinePage, BackForwardCacheNotRestoredReasonEmbedderChromePasswordManagerClientBindCredentialManager, BackForwardCacheNotRestoredReasonEmbedderPermissionRequestManager, BackForwardCacheNotRestoredReasonEmbedderModalDialog, BackForwardCacheNotRestoredReasonEmbedderExtensions, BackForwardCacheNotRestoredReasonEmbedderExtensionMessaging, BackForwardCacheNotRestoredReasonEmbedderExtensionMessagingForOpenPort, BackForwardCacheNotRestoredReasonEmbedderExtensionSentMessageToCachedFrame, BackForwardCacheNotRestoredReasonRequestedByWebViewClient, BackForwardCacheNotRestoredReasonPostMessageByWebViewClient, BackForwardCacheNotRestoredReasonCacheControlNoStoreDeviceBoundSessionTerminated, BackForwardCacheNotRestoredReasonCacheLimitPrunedOnModerateMemoryPressure, BackForwardCacheNotRestoredReasonCacheLimitPrunedOnCriticalMemoryPressure:
+		return true
+	}
+	return false
+}
+
+// Types of not restored reasons for back-forward cache.
+//
+// Experimental: this may change or be removed in any chrome release
+type BackForwardCacheNotRestoredReasonType string
+
+// BackForwardCacheNotRestoredReasonType values
+const (
+	BackForwardCacheNotRestoredReasonTypeSupportPending    BackForwardCacheNotRestoredReasonType = "SupportPending"
+	BackForwardCacheNotRestoredReasonTypePageSupportNeeded BackForwardCacheNotRestoredReasonType = "PageSupportNeeded"
+	BackForwardCacheNotRestoredReasonTypeCircumstantial    BackForwardCacheNotRestoredReasonType = "Circumstantial"
+)
+
+// Values gives every BackForwardCacheNotRestoredReasonType
+func (BackForwardCacheNotRestoredReasonType) Values() []BackForwardCacheNotRestoredReasonType {
+	return []BackForwardCacheNotRestoredReasonType{
+		BackForwardCacheNotRestoredReasonTypeSupportPending,
+		BackForwardCacheNotRestoredReasonTypePageSupportNeeded,
+		BackForwardCacheNotRestoredReasonTypeCircumstantial,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v BackForwardCacheNotRestoredReasonType) Valid() bool {
+	switch v {
+	case BackForwardCacheNotRestoredReasonTypeSupportPending, BackForwardCacheNotRestoredReasonTypePageSupportNeeded, BackForwardCacheNotRestoredReasonTypeCircumstantial:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type BackForwardCacheBlockingDetails struct {
+	/* Url of the file where blockage happened. Optional because of tests. */
+	Url *string `json:"url,omitempty"`
+	/* Function name where blockage happened. Optional because of anonymous functions and tests. */
+	Function *string `json:"function,omitempty"`
+	/* Line number in the script (0-based). */
+	LineNumber int `json:"lineNumber"`
+	/* Column number in the script (0-based). */
+	ColumnNumber int `json:"columnNumber"`
+}
+
+// MarshalCDP writes BackForwardCacheBlockingDetails as JSON
+func (v *BackForwardCacheBlockingDetails) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Function != nil {
+		e.Key("function")
+		e.String(*v.Function)
+	}
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BackForwardCacheBlockingDetails) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BackForwardCacheBlockingDetails from JSON
+func (v *BackForwardCacheBlockingDetails) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "function":
+			if d.Null() {
+				v.Function = nil
+			} else {
+				v.Function = new(string)
+				*v.Function = d.String()
+			}
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackForwardCacheBlockingDetails) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type BackForwardCacheNotRestoredExplanation struct {
+	/* Type of the reason */
+	Type BackForwardCacheNotRestoredReasonType `json:"type"`
+	/* Not restored reason */
+	Reason BackForwardCacheNotRestoredReason `json:"reason"`
+	/* Context associated with the reason. The meaning of this context is
+	dependent on the reason:
+	- EmbedderExtensionSentMessageToCachedFrame: the extension ID. */
+	Context *string                           `json:"context,omitempty"`
+	Details []BackForwardCacheBlockingDetails `json:"details"`
+}
+
+// MarshalCDP writes BackForwardCacheNotRestoredExplanation as JSON
+func (v *BackForwardCacheNotRestoredExplanation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("reason")
+	e.String(string(v.Reason))
+	if v.Context != nil {
+		e.Key("context")
+		e.String(*v.Context)
+	}
+	if v.Details != nil {
+		e.Key("details")
+		e.ArrayStart()
+		for i0 := range v.Details {
+			e.Elem()
+			v.Details[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BackForwardCacheNotRestoredExplanation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BackForwardCacheNotRestoredExplanation from JSON
+func (v *BackForwardCacheNotRestoredExplanation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = BackForwardCacheNotRestoredReasonType(d.String())
+		case "reason":
+			v.Reason = BackForwardCacheNotRestoredReason(d.String())
+		case "context":
+			if d.Null() {
+				v.Context = nil
+			} else {
+				v.Context = new(string)
+				*v.Context = d.String()
+			}
+		case "details":
+			if d.Array() {
+				v.Details = make([]BackForwardCacheBlockingDetails, 0)
+				for d.More() {
+					var x0 BackForwardCacheBlockingDetails
+					x0.UnmarshalCDP(d)
+					v.Details = append(v.Details, x0)
+				}
+			} else {
+				v.Details = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackForwardCacheNotRestoredExplanation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type BackForwardCacheNotRestoredExplanationTree struct {
+	/* URL of each frame */
+	Url string `json:"url"`
+	/* Not restored reasons of each frame */
+	Explanations []BackForwardCacheNotRestoredExplanation `json:"explanations"`
+	/* Array of children frame */
+	Children []BackForwardCacheNotRestoredExplanationTree `json:"children"`
+}
+
+// MarshalCDP writes BackForwardCacheNotRestoredExplanationTree as JSON
+func (v *BackForwardCacheNotRestoredExplanationTree) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("explanations")
+	if v.Explanations == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Explanations {
+			e.Elem()
+			v.Explanations[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("children")
+	if v.Children == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Children {
+			e.Elem()
+			v.Children[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BackForwardCacheNotRestoredExplanationTree) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BackForwardCacheNotRestoredExplanationTree from JSON
+func (v *BackForwardCacheNotRestoredExplanationTree) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "explanations":
+			if d.Array() {
+				v.Explanations = make([]BackForwardCacheNotRestoredExplanation, 0)
+				for d.More() {
+					var x0 BackForwardCacheNotRestoredExplanation
+					x0.UnmarshalCDP(d)
+					v.Explanations = append(v.Explanations, x0)
+				}
+			} else {
+				v.Explanations = nil
+			}
+		case "children":
+			if d.Array() {
+				v.Children = make([]BackForwardCacheNotRestoredExplanationTree, 0)
+				for d.More() {
+					var x0 BackForwardCacheNotRestoredExplanationTree
+					x0.UnmarshalCDP(d)
+					v.Children = append(v.Children, x0)
+				}
+			} else {
+				v.Children = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackForwardCacheNotRestoredExplanationTree) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CaptureScreenshotFormat string
+
+// CaptureScreenshotFormat values
+const (
+	CaptureScreenshotFormatJpeg CaptureScreenshotFormat = "jpeg"
+	CaptureScreenshotFormatPng  CaptureScreenshotFormat = "png"
+	CaptureScreenshotFormatWebp CaptureScreenshotFormat = "webp"
+)
+
+// Values gives every CaptureScreenshotFormat
+func (CaptureScreenshotFormat) Values() []CaptureScreenshotFormat {
+	return []CaptureScreenshotFormat{
+		CaptureScreenshotFormatJpeg,
+		CaptureScreenshotFormatPng,
+		CaptureScreenshotFormatWebp,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CaptureScreenshotFormat) Valid() bool {
+	switch v {
+	case CaptureScreenshotFormatJpeg, CaptureScreenshotFormatPng, CaptureScreenshotFormatWebp:
+		return true
+	}
+	return false
+}
+
+type CaptureSnapshotFormat string
+
+// CaptureSnapshotFormat values
+const (
+	CaptureSnapshotFormatMhtml CaptureSnapshotFormat = "mhtml"
+)
+
+// Values gives every CaptureSnapshotFormat
+func (CaptureSnapshotFormat) Values() []CaptureSnapshotFormat {
+	return []CaptureSnapshotFormat{
+		CaptureSnapshotFormatMhtml,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CaptureSnapshotFormat) Valid() bool {
+	switch v {
+	case CaptureSnapshotFormatMhtml:
+		return true
+	}
+	return false
+}
+
+type PrintToPDFTransferMode string
+
+// PrintToPDFTransferMode values
+const (
+	PrintToPDFTransferModeReturnAsBase64 PrintToPDFTransferMode = "ReturnAsBase64"
+	PrintToPDFTransferModeReturnAsStream PrintToPDFTransferMode = "ReturnAsStream"
+)
+
+// Values gives every PrintToPDFTransferMode
+func (PrintToPDFTransferMode) Values() []PrintToPDFTransferMode {
+	return []PrintToPDFTransferMode{
+		PrintToPDFTransferModeReturnAsBase64,
+		PrintToPDFTransferModeReturnAsStream,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PrintToPDFTransferMode) Valid() bool {
+	switch v {
+	case PrintToPDFTransferModeReturnAsBase64, PrintToPDFTransferModeReturnAsStream:
+		return true
+	}
+	return false
+}
+
+type SetDownloadBehaviorBehavior string
+
+// SetDownloadBehaviorBehavior values
+const (
+	SetDownloadBehaviorBehaviorDeny    SetDownloadBehaviorBehavior = "deny"
+	SetDownloadBehaviorBehaviorAllow   SetDownloadBehaviorBehavior = "allow"
+	SetDownloadBehaviorBehaviorDefault SetDownloadBehaviorBehavior = "default"
+)
+
+// Values gives every SetDownloadBehaviorBehavior
+func (SetDownloadBehaviorBehavior) Values() []SetDownloadBehaviorBehavior {
+	return []SetDownloadBehaviorBehavior{
+		SetDownloadBehaviorBehaviorDeny,
+		SetDownloadBehaviorBehaviorAllow,
+		SetDownloadBehaviorBehaviorDefault,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetDownloadBehaviorBehavior) Valid() bool {
+	switch v {
+	case SetDownloadBehaviorBehaviorDeny, SetDownloadBehaviorBehaviorAllow, SetDownloadBehaviorBehaviorDefault:
+		return true
+	}
+	return false
+}
+
+type SetTouchEmulationEnabledConfiguration string
+
+// SetTouchEmulationEnabledConfiguration values
+const (
+	SetTouchEmulationEnabledConfigurationMobile  SetTouchEmulationEnabledConfiguration = "mobile"
+	SetTouchEmulationEnabledConfigurationDesktop SetTouchEmulationEnabledConfiguration = "desktop"
+)
+
+// Values gives every SetTouchEmulationEnabledConfiguration
+func (SetTouchEmulationEnabledConfiguration) Values() []SetTouchEmulationEnabledConfiguration {
+	return []SetTouchEmulationEnabledConfiguration{
+		SetTouchEmulationEnabledConfigurationMobile,
+		SetTouchEmulationEnabledConfigurationDesktop,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetTouchEmulationEnabledConfiguration) Valid() bool {
+	switch v {
+	case SetTouchEmulationEnabledConfigurationMobile, SetTouchEmulationEnabledConfigurationDesktop:
+		return true
+	}
+	return false
+}
+
+type StartScreencastFormat string
+
+// StartScreencastFormat values
+const (
+	StartScreencastFormatJpeg StartScreencastFormat = "jpeg"
+	StartScreencastFormatPng  StartScreencastFormat = "png"
+)
+
+// Values gives every StartScreencastFormat
+func (StartScreencastFormat) Values() []StartScreencastFormat {
+	return []StartScreencastFormat{
+		StartScreencastFormatJpeg,
+		StartScreencastFormatPng,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StartScreencastFormat) Valid() bool {
+	switch v {
+	case StartScreencastFormatJpeg, StartScreencastFormatPng:
+		return true
+	}
+	return false
+}
+
+type SetWebLifecycleStateState string
+
+// SetWebLifecycleStateState values
+const (
+	SetWebLifecycleStateStateFrozen SetWebLifecycleStateState = "frozen"
+	SetWebLifecycleStateStateActive SetWebLifecycleStateState = "active"
+)
+
+// Values gives every SetWebLifecycleStateState
+func (SetWebLifecycleStateState) Values() []SetWebLifecycleStateState {
+	return []SetWebLifecycleStateState{
+		SetWebLifecycleStateStateFrozen,
+		SetWebLifecycleStateStateActive,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetWebLifecycleStateState) Valid() bool {
+	switch v {
+	case SetWebLifecycleStateStateFrozen, SetWebLifecycleStateStateActive:
+		return true
+	}
+	return false
+}
+
+type SetSPCTransactionModeMode string
+
+// SetSPCTransactionModeMode values
+const (
+	SetSPCTransactionModeModeNone                       SetSPCTransactionModeMode = "none"
+	SetSPCTransactionModeModeAutoAccept                 SetSPCTransactionModeMode = "autoAccept"
+	SetSPCTransactionModeModeAutoChooseToAuthAnotherWay SetSPCTransactionModeMode = "autoChooseToAuthAnotherWay"
+	SetSPCTransactionModeModeAutoReject                 SetSPCTransactionModeMode = "autoReject"
+	SetSPCTransactionModeModeAutoOptOut                 SetSPCTransactionModeMode = "autoOptOut"
+)
+
+// Values gives every SetSPCTransactionModeMode
+func (SetSPCTransactionModeMode) Values() []SetSPCTransactionModeMode {
+	return []SetSPCTransactionModeMode{
+		SetSPCTransactionModeModeNone,
+		SetSPCTransactionModeModeAutoAccept,
+		SetSPCTransactionModeModeAutoChooseToAuthAnotherWay,
+		SetSPCTransactionModeModeAutoReject,
+		SetSPCTransactionModeModeAutoOptOut,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetSPCTransactionModeMode) Valid() bool {
+	switch v {
+	case SetSPCTransactionModeModeNone, SetSPCTransactionModeModeAutoAccept, SetSPCTransactionModeModeAutoChooseToAuthAnotherWay, SetSPCTransactionModeModeAutoReject, SetSPCTransactionModeModeAutoOptOut:
+		return true
+	}
+	return false
+}
+
+type SetRPHRegistrationModeMode string
+
+// SetRPHRegistrationModeMode values
+const (
+	SetRPHRegistrationModeModeNone       SetRPHRegistrationModeMode = "none"
+	SetRPHRegistrationModeModeAutoAccept SetRPHRegistrationModeMode = "autoAccept"
+	SetRPHRegistrationModeModeAutoReject SetRPHRegistrationModeMode = "autoReject"
+)
+
+// Values gives every SetRPHRegistrationModeMode
+func (SetRPHRegistrationModeMode) Values() []SetRPHRegistrationModeMode {
+	return []SetRPHRegistrationModeMode{
+		SetRPHRegistrationModeModeNone,
+		SetRPHRegistrationModeModeAutoAccept,
+		SetRPHRegistrationModeModeAutoReject,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SetRPHRegistrationModeMode) Valid() bool {
+	switch v {
+	case SetRPHRegistrationModeModeNone, SetRPHRegistrationModeModeAutoAccept, SetRPHRegistrationModeModeAutoReject:
+		return true
+	}
+	return false
+}
+
+type FileChooserOpenedMode string
+
+// FileChooserOpenedMode values
+const (
+	FileChooserOpenedModeSelectSingle   FileChooserOpenedMode = "selectSingle"
+	FileChooserOpenedModeSelectMultiple FileChooserOpenedMode = "selectMultiple"
+)
+
+// Values gives every FileChooserOpenedMode
+func (FileChooserOpenedMode) Values() []FileChooserOpenedMode {
+	return []FileChooserOpenedMode{
+		FileChooserOpenedModeSelectSingle,
+		FileChooserOpenedModeSelectMultiple,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v FileChooserOpenedMode) Valid() bool {
+	switch v {
+	case FileChooserOpenedModeSelectSingle, FileChooserOpenedModeSelectMultiple:
+		return true
+	}
+	return false
+}
+
+type FrameDetachedReason string
+
+// FrameDetachedReason values
+const (
+	FrameDetachedReasonRemove FrameDetachedReason = "remove"
+	FrameDetachedReasonSwap   FrameDetachedReason = "swap"
+)
+
+// Values gives every FrameDetachedReason
+func (FrameDetachedReason) Values() []FrameDetachedReason {
+	return []FrameDetachedReason{
+		FrameDetachedReasonRemove,
+		FrameDetachedReasonSwap,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v FrameDetachedReason) Valid() bool {
+	switch v {
+	case FrameDetachedReasonRemove, FrameDetachedReasonSwap:
+		return true
+	}
+	return false
+}
+
+type FrameStartedNavigatingNavigationType string
+
+// FrameStartedNavigatingNavigationType values
+const (
+	FrameStartedNavigatingNavigationTypeReload                   FrameStartedNavigatingNavigationType = "reload"
+	FrameStartedNavigatingNavigationTypeReloadBypassingCache     FrameStartedNavigatingNavigationType = "reloadBypassingCache"
+	FrameStartedNavigatingNavigationTypeRestore                  FrameStartedNavigatingNavigationType = "restore"
+	FrameStartedNavigatingNavigationTypeRestoreWithPost          FrameStartedNavigatingNavigationType = "restoreWithPost"
+	FrameStartedNavigatingNavigationTypeHistorySameDocument      FrameStartedNavigatingNavigationType = "historySameDocument"
+	FrameStartedNavigatingNavigationTypeHistoryDifferentDocument FrameStartedNavigatingNavigationType = "historyDifferentDocument"
+	FrameStartedNavigatingNavigationTypeSameDocument             FrameStartedNavigatingNavigationType = "sameDocument"
+	FrameStartedNavigatingNavigationTypeDifferentDocument        FrameStartedNavigatingNavigationType = "differentDocument"
+)
+
+// Values gives every FrameStartedNavigatingNavigationType
+func (FrameStartedNavigatingNavigationType) Values() []FrameStartedNavigatingNavigationType {
+	return []FrameStartedNavigatingNavigationType{
+		FrameStartedNavigatingNavigationTypeReload,
+		FrameStartedNavigatingNavigationTypeReloadBypassingCache,
+		FrameStartedNavigatingNavigationTypeRestore,
+		FrameStartedNavigatingNavigationTypeRestoreWithPost,
+		FrameStartedNavigatingNavigationTypeHistorySameDocument,
+		FrameStartedNavigatingNavigationTypeHistoryDifferentDocument,
+		FrameStartedNavigatingNavigationTypeSameDocument,
+		FrameStartedNavigatingNavigationTypeDifferentDocument,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v FrameStartedNavigatingNavigationType) Valid() bool {
+	switch v {
+	case FrameStartedNavigatingNavigationTypeReload, FrameStartedNavigatingNavigationTypeReloadBypassingCache, FrameStartedNavigatingNavigationTypeRestore, FrameStartedNavigatingNavigationTypeRestoreWithPost, FrameStartedNavigatingNavigationTypeHistorySameDocument, FrameStartedNavigatingNavigationTypeHistoryDifferentDocument, FrameStartedNavigatingNavigationTypeSameDocument, FrameStartedNavigatingNavigationTypeDifferentDocument:
+		return true
+	}
+	return false
+}
+
+type DownloadProgressState string
+
+// DownloadProgressState values
+const (
+	DownloadProgressStateInProgress DownloadProgressState = "inProgress"
+	DownloadProgressStateCompleted  DownloadProgressState = "completed"
+	DownloadProgressStateCanceled   DownloadProgressState = "canceled"
+)
+
+// Values gives every DownloadProgressState
+func (DownloadProgressState) Values() []DownloadProgressState {
+	return []DownloadProgressState{
+		DownloadProgressStateInProgress,
+		DownloadProgressStateCompleted,
+		DownloadProgressStateCanceled,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DownloadProgressState) Valid() bool {
+	switch v {
+	case DownloadProgressStateInProgress, DownloadProgressStateCompleted, DownloadProgressStateCanceled:
+		return true
+	}
+	return false
+}
+
+type NavigatedWithinDocumentNavigationType string
+
+// NavigatedWithinDocumentNavigationType values
+const (
+	NavigatedWithinDocumentNavigationTypeFragment   NavigatedWithinDocumentNavigationType = "fragment"
+	NavigatedWithinDocumentNavigationTypeHistoryApi NavigatedWithinDocumentNavigationType = "historyApi"
+	NavigatedWithinDocumentNavigationTypeOther      NavigatedWithinDocumentNavigationType = "other"
+)
+
+// Values gives every NavigatedWithinDocumentNavigationType
+func (NavigatedWithinDocumentNavigationType) Values() []NavigatedWithinDocumentNavigationType {
+	return []NavigatedWithinDocumentNavigationType{
+		NavigatedWithinDocumentNavigationTypeFragment,
+		NavigatedWithinDocumentNavigationTypeHistoryApi,
+		NavigatedWithinDocumentNavigationTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NavigatedWithinDocumentNavigationType) Valid() bool {
+	switch v {
+	case NavigatedWithinDocumentNavigationTypeFragment, NavigatedWithinDocumentNavigationTypeHistoryApi, NavigatedWithinDocumentNavigationTypeOther:
+		return true
+	}
+	return false
+}
+
+type AddScriptToEvaluateOnNewDocumentReturns struct {
+
+	/* Identifier of the added script. */
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// UnmarshalCDP reads AddScriptToEvaluateOnNewDocumentReturns from JSON
+func (v *AddScriptToEvaluateOnNewDocumentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = ScriptIdentifier(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddScriptToEvaluateOnNewDocumentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddScriptToEvaluateOnNewDocumentParams are the parameters for Page.addScriptToEvaluateOnNewDocument
+// optional parameters are left out when nil
+type AddScriptToEvaluateOnNewDocumentParams struct {
+	Source string `json:"source"`
+	/* If specified, creates an isolated world with the given name and evaluates given script in it.
+	This world name will be used as the ExecutionContextDescription::name when the corresponding
+	event is emitted.
+
+	Experimental: this may change or be removed in any chrome release */
+	WorldName *string `json:"worldName,omitempty"`
+	/* Specifies whether command line API should be available to the script, defaults
+	to false.
+
+	Experimental: this may change or be removed in any chrome release */
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	/* If true, runs the script immediately on existing execution contexts or worlds.
+	Default: false.
+
+	Experimental: this may change or be removed in any chrome release */
+	RunImmediately *bool `json:"runImmediately,omitempty"`
+}
+
+// MarshalCDP writes AddScriptToEvaluateOnNewDocumentParams as JSON
+func (v *AddScriptToEvaluateOnNewDocumentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("source")
+	e.String(v.Source)
+	if v.WorldName != nil {
+		e.Key("worldName")
+		e.String(*v.WorldName)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		e.Key("includeCommandLineAPI")
+		e.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.RunImmediately != nil {
+		e.Key("runImmediately")
+		e.Bool(*v.RunImmediately)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddScriptToEvaluateOnNewDocumentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Evaluates given script in every frame upon creation (before loading frame's scripts).
+func (c Client) AddScriptToEvaluateOnNewDocument(params AddScriptToEvaluateOnNewDocumentParams) (AddScriptToEvaluateOnNewDocumentReturns, error) {
+	return c.AddScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+// AddScriptToEvaluateOnNewDocumentContext is AddScriptToEvaluateOnNewDocument with a context for cancellation and deadlines
+func (c Client) AddScriptToEvaluateOnNewDocumentContext(ctx context.Context, params AddScriptToEvaluateOnNewDocumentParams) (AddScriptToEvaluateOnNewDocumentReturns, error) {
+	var returns_ AddScriptToEvaluateOnNewDocumentReturns
+
+	err_ := c.caller.Call(ctx, "Page.addScriptToEvaluateOnNewDocument", &params, &returns_)
+
+	return returns_, err_
+}
+
+type BringToFrontReturns struct {
+}
+
+// UnmarshalCDP reads BringToFrontReturns from JSON
+func (v *BringToFrontReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BringToFrontReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Brings page to front (activates tab).
+func (c Client) BringToFront() (BringToFrontReturns, error) {
+	return c.BringToFrontContext(context.Background())
+}
+
+// BringToFrontContext is BringToFront with a context for cancellation and deadlines
+func (c Client) BringToFrontContext(ctx context.Context) (BringToFrontReturns, error) {
+	var returns_ BringToFrontReturns
+
+	err_ := c.caller.Call(ctx, "Page.bringToFront", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CaptureScreenshotReturns struct {
+
+	/* Base64-encoded image data. (Encoded as a base64 string when passed over JSON) */
+	Data string `json:"data"`
+}
+
+// UnmarshalCDP reads CaptureScreenshotReturns from JSON
+func (v *CaptureScreenshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CaptureScreenshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CaptureScreenshotParams are the parameters for Page.captureScreenshot
+// optional parameters are left out when nil
+type CaptureScreenshotParams struct {
+	/* Image compression format (defaults to png). */
+	Format *CaptureScreenshotFormat `json:"format,omitempty"`
+	/* Compression quality from range [0..100] (jpeg only). */
+	Quality *int `json:"quality,omitempty"`
+	/* Capture the screenshot of a given region only. */
+	Clip *Viewport `json:"clip,omitempty"`
+	/* Capture the screenshot from the surface, rather than the view. Defaults to true.
+
+	Experimental: this may change or be removed in any chrome release */
+	FromSurface *bool `json:"fromSurface,omitempty"`
+	/* Capture the screenshot beyond the viewport. Defaults to false.
+
+	Experimental: this may change or be removed in any chrome release */
+	CaptureBeyondViewport *bool `json:"captureBeyondViewport,omitempty"`
+	/* Optimize image encoding for speed, not for resulting size (defaults to false)
+
+	Experimental: this may change or be removed in any chrome release */
+	OptimizeForSpeed *bool `json:"optimizeForSpeed,omitempty"`
+}
+
+// MarshalCDP writes CaptureScreenshotParams as JSON
+func (v *CaptureScreenshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Format != nil {
+		e.Key("format")
+		e.String(string(*v.Format))
+	}
+	if v.Quality != nil {
+		e.Key("quality")
+		e.Int(*v.Quality)
+	}
+	if v.Clip != nil {
+		e.Key("clip")
+		(*v.Clip).MarshalCDP(e)
+	}
+	if v.FromSurface != nil {
+		e.Key("fromSurface")
+		e.Bool(*v.FromSurface)
+	}
+	if v.CaptureBeyondViewport != nil {
+		e.Key("captureBeyondViewport")
+		e.Bool(*v.CaptureBeyondViewport)
+	}
+	if v.OptimizeForSpeed != nil {
+		e.Key("optimizeForSpeed")
+		e.Bool(*v.OptimizeForSpeed)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CaptureScreenshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Capture page screenshot.
+func (c Client) CaptureScreenshot(params CaptureScreenshotParams) (CaptureScreenshotReturns, error) {
+	return c.CaptureScreenshotContext(context.Background(), params)
+}
+
+// CaptureScreenshotContext is CaptureScreenshot with a context for cancellation and deadlines
+func (c Client) CaptureScreenshotContext(ctx context.Context, params CaptureScreenshotParams) (CaptureScreenshotReturns, error) {
+	var returns_ CaptureScreenshotReturns
+
+	if params.Format != nil && !params.Format.Valid() {
+		return returns_, fmt.Errorf("Page.captureScreenshot: invalid format %q", *params.Format)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.captureScreenshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearGeolocationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearGeolocationOverrideReturns from JSON
+func (v *ClearGeolocationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearGeolocationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the overridden Geolocation Position and Error.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ClearGeolocationOverride() (ClearGeolocationOverrideReturns, error) {
+	return c.ClearGeolocationOverrideContext(context.Background())
+}
+
+// ClearGeolocationOverrideContext is ClearGeolocationOverride with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ClearGeolocationOverrideContext(ctx context.Context) (ClearGeolocationOverrideReturns, error) {
+	var returns_ ClearGeolocationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Page.clearGeolocationOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CreateIsolatedWorldReturns struct {
+
+	/* Execution context of the isolated world. */
+	ExecutionContextId cdp.RuntimeExecutionContextId `json:"executionContextId"`
+}
+
+// UnmarshalCDP reads CreateIsolatedWorldReturns from JSON
+func (v *CreateIsolatedWorldReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "executionContextId":
+			v.ExecutionContextId = cdp.RuntimeExecutionContextId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CreateIsolatedWorldReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CreateIsolatedWorldParams are the parameters for Page.createIsolatedWorld
+// optional parameters are left out when nil
+type CreateIsolatedWorldParams struct {
+	/* Id of the frame in which the isolated world should be created. */
+	FrameId FrameId `json:"frameId"`
+	/* An optional name which is reported in the Execution Context. */
+	WorldName *string `json:"worldName,omitempty"`
+	/* Whether or not universal access should be granted to the isolated world. This is a powerful
+	option, use with caution. */
+	GrantUniveralAccess *bool `json:"grantUniveralAccess,omitempty"`
+}
+
+// MarshalCDP writes CreateIsolatedWorldParams as JSON
+func (v *CreateIsolatedWorldParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	if v.WorldName != nil {
+		e.Key("worldName")
+		e.String(*v.WorldName)
+	}
+	if v.GrantUniveralAccess != nil {
+		e.Key("grantUniveralAccess")
+		e.Bool(*v.GrantUniveralAccess)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CreateIsolatedWorldParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Creates an isolated world for the given frame.
+func (c Client) CreateIsolatedWorld(params CreateIsolatedWorldParams) (CreateIsolatedWorldReturns, error) {
+	return c.CreateIsolatedWorldContext(context.Background(), params)
+}
+
+// CreateIsolatedWorldContext is CreateIsolatedWorld with a context for cancellation and deadlines
+func (c Client) CreateIsolatedWorldContext(ctx context.Context, params CreateIsolatedWorldParams) (CreateIsolatedWorldReturns, error) {
+	var returns_ CreateIsolatedWorldReturns
+
+	err_ := c.caller.Call(ctx, "Page.createIsolatedWorld", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables page domain notifications.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Page.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for Page.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* If true, the `Page.fileChooserOpened` event will be emitted regardless of the state set by
+	`Page.setInterceptFileChooserDialog` command (default: false).
+
+	Experimental: this may change or be removed in any chrome release */
+	EnableFileChooserOpenedEvent *bool `json:"enableFileChooserOpenedEvent,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.EnableFileChooserOpenedEvent != nil {
+		e.Key("enableFileChooserOpenedEvent")
+		e.Bool(*v.EnableFileChooserOpenedEvent)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables page domain notifications.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Page.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetAppManifestReturns struct {
+
+	/* Manifest location. */
+	Url string `json:"url"`
+
+	Errors []AppManifestError `json:"errors"`
+
+	/* Manifest content. */
+	Data *string `json:"data,omitempty"`
+
+	/* Parsed manifest properties. Deprecated, use manifest instead.
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Parsed *AppManifestParsedProperties `json:"parsed,omitempty"`
+
+	/* Experimental: this may change or be removed in any chrome release */
+	Manifest WebAppManifest `json:"manifest"`
+}
+
+// UnmarshalCDP reads GetAppManifestReturns from JSON
+func (v *GetAppManifestReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "errors":
+			if d.Array() {
+				v.Errors = make([]AppManifestError, 0)
+				for d.More() {
+					var x0 AppManifestError
+					x0.UnmarshalCDP(d)
+					v.Errors = append(v.Errors, x0)
+				}
+			} else {
+				v.Errors = nil
+			}
+		case "data":
+			if d.Null() {
+				v.Data = nil
+			} else {
+				v.Data = new(string)
+				*v.Data = d.String()
+			}
+		case "parsed":
+			if d.Null() {
+				v.Parsed = nil
+			} else {
+				v.Parsed = new(AppManifestParsedProperties)
+				(*v.Parsed).UnmarshalCDP(d)
+			}
+		case "manifest":
+			v.Manifest.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAppManifestReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAppManifestParams are the parameters for Page.getAppManifest
+// optional parameters are left out when nil
+type GetAppManifestParams struct {
+	ManifestId *string `json:"manifestId,omitempty"`
+}
+
+// MarshalCDP writes GetAppManifestParams as JSON
+func (v *GetAppManifestParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ManifestId != nil {
+		e.Key("manifestId")
+		e.String(*v.ManifestId)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAppManifestParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets the processed manifest for this current document.
+//
+//	This API always waits for the manifest to be loaded.
+//	If manifestId is provided, and it does not match the manifest of the
+//	  current document, this API errors out.
+//	If there is not a loaded page, this API errors out immediately.
+func (c Client) GetAppManifest(params GetAppManifestParams) (GetAppManifestReturns, error) {
+	return c.GetAppManifestContext(context.Background(), params)
+}
+
+// GetAppManifestContext is GetAppManifest with a context for cancellation and deadlines
+func (c Client) GetAppManifestContext(ctx context.Context, params GetAppManifestParams) (GetAppManifestReturns, error) {
+	var returns_ GetAppManifestReturns
+
+	err_ := c.caller.Call(ctx, "Page.getAppManifest", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetFrameTreeReturns struct {
+
+	/* Present frame tree structure. */
+	FrameTree FrameTree `json:"frameTree"`
+}
+
+// UnmarshalCDP reads GetFrameTreeReturns from JSON
+func (v *GetFrameTreeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameTree":
+			v.FrameTree.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetFrameTreeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns present frame tree structure.
+func (c Client) GetFrameTree() (GetFrameTreeReturns, error) {
+	return c.GetFrameTreeContext(context.Background())
+}
+
+// GetFrameTreeContext is GetFrameTree with a context for cancellation and deadlines
+func (c Client) GetFrameTreeContext(ctx context.Context) (GetFrameTreeReturns, error) {
+	var returns_ GetFrameTreeReturns
+
+	err_ := c.caller.Call(ctx, "Page.getFrameTree", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetLayoutMetricsReturns struct {
+
+	/* Deprecated metrics relating to the layout viewport. Is in device pixels. Use `cssLayoutViewport` instead.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	LayoutViewport LayoutViewport `json:"layoutViewport"`
+
+	/* Deprecated metrics relating to the visual viewport. Is in device pixels. Use `cssVisualViewport` instead.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	VisualViewport VisualViewport `json:"visualViewport"`
+
+	/* Deprecated size of scrollable area. Is in DP. Use `cssContentSize` instead.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	ContentSize cdp.DOMRect `json:"contentSize"`
+
+	/* Metrics relating to the layout viewport in CSS pixels. */
+	CssLayoutViewport LayoutViewport `json:"cssLayoutViewport"`
+
+	/* Metrics relating to the visual viewport in CSS pixels. */
+	CssVisualViewport VisualViewport `json:"cssVisualViewport"`
+
+	/* Size of scrollable area in CSS pixels. */
+	CssContentSize cdp.DOMRect `json:"cssContentSize"`
+}
+
+// UnmarshalCDP reads GetLayoutMetricsReturns from JSON
+func (v *GetLayoutMetricsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "layoutViewport":
+			v.LayoutViewport.UnmarshalCDP(d)
+		case "visualViewport":
+			v.VisualViewport.UnmarshalCDP(d)
+		case "contentSize":
+			v.ContentSize.UnmarshalCDP(d)
+		case "cssLayoutViewport":
+			v.CssLayoutViewport.UnmarshalCDP(d)
+		case "cssVisualViewport":
+			v.CssVisualViewport.UnmarshalCDP(d)
+		case "cssContentSize":
+			v.CssContentSize.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetLayoutMetricsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns metrics relating to the layouting of the page, such as viewport bounds/scale.
+func (c Client) GetLayoutMetrics() (GetLayoutMetricsReturns, error) {
+	return c.GetLayoutMetricsContext(context.Background())
+}
+
+// GetLayoutMetricsContext is GetLayoutMetrics with a context for cancellation and deadlines
+func (c Client) GetLayoutMetricsContext(ctx context.Context) (GetLayoutMetricsReturns, error) {
+	var returns_ GetLayoutMetricsReturns
+
+	err_ := c.caller.Call(ctx, "Page.getLayoutMetrics", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetNavigationHistoryReturns struct {
+
+	/* Index of the current navigation history entry. */
+	CurrentIndex int `json:"currentIndex"`
+
+	/* Array of navigation history entries. */
+	Entries []NavigationEntry `json:"entries"`
+}
+
+// UnmarshalCDP reads GetNavigationHistoryReturns from JSON
+func (v *GetNavigationHistoryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "currentIndex":
+			v.CurrentIndex = d.Int()
+		case "entries":
+			if d.Array() {
+				v.Entries = make([]NavigationEntry, 0)
+				for d.More() {
+					var x0 NavigationEntry
+					x0.UnmarshalCDP(d)
+					v.Entries = append(v.Entries, x0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetNavigationHistoryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns navigation history for the current page.
+func (c Client) GetNavigationHistory() (GetNavigationHistoryReturns, error) {
+	return c.GetNavigationHistoryContext(context.Background())
+}
+
+// GetNavigationHistoryContext is GetNavigationHistory with a context for cancellation and deadlines
+func (c Client) GetNavigationHistoryContext(ctx context.Context) (GetNavigationHistoryReturns, error) {
+	var returns_ GetNavigationHistoryReturns
+
+	err_ := c.caller.Call(ctx, "Page.getNavigationHistory", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ResetNavigationHistoryReturns struct {
+}
+
+// UnmarshalCDP reads ResetNavigationHistoryReturns from JSON
+func (v *ResetNavigationHistoryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResetNavigationHistoryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Resets navigation history for the current page.
+func (c Client) ResetNavigationHistory() (ResetNavigationHistoryReturns, error) {
+	return c.ResetNavigationHistoryContext(context.Background())
+}
+
+// ResetNavigationHistoryContext is ResetNavigationHistory with a context for cancellation and deadlines
+func (c Client) ResetNavigationHistoryContext(ctx context.Context) (ResetNavigationHistoryReturns, error) {
+	var returns_ ResetNavigationHistoryReturns
+
+	err_ := c.caller.Call(ctx, "Page.resetNavigationHistory", nil, &returns_)
+
+	return returns_, err_
+}
+
+type HandleJavaScriptDialogReturns struct {
+}
+
+// UnmarshalCDP reads HandleJavaScriptDialogReturns from JSON
+func (v *HandleJavaScriptDialogReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HandleJavaScriptDialogReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HandleJavaScriptDialogParams are the parameters for Page.handleJavaScriptDialog
+// optional parameters are left out when nil
+type HandleJavaScriptDialogParams struct {
+	/* Whether to accept or dismiss the dialog. */
+	Accept bool `json:"accept"`
+	/* The text to enter into the dialog prompt before accepting. Used only if this is a prompt
+	dialog. */
+	PromptText *string `json:"promptText,omitempty"`
+}
+
+// MarshalCDP writes HandleJavaScriptDialogParams as JSON
+func (v *HandleJavaScriptDialogParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("accept")
+	e.Bool(v.Accept)
+	if v.PromptText != nil {
+		e.Key("promptText")
+		e.String(*v.PromptText)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HandleJavaScriptDialogParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
+func (c Client) HandleJavaScriptDialog(params HandleJavaScriptDialogParams) (HandleJavaScriptDialogReturns, error) {
+	return c.HandleJavaScriptDialogContext(context.Background(), params)
+}
+
+// HandleJavaScriptDialogContext is HandleJavaScriptDialog with a context for cancellation and deadlines
+func (c Client) HandleJavaScriptDialogContext(ctx context.Context, params HandleJavaScriptDialogParams) (HandleJavaScriptDialogReturns, error) {
+	var returns_ HandleJavaScriptDialogReturns
+
+	err_ := c.caller.Call(ctx, "Page.handleJavaScriptDialog", &params, &returns_)
+
+	return returns_, err_
+}
+
+type NavigateReturns struct {
+
+	/* Frame id that has navigated (or failed to navigate) */
+	FrameId FrameId `json:"frameId"`
+
+	/* Loader identifier. This is omitted in case of same-document navigation,
+	as the previously committed loaderId would not change. */
+	LoaderId *cdp.NetworkLoaderId `json:"loaderId,omitempty"`
+
+	/* User friendly error message, present if and only if navigation has failed. */
+	ErrorText *string `json:"errorText,omitempty"`
+
+	/* Whether the navigation resulted in a download.
+
+	Experimental: this may change or be removed in any chrome release */
+	IsDownload *bool `json:"isDownload,omitempty"`
+}
+
+// UnmarshalCDP reads NavigateReturns from JSON
+func (v *NavigateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "loaderId":
+			if d.Null() {
+				v.LoaderId = nil
+			} else {
+				v.LoaderId = new(cdp.NetworkLoaderId)
+				*v.LoaderId = cdp.NetworkLoaderId(d.String())
+			}
+		case "errorText":
+			if d.Null() {
+				v.ErrorText = nil
+			} else {
+				v.ErrorText = new(string)
+				*v.ErrorText = d.String()
+			}
+		case "isDownload":
+			if d.Null() {
+				v.IsDownload = nil
+			} else {
+				v.IsDownload = new(bool)
+				*v.IsDownload = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NavigateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// NavigateParams are the parameters for Page.navigate
+// optional parameters are left out when nil
+type NavigateParams struct {
+	/* URL to navigate the page to. */
+	Url string `json:"url"`
+	/* Referrer URL. */
+	Referrer *string `json:"referrer,omitempty"`
+	/* Intended transition type. */
+	TransitionType *TransitionType `json:"transitionType,omitempty"`
+	/* Frame id to navigate, if not specified navigates the top frame. */
+	FrameId *FrameId `json:"frameId,omitempty"`
+	/* Referrer-policy used for the navigation.
+
+	Experimental: this may change or be removed in any chrome release */
+	ReferrerPolicy *ReferrerPolicy `json:"referrerPolicy,omitempty"`
+}
+
+// MarshalCDP writes NavigateParams as JSON
+func (v *NavigateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	if v.Referrer != nil {
+		e.Key("referrer")
+		e.String(*v.Referrer)
+	}
+	if v.TransitionType != nil {
+		e.Key("transitionType")
+		e.String(string(*v.TransitionType))
+	}
+	if v.FrameId != nil {
+		e.Key("frameId")
+		e.String(string(*v.FrameId))
+	}
+	if v.ReferrerPolicy != nil {
+		e.Key("referrerPolicy")
+		e.String(string(*v.ReferrerPolicy))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NavigateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Navigates current page to the given URL.
+func (c Client) Navigate(params NavigateParams) (NavigateReturns, error) {
+	return c.NavigateContext(context.Background(), params)
+}
+
+// NavigateContext is Navigate with a context for cancellation and deadlines
+func (c Client) NavigateContext(ctx context.Context, params NavigateParams) (NavigateReturns, error) {
+	var returns_ NavigateReturns
+
+	if params.TransitionType != nil && !params.TransitionType.Valid() {
+		return returns_, fmt.Errorf("Page.navigate: invalid transitionType %q", *params.TransitionType)
+	}
+
+	if params.ReferrerPolicy != nil && !params.ReferrerPolicy.Valid() {
+		return returns_, fmt.Errorf("Page.navigate: invalid referrerPolicy %q", *params.ReferrerPolicy)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.navigate", &params, &returns_)
+
+	return returns_, err_
+}
+
+type NavigateToHistoryEntryReturns struct {
+}
+
+// UnmarshalCDP reads NavigateToHistoryEntryReturns from JSON
+func (v *NavigateToHistoryEntryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NavigateToHistoryEntryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// NavigateToHistoryEntryParams are the parameters for Page.navigateToHistoryEntry
+// optional parameters are left out when nil
+type NavigateToHistoryEntryParams struct {
+	/* Unique id of the entry to navigate to. */
+	EntryId int `json:"entryId"`
+}
+
+// MarshalCDP writes NavigateToHistoryEntryParams as JSON
+func (v *NavigateToHistoryEntryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("entryId")
+	e.Int(v.EntryId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NavigateToHistoryEntryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Navigates current page to the given history entry.
+func (c Client) NavigateToHistoryEntry(params NavigateToHistoryEntryParams) (NavigateToHistoryEntryReturns, error) {
+	return c.NavigateToHistoryEntryContext(context.Background(), params)
+}
+
+// NavigateToHistoryEntryContext is NavigateToHistoryEntry with a context for cancellation and deadlines
+func (c Client) NavigateToHistoryEntryContext(ctx context.Context, params NavigateToHistoryEntryParams) (NavigateToHistoryEntryReturns, error) {
+	var returns_ NavigateToHistoryEntryReturns
+
+	err_ := c.caller.Call(ctx, "Page.navigateToHistoryEntry", &params, &returns_)
+
+	return returns_, err_
+}
+
+type PrintToPDFReturns struct {
+
+	/* Base64-encoded pdf data. Empty if |returnAsStream| is specified. (Encoded as a base64 string when passed over JSON) */
+	Data string `json:"data"`
+
+	/* A handle of the stream that holds resulting PDF data.
+
+	Experimental: this may change or be removed in any chrome release */
+	Stream *cdp.IOStreamHandle `json:"stream,omitempty"`
+}
+
+// UnmarshalCDP reads PrintToPDFReturns from JSON
+func (v *PrintToPDFReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = d.String()
+		case "stream":
+			if d.Null() {
+				v.Stream = nil
+			} else {
+				v.Stream = new(cdp.IOStreamHandle)
+				*v.Stream = cdp.IOStreamHandle(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PrintToPDFReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// PrintToPDFParams are the parameters for Page.printToPDF
+// optional parameters are left out when nil
+type PrintToPDFParams struct {
+	/* Paper orientation. Defaults to false. */
+	Landscape *bool `json:"landscape,omitempty"`
+	/* Display header and footer. Defaults to false. */
+	DisplayHeaderFooter *bool `json:"displayHeaderFooter,omitempty"`
+	/* Print background graphics. Defaults to false. */
+	PrintBackground *bool `json:"printBackground,omitempty"`
+	/* Scale of the webpage rendering. Defaults to 1. */
+	Scale *float64 `json:"scale,omitempty"`
+	/* Paper width in inches. Defaults to 8.5 inches. */
+	PaperWidth *float64 `json:"paperWidth,omitempty"`
+	/* Paper height in inches. Defaults to 11 inches. */
+	PaperHeight *float64 `json:"paperHeight,omitempty"`
+	/* Top margin in inches. Defaults to 1cm (~0.4 inches). */
+	MarginTop *float64 `json:"marginTop,omitempty"`
+	/* Bottom margin in inches. Defaults to 1cm (~0.4 inches). */
+	MarginBottom *float64 `json:"marginBottom,omitempty"`
+	/* Left margin in inches. Defaults to 1cm (~0.4 inches). */
+	MarginLeft *float64 `json:"marginLeft,omitempty"`
+	/* Right margin in inches. Defaults to 1cm (~0.4 inches). */
+	MarginRight *float64 `json:"marginRight,omitempty"`
+	/* Paper ranges to print, one based, e.g., '1-5, 8, 11-13'. Pages are
+	printed in the document order, not in the order specified, and no
+	more than once.
+	Defaults to empty string, which implies the entire document is printed.
+	The page numbers are quietly capped to actual page count of the
+	document, and ranges beyond the end of the document are ignored.
+	If this results in no pages to print, an error is reported.
+	It is an error to specify a range with start greater than end. */
+	PageRanges *string `json:"pageRanges,omitempty"`
+	/* HTML template for the print header. Should be valid HTML markup with following
+	classes used to inject printing values into them:
+	- `date`: formatted print date
+	- `title`: document title
+	- `url`: document location
+	- `pageNumber`: current page number
+	- `totalPages`: total pages in the document
+
+	For example, `<span class=title></span>` would generate span containing the title. */
+	HeaderTemplate *string `json:"headerTemplate,omitempty"`
+	/* HTML template for the print footer. Should use the same format as the `headerTemplate`. */
+	FooterTemplate *string `json:"footerTemplate,omitempty"`
+	/* Whether or not to prefer page size as defined by css. Defaults to false,
+	in which case the content will be scaled to fit the paper size. */
+	PreferCSSPageSize *bool `json:"preferCSSPageSize,omitempty"`
+	/* return as stream
+
+	Experimental: this may change or be removed in any chrome release */
+	TransferMode *PrintToPDFTransferMode `json:"transferMode,omitempty"`
+	/* Whether or not to generate tagged (accessible) PDF. Defaults to embedder choice.
+
+	Experimental: this may change or be removed in any chrome release */
+	GenerateTaggedPDF *bool `json:"generateTaggedPDF,omitempty"`
+	/* Whether or not to embed the document outline into the PDF.
+
+	Experimental: this may change or be removed in any chrome release */
+	GenerateDocumentOutline *bool `json:"generateDocumentOutline,omitempty"`
+}
+
+// MarshalCDP writes PrintToPDFParams as JSON
+func (v *PrintToPDFParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Landscape != nil {
+		e.Key("landscape")
+		e.Bool(*v.Landscape)
+	}
+	if v.DisplayHeaderFooter != nil {
+		e.Key("displayHeaderFooter")
+		e.Bool(*v.DisplayHeaderFooter)
+	}
+	if v.PrintBackground != nil {
+		e.Key("printBackground")
+		e.Bool(*v.PrintBackground)
+	}
+	if v.Scale != nil {
+		e.Key("scale")
+		e.Float(*v.Scale)
+	}
+	if v.PaperWidth != nil {
+		e.Key("paperWidth")
+		e.Float(*v.PaperWidth)
+	}
+	if v.PaperHeight != nil {
+		e.Key("paperHeight")
+		e.Float(*v.PaperHeight)
+	}
+	if v.MarginTop != nil {
+		e.Key("marginTop")
+		e.Float(*v.MarginTop)
+	}
+	if v.MarginBottom != nil {
+		e.Key("marginBottom")
+		e.Float(*v.MarginBottom)
+	}
+	if v.MarginLeft != nil {
+		e.Key("marginLeft")
+		e.Float(*v.MarginLeft)
+	}
+	if v.MarginRight != nil {
+		e.Key("marginRight")
+		e.Float(*v.MarginRight)
+	}
+	if v.PageRanges != nil {
+		e.Key("pageRanges")
+		e.String(*v.PageRanges)
+	}
+	if v.HeaderTemplate != nil {
+		e.Key("headerTemplate")
+		e.String(*v.HeaderTemplate)
+	}
+	if v.FooterTemplate != nil {
+		e.Key("footerTemplate")
+		e.String(*v.FooterTemplate)
+	}
+	if v.PreferCSSPageSize != nil {
+		e.Key("preferCSSPageSize")
+		e.Bool(*v.PreferCSSPageSize)
+	}
+	if v.TransferMode != nil {
+		e.Key("transferMode")
+		e.String(string(*v.TransferMode))
+	}
+	if v.GenerateTaggedPDF != nil {
+		e.Key("generateTaggedPDF")
+		e.Bool(*v.GenerateTaggedPDF)
+	}
+	if v.GenerateDocumentOutline != nil {
+		e.Key("generateDocumentOutline")
+		e.Bool(*v.GenerateDocumentOutline)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PrintToPDFParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Print page as PDF.
+func (c Client) PrintToPDF(params PrintToPDFParams) (PrintToPDFReturns, error) {
+	return c.PrintToPDFContext(context.Background(), params)
+}
+
+// PrintToPDFContext is PrintToPDF with a context for cancellation and deadlines
+func (c Client) PrintToPDFContext(ctx context.Context, params PrintToPDFParams) (PrintToPDFReturns, error) {
+	var returns_ PrintToPDFReturns
+
+	if params.TransferMode != nil && !params.TransferMode.Valid() {
+		return returns_, fmt.Errorf("Page.printToPDF: invalid transferMode %q", *params.TransferMode)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.printToPDF", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ReloadReturns struct {
+}
+
+// UnmarshalCDP reads ReloadReturns from JSON
+func (v *ReloadReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReloadReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReloadParams are the parameters for Page.reload
+// optional parameters are left out when nil
+type ReloadParams struct {
+	/* If true, browser cache is ignored (as if the user pressed Shift+refresh). */
+	IgnoreCache *bool `json:"ignoreCache,omitempty"`
+	/* If set, the script will be injected into all frames of the inspected page after reload.
+	Argument will be ignored if reloading dataURL origin. */
+	ScriptToEvaluateOnLoad *string `json:"scriptToEvaluateOnLoad,omitempty"`
+	/* If set, an error will be thrown if the target page's main frame's
+	loader id does not match the provided id. This prevents accidentally
+	reloading an unintended target in case there's a racing navigation.
+
+	Experimental: this may change or be removed in any chrome release */
+	LoaderId *cdp.NetworkLoaderId `json:"loaderId,omitempty"`
+}
+
+// MarshalCDP writes ReloadParams as JSON
+func (v *ReloadParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.IgnoreCache != nil {
+		e.Key("ignoreCache")
+		e.Bool(*v.IgnoreCache)
+	}
+	if v.ScriptToEvaluateOnLoad != nil {
+		e.Key("scriptToEvaluateOnLoad")
+		e.String(*v.ScriptToEvaluateOnLoad)
+	}
+	if v.LoaderId != nil {
+		e.Key("loaderId")
+		e.String(string(*v.LoaderId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReloadParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Reloads given page optionally ignoring the cache.
+func (c Client) Reload(params ReloadParams) (ReloadReturns, error) {
+	return c.ReloadContext(context.Background(), params)
+}
+
+// ReloadContext is Reload with a context for cancellation and deadlines
+func (c Client) ReloadContext(ctx context.Context, params ReloadParams) (ReloadReturns, error) {
+	var returns_ ReloadReturns
+
+	err_ := c.caller.Call(ctx, "Page.reload", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveScriptToEvaluateOnNewDocumentReturns struct {
+}
+
+// UnmarshalCDP reads RemoveScriptToEvaluateOnNewDocumentReturns from JSON
+func (v *RemoveScriptToEvaluateOnNewDocumentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveScriptToEvaluateOnNewDocumentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveScriptToEvaluateOnNewDocumentParams are the parameters for Page.removeScriptToEvaluateOnNewDocument
+// optional parameters are left out when nil
+type RemoveScriptToEvaluateOnNewDocumentParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// MarshalCDP writes RemoveScriptToEvaluateOnNewDocumentParams as JSON
+func (v *RemoveScriptToEvaluateOnNewDocumentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("identifier")
+	e.String(string(v.Identifier))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveScriptToEvaluateOnNewDocumentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes given script from the list.
+func (c Client) RemoveScriptToEvaluateOnNewDocument(params RemoveScriptToEvaluateOnNewDocumentParams) (RemoveScriptToEvaluateOnNewDocumentReturns, error) {
+	return c.RemoveScriptToEvaluateOnNewDocumentContext(context.Background(), params)
+}
+
+// RemoveScriptToEvaluateOnNewDocumentContext is RemoveScriptToEvaluateOnNewDocument with a context for cancellation and deadlines
+func (c Client) RemoveScriptToEvaluateOnNewDocumentContext(ctx context.Context, params RemoveScriptToEvaluateOnNewDocumentParams) (RemoveScriptToEvaluateOnNewDocumentReturns, error) {
+	var returns_ RemoveScriptToEvaluateOnNewDocumentReturns
+
+	err_ := c.caller.Call(ctx, "Page.removeScriptToEvaluateOnNewDocument", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetBypassCSPReturns struct {
+}
+
+// UnmarshalCDP reads SetBypassCSPReturns from JSON
+func (v *SetBypassCSPReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetBypassCSPReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetBypassCSPParams are the parameters for Page.setBypassCSP
+// optional parameters are left out when nil
+type SetBypassCSPParams struct {
+	/* Whether to bypass page CSP. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetBypassCSPParams as JSON
+func (v *SetBypassCSPParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetBypassCSPParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable page Content Security Policy by-passing.
+func (c Client) SetBypassCSP(params SetBypassCSPParams) (SetBypassCSPReturns, error) {
+	return c.SetBypassCSPContext(context.Background(), params)
+}
+
+// SetBypassCSPContext is SetBypassCSP with a context for cancellation and deadlines
+func (c Client) SetBypassCSPContext(ctx context.Context, params SetBypassCSPParams) (SetBypassCSPReturns, error) {
+	var returns_ SetBypassCSPReturns
+
+	err_ := c.caller.Call(ctx, "Page.setBypassCSP", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDocumentContentReturns struct {
+}
+
+// UnmarshalCDP reads SetDocumentContentReturns from JSON
+func (v *SetDocumentContentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDocumentContentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDocumentContentParams are the parameters for Page.setDocumentContent
+// optional parameters are left out when nil
+type SetDocumentContentParams struct {
+	/* Frame id to set HTML for. */
+	FrameId FrameId `json:"frameId"`
+	/* HTML content to set. */
+	Html string `json:"html"`
+}
+
+// MarshalCDP writes SetDocumentContentParams as JSON
+func (v *SetDocumentContentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("html")
+	e.String(v.Html)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDocumentContentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets given markup as the document's HTML.
+func (c Client) SetDocumentContent(params SetDocumentContentParams) (SetDocumentContentReturns, error) {
+	return c.SetDocumentContentContext(context.Background(), params)
+}
+
+// SetDocumentContentContext is SetDocumentContent with a context for cancellation and deadlines
+func (c Client) SetDocumentContentContext(ctx context.Context, params SetDocumentContentParams) (SetDocumentContentReturns, error) {
+	var returns_ SetDocumentContentReturns
+
+	err_ := c.caller.Call(ctx, "Page.setDocumentContent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetGeolocationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetGeolocationOverrideReturns from JSON
+func (v *SetGeolocationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetGeolocationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetGeolocationOverrideParams are the parameters for Page.setGeolocationOverride
+// optional parameters are left out when nil
+type SetGeolocationOverrideParams struct {
+	/* Mock latitude */
+	Latitude *float64 `json:"latitude,omitempty"`
+	/* Mock longitude */
+	Longitude *float64 `json:"longitude,omitempty"`
+	/* Mock accuracy */
+	Accuracy *float64 `json:"accuracy,omitempty"`
+}
+
+// MarshalCDP writes SetGeolocationOverrideParams as JSON
+func (v *SetGeolocationOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Latitude != nil {
+		e.Key("latitude")
+		e.Float(*v.Latitude)
+	}
+	if v.Longitude != nil {
+		e.Key("longitude")
+		e.Float(*v.Longitude)
+	}
+	if v.Accuracy != nil {
+		e.Key("accuracy")
+		e.Float(*v.Accuracy)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetGeolocationOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position
+// unavailable.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetGeolocationOverride(params SetGeolocationOverrideParams) (SetGeolocationOverrideReturns, error) {
+	return c.SetGeolocationOverrideContext(context.Background(), params)
+}
+
+// SetGeolocationOverrideContext is SetGeolocationOverride with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetGeolocationOverrideContext(ctx context.Context, params SetGeolocationOverrideParams) (SetGeolocationOverrideReturns, error) {
+	var returns_ SetGeolocationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Page.setGeolocationOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetLifecycleEventsEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetLifecycleEventsEnabledReturns from JSON
+func (v *SetLifecycleEventsEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetLifecycleEventsEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetLifecycleEventsEnabledParams are the parameters for Page.setLifecycleEventsEnabled
+// optional parameters are left out when nil
+type SetLifecycleEventsEnabledParams struct {
+	/* If true, starts emitting lifecycle events. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetLifecycleEventsEnabledParams as JSON
+func (v *SetLifecycleEventsEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetLifecycleEventsEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Controls whether page will emit lifecycle events.
+func (c Client) SetLifecycleEventsEnabled(params SetLifecycleEventsEnabledParams) (SetLifecycleEventsEnabledReturns, error) {
+	return c.SetLifecycleEventsEnabledContext(context.Background(), params)
+}
+
+// SetLifecycleEventsEnabledContext is SetLifecycleEventsEnabled with a context for cancellation and deadlines
+func (c Client) SetLifecycleEventsEnabledContext(ctx context.Context, params SetLifecycleEventsEnabledParams) (SetLifecycleEventsEnabledReturns, error) {
+	var returns_ SetLifecycleEventsEnabledReturns
+
+	err_ := c.caller.Call(ctx, "Page.setLifecycleEventsEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopLoadingReturns struct {
+}
+
+// UnmarshalCDP reads StopLoadingReturns from JSON
+func (v *StopLoadingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopLoadingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Force the page stop all navigations and pending resource fetches.
+func (c Client) StopLoading() (StopLoadingReturns, error) {
+	return c.StopLoadingContext(context.Background())
+}
+
+// StopLoadingContext is StopLoading with a context for cancellation and deadlines
+func (c Client) StopLoadingContext(ctx context.Context) (StopLoadingReturns, error) {
+	var returns_ StopLoadingReturns
+
+	err_ := c.caller.Call(ctx, "Page.stopLoading", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CloseReturns struct {
+}
+
+// UnmarshalCDP reads CloseReturns from JSON
+func (v *CloseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CloseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Tries to close page, running its beforeunload hooks, if any.
+func (c Client) Close() (CloseReturns, error) {
+	return c.CloseContext(context.Background())
+}
+
+// CloseContext is Close with a context for cancellation and deadlines
+func (c Client) CloseContext(ctx context.Context) (CloseReturns, error) {
+	var returns_ CloseReturns
+
+	err_ := c.caller.Call(ctx, "Page.close", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetInterceptFileChooserDialogReturns struct {
+}
+
+// UnmarshalCDP reads SetInterceptFileChooserDialogReturns from JSON
+func (v *SetInterceptFileChooserDialogReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInterceptFileChooserDialogReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInterceptFileChooserDialogParams are the parameters for Page.setInterceptFileChooserDialog
+// optional parameters are left out when nil
+type SetInterceptFileChooserDialogParams struct {
+	Enabled bool `json:"enabled"`
+	/* If true, cancels the dialog by emitting relevant events (if any)
+	in addition to not showing it if the interception is enabled
+	(default: false).
+
+	Experimental: this may change or be removed in any chrome release */
+	Cancel *bool `json:"cancel,omitempty"`
+}
+
+// MarshalCDP writes SetInterceptFileChooserDialogParams as JSON
+func (v *SetInterceptFileChooserDialogParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	if v.Cancel != nil {
+		e.Key("cancel")
+		e.Bool(*v.Cancel)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInterceptFileChooserDialogParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Intercept file chooser requests and transfer control to protocol clients.
+// When file chooser interception is enabled, native file chooser dialog is not shown.
+// Instead, a protocol event `Page.fileChooserOpened` is emitted.
+func (c Client) SetInterceptFileChooserDialog(params SetInterceptFileChooserDialogParams) (SetInterceptFileChooserDialogReturns, error) {
+	return c.SetInterceptFileChooserDialogContext(context.Background(), params)
+}
+
+// SetInterceptFileChooserDialogContext is SetInterceptFileChooserDialog with a context for cancellation and deadlines
+func (c Client) SetInterceptFileChooserDialogContext(ctx context.Context, params SetInterceptFileChooserDialogParams) (SetInterceptFileChooserDialogReturns, error) {
+	var returns_ SetInterceptFileChooserDialogReturns
+
+	err_ := c.caller.Call(ctx, "Page.setInterceptFileChooserDialog", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type DomContentEventFiredEvent struct {
+	Timestamp cdp.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads DomContentEventFiredEvent from JSON
+func (v *DomContentEventFiredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = cdp.NetworkMonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DomContentEventFiredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DomContentEventFiredHandler func(ev DomContentEventFiredEvent)
+
+// EventMethod is Page.domContentEventFired
+func (DomContentEventFiredEvent) EventMethod() string {
+	return "Page.domContentEventFired"
+}
+
+// OnDomContentEventFired calls handler for each Page.domContentEventFired event
+func (c Client) OnDomContentEventFired(handler DomContentEventFiredHandler) (unsubscribe func()) {
+	return c.caller.On("Page.domContentEventFired", func(ev interface{}) {
+		handler(ev.(DomContentEventFiredEvent))
+	})
+}
+
+// Emitted only when `page.interceptFileChooser` is enabled.
+type FileChooserOpenedEvent struct {
+
+	/* Id of the frame containing input node.
+
+	Experimental: this may change or be removed in any chrome release */
+	FrameId FrameId `json:"frameId"`
+
+	/* Input mode. */
+	Mode FileChooserOpenedMode `json:"mode"`
+
+	/* Input node id. Only present for file choosers opened via an `<input type="file">` element.
+
+	Experimental: this may change or be removed in any chrome release */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+}
+
+// UnmarshalCDP reads FileChooserOpenedEvent from JSON
+func (v *FileChooserOpenedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "mode":
+			v.Mode = FileChooserOpenedMode(d.String())
+		case "backendNodeId":
+			if d.Null() {
+				v.BackendNodeId = nil
+			} else {
+				v.BackendNodeId = new(cdp.DOMBackendNodeId)
+				*v.BackendNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FileChooserOpenedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FileChooserOpenedHandler func(ev FileChooserOpenedEvent)
+
+// EventMethod is Page.fileChooserOpened
+func (FileChooserOpenedEvent) EventMethod() string {
+	return "Page.fileChooserOpened"
+}
+
+// OnFileChooserOpened calls handler for each Page.fileChooserOpened event
+func (c Client) OnFileChooserOpened(handler FileChooserOpenedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.fileChooserOpened", func(ev interface{}) {
+		handler(ev.(FileChooserOpenedEvent))
+	})
+}
+
+// Fired when frame has been attached to its parent.
+type FrameAttachedEvent struct {
+
+	/* Id of the frame that has been attached. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Parent frame identifier. */
+	ParentFrameId FrameId `json:"parentFrameId"`
+
+	/* JavaScript stack trace of when frame was attached, only set if frame initiated from script. */
+	Stack *cdp.RuntimeStackTrace `json:"stack,omitempty"`
+}
+
+// UnmarshalCDP reads FrameAttachedEvent from JSON
+func (v *FrameAttachedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "parentFrameId":
+			v.ParentFrameId = FrameId(d.String())
+		case "stack":
+			if d.Null() {
+				v.Stack = nil
+			} else {
+				v.Stack = new(cdp.RuntimeStackTrace)
+				(*v.Stack).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameAttachedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameAttachedHandler func(ev FrameAttachedEvent)
+
+// EventMethod is Page.frameAttached
+func (FrameAttachedEvent) EventMethod() string {
+	return "Page.frameAttached"
+}
+
+// OnFrameAttached calls handler for each Page.frameAttached event
+func (c Client) OnFrameAttached(handler FrameAttachedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameAttached", func(ev interface{}) {
+		handler(ev.(FrameAttachedEvent))
+	})
+}
+
+// Fired when frame no longer has a scheduled navigation.
+//
+// Deprecated: this is deprecated in the devtools protocol
+type FrameClearedScheduledNavigationEvent struct {
+
+	/* Id of the frame that has cleared its scheduled navigation. */
+	FrameId FrameId `json:"frameId"`
+}
+
+// UnmarshalCDP reads FrameClearedScheduledNavigationEvent from JSON
+func (v *FrameClearedScheduledNavigationEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameClearedScheduledNavigationEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameClearedScheduledNavigationHandler func(ev FrameClearedScheduledNavigationEvent)
+
+// EventMethod is Page.frameClearedScheduledNavigation
+func (FrameClearedScheduledNavigationEvent) EventMethod() string {
+	return "Page.frameClearedScheduledNavigation"
+}
+
+// OnFrameClearedScheduledNavigation calls handler for each Page.frameClearedScheduledNavigation event
+func (c Client) OnFrameClearedScheduledNavigation(handler FrameClearedScheduledNavigationHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameClearedScheduledNavigation", func(ev interface{}) {
+		handler(ev.(FrameClearedScheduledNavigationEvent))
+	})
+}
+
+// Fired when frame has been detached from its parent.
+type FrameDetachedEvent struct {
+
+	/* Id of the frame that has been detached. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Experimental: this may change or be removed in any chrome release */
+	Reason FrameDetachedReason `json:"reason"`
+}
+
+// UnmarshalCDP reads FrameDetachedEvent from JSON
+func (v *FrameDetachedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "reason":
+			v.Reason = FrameDetachedReason(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameDetachedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameDetachedHandler func(ev FrameDetachedEvent)
+
+// EventMethod is Page.frameDetached
+func (FrameDetachedEvent) EventMethod() string {
+	return "Page.frameDetached"
+}
+
+// OnFrameDetached calls handler for each Page.frameDetached event
+func (c Client) OnFrameDetached(handler FrameDetachedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameDetached", func(ev interface{}) {
+		handler(ev.(FrameDetachedEvent))
+	})
+}
+
+// Fired once navigation of the frame has completed. Frame is now associated with the new loader.
+type FrameNavigatedEvent struct {
+
+	/* Frame object. */
+	Frame Frame `json:"frame"`
+
+	/* Experimental: this may change or be removed in any chrome release */
+	Type NavigationType `json:"type"`
+}
+
+// UnmarshalCDP reads FrameNavigatedEvent from JSON
+func (v *FrameNavigatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frame":
+			v.Frame.UnmarshalCDP(d)
+		case "type":
+			v.Type = NavigationType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameNavigatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameNavigatedHandler func(ev FrameNavigatedEvent)
+
+// EventMethod is Page.frameNavigated
+func (FrameNavigatedEvent) EventMethod() string {
+	return "Page.frameNavigated"
+}
+
+// OnFrameNavigated calls handler for each Page.frameNavigated event
+func (c Client) OnFrameNavigated(handler FrameNavigatedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameNavigated", func(ev interface{}) {
+		handler(ev.(FrameNavigatedEvent))
+	})
+}
+
+// Fired when frame schedules a potential navigation.
+//
+// Deprecated: this is deprecated in the devtools protocol
+type FrameScheduledNavigationEvent struct {
+
+	/* Id of the frame that has scheduled a navigation. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Delay (in seconds) until the navigation is scheduled to begin. The navigation is not
+	guaranteed to start. */
+	Delay float64 `json:"delay"`
+
+	/* The reason for the navigation. */
+	Reason ClientNavigationReason `json:"reason"`
+
+	/* The destination URL for the scheduled navigation. */
+	Url string `json:"url"`
+}
+
+// UnmarshalCDP reads FrameScheduledNavigationEvent from JSON
+func (v *FrameScheduledNavigationEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "delay":
+			v.Delay = d.Float()
+		case "reason":
+			v.Reason = ClientNavigationReason(d.String())
+		case "url":
+			v.Url = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameScheduledNavigationEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameScheduledNavigationHandler func(ev FrameScheduledNavigationEvent)
+
+// EventMethod is Page.frameScheduledNavigation
+func (FrameScheduledNavigationEvent) EventMethod() string {
+	return "Page.frameScheduledNavigation"
+}
+
+// OnFrameScheduledNavigation calls handler for each Page.frameScheduledNavigation event
+func (c Client) OnFrameScheduledNavigation(handler FrameScheduledNavigationHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameScheduledNavigation", func(ev interface{}) {
+		handler(ev.(FrameScheduledNavigationEvent))
+	})
+}
+
+// Fired when interstitial page was hidden
+type InterstitialHiddenEvent struct {
+}
+
+// UnmarshalCDP reads InterstitialHiddenEvent from JSON
+func (v *InterstitialHiddenEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InterstitialHiddenEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InterstitialHiddenHandler func(ev InterstitialHiddenEvent)
+
+// EventMethod is Page.interstitialHidden
+func (InterstitialHiddenEvent) EventMethod() string {
+	return "Page.interstitialHidden"
+}
+
+// OnInterstitialHidden calls handler for each Page.interstitialHidden event
+func (c Client) OnInterstitialHidden(handler InterstitialHiddenHandler) (unsubscribe func()) {
+	return c.caller.On("Page.interstitialHidden", func(ev interface{}) {
+		handler(ev.(InterstitialHiddenEvent))
+	})
+}
+
+// Fired when interstitial page was shown
+type InterstitialShownEvent struct {
+}
+
+// UnmarshalCDP reads InterstitialShownEvent from JSON
+func (v *InterstitialShownEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InterstitialShownEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InterstitialShownHandler func(ev InterstitialShownEvent)
+
+// EventMethod is Page.interstitialShown
+func (InterstitialShownEvent) EventMethod() string {
+	return "Page.interstitialShown"
+}
+
+// OnInterstitialShown calls handler for each Page.interstitialShown event
+func (c Client) OnInterstitialShown(handler InterstitialShownHandler) (unsubscribe func()) {
+	return c.caller.On("Page.interstitialShown", func(ev interface{}) {
+		handler(ev.(InterstitialShownEvent))
+	})
+}
+
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been
+// closed.
+type JavascriptDialogClosedEvent struct {
+
+	/* Frame id.
+
+	Experimental: this may change or be removed in any chrome release */
+	FrameId FrameId `json:"frameId"`
+
+	/* Whether dialog was confirmed. */
+	Result bool `json:"result"`
+
+	/* User input in case of prompt. */
+	UserInput string `json:"userInput"`
+}
+
+// UnmarshalCDP reads JavascriptDialogClosedEvent from JSON
+func (v *JavascriptDialogClosedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "result":
+			v.Result = d.Bool()
+		case "userInput":
+			v.UserInput = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *JavascriptDialogClosedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type JavascriptDialogClosedHandler func(ev JavascriptDialogClosedEvent)
+
+// EventMethod is Page.javascriptDialogClosed
+func (JavascriptDialogClosedEvent) EventMethod() string {
+	return "Page.javascriptDialogClosed"
+}
+
+// OnJavascriptDialogClosed calls handler for each Page.javascriptDialogClosed event
+func (c Client) OnJavascriptDialogClosed(handler JavascriptDialogClosedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.javascriptDialogClosed", func(ev interface{}) {
+		handler(ev.(JavascriptDialogClosedEvent))
+	})
+}
+
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to
+// open.
+type JavascriptDialogOpeningEvent struct {
+
+	/* Frame url. */
+	Url string `json:"url"`
+
+	/* Frame id.
+
+	Experimental: this may change or be removed in any chrome release */
+	FrameId FrameId `json:"frameId"`
+
+	/* Message that will be displayed by the dialog. */
+	Message string `json:"message"`
+
+	/* Dialog type. */
+	Type DialogType `json:"type"`
+
+	/* True iff browser is capable showing or acting on the given dialog. When browser has no
+	dialog handler for given target, calling alert while Page domain is engaged will stall
+	the page execution. Execution can be resumed via calling Page.handleJavaScriptDialog. */
+	HasBrowserHandler bool `json:"hasBrowserHandler"`
+
+	/* Default dialog prompt. */
+	DefaultPrompt *string `json:"defaultPrompt,omitempty"`
+}
+
+// UnmarshalCDP reads JavascriptDialogOpeningEvent from JSON
+func (v *JavascriptDialogOpeningEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "message":
+			v.Message = d.String()
+		case "type":
+			v.Type = DialogType(d.String())
+		case "hasBrowserHandler":
+			v.HasBrowserHandler = d.Bool()
+		case "defaultPrompt":
+			if d.Null() {
+				v.DefaultPrompt = nil
+			} else {
+				v.DefaultPrompt = new(string)
+				*v.DefaultPrompt = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *JavascriptDialogOpeningEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type JavascriptDialogOpeningHandler func(ev JavascriptDialogOpeningEvent)
+
+// EventMethod is Page.javascriptDialogOpening
+func (JavascriptDialogOpeningEvent) EventMethod() string {
+	return "Page.javascriptDialogOpening"
+}
+
+// OnJavascriptDialogOpening calls handler for each Page.javascriptDialogOpening event
+func (c Client) OnJavascriptDialogOpening(handler JavascriptDialogOpeningHandler) (unsubscribe func()) {
+	return c.caller.On("Page.javascriptDialogOpening", func(ev interface{}) {
+		handler(ev.(JavascriptDialogOpeningEvent))
+	})
+}
+
+// Fired for lifecycle events (navigation, load, paint, etc) in the current
+// target (including local frames).
+type LifecycleEventEvent struct {
+
+	/* Id of the frame. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Loader identifier. Empty string if the request is fetched from worker. */
+	LoaderId cdp.NetworkLoaderId `json:"loaderId"`
+
+	Name string `json:"name"`
+
+	Timestamp cdp.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads LifecycleEventEvent from JSON
+func (v *LifecycleEventEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "name":
+			v.Name = d.String()
+		case "timestamp":
+			v.Timestamp = cdp.NetworkMonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LifecycleEventEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LifecycleEventHandler func(ev LifecycleEventEvent)
+
+// EventMethod is Page.lifecycleEvent
+func (LifecycleEventEvent) EventMethod() string {
+	return "Page.lifecycleEvent"
+}
+
+// OnLifecycleEvent calls handler for each Page.lifecycleEvent event
+func (c Client) OnLifecycleEvent(handler LifecycleEventHandler) (unsubscribe func()) {
+	return c.caller.On("Page.lifecycleEvent", func(ev interface{}) {
+		handler(ev.(LifecycleEventEvent))
+	})
+}
+
+type LoadEventFiredEvent struct {
+	Timestamp cdp.NetworkMonotonicTime `json:"timestamp"`
+}
+
+// UnmarshalCDP reads LoadEventFiredEvent from JSON
+func (v *LoadEventFiredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = cdp.NetworkMonotonicTime(d.Float())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LoadEventFiredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LoadEventFiredHandler func(ev LoadEventFiredEvent)
+
+// EventMethod is Page.loadEventFired
+func (LoadEventFiredEvent) EventMethod() string {
+	return "Page.loadEventFired"
+}
+
+// OnLoadEventFired calls handler for each Page.loadEventFired event
+func (c Client) OnLoadEventFired(handler LoadEventFiredHandler) (unsubscribe func()) {
+	return c.caller.On("Page.loadEventFired", func(ev interface{}) {
+		handler(ev.(LoadEventFiredEvent))
+	})
+}
+
+// Fired when a new window is going to be opened, via window.open(), link click, form submission,
+// etc.
+type WindowOpenEvent struct {
+
+	/* The URL for the new window. */
+	Url string `json:"url"`
+
+	/* Window name. */
+	WindowName string `json:"windowName"`
+
+	/* An array of enabled window features. */
+	WindowFeatures []string `json:"windowFeatures"`
+
+	/* Whether or not it was triggered by user gesture. */
+	UserGesture bool `json:"userGesture"`
+}
+
+// UnmarshalCDP reads WindowOpenEvent from JSON
+func (v *WindowOpenEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "windowName":
+			v.WindowName = d.String()
+		case "windowFeatures":
+			if d.Array() {
+				v.WindowFeatures = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.WindowFeatures = append(v.WindowFeatures, x0)
+				}
+			} else {
+				v.WindowFeatures = nil
+			}
+		case "userGesture":
+			v.UserGesture = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WindowOpenEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WindowOpenHandler func(ev WindowOpenEvent)
+
+// EventMethod is Page.windowOpen
+func (WindowOpenEvent) EventMethod() string {
+	return "Page.windowOpen"
+}
+
+// OnWindowOpen calls handler for each Page.windowOpen event
+func (c Client) OnWindowOpen(handler WindowOpenHandler) (unsubscribe func()) {
+	return c.caller.On("Page.windowOpen", func(ev interface{}) {
+		handler(ev.(WindowOpenEvent))
+	})
+}
+
+// decoders for Page events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Page.domContentEventFired":            cdp.Decode[DomContentEventFiredEvent],
+	"Page.fileChooserOpened":               cdp.Decode[FileChooserOpenedEvent],
+	"Page.frameAttached":                   cdp.Decode[FrameAttachedEvent],
+	"Page.frameClearedScheduledNavigation": cdp.Decode[FrameClearedScheduledNavigationEvent],
+	"Page.frameDetached":                   cdp.Decode[FrameDetachedEvent],
+	"Page.frameNavigated":                  cdp.Decode[FrameNavigatedEvent],
+	"Page.frameScheduledNavigation":        cdp.Decode[FrameScheduledNavigationEvent],
+	"Page.interstitialHidden":              cdp.Decode[InterstitialHiddenEvent],
+	"Page.interstitialShown":               cdp.Decode[InterstitialShownEvent],
+	"Page.javascriptDialogClosed":          cdp.Decode[JavascriptDialogClosedEvent],
+	"Page.javascriptDialogOpening":         cdp.Decode[JavascriptDialogOpeningEvent],
+	"Page.lifecycleEvent":                  cdp.Decode[LifecycleEventEvent],
+	"Page.loadEventFired":                  cdp.Decode[LoadEventFiredEvent],
+	"Page.windowOpen":                      cdp.Decode[WindowOpenEvent],
+}
+
+// DecodeEvent decodes a Page event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
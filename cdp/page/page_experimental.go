@@ -0,0 +1,2982 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package page
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type AddScriptToEvaluateOnLoadReturns struct {
+
+	/* Identifier of the added script. */
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// UnmarshalCDP reads AddScriptToEvaluateOnLoadReturns from JSON
+func (v *AddScriptToEvaluateOnLoadReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "identifier":
+			v.Identifier = ScriptIdentifier(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddScriptToEvaluateOnLoadReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddScriptToEvaluateOnLoadParams are the parameters for Page.addScriptToEvaluateOnLoad
+// optional parameters are left out when nil
+type AddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+}
+
+// MarshalCDP writes AddScriptToEvaluateOnLoadParams as JSON
+func (v *AddScriptToEvaluateOnLoadParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptSource")
+	e.String(v.ScriptSource)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddScriptToEvaluateOnLoadParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deprecated, please use addScriptToEvaluateOnNewDocument instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) AddScriptToEvaluateOnLoad(params AddScriptToEvaluateOnLoadParams) (AddScriptToEvaluateOnLoadReturns, error) {
+	return c.AddScriptToEvaluateOnLoadContext(context.Background(), params)
+}
+
+// AddScriptToEvaluateOnLoadContext is AddScriptToEvaluateOnLoad with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) AddScriptToEvaluateOnLoadContext(ctx context.Context, params AddScriptToEvaluateOnLoadParams) (AddScriptToEvaluateOnLoadReturns, error) {
+	var returns_ AddScriptToEvaluateOnLoadReturns
+
+	err_ := c.caller.Call(ctx, "Page.addScriptToEvaluateOnLoad", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CaptureSnapshotReturns struct {
+
+	/* Serialized page data. */
+	Data string `json:"data"`
+}
+
+// UnmarshalCDP reads CaptureSnapshotReturns from JSON
+func (v *CaptureSnapshotReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CaptureSnapshotReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CaptureSnapshotParams are the parameters for Page.captureSnapshot
+// optional parameters are left out when nil
+type CaptureSnapshotParams struct {
+	/* Format (defaults to mhtml). */
+	Format *CaptureSnapshotFormat `json:"format,omitempty"`
+}
+
+// MarshalCDP writes CaptureSnapshotParams as JSON
+func (v *CaptureSnapshotParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Format != nil {
+		e.Key("format")
+		e.String(string(*v.Format))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CaptureSnapshotParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a snapshot of the page as a string. For MHTML format, the serialization includes
+// iframes, shadow DOM, external resources, and element-inline styles.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CaptureSnapshot(params CaptureSnapshotParams) (CaptureSnapshotReturns, error) {
+	return c.CaptureSnapshotContext(context.Background(), params)
+}
+
+// CaptureSnapshotContext is CaptureSnapshot with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CaptureSnapshotContext(ctx context.Context, params CaptureSnapshotParams) (CaptureSnapshotReturns, error) {
+	var returns_ CaptureSnapshotReturns
+
+	if params.Format != nil && !params.Format.Valid() {
+		return returns_, fmt.Errorf("Page.captureSnapshot: invalid format %q", *params.Format)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.captureSnapshot", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDeviceMetricsOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearDeviceMetricsOverrideReturns from JSON
+func (v *ClearDeviceMetricsOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDeviceMetricsOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the overridden device metrics.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ClearDeviceMetricsOverride() (ClearDeviceMetricsOverrideReturns, error) {
+	return c.ClearDeviceMetricsOverrideContext(context.Background())
+}
+
+// ClearDeviceMetricsOverrideContext is ClearDeviceMetricsOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ClearDeviceMetricsOverrideContext(ctx context.Context) (ClearDeviceMetricsOverrideReturns, error) {
+	var returns_ ClearDeviceMetricsOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Page.clearDeviceMetricsOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDeviceOrientationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads ClearDeviceOrientationOverrideReturns from JSON
+func (v *ClearDeviceOrientationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDeviceOrientationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears the overridden Device Orientation.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ClearDeviceOrientationOverride() (ClearDeviceOrientationOverrideReturns, error) {
+	return c.ClearDeviceOrientationOverrideContext(context.Background())
+}
+
+// ClearDeviceOrientationOverrideContext is ClearDeviceOrientationOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) ClearDeviceOrientationOverrideContext(ctx context.Context) (ClearDeviceOrientationOverrideReturns, error) {
+	var returns_ ClearDeviceOrientationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Page.clearDeviceOrientationOverride", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteCookieReturns struct {
+}
+
+// UnmarshalCDP reads DeleteCookieReturns from JSON
+func (v *DeleteCookieReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteCookieReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteCookieParams are the parameters for Page.deleteCookie
+// optional parameters are left out when nil
+type DeleteCookieParams struct {
+	/* Name of the cookie to remove. */
+	CookieName string `json:"cookieName"`
+	/* URL to match cooke domain and path. */
+	Url string `json:"url"`
+}
+
+// MarshalCDP writes DeleteCookieParams as JSON
+func (v *DeleteCookieParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cookieName")
+	e.String(v.CookieName)
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteCookieParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes browser cookie with given name, domain and path.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) DeleteCookie(params DeleteCookieParams) (DeleteCookieReturns, error) {
+	return c.DeleteCookieContext(context.Background(), params)
+}
+
+// DeleteCookieContext is DeleteCookie with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) DeleteCookieContext(ctx context.Context, params DeleteCookieParams) (DeleteCookieReturns, error) {
+	var returns_ DeleteCookieReturns
+
+	err_ := c.caller.Call(ctx, "Page.deleteCookie", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetInstallabilityErrorsReturns struct {
+	InstallabilityErrors []InstallabilityError `json:"installabilityErrors"`
+}
+
+// UnmarshalCDP reads GetInstallabilityErrorsReturns from JSON
+func (v *GetInstallabilityErrorsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "installabilityErrors":
+			if d.Array() {
+				v.InstallabilityErrors = make([]InstallabilityError, 0)
+				for d.More() {
+					var x0 InstallabilityError
+					x0.UnmarshalCDP(d)
+					v.InstallabilityErrors = append(v.InstallabilityErrors, x0)
+				}
+			} else {
+				v.InstallabilityErrors = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetInstallabilityErrorsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetInstallabilityErrors() (GetInstallabilityErrorsReturns, error) {
+	return c.GetInstallabilityErrorsContext(context.Background())
+}
+
+// GetInstallabilityErrorsContext is GetInstallabilityErrors with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetInstallabilityErrorsContext(ctx context.Context) (GetInstallabilityErrorsReturns, error) {
+	var returns_ GetInstallabilityErrorsReturns
+
+	err_ := c.caller.Call(ctx, "Page.getInstallabilityErrors", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetManifestIconsReturns struct {
+	PrimaryIcon *string `json:"primaryIcon,omitempty"`
+}
+
+// UnmarshalCDP reads GetManifestIconsReturns from JSON
+func (v *GetManifestIconsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "primaryIcon":
+			if d.Null() {
+				v.PrimaryIcon = nil
+			} else {
+				v.PrimaryIcon = new(string)
+				*v.PrimaryIcon = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetManifestIconsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Deprecated because it's not guaranteed that the returned icon is in fact the one used for PWA installation.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetManifestIcons() (GetManifestIconsReturns, error) {
+	return c.GetManifestIconsContext(context.Background())
+}
+
+// GetManifestIconsContext is GetManifestIcons with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) GetManifestIconsContext(ctx context.Context) (GetManifestIconsReturns, error) {
+	var returns_ GetManifestIconsReturns
+
+	err_ := c.caller.Call(ctx, "Page.getManifestIcons", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetAppIdReturns struct {
+
+	/* App id, either from manifest's id attribute or computed from start_url */
+	AppId *string `json:"appId,omitempty"`
+
+	/* Recommendation for manifest's id attribute to match current id computed from start_url */
+	RecommendedId *string `json:"recommendedId,omitempty"`
+}
+
+// UnmarshalCDP reads GetAppIdReturns from JSON
+func (v *GetAppIdReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "appId":
+			if d.Null() {
+				v.AppId = nil
+			} else {
+				v.AppId = new(string)
+				*v.AppId = d.String()
+			}
+		case "recommendedId":
+			if d.Null() {
+				v.RecommendedId = nil
+			} else {
+				v.RecommendedId = new(string)
+				*v.RecommendedId = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAppIdReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the unique (PWA) app id.
+// Only returns values if the feature flag 'WebAppEnableManifestId' is enabled
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAppId() (GetAppIdReturns, error) {
+	return c.GetAppIdContext(context.Background())
+}
+
+// GetAppIdContext is GetAppId with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAppIdContext(ctx context.Context) (GetAppIdReturns, error) {
+	var returns_ GetAppIdReturns
+
+	err_ := c.caller.Call(ctx, "Page.getAppId", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetAdScriptAncestryReturns struct {
+
+	/* The ancestry chain of ad script identifiers leading to this frame's
+	creation, along with the root script's filterlist rule. The ancestry
+	chain is ordered from the most immediate script (in the frame creation
+	stack) to more distant ancestors (that created the immediately preceding
+	script). Only sent if frame is labelled as an ad and ids are available. */
+	AdScriptAncestry *AdScriptAncestry `json:"adScriptAncestry,omitempty"`
+}
+
+// UnmarshalCDP reads GetAdScriptAncestryReturns from JSON
+func (v *GetAdScriptAncestryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "adScriptAncestry":
+			if d.Null() {
+				v.AdScriptAncestry = nil
+			} else {
+				v.AdScriptAncestry = new(AdScriptAncestry)
+				(*v.AdScriptAncestry).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAdScriptAncestryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAdScriptAncestryParams are the parameters for Page.getAdScriptAncestry
+// optional parameters are left out when nil
+type GetAdScriptAncestryParams struct {
+	FrameId FrameId `json:"frameId"`
+}
+
+// MarshalCDP writes GetAdScriptAncestryParams as JSON
+func (v *GetAdScriptAncestryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAdScriptAncestryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAdScriptAncestry(params GetAdScriptAncestryParams) (GetAdScriptAncestryReturns, error) {
+	return c.GetAdScriptAncestryContext(context.Background(), params)
+}
+
+// GetAdScriptAncestryContext is GetAdScriptAncestry with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAdScriptAncestryContext(ctx context.Context, params GetAdScriptAncestryParams) (GetAdScriptAncestryReturns, error) {
+	var returns_ GetAdScriptAncestryReturns
+
+	err_ := c.caller.Call(ctx, "Page.getAdScriptAncestry", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetResourceContentReturns struct {
+
+	/* Resource content. */
+	Content string `json:"content"`
+
+	/* True, if content was served as base64. */
+	Base64Encoded bool `json:"base64Encoded"`
+}
+
+// UnmarshalCDP reads GetResourceContentReturns from JSON
+func (v *GetResourceContentReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "content":
+			v.Content = d.String()
+		case "base64Encoded":
+			v.Base64Encoded = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetResourceContentReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetResourceContentParams are the parameters for Page.getResourceContent
+// optional parameters are left out when nil
+type GetResourceContentParams struct {
+	/* Frame id to get resource for. */
+	FrameId FrameId `json:"frameId"`
+	/* URL of the resource to get content for. */
+	Url string `json:"url"`
+}
+
+// MarshalCDP writes GetResourceContentParams as JSON
+func (v *GetResourceContentParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("url")
+	e.String(v.Url)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetResourceContentParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns content of the given resource.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetResourceContent(params GetResourceContentParams) (GetResourceContentReturns, error) {
+	return c.GetResourceContentContext(context.Background(), params)
+}
+
+// GetResourceContentContext is GetResourceContent with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetResourceContentContext(ctx context.Context, params GetResourceContentParams) (GetResourceContentReturns, error) {
+	var returns_ GetResourceContentReturns
+
+	err_ := c.caller.Call(ctx, "Page.getResourceContent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetResourceTreeReturns struct {
+
+	/* Present frame / resource tree structure. */
+	FrameTree FrameResourceTree `json:"frameTree"`
+}
+
+// UnmarshalCDP reads GetResourceTreeReturns from JSON
+func (v *GetResourceTreeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameTree":
+			v.FrameTree.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetResourceTreeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns present frame / resource tree structure.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetResourceTree() (GetResourceTreeReturns, error) {
+	return c.GetResourceTreeContext(context.Background())
+}
+
+// GetResourceTreeContext is GetResourceTree with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetResourceTreeContext(ctx context.Context) (GetResourceTreeReturns, error) {
+	var returns_ GetResourceTreeReturns
+
+	err_ := c.caller.Call(ctx, "Page.getResourceTree", nil, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveScriptToEvaluateOnLoadReturns struct {
+}
+
+// UnmarshalCDP reads RemoveScriptToEvaluateOnLoadReturns from JSON
+func (v *RemoveScriptToEvaluateOnLoadReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveScriptToEvaluateOnLoadReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveScriptToEvaluateOnLoadParams are the parameters for Page.removeScriptToEvaluateOnLoad
+// optional parameters are left out when nil
+type RemoveScriptToEvaluateOnLoadParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+}
+
+// MarshalCDP writes RemoveScriptToEvaluateOnLoadParams as JSON
+func (v *RemoveScriptToEvaluateOnLoadParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("identifier")
+	e.String(string(v.Identifier))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveScriptToEvaluateOnLoadParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deprecated, please use removeScriptToEvaluateOnNewDocument instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) RemoveScriptToEvaluateOnLoad(params RemoveScriptToEvaluateOnLoadParams) (RemoveScriptToEvaluateOnLoadReturns, error) {
+	return c.RemoveScriptToEvaluateOnLoadContext(context.Background(), params)
+}
+
+// RemoveScriptToEvaluateOnLoadContext is RemoveScriptToEvaluateOnLoad with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) RemoveScriptToEvaluateOnLoadContext(ctx context.Context, params RemoveScriptToEvaluateOnLoadParams) (RemoveScriptToEvaluateOnLoadReturns, error) {
+	var returns_ RemoveScriptToEvaluateOnLoadReturns
+
+	err_ := c.caller.Call(ctx, "Page.removeScriptToEvaluateOnLoad", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ScreencastFrameAckReturns struct {
+}
+
+// UnmarshalCDP reads ScreencastFrameAckReturns from JSON
+func (v *ScreencastFrameAckReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScreencastFrameAckReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ScreencastFrameAckParams are the parameters for Page.screencastFrameAck
+// optional parameters are left out when nil
+type ScreencastFrameAckParams struct {
+	/* Frame number. */
+	SessionId int `json:"sessionId"`
+}
+
+// MarshalCDP writes ScreencastFrameAckParams as JSON
+func (v *ScreencastFrameAckParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("sessionId")
+	e.Int(v.SessionId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScreencastFrameAckParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Acknowledges that a screencast frame has been received by the frontend.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ScreencastFrameAck(params ScreencastFrameAckParams) (ScreencastFrameAckReturns, error) {
+	return c.ScreencastFrameAckContext(context.Background(), params)
+}
+
+// ScreencastFrameAckContext is ScreencastFrameAck with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ScreencastFrameAckContext(ctx context.Context, params ScreencastFrameAckParams) (ScreencastFrameAckReturns, error) {
+	var returns_ ScreencastFrameAckReturns
+
+	err_ := c.caller.Call(ctx, "Page.screencastFrameAck", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SearchInResourceReturns struct {
+
+	/* List of search matches. */
+	Result []cdp.DebuggerSearchMatch `json:"result"`
+}
+
+// UnmarshalCDP reads SearchInResourceReturns from JSON
+func (v *SearchInResourceReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			if d.Array() {
+				v.Result = make([]cdp.DebuggerSearchMatch, 0)
+				for d.More() {
+					var x0 cdp.DebuggerSearchMatch
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SearchInResourceReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SearchInResourceParams are the parameters for Page.searchInResource
+// optional parameters are left out when nil
+type SearchInResourceParams struct {
+	/* Frame id for resource to search in. */
+	FrameId FrameId `json:"frameId"`
+	/* URL of the resource to search in. */
+	Url string `json:"url"`
+	/* String to search for. */
+	Query string `json:"query"`
+	/* If true, search is case sensitive. */
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	/* If true, treats string parameter as regex. */
+	IsRegex *bool `json:"isRegex,omitempty"`
+}
+
+// MarshalCDP writes SearchInResourceParams as JSON
+func (v *SearchInResourceParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("query")
+	e.String(v.Query)
+	if v.CaseSensitive != nil {
+		e.Key("caseSensitive")
+		e.Bool(*v.CaseSensitive)
+	}
+	if v.IsRegex != nil {
+		e.Key("isRegex")
+		e.Bool(*v.IsRegex)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SearchInResourceParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Searches for given string in resource content.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SearchInResource(params SearchInResourceParams) (SearchInResourceReturns, error) {
+	return c.SearchInResourceContext(context.Background(), params)
+}
+
+// SearchInResourceContext is SearchInResource with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SearchInResourceContext(ctx context.Context, params SearchInResourceParams) (SearchInResourceReturns, error) {
+	var returns_ SearchInResourceReturns
+
+	err_ := c.caller.Call(ctx, "Page.searchInResource", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAdBlockingEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetAdBlockingEnabledReturns from JSON
+func (v *SetAdBlockingEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAdBlockingEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAdBlockingEnabledParams are the parameters for Page.setAdBlockingEnabled
+// optional parameters are left out when nil
+type SetAdBlockingEnabledParams struct {
+	/* Whether to block ads. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetAdBlockingEnabledParams as JSON
+func (v *SetAdBlockingEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAdBlockingEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable Chrome's experimental ad filter on all sites.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAdBlockingEnabled(params SetAdBlockingEnabledParams) (SetAdBlockingEnabledReturns, error) {
+	return c.SetAdBlockingEnabledContext(context.Background(), params)
+}
+
+// SetAdBlockingEnabledContext is SetAdBlockingEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAdBlockingEnabledContext(ctx context.Context, params SetAdBlockingEnabledParams) (SetAdBlockingEnabledReturns, error) {
+	var returns_ SetAdBlockingEnabledReturns
+
+	err_ := c.caller.Call(ctx, "Page.setAdBlockingEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetPermissionsPolicyStateReturns struct {
+	States []PermissionsPolicyFeatureState `json:"states"`
+}
+
+// UnmarshalCDP reads GetPermissionsPolicyStateReturns from JSON
+func (v *GetPermissionsPolicyStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "states":
+			if d.Array() {
+				v.States = make([]PermissionsPolicyFeatureState, 0)
+				for d.More() {
+					var x0 PermissionsPolicyFeatureState
+					x0.UnmarshalCDP(d)
+					v.States = append(v.States, x0)
+				}
+			} else {
+				v.States = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetPermissionsPolicyStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetPermissionsPolicyStateParams are the parameters for Page.getPermissionsPolicyState
+// optional parameters are left out when nil
+type GetPermissionsPolicyStateParams struct {
+	FrameId FrameId `json:"frameId"`
+}
+
+// MarshalCDP writes GetPermissionsPolicyStateParams as JSON
+func (v *GetPermissionsPolicyStateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetPermissionsPolicyStateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Get Permissions Policy state on given frame.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetPermissionsPolicyState(params GetPermissionsPolicyStateParams) (GetPermissionsPolicyStateReturns, error) {
+	return c.GetPermissionsPolicyStateContext(context.Background(), params)
+}
+
+// GetPermissionsPolicyStateContext is GetPermissionsPolicyState with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetPermissionsPolicyStateContext(ctx context.Context, params GetPermissionsPolicyStateParams) (GetPermissionsPolicyStateReturns, error) {
+	var returns_ GetPermissionsPolicyStateReturns
+
+	err_ := c.caller.Call(ctx, "Page.getPermissionsPolicyState", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetOriginTrialsReturns struct {
+	OriginTrials []OriginTrial `json:"originTrials"`
+}
+
+// UnmarshalCDP reads GetOriginTrialsReturns from JSON
+func (v *GetOriginTrialsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "originTrials":
+			if d.Array() {
+				v.OriginTrials = make([]OriginTrial, 0)
+				for d.More() {
+					var x0 OriginTrial
+					x0.UnmarshalCDP(d)
+					v.OriginTrials = append(v.OriginTrials, x0)
+				}
+			} else {
+				v.OriginTrials = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetOriginTrialsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetOriginTrialsParams are the parameters for Page.getOriginTrials
+// optional parameters are left out when nil
+type GetOriginTrialsParams struct {
+	FrameId FrameId `json:"frameId"`
+}
+
+// MarshalCDP writes GetOriginTrialsParams as JSON
+func (v *GetOriginTrialsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetOriginTrialsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Get Origin Trials on given frame.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetOriginTrials(params GetOriginTrialsParams) (GetOriginTrialsReturns, error) {
+	return c.GetOriginTrialsContext(context.Background(), params)
+}
+
+// GetOriginTrialsContext is GetOriginTrials with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetOriginTrialsContext(ctx context.Context, params GetOriginTrialsParams) (GetOriginTrialsReturns, error) {
+	var returns_ GetOriginTrialsReturns
+
+	err_ := c.caller.Call(ctx, "Page.getOriginTrials", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDeviceMetricsOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDeviceMetricsOverrideReturns from JSON
+func (v *SetDeviceMetricsOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDeviceMetricsOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDeviceMetricsOverrideParams are the parameters for Page.setDeviceMetricsOverride
+// optional parameters are left out when nil
+type SetDeviceMetricsOverrideParams struct {
+	/* Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override. */
+	Width int `json:"width"`
+	/* Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override. */
+	Height int `json:"height"`
+	/* Overriding device scale factor value. 0 disables the override. */
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+	/* Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text
+	autosizing and more. */
+	Mobile bool `json:"mobile"`
+	/* Scale to apply to resulting view image. */
+	Scale *float64 `json:"scale,omitempty"`
+	/* Overriding screen width value in pixels (minimum 0, maximum 10000000). */
+	ScreenWidth *int `json:"screenWidth,omitempty"`
+	/* Overriding screen height value in pixels (minimum 0, maximum 10000000). */
+	ScreenHeight *int `json:"screenHeight,omitempty"`
+	/* Overriding view X position on screen in pixels (minimum 0, maximum 10000000). */
+	PositionX *int `json:"positionX,omitempty"`
+	/* Overriding view Y position on screen in pixels (minimum 0, maximum 10000000). */
+	PositionY *int `json:"positionY,omitempty"`
+	/* Do not set visible view size, rely upon explicit setVisibleSize call. */
+	DontSetVisibleSize *bool `json:"dontSetVisibleSize,omitempty"`
+	/* Screen orientation override. */
+	ScreenOrientation *cdp.EmulationScreenOrientation `json:"screenOrientation,omitempty"`
+	/* The viewport dimensions and scale. If not set, the override is cleared. */
+	Viewport *Viewport `json:"viewport,omitempty"`
+}
+
+// MarshalCDP writes SetDeviceMetricsOverrideParams as JSON
+func (v *SetDeviceMetricsOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("width")
+	e.Int(v.Width)
+	e.Key("height")
+	e.Int(v.Height)
+	e.Key("deviceScaleFactor")
+	e.Float(v.DeviceScaleFactor)
+	e.Key("mobile")
+	e.Bool(v.Mobile)
+	if v.Scale != nil {
+		e.Key("scale")
+		e.Float(*v.Scale)
+	}
+	if v.ScreenWidth != nil {
+		e.Key("screenWidth")
+		e.Int(*v.ScreenWidth)
+	}
+	if v.ScreenHeight != nil {
+		e.Key("screenHeight")
+		e.Int(*v.ScreenHeight)
+	}
+	if v.PositionX != nil {
+		e.Key("positionX")
+		e.Int(*v.PositionX)
+	}
+	if v.PositionY != nil {
+		e.Key("positionY")
+		e.Int(*v.PositionY)
+	}
+	if v.DontSetVisibleSize != nil {
+		e.Key("dontSetVisibleSize")
+		e.Bool(*v.DontSetVisibleSize)
+	}
+	if v.ScreenOrientation != nil {
+		e.Key("screenOrientation")
+		(*v.ScreenOrientation).MarshalCDP(e)
+	}
+	if v.Viewport != nil {
+		e.Key("viewport")
+		(*v.Viewport).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDeviceMetricsOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the values of device screen dimensions (window.screen.width, window.screen.height,
+// window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media
+// query results).
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetDeviceMetricsOverride(params SetDeviceMetricsOverrideParams) (SetDeviceMetricsOverrideReturns, error) {
+	return c.SetDeviceMetricsOverrideContext(context.Background(), params)
+}
+
+// SetDeviceMetricsOverrideContext is SetDeviceMetricsOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetDeviceMetricsOverrideContext(ctx context.Context, params SetDeviceMetricsOverrideParams) (SetDeviceMetricsOverrideReturns, error) {
+	var returns_ SetDeviceMetricsOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Page.setDeviceMetricsOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDeviceOrientationOverrideReturns struct {
+}
+
+// UnmarshalCDP reads SetDeviceOrientationOverrideReturns from JSON
+func (v *SetDeviceOrientationOverrideReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDeviceOrientationOverrideReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDeviceOrientationOverrideParams are the parameters for Page.setDeviceOrientationOverride
+// optional parameters are left out when nil
+type SetDeviceOrientationOverrideParams struct {
+	/* Mock alpha */
+	Alpha float64 `json:"alpha"`
+	/* Mock beta */
+	Beta float64 `json:"beta"`
+	/* Mock gamma */
+	Gamma float64 `json:"gamma"`
+}
+
+// MarshalCDP writes SetDeviceOrientationOverrideParams as JSON
+func (v *SetDeviceOrientationOverrideParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("alpha")
+	e.Float(v.Alpha)
+	e.Key("beta")
+	e.Float(v.Beta)
+	e.Key("gamma")
+	e.Float(v.Gamma)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDeviceOrientationOverrideParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Overrides the Device Orientation.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetDeviceOrientationOverride(params SetDeviceOrientationOverrideParams) (SetDeviceOrientationOverrideReturns, error) {
+	return c.SetDeviceOrientationOverrideContext(context.Background(), params)
+}
+
+// SetDeviceOrientationOverrideContext is SetDeviceOrientationOverride with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetDeviceOrientationOverrideContext(ctx context.Context, params SetDeviceOrientationOverrideParams) (SetDeviceOrientationOverrideReturns, error) {
+	var returns_ SetDeviceOrientationOverrideReturns
+
+	err_ := c.caller.Call(ctx, "Page.setDeviceOrientationOverride", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetFontFamiliesReturns struct {
+}
+
+// UnmarshalCDP reads SetFontFamiliesReturns from JSON
+func (v *SetFontFamiliesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetFontFamiliesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetFontFamiliesParams are the parameters for Page.setFontFamilies
+// optional parameters are left out when nil
+type SetFontFamiliesParams struct {
+	/* Specifies font families to set. If a font family is not specified, it won't be changed. */
+	FontFamilies FontFamilies `json:"fontFamilies"`
+	/* Specifies font families to set for individual scripts. */
+	ForScripts []ScriptFontFamilies `json:"forScripts"`
+}
+
+// MarshalCDP writes SetFontFamiliesParams as JSON
+func (v *SetFontFamiliesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("fontFamilies")
+	v.FontFamilies.MarshalCDP(e)
+	if v.ForScripts != nil {
+		e.Key("forScripts")
+		e.ArrayStart()
+		for i0 := range v.ForScripts {
+			e.Elem()
+			v.ForScripts[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetFontFamiliesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set generic font families.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetFontFamilies(params SetFontFamiliesParams) (SetFontFamiliesReturns, error) {
+	return c.SetFontFamiliesContext(context.Background(), params)
+}
+
+// SetFontFamiliesContext is SetFontFamilies with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetFontFamiliesContext(ctx context.Context, params SetFontFamiliesParams) (SetFontFamiliesReturns, error) {
+	var returns_ SetFontFamiliesReturns
+
+	err_ := c.caller.Call(ctx, "Page.setFontFamilies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetFontSizesReturns struct {
+}
+
+// UnmarshalCDP reads SetFontSizesReturns from JSON
+func (v *SetFontSizesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetFontSizesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetFontSizesParams are the parameters for Page.setFontSizes
+// optional parameters are left out when nil
+type SetFontSizesParams struct {
+	/* Specifies font sizes to set. If a font size is not specified, it won't be changed. */
+	FontSizes FontSizes `json:"fontSizes"`
+}
+
+// MarshalCDP writes SetFontSizesParams as JSON
+func (v *SetFontSizesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("fontSizes")
+	v.FontSizes.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetFontSizesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set default font sizes.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetFontSizes(params SetFontSizesParams) (SetFontSizesReturns, error) {
+	return c.SetFontSizesContext(context.Background(), params)
+}
+
+// SetFontSizesContext is SetFontSizes with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetFontSizesContext(ctx context.Context, params SetFontSizesParams) (SetFontSizesReturns, error) {
+	var returns_ SetFontSizesReturns
+
+	err_ := c.caller.Call(ctx, "Page.setFontSizes", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDownloadBehaviorReturns struct {
+}
+
+// UnmarshalCDP reads SetDownloadBehaviorReturns from JSON
+func (v *SetDownloadBehaviorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDownloadBehaviorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDownloadBehaviorParams are the parameters for Page.setDownloadBehavior
+// optional parameters are left out when nil
+type SetDownloadBehaviorParams struct {
+	/* Whether to allow all or deny all download requests, or use default Chrome behavior if
+	available (otherwise deny). */
+	Behavior SetDownloadBehaviorBehavior `json:"behavior"`
+	/* The default path to save downloaded files to. This is required if behavior is set to 'allow' */
+	DownloadPath *string `json:"downloadPath,omitempty"`
+}
+
+// MarshalCDP writes SetDownloadBehaviorParams as JSON
+func (v *SetDownloadBehaviorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("behavior")
+	e.String(string(v.Behavior))
+	if v.DownloadPath != nil {
+		e.Key("downloadPath")
+		e.String(*v.DownloadPath)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDownloadBehaviorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set the behavior when downloading a file.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetDownloadBehavior(params SetDownloadBehaviorParams) (SetDownloadBehaviorReturns, error) {
+	return c.SetDownloadBehaviorContext(context.Background(), params)
+}
+
+// SetDownloadBehaviorContext is SetDownloadBehavior with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetDownloadBehaviorContext(ctx context.Context, params SetDownloadBehaviorParams) (SetDownloadBehaviorReturns, error) {
+	var returns_ SetDownloadBehaviorReturns
+
+	if !params.Behavior.Valid() {
+		return returns_, fmt.Errorf("Page.setDownloadBehavior: invalid behavior %q", params.Behavior)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.setDownloadBehavior", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetTouchEmulationEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetTouchEmulationEnabledReturns from JSON
+func (v *SetTouchEmulationEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetTouchEmulationEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetTouchEmulationEnabledParams are the parameters for Page.setTouchEmulationEnabled
+// optional parameters are left out when nil
+type SetTouchEmulationEnabledParams struct {
+	/* Whether the touch event emulation should be enabled. */
+	Enabled bool `json:"enabled"`
+	/* Touch/gesture events configuration. Default: current platform. */
+	Configuration *SetTouchEmulationEnabledConfiguration `json:"configuration,omitempty"`
+}
+
+// MarshalCDP writes SetTouchEmulationEnabledParams as JSON
+func (v *SetTouchEmulationEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	if v.Configuration != nil {
+		e.Key("configuration")
+		e.String(string(*v.Configuration))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetTouchEmulationEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Toggles mouse event-based touch event emulation.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetTouchEmulationEnabled(params SetTouchEmulationEnabledParams) (SetTouchEmulationEnabledReturns, error) {
+	return c.SetTouchEmulationEnabledContext(context.Background(), params)
+}
+
+// SetTouchEmulationEnabledContext is SetTouchEmulationEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetTouchEmulationEnabledContext(ctx context.Context, params SetTouchEmulationEnabledParams) (SetTouchEmulationEnabledReturns, error) {
+	var returns_ SetTouchEmulationEnabledReturns
+
+	if params.Configuration != nil && !params.Configuration.Valid() {
+		return returns_, fmt.Errorf("Page.setTouchEmulationEnabled: invalid configuration %q", *params.Configuration)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.setTouchEmulationEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartScreencastReturns struct {
+}
+
+// UnmarshalCDP reads StartScreencastReturns from JSON
+func (v *StartScreencastReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartScreencastReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartScreencastParams are the parameters for Page.startScreencast
+// optional parameters are left out when nil
+type StartScreencastParams struct {
+	/* Image compression format. */
+	Format *StartScreencastFormat `json:"format,omitempty"`
+	/* Compression quality from range [0..100]. */
+	Quality *int `json:"quality,omitempty"`
+	/* Maximum screenshot width. */
+	MaxWidth *int `json:"maxWidth,omitempty"`
+	/* Maximum screenshot height. */
+	MaxHeight *int `json:"maxHeight,omitempty"`
+	/* Send every n-th frame. */
+	EveryNthFrame *int `json:"everyNthFrame,omitempty"`
+}
+
+// MarshalCDP writes StartScreencastParams as JSON
+func (v *StartScreencastParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Format != nil {
+		e.Key("format")
+		e.String(string(*v.Format))
+	}
+	if v.Quality != nil {
+		e.Key("quality")
+		e.Int(*v.Quality)
+	}
+	if v.MaxWidth != nil {
+		e.Key("maxWidth")
+		e.Int(*v.MaxWidth)
+	}
+	if v.MaxHeight != nil {
+		e.Key("maxHeight")
+		e.Int(*v.MaxHeight)
+	}
+	if v.EveryNthFrame != nil {
+		e.Key("everyNthFrame")
+		e.Int(*v.EveryNthFrame)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartScreencastParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Starts sending each frame using the `screencastFrame` event.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) StartScreencast(params StartScreencastParams) (StartScreencastReturns, error) {
+	return c.StartScreencastContext(context.Background(), params)
+}
+
+// StartScreencastContext is StartScreencast with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) StartScreencastContext(ctx context.Context, params StartScreencastParams) (StartScreencastReturns, error) {
+	var returns_ StartScreencastReturns
+
+	if params.Format != nil && !params.Format.Valid() {
+		return returns_, fmt.Errorf("Page.startScreencast: invalid format %q", *params.Format)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.startScreencast", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CrashReturns struct {
+}
+
+// UnmarshalCDP reads CrashReturns from JSON
+func (v *CrashReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CrashReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Crashes renderer on the IO thread, generates minidumps.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) Crash() (CrashReturns, error) {
+	return c.CrashContext(context.Background())
+}
+
+// CrashContext is Crash with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) CrashContext(ctx context.Context) (CrashReturns, error) {
+	var returns_ CrashReturns
+
+	err_ := c.caller.Call(ctx, "Page.crash", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetWebLifecycleStateReturns struct {
+}
+
+// UnmarshalCDP reads SetWebLifecycleStateReturns from JSON
+func (v *SetWebLifecycleStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetWebLifecycleStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetWebLifecycleStateParams are the parameters for Page.setWebLifecycleState
+// optional parameters are left out when nil
+type SetWebLifecycleStateParams struct {
+	/* Target lifecycle state */
+	State SetWebLifecycleStateState `json:"state"`
+}
+
+// MarshalCDP writes SetWebLifecycleStateParams as JSON
+func (v *SetWebLifecycleStateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("state")
+	e.String(string(v.State))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetWebLifecycleStateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Tries to update the web lifecycle state of the page.
+// It will transition the page to the given state according to:
+// https://github.com/WICG/web-lifecycle/
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetWebLifecycleState(params SetWebLifecycleStateParams) (SetWebLifecycleStateReturns, error) {
+	return c.SetWebLifecycleStateContext(context.Background(), params)
+}
+
+// SetWebLifecycleStateContext is SetWebLifecycleState with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetWebLifecycleStateContext(ctx context.Context, params SetWebLifecycleStateParams) (SetWebLifecycleStateReturns, error) {
+	var returns_ SetWebLifecycleStateReturns
+
+	if !params.State.Valid() {
+		return returns_, fmt.Errorf("Page.setWebLifecycleState: invalid state %q", params.State)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.setWebLifecycleState", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopScreencastReturns struct {
+}
+
+// UnmarshalCDP reads StopScreencastReturns from JSON
+func (v *StopScreencastReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopScreencastReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stops sending each frame in the `screencastFrame`.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) StopScreencast() (StopScreencastReturns, error) {
+	return c.StopScreencastContext(context.Background())
+}
+
+// StopScreencastContext is StopScreencast with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) StopScreencastContext(ctx context.Context) (StopScreencastReturns, error) {
+	var returns_ StopScreencastReturns
+
+	err_ := c.caller.Call(ctx, "Page.stopScreencast", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ProduceCompilationCacheReturns struct {
+}
+
+// UnmarshalCDP reads ProduceCompilationCacheReturns from JSON
+func (v *ProduceCompilationCacheReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ProduceCompilationCacheReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ProduceCompilationCacheParams are the parameters for Page.produceCompilationCache
+// optional parameters are left out when nil
+type ProduceCompilationCacheParams struct {
+	Scripts []CompilationCacheParams `json:"scripts"`
+}
+
+// MarshalCDP writes ProduceCompilationCacheParams as JSON
+func (v *ProduceCompilationCacheParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scripts")
+	if v.Scripts == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Scripts {
+			e.Elem()
+			v.Scripts[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ProduceCompilationCacheParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Requests backend to produce compilation cache for the specified scripts.
+// `scripts` are appended to the list of scripts for which the cache
+// would be produced. The list may be reset during page navigation.
+// When script with a matching URL is encountered, the cache is optionally
+// produced upon backend discretion, based on internal heuristics.
+// See also: `Page.compilationCacheProduced`.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ProduceCompilationCache(params ProduceCompilationCacheParams) (ProduceCompilationCacheReturns, error) {
+	return c.ProduceCompilationCacheContext(context.Background(), params)
+}
+
+// ProduceCompilationCacheContext is ProduceCompilationCache with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ProduceCompilationCacheContext(ctx context.Context, params ProduceCompilationCacheParams) (ProduceCompilationCacheReturns, error) {
+	var returns_ ProduceCompilationCacheReturns
+
+	err_ := c.caller.Call(ctx, "Page.produceCompilationCache", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddCompilationCacheReturns struct {
+}
+
+// UnmarshalCDP reads AddCompilationCacheReturns from JSON
+func (v *AddCompilationCacheReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddCompilationCacheReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddCompilationCacheParams are the parameters for Page.addCompilationCache
+// optional parameters are left out when nil
+type AddCompilationCacheParams struct {
+	Url string `json:"url"`
+	/* Base64-encoded data (Encoded as a base64 string when passed over JSON) */
+	Data string `json:"data"`
+}
+
+// MarshalCDP writes AddCompilationCacheParams as JSON
+func (v *AddCompilationCacheParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("data")
+	e.String(v.Data)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddCompilationCacheParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Seeds compilation cache for given url. Compilation cache does not survive
+// cross-process navigation.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) AddCompilationCache(params AddCompilationCacheParams) (AddCompilationCacheReturns, error) {
+	return c.AddCompilationCacheContext(context.Background(), params)
+}
+
+// AddCompilationCacheContext is AddCompilationCache with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) AddCompilationCacheContext(ctx context.Context, params AddCompilationCacheParams) (AddCompilationCacheReturns, error) {
+	var returns_ AddCompilationCacheReturns
+
+	err_ := c.caller.Call(ctx, "Page.addCompilationCache", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearCompilationCacheReturns struct {
+}
+
+// UnmarshalCDP reads ClearCompilationCacheReturns from JSON
+func (v *ClearCompilationCacheReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearCompilationCacheReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Clears seeded compilation cache.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearCompilationCache() (ClearCompilationCacheReturns, error) {
+	return c.ClearCompilationCacheContext(context.Background())
+}
+
+// ClearCompilationCacheContext is ClearCompilationCache with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearCompilationCacheContext(ctx context.Context) (ClearCompilationCacheReturns, error) {
+	var returns_ ClearCompilationCacheReturns
+
+	err_ := c.caller.Call(ctx, "Page.clearCompilationCache", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetSPCTransactionModeReturns struct {
+}
+
+// UnmarshalCDP reads SetSPCTransactionModeReturns from JSON
+func (v *SetSPCTransactionModeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSPCTransactionModeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSPCTransactionModeParams are the parameters for Page.setSPCTransactionMode
+// optional parameters are left out when nil
+type SetSPCTransactionModeParams struct {
+	Mode SetSPCTransactionModeMode `json:"mode"`
+}
+
+// MarshalCDP writes SetSPCTransactionModeParams as JSON
+func (v *SetSPCTransactionModeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("mode")
+	e.String(string(v.Mode))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSPCTransactionModeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets the Secure Payment Confirmation transaction mode.
+// https://w3c.github.io/secure-payment-confirmation/#sctn-automation-set-spc-transaction-mode
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSPCTransactionMode(params SetSPCTransactionModeParams) (SetSPCTransactionModeReturns, error) {
+	return c.SetSPCTransactionModeContext(context.Background(), params)
+}
+
+// SetSPCTransactionModeContext is SetSPCTransactionMode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSPCTransactionModeContext(ctx context.Context, params SetSPCTransactionModeParams) (SetSPCTransactionModeReturns, error) {
+	var returns_ SetSPCTransactionModeReturns
+
+	if !params.Mode.Valid() {
+		return returns_, fmt.Errorf("Page.setSPCTransactionMode: invalid mode %q", params.Mode)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.setSPCTransactionMode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetRPHRegistrationModeReturns struct {
+}
+
+// UnmarshalCDP reads SetRPHRegistrationModeReturns from JSON
+func (v *SetRPHRegistrationModeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetRPHRegistrationModeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetRPHRegistrationModeParams are the parameters for Page.setRPHRegistrationMode
+// optional parameters are left out when nil
+type SetRPHRegistrationModeParams struct {
+	Mode SetRPHRegistrationModeMode `json:"mode"`
+}
+
+// MarshalCDP writes SetRPHRegistrationModeParams as JSON
+func (v *SetRPHRegistrationModeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("mode")
+	e.String(string(v.Mode))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetRPHRegistrationModeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Extensions for Custom Handlers API:
+// https://html.spec.whatwg.org/multipage/system-state.html#rph-automation
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetRPHRegistrationMode(params SetRPHRegistrationModeParams) (SetRPHRegistrationModeReturns, error) {
+	return c.SetRPHRegistrationModeContext(context.Background(), params)
+}
+
+// SetRPHRegistrationModeContext is SetRPHRegistrationMode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetRPHRegistrationModeContext(ctx context.Context, params SetRPHRegistrationModeParams) (SetRPHRegistrationModeReturns, error) {
+	var returns_ SetRPHRegistrationModeReturns
+
+	if !params.Mode.Valid() {
+		return returns_, fmt.Errorf("Page.setRPHRegistrationMode: invalid mode %q", params.Mode)
+	}
+
+	err_ := c.caller.Call(ctx, "Page.setRPHRegistrationMode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GenerateTestReportReturns struct {
+}
+
+// UnmarshalCDP reads GenerateTestReportReturns from JSON
+func (v *GenerateTestReportReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GenerateTestReportReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GenerateTestReportParams are the parameters for Page.generateTestReport
+// optional parameters are left out when nil
+type GenerateTestReportParams struct {
+	/* Message to be displayed in the report. */
+	Message string `json:"message"`
+	/* Specifies the endpoint group to deliver the report to. */
+	Group *string `json:"group,omitempty"`
+}
+
+// MarshalCDP writes GenerateTestReportParams as JSON
+func (v *GenerateTestReportParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("message")
+	e.String(v.Message)
+	if v.Group != nil {
+		e.Key("group")
+		e.String(*v.Group)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GenerateTestReportParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Generates a report for testing.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GenerateTestReport(params GenerateTestReportParams) (GenerateTestReportReturns, error) {
+	return c.GenerateTestReportContext(context.Background(), params)
+}
+
+// GenerateTestReportContext is GenerateTestReport with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GenerateTestReportContext(ctx context.Context, params GenerateTestReportParams) (GenerateTestReportReturns, error) {
+	var returns_ GenerateTestReportReturns
+
+	err_ := c.caller.Call(ctx, "Page.generateTestReport", &params, &returns_)
+
+	return returns_, err_
+}
+
+type WaitForDebuggerReturns struct {
+}
+
+// UnmarshalCDP reads WaitForDebuggerReturns from JSON
+func (v *WaitForDebuggerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WaitForDebuggerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Pauses page execution. Can be resumed using generic Runtime.runIfWaitingForDebugger.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) WaitForDebugger() (WaitForDebuggerReturns, error) {
+	return c.WaitForDebuggerContext(context.Background())
+}
+
+// WaitForDebuggerContext is WaitForDebugger with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) WaitForDebuggerContext(ctx context.Context) (WaitForDebuggerReturns, error) {
+	var returns_ WaitForDebuggerReturns
+
+	err_ := c.caller.Call(ctx, "Page.waitForDebugger", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetPrerenderingAllowedReturns struct {
+}
+
+// UnmarshalCDP reads SetPrerenderingAllowedReturns from JSON
+func (v *SetPrerenderingAllowedReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetPrerenderingAllowedReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetPrerenderingAllowedParams are the parameters for Page.setPrerenderingAllowed
+// optional parameters are left out when nil
+type SetPrerenderingAllowedParams struct {
+	IsAllowed bool `json:"isAllowed"`
+}
+
+// MarshalCDP writes SetPrerenderingAllowedParams as JSON
+func (v *SetPrerenderingAllowedParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("isAllowed")
+	e.Bool(v.IsAllowed)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetPrerenderingAllowedParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable/disable prerendering manually.
+//
+// This command is a short-term solution for https://crbug.com/1440085.
+// See https://docs.google.com/document/d/12HVmFxYj5Jc-eJr5OmWsa2bqTJsbgGLKI6ZIyx0_wpA
+// for more details.
+//
+// TODO(https://crbug.com/1440085): Remove this once Puppeteer supports tab targets.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPrerenderingAllowed(params SetPrerenderingAllowedParams) (SetPrerenderingAllowedReturns, error) {
+	return c.SetPrerenderingAllowedContext(context.Background(), params)
+}
+
+// SetPrerenderingAllowedContext is SetPrerenderingAllowed with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetPrerenderingAllowedContext(ctx context.Context, params SetPrerenderingAllowedParams) (SetPrerenderingAllowedReturns, error) {
+	var returns_ SetPrerenderingAllowedReturns
+
+	err_ := c.caller.Call(ctx, "Page.setPrerenderingAllowed", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Fired before frame subtree is detached. Emitted before any frame of the
+// subtree is actually detached.
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameSubtreeWillBeDetachedEvent struct {
+
+	/* Id of the frame that is the root of the subtree that will be detached. */
+	FrameId FrameId `json:"frameId"`
+}
+
+// UnmarshalCDP reads FrameSubtreeWillBeDetachedEvent from JSON
+func (v *FrameSubtreeWillBeDetachedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameSubtreeWillBeDetachedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameSubtreeWillBeDetachedHandler func(ev FrameSubtreeWillBeDetachedEvent)
+
+// EventMethod is Page.frameSubtreeWillBeDetached
+func (FrameSubtreeWillBeDetachedEvent) EventMethod() string {
+	return "Page.frameSubtreeWillBeDetached"
+}
+
+// OnFrameSubtreeWillBeDetached calls handler for each Page.frameSubtreeWillBeDetached event
+func (c Client) OnFrameSubtreeWillBeDetached(handler FrameSubtreeWillBeDetachedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameSubtreeWillBeDetached", func(ev interface{}) {
+		handler(ev.(FrameSubtreeWillBeDetachedEvent))
+	})
+}
+
+// Fired when opening document to write to.
+//
+// Experimental: this may change or be removed in any chrome release
+type DocumentOpenedEvent struct {
+
+	/* Frame object. */
+	Frame Frame `json:"frame"`
+}
+
+// UnmarshalCDP reads DocumentOpenedEvent from JSON
+func (v *DocumentOpenedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frame":
+			v.Frame.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DocumentOpenedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DocumentOpenedHandler func(ev DocumentOpenedEvent)
+
+// EventMethod is Page.documentOpened
+func (DocumentOpenedEvent) EventMethod() string {
+	return "Page.documentOpened"
+}
+
+// OnDocumentOpened calls handler for each Page.documentOpened event
+func (c Client) OnDocumentOpened(handler DocumentOpenedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.documentOpened", func(ev interface{}) {
+		handler(ev.(DocumentOpenedEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type FrameResizedEvent struct {
+}
+
+// UnmarshalCDP reads FrameResizedEvent from JSON
+func (v *FrameResizedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameResizedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameResizedHandler func(ev FrameResizedEvent)
+
+// EventMethod is Page.frameResized
+func (FrameResizedEvent) EventMethod() string {
+	return "Page.frameResized"
+}
+
+// OnFrameResized calls handler for each Page.frameResized event
+func (c Client) OnFrameResized(handler FrameResizedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameResized", func(ev interface{}) {
+		handler(ev.(FrameResizedEvent))
+	})
+}
+
+// Fired when a navigation starts. This event is fired for both
+// renderer-initiated and browser-initiated navigations. For renderer-initiated
+// navigations, the event is fired after `frameRequestedNavigation`.
+// Navigation may still be cancelled after the event is issued. Multiple events
+// can be fired for a single navigation, for example, when a same-document
+// navigation becomes a cross-document navigation (such as in the case of a
+// frameset).
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameStartedNavigatingEvent struct {
+
+	/* ID of the frame that is being navigated. */
+	FrameId FrameId `json:"frameId"`
+
+	/* The URL the navigation started with. The final URL can be different. */
+	Url string `json:"url"`
+
+	/* Loader identifier. Even though it is present in case of same-document
+	navigation, the previously committed loaderId would not change unless
+	the navigation changes from a same-document to a cross-document
+	navigation. */
+	LoaderId cdp.NetworkLoaderId `json:"loaderId"`
+
+	NavigationType FrameStartedNavigatingNavigationType `json:"navigationType"`
+}
+
+// UnmarshalCDP reads FrameStartedNavigatingEvent from JSON
+func (v *FrameStartedNavigatingEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "navigationType":
+			v.NavigationType = FrameStartedNavigatingNavigationType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameStartedNavigatingEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameStartedNavigatingHandler func(ev FrameStartedNavigatingEvent)
+
+// EventMethod is Page.frameStartedNavigating
+func (FrameStartedNavigatingEvent) EventMethod() string {
+	return "Page.frameStartedNavigating"
+}
+
+// OnFrameStartedNavigating calls handler for each Page.frameStartedNavigating event
+func (c Client) OnFrameStartedNavigating(handler FrameStartedNavigatingHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameStartedNavigating", func(ev interface{}) {
+		handler(ev.(FrameStartedNavigatingEvent))
+	})
+}
+
+// Fired when a renderer-initiated navigation is requested.
+// Navigation may still be cancelled after the event is issued.
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameRequestedNavigationEvent struct {
+
+	/* Id of the frame that is being navigated. */
+	FrameId FrameId `json:"frameId"`
+
+	/* The reason for the navigation. */
+	Reason ClientNavigationReason `json:"reason"`
+
+	/* The destination URL for the requested navigation. */
+	Url string `json:"url"`
+
+	/* The disposition for the navigation. */
+	Disposition ClientNavigationDisposition `json:"disposition"`
+}
+
+// UnmarshalCDP reads FrameRequestedNavigationEvent from JSON
+func (v *FrameRequestedNavigationEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "reason":
+			v.Reason = ClientNavigationReason(d.String())
+		case "url":
+			v.Url = d.String()
+		case "disposition":
+			v.Disposition = ClientNavigationDisposition(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameRequestedNavigationEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameRequestedNavigationHandler func(ev FrameRequestedNavigationEvent)
+
+// EventMethod is Page.frameRequestedNavigation
+func (FrameRequestedNavigationEvent) EventMethod() string {
+	return "Page.frameRequestedNavigation"
+}
+
+// OnFrameRequestedNavigation calls handler for each Page.frameRequestedNavigation event
+func (c Client) OnFrameRequestedNavigation(handler FrameRequestedNavigationHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameRequestedNavigation", func(ev interface{}) {
+		handler(ev.(FrameRequestedNavigationEvent))
+	})
+}
+
+// Fired when frame has started loading.
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameStartedLoadingEvent struct {
+
+	/* Id of the frame that has started loading. */
+	FrameId FrameId `json:"frameId"`
+}
+
+// UnmarshalCDP reads FrameStartedLoadingEvent from JSON
+func (v *FrameStartedLoadingEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameStartedLoadingEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameStartedLoadingHandler func(ev FrameStartedLoadingEvent)
+
+// EventMethod is Page.frameStartedLoading
+func (FrameStartedLoadingEvent) EventMethod() string {
+	return "Page.frameStartedLoading"
+}
+
+// OnFrameStartedLoading calls handler for each Page.frameStartedLoading event
+func (c Client) OnFrameStartedLoading(handler FrameStartedLoadingHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameStartedLoading", func(ev interface{}) {
+		handler(ev.(FrameStartedLoadingEvent))
+	})
+}
+
+// Fired when frame has stopped loading.
+//
+// Experimental: this may change or be removed in any chrome release
+type FrameStoppedLoadingEvent struct {
+
+	/* Id of the frame that has stopped loading. */
+	FrameId FrameId `json:"frameId"`
+}
+
+// UnmarshalCDP reads FrameStoppedLoadingEvent from JSON
+func (v *FrameStoppedLoadingEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FrameStoppedLoadingEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FrameStoppedLoadingHandler func(ev FrameStoppedLoadingEvent)
+
+// EventMethod is Page.frameStoppedLoading
+func (FrameStoppedLoadingEvent) EventMethod() string {
+	return "Page.frameStoppedLoading"
+}
+
+// OnFrameStoppedLoading calls handler for each Page.frameStoppedLoading event
+func (c Client) OnFrameStoppedLoading(handler FrameStoppedLoadingHandler) (unsubscribe func()) {
+	return c.caller.On("Page.frameStoppedLoading", func(ev interface{}) {
+		handler(ev.(FrameStoppedLoadingEvent))
+	})
+}
+
+// Fired when page is about to start a download.
+// Deprecated. Use Browser.downloadWillBegin instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+type DownloadWillBeginEvent struct {
+
+	/* Id of the frame that caused download to begin. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Global unique identifier of the download. */
+	Guid string `json:"guid"`
+
+	/* URL of the resource being downloaded. */
+	Url string `json:"url"`
+
+	/* Suggested file name of the resource (the actual name of the file saved on disk may differ). */
+	SuggestedFilename string `json:"suggestedFilename"`
+}
+
+// UnmarshalCDP reads DownloadWillBeginEvent from JSON
+func (v *DownloadWillBeginEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "guid":
+			v.Guid = d.String()
+		case "url":
+			v.Url = d.String()
+		case "suggestedFilename":
+			v.SuggestedFilename = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DownloadWillBeginEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DownloadWillBeginHandler func(ev DownloadWillBeginEvent)
+
+// EventMethod is Page.downloadWillBegin
+func (DownloadWillBeginEvent) EventMethod() string {
+	return "Page.downloadWillBegin"
+}
+
+// OnDownloadWillBegin calls handler for each Page.downloadWillBegin event
+func (c Client) OnDownloadWillBegin(handler DownloadWillBeginHandler) (unsubscribe func()) {
+	return c.caller.On("Page.downloadWillBegin", func(ev interface{}) {
+		handler(ev.(DownloadWillBeginEvent))
+	})
+}
+
+// Fired when download makes progress. Last call has |done| == true.
+// Deprecated. Use Browser.downloadProgress instead.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+type DownloadProgressEvent struct {
+
+	/* Global unique identifier of the download. */
+	Guid string `json:"guid"`
+
+	/* Total expected bytes to download. */
+	TotalBytes float64 `json:"totalBytes"`
+
+	/* Total bytes received. */
+	ReceivedBytes float64 `json:"receivedBytes"`
+
+	/* Download status. */
+	State DownloadProgressState `json:"state"`
+}
+
+// UnmarshalCDP reads DownloadProgressEvent from JSON
+func (v *DownloadProgressEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "guid":
+			v.Guid = d.String()
+		case "totalBytes":
+			v.TotalBytes = d.Float()
+		case "receivedBytes":
+			v.ReceivedBytes = d.Float()
+		case "state":
+			v.State = DownloadProgressState(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DownloadProgressEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DownloadProgressHandler func(ev DownloadProgressEvent)
+
+// EventMethod is Page.downloadProgress
+func (DownloadProgressEvent) EventMethod() string {
+	return "Page.downloadProgress"
+}
+
+// OnDownloadProgress calls handler for each Page.downloadProgress event
+func (c Client) OnDownloadProgress(handler DownloadProgressHandler) (unsubscribe func()) {
+	return c.caller.On("Page.downloadProgress", func(ev interface{}) {
+		handler(ev.(DownloadProgressEvent))
+	})
+}
+
+// Fired for failed bfcache history navigations if BackForwardCache feature is enabled. Do
+// not assume any ordering with the Page.frameNavigated event. This event is fired only for
+// main-frame history navigation where the document changes (non-same-document navigations),
+// when bfcache navigation fails.
+//
+// Experimental: this may change or be removed in any chrome release
+type BackForwardCacheNotUsedEvent struct {
+
+	/* The loader id for the associated navigation. */
+	LoaderId cdp.NetworkLoaderId `json:"loaderId"`
+
+	/* The frame id of the associated frame. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Array of reasons why the page could not be cached. This must not be empty. */
+	NotRestoredExplanations []BackForwardCacheNotRestoredExplanation `json:"notRestoredExplanations"`
+
+	/* Tree structure of reasons why the page could not be cached for each frame. */
+	NotRestoredExplanationsTree *BackForwardCacheNotRestoredExplanationTree `json:"notRestoredExplanationsTree,omitempty"`
+}
+
+// UnmarshalCDP reads BackForwardCacheNotUsedEvent from JSON
+func (v *BackForwardCacheNotUsedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "notRestoredExplanations":
+			if d.Array() {
+				v.NotRestoredExplanations = make([]BackForwardCacheNotRestoredExplanation, 0)
+				for d.More() {
+					var x0 BackForwardCacheNotRestoredExplanation
+					x0.UnmarshalCDP(d)
+					v.NotRestoredExplanations = append(v.NotRestoredExplanations, x0)
+				}
+			} else {
+				v.NotRestoredExplanations = nil
+			}
+		case "notRestoredExplanationsTree":
+			if d.Null() {
+				v.NotRestoredExplanationsTree = nil
+			} else {
+				v.NotRestoredExplanationsTree = new(BackForwardCacheNotRestoredExplanationTree)
+				(*v.NotRestoredExplanationsTree).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BackForwardCacheNotUsedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BackForwardCacheNotUsedHandler func(ev BackForwardCacheNotUsedEvent)
+
+// EventMethod is Page.backForwardCacheNotUsed
+func (BackForwardCacheNotUsedEvent) EventMethod() string {
+	return "Page.backForwardCacheNotUsed"
+}
+
+// OnBackForwardCacheNotUsed calls handler for each Page.backForwardCacheNotUsed event
+func (c Client) OnBackForwardCacheNotUsed(handler BackForwardCacheNotUsedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.backForwardCacheNotUsed", func(ev interface{}) {
+		handler(ev.(BackForwardCacheNotUsedEvent))
+	})
+}
+
+// Fired when same-document navigation happens, e.g. due to history API usage or anchor navigation.
+//
+// Experimental: this may change or be removed in any chrome release
+type NavigatedWithinDocumentEvent struct {
+
+	/* Id of the frame. */
+	FrameId FrameId `json:"frameId"`
+
+	/* Frame's new url. */
+	Url string `json:"url"`
+
+	/* Navigation type */
+	NavigationType NavigatedWithinDocumentNavigationType `json:"navigationType"`
+}
+
+// UnmarshalCDP reads NavigatedWithinDocumentEvent from JSON
+func (v *NavigatedWithinDocumentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = FrameId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "navigationType":
+			v.NavigationType = NavigatedWithinDocumentNavigationType(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NavigatedWithinDocumentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NavigatedWithinDocumentHandler func(ev NavigatedWithinDocumentEvent)
+
+// EventMethod is Page.navigatedWithinDocument
+func (NavigatedWithinDocumentEvent) EventMethod() string {
+	return "Page.navigatedWithinDocument"
+}
+
+// OnNavigatedWithinDocument calls handler for each Page.navigatedWithinDocument event
+func (c Client) OnNavigatedWithinDocument(handler NavigatedWithinDocumentHandler) (unsubscribe func()) {
+	return c.caller.On("Page.navigatedWithinDocument", func(ev interface{}) {
+		handler(ev.(NavigatedWithinDocumentEvent))
+	})
+}
+
+// Compressed image data requested by the `startScreencast`.
+//
+// Experimental: this may change or be removed in any chrome release
+type ScreencastFrameEvent struct {
+
+	/* Base64-encoded compressed image. (Encoded as a base64 string when passed over JSON) */
+	Data string `json:"data"`
+
+	/* Screencast frame metadata. */
+	Metadata ScreencastFrameMetadata `json:"metadata"`
+
+	/* Frame number. */
+	SessionId int `json:"sessionId"`
+}
+
+// UnmarshalCDP reads ScreencastFrameEvent from JSON
+func (v *ScreencastFrameEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = d.String()
+		case "metadata":
+			v.Metadata.UnmarshalCDP(d)
+		case "sessionId":
+			v.SessionId = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScreencastFrameEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScreencastFrameHandler func(ev ScreencastFrameEvent)
+
+// EventMethod is Page.screencastFrame
+func (ScreencastFrameEvent) EventMethod() string {
+	return "Page.screencastFrame"
+}
+
+// OnScreencastFrame calls handler for each Page.screencastFrame event
+func (c Client) OnScreencastFrame(handler ScreencastFrameHandler) (unsubscribe func()) {
+	return c.caller.On("Page.screencastFrame", func(ev interface{}) {
+		handler(ev.(ScreencastFrameEvent))
+	})
+}
+
+// Fired when the page with currently enabled screencast was shown or hidden `.
+//
+// Experimental: this may change or be removed in any chrome release
+type ScreencastVisibilityChangedEvent struct {
+
+	/* True if the page is visible. */
+	Visible bool `json:"visible"`
+}
+
+// UnmarshalCDP reads ScreencastVisibilityChangedEvent from JSON
+func (v *ScreencastVisibilityChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "visible":
+			v.Visible = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScreencastVisibilityChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ScreencastVisibilityChangedHandler func(ev ScreencastVisibilityChangedEvent)
+
+// EventMethod is Page.screencastVisibilityChanged
+func (ScreencastVisibilityChangedEvent) EventMethod() string {
+	return "Page.screencastVisibilityChanged"
+}
+
+// OnScreencastVisibilityChanged calls handler for each Page.screencastVisibilityChanged event
+func (c Client) OnScreencastVisibilityChanged(handler ScreencastVisibilityChangedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.screencastVisibilityChanged", func(ev interface{}) {
+		handler(ev.(ScreencastVisibilityChangedEvent))
+	})
+}
+
+// Issued for every compilation cache generated.
+//
+// Experimental: this may change or be removed in any chrome release
+type CompilationCacheProducedEvent struct {
+	Url string `json:"url"`
+
+	/* Base64-encoded data (Encoded as a base64 string when passed over JSON) */
+	Data string `json:"data"`
+}
+
+// UnmarshalCDP reads CompilationCacheProducedEvent from JSON
+func (v *CompilationCacheProducedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "data":
+			v.Data = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CompilationCacheProducedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CompilationCacheProducedHandler func(ev CompilationCacheProducedEvent)
+
+// EventMethod is Page.compilationCacheProduced
+func (CompilationCacheProducedEvent) EventMethod() string {
+	return "Page.compilationCacheProduced"
+}
+
+// OnCompilationCacheProduced calls handler for each Page.compilationCacheProduced event
+func (c Client) OnCompilationCacheProduced(handler CompilationCacheProducedHandler) (unsubscribe func()) {
+	return c.caller.On("Page.compilationCacheProduced", func(ev interface{}) {
+		handler(ev.(CompilationCacheProducedEvent))
+	})
+}
+
+func init() {
+	decoders["Page.frameSubtreeWillBeDetached"] = cdp.Decode[FrameSubtreeWillBeDetachedEvent]
+	decoders["Page.documentOpened"] = cdp.Decode[DocumentOpenedEvent]
+	decoders["Page.frameResized"] = cdp.Decode[FrameResizedEvent]
+	decoders["Page.frameStartedNavigating"] = cdp.Decode[FrameStartedNavigatingEvent]
+	decoders["Page.frameRequestedNavigation"] = cdp.Decode[FrameRequestedNavigationEvent]
+	decoders["Page.frameStartedLoading"] = cdp.Decode[FrameStartedLoadingEvent]
+	decoders["Page.frameStoppedLoading"] = cdp.Decode[FrameStoppedLoadingEvent]
+	decoders["Page.downloadWillBegin"] = cdp.Decode[DownloadWillBeginEvent]
+	decoders["Page.downloadProgress"] = cdp.Decode[DownloadProgressEvent]
+	decoders["Page.backForwardCacheNotUsed"] = cdp.Decode[BackForwardCacheNotUsedEvent]
+	decoders["Page.navigatedWithinDocument"] = cdp.Decode[NavigatedWithinDocumentEvent]
+	decoders["Page.screencastFrame"] = cdp.Decode[ScreencastFrameEvent]
+	decoders["Page.screencastVisibilityChanged"] = cdp.Decode[ScreencastVisibilityChangedEvent]
+	decoders["Page.compilationCacheProduced"] = cdp.Decode[CompilationCacheProducedEvent]
+}
@@ -0,0 +1,82 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package performance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type SetTimeDomainReturns struct {
+}
+
+// UnmarshalCDP reads SetTimeDomainReturns from JSON
+func (v *SetTimeDomainReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetTimeDomainReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetTimeDomainParams are the parameters for Performance.setTimeDomain
+// optional parameters are left out when nil
+type SetTimeDomainParams struct {
+	/* Time domain */
+	TimeDomain SetTimeDomainTimeDomain `json:"timeDomain"`
+}
+
+// MarshalCDP writes SetTimeDomainParams as JSON
+func (v *SetTimeDomainParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("timeDomain")
+	e.String(string(v.TimeDomain))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetTimeDomainParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets time domain to use for collecting and reporting duration metrics.
+// Note that this must be called before enabling metrics collection. Calling
+// this method while metrics collection is enabled returns an error.
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetTimeDomain(params SetTimeDomainParams) (SetTimeDomainReturns, error) {
+	return c.SetTimeDomainContext(context.Background(), params)
+}
+
+// SetTimeDomainContext is SetTimeDomain with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetTimeDomainContext(ctx context.Context, params SetTimeDomainParams) (SetTimeDomainReturns, error) {
+	var returns_ SetTimeDomainReturns
+
+	if !params.TimeDomain.Valid() {
+		return returns_, fmt.Errorf("Performance.setTimeDomain: invalid timeDomain %q", params.TimeDomain)
+	}
+
+	err_ := c.caller.Call(ctx, "Performance.setTimeDomain", &params, &returns_)
+
+	return returns_, err_
+}
@@ -0,0 +1,461 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package performancetimeline is the PerformanceTimeline domain of the chrome devtools protocol
+//
+// Reporting of performance timeline events, as specified in
+// https://w3c.github.io/performance-timeline/#dom-performanceobserver.
+//
+// Experimental: this may change or be removed in any chrome release
+package performancetimeline
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends PerformanceTimeline commands and listens for PerformanceTimeline events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// See https://github.com/WICG/LargestContentfulPaint and largest_contentful_paint.idl
+type LargestContentfulPaint struct {
+	RenderTime cdp.NetworkTimeSinceEpoch `json:"renderTime"`
+	LoadTime   cdp.NetworkTimeSinceEpoch `json:"loadTime"`
+	/* The number of pixels being painted. */
+	Size float64 `json:"size"`
+	/* The id attribute of the element, if available. */
+	ElementId *string `json:"elementId,omitempty"`
+	/* The URL of the image (may be trimmed). */
+	Url    *string               `json:"url,omitempty"`
+	NodeId *cdp.DOMBackendNodeId `json:"nodeId,omitempty"`
+}
+
+// MarshalCDP writes LargestContentfulPaint as JSON
+func (v *LargestContentfulPaint) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("renderTime")
+	e.Float(float64(v.RenderTime))
+	e.Key("loadTime")
+	e.Float(float64(v.LoadTime))
+	e.Key("size")
+	e.Float(v.Size)
+	if v.ElementId != nil {
+		e.Key("elementId")
+		e.String(*v.ElementId)
+	}
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LargestContentfulPaint) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LargestContentfulPaint from JSON
+func (v *LargestContentfulPaint) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "renderTime":
+			v.RenderTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "loadTime":
+			v.LoadTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "size":
+			v.Size = d.Float()
+		case "elementId":
+			if d.Null() {
+				v.ElementId = nil
+			} else {
+				v.ElementId = new(string)
+				*v.ElementId = d.String()
+			}
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "nodeId":
+			if d.Null() {
+				v.NodeId = nil
+			} else {
+				v.NodeId = new(cdp.DOMBackendNodeId)
+				*v.NodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LargestContentfulPaint) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type LayoutShiftAttribution struct {
+	PreviousRect cdp.DOMRect           `json:"previousRect"`
+	CurrentRect  cdp.DOMRect           `json:"currentRect"`
+	NodeId       *cdp.DOMBackendNodeId `json:"nodeId,omitempty"`
+}
+
+// MarshalCDP writes LayoutShiftAttribution as JSON
+func (v *LayoutShiftAttribution) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("previousRect")
+	v.PreviousRect.MarshalCDP(e)
+	e.Key("currentRect")
+	v.CurrentRect.MarshalCDP(e)
+	if v.NodeId != nil {
+		e.Key("nodeId")
+		e.Int(int(*v.NodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LayoutShiftAttribution) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LayoutShiftAttribution from JSON
+func (v *LayoutShiftAttribution) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "previousRect":
+			v.PreviousRect.UnmarshalCDP(d)
+		case "currentRect":
+			v.CurrentRect.UnmarshalCDP(d)
+		case "nodeId":
+			if d.Null() {
+				v.NodeId = nil
+			} else {
+				v.NodeId = new(cdp.DOMBackendNodeId)
+				*v.NodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayoutShiftAttribution) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// See https://wicg.github.io/layout-instability/#sec-layout-shift and layout_shift.idl
+type LayoutShift struct {
+	/* Score increment produced by this event. */
+	Value          float64                   `json:"value"`
+	HadRecentInput bool                      `json:"hadRecentInput"`
+	LastInputTime  cdp.NetworkTimeSinceEpoch `json:"lastInputTime"`
+	Sources        []LayoutShiftAttribution  `json:"sources"`
+}
+
+// MarshalCDP writes LayoutShift as JSON
+func (v *LayoutShift) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("value")
+	e.Float(v.Value)
+	e.Key("hadRecentInput")
+	e.Bool(v.HadRecentInput)
+	e.Key("lastInputTime")
+	e.Float(float64(v.LastInputTime))
+	e.Key("sources")
+	if v.Sources == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Sources {
+			e.Elem()
+			v.Sources[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LayoutShift) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads LayoutShift from JSON
+func (v *LayoutShift) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			v.Value = d.Float()
+		case "hadRecentInput":
+			v.HadRecentInput = d.Bool()
+		case "lastInputTime":
+			v.LastInputTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "sources":
+			if d.Array() {
+				v.Sources = make([]LayoutShiftAttribution, 0)
+				for d.More() {
+					var x0 LayoutShiftAttribution
+					x0.UnmarshalCDP(d)
+					v.Sources = append(v.Sources, x0)
+				}
+			} else {
+				v.Sources = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LayoutShift) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TimelineEvent struct {
+	/* Identifies the frame that this event is related to. Empty for non-frame targets. */
+	FrameId cdp.PageFrameId `json:"frameId"`
+	/* The event type, as specified in https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	This determines which of the optional "details" fields is present. */
+	Type string `json:"type"`
+	/* Name may be empty depending on the type. */
+	Name string `json:"name"`
+	/* Time in seconds since Epoch, monotonically increasing within document lifetime. */
+	Time cdp.NetworkTimeSinceEpoch `json:"time"`
+	/* Event duration, if applicable. */
+	Duration           *float64                `json:"duration,omitempty"`
+	LcpDetails         *LargestContentfulPaint `json:"lcpDetails,omitempty"`
+	LayoutShiftDetails *LayoutShift            `json:"layoutShiftDetails,omitempty"`
+}
+
+// MarshalCDP writes TimelineEvent as JSON
+func (v *TimelineEvent) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.Key("type")
+	e.String(v.Type)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("time")
+	e.Float(float64(v.Time))
+	if v.Duration != nil {
+		e.Key("duration")
+		e.Float(*v.Duration)
+	}
+	if v.LcpDetails != nil {
+		e.Key("lcpDetails")
+		(*v.LcpDetails).MarshalCDP(e)
+	}
+	if v.LayoutShiftDetails != nil {
+		e.Key("layoutShiftDetails")
+		(*v.LayoutShiftDetails).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TimelineEvent) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads TimelineEvent from JSON
+func (v *TimelineEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "frameId":
+			v.FrameId = cdp.PageFrameId(d.String())
+		case "type":
+			v.Type = d.String()
+		case "name":
+			v.Name = d.String()
+		case "time":
+			v.Time = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "duration":
+			if d.Null() {
+				v.Duration = nil
+			} else {
+				v.Duration = new(float64)
+				*v.Duration = d.Float()
+			}
+		case "lcpDetails":
+			if d.Null() {
+				v.LcpDetails = nil
+			} else {
+				v.LcpDetails = new(LargestContentfulPaint)
+				(*v.LcpDetails).UnmarshalCDP(d)
+			}
+		case "layoutShiftDetails":
+			if d.Null() {
+				v.LayoutShiftDetails = nil
+			} else {
+				v.LayoutShiftDetails = new(LayoutShift)
+				(*v.LayoutShiftDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TimelineEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for PerformanceTimeline.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* The types of event to report, as specified in
+	https://w3c.github.io/performance-timeline/#dom-performanceentry-entrytype
+	The specified filter overrides any previous filters, passing empty
+	filter disables recording.
+	Note that not all types exposed to the web platform are currently supported. */
+	EventTypes []string `json:"eventTypes"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventTypes")
+	if v.EventTypes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.EventTypes {
+			e.Elem()
+			e.String(v.EventTypes[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Previously buffered events would be reported before method returns.
+// See also: timelineEventAdded
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "PerformanceTimeline.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Sent when a performance timeline event is added. See reportPerformanceTimeline method.
+type TimelineEventAddedEvent struct {
+	Event TimelineEvent `json:"event"`
+}
+
+// UnmarshalCDP reads TimelineEventAddedEvent from JSON
+func (v *TimelineEventAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "event":
+			v.Event.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TimelineEventAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TimelineEventAddedHandler func(ev TimelineEventAddedEvent)
+
+// EventMethod is PerformanceTimeline.timelineEventAdded
+func (TimelineEventAddedEvent) EventMethod() string {
+	return "PerformanceTimeline.timelineEventAdded"
+}
+
+// OnTimelineEventAdded calls handler for each PerformanceTimeline.timelineEventAdded event
+func (c Client) OnTimelineEventAdded(handler TimelineEventAddedHandler) (unsubscribe func()) {
+	return c.caller.On("PerformanceTimeline.timelineEventAdded", func(ev interface{}) {
+		handler(ev.(TimelineEventAddedEvent))
+	})
+}
+
+// decoders for PerformanceTimeline events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"PerformanceTimeline.timelineEventAdded": cdp.Decode[TimelineEventAddedEvent],
+}
+
+// DecodeEvent decodes a PerformanceTimeline event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,1180 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package preload is the Preload domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package preload
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Preload commands and listens for Preload events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Unique id
+type RuleSetId string
+
+// Corresponds to SpeculationRuleSet
+type RuleSet struct {
+	Id RuleSetId `json:"id"`
+	/* Identifies a document which the rule set is associated with. */
+	LoaderId cdp.NetworkLoaderId `json:"loaderId"`
+	/* Source text of JSON representing the rule set. If it comes from
+	`<script>` tag, it is the textContent of the node. Note that it is
+	a JSON for valid case.
+
+	See also:
+	- https://wicg.github.io/nav-speculation/speculation-rules.html
+	- https://github.com/WICG/nav-speculation/blob/main/triggers.md */
+	SourceText string `json:"sourceText"`
+	/* A speculation rule set is either added through an inline
+	`<script>` tag or through an external resource via the
+	'Speculation-Rules' HTTP header. For the first case, we include
+	the BackendNodeId of the relevant `<script>` tag. For the second
+	case, we include the external URL where the rule set was loaded
+	from, and also RequestId if Network domain is enabled.
+
+	See also:
+	- https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-script
+	- https://wicg.github.io/nav-speculation/speculation-rules.html#speculation-rules-header */
+	BackendNodeId *cdp.DOMBackendNodeId `json:"backendNodeId,omitempty"`
+	Url           *string               `json:"url,omitempty"`
+	RequestId     *cdp.NetworkRequestId `json:"requestId,omitempty"`
+	/* Error information
+	`errorMessage` is null iff `errorType` is null. */
+	ErrorType *RuleSetErrorType `json:"errorType,omitempty"`
+	/* TODO(https://crbug.com/1425354): Replace this property with structured error.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+}
+
+// MarshalCDP writes RuleSet as JSON
+func (v *RuleSet) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(string(v.Id))
+	e.Key("loaderId")
+	e.String(string(v.LoaderId))
+	e.Key("sourceText")
+	e.String(v.SourceText)
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.RequestId != nil {
+		e.Key("requestId")
+		e.String(string(*v.RequestId))
+	}
+	if v.ErrorType != nil {
+		e.Key("errorType")
+		e.String(string(*v.ErrorType))
+	}
+	if v.ErrorMessage != nil {
+		e.Key("errorMessage")
+		e.String(*v.ErrorMessage)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuleSet) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RuleSet from JSON
+func (v *RuleSet) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = RuleSetId(d.String())
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "sourceText":
+			v.SourceText = d.String()
+		case "backendNodeId":
+			if d.Null() {
+				v.BackendNodeId = nil
+			} else {
+				v.BackendNodeId = new(cdp.DOMBackendNodeId)
+				*v.BackendNodeId = cdp.DOMBackendNodeId(d.Int())
+			}
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "requestId":
+			if d.Null() {
+				v.RequestId = nil
+			} else {
+				v.RequestId = new(cdp.NetworkRequestId)
+				*v.RequestId = cdp.NetworkRequestId(d.String())
+			}
+		case "errorType":
+			if d.Null() {
+				v.ErrorType = nil
+			} else {
+				v.ErrorType = new(RuleSetErrorType)
+				*v.ErrorType = RuleSetErrorType(d.String())
+			}
+		case "errorMessage":
+			if d.Null() {
+				v.ErrorMessage = nil
+			} else {
+				v.ErrorMessage = new(string)
+				*v.ErrorMessage = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuleSet) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RuleSetErrorType string
+
+// RuleSetErrorType values
+const (
+	RuleSetErrorTypeSourceIsNotJsonObject  RuleSetErrorType = "SourceIsNotJsonObject"
+	RuleSetErrorTypeInvalidRulesSkipped    RuleSetErrorType = "InvalidRulesSkipped"
+	RuleSetErrorTypeInvalidRulesetLevelTag RuleSetErrorType = "InvalidRulesetLevelTag"
+)
+
+// Values gives every RuleSetErrorType
+func (RuleSetErrorType) Values() []RuleSetErrorType {
+	return []RuleSetErrorType{
+		RuleSetErrorTypeSourceIsNotJsonObject,
+		RuleSetErrorTypeInvalidRulesSkipped,
+		RuleSetErrorTypeInvalidRulesetLevelTag,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuleSetErrorType) Valid() bool {
+	switch v {
+	case RuleSetErrorTypeSourceIsNotJsonObject, RuleSetErrorTypeInvalidRulesSkipped, RuleSetErrorTypeInvalidRulesetLevelTag:
+		return true
+	}
+	return false
+}
+
+// The type of preloading attempted. It corresponds to
+// mojom::SpeculationAction (although PrefetchWithSubresources is omitted as it
+// isn't being used by clients).
+type SpeculationAction string
+
+// SpeculationAction values
+const (
+	SpeculationActionPrefetch  SpeculationAction = "Prefetch"
+	SpeculationActionPrerender SpeculationAction = "Prerender"
+)
+
+// Values gives every SpeculationAction
+func (SpeculationAction) Values() []SpeculationAction {
+	return []SpeculationAction{
+		SpeculationActionPrefetch,
+		SpeculationActionPrerender,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SpeculationAction) Valid() bool {
+	switch v {
+	case SpeculationActionPrefetch, SpeculationActionPrerender:
+		return true
+	}
+	return false
+}
+
+// Corresponds to mojom::SpeculationTargetHint.
+// See https://github.com/WICG/nav-speculation/blob/main/triggers.md#window-name-targeting-hints
+type SpeculationTargetHint string
+
+// SpeculationTargetHint values
+const (
+	SpeculationTargetHintBlank SpeculationTargetHint = "Blank"
+	SpeculationTargetHintSelf  SpeculationTargetHint = "Self"
+)
+
+// Values gives every SpeculationTargetHint
+func (SpeculationTargetHint) Values() []SpeculationTargetHint {
+	return []SpeculationTargetHint{
+		SpeculationTargetHintBlank,
+		SpeculationTargetHintSelf,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SpeculationTargetHint) Valid() bool {
+	switch v {
+	case SpeculationTargetHintBlank, SpeculationTargetHintSelf:
+		return true
+	}
+	return false
+}
+
+// A key that identifies a preloading attempt.
+//
+// The url used is the url specified by the trigger (i.e. the initial URL), and
+// not the final url that is navigated to. For example, prerendering allows
+// same-origin main frame navigations during the attempt, but the attempt is
+// still keyed with the initial URL.
+type PreloadingAttemptKey struct {
+	LoaderId   cdp.NetworkLoaderId    `json:"loaderId"`
+	Action     SpeculationAction      `json:"action"`
+	Url        string                 `json:"url"`
+	TargetHint *SpeculationTargetHint `json:"targetHint,omitempty"`
+}
+
+// MarshalCDP writes PreloadingAttemptKey as JSON
+func (v *PreloadingAttemptKey) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("loaderId")
+	e.String(string(v.LoaderId))
+	e.Key("action")
+	e.String(string(v.Action))
+	e.Key("url")
+	e.String(v.Url)
+	if v.TargetHint != nil {
+		e.Key("targetHint")
+		e.String(string(*v.TargetHint))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PreloadingAttemptKey) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PreloadingAttemptKey from JSON
+func (v *PreloadingAttemptKey) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "action":
+			v.Action = SpeculationAction(d.String())
+		case "url":
+			v.Url = d.String()
+		case "targetHint":
+			if d.Null() {
+				v.TargetHint = nil
+			} else {
+				v.TargetHint = new(SpeculationTargetHint)
+				*v.TargetHint = SpeculationTargetHint(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PreloadingAttemptKey) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Lists sources for a preloading attempt, specifically the ids of rule sets
+// that had a speculation rule that triggered the attempt, and the
+// BackendNodeIds of <a href> or <area href> elements that triggered the
+// attempt (in the case of attempts triggered by a document rule). It is
+// possible for multiple rule sets and links to trigger a single attempt.
+type PreloadingAttemptSource struct {
+	Key        PreloadingAttemptKey   `json:"key"`
+	RuleSetIds []RuleSetId            `json:"ruleSetIds"`
+	NodeIds    []cdp.DOMBackendNodeId `json:"nodeIds"`
+}
+
+// MarshalCDP writes PreloadingAttemptSource as JSON
+func (v *PreloadingAttemptSource) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	v.Key.MarshalCDP(e)
+	e.Key("ruleSetIds")
+	if v.RuleSetIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.RuleSetIds {
+			e.Elem()
+			e.String(string(v.RuleSetIds[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.Key("nodeIds")
+	if v.NodeIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NodeIds {
+			e.Elem()
+			e.Int(int(v.NodeIds[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PreloadingAttemptSource) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PreloadingAttemptSource from JSON
+func (v *PreloadingAttemptSource) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key.UnmarshalCDP(d)
+		case "ruleSetIds":
+			if d.Array() {
+				v.RuleSetIds = make([]RuleSetId, 0)
+				for d.More() {
+					var x0 RuleSetId
+					x0 = RuleSetId(d.String())
+					v.RuleSetIds = append(v.RuleSetIds, x0)
+				}
+			} else {
+				v.RuleSetIds = nil
+			}
+		case "nodeIds":
+			if d.Array() {
+				v.NodeIds = make([]cdp.DOMBackendNodeId, 0)
+				for d.More() {
+					var x0 cdp.DOMBackendNodeId
+					x0 = cdp.DOMBackendNodeId(d.Int())
+					v.NodeIds = append(v.NodeIds, x0)
+				}
+			} else {
+				v.NodeIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PreloadingAttemptSource) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Chrome manages different types of preloads together using a
+// concept of preloading pipeline. For example, if a site uses a
+// SpeculationRules for prerender, Chrome first starts a prefetch and
+// then upgrades it to prerender.
+//
+// CDP events for them are emitted separately but they share
+// `PreloadPipelineId`.
+type PreloadPipelineId string
+
+// List of FinalStatus reasons for Prerender2.
+type PrerenderFinalStatus string
+
+// PrerenderFinalStatus values
+const (
+	PrerenderFinalStatusActivated                                                  PrerenderFinalStatus = "Activated"
+	PrerenderFinalStatusDestroyed                                                  PrerenderFinalStatus = "Destroyed"
+	PrerenderFinalStatusLowEndDevice                                               PrerenderFinalStatus = "LowEndDevice"
+	PrerenderFinalStatusInvalidSchemeRedirect                                      PrerenderFinalStatus = "InvalidSchemeRedirect"
+	PrerenderFinalStatusInvalidSchemeNavigation                                    PrerenderFinalStatus = "InvalidSchemeNavigation"
+	PrerenderFinalStatusNavigationRequestBlockedByCsp                              PrerenderFinalStatus = "NavigationRequestBlockedByCsp"
+	PrerenderFinalStatusMojoBinderPolicy                                           PrerenderFinalStatus = "MojoBinderPolicy"
+	PrerenderFinalStatusRendererProcessCrashed                                     PrerenderFinalStatus = "RendererProcessCrashed"
+	PrerenderFinalStatusRendererProcessKilled                                      PrerenderFinalStatus = "RendererProcessKilled"
+	PrerenderFinalStatusDownload                                                   PrerenderFinalStatus = "Download"
+	PrerenderFinalStatusTriggerDestroyed                                           PrerenderFinalStatus = "TriggerDestroyed"
+	PrerenderFinalStatusNavigationNotCommitted                                     PrerenderFinalStatus = "NavigationNotCommitted"
+	PrerenderFinalStatusNavigationBadHttpStatus                                    PrerenderFinalStatus = "NavigationBadHttpStatus"
+	PrerenderFinalStatusClientCertRequested                                        PrerenderFinalStatus = "ClientCertRequested"
+	PrerenderFinalStatusNavigationRequestNetworkError                              PrerenderFinalStatus = "NavigationRequestNetworkError"
+	PrerenderFinalStatusCancelAllHostsForTesting                                   PrerenderFinalStatus = "CancelAllHostsForTesting"
+	PrerenderFinalStatusDidFailLoad                                                PrerenderFinalStatus = "DidFailLoad"
+	PrerenderFinalStatusStop                                                       PrerenderFinalStatus = "Stop"
+	PrerenderFinalStatusSslCertificateError                                        PrerenderFinalStatus = "SslCertificateError"
+	PrerenderFinalStatusLoginAuthRequested                                         PrerenderFinalStatus = "LoginAuthRequested"
+	PrerenderFinalStatusUaChangeRequiresReload                                     PrerenderFinalStatus = "UaChangeRequiresReload"
+	PrerenderFinalStatusBlockedByClient                                            PrerenderFinalStatus = "BlockedByClient"
+	PrerenderFinalStatusAudioOutputDeviceRequested                                 PrerenderFinalStatus = "AudioOutputDeviceRequested"
+	PrerenderFinalStatusMixedContent                                               PrerenderFinalStatus = "MixedContent"
+	PrerenderFinalStatusTriggerBackgrounded                                        PrerenderFinalStatus = "TriggerBackgrounded"
+	PrerenderFinalStatusMemoryLimitExceeded                                        PrerenderFinalStatus = "MemoryLimitExceeded"
+	PrerenderFinalStatusDataSaverEnabled                                           PrerenderFinalStatus = "DataSaverEnabled"
+	PrerenderFinalStatusTriggerUrlHasEffectiveUrl                                  PrerenderFinalStatus = "TriggerUrlHasEffectiveUrl"
+	PrerenderFinalStatusActivatedBeforeStarted                                     PrerenderFinalStatus = "ActivatedBeforeStarted"
+	PrerenderFinalStatusInactivePageRestriction                                    PrerenderFinalStatus = "InactivePageRestriction"
+	PrerenderFinalStatusStartFailed                                                PrerenderFinalStatus = "StartFailed"
+	PrerenderFinalStatusTimeoutBackgrounded                                        PrerenderFinalStatus = "TimeoutBackgrounded"
+	PrerenderFinalStatusCrossSiteRedirectInInitialNavigation                       PrerenderFinalStatus = "CrossSiteRedirectInInitialNavigation"
+	PrerenderFinalStatusCrossSiteNavigationInInitialNavigation                     PrerenderFinalStatus = "CrossSiteNavigationInInitialNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation     PrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInInitialNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation   PrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInInitialNavigation"
+	PrerenderFinalStatusActivationNavigationParameterMismatch                      PrerenderFinalStatus = "ActivationNavigationParameterMismatch"
+	PrerenderFinalStatusActivatedInBackground                                      PrerenderFinalStatus = "ActivatedInBackground"
+	PrerenderFinalStatusEmbedderHostDisallowed                                     PrerenderFinalStatus = "EmbedderHostDisallowed"
+	PrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess                 PrerenderFinalStatus = "ActivationNavigationDestroyedBeforeSuccess"
+	PrerenderFinalStatusTabClosedByUserGesture                                     PrerenderFinalStatus = "TabClosedByUserGesture"
+	PrerenderFinalStatusTabClosedWithoutUserGesture                                PrerenderFinalStatus = "TabClosedWithoutUserGesture"
+	PrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed                     PrerenderFinalStatus = "PrimaryMainFrameRendererProcessCrashed"
+	PrerenderFinalStatusPrimaryMainFrameRendererProcessKilled                      PrerenderFinalStatus = "PrimaryMainFrameRendererProcessKilled"
+	PrerenderFinalStatusActivationFramePolicyNotCompatible                         PrerenderFinalStatus = "ActivationFramePolicyNotCompatible"
+	PrerenderFinalStatusPreloadingDisabled                                         PrerenderFinalStatus = "PreloadingDisabled"
+	PrerenderFinalStatusBatterySaverEnabled                                        PrerenderFinalStatus = "BatterySaverEnabled"
+	PrerenderFinalStatusActivatedDuringMainFrameNavigation                         PrerenderFinalStatus = "ActivatedDuringMainFrameNavigation"
+	PrerenderFinalStatusPreloadingUnsupportedByWebContents                         PrerenderFinalStatus = "PreloadingUnsupportedByWebContents"
+	PrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation                     PrerenderFinalStatus = "CrossSiteRedirectInMainFrameNavigation"
+	PrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation                   PrerenderFinalStatus = "CrossSiteNavigationInMainFrameNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation   PrerenderFinalStatus = "SameSiteCrossOriginRedirectNotOptInInMainFrameNavigation"
+	PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation PrerenderFinalStatus = "SameSiteCrossOriginNavigationNotOptInInMainFrameNavigation"
+	PrerenderFinalStatusMemoryPressureOnTrigger                                    PrerenderFinalStatus = "MemoryPressureOnTrigger"
+	PrerenderFinalStatusMemoryPressureAfterTriggered                               PrerenderFinalStatus = "MemoryPressureAfterTriggered"
+	PrerenderFinalStatusPrerenderingDisabledByDevTools                             PrerenderFinalStatus = "PrerenderingDisabledByDevTools"
+	PrerenderFinalStatusSpeculationRuleRemoved                                     PrerenderFinalStatus = "SpeculationRuleRemoved"
+	PrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts                     PrerenderFinalStatus = "ActivatedWithAuxiliaryBrowsingContexts"
+	PrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded                     PrerenderFinalStatus = "MaxNumOfRunningEagerPrerendersExceeded"
+	PrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded                  PrerenderFinalStatus = "MaxNumOfRunningNonEagerPrerendersExceeded"
+	PrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded                  PrerenderFinalStatus = "MaxNumOfRunningEmbedderPrerendersExceeded"
+	PrerenderFinalStatusPrerenderingUrlHasEffectiveUrl                             PrerenderFinalStatus = "PrerenderingUrlHasEffectiveUrl"
+	PrerenderFinalStatusRedirectedPrerenderingUrlHasEffectiveUrl                   PrerenderFinalStatus = "RedirectedPrerenderingUrlHasEffectiveUrl"
+	PrerenderFinalStatusActivationUrlHasEffectiveUrl                               PrerenderFinalStatus = "ActivationUrlHasEffectiveUrl"
+	PrerenderFinalStatusJavaScriptInterfaceAdded                                   PrerenderFinalStatus = "JavaScriptInterfaceAdded"
+	PrerenderFinalStatusJavaScriptInterfaceRemoved                                 PrerenderFinalStatus = "JavaScriptInterfaceRemoved"
+	PrerenderFinalStatusAllPrerenderingCanceled                                    PrerenderFinalStatus = "AllPrerenderingCanceled"
+	PrerenderFinalStatusWindowClosed                                               PrerenderFinalStatus = "WindowClosed"
+	PrerenderFinalStatusSlowNetwork                                                PrerenderFinalStatus = "SlowNetwork"
+	PrerenderFinalStatusOtherPrerenderedPageActivated                              PrerenderFinalStatus = "OtherPrerenderedPageActivated"
+	PrerenderFinalStatusV8OptimizerDisabled                                        PrerenderFinalStatus = "V8OptimizerDisabled"
+	PrerenderFinalStatusPrerenderFailedDuringPrefetch                              PrerenderFinalStatus = "PrerenderFailedDuringPrefetch"
+	PrerenderFinalStatusBrowsingDataRemoved                                        PrerenderFinalStatus = "BrowsingDataRemoved"
+	PrerenderFinalStatusPrerenderHostReused                                        PrerenderFinalStatus = "PrerenderHostReused"
+)
+
+// Values gives every PrerenderFinalStatus
+func (PrerenderFinalStatus) Values() []PrerenderFinalStatus {
+	return []PrerenderFinalStatus{
+		PrerenderFinalStatusActivated,
+		PrerenderFinalStatusDestroyed,
+		PrerenderFinalStatusLowEndDevice,
+		PrerenderFinalStatusInvalidSchemeRedirect,
+		PrerenderFinalStatusInvalidSchemeNavigation,
+		PrerenderFinalStatusNavigationRequestBlockedByCsp,
+		PrerenderFinalStatusMojoBinderPolicy,
+		PrerenderFinalStatusRendererProcessCrashed,
+		PrerenderFinalStatusRendererProcessKilled,
+		PrerenderFinalStatusDownload,
+		PrerenderFinalStatusTriggerDestroyed,
+		PrerenderFinalStatusNavigationNotCommitted,
+		PrerenderFinalStatusNavigationBadHttpStatus,
+		PrerenderFinalStatusClientCertRequested,
+		PrerenderFinalStatusNavigationRequestNetworkError,
+		PrerenderFinalStatusCancelAllHostsForTesting,
+		PrerenderFinalStatusDidFailLoad,
+		PrerenderFinalStatusStop,
+		PrerenderFinalStatusSslCertificateError,
+		PrerenderFinalStatusLoginAuthRequested,
+		PrerenderFinalStatusUaChangeRequiresReload,
+		PrerenderFinalStatusBlockedByClient,
+		PrerenderFinalStatusAudioOutputDeviceRequested,
+		PrerenderFinalStatusMixedContent,
+		PrerenderFinalStatusTriggerBackgrounded,
+		PrerenderFinalStatusMemoryLimitExceeded,
+		PrerenderFinalStatusDataSaverEnabled,
+		PrerenderFinalStatusTriggerUrlHasEffectiveUrl,
+		PrerenderFinalStatusActivatedBeforeStarted,
+		PrerenderFinalStatusInactivePageRestriction,
+		PrerenderFinalStatusStartFailed,
+		PrerenderFinalStatusTimeoutBackgrounded,
+		PrerenderFinalStatusCrossSiteRedirectInInitialNavigation,
+		PrerenderFinalStatusCrossSiteNavigationInInitialNavigation,
+		PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation,
+		PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation,
+		PrerenderFinalStatusActivationNavigationParameterMismatch,
+		PrerenderFinalStatusActivatedInBackground,
+		PrerenderFinalStatusEmbedderHostDisallowed,
+		PrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess,
+		PrerenderFinalStatusTabClosedByUserGesture,
+		PrerenderFinalStatusTabClosedWithoutUserGesture,
+		PrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed,
+		PrerenderFinalStatusPrimaryMainFrameRendererProcessKilled,
+		PrerenderFinalStatusActivationFramePolicyNotCompatible,
+		PrerenderFinalStatusPreloadingDisabled,
+		PrerenderFinalStatusBatterySaverEnabled,
+		PrerenderFinalStatusActivatedDuringMainFrameNavigation,
+		PrerenderFinalStatusPreloadingUnsupportedByWebContents,
+		PrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation,
+		PrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation,
+		PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation,
+		PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation,
+		PrerenderFinalStatusMemoryPressureOnTrigger,
+		PrerenderFinalStatusMemoryPressureAfterTriggered,
+		PrerenderFinalStatusPrerenderingDisabledByDevTools,
+		PrerenderFinalStatusSpeculationRuleRemoved,
+		PrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts,
+		PrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded,
+		PrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded,
+		PrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded,
+		PrerenderFinalStatusPrerenderingUrlHasEffectiveUrl,
+		PrerenderFinalStatusRedirectedPrerenderingUrlHasEffectiveUrl,
+		PrerenderFinalStatusActivationUrlHasEffectiveUrl,
+		PrerenderFinalStatusJavaScriptInterfaceAdded,
+		PrerenderFinalStatusJavaScriptInterfaceRemoved,
+		PrerenderFinalStatusAllPrerenderingCanceled,
+		PrerenderFinalStatusWindowClosed,
+		PrerenderFinalStatusSlowNetwork,
+		PrerenderFinalStatusOtherPrerenderedPageActivated,
+		PrerenderFinalStatusV8OptimizerDisabled,
+		PrerenderFinalStatusPrerenderFailedDuringPrefetch,
+		PrerenderFinalStatusBrowsingDataRemoved,
+		PrerenderFinalStatusPrerenderHostReused,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PrerenderFinalStatus) Valid() bool {
+	switch v {
+	case PrerenderFinalStatusActivated, PrerenderFinalStatusDestroyed, PrerenderFinalStatusLowEndDevice, PrerenderFinalStatusInvalidSchemeRedirect, PrerenderFinalStatusInvalidSchemeNavigation, PrerenderFinalStatusNavigationRequestBlockedByCsp, PrerenderFinalStatusMojoBinderPolicy, PrerenderFinalStatusRendererProcessCrashed, PrerenderFinalStatusRendererProcessKilled, PrerenderFinalStatusDownload, PrerenderFinalStatusTriggerDestroyed, PrerenderFinalStatusNavigationNotCommitted, PrerenderFinalStatusNavigationBadHttpStatus, PrerenderFinalStatusClientCertRequested, PrerenderFinalStatusNavigationRequestNetworkError, PrerenderFinalStatusCancelAllHostsForTesting, PrerenderFinalStatusDidFailLoad, PrerenderFinalStatusStop, PrerenderFinalStatusSslCertificateError, PrerenderFinalStatusLoginAuthRequested, PrerenderFinalStatusUaChangeRequiresReload, PrerenderFinalStatusBlockedByClient, PrerenderFinalStatusAudioOutputDeviceRequested, PrerenderFinalStatusMixedContent, PrerenderFinalStatusTriggerBackgrounded, PrerenderFinalStatusMemoryLimitExceeded, PrerenderFinalStatusDataSaverEnabled, PrerenderFinalStatusTriggerUrlHasEffectiveUrl, PrerenderFinalStatusActivatedBeforeStarted, PrerenderFinalStatusInactivePageRestriction, PrerenderFinalStatusStartFailed, PrerenderFinalStatusTimeoutBackgrounded, PrerenderFinalStatusCrossSiteRedirectInInitialNavigation, PrerenderFinalStatusCrossSiteNavigationInInitialNavigation, PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInInitialNavigation, PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInInitialNavigation, PrerenderFinalStatusActivationNavigationParameterMismatch, PrerenderFinalStatusActivatedInBackground, PrerenderFinalStatusEmbedderHostDisallowed, PrerenderFinalStatusActivationNavigationDestroyedBeforeSuccess, PrerenderFinalStatusTabClosedByUserGesture, PrerenderFinalStatusTabClosedWithoutUserGesture, PrerenderFinalStatusPrimaryMainFrameRendererProcessCrashed, PrerenderFinalStatusPrimaryMainFrameRendererProcessKilled, PrerenderFinalStatusActivationFramePolicyNotCompatible, PrerenderFinalStatusPreloadingDisabled, PrerenderFinalStatusBatterySaverEnabled, PrerenderFinalStatusActivatedDuringMainFrameNavigation, PrerenderFinalStatusPreloadingUnsupportedByWebContents, PrerenderFinalStatusCrossSiteRedirectInMainFrameNavigation, PrerenderFinalStatusCrossSiteNavigationInMainFrameNavigation, PrerenderFinalStatusSameSiteCrossOriginRedirectNotOptInInMainFrameNavigation, PrerenderFinalStatusSameSiteCrossOriginNavigationNotOptInInMainFrameNavigation, PrerenderFinalStatusMemoryPressureOnTrigger, PrerenderFinalStatusMemoryPressureAfterTriggered, PrerenderFinalStatusPrerenderingDisabledByDevTools, PrerenderFinalStatusSpeculationRuleRemoved, PrerenderFinalStatusActivatedWithAuxiliaryBrowsingContexts, PrerenderFinalStatusMaxNumOfRunningEagerPrerendersExceeded, PrerenderFinalStatusMaxNumOfRunningNonEagerPrerendersExceeded, PrerenderFinalStatusMaxNumOfRunningEmbedderPrerendersExceeded, PrerenderFinalStatusPrerenderingUrlHasEffectiveUrl, PrerenderFinalStatusRedirectedPrerenderingUrlHasEffectiveUrl, PrerenderFinalStatusActivationUrlHasEffectiveUrl, PrerenderFinalStatusJavaScriptInterfaceAdded, PrerenderFinalStatusJavaScriptInterfaceRemoved, PrerenderFinalStatusAllPrerenderingCanceled, PrerenderFinalStatusWindowClosed, PrerenderFinalStatusSlowNetwork, PrerenderFinalStatusOtherPrerenderedPageActivated, PrerenderFinalStatusV8OptimizerDisabled, PrerenderFinalStatusPrerenderFailedDuringPrefetch, PrerenderFinalStatusBrowsingDataRemoved, PrerenderFinalStatusPrerenderHostReused:
+		return true
+	}
+	return false
+}
+
+// Preloading status values, see also PreloadingTriggeringOutcome. This
+// status is shared by prefetchStatusUpdated and prerenderStatusUpdated.
+type PreloadingStatus string
+
+// PreloadingStatus values
+const (
+	PreloadingStatusPending      PreloadingStatus = "Pending"
+	PreloadingStatusRunning      PreloadingStatus = "Running"
+	PreloadingStatusReady        PreloadingStatus = "Ready"
+	PreloadingStatusSuccess      PreloadingStatus = "Success"
+	PreloadingStatusFailure      PreloadingStatus = "Failure"
+	PreloadingStatusNotSupported PreloadingStatus = "NotSupported"
+)
+
+// Values gives every PreloadingStatus
+func (PreloadingStatus) Values() []PreloadingStatus {
+	return []PreloadingStatus{
+		PreloadingStatusPending,
+		PreloadingStatusRunning,
+		PreloadingStatusReady,
+		PreloadingStatusSuccess,
+		PreloadingStatusFailure,
+		PreloadingStatusNotSupported,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PreloadingStatus) Valid() bool {
+	switch v {
+	case PreloadingStatusPending, PreloadingStatusRunning, PreloadingStatusReady, PreloadingStatusSuccess, PreloadingStatusFailure, PreloadingStatusNotSupported:
+		return true
+	}
+	return false
+}
+
+// TODO(https://crbug.com/1384419): revisit the list of PrefetchStatus and
+// filter out the ones that aren't necessary to the developers.
+type PrefetchStatus string
+
+// PrefetchStatus values
+const (
+	PrefetchStatusPrefetchAllowed                                             PrefetchStatus = "PrefetchAllowed"
+	PrefetchStatusPrefetchFailedIneligibleRedirect                            PrefetchStatus = "PrefetchFailedIneligibleRedirect"
+	PrefetchStatusPrefetchFailedInvalidRedirect                               PrefetchStatus = "PrefetchFailedInvalidRedirect"
+	PrefetchStatusPrefetchFailedMIMENotSupported                              PrefetchStatus = "PrefetchFailedMIMENotSupported"
+	PrefetchStatusPrefetchFailedNetError                                      PrefetchStatus = "PrefetchFailedNetError"
+	PrefetchStatusPrefetchFailedNon2XX                                        PrefetchStatus = "PrefetchFailedNon2XX"
+	PrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved                     PrefetchStatus = "PrefetchEvictedAfterBrowsingDataRemoved"
+	PrefetchStatusPrefetchEvictedAfterCandidateRemoved                        PrefetchStatus = "PrefetchEvictedAfterCandidateRemoved"
+	PrefetchStatusPrefetchEvictedForNewerPrefetch                             PrefetchStatus = "PrefetchEvictedForNewerPrefetch"
+	PrefetchStatusPrefetchHeldback                                            PrefetchStatus = "PrefetchHeldback"
+	PrefetchStatusPrefetchIneligibleRetryAfter                                PrefetchStatus = "PrefetchIneligibleRetryAfter"
+	PrefetchStatusPrefetchIsPrivacyDecoy                                      PrefetchStatus = "PrefetchIsPrivacyDecoy"
+	PrefetchStatusPrefetchIsStale                                             PrefetchStatus = "PrefetchIsStale"
+	PrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord               PrefetchStatus = "PrefetchNotEligibleBrowserContextOffTheRecord"
+	PrefetchStatusPrefetchNotEligibleDataSaverEnabled                         PrefetchStatus = "PrefetchNotEligibleDataSaverEnabled"
+	PrefetchStatusPrefetchNotEligibleExistingProxy                            PrefetchStatus = "PrefetchNotEligibleExistingProxy"
+	PrefetchStatusPrefetchNotEligibleHostIsNonUnique                          PrefetchStatus = "PrefetchNotEligibleHostIsNonUnique"
+	PrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition               PrefetchStatus = "PrefetchNotEligibleNonDefaultStoragePartition"
+	PrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy PrefetchStatus = "PrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy"
+	PrefetchStatusPrefetchNotEligibleSchemeIsNotHttps                         PrefetchStatus = "PrefetchNotEligibleSchemeIsNotHttps"
+	PrefetchStatusPrefetchNotEligibleUserHasCookies                           PrefetchStatus = "PrefetchNotEligibleUserHasCookies"
+	PrefetchStatusPrefetchNotEligibleUserHasServiceWorker                     PrefetchStatus = "PrefetchNotEligibleUserHasServiceWorker"
+	PrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler       PrefetchStatus = "PrefetchNotEligibleUserHasServiceWorkerNoFetchHandler"
+	PrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker                PrefetchStatus = "PrefetchNotEligibleRedirectFromServiceWorker"
+	PrefetchStatusPrefetchNotEligibleRedirectToServiceWorker                  PrefetchStatus = "PrefetchNotEligibleRedirectToServiceWorker"
+	PrefetchStatusPrefetchNotEligibleBatterySaverEnabled                      PrefetchStatus = "PrefetchNotEligibleBatterySaverEnabled"
+	PrefetchStatusPrefetchNotEligiblePreloadingDisabled                       PrefetchStatus = "PrefetchNotEligiblePreloadingDisabled"
+	PrefetchStatusPrefetchNotFinishedInTime                                   PrefetchStatus = "PrefetchNotFinishedInTime"
+	PrefetchStatusPrefetchNotStarted                                          PrefetchStatus = "PrefetchNotStarted"
+	PrefetchStatusPrefetchNotUsedCookiesChanged                               PrefetchStatus = "PrefetchNotUsedCookiesChanged"
+	PrefetchStatusPrefetchProxyNotAvailable                                   PrefetchStatus = "PrefetchProxyNotAvailable"
+	PrefetchStatusPrefetchResponseUsed                                        PrefetchStatus = "PrefetchResponseUsed"
+	PrefetchStatusPrefetchSuccessfulButNotUsed                                PrefetchStatus = "PrefetchSuccessfulButNotUsed"
+	PrefetchStatusPrefetchNotUsedProbeFailed                                  PrefetchStatus = "PrefetchNotUsedProbeFailed"
+)
+
+// Values gives every PrefetchStatus
+func (PrefetchStatus) Values() []PrefetchStatus {
+	return []PrefetchStatus{
+		PrefetchStatusPrefetchAllowed,
+		PrefetchStatusPrefetchFailedIneligibleRedirect,
+		PrefetchStatusPrefetchFailedInvalidRedirect,
+		PrefetchStatusPrefetchFailedMIMENotSupported,
+		PrefetchStatusPrefetchFailedNetError,
+		PrefetchStatusPrefetchFailedNon2XX,
+		PrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved,
+		PrefetchStatusPrefetchEvictedAfterCandidateRemoved,
+		PrefetchStatusPrefetchEvictedForNewerPrefetch,
+		PrefetchStatusPrefetchHeldback,
+		PrefetchStatusPrefetchIneligibleRetryAfter,
+		PrefetchStatusPrefetchIsPrivacyDecoy,
+		PrefetchStatusPrefetchIsStale,
+		PrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord,
+		PrefetchStatusPrefetchNotEligibleDataSaverEnabled,
+		PrefetchStatusPrefetchNotEligibleExistingProxy,
+		PrefetchStatusPrefetchNotEligibleHostIsNonUnique,
+		PrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition,
+		PrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy,
+		PrefetchStatusPrefetchNotEligibleSchemeIsNotHttps,
+		PrefetchStatusPrefetchNotEligibleUserHasCookies,
+		PrefetchStatusPrefetchNotEligibleUserHasServiceWorker,
+		PrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler,
+		PrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker,
+		PrefetchStatusPrefetchNotEligibleRedirectToServiceWorker,
+		PrefetchStatusPrefetchNotEligibleBatterySaverEnabled,
+		PrefetchStatusPrefetchNotEligiblePreloadingDisabled,
+		PrefetchStatusPrefetchNotFinishedInTime,
+		PrefetchStatusPrefetchNotStarted,
+		PrefetchStatusPrefetchNotUsedCookiesChanged,
+		PrefetchStatusPrefetchProxyNotAvailable,
+		PrefetchStatusPrefetchResponseUsed,
+		PrefetchStatusPrefetchSuccessfulButNotUsed,
+		PrefetchStatusPrefetchNotUsedProbeFailed,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v PrefetchStatus) Valid() bool {
+	switch v {
+	case PrefetchStatusPrefetchAllowed, PrefetchStatusPrefetchFailedIneligibleRedirect, PrefetchStatusPrefetchFailedInvalidRedirect, PrefetchStatusPrefetchFailedMIMENotSupported, PrefetchStatusPrefetchFailedNetError, PrefetchStatusPrefetchFailedNon2XX, PrefetchStatusPrefetchEvictedAfterBrowsingDataRemoved, PrefetchStatusPrefetchEvictedAfterCandidateRemoved, PrefetchStatusPrefetchEvictedForNewerPrefetch, PrefetchStatusPrefetchHeldback, PrefetchStatusPrefetchIneligibleRetryAfter, PrefetchStatusPrefetchIsPrivacyDecoy, PrefetchStatusPrefetchIsStale, PrefetchStatusPrefetchNotEligibleBrowserContextOffTheRecord, PrefetchStatusPrefetchNotEligibleDataSaverEnabled, PrefetchStatusPrefetchNotEligibleExistingProxy, PrefetchStatusPrefetchNotEligibleHostIsNonUnique, PrefetchStatusPrefetchNotEligibleNonDefaultStoragePartition, PrefetchStatusPrefetchNotEligibleSameSiteCrossOriginPrefetchRequiredProxy, PrefetchStatusPrefetchNotEligibleSchemeIsNotHttps, PrefetchStatusPrefetchNotEligibleUserHasCookies, PrefetchStatusPrefetchNotEligibleUserHasServiceWorker, PrefetchStatusPrefetchNotEligibleUserHasServiceWorkerNoFetchHandler, PrefetchStatusPrefetchNotEligibleRedirectFromServiceWorker, PrefetchStatusPrefetchNotEligibleRedirectToServiceWorker, PrefetchStatusPrefetchNotEligibleBatterySaverEnabled, PrefetchStatusPrefetchNotEligiblePreloadingDisabled, PrefetchStatusPrefetchNotFinishedInTime, PrefetchStatusPrefetchNotStarted, PrefetchStatusPrefetchNotUsedCookiesChanged, PrefetchStatusPrefetchProxyNotAvailable, PrefetchStatusPrefetchResponseUsed, PrefetchStatusPrefetchSuccessfulButNotUsed, PrefetchStatusPrefetchNotUsedProbeFailed:
+		return true
+	}
+	return false
+}
+
+// Information of headers to be displayed when the header mismatch occurred.
+type PrerenderMismatchedHeaders struct {
+	HeaderName      string  `json:"headerName"`
+	InitialValue    *string `json:"initialValue,omitempty"`
+	ActivationValue *string `json:"activationValue,omitempty"`
+}
+
+// MarshalCDP writes PrerenderMismatchedHeaders as JSON
+func (v *PrerenderMismatchedHeaders) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("headerName")
+	e.String(v.HeaderName)
+	if v.InitialValue != nil {
+		e.Key("initialValue")
+		e.String(*v.InitialValue)
+	}
+	if v.ActivationValue != nil {
+		e.Key("activationValue")
+		e.String(*v.ActivationValue)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PrerenderMismatchedHeaders) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PrerenderMismatchedHeaders from JSON
+func (v *PrerenderMismatchedHeaders) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "headerName":
+			v.HeaderName = d.String()
+		case "initialValue":
+			if d.Null() {
+				v.InitialValue = nil
+			} else {
+				v.InitialValue = new(string)
+				*v.InitialValue = d.String()
+			}
+		case "activationValue":
+			if d.Null() {
+				v.ActivationValue = nil
+			} else {
+				v.ActivationValue = new(string)
+				*v.ActivationValue = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PrerenderMismatchedHeaders) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Preload.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Preload.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Upsert. Currently, it is only emitted when a rule set added.
+type RuleSetUpdatedEvent struct {
+	RuleSet RuleSet `json:"ruleSet"`
+}
+
+// UnmarshalCDP reads RuleSetUpdatedEvent from JSON
+func (v *RuleSetUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "ruleSet":
+			v.RuleSet.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuleSetUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RuleSetUpdatedHandler func(ev RuleSetUpdatedEvent)
+
+// EventMethod is Preload.ruleSetUpdated
+func (RuleSetUpdatedEvent) EventMethod() string {
+	return "Preload.ruleSetUpdated"
+}
+
+// OnRuleSetUpdated calls handler for each Preload.ruleSetUpdated event
+func (c Client) OnRuleSetUpdated(handler RuleSetUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Preload.ruleSetUpdated", func(ev interface{}) {
+		handler(ev.(RuleSetUpdatedEvent))
+	})
+}
+
+type RuleSetRemovedEvent struct {
+	Id RuleSetId `json:"id"`
+}
+
+// UnmarshalCDP reads RuleSetRemovedEvent from JSON
+func (v *RuleSetRemovedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = RuleSetId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuleSetRemovedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type RuleSetRemovedHandler func(ev RuleSetRemovedEvent)
+
+// EventMethod is Preload.ruleSetRemoved
+func (RuleSetRemovedEvent) EventMethod() string {
+	return "Preload.ruleSetRemoved"
+}
+
+// OnRuleSetRemoved calls handler for each Preload.ruleSetRemoved event
+func (c Client) OnRuleSetRemoved(handler RuleSetRemovedHandler) (unsubscribe func()) {
+	return c.caller.On("Preload.ruleSetRemoved", func(ev interface{}) {
+		handler(ev.(RuleSetRemovedEvent))
+	})
+}
+
+// Fired when a preload enabled state is updated.
+type PreloadEnabledStateUpdatedEvent struct {
+	DisabledByPreference bool `json:"disabledByPreference"`
+
+	DisabledByDataSaver bool `json:"disabledByDataSaver"`
+
+	DisabledByBatterySaver bool `json:"disabledByBatterySaver"`
+
+	DisabledByHoldbackPrefetchSpeculationRules bool `json:"disabledByHoldbackPrefetchSpeculationRules"`
+
+	DisabledByHoldbackPrerenderSpeculationRules bool `json:"disabledByHoldbackPrerenderSpeculationRules"`
+}
+
+// UnmarshalCDP reads PreloadEnabledStateUpdatedEvent from JSON
+func (v *PreloadEnabledStateUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "disabledByPreference":
+			v.DisabledByPreference = d.Bool()
+		case "disabledByDataSaver":
+			v.DisabledByDataSaver = d.Bool()
+		case "disabledByBatterySaver":
+			v.DisabledByBatterySaver = d.Bool()
+		case "disabledByHoldbackPrefetchSpeculationRules":
+			v.DisabledByHoldbackPrefetchSpeculationRules = d.Bool()
+		case "disabledByHoldbackPrerenderSpeculationRules":
+			v.DisabledByHoldbackPrerenderSpeculationRules = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PreloadEnabledStateUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PreloadEnabledStateUpdatedHandler func(ev PreloadEnabledStateUpdatedEvent)
+
+// EventMethod is Preload.preloadEnabledStateUpdated
+func (PreloadEnabledStateUpdatedEvent) EventMethod() string {
+	return "Preload.preloadEnabledStateUpdated"
+}
+
+// OnPreloadEnabledStateUpdated calls handler for each Preload.preloadEnabledStateUpdated event
+func (c Client) OnPreloadEnabledStateUpdated(handler PreloadEnabledStateUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Preload.preloadEnabledStateUpdated", func(ev interface{}) {
+		handler(ev.(PreloadEnabledStateUpdatedEvent))
+	})
+}
+
+// Fired when a prefetch attempt is updated.
+type PrefetchStatusUpdatedEvent struct {
+	Key PreloadingAttemptKey `json:"key"`
+
+	PipelineId PreloadPipelineId `json:"pipelineId"`
+
+	/* The frame id of the frame initiating prefetch. */
+	InitiatingFrameId cdp.PageFrameId `json:"initiatingFrameId"`
+
+	PrefetchUrl string `json:"prefetchUrl"`
+
+	Status PreloadingStatus `json:"status"`
+
+	PrefetchStatus PrefetchStatus `json:"prefetchStatus"`
+
+	RequestId cdp.NetworkRequestId `json:"requestId"`
+}
+
+// UnmarshalCDP reads PrefetchStatusUpdatedEvent from JSON
+func (v *PrefetchStatusUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key.UnmarshalCDP(d)
+		case "pipelineId":
+			v.PipelineId = PreloadPipelineId(d.String())
+		case "initiatingFrameId":
+			v.InitiatingFrameId = cdp.PageFrameId(d.String())
+		case "prefetchUrl":
+			v.PrefetchUrl = d.String()
+		case "status":
+			v.Status = PreloadingStatus(d.String())
+		case "prefetchStatus":
+			v.PrefetchStatus = PrefetchStatus(d.String())
+		case "requestId":
+			v.RequestId = cdp.NetworkRequestId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PrefetchStatusUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PrefetchStatusUpdatedHandler func(ev PrefetchStatusUpdatedEvent)
+
+// EventMethod is Preload.prefetchStatusUpdated
+func (PrefetchStatusUpdatedEvent) EventMethod() string {
+	return "Preload.prefetchStatusUpdated"
+}
+
+// OnPrefetchStatusUpdated calls handler for each Preload.prefetchStatusUpdated event
+func (c Client) OnPrefetchStatusUpdated(handler PrefetchStatusUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Preload.prefetchStatusUpdated", func(ev interface{}) {
+		handler(ev.(PrefetchStatusUpdatedEvent))
+	})
+}
+
+// Fired when a prerender attempt is updated.
+type PrerenderStatusUpdatedEvent struct {
+	Key PreloadingAttemptKey `json:"key"`
+
+	PipelineId PreloadPipelineId `json:"pipelineId"`
+
+	Status PreloadingStatus `json:"status"`
+
+	PrerenderStatus *PrerenderFinalStatus `json:"prerenderStatus,omitempty"`
+
+	/* This is used to give users more information about the name of Mojo interface
+	that is incompatible with prerender and has caused the cancellation of the attempt. */
+	DisallowedMojoInterface *string `json:"disallowedMojoInterface,omitempty"`
+
+	MismatchedHeaders []PrerenderMismatchedHeaders `json:"mismatchedHeaders,omitempty"`
+}
+
+// UnmarshalCDP reads PrerenderStatusUpdatedEvent from JSON
+func (v *PrerenderStatusUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key.UnmarshalCDP(d)
+		case "pipelineId":
+			v.PipelineId = PreloadPipelineId(d.String())
+		case "status":
+			v.Status = PreloadingStatus(d.String())
+		case "prerenderStatus":
+			if d.Null() {
+				v.PrerenderStatus = nil
+			} else {
+				v.PrerenderStatus = new(PrerenderFinalStatus)
+				*v.PrerenderStatus = PrerenderFinalStatus(d.String())
+			}
+		case "disallowedMojoInterface":
+			if d.Null() {
+				v.DisallowedMojoInterface = nil
+			} else {
+				v.DisallowedMojoInterface = new(string)
+				*v.DisallowedMojoInterface = d.String()
+			}
+		case "mismatchedHeaders":
+			if d.Array() {
+				v.MismatchedHeaders = make([]PrerenderMismatchedHeaders, 0)
+				for d.More() {
+					var x0 PrerenderMismatchedHeaders
+					x0.UnmarshalCDP(d)
+					v.MismatchedHeaders = append(v.MismatchedHeaders, x0)
+				}
+			} else {
+				v.MismatchedHeaders = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PrerenderStatusUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PrerenderStatusUpdatedHandler func(ev PrerenderStatusUpdatedEvent)
+
+// EventMethod is Preload.prerenderStatusUpdated
+func (PrerenderStatusUpdatedEvent) EventMethod() string {
+	return "Preload.prerenderStatusUpdated"
+}
+
+// OnPrerenderStatusUpdated calls handler for each Preload.prerenderStatusUpdated event
+func (c Client) OnPrerenderStatusUpdated(handler PrerenderStatusUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Preload.prerenderStatusUpdated", func(ev interface{}) {
+		handler(ev.(PrerenderStatusUpdatedEvent))
+	})
+}
+
+// Send a list of sources for all preloading attempts in a document.
+type PreloadingAttemptSourcesUpdatedEvent struct {
+	LoaderId cdp.NetworkLoaderId `json:"loaderId"`
+
+	PreloadingAttemptSources []PreloadingAttemptSource `json:"preloadingAttemptSources"`
+}
+
+// UnmarshalCDP reads PreloadingAttemptSourcesUpdatedEvent from JSON
+func (v *PreloadingAttemptSourcesUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "loaderId":
+			v.LoaderId = cdp.NetworkLoaderId(d.String())
+		case "preloadingAttemptSources":
+			if d.Array() {
+				v.PreloadingAttemptSources = make([]PreloadingAttemptSource, 0)
+				for d.More() {
+					var x0 PreloadingAttemptSource
+					x0.UnmarshalCDP(d)
+					v.PreloadingAttemptSources = append(v.PreloadingAttemptSources, x0)
+				}
+			} else {
+				v.PreloadingAttemptSources = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PreloadingAttemptSourcesUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PreloadingAttemptSourcesUpdatedHandler func(ev PreloadingAttemptSourcesUpdatedEvent)
+
+// EventMethod is Preload.preloadingAttemptSourcesUpdated
+func (PreloadingAttemptSourcesUpdatedEvent) EventMethod() string {
+	return "Preload.preloadingAttemptSourcesUpdated"
+}
+
+// OnPreloadingAttemptSourcesUpdated calls handler for each Preload.preloadingAttemptSourcesUpdated event
+func (c Client) OnPreloadingAttemptSourcesUpdated(handler PreloadingAttemptSourcesUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Preload.preloadingAttemptSourcesUpdated", func(ev interface{}) {
+		handler(ev.(PreloadingAttemptSourcesUpdatedEvent))
+	})
+}
+
+// decoders for Preload events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Preload.ruleSetUpdated":                  cdp.Decode[RuleSetUpdatedEvent],
+	"Preload.ruleSetRemoved":                  cdp.Decode[RuleSetRemovedEvent],
+	"Preload.preloadEnabledStateUpdated":      cdp.Decode[PreloadEnabledStateUpdatedEvent],
+	"Preload.prefetchStatusUpdated":           cdp.Decode[PrefetchStatusUpdatedEvent],
+	"Preload.prerenderStatusUpdated":          cdp.Decode[PrerenderStatusUpdatedEvent],
+	"Preload.preloadingAttemptSourcesUpdated": cdp.Decode[PreloadingAttemptSourcesUpdatedEvent],
+}
+
+// DecodeEvent decodes a Preload event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,1028 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package profiler is the Profiler domain of the chrome devtools protocol
+package profiler
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Profiler commands and listens for Profiler events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Profile node. Holds callsite information, execution statistics and child nodes.
+type ProfileNode struct {
+	/* Unique id of the node. */
+	Id int `json:"id"`
+	/* Function location. */
+	CallFrame cdp.RuntimeCallFrame `json:"callFrame"`
+	/* Number of samples where this node was on top of the call stack. */
+	HitCount *int `json:"hitCount,omitempty"`
+	/* Child node ids. */
+	Children []int `json:"children"`
+	/* The reason of being not optimized. The function may be deoptimized or marked as don't
+	optimize. */
+	DeoptReason *string `json:"deoptReason,omitempty"`
+	/* An array of source position ticks. */
+	PositionTicks []PositionTickInfo `json:"positionTicks"`
+}
+
+// MarshalCDP writes ProfileNode as JSON
+func (v *ProfileNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.Int(v.Id)
+	e.Key("callFrame")
+	v.CallFrame.MarshalCDP(e)
+	if v.HitCount != nil {
+		e.Key("hitCount")
+		e.Int(*v.HitCount)
+	}
+	if v.Children != nil {
+		e.Key("children")
+		e.ArrayStart()
+		for i0 := range v.Children {
+			e.Elem()
+			e.Int(v.Children[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.DeoptReason != nil {
+		e.Key("deoptReason")
+		e.String(*v.DeoptReason)
+	}
+	if v.PositionTicks != nil {
+		e.Key("positionTicks")
+		e.ArrayStart()
+		for i0 := range v.PositionTicks {
+			e.Elem()
+			v.PositionTicks[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ProfileNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ProfileNode from JSON
+func (v *ProfileNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.Int()
+		case "callFrame":
+			v.CallFrame.UnmarshalCDP(d)
+		case "hitCount":
+			if d.Null() {
+				v.HitCount = nil
+			} else {
+				v.HitCount = new(int)
+				*v.HitCount = d.Int()
+			}
+		case "children":
+			if d.Array() {
+				v.Children = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Children = append(v.Children, x0)
+				}
+			} else {
+				v.Children = nil
+			}
+		case "deoptReason":
+			if d.Null() {
+				v.DeoptReason = nil
+			} else {
+				v.DeoptReason = new(string)
+				*v.DeoptReason = d.String()
+			}
+		case "positionTicks":
+			if d.Array() {
+				v.PositionTicks = make([]PositionTickInfo, 0)
+				for d.More() {
+					var x0 PositionTickInfo
+					x0.UnmarshalCDP(d)
+					v.PositionTicks = append(v.PositionTicks, x0)
+				}
+			} else {
+				v.PositionTicks = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ProfileNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Profile.
+type Profile struct {
+	/* The list of profile nodes. First item is the root node. */
+	Nodes []ProfileNode `json:"nodes"`
+	/* Profiling start timestamp in microseconds. */
+	StartTime float64 `json:"startTime"`
+	/* Profiling end timestamp in microseconds. */
+	EndTime float64 `json:"endTime"`
+	/* Ids of samples top nodes. */
+	Samples []int `json:"samples"`
+	/* Time intervals between adjacent samples in microseconds. The first delta is relative to the
+	profile startTime. */
+	TimeDeltas []int `json:"timeDeltas"`
+}
+
+// MarshalCDP writes Profile as JSON
+func (v *Profile) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodes")
+	if v.Nodes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Nodes {
+			e.Elem()
+			v.Nodes[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("startTime")
+	e.Float(v.StartTime)
+	e.Key("endTime")
+	e.Float(v.EndTime)
+	if v.Samples != nil {
+		e.Key("samples")
+		e.ArrayStart()
+		for i0 := range v.Samples {
+			e.Elem()
+			e.Int(v.Samples[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.TimeDeltas != nil {
+		e.Key("timeDeltas")
+		e.ArrayStart()
+		for i0 := range v.TimeDeltas {
+			e.Elem()
+			e.Int(v.TimeDeltas[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Profile) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Profile from JSON
+func (v *Profile) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodes":
+			if d.Array() {
+				v.Nodes = make([]ProfileNode, 0)
+				for d.More() {
+					var x0 ProfileNode
+					x0.UnmarshalCDP(d)
+					v.Nodes = append(v.Nodes, x0)
+				}
+			} else {
+				v.Nodes = nil
+			}
+		case "startTime":
+			v.StartTime = d.Float()
+		case "endTime":
+			v.EndTime = d.Float()
+		case "samples":
+			if d.Array() {
+				v.Samples = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Samples = append(v.Samples, x0)
+				}
+			} else {
+				v.Samples = nil
+			}
+		case "timeDeltas":
+			if d.Array() {
+				v.TimeDeltas = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.TimeDeltas = append(v.TimeDeltas, x0)
+				}
+			} else {
+				v.TimeDeltas = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Profile) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Specifies a number of samples attributed to a certain source position.
+type PositionTickInfo struct {
+	/* Source line number (1-based). */
+	Line int `json:"line"`
+	/* Number of samples attributed to the source line. */
+	Ticks int `json:"ticks"`
+}
+
+// MarshalCDP writes PositionTickInfo as JSON
+func (v *PositionTickInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("line")
+	e.Int(v.Line)
+	e.Key("ticks")
+	e.Int(v.Ticks)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PositionTickInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PositionTickInfo from JSON
+func (v *PositionTickInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "line":
+			v.Line = d.Int()
+		case "ticks":
+			v.Ticks = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PositionTickInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Coverage data for a source range.
+type CoverageRange struct {
+	/* JavaScript script source offset for the range start. */
+	StartOffset int `json:"startOffset"`
+	/* JavaScript script source offset for the range end. */
+	EndOffset int `json:"endOffset"`
+	/* Collected execution count of the source range. */
+	Count int `json:"count"`
+}
+
+// MarshalCDP writes CoverageRange as JSON
+func (v *CoverageRange) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("startOffset")
+	e.Int(v.StartOffset)
+	e.Key("endOffset")
+	e.Int(v.EndOffset)
+	e.Key("count")
+	e.Int(v.Count)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CoverageRange) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CoverageRange from JSON
+func (v *CoverageRange) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "startOffset":
+			v.StartOffset = d.Int()
+		case "endOffset":
+			v.EndOffset = d.Int()
+		case "count":
+			v.Count = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CoverageRange) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Coverage data for a JavaScript function.
+type FunctionCoverage struct {
+	/* JavaScript function name. */
+	FunctionName string `json:"functionName"`
+	/* Source ranges inside the function with coverage data. */
+	Ranges []CoverageRange `json:"ranges"`
+	/* Whether coverage data for this function has block granularity. */
+	IsBlockCoverage bool `json:"isBlockCoverage"`
+}
+
+// MarshalCDP writes FunctionCoverage as JSON
+func (v *FunctionCoverage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("functionName")
+	e.String(v.FunctionName)
+	e.Key("ranges")
+	if v.Ranges == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Ranges {
+			e.Elem()
+			v.Ranges[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("isBlockCoverage")
+	e.Bool(v.IsBlockCoverage)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FunctionCoverage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FunctionCoverage from JSON
+func (v *FunctionCoverage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "functionName":
+			v.FunctionName = d.String()
+		case "ranges":
+			if d.Array() {
+				v.Ranges = make([]CoverageRange, 0)
+				for d.More() {
+					var x0 CoverageRange
+					x0.UnmarshalCDP(d)
+					v.Ranges = append(v.Ranges, x0)
+				}
+			} else {
+				v.Ranges = nil
+			}
+		case "isBlockCoverage":
+			v.IsBlockCoverage = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FunctionCoverage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Coverage data for a JavaScript script.
+type ScriptCoverage struct {
+	/* JavaScript script id. */
+	ScriptId cdp.RuntimeScriptId `json:"scriptId"`
+	/* JavaScript script name or url. */
+	Url string `json:"url"`
+	/* Functions contained in the script that has coverage data. */
+	Functions []FunctionCoverage `json:"functions"`
+}
+
+// MarshalCDP writes ScriptCoverage as JSON
+func (v *ScriptCoverage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("functions")
+	if v.Functions == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Functions {
+			e.Elem()
+			v.Functions[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ScriptCoverage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ScriptCoverage from JSON
+func (v *ScriptCoverage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = cdp.RuntimeScriptId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "functions":
+			if d.Array() {
+				v.Functions = make([]FunctionCoverage, 0)
+				for d.More() {
+					var x0 FunctionCoverage
+					x0.UnmarshalCDP(d)
+					v.Functions = append(v.Functions, x0)
+				}
+			} else {
+				v.Functions = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ScriptCoverage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetBestEffortCoverageReturns struct {
+
+	/* Coverage data for the current isolate. */
+	Result []ScriptCoverage `json:"result"`
+}
+
+// UnmarshalCDP reads GetBestEffortCoverageReturns from JSON
+func (v *GetBestEffortCoverageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			if d.Array() {
+				v.Result = make([]ScriptCoverage, 0)
+				for d.More() {
+					var x0 ScriptCoverage
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetBestEffortCoverageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Collect coverage data for the current isolate. The coverage data may be incomplete due to
+// garbage collection.
+func (c Client) GetBestEffortCoverage() (GetBestEffortCoverageReturns, error) {
+	return c.GetBestEffortCoverageContext(context.Background())
+}
+
+// GetBestEffortCoverageContext is GetBestEffortCoverage with a context for cancellation and deadlines
+func (c Client) GetBestEffortCoverageContext(ctx context.Context) (GetBestEffortCoverageReturns, error) {
+	var returns_ GetBestEffortCoverageReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.getBestEffortCoverage", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetSamplingIntervalReturns struct {
+}
+
+// UnmarshalCDP reads SetSamplingIntervalReturns from JSON
+func (v *SetSamplingIntervalReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSamplingIntervalReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSamplingIntervalParams are the parameters for Profiler.setSamplingInterval
+// optional parameters are left out when nil
+type SetSamplingIntervalParams struct {
+	/* New sampling interval in microseconds. */
+	Interval int `json:"interval"`
+}
+
+// MarshalCDP writes SetSamplingIntervalParams as JSON
+func (v *SetSamplingIntervalParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("interval")
+	e.Int(v.Interval)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSamplingIntervalParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.
+func (c Client) SetSamplingInterval(params SetSamplingIntervalParams) (SetSamplingIntervalReturns, error) {
+	return c.SetSamplingIntervalContext(context.Background(), params)
+}
+
+// SetSamplingIntervalContext is SetSamplingInterval with a context for cancellation and deadlines
+func (c Client) SetSamplingIntervalContext(ctx context.Context, params SetSamplingIntervalParams) (SetSamplingIntervalReturns, error) {
+	var returns_ SetSamplingIntervalReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.setSamplingInterval", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartReturns struct {
+}
+
+// UnmarshalCDP reads StartReturns from JSON
+func (v *StartReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Start() (StartReturns, error) {
+	return c.StartContext(context.Background())
+}
+
+// StartContext is Start with a context for cancellation and deadlines
+func (c Client) StartContext(ctx context.Context) (StartReturns, error) {
+	var returns_ StartReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.start", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StartPreciseCoverageReturns struct {
+
+	/* Monotonically increasing time (in seconds) when the coverage update was taken in the backend. */
+	Timestamp float64 `json:"timestamp"`
+}
+
+// UnmarshalCDP reads StartPreciseCoverageReturns from JSON
+func (v *StartPreciseCoverageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartPreciseCoverageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartPreciseCoverageParams are the parameters for Profiler.startPreciseCoverage
+// optional parameters are left out when nil
+type StartPreciseCoverageParams struct {
+	/* Collect accurate call counts beyond simple 'covered' or 'not covered'. */
+	CallCount *bool `json:"callCount,omitempty"`
+	/* Collect block-based coverage. */
+	Detailed *bool `json:"detailed,omitempty"`
+	/* Allow the backend to send updates on its own initiative */
+	AllowTriggeredUpdates *bool `json:"allowTriggeredUpdates,omitempty"`
+}
+
+// MarshalCDP writes StartPreciseCoverageParams as JSON
+func (v *StartPreciseCoverageParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.CallCount != nil {
+		e.Key("callCount")
+		e.Bool(*v.CallCount)
+	}
+	if v.Detailed != nil {
+		e.Key("detailed")
+		e.Bool(*v.Detailed)
+	}
+	if v.AllowTriggeredUpdates != nil {
+		e.Key("allowTriggeredUpdates")
+		e.Bool(*v.AllowTriggeredUpdates)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartPreciseCoverageParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code
+// coverage may be incomplete. Enabling prevents running optimized code and resets execution
+// counters.
+func (c Client) StartPreciseCoverage(params StartPreciseCoverageParams) (StartPreciseCoverageReturns, error) {
+	return c.StartPreciseCoverageContext(context.Background(), params)
+}
+
+// StartPreciseCoverageContext is StartPreciseCoverage with a context for cancellation and deadlines
+func (c Client) StartPreciseCoverageContext(ctx context.Context, params StartPreciseCoverageParams) (StartPreciseCoverageReturns, error) {
+	var returns_ StartPreciseCoverageReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.startPreciseCoverage", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopReturns struct {
+
+	/* Recorded profile. */
+	Profile Profile `json:"profile"`
+}
+
+// UnmarshalCDP reads StopReturns from JSON
+func (v *StopReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Stop() (StopReturns, error) {
+	return c.StopContext(context.Background())
+}
+
+// StopContext is Stop with a context for cancellation and deadlines
+func (c Client) StopContext(ctx context.Context) (StopReturns, error) {
+	var returns_ StopReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.stop", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StopPreciseCoverageReturns struct {
+}
+
+// UnmarshalCDP reads StopPreciseCoverageReturns from JSON
+func (v *StopPreciseCoverageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopPreciseCoverageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disable precise code coverage. Disabling releases unnecessary execution count records and allows
+// executing optimized code.
+func (c Client) StopPreciseCoverage() (StopPreciseCoverageReturns, error) {
+	return c.StopPreciseCoverageContext(context.Background())
+}
+
+// StopPreciseCoverageContext is StopPreciseCoverage with a context for cancellation and deadlines
+func (c Client) StopPreciseCoverageContext(ctx context.Context) (StopPreciseCoverageReturns, error) {
+	var returns_ StopPreciseCoverageReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.stopPreciseCoverage", nil, &returns_)
+
+	return returns_, err_
+}
+
+type TakePreciseCoverageReturns struct {
+
+	/* Coverage data for the current isolate. */
+	Result []ScriptCoverage `json:"result"`
+
+	/* Monotonically increasing time (in seconds) when the coverage update was taken in the backend. */
+	Timestamp float64 `json:"timestamp"`
+}
+
+// UnmarshalCDP reads TakePreciseCoverageReturns from JSON
+func (v *TakePreciseCoverageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			if d.Array() {
+				v.Result = make([]ScriptCoverage, 0)
+				for d.More() {
+					var x0 ScriptCoverage
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		case "timestamp":
+			v.Timestamp = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TakePreciseCoverageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Collect coverage data for the current isolate, and resets execution counters. Precise code
+// coverage needs to have started.
+func (c Client) TakePreciseCoverage() (TakePreciseCoverageReturns, error) {
+	return c.TakePreciseCoverageContext(context.Background())
+}
+
+// TakePreciseCoverageContext is TakePreciseCoverage with a context for cancellation and deadlines
+func (c Client) TakePreciseCoverageContext(ctx context.Context) (TakePreciseCoverageReturns, error) {
+	var returns_ TakePreciseCoverageReturns
+
+	err_ := c.caller.Call(ctx, "Profiler.takePreciseCoverage", nil, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type ConsoleProfileFinishedEvent struct {
+	Id string `json:"id"`
+
+	/* Location of console.profileEnd(). */
+	Location cdp.DebuggerLocation `json:"location"`
+
+	Profile Profile `json:"profile"`
+
+	/* Profile title passed as an argument to console.profile(). */
+	Title *string `json:"title,omitempty"`
+}
+
+// UnmarshalCDP reads ConsoleProfileFinishedEvent from JSON
+func (v *ConsoleProfileFinishedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		case "location":
+			v.Location.UnmarshalCDP(d)
+		case "profile":
+			v.Profile.UnmarshalCDP(d)
+		case "title":
+			if d.Null() {
+				v.Title = nil
+			} else {
+				v.Title = new(string)
+				*v.Title = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ConsoleProfileFinishedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ConsoleProfileFinishedHandler func(ev ConsoleProfileFinishedEvent)
+
+// EventMethod is Profiler.consoleProfileFinished
+func (ConsoleProfileFinishedEvent) EventMethod() string {
+	return "Profiler.consoleProfileFinished"
+}
+
+// OnConsoleProfileFinished calls handler for each Profiler.consoleProfileFinished event
+func (c Client) OnConsoleProfileFinished(handler ConsoleProfileFinishedHandler) (unsubscribe func()) {
+	return c.caller.On("Profiler.consoleProfileFinished", func(ev interface{}) {
+		handler(ev.(ConsoleProfileFinishedEvent))
+	})
+}
+
+// Sent when new profile recording is started using console.profile() call.
+type ConsoleProfileStartedEvent struct {
+	Id string `json:"id"`
+
+	/* Location of console.profile(). */
+	Location cdp.DebuggerLocation `json:"location"`
+
+	/* Profile title passed as an argument to console.profile(). */
+	Title *string `json:"title,omitempty"`
+}
+
+// UnmarshalCDP reads ConsoleProfileStartedEvent from JSON
+func (v *ConsoleProfileStartedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		case "location":
+			v.Location.UnmarshalCDP(d)
+		case "title":
+			if d.Null() {
+				v.Title = nil
+			} else {
+				v.Title = new(string)
+				*v.Title = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ConsoleProfileStartedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ConsoleProfileStartedHandler func(ev ConsoleProfileStartedEvent)
+
+// EventMethod is Profiler.consoleProfileStarted
+func (ConsoleProfileStartedEvent) EventMethod() string {
+	return "Profiler.consoleProfileStarted"
+}
+
+// OnConsoleProfileStarted calls handler for each Profiler.consoleProfileStarted event
+func (c Client) OnConsoleProfileStarted(handler ConsoleProfileStartedHandler) (unsubscribe func()) {
+	return c.caller.On("Profiler.consoleProfileStarted", func(ev interface{}) {
+		handler(ev.(ConsoleProfileStartedEvent))
+	})
+}
+
+// decoders for Profiler events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Profiler.consoleProfileFinished": cdp.Decode[ConsoleProfileFinishedEvent],
+	"Profiler.consoleProfileStarted":  cdp.Decode[ConsoleProfileStartedEvent],
+}
+
+// DecodeEvent decodes a Profiler event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
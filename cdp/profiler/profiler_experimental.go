@@ -0,0 +1,81 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package profiler
+
+import (
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+/* Event Handlers */
+
+// Reports coverage delta since the last poll (either from an event like this, or from
+// `takePreciseCoverage` for the current isolate. May only be sent if precise code
+// coverage has been started. This event can be trigged by the embedder to, for example,
+// trigger collection of coverage data immediately at a certain point in time.
+//
+// Experimental: this may change or be removed in any chrome release
+type PreciseCoverageDeltaUpdateEvent struct {
+
+	/* Monotonically increasing time (in seconds) when the coverage update was taken in the backend. */
+	Timestamp float64 `json:"timestamp"`
+
+	/* Identifier for distinguishing coverage events. */
+	Occasion string `json:"occasion"`
+
+	/* Coverage data for the current isolate. */
+	Result []ScriptCoverage `json:"result"`
+}
+
+// UnmarshalCDP reads PreciseCoverageDeltaUpdateEvent from JSON
+func (v *PreciseCoverageDeltaUpdateEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = d.Float()
+		case "occasion":
+			v.Occasion = d.String()
+		case "result":
+			if d.Array() {
+				v.Result = make([]ScriptCoverage, 0)
+				for d.More() {
+					var x0 ScriptCoverage
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PreciseCoverageDeltaUpdateEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type PreciseCoverageDeltaUpdateHandler func(ev PreciseCoverageDeltaUpdateEvent)
+
+// EventMethod is Profiler.preciseCoverageDeltaUpdate
+func (PreciseCoverageDeltaUpdateEvent) EventMethod() string {
+	return "Profiler.preciseCoverageDeltaUpdate"
+}
+
+// OnPreciseCoverageDeltaUpdate calls handler for each Profiler.preciseCoverageDeltaUpdate event
+func (c Client) OnPreciseCoverageDeltaUpdate(handler PreciseCoverageDeltaUpdateHandler) (unsubscribe func()) {
+	return c.caller.On("Profiler.preciseCoverageDeltaUpdate", func(ev interface{}) {
+		handler(ev.(PreciseCoverageDeltaUpdateEvent))
+	})
+}
+
+func init() {
+	decoders["Profiler.preciseCoverageDeltaUpdate"] = cdp.Decode[PreciseCoverageDeltaUpdateEvent]
+}
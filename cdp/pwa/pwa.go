@@ -0,0 +1,697 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package pwa is the PWA domain of the chrome devtools protocol
+//
+// This domain allows interacting with the browser to control PWAs.
+//
+// Experimental: this may change or be removed in any chrome release
+package pwa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends PWA commands and listens for PWA events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// The following types are the replica of
+// https://crsrc.org/c/chrome/browser/web_applications/proto/web_app_os_integration_state.proto;drc=9910d3be894c8f142c977ba1023f30a656bc13fc;l=67
+type FileHandlerAccept struct {
+	/* New name of the mimetype according to
+	https://www.iana.org/assignments/media-types/media-types.xhtml */
+	MediaType      string   `json:"mediaType"`
+	FileExtensions []string `json:"fileExtensions"`
+}
+
+// MarshalCDP writes FileHandlerAccept as JSON
+func (v *FileHandlerAccept) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("mediaType")
+	e.String(v.MediaType)
+	e.Key("fileExtensions")
+	if v.FileExtensions == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.FileExtensions {
+			e.Elem()
+			e.String(v.FileExtensions[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FileHandlerAccept) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FileHandlerAccept from JSON
+func (v *FileHandlerAccept) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "mediaType":
+			v.MediaType = d.String()
+		case "fileExtensions":
+			if d.Array() {
+				v.FileExtensions = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.FileExtensions = append(v.FileExtensions, x0)
+				}
+			} else {
+				v.FileExtensions = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FileHandlerAccept) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type FileHandler struct {
+	Action      string              `json:"action"`
+	Accepts     []FileHandlerAccept `json:"accepts"`
+	DisplayName string              `json:"displayName"`
+}
+
+// MarshalCDP writes FileHandler as JSON
+func (v *FileHandler) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("action")
+	e.String(v.Action)
+	e.Key("accepts")
+	if v.Accepts == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Accepts {
+			e.Elem()
+			v.Accepts[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("displayName")
+	e.String(v.DisplayName)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FileHandler) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FileHandler from JSON
+func (v *FileHandler) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "action":
+			v.Action = d.String()
+		case "accepts":
+			if d.Array() {
+				v.Accepts = make([]FileHandlerAccept, 0)
+				for d.More() {
+					var x0 FileHandlerAccept
+					x0.UnmarshalCDP(d)
+					v.Accepts = append(v.Accepts, x0)
+				}
+			} else {
+				v.Accepts = nil
+			}
+		case "displayName":
+			v.DisplayName = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FileHandler) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// If user prefers opening the app in browser or an app window.
+type DisplayMode string
+
+// DisplayMode values
+const (
+	DisplayModeStandalone DisplayMode = "standalone"
+	DisplayModeBrowser    DisplayMode = "browser"
+)
+
+// Values gives every DisplayMode
+func (DisplayMode) Values() []DisplayMode {
+	return []DisplayMode{
+		DisplayModeStandalone,
+		DisplayModeBrowser,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DisplayMode) Valid() bool {
+	switch v {
+	case DisplayModeStandalone, DisplayModeBrowser:
+		return true
+	}
+	return false
+}
+
+type GetOsAppStateReturns struct {
+	BadgeCount int `json:"badgeCount"`
+
+	FileHandlers []FileHandler `json:"fileHandlers"`
+}
+
+// UnmarshalCDP reads GetOsAppStateReturns from JSON
+func (v *GetOsAppStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "badgeCount":
+			v.BadgeCount = d.Int()
+		case "fileHandlers":
+			if d.Array() {
+				v.FileHandlers = make([]FileHandler, 0)
+				for d.More() {
+					var x0 FileHandler
+					x0.UnmarshalCDP(d)
+					v.FileHandlers = append(v.FileHandlers, x0)
+				}
+			} else {
+				v.FileHandlers = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetOsAppStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetOsAppStateParams are the parameters for PWA.getOsAppState
+// optional parameters are left out when nil
+type GetOsAppStateParams struct {
+	/* The id from the webapp's manifest file, commonly it's the url of the
+	site installing the webapp. See
+	https://web.dev/learn/pwa/web-app-manifest. */
+	ManifestId string `json:"manifestId"`
+}
+
+// MarshalCDP writes GetOsAppStateParams as JSON
+func (v *GetOsAppStateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetOsAppStateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the following OS state for the given manifest id.
+func (c Client) GetOsAppState(params GetOsAppStateParams) (GetOsAppStateReturns, error) {
+	return c.GetOsAppStateContext(context.Background(), params)
+}
+
+// GetOsAppStateContext is GetOsAppState with a context for cancellation and deadlines
+func (c Client) GetOsAppStateContext(ctx context.Context, params GetOsAppStateParams) (GetOsAppStateReturns, error) {
+	var returns_ GetOsAppStateReturns
+
+	err_ := c.caller.Call(ctx, "PWA.getOsAppState", &params, &returns_)
+
+	return returns_, err_
+}
+
+type InstallReturns struct {
+}
+
+// UnmarshalCDP reads InstallReturns from JSON
+func (v *InstallReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InstallReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// InstallParams are the parameters for PWA.install
+// optional parameters are left out when nil
+type InstallParams struct {
+	ManifestId string `json:"manifestId"`
+	/* The location of the app or bundle overriding the one derived from the
+	manifestId. */
+	InstallUrlOrBundleUrl *string `json:"installUrlOrBundleUrl,omitempty"`
+}
+
+// MarshalCDP writes InstallParams as JSON
+func (v *InstallParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	if v.InstallUrlOrBundleUrl != nil {
+		e.Key("installUrlOrBundleUrl")
+		e.String(*v.InstallUrlOrBundleUrl)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InstallParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Installs the given manifest identity, optionally using the given installUrlOrBundleUrl
+//
+// IWA-specific install description:
+// manifestId corresponds to isolated-app:// + web_package::SignedWebBundleId
+//
+// File installation mode:
+// The installUrlOrBundleUrl can be either file:// or http(s):// pointing
+// to a signed web bundle (.swbn). In this case SignedWebBundleId must correspond to
+// The .swbn file's signing key.
+//
+// Dev proxy installation mode:
+// installUrlOrBundleUrl must be http(s):// that serves dev mode IWA.
+// web_package::SignedWebBundleId must be of type dev proxy.
+//
+// The advantage of dev proxy mode is that all changes to IWA
+// automatically will be reflected in the running app without
+// reinstallation.
+//
+// To generate bundle id for proxy mode:
+// 1. Generate 32 random bytes.
+// 2. Add a specific suffix 0x00 at the end.
+// 3. Encode the entire sequence using Base32 without padding.
+//
+// If Chrome is not in IWA dev
+// mode, the installation will fail, regardless of the state of the allowlist.
+func (c Client) Install(params InstallParams) (InstallReturns, error) {
+	return c.InstallContext(context.Background(), params)
+}
+
+// InstallContext is Install with a context for cancellation and deadlines
+func (c Client) InstallContext(ctx context.Context, params InstallParams) (InstallReturns, error) {
+	var returns_ InstallReturns
+
+	err_ := c.caller.Call(ctx, "PWA.install", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UninstallReturns struct {
+}
+
+// UnmarshalCDP reads UninstallReturns from JSON
+func (v *UninstallReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UninstallReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UninstallParams are the parameters for PWA.uninstall
+// optional parameters are left out when nil
+type UninstallParams struct {
+	ManifestId string `json:"manifestId"`
+}
+
+// MarshalCDP writes UninstallParams as JSON
+func (v *UninstallParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UninstallParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Uninstalls the given manifest_id and closes any opened app windows.
+func (c Client) Uninstall(params UninstallParams) (UninstallReturns, error) {
+	return c.UninstallContext(context.Background(), params)
+}
+
+// UninstallContext is Uninstall with a context for cancellation and deadlines
+func (c Client) UninstallContext(ctx context.Context, params UninstallParams) (UninstallReturns, error) {
+	var returns_ UninstallReturns
+
+	err_ := c.caller.Call(ctx, "PWA.uninstall", &params, &returns_)
+
+	return returns_, err_
+}
+
+type LaunchReturns struct {
+
+	/* ID of the tab target created as a result. */
+	TargetId cdp.TargetTargetID `json:"targetId"`
+}
+
+// UnmarshalCDP reads LaunchReturns from JSON
+func (v *LaunchReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetId":
+			v.TargetId = cdp.TargetTargetID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LaunchReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// LaunchParams are the parameters for PWA.launch
+// optional parameters are left out when nil
+type LaunchParams struct {
+	ManifestId string  `json:"manifestId"`
+	Url        *string `json:"url,omitempty"`
+}
+
+// MarshalCDP writes LaunchParams as JSON
+func (v *LaunchParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LaunchParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Launches the installed web app, or an url in the same web app instead of the
+// default start url if it is provided. Returns a page Target.TargetID which
+// can be used to attach to via Target.attachToTarget or similar APIs.
+func (c Client) Launch(params LaunchParams) (LaunchReturns, error) {
+	return c.LaunchContext(context.Background(), params)
+}
+
+// LaunchContext is Launch with a context for cancellation and deadlines
+func (c Client) LaunchContext(ctx context.Context, params LaunchParams) (LaunchReturns, error) {
+	var returns_ LaunchReturns
+
+	err_ := c.caller.Call(ctx, "PWA.launch", &params, &returns_)
+
+	return returns_, err_
+}
+
+type LaunchFilesInAppReturns struct {
+
+	/* IDs of the tab targets created as the result. */
+	TargetIds []cdp.TargetTargetID `json:"targetIds"`
+}
+
+// UnmarshalCDP reads LaunchFilesInAppReturns from JSON
+func (v *LaunchFilesInAppReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetIds":
+			if d.Array() {
+				v.TargetIds = make([]cdp.TargetTargetID, 0)
+				for d.More() {
+					var x0 cdp.TargetTargetID
+					x0 = cdp.TargetTargetID(d.String())
+					v.TargetIds = append(v.TargetIds, x0)
+				}
+			} else {
+				v.TargetIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *LaunchFilesInAppReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// LaunchFilesInAppParams are the parameters for PWA.launchFilesInApp
+// optional parameters are left out when nil
+type LaunchFilesInAppParams struct {
+	ManifestId string   `json:"manifestId"`
+	Files      []string `json:"files"`
+}
+
+// MarshalCDP writes LaunchFilesInAppParams as JSON
+func (v *LaunchFilesInAppParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	e.Key("files")
+	if v.Files == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Files {
+			e.Elem()
+			e.String(v.Files[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v LaunchFilesInAppParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Opens one or more local files from an installed web app identified by its
+// manifestId. The web app needs to have file handlers registered to process
+// the files. The API returns one or more page Target.TargetIDs which can be
+// used to attach to via Target.attachToTarget or similar APIs.
+// If some files in the parameters cannot be handled by the web app, they will
+// be ignored. If none of the files can be handled, this API returns an error.
+// If no files are provided as the parameter, this API also returns an error.
+//
+// According to the definition of the file handlers in the manifest file, one
+// Target.TargetID may represent a page handling one or more files. The order
+// of the returned Target.TargetIDs is not guaranteed.
+//
+// TODO(crbug.com/339454034): Check the existences of the input files.
+func (c Client) LaunchFilesInApp(params LaunchFilesInAppParams) (LaunchFilesInAppReturns, error) {
+	return c.LaunchFilesInAppContext(context.Background(), params)
+}
+
+// LaunchFilesInAppContext is LaunchFilesInApp with a context for cancellation and deadlines
+func (c Client) LaunchFilesInAppContext(ctx context.Context, params LaunchFilesInAppParams) (LaunchFilesInAppReturns, error) {
+	var returns_ LaunchFilesInAppReturns
+
+	err_ := c.caller.Call(ctx, "PWA.launchFilesInApp", &params, &returns_)
+
+	return returns_, err_
+}
+
+type OpenCurrentPageInAppReturns struct {
+}
+
+// UnmarshalCDP reads OpenCurrentPageInAppReturns from JSON
+func (v *OpenCurrentPageInAppReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OpenCurrentPageInAppReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// OpenCurrentPageInAppParams are the parameters for PWA.openCurrentPageInApp
+// optional parameters are left out when nil
+type OpenCurrentPageInAppParams struct {
+	ManifestId string `json:"manifestId"`
+}
+
+// MarshalCDP writes OpenCurrentPageInAppParams as JSON
+func (v *OpenCurrentPageInAppParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OpenCurrentPageInAppParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Opens the current page in its web app identified by the manifest id, needs
+// to be called on a page target. This function returns immediately without
+// waiting for the app to finish loading.
+func (c Client) OpenCurrentPageInApp(params OpenCurrentPageInAppParams) (OpenCurrentPageInAppReturns, error) {
+	return c.OpenCurrentPageInAppContext(context.Background(), params)
+}
+
+// OpenCurrentPageInAppContext is OpenCurrentPageInApp with a context for cancellation and deadlines
+func (c Client) OpenCurrentPageInAppContext(ctx context.Context, params OpenCurrentPageInAppParams) (OpenCurrentPageInAppReturns, error) {
+	var returns_ OpenCurrentPageInAppReturns
+
+	err_ := c.caller.Call(ctx, "PWA.openCurrentPageInApp", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ChangeAppUserSettingsReturns struct {
+}
+
+// UnmarshalCDP reads ChangeAppUserSettingsReturns from JSON
+func (v *ChangeAppUserSettingsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ChangeAppUserSettingsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ChangeAppUserSettingsParams are the parameters for PWA.changeAppUserSettings
+// optional parameters are left out when nil
+type ChangeAppUserSettingsParams struct {
+	ManifestId string `json:"manifestId"`
+	/* If user allows the links clicked on by the user in the app's scope, or
+	extended scope if the manifest has scope extensions and the flags
+	`DesktopPWAsLinkCapturingWithScopeExtensions` and
+	`WebAppEnableScopeExtensions` are enabled.
+
+	Note, the API does not support resetting the linkCapturing to the
+	initial value, uninstalling and installing the web app again will reset
+	it.
+
+	TODO(crbug.com/339453269): Setting this value on ChromeOS is not
+	supported yet. */
+	LinkCapturing *bool        `json:"linkCapturing,omitempty"`
+	DisplayMode   *DisplayMode `json:"displayMode,omitempty"`
+}
+
+// MarshalCDP writes ChangeAppUserSettingsParams as JSON
+func (v *ChangeAppUserSettingsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("manifestId")
+	e.String(v.ManifestId)
+	if v.LinkCapturing != nil {
+		e.Key("linkCapturing")
+		e.Bool(*v.LinkCapturing)
+	}
+	if v.DisplayMode != nil {
+		e.Key("displayMode")
+		e.String(string(*v.DisplayMode))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ChangeAppUserSettingsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Changes user settings of the web app identified by its manifestId. If the
+// app was not installed, this command returns an error. Unset parameters will
+// be ignored; unrecognized values will cause an error.
+//
+// Unlike the ones defined in the manifest files of the web apps, these
+// settings are provided by the browser and controlled by the users, they
+// impact the way the browser handling the web apps.
+//
+// See the comment of each parameter.
+func (c Client) ChangeAppUserSettings(params ChangeAppUserSettingsParams) (ChangeAppUserSettingsReturns, error) {
+	return c.ChangeAppUserSettingsContext(context.Background(), params)
+}
+
+// ChangeAppUserSettingsContext is ChangeAppUserSettings with a context for cancellation and deadlines
+func (c Client) ChangeAppUserSettingsContext(ctx context.Context, params ChangeAppUserSettingsParams) (ChangeAppUserSettingsReturns, error) {
+	var returns_ ChangeAppUserSettingsReturns
+
+	if params.DisplayMode != nil && !params.DisplayMode.Valid() {
+		return returns_, fmt.Errorf("PWA.changeAppUserSettings: invalid displayMode %q", *params.DisplayMode)
+	}
+
+	err_ := c.caller.Call(ctx, "PWA.changeAppUserSettings", &params, &returns_)
+
+	return returns_, err_
+}
@@ -0,0 +1,2448 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package runtime is the Runtime domain of the chrome devtools protocol
+//
+// Runtime domain exposes JavaScript runtime by means of remote evaluation and mirror objects.
+// Evaluation results are returned as mirror object that expose object type, string representation
+// and unique identifier that can be used for further object reference. Original objects are
+// maintained in memory unless they are either explicitly released or are released along with the
+// other objects in their object group.
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Runtime commands and listens for Runtime events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	ScriptId                = cdp.RuntimeScriptId
+	DeepSerializedValue     = cdp.RuntimeDeepSerializedValue
+	DeepSerializedValueType = cdp.RuntimeDeepSerializedValueType
+	RemoteObjectId          = cdp.RuntimeRemoteObjectId
+	UnserializableValue     = cdp.RuntimeUnserializableValue
+	RemoteObject            = cdp.RuntimeRemoteObject
+	RemoteObjectType        = cdp.RuntimeRemoteObjectType
+	RemoteObjectSubtype     = cdp.RuntimeRemoteObjectSubtype
+	CustomPreview           = cdp.RuntimeCustomPreview
+	ObjectPreview           = cdp.RuntimeObjectPreview
+	ObjectPreviewType       = cdp.RuntimeObjectPreviewType
+	ObjectPreviewSubtype    = cdp.RuntimeObjectPreviewSubtype
+	PropertyPreview         = cdp.RuntimePropertyPreview
+	PropertyPreviewType     = cdp.RuntimePropertyPreviewType
+	PropertyPreviewSubtype  = cdp.RuntimePropertyPreviewSubtype
+	EntryPreview            = cdp.RuntimeEntryPreview
+	CallArgument            = cdp.RuntimeCallArgument
+	ExecutionContextId      = cdp.RuntimeExecutionContextId
+	ExceptionDetails        = cdp.RuntimeExceptionDetails
+	Timestamp               = cdp.RuntimeTimestamp
+	TimeDelta               = cdp.RuntimeTimeDelta
+	CallFrame               = cdp.RuntimeCallFrame
+	StackTrace              = cdp.RuntimeStackTrace
+	UniqueDebuggerId        = cdp.RuntimeUniqueDebuggerId
+	StackTraceId            = cdp.RuntimeStackTraceId
+)
+
+// DeepSerializedValueType values
+const (
+	DeepSerializedValueTypeUndefined   = cdp.RuntimeDeepSerializedValueTypeUndefined
+	DeepSerializedValueTypeNull        = cdp.RuntimeDeepSerializedValueTypeNull
+	DeepSerializedValueTypeString      = cdp.RuntimeDeepSerializedValueTypeString
+	DeepSerializedValueTypeNumber      = cdp.RuntimeDeepSerializedValueTypeNumber
+	DeepSerializedValueTypeBoolean     = cdp.RuntimeDeepSerializedValueTypeBoolean
+	DeepSerializedValueTypeBigint      = cdp.RuntimeDeepSerializedValueTypeBigint
+	DeepSerializedValueTypeRegexp      = cdp.RuntimeDeepSerializedValueTypeRegexp
+	DeepSerializedValueTypeDate        = cdp.RuntimeDeepSerializedValueTypeDate
+	DeepSerializedValueTypeSymbol      = cdp.RuntimeDeepSerializedValueTypeSymbol
+	DeepSerializedValueTypeArray       = cdp.RuntimeDeepSerializedValueTypeArray
+	DeepSerializedValueTypeObject      = cdp.RuntimeDeepSerializedValueTypeObject
+	DeepSerializedValueTypeFunction    = cdp.RuntimeDeepSerializedValueTypeFunction
+	DeepSerializedValueTypeMap         = cdp.RuntimeDeepSerializedValueTypeMap
+	DeepSerializedValueTypeSet         = cdp.RuntimeDeepSerializedValueTypeSet
+	DeepSerializedValueTypeWeakmap     = cdp.RuntimeDeepSerializedValueTypeWeakmap
+	DeepSerializedValueTypeWeakset     = cdp.RuntimeDeepSerializedValueTypeWeakset
+	DeepSerializedValueTypeError       = cdp.RuntimeDeepSerializedValueTypeError
+	DeepSerializedValueTypeProxy       = cdp.RuntimeDeepSerializedValueTypeProxy
+	DeepSerializedValueTypePromise     = cdp.RuntimeDeepSerializedValueTypePromise
+	DeepSerializedValueTypeTypedarray  = cdp.RuntimeDeepSerializedValueTypeTypedarray
+	DeepSerializedValueTypeArraybuffer = cdp.RuntimeDeepSerializedValueTypeArraybuffer
+	DeepSerializedValueTypeNode        = cdp.RuntimeDeepSerializedValueTypeNode
+	DeepSerializedValueTypeWindow      = cdp.RuntimeDeepSerializedValueTypeWindow
+	DeepSerializedValueTypeGenerator   = cdp.RuntimeDeepSerializedValueTypeGenerator
+)
+
+// RemoteObjectType values
+const (
+	RemoteObjectTypeObject    = cdp.RuntimeRemoteObjectTypeObject
+	RemoteObjectTypeFunction  = cdp.RuntimeRemoteObjectTypeFunction
+	RemoteObjectTypeUndefined = cdp.RuntimeRemoteObjectTypeUndefined
+	RemoteObjectTypeString    = cdp.RuntimeRemoteObjectTypeString
+	RemoteObjectTypeNumber    = cdp.RuntimeRemoteObjectTypeNumber
+	RemoteObjectTypeBoolean   = cdp.RuntimeRemoteObjectTypeBoolean
+	RemoteObjectTypeSymbol    = cdp.RuntimeRemoteObjectTypeSymbol
+	RemoteObjectTypeBigint    = cdp.RuntimeRemoteObjectTypeBigint
+)
+
+// RemoteObjectSubtype values
+const (
+	RemoteObjectSubtypeArray             = cdp.RuntimeRemoteObjectSubtypeArray
+	RemoteObjectSubtypeNull              = cdp.RuntimeRemoteObjectSubtypeNull
+	RemoteObjectSubtypeNode              = cdp.RuntimeRemoteObjectSubtypeNode
+	RemoteObjectSubtypeRegexp            = cdp.RuntimeRemoteObjectSubtypeRegexp
+	RemoteObjectSubtypeDate              = cdp.RuntimeRemoteObjectSubtypeDate
+	RemoteObjectSubtypeMap               = cdp.RuntimeRemoteObjectSubtypeMap
+	RemoteObjectSubtypeSet               = cdp.RuntimeRemoteObjectSubtypeSet
+	RemoteObjectSubtypeWeakmap           = cdp.RuntimeRemoteObjectSubtypeWeakmap
+	RemoteObjectSubtypeWeakset           = cdp.RuntimeRemoteObjectSubtypeWeakset
+	RemoteObjectSubtypeIterator          = cdp.RuntimeRemoteObjectSubtypeIterator
+	RemoteObjectSubtypeGenerator         = cdp.RuntimeRemoteObjectSubtypeGenerator
+	RemoteObjectSubtypeError             = cdp.RuntimeRemoteObjectSubtypeError
+	RemoteObjectSubtypeProxy             = cdp.RuntimeRemoteObjectSubtypeProxy
+	RemoteObjectSubtypePromise           = cdp.RuntimeRemoteObjectSubtypePromise
+	RemoteObjectSubtypeTypedarray        = cdp.RuntimeRemoteObjectSubtypeTypedarray
+	RemoteObjectSubtypeArraybuffer       = cdp.RuntimeRemoteObjectSubtypeArraybuffer
+	RemoteObjectSubtypeDataview          = cdp.RuntimeRemoteObjectSubtypeDataview
+	RemoteObjectSubtypeWebassemblymemory = cdp.RuntimeRemoteObjectSubtypeWebassemblymemory
+	RemoteObjectSubtypeWasmvalue         = cdp.RuntimeRemoteObjectSubtypeWasmvalue
+)
+
+// ObjectPreviewType values
+const (
+	ObjectPreviewTypeObject    = cdp.RuntimeObjectPreviewTypeObject
+	ObjectPreviewTypeFunction  = cdp.RuntimeObjectPreviewTypeFunction
+	ObjectPreviewTypeUndefined = cdp.RuntimeObjectPreviewTypeUndefined
+	ObjectPreviewTypeString    = cdp.RuntimeObjectPreviewTypeString
+	ObjectPreviewTypeNumber    = cdp.RuntimeObjectPreviewTypeNumber
+	ObjectPreviewTypeBoolean   = cdp.RuntimeObjectPreviewTypeBoolean
+	ObjectPreviewTypeSymbol    = cdp.RuntimeObjectPreviewTypeSymbol
+	ObjectPreviewTypeBigint    = cdp.RuntimeObjectPreviewTypeBigint
+)
+
+// ObjectPreviewSubtype values
+const (
+	ObjectPreviewSubtypeArray             = cdp.RuntimeObjectPreviewSubtypeArray
+	ObjectPreviewSubtypeNull              = cdp.RuntimeObjectPreviewSubtypeNull
+	ObjectPreviewSubtypeNode              = cdp.RuntimeObjectPreviewSubtypeNode
+	ObjectPreviewSubtypeRegexp            = cdp.RuntimeObjectPreviewSubtypeRegexp
+	ObjectPreviewSubtypeDate              = cdp.RuntimeObjectPreviewSubtypeDate
+	ObjectPreviewSubtypeMap               = cdp.RuntimeObjectPreviewSubtypeMap
+	ObjectPreviewSubtypeSet               = cdp.RuntimeObjectPreviewSubtypeSet
+	ObjectPreviewSubtypeWeakmap           = cdp.RuntimeObjectPreviewSubtypeWeakmap
+	ObjectPreviewSubtypeWeakset           = cdp.RuntimeObjectPreviewSubtypeWeakset
+	ObjectPreviewSubtypeIterator          = cdp.RuntimeObjectPreviewSubtypeIterator
+	ObjectPreviewSubtypeGenerator         = cdp.RuntimeObjectPreviewSubtypeGenerator
+	ObjectPreviewSubtypeError             = cdp.RuntimeObjectPreviewSubtypeError
+	ObjectPreviewSubtypeProxy             = cdp.RuntimeObjectPreviewSubtypeProxy
+	ObjectPreviewSubtypePromise           = cdp.RuntimeObjectPreviewSubtypePromise
+	ObjectPreviewSubtypeTypedarray        = cdp.RuntimeObjectPreviewSubtypeTypedarray
+	ObjectPreviewSubtypeArraybuffer       = cdp.RuntimeObjectPreviewSubtypeArraybuffer
+	ObjectPreviewSubtypeDataview          = cdp.RuntimeObjectPreviewSubtypeDataview
+	ObjectPreviewSubtypeWebassemblymemory = cdp.RuntimeObjectPreviewSubtypeWebassemblymemory
+	ObjectPreviewSubtypeWasmvalue         = cdp.RuntimeObjectPreviewSubtypeWasmvalue
+)
+
+// PropertyPreviewType values
+const (
+	PropertyPreviewTypeObject    = cdp.RuntimePropertyPreviewTypeObject
+	PropertyPreviewTypeFunction  = cdp.RuntimePropertyPreviewTypeFunction
+	PropertyPreviewTypeUndefined = cdp.RuntimePropertyPreviewTypeUndefined
+	PropertyPreviewTypeString    = cdp.RuntimePropertyPreviewTypeString
+	PropertyPreviewTypeNumber    = cdp.RuntimePropertyPreviewTypeNumber
+	PropertyPreviewTypeBoolean   = cdp.RuntimePropertyPreviewTypeBoolean
+	PropertyPreviewTypeSymbol    = cdp.RuntimePropertyPreviewTypeSymbol
+	PropertyPreviewTypeAccessor  = cdp.RuntimePropertyPreviewTypeAccessor
+	PropertyPreviewTypeBigint    = cdp.RuntimePropertyPreviewTypeBigint
+)
+
+// PropertyPreviewSubtype values
+const (
+	PropertyPreviewSubtypeArray             = cdp.RuntimePropertyPreviewSubtypeArray
+	PropertyPreviewSubtypeNull              = cdp.RuntimePropertyPreviewSubtypeNull
+	PropertyPreviewSubtypeNode              = cdp.RuntimePropertyPreviewSubtypeNode
+	PropertyPreviewSubtypeRegexp            = cdp.RuntimePropertyPreviewSubtypeRegexp
+	PropertyPreviewSubtypeDate              = cdp.RuntimePropertyPreviewSubtypeDate
+	PropertyPreviewSubtypeMap               = cdp.RuntimePropertyPreviewSubtypeMap
+	PropertyPreviewSubtypeSet               = cdp.RuntimePropertyPreviewSubtypeSet
+	PropertyPreviewSubtypeWeakmap           = cdp.RuntimePropertyPreviewSubtypeWeakmap
+	PropertyPreviewSubtypeWeakset           = cdp.RuntimePropertyPreviewSubtypeWeakset
+	PropertyPreviewSubtypeIterator          = cdp.RuntimePropertyPreviewSubtypeIterator
+	PropertyPreviewSubtypeGenerator         = cdp.RuntimePropertyPreviewSubtypeGenerator
+	PropertyPreviewSubtypeError             = cdp.RuntimePropertyPreviewSubtypeError
+	PropertyPreviewSubtypeProxy             = cdp.RuntimePropertyPreviewSubtypeProxy
+	PropertyPreviewSubtypePromise           = cdp.RuntimePropertyPreviewSubtypePromise
+	PropertyPreviewSubtypeTypedarray        = cdp.RuntimePropertyPreviewSubtypeTypedarray
+	PropertyPreviewSubtypeArraybuffer       = cdp.RuntimePropertyPreviewSubtypeArraybuffer
+	PropertyPreviewSubtypeDataview          = cdp.RuntimePropertyPreviewSubtypeDataview
+	PropertyPreviewSubtypeWebassemblymemory = cdp.RuntimePropertyPreviewSubtypeWebassemblymemory
+	PropertyPreviewSubtypeWasmvalue         = cdp.RuntimePropertyPreviewSubtypeWasmvalue
+)
+
+// Represents options for serialization. Overrides `generatePreview` and `returnByValue`.
+type SerializationOptions struct {
+	Serialization SerializationOptionsSerialization `json:"serialization"`
+	/* Deep serialization depth. Default is full depth. Respected only in `deep` serialization mode. */
+	MaxDepth *int `json:"maxDepth,omitempty"`
+	/* Embedder-specific parameters. For example if connected to V8 in Chrome these control DOM
+	serialization via `maxNodeDepth: integer` and `includeShadowTree: "none" | "open" | "all"`.
+	Values can be only of type string or integer. */
+	AdditionalParameters map[string]interface{} `json:"additionalParameters"`
+}
+
+// MarshalCDP writes SerializationOptions as JSON
+func (v *SerializationOptions) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("serialization")
+	e.String(string(v.Serialization))
+	if v.MaxDepth != nil {
+		e.Key("maxDepth")
+		e.Int(*v.MaxDepth)
+	}
+	if v.AdditionalParameters != nil {
+		e.Key("additionalParameters")
+		e.Map(v.AdditionalParameters)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SerializationOptions) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SerializationOptions from JSON
+func (v *SerializationOptions) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "serialization":
+			v.Serialization = SerializationOptionsSerialization(d.String())
+		case "maxDepth":
+			if d.Null() {
+				v.MaxDepth = nil
+			} else {
+				v.MaxDepth = new(int)
+				*v.MaxDepth = d.Int()
+			}
+		case "additionalParameters":
+			v.AdditionalParameters = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SerializationOptions) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SerializationOptionsSerialization string
+
+// SerializationOptionsSerialization values
+const (
+	SerializationOptionsSerializationDeep   SerializationOptionsSerialization = "deep"
+	SerializationOptionsSerializationJson   SerializationOptionsSerialization = "json"
+	SerializationOptionsSerializationIdOnly SerializationOptionsSerialization = "idOnly"
+)
+
+// Values gives every SerializationOptionsSerialization
+func (SerializationOptionsSerialization) Values() []SerializationOptionsSerialization {
+	return []SerializationOptionsSerialization{
+		SerializationOptionsSerializationDeep,
+		SerializationOptionsSerializationJson,
+		SerializationOptionsSerializationIdOnly,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SerializationOptionsSerialization) Valid() bool {
+	switch v {
+	case SerializationOptionsSerializationDeep, SerializationOptionsSerializationJson, SerializationOptionsSerializationIdOnly:
+		return true
+	}
+	return false
+}
+
+// Object property descriptor.
+type PropertyDescriptor struct {
+	/* Property name or symbol description. */
+	Name string `json:"name"`
+	/* The value associated with the property. */
+	Value *RemoteObject `json:"value,omitempty"`
+	/* True if the value associated with the property may be changed (data descriptors only). */
+	Writable *bool `json:"writable,omitempty"`
+	/* A function which serves as a getter for the property, or `undefined` if there is no getter
+	(accessor descriptors only). */
+	Get *RemoteObject `json:"get,omitempty"`
+	/* A function which serves as a setter for the property, or `undefined` if there is no setter
+	(accessor descriptors only). */
+	Set *RemoteObject `json:"set,omitempty"`
+	/* True if the type of this property descriptor may be changed and if the property may be
+	deleted from the corresponding object. */
+	Configurable bool `json:"configurable"`
+	/* True if this property shows up during enumeration of the properties on the corresponding
+	object. */
+	Enumerable bool `json:"enumerable"`
+	/* True if the result was thrown during the evaluation. */
+	WasThrown *bool `json:"wasThrown,omitempty"`
+	/* True if the property is owned for the object. */
+	IsOwn *bool `json:"isOwn,omitempty"`
+	/* Property symbol object, if the property is of the `symbol` type. */
+	Symbol *RemoteObject `json:"symbol,omitempty"`
+}
+
+// MarshalCDP writes PropertyDescriptor as JSON
+func (v *PropertyDescriptor) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.Value != nil {
+		e.Key("value")
+		(*v.Value).MarshalCDP(e)
+	}
+	if v.Writable != nil {
+		e.Key("writable")
+		e.Bool(*v.Writable)
+	}
+	if v.Get != nil {
+		e.Key("get")
+		(*v.Get).MarshalCDP(e)
+	}
+	if v.Set != nil {
+		e.Key("set")
+		(*v.Set).MarshalCDP(e)
+	}
+	e.Key("configurable")
+	e.Bool(v.Configurable)
+	e.Key("enumerable")
+	e.Bool(v.Enumerable)
+	if v.WasThrown != nil {
+		e.Key("wasThrown")
+		e.Bool(*v.WasThrown)
+	}
+	if v.IsOwn != nil {
+		e.Key("isOwn")
+		e.Bool(*v.IsOwn)
+	}
+	if v.Symbol != nil {
+		e.Key("symbol")
+		(*v.Symbol).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PropertyDescriptor) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PropertyDescriptor from JSON
+func (v *PropertyDescriptor) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(RemoteObject)
+				(*v.Value).UnmarshalCDP(d)
+			}
+		case "writable":
+			if d.Null() {
+				v.Writable = nil
+			} else {
+				v.Writable = new(bool)
+				*v.Writable = d.Bool()
+			}
+		case "get":
+			if d.Null() {
+				v.Get = nil
+			} else {
+				v.Get = new(RemoteObject)
+				(*v.Get).UnmarshalCDP(d)
+			}
+		case "set":
+			if d.Null() {
+				v.Set = nil
+			} else {
+				v.Set = new(RemoteObject)
+				(*v.Set).UnmarshalCDP(d)
+			}
+		case "configurable":
+			v.Configurable = d.Bool()
+		case "enumerable":
+			v.Enumerable = d.Bool()
+		case "wasThrown":
+			if d.Null() {
+				v.WasThrown = nil
+			} else {
+				v.WasThrown = new(bool)
+				*v.WasThrown = d.Bool()
+			}
+		case "isOwn":
+			if d.Null() {
+				v.IsOwn = nil
+			} else {
+				v.IsOwn = new(bool)
+				*v.IsOwn = d.Bool()
+			}
+		case "symbol":
+			if d.Null() {
+				v.Symbol = nil
+			} else {
+				v.Symbol = new(RemoteObject)
+				(*v.Symbol).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PropertyDescriptor) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Object internal property descriptor. This property isn't normally visible in JavaScript code.
+type InternalPropertyDescriptor struct {
+	/* Conventional property name. */
+	Name string `json:"name"`
+	/* The value associated with the property. */
+	Value *RemoteObject `json:"value,omitempty"`
+}
+
+// MarshalCDP writes InternalPropertyDescriptor as JSON
+func (v *InternalPropertyDescriptor) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.Value != nil {
+		e.Key("value")
+		(*v.Value).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InternalPropertyDescriptor) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InternalPropertyDescriptor from JSON
+func (v *InternalPropertyDescriptor) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(RemoteObject)
+				(*v.Value).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InternalPropertyDescriptor) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Object private field descriptor.
+//
+// Experimental: this may change or be removed in any chrome release
+type PrivatePropertyDescriptor struct {
+	/* Private property name. */
+	Name string `json:"name"`
+	/* The value associated with the private property. */
+	Value *RemoteObject `json:"value,omitempty"`
+	/* A function which serves as a getter for the private property,
+	or `undefined` if there is no getter (accessor descriptors only). */
+	Get *RemoteObject `json:"get,omitempty"`
+	/* A function which serves as a setter for the private property,
+	or `undefined` if there is no setter (accessor descriptors only). */
+	Set *RemoteObject `json:"set,omitempty"`
+}
+
+// MarshalCDP writes PrivatePropertyDescriptor as JSON
+func (v *PrivatePropertyDescriptor) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.Value != nil {
+		e.Key("value")
+		(*v.Value).MarshalCDP(e)
+	}
+	if v.Get != nil {
+		e.Key("get")
+		(*v.Get).MarshalCDP(e)
+	}
+	if v.Set != nil {
+		e.Key("set")
+		(*v.Set).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PrivatePropertyDescriptor) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads PrivatePropertyDescriptor from JSON
+func (v *PrivatePropertyDescriptor) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(RemoteObject)
+				(*v.Value).UnmarshalCDP(d)
+			}
+		case "get":
+			if d.Null() {
+				v.Get = nil
+			} else {
+				v.Get = new(RemoteObject)
+				(*v.Get).UnmarshalCDP(d)
+			}
+		case "set":
+			if d.Null() {
+				v.Set = nil
+			} else {
+				v.Set = new(RemoteObject)
+				(*v.Set).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PrivatePropertyDescriptor) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Description of an isolated world.
+type ExecutionContextDescription struct {
+	/* Unique id of the execution context. It can be used to specify in which execution context
+	script evaluation should be performed. */
+	Id ExecutionContextId `json:"id"`
+	/* Execution context origin. */
+	Origin string `json:"origin"`
+	/* Human readable name describing given context. */
+	Name string `json:"name"`
+	/* A system-unique execution context identifier. Unlike the id, this is unique across
+	multiple processes, so can be reliably used to identify specific context while backend
+	performs a cross-process navigation.
+
+	Experimental: this may change or be removed in any chrome release */
+	UniqueId string `json:"uniqueId"`
+	/* Embedder-specific auxiliary data likely matching {isDefault: boolean, type: 'default'|'isolated'|'worker', frameId: string} */
+	AuxData map[string]interface{} `json:"auxData"`
+}
+
+// MarshalCDP writes ExecutionContextDescription as JSON
+func (v *ExecutionContextDescription) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.Int(int(v.Id))
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("uniqueId")
+	e.String(v.UniqueId)
+	if v.AuxData != nil {
+		e.Key("auxData")
+		e.Map(v.AuxData)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ExecutionContextDescription) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ExecutionContextDescription from JSON
+func (v *ExecutionContextDescription) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = ExecutionContextId(d.Int())
+		case "origin":
+			v.Origin = d.String()
+		case "name":
+			v.Name = d.String()
+		case "uniqueId":
+			v.UniqueId = d.String()
+		case "auxData":
+			v.AuxData = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExecutionContextDescription) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ConsoleAPICalledType string
+
+// ConsoleAPICalledType values
+const (
+	ConsoleAPICalledTypeLog                 ConsoleAPICalledType = "log"
+	ConsoleAPICalledTypeDebug               ConsoleAPICalledType = "debug"
+	ConsoleAPICalledTypeInfo                ConsoleAPICalledType = "info"
+	ConsoleAPICalledTypeError               ConsoleAPICalledType = "error"
+	ConsoleAPICalledTypeWarning             ConsoleAPICalledType = "warning"
+	ConsoleAPICalledTypeDir                 ConsoleAPICalledType = "dir"
+	ConsoleAPICalledTypeDirxml              ConsoleAPICalledType = "dirxml"
+	ConsoleAPICalledTypeTable               ConsoleAPICalledType = "table"
+	ConsoleAPICalledTypeTrace               ConsoleAPICalledType = "trace"
+	ConsoleAPICalledTypeClear               ConsoleAPICalledType = "clear"
+	ConsoleAPICalledTypeStartGroup          ConsoleAPICalledType = "startGroup"
+	ConsoleAPICalledTypeStartGroupCollapsed ConsoleAPICalledType = "startGroupCollapsed"
+	ConsoleAPICalledTypeEndGroup            ConsoleAPICalledType = "endGroup"
+	ConsoleAPICalledTypeAssert              ConsoleAPICalledType = "assert"
+	ConsoleAPICalledTypeProfile             ConsoleAPICalledType = "profile"
+	ConsoleAPICalledTypeProfileEnd          ConsoleAPICalledType = "profileEnd"
+	ConsoleAPICalledTypeCount               ConsoleAPICalledType = "count"
+	ConsoleAPICalledTypeTimeEnd             ConsoleAPICalledType = "timeEnd"
+)
+
+// Values gives every ConsoleAPICalledType
+func (ConsoleAPICalledType) Values() []ConsoleAPICalledType {
+	return []ConsoleAPICalledType{
+		ConsoleAPICalledTypeLog,
+		ConsoleAPICalledTypeDebug,
+		ConsoleAPICalledTypeInfo,
+		ConsoleAPICalledTypeError,
+		ConsoleAPICalledTypeWarning,
+		ConsoleAPICalledTypeDir,
+		ConsoleAPICalledTypeDirxml,
+		ConsoleAPICalledTypeTable,
+		ConsoleAPICalledTypeTrace,
+		ConsoleAPICalledTypeClear,
+		ConsoleAPICalledTypeStartGroup,
+		ConsoleAPICalledTypeStartGroupCollapsed,
+		ConsoleAPICalledTypeEndGroup,
+		ConsoleAPICalledTypeAssert,
+		ConsoleAPICalledTypeProfile,
+		ConsoleAPICalledTypeProfileEnd,
+		ConsoleAPICalledTypeCount,
+		ConsoleAPICalledTypeTimeEnd,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ConsoleAPICalledType) Valid() bool {
+	switch v {
+	case ConsoleAPICalledTypeLog, ConsoleAPICalledTypeDebug, ConsoleAPICalledTypeInfo, ConsoleAPICalledTypeError, ConsoleAPICalledTypeWarning, ConsoleAPICalledTypeDir, ConsoleAPICalledTypeDirxml, ConsoleAPICalledTypeTable, ConsoleAPICalledTypeTrace, ConsoleAPICalledTypeClear, ConsoleAPICalledTypeStartGroup, ConsoleAPICalledTypeStartGroupCollapsed, ConsoleAPICalledTypeEndGroup, ConsoleAPICalledTypeAssert, ConsoleAPICalledTypeProfile, ConsoleAPICalledTypeProfileEnd, ConsoleAPICalledTypeCount, ConsoleAPICalledTypeTimeEnd:
+		return true
+	}
+	return false
+}
+
+type AwaitPromiseReturns struct {
+
+	/* Promise result. Will contain rejected value if promise was rejected. */
+	Result RemoteObject `json:"result"`
+
+	/* Exception details if stack strace is available. */
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads AwaitPromiseReturns from JSON
+func (v *AwaitPromiseReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result.UnmarshalCDP(d)
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AwaitPromiseReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AwaitPromiseParams are the parameters for Runtime.awaitPromise
+// optional parameters are left out when nil
+type AwaitPromiseParams struct {
+	/* Identifier of the promise. */
+	PromiseObjectId RemoteObjectId `json:"promiseObjectId"`
+	/* Whether the result is expected to be a JSON object that should be sent by value. */
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	/* Whether preview should be generated for the result. */
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+}
+
+// MarshalCDP writes AwaitPromiseParams as JSON
+func (v *AwaitPromiseParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("promiseObjectId")
+	e.String(string(v.PromiseObjectId))
+	if v.ReturnByValue != nil {
+		e.Key("returnByValue")
+		e.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		e.Key("generatePreview")
+		e.Bool(*v.GeneratePreview)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AwaitPromiseParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Add handler to promise with given promise object id.
+func (c Client) AwaitPromise(params AwaitPromiseParams) (AwaitPromiseReturns, error) {
+	return c.AwaitPromiseContext(context.Background(), params)
+}
+
+// AwaitPromiseContext is AwaitPromise with a context for cancellation and deadlines
+func (c Client) AwaitPromiseContext(ctx context.Context, params AwaitPromiseParams) (AwaitPromiseReturns, error) {
+	var returns_ AwaitPromiseReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.awaitPromise", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CallFunctionOnReturns struct {
+
+	/* Call result. */
+	Result RemoteObject `json:"result"`
+
+	/* Exception details. */
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads CallFunctionOnReturns from JSON
+func (v *CallFunctionOnReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result.UnmarshalCDP(d)
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CallFunctionOnReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CallFunctionOnParams are the parameters for Runtime.callFunctionOn
+// optional parameters are left out when nil
+type CallFunctionOnParams struct {
+	/* Declaration of the function to call. */
+	FunctionDeclaration string `json:"functionDeclaration"`
+	/* Identifier of the object to call function on. Either objectId or executionContextId should
+	be specified. */
+	ObjectId *RemoteObjectId `json:"objectId,omitempty"`
+	/* Call arguments. All call arguments must belong to the same JavaScript world as the target
+	object. */
+	Arguments []CallArgument `json:"arguments"`
+	/* In silent mode exceptions thrown during evaluation are not reported and do not pause
+	execution. Overrides `setPauseOnException` state. */
+	Silent *bool `json:"silent,omitempty"`
+	/* Whether the result is expected to be a JSON object which should be sent by value.
+	Can be overriden by `serializationOptions`. */
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	/* Whether preview should be generated for the result.
+
+	Experimental: this may change or be removed in any chrome release */
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	/* Whether execution should be treated as initiated by user in the UI. */
+	UserGesture *bool `json:"userGesture,omitempty"`
+	/* Whether execution should `await` for resulting value and return once awaited promise is
+	resolved. */
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+	/* Specifies execution context which global object will be used to call function on. Either
+	executionContextId or objectId should be specified. */
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+	/* Symbolic group name that can be used to release multiple objects. If objectGroup is not
+	specified and objectId is, objectGroup will be inherited from object. */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+	/* Whether to throw an exception if side effect cannot be ruled out during evaluation.
+
+	Experimental: this may change or be removed in any chrome release */
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	/* An alternative way to specify the execution context to call function on.
+	Compared to contextId that may be reused across processes, this is guaranteed to be
+	system-unique, so it can be used to prevent accidental function call
+	in context different than intended (e.g. as a result of navigation across process
+	boundaries).
+	This is mutually exclusive with `executionContextId`.
+
+	Experimental: this may change or be removed in any chrome release */
+	UniqueContextId *string `json:"uniqueContextId,omitempty"`
+	/* Specifies the result serialization. If provided, overrides
+	`generatePreview` and `returnByValue`.
+
+	Experimental: this may change or be removed in any chrome release */
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// MarshalCDP writes CallFunctionOnParams as JSON
+func (v *CallFunctionOnParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("functionDeclaration")
+	e.String(v.FunctionDeclaration)
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.Arguments != nil {
+		e.Key("arguments")
+		e.ArrayStart()
+		for i0 := range v.Arguments {
+			e.Elem()
+			v.Arguments[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Silent != nil {
+		e.Key("silent")
+		e.Bool(*v.Silent)
+	}
+	if v.ReturnByValue != nil {
+		e.Key("returnByValue")
+		e.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		e.Key("generatePreview")
+		e.Bool(*v.GeneratePreview)
+	}
+	if v.UserGesture != nil {
+		e.Key("userGesture")
+		e.Bool(*v.UserGesture)
+	}
+	if v.AwaitPromise != nil {
+		e.Key("awaitPromise")
+		e.Bool(*v.AwaitPromise)
+	}
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	if v.ThrowOnSideEffect != nil {
+		e.Key("throwOnSideEffect")
+		e.Bool(*v.ThrowOnSideEffect)
+	}
+	if v.UniqueContextId != nil {
+		e.Key("uniqueContextId")
+		e.String(*v.UniqueContextId)
+	}
+	if v.SerializationOptions != nil {
+		e.Key("serializationOptions")
+		(*v.SerializationOptions).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CallFunctionOnParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Calls function with given declaration on the given object. Object group of the result is
+// inherited from the target object.
+func (c Client) CallFunctionOn(params CallFunctionOnParams) (CallFunctionOnReturns, error) {
+	return c.CallFunctionOnContext(context.Background(), params)
+}
+
+// CallFunctionOnContext is CallFunctionOn with a context for cancellation and deadlines
+func (c Client) CallFunctionOnContext(ctx context.Context, params CallFunctionOnParams) (CallFunctionOnReturns, error) {
+	var returns_ CallFunctionOnReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.callFunctionOn", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CompileScriptReturns struct {
+
+	/* Id of the script. */
+	ScriptId *ScriptId `json:"scriptId,omitempty"`
+
+	/* Exception details. */
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads CompileScriptReturns from JSON
+func (v *CompileScriptReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			if d.Null() {
+				v.ScriptId = nil
+			} else {
+				v.ScriptId = new(ScriptId)
+				*v.ScriptId = ScriptId(d.String())
+			}
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CompileScriptReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CompileScriptParams are the parameters for Runtime.compileScript
+// optional parameters are left out when nil
+type CompileScriptParams struct {
+	/* Expression to compile. */
+	Expression string `json:"expression"`
+	/* Source url to be set for the script. */
+	SourceURL string `json:"sourceURL"`
+	/* Specifies whether the compiled script should be persisted. */
+	PersistScript bool `json:"persistScript"`
+	/* Specifies in which execution context to perform script run. If the parameter is omitted the
+	evaluation will be performed in the context of the inspected page. */
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+}
+
+// MarshalCDP writes CompileScriptParams as JSON
+func (v *CompileScriptParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("expression")
+	e.String(v.Expression)
+	e.Key("sourceURL")
+	e.String(v.SourceURL)
+	e.Key("persistScript")
+	e.Bool(v.PersistScript)
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CompileScriptParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Compiles expression.
+func (c Client) CompileScript(params CompileScriptParams) (CompileScriptReturns, error) {
+	return c.CompileScriptContext(context.Background(), params)
+}
+
+// CompileScriptContext is CompileScript with a context for cancellation and deadlines
+func (c Client) CompileScriptContext(ctx context.Context, params CompileScriptParams) (CompileScriptReturns, error) {
+	var returns_ CompileScriptReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.compileScript", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables reporting of execution contexts creation.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DiscardConsoleEntriesReturns struct {
+}
+
+// UnmarshalCDP reads DiscardConsoleEntriesReturns from JSON
+func (v *DiscardConsoleEntriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DiscardConsoleEntriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Discards collected exceptions and console API calls.
+func (c Client) DiscardConsoleEntries() (DiscardConsoleEntriesReturns, error) {
+	return c.DiscardConsoleEntriesContext(context.Background())
+}
+
+// DiscardConsoleEntriesContext is DiscardConsoleEntries with a context for cancellation and deadlines
+func (c Client) DiscardConsoleEntriesContext(ctx context.Context) (DiscardConsoleEntriesReturns, error) {
+	var returns_ DiscardConsoleEntriesReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.discardConsoleEntries", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables reporting of execution contexts creation by means of `executionContextCreated` event.
+// When the reporting gets enabled the event will be sent immediately for each existing execution
+// context.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EvaluateReturns struct {
+
+	/* Evaluation result. */
+	Result RemoteObject `json:"result"`
+
+	/* Exception details. */
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads EvaluateReturns from JSON
+func (v *EvaluateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result.UnmarshalCDP(d)
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EvaluateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EvaluateParams are the parameters for Runtime.evaluate
+// optional parameters are left out when nil
+type EvaluateParams struct {
+	/* Expression to evaluate. */
+	Expression string `json:"expression"`
+	/* Symbolic group name that can be used to release multiple objects. */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+	/* Determines whether Command Line API should be available during the evaluation. */
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	/* In silent mode exceptions thrown during evaluation are not reported and do not pause
+	execution. Overrides `setPauseOnException` state. */
+	Silent *bool `json:"silent,omitempty"`
+	/* Specifies in which execution context to perform evaluation. If the parameter is omitted the
+	evaluation will be performed in the context of the inspected page.
+	This is mutually exclusive with `uniqueContextId`, which offers an
+	alternative way to identify the execution context that is more reliable
+	in a multi-process environment. */
+	ContextId *ExecutionContextId `json:"contextId,omitempty"`
+	/* Whether the result is expected to be a JSON object that should be sent by value. */
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	/* Whether preview should be generated for the result.
+
+	Experimental: this may change or be removed in any chrome release */
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	/* Whether execution should be treated as initiated by user in the UI. */
+	UserGesture *bool `json:"userGesture,omitempty"`
+	/* Whether execution should `await` for resulting value and return once awaited promise is
+	resolved. */
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+	/* Whether to throw an exception if side effect cannot be ruled out during evaluation.
+	This implies `disableBreaks` below.
+
+	Experimental: this may change or be removed in any chrome release */
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+	/* Terminate execution after timing out (number of milliseconds).
+
+	Experimental: this may change or be removed in any chrome release */
+	Timeout *TimeDelta `json:"timeout,omitempty"`
+	/* Disable breakpoints during execution.
+
+	Experimental: this may change or be removed in any chrome release */
+	DisableBreaks *bool `json:"disableBreaks,omitempty"`
+	/* Setting this flag to true enables `let` re-declaration and top-level `await`.
+	Note that `let` variables can only be re-declared if they originate from
+	`replMode` themselves.
+
+	Experimental: this may change or be removed in any chrome release */
+	ReplMode *bool `json:"replMode,omitempty"`
+	/* The Content Security Policy (CSP) for the target might block 'unsafe-eval'
+	which includes eval(), Function(), setTimeout() and setInterval()
+	when called with non-callable arguments. This flag bypasses CSP for this
+	evaluation and allows unsafe-eval. Defaults to true.
+
+	Experimental: this may change or be removed in any chrome release */
+	AllowUnsafeEvalBlockedByCSP *bool `json:"allowUnsafeEvalBlockedByCSP,omitempty"`
+	/* An alternative way to specify the execution context to evaluate in.
+	Compared to contextId that may be reused across processes, this is guaranteed to be
+	system-unique, so it can be used to prevent accidental evaluation of the expression
+	in context different than intended (e.g. as a result of navigation across process
+	boundaries).
+	This is mutually exclusive with `contextId`.
+
+	Experimental: this may change or be removed in any chrome release */
+	UniqueContextId *string `json:"uniqueContextId,omitempty"`
+	/* Specifies the result serialization. If provided, overrides
+	`generatePreview` and `returnByValue`.
+
+	Experimental: this may change or be removed in any chrome release */
+	SerializationOptions *SerializationOptions `json:"serializationOptions,omitempty"`
+}
+
+// MarshalCDP writes EvaluateParams as JSON
+func (v *EvaluateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("expression")
+	e.String(v.Expression)
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		e.Key("includeCommandLineAPI")
+		e.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.Silent != nil {
+		e.Key("silent")
+		e.Bool(*v.Silent)
+	}
+	if v.ContextId != nil {
+		e.Key("contextId")
+		e.Int(int(*v.ContextId))
+	}
+	if v.ReturnByValue != nil {
+		e.Key("returnByValue")
+		e.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		e.Key("generatePreview")
+		e.Bool(*v.GeneratePreview)
+	}
+	if v.UserGesture != nil {
+		e.Key("userGesture")
+		e.Bool(*v.UserGesture)
+	}
+	if v.AwaitPromise != nil {
+		e.Key("awaitPromise")
+		e.Bool(*v.AwaitPromise)
+	}
+	if v.ThrowOnSideEffect != nil {
+		e.Key("throwOnSideEffect")
+		e.Bool(*v.ThrowOnSideEffect)
+	}
+	if v.Timeout != nil {
+		e.Key("timeout")
+		e.Float(float64(*v.Timeout))
+	}
+	if v.DisableBreaks != nil {
+		e.Key("disableBreaks")
+		e.Bool(*v.DisableBreaks)
+	}
+	if v.ReplMode != nil {
+		e.Key("replMode")
+		e.Bool(*v.ReplMode)
+	}
+	if v.AllowUnsafeEvalBlockedByCSP != nil {
+		e.Key("allowUnsafeEvalBlockedByCSP")
+		e.Bool(*v.AllowUnsafeEvalBlockedByCSP)
+	}
+	if v.UniqueContextId != nil {
+		e.Key("uniqueContextId")
+		e.String(*v.UniqueContextId)
+	}
+	if v.SerializationOptions != nil {
+		e.Key("serializationOptions")
+		(*v.SerializationOptions).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EvaluateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Evaluates expression on global object.
+func (c Client) Evaluate(params EvaluateParams) (EvaluateReturns, error) {
+	return c.EvaluateContext(context.Background(), params)
+}
+
+// EvaluateContext is Evaluate with a context for cancellation and deadlines
+func (c Client) EvaluateContext(ctx context.Context, params EvaluateParams) (EvaluateReturns, error) {
+	var returns_ EvaluateReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.evaluate", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetPropertiesReturns struct {
+
+	/* Object properties. */
+	Result []PropertyDescriptor `json:"result"`
+
+	/* Internal object properties (only of the element itself). */
+	InternalProperties []InternalPropertyDescriptor `json:"internalProperties,omitempty"`
+
+	/* Object private properties.
+
+	Experimental: this may change or be removed in any chrome release */
+	PrivateProperties []PrivatePropertyDescriptor `json:"privateProperties,omitempty"`
+
+	/* Exception details. */
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads GetPropertiesReturns from JSON
+func (v *GetPropertiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			if d.Array() {
+				v.Result = make([]PropertyDescriptor, 0)
+				for d.More() {
+					var x0 PropertyDescriptor
+					x0.UnmarshalCDP(d)
+					v.Result = append(v.Result, x0)
+				}
+			} else {
+				v.Result = nil
+			}
+		case "internalProperties":
+			if d.Array() {
+				v.InternalProperties = make([]InternalPropertyDescriptor, 0)
+				for d.More() {
+					var x0 InternalPropertyDescriptor
+					x0.UnmarshalCDP(d)
+					v.InternalProperties = append(v.InternalProperties, x0)
+				}
+			} else {
+				v.InternalProperties = nil
+			}
+		case "privateProperties":
+			if d.Array() {
+				v.PrivateProperties = make([]PrivatePropertyDescriptor, 0)
+				for d.More() {
+					var x0 PrivatePropertyDescriptor
+					x0.UnmarshalCDP(d)
+					v.PrivateProperties = append(v.PrivateProperties, x0)
+				}
+			} else {
+				v.PrivateProperties = nil
+			}
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetPropertiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetPropertiesParams are the parameters for Runtime.getProperties
+// optional parameters are left out when nil
+type GetPropertiesParams struct {
+	/* Identifier of the object to return properties for. */
+	ObjectId RemoteObjectId `json:"objectId"`
+	/* If true, returns properties belonging only to the element itself, not to its prototype
+	chain. */
+	OwnProperties *bool `json:"ownProperties,omitempty"`
+	/* If true, returns accessor properties (with getter/setter) only; internal properties are not
+	returned either.
+
+	Experimental: this may change or be removed in any chrome release */
+	AccessorPropertiesOnly *bool `json:"accessorPropertiesOnly,omitempty"`
+	/* Whether preview should be generated for the results.
+
+	Experimental: this may change or be removed in any chrome release */
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	/* If true, returns non-indexed properties only.
+
+	Experimental: this may change or be removed in any chrome release */
+	NonIndexedPropertiesOnly *bool `json:"nonIndexedPropertiesOnly,omitempty"`
+}
+
+// MarshalCDP writes GetPropertiesParams as JSON
+func (v *GetPropertiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	if v.OwnProperties != nil {
+		e.Key("ownProperties")
+		e.Bool(*v.OwnProperties)
+	}
+	if v.AccessorPropertiesOnly != nil {
+		e.Key("accessorPropertiesOnly")
+		e.Bool(*v.AccessorPropertiesOnly)
+	}
+	if v.GeneratePreview != nil {
+		e.Key("generatePreview")
+		e.Bool(*v.GeneratePreview)
+	}
+	if v.NonIndexedPropertiesOnly != nil {
+		e.Key("nonIndexedPropertiesOnly")
+		e.Bool(*v.NonIndexedPropertiesOnly)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetPropertiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns properties of a given object. Object group of the result is inherited from the target
+// object.
+func (c Client) GetProperties(params GetPropertiesParams) (GetPropertiesReturns, error) {
+	return c.GetPropertiesContext(context.Background(), params)
+}
+
+// GetPropertiesContext is GetProperties with a context for cancellation and deadlines
+func (c Client) GetPropertiesContext(ctx context.Context, params GetPropertiesParams) (GetPropertiesReturns, error) {
+	var returns_ GetPropertiesReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.getProperties", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GlobalLexicalScopeNamesReturns struct {
+	Names []string `json:"names"`
+}
+
+// UnmarshalCDP reads GlobalLexicalScopeNamesReturns from JSON
+func (v *GlobalLexicalScopeNamesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "names":
+			if d.Array() {
+				v.Names = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Names = append(v.Names, x0)
+				}
+			} else {
+				v.Names = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GlobalLexicalScopeNamesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GlobalLexicalScopeNamesParams are the parameters for Runtime.globalLexicalScopeNames
+// optional parameters are left out when nil
+type GlobalLexicalScopeNamesParams struct {
+	/* Specifies in which execution context to lookup global scope variables. */
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+}
+
+// MarshalCDP writes GlobalLexicalScopeNamesParams as JSON
+func (v *GlobalLexicalScopeNamesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GlobalLexicalScopeNamesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns all let, const and class variables from global scope.
+func (c Client) GlobalLexicalScopeNames(params GlobalLexicalScopeNamesParams) (GlobalLexicalScopeNamesReturns, error) {
+	return c.GlobalLexicalScopeNamesContext(context.Background(), params)
+}
+
+// GlobalLexicalScopeNamesContext is GlobalLexicalScopeNames with a context for cancellation and deadlines
+func (c Client) GlobalLexicalScopeNamesContext(ctx context.Context, params GlobalLexicalScopeNamesParams) (GlobalLexicalScopeNamesReturns, error) {
+	var returns_ GlobalLexicalScopeNamesReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.globalLexicalScopeNames", &params, &returns_)
+
+	return returns_, err_
+}
+
+type QueryObjectsReturns struct {
+
+	/* Array with objects. */
+	Objects RemoteObject `json:"objects"`
+}
+
+// UnmarshalCDP reads QueryObjectsReturns from JSON
+func (v *QueryObjectsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "objects":
+			v.Objects.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *QueryObjectsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// QueryObjectsParams are the parameters for Runtime.queryObjects
+// optional parameters are left out when nil
+type QueryObjectsParams struct {
+	/* Identifier of the prototype to return objects for. */
+	PrototypeObjectId RemoteObjectId `json:"prototypeObjectId"`
+	/* Symbolic group name that can be used to release the results. */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+}
+
+// MarshalCDP writes QueryObjectsParams as JSON
+func (v *QueryObjectsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("prototypeObjectId")
+	e.String(string(v.PrototypeObjectId))
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v QueryObjectsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) QueryObjects(params QueryObjectsParams) (QueryObjectsReturns, error) {
+	return c.QueryObjectsContext(context.Background(), params)
+}
+
+// QueryObjectsContext is QueryObjects with a context for cancellation and deadlines
+func (c Client) QueryObjectsContext(ctx context.Context, params QueryObjectsParams) (QueryObjectsReturns, error) {
+	var returns_ QueryObjectsReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.queryObjects", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ReleaseObjectReturns struct {
+}
+
+// UnmarshalCDP reads ReleaseObjectReturns from JSON
+func (v *ReleaseObjectReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReleaseObjectReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReleaseObjectParams are the parameters for Runtime.releaseObject
+// optional parameters are left out when nil
+type ReleaseObjectParams struct {
+	/* Identifier of the object to release. */
+	ObjectId RemoteObjectId `json:"objectId"`
+}
+
+// MarshalCDP writes ReleaseObjectParams as JSON
+func (v *ReleaseObjectParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectId")
+	e.String(string(v.ObjectId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReleaseObjectParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Releases remote object with given id.
+func (c Client) ReleaseObject(params ReleaseObjectParams) (ReleaseObjectReturns, error) {
+	return c.ReleaseObjectContext(context.Background(), params)
+}
+
+// ReleaseObjectContext is ReleaseObject with a context for cancellation and deadlines
+func (c Client) ReleaseObjectContext(ctx context.Context, params ReleaseObjectParams) (ReleaseObjectReturns, error) {
+	var returns_ ReleaseObjectReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.releaseObject", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ReleaseObjectGroupReturns struct {
+}
+
+// UnmarshalCDP reads ReleaseObjectGroupReturns from JSON
+func (v *ReleaseObjectGroupReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReleaseObjectGroupReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ReleaseObjectGroupParams are the parameters for Runtime.releaseObjectGroup
+// optional parameters are left out when nil
+type ReleaseObjectGroupParams struct {
+	/* Symbolic object group name. */
+	ObjectGroup string `json:"objectGroup"`
+}
+
+// MarshalCDP writes ReleaseObjectGroupParams as JSON
+func (v *ReleaseObjectGroupParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("objectGroup")
+	e.String(v.ObjectGroup)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ReleaseObjectGroupParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Releases all remote objects that belong to a given group.
+func (c Client) ReleaseObjectGroup(params ReleaseObjectGroupParams) (ReleaseObjectGroupReturns, error) {
+	return c.ReleaseObjectGroupContext(context.Background(), params)
+}
+
+// ReleaseObjectGroupContext is ReleaseObjectGroup with a context for cancellation and deadlines
+func (c Client) ReleaseObjectGroupContext(ctx context.Context, params ReleaseObjectGroupParams) (ReleaseObjectGroupReturns, error) {
+	var returns_ ReleaseObjectGroupReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.releaseObjectGroup", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RunIfWaitingForDebuggerReturns struct {
+}
+
+// UnmarshalCDP reads RunIfWaitingForDebuggerReturns from JSON
+func (v *RunIfWaitingForDebuggerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RunIfWaitingForDebuggerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Tells inspected instance to run if it was waiting for debugger to attach.
+func (c Client) RunIfWaitingForDebugger() (RunIfWaitingForDebuggerReturns, error) {
+	return c.RunIfWaitingForDebuggerContext(context.Background())
+}
+
+// RunIfWaitingForDebuggerContext is RunIfWaitingForDebugger with a context for cancellation and deadlines
+func (c Client) RunIfWaitingForDebuggerContext(ctx context.Context) (RunIfWaitingForDebuggerReturns, error) {
+	var returns_ RunIfWaitingForDebuggerReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.runIfWaitingForDebugger", nil, &returns_)
+
+	return returns_, err_
+}
+
+type RunScriptReturns struct {
+
+	/* Run result. */
+	Result RemoteObject `json:"result"`
+
+	/* Exception details. */
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads RunScriptReturns from JSON
+func (v *RunScriptReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "result":
+			v.Result.UnmarshalCDP(d)
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RunScriptReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RunScriptParams are the parameters for Runtime.runScript
+// optional parameters are left out when nil
+type RunScriptParams struct {
+	/* Id of the script to run. */
+	ScriptId ScriptId `json:"scriptId"`
+	/* Specifies in which execution context to perform script run. If the parameter is omitted the
+	evaluation will be performed in the context of the inspected page. */
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+	/* Symbolic group name that can be used to release multiple objects. */
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+	/* In silent mode exceptions thrown during evaluation are not reported and do not pause
+	execution. Overrides `setPauseOnException` state. */
+	Silent *bool `json:"silent,omitempty"`
+	/* Determines whether Command Line API should be available during the evaluation. */
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+	/* Whether the result is expected to be a JSON object which should be sent by value. */
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+	/* Whether preview should be generated for the result. */
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+	/* Whether execution should `await` for resulting value and return once awaited promise is
+	resolved. */
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+}
+
+// MarshalCDP writes RunScriptParams as JSON
+func (v *RunScriptParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	if v.ObjectGroup != nil {
+		e.Key("objectGroup")
+		e.String(*v.ObjectGroup)
+	}
+	if v.Silent != nil {
+		e.Key("silent")
+		e.Bool(*v.Silent)
+	}
+	if v.IncludeCommandLineAPI != nil {
+		e.Key("includeCommandLineAPI")
+		e.Bool(*v.IncludeCommandLineAPI)
+	}
+	if v.ReturnByValue != nil {
+		e.Key("returnByValue")
+		e.Bool(*v.ReturnByValue)
+	}
+	if v.GeneratePreview != nil {
+		e.Key("generatePreview")
+		e.Bool(*v.GeneratePreview)
+	}
+	if v.AwaitPromise != nil {
+		e.Key("awaitPromise")
+		e.Bool(*v.AwaitPromise)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RunScriptParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Runs script with given id in a given context.
+func (c Client) RunScript(params RunScriptParams) (RunScriptReturns, error) {
+	return c.RunScriptContext(context.Background(), params)
+}
+
+// RunScriptContext is RunScript with a context for cancellation and deadlines
+func (c Client) RunScriptContext(ctx context.Context, params RunScriptParams) (RunScriptReturns, error) {
+	var returns_ RunScriptReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.runScript", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAsyncCallStackDepthReturns struct {
+}
+
+// UnmarshalCDP reads SetAsyncCallStackDepthReturns from JSON
+func (v *SetAsyncCallStackDepthReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAsyncCallStackDepthReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAsyncCallStackDepthParams are the parameters for Runtime.setAsyncCallStackDepth
+// optional parameters are left out when nil
+type SetAsyncCallStackDepthParams struct {
+	/* Maximum depth of async call stacks. Setting to `0` will effectively disable collecting async
+	call stacks (default). */
+	MaxDepth int `json:"maxDepth"`
+}
+
+// MarshalCDP writes SetAsyncCallStackDepthParams as JSON
+func (v *SetAsyncCallStackDepthParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("maxDepth")
+	e.Int(v.MaxDepth)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAsyncCallStackDepthParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables or disables async call stacks tracking.
+func (c Client) SetAsyncCallStackDepth(params SetAsyncCallStackDepthParams) (SetAsyncCallStackDepthReturns, error) {
+	return c.SetAsyncCallStackDepthContext(context.Background(), params)
+}
+
+// SetAsyncCallStackDepthContext is SetAsyncCallStackDepth with a context for cancellation and deadlines
+func (c Client) SetAsyncCallStackDepthContext(ctx context.Context, params SetAsyncCallStackDepthParams) (SetAsyncCallStackDepthReturns, error) {
+	var returns_ SetAsyncCallStackDepthReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.setAsyncCallStackDepth", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddBindingReturns struct {
+}
+
+// UnmarshalCDP reads AddBindingReturns from JSON
+func (v *AddBindingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddBindingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddBindingParams are the parameters for Runtime.addBinding
+// optional parameters are left out when nil
+type AddBindingParams struct {
+	Name string `json:"name"`
+	/* If specified, the binding would only be exposed to the specified
+	execution context. If omitted and `executionContextName` is not set,
+	the binding is exposed to all execution contexts of the target.
+	This parameter is mutually exclusive with `executionContextName`.
+	Deprecated in favor of `executionContextName` due to an unclear use case
+	and bugs in implementation (crbug.com/1169639). `executionContextId` will be
+	removed in the future.
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+	/* If specified, the binding is exposed to the executionContext with
+	matching name, even for contexts created after the binding is added.
+	See also `ExecutionContext.name` and `worldName` parameter to
+	`Page.addScriptToEvaluateOnNewDocument`.
+	This parameter is mutually exclusive with `executionContextId`. */
+	ExecutionContextName *string `json:"executionContextName,omitempty"`
+}
+
+// MarshalCDP writes AddBindingParams as JSON
+func (v *AddBindingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	if v.ExecutionContextName != nil {
+		e.Key("executionContextName")
+		e.String(*v.ExecutionContextName)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddBindingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// If executionContextId is empty, adds binding with the given name on the
+// global objects of all inspected contexts, including those created later,
+// bindings survive reloads.
+// Binding function takes exactly one argument, this argument should be string,
+// in case of any other input, function throws an exception.
+// Each binding function call produces Runtime.bindingCalled notification.
+func (c Client) AddBinding(params AddBindingParams) (AddBindingReturns, error) {
+	return c.AddBindingContext(context.Background(), params)
+}
+
+// AddBindingContext is AddBinding with a context for cancellation and deadlines
+func (c Client) AddBindingContext(ctx context.Context, params AddBindingParams) (AddBindingReturns, error) {
+	var returns_ AddBindingReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.addBinding", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveBindingReturns struct {
+}
+
+// UnmarshalCDP reads RemoveBindingReturns from JSON
+func (v *RemoveBindingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveBindingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveBindingParams are the parameters for Runtime.removeBinding
+// optional parameters are left out when nil
+type RemoveBindingParams struct {
+	Name string `json:"name"`
+}
+
+// MarshalCDP writes RemoveBindingParams as JSON
+func (v *RemoveBindingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveBindingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// This method does not remove binding function from global object but
+// unsubscribes current runtime agent from Runtime.bindingCalled notifications.
+func (c Client) RemoveBinding(params RemoveBindingParams) (RemoveBindingReturns, error) {
+	return c.RemoveBindingContext(context.Background(), params)
+}
+
+// RemoveBindingContext is RemoveBinding with a context for cancellation and deadlines
+func (c Client) RemoveBindingContext(ctx context.Context, params RemoveBindingParams) (RemoveBindingReturns, error) {
+	var returns_ RemoveBindingReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.removeBinding", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Issued when console API was called.
+type ConsoleAPICalledEvent struct {
+
+	/* Type of the call. */
+	Type ConsoleAPICalledType `json:"type"`
+
+	/* Call arguments. */
+	Args []RemoteObject `json:"args"`
+
+	/* Identifier of the context where the call was made. */
+	ExecutionContextId ExecutionContextId `json:"executionContextId"`
+
+	/* Call timestamp. */
+	Timestamp Timestamp `json:"timestamp"`
+
+	/* Stack trace captured when the call was made. The async stack chain is automatically reported for
+	the following call types: `assert`, `error`, `trace`, `warning`. For other types the async call
+	chain can be retrieved using `Debugger.getStackTrace` and `stackTrace.parentId` field. */
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
+
+	/* Console context descriptor for calls on non-default console context (not console.*):
+	'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call
+	on named context.
+
+	Experimental: this may change or be removed in any chrome release */
+	Context *string `json:"context,omitempty"`
+}
+
+// UnmarshalCDP reads ConsoleAPICalledEvent from JSON
+func (v *ConsoleAPICalledEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = ConsoleAPICalledType(d.String())
+		case "args":
+			if d.Array() {
+				v.Args = make([]RemoteObject, 0)
+				for d.More() {
+					var x0 RemoteObject
+					x0.UnmarshalCDP(d)
+					v.Args = append(v.Args, x0)
+				}
+			} else {
+				v.Args = nil
+			}
+		case "executionContextId":
+			v.ExecutionContextId = ExecutionContextId(d.Int())
+		case "timestamp":
+			v.Timestamp = Timestamp(d.Float())
+		case "stackTrace":
+			if d.Null() {
+				v.StackTrace = nil
+			} else {
+				v.StackTrace = new(StackTrace)
+				(*v.StackTrace).UnmarshalCDP(d)
+			}
+		case "context":
+			if d.Null() {
+				v.Context = nil
+			} else {
+				v.Context = new(string)
+				*v.Context = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ConsoleAPICalledEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ConsoleAPICalledHandler func(ev ConsoleAPICalledEvent)
+
+// EventMethod is Runtime.consoleAPICalled
+func (ConsoleAPICalledEvent) EventMethod() string {
+	return "Runtime.consoleAPICalled"
+}
+
+// OnConsoleAPICalled calls handler for each Runtime.consoleAPICalled event
+func (c Client) OnConsoleAPICalled(handler ConsoleAPICalledHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.consoleAPICalled", func(ev interface{}) {
+		handler(ev.(ConsoleAPICalledEvent))
+	})
+}
+
+// Issued when unhandled exception was revoked.
+type ExceptionRevokedEvent struct {
+
+	/* Reason describing why exception was revoked. */
+	Reason string `json:"reason"`
+
+	/* The id of revoked exception, as reported in `exceptionThrown`. */
+	ExceptionId int `json:"exceptionId"`
+}
+
+// UnmarshalCDP reads ExceptionRevokedEvent from JSON
+func (v *ExceptionRevokedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "reason":
+			v.Reason = d.String()
+		case "exceptionId":
+			v.ExceptionId = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExceptionRevokedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ExceptionRevokedHandler func(ev ExceptionRevokedEvent)
+
+// EventMethod is Runtime.exceptionRevoked
+func (ExceptionRevokedEvent) EventMethod() string {
+	return "Runtime.exceptionRevoked"
+}
+
+// OnExceptionRevoked calls handler for each Runtime.exceptionRevoked event
+func (c Client) OnExceptionRevoked(handler ExceptionRevokedHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.exceptionRevoked", func(ev interface{}) {
+		handler(ev.(ExceptionRevokedEvent))
+	})
+}
+
+// Issued when exception was thrown and unhandled.
+type ExceptionThrownEvent struct {
+
+	/* Timestamp of the exception. */
+	Timestamp Timestamp `json:"timestamp"`
+
+	ExceptionDetails ExceptionDetails `json:"exceptionDetails"`
+}
+
+// UnmarshalCDP reads ExceptionThrownEvent from JSON
+func (v *ExceptionThrownEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "timestamp":
+			v.Timestamp = Timestamp(d.Float())
+		case "exceptionDetails":
+			v.ExceptionDetails.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExceptionThrownEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ExceptionThrownHandler func(ev ExceptionThrownEvent)
+
+// EventMethod is Runtime.exceptionThrown
+func (ExceptionThrownEvent) EventMethod() string {
+	return "Runtime.exceptionThrown"
+}
+
+// OnExceptionThrown calls handler for each Runtime.exceptionThrown event
+func (c Client) OnExceptionThrown(handler ExceptionThrownHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.exceptionThrown", func(ev interface{}) {
+		handler(ev.(ExceptionThrownEvent))
+	})
+}
+
+// Issued when new execution context is created.
+type ExecutionContextCreatedEvent struct {
+
+	/* A newly created execution context. */
+	Context ExecutionContextDescription `json:"context"`
+}
+
+// UnmarshalCDP reads ExecutionContextCreatedEvent from JSON
+func (v *ExecutionContextCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "context":
+			v.Context.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExecutionContextCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ExecutionContextCreatedHandler func(ev ExecutionContextCreatedEvent)
+
+// EventMethod is Runtime.executionContextCreated
+func (ExecutionContextCreatedEvent) EventMethod() string {
+	return "Runtime.executionContextCreated"
+}
+
+// OnExecutionContextCreated calls handler for each Runtime.executionContextCreated event
+func (c Client) OnExecutionContextCreated(handler ExecutionContextCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.executionContextCreated", func(ev interface{}) {
+		handler(ev.(ExecutionContextCreatedEvent))
+	})
+}
+
+// Issued when execution context is destroyed.
+type ExecutionContextDestroyedEvent struct {
+
+	/* Id of the destroyed context
+
+	Deprecated: this is deprecated in the devtools protocol */
+	ExecutionContextId ExecutionContextId `json:"executionContextId"`
+
+	/* Unique Id of the destroyed context
+
+	Experimental: this may change or be removed in any chrome release */
+	ExecutionContextUniqueId string `json:"executionContextUniqueId"`
+}
+
+// UnmarshalCDP reads ExecutionContextDestroyedEvent from JSON
+func (v *ExecutionContextDestroyedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "executionContextId":
+			v.ExecutionContextId = ExecutionContextId(d.Int())
+		case "executionContextUniqueId":
+			v.ExecutionContextUniqueId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExecutionContextDestroyedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ExecutionContextDestroyedHandler func(ev ExecutionContextDestroyedEvent)
+
+// EventMethod is Runtime.executionContextDestroyed
+func (ExecutionContextDestroyedEvent) EventMethod() string {
+	return "Runtime.executionContextDestroyed"
+}
+
+// OnExecutionContextDestroyed calls handler for each Runtime.executionContextDestroyed event
+func (c Client) OnExecutionContextDestroyed(handler ExecutionContextDestroyedHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.executionContextDestroyed", func(ev interface{}) {
+		handler(ev.(ExecutionContextDestroyedEvent))
+	})
+}
+
+// Issued when all executionContexts were cleared in browser
+type ExecutionContextsClearedEvent struct {
+}
+
+// UnmarshalCDP reads ExecutionContextsClearedEvent from JSON
+func (v *ExecutionContextsClearedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExecutionContextsClearedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ExecutionContextsClearedHandler func(ev ExecutionContextsClearedEvent)
+
+// EventMethod is Runtime.executionContextsCleared
+func (ExecutionContextsClearedEvent) EventMethod() string {
+	return "Runtime.executionContextsCleared"
+}
+
+// OnExecutionContextsCleared calls handler for each Runtime.executionContextsCleared event
+func (c Client) OnExecutionContextsCleared(handler ExecutionContextsClearedHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.executionContextsCleared", func(ev interface{}) {
+		handler(ev.(ExecutionContextsClearedEvent))
+	})
+}
+
+// Issued when object should be inspected (for example, as a result of inspect() command line API
+// call).
+type InspectRequestedEvent struct {
+	Object RemoteObject `json:"object"`
+
+	Hints map[string]interface{} `json:"hints"`
+
+	/* Identifier of the context where the call was made.
+
+	Experimental: this may change or be removed in any chrome release */
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+}
+
+// UnmarshalCDP reads InspectRequestedEvent from JSON
+func (v *InspectRequestedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "object":
+			v.Object.UnmarshalCDP(d)
+		case "hints":
+			v.Hints = d.Map()
+		case "executionContextId":
+			if d.Null() {
+				v.ExecutionContextId = nil
+			} else {
+				v.ExecutionContextId = new(ExecutionContextId)
+				*v.ExecutionContextId = ExecutionContextId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InspectRequestedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InspectRequestedHandler func(ev InspectRequestedEvent)
+
+// EventMethod is Runtime.inspectRequested
+func (InspectRequestedEvent) EventMethod() string {
+	return "Runtime.inspectRequested"
+}
+
+// OnInspectRequested calls handler for each Runtime.inspectRequested event
+func (c Client) OnInspectRequested(handler InspectRequestedHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.inspectRequested", func(ev interface{}) {
+		handler(ev.(InspectRequestedEvent))
+	})
+}
+
+// decoders for Runtime events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Runtime.consoleAPICalled":          cdp.Decode[ConsoleAPICalledEvent],
+	"Runtime.exceptionRevoked":          cdp.Decode[ExceptionRevokedEvent],
+	"Runtime.exceptionThrown":           cdp.Decode[ExceptionThrownEvent],
+	"Runtime.executionContextCreated":   cdp.Decode[ExecutionContextCreatedEvent],
+	"Runtime.executionContextDestroyed": cdp.Decode[ExecutionContextDestroyedEvent],
+	"Runtime.executionContextsCleared":  cdp.Decode[ExecutionContextsClearedEvent],
+	"Runtime.inspectRequested":          cdp.Decode[InspectRequestedEvent],
+}
+
+// DecodeEvent decodes a Runtime event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,395 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type GetIsolateIdReturns struct {
+
+	/* The isolate id. */
+	Id string `json:"id"`
+}
+
+// UnmarshalCDP reads GetIsolateIdReturns from JSON
+func (v *GetIsolateIdReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetIsolateIdReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the isolate id.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetIsolateId() (GetIsolateIdReturns, error) {
+	return c.GetIsolateIdContext(context.Background())
+}
+
+// GetIsolateIdContext is GetIsolateId with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetIsolateIdContext(ctx context.Context) (GetIsolateIdReturns, error) {
+	var returns_ GetIsolateIdReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.getIsolateId", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetHeapUsageReturns struct {
+
+	/* Used JavaScript heap size in bytes. */
+	UsedSize float64 `json:"usedSize"`
+
+	/* Allocated JavaScript heap size in bytes. */
+	TotalSize float64 `json:"totalSize"`
+
+	/* Used size in bytes in the embedder's garbage-collected heap. */
+	EmbedderHeapUsedSize float64 `json:"embedderHeapUsedSize"`
+
+	/* Size in bytes of backing storage for array buffers and external strings. */
+	BackingStorageSize float64 `json:"backingStorageSize"`
+}
+
+// UnmarshalCDP reads GetHeapUsageReturns from JSON
+func (v *GetHeapUsageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "usedSize":
+			v.UsedSize = d.Float()
+		case "totalSize":
+			v.TotalSize = d.Float()
+		case "embedderHeapUsedSize":
+			v.EmbedderHeapUsedSize = d.Float()
+		case "backingStorageSize":
+			v.BackingStorageSize = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetHeapUsageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the JavaScript heap usage.
+// It is the total usage of the corresponding isolate not scoped to a particular Runtime.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetHeapUsage() (GetHeapUsageReturns, error) {
+	return c.GetHeapUsageContext(context.Background())
+}
+
+// GetHeapUsageContext is GetHeapUsage with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetHeapUsageContext(ctx context.Context) (GetHeapUsageReturns, error) {
+	var returns_ GetHeapUsageReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.getHeapUsage", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetCustomObjectFormatterEnabledReturns struct {
+}
+
+// UnmarshalCDP reads SetCustomObjectFormatterEnabledReturns from JSON
+func (v *SetCustomObjectFormatterEnabledReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCustomObjectFormatterEnabledReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCustomObjectFormatterEnabledParams are the parameters for Runtime.setCustomObjectFormatterEnabled
+// optional parameters are left out when nil
+type SetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetCustomObjectFormatterEnabledParams as JSON
+func (v *SetCustomObjectFormatterEnabledParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCustomObjectFormatterEnabledParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetCustomObjectFormatterEnabled(params SetCustomObjectFormatterEnabledParams) (SetCustomObjectFormatterEnabledReturns, error) {
+	return c.SetCustomObjectFormatterEnabledContext(context.Background(), params)
+}
+
+// SetCustomObjectFormatterEnabledContext is SetCustomObjectFormatterEnabled with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetCustomObjectFormatterEnabledContext(ctx context.Context, params SetCustomObjectFormatterEnabledParams) (SetCustomObjectFormatterEnabledReturns, error) {
+	var returns_ SetCustomObjectFormatterEnabledReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.setCustomObjectFormatterEnabled", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetMaxCallStackSizeToCaptureReturns struct {
+}
+
+// UnmarshalCDP reads SetMaxCallStackSizeToCaptureReturns from JSON
+func (v *SetMaxCallStackSizeToCaptureReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetMaxCallStackSizeToCaptureReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetMaxCallStackSizeToCaptureParams are the parameters for Runtime.setMaxCallStackSizeToCapture
+// optional parameters are left out when nil
+type SetMaxCallStackSizeToCaptureParams struct {
+	Size int `json:"size"`
+}
+
+// MarshalCDP writes SetMaxCallStackSizeToCaptureParams as JSON
+func (v *SetMaxCallStackSizeToCaptureParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("size")
+	e.Int(v.Size)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetMaxCallStackSizeToCaptureParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetMaxCallStackSizeToCapture(params SetMaxCallStackSizeToCaptureParams) (SetMaxCallStackSizeToCaptureReturns, error) {
+	return c.SetMaxCallStackSizeToCaptureContext(context.Background(), params)
+}
+
+// SetMaxCallStackSizeToCaptureContext is SetMaxCallStackSizeToCapture with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetMaxCallStackSizeToCaptureContext(ctx context.Context, params SetMaxCallStackSizeToCaptureParams) (SetMaxCallStackSizeToCaptureReturns, error) {
+	var returns_ SetMaxCallStackSizeToCaptureReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.setMaxCallStackSizeToCapture", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TerminateExecutionReturns struct {
+}
+
+// UnmarshalCDP reads TerminateExecutionReturns from JSON
+func (v *TerminateExecutionReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TerminateExecutionReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Terminate current or next JavaScript execution.
+// Will cancel the termination when the outer-most script execution ends.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TerminateExecution() (TerminateExecutionReturns, error) {
+	return c.TerminateExecutionContext(context.Background())
+}
+
+// TerminateExecutionContext is TerminateExecution with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) TerminateExecutionContext(ctx context.Context) (TerminateExecutionReturns, error) {
+	var returns_ TerminateExecutionReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.terminateExecution", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetExceptionDetailsReturns struct {
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
+}
+
+// UnmarshalCDP reads GetExceptionDetailsReturns from JSON
+func (v *GetExceptionDetailsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "exceptionDetails":
+			if d.Null() {
+				v.ExceptionDetails = nil
+			} else {
+				v.ExceptionDetails = new(ExceptionDetails)
+				(*v.ExceptionDetails).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetExceptionDetailsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetExceptionDetailsParams are the parameters for Runtime.getExceptionDetails
+// optional parameters are left out when nil
+type GetExceptionDetailsParams struct {
+	/* The error object for which to resolve the exception details. */
+	ErrorObjectId RemoteObjectId `json:"errorObjectId"`
+}
+
+// MarshalCDP writes GetExceptionDetailsParams as JSON
+func (v *GetExceptionDetailsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("errorObjectId")
+	e.String(string(v.ErrorObjectId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetExceptionDetailsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// This method tries to lookup and populate exception details for a
+// JavaScript Error object.
+// Note that the stackTrace portion of the resulting exceptionDetails will
+// only be populated if the Runtime domain was enabled at the time when the
+// Error was thrown.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetExceptionDetails(params GetExceptionDetailsParams) (GetExceptionDetailsReturns, error) {
+	return c.GetExceptionDetailsContext(context.Background(), params)
+}
+
+// GetExceptionDetailsContext is GetExceptionDetails with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetExceptionDetailsContext(ctx context.Context, params GetExceptionDetailsParams) (GetExceptionDetailsReturns, error) {
+	var returns_ GetExceptionDetailsReturns
+
+	err_ := c.caller.Call(ctx, "Runtime.getExceptionDetails", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Notification is issued every time when binding is called.
+//
+// Experimental: this may change or be removed in any chrome release
+type BindingCalledEvent struct {
+	Name string `json:"name"`
+
+	Payload string `json:"payload"`
+
+	/* Identifier of the context where the call was made. */
+	ExecutionContextId ExecutionContextId `json:"executionContextId"`
+}
+
+// UnmarshalCDP reads BindingCalledEvent from JSON
+func (v *BindingCalledEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "payload":
+			v.Payload = d.String()
+		case "executionContextId":
+			v.ExecutionContextId = ExecutionContextId(d.Int())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BindingCalledEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BindingCalledHandler func(ev BindingCalledEvent)
+
+// EventMethod is Runtime.bindingCalled
+func (BindingCalledEvent) EventMethod() string {
+	return "Runtime.bindingCalled"
+}
+
+// OnBindingCalled calls handler for each Runtime.bindingCalled event
+func (c Client) OnBindingCalled(handler BindingCalledHandler) (unsubscribe func()) {
+	return c.caller.On("Runtime.bindingCalled", func(ev interface{}) {
+		handler(ev.(BindingCalledEvent))
+	})
+}
+
+func init() {
+	decoders["Runtime.bindingCalled"] = cdp.Decode[BindingCalledEvent]
+}
@@ -0,0 +1,1025 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package security is the Security domain of the chrome devtools protocol
+//
+// Security
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Security commands and listens for Security events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	CertificateId    = cdp.SecurityCertificateId
+	MixedContentType = cdp.SecurityMixedContentType
+	SecurityState    = cdp.SecuritySecurityState
+)
+
+// MixedContentType values
+const (
+	MixedContentTypeBlockable           = cdp.SecurityMixedContentTypeBlockable
+	MixedContentTypeOptionallyBlockable = cdp.SecurityMixedContentTypeOptionallyBlockable
+	MixedContentTypeNone                = cdp.SecurityMixedContentTypeNone
+)
+
+// SecurityState values
+const (
+	SecurityStateUnknown        = cdp.SecuritySecurityStateUnknown
+	SecurityStateNeutral        = cdp.SecuritySecurityStateNeutral
+	SecurityStateInsecure       = cdp.SecuritySecurityStateInsecure
+	SecurityStateSecure         = cdp.SecuritySecurityStateSecure
+	SecurityStateInfo           = cdp.SecuritySecurityStateInfo
+	SecurityStateInsecureBroken = cdp.SecuritySecurityStateInsecureBroken
+)
+
+// Details about the security state of the page certificate.
+//
+// Experimental: this may change or be removed in any chrome release
+type CertificateSecurityState struct {
+	/* Protocol name (e.g. "TLS 1.2" or "QUIC"). */
+	Protocol string `json:"protocol"`
+	/* Key Exchange used by the connection, or the empty string if not applicable. */
+	KeyExchange string `json:"keyExchange"`
+	/* (EC)DH group used by the connection, if applicable. */
+	KeyExchangeGroup *string `json:"keyExchangeGroup,omitempty"`
+	/* Cipher name. */
+	Cipher string `json:"cipher"`
+	/* TLS MAC. Note that AEAD ciphers do not have separate MACs. */
+	Mac *string `json:"mac,omitempty"`
+	/* Page certificate. */
+	Certificate []string `json:"certificate"`
+	/* Certificate subject name. */
+	SubjectName string `json:"subjectName"`
+	/* Name of the issuing CA. */
+	Issuer string `json:"issuer"`
+	/* Certificate valid from date. */
+	ValidFrom cdp.NetworkTimeSinceEpoch `json:"validFrom"`
+	/* Certificate valid to (expiration) date */
+	ValidTo cdp.NetworkTimeSinceEpoch `json:"validTo"`
+	/* The highest priority network error code, if the certificate has an error. */
+	CertificateNetworkError *string `json:"certificateNetworkError,omitempty"`
+	/* True if the certificate uses a weak signature algorithm. */
+	CertificateHasWeakSignature bool `json:"certificateHasWeakSignature"`
+	/* True if the certificate has a SHA1 signature in the chain. */
+	CertificateHasSha1Signature bool `json:"certificateHasSha1Signature"`
+	/* True if modern SSL */
+	ModernSSL bool `json:"modernSSL"`
+	/* True if the connection is using an obsolete SSL protocol. */
+	ObsoleteSslProtocol bool `json:"obsoleteSslProtocol"`
+	/* True if the connection is using an obsolete SSL key exchange. */
+	ObsoleteSslKeyExchange bool `json:"obsoleteSslKeyExchange"`
+	/* True if the connection is using an obsolete SSL cipher. */
+	ObsoleteSslCipher bool `json:"obsoleteSslCipher"`
+	/* True if the connection is using an obsolete SSL signature. */
+	ObsoleteSslSignature bool `json:"obsoleteSslSignature"`
+}
+
+// MarshalCDP writes CertificateSecurityState as JSON
+func (v *CertificateSecurityState) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("protocol")
+	e.String(v.Protocol)
+	e.Key("keyExchange")
+	e.String(v.KeyExchange)
+	if v.KeyExchangeGroup != nil {
+		e.Key("keyExchangeGroup")
+		e.String(*v.KeyExchangeGroup)
+	}
+	e.Key("cipher")
+	e.String(v.Cipher)
+	if v.Mac != nil {
+		e.Key("mac")
+		e.String(*v.Mac)
+	}
+	e.Key("certificate")
+	if v.Certificate == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Certificate {
+			e.Elem()
+			e.String(v.Certificate[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("subjectName")
+	e.String(v.SubjectName)
+	e.Key("issuer")
+	e.String(v.Issuer)
+	e.Key("validFrom")
+	e.Float(float64(v.ValidFrom))
+	e.Key("validTo")
+	e.Float(float64(v.ValidTo))
+	if v.CertificateNetworkError != nil {
+		e.Key("certificateNetworkError")
+		e.String(*v.CertificateNetworkError)
+	}
+	e.Key("certificateHasWeakSignature")
+	e.Bool(v.CertificateHasWeakSignature)
+	e.Key("certificateHasSha1Signature")
+	e.Bool(v.CertificateHasSha1Signature)
+	e.Key("modernSSL")
+	e.Bool(v.ModernSSL)
+	e.Key("obsoleteSslProtocol")
+	e.Bool(v.ObsoleteSslProtocol)
+	e.Key("obsoleteSslKeyExchange")
+	e.Bool(v.ObsoleteSslKeyExchange)
+	e.Key("obsoleteSslCipher")
+	e.Bool(v.ObsoleteSslCipher)
+	e.Key("obsoleteSslSignature")
+	e.Bool(v.ObsoleteSslSignature)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CertificateSecurityState) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads CertificateSecurityState from JSON
+func (v *CertificateSecurityState) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "protocol":
+			v.Protocol = d.String()
+		case "keyExchange":
+			v.KeyExchange = d.String()
+		case "keyExchangeGroup":
+			if d.Null() {
+				v.KeyExchangeGroup = nil
+			} else {
+				v.KeyExchangeGroup = new(string)
+				*v.KeyExchangeGroup = d.String()
+			}
+		case "cipher":
+			v.Cipher = d.String()
+		case "mac":
+			if d.Null() {
+				v.Mac = nil
+			} else {
+				v.Mac = new(string)
+				*v.Mac = d.String()
+			}
+		case "certificate":
+			if d.Array() {
+				v.Certificate = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Certificate = append(v.Certificate, x0)
+				}
+			} else {
+				v.Certificate = nil
+			}
+		case "subjectName":
+			v.SubjectName = d.String()
+		case "issuer":
+			v.Issuer = d.String()
+		case "validFrom":
+			v.ValidFrom = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "validTo":
+			v.ValidTo = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "certificateNetworkError":
+			if d.Null() {
+				v.CertificateNetworkError = nil
+			} else {
+				v.CertificateNetworkError = new(string)
+				*v.CertificateNetworkError = d.String()
+			}
+		case "certificateHasWeakSignature":
+			v.CertificateHasWeakSignature = d.Bool()
+		case "certificateHasSha1Signature":
+			v.CertificateHasSha1Signature = d.Bool()
+		case "modernSSL":
+			v.ModernSSL = d.Bool()
+		case "obsoleteSslProtocol":
+			v.ObsoleteSslProtocol = d.Bool()
+		case "obsoleteSslKeyExchange":
+			v.ObsoleteSslKeyExchange = d.Bool()
+		case "obsoleteSslCipher":
+			v.ObsoleteSslCipher = d.Bool()
+		case "obsoleteSslSignature":
+			v.ObsoleteSslSignature = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CertificateSecurityState) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SafetyTipStatus string
+
+// SafetyTipStatus values
+const (
+	SafetyTipStatusBadReputation SafetyTipStatus = "badReputation"
+	SafetyTipStatusLookalike     SafetyTipStatus = "lookalike"
+)
+
+// Values gives every SafetyTipStatus
+func (SafetyTipStatus) Values() []SafetyTipStatus {
+	return []SafetyTipStatus{
+		SafetyTipStatusBadReputation,
+		SafetyTipStatusLookalike,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SafetyTipStatus) Valid() bool {
+	switch v {
+	case SafetyTipStatusBadReputation, SafetyTipStatusLookalike:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type SafetyTipInfo struct {
+	/* Describes whether the page triggers any safety tips or reputation warnings. Default is unknown. */
+	SafetyTipStatus SafetyTipStatus `json:"safetyTipStatus"`
+	/* The URL the safety tip suggested ("Did you mean?"). Only filled in for lookalike matches. */
+	SafeUrl *string `json:"safeUrl,omitempty"`
+}
+
+// MarshalCDP writes SafetyTipInfo as JSON
+func (v *SafetyTipInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("safetyTipStatus")
+	e.String(string(v.SafetyTipStatus))
+	if v.SafeUrl != nil {
+		e.Key("safeUrl")
+		e.String(*v.SafeUrl)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SafetyTipInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SafetyTipInfo from JSON
+func (v *SafetyTipInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "safetyTipStatus":
+			v.SafetyTipStatus = SafetyTipStatus(d.String())
+		case "safeUrl":
+			if d.Null() {
+				v.SafeUrl = nil
+			} else {
+				v.SafeUrl = new(string)
+				*v.SafeUrl = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SafetyTipInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Security state information about the page.
+//
+// Experimental: this may change or be removed in any chrome release
+type VisibleSecurityState struct {
+	/* The security level of the page. */
+	SecurityState SecurityState `json:"securityState"`
+	/* Security state details about the page certificate. */
+	CertificateSecurityState *CertificateSecurityState `json:"certificateSecurityState,omitempty"`
+	/* The type of Safety Tip triggered on the page. Note that this field will be set even if the Safety Tip UI was not actually shown. */
+	SafetyTipInfo *SafetyTipInfo `json:"safetyTipInfo,omitempty"`
+	/* Array of security state issues ids. */
+	SecurityStateIssueIds []string `json:"securityStateIssueIds"`
+}
+
+// MarshalCDP writes VisibleSecurityState as JSON
+func (v *VisibleSecurityState) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("securityState")
+	e.String(string(v.SecurityState))
+	if v.CertificateSecurityState != nil {
+		e.Key("certificateSecurityState")
+		(*v.CertificateSecurityState).MarshalCDP(e)
+	}
+	if v.SafetyTipInfo != nil {
+		e.Key("safetyTipInfo")
+		(*v.SafetyTipInfo).MarshalCDP(e)
+	}
+	e.Key("securityStateIssueIds")
+	if v.SecurityStateIssueIds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.SecurityStateIssueIds {
+			e.Elem()
+			e.String(v.SecurityStateIssueIds[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v VisibleSecurityState) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads VisibleSecurityState from JSON
+func (v *VisibleSecurityState) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "securityState":
+			v.SecurityState = SecurityState(d.String())
+		case "certificateSecurityState":
+			if d.Null() {
+				v.CertificateSecurityState = nil
+			} else {
+				v.CertificateSecurityState = new(CertificateSecurityState)
+				(*v.CertificateSecurityState).UnmarshalCDP(d)
+			}
+		case "safetyTipInfo":
+			if d.Null() {
+				v.SafetyTipInfo = nil
+			} else {
+				v.SafetyTipInfo = new(SafetyTipInfo)
+				(*v.SafetyTipInfo).UnmarshalCDP(d)
+			}
+		case "securityStateIssueIds":
+			if d.Array() {
+				v.SecurityStateIssueIds = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.SecurityStateIssueIds = append(v.SecurityStateIssueIds, x0)
+				}
+			} else {
+				v.SecurityStateIssueIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VisibleSecurityState) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// An explanation of an factor contributing to the security state.
+type SecurityStateExplanation struct {
+	/* Security state representing the severity of the factor being explained. */
+	SecurityState SecurityState `json:"securityState"`
+	/* Title describing the type of factor. */
+	Title string `json:"title"`
+	/* Short phrase describing the type of factor. */
+	Summary string `json:"summary"`
+	/* Full text explanation of the factor. */
+	Description string `json:"description"`
+	/* The type of mixed content described by the explanation. */
+	MixedContentType MixedContentType `json:"mixedContentType"`
+	/* Page certificate. */
+	Certificate []string `json:"certificate"`
+	/* Recommendations to fix any issues. */
+	Recommendations []string `json:"recommendations"`
+}
+
+// MarshalCDP writes SecurityStateExplanation as JSON
+func (v *SecurityStateExplanation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("securityState")
+	e.String(string(v.SecurityState))
+	e.Key("title")
+	e.String(v.Title)
+	e.Key("summary")
+	e.String(v.Summary)
+	e.Key("description")
+	e.String(v.Description)
+	e.Key("mixedContentType")
+	e.String(string(v.MixedContentType))
+	e.Key("certificate")
+	if v.Certificate == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Certificate {
+			e.Elem()
+			e.String(v.Certificate[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.Recommendations != nil {
+		e.Key("recommendations")
+		e.ArrayStart()
+		for i0 := range v.Recommendations {
+			e.Elem()
+			e.String(v.Recommendations[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SecurityStateExplanation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SecurityStateExplanation from JSON
+func (v *SecurityStateExplanation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "securityState":
+			v.SecurityState = SecurityState(d.String())
+		case "title":
+			v.Title = d.String()
+		case "summary":
+			v.Summary = d.String()
+		case "description":
+			v.Description = d.String()
+		case "mixedContentType":
+			v.MixedContentType = MixedContentType(d.String())
+		case "certificate":
+			if d.Array() {
+				v.Certificate = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Certificate = append(v.Certificate, x0)
+				}
+			} else {
+				v.Certificate = nil
+			}
+		case "recommendations":
+			if d.Array() {
+				v.Recommendations = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Recommendations = append(v.Recommendations, x0)
+				}
+			} else {
+				v.Recommendations = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SecurityStateExplanation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Information about insecure content on the page.
+//
+// Deprecated: this is deprecated in the devtools protocol
+type InsecureContentStatus struct {
+	/* Always false. */
+	RanMixedContent bool `json:"ranMixedContent"`
+	/* Always false. */
+	DisplayedMixedContent bool `json:"displayedMixedContent"`
+	/* Always false. */
+	ContainedMixedForm bool `json:"containedMixedForm"`
+	/* Always false. */
+	RanContentWithCertErrors bool `json:"ranContentWithCertErrors"`
+	/* Always false. */
+	DisplayedContentWithCertErrors bool `json:"displayedContentWithCertErrors"`
+	/* Always set to unknown. */
+	RanInsecureContentStyle SecurityState `json:"ranInsecureContentStyle"`
+	/* Always set to unknown. */
+	DisplayedInsecureContentStyle SecurityState `json:"displayedInsecureContentStyle"`
+}
+
+// MarshalCDP writes InsecureContentStatus as JSON
+func (v *InsecureContentStatus) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ranMixedContent")
+	e.Bool(v.RanMixedContent)
+	e.Key("displayedMixedContent")
+	e.Bool(v.DisplayedMixedContent)
+	e.Key("containedMixedForm")
+	e.Bool(v.ContainedMixedForm)
+	e.Key("ranContentWithCertErrors")
+	e.Bool(v.RanContentWithCertErrors)
+	e.Key("displayedContentWithCertErrors")
+	e.Bool(v.DisplayedContentWithCertErrors)
+	e.Key("ranInsecureContentStyle")
+	e.String(string(v.RanInsecureContentStyle))
+	e.Key("displayedInsecureContentStyle")
+	e.String(string(v.DisplayedInsecureContentStyle))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v InsecureContentStatus) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads InsecureContentStatus from JSON
+func (v *InsecureContentStatus) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "ranMixedContent":
+			v.RanMixedContent = d.Bool()
+		case "displayedMixedContent":
+			v.DisplayedMixedContent = d.Bool()
+		case "containedMixedForm":
+			v.ContainedMixedForm = d.Bool()
+		case "ranContentWithCertErrors":
+			v.RanContentWithCertErrors = d.Bool()
+		case "displayedContentWithCertErrors":
+			v.DisplayedContentWithCertErrors = d.Bool()
+		case "ranInsecureContentStyle":
+			v.RanInsecureContentStyle = SecurityState(d.String())
+		case "displayedInsecureContentStyle":
+			v.DisplayedInsecureContentStyle = SecurityState(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InsecureContentStatus) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The action to take when a certificate error occurs. continue will continue processing the
+// request and cancel will cancel the request.
+type CertificateErrorAction string
+
+// CertificateErrorAction values
+const (
+	CertificateErrorActionContinue CertificateErrorAction = "continue"
+	CertificateErrorActionCancel   CertificateErrorAction = "cancel"
+)
+
+// Values gives every CertificateErrorAction
+func (CertificateErrorAction) Values() []CertificateErrorAction {
+	return []CertificateErrorAction{
+		CertificateErrorActionContinue,
+		CertificateErrorActionCancel,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v CertificateErrorAction) Valid() bool {
+	switch v {
+	case CertificateErrorActionContinue, CertificateErrorActionCancel:
+		return true
+	}
+	return false
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables tracking security state changes.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "Security.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables tracking security state changes.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "Security.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetIgnoreCertificateErrorsReturns struct {
+}
+
+// UnmarshalCDP reads SetIgnoreCertificateErrorsReturns from JSON
+func (v *SetIgnoreCertificateErrorsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetIgnoreCertificateErrorsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetIgnoreCertificateErrorsParams are the parameters for Security.setIgnoreCertificateErrors
+// optional parameters are left out when nil
+type SetIgnoreCertificateErrorsParams struct {
+	/* If true, all certificate errors will be ignored. */
+	Ignore bool `json:"ignore"`
+}
+
+// MarshalCDP writes SetIgnoreCertificateErrorsParams as JSON
+func (v *SetIgnoreCertificateErrorsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ignore")
+	e.Bool(v.Ignore)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetIgnoreCertificateErrorsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable/disable whether all certificate errors should be ignored.
+func (c Client) SetIgnoreCertificateErrors(params SetIgnoreCertificateErrorsParams) (SetIgnoreCertificateErrorsReturns, error) {
+	return c.SetIgnoreCertificateErrorsContext(context.Background(), params)
+}
+
+// SetIgnoreCertificateErrorsContext is SetIgnoreCertificateErrors with a context for cancellation and deadlines
+func (c Client) SetIgnoreCertificateErrorsContext(ctx context.Context, params SetIgnoreCertificateErrorsParams) (SetIgnoreCertificateErrorsReturns, error) {
+	var returns_ SetIgnoreCertificateErrorsReturns
+
+	err_ := c.caller.Call(ctx, "Security.setIgnoreCertificateErrors", &params, &returns_)
+
+	return returns_, err_
+}
+
+type HandleCertificateErrorReturns struct {
+}
+
+// UnmarshalCDP reads HandleCertificateErrorReturns from JSON
+func (v *HandleCertificateErrorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *HandleCertificateErrorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// HandleCertificateErrorParams are the parameters for Security.handleCertificateError
+// optional parameters are left out when nil
+type HandleCertificateErrorParams struct {
+	/* The ID of the event. */
+	EventId int `json:"eventId"`
+	/* The action to take on the certificate error. */
+	Action CertificateErrorAction `json:"action"`
+}
+
+// MarshalCDP writes HandleCertificateErrorParams as JSON
+func (v *HandleCertificateErrorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventId")
+	e.Int(v.EventId)
+	e.Key("action")
+	e.String(string(v.Action))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v HandleCertificateErrorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Handles a certificate error that fired a certificateError event.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) HandleCertificateError(params HandleCertificateErrorParams) (HandleCertificateErrorReturns, error) {
+	return c.HandleCertificateErrorContext(context.Background(), params)
+}
+
+// HandleCertificateErrorContext is HandleCertificateError with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) HandleCertificateErrorContext(ctx context.Context, params HandleCertificateErrorParams) (HandleCertificateErrorReturns, error) {
+	var returns_ HandleCertificateErrorReturns
+
+	if !params.Action.Valid() {
+		return returns_, fmt.Errorf("Security.handleCertificateError: invalid action %q", params.Action)
+	}
+
+	err_ := c.caller.Call(ctx, "Security.handleCertificateError", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetOverrideCertificateErrorsReturns struct {
+}
+
+// UnmarshalCDP reads SetOverrideCertificateErrorsReturns from JSON
+func (v *SetOverrideCertificateErrorsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetOverrideCertificateErrorsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetOverrideCertificateErrorsParams are the parameters for Security.setOverrideCertificateErrors
+// optional parameters are left out when nil
+type SetOverrideCertificateErrorsParams struct {
+	/* If true, certificate errors will be overridden. */
+	Override bool `json:"override"`
+}
+
+// MarshalCDP writes SetOverrideCertificateErrorsParams as JSON
+func (v *SetOverrideCertificateErrorsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("override")
+	e.Bool(v.Override)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetOverrideCertificateErrorsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable/disable overriding certificate errors. If enabled, all certificate error events need to
+// be handled by the DevTools client and should be answered with `handleCertificateError` commands.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetOverrideCertificateErrors(params SetOverrideCertificateErrorsParams) (SetOverrideCertificateErrorsReturns, error) {
+	return c.SetOverrideCertificateErrorsContext(context.Background(), params)
+}
+
+// SetOverrideCertificateErrorsContext is SetOverrideCertificateErrors with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SetOverrideCertificateErrorsContext(ctx context.Context, params SetOverrideCertificateErrorsParams) (SetOverrideCertificateErrorsReturns, error) {
+	var returns_ SetOverrideCertificateErrorsReturns
+
+	err_ := c.caller.Call(ctx, "Security.setOverrideCertificateErrors", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// There is a certificate error. If overriding certificate errors is enabled, then it should be
+// handled with the `handleCertificateError` command. Note: this event does not fire if the
+// certificate error has been allowed internally. Only one client per target should override
+// certificate errors at the same time.
+//
+// Deprecated: this is deprecated in the devtools protocol
+type CertificateErrorEvent struct {
+
+	/* The ID of the event. */
+	EventId int `json:"eventId"`
+
+	/* The type of the error. */
+	ErrorType string `json:"errorType"`
+
+	/* The url that was requested. */
+	RequestURL string `json:"requestURL"`
+}
+
+// UnmarshalCDP reads CertificateErrorEvent from JSON
+func (v *CertificateErrorEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "eventId":
+			v.EventId = d.Int()
+		case "errorType":
+			v.ErrorType = d.String()
+		case "requestURL":
+			v.RequestURL = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CertificateErrorEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CertificateErrorHandler func(ev CertificateErrorEvent)
+
+// EventMethod is Security.certificateError
+func (CertificateErrorEvent) EventMethod() string {
+	return "Security.certificateError"
+}
+
+// OnCertificateError calls handler for each Security.certificateError event
+func (c Client) OnCertificateError(handler CertificateErrorHandler) (unsubscribe func()) {
+	return c.caller.On("Security.certificateError", func(ev interface{}) {
+		handler(ev.(CertificateErrorEvent))
+	})
+}
+
+// The security state of the page changed. No longer being sent.
+//
+// Deprecated: this is deprecated in the devtools protocol
+type SecurityStateChangedEvent struct {
+
+	/* Security state. */
+	SecurityState SecurityState `json:"securityState"`
+
+	/* True if the page was loaded over cryptographic transport such as HTTPS.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	SchemeIsCryptographic bool `json:"schemeIsCryptographic"`
+
+	/* Previously a list of explanations for the security state. Now always
+	empty.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Explanations []SecurityStateExplanation `json:"explanations"`
+
+	/* Information about insecure content on the page.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	InsecureContentStatus InsecureContentStatus `json:"insecureContentStatus"`
+
+	/* Overrides user-visible description of the state. Always omitted.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Summary *string `json:"summary,omitempty"`
+}
+
+// UnmarshalCDP reads SecurityStateChangedEvent from JSON
+func (v *SecurityStateChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "securityState":
+			v.SecurityState = SecurityState(d.String())
+		case "schemeIsCryptographic":
+			v.SchemeIsCryptographic = d.Bool()
+		case "explanations":
+			if d.Array() {
+				v.Explanations = make([]SecurityStateExplanation, 0)
+				for d.More() {
+					var x0 SecurityStateExplanation
+					x0.UnmarshalCDP(d)
+					v.Explanations = append(v.Explanations, x0)
+				}
+			} else {
+				v.Explanations = nil
+			}
+		case "insecureContentStatus":
+			v.InsecureContentStatus.UnmarshalCDP(d)
+		case "summary":
+			if d.Null() {
+				v.Summary = nil
+			} else {
+				v.Summary = new(string)
+				*v.Summary = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SecurityStateChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SecurityStateChangedHandler func(ev SecurityStateChangedEvent)
+
+// EventMethod is Security.securityStateChanged
+func (SecurityStateChangedEvent) EventMethod() string {
+	return "Security.securityStateChanged"
+}
+
+// OnSecurityStateChanged calls handler for each Security.securityStateChanged event
+func (c Client) OnSecurityStateChanged(handler SecurityStateChangedHandler) (unsubscribe func()) {
+	return c.caller.On("Security.securityStateChanged", func(ev interface{}) {
+		handler(ev.(SecurityStateChangedEvent))
+	})
+}
+
+// decoders for Security events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Security.certificateError":     cdp.Decode[CertificateErrorEvent],
+	"Security.securityStateChanged": cdp.Decode[SecurityStateChangedEvent],
+}
+
+// DecodeEvent decodes a Security event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,59 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package security
+
+import (
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+/* Event Handlers */
+
+// The security state of the page changed.
+//
+// Experimental: this may change or be removed in any chrome release
+type VisibleSecurityStateChangedEvent struct {
+
+	/* Security state information about the page. */
+	VisibleSecurityState VisibleSecurityState `json:"visibleSecurityState"`
+}
+
+// UnmarshalCDP reads VisibleSecurityStateChangedEvent from JSON
+func (v *VisibleSecurityStateChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "visibleSecurityState":
+			v.VisibleSecurityState.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VisibleSecurityStateChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type VisibleSecurityStateChangedHandler func(ev VisibleSecurityStateChangedEvent)
+
+// EventMethod is Security.visibleSecurityStateChanged
+func (VisibleSecurityStateChangedEvent) EventMethod() string {
+	return "Security.visibleSecurityStateChanged"
+}
+
+// OnVisibleSecurityStateChanged calls handler for each Security.visibleSecurityStateChanged event
+func (c Client) OnVisibleSecurityStateChanged(handler VisibleSecurityStateChangedHandler) (unsubscribe func()) {
+	return c.caller.On("Security.visibleSecurityStateChanged", func(ev interface{}) {
+		handler(ev.(VisibleSecurityStateChangedEvent))
+	})
+}
+
+func init() {
+	decoders["Security.visibleSecurityStateChanged"] = cdp.Decode[VisibleSecurityStateChangedEvent]
+}
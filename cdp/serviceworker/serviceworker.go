@@ -0,0 +1,1085 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package serviceworker is the ServiceWorker domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package serviceworker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends ServiceWorker commands and listens for ServiceWorker events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	RegistrationID = cdp.ServiceWorkerRegistrationID
+)
+
+// ServiceWorker registration.
+type ServiceWorkerRegistration struct {
+	RegistrationId RegistrationID `json:"registrationId"`
+	ScopeURL       string         `json:"scopeURL"`
+	IsDeleted      bool           `json:"isDeleted"`
+}
+
+// MarshalCDP writes ServiceWorkerRegistration as JSON
+func (v *ServiceWorkerRegistration) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("registrationId")
+	e.String(string(v.RegistrationId))
+	e.Key("scopeURL")
+	e.String(v.ScopeURL)
+	e.Key("isDeleted")
+	e.Bool(v.IsDeleted)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ServiceWorkerRegistration) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ServiceWorkerRegistration from JSON
+func (v *ServiceWorkerRegistration) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "registrationId":
+			v.RegistrationId = RegistrationID(d.String())
+		case "scopeURL":
+			v.ScopeURL = d.String()
+		case "isDeleted":
+			v.IsDeleted = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ServiceWorkerRegistration) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ServiceWorkerVersionRunningStatus string
+
+// ServiceWorkerVersionRunningStatus values
+const (
+	ServiceWorkerVersionRunningStatusStopped  ServiceWorkerVersionRunningStatus = "stopped"
+	ServiceWorkerVersionRunningStatusStarting ServiceWorkerVersionRunningStatus = "starting"
+	ServiceWorkerVersionRunningStatusRunning  ServiceWorkerVersionRunningStatus = "running"
+	ServiceWorkerVersionRunningStatusStopping ServiceWorkerVersionRunningStatus = "stopping"
+)
+
+// Values gives every ServiceWorkerVersionRunningStatus
+func (ServiceWorkerVersionRunningStatus) Values() []ServiceWorkerVersionRunningStatus {
+	return []ServiceWorkerVersionRunningStatus{
+		ServiceWorkerVersionRunningStatusStopped,
+		ServiceWorkerVersionRunningStatusStarting,
+		ServiceWorkerVersionRunningStatusRunning,
+		ServiceWorkerVersionRunningStatusStopping,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ServiceWorkerVersionRunningStatus) Valid() bool {
+	switch v {
+	case ServiceWorkerVersionRunningStatusStopped, ServiceWorkerVersionRunningStatusStarting, ServiceWorkerVersionRunningStatusRunning, ServiceWorkerVersionRunningStatusStopping:
+		return true
+	}
+	return false
+}
+
+type ServiceWorkerVersionStatus string
+
+// ServiceWorkerVersionStatus values
+const (
+	ServiceWorkerVersionStatusNew        ServiceWorkerVersionStatus = "new"
+	ServiceWorkerVersionStatusInstalling ServiceWorkerVersionStatus = "installing"
+	ServiceWorkerVersionStatusInstalled  ServiceWorkerVersionStatus = "installed"
+	ServiceWorkerVersionStatusActivating ServiceWorkerVersionStatus = "activating"
+	ServiceWorkerVersionStatusActivated  ServiceWorkerVersionStatus = "activated"
+	ServiceWorkerVersionStatusRedundant  ServiceWorkerVersionStatus = "redundant"
+)
+
+// Values gives every ServiceWorkerVersionStatus
+func (ServiceWorkerVersionStatus) Values() []ServiceWorkerVersionStatus {
+	return []ServiceWorkerVersionStatus{
+		ServiceWorkerVersionStatusNew,
+		ServiceWorkerVersionStatusInstalling,
+		ServiceWorkerVersionStatusInstalled,
+		ServiceWorkerVersionStatusActivating,
+		ServiceWorkerVersionStatusActivated,
+		ServiceWorkerVersionStatusRedundant,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ServiceWorkerVersionStatus) Valid() bool {
+	switch v {
+	case ServiceWorkerVersionStatusNew, ServiceWorkerVersionStatusInstalling, ServiceWorkerVersionStatusInstalled, ServiceWorkerVersionStatusActivating, ServiceWorkerVersionStatusActivated, ServiceWorkerVersionStatusRedundant:
+		return true
+	}
+	return false
+}
+
+// ServiceWorker version.
+type ServiceWorkerVersion struct {
+	VersionId      string                            `json:"versionId"`
+	RegistrationId RegistrationID                    `json:"registrationId"`
+	ScriptURL      string                            `json:"scriptURL"`
+	RunningStatus  ServiceWorkerVersionRunningStatus `json:"runningStatus"`
+	Status         ServiceWorkerVersionStatus        `json:"status"`
+	/* The Last-Modified header value of the main script. */
+	ScriptLastModified *float64 `json:"scriptLastModified,omitempty"`
+	/* The time at which the response headers of the main script were received from the server.
+	For cached script it is the last time the cache entry was validated. */
+	ScriptResponseTime *float64             `json:"scriptResponseTime,omitempty"`
+	ControlledClients  []cdp.TargetTargetID `json:"controlledClients"`
+	TargetId           *cdp.TargetTargetID  `json:"targetId,omitempty"`
+	RouterRules        *string              `json:"routerRules,omitempty"`
+}
+
+// MarshalCDP writes ServiceWorkerVersion as JSON
+func (v *ServiceWorkerVersion) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("versionId")
+	e.String(v.VersionId)
+	e.Key("registrationId")
+	e.String(string(v.RegistrationId))
+	e.Key("scriptURL")
+	e.String(v.ScriptURL)
+	e.Key("runningStatus")
+	e.String(string(v.RunningStatus))
+	e.Key("status")
+	e.String(string(v.Status))
+	if v.ScriptLastModified != nil {
+		e.Key("scriptLastModified")
+		e.Float(*v.ScriptLastModified)
+	}
+	if v.ScriptResponseTime != nil {
+		e.Key("scriptResponseTime")
+		e.Float(*v.ScriptResponseTime)
+	}
+	if v.ControlledClients != nil {
+		e.Key("controlledClients")
+		e.ArrayStart()
+		for i0 := range v.ControlledClients {
+			e.Elem()
+			e.String(string(v.ControlledClients[i0]))
+		}
+		e.ArrayEnd()
+	}
+	if v.TargetId != nil {
+		e.Key("targetId")
+		e.String(string(*v.TargetId))
+	}
+	if v.RouterRules != nil {
+		e.Key("routerRules")
+		e.String(*v.RouterRules)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ServiceWorkerVersion) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ServiceWorkerVersion from JSON
+func (v *ServiceWorkerVersion) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "versionId":
+			v.VersionId = d.String()
+		case "registrationId":
+			v.RegistrationId = RegistrationID(d.String())
+		case "scriptURL":
+			v.ScriptURL = d.String()
+		case "runningStatus":
+			v.RunningStatus = ServiceWorkerVersionRunningStatus(d.String())
+		case "status":
+			v.Status = ServiceWorkerVersionStatus(d.String())
+		case "scriptLastModified":
+			if d.Null() {
+				v.ScriptLastModified = nil
+			} else {
+				v.ScriptLastModified = new(float64)
+				*v.ScriptLastModified = d.Float()
+			}
+		case "scriptResponseTime":
+			if d.Null() {
+				v.ScriptResponseTime = nil
+			} else {
+				v.ScriptResponseTime = new(float64)
+				*v.ScriptResponseTime = d.Float()
+			}
+		case "controlledClients":
+			if d.Array() {
+				v.ControlledClients = make([]cdp.TargetTargetID, 0)
+				for d.More() {
+					var x0 cdp.TargetTargetID
+					x0 = cdp.TargetTargetID(d.String())
+					v.ControlledClients = append(v.ControlledClients, x0)
+				}
+			} else {
+				v.ControlledClients = nil
+			}
+		case "targetId":
+			if d.Null() {
+				v.TargetId = nil
+			} else {
+				v.TargetId = new(cdp.TargetTargetID)
+				*v.TargetId = cdp.TargetTargetID(d.String())
+			}
+		case "routerRules":
+			if d.Null() {
+				v.RouterRules = nil
+			} else {
+				v.RouterRules = new(string)
+				*v.RouterRules = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ServiceWorkerVersion) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ServiceWorker error message.
+type ServiceWorkerErrorMessage struct {
+	ErrorMessage   string         `json:"errorMessage"`
+	RegistrationId RegistrationID `json:"registrationId"`
+	VersionId      string         `json:"versionId"`
+	SourceURL      string         `json:"sourceURL"`
+	LineNumber     int            `json:"lineNumber"`
+	ColumnNumber   int            `json:"columnNumber"`
+}
+
+// MarshalCDP writes ServiceWorkerErrorMessage as JSON
+func (v *ServiceWorkerErrorMessage) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("errorMessage")
+	e.String(v.ErrorMessage)
+	e.Key("registrationId")
+	e.String(string(v.RegistrationId))
+	e.Key("versionId")
+	e.String(v.VersionId)
+	e.Key("sourceURL")
+	e.String(v.SourceURL)
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ServiceWorkerErrorMessage) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ServiceWorkerErrorMessage from JSON
+func (v *ServiceWorkerErrorMessage) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "errorMessage":
+			v.ErrorMessage = d.String()
+		case "registrationId":
+			v.RegistrationId = RegistrationID(d.String())
+		case "versionId":
+			v.VersionId = d.String()
+		case "sourceURL":
+			v.SourceURL = d.String()
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ServiceWorkerErrorMessage) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DeliverPushMessageReturns struct {
+}
+
+// UnmarshalCDP reads DeliverPushMessageReturns from JSON
+func (v *DeliverPushMessageReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeliverPushMessageReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeliverPushMessageParams are the parameters for ServiceWorker.deliverPushMessage
+// optional parameters are left out when nil
+type DeliverPushMessageParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationId RegistrationID `json:"registrationId"`
+	Data           string         `json:"data"`
+}
+
+// MarshalCDP writes DeliverPushMessageParams as JSON
+func (v *DeliverPushMessageParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("registrationId")
+	e.String(string(v.RegistrationId))
+	e.Key("data")
+	e.String(v.Data)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeliverPushMessageParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) DeliverPushMessage(params DeliverPushMessageParams) (DeliverPushMessageReturns, error) {
+	return c.DeliverPushMessageContext(context.Background(), params)
+}
+
+// DeliverPushMessageContext is DeliverPushMessage with a context for cancellation and deadlines
+func (c Client) DeliverPushMessageContext(ctx context.Context, params DeliverPushMessageParams) (DeliverPushMessageReturns, error) {
+	var returns_ DeliverPushMessageReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.deliverPushMessage", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DispatchSyncEventReturns struct {
+}
+
+// UnmarshalCDP reads DispatchSyncEventReturns from JSON
+func (v *DispatchSyncEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DispatchSyncEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DispatchSyncEventParams are the parameters for ServiceWorker.dispatchSyncEvent
+// optional parameters are left out when nil
+type DispatchSyncEventParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationId RegistrationID `json:"registrationId"`
+	Tag            string         `json:"tag"`
+	LastChance     bool           `json:"lastChance"`
+}
+
+// MarshalCDP writes DispatchSyncEventParams as JSON
+func (v *DispatchSyncEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("registrationId")
+	e.String(string(v.RegistrationId))
+	e.Key("tag")
+	e.String(v.Tag)
+	e.Key("lastChance")
+	e.Bool(v.LastChance)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DispatchSyncEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) DispatchSyncEvent(params DispatchSyncEventParams) (DispatchSyncEventReturns, error) {
+	return c.DispatchSyncEventContext(context.Background(), params)
+}
+
+// DispatchSyncEventContext is DispatchSyncEvent with a context for cancellation and deadlines
+func (c Client) DispatchSyncEventContext(ctx context.Context, params DispatchSyncEventParams) (DispatchSyncEventReturns, error) {
+	var returns_ DispatchSyncEventReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.dispatchSyncEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DispatchPeriodicSyncEventReturns struct {
+}
+
+// UnmarshalCDP reads DispatchPeriodicSyncEventReturns from JSON
+func (v *DispatchPeriodicSyncEventReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DispatchPeriodicSyncEventReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DispatchPeriodicSyncEventParams are the parameters for ServiceWorker.dispatchPeriodicSyncEvent
+// optional parameters are left out when nil
+type DispatchPeriodicSyncEventParams struct {
+	Origin         string         `json:"origin"`
+	RegistrationId RegistrationID `json:"registrationId"`
+	Tag            string         `json:"tag"`
+}
+
+// MarshalCDP writes DispatchPeriodicSyncEventParams as JSON
+func (v *DispatchPeriodicSyncEventParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("registrationId")
+	e.String(string(v.RegistrationId))
+	e.Key("tag")
+	e.String(v.Tag)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DispatchPeriodicSyncEventParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) DispatchPeriodicSyncEvent(params DispatchPeriodicSyncEventParams) (DispatchPeriodicSyncEventReturns, error) {
+	return c.DispatchPeriodicSyncEventContext(context.Background(), params)
+}
+
+// DispatchPeriodicSyncEventContext is DispatchPeriodicSyncEvent with a context for cancellation and deadlines
+func (c Client) DispatchPeriodicSyncEventContext(ctx context.Context, params DispatchPeriodicSyncEventParams) (DispatchPeriodicSyncEventReturns, error) {
+	var returns_ DispatchPeriodicSyncEventReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.dispatchPeriodicSyncEvent", &params, &returns_)
+
+	return returns_, err_
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetForceUpdateOnPageLoadReturns struct {
+}
+
+// UnmarshalCDP reads SetForceUpdateOnPageLoadReturns from JSON
+func (v *SetForceUpdateOnPageLoadReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetForceUpdateOnPageLoadReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetForceUpdateOnPageLoadParams are the parameters for ServiceWorker.setForceUpdateOnPageLoad
+// optional parameters are left out when nil
+type SetForceUpdateOnPageLoadParams struct {
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
+}
+
+// MarshalCDP writes SetForceUpdateOnPageLoadParams as JSON
+func (v *SetForceUpdateOnPageLoadParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("forceUpdateOnPageLoad")
+	e.Bool(v.ForceUpdateOnPageLoad)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetForceUpdateOnPageLoadParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetForceUpdateOnPageLoad(params SetForceUpdateOnPageLoadParams) (SetForceUpdateOnPageLoadReturns, error) {
+	return c.SetForceUpdateOnPageLoadContext(context.Background(), params)
+}
+
+// SetForceUpdateOnPageLoadContext is SetForceUpdateOnPageLoad with a context for cancellation and deadlines
+func (c Client) SetForceUpdateOnPageLoadContext(ctx context.Context, params SetForceUpdateOnPageLoadParams) (SetForceUpdateOnPageLoadReturns, error) {
+	var returns_ SetForceUpdateOnPageLoadReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.setForceUpdateOnPageLoad", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SkipWaitingReturns struct {
+}
+
+// UnmarshalCDP reads SkipWaitingReturns from JSON
+func (v *SkipWaitingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SkipWaitingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SkipWaitingParams are the parameters for ServiceWorker.skipWaiting
+// optional parameters are left out when nil
+type SkipWaitingParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// MarshalCDP writes SkipWaitingParams as JSON
+func (v *SkipWaitingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scopeURL")
+	e.String(v.ScopeURL)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SkipWaitingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SkipWaiting(params SkipWaitingParams) (SkipWaitingReturns, error) {
+	return c.SkipWaitingContext(context.Background(), params)
+}
+
+// SkipWaitingContext is SkipWaiting with a context for cancellation and deadlines
+func (c Client) SkipWaitingContext(ctx context.Context, params SkipWaitingParams) (SkipWaitingReturns, error) {
+	var returns_ SkipWaitingReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.skipWaiting", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StartWorkerReturns struct {
+}
+
+// UnmarshalCDP reads StartWorkerReturns from JSON
+func (v *StartWorkerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartWorkerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartWorkerParams are the parameters for ServiceWorker.startWorker
+// optional parameters are left out when nil
+type StartWorkerParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// MarshalCDP writes StartWorkerParams as JSON
+func (v *StartWorkerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scopeURL")
+	e.String(v.ScopeURL)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartWorkerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) StartWorker(params StartWorkerParams) (StartWorkerReturns, error) {
+	return c.StartWorkerContext(context.Background(), params)
+}
+
+// StartWorkerContext is StartWorker with a context for cancellation and deadlines
+func (c Client) StartWorkerContext(ctx context.Context, params StartWorkerParams) (StartWorkerReturns, error) {
+	var returns_ StartWorkerReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.startWorker", &params, &returns_)
+
+	return returns_, err_
+}
+
+type StopAllWorkersReturns struct {
+}
+
+// UnmarshalCDP reads StopAllWorkersReturns from JSON
+func (v *StopAllWorkersReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopAllWorkersReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+func (c Client) StopAllWorkers() (StopAllWorkersReturns, error) {
+	return c.StopAllWorkersContext(context.Background())
+}
+
+// StopAllWorkersContext is StopAllWorkers with a context for cancellation and deadlines
+func (c Client) StopAllWorkersContext(ctx context.Context) (StopAllWorkersReturns, error) {
+	var returns_ StopAllWorkersReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.stopAllWorkers", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StopWorkerReturns struct {
+}
+
+// UnmarshalCDP reads StopWorkerReturns from JSON
+func (v *StopWorkerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StopWorkerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StopWorkerParams are the parameters for ServiceWorker.stopWorker
+// optional parameters are left out when nil
+type StopWorkerParams struct {
+	VersionId string `json:"versionId"`
+}
+
+// MarshalCDP writes StopWorkerParams as JSON
+func (v *StopWorkerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("versionId")
+	e.String(v.VersionId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StopWorkerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) StopWorker(params StopWorkerParams) (StopWorkerReturns, error) {
+	return c.StopWorkerContext(context.Background(), params)
+}
+
+// StopWorkerContext is StopWorker with a context for cancellation and deadlines
+func (c Client) StopWorkerContext(ctx context.Context, params StopWorkerParams) (StopWorkerReturns, error) {
+	var returns_ StopWorkerReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.stopWorker", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UnregisterReturns struct {
+}
+
+// UnmarshalCDP reads UnregisterReturns from JSON
+func (v *UnregisterReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UnregisterReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UnregisterParams are the parameters for ServiceWorker.unregister
+// optional parameters are left out when nil
+type UnregisterParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// MarshalCDP writes UnregisterParams as JSON
+func (v *UnregisterParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scopeURL")
+	e.String(v.ScopeURL)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UnregisterParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) Unregister(params UnregisterParams) (UnregisterReturns, error) {
+	return c.UnregisterContext(context.Background(), params)
+}
+
+// UnregisterContext is Unregister with a context for cancellation and deadlines
+func (c Client) UnregisterContext(ctx context.Context, params UnregisterParams) (UnregisterReturns, error) {
+	var returns_ UnregisterReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.unregister", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UpdateRegistrationReturns struct {
+}
+
+// UnmarshalCDP reads UpdateRegistrationReturns from JSON
+func (v *UpdateRegistrationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UpdateRegistrationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UpdateRegistrationParams are the parameters for ServiceWorker.updateRegistration
+// optional parameters are left out when nil
+type UpdateRegistrationParams struct {
+	ScopeURL string `json:"scopeURL"`
+}
+
+// MarshalCDP writes UpdateRegistrationParams as JSON
+func (v *UpdateRegistrationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("scopeURL")
+	e.String(v.ScopeURL)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UpdateRegistrationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) UpdateRegistration(params UpdateRegistrationParams) (UpdateRegistrationReturns, error) {
+	return c.UpdateRegistrationContext(context.Background(), params)
+}
+
+// UpdateRegistrationContext is UpdateRegistration with a context for cancellation and deadlines
+func (c Client) UpdateRegistrationContext(ctx context.Context, params UpdateRegistrationParams) (UpdateRegistrationReturns, error) {
+	var returns_ UpdateRegistrationReturns
+
+	err_ := c.caller.Call(ctx, "ServiceWorker.updateRegistration", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+type WorkerErrorReportedEvent struct {
+	ErrorMessage ServiceWorkerErrorMessage `json:"errorMessage"`
+}
+
+// UnmarshalCDP reads WorkerErrorReportedEvent from JSON
+func (v *WorkerErrorReportedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "errorMessage":
+			v.ErrorMessage.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WorkerErrorReportedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WorkerErrorReportedHandler func(ev WorkerErrorReportedEvent)
+
+// EventMethod is ServiceWorker.workerErrorReported
+func (WorkerErrorReportedEvent) EventMethod() string {
+	return "ServiceWorker.workerErrorReported"
+}
+
+// OnWorkerErrorReported calls handler for each ServiceWorker.workerErrorReported event
+func (c Client) OnWorkerErrorReported(handler WorkerErrorReportedHandler) (unsubscribe func()) {
+	return c.caller.On("ServiceWorker.workerErrorReported", func(ev interface{}) {
+		handler(ev.(WorkerErrorReportedEvent))
+	})
+}
+
+type WorkerRegistrationUpdatedEvent struct {
+	Registrations []ServiceWorkerRegistration `json:"registrations"`
+}
+
+// UnmarshalCDP reads WorkerRegistrationUpdatedEvent from JSON
+func (v *WorkerRegistrationUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "registrations":
+			if d.Array() {
+				v.Registrations = make([]ServiceWorkerRegistration, 0)
+				for d.More() {
+					var x0 ServiceWorkerRegistration
+					x0.UnmarshalCDP(d)
+					v.Registrations = append(v.Registrations, x0)
+				}
+			} else {
+				v.Registrations = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WorkerRegistrationUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WorkerRegistrationUpdatedHandler func(ev WorkerRegistrationUpdatedEvent)
+
+// EventMethod is ServiceWorker.workerRegistrationUpdated
+func (WorkerRegistrationUpdatedEvent) EventMethod() string {
+	return "ServiceWorker.workerRegistrationUpdated"
+}
+
+// OnWorkerRegistrationUpdated calls handler for each ServiceWorker.workerRegistrationUpdated event
+func (c Client) OnWorkerRegistrationUpdated(handler WorkerRegistrationUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("ServiceWorker.workerRegistrationUpdated", func(ev interface{}) {
+		handler(ev.(WorkerRegistrationUpdatedEvent))
+	})
+}
+
+type WorkerVersionUpdatedEvent struct {
+	Versions []ServiceWorkerVersion `json:"versions"`
+}
+
+// UnmarshalCDP reads WorkerVersionUpdatedEvent from JSON
+func (v *WorkerVersionUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "versions":
+			if d.Array() {
+				v.Versions = make([]ServiceWorkerVersion, 0)
+				for d.More() {
+					var x0 ServiceWorkerVersion
+					x0.UnmarshalCDP(d)
+					v.Versions = append(v.Versions, x0)
+				}
+			} else {
+				v.Versions = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *WorkerVersionUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type WorkerVersionUpdatedHandler func(ev WorkerVersionUpdatedEvent)
+
+// EventMethod is ServiceWorker.workerVersionUpdated
+func (WorkerVersionUpdatedEvent) EventMethod() string {
+	return "ServiceWorker.workerVersionUpdated"
+}
+
+// OnWorkerVersionUpdated calls handler for each ServiceWorker.workerVersionUpdated event
+func (c Client) OnWorkerVersionUpdated(handler WorkerVersionUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("ServiceWorker.workerVersionUpdated", func(ev interface{}) {
+		handler(ev.(WorkerVersionUpdatedEvent))
+	})
+}
+
+// decoders for ServiceWorker events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"ServiceWorker.workerErrorReported":       cdp.Decode[WorkerErrorReportedEvent],
+	"ServiceWorker.workerRegistrationUpdated": cdp.Decode[WorkerRegistrationUpdatedEvent],
+	"ServiceWorker.workerVersionUpdated":      cdp.Decode[WorkerVersionUpdatedEvent],
+}
+
+// DecodeEvent decodes a ServiceWorker event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
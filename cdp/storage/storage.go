@@ -0,0 +1,5999 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package storage is the Storage domain of the chrome devtools protocol
+//
+// Experimental: this may change or be removed in any chrome release
+package storage
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Storage commands and listens for Storage events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	SerializedStorageKey = cdp.StorageSerializedStorageKey
+	StorageBucket        = cdp.StorageStorageBucket
+)
+
+// Enum of possible storage types.
+type StorageType string
+
+// StorageType values
+const (
+	StorageTypeCookies        StorageType = "cookies"
+	StorageTypeFileSystems    StorageType = "file_systems"
+	StorageTypeIndexeddb      StorageType = "indexeddb"
+	StorageTypeLocalStorage   StorageType = "local_storage"
+	StorageTypeShaderCache    StorageType = "shader_cache"
+	StorageTypeWebsql         StorageType = "websql"
+	StorageTypeServiceWorkers StorageType = "service_workers"
+	StorageTypeCacheStorage   StorageType = "cache_storage"
+	StorageTypeInterestGroups StorageType = "interest_groups"
+	StorageTypeSharedStorage  StorageType = "shared_storage"
+	StorageTypeStorageBuckets StorageType = "storage_buckets"
+	StorageTypeAll            StorageType = "all"
+	StorageTypeOther          StorageType = "other"
+)
+
+// Values gives every StorageType
+func (StorageType) Values() []StorageType {
+	return []StorageType{
+		StorageTypeCookies,
+		StorageTypeFileSystems,
+		StorageTypeIndexeddb,
+		StorageTypeLocalStorage,
+		StorageTypeShaderCache,
+		StorageTypeWebsql,
+		StorageTypeServiceWorkers,
+		StorageTypeCacheStorage,
+		StorageTypeInterestGroups,
+		StorageTypeSharedStorage,
+		StorageTypeStorageBuckets,
+		StorageTypeAll,
+		StorageTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StorageType) Valid() bool {
+	switch v {
+	case StorageTypeCookies, StorageTypeFileSystems, StorageTypeIndexeddb, StorageTypeLocalStorage, StorageTypeShaderCache, StorageTypeWebsql, StorageTypeServiceWorkers, StorageTypeCacheStorage, StorageTypeInterestGroups, StorageTypeSharedStorage, StorageTypeStorageBuckets, StorageTypeAll, StorageTypeOther:
+		return true
+	}
+	return false
+}
+
+// Usage for a storage type.
+type UsageForType struct {
+	/* Name of storage type. */
+	StorageType StorageType `json:"storageType"`
+	/* Storage usage (bytes). */
+	Usage float64 `json:"usage"`
+}
+
+// MarshalCDP writes UsageForType as JSON
+func (v *UsageForType) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageType")
+	e.String(string(v.StorageType))
+	e.Key("usage")
+	e.Float(v.Usage)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UsageForType) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads UsageForType from JSON
+func (v *UsageForType) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageType":
+			v.StorageType = StorageType(d.String())
+		case "usage":
+			v.Usage = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UsageForType) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Pair of issuer origin and number of available (signed, but not used) Trust
+// Tokens from that issuer.
+//
+// Experimental: this may change or be removed in any chrome release
+type TrustTokens struct {
+	IssuerOrigin string  `json:"issuerOrigin"`
+	Count        float64 `json:"count"`
+}
+
+// MarshalCDP writes TrustTokens as JSON
+func (v *TrustTokens) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("issuerOrigin")
+	e.String(v.IssuerOrigin)
+	e.Key("count")
+	e.Float(v.Count)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrustTokens) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads TrustTokens from JSON
+func (v *TrustTokens) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "issuerOrigin":
+			v.IssuerOrigin = d.String()
+		case "count":
+			v.Count = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrustTokens) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Protected audience interest group auction identifier.
+type InterestGroupAuctionId string
+
+// Enum of interest group access types.
+type InterestGroupAccessType string
+
+// InterestGroupAccessType values
+const (
+	InterestGroupAccessTypeJoin                  InterestGroupAccessType = "join"
+	InterestGroupAccessTypeLeave                 InterestGroupAccessType = "leave"
+	InterestGroupAccessTypeUpdate                InterestGroupAccessType = "update"
+	InterestGroupAccessTypeLoaded                InterestGroupAccessType = "loaded"
+	InterestGroupAccessTypeBid                   InterestGroupAccessType = "bid"
+	InterestGroupAccessTypeWin                   InterestGroupAccessType = "win"
+	InterestGroupAccessTypeAdditionalBid         InterestGroupAccessType = "additionalBid"
+	InterestGroupAccessTypeAdditionalBidWin      InterestGroupAccessType = "additionalBidWin"
+	InterestGroupAccessTypeTopLevelBid           InterestGroupAccessType = "topLevelBid"
+	InterestGroupAccessTypeTopLevelAdditionalBid InterestGroupAccessType = "topLevelAdditionalBid"
+	InterestGroupAccessTypeClear                 InterestGroupAccessType = "clear"
+)
+
+// Values gives every InterestGroupAccessType
+func (InterestGroupAccessType) Values() []InterestGroupAccessType {
+	return []InterestGroupAccessType{
+		InterestGroupAccessTypeJoin,
+		InterestGroupAccessTypeLeave,
+		InterestGroupAccessTypeUpdate,
+		InterestGroupAccessTypeLoaded,
+		InterestGroupAccessTypeBid,
+		InterestGroupAccessTypeWin,
+		InterestGroupAccessTypeAdditionalBid,
+		InterestGroupAccessTypeAdditionalBidWin,
+		InterestGroupAccessTypeTopLevelBid,
+		InterestGroupAccessTypeTopLevelAdditionalBid,
+		InterestGroupAccessTypeClear,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InterestGroupAccessType) Valid() bool {
+	switch v {
+	case InterestGroupAccessTypeJoin, InterestGroupAccessTypeLeave, InterestGroupAccessTypeUpdate, InterestGroupAccessTypeLoaded, InterestGroupAccessTypeBid, InterestGroupAccessTypeWin, InterestGroupAccessTypeAdditionalBid, InterestGroupAccessTypeAdditionalBidWin, InterestGroupAccessTypeTopLevelBid, InterestGroupAccessTypeTopLevelAdditionalBid, InterestGroupAccessTypeClear:
+		return true
+	}
+	return false
+}
+
+// Enum of auction events.
+type InterestGroupAuctionEventType string
+
+// InterestGroupAuctionEventType values
+const (
+	InterestGroupAuctionEventTypeStarted        InterestGroupAuctionEventType = "started"
+	InterestGroupAuctionEventTypeConfigResolved InterestGroupAuctionEventType = "configResolved"
+)
+
+// Values gives every InterestGroupAuctionEventType
+func (InterestGroupAuctionEventType) Values() []InterestGroupAuctionEventType {
+	return []InterestGroupAuctionEventType{
+		InterestGroupAuctionEventTypeStarted,
+		InterestGroupAuctionEventTypeConfigResolved,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InterestGroupAuctionEventType) Valid() bool {
+	switch v {
+	case InterestGroupAuctionEventTypeStarted, InterestGroupAuctionEventTypeConfigResolved:
+		return true
+	}
+	return false
+}
+
+// Enum of network fetches auctions can do.
+type InterestGroupAuctionFetchType string
+
+// InterestGroupAuctionFetchType values
+const (
+	InterestGroupAuctionFetchTypeBidderJs             InterestGroupAuctionFetchType = "bidderJs"
+	InterestGroupAuctionFetchTypeBidderWasm           InterestGroupAuctionFetchType = "bidderWasm"
+	InterestGroupAuctionFetchTypeSellerJs             InterestGroupAuctionFetchType = "sellerJs"
+	InterestGroupAuctionFetchTypeBidderTrustedSignals InterestGroupAuctionFetchType = "bidderTrustedSignals"
+	InterestGroupAuctionFetchTypeSellerTrustedSignals InterestGroupAuctionFetchType = "sellerTrustedSignals"
+)
+
+// Values gives every InterestGroupAuctionFetchType
+func (InterestGroupAuctionFetchType) Values() []InterestGroupAuctionFetchType {
+	return []InterestGroupAuctionFetchType{
+		InterestGroupAuctionFetchTypeBidderJs,
+		InterestGroupAuctionFetchTypeBidderWasm,
+		InterestGroupAuctionFetchTypeSellerJs,
+		InterestGroupAuctionFetchTypeBidderTrustedSignals,
+		InterestGroupAuctionFetchTypeSellerTrustedSignals,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v InterestGroupAuctionFetchType) Valid() bool {
+	switch v {
+	case InterestGroupAuctionFetchTypeBidderJs, InterestGroupAuctionFetchTypeBidderWasm, InterestGroupAuctionFetchTypeSellerJs, InterestGroupAuctionFetchTypeBidderTrustedSignals, InterestGroupAuctionFetchTypeSellerTrustedSignals:
+		return true
+	}
+	return false
+}
+
+// Enum of shared storage access scopes.
+type SharedStorageAccessScope string
+
+// SharedStorageAccessScope values
+const (
+	SharedStorageAccessScopeWindow                   SharedStorageAccessScope = "window"
+	SharedStorageAccessScopeSharedStorageWorklet     SharedStorageAccessScope = "sharedStorageWorklet"
+	SharedStorageAccessScopeProtectedAudienceWorklet SharedStorageAccessScope = "protectedAudienceWorklet"
+	SharedStorageAccessScopeHeader                   SharedStorageAccessScope = "header"
+)
+
+// Values gives every SharedStorageAccessScope
+func (SharedStorageAccessScope) Values() []SharedStorageAccessScope {
+	return []SharedStorageAccessScope{
+		SharedStorageAccessScopeWindow,
+		SharedStorageAccessScopeSharedStorageWorklet,
+		SharedStorageAccessScopeProtectedAudienceWorklet,
+		SharedStorageAccessScopeHeader,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SharedStorageAccessScope) Valid() bool {
+	switch v {
+	case SharedStorageAccessScopeWindow, SharedStorageAccessScopeSharedStorageWorklet, SharedStorageAccessScopeProtectedAudienceWorklet, SharedStorageAccessScopeHeader:
+		return true
+	}
+	return false
+}
+
+// Enum of shared storage access methods.
+type SharedStorageAccessMethod string
+
+// SharedStorageAccessMethod values
+const (
+	SharedStorageAccessMethodAddModule       SharedStorageAccessMethod = "addModule"
+	SharedStorageAccessMethodCreateWorklet   SharedStorageAccessMethod = "createWorklet"
+	SharedStorageAccessMethodSelectURL       SharedStorageAccessMethod = "selectURL"
+	SharedStorageAccessMethodRun             SharedStorageAccessMethod = "run"
+	SharedStorageAccessMethodBatchUpdate     SharedStorageAccessMethod = "batchUpdate"
+	SharedStorageAccessMethodSet             SharedStorageAccessMethod = "set"
+	SharedStorageAccessMethodAppend          SharedStorageAccessMethod = "append"
+	SharedStorageAccessMethodDelete          SharedStorageAccessMethod = "delete"
+	SharedStorageAccessMethodClear           SharedStorageAccessMethod = "clear"
+	SharedStorageAccessMethodGet             SharedStorageAccessMethod = "get"
+	SharedStorageAccessMethodKeys            SharedStorageAccessMethod = "keys"
+	SharedStorageAccessMethodValues          SharedStorageAccessMethod = "values"
+	SharedStorageAccessMethodEntries         SharedStorageAccessMethod = "entries"
+	SharedStorageAccessMethodLength          SharedStorageAccessMethod = "length"
+	SharedStorageAccessMethodRemainingBudget SharedStorageAccessMethod = "remainingBudget"
+)
+
+// Values gives every SharedStorageAccessMethod
+func (SharedStorageAccessMethod) Values() []SharedStorageAccessMethod {
+	return []SharedStorageAccessMethod{
+		SharedStorageAccessMethodAddModule,
+		SharedStorageAccessMethodCreateWorklet,
+		SharedStorageAccessMethodSelectURL,
+		SharedStorageAccessMethodRun,
+		SharedStorageAccessMethodBatchUpdate,
+		SharedStorageAccessMethodSet,
+		SharedStorageAccessMethodAppend,
+		SharedStorageAccessMethodDelete,
+		SharedStorageAccessMethodClear,
+		SharedStorageAccessMethodGet,
+		SharedStorageAccessMethodKeys,
+		SharedStorageAccessMethodValues,
+		SharedStorageAccessMethodEntries,
+		SharedStorageAccessMethodLength,
+		SharedStorageAccessMethodRemainingBudget,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SharedStorageAccessMethod) Valid() bool {
+	switch v {
+	case SharedStorageAccessMethodAddModule, SharedStorageAccessMethodCreateWorklet, SharedStorageAccessMethodSelectURL, SharedStorageAccessMethodRun, SharedStorageAccessMethodBatchUpdate, SharedStorageAccessMethodSet, SharedStorageAccessMethodAppend, SharedStorageAccessMethodDelete, SharedStorageAccessMethodClear, SharedStorageAccessMethodGet, SharedStorageAccessMethodKeys, SharedStorageAccessMethodValues, SharedStorageAccessMethodEntries, SharedStorageAccessMethodLength, SharedStorageAccessMethodRemainingBudget:
+		return true
+	}
+	return false
+}
+
+// Struct for a single key-value pair in an origin's shared storage.
+type SharedStorageEntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// MarshalCDP writes SharedStorageEntry as JSON
+func (v *SharedStorageEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("value")
+	e.String(v.Value)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedStorageEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedStorageEntry from JSON
+func (v *SharedStorageEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key = d.String()
+		case "value":
+			v.Value = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Details for an origin's shared storage.
+type SharedStorageMetadata struct {
+	/* Time when the origin's shared storage was last created. */
+	CreationTime cdp.NetworkTimeSinceEpoch `json:"creationTime"`
+	/* Number of key-value pairs stored in origin's shared storage. */
+	Length int `json:"length"`
+	/* Current amount of bits of entropy remaining in the navigation budget. */
+	RemainingBudget float64 `json:"remainingBudget"`
+	/* Total number of bytes stored as key-value pairs in origin's shared
+	storage. */
+	BytesUsed int `json:"bytesUsed"`
+}
+
+// MarshalCDP writes SharedStorageMetadata as JSON
+func (v *SharedStorageMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("creationTime")
+	e.Float(float64(v.CreationTime))
+	e.Key("length")
+	e.Int(v.Length)
+	e.Key("remainingBudget")
+	e.Float(v.RemainingBudget)
+	e.Key("bytesUsed")
+	e.Int(v.BytesUsed)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedStorageMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedStorageMetadata from JSON
+func (v *SharedStorageMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "creationTime":
+			v.CreationTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "length":
+			v.Length = d.Int()
+		case "remainingBudget":
+			v.RemainingBudget = d.Float()
+		case "bytesUsed":
+			v.BytesUsed = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Represents a dictionary object passed in as privateAggregationConfig to
+// run or selectURL.
+type SharedStoragePrivateAggregationConfig struct {
+	/* The chosen aggregation service deployment. */
+	AggregationCoordinatorOrigin *string `json:"aggregationCoordinatorOrigin,omitempty"`
+	/* The context ID provided. */
+	ContextId *string `json:"contextId,omitempty"`
+	/* Configures the maximum size allowed for filtering IDs. */
+	FilteringIdMaxBytes int `json:"filteringIdMaxBytes"`
+	/* The limit on the number of contributions in the final report. */
+	MaxContributions *int `json:"maxContributions,omitempty"`
+}
+
+// MarshalCDP writes SharedStoragePrivateAggregationConfig as JSON
+func (v *SharedStoragePrivateAggregationConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.AggregationCoordinatorOrigin != nil {
+		e.Key("aggregationCoordinatorOrigin")
+		e.String(*v.AggregationCoordinatorOrigin)
+	}
+	if v.ContextId != nil {
+		e.Key("contextId")
+		e.String(*v.ContextId)
+	}
+	e.Key("filteringIdMaxBytes")
+	e.Int(v.FilteringIdMaxBytes)
+	if v.MaxContributions != nil {
+		e.Key("maxContributions")
+		e.Int(*v.MaxContributions)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedStoragePrivateAggregationConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedStoragePrivateAggregationConfig from JSON
+func (v *SharedStoragePrivateAggregationConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "aggregationCoordinatorOrigin":
+			if d.Null() {
+				v.AggregationCoordinatorOrigin = nil
+			} else {
+				v.AggregationCoordinatorOrigin = new(string)
+				*v.AggregationCoordinatorOrigin = d.String()
+			}
+		case "contextId":
+			if d.Null() {
+				v.ContextId = nil
+			} else {
+				v.ContextId = new(string)
+				*v.ContextId = d.String()
+			}
+		case "filteringIdMaxBytes":
+			v.FilteringIdMaxBytes = d.Int()
+		case "maxContributions":
+			if d.Null() {
+				v.MaxContributions = nil
+			} else {
+				v.MaxContributions = new(int)
+				*v.MaxContributions = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStoragePrivateAggregationConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Pair of reporting metadata details for a candidate URL for `selectURL()`.
+type SharedStorageReportingMetadata struct {
+	EventType    string `json:"eventType"`
+	ReportingUrl string `json:"reportingUrl"`
+}
+
+// MarshalCDP writes SharedStorageReportingMetadata as JSON
+func (v *SharedStorageReportingMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("eventType")
+	e.String(v.EventType)
+	e.Key("reportingUrl")
+	e.String(v.ReportingUrl)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedStorageReportingMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedStorageReportingMetadata from JSON
+func (v *SharedStorageReportingMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "eventType":
+			v.EventType = d.String()
+		case "reportingUrl":
+			v.ReportingUrl = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageReportingMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Bundles a candidate URL with its reporting metadata.
+type SharedStorageUrlWithMetadata struct {
+	/* Spec of candidate URL. */
+	Url string `json:"url"`
+	/* Any associated reporting metadata. */
+	ReportingMetadata []SharedStorageReportingMetadata `json:"reportingMetadata"`
+}
+
+// MarshalCDP writes SharedStorageUrlWithMetadata as JSON
+func (v *SharedStorageUrlWithMetadata) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("reportingMetadata")
+	if v.ReportingMetadata == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ReportingMetadata {
+			e.Elem()
+			v.ReportingMetadata[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedStorageUrlWithMetadata) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedStorageUrlWithMetadata from JSON
+func (v *SharedStorageUrlWithMetadata) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "reportingMetadata":
+			if d.Array() {
+				v.ReportingMetadata = make([]SharedStorageReportingMetadata, 0)
+				for d.More() {
+					var x0 SharedStorageReportingMetadata
+					x0.UnmarshalCDP(d)
+					v.ReportingMetadata = append(v.ReportingMetadata, x0)
+				}
+			} else {
+				v.ReportingMetadata = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageUrlWithMetadata) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Bundles the parameters for shared storage access events whose
+// presence/absence can vary according to SharedStorageAccessType.
+type SharedStorageAccessParams struct {
+	/* Spec of the module script URL.
+	Present only for SharedStorageAccessMethods: addModule and
+	createWorklet. */
+	ScriptSourceUrl *string `json:"scriptSourceUrl,omitempty"`
+	/* String denoting "context-origin", "script-origin", or a custom
+	origin to be used as the worklet's data origin.
+	Present only for SharedStorageAccessMethod: createWorklet. */
+	DataOrigin *string `json:"dataOrigin,omitempty"`
+	/* Name of the registered operation to be run.
+	Present only for SharedStorageAccessMethods: run and selectURL. */
+	OperationName *string `json:"operationName,omitempty"`
+	/* ID of the operation call.
+	Present only for SharedStorageAccessMethods: run and selectURL. */
+	OperationId *string `json:"operationId,omitempty"`
+	/* Whether or not to keep the worket alive for future run or selectURL
+	calls.
+	Present only for SharedStorageAccessMethods: run and selectURL. */
+	KeepAlive *bool `json:"keepAlive,omitempty"`
+	/* Configures the private aggregation options.
+	Present only for SharedStorageAccessMethods: run and selectURL. */
+	PrivateAggregationConfig *SharedStoragePrivateAggregationConfig `json:"privateAggregationConfig,omitempty"`
+	/* The operation's serialized data in bytes (converted to a string).
+	Present only for SharedStorageAccessMethods: run and selectURL.
+	TODO(crbug.com/401011862): Consider updating this parameter to binary. */
+	SerializedData *string `json:"serializedData,omitempty"`
+	/* Array of candidate URLs' specs, along with any associated metadata.
+	Present only for SharedStorageAccessMethod: selectURL. */
+	UrlsWithMetadata []SharedStorageUrlWithMetadata `json:"urlsWithMetadata"`
+	/* Spec of the URN:UUID generated for a selectURL call.
+	Present only for SharedStorageAccessMethod: selectURL. */
+	UrnUuid *string `json:"urnUuid,omitempty"`
+	/* Key for a specific entry in an origin's shared storage.
+	Present only for SharedStorageAccessMethods: set, append, delete, and
+	get. */
+	Key *string `json:"key,omitempty"`
+	/* Value for a specific entry in an origin's shared storage.
+	Present only for SharedStorageAccessMethods: set and append. */
+	Value *string `json:"value,omitempty"`
+	/* Whether or not to set an entry for a key if that key is already present.
+	Present only for SharedStorageAccessMethod: set. */
+	IgnoreIfPresent *bool `json:"ignoreIfPresent,omitempty"`
+	/* A number denoting the (0-based) order of the worklet's
+	creation relative to all other shared storage worklets created by
+	documents using the current storage partition.
+	Present only for SharedStorageAccessMethods: addModule, createWorklet. */
+	WorkletOrdinal *int `json:"workletOrdinal,omitempty"`
+	/* Hex representation of the DevTools token used as the TargetID for the
+	associated shared storage worklet.
+	Present only for SharedStorageAccessMethods: addModule, createWorklet,
+	run, selectURL, and any other SharedStorageAccessMethod when the
+	SharedStorageAccessScope is sharedStorageWorklet. */
+	WorkletTargetId *cdp.TargetTargetID `json:"workletTargetId,omitempty"`
+	/* Name of the lock to be acquired, if present.
+	Optionally present only for SharedStorageAccessMethods: batchUpdate,
+	set, append, delete, and clear. */
+	WithLock *string `json:"withLock,omitempty"`
+	/* If the method has been called as part of a batchUpdate, then this
+	number identifies the batch to which it belongs.
+	Optionally present only for SharedStorageAccessMethods:
+	batchUpdate (required), set, append, delete, and clear. */
+	BatchUpdateId *string `json:"batchUpdateId,omitempty"`
+	/* Number of modifier methods sent in batch.
+	Present only for SharedStorageAccessMethod: batchUpdate. */
+	BatchSize *int `json:"batchSize,omitempty"`
+}
+
+// MarshalCDP writes SharedStorageAccessParams as JSON
+func (v *SharedStorageAccessParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.ScriptSourceUrl != nil {
+		e.Key("scriptSourceUrl")
+		e.String(*v.ScriptSourceUrl)
+	}
+	if v.DataOrigin != nil {
+		e.Key("dataOrigin")
+		e.String(*v.DataOrigin)
+	}
+	if v.OperationName != nil {
+		e.Key("operationName")
+		e.String(*v.OperationName)
+	}
+	if v.OperationId != nil {
+		e.Key("operationId")
+		e.String(*v.OperationId)
+	}
+	if v.KeepAlive != nil {
+		e.Key("keepAlive")
+		e.Bool(*v.KeepAlive)
+	}
+	if v.PrivateAggregationConfig != nil {
+		e.Key("privateAggregationConfig")
+		(*v.PrivateAggregationConfig).MarshalCDP(e)
+	}
+	if v.SerializedData != nil {
+		e.Key("serializedData")
+		e.String(*v.SerializedData)
+	}
+	if v.UrlsWithMetadata != nil {
+		e.Key("urlsWithMetadata")
+		e.ArrayStart()
+		for i0 := range v.UrlsWithMetadata {
+			e.Elem()
+			v.UrlsWithMetadata[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.UrnUuid != nil {
+		e.Key("urnUuid")
+		e.String(*v.UrnUuid)
+	}
+	if v.Key != nil {
+		e.Key("key")
+		e.String(*v.Key)
+	}
+	if v.Value != nil {
+		e.Key("value")
+		e.String(*v.Value)
+	}
+	if v.IgnoreIfPresent != nil {
+		e.Key("ignoreIfPresent")
+		e.Bool(*v.IgnoreIfPresent)
+	}
+	if v.WorkletOrdinal != nil {
+		e.Key("workletOrdinal")
+		e.Int(*v.WorkletOrdinal)
+	}
+	if v.WorkletTargetId != nil {
+		e.Key("workletTargetId")
+		e.String(string(*v.WorkletTargetId))
+	}
+	if v.WithLock != nil {
+		e.Key("withLock")
+		e.String(*v.WithLock)
+	}
+	if v.BatchUpdateId != nil {
+		e.Key("batchUpdateId")
+		e.String(*v.BatchUpdateId)
+	}
+	if v.BatchSize != nil {
+		e.Key("batchSize")
+		e.Int(*v.BatchSize)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SharedStorageAccessParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads SharedStorageAccessParams from JSON
+func (v *SharedStorageAccessParams) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptSourceUrl":
+			if d.Null() {
+				v.ScriptSourceUrl = nil
+			} else {
+				v.ScriptSourceUrl = new(string)
+				*v.ScriptSourceUrl = d.String()
+			}
+		case "dataOrigin":
+			if d.Null() {
+				v.DataOrigin = nil
+			} else {
+				v.DataOrigin = new(string)
+				*v.DataOrigin = d.String()
+			}
+		case "operationName":
+			if d.Null() {
+				v.OperationName = nil
+			} else {
+				v.OperationName = new(string)
+				*v.OperationName = d.String()
+			}
+		case "operationId":
+			if d.Null() {
+				v.OperationId = nil
+			} else {
+				v.OperationId = new(string)
+				*v.OperationId = d.String()
+			}
+		case "keepAlive":
+			if d.Null() {
+				v.KeepAlive = nil
+			} else {
+				v.KeepAlive = new(bool)
+				*v.KeepAlive = d.Bool()
+			}
+		case "privateAggregationConfig":
+			if d.Null() {
+				v.PrivateAggregationConfig = nil
+			} else {
+				v.PrivateAggregationConfig = new(SharedStoragePrivateAggregationConfig)
+				(*v.PrivateAggregationConfig).UnmarshalCDP(d)
+			}
+		case "serializedData":
+			if d.Null() {
+				v.SerializedData = nil
+			} else {
+				v.SerializedData = new(string)
+				*v.SerializedData = d.String()
+			}
+		case "urlsWithMetadata":
+			if d.Array() {
+				v.UrlsWithMetadata = make([]SharedStorageUrlWithMetadata, 0)
+				for d.More() {
+					var x0 SharedStorageUrlWithMetadata
+					x0.UnmarshalCDP(d)
+					v.UrlsWithMetadata = append(v.UrlsWithMetadata, x0)
+				}
+			} else {
+				v.UrlsWithMetadata = nil
+			}
+		case "urnUuid":
+			if d.Null() {
+				v.UrnUuid = nil
+			} else {
+				v.UrnUuid = new(string)
+				*v.UrnUuid = d.String()
+			}
+		case "key":
+			if d.Null() {
+				v.Key = nil
+			} else {
+				v.Key = new(string)
+				*v.Key = d.String()
+			}
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(string)
+				*v.Value = d.String()
+			}
+		case "ignoreIfPresent":
+			if d.Null() {
+				v.IgnoreIfPresent = nil
+			} else {
+				v.IgnoreIfPresent = new(bool)
+				*v.IgnoreIfPresent = d.Bool()
+			}
+		case "workletOrdinal":
+			if d.Null() {
+				v.WorkletOrdinal = nil
+			} else {
+				v.WorkletOrdinal = new(int)
+				*v.WorkletOrdinal = d.Int()
+			}
+		case "workletTargetId":
+			if d.Null() {
+				v.WorkletTargetId = nil
+			} else {
+				v.WorkletTargetId = new(cdp.TargetTargetID)
+				*v.WorkletTargetId = cdp.TargetTargetID(d.String())
+			}
+		case "withLock":
+			if d.Null() {
+				v.WithLock = nil
+			} else {
+				v.WithLock = new(string)
+				*v.WithLock = d.String()
+			}
+		case "batchUpdateId":
+			if d.Null() {
+				v.BatchUpdateId = nil
+			} else {
+				v.BatchUpdateId = new(string)
+				*v.BatchUpdateId = d.String()
+			}
+		case "batchSize":
+			if d.Null() {
+				v.BatchSize = nil
+			} else {
+				v.BatchSize = new(int)
+				*v.BatchSize = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageAccessParams) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StorageBucketsDurability string
+
+// StorageBucketsDurability values
+const (
+	StorageBucketsDurabilityRelaxed StorageBucketsDurability = "relaxed"
+	StorageBucketsDurabilityStrict  StorageBucketsDurability = "strict"
+)
+
+// Values gives every StorageBucketsDurability
+func (StorageBucketsDurability) Values() []StorageBucketsDurability {
+	return []StorageBucketsDurability{
+		StorageBucketsDurabilityRelaxed,
+		StorageBucketsDurabilityStrict,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StorageBucketsDurability) Valid() bool {
+	switch v {
+	case StorageBucketsDurabilityRelaxed, StorageBucketsDurabilityStrict:
+		return true
+	}
+	return false
+}
+
+type StorageBucketInfo struct {
+	Bucket     StorageBucket             `json:"bucket"`
+	Id         string                    `json:"id"`
+	Expiration cdp.NetworkTimeSinceEpoch `json:"expiration"`
+	/* Storage quota (bytes). */
+	Quota      float64                  `json:"quota"`
+	Persistent bool                     `json:"persistent"`
+	Durability StorageBucketsDurability `json:"durability"`
+}
+
+// MarshalCDP writes StorageBucketInfo as JSON
+func (v *StorageBucketInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("bucket")
+	v.Bucket.MarshalCDP(e)
+	e.Key("id")
+	e.String(v.Id)
+	e.Key("expiration")
+	e.Float(float64(v.Expiration))
+	e.Key("quota")
+	e.Float(v.Quota)
+	e.Key("persistent")
+	e.Bool(v.Persistent)
+	e.Key("durability")
+	e.String(string(v.Durability))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StorageBucketInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads StorageBucketInfo from JSON
+func (v *StorageBucketInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bucket":
+			v.Bucket.UnmarshalCDP(d)
+		case "id":
+			v.Id = d.String()
+		case "expiration":
+			v.Expiration = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "quota":
+			v.Quota = d.Float()
+		case "persistent":
+			v.Persistent = d.Bool()
+		case "durability":
+			v.Durability = StorageBucketsDurability(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StorageBucketInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingSourceType string
+
+// AttributionReportingSourceType values
+const (
+	AttributionReportingSourceTypeNavigation AttributionReportingSourceType = "navigation"
+	AttributionReportingSourceTypeEvent      AttributionReportingSourceType = "event"
+)
+
+// Values gives every AttributionReportingSourceType
+func (AttributionReportingSourceType) Values() []AttributionReportingSourceType {
+	return []AttributionReportingSourceType{
+		AttributionReportingSourceTypeNavigation,
+		AttributionReportingSourceTypeEvent,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingSourceType) Valid() bool {
+	switch v {
+	case AttributionReportingSourceTypeNavigation, AttributionReportingSourceTypeEvent:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type UnsignedInt64AsBase10 string
+
+// Experimental: this may change or be removed in any chrome release
+type UnsignedInt128AsBase16 string
+
+// Experimental: this may change or be removed in any chrome release
+type SignedInt64AsBase10 string
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingFilterDataEntry struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+}
+
+// MarshalCDP writes AttributionReportingFilterDataEntry as JSON
+func (v *AttributionReportingFilterDataEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("values")
+	if v.Values == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Values {
+			e.Elem()
+			e.String(v.Values[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingFilterDataEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingFilterDataEntry from JSON
+func (v *AttributionReportingFilterDataEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key = d.String()
+		case "values":
+			if d.Array() {
+				v.Values = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Values = append(v.Values, x0)
+				}
+			} else {
+				v.Values = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingFilterDataEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingFilterConfig struct {
+	FilterValues []AttributionReportingFilterDataEntry `json:"filterValues"`
+	/* duration in seconds */
+	LookbackWindow *int `json:"lookbackWindow,omitempty"`
+}
+
+// MarshalCDP writes AttributionReportingFilterConfig as JSON
+func (v *AttributionReportingFilterConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("filterValues")
+	if v.FilterValues == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.FilterValues {
+			e.Elem()
+			v.FilterValues[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.LookbackWindow != nil {
+		e.Key("lookbackWindow")
+		e.Int(*v.LookbackWindow)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingFilterConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingFilterConfig from JSON
+func (v *AttributionReportingFilterConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "filterValues":
+			if d.Array() {
+				v.FilterValues = make([]AttributionReportingFilterDataEntry, 0)
+				for d.More() {
+					var x0 AttributionReportingFilterDataEntry
+					x0.UnmarshalCDP(d)
+					v.FilterValues = append(v.FilterValues, x0)
+				}
+			} else {
+				v.FilterValues = nil
+			}
+		case "lookbackWindow":
+			if d.Null() {
+				v.LookbackWindow = nil
+			} else {
+				v.LookbackWindow = new(int)
+				*v.LookbackWindow = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingFilterConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingFilterPair struct {
+	Filters    []AttributionReportingFilterConfig `json:"filters"`
+	NotFilters []AttributionReportingFilterConfig `json:"notFilters"`
+}
+
+// MarshalCDP writes AttributionReportingFilterPair as JSON
+func (v *AttributionReportingFilterPair) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("filters")
+	if v.Filters == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Filters {
+			e.Elem()
+			v.Filters[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("notFilters")
+	if v.NotFilters == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NotFilters {
+			e.Elem()
+			v.NotFilters[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingFilterPair) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingFilterPair from JSON
+func (v *AttributionReportingFilterPair) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "filters":
+			if d.Array() {
+				v.Filters = make([]AttributionReportingFilterConfig, 0)
+				for d.More() {
+					var x0 AttributionReportingFilterConfig
+					x0.UnmarshalCDP(d)
+					v.Filters = append(v.Filters, x0)
+				}
+			} else {
+				v.Filters = nil
+			}
+		case "notFilters":
+			if d.Array() {
+				v.NotFilters = make([]AttributionReportingFilterConfig, 0)
+				for d.More() {
+					var x0 AttributionReportingFilterConfig
+					x0.UnmarshalCDP(d)
+					v.NotFilters = append(v.NotFilters, x0)
+				}
+			} else {
+				v.NotFilters = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingFilterPair) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregationKeysEntry struct {
+	Key   string                 `json:"key"`
+	Value UnsignedInt128AsBase16 `json:"value"`
+}
+
+// MarshalCDP writes AttributionReportingAggregationKeysEntry as JSON
+func (v *AttributionReportingAggregationKeysEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("value")
+	e.String(string(v.Value))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregationKeysEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregationKeysEntry from JSON
+func (v *AttributionReportingAggregationKeysEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key = d.String()
+		case "value":
+			v.Value = UnsignedInt128AsBase16(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregationKeysEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingEventReportWindows struct {
+	/* duration in seconds */
+	Start int `json:"start"`
+	/* duration in seconds */
+	Ends []int `json:"ends"`
+}
+
+// MarshalCDP writes AttributionReportingEventReportWindows as JSON
+func (v *AttributionReportingEventReportWindows) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("start")
+	e.Int(v.Start)
+	e.Key("ends")
+	if v.Ends == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Ends {
+			e.Elem()
+			e.Int(v.Ends[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingEventReportWindows) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingEventReportWindows from JSON
+func (v *AttributionReportingEventReportWindows) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "start":
+			v.Start = d.Int()
+		case "ends":
+			if d.Array() {
+				v.Ends = make([]int, 0)
+				for d.More() {
+					var x0 int
+					x0 = d.Int()
+					v.Ends = append(v.Ends, x0)
+				}
+			} else {
+				v.Ends = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingEventReportWindows) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingTriggerDataMatching string
+
+// AttributionReportingTriggerDataMatching values
+const (
+	AttributionReportingTriggerDataMatchingExact   AttributionReportingTriggerDataMatching = "exact"
+	AttributionReportingTriggerDataMatchingModulus AttributionReportingTriggerDataMatching = "modulus"
+)
+
+// Values gives every AttributionReportingTriggerDataMatching
+func (AttributionReportingTriggerDataMatching) Values() []AttributionReportingTriggerDataMatching {
+	return []AttributionReportingTriggerDataMatching{
+		AttributionReportingTriggerDataMatchingExact,
+		AttributionReportingTriggerDataMatchingModulus,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingTriggerDataMatching) Valid() bool {
+	switch v {
+	case AttributionReportingTriggerDataMatchingExact, AttributionReportingTriggerDataMatchingModulus:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableDebugReportingData struct {
+	KeyPiece UnsignedInt128AsBase16 `json:"keyPiece"`
+	/* number instead of integer because not all uint32 can be represented by
+	int */
+	Value float64  `json:"value"`
+	Types []string `json:"types"`
+}
+
+// MarshalCDP writes AttributionReportingAggregatableDebugReportingData as JSON
+func (v *AttributionReportingAggregatableDebugReportingData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("keyPiece")
+	e.String(string(v.KeyPiece))
+	e.Key("value")
+	e.Float(v.Value)
+	e.Key("types")
+	if v.Types == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Types {
+			e.Elem()
+			e.String(v.Types[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregatableDebugReportingData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregatableDebugReportingData from JSON
+func (v *AttributionReportingAggregatableDebugReportingData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "keyPiece":
+			v.KeyPiece = UnsignedInt128AsBase16(d.String())
+		case "value":
+			v.Value = d.Float()
+		case "types":
+			if d.Array() {
+				v.Types = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Types = append(v.Types, x0)
+				}
+			} else {
+				v.Types = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregatableDebugReportingData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableDebugReportingConfig struct {
+	/* number instead of integer because not all uint32 can be represented by
+	int, only present for source registrations */
+	Budget                       *float64                                             `json:"budget,omitempty"`
+	KeyPiece                     UnsignedInt128AsBase16                               `json:"keyPiece"`
+	DebugData                    []AttributionReportingAggregatableDebugReportingData `json:"debugData"`
+	AggregationCoordinatorOrigin *string                                              `json:"aggregationCoordinatorOrigin,omitempty"`
+}
+
+// MarshalCDP writes AttributionReportingAggregatableDebugReportingConfig as JSON
+func (v *AttributionReportingAggregatableDebugReportingConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Budget != nil {
+		e.Key("budget")
+		e.Float(*v.Budget)
+	}
+	e.Key("keyPiece")
+	e.String(string(v.KeyPiece))
+	e.Key("debugData")
+	if v.DebugData == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.DebugData {
+			e.Elem()
+			v.DebugData[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.AggregationCoordinatorOrigin != nil {
+		e.Key("aggregationCoordinatorOrigin")
+		e.String(*v.AggregationCoordinatorOrigin)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregatableDebugReportingConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregatableDebugReportingConfig from JSON
+func (v *AttributionReportingAggregatableDebugReportingConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "budget":
+			if d.Null() {
+				v.Budget = nil
+			} else {
+				v.Budget = new(float64)
+				*v.Budget = d.Float()
+			}
+		case "keyPiece":
+			v.KeyPiece = UnsignedInt128AsBase16(d.String())
+		case "debugData":
+			if d.Array() {
+				v.DebugData = make([]AttributionReportingAggregatableDebugReportingData, 0)
+				for d.More() {
+					var x0 AttributionReportingAggregatableDebugReportingData
+					x0.UnmarshalCDP(d)
+					v.DebugData = append(v.DebugData, x0)
+				}
+			} else {
+				v.DebugData = nil
+			}
+		case "aggregationCoordinatorOrigin":
+			if d.Null() {
+				v.AggregationCoordinatorOrigin = nil
+			} else {
+				v.AggregationCoordinatorOrigin = new(string)
+				*v.AggregationCoordinatorOrigin = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregatableDebugReportingConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionScopesData struct {
+	Values []string `json:"values"`
+	/* number instead of integer because not all uint32 can be represented by
+	int */
+	Limit          float64 `json:"limit"`
+	MaxEventStates float64 `json:"maxEventStates"`
+}
+
+// MarshalCDP writes AttributionScopesData as JSON
+func (v *AttributionScopesData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("values")
+	if v.Values == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Values {
+			e.Elem()
+			e.String(v.Values[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("limit")
+	e.Float(v.Limit)
+	e.Key("maxEventStates")
+	e.Float(v.MaxEventStates)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionScopesData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionScopesData from JSON
+func (v *AttributionScopesData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "values":
+			if d.Array() {
+				v.Values = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Values = append(v.Values, x0)
+				}
+			} else {
+				v.Values = nil
+			}
+		case "limit":
+			v.Limit = d.Float()
+		case "maxEventStates":
+			v.MaxEventStates = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionScopesData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingNamedBudgetDef struct {
+	Name   string `json:"name"`
+	Budget int    `json:"budget"`
+}
+
+// MarshalCDP writes AttributionReportingNamedBudgetDef as JSON
+func (v *AttributionReportingNamedBudgetDef) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("budget")
+	e.Int(v.Budget)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingNamedBudgetDef) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingNamedBudgetDef from JSON
+func (v *AttributionReportingNamedBudgetDef) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "budget":
+			v.Budget = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingNamedBudgetDef) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingSourceRegistration struct {
+	Time cdp.NetworkTimeSinceEpoch `json:"time"`
+	/* duration in seconds */
+	Expiry int `json:"expiry"`
+	/* number instead of integer because not all uint32 can be represented by
+	int */
+	TriggerData        []float64                              `json:"triggerData"`
+	EventReportWindows AttributionReportingEventReportWindows `json:"eventReportWindows"`
+	/* duration in seconds */
+	AggregatableReportWindow         int                                                  `json:"aggregatableReportWindow"`
+	Type                             AttributionReportingSourceType                       `json:"type"`
+	SourceOrigin                     string                                               `json:"sourceOrigin"`
+	ReportingOrigin                  string                                               `json:"reportingOrigin"`
+	DestinationSites                 []string                                             `json:"destinationSites"`
+	EventId                          UnsignedInt64AsBase10                                `json:"eventId"`
+	Priority                         SignedInt64AsBase10                                  `json:"priority"`
+	FilterData                       []AttributionReportingFilterDataEntry                `json:"filterData"`
+	AggregationKeys                  []AttributionReportingAggregationKeysEntry           `json:"aggregationKeys"`
+	DebugKey                         *UnsignedInt64AsBase10                               `json:"debugKey,omitempty"`
+	TriggerDataMatching              AttributionReportingTriggerDataMatching              `json:"triggerDataMatching"`
+	DestinationLimitPriority         SignedInt64AsBase10                                  `json:"destinationLimitPriority"`
+	AggregatableDebugReportingConfig AttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	ScopesData                       *AttributionScopesData                               `json:"scopesData,omitempty"`
+	MaxEventLevelReports             int                                                  `json:"maxEventLevelReports"`
+	NamedBudgets                     []AttributionReportingNamedBudgetDef                 `json:"namedBudgets"`
+	DebugReporting                   bool                                                 `json:"debugReporting"`
+	EventLevelEpsilon                float64                                              `json:"eventLevelEpsilon"`
+}
+
+// MarshalCDP writes AttributionReportingSourceRegistration as JSON
+func (v *AttributionReportingSourceRegistration) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("time")
+	e.Float(float64(v.Time))
+	e.Key("expiry")
+	e.Int(v.Expiry)
+	e.Key("triggerData")
+	if v.TriggerData == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.TriggerData {
+			e.Elem()
+			e.Float(v.TriggerData[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("eventReportWindows")
+	v.EventReportWindows.MarshalCDP(e)
+	e.Key("aggregatableReportWindow")
+	e.Int(v.AggregatableReportWindow)
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("sourceOrigin")
+	e.String(v.SourceOrigin)
+	e.Key("reportingOrigin")
+	e.String(v.ReportingOrigin)
+	e.Key("destinationSites")
+	if v.DestinationSites == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.DestinationSites {
+			e.Elem()
+			e.String(v.DestinationSites[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("eventId")
+	e.String(string(v.EventId))
+	e.Key("priority")
+	e.String(string(v.Priority))
+	e.Key("filterData")
+	if v.FilterData == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.FilterData {
+			e.Elem()
+			v.FilterData[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("aggregationKeys")
+	if v.AggregationKeys == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AggregationKeys {
+			e.Elem()
+			v.AggregationKeys[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.DebugKey != nil {
+		e.Key("debugKey")
+		e.String(string(*v.DebugKey))
+	}
+	e.Key("triggerDataMatching")
+	e.String(string(v.TriggerDataMatching))
+	e.Key("destinationLimitPriority")
+	e.String(string(v.DestinationLimitPriority))
+	e.Key("aggregatableDebugReportingConfig")
+	v.AggregatableDebugReportingConfig.MarshalCDP(e)
+	if v.ScopesData != nil {
+		e.Key("scopesData")
+		(*v.ScopesData).MarshalCDP(e)
+	}
+	e.Key("maxEventLevelReports")
+	e.Int(v.MaxEventLevelReports)
+	e.Key("namedBudgets")
+	if v.NamedBudgets == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NamedBudgets {
+			e.Elem()
+			v.NamedBudgets[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("debugReporting")
+	e.Bool(v.DebugReporting)
+	e.Key("eventLevelEpsilon")
+	e.Float(v.EventLevelEpsilon)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingSourceRegistration) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingSourceRegistration from JSON
+func (v *AttributionReportingSourceRegistration) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "time":
+			v.Time = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "expiry":
+			v.Expiry = d.Int()
+		case "triggerData":
+			if d.Array() {
+				v.TriggerData = make([]float64, 0)
+				for d.More() {
+					var x0 float64
+					x0 = d.Float()
+					v.TriggerData = append(v.TriggerData, x0)
+				}
+			} else {
+				v.TriggerData = nil
+			}
+		case "eventReportWindows":
+			v.EventReportWindows.UnmarshalCDP(d)
+		case "aggregatableReportWindow":
+			v.AggregatableReportWindow = d.Int()
+		case "type":
+			v.Type = AttributionReportingSourceType(d.String())
+		case "sourceOrigin":
+			v.SourceOrigin = d.String()
+		case "reportingOrigin":
+			v.ReportingOrigin = d.String()
+		case "destinationSites":
+			if d.Array() {
+				v.DestinationSites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.DestinationSites = append(v.DestinationSites, x0)
+				}
+			} else {
+				v.DestinationSites = nil
+			}
+		case "eventId":
+			v.EventId = UnsignedInt64AsBase10(d.String())
+		case "priority":
+			v.Priority = SignedInt64AsBase10(d.String())
+		case "filterData":
+			if d.Array() {
+				v.FilterData = make([]AttributionReportingFilterDataEntry, 0)
+				for d.More() {
+					var x0 AttributionReportingFilterDataEntry
+					x0.UnmarshalCDP(d)
+					v.FilterData = append(v.FilterData, x0)
+				}
+			} else {
+				v.FilterData = nil
+			}
+		case "aggregationKeys":
+			if d.Array() {
+				v.AggregationKeys = make([]AttributionReportingAggregationKeysEntry, 0)
+				for d.More() {
+					var x0 AttributionReportingAggregationKeysEntry
+					x0.UnmarshalCDP(d)
+					v.AggregationKeys = append(v.AggregationKeys, x0)
+				}
+			} else {
+				v.AggregationKeys = nil
+			}
+		case "debugKey":
+			if d.Null() {
+				v.DebugKey = nil
+			} else {
+				v.DebugKey = new(UnsignedInt64AsBase10)
+				*v.DebugKey = UnsignedInt64AsBase10(d.String())
+			}
+		case "triggerDataMatching":
+			v.TriggerDataMatching = AttributionReportingTriggerDataMatching(d.String())
+		case "destinationLimitPriority":
+			v.DestinationLimitPriority = SignedInt64AsBase10(d.String())
+		case "aggregatableDebugReportingConfig":
+			v.AggregatableDebugReportingConfig.UnmarshalCDP(d)
+		case "scopesData":
+			if d.Null() {
+				v.ScopesData = nil
+			} else {
+				v.ScopesData = new(AttributionScopesData)
+				(*v.ScopesData).UnmarshalCDP(d)
+			}
+		case "maxEventLevelReports":
+			v.MaxEventLevelReports = d.Int()
+		case "namedBudgets":
+			if d.Array() {
+				v.NamedBudgets = make([]AttributionReportingNamedBudgetDef, 0)
+				for d.More() {
+					var x0 AttributionReportingNamedBudgetDef
+					x0.UnmarshalCDP(d)
+					v.NamedBudgets = append(v.NamedBudgets, x0)
+				}
+			} else {
+				v.NamedBudgets = nil
+			}
+		case "debugReporting":
+			v.DebugReporting = d.Bool()
+		case "eventLevelEpsilon":
+			v.EventLevelEpsilon = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingSourceRegistration) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingSourceRegistrationResult string
+
+// AttributionReportingSourceRegistrationResult values
+const (
+	AttributionReportingSourceRegistrationResultSuccess                                AttributionReportingSourceRegistrationResult = "success"
+	AttributionReportingSourceRegistrationResultInternalError                          AttributionReportingSourceRegistrationResult = "internalError"
+	AttributionReportingSourceRegistrationResultInsufficientSourceCapacity             AttributionReportingSourceRegistrationResult = "insufficientSourceCapacity"
+	AttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity  AttributionReportingSourceRegistrationResult = "insufficientUniqueDestinationCapacity"
+	AttributionReportingSourceRegistrationResultExcessiveReportingOrigins              AttributionReportingSourceRegistrationResult = "excessiveReportingOrigins"
+	AttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy              AttributionReportingSourceRegistrationResult = "prohibitedByBrowserPolicy"
+	AttributionReportingSourceRegistrationResultSuccessNoised                          AttributionReportingSourceRegistrationResult = "successNoised"
+	AttributionReportingSourceRegistrationResultDestinationReportingLimitReached       AttributionReportingSourceRegistrationResult = "destinationReportingLimitReached"
+	AttributionReportingSourceRegistrationResultDestinationGlobalLimitReached          AttributionReportingSourceRegistrationResult = "destinationGlobalLimitReached"
+	AttributionReportingSourceRegistrationResultDestinationBothLimitsReached           AttributionReportingSourceRegistrationResult = "destinationBothLimitsReached"
+	AttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached    AttributionReportingSourceRegistrationResult = "reportingOriginsPerSiteLimitReached"
+	AttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity              AttributionReportingSourceRegistrationResult = "exceedsMaxChannelCapacity"
+	AttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity        AttributionReportingSourceRegistrationResult = "exceedsMaxScopesChannelCapacity"
+	AttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality      AttributionReportingSourceRegistrationResult = "exceedsMaxTriggerStateCardinality"
+	AttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit             AttributionReportingSourceRegistrationResult = "exceedsMaxEventStatesLimit"
+	AttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached AttributionReportingSourceRegistrationResult = "destinationPerDayReportingLimitReached"
+)
+
+// Values gives every AttributionReportingSourceRegistrationResult
+func (AttributionReportingSourceRegistrationResult) Values() []AttributionReportingSourceRegistrationResult {
+	return []AttributionReportingSourceRegistrationResult{
+		AttributionReportingSourceRegistrationResultSuccess,
+		AttributionReportingSourceRegistrationResultInternalError,
+		AttributionReportingSourceRegistrationResultInsufficientSourceCapacity,
+		AttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity,
+		AttributionReportingSourceRegistrationResultExcessiveReportingOrigins,
+		AttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy,
+		AttributionReportingSourceRegistrationResultSuccessNoised,
+		AttributionReportingSourceRegistrationResultDestinationReportingLimitReached,
+		AttributionReportingSourceRegistrationResultDestinationGlobalLimitReached,
+		AttributionReportingSourceRegistrationResultDestinationBothLimitsReached,
+		AttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached,
+		AttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity,
+		AttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity,
+		AttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality,
+		AttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit,
+		AttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingSourceRegistrationResult) Valid() bool {
+	switch v {
+	case AttributionReportingSourceRegistrationResultSuccess, AttributionReportingSourceRegistrationResultInternalError, AttributionReportingSourceRegistrationResultInsufficientSourceCapacity, AttributionReportingSourceRegistrationResultInsufficientUniqueDestinationCapacity, AttributionReportingSourceRegistrationResultExcessiveReportingOrigins, AttributionReportingSourceRegistrationResultProhibitedByBrowserPolicy, AttributionReportingSourceRegistrationResultSuccessNoised, AttributionReportingSourceRegistrationResultDestinationReportingLimitReached, AttributionReportingSourceRegistrationResultDestinationGlobalLimitReached, AttributionReportingSourceRegistrationResultDestinationBothLimitsReached, AttributionReportingSourceRegistrationResultReportingOriginsPerSiteLimitReached, AttributionReportingSourceRegistrationResultExceedsMaxChannelCapacity, AttributionReportingSourceRegistrationResultExceedsMaxScopesChannelCapacity, AttributionReportingSourceRegistrationResultExceedsMaxTriggerStateCardinality, AttributionReportingSourceRegistrationResultExceedsMaxEventStatesLimit, AttributionReportingSourceRegistrationResultDestinationPerDayReportingLimitReached:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingSourceRegistrationTimeConfig string
+
+// AttributionReportingSourceRegistrationTimeConfig values
+const (
+	AttributionReportingSourceRegistrationTimeConfigInclude AttributionReportingSourceRegistrationTimeConfig = "include"
+	AttributionReportingSourceRegistrationTimeConfigExclude AttributionReportingSourceRegistrationTimeConfig = "exclude"
+)
+
+// Values gives every AttributionReportingSourceRegistrationTimeConfig
+func (AttributionReportingSourceRegistrationTimeConfig) Values() []AttributionReportingSourceRegistrationTimeConfig {
+	return []AttributionReportingSourceRegistrationTimeConfig{
+		AttributionReportingSourceRegistrationTimeConfigInclude,
+		AttributionReportingSourceRegistrationTimeConfigExclude,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingSourceRegistrationTimeConfig) Valid() bool {
+	switch v {
+	case AttributionReportingSourceRegistrationTimeConfigInclude, AttributionReportingSourceRegistrationTimeConfigExclude:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableValueDictEntry struct {
+	Key string `json:"key"`
+	/* number instead of integer because not all uint32 can be represented by
+	int */
+	Value       float64               `json:"value"`
+	FilteringId UnsignedInt64AsBase10 `json:"filteringId"`
+}
+
+// MarshalCDP writes AttributionReportingAggregatableValueDictEntry as JSON
+func (v *AttributionReportingAggregatableValueDictEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("value")
+	e.Float(v.Value)
+	e.Key("filteringId")
+	e.String(string(v.FilteringId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregatableValueDictEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregatableValueDictEntry from JSON
+func (v *AttributionReportingAggregatableValueDictEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			v.Key = d.String()
+		case "value":
+			v.Value = d.Float()
+		case "filteringId":
+			v.FilteringId = UnsignedInt64AsBase10(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregatableValueDictEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableValueEntry struct {
+	Values  []AttributionReportingAggregatableValueDictEntry `json:"values"`
+	Filters AttributionReportingFilterPair                   `json:"filters"`
+}
+
+// MarshalCDP writes AttributionReportingAggregatableValueEntry as JSON
+func (v *AttributionReportingAggregatableValueEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("values")
+	if v.Values == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Values {
+			e.Elem()
+			v.Values[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("filters")
+	v.Filters.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregatableValueEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregatableValueEntry from JSON
+func (v *AttributionReportingAggregatableValueEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "values":
+			if d.Array() {
+				v.Values = make([]AttributionReportingAggregatableValueDictEntry, 0)
+				for d.More() {
+					var x0 AttributionReportingAggregatableValueDictEntry
+					x0.UnmarshalCDP(d)
+					v.Values = append(v.Values, x0)
+				}
+			} else {
+				v.Values = nil
+			}
+		case "filters":
+			v.Filters.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregatableValueEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingEventTriggerData struct {
+	Data     UnsignedInt64AsBase10          `json:"data"`
+	Priority SignedInt64AsBase10            `json:"priority"`
+	DedupKey *UnsignedInt64AsBase10         `json:"dedupKey,omitempty"`
+	Filters  AttributionReportingFilterPair `json:"filters"`
+}
+
+// MarshalCDP writes AttributionReportingEventTriggerData as JSON
+func (v *AttributionReportingEventTriggerData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("data")
+	e.String(string(v.Data))
+	e.Key("priority")
+	e.String(string(v.Priority))
+	if v.DedupKey != nil {
+		e.Key("dedupKey")
+		e.String(string(*v.DedupKey))
+	}
+	e.Key("filters")
+	v.Filters.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingEventTriggerData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingEventTriggerData from JSON
+func (v *AttributionReportingEventTriggerData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "data":
+			v.Data = UnsignedInt64AsBase10(d.String())
+		case "priority":
+			v.Priority = SignedInt64AsBase10(d.String())
+		case "dedupKey":
+			if d.Null() {
+				v.DedupKey = nil
+			} else {
+				v.DedupKey = new(UnsignedInt64AsBase10)
+				*v.DedupKey = UnsignedInt64AsBase10(d.String())
+			}
+		case "filters":
+			v.Filters.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingEventTriggerData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableTriggerData struct {
+	KeyPiece   UnsignedInt128AsBase16         `json:"keyPiece"`
+	SourceKeys []string                       `json:"sourceKeys"`
+	Filters    AttributionReportingFilterPair `json:"filters"`
+}
+
+// MarshalCDP writes AttributionReportingAggregatableTriggerData as JSON
+func (v *AttributionReportingAggregatableTriggerData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("keyPiece")
+	e.String(string(v.KeyPiece))
+	e.Key("sourceKeys")
+	if v.SourceKeys == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.SourceKeys {
+			e.Elem()
+			e.String(v.SourceKeys[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("filters")
+	v.Filters.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregatableTriggerData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregatableTriggerData from JSON
+func (v *AttributionReportingAggregatableTriggerData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "keyPiece":
+			v.KeyPiece = UnsignedInt128AsBase16(d.String())
+		case "sourceKeys":
+			if d.Array() {
+				v.SourceKeys = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.SourceKeys = append(v.SourceKeys, x0)
+				}
+			} else {
+				v.SourceKeys = nil
+			}
+		case "filters":
+			v.Filters.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregatableTriggerData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableDedupKey struct {
+	DedupKey *UnsignedInt64AsBase10         `json:"dedupKey,omitempty"`
+	Filters  AttributionReportingFilterPair `json:"filters"`
+}
+
+// MarshalCDP writes AttributionReportingAggregatableDedupKey as JSON
+func (v *AttributionReportingAggregatableDedupKey) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.DedupKey != nil {
+		e.Key("dedupKey")
+		e.String(string(*v.DedupKey))
+	}
+	e.Key("filters")
+	v.Filters.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingAggregatableDedupKey) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingAggregatableDedupKey from JSON
+func (v *AttributionReportingAggregatableDedupKey) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "dedupKey":
+			if d.Null() {
+				v.DedupKey = nil
+			} else {
+				v.DedupKey = new(UnsignedInt64AsBase10)
+				*v.DedupKey = UnsignedInt64AsBase10(d.String())
+			}
+		case "filters":
+			v.Filters.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingAggregatableDedupKey) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingNamedBudgetCandidate struct {
+	Name    *string                        `json:"name,omitempty"`
+	Filters AttributionReportingFilterPair `json:"filters"`
+}
+
+// MarshalCDP writes AttributionReportingNamedBudgetCandidate as JSON
+func (v *AttributionReportingNamedBudgetCandidate) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	e.Key("filters")
+	v.Filters.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingNamedBudgetCandidate) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingNamedBudgetCandidate from JSON
+func (v *AttributionReportingNamedBudgetCandidate) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		case "filters":
+			v.Filters.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingNamedBudgetCandidate) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingTriggerRegistration struct {
+	Filters                          AttributionReportingFilterPair                       `json:"filters"`
+	DebugKey                         *UnsignedInt64AsBase10                               `json:"debugKey,omitempty"`
+	AggregatableDedupKeys            []AttributionReportingAggregatableDedupKey           `json:"aggregatableDedupKeys"`
+	EventTriggerData                 []AttributionReportingEventTriggerData               `json:"eventTriggerData"`
+	AggregatableTriggerData          []AttributionReportingAggregatableTriggerData        `json:"aggregatableTriggerData"`
+	AggregatableValues               []AttributionReportingAggregatableValueEntry         `json:"aggregatableValues"`
+	AggregatableFilteringIdMaxBytes  int                                                  `json:"aggregatableFilteringIdMaxBytes"`
+	DebugReporting                   bool                                                 `json:"debugReporting"`
+	AggregationCoordinatorOrigin     *string                                              `json:"aggregationCoordinatorOrigin,omitempty"`
+	SourceRegistrationTimeConfig     AttributionReportingSourceRegistrationTimeConfig     `json:"sourceRegistrationTimeConfig"`
+	TriggerContextId                 *string                                              `json:"triggerContextId,omitempty"`
+	AggregatableDebugReportingConfig AttributionReportingAggregatableDebugReportingConfig `json:"aggregatableDebugReportingConfig"`
+	Scopes                           []string                                             `json:"scopes"`
+	NamedBudgets                     []AttributionReportingNamedBudgetCandidate           `json:"namedBudgets"`
+}
+
+// MarshalCDP writes AttributionReportingTriggerRegistration as JSON
+func (v *AttributionReportingTriggerRegistration) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("filters")
+	v.Filters.MarshalCDP(e)
+	if v.DebugKey != nil {
+		e.Key("debugKey")
+		e.String(string(*v.DebugKey))
+	}
+	e.Key("aggregatableDedupKeys")
+	if v.AggregatableDedupKeys == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AggregatableDedupKeys {
+			e.Elem()
+			v.AggregatableDedupKeys[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("eventTriggerData")
+	if v.EventTriggerData == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.EventTriggerData {
+			e.Elem()
+			v.EventTriggerData[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("aggregatableTriggerData")
+	if v.AggregatableTriggerData == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AggregatableTriggerData {
+			e.Elem()
+			v.AggregatableTriggerData[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("aggregatableValues")
+	if v.AggregatableValues == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AggregatableValues {
+			e.Elem()
+			v.AggregatableValues[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("aggregatableFilteringIdMaxBytes")
+	e.Int(v.AggregatableFilteringIdMaxBytes)
+	e.Key("debugReporting")
+	e.Bool(v.DebugReporting)
+	if v.AggregationCoordinatorOrigin != nil {
+		e.Key("aggregationCoordinatorOrigin")
+		e.String(*v.AggregationCoordinatorOrigin)
+	}
+	e.Key("sourceRegistrationTimeConfig")
+	e.String(string(v.SourceRegistrationTimeConfig))
+	if v.TriggerContextId != nil {
+		e.Key("triggerContextId")
+		e.String(*v.TriggerContextId)
+	}
+	e.Key("aggregatableDebugReportingConfig")
+	v.AggregatableDebugReportingConfig.MarshalCDP(e)
+	e.Key("scopes")
+	if v.Scopes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Scopes {
+			e.Elem()
+			e.String(v.Scopes[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("namedBudgets")
+	if v.NamedBudgets == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.NamedBudgets {
+			e.Elem()
+			v.NamedBudgets[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttributionReportingTriggerRegistration) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AttributionReportingTriggerRegistration from JSON
+func (v *AttributionReportingTriggerRegistration) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "filters":
+			v.Filters.UnmarshalCDP(d)
+		case "debugKey":
+			if d.Null() {
+				v.DebugKey = nil
+			} else {
+				v.DebugKey = new(UnsignedInt64AsBase10)
+				*v.DebugKey = UnsignedInt64AsBase10(d.String())
+			}
+		case "aggregatableDedupKeys":
+			if d.Array() {
+				v.AggregatableDedupKeys = make([]AttributionReportingAggregatableDedupKey, 0)
+				for d.More() {
+					var x0 AttributionReportingAggregatableDedupKey
+					x0.UnmarshalCDP(d)
+					v.AggregatableDedupKeys = append(v.AggregatableDedupKeys, x0)
+				}
+			} else {
+				v.AggregatableDedupKeys = nil
+			}
+		case "eventTriggerData":
+			if d.Array() {
+				v.EventTriggerData = make([]AttributionReportingEventTriggerData, 0)
+				for d.More() {
+					var x0 AttributionReportingEventTriggerData
+					x0.UnmarshalCDP(d)
+					v.EventTriggerData = append(v.EventTriggerData, x0)
+				}
+			} else {
+				v.EventTriggerData = nil
+			}
+		case "aggregatableTriggerData":
+			if d.Array() {
+				v.AggregatableTriggerData = make([]AttributionReportingAggregatableTriggerData, 0)
+				for d.More() {
+					var x0 AttributionReportingAggregatableTriggerData
+					x0.UnmarshalCDP(d)
+					v.AggregatableTriggerData = append(v.AggregatableTriggerData, x0)
+				}
+			} else {
+				v.AggregatableTriggerData = nil
+			}
+		case "aggregatableValues":
+			if d.Array() {
+				v.AggregatableValues = make([]AttributionReportingAggregatableValueEntry, 0)
+				for d.More() {
+					var x0 AttributionReportingAggregatableValueEntry
+					x0.UnmarshalCDP(d)
+					v.AggregatableValues = append(v.AggregatableValues, x0)
+				}
+			} else {
+				v.AggregatableValues = nil
+			}
+		case "aggregatableFilteringIdMaxBytes":
+			v.AggregatableFilteringIdMaxBytes = d.Int()
+		case "debugReporting":
+			v.DebugReporting = d.Bool()
+		case "aggregationCoordinatorOrigin":
+			if d.Null() {
+				v.AggregationCoordinatorOrigin = nil
+			} else {
+				v.AggregationCoordinatorOrigin = new(string)
+				*v.AggregationCoordinatorOrigin = d.String()
+			}
+		case "sourceRegistrationTimeConfig":
+			v.SourceRegistrationTimeConfig = AttributionReportingSourceRegistrationTimeConfig(d.String())
+		case "triggerContextId":
+			if d.Null() {
+				v.TriggerContextId = nil
+			} else {
+				v.TriggerContextId = new(string)
+				*v.TriggerContextId = d.String()
+			}
+		case "aggregatableDebugReportingConfig":
+			v.AggregatableDebugReportingConfig.UnmarshalCDP(d)
+		case "scopes":
+			if d.Array() {
+				v.Scopes = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Scopes = append(v.Scopes, x0)
+				}
+			} else {
+				v.Scopes = nil
+			}
+		case "namedBudgets":
+			if d.Array() {
+				v.NamedBudgets = make([]AttributionReportingNamedBudgetCandidate, 0)
+				for d.More() {
+					var x0 AttributionReportingNamedBudgetCandidate
+					x0.UnmarshalCDP(d)
+					v.NamedBudgets = append(v.NamedBudgets, x0)
+				}
+			} else {
+				v.NamedBudgets = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingTriggerRegistration) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingEventLevelResult string
+
+// AttributionReportingEventLevelResult values
+const (
+	AttributionReportingEventLevelResultSuccess                             AttributionReportingEventLevelResult = "success"
+	AttributionReportingEventLevelResultSuccessDroppedLowerPriority         AttributionReportingEventLevelResult = "successDroppedLowerPriority"
+	AttributionReportingEventLevelResultInternalError                       AttributionReportingEventLevelResult = "internalError"
+	AttributionReportingEventLevelResultNoCapacityForAttributionDestination AttributionReportingEventLevelResult = "noCapacityForAttributionDestination"
+	AttributionReportingEventLevelResultNoMatchingSources                   AttributionReportingEventLevelResult = "noMatchingSources"
+	AttributionReportingEventLevelResultDeduplicated                        AttributionReportingEventLevelResult = "deduplicated"
+	AttributionReportingEventLevelResultExcessiveAttributions               AttributionReportingEventLevelResult = "excessiveAttributions"
+	AttributionReportingEventLevelResultPriorityTooLow                      AttributionReportingEventLevelResult = "priorityTooLow"
+	AttributionReportingEventLevelResultNeverAttributedSource               AttributionReportingEventLevelResult = "neverAttributedSource"
+	AttributionReportingEventLevelResultExcessiveReportingOrigins           AttributionReportingEventLevelResult = "excessiveReportingOrigins"
+	AttributionReportingEventLevelResultNoMatchingSourceFilterData          AttributionReportingEventLevelResult = "noMatchingSourceFilterData"
+	AttributionReportingEventLevelResultProhibitedByBrowserPolicy           AttributionReportingEventLevelResult = "prohibitedByBrowserPolicy"
+	AttributionReportingEventLevelResultNoMatchingConfigurations            AttributionReportingEventLevelResult = "noMatchingConfigurations"
+	AttributionReportingEventLevelResultExcessiveReports                    AttributionReportingEventLevelResult = "excessiveReports"
+	AttributionReportingEventLevelResultFalselyAttributedSource             AttributionReportingEventLevelResult = "falselyAttributedSource"
+	AttributionReportingEventLevelResultReportWindowPassed                  AttributionReportingEventLevelResult = "reportWindowPassed"
+	AttributionReportingEventLevelResultNotRegistered                       AttributionReportingEventLevelResult = "notRegistered"
+	AttributionReportingEventLevelResultReportWindowNotStarted              AttributionReportingEventLevelResult = "reportWindowNotStarted"
+	AttributionReportingEventLevelResultNoMatchingTriggerData               AttributionReportingEventLevelResult = "noMatchingTriggerData"
+)
+
+// Values gives every AttributionReportingEventLevelResult
+func (AttributionReportingEventLevelResult) Values() []AttributionReportingEventLevelResult {
+	return []AttributionReportingEventLevelResult{
+		AttributionReportingEventLevelResultSuccess,
+		AttributionReportingEventLevelResultSuccessDroppedLowerPriority,
+		AttributionReportingEventLevelResultInternalError,
+		AttributionReportingEventLevelResultNoCapacityForAttributionDestination,
+		AttributionReportingEventLevelResultNoMatchingSources,
+		AttributionReportingEventLevelResultDeduplicated,
+		AttributionReportingEventLevelResultExcessiveAttributions,
+		AttributionReportingEventLevelResultPriorityTooLow,
+		AttributionReportingEventLevelResultNeverAttributedSource,
+		AttributionReportingEventLevelResultExcessiveReportingOrigins,
+		AttributionReportingEventLevelResultNoMatchingSourceFilterData,
+		AttributionReportingEventLevelResultProhibitedByBrowserPolicy,
+		AttributionReportingEventLevelResultNoMatchingConfigurations,
+		AttributionReportingEventLevelResultExcessiveReports,
+		AttributionReportingEventLevelResultFalselyAttributedSource,
+		AttributionReportingEventLevelResultReportWindowPassed,
+		AttributionReportingEventLevelResultNotRegistered,
+		AttributionReportingEventLevelResultReportWindowNotStarted,
+		AttributionReportingEventLevelResultNoMatchingTriggerData,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingEventLevelResult) Valid() bool {
+	switch v {
+	case AttributionReportingEventLevelResultSuccess, AttributionReportingEventLevelResultSuccessDroppedLowerPriority, AttributionReportingEventLevelResultInternalError, AttributionReportingEventLevelResultNoCapacityForAttributionDestination, AttributionReportingEventLevelResultNoMatchingSources, AttributionReportingEventLevelResultDeduplicated, AttributionReportingEventLevelResultExcessiveAttributions, AttributionReportingEventLevelResultPriorityTooLow, AttributionReportingEventLevelResultNeverAttributedSource, AttributionReportingEventLevelResultExcessiveReportingOrigins, AttributionReportingEventLevelResultNoMatchingSourceFilterData, AttributionReportingEventLevelResultProhibitedByBrowserPolicy, AttributionReportingEventLevelResultNoMatchingConfigurations, AttributionReportingEventLevelResultExcessiveReports, AttributionReportingEventLevelResultFalselyAttributedSource, AttributionReportingEventLevelResultReportWindowPassed, AttributionReportingEventLevelResultNotRegistered, AttributionReportingEventLevelResultReportWindowNotStarted, AttributionReportingEventLevelResultNoMatchingTriggerData:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingAggregatableResult string
+
+// AttributionReportingAggregatableResult values
+const (
+	AttributionReportingAggregatableResultSuccess                             AttributionReportingAggregatableResult = "success"
+	AttributionReportingAggregatableResultInternalError                       AttributionReportingAggregatableResult = "internalError"
+	AttributionReportingAggregatableResultNoCapacityForAttributionDestination AttributionReportingAggregatableResult = "noCapacityForAttributionDestination"
+	AttributionReportingAggregatableResultNoMatchingSources                   AttributionReportingAggregatableResult = "noMatchingSources"
+	AttributionReportingAggregatableResultExcessiveAttributions               AttributionReportingAggregatableResult = "excessiveAttributions"
+	AttributionReportingAggregatableResultExcessiveReportingOrigins           AttributionReportingAggregatableResult = "excessiveReportingOrigins"
+	AttributionReportingAggregatableResultNoHistograms                        AttributionReportingAggregatableResult = "noHistograms"
+	AttributionReportingAggregatableResultInsufficientBudget                  AttributionReportingAggregatableResult = "insufficientBudget"
+	AttributionReportingAggregatableResultInsufficientNamedBudget             AttributionReportingAggregatableResult = "insufficientNamedBudget"
+	AttributionReportingAggregatableResultNoMatchingSourceFilterData          AttributionReportingAggregatableResult = "noMatchingSourceFilterData"
+	AttributionReportingAggregatableResultNotRegistered                       AttributionReportingAggregatableResult = "notRegistered"
+	AttributionReportingAggregatableResultProhibitedByBrowserPolicy           AttributionReportingAggregatableResult = "prohibitedByBrowserPolicy"
+	AttributionReportingAggregatableResultDeduplicated                        AttributionReportingAggregatableResult = "deduplicated"
+	AttributionReportingAggregatableResultReportWindowPassed                  AttributionReportingAggregatableResult = "reportWindowPassed"
+	AttributionReportingAggregatableResultExcessiveReports                    AttributionReportingAggregatableResult = "excessiveReports"
+)
+
+// Values gives every AttributionReportingAggregatableResult
+func (AttributionReportingAggregatableResult) Values() []AttributionReportingAggregatableResult {
+	return []AttributionReportingAggregatableResult{
+		AttributionReportingAggregatableResultSuccess,
+		AttributionReportingAggregatableResultInternalError,
+		AttributionReportingAggregatableResultNoCapacityForAttributionDestination,
+		AttributionReportingAggregatableResultNoMatchingSources,
+		AttributionReportingAggregatableResultExcessiveAttributions,
+		AttributionReportingAggregatableResultExcessiveReportingOrigins,
+		AttributionReportingAggregatableResultNoHistograms,
+		AttributionReportingAggregatableResultInsufficientBudget,
+		AttributionReportingAggregatableResultInsufficientNamedBudget,
+		AttributionReportingAggregatableResultNoMatchingSourceFilterData,
+		AttributionReportingAggregatableResultNotRegistered,
+		AttributionReportingAggregatableResultProhibitedByBrowserPolicy,
+		AttributionReportingAggregatableResultDeduplicated,
+		AttributionReportingAggregatableResultReportWindowPassed,
+		AttributionReportingAggregatableResultExcessiveReports,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingAggregatableResult) Valid() bool {
+	switch v {
+	case AttributionReportingAggregatableResultSuccess, AttributionReportingAggregatableResultInternalError, AttributionReportingAggregatableResultNoCapacityForAttributionDestination, AttributionReportingAggregatableResultNoMatchingSources, AttributionReportingAggregatableResultExcessiveAttributions, AttributionReportingAggregatableResultExcessiveReportingOrigins, AttributionReportingAggregatableResultNoHistograms, AttributionReportingAggregatableResultInsufficientBudget, AttributionReportingAggregatableResultInsufficientNamedBudget, AttributionReportingAggregatableResultNoMatchingSourceFilterData, AttributionReportingAggregatableResultNotRegistered, AttributionReportingAggregatableResultProhibitedByBrowserPolicy, AttributionReportingAggregatableResultDeduplicated, AttributionReportingAggregatableResultReportWindowPassed, AttributionReportingAggregatableResultExcessiveReports:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingReportResult string
+
+// AttributionReportingReportResult values
+const (
+	AttributionReportingReportResultSent             AttributionReportingReportResult = "sent"
+	AttributionReportingReportResultProhibited       AttributionReportingReportResult = "prohibited"
+	AttributionReportingReportResultFailedToAssemble AttributionReportingReportResult = "failedToAssemble"
+	AttributionReportingReportResultExpired          AttributionReportingReportResult = "expired"
+)
+
+// Values gives every AttributionReportingReportResult
+func (AttributionReportingReportResult) Values() []AttributionReportingReportResult {
+	return []AttributionReportingReportResult{
+		AttributionReportingReportResultSent,
+		AttributionReportingReportResultProhibited,
+		AttributionReportingReportResultFailedToAssemble,
+		AttributionReportingReportResultExpired,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AttributionReportingReportResult) Valid() bool {
+	switch v {
+	case AttributionReportingReportResultSent, AttributionReportingReportResultProhibited, AttributionReportingReportResultFailedToAssemble, AttributionReportingReportResultExpired:
+		return true
+	}
+	return false
+}
+
+// A single Related Website Set object.
+//
+// Experimental: this may change or be removed in any chrome release
+type RelatedWebsiteSet struct {
+	/* The primary site of this set, along with the ccTLDs if there is any. */
+	PrimarySites []string `json:"primarySites"`
+	/* The associated sites of this set, along with the ccTLDs if there is any. */
+	AssociatedSites []string `json:"associatedSites"`
+	/* The service sites of this set, along with the ccTLDs if there is any. */
+	ServiceSites []string `json:"serviceSites"`
+}
+
+// MarshalCDP writes RelatedWebsiteSet as JSON
+func (v *RelatedWebsiteSet) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("primarySites")
+	if v.PrimarySites == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.PrimarySites {
+			e.Elem()
+			e.String(v.PrimarySites[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("associatedSites")
+	if v.AssociatedSites == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.AssociatedSites {
+			e.Elem()
+			e.String(v.AssociatedSites[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("serviceSites")
+	if v.ServiceSites == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ServiceSites {
+			e.Elem()
+			e.String(v.ServiceSites[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RelatedWebsiteSet) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RelatedWebsiteSet from JSON
+func (v *RelatedWebsiteSet) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "primarySites":
+			if d.Array() {
+				v.PrimarySites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.PrimarySites = append(v.PrimarySites, x0)
+				}
+			} else {
+				v.PrimarySites = nil
+			}
+		case "associatedSites":
+			if d.Array() {
+				v.AssociatedSites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.AssociatedSites = append(v.AssociatedSites, x0)
+				}
+			} else {
+				v.AssociatedSites = nil
+			}
+		case "serviceSites":
+			if d.Array() {
+				v.ServiceSites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.ServiceSites = append(v.ServiceSites, x0)
+				}
+			} else {
+				v.ServiceSites = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RelatedWebsiteSet) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GetStorageKeyForFrameReturns struct {
+	StorageKey SerializedStorageKey `json:"storageKey"`
+}
+
+// UnmarshalCDP reads GetStorageKeyForFrameReturns from JSON
+func (v *GetStorageKeyForFrameReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageKey":
+			v.StorageKey = SerializedStorageKey(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetStorageKeyForFrameReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetStorageKeyForFrameParams are the parameters for Storage.getStorageKeyForFrame
+// optional parameters are left out when nil
+type GetStorageKeyForFrameParams struct {
+	FrameId cdp.PageFrameId `json:"frameId"`
+}
+
+// MarshalCDP writes GetStorageKeyForFrameParams as JSON
+func (v *GetStorageKeyForFrameParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("frameId")
+	e.String(string(v.FrameId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetStorageKeyForFrameParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a storage key given a frame id.
+func (c Client) GetStorageKeyForFrame(params GetStorageKeyForFrameParams) (GetStorageKeyForFrameReturns, error) {
+	return c.GetStorageKeyForFrameContext(context.Background(), params)
+}
+
+// GetStorageKeyForFrameContext is GetStorageKeyForFrame with a context for cancellation and deadlines
+func (c Client) GetStorageKeyForFrameContext(ctx context.Context, params GetStorageKeyForFrameParams) (GetStorageKeyForFrameReturns, error) {
+	var returns_ GetStorageKeyForFrameReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getStorageKeyForFrame", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDataForOriginReturns struct {
+}
+
+// UnmarshalCDP reads ClearDataForOriginReturns from JSON
+func (v *ClearDataForOriginReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDataForOriginReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearDataForOriginParams are the parameters for Storage.clearDataForOrigin
+// optional parameters are left out when nil
+type ClearDataForOriginParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+	/* Comma separated list of StorageType to clear. */
+	StorageTypes string `json:"storageTypes"`
+}
+
+// MarshalCDP writes ClearDataForOriginParams as JSON
+func (v *ClearDataForOriginParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.Key("storageTypes")
+	e.String(v.StorageTypes)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearDataForOriginParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears storage for origin.
+func (c Client) ClearDataForOrigin(params ClearDataForOriginParams) (ClearDataForOriginReturns, error) {
+	return c.ClearDataForOriginContext(context.Background(), params)
+}
+
+// ClearDataForOriginContext is ClearDataForOrigin with a context for cancellation and deadlines
+func (c Client) ClearDataForOriginContext(ctx context.Context, params ClearDataForOriginParams) (ClearDataForOriginReturns, error) {
+	var returns_ ClearDataForOriginReturns
+
+	err_ := c.caller.Call(ctx, "Storage.clearDataForOrigin", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearDataForStorageKeyReturns struct {
+}
+
+// UnmarshalCDP reads ClearDataForStorageKeyReturns from JSON
+func (v *ClearDataForStorageKeyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearDataForStorageKeyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearDataForStorageKeyParams are the parameters for Storage.clearDataForStorageKey
+// optional parameters are left out when nil
+type ClearDataForStorageKeyParams struct {
+	/* Storage key. */
+	StorageKey string `json:"storageKey"`
+	/* Comma separated list of StorageType to clear. */
+	StorageTypes string `json:"storageTypes"`
+}
+
+// MarshalCDP writes ClearDataForStorageKeyParams as JSON
+func (v *ClearDataForStorageKeyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.Key("storageTypes")
+	e.String(v.StorageTypes)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearDataForStorageKeyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears storage for storage key.
+func (c Client) ClearDataForStorageKey(params ClearDataForStorageKeyParams) (ClearDataForStorageKeyReturns, error) {
+	return c.ClearDataForStorageKeyContext(context.Background(), params)
+}
+
+// ClearDataForStorageKeyContext is ClearDataForStorageKey with a context for cancellation and deadlines
+func (c Client) ClearDataForStorageKeyContext(ctx context.Context, params ClearDataForStorageKeyParams) (ClearDataForStorageKeyReturns, error) {
+	var returns_ ClearDataForStorageKeyReturns
+
+	err_ := c.caller.Call(ctx, "Storage.clearDataForStorageKey", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetCookiesReturns struct {
+
+	/* Array of cookie objects. */
+	Cookies []cdp.NetworkCookie `json:"cookies"`
+}
+
+// UnmarshalCDP reads GetCookiesReturns from JSON
+func (v *GetCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "cookies":
+			if d.Array() {
+				v.Cookies = make([]cdp.NetworkCookie, 0)
+				for d.More() {
+					var x0 cdp.NetworkCookie
+					x0.UnmarshalCDP(d)
+					v.Cookies = append(v.Cookies, x0)
+				}
+			} else {
+				v.Cookies = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetCookiesParams are the parameters for Storage.getCookies
+// optional parameters are left out when nil
+type GetCookiesParams struct {
+	/* Browser context to use when called on the browser endpoint. */
+	BrowserContextId *cdp.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes GetCookiesParams as JSON
+func (v *GetCookiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetCookiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns all browser cookies.
+func (c Client) GetCookies(params GetCookiesParams) (GetCookiesReturns, error) {
+	return c.GetCookiesContext(context.Background(), params)
+}
+
+// GetCookiesContext is GetCookies with a context for cancellation and deadlines
+func (c Client) GetCookiesContext(ctx context.Context, params GetCookiesParams) (GetCookiesReturns, error) {
+	var returns_ GetCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getCookies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetCookiesReturns struct {
+}
+
+// UnmarshalCDP reads SetCookiesReturns from JSON
+func (v *SetCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCookiesParams are the parameters for Storage.setCookies
+// optional parameters are left out when nil
+type SetCookiesParams struct {
+	/* Cookies to be set. */
+	Cookies []cdp.NetworkCookieParam `json:"cookies"`
+	/* Browser context to use when called on the browser endpoint. */
+	BrowserContextId *cdp.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes SetCookiesParams as JSON
+func (v *SetCookiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("cookies")
+	if v.Cookies == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Cookies {
+			e.Elem()
+			v.Cookies[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCookiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets given cookies.
+func (c Client) SetCookies(params SetCookiesParams) (SetCookiesReturns, error) {
+	return c.SetCookiesContext(context.Background(), params)
+}
+
+// SetCookiesContext is SetCookies with a context for cancellation and deadlines
+func (c Client) SetCookiesContext(ctx context.Context, params SetCookiesParams) (SetCookiesReturns, error) {
+	var returns_ SetCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setCookies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearCookiesReturns struct {
+}
+
+// UnmarshalCDP reads ClearCookiesReturns from JSON
+func (v *ClearCookiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearCookiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearCookiesParams are the parameters for Storage.clearCookies
+// optional parameters are left out when nil
+type ClearCookiesParams struct {
+	/* Browser context to use when called on the browser endpoint. */
+	BrowserContextId *cdp.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+}
+
+// MarshalCDP writes ClearCookiesParams as JSON
+func (v *ClearCookiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearCookiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears cookies.
+func (c Client) ClearCookies(params ClearCookiesParams) (ClearCookiesReturns, error) {
+	return c.ClearCookiesContext(context.Background(), params)
+}
+
+// ClearCookiesContext is ClearCookies with a context for cancellation and deadlines
+func (c Client) ClearCookiesContext(ctx context.Context, params ClearCookiesParams) (ClearCookiesReturns, error) {
+	var returns_ ClearCookiesReturns
+
+	err_ := c.caller.Call(ctx, "Storage.clearCookies", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetUsageAndQuotaReturns struct {
+
+	/* Storage usage (bytes). */
+	Usage float64 `json:"usage"`
+
+	/* Storage quota (bytes). */
+	Quota float64 `json:"quota"`
+
+	/* Whether or not the origin has an active storage quota override */
+	OverrideActive bool `json:"overrideActive"`
+
+	/* Storage usage per type (bytes). */
+	UsageBreakdown []UsageForType `json:"usageBreakdown"`
+}
+
+// UnmarshalCDP reads GetUsageAndQuotaReturns from JSON
+func (v *GetUsageAndQuotaReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "usage":
+			v.Usage = d.Float()
+		case "quota":
+			v.Quota = d.Float()
+		case "overrideActive":
+			v.OverrideActive = d.Bool()
+		case "usageBreakdown":
+			if d.Array() {
+				v.UsageBreakdown = make([]UsageForType, 0)
+				for d.More() {
+					var x0 UsageForType
+					x0.UnmarshalCDP(d)
+					v.UsageBreakdown = append(v.UsageBreakdown, x0)
+				}
+			} else {
+				v.UsageBreakdown = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetUsageAndQuotaReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetUsageAndQuotaParams are the parameters for Storage.getUsageAndQuota
+// optional parameters are left out when nil
+type GetUsageAndQuotaParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+}
+
+// MarshalCDP writes GetUsageAndQuotaParams as JSON
+func (v *GetUsageAndQuotaParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetUsageAndQuotaParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns usage and quota in bytes.
+func (c Client) GetUsageAndQuota(params GetUsageAndQuotaParams) (GetUsageAndQuotaReturns, error) {
+	return c.GetUsageAndQuotaContext(context.Background(), params)
+}
+
+// GetUsageAndQuotaContext is GetUsageAndQuota with a context for cancellation and deadlines
+func (c Client) GetUsageAndQuotaContext(ctx context.Context, params GetUsageAndQuotaParams) (GetUsageAndQuotaReturns, error) {
+	var returns_ GetUsageAndQuotaReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getUsageAndQuota", &params, &returns_)
+
+	return returns_, err_
+}
+
+type OverrideQuotaForOriginReturns struct {
+}
+
+// UnmarshalCDP reads OverrideQuotaForOriginReturns from JSON
+func (v *OverrideQuotaForOriginReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OverrideQuotaForOriginReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// OverrideQuotaForOriginParams are the parameters for Storage.overrideQuotaForOrigin
+// optional parameters are left out when nil
+type OverrideQuotaForOriginParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+	/* The quota size (in bytes) to override the original quota with.
+	If this is called multiple times, the overridden quota will be equal to
+	the quotaSize provided in the final call. If this is called without
+	specifying a quotaSize, the quota will be reset to the default value for
+	the specified origin. If this is called multiple times with different
+	origins, the override will be maintained for each origin until it is
+	disabled (called without a quotaSize). */
+	QuotaSize *float64 `json:"quotaSize,omitempty"`
+}
+
+// MarshalCDP writes OverrideQuotaForOriginParams as JSON
+func (v *OverrideQuotaForOriginParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	if v.QuotaSize != nil {
+		e.Key("quotaSize")
+		e.Float(*v.QuotaSize)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OverrideQuotaForOriginParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Override quota for the specified origin
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) OverrideQuotaForOrigin(params OverrideQuotaForOriginParams) (OverrideQuotaForOriginReturns, error) {
+	return c.OverrideQuotaForOriginContext(context.Background(), params)
+}
+
+// OverrideQuotaForOriginContext is OverrideQuotaForOrigin with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) OverrideQuotaForOriginContext(ctx context.Context, params OverrideQuotaForOriginParams) (OverrideQuotaForOriginReturns, error) {
+	var returns_ OverrideQuotaForOriginReturns
+
+	err_ := c.caller.Call(ctx, "Storage.overrideQuotaForOrigin", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TrackCacheStorageForOriginReturns struct {
+}
+
+// UnmarshalCDP reads TrackCacheStorageForOriginReturns from JSON
+func (v *TrackCacheStorageForOriginReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrackCacheStorageForOriginReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TrackCacheStorageForOriginParams are the parameters for Storage.trackCacheStorageForOrigin
+// optional parameters are left out when nil
+type TrackCacheStorageForOriginParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+}
+
+// MarshalCDP writes TrackCacheStorageForOriginParams as JSON
+func (v *TrackCacheStorageForOriginParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrackCacheStorageForOriginParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Registers origin to be notified when an update occurs to its cache storage list.
+func (c Client) TrackCacheStorageForOrigin(params TrackCacheStorageForOriginParams) (TrackCacheStorageForOriginReturns, error) {
+	return c.TrackCacheStorageForOriginContext(context.Background(), params)
+}
+
+// TrackCacheStorageForOriginContext is TrackCacheStorageForOrigin with a context for cancellation and deadlines
+func (c Client) TrackCacheStorageForOriginContext(ctx context.Context, params TrackCacheStorageForOriginParams) (TrackCacheStorageForOriginReturns, error) {
+	var returns_ TrackCacheStorageForOriginReturns
+
+	err_ := c.caller.Call(ctx, "Storage.trackCacheStorageForOrigin", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TrackCacheStorageForStorageKeyReturns struct {
+}
+
+// UnmarshalCDP reads TrackCacheStorageForStorageKeyReturns from JSON
+func (v *TrackCacheStorageForStorageKeyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrackCacheStorageForStorageKeyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TrackCacheStorageForStorageKeyParams are the parameters for Storage.trackCacheStorageForStorageKey
+// optional parameters are left out when nil
+type TrackCacheStorageForStorageKeyParams struct {
+	/* Storage key. */
+	StorageKey string `json:"storageKey"`
+}
+
+// MarshalCDP writes TrackCacheStorageForStorageKeyParams as JSON
+func (v *TrackCacheStorageForStorageKeyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrackCacheStorageForStorageKeyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Registers storage key to be notified when an update occurs to its cache storage list.
+func (c Client) TrackCacheStorageForStorageKey(params TrackCacheStorageForStorageKeyParams) (TrackCacheStorageForStorageKeyReturns, error) {
+	return c.TrackCacheStorageForStorageKeyContext(context.Background(), params)
+}
+
+// TrackCacheStorageForStorageKeyContext is TrackCacheStorageForStorageKey with a context for cancellation and deadlines
+func (c Client) TrackCacheStorageForStorageKeyContext(ctx context.Context, params TrackCacheStorageForStorageKeyParams) (TrackCacheStorageForStorageKeyReturns, error) {
+	var returns_ TrackCacheStorageForStorageKeyReturns
+
+	err_ := c.caller.Call(ctx, "Storage.trackCacheStorageForStorageKey", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TrackIndexedDBForOriginReturns struct {
+}
+
+// UnmarshalCDP reads TrackIndexedDBForOriginReturns from JSON
+func (v *TrackIndexedDBForOriginReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrackIndexedDBForOriginReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TrackIndexedDBForOriginParams are the parameters for Storage.trackIndexedDBForOrigin
+// optional parameters are left out when nil
+type TrackIndexedDBForOriginParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+}
+
+// MarshalCDP writes TrackIndexedDBForOriginParams as JSON
+func (v *TrackIndexedDBForOriginParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrackIndexedDBForOriginParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Registers origin to be notified when an update occurs to its IndexedDB.
+func (c Client) TrackIndexedDBForOrigin(params TrackIndexedDBForOriginParams) (TrackIndexedDBForOriginReturns, error) {
+	return c.TrackIndexedDBForOriginContext(context.Background(), params)
+}
+
+// TrackIndexedDBForOriginContext is TrackIndexedDBForOrigin with a context for cancellation and deadlines
+func (c Client) TrackIndexedDBForOriginContext(ctx context.Context, params TrackIndexedDBForOriginParams) (TrackIndexedDBForOriginReturns, error) {
+	var returns_ TrackIndexedDBForOriginReturns
+
+	err_ := c.caller.Call(ctx, "Storage.trackIndexedDBForOrigin", &params, &returns_)
+
+	return returns_, err_
+}
+
+type TrackIndexedDBForStorageKeyReturns struct {
+}
+
+// UnmarshalCDP reads TrackIndexedDBForStorageKeyReturns from JSON
+func (v *TrackIndexedDBForStorageKeyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TrackIndexedDBForStorageKeyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// TrackIndexedDBForStorageKeyParams are the parameters for Storage.trackIndexedDBForStorageKey
+// optional parameters are left out when nil
+type TrackIndexedDBForStorageKeyParams struct {
+	/* Storage key. */
+	StorageKey string `json:"storageKey"`
+}
+
+// MarshalCDP writes TrackIndexedDBForStorageKeyParams as JSON
+func (v *TrackIndexedDBForStorageKeyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TrackIndexedDBForStorageKeyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Registers storage key to be notified when an update occurs to its IndexedDB.
+func (c Client) TrackIndexedDBForStorageKey(params TrackIndexedDBForStorageKeyParams) (TrackIndexedDBForStorageKeyReturns, error) {
+	return c.TrackIndexedDBForStorageKeyContext(context.Background(), params)
+}
+
+// TrackIndexedDBForStorageKeyContext is TrackIndexedDBForStorageKey with a context for cancellation and deadlines
+func (c Client) TrackIndexedDBForStorageKeyContext(ctx context.Context, params TrackIndexedDBForStorageKeyParams) (TrackIndexedDBForStorageKeyReturns, error) {
+	var returns_ TrackIndexedDBForStorageKeyReturns
+
+	err_ := c.caller.Call(ctx, "Storage.trackIndexedDBForStorageKey", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UntrackCacheStorageForOriginReturns struct {
+}
+
+// UnmarshalCDP reads UntrackCacheStorageForOriginReturns from JSON
+func (v *UntrackCacheStorageForOriginReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UntrackCacheStorageForOriginReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UntrackCacheStorageForOriginParams are the parameters for Storage.untrackCacheStorageForOrigin
+// optional parameters are left out when nil
+type UntrackCacheStorageForOriginParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+}
+
+// MarshalCDP writes UntrackCacheStorageForOriginParams as JSON
+func (v *UntrackCacheStorageForOriginParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UntrackCacheStorageForOriginParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Unregisters origin from receiving notifications for cache storage.
+func (c Client) UntrackCacheStorageForOrigin(params UntrackCacheStorageForOriginParams) (UntrackCacheStorageForOriginReturns, error) {
+	return c.UntrackCacheStorageForOriginContext(context.Background(), params)
+}
+
+// UntrackCacheStorageForOriginContext is UntrackCacheStorageForOrigin with a context for cancellation and deadlines
+func (c Client) UntrackCacheStorageForOriginContext(ctx context.Context, params UntrackCacheStorageForOriginParams) (UntrackCacheStorageForOriginReturns, error) {
+	var returns_ UntrackCacheStorageForOriginReturns
+
+	err_ := c.caller.Call(ctx, "Storage.untrackCacheStorageForOrigin", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UntrackCacheStorageForStorageKeyReturns struct {
+}
+
+// UnmarshalCDP reads UntrackCacheStorageForStorageKeyReturns from JSON
+func (v *UntrackCacheStorageForStorageKeyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UntrackCacheStorageForStorageKeyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UntrackCacheStorageForStorageKeyParams are the parameters for Storage.untrackCacheStorageForStorageKey
+// optional parameters are left out when nil
+type UntrackCacheStorageForStorageKeyParams struct {
+	/* Storage key. */
+	StorageKey string `json:"storageKey"`
+}
+
+// MarshalCDP writes UntrackCacheStorageForStorageKeyParams as JSON
+func (v *UntrackCacheStorageForStorageKeyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UntrackCacheStorageForStorageKeyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Unregisters storage key from receiving notifications for cache storage.
+func (c Client) UntrackCacheStorageForStorageKey(params UntrackCacheStorageForStorageKeyParams) (UntrackCacheStorageForStorageKeyReturns, error) {
+	return c.UntrackCacheStorageForStorageKeyContext(context.Background(), params)
+}
+
+// UntrackCacheStorageForStorageKeyContext is UntrackCacheStorageForStorageKey with a context for cancellation and deadlines
+func (c Client) UntrackCacheStorageForStorageKeyContext(ctx context.Context, params UntrackCacheStorageForStorageKeyParams) (UntrackCacheStorageForStorageKeyReturns, error) {
+	var returns_ UntrackCacheStorageForStorageKeyReturns
+
+	err_ := c.caller.Call(ctx, "Storage.untrackCacheStorageForStorageKey", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UntrackIndexedDBForOriginReturns struct {
+}
+
+// UnmarshalCDP reads UntrackIndexedDBForOriginReturns from JSON
+func (v *UntrackIndexedDBForOriginReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UntrackIndexedDBForOriginReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UntrackIndexedDBForOriginParams are the parameters for Storage.untrackIndexedDBForOrigin
+// optional parameters are left out when nil
+type UntrackIndexedDBForOriginParams struct {
+	/* Security origin. */
+	Origin string `json:"origin"`
+}
+
+// MarshalCDP writes UntrackIndexedDBForOriginParams as JSON
+func (v *UntrackIndexedDBForOriginParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("origin")
+	e.String(v.Origin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UntrackIndexedDBForOriginParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Unregisters origin from receiving notifications for IndexedDB.
+func (c Client) UntrackIndexedDBForOrigin(params UntrackIndexedDBForOriginParams) (UntrackIndexedDBForOriginReturns, error) {
+	return c.UntrackIndexedDBForOriginContext(context.Background(), params)
+}
+
+// UntrackIndexedDBForOriginContext is UntrackIndexedDBForOrigin with a context for cancellation and deadlines
+func (c Client) UntrackIndexedDBForOriginContext(ctx context.Context, params UntrackIndexedDBForOriginParams) (UntrackIndexedDBForOriginReturns, error) {
+	var returns_ UntrackIndexedDBForOriginReturns
+
+	err_ := c.caller.Call(ctx, "Storage.untrackIndexedDBForOrigin", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UntrackIndexedDBForStorageKeyReturns struct {
+}
+
+// UnmarshalCDP reads UntrackIndexedDBForStorageKeyReturns from JSON
+func (v *UntrackIndexedDBForStorageKeyReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UntrackIndexedDBForStorageKeyReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UntrackIndexedDBForStorageKeyParams are the parameters for Storage.untrackIndexedDBForStorageKey
+// optional parameters are left out when nil
+type UntrackIndexedDBForStorageKeyParams struct {
+	/* Storage key. */
+	StorageKey string `json:"storageKey"`
+}
+
+// MarshalCDP writes UntrackIndexedDBForStorageKeyParams as JSON
+func (v *UntrackIndexedDBForStorageKeyParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UntrackIndexedDBForStorageKeyParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Unregisters storage key from receiving notifications for IndexedDB.
+func (c Client) UntrackIndexedDBForStorageKey(params UntrackIndexedDBForStorageKeyParams) (UntrackIndexedDBForStorageKeyReturns, error) {
+	return c.UntrackIndexedDBForStorageKeyContext(context.Background(), params)
+}
+
+// UntrackIndexedDBForStorageKeyContext is UntrackIndexedDBForStorageKey with a context for cancellation and deadlines
+func (c Client) UntrackIndexedDBForStorageKeyContext(ctx context.Context, params UntrackIndexedDBForStorageKeyParams) (UntrackIndexedDBForStorageKeyReturns, error) {
+	var returns_ UntrackIndexedDBForStorageKeyReturns
+
+	err_ := c.caller.Call(ctx, "Storage.untrackIndexedDBForStorageKey", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetTrustTokensReturns struct {
+	Tokens []TrustTokens `json:"tokens"`
+}
+
+// UnmarshalCDP reads GetTrustTokensReturns from JSON
+func (v *GetTrustTokensReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "tokens":
+			if d.Array() {
+				v.Tokens = make([]TrustTokens, 0)
+				for d.More() {
+					var x0 TrustTokens
+					x0.UnmarshalCDP(d)
+					v.Tokens = append(v.Tokens, x0)
+				}
+			} else {
+				v.Tokens = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetTrustTokensReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the number of stored Trust Tokens per issuer for the
+// current browsing context.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetTrustTokens() (GetTrustTokensReturns, error) {
+	return c.GetTrustTokensContext(context.Background())
+}
+
+// GetTrustTokensContext is GetTrustTokens with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetTrustTokensContext(ctx context.Context) (GetTrustTokensReturns, error) {
+	var returns_ GetTrustTokensReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getTrustTokens", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ClearTrustTokensReturns struct {
+
+	/* True if any tokens were deleted, false otherwise. */
+	DidDeleteTokens bool `json:"didDeleteTokens"`
+}
+
+// UnmarshalCDP reads ClearTrustTokensReturns from JSON
+func (v *ClearTrustTokensReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "didDeleteTokens":
+			v.DidDeleteTokens = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearTrustTokensReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearTrustTokensParams are the parameters for Storage.clearTrustTokens
+// optional parameters are left out when nil
+type ClearTrustTokensParams struct {
+	IssuerOrigin string `json:"issuerOrigin"`
+}
+
+// MarshalCDP writes ClearTrustTokensParams as JSON
+func (v *ClearTrustTokensParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("issuerOrigin")
+	e.String(v.IssuerOrigin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearTrustTokensParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes all Trust Tokens issued by the provided issuerOrigin.
+// Leaves other stored data, including the issuer's Redemption Records, intact.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearTrustTokens(params ClearTrustTokensParams) (ClearTrustTokensReturns, error) {
+	return c.ClearTrustTokensContext(context.Background(), params)
+}
+
+// ClearTrustTokensContext is ClearTrustTokens with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearTrustTokensContext(ctx context.Context, params ClearTrustTokensParams) (ClearTrustTokensReturns, error) {
+	var returns_ ClearTrustTokensReturns
+
+	err_ := c.caller.Call(ctx, "Storage.clearTrustTokens", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetInterestGroupDetailsReturns struct {
+
+	/* This largely corresponds to:
+	https://wicg.github.io/turtledove/#dictdef-generatebidinterestgroup
+	but has absolute expirationTime instead of relative lifetimeMs and
+	also adds joiningOrigin. */
+	Details map[string]interface{} `json:"details"`
+}
+
+// UnmarshalCDP reads GetInterestGroupDetailsReturns from JSON
+func (v *GetInterestGroupDetailsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "details":
+			v.Details = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetInterestGroupDetailsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetInterestGroupDetailsParams are the parameters for Storage.getInterestGroupDetails
+// optional parameters are left out when nil
+type GetInterestGroupDetailsParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Name        string `json:"name"`
+}
+
+// MarshalCDP writes GetInterestGroupDetailsParams as JSON
+func (v *GetInterestGroupDetailsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.Key("name")
+	e.String(v.Name)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetInterestGroupDetailsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets details for a named interest group.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetInterestGroupDetails(params GetInterestGroupDetailsParams) (GetInterestGroupDetailsReturns, error) {
+	return c.GetInterestGroupDetailsContext(context.Background(), params)
+}
+
+// GetInterestGroupDetailsContext is GetInterestGroupDetails with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetInterestGroupDetailsContext(ctx context.Context, params GetInterestGroupDetailsParams) (GetInterestGroupDetailsReturns, error) {
+	var returns_ GetInterestGroupDetailsReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getInterestGroupDetails", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetInterestGroupTrackingReturns struct {
+}
+
+// UnmarshalCDP reads SetInterestGroupTrackingReturns from JSON
+func (v *SetInterestGroupTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInterestGroupTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInterestGroupTrackingParams are the parameters for Storage.setInterestGroupTracking
+// optional parameters are left out when nil
+type SetInterestGroupTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes SetInterestGroupTrackingParams as JSON
+func (v *SetInterestGroupTrackingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInterestGroupTrackingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables/Disables issuing of interestGroupAccessed events.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInterestGroupTracking(params SetInterestGroupTrackingParams) (SetInterestGroupTrackingReturns, error) {
+	return c.SetInterestGroupTrackingContext(context.Background(), params)
+}
+
+// SetInterestGroupTrackingContext is SetInterestGroupTracking with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInterestGroupTrackingContext(ctx context.Context, params SetInterestGroupTrackingParams) (SetInterestGroupTrackingReturns, error) {
+	var returns_ SetInterestGroupTrackingReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setInterestGroupTracking", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetInterestGroupAuctionTrackingReturns struct {
+}
+
+// UnmarshalCDP reads SetInterestGroupAuctionTrackingReturns from JSON
+func (v *SetInterestGroupAuctionTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetInterestGroupAuctionTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetInterestGroupAuctionTrackingParams are the parameters for Storage.setInterestGroupAuctionTracking
+// optional parameters are left out when nil
+type SetInterestGroupAuctionTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes SetInterestGroupAuctionTrackingParams as JSON
+func (v *SetInterestGroupAuctionTrackingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetInterestGroupAuctionTrackingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables/Disables issuing of interestGroupAuctionEventOccurred and
+// interestGroupAuctionNetworkRequestCreated.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInterestGroupAuctionTracking(params SetInterestGroupAuctionTrackingParams) (SetInterestGroupAuctionTrackingReturns, error) {
+	return c.SetInterestGroupAuctionTrackingContext(context.Background(), params)
+}
+
+// SetInterestGroupAuctionTrackingContext is SetInterestGroupAuctionTracking with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetInterestGroupAuctionTrackingContext(ctx context.Context, params SetInterestGroupAuctionTrackingParams) (SetInterestGroupAuctionTrackingReturns, error) {
+	var returns_ SetInterestGroupAuctionTrackingReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setInterestGroupAuctionTracking", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetSharedStorageMetadataReturns struct {
+	Metadata SharedStorageMetadata `json:"metadata"`
+}
+
+// UnmarshalCDP reads GetSharedStorageMetadataReturns from JSON
+func (v *GetSharedStorageMetadataReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "metadata":
+			v.Metadata.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSharedStorageMetadataReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetSharedStorageMetadataParams are the parameters for Storage.getSharedStorageMetadata
+// optional parameters are left out when nil
+type GetSharedStorageMetadataParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// MarshalCDP writes GetSharedStorageMetadataParams as JSON
+func (v *GetSharedStorageMetadataParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetSharedStorageMetadataParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets metadata for an origin's shared storage.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSharedStorageMetadata(params GetSharedStorageMetadataParams) (GetSharedStorageMetadataReturns, error) {
+	return c.GetSharedStorageMetadataContext(context.Background(), params)
+}
+
+// GetSharedStorageMetadataContext is GetSharedStorageMetadata with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSharedStorageMetadataContext(ctx context.Context, params GetSharedStorageMetadataParams) (GetSharedStorageMetadataReturns, error) {
+	var returns_ GetSharedStorageMetadataReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getSharedStorageMetadata", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetSharedStorageEntriesReturns struct {
+	Entries []SharedStorageEntry `json:"entries"`
+}
+
+// UnmarshalCDP reads GetSharedStorageEntriesReturns from JSON
+func (v *GetSharedStorageEntriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "entries":
+			if d.Array() {
+				v.Entries = make([]SharedStorageEntry, 0)
+				for d.More() {
+					var x0 SharedStorageEntry
+					x0.UnmarshalCDP(d)
+					v.Entries = append(v.Entries, x0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetSharedStorageEntriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetSharedStorageEntriesParams are the parameters for Storage.getSharedStorageEntries
+// optional parameters are left out when nil
+type GetSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// MarshalCDP writes GetSharedStorageEntriesParams as JSON
+func (v *GetSharedStorageEntriesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetSharedStorageEntriesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Gets the entries in an given origin's shared storage.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSharedStorageEntries(params GetSharedStorageEntriesParams) (GetSharedStorageEntriesReturns, error) {
+	return c.GetSharedStorageEntriesContext(context.Background(), params)
+}
+
+// GetSharedStorageEntriesContext is GetSharedStorageEntries with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetSharedStorageEntriesContext(ctx context.Context, params GetSharedStorageEntriesParams) (GetSharedStorageEntriesReturns, error) {
+	var returns_ GetSharedStorageEntriesReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getSharedStorageEntries", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSharedStorageEntryReturns struct {
+}
+
+// UnmarshalCDP reads SetSharedStorageEntryReturns from JSON
+func (v *SetSharedStorageEntryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSharedStorageEntryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSharedStorageEntryParams are the parameters for Storage.setSharedStorageEntry
+// optional parameters are left out when nil
+type SetSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	/* If `ignoreIfPresent` is included and true, then only sets the entry if
+	`key` doesn't already exist. */
+	IgnoreIfPresent *bool `json:"ignoreIfPresent,omitempty"`
+}
+
+// MarshalCDP writes SetSharedStorageEntryParams as JSON
+func (v *SetSharedStorageEntryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.Key("key")
+	e.String(v.Key)
+	e.Key("value")
+	e.String(v.Value)
+	if v.IgnoreIfPresent != nil {
+		e.Key("ignoreIfPresent")
+		e.Bool(*v.IgnoreIfPresent)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSharedStorageEntryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets entry with `key` and `value` for a given origin's shared storage.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSharedStorageEntry(params SetSharedStorageEntryParams) (SetSharedStorageEntryReturns, error) {
+	return c.SetSharedStorageEntryContext(context.Background(), params)
+}
+
+// SetSharedStorageEntryContext is SetSharedStorageEntry with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSharedStorageEntryContext(ctx context.Context, params SetSharedStorageEntryParams) (SetSharedStorageEntryReturns, error) {
+	var returns_ SetSharedStorageEntryReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setSharedStorageEntry", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteSharedStorageEntryReturns struct {
+}
+
+// UnmarshalCDP reads DeleteSharedStorageEntryReturns from JSON
+func (v *DeleteSharedStorageEntryReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteSharedStorageEntryReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteSharedStorageEntryParams are the parameters for Storage.deleteSharedStorageEntry
+// optional parameters are left out when nil
+type DeleteSharedStorageEntryParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+	Key         string `json:"key"`
+}
+
+// MarshalCDP writes DeleteSharedStorageEntryParams as JSON
+func (v *DeleteSharedStorageEntryParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.Key("key")
+	e.String(v.Key)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteSharedStorageEntryParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes entry for `key` (if it exists) for a given origin's shared storage.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DeleteSharedStorageEntry(params DeleteSharedStorageEntryParams) (DeleteSharedStorageEntryReturns, error) {
+	return c.DeleteSharedStorageEntryContext(context.Background(), params)
+}
+
+// DeleteSharedStorageEntryContext is DeleteSharedStorageEntry with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DeleteSharedStorageEntryContext(ctx context.Context, params DeleteSharedStorageEntryParams) (DeleteSharedStorageEntryReturns, error) {
+	var returns_ DeleteSharedStorageEntryReturns
+
+	err_ := c.caller.Call(ctx, "Storage.deleteSharedStorageEntry", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearSharedStorageEntriesReturns struct {
+}
+
+// UnmarshalCDP reads ClearSharedStorageEntriesReturns from JSON
+func (v *ClearSharedStorageEntriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearSharedStorageEntriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearSharedStorageEntriesParams are the parameters for Storage.clearSharedStorageEntries
+// optional parameters are left out when nil
+type ClearSharedStorageEntriesParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// MarshalCDP writes ClearSharedStorageEntriesParams as JSON
+func (v *ClearSharedStorageEntriesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearSharedStorageEntriesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears all entries for a given origin's shared storage.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearSharedStorageEntries(params ClearSharedStorageEntriesParams) (ClearSharedStorageEntriesReturns, error) {
+	return c.ClearSharedStorageEntriesContext(context.Background(), params)
+}
+
+// ClearSharedStorageEntriesContext is ClearSharedStorageEntries with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ClearSharedStorageEntriesContext(ctx context.Context, params ClearSharedStorageEntriesParams) (ClearSharedStorageEntriesReturns, error) {
+	var returns_ ClearSharedStorageEntriesReturns
+
+	err_ := c.caller.Call(ctx, "Storage.clearSharedStorageEntries", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ResetSharedStorageBudgetReturns struct {
+}
+
+// UnmarshalCDP reads ResetSharedStorageBudgetReturns from JSON
+func (v *ResetSharedStorageBudgetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ResetSharedStorageBudgetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ResetSharedStorageBudgetParams are the parameters for Storage.resetSharedStorageBudget
+// optional parameters are left out when nil
+type ResetSharedStorageBudgetParams struct {
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// MarshalCDP writes ResetSharedStorageBudgetParams as JSON
+func (v *ResetSharedStorageBudgetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("ownerOrigin")
+	e.String(v.OwnerOrigin)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ResetSharedStorageBudgetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Resets the budget for `ownerOrigin` by clearing all budget withdrawals.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ResetSharedStorageBudget(params ResetSharedStorageBudgetParams) (ResetSharedStorageBudgetReturns, error) {
+	return c.ResetSharedStorageBudgetContext(context.Background(), params)
+}
+
+// ResetSharedStorageBudgetContext is ResetSharedStorageBudget with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ResetSharedStorageBudgetContext(ctx context.Context, params ResetSharedStorageBudgetParams) (ResetSharedStorageBudgetReturns, error) {
+	var returns_ ResetSharedStorageBudgetReturns
+
+	err_ := c.caller.Call(ctx, "Storage.resetSharedStorageBudget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetSharedStorageTrackingReturns struct {
+}
+
+// UnmarshalCDP reads SetSharedStorageTrackingReturns from JSON
+func (v *SetSharedStorageTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetSharedStorageTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetSharedStorageTrackingParams are the parameters for Storage.setSharedStorageTracking
+// optional parameters are left out when nil
+type SetSharedStorageTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes SetSharedStorageTrackingParams as JSON
+func (v *SetSharedStorageTrackingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetSharedStorageTrackingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables/disables issuing of sharedStorageAccessed events.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSharedStorageTracking(params SetSharedStorageTrackingParams) (SetSharedStorageTrackingReturns, error) {
+	return c.SetSharedStorageTrackingContext(context.Background(), params)
+}
+
+// SetSharedStorageTrackingContext is SetSharedStorageTracking with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetSharedStorageTrackingContext(ctx context.Context, params SetSharedStorageTrackingParams) (SetSharedStorageTrackingReturns, error) {
+	var returns_ SetSharedStorageTrackingReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setSharedStorageTracking", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetStorageBucketTrackingReturns struct {
+}
+
+// UnmarshalCDP reads SetStorageBucketTrackingReturns from JSON
+func (v *SetStorageBucketTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetStorageBucketTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetStorageBucketTrackingParams are the parameters for Storage.setStorageBucketTracking
+// optional parameters are left out when nil
+type SetStorageBucketTrackingParams struct {
+	StorageKey string `json:"storageKey"`
+	Enable     bool   `json:"enable"`
+}
+
+// MarshalCDP writes SetStorageBucketTrackingParams as JSON
+func (v *SetStorageBucketTrackingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(v.StorageKey)
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetStorageBucketTrackingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Set tracking for a storage key's buckets.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetStorageBucketTracking(params SetStorageBucketTrackingParams) (SetStorageBucketTrackingReturns, error) {
+	return c.SetStorageBucketTrackingContext(context.Background(), params)
+}
+
+// SetStorageBucketTrackingContext is SetStorageBucketTracking with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetStorageBucketTrackingContext(ctx context.Context, params SetStorageBucketTrackingParams) (SetStorageBucketTrackingReturns, error) {
+	var returns_ SetStorageBucketTrackingReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setStorageBucketTracking", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DeleteStorageBucketReturns struct {
+}
+
+// UnmarshalCDP reads DeleteStorageBucketReturns from JSON
+func (v *DeleteStorageBucketReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DeleteStorageBucketReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DeleteStorageBucketParams are the parameters for Storage.deleteStorageBucket
+// optional parameters are left out when nil
+type DeleteStorageBucketParams struct {
+	Bucket StorageBucket `json:"bucket"`
+}
+
+// MarshalCDP writes DeleteStorageBucketParams as JSON
+func (v *DeleteStorageBucketParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("bucket")
+	v.Bucket.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DeleteStorageBucketParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes the Storage Bucket with the given storage key and bucket name.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DeleteStorageBucket(params DeleteStorageBucketParams) (DeleteStorageBucketReturns, error) {
+	return c.DeleteStorageBucketContext(context.Background(), params)
+}
+
+// DeleteStorageBucketContext is DeleteStorageBucket with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) DeleteStorageBucketContext(ctx context.Context, params DeleteStorageBucketParams) (DeleteStorageBucketReturns, error) {
+	var returns_ DeleteStorageBucketReturns
+
+	err_ := c.caller.Call(ctx, "Storage.deleteStorageBucket", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RunBounceTrackingMitigationsReturns struct {
+	DeletedSites []string `json:"deletedSites"`
+}
+
+// UnmarshalCDP reads RunBounceTrackingMitigationsReturns from JSON
+func (v *RunBounceTrackingMitigationsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "deletedSites":
+			if d.Array() {
+				v.DeletedSites = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.DeletedSites = append(v.DeletedSites, x0)
+				}
+			} else {
+				v.DeletedSites = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RunBounceTrackingMitigationsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Deletes state for sites identified as potential bounce trackers, immediately.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RunBounceTrackingMitigations() (RunBounceTrackingMitigationsReturns, error) {
+	return c.RunBounceTrackingMitigationsContext(context.Background())
+}
+
+// RunBounceTrackingMitigationsContext is RunBounceTrackingMitigations with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RunBounceTrackingMitigationsContext(ctx context.Context) (RunBounceTrackingMitigationsReturns, error) {
+	var returns_ RunBounceTrackingMitigationsReturns
+
+	err_ := c.caller.Call(ctx, "Storage.runBounceTrackingMitigations", nil, &returns_)
+
+	return returns_, err_
+}
+
+type SetAttributionReportingLocalTestingModeReturns struct {
+}
+
+// UnmarshalCDP reads SetAttributionReportingLocalTestingModeReturns from JSON
+func (v *SetAttributionReportingLocalTestingModeReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAttributionReportingLocalTestingModeReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAttributionReportingLocalTestingModeParams are the parameters for Storage.setAttributionReportingLocalTestingMode
+// optional parameters are left out when nil
+type SetAttributionReportingLocalTestingModeParams struct {
+	/* If enabled, noise is suppressed and reports are sent immediately. */
+	Enabled bool `json:"enabled"`
+}
+
+// MarshalCDP writes SetAttributionReportingLocalTestingModeParams as JSON
+func (v *SetAttributionReportingLocalTestingModeParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAttributionReportingLocalTestingModeParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// https://wicg.github.io/attribution-reporting-api/
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAttributionReportingLocalTestingMode(params SetAttributionReportingLocalTestingModeParams) (SetAttributionReportingLocalTestingModeReturns, error) {
+	return c.SetAttributionReportingLocalTestingModeContext(context.Background(), params)
+}
+
+// SetAttributionReportingLocalTestingModeContext is SetAttributionReportingLocalTestingMode with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAttributionReportingLocalTestingModeContext(ctx context.Context, params SetAttributionReportingLocalTestingModeParams) (SetAttributionReportingLocalTestingModeReturns, error) {
+	var returns_ SetAttributionReportingLocalTestingModeReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setAttributionReportingLocalTestingMode", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAttributionReportingTrackingReturns struct {
+}
+
+// UnmarshalCDP reads SetAttributionReportingTrackingReturns from JSON
+func (v *SetAttributionReportingTrackingReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAttributionReportingTrackingReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAttributionReportingTrackingParams are the parameters for Storage.setAttributionReportingTracking
+// optional parameters are left out when nil
+type SetAttributionReportingTrackingParams struct {
+	Enable bool `json:"enable"`
+}
+
+// MarshalCDP writes SetAttributionReportingTrackingParams as JSON
+func (v *SetAttributionReportingTrackingParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("enable")
+	e.Bool(v.Enable)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAttributionReportingTrackingParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables/disables issuing of Attribution Reporting events.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAttributionReportingTracking(params SetAttributionReportingTrackingParams) (SetAttributionReportingTrackingReturns, error) {
+	return c.SetAttributionReportingTrackingContext(context.Background(), params)
+}
+
+// SetAttributionReportingTrackingContext is SetAttributionReportingTracking with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetAttributionReportingTrackingContext(ctx context.Context, params SetAttributionReportingTrackingParams) (SetAttributionReportingTrackingReturns, error) {
+	var returns_ SetAttributionReportingTrackingReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setAttributionReportingTracking", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SendPendingAttributionReportsReturns struct {
+
+	/* The number of reports that were sent. */
+	NumSent int `json:"numSent"`
+}
+
+// UnmarshalCDP reads SendPendingAttributionReportsReturns from JSON
+func (v *SendPendingAttributionReportsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "numSent":
+			v.NumSent = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SendPendingAttributionReportsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Sends all pending Attribution Reports immediately, regardless of their
+// scheduled report time.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SendPendingAttributionReports() (SendPendingAttributionReportsReturns, error) {
+	return c.SendPendingAttributionReportsContext(context.Background())
+}
+
+// SendPendingAttributionReportsContext is SendPendingAttributionReports with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SendPendingAttributionReportsContext(ctx context.Context) (SendPendingAttributionReportsReturns, error) {
+	var returns_ SendPendingAttributionReportsReturns
+
+	err_ := c.caller.Call(ctx, "Storage.sendPendingAttributionReports", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetRelatedWebsiteSetsReturns struct {
+	Sets []RelatedWebsiteSet `json:"sets"`
+}
+
+// UnmarshalCDP reads GetRelatedWebsiteSetsReturns from JSON
+func (v *GetRelatedWebsiteSetsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sets":
+			if d.Array() {
+				v.Sets = make([]RelatedWebsiteSet, 0)
+				for d.More() {
+					var x0 RelatedWebsiteSet
+					x0.UnmarshalCDP(d)
+					v.Sets = append(v.Sets, x0)
+				}
+			} else {
+				v.Sets = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetRelatedWebsiteSetsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns the effective Related Website Sets in use by this profile for the browser
+// session. The effective Related Website Sets will not change during a browser session.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetRelatedWebsiteSets() (GetRelatedWebsiteSetsReturns, error) {
+	return c.GetRelatedWebsiteSetsContext(context.Background())
+}
+
+// GetRelatedWebsiteSetsContext is GetRelatedWebsiteSets with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetRelatedWebsiteSetsContext(ctx context.Context) (GetRelatedWebsiteSetsReturns, error) {
+	var returns_ GetRelatedWebsiteSetsReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getRelatedWebsiteSets", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetAffectedUrlsForThirdPartyCookieMetadataReturns struct {
+
+	/* Array of matching URLs. If there is a primary pattern match for the first-
+	party URL, only the first-party URL is returned in the array. */
+	MatchedUrls []string `json:"matchedUrls"`
+}
+
+// UnmarshalCDP reads GetAffectedUrlsForThirdPartyCookieMetadataReturns from JSON
+func (v *GetAffectedUrlsForThirdPartyCookieMetadataReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "matchedUrls":
+			if d.Array() {
+				v.MatchedUrls = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.MatchedUrls = append(v.MatchedUrls, x0)
+				}
+			} else {
+				v.MatchedUrls = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetAffectedUrlsForThirdPartyCookieMetadataReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetAffectedUrlsForThirdPartyCookieMetadataParams are the parameters for Storage.getAffectedUrlsForThirdPartyCookieMetadata
+// optional parameters are left out when nil
+type GetAffectedUrlsForThirdPartyCookieMetadataParams struct {
+	/* The URL of the page currently being visited. */
+	FirstPartyUrl string `json:"firstPartyUrl"`
+	/* The list of embedded resource URLs from the page. */
+	ThirdPartyUrls []string `json:"thirdPartyUrls"`
+}
+
+// MarshalCDP writes GetAffectedUrlsForThirdPartyCookieMetadataParams as JSON
+func (v *GetAffectedUrlsForThirdPartyCookieMetadataParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("firstPartyUrl")
+	e.String(v.FirstPartyUrl)
+	e.Key("thirdPartyUrls")
+	if v.ThirdPartyUrls == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ThirdPartyUrls {
+			e.Elem()
+			e.String(v.ThirdPartyUrls[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetAffectedUrlsForThirdPartyCookieMetadataParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns the list of URLs from a page and its embedded resources that match
+// existing grace period URL pattern rules.
+// https://developers.google.com/privacy-sandbox/cookies/temporary-exceptions/grace-period
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAffectedUrlsForThirdPartyCookieMetadata(params GetAffectedUrlsForThirdPartyCookieMetadataParams) (GetAffectedUrlsForThirdPartyCookieMetadataReturns, error) {
+	return c.GetAffectedUrlsForThirdPartyCookieMetadataContext(context.Background(), params)
+}
+
+// GetAffectedUrlsForThirdPartyCookieMetadataContext is GetAffectedUrlsForThirdPartyCookieMetadata with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetAffectedUrlsForThirdPartyCookieMetadataContext(ctx context.Context, params GetAffectedUrlsForThirdPartyCookieMetadataParams) (GetAffectedUrlsForThirdPartyCookieMetadataReturns, error) {
+	var returns_ GetAffectedUrlsForThirdPartyCookieMetadataReturns
+
+	err_ := c.caller.Call(ctx, "Storage.getAffectedUrlsForThirdPartyCookieMetadata", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetProtectedAudienceKAnonymityReturns struct {
+}
+
+// UnmarshalCDP reads SetProtectedAudienceKAnonymityReturns from JSON
+func (v *SetProtectedAudienceKAnonymityReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetProtectedAudienceKAnonymityReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetProtectedAudienceKAnonymityParams are the parameters for Storage.setProtectedAudienceKAnonymity
+// optional parameters are left out when nil
+type SetProtectedAudienceKAnonymityParams struct {
+	Owner  string   `json:"owner"`
+	Name   string   `json:"name"`
+	Hashes []string `json:"hashes"`
+}
+
+// MarshalCDP writes SetProtectedAudienceKAnonymityParams as JSON
+func (v *SetProtectedAudienceKAnonymityParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("owner")
+	e.String(v.Owner)
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("hashes")
+	if v.Hashes == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Hashes {
+			e.Elem()
+			e.String(v.Hashes[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetProtectedAudienceKAnonymityParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+func (c Client) SetProtectedAudienceKAnonymity(params SetProtectedAudienceKAnonymityParams) (SetProtectedAudienceKAnonymityReturns, error) {
+	return c.SetProtectedAudienceKAnonymityContext(context.Background(), params)
+}
+
+// SetProtectedAudienceKAnonymityContext is SetProtectedAudienceKAnonymity with a context for cancellation and deadlines
+func (c Client) SetProtectedAudienceKAnonymityContext(ctx context.Context, params SetProtectedAudienceKAnonymityParams) (SetProtectedAudienceKAnonymityReturns, error) {
+	var returns_ SetProtectedAudienceKAnonymityReturns
+
+	err_ := c.caller.Call(ctx, "Storage.setProtectedAudienceKAnonymity", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// A cache's contents have been modified.
+type CacheStorageContentUpdatedEvent struct {
+
+	/* Origin to update. */
+	Origin string `json:"origin"`
+
+	/* Storage key to update. */
+	StorageKey string `json:"storageKey"`
+
+	/* Storage bucket to update. */
+	BucketId string `json:"bucketId"`
+
+	/* Name of cache in origin. */
+	CacheName string `json:"cacheName"`
+}
+
+// UnmarshalCDP reads CacheStorageContentUpdatedEvent from JSON
+func (v *CacheStorageContentUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "storageKey":
+			v.StorageKey = d.String()
+		case "bucketId":
+			v.BucketId = d.String()
+		case "cacheName":
+			v.CacheName = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CacheStorageContentUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CacheStorageContentUpdatedHandler func(ev CacheStorageContentUpdatedEvent)
+
+// EventMethod is Storage.cacheStorageContentUpdated
+func (CacheStorageContentUpdatedEvent) EventMethod() string {
+	return "Storage.cacheStorageContentUpdated"
+}
+
+// OnCacheStorageContentUpdated calls handler for each Storage.cacheStorageContentUpdated event
+func (c Client) OnCacheStorageContentUpdated(handler CacheStorageContentUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.cacheStorageContentUpdated", func(ev interface{}) {
+		handler(ev.(CacheStorageContentUpdatedEvent))
+	})
+}
+
+// A cache has been added/deleted.
+type CacheStorageListUpdatedEvent struct {
+
+	/* Origin to update. */
+	Origin string `json:"origin"`
+
+	/* Storage key to update. */
+	StorageKey string `json:"storageKey"`
+
+	/* Storage bucket to update. */
+	BucketId string `json:"bucketId"`
+}
+
+// UnmarshalCDP reads CacheStorageListUpdatedEvent from JSON
+func (v *CacheStorageListUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "storageKey":
+			v.StorageKey = d.String()
+		case "bucketId":
+			v.BucketId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CacheStorageListUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CacheStorageListUpdatedHandler func(ev CacheStorageListUpdatedEvent)
+
+// EventMethod is Storage.cacheStorageListUpdated
+func (CacheStorageListUpdatedEvent) EventMethod() string {
+	return "Storage.cacheStorageListUpdated"
+}
+
+// OnCacheStorageListUpdated calls handler for each Storage.cacheStorageListUpdated event
+func (c Client) OnCacheStorageListUpdated(handler CacheStorageListUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.cacheStorageListUpdated", func(ev interface{}) {
+		handler(ev.(CacheStorageListUpdatedEvent))
+	})
+}
+
+// The origin's IndexedDB object store has been modified.
+type IndexedDBContentUpdatedEvent struct {
+
+	/* Origin to update. */
+	Origin string `json:"origin"`
+
+	/* Storage key to update. */
+	StorageKey string `json:"storageKey"`
+
+	/* Storage bucket to update. */
+	BucketId string `json:"bucketId"`
+
+	/* Database to update. */
+	DatabaseName string `json:"databaseName"`
+
+	/* ObjectStore to update. */
+	ObjectStoreName string `json:"objectStoreName"`
+}
+
+// UnmarshalCDP reads IndexedDBContentUpdatedEvent from JSON
+func (v *IndexedDBContentUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "storageKey":
+			v.StorageKey = d.String()
+		case "bucketId":
+			v.BucketId = d.String()
+		case "databaseName":
+			v.DatabaseName = d.String()
+		case "objectStoreName":
+			v.ObjectStoreName = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *IndexedDBContentUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type IndexedDBContentUpdatedHandler func(ev IndexedDBContentUpdatedEvent)
+
+// EventMethod is Storage.indexedDBContentUpdated
+func (IndexedDBContentUpdatedEvent) EventMethod() string {
+	return "Storage.indexedDBContentUpdated"
+}
+
+// OnIndexedDBContentUpdated calls handler for each Storage.indexedDBContentUpdated event
+func (c Client) OnIndexedDBContentUpdated(handler IndexedDBContentUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.indexedDBContentUpdated", func(ev interface{}) {
+		handler(ev.(IndexedDBContentUpdatedEvent))
+	})
+}
+
+// The origin's IndexedDB database list has been modified.
+type IndexedDBListUpdatedEvent struct {
+
+	/* Origin to update. */
+	Origin string `json:"origin"`
+
+	/* Storage key to update. */
+	StorageKey string `json:"storageKey"`
+
+	/* Storage bucket to update. */
+	BucketId string `json:"bucketId"`
+}
+
+// UnmarshalCDP reads IndexedDBListUpdatedEvent from JSON
+func (v *IndexedDBListUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "origin":
+			v.Origin = d.String()
+		case "storageKey":
+			v.StorageKey = d.String()
+		case "bucketId":
+			v.BucketId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *IndexedDBListUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type IndexedDBListUpdatedHandler func(ev IndexedDBListUpdatedEvent)
+
+// EventMethod is Storage.indexedDBListUpdated
+func (IndexedDBListUpdatedEvent) EventMethod() string {
+	return "Storage.indexedDBListUpdated"
+}
+
+// OnIndexedDBListUpdated calls handler for each Storage.indexedDBListUpdated event
+func (c Client) OnIndexedDBListUpdated(handler IndexedDBListUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.indexedDBListUpdated", func(ev interface{}) {
+		handler(ev.(IndexedDBListUpdatedEvent))
+	})
+}
+
+// One of the interest groups was accessed. Note that these events are global
+// to all targets sharing an interest group store.
+type InterestGroupAccessedEvent struct {
+	AccessTime cdp.NetworkTimeSinceEpoch `json:"accessTime"`
+
+	Type InterestGroupAccessType `json:"type"`
+
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	Name string `json:"name"`
+
+	/* For topLevelBid/topLevelAdditionalBid, and when appropriate,
+	win and additionalBidWin */
+	ComponentSellerOrigin *string `json:"componentSellerOrigin,omitempty"`
+
+	/* For bid or somethingBid event, if done locally and not on a server. */
+	Bid *float64 `json:"bid,omitempty"`
+
+	BidCurrency *string `json:"bidCurrency,omitempty"`
+
+	/* For non-global events --- links to interestGroupAuctionEvent */
+	UniqueAuctionId *InterestGroupAuctionId `json:"uniqueAuctionId,omitempty"`
+}
+
+// UnmarshalCDP reads InterestGroupAccessedEvent from JSON
+func (v *InterestGroupAccessedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "accessTime":
+			v.AccessTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "type":
+			v.Type = InterestGroupAccessType(d.String())
+		case "ownerOrigin":
+			v.OwnerOrigin = d.String()
+		case "name":
+			v.Name = d.String()
+		case "componentSellerOrigin":
+			if d.Null() {
+				v.ComponentSellerOrigin = nil
+			} else {
+				v.ComponentSellerOrigin = new(string)
+				*v.ComponentSellerOrigin = d.String()
+			}
+		case "bid":
+			if d.Null() {
+				v.Bid = nil
+			} else {
+				v.Bid = new(float64)
+				*v.Bid = d.Float()
+			}
+		case "bidCurrency":
+			if d.Null() {
+				v.BidCurrency = nil
+			} else {
+				v.BidCurrency = new(string)
+				*v.BidCurrency = d.String()
+			}
+		case "uniqueAuctionId":
+			if d.Null() {
+				v.UniqueAuctionId = nil
+			} else {
+				v.UniqueAuctionId = new(InterestGroupAuctionId)
+				*v.UniqueAuctionId = InterestGroupAuctionId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InterestGroupAccessedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InterestGroupAccessedHandler func(ev InterestGroupAccessedEvent)
+
+// EventMethod is Storage.interestGroupAccessed
+func (InterestGroupAccessedEvent) EventMethod() string {
+	return "Storage.interestGroupAccessed"
+}
+
+// OnInterestGroupAccessed calls handler for each Storage.interestGroupAccessed event
+func (c Client) OnInterestGroupAccessed(handler InterestGroupAccessedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.interestGroupAccessed", func(ev interface{}) {
+		handler(ev.(InterestGroupAccessedEvent))
+	})
+}
+
+// An auction involving interest groups is taking place. These events are
+// target-specific.
+type InterestGroupAuctionEventOccurredEvent struct {
+	EventTime cdp.NetworkTimeSinceEpoch `json:"eventTime"`
+
+	Type InterestGroupAuctionEventType `json:"type"`
+
+	UniqueAuctionId InterestGroupAuctionId `json:"uniqueAuctionId"`
+
+	/* Set for child auctions. */
+	ParentAuctionId *InterestGroupAuctionId `json:"parentAuctionId,omitempty"`
+
+	/* Set for started and configResolved */
+	AuctionConfig map[string]interface{} `json:"auctionConfig,omitempty"`
+}
+
+// UnmarshalCDP reads InterestGroupAuctionEventOccurredEvent from JSON
+func (v *InterestGroupAuctionEventOccurredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "eventTime":
+			v.EventTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "type":
+			v.Type = InterestGroupAuctionEventType(d.String())
+		case "uniqueAuctionId":
+			v.UniqueAuctionId = InterestGroupAuctionId(d.String())
+		case "parentAuctionId":
+			if d.Null() {
+				v.ParentAuctionId = nil
+			} else {
+				v.ParentAuctionId = new(InterestGroupAuctionId)
+				*v.ParentAuctionId = InterestGroupAuctionId(d.String())
+			}
+		case "auctionConfig":
+			v.AuctionConfig = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InterestGroupAuctionEventOccurredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InterestGroupAuctionEventOccurredHandler func(ev InterestGroupAuctionEventOccurredEvent)
+
+// EventMethod is Storage.interestGroupAuctionEventOccurred
+func (InterestGroupAuctionEventOccurredEvent) EventMethod() string {
+	return "Storage.interestGroupAuctionEventOccurred"
+}
+
+// OnInterestGroupAuctionEventOccurred calls handler for each Storage.interestGroupAuctionEventOccurred event
+func (c Client) OnInterestGroupAuctionEventOccurred(handler InterestGroupAuctionEventOccurredHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.interestGroupAuctionEventOccurred", func(ev interface{}) {
+		handler(ev.(InterestGroupAuctionEventOccurredEvent))
+	})
+}
+
+// Specifies which auctions a particular network fetch may be related to, and
+// in what role. Note that it is not ordered with respect to
+// Network.requestWillBeSent (but will happen before loadingFinished
+// loadingFailed).
+type InterestGroupAuctionNetworkRequestCreatedEvent struct {
+	Type InterestGroupAuctionFetchType `json:"type"`
+
+	RequestId cdp.NetworkRequestId `json:"requestId"`
+
+	/* This is the set of the auctions using the worklet that issued this
+	request.  In the case of trusted signals, it's possible that only some of
+	them actually care about the keys being queried. */
+	Auctions []InterestGroupAuctionId `json:"auctions"`
+}
+
+// UnmarshalCDP reads InterestGroupAuctionNetworkRequestCreatedEvent from JSON
+func (v *InterestGroupAuctionNetworkRequestCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = InterestGroupAuctionFetchType(d.String())
+		case "requestId":
+			v.RequestId = cdp.NetworkRequestId(d.String())
+		case "auctions":
+			if d.Array() {
+				v.Auctions = make([]InterestGroupAuctionId, 0)
+				for d.More() {
+					var x0 InterestGroupAuctionId
+					x0 = InterestGroupAuctionId(d.String())
+					v.Auctions = append(v.Auctions, x0)
+				}
+			} else {
+				v.Auctions = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *InterestGroupAuctionNetworkRequestCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type InterestGroupAuctionNetworkRequestCreatedHandler func(ev InterestGroupAuctionNetworkRequestCreatedEvent)
+
+// EventMethod is Storage.interestGroupAuctionNetworkRequestCreated
+func (InterestGroupAuctionNetworkRequestCreatedEvent) EventMethod() string {
+	return "Storage.interestGroupAuctionNetworkRequestCreated"
+}
+
+// OnInterestGroupAuctionNetworkRequestCreated calls handler for each Storage.interestGroupAuctionNetworkRequestCreated event
+func (c Client) OnInterestGroupAuctionNetworkRequestCreated(handler InterestGroupAuctionNetworkRequestCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.interestGroupAuctionNetworkRequestCreated", func(ev interface{}) {
+		handler(ev.(InterestGroupAuctionNetworkRequestCreatedEvent))
+	})
+}
+
+// Shared storage was accessed by the associated page.
+// The following parameters are included in all events.
+type SharedStorageAccessedEvent struct {
+
+	/* Time of the access. */
+	AccessTime cdp.NetworkTimeSinceEpoch `json:"accessTime"`
+
+	/* Enum value indicating the access scope. */
+	Scope SharedStorageAccessScope `json:"scope"`
+
+	/* Enum value indicating the Shared Storage API method invoked. */
+	Method SharedStorageAccessMethod `json:"method"`
+
+	/* DevTools Frame Token for the primary frame tree's root. */
+	MainFrameId cdp.PageFrameId `json:"mainFrameId"`
+
+	/* Serialization of the origin owning the Shared Storage data. */
+	OwnerOrigin string `json:"ownerOrigin"`
+
+	/* Serialization of the site owning the Shared Storage data. */
+	OwnerSite string `json:"ownerSite"`
+
+	/* The sub-parameters wrapped by `params` are all optional and their
+	presence/absence depends on `type`. */
+	Params SharedStorageAccessParams `json:"params"`
+}
+
+// UnmarshalCDP reads SharedStorageAccessedEvent from JSON
+func (v *SharedStorageAccessedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "accessTime":
+			v.AccessTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "scope":
+			v.Scope = SharedStorageAccessScope(d.String())
+		case "method":
+			v.Method = SharedStorageAccessMethod(d.String())
+		case "mainFrameId":
+			v.MainFrameId = cdp.PageFrameId(d.String())
+		case "ownerOrigin":
+			v.OwnerOrigin = d.String()
+		case "ownerSite":
+			v.OwnerSite = d.String()
+		case "params":
+			v.Params.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageAccessedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SharedStorageAccessedHandler func(ev SharedStorageAccessedEvent)
+
+// EventMethod is Storage.sharedStorageAccessed
+func (SharedStorageAccessedEvent) EventMethod() string {
+	return "Storage.sharedStorageAccessed"
+}
+
+// OnSharedStorageAccessed calls handler for each Storage.sharedStorageAccessed event
+func (c Client) OnSharedStorageAccessed(handler SharedStorageAccessedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.sharedStorageAccessed", func(ev interface{}) {
+		handler(ev.(SharedStorageAccessedEvent))
+	})
+}
+
+// A shared storage run or selectURL operation finished its execution.
+// The following parameters are included in all events.
+type SharedStorageWorkletOperationExecutionFinishedEvent struct {
+
+	/* Time that the operation finished. */
+	FinishedTime cdp.NetworkTimeSinceEpoch `json:"finishedTime"`
+
+	/* Time, in microseconds, from start of shared storage JS API call until
+	end of operation execution in the worklet. */
+	ExecutionTime int `json:"executionTime"`
+
+	/* Enum value indicating the Shared Storage API method invoked. */
+	Method SharedStorageAccessMethod `json:"method"`
+
+	/* ID of the operation call. */
+	OperationId string `json:"operationId"`
+
+	/* Hex representation of the DevTools token used as the TargetID for the
+	associated shared storage worklet. */
+	WorkletTargetId cdp.TargetTargetID `json:"workletTargetId"`
+
+	/* DevTools Frame Token for the primary frame tree's root. */
+	MainFrameId cdp.PageFrameId `json:"mainFrameId"`
+
+	/* Serialization of the origin owning the Shared Storage data. */
+	OwnerOrigin string `json:"ownerOrigin"`
+}
+
+// UnmarshalCDP reads SharedStorageWorkletOperationExecutionFinishedEvent from JSON
+func (v *SharedStorageWorkletOperationExecutionFinishedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "finishedTime":
+			v.FinishedTime = cdp.NetworkTimeSinceEpoch(d.Float())
+		case "executionTime":
+			v.ExecutionTime = d.Int()
+		case "method":
+			v.Method = SharedStorageAccessMethod(d.String())
+		case "operationId":
+			v.OperationId = d.String()
+		case "workletTargetId":
+			v.WorkletTargetId = cdp.TargetTargetID(d.String())
+		case "mainFrameId":
+			v.MainFrameId = cdp.PageFrameId(d.String())
+		case "ownerOrigin":
+			v.OwnerOrigin = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SharedStorageWorkletOperationExecutionFinishedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type SharedStorageWorkletOperationExecutionFinishedHandler func(ev SharedStorageWorkletOperationExecutionFinishedEvent)
+
+// EventMethod is Storage.sharedStorageWorkletOperationExecutionFinished
+func (SharedStorageWorkletOperationExecutionFinishedEvent) EventMethod() string {
+	return "Storage.sharedStorageWorkletOperationExecutionFinished"
+}
+
+// OnSharedStorageWorkletOperationExecutionFinished calls handler for each Storage.sharedStorageWorkletOperationExecutionFinished event
+func (c Client) OnSharedStorageWorkletOperationExecutionFinished(handler SharedStorageWorkletOperationExecutionFinishedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.sharedStorageWorkletOperationExecutionFinished", func(ev interface{}) {
+		handler(ev.(SharedStorageWorkletOperationExecutionFinishedEvent))
+	})
+}
+
+type StorageBucketCreatedOrUpdatedEvent struct {
+	BucketInfo StorageBucketInfo `json:"bucketInfo"`
+}
+
+// UnmarshalCDP reads StorageBucketCreatedOrUpdatedEvent from JSON
+func (v *StorageBucketCreatedOrUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bucketInfo":
+			v.BucketInfo.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StorageBucketCreatedOrUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StorageBucketCreatedOrUpdatedHandler func(ev StorageBucketCreatedOrUpdatedEvent)
+
+// EventMethod is Storage.storageBucketCreatedOrUpdated
+func (StorageBucketCreatedOrUpdatedEvent) EventMethod() string {
+	return "Storage.storageBucketCreatedOrUpdated"
+}
+
+// OnStorageBucketCreatedOrUpdated calls handler for each Storage.storageBucketCreatedOrUpdated event
+func (c Client) OnStorageBucketCreatedOrUpdated(handler StorageBucketCreatedOrUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.storageBucketCreatedOrUpdated", func(ev interface{}) {
+		handler(ev.(StorageBucketCreatedOrUpdatedEvent))
+	})
+}
+
+type StorageBucketDeletedEvent struct {
+	BucketId string `json:"bucketId"`
+}
+
+// UnmarshalCDP reads StorageBucketDeletedEvent from JSON
+func (v *StorageBucketDeletedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bucketId":
+			v.BucketId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StorageBucketDeletedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type StorageBucketDeletedHandler func(ev StorageBucketDeletedEvent)
+
+// EventMethod is Storage.storageBucketDeleted
+func (StorageBucketDeletedEvent) EventMethod() string {
+	return "Storage.storageBucketDeleted"
+}
+
+// OnStorageBucketDeleted calls handler for each Storage.storageBucketDeleted event
+func (c Client) OnStorageBucketDeleted(handler StorageBucketDeletedHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.storageBucketDeleted", func(ev interface{}) {
+		handler(ev.(StorageBucketDeletedEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingSourceRegisteredEvent struct {
+	Registration AttributionReportingSourceRegistration `json:"registration"`
+
+	Result AttributionReportingSourceRegistrationResult `json:"result"`
+}
+
+// UnmarshalCDP reads AttributionReportingSourceRegisteredEvent from JSON
+func (v *AttributionReportingSourceRegisteredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "registration":
+			v.Registration.UnmarshalCDP(d)
+		case "result":
+			v.Result = AttributionReportingSourceRegistrationResult(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingSourceRegisteredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributionReportingSourceRegisteredHandler func(ev AttributionReportingSourceRegisteredEvent)
+
+// EventMethod is Storage.attributionReportingSourceRegistered
+func (AttributionReportingSourceRegisteredEvent) EventMethod() string {
+	return "Storage.attributionReportingSourceRegistered"
+}
+
+// OnAttributionReportingSourceRegistered calls handler for each Storage.attributionReportingSourceRegistered event
+func (c Client) OnAttributionReportingSourceRegistered(handler AttributionReportingSourceRegisteredHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.attributionReportingSourceRegistered", func(ev interface{}) {
+		handler(ev.(AttributionReportingSourceRegisteredEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingTriggerRegisteredEvent struct {
+	Registration AttributionReportingTriggerRegistration `json:"registration"`
+
+	EventLevel AttributionReportingEventLevelResult `json:"eventLevel"`
+
+	Aggregatable AttributionReportingAggregatableResult `json:"aggregatable"`
+}
+
+// UnmarshalCDP reads AttributionReportingTriggerRegisteredEvent from JSON
+func (v *AttributionReportingTriggerRegisteredEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "registration":
+			v.Registration.UnmarshalCDP(d)
+		case "eventLevel":
+			v.EventLevel = AttributionReportingEventLevelResult(d.String())
+		case "aggregatable":
+			v.Aggregatable = AttributionReportingAggregatableResult(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingTriggerRegisteredEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributionReportingTriggerRegisteredHandler func(ev AttributionReportingTriggerRegisteredEvent)
+
+// EventMethod is Storage.attributionReportingTriggerRegistered
+func (AttributionReportingTriggerRegisteredEvent) EventMethod() string {
+	return "Storage.attributionReportingTriggerRegistered"
+}
+
+// OnAttributionReportingTriggerRegistered calls handler for each Storage.attributionReportingTriggerRegistered event
+func (c Client) OnAttributionReportingTriggerRegistered(handler AttributionReportingTriggerRegisteredHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.attributionReportingTriggerRegistered", func(ev interface{}) {
+		handler(ev.(AttributionReportingTriggerRegisteredEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingReportSentEvent struct {
+	Url string `json:"url"`
+
+	Body map[string]interface{} `json:"body"`
+
+	Result AttributionReportingReportResult `json:"result"`
+
+	/* If result is `sent`, populated with net/HTTP status. */
+	NetError *int `json:"netError,omitempty"`
+
+	NetErrorName *string `json:"netErrorName,omitempty"`
+
+	HttpStatusCode *int `json:"httpStatusCode,omitempty"`
+}
+
+// UnmarshalCDP reads AttributionReportingReportSentEvent from JSON
+func (v *AttributionReportingReportSentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "body":
+			v.Body = d.Map()
+		case "result":
+			v.Result = AttributionReportingReportResult(d.String())
+		case "netError":
+			if d.Null() {
+				v.NetError = nil
+			} else {
+				v.NetError = new(int)
+				*v.NetError = d.Int()
+			}
+		case "netErrorName":
+			if d.Null() {
+				v.NetErrorName = nil
+			} else {
+				v.NetErrorName = new(string)
+				*v.NetErrorName = d.String()
+			}
+		case "httpStatusCode":
+			if d.Null() {
+				v.HttpStatusCode = nil
+			} else {
+				v.HttpStatusCode = new(int)
+				*v.HttpStatusCode = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingReportSentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributionReportingReportSentHandler func(ev AttributionReportingReportSentEvent)
+
+// EventMethod is Storage.attributionReportingReportSent
+func (AttributionReportingReportSentEvent) EventMethod() string {
+	return "Storage.attributionReportingReportSent"
+}
+
+// OnAttributionReportingReportSent calls handler for each Storage.attributionReportingReportSent event
+func (c Client) OnAttributionReportingReportSent(handler AttributionReportingReportSentHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.attributionReportingReportSent", func(ev interface{}) {
+		handler(ev.(AttributionReportingReportSentEvent))
+	})
+}
+
+// Experimental: this may change or be removed in any chrome release
+type AttributionReportingVerboseDebugReportSentEvent struct {
+	Url string `json:"url"`
+
+	Body []map[string]interface{} `json:"body,omitempty"`
+
+	NetError *int `json:"netError,omitempty"`
+
+	NetErrorName *string `json:"netErrorName,omitempty"`
+
+	HttpStatusCode *int `json:"httpStatusCode,omitempty"`
+}
+
+// UnmarshalCDP reads AttributionReportingVerboseDebugReportSentEvent from JSON
+func (v *AttributionReportingVerboseDebugReportSentEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "body":
+			if d.Array() {
+				v.Body = make([]map[string]interface{}, 0)
+				for d.More() {
+					var x0 map[string]interface{}
+					x0 = d.Map()
+					v.Body = append(v.Body, x0)
+				}
+			} else {
+				v.Body = nil
+			}
+		case "netError":
+			if d.Null() {
+				v.NetError = nil
+			} else {
+				v.NetError = new(int)
+				*v.NetError = d.Int()
+			}
+		case "netErrorName":
+			if d.Null() {
+				v.NetErrorName = nil
+			} else {
+				v.NetErrorName = new(string)
+				*v.NetErrorName = d.String()
+			}
+		case "httpStatusCode":
+			if d.Null() {
+				v.HttpStatusCode = nil
+			} else {
+				v.HttpStatusCode = new(int)
+				*v.HttpStatusCode = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttributionReportingVerboseDebugReportSentEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttributionReportingVerboseDebugReportSentHandler func(ev AttributionReportingVerboseDebugReportSentEvent)
+
+// EventMethod is Storage.attributionReportingVerboseDebugReportSent
+func (AttributionReportingVerboseDebugReportSentEvent) EventMethod() string {
+	return "Storage.attributionReportingVerboseDebugReportSent"
+}
+
+// OnAttributionReportingVerboseDebugReportSent calls handler for each Storage.attributionReportingVerboseDebugReportSent event
+func (c Client) OnAttributionReportingVerboseDebugReportSent(handler AttributionReportingVerboseDebugReportSentHandler) (unsubscribe func()) {
+	return c.caller.On("Storage.attributionReportingVerboseDebugReportSent", func(ev interface{}) {
+		handler(ev.(AttributionReportingVerboseDebugReportSentEvent))
+	})
+}
+
+// decoders for Storage events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Storage.cacheStorageContentUpdated":                     cdp.Decode[CacheStorageContentUpdatedEvent],
+	"Storage.cacheStorageListUpdated":                        cdp.Decode[CacheStorageListUpdatedEvent],
+	"Storage.indexedDBContentUpdated":                        cdp.Decode[IndexedDBContentUpdatedEvent],
+	"Storage.indexedDBListUpdated":                           cdp.Decode[IndexedDBListUpdatedEvent],
+	"Storage.interestGroupAccessed":                          cdp.Decode[InterestGroupAccessedEvent],
+	"Storage.interestGroupAuctionEventOccurred":              cdp.Decode[InterestGroupAuctionEventOccurredEvent],
+	"Storage.interestGroupAuctionNetworkRequestCreated":      cdp.Decode[InterestGroupAuctionNetworkRequestCreatedEvent],
+	"Storage.sharedStorageAccessed":                          cdp.Decode[SharedStorageAccessedEvent],
+	"Storage.sharedStorageWorkletOperationExecutionFinished": cdp.Decode[SharedStorageWorkletOperationExecutionFinishedEvent],
+	"Storage.storageBucketCreatedOrUpdated":                  cdp.Decode[StorageBucketCreatedOrUpdatedEvent],
+	"Storage.storageBucketDeleted":                           cdp.Decode[StorageBucketDeletedEvent],
+	"Storage.attributionReportingSourceRegistered":           cdp.Decode[AttributionReportingSourceRegisteredEvent],
+	"Storage.attributionReportingTriggerRegistered":          cdp.Decode[AttributionReportingTriggerRegisteredEvent],
+	"Storage.attributionReportingReportSent":                 cdp.Decode[AttributionReportingReportSentEvent],
+	"Storage.attributionReportingVerboseDebugReportSent":     cdp.Decode[AttributionReportingVerboseDebugReportSentEvent],
+}
+
+// DecodeEvent decodes a Storage event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
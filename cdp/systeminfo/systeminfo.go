@@ -0,0 +1,796 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package systeminfo is the SystemInfo domain of the chrome devtools protocol
+//
+// The SystemInfo domain defines methods and events for querying low-level system information.
+//
+// Experimental: this may change or be removed in any chrome release
+package systeminfo
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends SystemInfo commands and listens for SystemInfo events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Describes a single graphics processor (GPU).
+type GPUDevice struct {
+	/* PCI ID of the GPU vendor, if available; 0 otherwise. */
+	VendorId float64 `json:"vendorId"`
+	/* PCI ID of the GPU device, if available; 0 otherwise. */
+	DeviceId float64 `json:"deviceId"`
+	/* Sub sys ID of the GPU, only available on Windows. */
+	SubSysId *float64 `json:"subSysId,omitempty"`
+	/* Revision of the GPU, only available on Windows. */
+	Revision *float64 `json:"revision,omitempty"`
+	/* String description of the GPU vendor, if the PCI ID is not available. */
+	VendorString string `json:"vendorString"`
+	/* String description of the GPU device, if the PCI ID is not available. */
+	DeviceString string `json:"deviceString"`
+	/* String description of the GPU driver vendor. */
+	DriverVendor string `json:"driverVendor"`
+	/* String description of the GPU driver version. */
+	DriverVersion string `json:"driverVersion"`
+}
+
+// MarshalCDP writes GPUDevice as JSON
+func (v *GPUDevice) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("vendorId")
+	e.Float(v.VendorId)
+	e.Key("deviceId")
+	e.Float(v.DeviceId)
+	if v.SubSysId != nil {
+		e.Key("subSysId")
+		e.Float(*v.SubSysId)
+	}
+	if v.Revision != nil {
+		e.Key("revision")
+		e.Float(*v.Revision)
+	}
+	e.Key("vendorString")
+	e.String(v.VendorString)
+	e.Key("deviceString")
+	e.String(v.DeviceString)
+	e.Key("driverVendor")
+	e.String(v.DriverVendor)
+	e.Key("driverVersion")
+	e.String(v.DriverVersion)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GPUDevice) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads GPUDevice from JSON
+func (v *GPUDevice) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "vendorId":
+			v.VendorId = d.Float()
+		case "deviceId":
+			v.DeviceId = d.Float()
+		case "subSysId":
+			if d.Null() {
+				v.SubSysId = nil
+			} else {
+				v.SubSysId = new(float64)
+				*v.SubSysId = d.Float()
+			}
+		case "revision":
+			if d.Null() {
+				v.Revision = nil
+			} else {
+				v.Revision = new(float64)
+				*v.Revision = d.Float()
+			}
+		case "vendorString":
+			v.VendorString = d.String()
+		case "deviceString":
+			v.DeviceString = d.String()
+		case "driverVendor":
+			v.DriverVendor = d.String()
+		case "driverVersion":
+			v.DriverVersion = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GPUDevice) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Describes the width and height dimensions of an entity.
+type Size struct {
+	/* Width in pixels. */
+	Width int `json:"width"`
+	/* Height in pixels. */
+	Height int `json:"height"`
+}
+
+// MarshalCDP writes Size as JSON
+func (v *Size) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("width")
+	e.Int(v.Width)
+	e.Key("height")
+	e.Int(v.Height)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Size) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Size from JSON
+func (v *Size) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "width":
+			v.Width = d.Int()
+		case "height":
+			v.Height = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Size) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Describes a supported video decoding profile with its associated minimum and
+// maximum resolutions.
+type VideoDecodeAcceleratorCapability struct {
+	/* Video codec profile that is supported, e.g. VP9 Profile 2. */
+	Profile string `json:"profile"`
+	/* Maximum video dimensions in pixels supported for this |profile|. */
+	MaxResolution Size `json:"maxResolution"`
+	/* Minimum video dimensions in pixels supported for this |profile|. */
+	MinResolution Size `json:"minResolution"`
+}
+
+// MarshalCDP writes VideoDecodeAcceleratorCapability as JSON
+func (v *VideoDecodeAcceleratorCapability) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("profile")
+	e.String(v.Profile)
+	e.Key("maxResolution")
+	v.MaxResolution.MarshalCDP(e)
+	e.Key("minResolution")
+	v.MinResolution.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v VideoDecodeAcceleratorCapability) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads VideoDecodeAcceleratorCapability from JSON
+func (v *VideoDecodeAcceleratorCapability) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile = d.String()
+		case "maxResolution":
+			v.MaxResolution.UnmarshalCDP(d)
+		case "minResolution":
+			v.MinResolution.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VideoDecodeAcceleratorCapability) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Describes a supported video encoding profile with its associated maximum
+// resolution and maximum framerate.
+type VideoEncodeAcceleratorCapability struct {
+	/* Video codec profile that is supported, e.g H264 Main. */
+	Profile string `json:"profile"`
+	/* Maximum video dimensions in pixels supported for this |profile|. */
+	MaxResolution Size `json:"maxResolution"`
+	/* Maximum encoding framerate in frames per second supported for this
+	|profile|, as fraction's numerator and denominator, e.g. 24/1 fps,
+	24000/1001 fps, etc. */
+	MaxFramerateNumerator   int `json:"maxFramerateNumerator"`
+	MaxFramerateDenominator int `json:"maxFramerateDenominator"`
+}
+
+// MarshalCDP writes VideoEncodeAcceleratorCapability as JSON
+func (v *VideoEncodeAcceleratorCapability) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("profile")
+	e.String(v.Profile)
+	e.Key("maxResolution")
+	v.MaxResolution.MarshalCDP(e)
+	e.Key("maxFramerateNumerator")
+	e.Int(v.MaxFramerateNumerator)
+	e.Key("maxFramerateDenominator")
+	e.Int(v.MaxFramerateDenominator)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v VideoEncodeAcceleratorCapability) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads VideoEncodeAcceleratorCapability from JSON
+func (v *VideoEncodeAcceleratorCapability) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "profile":
+			v.Profile = d.String()
+		case "maxResolution":
+			v.MaxResolution.UnmarshalCDP(d)
+		case "maxFramerateNumerator":
+			v.MaxFramerateNumerator = d.Int()
+		case "maxFramerateDenominator":
+			v.MaxFramerateDenominator = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VideoEncodeAcceleratorCapability) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// YUV subsampling type of the pixels of a given image.
+type SubsamplingFormat string
+
+// SubsamplingFormat values
+const (
+	SubsamplingFormatYuv420 SubsamplingFormat = "yuv420"
+	SubsamplingFormatYuv422 SubsamplingFormat = "yuv422"
+	SubsamplingFormatYuv444 SubsamplingFormat = "yuv444"
+)
+
+// Values gives every SubsamplingFormat
+func (SubsamplingFormat) Values() []SubsamplingFormat {
+	return []SubsamplingFormat{
+		SubsamplingFormatYuv420,
+		SubsamplingFormatYuv422,
+		SubsamplingFormatYuv444,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SubsamplingFormat) Valid() bool {
+	switch v {
+	case SubsamplingFormatYuv420, SubsamplingFormatYuv422, SubsamplingFormatYuv444:
+		return true
+	}
+	return false
+}
+
+// Image format of a given image.
+type ImageType string
+
+// ImageType values
+const (
+	ImageTypeJpeg    ImageType = "jpeg"
+	ImageTypeWebp    ImageType = "webp"
+	ImageTypeUnknown ImageType = "unknown"
+)
+
+// Values gives every ImageType
+func (ImageType) Values() []ImageType {
+	return []ImageType{
+		ImageTypeJpeg,
+		ImageTypeWebp,
+		ImageTypeUnknown,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ImageType) Valid() bool {
+	switch v {
+	case ImageTypeJpeg, ImageTypeWebp, ImageTypeUnknown:
+		return true
+	}
+	return false
+}
+
+// Describes a supported image decoding profile with its associated minimum and
+// maximum resolutions and subsampling.
+type ImageDecodeAcceleratorCapability struct {
+	/* Image coded, e.g. Jpeg. */
+	ImageType ImageType `json:"imageType"`
+	/* Maximum supported dimensions of the image in pixels. */
+	MaxDimensions Size `json:"maxDimensions"`
+	/* Minimum supported dimensions of the image in pixels. */
+	MinDimensions Size `json:"minDimensions"`
+	/* Optional array of supported subsampling formats, e.g. 4:2:0, if known. */
+	Subsamplings []SubsamplingFormat `json:"subsamplings"`
+}
+
+// MarshalCDP writes ImageDecodeAcceleratorCapability as JSON
+func (v *ImageDecodeAcceleratorCapability) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("imageType")
+	e.String(string(v.ImageType))
+	e.Key("maxDimensions")
+	v.MaxDimensions.MarshalCDP(e)
+	e.Key("minDimensions")
+	v.MinDimensions.MarshalCDP(e)
+	e.Key("subsamplings")
+	if v.Subsamplings == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Subsamplings {
+			e.Elem()
+			e.String(string(v.Subsamplings[i0]))
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ImageDecodeAcceleratorCapability) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ImageDecodeAcceleratorCapability from JSON
+func (v *ImageDecodeAcceleratorCapability) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "imageType":
+			v.ImageType = ImageType(d.String())
+		case "maxDimensions":
+			v.MaxDimensions.UnmarshalCDP(d)
+		case "minDimensions":
+			v.MinDimensions.UnmarshalCDP(d)
+		case "subsamplings":
+			if d.Array() {
+				v.Subsamplings = make([]SubsamplingFormat, 0)
+				for d.More() {
+					var x0 SubsamplingFormat
+					x0 = SubsamplingFormat(d.String())
+					v.Subsamplings = append(v.Subsamplings, x0)
+				}
+			} else {
+				v.Subsamplings = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ImageDecodeAcceleratorCapability) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Provides information about the GPU(s) on the system.
+type GPUInfo struct {
+	/* The graphics devices on the system. Element 0 is the primary GPU. */
+	Devices []GPUDevice `json:"devices"`
+	/* An optional dictionary of additional GPU related attributes. */
+	AuxAttributes map[string]interface{} `json:"auxAttributes"`
+	/* An optional dictionary of graphics features and their status. */
+	FeatureStatus map[string]interface{} `json:"featureStatus"`
+	/* An optional array of GPU driver bug workarounds. */
+	DriverBugWorkarounds []string `json:"driverBugWorkarounds"`
+	/* Supported accelerated video decoding capabilities. */
+	VideoDecoding []VideoDecodeAcceleratorCapability `json:"videoDecoding"`
+	/* Supported accelerated video encoding capabilities. */
+	VideoEncoding []VideoEncodeAcceleratorCapability `json:"videoEncoding"`
+	/* Supported accelerated image decoding capabilities. */
+	ImageDecoding []ImageDecodeAcceleratorCapability `json:"imageDecoding"`
+}
+
+// MarshalCDP writes GPUInfo as JSON
+func (v *GPUInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("devices")
+	if v.Devices == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Devices {
+			e.Elem()
+			v.Devices[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.AuxAttributes != nil {
+		e.Key("auxAttributes")
+		e.Map(v.AuxAttributes)
+	}
+	if v.FeatureStatus != nil {
+		e.Key("featureStatus")
+		e.Map(v.FeatureStatus)
+	}
+	e.Key("driverBugWorkarounds")
+	if v.DriverBugWorkarounds == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.DriverBugWorkarounds {
+			e.Elem()
+			e.String(v.DriverBugWorkarounds[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.Key("videoDecoding")
+	if v.VideoDecoding == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.VideoDecoding {
+			e.Elem()
+			v.VideoDecoding[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("videoEncoding")
+	if v.VideoEncoding == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.VideoEncoding {
+			e.Elem()
+			v.VideoEncoding[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.Key("imageDecoding")
+	if v.ImageDecoding == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.ImageDecoding {
+			e.Elem()
+			v.ImageDecoding[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GPUInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads GPUInfo from JSON
+func (v *GPUInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "devices":
+			if d.Array() {
+				v.Devices = make([]GPUDevice, 0)
+				for d.More() {
+					var x0 GPUDevice
+					x0.UnmarshalCDP(d)
+					v.Devices = append(v.Devices, x0)
+				}
+			} else {
+				v.Devices = nil
+			}
+		case "auxAttributes":
+			v.AuxAttributes = d.Map()
+		case "featureStatus":
+			v.FeatureStatus = d.Map()
+		case "driverBugWorkarounds":
+			if d.Array() {
+				v.DriverBugWorkarounds = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.DriverBugWorkarounds = append(v.DriverBugWorkarounds, x0)
+				}
+			} else {
+				v.DriverBugWorkarounds = nil
+			}
+		case "videoDecoding":
+			if d.Array() {
+				v.VideoDecoding = make([]VideoDecodeAcceleratorCapability, 0)
+				for d.More() {
+					var x0 VideoDecodeAcceleratorCapability
+					x0.UnmarshalCDP(d)
+					v.VideoDecoding = append(v.VideoDecoding, x0)
+				}
+			} else {
+				v.VideoDecoding = nil
+			}
+		case "videoEncoding":
+			if d.Array() {
+				v.VideoEncoding = make([]VideoEncodeAcceleratorCapability, 0)
+				for d.More() {
+					var x0 VideoEncodeAcceleratorCapability
+					x0.UnmarshalCDP(d)
+					v.VideoEncoding = append(v.VideoEncoding, x0)
+				}
+			} else {
+				v.VideoEncoding = nil
+			}
+		case "imageDecoding":
+			if d.Array() {
+				v.ImageDecoding = make([]ImageDecodeAcceleratorCapability, 0)
+				for d.More() {
+					var x0 ImageDecodeAcceleratorCapability
+					x0.UnmarshalCDP(d)
+					v.ImageDecoding = append(v.ImageDecoding, x0)
+				}
+			} else {
+				v.ImageDecoding = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GPUInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Represents process info.
+type ProcessInfo struct {
+	/* Specifies process type. */
+	Type string `json:"type"`
+	/* Specifies process id. */
+	Id int `json:"id"`
+	/* Specifies cumulative CPU usage in seconds across all threads of the
+	process since the process start. */
+	CpuTime float64 `json:"cpuTime"`
+}
+
+// MarshalCDP writes ProcessInfo as JSON
+func (v *ProcessInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(v.Type)
+	e.Key("id")
+	e.Int(v.Id)
+	e.Key("cpuTime")
+	e.Float(v.CpuTime)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ProcessInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ProcessInfo from JSON
+func (v *ProcessInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = d.String()
+		case "id":
+			v.Id = d.Int()
+		case "cpuTime":
+			v.CpuTime = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ProcessInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type GetInfoReturns struct {
+
+	/* Information about the GPUs on the system. */
+	Gpu GPUInfo `json:"gpu"`
+
+	/* A platform-dependent description of the model of the machine. On Mac OS, this is, for
+	example, 'MacBookPro'. Will be the empty string if not supported. */
+	ModelName string `json:"modelName"`
+
+	/* A platform-dependent description of the version of the machine. On Mac OS, this is, for
+	example, '10.1'. Will be the empty string if not supported. */
+	ModelVersion string `json:"modelVersion"`
+
+	/* The command line string used to launch the browser. Will be the empty string if not
+	supported. */
+	CommandLine string `json:"commandLine"`
+}
+
+// UnmarshalCDP reads GetInfoReturns from JSON
+func (v *GetInfoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "gpu":
+			v.Gpu.UnmarshalCDP(d)
+		case "modelName":
+			v.ModelName = d.String()
+		case "modelVersion":
+			v.ModelVersion = d.String()
+		case "commandLine":
+			v.CommandLine = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetInfoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns information about the system.
+func (c Client) GetInfo() (GetInfoReturns, error) {
+	return c.GetInfoContext(context.Background())
+}
+
+// GetInfoContext is GetInfo with a context for cancellation and deadlines
+func (c Client) GetInfoContext(ctx context.Context) (GetInfoReturns, error) {
+	var returns_ GetInfoReturns
+
+	err_ := c.caller.Call(ctx, "SystemInfo.getInfo", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetFeatureStateReturns struct {
+	FeatureEnabled bool `json:"featureEnabled"`
+}
+
+// UnmarshalCDP reads GetFeatureStateReturns from JSON
+func (v *GetFeatureStateReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "featureEnabled":
+			v.FeatureEnabled = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetFeatureStateReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetFeatureStateParams are the parameters for SystemInfo.getFeatureState
+// optional parameters are left out when nil
+type GetFeatureStateParams struct {
+	FeatureState string `json:"featureState"`
+}
+
+// MarshalCDP writes GetFeatureStateParams as JSON
+func (v *GetFeatureStateParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("featureState")
+	e.String(v.FeatureState)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetFeatureStateParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns information about the feature state.
+func (c Client) GetFeatureState(params GetFeatureStateParams) (GetFeatureStateReturns, error) {
+	return c.GetFeatureStateContext(context.Background(), params)
+}
+
+// GetFeatureStateContext is GetFeatureState with a context for cancellation and deadlines
+func (c Client) GetFeatureStateContext(ctx context.Context, params GetFeatureStateParams) (GetFeatureStateReturns, error) {
+	var returns_ GetFeatureStateReturns
+
+	err_ := c.caller.Call(ctx, "SystemInfo.getFeatureState", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetProcessInfoReturns struct {
+
+	/* An array of process info blocks. */
+	ProcessInfo []ProcessInfo `json:"processInfo"`
+}
+
+// UnmarshalCDP reads GetProcessInfoReturns from JSON
+func (v *GetProcessInfoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "processInfo":
+			if d.Array() {
+				v.ProcessInfo = make([]ProcessInfo, 0)
+				for d.More() {
+					var x0 ProcessInfo
+					x0.UnmarshalCDP(d)
+					v.ProcessInfo = append(v.ProcessInfo, x0)
+				}
+			} else {
+				v.ProcessInfo = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetProcessInfoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns information about all running processes.
+func (c Client) GetProcessInfo() (GetProcessInfoReturns, error) {
+	return c.GetProcessInfoContext(context.Background())
+}
+
+// GetProcessInfoContext is GetProcessInfo with a context for cancellation and deadlines
+func (c Client) GetProcessInfoContext(ctx context.Context) (GetProcessInfoReturns, error) {
+	var returns_ GetProcessInfoReturns
+
+	err_ := c.caller.Call(ctx, "SystemInfo.getProcessInfo", nil, &returns_)
+
+	return returns_, err_
+}
@@ -0,0 +1,1456 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package target is the Target domain of the chrome devtools protocol
+//
+// Supports additional targets discovery and allows to attach to them.
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Target commands and listens for Target events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// types used by other domains are declared in package cdp
+type (
+	TargetID = cdp.TargetTargetID
+)
+
+// Unique identifier of attached debugging session.
+type SessionID string
+
+type TargetInfo struct {
+	TargetId TargetID `json:"targetId"`
+	/* List of types: https://source.chromium.org/chromium/chromium/src/+/main:content/browser/devtools/devtools_agent_host_impl.cc?ss=chromium&q=f:devtools%20-f:out%20%22::kTypeTab%5B%5D%22 */
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	Url   string `json:"url"`
+	/* Whether the target has an attached client. */
+	Attached bool `json:"attached"`
+	/* Opener target Id */
+	OpenerId *TargetID `json:"openerId,omitempty"`
+	/* Whether the target has access to the originating window.
+
+	Experimental: this may change or be removed in any chrome release */
+	CanAccessOpener bool `json:"canAccessOpener"`
+	/* Frame id of originating window (is only set if target has an opener).
+
+	Experimental: this may change or be removed in any chrome release */
+	OpenerFrameId *cdp.PageFrameId `json:"openerFrameId,omitempty"`
+	/* Experimental: this may change or be removed in any chrome release */
+	BrowserContextId *cdp.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	/* Provides additional details for specific target types. For example, for
+	the type of "page", this may be set to "prerender".
+
+	Experimental: this may change or be removed in any chrome release */
+	Subtype *string `json:"subtype,omitempty"`
+}
+
+// MarshalCDP writes TargetInfo as JSON
+func (v *TargetInfo) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	e.Key("type")
+	e.String(v.Type)
+	e.Key("title")
+	e.String(v.Title)
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("attached")
+	e.Bool(v.Attached)
+	if v.OpenerId != nil {
+		e.Key("openerId")
+		e.String(string(*v.OpenerId))
+	}
+	e.Key("canAccessOpener")
+	e.Bool(v.CanAccessOpener)
+	if v.OpenerFrameId != nil {
+		e.Key("openerFrameId")
+		e.String(string(*v.OpenerFrameId))
+	}
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	if v.Subtype != nil {
+		e.Key("subtype")
+		e.String(*v.Subtype)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TargetInfo) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads TargetInfo from JSON
+func (v *TargetInfo) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetId":
+			v.TargetId = TargetID(d.String())
+		case "type":
+			v.Type = d.String()
+		case "title":
+			v.Title = d.String()
+		case "url":
+			v.Url = d.String()
+		case "attached":
+			v.Attached = d.Bool()
+		case "openerId":
+			if d.Null() {
+				v.OpenerId = nil
+			} else {
+				v.OpenerId = new(TargetID)
+				*v.OpenerId = TargetID(d.String())
+			}
+		case "canAccessOpener":
+			v.CanAccessOpener = d.Bool()
+		case "openerFrameId":
+			if d.Null() {
+				v.OpenerFrameId = nil
+			} else {
+				v.OpenerFrameId = new(cdp.PageFrameId)
+				*v.OpenerFrameId = cdp.PageFrameId(d.String())
+			}
+		case "browserContextId":
+			if d.Null() {
+				v.BrowserContextId = nil
+			} else {
+				v.BrowserContextId = new(cdp.BrowserBrowserContextID)
+				*v.BrowserContextId = cdp.BrowserBrowserContextID(d.String())
+			}
+		case "subtype":
+			if d.Null() {
+				v.Subtype = nil
+			} else {
+				v.Subtype = new(string)
+				*v.Subtype = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TargetInfo) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// A filter used by target query/discovery/auto-attach operations.
+//
+// Experimental: this may change or be removed in any chrome release
+type FilterEntry struct {
+	/* If set, causes exclusion of matching targets from the list. */
+	Exclude *bool `json:"exclude,omitempty"`
+	/* If not present, matches any type. */
+	Type *string `json:"type,omitempty"`
+}
+
+// MarshalCDP writes FilterEntry as JSON
+func (v *FilterEntry) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Exclude != nil {
+		e.Key("exclude")
+		e.Bool(*v.Exclude)
+	}
+	if v.Type != nil {
+		e.Key("type")
+		e.String(*v.Type)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v FilterEntry) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads FilterEntry from JSON
+func (v *FilterEntry) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "exclude":
+			if d.Null() {
+				v.Exclude = nil
+			} else {
+				v.Exclude = new(bool)
+				*v.Exclude = d.Bool()
+			}
+		case "type":
+			if d.Null() {
+				v.Type = nil
+			} else {
+				v.Type = new(string)
+				*v.Type = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *FilterEntry) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The entries in TargetFilter are matched sequentially against targets and
+// the first entry that matches determines if the target is included or not,
+// depending on the value of `exclude` field in the entry.
+// If filter is not specified, the one assumed is
+// [{type: "browser", exclude: true}, {type: "tab", exclude: true}, {}]
+// (i.e. include everything but `browser` and `tab`).
+//
+// Experimental: this may change or be removed in any chrome release
+type TargetFilter []FilterEntry
+
+// Experimental: this may change or be removed in any chrome release
+type RemoteLocation struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+// MarshalCDP writes RemoteLocation as JSON
+func (v *RemoteLocation) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("host")
+	e.String(v.Host)
+	e.Key("port")
+	e.Int(v.Port)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoteLocation) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads RemoteLocation from JSON
+func (v *RemoteLocation) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "host":
+			v.Host = d.String()
+		case "port":
+			v.Port = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoteLocation) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// The state of the target window.
+//
+// Experimental: this may change or be removed in any chrome release
+type WindowState string
+
+// WindowState values
+const (
+	WindowStateNormal     WindowState = "normal"
+	WindowStateMinimized  WindowState = "minimized"
+	WindowStateMaximized  WindowState = "maximized"
+	WindowStateFullscreen WindowState = "fullscreen"
+)
+
+// Values gives every WindowState
+func (WindowState) Values() []WindowState {
+	return []WindowState{
+		WindowStateNormal,
+		WindowStateMinimized,
+		WindowStateMaximized,
+		WindowStateFullscreen,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v WindowState) Valid() bool {
+	switch v {
+	case WindowStateNormal, WindowStateMinimized, WindowStateMaximized, WindowStateFullscreen:
+		return true
+	}
+	return false
+}
+
+type ActivateTargetReturns struct {
+}
+
+// UnmarshalCDP reads ActivateTargetReturns from JSON
+func (v *ActivateTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ActivateTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ActivateTargetParams are the parameters for Target.activateTarget
+// optional parameters are left out when nil
+type ActivateTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+}
+
+// MarshalCDP writes ActivateTargetParams as JSON
+func (v *ActivateTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ActivateTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Activates (focuses) the target.
+func (c Client) ActivateTarget(params ActivateTargetParams) (ActivateTargetReturns, error) {
+	return c.ActivateTargetContext(context.Background(), params)
+}
+
+// ActivateTargetContext is ActivateTarget with a context for cancellation and deadlines
+func (c Client) ActivateTargetContext(ctx context.Context, params ActivateTargetParams) (ActivateTargetReturns, error) {
+	var returns_ ActivateTargetReturns
+
+	err_ := c.caller.Call(ctx, "Target.activateTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AttachToTargetReturns struct {
+
+	/* Id assigned to the session. */
+	SessionId SessionID `json:"sessionId"`
+}
+
+// UnmarshalCDP reads AttachToTargetReturns from JSON
+func (v *AttachToTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sessionId":
+			v.SessionId = SessionID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttachToTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AttachToTargetParams are the parameters for Target.attachToTarget
+// optional parameters are left out when nil
+type AttachToTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+	/* Enables "flat" access to the session via specifying sessionId attribute in the commands.
+	We plan to make this the default, deprecate non-flattened mode,
+	and eventually retire it. See crbug.com/991325. */
+	Flatten *bool `json:"flatten,omitempty"`
+}
+
+// MarshalCDP writes AttachToTargetParams as JSON
+func (v *AttachToTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	if v.Flatten != nil {
+		e.Key("flatten")
+		e.Bool(*v.Flatten)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AttachToTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Attaches to the target with given id.
+func (c Client) AttachToTarget(params AttachToTargetParams) (AttachToTargetReturns, error) {
+	return c.AttachToTargetContext(context.Background(), params)
+}
+
+// AttachToTargetContext is AttachToTarget with a context for cancellation and deadlines
+func (c Client) AttachToTargetContext(ctx context.Context, params AttachToTargetParams) (AttachToTargetReturns, error) {
+	var returns_ AttachToTargetReturns
+
+	err_ := c.caller.Call(ctx, "Target.attachToTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CloseTargetReturns struct {
+
+	/* Always set to true. If an error occurs, the response indicates protocol error.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Success bool `json:"success"`
+}
+
+// UnmarshalCDP reads CloseTargetReturns from JSON
+func (v *CloseTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "success":
+			v.Success = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CloseTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CloseTargetParams are the parameters for Target.closeTarget
+// optional parameters are left out when nil
+type CloseTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+}
+
+// MarshalCDP writes CloseTargetParams as JSON
+func (v *CloseTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CloseTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Closes the target. If the target is a page that gets closed too.
+func (c Client) CloseTarget(params CloseTargetParams) (CloseTargetReturns, error) {
+	return c.CloseTargetContext(context.Background(), params)
+}
+
+// CloseTargetContext is CloseTarget with a context for cancellation and deadlines
+func (c Client) CloseTargetContext(ctx context.Context, params CloseTargetParams) (CloseTargetReturns, error) {
+	var returns_ CloseTargetReturns
+
+	err_ := c.caller.Call(ctx, "Target.closeTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type CreateBrowserContextReturns struct {
+
+	/* The id of the context created. */
+	BrowserContextId cdp.BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// UnmarshalCDP reads CreateBrowserContextReturns from JSON
+func (v *CreateBrowserContextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "browserContextId":
+			v.BrowserContextId = cdp.BrowserBrowserContextID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CreateBrowserContextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CreateBrowserContextParams are the parameters for Target.createBrowserContext
+// optional parameters are left out when nil
+type CreateBrowserContextParams struct {
+	/* If specified, disposes this context when debugging session disconnects.
+
+	Experimental: this may change or be removed in any chrome release */
+	DisposeOnDetach *bool `json:"disposeOnDetach,omitempty"`
+	/* Proxy server, similar to the one passed to --proxy-server
+
+	Experimental: this may change or be removed in any chrome release */
+	ProxyServer *string `json:"proxyServer,omitempty"`
+	/* Proxy bypass list, similar to the one passed to --proxy-bypass-list
+
+	Experimental: this may change or be removed in any chrome release */
+	ProxyBypassList *string `json:"proxyBypassList,omitempty"`
+	/* An optional list of origins to grant unlimited cross-origin access to.
+	Parts of the URL other than those constituting origin are ignored.
+
+	Experimental: this may change or be removed in any chrome release */
+	OriginsWithUniversalNetworkAccess []string `json:"originsWithUniversalNetworkAccess"`
+}
+
+// MarshalCDP writes CreateBrowserContextParams as JSON
+func (v *CreateBrowserContextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.DisposeOnDetach != nil {
+		e.Key("disposeOnDetach")
+		e.Bool(*v.DisposeOnDetach)
+	}
+	if v.ProxyServer != nil {
+		e.Key("proxyServer")
+		e.String(*v.ProxyServer)
+	}
+	if v.ProxyBypassList != nil {
+		e.Key("proxyBypassList")
+		e.String(*v.ProxyBypassList)
+	}
+	if v.OriginsWithUniversalNetworkAccess != nil {
+		e.Key("originsWithUniversalNetworkAccess")
+		e.ArrayStart()
+		for i0 := range v.OriginsWithUniversalNetworkAccess {
+			e.Elem()
+			e.String(v.OriginsWithUniversalNetworkAccess[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CreateBrowserContextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than
+// one.
+func (c Client) CreateBrowserContext(params CreateBrowserContextParams) (CreateBrowserContextReturns, error) {
+	return c.CreateBrowserContextContext(context.Background(), params)
+}
+
+// CreateBrowserContextContext is CreateBrowserContext with a context for cancellation and deadlines
+func (c Client) CreateBrowserContextContext(ctx context.Context, params CreateBrowserContextParams) (CreateBrowserContextReturns, error) {
+	var returns_ CreateBrowserContextReturns
+
+	err_ := c.caller.Call(ctx, "Target.createBrowserContext", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetBrowserContextsReturns struct {
+
+	/* An array of browser context ids. */
+	BrowserContextIds []cdp.BrowserBrowserContextID `json:"browserContextIds"`
+}
+
+// UnmarshalCDP reads GetBrowserContextsReturns from JSON
+func (v *GetBrowserContextsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "browserContextIds":
+			if d.Array() {
+				v.BrowserContextIds = make([]cdp.BrowserBrowserContextID, 0)
+				for d.More() {
+					var x0 cdp.BrowserBrowserContextID
+					x0 = cdp.BrowserBrowserContextID(d.String())
+					v.BrowserContextIds = append(v.BrowserContextIds, x0)
+				}
+			} else {
+				v.BrowserContextIds = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetBrowserContextsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Returns all browser contexts created with `Target.createBrowserContext` method.
+func (c Client) GetBrowserContexts() (GetBrowserContextsReturns, error) {
+	return c.GetBrowserContextsContext(context.Background())
+}
+
+// GetBrowserContextsContext is GetBrowserContexts with a context for cancellation and deadlines
+func (c Client) GetBrowserContextsContext(ctx context.Context) (GetBrowserContextsReturns, error) {
+	var returns_ GetBrowserContextsReturns
+
+	err_ := c.caller.Call(ctx, "Target.getBrowserContexts", nil, &returns_)
+
+	return returns_, err_
+}
+
+type CreateTargetReturns struct {
+
+	/* The id of the page opened. */
+	TargetId TargetID `json:"targetId"`
+}
+
+// UnmarshalCDP reads CreateTargetReturns from JSON
+func (v *CreateTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetId":
+			v.TargetId = TargetID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CreateTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// CreateTargetParams are the parameters for Target.createTarget
+// optional parameters are left out when nil
+type CreateTargetParams struct {
+	/* The initial URL the page will be navigated to. An empty string indicates about:blank. */
+	Url string `json:"url"`
+	/* Frame left origin in DIP (requires newWindow to be true or headless shell).
+
+	Experimental: this may change or be removed in any chrome release */
+	Left *int `json:"left,omitempty"`
+	/* Frame top origin in DIP (requires newWindow to be true or headless shell).
+
+	Experimental: this may change or be removed in any chrome release */
+	Top *int `json:"top,omitempty"`
+	/* Frame width in DIP (requires newWindow to be true or headless shell). */
+	Width *int `json:"width,omitempty"`
+	/* Frame height in DIP (requires newWindow to be true or headless shell). */
+	Height *int `json:"height,omitempty"`
+	/* Frame window state (requires newWindow to be true or headless shell).
+	Default is normal. */
+	WindowState *WindowState `json:"windowState,omitempty"`
+	/* The browser context to create the page in.
+
+	Experimental: this may change or be removed in any chrome release */
+	BrowserContextId *cdp.BrowserBrowserContextID `json:"browserContextId,omitempty"`
+	/* Whether BeginFrames for this target will be controlled via DevTools (headless shell only,
+	not supported on MacOS yet, false by default).
+
+	Experimental: this may change or be removed in any chrome release */
+	EnableBeginFrameControl *bool `json:"enableBeginFrameControl,omitempty"`
+	/* Whether to create a new Window or Tab (false by default, not supported by headless shell). */
+	NewWindow *bool `json:"newWindow,omitempty"`
+	/* Whether to create the target in background or foreground (false by default, not supported
+	by headless shell). */
+	Background *bool `json:"background,omitempty"`
+	/* Whether to create the target of type "tab".
+
+	Experimental: this may change or be removed in any chrome release */
+	ForTab *bool `json:"forTab,omitempty"`
+	/* Whether to create a hidden target. The hidden target is observable via protocol, but not
+	present in the tab UI strip. Cannot be created with `forTab: true`, `newWindow: true` or
+	`background: false`. The life-time of the tab is limited to the life-time of the session.
+
+	Experimental: this may change or be removed in any chrome release */
+	Hidden *bool `json:"hidden,omitempty"`
+}
+
+// MarshalCDP writes CreateTargetParams as JSON
+func (v *CreateTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	if v.Left != nil {
+		e.Key("left")
+		e.Int(*v.Left)
+	}
+	if v.Top != nil {
+		e.Key("top")
+		e.Int(*v.Top)
+	}
+	if v.Width != nil {
+		e.Key("width")
+		e.Int(*v.Width)
+	}
+	if v.Height != nil {
+		e.Key("height")
+		e.Int(*v.Height)
+	}
+	if v.WindowState != nil {
+		e.Key("windowState")
+		e.String(string(*v.WindowState))
+	}
+	if v.BrowserContextId != nil {
+		e.Key("browserContextId")
+		e.String(string(*v.BrowserContextId))
+	}
+	if v.EnableBeginFrameControl != nil {
+		e.Key("enableBeginFrameControl")
+		e.Bool(*v.EnableBeginFrameControl)
+	}
+	if v.NewWindow != nil {
+		e.Key("newWindow")
+		e.Bool(*v.NewWindow)
+	}
+	if v.Background != nil {
+		e.Key("background")
+		e.Bool(*v.Background)
+	}
+	if v.ForTab != nil {
+		e.Key("forTab")
+		e.Bool(*v.ForTab)
+	}
+	if v.Hidden != nil {
+		e.Key("hidden")
+		e.Bool(*v.Hidden)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v CreateTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Creates a new page.
+func (c Client) CreateTarget(params CreateTargetParams) (CreateTargetReturns, error) {
+	return c.CreateTargetContext(context.Background(), params)
+}
+
+// CreateTargetContext is CreateTarget with a context for cancellation and deadlines
+func (c Client) CreateTargetContext(ctx context.Context, params CreateTargetParams) (CreateTargetReturns, error) {
+	var returns_ CreateTargetReturns
+
+	if params.WindowState != nil && !params.WindowState.Valid() {
+		return returns_, fmt.Errorf("Target.createTarget: invalid windowState %q", *params.WindowState)
+	}
+
+	err_ := c.caller.Call(ctx, "Target.createTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DetachFromTargetReturns struct {
+}
+
+// UnmarshalCDP reads DetachFromTargetReturns from JSON
+func (v *DetachFromTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DetachFromTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DetachFromTargetParams are the parameters for Target.detachFromTarget
+// optional parameters are left out when nil
+type DetachFromTargetParams struct {
+	/* Session to detach. */
+	SessionId *SessionID `json:"sessionId,omitempty"`
+	/* Deprecated.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	TargetId *TargetID `json:"targetId,omitempty"`
+}
+
+// MarshalCDP writes DetachFromTargetParams as JSON
+func (v *DetachFromTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.SessionId != nil {
+		e.Key("sessionId")
+		e.String(string(*v.SessionId))
+	}
+	if v.TargetId != nil {
+		e.Key("targetId")
+		e.String(string(*v.TargetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DetachFromTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Detaches session with given id.
+func (c Client) DetachFromTarget(params DetachFromTargetParams) (DetachFromTargetReturns, error) {
+	return c.DetachFromTargetContext(context.Background(), params)
+}
+
+// DetachFromTargetContext is DetachFromTarget with a context for cancellation and deadlines
+func (c Client) DetachFromTargetContext(ctx context.Context, params DetachFromTargetParams) (DetachFromTargetReturns, error) {
+	var returns_ DetachFromTargetReturns
+
+	err_ := c.caller.Call(ctx, "Target.detachFromTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisposeBrowserContextReturns struct {
+}
+
+// UnmarshalCDP reads DisposeBrowserContextReturns from JSON
+func (v *DisposeBrowserContextReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisposeBrowserContextReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// DisposeBrowserContextParams are the parameters for Target.disposeBrowserContext
+// optional parameters are left out when nil
+type DisposeBrowserContextParams struct {
+	BrowserContextId cdp.BrowserBrowserContextID `json:"browserContextId"`
+}
+
+// MarshalCDP writes DisposeBrowserContextParams as JSON
+func (v *DisposeBrowserContextParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("browserContextId")
+	e.String(string(v.BrowserContextId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DisposeBrowserContextParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Deletes a BrowserContext. All the belonging pages will be closed without calling their
+// beforeunload hooks.
+func (c Client) DisposeBrowserContext(params DisposeBrowserContextParams) (DisposeBrowserContextReturns, error) {
+	return c.DisposeBrowserContextContext(context.Background(), params)
+}
+
+// DisposeBrowserContextContext is DisposeBrowserContext with a context for cancellation and deadlines
+func (c Client) DisposeBrowserContextContext(ctx context.Context, params DisposeBrowserContextParams) (DisposeBrowserContextReturns, error) {
+	var returns_ DisposeBrowserContextReturns
+
+	err_ := c.caller.Call(ctx, "Target.disposeBrowserContext", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetTargetsReturns struct {
+
+	/* The list of targets. */
+	TargetInfos []TargetInfo `json:"targetInfos"`
+}
+
+// UnmarshalCDP reads GetTargetsReturns from JSON
+func (v *GetTargetsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetInfos":
+			if d.Array() {
+				v.TargetInfos = make([]TargetInfo, 0)
+				for d.More() {
+					var x0 TargetInfo
+					x0.UnmarshalCDP(d)
+					v.TargetInfos = append(v.TargetInfos, x0)
+				}
+			} else {
+				v.TargetInfos = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetTargetsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetTargetsParams are the parameters for Target.getTargets
+// optional parameters are left out when nil
+type GetTargetsParams struct {
+	/* Only targets matching filter will be reported. If filter is not specified
+	and target discovery is currently enabled, a filter used for target discovery
+	is used for consistency.
+
+	Experimental: this may change or be removed in any chrome release */
+	Filter TargetFilter `json:"filter"`
+}
+
+// MarshalCDP writes GetTargetsParams as JSON
+func (v *GetTargetsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Filter != nil {
+		e.Key("filter")
+		e.ArrayStart()
+		for i0 := range v.Filter {
+			e.Elem()
+			v.Filter[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetTargetsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Retrieves a list of available targets.
+func (c Client) GetTargets(params GetTargetsParams) (GetTargetsReturns, error) {
+	return c.GetTargetsContext(context.Background(), params)
+}
+
+// GetTargetsContext is GetTargets with a context for cancellation and deadlines
+func (c Client) GetTargetsContext(ctx context.Context, params GetTargetsParams) (GetTargetsReturns, error) {
+	var returns_ GetTargetsReturns
+
+	err_ := c.caller.Call(ctx, "Target.getTargets", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SendMessageToTargetReturns struct {
+}
+
+// UnmarshalCDP reads SendMessageToTargetReturns from JSON
+func (v *SendMessageToTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SendMessageToTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SendMessageToTargetParams are the parameters for Target.sendMessageToTarget
+// optional parameters are left out when nil
+type SendMessageToTargetParams struct {
+	Message string `json:"message"`
+	/* Identifier of the session. */
+	SessionId *SessionID `json:"sessionId,omitempty"`
+	/* Deprecated.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	TargetId *TargetID `json:"targetId,omitempty"`
+}
+
+// MarshalCDP writes SendMessageToTargetParams as JSON
+func (v *SendMessageToTargetParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("message")
+	e.String(v.Message)
+	if v.SessionId != nil {
+		e.Key("sessionId")
+		e.String(string(*v.SessionId))
+	}
+	if v.TargetId != nil {
+		e.Key("targetId")
+		e.String(string(*v.TargetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SendMessageToTargetParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sends protocol message over session with given id.
+// Consider using flat mode instead; see commands attachToTarget, setAutoAttach,
+// and crbug.com/991325.
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SendMessageToTarget(params SendMessageToTargetParams) (SendMessageToTargetReturns, error) {
+	return c.SendMessageToTargetContext(context.Background(), params)
+}
+
+// SendMessageToTargetContext is SendMessageToTarget with a context for cancellation and deadlines
+//
+// Deprecated: this is deprecated in the devtools protocol
+func (c Client) SendMessageToTargetContext(ctx context.Context, params SendMessageToTargetParams) (SendMessageToTargetReturns, error) {
+	var returns_ SendMessageToTargetReturns
+
+	err_ := c.caller.Call(ctx, "Target.sendMessageToTarget", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAutoAttachReturns struct {
+}
+
+// UnmarshalCDP reads SetAutoAttachReturns from JSON
+func (v *SetAutoAttachReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAutoAttachReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAutoAttachParams are the parameters for Target.setAutoAttach
+// optional parameters are left out when nil
+type SetAutoAttachParams struct {
+	/* Whether to auto-attach to related targets. */
+	AutoAttach bool `json:"autoAttach"`
+	/* Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger`
+	to run paused targets. */
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	/* Enables "flat" access to the session via specifying sessionId attribute in the commands.
+	We plan to make this the default, deprecate non-flattened mode,
+	and eventually retire it. See crbug.com/991325.
+
+	Experimental: this may change or be removed in any chrome release */
+	Flatten *bool `json:"flatten,omitempty"`
+	/* Only targets matching filter will be attached.
+
+	Experimental: this may change or be removed in any chrome release */
+	Filter TargetFilter `json:"filter"`
+}
+
+// MarshalCDP writes SetAutoAttachParams as JSON
+func (v *SetAutoAttachParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("autoAttach")
+	e.Bool(v.AutoAttach)
+	e.Key("waitForDebuggerOnStart")
+	e.Bool(v.WaitForDebuggerOnStart)
+	if v.Flatten != nil {
+		e.Key("flatten")
+		e.Bool(*v.Flatten)
+	}
+	if v.Filter != nil {
+		e.Key("filter")
+		e.ArrayStart()
+		for i0 := range v.Filter {
+			e.Elem()
+			v.Filter[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAutoAttachParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Controls whether to automatically attach to new targets which are considered
+// to be directly related to this one (for example, iframes or workers).
+// When turned on, attaches to all existing related targets as well. When turned off,
+// automatically detaches from all currently attached targets.
+// This also clears all targets added by `autoAttachRelated` from the list of targets to watch
+// for creation of related targets.
+// You might want to call this recursively for auto-attached targets to attach
+// to all available targets.
+func (c Client) SetAutoAttach(params SetAutoAttachParams) (SetAutoAttachReturns, error) {
+	return c.SetAutoAttachContext(context.Background(), params)
+}
+
+// SetAutoAttachContext is SetAutoAttach with a context for cancellation and deadlines
+func (c Client) SetAutoAttachContext(ctx context.Context, params SetAutoAttachParams) (SetAutoAttachReturns, error) {
+	var returns_ SetAutoAttachReturns
+
+	err_ := c.caller.Call(ctx, "Target.setAutoAttach", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetDiscoverTargetsReturns struct {
+}
+
+// UnmarshalCDP reads SetDiscoverTargetsReturns from JSON
+func (v *SetDiscoverTargetsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetDiscoverTargetsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetDiscoverTargetsParams are the parameters for Target.setDiscoverTargets
+// optional parameters are left out when nil
+type SetDiscoverTargetsParams struct {
+	/* Whether to discover available targets. */
+	Discover bool `json:"discover"`
+	/* Only targets matching filter will be attached. If `discover` is false,
+	`filter` must be omitted or empty.
+
+	Experimental: this may change or be removed in any chrome release */
+	Filter TargetFilter `json:"filter"`
+}
+
+// MarshalCDP writes SetDiscoverTargetsParams as JSON
+func (v *SetDiscoverTargetsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("discover")
+	e.Bool(v.Discover)
+	if v.Filter != nil {
+		e.Key("filter")
+		e.ArrayStart()
+		for i0 := range v.Filter {
+			e.Elem()
+			v.Filter[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetDiscoverTargetsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Controls whether to discover available targets and notify via
+// `targetCreated/targetInfoChanged/targetDestroyed` events.
+func (c Client) SetDiscoverTargets(params SetDiscoverTargetsParams) (SetDiscoverTargetsReturns, error) {
+	return c.SetDiscoverTargetsContext(context.Background(), params)
+}
+
+// SetDiscoverTargetsContext is SetDiscoverTargets with a context for cancellation and deadlines
+func (c Client) SetDiscoverTargetsContext(ctx context.Context, params SetDiscoverTargetsParams) (SetDiscoverTargetsReturns, error) {
+	var returns_ SetDiscoverTargetsReturns
+
+	err_ := c.caller.Call(ctx, "Target.setDiscoverTargets", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Notifies about a new protocol message received from the session (as reported in
+// `attachedToTarget` event).
+type ReceivedMessageFromTargetEvent struct {
+
+	/* Identifier of a session which sends a message. */
+	SessionId SessionID `json:"sessionId"`
+
+	Message string `json:"message"`
+
+	/* Deprecated.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	TargetId *TargetID `json:"targetId,omitempty"`
+}
+
+// UnmarshalCDP reads ReceivedMessageFromTargetEvent from JSON
+func (v *ReceivedMessageFromTargetEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sessionId":
+			v.SessionId = SessionID(d.String())
+		case "message":
+			v.Message = d.String()
+		case "targetId":
+			if d.Null() {
+				v.TargetId = nil
+			} else {
+				v.TargetId = new(TargetID)
+				*v.TargetId = TargetID(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ReceivedMessageFromTargetEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ReceivedMessageFromTargetHandler func(ev ReceivedMessageFromTargetEvent)
+
+// EventMethod is Target.receivedMessageFromTarget
+func (ReceivedMessageFromTargetEvent) EventMethod() string {
+	return "Target.receivedMessageFromTarget"
+}
+
+// OnReceivedMessageFromTarget calls handler for each Target.receivedMessageFromTarget event
+func (c Client) OnReceivedMessageFromTarget(handler ReceivedMessageFromTargetHandler) (unsubscribe func()) {
+	return c.caller.On("Target.receivedMessageFromTarget", func(ev interface{}) {
+		handler(ev.(ReceivedMessageFromTargetEvent))
+	})
+}
+
+// Issued when a possible inspection target is created.
+type TargetCreatedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// UnmarshalCDP reads TargetCreatedEvent from JSON
+func (v *TargetCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetInfo":
+			v.TargetInfo.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TargetCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TargetCreatedHandler func(ev TargetCreatedEvent)
+
+// EventMethod is Target.targetCreated
+func (TargetCreatedEvent) EventMethod() string {
+	return "Target.targetCreated"
+}
+
+// OnTargetCreated calls handler for each Target.targetCreated event
+func (c Client) OnTargetCreated(handler TargetCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("Target.targetCreated", func(ev interface{}) {
+		handler(ev.(TargetCreatedEvent))
+	})
+}
+
+// Issued when a target is destroyed.
+type TargetDestroyedEvent struct {
+	TargetId TargetID `json:"targetId"`
+}
+
+// UnmarshalCDP reads TargetDestroyedEvent from JSON
+func (v *TargetDestroyedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetId":
+			v.TargetId = TargetID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TargetDestroyedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TargetDestroyedHandler func(ev TargetDestroyedEvent)
+
+// EventMethod is Target.targetDestroyed
+func (TargetDestroyedEvent) EventMethod() string {
+	return "Target.targetDestroyed"
+}
+
+// OnTargetDestroyed calls handler for each Target.targetDestroyed event
+func (c Client) OnTargetDestroyed(handler TargetDestroyedHandler) (unsubscribe func()) {
+	return c.caller.On("Target.targetDestroyed", func(ev interface{}) {
+		handler(ev.(TargetDestroyedEvent))
+	})
+}
+
+// Issued when a target has crashed.
+type TargetCrashedEvent struct {
+	TargetId TargetID `json:"targetId"`
+
+	/* Termination status type. */
+	Status string `json:"status"`
+
+	/* Termination error code. */
+	ErrorCode int `json:"errorCode"`
+}
+
+// UnmarshalCDP reads TargetCrashedEvent from JSON
+func (v *TargetCrashedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetId":
+			v.TargetId = TargetID(d.String())
+		case "status":
+			v.Status = d.String()
+		case "errorCode":
+			v.ErrorCode = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TargetCrashedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TargetCrashedHandler func(ev TargetCrashedEvent)
+
+// EventMethod is Target.targetCrashed
+func (TargetCrashedEvent) EventMethod() string {
+	return "Target.targetCrashed"
+}
+
+// OnTargetCrashed calls handler for each Target.targetCrashed event
+func (c Client) OnTargetCrashed(handler TargetCrashedHandler) (unsubscribe func()) {
+	return c.caller.On("Target.targetCrashed", func(ev interface{}) {
+		handler(ev.(TargetCrashedEvent))
+	})
+}
+
+// Issued when some information about a target has changed. This only happens between
+// `targetCreated` and `targetDestroyed`.
+type TargetInfoChangedEvent struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// UnmarshalCDP reads TargetInfoChangedEvent from JSON
+func (v *TargetInfoChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetInfo":
+			v.TargetInfo.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TargetInfoChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TargetInfoChangedHandler func(ev TargetInfoChangedEvent)
+
+// EventMethod is Target.targetInfoChanged
+func (TargetInfoChangedEvent) EventMethod() string {
+	return "Target.targetInfoChanged"
+}
+
+// OnTargetInfoChanged calls handler for each Target.targetInfoChanged event
+func (c Client) OnTargetInfoChanged(handler TargetInfoChangedHandler) (unsubscribe func()) {
+	return c.caller.On("Target.targetInfoChanged", func(ev interface{}) {
+		handler(ev.(TargetInfoChangedEvent))
+	})
+}
+
+// decoders for Target events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Target.receivedMessageFromTarget": cdp.Decode[ReceivedMessageFromTargetEvent],
+	"Target.targetCreated":             cdp.Decode[TargetCreatedEvent],
+	"Target.targetDestroyed":           cdp.Decode[TargetDestroyedEvent],
+	"Target.targetCrashed":             cdp.Decode[TargetCrashedEvent],
+	"Target.targetInfoChanged":         cdp.Decode[TargetInfoChangedEvent],
+}
+
+// DecodeEvent decodes a Target event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -0,0 +1,523 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package target
+
+import (
+	"context"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type AttachToBrowserTargetReturns struct {
+
+	/* Id assigned to the session. */
+	SessionId SessionID `json:"sessionId"`
+}
+
+// UnmarshalCDP reads AttachToBrowserTargetReturns from JSON
+func (v *AttachToBrowserTargetReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sessionId":
+			v.SessionId = SessionID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttachToBrowserTargetReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Attaches to the browser target, only uses flat sessionId mode.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) AttachToBrowserTarget() (AttachToBrowserTargetReturns, error) {
+	return c.AttachToBrowserTargetContext(context.Background())
+}
+
+// AttachToBrowserTargetContext is AttachToBrowserTarget with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) AttachToBrowserTargetContext(ctx context.Context) (AttachToBrowserTargetReturns, error) {
+	var returns_ AttachToBrowserTargetReturns
+
+	err_ := c.caller.Call(ctx, "Target.attachToBrowserTarget", nil, &returns_)
+
+	return returns_, err_
+}
+
+type ExposeDevToolsProtocolReturns struct {
+}
+
+// UnmarshalCDP reads ExposeDevToolsProtocolReturns from JSON
+func (v *ExposeDevToolsProtocolReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ExposeDevToolsProtocolReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ExposeDevToolsProtocolParams are the parameters for Target.exposeDevToolsProtocol
+// optional parameters are left out when nil
+type ExposeDevToolsProtocolParams struct {
+	TargetId TargetID `json:"targetId"`
+	/* Binding name, 'cdp' if not specified. */
+	BindingName *string `json:"bindingName,omitempty"`
+	/* If true, inherits the current root session's permissions (default: false). */
+	InheritPermissions *bool `json:"inheritPermissions,omitempty"`
+}
+
+// MarshalCDP writes ExposeDevToolsProtocolParams as JSON
+func (v *ExposeDevToolsProtocolParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	if v.BindingName != nil {
+		e.Key("bindingName")
+		e.String(*v.BindingName)
+	}
+	if v.InheritPermissions != nil {
+		e.Key("inheritPermissions")
+		e.Bool(*v.InheritPermissions)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ExposeDevToolsProtocolParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Inject object to the target's main frame that provides a communication
+// channel with browser target.
+//
+// Injected object will be available as `window[bindingName]`.
+//
+// The object has the following API:
+// - `binding.send(json)` - a method to send messages over the remote debugging protocol
+// - `binding.onmessage = json => handleMessage(json)` - a callback that will be called for the protocol notifications and command responses.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ExposeDevToolsProtocol(params ExposeDevToolsProtocolParams) (ExposeDevToolsProtocolReturns, error) {
+	return c.ExposeDevToolsProtocolContext(context.Background(), params)
+}
+
+// ExposeDevToolsProtocolContext is ExposeDevToolsProtocol with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) ExposeDevToolsProtocolContext(ctx context.Context, params ExposeDevToolsProtocolParams) (ExposeDevToolsProtocolReturns, error) {
+	var returns_ ExposeDevToolsProtocolReturns
+
+	err_ := c.caller.Call(ctx, "Target.exposeDevToolsProtocol", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetTargetInfoReturns struct {
+	TargetInfo TargetInfo `json:"targetInfo"`
+}
+
+// UnmarshalCDP reads GetTargetInfoReturns from JSON
+func (v *GetTargetInfoReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetInfo":
+			v.TargetInfo.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetTargetInfoReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetTargetInfoParams are the parameters for Target.getTargetInfo
+// optional parameters are left out when nil
+type GetTargetInfoParams struct {
+	TargetId *TargetID `json:"targetId,omitempty"`
+}
+
+// MarshalCDP writes GetTargetInfoParams as JSON
+func (v *GetTargetInfoParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.TargetId != nil {
+		e.Key("targetId")
+		e.String(string(*v.TargetId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetTargetInfoParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns information about a target.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetTargetInfo(params GetTargetInfoParams) (GetTargetInfoReturns, error) {
+	return c.GetTargetInfoContext(context.Background(), params)
+}
+
+// GetTargetInfoContext is GetTargetInfo with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetTargetInfoContext(ctx context.Context, params GetTargetInfoParams) (GetTargetInfoReturns, error) {
+	var returns_ GetTargetInfoReturns
+
+	err_ := c.caller.Call(ctx, "Target.getTargetInfo", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AutoAttachRelatedReturns struct {
+}
+
+// UnmarshalCDP reads AutoAttachRelatedReturns from JSON
+func (v *AutoAttachRelatedReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AutoAttachRelatedReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AutoAttachRelatedParams are the parameters for Target.autoAttachRelated
+// optional parameters are left out when nil
+type AutoAttachRelatedParams struct {
+	TargetId TargetID `json:"targetId"`
+	/* Whether to pause new targets when attaching to them. Use `Runtime.runIfWaitingForDebugger`
+	to run paused targets. */
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+	/* Only targets matching filter will be attached.
+
+	Experimental: this may change or be removed in any chrome release */
+	Filter TargetFilter `json:"filter"`
+}
+
+// MarshalCDP writes AutoAttachRelatedParams as JSON
+func (v *AutoAttachRelatedParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	e.Key("waitForDebuggerOnStart")
+	e.Bool(v.WaitForDebuggerOnStart)
+	if v.Filter != nil {
+		e.Key("filter")
+		e.ArrayStart()
+		for i0 := range v.Filter {
+			e.Elem()
+			v.Filter[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AutoAttachRelatedParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Adds the specified target to the list of targets that will be monitored for any related target
+// creation (such as child frames, child workers and new versions of service worker) and reported
+// through `attachedToTarget`. The specified target is also auto-attached.
+// This cancels the effect of any previous `setAutoAttach` and is also cancelled by subsequent
+// `setAutoAttach`. Only available at the Browser target.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) AutoAttachRelated(params AutoAttachRelatedParams) (AutoAttachRelatedReturns, error) {
+	return c.AutoAttachRelatedContext(context.Background(), params)
+}
+
+// AutoAttachRelatedContext is AutoAttachRelated with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) AutoAttachRelatedContext(ctx context.Context, params AutoAttachRelatedParams) (AutoAttachRelatedReturns, error) {
+	var returns_ AutoAttachRelatedReturns
+
+	err_ := c.caller.Call(ctx, "Target.autoAttachRelated", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetRemoteLocationsReturns struct {
+}
+
+// UnmarshalCDP reads SetRemoteLocationsReturns from JSON
+func (v *SetRemoteLocationsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetRemoteLocationsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetRemoteLocationsParams are the parameters for Target.setRemoteLocations
+// optional parameters are left out when nil
+type SetRemoteLocationsParams struct {
+	/* List of remote locations. */
+	Locations []RemoteLocation `json:"locations"`
+}
+
+// MarshalCDP writes SetRemoteLocationsParams as JSON
+func (v *SetRemoteLocationsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("locations")
+	if v.Locations == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Locations {
+			e.Elem()
+			v.Locations[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetRemoteLocationsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enables target discovery for the specified locations, when `setDiscoverTargets` was set to
+// `true`.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetRemoteLocations(params SetRemoteLocationsParams) (SetRemoteLocationsReturns, error) {
+	return c.SetRemoteLocationsContext(context.Background(), params)
+}
+
+// SetRemoteLocationsContext is SetRemoteLocations with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) SetRemoteLocationsContext(ctx context.Context, params SetRemoteLocationsParams) (SetRemoteLocationsReturns, error) {
+	var returns_ SetRemoteLocationsReturns
+
+	err_ := c.caller.Call(ctx, "Target.setRemoteLocations", &params, &returns_)
+
+	return returns_, err_
+}
+
+type OpenDevToolsReturns struct {
+
+	/* The targetId of DevTools page target. */
+	TargetId TargetID `json:"targetId"`
+}
+
+// UnmarshalCDP reads OpenDevToolsReturns from JSON
+func (v *OpenDevToolsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "targetId":
+			v.TargetId = TargetID(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *OpenDevToolsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// OpenDevToolsParams are the parameters for Target.openDevTools
+// optional parameters are left out when nil
+type OpenDevToolsParams struct {
+	/* This can be the page or tab target ID. */
+	TargetId TargetID `json:"targetId"`
+}
+
+// MarshalCDP writes OpenDevToolsParams as JSON
+func (v *OpenDevToolsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("targetId")
+	e.String(string(v.TargetId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v OpenDevToolsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Opens a DevTools window for the target.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) OpenDevTools(params OpenDevToolsParams) (OpenDevToolsReturns, error) {
+	return c.OpenDevToolsContext(context.Background(), params)
+}
+
+// OpenDevToolsContext is OpenDevTools with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) OpenDevToolsContext(ctx context.Context, params OpenDevToolsParams) (OpenDevToolsReturns, error) {
+	var returns_ OpenDevToolsReturns
+
+	err_ := c.caller.Call(ctx, "Target.openDevTools", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Issued when attached to target because of auto-attach or `attachToTarget` command.
+//
+// Experimental: this may change or be removed in any chrome release
+type AttachedToTargetEvent struct {
+
+	/* Identifier assigned to the session used to send/receive messages. */
+	SessionId SessionID `json:"sessionId"`
+
+	TargetInfo TargetInfo `json:"targetInfo"`
+
+	WaitingForDebugger bool `json:"waitingForDebugger"`
+}
+
+// UnmarshalCDP reads AttachedToTargetEvent from JSON
+func (v *AttachedToTargetEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sessionId":
+			v.SessionId = SessionID(d.String())
+		case "targetInfo":
+			v.TargetInfo.UnmarshalCDP(d)
+		case "waitingForDebugger":
+			v.WaitingForDebugger = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AttachedToTargetEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AttachedToTargetHandler func(ev AttachedToTargetEvent)
+
+// EventMethod is Target.attachedToTarget
+func (AttachedToTargetEvent) EventMethod() string {
+	return "Target.attachedToTarget"
+}
+
+// OnAttachedToTarget calls handler for each Target.attachedToTarget event
+func (c Client) OnAttachedToTarget(handler AttachedToTargetHandler) (unsubscribe func()) {
+	return c.caller.On("Target.attachedToTarget", func(ev interface{}) {
+		handler(ev.(AttachedToTargetEvent))
+	})
+}
+
+// Issued when detached from target for any reason (including `detachFromTarget` command). Can be
+// issued multiple times per target if multiple sessions have been attached to it.
+//
+// Experimental: this may change or be removed in any chrome release
+type DetachedFromTargetEvent struct {
+
+	/* Detached session identifier. */
+	SessionId SessionID `json:"sessionId"`
+
+	/* Deprecated.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	TargetId *TargetID `json:"targetId,omitempty"`
+}
+
+// UnmarshalCDP reads DetachedFromTargetEvent from JSON
+func (v *DetachedFromTargetEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "sessionId":
+			v.SessionId = SessionID(d.String())
+		case "targetId":
+			if d.Null() {
+				v.TargetId = nil
+			} else {
+				v.TargetId = new(TargetID)
+				*v.TargetId = TargetID(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DetachedFromTargetEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DetachedFromTargetHandler func(ev DetachedFromTargetEvent)
+
+// EventMethod is Target.detachedFromTarget
+func (DetachedFromTargetEvent) EventMethod() string {
+	return "Target.detachedFromTarget"
+}
+
+// OnDetachedFromTarget calls handler for each Target.detachedFromTarget event
+func (c Client) OnDetachedFromTarget(handler DetachedFromTargetHandler) (unsubscribe func()) {
+	return c.caller.On("Target.detachedFromTarget", func(ev interface{}) {
+		handler(ev.(DetachedFromTargetEvent))
+	})
+}
+
+func init() {
+	decoders["Target.attachedToTarget"] = cdp.Decode[AttachedToTargetEvent]
+	decoders["Target.detachedFromTarget"] = cdp.Decode[DetachedFromTargetEvent]
+}
@@ -0,0 +1,201 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package tethering is the Tethering domain of the chrome devtools protocol
+//
+// The Tethering domain defines methods and events for browser port binding.
+//
+// Experimental: this may change or be removed in any chrome release
+package tethering
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Tethering commands and listens for Tethering events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type BindReturns struct {
+}
+
+// UnmarshalCDP reads BindReturns from JSON
+func (v *BindReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BindReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// BindParams are the parameters for Tethering.bind
+// optional parameters are left out when nil
+type BindParams struct {
+	/* Port number to bind. */
+	Port int `json:"port"`
+}
+
+// MarshalCDP writes BindParams as JSON
+func (v *BindParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("port")
+	e.Int(v.Port)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BindParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Request browser port binding.
+func (c Client) Bind(params BindParams) (BindReturns, error) {
+	return c.BindContext(context.Background(), params)
+}
+
+// BindContext is Bind with a context for cancellation and deadlines
+func (c Client) BindContext(ctx context.Context, params BindParams) (BindReturns, error) {
+	var returns_ BindReturns
+
+	err_ := c.caller.Call(ctx, "Tethering.bind", &params, &returns_)
+
+	return returns_, err_
+}
+
+type UnbindReturns struct {
+}
+
+// UnmarshalCDP reads UnbindReturns from JSON
+func (v *UnbindReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *UnbindReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// UnbindParams are the parameters for Tethering.unbind
+// optional parameters are left out when nil
+type UnbindParams struct {
+	/* Port number to unbind. */
+	Port int `json:"port"`
+}
+
+// MarshalCDP writes UnbindParams as JSON
+func (v *UnbindParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("port")
+	e.Int(v.Port)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v UnbindParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Request browser port unbinding.
+func (c Client) Unbind(params UnbindParams) (UnbindReturns, error) {
+	return c.UnbindContext(context.Background(), params)
+}
+
+// UnbindContext is Unbind with a context for cancellation and deadlines
+func (c Client) UnbindContext(ctx context.Context, params UnbindParams) (UnbindReturns, error) {
+	var returns_ UnbindReturns
+
+	err_ := c.caller.Call(ctx, "Tethering.unbind", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Informs that port was successfully bound and got a specified connection id.
+type AcceptedEvent struct {
+
+	/* Port number that was successfully bound. */
+	Port int `json:"port"`
+
+	/* Connection id to be used. */
+	ConnectionId string `json:"connectionId"`
+}
+
+// UnmarshalCDP reads AcceptedEvent from JSON
+func (v *AcceptedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "port":
+			v.Port = d.Int()
+		case "connectionId":
+			v.ConnectionId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AcceptedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AcceptedHandler func(ev AcceptedEvent)
+
+// EventMethod is Tethering.accepted
+func (AcceptedEvent) EventMethod() string {
+	return "Tethering.accepted"
+}
+
+// OnAccepted calls handler for each Tethering.accepted event
+func (c Client) OnAccepted(handler AcceptedHandler) (unsubscribe func()) {
+	return c.caller.On("Tethering.accepted", func(ev interface{}) {
+		handler(ev.(AcceptedEvent))
+	})
+}
+
+// decoders for Tethering events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Tethering.accepted": cdp.Decode[AcceptedEvent],
+}
+
+// DecodeEvent decodes a Tethering event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
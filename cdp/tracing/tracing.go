@@ -0,0 +1,657 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+// Package tracing is the Tracing domain of the chrome devtools protocol
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends Tracing commands and listens for Tracing events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// Configuration for memory dump. Used only when "memory-infra" category is enabled.
+//
+// Experimental: this may change or be removed in any chrome release
+type MemoryDumpConfig map[string]interface{}
+
+type TraceConfig struct {
+	/* Controls how the trace buffer stores data. The default is `recordUntilFull`.
+
+	Experimental: this may change or be removed in any chrome release */
+	RecordMode *TraceConfigRecordMode `json:"recordMode,omitempty"`
+	/* Size of the trace buffer in kilobytes. If not specified or zero is passed, a default value
+	of 200 MB would be used.
+
+	Experimental: this may change or be removed in any chrome release */
+	TraceBufferSizeInKb *float64 `json:"traceBufferSizeInKb,omitempty"`
+	/* Turns on JavaScript stack sampling.
+
+	Experimental: this may change or be removed in any chrome release */
+	EnableSampling *bool `json:"enableSampling,omitempty"`
+	/* Turns on system tracing.
+
+	Experimental: this may change or be removed in any chrome release */
+	EnableSystrace *bool `json:"enableSystrace,omitempty"`
+	/* Turns on argument filter.
+
+	Experimental: this may change or be removed in any chrome release */
+	EnableArgumentFilter *bool `json:"enableArgumentFilter,omitempty"`
+	/* Included category filters. */
+	IncludedCategories []string `json:"includedCategories"`
+	/* Excluded category filters. */
+	ExcludedCategories []string `json:"excludedCategories"`
+	/* Configuration to synthesize the delays in tracing.
+
+	Experimental: this may change or be removed in any chrome release */
+	SyntheticDelays []string `json:"syntheticDelays"`
+	/* Configuration for memory dump triggers. Used only when "memory-infra" category is enabled.
+
+	Experimental: this may change or be removed in any chrome release */
+	MemoryDumpConfig MemoryDumpConfig `json:"memoryDumpConfig"`
+}
+
+// MarshalCDP writes TraceConfig as JSON
+func (v *TraceConfig) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.RecordMode != nil {
+		e.Key("recordMode")
+		e.String(string(*v.RecordMode))
+	}
+	if v.TraceBufferSizeInKb != nil {
+		e.Key("traceBufferSizeInKb")
+		e.Float(*v.TraceBufferSizeInKb)
+	}
+	if v.EnableSampling != nil {
+		e.Key("enableSampling")
+		e.Bool(*v.EnableSampling)
+	}
+	if v.EnableSystrace != nil {
+		e.Key("enableSystrace")
+		e.Bool(*v.EnableSystrace)
+	}
+	if v.EnableArgumentFilter != nil {
+		e.Key("enableArgumentFilter")
+		e.Bool(*v.EnableArgumentFilter)
+	}
+	if v.IncludedCategories != nil {
+		e.Key("includedCategories")
+		e.ArrayStart()
+		for i0 := range v.IncludedCategories {
+			e.Elem()
+			e.String(v.IncludedCategories[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.ExcludedCategories != nil {
+		e.Key("excludedCategories")
+		e.ArrayStart()
+		for i0 := range v.ExcludedCategories {
+			e.Elem()
+			e.String(v.ExcludedCategories[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.SyntheticDelays != nil {
+		e.Key("syntheticDelays")
+		e.ArrayStart()
+		for i0 := range v.SyntheticDelays {
+			e.Elem()
+			e.String(v.SyntheticDelays[i0])
+		}
+		e.ArrayEnd()
+	}
+	if v.MemoryDumpConfig != nil {
+		e.Key("memoryDumpConfig")
+		e.Map(map[string]interface{}(v.MemoryDumpConfig))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v TraceConfig) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads TraceConfig from JSON
+func (v *TraceConfig) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "recordMode":
+			if d.Null() {
+				v.RecordMode = nil
+			} else {
+				v.RecordMode = new(TraceConfigRecordMode)
+				*v.RecordMode = TraceConfigRecordMode(d.String())
+			}
+		case "traceBufferSizeInKb":
+			if d.Null() {
+				v.TraceBufferSizeInKb = nil
+			} else {
+				v.TraceBufferSizeInKb = new(float64)
+				*v.TraceBufferSizeInKb = d.Float()
+			}
+		case "enableSampling":
+			if d.Null() {
+				v.EnableSampling = nil
+			} else {
+				v.EnableSampling = new(bool)
+				*v.EnableSampling = d.Bool()
+			}
+		case "enableSystrace":
+			if d.Null() {
+				v.EnableSystrace = nil
+			} else {
+				v.EnableSystrace = new(bool)
+				*v.EnableSystrace = d.Bool()
+			}
+		case "enableArgumentFilter":
+			if d.Null() {
+				v.EnableArgumentFilter = nil
+			} else {
+				v.EnableArgumentFilter = new(bool)
+				*v.EnableArgumentFilter = d.Bool()
+			}
+		case "includedCategories":
+			if d.Array() {
+				v.IncludedCategories = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.IncludedCategories = append(v.IncludedCategories, x0)
+				}
+			} else {
+				v.IncludedCategories = nil
+			}
+		case "excludedCategories":
+			if d.Array() {
+				v.ExcludedCategories = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.ExcludedCategories = append(v.ExcludedCategories, x0)
+				}
+			} else {
+				v.ExcludedCategories = nil
+			}
+		case "syntheticDelays":
+			if d.Array() {
+				v.SyntheticDelays = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.SyntheticDelays = append(v.SyntheticDelays, x0)
+				}
+			} else {
+				v.SyntheticDelays = nil
+			}
+		case "memoryDumpConfig":
+			v.MemoryDumpConfig = MemoryDumpConfig(d.Map())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TraceConfig) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TraceConfigRecordMode string
+
+// TraceConfigRecordMode values
+const (
+	TraceConfigRecordModeRecordUntilFull        TraceConfigRecordMode = "recordUntilFull"
+	TraceConfigRecordModeRecordContinuously     TraceConfigRecordMode = "recordContinuously"
+	TraceConfigRecordModeRecordAsMuchAsPossible TraceConfigRecordMode = "recordAsMuchAsPossible"
+	TraceConfigRecordModeEchoToConsole          TraceConfigRecordMode = "echoToConsole"
+)
+
+// Values gives every TraceConfigRecordMode
+func (TraceConfigRecordMode) Values() []TraceConfigRecordMode {
+	return []TraceConfigRecordMode{
+		TraceConfigRecordModeRecordUntilFull,
+		TraceConfigRecordModeRecordContinuously,
+		TraceConfigRecordModeRecordAsMuchAsPossible,
+		TraceConfigRecordModeEchoToConsole,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v TraceConfigRecordMode) Valid() bool {
+	switch v {
+	case TraceConfigRecordModeRecordUntilFull, TraceConfigRecordModeRecordContinuously, TraceConfigRecordModeRecordAsMuchAsPossible, TraceConfigRecordModeEchoToConsole:
+		return true
+	}
+	return false
+}
+
+// Data format of a trace. Can be either the legacy JSON format or the
+// protocol buffer format. Note that the JSON format will be deprecated soon.
+//
+// Experimental: this may change or be removed in any chrome release
+type StreamFormat string
+
+// StreamFormat values
+const (
+	StreamFormatJson  StreamFormat = "json"
+	StreamFormatProto StreamFormat = "proto"
+)
+
+// Values gives every StreamFormat
+func (StreamFormat) Values() []StreamFormat {
+	return []StreamFormat{
+		StreamFormatJson,
+		StreamFormatProto,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StreamFormat) Valid() bool {
+	switch v {
+	case StreamFormatJson, StreamFormatProto:
+		return true
+	}
+	return false
+}
+
+// Compression type to use for traces returned via streams.
+//
+// Experimental: this may change or be removed in any chrome release
+type StreamCompression string
+
+// StreamCompression values
+const (
+	StreamCompressionNone StreamCompression = "none"
+	StreamCompressionGzip StreamCompression = "gzip"
+)
+
+// Values gives every StreamCompression
+func (StreamCompression) Values() []StreamCompression {
+	return []StreamCompression{
+		StreamCompressionNone,
+		StreamCompressionGzip,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StreamCompression) Valid() bool {
+	switch v {
+	case StreamCompressionNone, StreamCompressionGzip:
+		return true
+	}
+	return false
+}
+
+// Details exposed when memory request explicitly declared.
+// Keep consistent with memory_dump_request_args.h and
+// memory_instrumentation.mojom
+//
+// Experimental: this may change or be removed in any chrome release
+type MemoryDumpLevelOfDetail string
+
+// MemoryDumpLevelOfDetail values
+const (
+	MemoryDumpLevelOfDetailBackground MemoryDumpLevelOfDetail = "background"
+	MemoryDumpLevelOfDetailLight      MemoryDumpLevelOfDetail = "light"
+	MemoryDumpLevelOfDetailDetailed   MemoryDumpLevelOfDetail = "detailed"
+)
+
+// Values gives every MemoryDumpLevelOfDetail
+func (MemoryDumpLevelOfDetail) Values() []MemoryDumpLevelOfDetail {
+	return []MemoryDumpLevelOfDetail{
+		MemoryDumpLevelOfDetailBackground,
+		MemoryDumpLevelOfDetailLight,
+		MemoryDumpLevelOfDetailDetailed,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v MemoryDumpLevelOfDetail) Valid() bool {
+	switch v {
+	case MemoryDumpLevelOfDetailBackground, MemoryDumpLevelOfDetailLight, MemoryDumpLevelOfDetailDetailed:
+		return true
+	}
+	return false
+}
+
+// Backend type to use for tracing. `chrome` uses the Chrome-integrated
+// tracing service and is supported on all platforms. `system` is only
+// supported on Chrome OS and uses the Perfetto system tracing service.
+// `auto` chooses `system` when the perfettoConfig provided to Tracing.start
+// specifies at least one non-Chrome data source; otherwise uses `chrome`.
+//
+// Experimental: this may change or be removed in any chrome release
+type TracingBackend string
+
+// TracingBackend values
+const (
+	TracingBackendAuto   TracingBackend = "auto"
+	TracingBackendChrome TracingBackend = "chrome"
+	TracingBackendSystem TracingBackend = "system"
+)
+
+// Values gives every TracingBackend
+func (TracingBackend) Values() []TracingBackend {
+	return []TracingBackend{
+		TracingBackendAuto,
+		TracingBackendChrome,
+		TracingBackendSystem,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v TracingBackend) Valid() bool {
+	switch v {
+	case TracingBackendAuto, TracingBackendChrome, TracingBackendSystem:
+		return true
+	}
+	return false
+}
+
+type StartTransferMode string
+
+// StartTransferMode values
+const (
+	StartTransferModeReportEvents   StartTransferMode = "ReportEvents"
+	StartTransferModeReturnAsStream StartTransferMode = "ReturnAsStream"
+)
+
+// Values gives every StartTransferMode
+func (StartTransferMode) Values() []StartTransferMode {
+	return []StartTransferMode{
+		StartTransferModeReportEvents,
+		StartTransferModeReturnAsStream,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v StartTransferMode) Valid() bool {
+	switch v {
+	case StartTransferModeReportEvents, StartTransferModeReturnAsStream:
+		return true
+	}
+	return false
+}
+
+type EndReturns struct {
+}
+
+// UnmarshalCDP reads EndReturns from JSON
+func (v *EndReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EndReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Stop trace events collection.
+func (c Client) End() (EndReturns, error) {
+	return c.EndContext(context.Background())
+}
+
+// EndContext is End with a context for cancellation and deadlines
+func (c Client) EndContext(ctx context.Context) (EndReturns, error) {
+	var returns_ EndReturns
+
+	err_ := c.caller.Call(ctx, "Tracing.end", nil, &returns_)
+
+	return returns_, err_
+}
+
+type StartReturns struct {
+}
+
+// UnmarshalCDP reads StartReturns from JSON
+func (v *StartReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StartReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// StartParams are the parameters for Tracing.start
+// optional parameters are left out when nil
+type StartParams struct {
+	/* Category/tag filter
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Categories *string `json:"categories,omitempty"`
+	/* Tracing options
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	Options *string `json:"options,omitempty"`
+	/* If set, the agent will issue bufferUsage events at this interval, specified in milliseconds
+
+	Experimental: this may change or be removed in any chrome release */
+	BufferUsageReportingInterval *float64 `json:"bufferUsageReportingInterval,omitempty"`
+	/* Whether to report trace events as series of dataCollected events or to save trace to a
+	stream (defaults to `ReportEvents`). */
+	TransferMode *StartTransferMode `json:"transferMode,omitempty"`
+	/* Trace data format to use. This only applies when using `ReturnAsStream`
+	transfer mode (defaults to `json`). */
+	StreamFormat *StreamFormat `json:"streamFormat,omitempty"`
+	/* Compression format to use. This only applies when using `ReturnAsStream`
+	transfer mode (defaults to `none`)
+
+	Experimental: this may change or be removed in any chrome release */
+	StreamCompression *StreamCompression `json:"streamCompression,omitempty"`
+	TraceConfig       *TraceConfig       `json:"traceConfig,omitempty"`
+	/* Base64-encoded serialized perfetto.protos.TraceConfig protobuf message
+	When specified, the parameters `categories`, `options`, `traceConfig`
+	are ignored. (Encoded as a base64 string when passed over JSON)
+
+	Experimental: this may change or be removed in any chrome release */
+	PerfettoConfig *string `json:"perfettoConfig,omitempty"`
+	/* Backend type (defaults to `auto`)
+
+	Experimental: this may change or be removed in any chrome release */
+	TracingBackend *TracingBackend `json:"tracingBackend,omitempty"`
+}
+
+// MarshalCDP writes StartParams as JSON
+func (v *StartParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Categories != nil {
+		e.Key("categories")
+		e.String(*v.Categories)
+	}
+	if v.Options != nil {
+		e.Key("options")
+		e.String(*v.Options)
+	}
+	if v.BufferUsageReportingInterval != nil {
+		e.Key("bufferUsageReportingInterval")
+		e.Float(*v.BufferUsageReportingInterval)
+	}
+	if v.TransferMode != nil {
+		e.Key("transferMode")
+		e.String(string(*v.TransferMode))
+	}
+	if v.StreamFormat != nil {
+		e.Key("streamFormat")
+		e.String(string(*v.StreamFormat))
+	}
+	if v.StreamCompression != nil {
+		e.Key("streamCompression")
+		e.String(string(*v.StreamCompression))
+	}
+	if v.TraceConfig != nil {
+		e.Key("traceConfig")
+		(*v.TraceConfig).MarshalCDP(e)
+	}
+	if v.PerfettoConfig != nil {
+		e.Key("perfettoConfig")
+		e.String(*v.PerfettoConfig)
+	}
+	if v.TracingBackend != nil {
+		e.Key("tracingBackend")
+		e.String(string(*v.TracingBackend))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StartParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Start trace events collection.
+func (c Client) Start(params StartParams) (StartReturns, error) {
+	return c.StartContext(context.Background(), params)
+}
+
+// StartContext is Start with a context for cancellation and deadlines
+func (c Client) StartContext(ctx context.Context, params StartParams) (StartReturns, error) {
+	var returns_ StartReturns
+
+	if params.TransferMode != nil && !params.TransferMode.Valid() {
+		return returns_, fmt.Errorf("Tracing.start: invalid transferMode %q", *params.TransferMode)
+	}
+
+	if params.StreamFormat != nil && !params.StreamFormat.Valid() {
+		return returns_, fmt.Errorf("Tracing.start: invalid streamFormat %q", *params.StreamFormat)
+	}
+
+	if params.StreamCompression != nil && !params.StreamCompression.Valid() {
+		return returns_, fmt.Errorf("Tracing.start: invalid streamCompression %q", *params.StreamCompression)
+	}
+
+	if params.TracingBackend != nil && !params.TracingBackend.Valid() {
+		return returns_, fmt.Errorf("Tracing.start: invalid tracingBackend %q", *params.TracingBackend)
+	}
+
+	err_ := c.caller.Call(ctx, "Tracing.start", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Signals that tracing is stopped and there is no trace buffers pending flush, all data were
+// delivered via dataCollected events.
+type TracingCompleteEvent struct {
+
+	/* Indicates whether some trace data is known to have been lost, e.g. because the trace ring
+	buffer wrapped around. */
+	DataLossOccurred bool `json:"dataLossOccurred"`
+
+	/* A handle of the stream that holds resulting trace data. */
+	Stream *cdp.IOStreamHandle `json:"stream,omitempty"`
+
+	/* Trace data format of returned stream. */
+	TraceFormat *StreamFormat `json:"traceFormat,omitempty"`
+
+	/* Compression format of returned stream. */
+	StreamCompression *StreamCompression `json:"streamCompression,omitempty"`
+}
+
+// UnmarshalCDP reads TracingCompleteEvent from JSON
+func (v *TracingCompleteEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "dataLossOccurred":
+			v.DataLossOccurred = d.Bool()
+		case "stream":
+			if d.Null() {
+				v.Stream = nil
+			} else {
+				v.Stream = new(cdp.IOStreamHandle)
+				*v.Stream = cdp.IOStreamHandle(d.String())
+			}
+		case "traceFormat":
+			if d.Null() {
+				v.TraceFormat = nil
+			} else {
+				v.TraceFormat = new(StreamFormat)
+				*v.TraceFormat = StreamFormat(d.String())
+			}
+		case "streamCompression":
+			if d.Null() {
+				v.StreamCompression = nil
+			} else {
+				v.StreamCompression = new(StreamCompression)
+				*v.StreamCompression = StreamCompression(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *TracingCompleteEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type TracingCompleteHandler func(ev TracingCompleteEvent)
+
+// EventMethod is Tracing.tracingComplete
+func (TracingCompleteEvent) EventMethod() string {
+	return "Tracing.tracingComplete"
+}
+
+// OnTracingComplete calls handler for each Tracing.tracingComplete event
+func (c Client) OnTracingComplete(handler TracingCompleteHandler) (unsubscribe func()) {
+	return c.caller.On("Tracing.tracingComplete", func(ev interface{}) {
+		handler(ev.(TracingCompleteEvent))
+	})
+}
+
+// decoders for Tracing events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"Tracing.tracingComplete": cdp.Decode[TracingCompleteEvent],
+}
+
+// DecodeEvent decodes a Tracing event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
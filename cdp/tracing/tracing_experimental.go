@@ -0,0 +1,332 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+type GetCategoriesReturns struct {
+
+	/* A list of supported tracing categories. */
+	Categories []string `json:"categories"`
+}
+
+// UnmarshalCDP reads GetCategoriesReturns from JSON
+func (v *GetCategoriesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "categories":
+			if d.Array() {
+				v.Categories = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Categories = append(v.Categories, x0)
+				}
+			} else {
+				v.Categories = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCategoriesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Gets supported tracing categories.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetCategories() (GetCategoriesReturns, error) {
+	return c.GetCategoriesContext(context.Background())
+}
+
+// GetCategoriesContext is GetCategories with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) GetCategoriesContext(ctx context.Context) (GetCategoriesReturns, error) {
+	var returns_ GetCategoriesReturns
+
+	err_ := c.caller.Call(ctx, "Tracing.getCategories", nil, &returns_)
+
+	return returns_, err_
+}
+
+type RecordClockSyncMarkerReturns struct {
+}
+
+// UnmarshalCDP reads RecordClockSyncMarkerReturns from JSON
+func (v *RecordClockSyncMarkerReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RecordClockSyncMarkerReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RecordClockSyncMarkerParams are the parameters for Tracing.recordClockSyncMarker
+// optional parameters are left out when nil
+type RecordClockSyncMarkerParams struct {
+	/* The ID of this clock sync marker */
+	SyncId string `json:"syncId"`
+}
+
+// MarshalCDP writes RecordClockSyncMarkerParams as JSON
+func (v *RecordClockSyncMarkerParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("syncId")
+	e.String(v.SyncId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RecordClockSyncMarkerParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Record a clock sync marker in the trace.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RecordClockSyncMarker(params RecordClockSyncMarkerParams) (RecordClockSyncMarkerReturns, error) {
+	return c.RecordClockSyncMarkerContext(context.Background(), params)
+}
+
+// RecordClockSyncMarkerContext is RecordClockSyncMarker with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RecordClockSyncMarkerContext(ctx context.Context, params RecordClockSyncMarkerParams) (RecordClockSyncMarkerReturns, error) {
+	var returns_ RecordClockSyncMarkerReturns
+
+	err_ := c.caller.Call(ctx, "Tracing.recordClockSyncMarker", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RequestMemoryDumpReturns struct {
+
+	/* GUID of the resulting global memory dump. */
+	DumpGuid string `json:"dumpGuid"`
+
+	/* True iff the global memory dump succeeded. */
+	Success bool `json:"success"`
+}
+
+// UnmarshalCDP reads RequestMemoryDumpReturns from JSON
+func (v *RequestMemoryDumpReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "dumpGuid":
+			v.DumpGuid = d.String()
+		case "success":
+			v.Success = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RequestMemoryDumpReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RequestMemoryDumpParams are the parameters for Tracing.requestMemoryDump
+// optional parameters are left out when nil
+type RequestMemoryDumpParams struct {
+	/* Enables more deterministic results by forcing garbage collection */
+	Deterministic *bool `json:"deterministic,omitempty"`
+	/* Specifies level of details in memory dump. Defaults to "detailed". */
+	LevelOfDetail *MemoryDumpLevelOfDetail `json:"levelOfDetail,omitempty"`
+}
+
+// MarshalCDP writes RequestMemoryDumpParams as JSON
+func (v *RequestMemoryDumpParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.Deterministic != nil {
+		e.Key("deterministic")
+		e.Bool(*v.Deterministic)
+	}
+	if v.LevelOfDetail != nil {
+		e.Key("levelOfDetail")
+		e.String(string(*v.LevelOfDetail))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RequestMemoryDumpParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Request a global memory dump.
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RequestMemoryDump(params RequestMemoryDumpParams) (RequestMemoryDumpReturns, error) {
+	return c.RequestMemoryDumpContext(context.Background(), params)
+}
+
+// RequestMemoryDumpContext is RequestMemoryDump with a context for cancellation and deadlines
+//
+// Experimental: this may change or be removed in any chrome release
+func (c Client) RequestMemoryDumpContext(ctx context.Context, params RequestMemoryDumpParams) (RequestMemoryDumpReturns, error) {
+	var returns_ RequestMemoryDumpReturns
+
+	if params.LevelOfDetail != nil && !params.LevelOfDetail.Valid() {
+		return returns_, fmt.Errorf("Tracing.requestMemoryDump: invalid levelOfDetail %q", *params.LevelOfDetail)
+	}
+
+	err_ := c.caller.Call(ctx, "Tracing.requestMemoryDump", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Experimental: this may change or be removed in any chrome release
+type BufferUsageEvent struct {
+
+	/* A number in range [0..1] that indicates the used size of event buffer as a fraction of its
+	total size. */
+	PercentFull *float64 `json:"percentFull,omitempty"`
+
+	/* An approximate number of events in the trace log. */
+	EventCount *float64 `json:"eventCount,omitempty"`
+
+	/* A number in range [0..1] that indicates the used size of event buffer as a fraction of its
+	total size. */
+	Value *float64 `json:"value,omitempty"`
+}
+
+// UnmarshalCDP reads BufferUsageEvent from JSON
+func (v *BufferUsageEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "percentFull":
+			if d.Null() {
+				v.PercentFull = nil
+			} else {
+				v.PercentFull = new(float64)
+				*v.PercentFull = d.Float()
+			}
+		case "eventCount":
+			if d.Null() {
+				v.EventCount = nil
+			} else {
+				v.EventCount = new(float64)
+				*v.EventCount = d.Float()
+			}
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(float64)
+				*v.Value = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BufferUsageEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type BufferUsageHandler func(ev BufferUsageEvent)
+
+// EventMethod is Tracing.bufferUsage
+func (BufferUsageEvent) EventMethod() string {
+	return "Tracing.bufferUsage"
+}
+
+// OnBufferUsage calls handler for each Tracing.bufferUsage event
+func (c Client) OnBufferUsage(handler BufferUsageHandler) (unsubscribe func()) {
+	return c.caller.On("Tracing.bufferUsage", func(ev interface{}) {
+		handler(ev.(BufferUsageEvent))
+	})
+}
+
+// Contains a bucket of collected trace events. When tracing is stopped collected events will be
+// sent as a sequence of dataCollected events followed by tracingComplete event.
+//
+// Experimental: this may change or be removed in any chrome release
+type DataCollectedEvent struct {
+	Value []map[string]interface{} `json:"value"`
+}
+
+// UnmarshalCDP reads DataCollectedEvent from JSON
+func (v *DataCollectedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			if d.Array() {
+				v.Value = make([]map[string]interface{}, 0)
+				for d.More() {
+					var x0 map[string]interface{}
+					x0 = d.Map()
+					v.Value = append(v.Value, x0)
+				}
+			} else {
+				v.Value = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DataCollectedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type DataCollectedHandler func(ev DataCollectedEvent)
+
+// EventMethod is Tracing.dataCollected
+func (DataCollectedEvent) EventMethod() string {
+	return "Tracing.dataCollected"
+}
+
+// OnDataCollected calls handler for each Tracing.dataCollected event
+func (c Client) OnDataCollected(handler DataCollectedHandler) (unsubscribe func()) {
+	return c.caller.On("Tracing.dataCollected", func(ev interface{}) {
+		handler(ev.(DataCollectedEvent))
+	})
+}
+
+func init() {
+	decoders["Tracing.bufferUsage"] = cdp.Decode[BufferUsageEvent]
+	decoders["Tracing.dataCollected"] = cdp.Decode[DataCollectedEvent]
+}
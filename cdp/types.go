@@ -0,0 +1,3702 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+package cdp
+
+// Experimental: this may change or be removed in any chrome release
+type BrowserBrowserContextID string
+
+// Unique DOM node identifier.
+type DOMNodeId int
+
+// Unique DOM node identifier used to reference a node that may not have been pushed to the
+// front-end.
+type DOMBackendNodeId int
+
+// Pseudo element type.
+type DOMPseudoType string
+
+// DOMPseudoType values
+const (
+	DOMPseudoTypeFirstLine                   DOMPseudoType = "first-line"
+	DOMPseudoTypeFirstLetter                 DOMPseudoType = "first-letter"
+	DOMPseudoTypeCheckmark                   DOMPseudoType = "checkmark"
+	DOMPseudoTypeBefore                      DOMPseudoType = "before"
+	DOMPseudoTypeAfter                       DOMPseudoType = "after"
+	DOMPseudoTypePickerIcon                  DOMPseudoType = "picker-icon"
+	DOMPseudoTypeMarker                      DOMPseudoType = "marker"
+	DOMPseudoTypeBackdrop                    DOMPseudoType = "backdrop"
+	DOMPseudoTypeColumn                      DOMPseudoType = "column"
+	DOMPseudoTypeSelection                   DOMPseudoType = "selection"
+	DOMPseudoTypeSearchText                  DOMPseudoType = "search-text"
+	DOMPseudoTypeTargetText                  DOMPseudoType = "target-text"
+	DOMPseudoTypeSpellingError               DOMPseudoType = "spelling-error"
+	DOMPseudoTypeGrammarError                DOMPseudoType = "grammar-error"
+	DOMPseudoTypeHighlight                   DOMPseudoType = "highlight"
+	DOMPseudoTypeFirstLineInherited          DOMPseudoType = "first-line-inherited"
+	DOMPseudoTypeScrollMarker                DOMPseudoType = "scroll-marker"
+	DOMPseudoTypeScrollMarkerGroup           DOMPseudoType = "scroll-marker-group"
+	DOMPseudoTypeScrollButton                DOMPseudoType = "scroll-button"
+	DOMPseudoTypeScrollbar                   DOMPseudoType = "scrollbar"
+	DOMPseudoTypeScrollbarThumb              DOMPseudoType = "scrollbar-thumb"
+	DOMPseudoTypeScrollbarButton             DOMPseudoType = "scrollbar-button"
+	DOMPseudoTypeScrollbarTrack              DOMPseudoType = "scrollbar-track"
+	DOMPseudoTypeScrollbarTrackPiece         DOMPseudoType = "scrollbar-track-piece"
+	DOMPseudoTypeScrollbarCorner             DOMPseudoType = "scrollbar-corner"
+	DOMPseudoTypeResizer                     DOMPseudoType = "resizer"
+	DOMPseudoTypeInputListButton             DOMPseudoType = "input-list-button"
+	DOMPseudoTypeViewTransition              DOMPseudoType = "view-transition"
+	DOMPseudoTypeViewTransitionGroup         DOMPseudoType = "view-transition-group"
+	DOMPseudoTypeViewTransitionImagePair     DOMPseudoType = "view-transition-image-pair"
+	DOMPseudoTypeViewTransitionGroupChildren DOMPseudoType = "view-transition-group-children"
+	DOMPseudoTypeViewTransitionOld           DOMPseudoType = "view-transition-old"
+	DOMPseudoTypeViewTransitionNew           DOMPseudoType = "view-transition-new"
+	DOMPseudoTypePlaceholder                 DOMPseudoType = "placeholder"
+	DOMPseudoTypeFileSelectorButton          DOMPseudoType = "file-selector-button"
+	DOMPseudoTypeDetailsContent              DOMPseudoType = "details-content"
+	DOMPseudoTypePicker                      DOMPseudoType = "picker"
+	DOMPseudoTypePermissionIcon              DOMPseudoType = "permission-icon"
+)
+
+// Values gives every DOMPseudoType
+func (DOMPseudoType) Values() []DOMPseudoType {
+	return []DOMPseudoType{
+		DOMPseudoTypeFirstLine,
+		DOMPseudoTypeFirstLetter,
+		DOMPseudoTypeCheckmark,
+		DOMPseudoTypeBefore,
+		DOMPseudoTypeAfter,
+		DOMPseudoTypePickerIcon,
+		DOMPseudoTypeMarker,
+		DOMPseudoTypeBackdrop,
+		DOMPseudoTypeColumn,
+		DOMPseudoTypeSelection,
+		DOMPseudoTypeSearchText,
+		DOMPseudoTypeTargetText,
+		DOMPseudoTypeSpellingError,
+		DOMPseudoTypeGrammarError,
+		DOMPseudoTypeHighlight,
+		DOMPseudoTypeFirstLineInherited,
+		DOMPseudoTypeScrollMarker,
+		DOMPseudoTypeScrollMarkerGroup,
+		DOMPseudoTypeScrollButton,
+		DOMPseudoTypeScrollbar,
+		DOMPseudoTypeScrollbarThumb,
+		DOMPseudoTypeScrollbarButton,
+		DOMPseudoTypeScrollbarTrack,
+		DOMPseudoTypeScrollbarTrackPiece,
+		DOMPseudoTypeScrollbarCorner,
+		DOMPseudoTypeResizer,
+		DOMPseudoTypeInputListButton,
+		DOMPseudoTypeViewTransition,
+		DOMPseudoTypeViewTransitionGroup,
+		DOMPseudoTypeViewTransitionImagePair,
+		DOMPseudoTypeViewTransitionGroupChildren,
+		DOMPseudoTypeViewTransitionOld,
+		DOMPseudoTypeViewTransitionNew,
+		DOMPseudoTypePlaceholder,
+		DOMPseudoTypeFileSelectorButton,
+		DOMPseudoTypeDetailsContent,
+		DOMPseudoTypePicker,
+		DOMPseudoTypePermissionIcon,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DOMPseudoType) Valid() bool {
+	switch v {
+	case DOMPseudoTypeFirstLine, DOMPseudoTypeFirstLetter, DOMPseudoTypeCheckmark, DOMPseudoTypeBefore, DOMPseudoTypeAfter, DOMPseudoTypePickerIcon, DOMPseudoTypeMarker, DOMPseudoTypeBackdrop, DOMPseudoTypeColumn, DOMPseudoTypeSelection, DOMPseudoTypeSearchText, DOMPseudoTypeTargetText, DOMPseudoTypeSpellingError, DOMPseudoTypeGrammarError, DOMPseudoTypeHighlight, DOMPseudoTypeFirstLineInherited, DOMPseudoTypeScrollMarker, DOMPseudoTypeScrollMarkerGroup, DOMPseudoTypeScrollButton, DOMPseudoTypeScrollbar, DOMPseudoTypeScrollbarThumb, DOMPseudoTypeScrollbarButton, DOMPseudoTypeScrollbarTrack, DOMPseudoTypeScrollbarTrackPiece, DOMPseudoTypeScrollbarCorner, DOMPseudoTypeResizer, DOMPseudoTypeInputListButton, DOMPseudoTypeViewTransition, DOMPseudoTypeViewTransitionGroup, DOMPseudoTypeViewTransitionImagePair, DOMPseudoTypeViewTransitionGroupChildren, DOMPseudoTypeViewTransitionOld, DOMPseudoTypeViewTransitionNew, DOMPseudoTypePlaceholder, DOMPseudoTypeFileSelectorButton, DOMPseudoTypeDetailsContent, DOMPseudoTypePicker, DOMPseudoTypePermissionIcon:
+		return true
+	}
+	return false
+}
+
+// Shadow root type.
+type DOMShadowRootType string
+
+// DOMShadowRootType values
+const (
+	DOMShadowRootTypeUserAgent DOMShadowRootType = "user-agent"
+	DOMShadowRootTypeOpen      DOMShadowRootType = "open"
+	DOMShadowRootTypeClosed    DOMShadowRootType = "closed"
+)
+
+// Values gives every DOMShadowRootType
+func (DOMShadowRootType) Values() []DOMShadowRootType {
+	return []DOMShadowRootType{
+		DOMShadowRootTypeUserAgent,
+		DOMShadowRootTypeOpen,
+		DOMShadowRootTypeClosed,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DOMShadowRootType) Valid() bool {
+	switch v {
+	case DOMShadowRootTypeUserAgent, DOMShadowRootTypeOpen, DOMShadowRootTypeClosed:
+		return true
+	}
+	return false
+}
+
+// ContainerSelector physical axes
+type DOMPhysicalAxes string
+
+// DOMPhysicalAxes values
+const (
+	DOMPhysicalAxesHorizontal DOMPhysicalAxes = "Horizontal"
+	DOMPhysicalAxesVertical   DOMPhysicalAxes = "Vertical"
+	DOMPhysicalAxesBoth       DOMPhysicalAxes = "Both"
+)
+
+// Values gives every DOMPhysicalAxes
+func (DOMPhysicalAxes) Values() []DOMPhysicalAxes {
+	return []DOMPhysicalAxes{
+		DOMPhysicalAxesHorizontal,
+		DOMPhysicalAxesVertical,
+		DOMPhysicalAxesBoth,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DOMPhysicalAxes) Valid() bool {
+	switch v {
+	case DOMPhysicalAxesHorizontal, DOMPhysicalAxesVertical, DOMPhysicalAxesBoth:
+		return true
+	}
+	return false
+}
+
+// ContainerSelector logical axes
+type DOMLogicalAxes string
+
+// DOMLogicalAxes values
+const (
+	DOMLogicalAxesInline DOMLogicalAxes = "Inline"
+	DOMLogicalAxesBlock  DOMLogicalAxes = "Block"
+	DOMLogicalAxesBoth   DOMLogicalAxes = "Both"
+)
+
+// Values gives every DOMLogicalAxes
+func (DOMLogicalAxes) Values() []DOMLogicalAxes {
+	return []DOMLogicalAxes{
+		DOMLogicalAxesInline,
+		DOMLogicalAxesBlock,
+		DOMLogicalAxesBoth,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DOMLogicalAxes) Valid() bool {
+	switch v {
+	case DOMLogicalAxesInline, DOMLogicalAxesBlock, DOMLogicalAxesBoth:
+		return true
+	}
+	return false
+}
+
+// Physical scroll orientation
+type DOMScrollOrientation string
+
+// DOMScrollOrientation values
+const (
+	DOMScrollOrientationHorizontal DOMScrollOrientation = "horizontal"
+	DOMScrollOrientationVertical   DOMScrollOrientation = "vertical"
+)
+
+// Values gives every DOMScrollOrientation
+func (DOMScrollOrientation) Values() []DOMScrollOrientation {
+	return []DOMScrollOrientation{
+		DOMScrollOrientationHorizontal,
+		DOMScrollOrientationVertical,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v DOMScrollOrientation) Valid() bool {
+	switch v {
+	case DOMScrollOrientationHorizontal, DOMScrollOrientationVertical:
+		return true
+	}
+	return false
+}
+
+// A structure holding an RGBA color.
+type DOMRGBA struct {
+	/* The red component, in the [0-255] range. */
+	R int `json:"r"`
+	/* The green component, in the [0-255] range. */
+	G int `json:"g"`
+	/* The blue component, in the [0-255] range. */
+	B int `json:"b"`
+	/* The alpha component, in the [0-1] range (default: 1). */
+	A *float64 `json:"a,omitempty"`
+}
+
+// MarshalCDP writes DOMRGBA as JSON
+func (v *DOMRGBA) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("r")
+	e.Int(v.R)
+	e.Key("g")
+	e.Int(v.G)
+	e.Key("b")
+	e.Int(v.B)
+	if v.A != nil {
+		e.Key("a")
+		e.Float(*v.A)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DOMRGBA) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads DOMRGBA from JSON
+func (v *DOMRGBA) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "r":
+			v.R = d.Int()
+		case "g":
+			v.G = d.Int()
+		case "b":
+			v.B = d.Int()
+		case "a":
+			if d.Null() {
+				v.A = nil
+			} else {
+				v.A = new(float64)
+				*v.A = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DOMRGBA) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// An array of quad vertices, x immediately followed by y for each point, points clock-wise.
+type DOMQuad []float64
+
+// Rectangle.
+type DOMRect struct {
+	/* X coordinate */
+	X float64 `json:"x"`
+	/* Y coordinate */
+	Y float64 `json:"y"`
+	/* Rectangle width */
+	Width float64 `json:"width"`
+	/* Rectangle height */
+	Height float64 `json:"height"`
+}
+
+// MarshalCDP writes DOMRect as JSON
+func (v *DOMRect) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("width")
+	e.Float(v.Width)
+	e.Key("height")
+	e.Float(v.Height)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DOMRect) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads DOMRect from JSON
+func (v *DOMRect) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			v.X = d.Float()
+		case "y":
+			v.Y = d.Float()
+		case "width":
+			v.Width = d.Float()
+		case "height":
+			v.Height = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DOMRect) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Object event listener.
+type DOMDebuggerEventListener struct {
+	/* `EventListener`'s type. */
+	Type string `json:"type"`
+	/* `EventListener`'s useCapture. */
+	UseCapture bool `json:"useCapture"`
+	/* `EventListener`'s passive flag. */
+	Passive bool `json:"passive"`
+	/* `EventListener`'s once flag. */
+	Once bool `json:"once"`
+	/* Script id of the handler code. */
+	ScriptId RuntimeScriptId `json:"scriptId"`
+	/* Line number in the script (0-based). */
+	LineNumber int `json:"lineNumber"`
+	/* Column number in the script (0-based). */
+	ColumnNumber int `json:"columnNumber"`
+	/* Event handler function value. */
+	Handler *RuntimeRemoteObject `json:"handler,omitempty"`
+	/* Event original handler function value. */
+	OriginalHandler *RuntimeRemoteObject `json:"originalHandler,omitempty"`
+	/* Node the listener is added to (if any). */
+	BackendNodeId *DOMBackendNodeId `json:"backendNodeId,omitempty"`
+}
+
+// MarshalCDP writes DOMDebuggerEventListener as JSON
+func (v *DOMDebuggerEventListener) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(v.Type)
+	e.Key("useCapture")
+	e.Bool(v.UseCapture)
+	e.Key("passive")
+	e.Bool(v.Passive)
+	e.Key("once")
+	e.Bool(v.Once)
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	if v.Handler != nil {
+		e.Key("handler")
+		(*v.Handler).MarshalCDP(e)
+	}
+	if v.OriginalHandler != nil {
+		e.Key("originalHandler")
+		(*v.OriginalHandler).MarshalCDP(e)
+	}
+	if v.BackendNodeId != nil {
+		e.Key("backendNodeId")
+		e.Int(int(*v.BackendNodeId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DOMDebuggerEventListener) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads DOMDebuggerEventListener from JSON
+func (v *DOMDebuggerEventListener) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = d.String()
+		case "useCapture":
+			v.UseCapture = d.Bool()
+		case "passive":
+			v.Passive = d.Bool()
+		case "once":
+			v.Once = d.Bool()
+		case "scriptId":
+			v.ScriptId = RuntimeScriptId(d.String())
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		case "handler":
+			if d.Null() {
+				v.Handler = nil
+			} else {
+				v.Handler = new(RuntimeRemoteObject)
+				(*v.Handler).UnmarshalCDP(d)
+			}
+		case "originalHandler":
+			if d.Null() {
+				v.OriginalHandler = nil
+			} else {
+				v.OriginalHandler = new(RuntimeRemoteObject)
+				(*v.OriginalHandler).UnmarshalCDP(d)
+			}
+		case "backendNodeId":
+			if d.Null() {
+				v.BackendNodeId = nil
+			} else {
+				v.BackendNodeId = new(DOMBackendNodeId)
+				*v.BackendNodeId = DOMBackendNodeId(d.Int())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DOMDebuggerEventListener) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Screen orientation.
+type EmulationScreenOrientation struct {
+	/* Orientation type. */
+	Type EmulationScreenOrientationType `json:"type"`
+	/* Orientation angle. */
+	Angle int `json:"angle"`
+}
+
+// MarshalCDP writes EmulationScreenOrientation as JSON
+func (v *EmulationScreenOrientation) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	e.Key("angle")
+	e.Int(v.Angle)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EmulationScreenOrientation) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads EmulationScreenOrientation from JSON
+func (v *EmulationScreenOrientation) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = EmulationScreenOrientationType(d.String())
+		case "angle":
+			v.Angle = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EmulationScreenOrientation) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type EmulationScreenOrientationType string
+
+// EmulationScreenOrientationType values
+const (
+	EmulationScreenOrientationTypePortraitPrimary    EmulationScreenOrientationType = "portraitPrimary"
+	EmulationScreenOrientationTypePortraitSecondary  EmulationScreenOrientationType = "portraitSecondary"
+	EmulationScreenOrientationTypeLandscapePrimary   EmulationScreenOrientationType = "landscapePrimary"
+	EmulationScreenOrientationTypeLandscapeSecondary EmulationScreenOrientationType = "landscapeSecondary"
+)
+
+// Values gives every EmulationScreenOrientationType
+func (EmulationScreenOrientationType) Values() []EmulationScreenOrientationType {
+	return []EmulationScreenOrientationType{
+		EmulationScreenOrientationTypePortraitPrimary,
+		EmulationScreenOrientationTypePortraitSecondary,
+		EmulationScreenOrientationTypeLandscapePrimary,
+		EmulationScreenOrientationTypeLandscapeSecondary,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v EmulationScreenOrientationType) Valid() bool {
+	switch v {
+	case EmulationScreenOrientationTypePortraitPrimary, EmulationScreenOrientationTypePortraitSecondary, EmulationScreenOrientationTypeLandscapePrimary, EmulationScreenOrientationTypeLandscapeSecondary:
+		return true
+	}
+	return false
+}
+
+// Used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+//
+// Experimental: this may change or be removed in any chrome release
+type EmulationUserAgentBrandVersion struct {
+	Brand   string `json:"brand"`
+	Version string `json:"version"`
+}
+
+// MarshalCDP writes EmulationUserAgentBrandVersion as JSON
+func (v *EmulationUserAgentBrandVersion) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("brand")
+	e.String(v.Brand)
+	e.Key("version")
+	e.String(v.Version)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EmulationUserAgentBrandVersion) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads EmulationUserAgentBrandVersion from JSON
+func (v *EmulationUserAgentBrandVersion) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "brand":
+			v.Brand = d.String()
+		case "version":
+			v.Version = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EmulationUserAgentBrandVersion) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Used to specify User Agent Client Hints to emulate. See https://wicg.github.io/ua-client-hints
+// Missing optional values will be filled in by the target with what it would normally use.
+//
+// Experimental: this may change or be removed in any chrome release
+type EmulationUserAgentMetadata struct {
+	/* Brands appearing in Sec-CH-UA. */
+	Brands []EmulationUserAgentBrandVersion `json:"brands"`
+	/* Brands appearing in Sec-CH-UA-Full-Version-List. */
+	FullVersionList []EmulationUserAgentBrandVersion `json:"fullVersionList"`
+	/* Deprecated: this is deprecated in the devtools protocol */
+	FullVersion     *string `json:"fullVersion,omitempty"`
+	Platform        string  `json:"platform"`
+	PlatformVersion string  `json:"platformVersion"`
+	Architecture    string  `json:"architecture"`
+	Model           string  `json:"model"`
+	Mobile          bool    `json:"mobile"`
+	Bitness         *string `json:"bitness,omitempty"`
+	Wow64           *bool   `json:"wow64,omitempty"`
+	/* Used to specify User Agent form-factor values.
+	See https://wicg.github.io/ua-client-hints/#sec-ch-ua-form-factors */
+	FormFactors []string `json:"formFactors"`
+}
+
+// MarshalCDP writes EmulationUserAgentMetadata as JSON
+func (v *EmulationUserAgentMetadata) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	if v.Brands != nil {
+		e.Key("brands")
+		e.ArrayStart()
+		for i0 := range v.Brands {
+			e.Elem()
+			v.Brands[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.FullVersionList != nil {
+		e.Key("fullVersionList")
+		e.ArrayStart()
+		for i0 := range v.FullVersionList {
+			e.Elem()
+			v.FullVersionList[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.FullVersion != nil {
+		e.Key("fullVersion")
+		e.String(*v.FullVersion)
+	}
+	e.Key("platform")
+	e.String(v.Platform)
+	e.Key("platformVersion")
+	e.String(v.PlatformVersion)
+	e.Key("architecture")
+	e.String(v.Architecture)
+	e.Key("model")
+	e.String(v.Model)
+	e.Key("mobile")
+	e.Bool(v.Mobile)
+	if v.Bitness != nil {
+		e.Key("bitness")
+		e.String(*v.Bitness)
+	}
+	if v.Wow64 != nil {
+		e.Key("wow64")
+		e.Bool(*v.Wow64)
+	}
+	if v.FormFactors != nil {
+		e.Key("formFactors")
+		e.ArrayStart()
+		for i0 := range v.FormFactors {
+			e.Elem()
+			e.String(v.FormFactors[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EmulationUserAgentMetadata) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads EmulationUserAgentMetadata from JSON
+func (v *EmulationUserAgentMetadata) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "brands":
+			if d.Array() {
+				v.Brands = make([]EmulationUserAgentBrandVersion, 0)
+				for d.More() {
+					var x0 EmulationUserAgentBrandVersion
+					x0.UnmarshalCDP(d)
+					v.Brands = append(v.Brands, x0)
+				}
+			} else {
+				v.Brands = nil
+			}
+		case "fullVersionList":
+			if d.Array() {
+				v.FullVersionList = make([]EmulationUserAgentBrandVersion, 0)
+				for d.More() {
+					var x0 EmulationUserAgentBrandVersion
+					x0.UnmarshalCDP(d)
+					v.FullVersionList = append(v.FullVersionList, x0)
+				}
+			} else {
+				v.FullVersionList = nil
+			}
+		case "fullVersion":
+			if d.Null() {
+				v.FullVersion = nil
+			} else {
+				v.FullVersion = new(string)
+				*v.FullVersion = d.String()
+			}
+		case "platform":
+			v.Platform = d.String()
+		case "platformVersion":
+			v.PlatformVersion = d.String()
+		case "architecture":
+			v.Architecture = d.String()
+		case "model":
+			v.Model = d.String()
+		case "mobile":
+			v.Mobile = d.Bool()
+		case "bitness":
+			if d.Null() {
+				v.Bitness = nil
+			} else {
+				v.Bitness = new(string)
+				*v.Bitness = d.String()
+			}
+		case "wow64":
+			if d.Null() {
+				v.Wow64 = nil
+			} else {
+				v.Wow64 = new(bool)
+				*v.Wow64 = d.Bool()
+			}
+		case "formFactors":
+			if d.Array() {
+				v.FormFactors = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.FormFactors = append(v.FormFactors, x0)
+				}
+			} else {
+				v.FormFactors = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EmulationUserAgentMetadata) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// This is either obtained from another method or specified as `blob:<uuid>` where
+// `<uuid>` is an UUID of a Blob.
+type IOStreamHandle string
+
+// Resource type as it was perceived by the rendering engine.
+type NetworkResourceType string
+
+// NetworkResourceType values
+const (
+	NetworkResourceTypeDocument           NetworkResourceType = "Document"
+	NetworkResourceTypeStylesheet         NetworkResourceType = "Stylesheet"
+	NetworkResourceTypeImage              NetworkResourceType = "Image"
+	NetworkResourceTypeMedia              NetworkResourceType = "Media"
+	NetworkResourceTypeFont               NetworkResourceType = "Font"
+	NetworkResourceTypeScript             NetworkResourceType = "Script"
+	NetworkResourceTypeTextTrack          NetworkResourceType = "TextTrack"
+	NetworkResourceTypeXHR                NetworkResourceType = "XHR"
+	NetworkResourceTypeFetch              NetworkResourceType = "Fetch"
+	NetworkResourceTypePrefetch           NetworkResourceType = "Prefetch"
+	NetworkResourceTypeEventSource        NetworkResourceType = "EventSource"
+	NetworkResourceTypeWebSocket          NetworkResourceType = "WebSocket"
+	NetworkResourceTypeManifest           NetworkResourceType = "Manifest"
+	NetworkResourceTypeSignedExchange     NetworkResourceType = "SignedExchange"
+	NetworkResourceTypePing               NetworkResourceType = "Ping"
+	NetworkResourceTypeCSPViolationReport NetworkResourceType = "CSPViolationReport"
+	NetworkResourceTypePreflight          NetworkResourceType = "Preflight"
+	NetworkResourceTypeFedCM              NetworkResourceType = "FedCM"
+	NetworkResourceTypeOther              NetworkResourceType = "Other"
+)
+
+// Values gives every NetworkResourceType
+func (NetworkResourceType) Values() []NetworkResourceType {
+	return []NetworkResourceType{
+		NetworkResourceTypeDocument,
+		NetworkResourceTypeStylesheet,
+		NetworkResourceTypeImage,
+		NetworkResourceTypeMedia,
+		NetworkResourceTypeFont,
+		NetworkResourceTypeScript,
+		NetworkResourceTypeTextTrack,
+		NetworkResourceTypeXHR,
+		NetworkResourceTypeFetch,
+		NetworkResourceTypePrefetch,
+		NetworkResourceTypeEventSource,
+		NetworkResourceTypeWebSocket,
+		NetworkResourceTypeManifest,
+		NetworkResourceTypeSignedExchange,
+		NetworkResourceTypePing,
+		NetworkResourceTypeCSPViolationReport,
+		NetworkResourceTypePreflight,
+		NetworkResourceTypeFedCM,
+		NetworkResourceTypeOther,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkResourceType) Valid() bool {
+	switch v {
+	case NetworkResourceTypeDocument, NetworkResourceTypeStylesheet, NetworkResourceTypeImage, NetworkResourceTypeMedia, NetworkResourceTypeFont, NetworkResourceTypeScript, NetworkResourceTypeTextTrack, NetworkResourceTypeXHR, NetworkResourceTypeFetch, NetworkResourceTypePrefetch, NetworkResourceTypeEventSource, NetworkResourceTypeWebSocket, NetworkResourceTypeManifest, NetworkResourceTypeSignedExchange, NetworkResourceTypePing, NetworkResourceTypeCSPViolationReport, NetworkResourceTypePreflight, NetworkResourceTypeFedCM, NetworkResourceTypeOther:
+		return true
+	}
+	return false
+}
+
+// Unique loader identifier.
+type NetworkLoaderId string
+
+// Unique network request identifier.
+// Note that this does not identify individual HTTP requests that are part of
+// a network request.
+type NetworkRequestId string
+
+// Network level fetch failure reason.
+type NetworkErrorReason string
+
+// NetworkErrorReason values
+const (
+	NetworkErrorReasonFailed               NetworkErrorReason = "Failed"
+	NetworkErrorReasonAborted              NetworkErrorReason = "Aborted"
+	NetworkErrorReasonTimedOut             NetworkErrorReason = "TimedOut"
+	NetworkErrorReasonAccessDenied         NetworkErrorReason = "AccessDenied"
+	NetworkErrorReasonConnectionClosed     NetworkErrorReason = "ConnectionClosed"
+	NetworkErrorReasonConnectionReset      NetworkErrorReason = "ConnectionReset"
+	NetworkErrorReasonConnectionRefused    NetworkErrorReason = "ConnectionRefused"
+	NetworkErrorReasonConnectionAborted    NetworkErrorReason = "ConnectionAborted"
+	NetworkErrorReasonConnectionFailed     NetworkErrorReason = "ConnectionFailed"
+	NetworkErrorReasonNameNotResolved      NetworkErrorReason = "NameNotResolved"
+	NetworkErrorReasonInternetDisconnected NetworkErrorReason = "InternetDisconnected"
+	NetworkErrorReasonAddressUnreachable   NetworkErrorReason = "AddressUnreachable"
+	NetworkErrorReasonBlockedByClient      NetworkErrorReason = "BlockedByClient"
+	NetworkErrorReasonBlockedByResponse    NetworkErrorReason = "BlockedByResponse"
+)
+
+// Values gives every NetworkErrorReason
+func (NetworkErrorReason) Values() []NetworkErrorReason {
+	return []NetworkErrorReason{
+		NetworkErrorReasonFailed,
+		NetworkErrorReasonAborted,
+		NetworkErrorReasonTimedOut,
+		NetworkErrorReasonAccessDenied,
+		NetworkErrorReasonConnectionClosed,
+		NetworkErrorReasonConnectionReset,
+		NetworkErrorReasonConnectionRefused,
+		NetworkErrorReasonConnectionAborted,
+		NetworkErrorReasonConnectionFailed,
+		NetworkErrorReasonNameNotResolved,
+		NetworkErrorReasonInternetDisconnected,
+		NetworkErrorReasonAddressUnreachable,
+		NetworkErrorReasonBlockedByClient,
+		NetworkErrorReasonBlockedByResponse,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkErrorReason) Valid() bool {
+	switch v {
+	case NetworkErrorReasonFailed, NetworkErrorReasonAborted, NetworkErrorReasonTimedOut, NetworkErrorReasonAccessDenied, NetworkErrorReasonConnectionClosed, NetworkErrorReasonConnectionReset, NetworkErrorReasonConnectionRefused, NetworkErrorReasonConnectionAborted, NetworkErrorReasonConnectionFailed, NetworkErrorReasonNameNotResolved, NetworkErrorReasonInternetDisconnected, NetworkErrorReasonAddressUnreachable, NetworkErrorReasonBlockedByClient, NetworkErrorReasonBlockedByResponse:
+		return true
+	}
+	return false
+}
+
+// UTC time in seconds, counted from January 1, 1970.
+type NetworkTimeSinceEpoch float64
+
+// Monotonically increasing time in seconds since an arbitrary point in the past.
+type NetworkMonotonicTime float64
+
+// Request / response headers as keys / values of JSON object.
+type NetworkHeaders map[string]interface{}
+
+// Represents the cookie's 'SameSite' status:
+// https://tools.ietf.org/html/draft-west-first-party-cookies
+type NetworkCookieSameSite string
+
+// NetworkCookieSameSite values
+const (
+	NetworkCookieSameSiteStrict NetworkCookieSameSite = "Strict"
+	NetworkCookieSameSiteLax    NetworkCookieSameSite = "Lax"
+	NetworkCookieSameSiteNone   NetworkCookieSameSite = "None"
+)
+
+// Values gives every NetworkCookieSameSite
+func (NetworkCookieSameSite) Values() []NetworkCookieSameSite {
+	return []NetworkCookieSameSite{
+		NetworkCookieSameSiteStrict,
+		NetworkCookieSameSiteLax,
+		NetworkCookieSameSiteNone,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkCookieSameSite) Valid() bool {
+	switch v {
+	case NetworkCookieSameSiteStrict, NetworkCookieSameSiteLax, NetworkCookieSameSiteNone:
+		return true
+	}
+	return false
+}
+
+// Represents the cookie's 'Priority' status:
+// https://tools.ietf.org/html/draft-west-cookie-priority-00
+//
+// Experimental: this may change or be removed in any chrome release
+type NetworkCookiePriority string
+
+// NetworkCookiePriority values
+const (
+	NetworkCookiePriorityLow    NetworkCookiePriority = "Low"
+	NetworkCookiePriorityMedium NetworkCookiePriority = "Medium"
+	NetworkCookiePriorityHigh   NetworkCookiePriority = "High"
+)
+
+// Values gives every NetworkCookiePriority
+func (NetworkCookiePriority) Values() []NetworkCookiePriority {
+	return []NetworkCookiePriority{
+		NetworkCookiePriorityLow,
+		NetworkCookiePriorityMedium,
+		NetworkCookiePriorityHigh,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkCookiePriority) Valid() bool {
+	switch v {
+	case NetworkCookiePriorityLow, NetworkCookiePriorityMedium, NetworkCookiePriorityHigh:
+		return true
+	}
+	return false
+}
+
+// Represents the source scheme of the origin that originally set the cookie.
+// A value of "Unset" allows protocol clients to emulate legacy cookie scope for the scheme.
+// This is a temporary ability and it will be removed in the future.
+//
+// Experimental: this may change or be removed in any chrome release
+type NetworkCookieSourceScheme string
+
+// NetworkCookieSourceScheme values
+const (
+	NetworkCookieSourceSchemeUnset     NetworkCookieSourceScheme = "Unset"
+	NetworkCookieSourceSchemeNonSecure NetworkCookieSourceScheme = "NonSecure"
+	NetworkCookieSourceSchemeSecure    NetworkCookieSourceScheme = "Secure"
+)
+
+// Values gives every NetworkCookieSourceScheme
+func (NetworkCookieSourceScheme) Values() []NetworkCookieSourceScheme {
+	return []NetworkCookieSourceScheme{
+		NetworkCookieSourceSchemeUnset,
+		NetworkCookieSourceSchemeNonSecure,
+		NetworkCookieSourceSchemeSecure,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkCookieSourceScheme) Valid() bool {
+	switch v {
+	case NetworkCookieSourceSchemeUnset, NetworkCookieSourceSchemeNonSecure, NetworkCookieSourceSchemeSecure:
+		return true
+	}
+	return false
+}
+
+// Loading priority of a resource request.
+type NetworkResourcePriority string
+
+// NetworkResourcePriority values
+const (
+	NetworkResourcePriorityVeryLow  NetworkResourcePriority = "VeryLow"
+	NetworkResourcePriorityLow      NetworkResourcePriority = "Low"
+	NetworkResourcePriorityMedium   NetworkResourcePriority = "Medium"
+	NetworkResourcePriorityHigh     NetworkResourcePriority = "High"
+	NetworkResourcePriorityVeryHigh NetworkResourcePriority = "VeryHigh"
+)
+
+// Values gives every NetworkResourcePriority
+func (NetworkResourcePriority) Values() []NetworkResourcePriority {
+	return []NetworkResourcePriority{
+		NetworkResourcePriorityVeryLow,
+		NetworkResourcePriorityLow,
+		NetworkResourcePriorityMedium,
+		NetworkResourcePriorityHigh,
+		NetworkResourcePriorityVeryHigh,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkResourcePriority) Valid() bool {
+	switch v {
+	case NetworkResourcePriorityVeryLow, NetworkResourcePriorityLow, NetworkResourcePriorityMedium, NetworkResourcePriorityHigh, NetworkResourcePriorityVeryHigh:
+		return true
+	}
+	return false
+}
+
+// Post data entry for HTTP request
+type NetworkPostDataEntry struct {
+	Bytes *string `json:"bytes,omitempty"`
+}
+
+// MarshalCDP writes NetworkPostDataEntry as JSON
+func (v *NetworkPostDataEntry) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	if v.Bytes != nil {
+		e.Key("bytes")
+		e.String(*v.Bytes)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkPostDataEntry) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkPostDataEntry from JSON
+func (v *NetworkPostDataEntry) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "bytes":
+			if d.Null() {
+				v.Bytes = nil
+			} else {
+				v.Bytes = new(string)
+				*v.Bytes = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkPostDataEntry) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// HTTP request data.
+type NetworkRequest struct {
+	/* Request URL (without fragment). */
+	Url string `json:"url"`
+	/* Fragment of the requested URL starting with hash, if present. */
+	UrlFragment *string `json:"urlFragment,omitempty"`
+	/* HTTP request method. */
+	Method string `json:"method"`
+	/* HTTP request headers. */
+	Headers NetworkHeaders `json:"headers"`
+	/* HTTP POST request data.
+	Use postDataEntries instead.
+
+	Deprecated: this is deprecated in the devtools protocol */
+	PostData *string `json:"postData,omitempty"`
+	/* True when the request has POST data. Note that postData might still be omitted when this flag is true when the data is too long. */
+	HasPostData *bool `json:"hasPostData,omitempty"`
+	/* Request body elements (post data broken into individual entries).
+
+	Experimental: this may change or be removed in any chrome release */
+	PostDataEntries []NetworkPostDataEntry `json:"postDataEntries"`
+	/* The mixed content type of the request. */
+	MixedContentType *SecurityMixedContentType `json:"mixedContentType,omitempty"`
+	/* Priority of the resource request at the time request is sent. */
+	InitialPriority NetworkResourcePriority `json:"initialPriority"`
+	/* The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/ */
+	ReferrerPolicy NetworkRequestReferrerPolicy `json:"referrerPolicy"`
+	/* Whether is loaded via link preload. */
+	IsLinkPreload *bool `json:"isLinkPreload,omitempty"`
+	/* Set for requests when the TrustToken API is used. Contains the parameters
+	passed by the developer (e.g. via "fetch") as understood by the backend.
+
+	Experimental: this may change or be removed in any chrome release */
+	TrustTokenParams *NetworkTrustTokenParams `json:"trustTokenParams,omitempty"`
+	/* True if this resource request is considered to be the 'same site' as the
+	request corresponding to the main frame.
+
+	Experimental: this may change or be removed in any chrome release */
+	IsSameSite *bool `json:"isSameSite,omitempty"`
+}
+
+// MarshalCDP writes NetworkRequest as JSON
+func (v *NetworkRequest) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("url")
+	e.String(v.Url)
+	if v.UrlFragment != nil {
+		e.Key("urlFragment")
+		e.String(*v.UrlFragment)
+	}
+	e.Key("method")
+	e.String(v.Method)
+	e.Key("headers")
+	e.Map(map[string]interface{}(v.Headers))
+	if v.PostData != nil {
+		e.Key("postData")
+		e.String(*v.PostData)
+	}
+	if v.HasPostData != nil {
+		e.Key("hasPostData")
+		e.Bool(*v.HasPostData)
+	}
+	if v.PostDataEntries != nil {
+		e.Key("postDataEntries")
+		e.ArrayStart()
+		for i0 := range v.PostDataEntries {
+			e.Elem()
+			v.PostDataEntries[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.MixedContentType != nil {
+		e.Key("mixedContentType")
+		e.String(string(*v.MixedContentType))
+	}
+	e.Key("initialPriority")
+	e.String(string(v.InitialPriority))
+	e.Key("referrerPolicy")
+	e.String(string(v.ReferrerPolicy))
+	if v.IsLinkPreload != nil {
+		e.Key("isLinkPreload")
+		e.Bool(*v.IsLinkPreload)
+	}
+	if v.TrustTokenParams != nil {
+		e.Key("trustTokenParams")
+		(*v.TrustTokenParams).MarshalCDP(e)
+	}
+	if v.IsSameSite != nil {
+		e.Key("isSameSite")
+		e.Bool(*v.IsSameSite)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkRequest) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkRequest from JSON
+func (v *NetworkRequest) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "url":
+			v.Url = d.String()
+		case "urlFragment":
+			if d.Null() {
+				v.UrlFragment = nil
+			} else {
+				v.UrlFragment = new(string)
+				*v.UrlFragment = d.String()
+			}
+		case "method":
+			v.Method = d.String()
+		case "headers":
+			v.Headers = NetworkHeaders(d.Map())
+		case "postData":
+			if d.Null() {
+				v.PostData = nil
+			} else {
+				v.PostData = new(string)
+				*v.PostData = d.String()
+			}
+		case "hasPostData":
+			if d.Null() {
+				v.HasPostData = nil
+			} else {
+				v.HasPostData = new(bool)
+				*v.HasPostData = d.Bool()
+			}
+		case "postDataEntries":
+			if d.Array() {
+				v.PostDataEntries = make([]NetworkPostDataEntry, 0)
+				for d.More() {
+					var x0 NetworkPostDataEntry
+					x0.UnmarshalCDP(d)
+					v.PostDataEntries = append(v.PostDataEntries, x0)
+				}
+			} else {
+				v.PostDataEntries = nil
+			}
+		case "mixedContentType":
+			if d.Null() {
+				v.MixedContentType = nil
+			} else {
+				v.MixedContentType = new(SecurityMixedContentType)
+				*v.MixedContentType = SecurityMixedContentType(d.String())
+			}
+		case "initialPriority":
+			v.InitialPriority = NetworkResourcePriority(d.String())
+		case "referrerPolicy":
+			v.ReferrerPolicy = NetworkRequestReferrerPolicy(d.String())
+		case "isLinkPreload":
+			if d.Null() {
+				v.IsLinkPreload = nil
+			} else {
+				v.IsLinkPreload = new(bool)
+				*v.IsLinkPreload = d.Bool()
+			}
+		case "trustTokenParams":
+			if d.Null() {
+				v.TrustTokenParams = nil
+			} else {
+				v.TrustTokenParams = new(NetworkTrustTokenParams)
+				(*v.TrustTokenParams).UnmarshalCDP(d)
+			}
+		case "isSameSite":
+			if d.Null() {
+				v.IsSameSite = nil
+			} else {
+				v.IsSameSite = new(bool)
+				*v.IsSameSite = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkRequest) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type NetworkRequestReferrerPolicy string
+
+// NetworkRequestReferrerPolicy values
+const (
+	NetworkRequestReferrerPolicyUnsafeUrl                   NetworkRequestReferrerPolicy = "unsafe-url"
+	NetworkRequestReferrerPolicyNoReferrerWhenDowngrade     NetworkRequestReferrerPolicy = "no-referrer-when-downgrade"
+	NetworkRequestReferrerPolicyNoReferrer                  NetworkRequestReferrerPolicy = "no-referrer"
+	NetworkRequestReferrerPolicyOrigin                      NetworkRequestReferrerPolicy = "origin"
+	NetworkRequestReferrerPolicyOriginWhenCrossOrigin       NetworkRequestReferrerPolicy = "origin-when-cross-origin"
+	NetworkRequestReferrerPolicySameOrigin                  NetworkRequestReferrerPolicy = "same-origin"
+	NetworkRequestReferrerPolicyStrictOrigin                NetworkRequestReferrerPolicy = "strict-origin"
+	NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin NetworkRequestReferrerPolicy = "strict-origin-when-cross-origin"
+)
+
+// Values gives every NetworkRequestReferrerPolicy
+func (NetworkRequestReferrerPolicy) Values() []NetworkRequestReferrerPolicy {
+	return []NetworkRequestReferrerPolicy{
+		NetworkRequestReferrerPolicyUnsafeUrl,
+		NetworkRequestReferrerPolicyNoReferrerWhenDowngrade,
+		NetworkRequestReferrerPolicyNoReferrer,
+		NetworkRequestReferrerPolicyOrigin,
+		NetworkRequestReferrerPolicyOriginWhenCrossOrigin,
+		NetworkRequestReferrerPolicySameOrigin,
+		NetworkRequestReferrerPolicyStrictOrigin,
+		NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkRequestReferrerPolicy) Valid() bool {
+	switch v {
+	case NetworkRequestReferrerPolicyUnsafeUrl, NetworkRequestReferrerPolicyNoReferrerWhenDowngrade, NetworkRequestReferrerPolicyNoReferrer, NetworkRequestReferrerPolicyOrigin, NetworkRequestReferrerPolicyOriginWhenCrossOrigin, NetworkRequestReferrerPolicySameOrigin, NetworkRequestReferrerPolicyStrictOrigin, NetworkRequestReferrerPolicyStrictOriginWhenCrossOrigin:
+		return true
+	}
+	return false
+}
+
+// The reason why request was blocked.
+type NetworkCorsError string
+
+// NetworkCorsError values
+const (
+	NetworkCorsErrorDisallowedByMode                          NetworkCorsError = "DisallowedByMode"
+	NetworkCorsErrorInvalidResponse                           NetworkCorsError = "InvalidResponse"
+	NetworkCorsErrorWildcardOriginNotAllowed                  NetworkCorsError = "WildcardOriginNotAllowed"
+	NetworkCorsErrorMissingAllowOriginHeader                  NetworkCorsError = "MissingAllowOriginHeader"
+	NetworkCorsErrorMultipleAllowOriginValues                 NetworkCorsError = "MultipleAllowOriginValues"
+	NetworkCorsErrorInvalidAllowOriginValue                   NetworkCorsError = "InvalidAllowOriginValue"
+	NetworkCorsErrorAllowOriginMismatch                       NetworkCorsError = "AllowOriginMismatch"
+	NetworkCorsErrorInvalidAllowCredentials                   NetworkCorsError = "InvalidAllowCredentials"
+	NetworkCorsErrorCorsDisabledScheme                        NetworkCorsError = "CorsDisabledScheme"
+	NetworkCorsErrorPreflightInvalidStatus                    NetworkCorsError = "PreflightInvalidStatus"
+	NetworkCorsErrorPreflightDisallowedRedirect               NetworkCorsError = "PreflightDisallowedRedirect"
+	NetworkCorsErrorPreflightWildcardOriginNotAllowed         NetworkCorsError = "PreflightWildcardOriginNotAllowed"
+	NetworkCorsErrorPreflightMissingAllowOriginHeader         NetworkCorsError = "PreflightMissingAllowOriginHeader"
+	NetworkCorsErrorPreflightMultipleAllowOriginValues        NetworkCorsError = "PreflightMultipleAllowOriginValues"
+	NetworkCorsErrorPreflightInvalidAllowOriginValue          NetworkCorsError = "PreflightInvalidAllowOriginValue"
+	NetworkCorsErrorPreflightAllowOriginMismatch              NetworkCorsError = "PreflightAllowOriginMismatch"
+	NetworkCorsErrorPreflightInvalidAllowCredentials          NetworkCorsError = "PreflightInvalidAllowCredentials"
+	NetworkCorsErrorPreflightMissingAllowExternal             NetworkCorsError = "PreflightMissingAllowExternal"
+	NetworkCorsErrorPreflightInvalidAllowExternal             NetworkCorsError = "PreflightInvalidAllowExternal"
+	NetworkCorsErrorPreflightMissingAllowPrivateNetwork       NetworkCorsError = "PreflightMissingAllowPrivateNetwork"
+	NetworkCorsErrorPreflightInvalidAllowPrivateNetwork       NetworkCorsError = "PreflightInvalidAllowPrivateNetwork"
+	NetworkCorsErrorInvalidAllowMethodsPreflightResponse      NetworkCorsError = "InvalidAllowMethodsPreflightResponse"
+	NetworkCorsErrorInvalidAllowHeadersPreflightResponse      NetworkCorsError = "InvalidAllowHeadersPreflightResponse"
+	NetworkCorsErrorMethodDisallowedByPreflightResponse       NetworkCorsError = "MethodDisallowedByPreflightResponse"
+	NetworkCorsErrorHeaderDisallowedByPreflightResponse       NetworkCorsError = "HeaderDisallowedByPreflightResponse"
+	NetworkCorsErrorRedirectContainsCredentials               NetworkCorsError = "RedirectContainsCredentials"
+	NetworkCorsErrorInsecurePrivateNetwork                    NetworkCorsError = "InsecurePrivateNetwork"
+	NetworkCorsErrorInvalidPrivateNetworkAccess               NetworkCorsError = "InvalidPrivateNetworkAccess"
+	NetworkCorsErrorUnexpectedPrivateNetworkAccess            NetworkCorsError = "UnexpectedPrivateNetworkAccess"
+	NetworkCorsErrorNoCorsRedirectModeNotFollow               NetworkCorsError = "NoCorsRedirectModeNotFollow"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessId    NetworkCorsError = "PreflightMissingPrivateNetworkAccessId"
+	NetworkCorsErrorPreflightMissingPrivateNetworkAccessName  NetworkCorsError = "PreflightMissingPrivateNetworkAccessName"
+	NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable NetworkCorsError = "PrivateNetworkAccessPermissionUnavailable"
+	NetworkCorsErrorPrivateNetworkAccessPermissionDenied      NetworkCorsError = "PrivateNetworkAccessPermissionDenied"
+	NetworkCorsErrorLocalNetworkAccessPermissionDenied        NetworkCorsError = "LocalNetworkAccessPermissionDenied"
+)
+
+// Values gives every NetworkCorsError
+func (NetworkCorsError) Values() []NetworkCorsError {
+	return []NetworkCorsError{
+		NetworkCorsErrorDisallowedByMode,
+		NetworkCorsErrorInvalidResponse,
+		NetworkCorsErrorWildcardOriginNotAllowed,
+		NetworkCorsErrorMissingAllowOriginHeader,
+		NetworkCorsErrorMultipleAllowOriginValues,
+		NetworkCorsErrorInvalidAllowOriginValue,
+		NetworkCorsErrorAllowOriginMismatch,
+		NetworkCorsErrorInvalidAllowCredentials,
+		NetworkCorsErrorCorsDisabledScheme,
+		NetworkCorsErrorPreflightInvalidStatus,
+		NetworkCorsErrorPreflightDisallowedRedirect,
+		NetworkCorsErrorPreflightWildcardOriginNotAllowed,
+		NetworkCorsErrorPreflightMissingAllowOriginHeader,
+		NetworkCorsErrorPreflightMultipleAllowOriginValues,
+		NetworkCorsErrorPreflightInvalidAllowOriginValue,
+		NetworkCorsErrorPreflightAllowOriginMismatch,
+		NetworkCorsErrorPreflightInvalidAllowCredentials,
+		NetworkCorsErrorPreflightMissingAllowExternal,
+		NetworkCorsErrorPreflightInvalidAllowExternal,
+		NetworkCorsErrorPreflightMissingAllowPrivateNetwork,
+		NetworkCorsErrorPreflightInvalidAllowPrivateNetwork,
+		NetworkCorsErrorInvalidAllowMethodsPreflightResponse,
+		NetworkCorsErrorInvalidAllowHeadersPreflightResponse,
+		NetworkCorsErrorMethodDisallowedByPreflightResponse,
+		NetworkCorsErrorHeaderDisallowedByPreflightResponse,
+		NetworkCorsErrorRedirectContainsCredentials,
+		NetworkCorsErrorInsecurePrivateNetwork,
+		NetworkCorsErrorInvalidPrivateNetworkAccess,
+		NetworkCorsErrorUnexpectedPrivateNetworkAccess,
+		NetworkCorsErrorNoCorsRedirectModeNotFollow,
+		NetworkCorsErrorPreflightMissingPrivateNetworkAccessId,
+		NetworkCorsErrorPreflightMissingPrivateNetworkAccessName,
+		NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable,
+		NetworkCorsErrorPrivateNetworkAccessPermissionDenied,
+		NetworkCorsErrorLocalNetworkAccessPermissionDenied,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkCorsError) Valid() bool {
+	switch v {
+	case NetworkCorsErrorDisallowedByMode, NetworkCorsErrorInvalidResponse, NetworkCorsErrorWildcardOriginNotAllowed, NetworkCorsErrorMissingAllowOriginHeader, NetworkCorsErrorMultipleAllowOriginValues, NetworkCorsErrorInvalidAllowOriginValue, NetworkCorsErrorAllowOriginMismatch, NetworkCorsErrorInvalidAllowCredentials, NetworkCorsErrorCorsDisabledScheme, NetworkCorsErrorPreflightInvalidStatus, NetworkCorsErrorPreflightDisallowedRedirect, NetworkCorsErrorPreflightWildcardOriginNotAllowed, NetworkCorsErrorPreflightMissingAllowOriginHeader, NetworkCorsErrorPreflightMultipleAllowOriginValues, NetworkCorsErrorPreflightInvalidAllowOriginValue, NetworkCorsErrorPreflightAllowOriginMismatch, NetworkCorsErrorPreflightInvalidAllowCredentials, NetworkCorsErrorPreflightMissingAllowExternal, NetworkCorsErrorPreflightInvalidAllowExternal, NetworkCorsErrorPreflightMissingAllowPrivateNetwork, NetworkCorsErrorPreflightInvalidAllowPrivateNetwork, NetworkCorsErrorInvalidAllowMethodsPreflightResponse, NetworkCorsErrorInvalidAllowHeadersPreflightResponse, NetworkCorsErrorMethodDisallowedByPreflightResponse, NetworkCorsErrorHeaderDisallowedByPreflightResponse, NetworkCorsErrorRedirectContainsCredentials, NetworkCorsErrorInsecurePrivateNetwork, NetworkCorsErrorInvalidPrivateNetworkAccess, NetworkCorsErrorUnexpectedPrivateNetworkAccess, NetworkCorsErrorNoCorsRedirectModeNotFollow, NetworkCorsErrorPreflightMissingPrivateNetworkAccessId, NetworkCorsErrorPreflightMissingPrivateNetworkAccessName, NetworkCorsErrorPrivateNetworkAccessPermissionUnavailable, NetworkCorsErrorPrivateNetworkAccessPermissionDenied, NetworkCorsErrorLocalNetworkAccessPermissionDenied:
+		return true
+	}
+	return false
+}
+
+type NetworkCorsErrorStatus struct {
+	CorsError       NetworkCorsError `json:"corsError"`
+	FailedParameter string           `json:"failedParameter"`
+}
+
+// MarshalCDP writes NetworkCorsErrorStatus as JSON
+func (v *NetworkCorsErrorStatus) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("corsError")
+	e.String(string(v.CorsError))
+	e.Key("failedParameter")
+	e.String(v.FailedParameter)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkCorsErrorStatus) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkCorsErrorStatus from JSON
+func (v *NetworkCorsErrorStatus) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "corsError":
+			v.CorsError = NetworkCorsError(d.String())
+		case "failedParameter":
+			v.FailedParameter = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkCorsErrorStatus) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Determines what type of Trust Token operation is executed and
+// depending on the type, some additional parameters. The values
+// are specified in third_party/blink/renderer/core/fetch/trust_token.idl.
+//
+// Experimental: this may change or be removed in any chrome release
+type NetworkTrustTokenParams struct {
+	Operation NetworkTrustTokenOperationType `json:"operation"`
+	/* Only set for "token-redemption" operation and determine whether
+	to request a fresh SRR or use a still valid cached SRR. */
+	RefreshPolicy NetworkTrustTokenParamsRefreshPolicy `json:"refreshPolicy"`
+	/* Origins of issuers from whom to request tokens or redemption
+	records. */
+	Issuers []string `json:"issuers"`
+}
+
+// MarshalCDP writes NetworkTrustTokenParams as JSON
+func (v *NetworkTrustTokenParams) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("operation")
+	e.String(string(v.Operation))
+	e.Key("refreshPolicy")
+	e.String(string(v.RefreshPolicy))
+	if v.Issuers != nil {
+		e.Key("issuers")
+		e.ArrayStart()
+		for i0 := range v.Issuers {
+			e.Elem()
+			e.String(v.Issuers[i0])
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkTrustTokenParams) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkTrustTokenParams from JSON
+func (v *NetworkTrustTokenParams) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "operation":
+			v.Operation = NetworkTrustTokenOperationType(d.String())
+		case "refreshPolicy":
+			v.RefreshPolicy = NetworkTrustTokenParamsRefreshPolicy(d.String())
+		case "issuers":
+			if d.Array() {
+				v.Issuers = make([]string, 0)
+				for d.More() {
+					var x0 string
+					x0 = d.String()
+					v.Issuers = append(v.Issuers, x0)
+				}
+			} else {
+				v.Issuers = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkTrustTokenParams) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type NetworkTrustTokenParamsRefreshPolicy string
+
+// NetworkTrustTokenParamsRefreshPolicy values
+const (
+	NetworkTrustTokenParamsRefreshPolicyUseCached NetworkTrustTokenParamsRefreshPolicy = "UseCached"
+	NetworkTrustTokenParamsRefreshPolicyRefresh   NetworkTrustTokenParamsRefreshPolicy = "Refresh"
+)
+
+// Values gives every NetworkTrustTokenParamsRefreshPolicy
+func (NetworkTrustTokenParamsRefreshPolicy) Values() []NetworkTrustTokenParamsRefreshPolicy {
+	return []NetworkTrustTokenParamsRefreshPolicy{
+		NetworkTrustTokenParamsRefreshPolicyUseCached,
+		NetworkTrustTokenParamsRefreshPolicyRefresh,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkTrustTokenParamsRefreshPolicy) Valid() bool {
+	switch v {
+	case NetworkTrustTokenParamsRefreshPolicyUseCached, NetworkTrustTokenParamsRefreshPolicyRefresh:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type NetworkTrustTokenOperationType string
+
+// NetworkTrustTokenOperationType values
+const (
+	NetworkTrustTokenOperationTypeIssuance   NetworkTrustTokenOperationType = "Issuance"
+	NetworkTrustTokenOperationTypeRedemption NetworkTrustTokenOperationType = "Redemption"
+	NetworkTrustTokenOperationTypeSigning    NetworkTrustTokenOperationType = "Signing"
+)
+
+// Values gives every NetworkTrustTokenOperationType
+func (NetworkTrustTokenOperationType) Values() []NetworkTrustTokenOperationType {
+	return []NetworkTrustTokenOperationType{
+		NetworkTrustTokenOperationTypeIssuance,
+		NetworkTrustTokenOperationTypeRedemption,
+		NetworkTrustTokenOperationTypeSigning,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkTrustTokenOperationType) Valid() bool {
+	switch v {
+	case NetworkTrustTokenOperationTypeIssuance, NetworkTrustTokenOperationTypeRedemption, NetworkTrustTokenOperationTypeSigning:
+		return true
+	}
+	return false
+}
+
+// cookiePartitionKey object
+// The representation of the components of the key that are created by the cookiePartitionKey class contained in net/cookies/cookie_partition_key.h.
+//
+// Experimental: this may change or be removed in any chrome release
+type NetworkCookiePartitionKey struct {
+	/* The site of the top-level URL the browser was visiting at the start
+	of the request to the endpoint that set the cookie. */
+	TopLevelSite string `json:"topLevelSite"`
+	/* Indicates if the cookie has any ancestors that are cross-site to the topLevelSite. */
+	HasCrossSiteAncestor bool `json:"hasCrossSiteAncestor"`
+}
+
+// MarshalCDP writes NetworkCookiePartitionKey as JSON
+func (v *NetworkCookiePartitionKey) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("topLevelSite")
+	e.String(v.TopLevelSite)
+	e.Key("hasCrossSiteAncestor")
+	e.Bool(v.HasCrossSiteAncestor)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkCookiePartitionKey) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkCookiePartitionKey from JSON
+func (v *NetworkCookiePartitionKey) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "topLevelSite":
+			v.TopLevelSite = d.String()
+		case "hasCrossSiteAncestor":
+			v.HasCrossSiteAncestor = d.Bool()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkCookiePartitionKey) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Cookie object
+type NetworkCookie struct {
+	/* Cookie name. */
+	Name string `json:"name"`
+	/* Cookie value. */
+	Value string `json:"value"`
+	/* Cookie domain. */
+	Domain string `json:"domain"`
+	/* Cookie path. */
+	Path string `json:"path"`
+	/* Cookie expiration date as the number of seconds since the UNIX epoch. */
+	Expires float64 `json:"expires"`
+	/* Cookie size. */
+	Size int `json:"size"`
+	/* True if cookie is http-only. */
+	HttpOnly bool `json:"httpOnly"`
+	/* True if cookie is secure. */
+	Secure bool `json:"secure"`
+	/* True in case of session cookie. */
+	Session bool `json:"session"`
+	/* Cookie SameSite type. */
+	SameSite *NetworkCookieSameSite `json:"sameSite,omitempty"`
+	/* Cookie Priority
+
+	Experimental: this may change or be removed in any chrome release */
+	Priority NetworkCookiePriority `json:"priority"`
+	/* True if cookie is SameParty.
+
+	Experimental: this may change or be removed in any chrome release
+
+	Deprecated: this is deprecated in the devtools protocol */
+	SameParty bool `json:"sameParty"`
+	/* Cookie source scheme type.
+
+	Experimental: this may change or be removed in any chrome release */
+	SourceScheme NetworkCookieSourceScheme `json:"sourceScheme"`
+	/* Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	This is a temporary ability and it will be removed in the future.
+
+	Experimental: this may change or be removed in any chrome release */
+	SourcePort int `json:"sourcePort"`
+	/* Cookie partition key.
+
+	Experimental: this may change or be removed in any chrome release */
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+	/* True if cookie partition key is opaque.
+
+	Experimental: this may change or be removed in any chrome release */
+	PartitionKeyOpaque *bool `json:"partitionKeyOpaque,omitempty"`
+}
+
+// MarshalCDP writes NetworkCookie as JSON
+func (v *NetworkCookie) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	e.Key("domain")
+	e.String(v.Domain)
+	e.Key("path")
+	e.String(v.Path)
+	e.Key("expires")
+	e.Float(v.Expires)
+	e.Key("size")
+	e.Int(v.Size)
+	e.Key("httpOnly")
+	e.Bool(v.HttpOnly)
+	e.Key("secure")
+	e.Bool(v.Secure)
+	e.Key("session")
+	e.Bool(v.Session)
+	if v.SameSite != nil {
+		e.Key("sameSite")
+		e.String(string(*v.SameSite))
+	}
+	e.Key("priority")
+	e.String(string(v.Priority))
+	e.Key("sameParty")
+	e.Bool(v.SameParty)
+	e.Key("sourceScheme")
+	e.String(string(v.SourceScheme))
+	e.Key("sourcePort")
+	e.Int(v.SourcePort)
+	if v.PartitionKey != nil {
+		e.Key("partitionKey")
+		(*v.PartitionKey).MarshalCDP(e)
+	}
+	if v.PartitionKeyOpaque != nil {
+		e.Key("partitionKeyOpaque")
+		e.Bool(*v.PartitionKeyOpaque)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkCookie) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkCookie from JSON
+func (v *NetworkCookie) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		case "domain":
+			v.Domain = d.String()
+		case "path":
+			v.Path = d.String()
+		case "expires":
+			v.Expires = d.Float()
+		case "size":
+			v.Size = d.Int()
+		case "httpOnly":
+			v.HttpOnly = d.Bool()
+		case "secure":
+			v.Secure = d.Bool()
+		case "session":
+			v.Session = d.Bool()
+		case "sameSite":
+			if d.Null() {
+				v.SameSite = nil
+			} else {
+				v.SameSite = new(NetworkCookieSameSite)
+				*v.SameSite = NetworkCookieSameSite(d.String())
+			}
+		case "priority":
+			v.Priority = NetworkCookiePriority(d.String())
+		case "sameParty":
+			v.SameParty = d.Bool()
+		case "sourceScheme":
+			v.SourceScheme = NetworkCookieSourceScheme(d.String())
+		case "sourcePort":
+			v.SourcePort = d.Int()
+		case "partitionKey":
+			if d.Null() {
+				v.PartitionKey = nil
+			} else {
+				v.PartitionKey = new(NetworkCookiePartitionKey)
+				(*v.PartitionKey).UnmarshalCDP(d)
+			}
+		case "partitionKeyOpaque":
+			if d.Null() {
+				v.PartitionKeyOpaque = nil
+			} else {
+				v.PartitionKeyOpaque = new(bool)
+				*v.PartitionKeyOpaque = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkCookie) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Cookie parameter object
+type NetworkCookieParam struct {
+	/* Cookie name. */
+	Name string `json:"name"`
+	/* Cookie value. */
+	Value string `json:"value"`
+	/* The request-URI to associate with the setting of the cookie. This value can affect the
+	default domain, path, source port, and source scheme values of the created cookie. */
+	Url *string `json:"url,omitempty"`
+	/* Cookie domain. */
+	Domain *string `json:"domain,omitempty"`
+	/* Cookie path. */
+	Path *string `json:"path,omitempty"`
+	/* True if cookie is secure. */
+	Secure *bool `json:"secure,omitempty"`
+	/* True if cookie is http-only. */
+	HttpOnly *bool `json:"httpOnly,omitempty"`
+	/* Cookie SameSite type. */
+	SameSite *NetworkCookieSameSite `json:"sameSite,omitempty"`
+	/* Cookie expiration date, session cookie if not set */
+	Expires *NetworkTimeSinceEpoch `json:"expires,omitempty"`
+	/* Cookie Priority.
+
+	Experimental: this may change or be removed in any chrome release */
+	Priority *NetworkCookiePriority `json:"priority,omitempty"`
+	/* True if cookie is SameParty.
+
+	Experimental: this may change or be removed in any chrome release */
+	SameParty *bool `json:"sameParty,omitempty"`
+	/* Cookie source scheme type.
+
+	Experimental: this may change or be removed in any chrome release */
+	SourceScheme *NetworkCookieSourceScheme `json:"sourceScheme,omitempty"`
+	/* Cookie source port. Valid values are {-1, [1, 65535]}, -1 indicates an unspecified port.
+	An unspecified port value allows protocol clients to emulate legacy cookie scope for the port.
+	This is a temporary ability and it will be removed in the future.
+
+	Experimental: this may change or be removed in any chrome release */
+	SourcePort *int `json:"sourcePort,omitempty"`
+	/* Cookie partition key. If not set, the cookie will be set as not partitioned.
+
+	Experimental: this may change or be removed in any chrome release */
+	PartitionKey *NetworkCookiePartitionKey `json:"partitionKey,omitempty"`
+}
+
+// MarshalCDP writes NetworkCookieParam as JSON
+func (v *NetworkCookieParam) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("value")
+	e.String(v.Value)
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.Domain != nil {
+		e.Key("domain")
+		e.String(*v.Domain)
+	}
+	if v.Path != nil {
+		e.Key("path")
+		e.String(*v.Path)
+	}
+	if v.Secure != nil {
+		e.Key("secure")
+		e.Bool(*v.Secure)
+	}
+	if v.HttpOnly != nil {
+		e.Key("httpOnly")
+		e.Bool(*v.HttpOnly)
+	}
+	if v.SameSite != nil {
+		e.Key("sameSite")
+		e.String(string(*v.SameSite))
+	}
+	if v.Expires != nil {
+		e.Key("expires")
+		e.Float(float64(*v.Expires))
+	}
+	if v.Priority != nil {
+		e.Key("priority")
+		e.String(string(*v.Priority))
+	}
+	if v.SameParty != nil {
+		e.Key("sameParty")
+		e.Bool(*v.SameParty)
+	}
+	if v.SourceScheme != nil {
+		e.Key("sourceScheme")
+		e.String(string(*v.SourceScheme))
+	}
+	if v.SourcePort != nil {
+		e.Key("sourcePort")
+		e.Int(*v.SourcePort)
+	}
+	if v.PartitionKey != nil {
+		e.Key("partitionKey")
+		(*v.PartitionKey).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkCookieParam) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkCookieParam from JSON
+func (v *NetworkCookieParam) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "value":
+			v.Value = d.String()
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "domain":
+			if d.Null() {
+				v.Domain = nil
+			} else {
+				v.Domain = new(string)
+				*v.Domain = d.String()
+			}
+		case "path":
+			if d.Null() {
+				v.Path = nil
+			} else {
+				v.Path = new(string)
+				*v.Path = d.String()
+			}
+		case "secure":
+			if d.Null() {
+				v.Secure = nil
+			} else {
+				v.Secure = new(bool)
+				*v.Secure = d.Bool()
+			}
+		case "httpOnly":
+			if d.Null() {
+				v.HttpOnly = nil
+			} else {
+				v.HttpOnly = new(bool)
+				*v.HttpOnly = d.Bool()
+			}
+		case "sameSite":
+			if d.Null() {
+				v.SameSite = nil
+			} else {
+				v.SameSite = new(NetworkCookieSameSite)
+				*v.SameSite = NetworkCookieSameSite(d.String())
+			}
+		case "expires":
+			if d.Null() {
+				v.Expires = nil
+			} else {
+				v.Expires = new(NetworkTimeSinceEpoch)
+				*v.Expires = NetworkTimeSinceEpoch(d.Float())
+			}
+		case "priority":
+			if d.Null() {
+				v.Priority = nil
+			} else {
+				v.Priority = new(NetworkCookiePriority)
+				*v.Priority = NetworkCookiePriority(d.String())
+			}
+		case "sameParty":
+			if d.Null() {
+				v.SameParty = nil
+			} else {
+				v.SameParty = new(bool)
+				*v.SameParty = d.Bool()
+			}
+		case "sourceScheme":
+			if d.Null() {
+				v.SourceScheme = nil
+			} else {
+				v.SourceScheme = new(NetworkCookieSourceScheme)
+				*v.SourceScheme = NetworkCookieSourceScheme(d.String())
+			}
+		case "sourcePort":
+			if d.Null() {
+				v.SourcePort = nil
+			} else {
+				v.SourcePort = new(int)
+				*v.SourcePort = d.Int()
+			}
+		case "partitionKey":
+			if d.Null() {
+				v.PartitionKey = nil
+			} else {
+				v.PartitionKey = new(NetworkCookiePartitionKey)
+				(*v.PartitionKey).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkCookieParam) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Experimental: this may change or be removed in any chrome release
+type NetworkPrivateNetworkRequestPolicy string
+
+// NetworkPrivateNetworkRequestPolicy values
+const (
+	NetworkPrivateNetworkRequestPolicyAllow                          NetworkPrivateNetworkRequestPolicy = "Allow"
+	NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate NetworkPrivateNetworkRequestPolicy = "BlockFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate  NetworkPrivateNetworkRequestPolicy = "WarnFromInsecureToMorePrivate"
+	NetworkPrivateNetworkRequestPolicyPreflightBlock                 NetworkPrivateNetworkRequestPolicy = "PreflightBlock"
+	NetworkPrivateNetworkRequestPolicyPreflightWarn                  NetworkPrivateNetworkRequestPolicy = "PreflightWarn"
+	NetworkPrivateNetworkRequestPolicyPermissionBlock                NetworkPrivateNetworkRequestPolicy = "PermissionBlock"
+	NetworkPrivateNetworkRequestPolicyPermissionWarn                 NetworkPrivateNetworkRequestPolicy = "PermissionWarn"
+)
+
+// Values gives every NetworkPrivateNetworkRequestPolicy
+func (NetworkPrivateNetworkRequestPolicy) Values() []NetworkPrivateNetworkRequestPolicy {
+	return []NetworkPrivateNetworkRequestPolicy{
+		NetworkPrivateNetworkRequestPolicyAllow,
+		NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate,
+		NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate,
+		NetworkPrivateNetworkRequestPolicyPreflightBlock,
+		NetworkPrivateNetworkRequestPolicyPreflightWarn,
+		NetworkPrivateNetworkRequestPolicyPermissionBlock,
+		NetworkPrivateNetworkRequestPolicyPermissionWarn,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkPrivateNetworkRequestPolicy) Valid() bool {
+	switch v {
+	case NetworkPrivateNetworkRequestPolicyAllow, NetworkPrivateNetworkRequestPolicyBlockFromInsecureToMorePrivate, NetworkPrivateNetworkRequestPolicyWarnFromInsecureToMorePrivate, NetworkPrivateNetworkRequestPolicyPreflightBlock, NetworkPrivateNetworkRequestPolicyPreflightWarn, NetworkPrivateNetworkRequestPolicyPermissionBlock, NetworkPrivateNetworkRequestPolicyPermissionWarn:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type NetworkIPAddressSpace string
+
+// NetworkIPAddressSpace values
+const (
+	NetworkIPAddressSpaceLoopback NetworkIPAddressSpace = "Loopback"
+	NetworkIPAddressSpaceLocal    NetworkIPAddressSpace = "Local"
+	NetworkIPAddressSpacePublic   NetworkIPAddressSpace = "Public"
+	NetworkIPAddressSpaceUnknown  NetworkIPAddressSpace = "Unknown"
+)
+
+// Values gives every NetworkIPAddressSpace
+func (NetworkIPAddressSpace) Values() []NetworkIPAddressSpace {
+	return []NetworkIPAddressSpace{
+		NetworkIPAddressSpaceLoopback,
+		NetworkIPAddressSpaceLocal,
+		NetworkIPAddressSpacePublic,
+		NetworkIPAddressSpaceUnknown,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v NetworkIPAddressSpace) Valid() bool {
+	switch v {
+	case NetworkIPAddressSpaceLoopback, NetworkIPAddressSpaceLocal, NetworkIPAddressSpacePublic, NetworkIPAddressSpaceUnknown:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type NetworkClientSecurityState struct {
+	InitiatorIsSecureContext    bool                               `json:"initiatorIsSecureContext"`
+	InitiatorIPAddressSpace     NetworkIPAddressSpace              `json:"initiatorIPAddressSpace"`
+	PrivateNetworkRequestPolicy NetworkPrivateNetworkRequestPolicy `json:"privateNetworkRequestPolicy"`
+}
+
+// MarshalCDP writes NetworkClientSecurityState as JSON
+func (v *NetworkClientSecurityState) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("initiatorIsSecureContext")
+	e.Bool(v.InitiatorIsSecureContext)
+	e.Key("initiatorIPAddressSpace")
+	e.String(string(v.InitiatorIPAddressSpace))
+	e.Key("privateNetworkRequestPolicy")
+	e.String(string(v.PrivateNetworkRequestPolicy))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v NetworkClientSecurityState) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads NetworkClientSecurityState from JSON
+func (v *NetworkClientSecurityState) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "initiatorIsSecureContext":
+			v.InitiatorIsSecureContext = d.Bool()
+		case "initiatorIPAddressSpace":
+			v.InitiatorIPAddressSpace = NetworkIPAddressSpace(d.String())
+		case "privateNetworkRequestPolicy":
+			v.PrivateNetworkRequestPolicy = NetworkPrivateNetworkRequestPolicy(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NetworkClientSecurityState) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Unique frame identifier.
+type PageFrameId string
+
+// Viewport for capturing screenshot.
+type PageViewport struct {
+	/* X offset in device independent pixels (dip). */
+	X float64 `json:"x"`
+	/* Y offset in device independent pixels (dip). */
+	Y float64 `json:"y"`
+	/* Rectangle width in device independent pixels (dip). */
+	Width float64 `json:"width"`
+	/* Rectangle height in device independent pixels (dip). */
+	Height float64 `json:"height"`
+	/* Page scale factor. */
+	Scale float64 `json:"scale"`
+}
+
+// MarshalCDP writes PageViewport as JSON
+func (v *PageViewport) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("x")
+	e.Float(v.X)
+	e.Key("y")
+	e.Float(v.Y)
+	e.Key("width")
+	e.Float(v.Width)
+	e.Key("height")
+	e.Float(v.Height)
+	e.Key("scale")
+	e.Float(v.Scale)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v PageViewport) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads PageViewport from JSON
+func (v *PageViewport) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "x":
+			v.X = d.Float()
+		case "y":
+			v.Y = d.Float()
+		case "width":
+			v.Width = d.Float()
+		case "height":
+			v.Height = d.Float()
+		case "scale":
+			v.Scale = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *PageViewport) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// An internal certificate ID value.
+type SecurityCertificateId int
+
+// A description of mixed content (HTTP resources on HTTPS pages), as defined by
+// https://www.w3.org/TR/mixed-content/#categories
+type SecurityMixedContentType string
+
+// SecurityMixedContentType values
+const (
+	SecurityMixedContentTypeBlockable           SecurityMixedContentType = "blockable"
+	SecurityMixedContentTypeOptionallyBlockable SecurityMixedContentType = "optionally-blockable"
+	SecurityMixedContentTypeNone                SecurityMixedContentType = "none"
+)
+
+// Values gives every SecurityMixedContentType
+func (SecurityMixedContentType) Values() []SecurityMixedContentType {
+	return []SecurityMixedContentType{
+		SecurityMixedContentTypeBlockable,
+		SecurityMixedContentTypeOptionallyBlockable,
+		SecurityMixedContentTypeNone,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SecurityMixedContentType) Valid() bool {
+	switch v {
+	case SecurityMixedContentTypeBlockable, SecurityMixedContentTypeOptionallyBlockable, SecurityMixedContentTypeNone:
+		return true
+	}
+	return false
+}
+
+// The security level of a page or resource.
+type SecuritySecurityState string
+
+// SecuritySecurityState values
+const (
+	SecuritySecurityStateUnknown        SecuritySecurityState = "unknown"
+	SecuritySecurityStateNeutral        SecuritySecurityState = "neutral"
+	SecuritySecurityStateInsecure       SecuritySecurityState = "insecure"
+	SecuritySecurityStateSecure         SecuritySecurityState = "secure"
+	SecuritySecurityStateInfo           SecuritySecurityState = "info"
+	SecuritySecurityStateInsecureBroken SecuritySecurityState = "insecure-broken"
+)
+
+// Values gives every SecuritySecurityState
+func (SecuritySecurityState) Values() []SecuritySecurityState {
+	return []SecuritySecurityState{
+		SecuritySecurityStateUnknown,
+		SecuritySecurityStateNeutral,
+		SecuritySecurityStateInsecure,
+		SecuritySecurityStateSecure,
+		SecuritySecurityStateInfo,
+		SecuritySecurityStateInsecureBroken,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v SecuritySecurityState) Valid() bool {
+	switch v {
+	case SecuritySecurityStateUnknown, SecuritySecurityStateNeutral, SecuritySecurityStateInsecure, SecuritySecurityStateSecure, SecuritySecurityStateInfo, SecuritySecurityStateInsecureBroken:
+		return true
+	}
+	return false
+}
+
+type ServiceWorkerRegistrationID string
+
+type StorageSerializedStorageKey string
+
+type StorageStorageBucket struct {
+	StorageKey StorageSerializedStorageKey `json:"storageKey"`
+	/* If not specified, it is the default bucket of the storageKey. */
+	Name *string `json:"name,omitempty"`
+}
+
+// MarshalCDP writes StorageStorageBucket as JSON
+func (v *StorageStorageBucket) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("storageKey")
+	e.String(string(v.StorageKey))
+	if v.Name != nil {
+		e.Key("name")
+		e.String(*v.Name)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v StorageStorageBucket) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads StorageStorageBucket from JSON
+func (v *StorageStorageBucket) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "storageKey":
+			v.StorageKey = StorageSerializedStorageKey(d.String())
+		case "name":
+			if d.Null() {
+				v.Name = nil
+			} else {
+				v.Name = new(string)
+				*v.Name = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *StorageStorageBucket) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type TargetTargetID string
+
+// Location in the source code.
+type DebuggerLocation struct {
+	/* Script identifier as reported in the `Debugger.scriptParsed`. */
+	ScriptId RuntimeScriptId `json:"scriptId"`
+	/* Line number in the script (0-based). */
+	LineNumber int `json:"lineNumber"`
+	/* Column number in the script (0-based). */
+	ColumnNumber *int `json:"columnNumber,omitempty"`
+}
+
+// MarshalCDP writes DebuggerLocation as JSON
+func (v *DebuggerLocation) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	if v.ColumnNumber != nil {
+		e.Key("columnNumber")
+		e.Int(*v.ColumnNumber)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DebuggerLocation) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads DebuggerLocation from JSON
+func (v *DebuggerLocation) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "scriptId":
+			v.ScriptId = RuntimeScriptId(d.String())
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			if d.Null() {
+				v.ColumnNumber = nil
+			} else {
+				v.ColumnNumber = new(int)
+				*v.ColumnNumber = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DebuggerLocation) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Search match for resource.
+type DebuggerSearchMatch struct {
+	/* Line number in resource content. */
+	LineNumber float64 `json:"lineNumber"`
+	/* Line with match content. */
+	LineContent string `json:"lineContent"`
+}
+
+// MarshalCDP writes DebuggerSearchMatch as JSON
+func (v *DebuggerSearchMatch) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("lineNumber")
+	e.Float(v.LineNumber)
+	e.Key("lineContent")
+	e.String(v.LineContent)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v DebuggerSearchMatch) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads DebuggerSearchMatch from JSON
+func (v *DebuggerSearchMatch) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "lineNumber":
+			v.LineNumber = d.Float()
+		case "lineContent":
+			v.LineContent = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DebuggerSearchMatch) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Unique script identifier.
+type RuntimeScriptId string
+
+// Represents deep serialized value.
+type RuntimeDeepSerializedValue struct {
+	Type     RuntimeDeepSerializedValueType `json:"type"`
+	Value    interface{}                    `json:"value"`
+	ObjectId *string                        `json:"objectId,omitempty"`
+	/* Set if value reference met more then once during serialization. In such
+	case, value is provided only to one of the serialized values. Unique
+	per value in the scope of one CDP call. */
+	WeakLocalObjectReference *int `json:"weakLocalObjectReference,omitempty"`
+}
+
+// MarshalCDP writes RuntimeDeepSerializedValue as JSON
+func (v *RuntimeDeepSerializedValue) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Value != nil {
+		e.Key("value")
+		e.Interface(v.Value)
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(*v.ObjectId)
+	}
+	if v.WeakLocalObjectReference != nil {
+		e.Key("weakLocalObjectReference")
+		e.Int(*v.WeakLocalObjectReference)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeDeepSerializedValue) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeDeepSerializedValue from JSON
+func (v *RuntimeDeepSerializedValue) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = RuntimeDeepSerializedValueType(d.String())
+		case "value":
+			v.Value = d.Interface()
+		case "objectId":
+			if d.Null() {
+				v.ObjectId = nil
+			} else {
+				v.ObjectId = new(string)
+				*v.ObjectId = d.String()
+			}
+		case "weakLocalObjectReference":
+			if d.Null() {
+				v.WeakLocalObjectReference = nil
+			} else {
+				v.WeakLocalObjectReference = new(int)
+				*v.WeakLocalObjectReference = d.Int()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeDeepSerializedValue) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type RuntimeDeepSerializedValueType string
+
+// RuntimeDeepSerializedValueType values
+const (
+	RuntimeDeepSerializedValueTypeUndefined   RuntimeDeepSerializedValueType = "undefined"
+	RuntimeDeepSerializedValueTypeNull        RuntimeDeepSerializedValueType = "null"
+	RuntimeDeepSerializedValueTypeString      RuntimeDeepSerializedValueType = "string"
+	RuntimeDeepSerializedValueTypeNumber      RuntimeDeepSerializedValueType = "number"
+	RuntimeDeepSerializedValueTypeBoolean     RuntimeDeepSerializedValueType = "boolean"
+	RuntimeDeepSerializedValueTypeBigint      RuntimeDeepSerializedValueType = "bigint"
+	RuntimeDeepSerializedValueTypeRegexp      RuntimeDeepSerializedValueType = "regexp"
+	RuntimeDeepSerializedValueTypeDate        RuntimeDeepSerializedValueType = "date"
+	RuntimeDeepSerializedValueTypeSymbol      RuntimeDeepSerializedValueType = "symbol"
+	RuntimeDeepSerializedValueTypeArray       RuntimeDeepSerializedValueType = "array"
+	RuntimeDeepSerializedValueTypeObject      RuntimeDeepSerializedValueType = "object"
+	RuntimeDeepSerializedValueTypeFunction    RuntimeDeepSerializedValueType = "function"
+	RuntimeDeepSerializedValueTypeMap         RuntimeDeepSerializedValueType = "map"
+	RuntimeDeepSerializedValueTypeSet         RuntimeDeepSerializedValueType = "set"
+	RuntimeDeepSerializedValueTypeWeakmap     RuntimeDeepSerializedValueType = "weakmap"
+	RuntimeDeepSerializedValueTypeWeakset     RuntimeDeepSerializedValueType = "weakset"
+	RuntimeDeepSerializedValueTypeError       RuntimeDeepSerializedValueType = "error"
+	RuntimeDeepSerializedValueTypeProxy       RuntimeDeepSerializedValueType = "proxy"
+	RuntimeDeepSerializedValueTypePromise     RuntimeDeepSerializedValueType = "promise"
+	RuntimeDeepSerializedValueTypeTypedarray  RuntimeDeepSerializedValueType = "typedarray"
+	RuntimeDeepSerializedValueTypeArraybuffer RuntimeDeepSerializedValueType = "arraybuffer"
+	RuntimeDeepSerializedValueTypeNode        RuntimeDeepSerializedValueType = "node"
+	RuntimeDeepSerializedValueTypeWindow      RuntimeDeepSerializedValueType = "window"
+	RuntimeDeepSerializedValueTypeGenerator   RuntimeDeepSerializedValueType = "generator"
+)
+
+// Values gives every RuntimeDeepSerializedValueType
+func (RuntimeDeepSerializedValueType) Values() []RuntimeDeepSerializedValueType {
+	return []RuntimeDeepSerializedValueType{
+		RuntimeDeepSerializedValueTypeUndefined,
+		RuntimeDeepSerializedValueTypeNull,
+		RuntimeDeepSerializedValueTypeString,
+		RuntimeDeepSerializedValueTypeNumber,
+		RuntimeDeepSerializedValueTypeBoolean,
+		RuntimeDeepSerializedValueTypeBigint,
+		RuntimeDeepSerializedValueTypeRegexp,
+		RuntimeDeepSerializedValueTypeDate,
+		RuntimeDeepSerializedValueTypeSymbol,
+		RuntimeDeepSerializedValueTypeArray,
+		RuntimeDeepSerializedValueTypeObject,
+		RuntimeDeepSerializedValueTypeFunction,
+		RuntimeDeepSerializedValueTypeMap,
+		RuntimeDeepSerializedValueTypeSet,
+		RuntimeDeepSerializedValueTypeWeakmap,
+		RuntimeDeepSerializedValueTypeWeakset,
+		RuntimeDeepSerializedValueTypeError,
+		RuntimeDeepSerializedValueTypeProxy,
+		RuntimeDeepSerializedValueTypePromise,
+		RuntimeDeepSerializedValueTypeTypedarray,
+		RuntimeDeepSerializedValueTypeArraybuffer,
+		RuntimeDeepSerializedValueTypeNode,
+		RuntimeDeepSerializedValueTypeWindow,
+		RuntimeDeepSerializedValueTypeGenerator,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimeDeepSerializedValueType) Valid() bool {
+	switch v {
+	case RuntimeDeepSerializedValueTypeUndefined, RuntimeDeepSerializedValueTypeNull, RuntimeDeepSerializedValueTypeString, RuntimeDeepSerializedValueTypeNumber, RuntimeDeepSerializedValueTypeBoolean, RuntimeDeepSerializedValueTypeBigint, RuntimeDeepSerializedValueTypeRegexp, RuntimeDeepSerializedValueTypeDate, RuntimeDeepSerializedValueTypeSymbol, RuntimeDeepSerializedValueTypeArray, RuntimeDeepSerializedValueTypeObject, RuntimeDeepSerializedValueTypeFunction, RuntimeDeepSerializedValueTypeMap, RuntimeDeepSerializedValueTypeSet, RuntimeDeepSerializedValueTypeWeakmap, RuntimeDeepSerializedValueTypeWeakset, RuntimeDeepSerializedValueTypeError, RuntimeDeepSerializedValueTypeProxy, RuntimeDeepSerializedValueTypePromise, RuntimeDeepSerializedValueTypeTypedarray, RuntimeDeepSerializedValueTypeArraybuffer, RuntimeDeepSerializedValueTypeNode, RuntimeDeepSerializedValueTypeWindow, RuntimeDeepSerializedValueTypeGenerator:
+		return true
+	}
+	return false
+}
+
+// Unique object identifier.
+type RuntimeRemoteObjectId string
+
+// Primitive value which cannot be JSON-stringified. Includes values `-0`, `NaN`, `Infinity`,
+// `-Infinity`, and bigint literals.
+type RuntimeUnserializableValue string
+
+// Mirror object referencing original JavaScript object.
+type RuntimeRemoteObject struct {
+	/* Object type. */
+	Type RuntimeRemoteObjectType `json:"type"`
+	/* Object subtype hint. Specified for `object` type values only.
+	NOTE: If you change anything here, make sure to also update
+	`subtype` in `ObjectPreview` and `PropertyPreview` below. */
+	Subtype *RuntimeRemoteObjectSubtype `json:"subtype,omitempty"`
+	/* Object class (constructor) name. Specified for `object` type values only. */
+	ClassName *string `json:"className,omitempty"`
+	/* Remote object value in case of primitive values or JSON values (if it was requested). */
+	Value interface{} `json:"value"`
+	/* Primitive value which can not be JSON-stringified does not have `value`, but gets this
+	property. */
+	UnserializableValue *RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	/* String representation of the object. */
+	Description *string `json:"description,omitempty"`
+	/* Deep serialized value.
+
+	Experimental: this may change or be removed in any chrome release */
+	DeepSerializedValue *RuntimeDeepSerializedValue `json:"deepSerializedValue,omitempty"`
+	/* Unique object identifier (for non-primitive values). */
+	ObjectId *RuntimeRemoteObjectId `json:"objectId,omitempty"`
+	/* Preview containing abbreviated property values. Specified for `object` type values only.
+
+	Experimental: this may change or be removed in any chrome release */
+	Preview *RuntimeObjectPreview `json:"preview,omitempty"`
+	/* Experimental: this may change or be removed in any chrome release */
+	CustomPreview *RuntimeCustomPreview `json:"customPreview,omitempty"`
+}
+
+// MarshalCDP writes RuntimeRemoteObject as JSON
+func (v *RuntimeRemoteObject) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Subtype != nil {
+		e.Key("subtype")
+		e.String(string(*v.Subtype))
+	}
+	if v.ClassName != nil {
+		e.Key("className")
+		e.String(*v.ClassName)
+	}
+	if v.Value != nil {
+		e.Key("value")
+		e.Interface(v.Value)
+	}
+	if v.UnserializableValue != nil {
+		e.Key("unserializableValue")
+		e.String(string(*v.UnserializableValue))
+	}
+	if v.Description != nil {
+		e.Key("description")
+		e.String(*v.Description)
+	}
+	if v.DeepSerializedValue != nil {
+		e.Key("deepSerializedValue")
+		(*v.DeepSerializedValue).MarshalCDP(e)
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	if v.Preview != nil {
+		e.Key("preview")
+		(*v.Preview).MarshalCDP(e)
+	}
+	if v.CustomPreview != nil {
+		e.Key("customPreview")
+		(*v.CustomPreview).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeRemoteObject) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeRemoteObject from JSON
+func (v *RuntimeRemoteObject) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = RuntimeRemoteObjectType(d.String())
+		case "subtype":
+			if d.Null() {
+				v.Subtype = nil
+			} else {
+				v.Subtype = new(RuntimeRemoteObjectSubtype)
+				*v.Subtype = RuntimeRemoteObjectSubtype(d.String())
+			}
+		case "className":
+			if d.Null() {
+				v.ClassName = nil
+			} else {
+				v.ClassName = new(string)
+				*v.ClassName = d.String()
+			}
+		case "value":
+			v.Value = d.Interface()
+		case "unserializableValue":
+			if d.Null() {
+				v.UnserializableValue = nil
+			} else {
+				v.UnserializableValue = new(RuntimeUnserializableValue)
+				*v.UnserializableValue = RuntimeUnserializableValue(d.String())
+			}
+		case "description":
+			if d.Null() {
+				v.Description = nil
+			} else {
+				v.Description = new(string)
+				*v.Description = d.String()
+			}
+		case "deepSerializedValue":
+			if d.Null() {
+				v.DeepSerializedValue = nil
+			} else {
+				v.DeepSerializedValue = new(RuntimeDeepSerializedValue)
+				(*v.DeepSerializedValue).UnmarshalCDP(d)
+			}
+		case "objectId":
+			if d.Null() {
+				v.ObjectId = nil
+			} else {
+				v.ObjectId = new(RuntimeRemoteObjectId)
+				*v.ObjectId = RuntimeRemoteObjectId(d.String())
+			}
+		case "preview":
+			if d.Null() {
+				v.Preview = nil
+			} else {
+				v.Preview = new(RuntimeObjectPreview)
+				(*v.Preview).UnmarshalCDP(d)
+			}
+		case "customPreview":
+			if d.Null() {
+				v.CustomPreview = nil
+			} else {
+				v.CustomPreview = new(RuntimeCustomPreview)
+				(*v.CustomPreview).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeRemoteObject) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type RuntimeRemoteObjectType string
+
+// RuntimeRemoteObjectType values
+const (
+	RuntimeRemoteObjectTypeObject    RuntimeRemoteObjectType = "object"
+	RuntimeRemoteObjectTypeFunction  RuntimeRemoteObjectType = "function"
+	RuntimeRemoteObjectTypeUndefined RuntimeRemoteObjectType = "undefined"
+	RuntimeRemoteObjectTypeString    RuntimeRemoteObjectType = "string"
+	RuntimeRemoteObjectTypeNumber    RuntimeRemoteObjectType = "number"
+	RuntimeRemoteObjectTypeBoolean   RuntimeRemoteObjectType = "boolean"
+	RuntimeRemoteObjectTypeSymbol    RuntimeRemoteObjectType = "symbol"
+	RuntimeRemoteObjectTypeBigint    RuntimeRemoteObjectType = "bigint"
+)
+
+// Values gives every RuntimeRemoteObjectType
+func (RuntimeRemoteObjectType) Values() []RuntimeRemoteObjectType {
+	return []RuntimeRemoteObjectType{
+		RuntimeRemoteObjectTypeObject,
+		RuntimeRemoteObjectTypeFunction,
+		RuntimeRemoteObjectTypeUndefined,
+		RuntimeRemoteObjectTypeString,
+		RuntimeRemoteObjectTypeNumber,
+		RuntimeRemoteObjectTypeBoolean,
+		RuntimeRemoteObjectTypeSymbol,
+		RuntimeRemoteObjectTypeBigint,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimeRemoteObjectType) Valid() bool {
+	switch v {
+	case RuntimeRemoteObjectTypeObject, RuntimeRemoteObjectTypeFunction, RuntimeRemoteObjectTypeUndefined, RuntimeRemoteObjectTypeString, RuntimeRemoteObjectTypeNumber, RuntimeRemoteObjectTypeBoolean, RuntimeRemoteObjectTypeSymbol, RuntimeRemoteObjectTypeBigint:
+		return true
+	}
+	return false
+}
+
+type RuntimeRemoteObjectSubtype string
+
+// RuntimeRemoteObjectSubtype values
+const (
+	RuntimeRemoteObjectSubtypeArray             RuntimeRemoteObjectSubtype = "array"
+	RuntimeRemoteObjectSubtypeNull              RuntimeRemoteObjectSubtype = "null"
+	RuntimeRemoteObjectSubtypeNode              RuntimeRemoteObjectSubtype = "node"
+	RuntimeRemoteObjectSubtypeRegexp            RuntimeRemoteObjectSubtype = "regexp"
+	RuntimeRemoteObjectSubtypeDate              RuntimeRemoteObjectSubtype = "date"
+	RuntimeRemoteObjectSubtypeMap               RuntimeRemoteObjectSubtype = "map"
+	RuntimeRemoteObjectSubtypeSet               RuntimeRemoteObjectSubtype = "set"
+	RuntimeRemoteObjectSubtypeWeakmap           RuntimeRemoteObjectSubtype = "weakmap"
+	RuntimeRemoteObjectSubtypeWeakset           RuntimeRemoteObjectSubtype = "weakset"
+	RuntimeRemoteObjectSubtypeIterator          RuntimeRemoteObjectSubtype = "iterator"
+	RuntimeRemoteObjectSubtypeGenerator         RuntimeRemoteObjectSubtype = "generator"
+	RuntimeRemoteObjectSubtypeError             RuntimeRemoteObjectSubtype = "error"
+	RuntimeRemoteObjectSubtypeProxy             RuntimeRemoteObjectSubtype = "proxy"
+	RuntimeRemoteObjectSubtypePromise           RuntimeRemoteObjectSubtype = "promise"
+	RuntimeRemoteObjectSubtypeTypedarray        RuntimeRemoteObjectSubtype = "typedarray"
+	RuntimeRemoteObjectSubtypeArraybuffer       RuntimeRemoteObjectSubtype = "arraybuffer"
+	RuntimeRemoteObjectSubtypeDataview          RuntimeRemoteObjectSubtype = "dataview"
+	RuntimeRemoteObjectSubtypeWebassemblymemory RuntimeRemoteObjectSubtype = "webassemblymemory"
+	RuntimeRemoteObjectSubtypeWasmvalue         RuntimeRemoteObjectSubtype = "wasmvalue"
+)
+
+// Values gives every RuntimeRemoteObjectSubtype
+func (RuntimeRemoteObjectSubtype) Values() []RuntimeRemoteObjectSubtype {
+	return []RuntimeRemoteObjectSubtype{
+		RuntimeRemoteObjectSubtypeArray,
+		RuntimeRemoteObjectSubtypeNull,
+		RuntimeRemoteObjectSubtypeNode,
+		RuntimeRemoteObjectSubtypeRegexp,
+		RuntimeRemoteObjectSubtypeDate,
+		RuntimeRemoteObjectSubtypeMap,
+		RuntimeRemoteObjectSubtypeSet,
+		RuntimeRemoteObjectSubtypeWeakmap,
+		RuntimeRemoteObjectSubtypeWeakset,
+		RuntimeRemoteObjectSubtypeIterator,
+		RuntimeRemoteObjectSubtypeGenerator,
+		RuntimeRemoteObjectSubtypeError,
+		RuntimeRemoteObjectSubtypeProxy,
+		RuntimeRemoteObjectSubtypePromise,
+		RuntimeRemoteObjectSubtypeTypedarray,
+		RuntimeRemoteObjectSubtypeArraybuffer,
+		RuntimeRemoteObjectSubtypeDataview,
+		RuntimeRemoteObjectSubtypeWebassemblymemory,
+		RuntimeRemoteObjectSubtypeWasmvalue,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimeRemoteObjectSubtype) Valid() bool {
+	switch v {
+	case RuntimeRemoteObjectSubtypeArray, RuntimeRemoteObjectSubtypeNull, RuntimeRemoteObjectSubtypeNode, RuntimeRemoteObjectSubtypeRegexp, RuntimeRemoteObjectSubtypeDate, RuntimeRemoteObjectSubtypeMap, RuntimeRemoteObjectSubtypeSet, RuntimeRemoteObjectSubtypeWeakmap, RuntimeRemoteObjectSubtypeWeakset, RuntimeRemoteObjectSubtypeIterator, RuntimeRemoteObjectSubtypeGenerator, RuntimeRemoteObjectSubtypeError, RuntimeRemoteObjectSubtypeProxy, RuntimeRemoteObjectSubtypePromise, RuntimeRemoteObjectSubtypeTypedarray, RuntimeRemoteObjectSubtypeArraybuffer, RuntimeRemoteObjectSubtypeDataview, RuntimeRemoteObjectSubtypeWebassemblymemory, RuntimeRemoteObjectSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type RuntimeCustomPreview struct {
+	/* The JSON-stringified result of formatter.header(object, config) call.
+	It contains json ML array that represents RemoteObject. */
+	Header string `json:"header"`
+	/* If formatter returns true as a result of formatter.hasBody call then bodyGetterId will
+	contain RemoteObjectId for the function that returns result of formatter.body(object, config) call.
+	The result value is json ML array. */
+	BodyGetterId *RuntimeRemoteObjectId `json:"bodyGetterId,omitempty"`
+}
+
+// MarshalCDP writes RuntimeCustomPreview as JSON
+func (v *RuntimeCustomPreview) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("header")
+	e.String(v.Header)
+	if v.BodyGetterId != nil {
+		e.Key("bodyGetterId")
+		e.String(string(*v.BodyGetterId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeCustomPreview) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeCustomPreview from JSON
+func (v *RuntimeCustomPreview) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "header":
+			v.Header = d.String()
+		case "bodyGetterId":
+			if d.Null() {
+				v.BodyGetterId = nil
+			} else {
+				v.BodyGetterId = new(RuntimeRemoteObjectId)
+				*v.BodyGetterId = RuntimeRemoteObjectId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeCustomPreview) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Object containing abbreviated remote object value.
+//
+// Experimental: this may change or be removed in any chrome release
+type RuntimeObjectPreview struct {
+	/* Object type. */
+	Type RuntimeObjectPreviewType `json:"type"`
+	/* Object subtype hint. Specified for `object` type values only. */
+	Subtype *RuntimeObjectPreviewSubtype `json:"subtype,omitempty"`
+	/* String representation of the object. */
+	Description *string `json:"description,omitempty"`
+	/* True iff some of the properties or entries of the original object did not fit. */
+	Overflow bool `json:"overflow"`
+	/* List of the properties. */
+	Properties []RuntimePropertyPreview `json:"properties"`
+	/* List of the entries. Specified for `map` and `set` subtype values only. */
+	Entries []RuntimeEntryPreview `json:"entries"`
+}
+
+// MarshalCDP writes RuntimeObjectPreview as JSON
+func (v *RuntimeObjectPreview) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Subtype != nil {
+		e.Key("subtype")
+		e.String(string(*v.Subtype))
+	}
+	if v.Description != nil {
+		e.Key("description")
+		e.String(*v.Description)
+	}
+	e.Key("overflow")
+	e.Bool(v.Overflow)
+	e.Key("properties")
+	if v.Properties == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.Properties {
+			e.Elem()
+			v.Properties[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Entries != nil {
+		e.Key("entries")
+		e.ArrayStart()
+		for i0 := range v.Entries {
+			e.Elem()
+			v.Entries[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeObjectPreview) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeObjectPreview from JSON
+func (v *RuntimeObjectPreview) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "type":
+			v.Type = RuntimeObjectPreviewType(d.String())
+		case "subtype":
+			if d.Null() {
+				v.Subtype = nil
+			} else {
+				v.Subtype = new(RuntimeObjectPreviewSubtype)
+				*v.Subtype = RuntimeObjectPreviewSubtype(d.String())
+			}
+		case "description":
+			if d.Null() {
+				v.Description = nil
+			} else {
+				v.Description = new(string)
+				*v.Description = d.String()
+			}
+		case "overflow":
+			v.Overflow = d.Bool()
+		case "properties":
+			if d.Array() {
+				v.Properties = make([]RuntimePropertyPreview, 0)
+				for d.More() {
+					var x0 RuntimePropertyPreview
+					x0.UnmarshalCDP(d)
+					v.Properties = append(v.Properties, x0)
+				}
+			} else {
+				v.Properties = nil
+			}
+		case "entries":
+			if d.Array() {
+				v.Entries = make([]RuntimeEntryPreview, 0)
+				for d.More() {
+					var x0 RuntimeEntryPreview
+					x0.UnmarshalCDP(d)
+					v.Entries = append(v.Entries, x0)
+				}
+			} else {
+				v.Entries = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeObjectPreview) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type RuntimeObjectPreviewType string
+
+// RuntimeObjectPreviewType values
+const (
+	RuntimeObjectPreviewTypeObject    RuntimeObjectPreviewType = "object"
+	RuntimeObjectPreviewTypeFunction  RuntimeObjectPreviewType = "function"
+	RuntimeObjectPreviewTypeUndefined RuntimeObjectPreviewType = "undefined"
+	RuntimeObjectPreviewTypeString    RuntimeObjectPreviewType = "string"
+	RuntimeObjectPreviewTypeNumber    RuntimeObjectPreviewType = "number"
+	RuntimeObjectPreviewTypeBoolean   RuntimeObjectPreviewType = "boolean"
+	RuntimeObjectPreviewTypeSymbol    RuntimeObjectPreviewType = "symbol"
+	RuntimeObjectPreviewTypeBigint    RuntimeObjectPreviewType = "bigint"
+)
+
+// Values gives every RuntimeObjectPreviewType
+func (RuntimeObjectPreviewType) Values() []RuntimeObjectPreviewType {
+	return []RuntimeObjectPreviewType{
+		RuntimeObjectPreviewTypeObject,
+		RuntimeObjectPreviewTypeFunction,
+		RuntimeObjectPreviewTypeUndefined,
+		RuntimeObjectPreviewTypeString,
+		RuntimeObjectPreviewTypeNumber,
+		RuntimeObjectPreviewTypeBoolean,
+		RuntimeObjectPreviewTypeSymbol,
+		RuntimeObjectPreviewTypeBigint,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimeObjectPreviewType) Valid() bool {
+	switch v {
+	case RuntimeObjectPreviewTypeObject, RuntimeObjectPreviewTypeFunction, RuntimeObjectPreviewTypeUndefined, RuntimeObjectPreviewTypeString, RuntimeObjectPreviewTypeNumber, RuntimeObjectPreviewTypeBoolean, RuntimeObjectPreviewTypeSymbol, RuntimeObjectPreviewTypeBigint:
+		return true
+	}
+	return false
+}
+
+type RuntimeObjectPreviewSubtype string
+
+// RuntimeObjectPreviewSubtype values
+const (
+	RuntimeObjectPreviewSubtypeArray             RuntimeObjectPreviewSubtype = "array"
+	RuntimeObjectPreviewSubtypeNull              RuntimeObjectPreviewSubtype = "null"
+	RuntimeObjectPreviewSubtypeNode              RuntimeObjectPreviewSubtype = "node"
+	RuntimeObjectPreviewSubtypeRegexp            RuntimeObjectPreviewSubtype = "regexp"
+	RuntimeObjectPreviewSubtypeDate              RuntimeObjectPreviewSubtype = "date"
+	RuntimeObjectPreviewSubtypeMap               RuntimeObjectPreviewSubtype = "map"
+	RuntimeObjectPreviewSubtypeSet               RuntimeObjectPreviewSubtype = "set"
+	RuntimeObjectPreviewSubtypeWeakmap           RuntimeObjectPreviewSubtype = "weakmap"
+	RuntimeObjectPreviewSubtypeWeakset           RuntimeObjectPreviewSubtype = "weakset"
+	RuntimeObjectPreviewSubtypeIterator          RuntimeObjectPreviewSubtype = "iterator"
+	RuntimeObjectPreviewSubtypeGenerator         RuntimeObjectPreviewSubtype = "generator"
+	RuntimeObjectPreviewSubtypeError             RuntimeObjectPreviewSubtype = "error"
+	RuntimeObjectPreviewSubtypeProxy             RuntimeObjectPreviewSubtype = "proxy"
+	RuntimeObjectPreviewSubtypePromise           RuntimeObjectPreviewSubtype = "promise"
+	RuntimeObjectPreviewSubtypeTypedarray        RuntimeObjectPreviewSubtype = "typedarray"
+	RuntimeObjectPreviewSubtypeArraybuffer       RuntimeObjectPreviewSubtype = "arraybuffer"
+	RuntimeObjectPreviewSubtypeDataview          RuntimeObjectPreviewSubtype = "dataview"
+	RuntimeObjectPreviewSubtypeWebassemblymemory RuntimeObjectPreviewSubtype = "webassemblymemory"
+	RuntimeObjectPreviewSubtypeWasmvalue         RuntimeObjectPreviewSubtype = "wasmvalue"
+)
+
+// Values gives every RuntimeObjectPreviewSubtype
+func (RuntimeObjectPreviewSubtype) Values() []RuntimeObjectPreviewSubtype {
+	return []RuntimeObjectPreviewSubtype{
+		RuntimeObjectPreviewSubtypeArray,
+		RuntimeObjectPreviewSubtypeNull,
+		RuntimeObjectPreviewSubtypeNode,
+		RuntimeObjectPreviewSubtypeRegexp,
+		RuntimeObjectPreviewSubtypeDate,
+		RuntimeObjectPreviewSubtypeMap,
+		RuntimeObjectPreviewSubtypeSet,
+		RuntimeObjectPreviewSubtypeWeakmap,
+		RuntimeObjectPreviewSubtypeWeakset,
+		RuntimeObjectPreviewSubtypeIterator,
+		RuntimeObjectPreviewSubtypeGenerator,
+		RuntimeObjectPreviewSubtypeError,
+		RuntimeObjectPreviewSubtypeProxy,
+		RuntimeObjectPreviewSubtypePromise,
+		RuntimeObjectPreviewSubtypeTypedarray,
+		RuntimeObjectPreviewSubtypeArraybuffer,
+		RuntimeObjectPreviewSubtypeDataview,
+		RuntimeObjectPreviewSubtypeWebassemblymemory,
+		RuntimeObjectPreviewSubtypeWasmvalue,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimeObjectPreviewSubtype) Valid() bool {
+	switch v {
+	case RuntimeObjectPreviewSubtypeArray, RuntimeObjectPreviewSubtypeNull, RuntimeObjectPreviewSubtypeNode, RuntimeObjectPreviewSubtypeRegexp, RuntimeObjectPreviewSubtypeDate, RuntimeObjectPreviewSubtypeMap, RuntimeObjectPreviewSubtypeSet, RuntimeObjectPreviewSubtypeWeakmap, RuntimeObjectPreviewSubtypeWeakset, RuntimeObjectPreviewSubtypeIterator, RuntimeObjectPreviewSubtypeGenerator, RuntimeObjectPreviewSubtypeError, RuntimeObjectPreviewSubtypeProxy, RuntimeObjectPreviewSubtypePromise, RuntimeObjectPreviewSubtypeTypedarray, RuntimeObjectPreviewSubtypeArraybuffer, RuntimeObjectPreviewSubtypeDataview, RuntimeObjectPreviewSubtypeWebassemblymemory, RuntimeObjectPreviewSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type RuntimePropertyPreview struct {
+	/* Property name. */
+	Name string `json:"name"`
+	/* Object type. Accessor means that the property itself is an accessor property. */
+	Type RuntimePropertyPreviewType `json:"type"`
+	/* User-friendly property value string. */
+	Value *string `json:"value,omitempty"`
+	/* Nested value preview. */
+	ValuePreview *RuntimeObjectPreview `json:"valuePreview,omitempty"`
+	/* Object subtype hint. Specified for `object` type values only. */
+	Subtype *RuntimePropertyPreviewSubtype `json:"subtype,omitempty"`
+}
+
+// MarshalCDP writes RuntimePropertyPreview as JSON
+func (v *RuntimePropertyPreview) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("name")
+	e.String(v.Name)
+	e.Key("type")
+	e.String(string(v.Type))
+	if v.Value != nil {
+		e.Key("value")
+		e.String(*v.Value)
+	}
+	if v.ValuePreview != nil {
+		e.Key("valuePreview")
+		(*v.ValuePreview).MarshalCDP(e)
+	}
+	if v.Subtype != nil {
+		e.Key("subtype")
+		e.String(string(*v.Subtype))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimePropertyPreview) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimePropertyPreview from JSON
+func (v *RuntimePropertyPreview) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "name":
+			v.Name = d.String()
+		case "type":
+			v.Type = RuntimePropertyPreviewType(d.String())
+		case "value":
+			if d.Null() {
+				v.Value = nil
+			} else {
+				v.Value = new(string)
+				*v.Value = d.String()
+			}
+		case "valuePreview":
+			if d.Null() {
+				v.ValuePreview = nil
+			} else {
+				v.ValuePreview = new(RuntimeObjectPreview)
+				(*v.ValuePreview).UnmarshalCDP(d)
+			}
+		case "subtype":
+			if d.Null() {
+				v.Subtype = nil
+			} else {
+				v.Subtype = new(RuntimePropertyPreviewSubtype)
+				*v.Subtype = RuntimePropertyPreviewSubtype(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimePropertyPreview) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+type RuntimePropertyPreviewType string
+
+// RuntimePropertyPreviewType values
+const (
+	RuntimePropertyPreviewTypeObject    RuntimePropertyPreviewType = "object"
+	RuntimePropertyPreviewTypeFunction  RuntimePropertyPreviewType = "function"
+	RuntimePropertyPreviewTypeUndefined RuntimePropertyPreviewType = "undefined"
+	RuntimePropertyPreviewTypeString    RuntimePropertyPreviewType = "string"
+	RuntimePropertyPreviewTypeNumber    RuntimePropertyPreviewType = "number"
+	RuntimePropertyPreviewTypeBoolean   RuntimePropertyPreviewType = "boolean"
+	RuntimePropertyPreviewTypeSymbol    RuntimePropertyPreviewType = "symbol"
+	RuntimePropertyPreviewTypeAccessor  RuntimePropertyPreviewType = "accessor"
+	RuntimePropertyPreviewTypeBigint    RuntimePropertyPreviewType = "bigint"
+)
+
+// Values gives every RuntimePropertyPreviewType
+func (RuntimePropertyPreviewType) Values() []RuntimePropertyPreviewType {
+	return []RuntimePropertyPreviewType{
+		RuntimePropertyPreviewTypeObject,
+		RuntimePropertyPreviewTypeFunction,
+		RuntimePropertyPreviewTypeUndefined,
+		RuntimePropertyPreviewTypeString,
+		RuntimePropertyPreviewTypeNumber,
+		RuntimePropertyPreviewTypeBoolean,
+		RuntimePropertyPreviewTypeSymbol,
+		RuntimePropertyPreviewTypeAccessor,
+		RuntimePropertyPreviewTypeBigint,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimePropertyPreviewType) Valid() bool {
+	switch v {
+	case RuntimePropertyPreviewTypeObject, RuntimePropertyPreviewTypeFunction, RuntimePropertyPreviewTypeUndefined, RuntimePropertyPreviewTypeString, RuntimePropertyPreviewTypeNumber, RuntimePropertyPreviewTypeBoolean, RuntimePropertyPreviewTypeSymbol, RuntimePropertyPreviewTypeAccessor, RuntimePropertyPreviewTypeBigint:
+		return true
+	}
+	return false
+}
+
+type RuntimePropertyPreviewSubtype string
+
+// RuntimePropertyPreviewSubtype values
+const (
+	RuntimePropertyPreviewSubtypeArray             RuntimePropertyPreviewSubtype = "array"
+	RuntimePropertyPreviewSubtypeNull              RuntimePropertyPreviewSubtype = "null"
+	RuntimePropertyPreviewSubtypeNode              RuntimePropertyPreviewSubtype = "node"
+	RuntimePropertyPreviewSubtypeRegexp            RuntimePropertyPreviewSubtype = "regexp"
+	RuntimePropertyPreviewSubtypeDate              RuntimePropertyPreviewSubtype = "date"
+	RuntimePropertyPreviewSubtypeMap               RuntimePropertyPreviewSubtype = "map"
+	RuntimePropertyPreviewSubtypeSet               RuntimePropertyPreviewSubtype = "set"
+	RuntimePropertyPreviewSubtypeWeakmap           RuntimePropertyPreviewSubtype = "weakmap"
+	RuntimePropertyPreviewSubtypeWeakset           RuntimePropertyPreviewSubtype = "weakset"
+	RuntimePropertyPreviewSubtypeIterator          RuntimePropertyPreviewSubtype = "iterator"
+	RuntimePropertyPreviewSubtypeGenerator         RuntimePropertyPreviewSubtype = "generator"
+	RuntimePropertyPreviewSubtypeError             RuntimePropertyPreviewSubtype = "error"
+	RuntimePropertyPreviewSubtypeProxy             RuntimePropertyPreviewSubtype = "proxy"
+	RuntimePropertyPreviewSubtypePromise           RuntimePropertyPreviewSubtype = "promise"
+	RuntimePropertyPreviewSubtypeTypedarray        RuntimePropertyPreviewSubtype = "typedarray"
+	RuntimePropertyPreviewSubtypeArraybuffer       RuntimePropertyPreviewSubtype = "arraybuffer"
+	RuntimePropertyPreviewSubtypeDataview          RuntimePropertyPreviewSubtype = "dataview"
+	RuntimePropertyPreviewSubtypeWebassemblymemory RuntimePropertyPreviewSubtype = "webassemblymemory"
+	RuntimePropertyPreviewSubtypeWasmvalue         RuntimePropertyPreviewSubtype = "wasmvalue"
+)
+
+// Values gives every RuntimePropertyPreviewSubtype
+func (RuntimePropertyPreviewSubtype) Values() []RuntimePropertyPreviewSubtype {
+	return []RuntimePropertyPreviewSubtype{
+		RuntimePropertyPreviewSubtypeArray,
+		RuntimePropertyPreviewSubtypeNull,
+		RuntimePropertyPreviewSubtypeNode,
+		RuntimePropertyPreviewSubtypeRegexp,
+		RuntimePropertyPreviewSubtypeDate,
+		RuntimePropertyPreviewSubtypeMap,
+		RuntimePropertyPreviewSubtypeSet,
+		RuntimePropertyPreviewSubtypeWeakmap,
+		RuntimePropertyPreviewSubtypeWeakset,
+		RuntimePropertyPreviewSubtypeIterator,
+		RuntimePropertyPreviewSubtypeGenerator,
+		RuntimePropertyPreviewSubtypeError,
+		RuntimePropertyPreviewSubtypeProxy,
+		RuntimePropertyPreviewSubtypePromise,
+		RuntimePropertyPreviewSubtypeTypedarray,
+		RuntimePropertyPreviewSubtypeArraybuffer,
+		RuntimePropertyPreviewSubtypeDataview,
+		RuntimePropertyPreviewSubtypeWebassemblymemory,
+		RuntimePropertyPreviewSubtypeWasmvalue,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v RuntimePropertyPreviewSubtype) Valid() bool {
+	switch v {
+	case RuntimePropertyPreviewSubtypeArray, RuntimePropertyPreviewSubtypeNull, RuntimePropertyPreviewSubtypeNode, RuntimePropertyPreviewSubtypeRegexp, RuntimePropertyPreviewSubtypeDate, RuntimePropertyPreviewSubtypeMap, RuntimePropertyPreviewSubtypeSet, RuntimePropertyPreviewSubtypeWeakmap, RuntimePropertyPreviewSubtypeWeakset, RuntimePropertyPreviewSubtypeIterator, RuntimePropertyPreviewSubtypeGenerator, RuntimePropertyPreviewSubtypeError, RuntimePropertyPreviewSubtypeProxy, RuntimePropertyPreviewSubtypePromise, RuntimePropertyPreviewSubtypeTypedarray, RuntimePropertyPreviewSubtypeArraybuffer, RuntimePropertyPreviewSubtypeDataview, RuntimePropertyPreviewSubtypeWebassemblymemory, RuntimePropertyPreviewSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Experimental: this may change or be removed in any chrome release
+type RuntimeEntryPreview struct {
+	/* Preview of the key. Specified for map-like collection entries. */
+	Key *RuntimeObjectPreview `json:"key,omitempty"`
+	/* Preview of the value. */
+	Value RuntimeObjectPreview `json:"value"`
+}
+
+// MarshalCDP writes RuntimeEntryPreview as JSON
+func (v *RuntimeEntryPreview) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	if v.Key != nil {
+		e.Key("key")
+		(*v.Key).MarshalCDP(e)
+	}
+	e.Key("value")
+	v.Value.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeEntryPreview) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeEntryPreview from JSON
+func (v *RuntimeEntryPreview) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "key":
+			if d.Null() {
+				v.Key = nil
+			} else {
+				v.Key = new(RuntimeObjectPreview)
+				(*v.Key).UnmarshalCDP(d)
+			}
+		case "value":
+			v.Value.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeEntryPreview) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Represents function call argument. Either remote object id `objectId`, primitive `value`,
+// unserializable primitive value or neither of (for undefined) them should be specified.
+type RuntimeCallArgument struct {
+	/* Primitive value or serializable javascript object. */
+	Value interface{} `json:"value"`
+	/* Primitive value which can not be JSON-stringified. */
+	UnserializableValue *RuntimeUnserializableValue `json:"unserializableValue,omitempty"`
+	/* Remote object handle. */
+	ObjectId *RuntimeRemoteObjectId `json:"objectId,omitempty"`
+}
+
+// MarshalCDP writes RuntimeCallArgument as JSON
+func (v *RuntimeCallArgument) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	if v.Value != nil {
+		e.Key("value")
+		e.Interface(v.Value)
+	}
+	if v.UnserializableValue != nil {
+		e.Key("unserializableValue")
+		e.String(string(*v.UnserializableValue))
+	}
+	if v.ObjectId != nil {
+		e.Key("objectId")
+		e.String(string(*v.ObjectId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeCallArgument) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeCallArgument from JSON
+func (v *RuntimeCallArgument) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "value":
+			v.Value = d.Interface()
+		case "unserializableValue":
+			if d.Null() {
+				v.UnserializableValue = nil
+			} else {
+				v.UnserializableValue = new(RuntimeUnserializableValue)
+				*v.UnserializableValue = RuntimeUnserializableValue(d.String())
+			}
+		case "objectId":
+			if d.Null() {
+				v.ObjectId = nil
+			} else {
+				v.ObjectId = new(RuntimeRemoteObjectId)
+				*v.ObjectId = RuntimeRemoteObjectId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeCallArgument) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Id of an execution context.
+type RuntimeExecutionContextId int
+
+// Detailed information about exception (or error) that was thrown during script compilation or
+// execution.
+type RuntimeExceptionDetails struct {
+	/* Exception id. */
+	ExceptionId int `json:"exceptionId"`
+	/* Exception text, which should be used together with exception object when available. */
+	Text string `json:"text"`
+	/* Line number of the exception location (0-based). */
+	LineNumber int `json:"lineNumber"`
+	/* Column number of the exception location (0-based). */
+	ColumnNumber int `json:"columnNumber"`
+	/* Script ID of the exception location. */
+	ScriptId *RuntimeScriptId `json:"scriptId,omitempty"`
+	/* URL of the exception location, to be used when the script was not reported. */
+	Url *string `json:"url,omitempty"`
+	/* JavaScript stack trace if available. */
+	StackTrace *RuntimeStackTrace `json:"stackTrace,omitempty"`
+	/* Exception object if available. */
+	Exception *RuntimeRemoteObject `json:"exception,omitempty"`
+	/* Identifier of the context where exception happened. */
+	ExecutionContextId *RuntimeExecutionContextId `json:"executionContextId,omitempty"`
+	/* Dictionary with entries of meta data that the client associated
+	with this exception, such as information about associated network
+	requests, etc.
+
+	Experimental: this may change or be removed in any chrome release */
+	ExceptionMetaData map[string]interface{} `json:"exceptionMetaData"`
+}
+
+// MarshalCDP writes RuntimeExceptionDetails as JSON
+func (v *RuntimeExceptionDetails) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("exceptionId")
+	e.Int(v.ExceptionId)
+	e.Key("text")
+	e.String(v.Text)
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	if v.ScriptId != nil {
+		e.Key("scriptId")
+		e.String(string(*v.ScriptId))
+	}
+	if v.Url != nil {
+		e.Key("url")
+		e.String(*v.Url)
+	}
+	if v.StackTrace != nil {
+		e.Key("stackTrace")
+		(*v.StackTrace).MarshalCDP(e)
+	}
+	if v.Exception != nil {
+		e.Key("exception")
+		(*v.Exception).MarshalCDP(e)
+	}
+	if v.ExecutionContextId != nil {
+		e.Key("executionContextId")
+		e.Int(int(*v.ExecutionContextId))
+	}
+	if v.ExceptionMetaData != nil {
+		e.Key("exceptionMetaData")
+		e.Map(v.ExceptionMetaData)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeExceptionDetails) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeExceptionDetails from JSON
+func (v *RuntimeExceptionDetails) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "exceptionId":
+			v.ExceptionId = d.Int()
+		case "text":
+			v.Text = d.String()
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		case "scriptId":
+			if d.Null() {
+				v.ScriptId = nil
+			} else {
+				v.ScriptId = new(RuntimeScriptId)
+				*v.ScriptId = RuntimeScriptId(d.String())
+			}
+		case "url":
+			if d.Null() {
+				v.Url = nil
+			} else {
+				v.Url = new(string)
+				*v.Url = d.String()
+			}
+		case "stackTrace":
+			if d.Null() {
+				v.StackTrace = nil
+			} else {
+				v.StackTrace = new(RuntimeStackTrace)
+				(*v.StackTrace).UnmarshalCDP(d)
+			}
+		case "exception":
+			if d.Null() {
+				v.Exception = nil
+			} else {
+				v.Exception = new(RuntimeRemoteObject)
+				(*v.Exception).UnmarshalCDP(d)
+			}
+		case "executionContextId":
+			if d.Null() {
+				v.ExecutionContextId = nil
+			} else {
+				v.ExecutionContextId = new(RuntimeExecutionContextId)
+				*v.ExecutionContextId = RuntimeExecutionContextId(d.Int())
+			}
+		case "exceptionMetaData":
+			v.ExceptionMetaData = d.Map()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeExceptionDetails) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Number of milliseconds since epoch.
+type RuntimeTimestamp float64
+
+// Number of milliseconds.
+type RuntimeTimeDelta float64
+
+// Stack entry for runtime errors and assertions.
+type RuntimeCallFrame struct {
+	/* JavaScript function name. */
+	FunctionName string `json:"functionName"`
+	/* JavaScript script id. */
+	ScriptId RuntimeScriptId `json:"scriptId"`
+	/* JavaScript script name or url. */
+	Url string `json:"url"`
+	/* JavaScript script line number (0-based). */
+	LineNumber int `json:"lineNumber"`
+	/* JavaScript script column number (0-based). */
+	ColumnNumber int `json:"columnNumber"`
+}
+
+// MarshalCDP writes RuntimeCallFrame as JSON
+func (v *RuntimeCallFrame) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("functionName")
+	e.String(v.FunctionName)
+	e.Key("scriptId")
+	e.String(string(v.ScriptId))
+	e.Key("url")
+	e.String(v.Url)
+	e.Key("lineNumber")
+	e.Int(v.LineNumber)
+	e.Key("columnNumber")
+	e.Int(v.ColumnNumber)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeCallFrame) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeCallFrame from JSON
+func (v *RuntimeCallFrame) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "functionName":
+			v.FunctionName = d.String()
+		case "scriptId":
+			v.ScriptId = RuntimeScriptId(d.String())
+		case "url":
+			v.Url = d.String()
+		case "lineNumber":
+			v.LineNumber = d.Int()
+		case "columnNumber":
+			v.ColumnNumber = d.Int()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeCallFrame) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Call frames for assertions or error messages.
+type RuntimeStackTrace struct {
+	/* String label of this stack trace. For async traces this may be a name of the function that
+	initiated the async call. */
+	Description *string `json:"description,omitempty"`
+	/* JavaScript function name. */
+	CallFrames []RuntimeCallFrame `json:"callFrames"`
+	/* Asynchronous JavaScript stack trace that preceded this stack, if available. */
+	Parent *RuntimeStackTrace `json:"parent,omitempty"`
+	/* Asynchronous JavaScript stack trace that preceded this stack, if available.
+
+	Experimental: this may change or be removed in any chrome release */
+	ParentId *RuntimeStackTraceId `json:"parentId,omitempty"`
+}
+
+// MarshalCDP writes RuntimeStackTrace as JSON
+func (v *RuntimeStackTrace) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	if v.Description != nil {
+		e.Key("description")
+		e.String(*v.Description)
+	}
+	e.Key("callFrames")
+	if v.CallFrames == nil {
+		e.Null()
+	} else {
+		e.ArrayStart()
+		for i0 := range v.CallFrames {
+			e.Elem()
+			v.CallFrames[i0].MarshalCDP(e)
+		}
+		e.ArrayEnd()
+	}
+	if v.Parent != nil {
+		e.Key("parent")
+		(*v.Parent).MarshalCDP(e)
+	}
+	if v.ParentId != nil {
+		e.Key("parentId")
+		(*v.ParentId).MarshalCDP(e)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeStackTrace) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeStackTrace from JSON
+func (v *RuntimeStackTrace) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "description":
+			if d.Null() {
+				v.Description = nil
+			} else {
+				v.Description = new(string)
+				*v.Description = d.String()
+			}
+		case "callFrames":
+			if d.Array() {
+				v.CallFrames = make([]RuntimeCallFrame, 0)
+				for d.More() {
+					var x0 RuntimeCallFrame
+					x0.UnmarshalCDP(d)
+					v.CallFrames = append(v.CallFrames, x0)
+				}
+			} else {
+				v.CallFrames = nil
+			}
+		case "parent":
+			if d.Null() {
+				v.Parent = nil
+			} else {
+				v.Parent = new(RuntimeStackTrace)
+				(*v.Parent).UnmarshalCDP(d)
+			}
+		case "parentId":
+			if d.Null() {
+				v.ParentId = nil
+			} else {
+				v.ParentId = new(RuntimeStackTraceId)
+				(*v.ParentId).UnmarshalCDP(d)
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeStackTrace) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
+
+// Unique identifier of current debugger.
+//
+// Experimental: this may change or be removed in any chrome release
+type RuntimeUniqueDebuggerId string
+
+// If `debuggerId` is set stack trace comes from another debugger and can be resolved there. This
+// allows to track cross-debugger calls. See `Runtime.StackTrace` and `Debugger.paused` for usages.
+//
+// Experimental: this may change or be removed in any chrome release
+type RuntimeStackTraceId struct {
+	Id         string                   `json:"id"`
+	DebuggerId *RuntimeUniqueDebuggerId `json:"debuggerId,omitempty"`
+}
+
+// MarshalCDP writes RuntimeStackTraceId as JSON
+func (v *RuntimeStackTraceId) MarshalCDP(e *Encoder) {
+	e.ObjectStart()
+	e.Key("id")
+	e.String(v.Id)
+	if v.DebuggerId != nil {
+		e.Key("debuggerId")
+		e.String(string(*v.DebuggerId))
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RuntimeStackTraceId) MarshalJSON() ([]byte, error) {
+	return Marshal(&v)
+}
+
+// UnmarshalCDP reads RuntimeStackTraceId from JSON
+func (v *RuntimeStackTraceId) UnmarshalCDP(d *Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			v.Id = d.String()
+		case "debuggerId":
+			if d.Null() {
+				v.DebuggerId = nil
+			} else {
+				v.DebuggerId = new(RuntimeUniqueDebuggerId)
+				*v.DebuggerId = RuntimeUniqueDebuggerId(d.String())
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RuntimeStackTraceId) UnmarshalJSON(data []byte) error {
+	return Unmarshal(data, v)
+}
@@ -0,0 +1,1265 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package webaudio is the WebAudio domain of the chrome devtools protocol
+//
+// This domain allows inspection of Web Audio API.
+// https://webaudio.github.io/web-audio-api/
+//
+// Experimental: this may change or be removed in any chrome release
+package webaudio
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends WebAudio commands and listens for WebAudio events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+// An unique ID for a graph object (AudioContext, AudioNode, AudioParam) in Web Audio API
+type GraphObjectId string
+
+// Enum of BaseAudioContext types
+type ContextType string
+
+// ContextType values
+const (
+	ContextTypeRealtime ContextType = "realtime"
+	ContextTypeOffline  ContextType = "offline"
+)
+
+// Values gives every ContextType
+func (ContextType) Values() []ContextType {
+	return []ContextType{
+		ContextTypeRealtime,
+		ContextTypeOffline,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContextType) Valid() bool {
+	switch v {
+	case ContextTypeRealtime, ContextTypeOffline:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioContextState from the spec
+type ContextState string
+
+// ContextState values
+const (
+	ContextStateSuspended   ContextState = "suspended"
+	ContextStateRunning     ContextState = "running"
+	ContextStateClosed      ContextState = "closed"
+	ContextStateInterrupted ContextState = "interrupted"
+)
+
+// Values gives every ContextState
+func (ContextState) Values() []ContextState {
+	return []ContextState{
+		ContextStateSuspended,
+		ContextStateRunning,
+		ContextStateClosed,
+		ContextStateInterrupted,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ContextState) Valid() bool {
+	switch v {
+	case ContextStateSuspended, ContextStateRunning, ContextStateClosed, ContextStateInterrupted:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioNode types
+type NodeType string
+
+// Enum of AudioNode::ChannelCountMode from the spec
+type ChannelCountMode string
+
+// ChannelCountMode values
+const (
+	ChannelCountModeClampedMax ChannelCountMode = "clamped-max"
+	ChannelCountModeExplicit   ChannelCountMode = "explicit"
+	ChannelCountModeMax        ChannelCountMode = "max"
+)
+
+// Values gives every ChannelCountMode
+func (ChannelCountMode) Values() []ChannelCountMode {
+	return []ChannelCountMode{
+		ChannelCountModeClampedMax,
+		ChannelCountModeExplicit,
+		ChannelCountModeMax,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ChannelCountMode) Valid() bool {
+	switch v {
+	case ChannelCountModeClampedMax, ChannelCountModeExplicit, ChannelCountModeMax:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioNode::ChannelInterpretation from the spec
+type ChannelInterpretation string
+
+// ChannelInterpretation values
+const (
+	ChannelInterpretationDiscrete ChannelInterpretation = "discrete"
+	ChannelInterpretationSpeakers ChannelInterpretation = "speakers"
+)
+
+// Values gives every ChannelInterpretation
+func (ChannelInterpretation) Values() []ChannelInterpretation {
+	return []ChannelInterpretation{
+		ChannelInterpretationDiscrete,
+		ChannelInterpretationSpeakers,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v ChannelInterpretation) Valid() bool {
+	switch v {
+	case ChannelInterpretationDiscrete, ChannelInterpretationSpeakers:
+		return true
+	}
+	return false
+}
+
+// Enum of AudioParam types
+type ParamType string
+
+// Enum of AudioParam::AutomationRate from the spec
+type AutomationRate string
+
+// AutomationRate values
+const (
+	AutomationRateARate AutomationRate = "a-rate"
+	AutomationRateKRate AutomationRate = "k-rate"
+)
+
+// Values gives every AutomationRate
+func (AutomationRate) Values() []AutomationRate {
+	return []AutomationRate{
+		AutomationRateARate,
+		AutomationRateKRate,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AutomationRate) Valid() bool {
+	switch v {
+	case AutomationRateARate, AutomationRateKRate:
+		return true
+	}
+	return false
+}
+
+// Fields in AudioContext that change in real-time.
+type ContextRealtimeData struct {
+	/* The current context time in second in BaseAudioContext. */
+	CurrentTime float64 `json:"currentTime"`
+	/* The time spent on rendering graph divided by render quantum duration,
+	and multiplied by 100. 100 means the audio renderer reached the full
+	capacity and glitch may occur. */
+	RenderCapacity float64 `json:"renderCapacity"`
+	/* A running mean of callback interval. */
+	CallbackIntervalMean float64 `json:"callbackIntervalMean"`
+	/* A running variance of callback interval. */
+	CallbackIntervalVariance float64 `json:"callbackIntervalVariance"`
+}
+
+// MarshalCDP writes ContextRealtimeData as JSON
+func (v *ContextRealtimeData) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("currentTime")
+	e.Float(v.CurrentTime)
+	e.Key("renderCapacity")
+	e.Float(v.RenderCapacity)
+	e.Key("callbackIntervalMean")
+	e.Float(v.CallbackIntervalMean)
+	e.Key("callbackIntervalVariance")
+	e.Float(v.CallbackIntervalVariance)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ContextRealtimeData) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads ContextRealtimeData from JSON
+func (v *ContextRealtimeData) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "currentTime":
+			v.CurrentTime = d.Float()
+		case "renderCapacity":
+			v.RenderCapacity = d.Float()
+		case "callbackIntervalMean":
+			v.CallbackIntervalMean = d.Float()
+		case "callbackIntervalVariance":
+			v.CallbackIntervalVariance = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContextRealtimeData) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Protocol object for BaseAudioContext
+type BaseAudioContext struct {
+	ContextId    GraphObjectId        `json:"contextId"`
+	ContextType  ContextType          `json:"contextType"`
+	ContextState ContextState         `json:"contextState"`
+	RealtimeData *ContextRealtimeData `json:"realtimeData,omitempty"`
+	/* Platform-dependent callback buffer size. */
+	CallbackBufferSize float64 `json:"callbackBufferSize"`
+	/* Number of output channels supported by audio hardware in use. */
+	MaxOutputChannelCount float64 `json:"maxOutputChannelCount"`
+	/* Context sample rate. */
+	SampleRate float64 `json:"sampleRate"`
+}
+
+// MarshalCDP writes BaseAudioContext as JSON
+func (v *BaseAudioContext) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("contextId")
+	e.String(string(v.ContextId))
+	e.Key("contextType")
+	e.String(string(v.ContextType))
+	e.Key("contextState")
+	e.String(string(v.ContextState))
+	if v.RealtimeData != nil {
+		e.Key("realtimeData")
+		(*v.RealtimeData).MarshalCDP(e)
+	}
+	e.Key("callbackBufferSize")
+	e.Float(v.CallbackBufferSize)
+	e.Key("maxOutputChannelCount")
+	e.Float(v.MaxOutputChannelCount)
+	e.Key("sampleRate")
+	e.Float(v.SampleRate)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v BaseAudioContext) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads BaseAudioContext from JSON
+func (v *BaseAudioContext) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "contextType":
+			v.ContextType = ContextType(d.String())
+		case "contextState":
+			v.ContextState = ContextState(d.String())
+		case "realtimeData":
+			if d.Null() {
+				v.RealtimeData = nil
+			} else {
+				v.RealtimeData = new(ContextRealtimeData)
+				(*v.RealtimeData).UnmarshalCDP(d)
+			}
+		case "callbackBufferSize":
+			v.CallbackBufferSize = d.Float()
+		case "maxOutputChannelCount":
+			v.MaxOutputChannelCount = d.Float()
+		case "sampleRate":
+			v.SampleRate = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *BaseAudioContext) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Protocol object for AudioListener
+type AudioListener struct {
+	ListenerId GraphObjectId `json:"listenerId"`
+	ContextId  GraphObjectId `json:"contextId"`
+}
+
+// MarshalCDP writes AudioListener as JSON
+func (v *AudioListener) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("listenerId")
+	e.String(string(v.ListenerId))
+	e.Key("contextId")
+	e.String(string(v.ContextId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AudioListener) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AudioListener from JSON
+func (v *AudioListener) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "listenerId":
+			v.ListenerId = GraphObjectId(d.String())
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioListener) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Protocol object for AudioNode
+type AudioNode struct {
+	NodeId                GraphObjectId         `json:"nodeId"`
+	ContextId             GraphObjectId         `json:"contextId"`
+	NodeType              NodeType              `json:"nodeType"`
+	NumberOfInputs        float64               `json:"numberOfInputs"`
+	NumberOfOutputs       float64               `json:"numberOfOutputs"`
+	ChannelCount          float64               `json:"channelCount"`
+	ChannelCountMode      ChannelCountMode      `json:"channelCountMode"`
+	ChannelInterpretation ChannelInterpretation `json:"channelInterpretation"`
+}
+
+// MarshalCDP writes AudioNode as JSON
+func (v *AudioNode) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("nodeId")
+	e.String(string(v.NodeId))
+	e.Key("contextId")
+	e.String(string(v.ContextId))
+	e.Key("nodeType")
+	e.String(string(v.NodeType))
+	e.Key("numberOfInputs")
+	e.Float(v.NumberOfInputs)
+	e.Key("numberOfOutputs")
+	e.Float(v.NumberOfOutputs)
+	e.Key("channelCount")
+	e.Float(v.ChannelCount)
+	e.Key("channelCountMode")
+	e.String(string(v.ChannelCountMode))
+	e.Key("channelInterpretation")
+	e.String(string(v.ChannelInterpretation))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AudioNode) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AudioNode from JSON
+func (v *AudioNode) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "nodeId":
+			v.NodeId = GraphObjectId(d.String())
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "nodeType":
+			v.NodeType = NodeType(d.String())
+		case "numberOfInputs":
+			v.NumberOfInputs = d.Float()
+		case "numberOfOutputs":
+			v.NumberOfOutputs = d.Float()
+		case "channelCount":
+			v.ChannelCount = d.Float()
+		case "channelCountMode":
+			v.ChannelCountMode = ChannelCountMode(d.String())
+		case "channelInterpretation":
+			v.ChannelInterpretation = ChannelInterpretation(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioNode) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Protocol object for AudioParam
+type AudioParam struct {
+	ParamId      GraphObjectId  `json:"paramId"`
+	NodeId       GraphObjectId  `json:"nodeId"`
+	ContextId    GraphObjectId  `json:"contextId"`
+	ParamType    ParamType      `json:"paramType"`
+	Rate         AutomationRate `json:"rate"`
+	DefaultValue float64        `json:"defaultValue"`
+	MinValue     float64        `json:"minValue"`
+	MaxValue     float64        `json:"maxValue"`
+}
+
+// MarshalCDP writes AudioParam as JSON
+func (v *AudioParam) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("paramId")
+	e.String(string(v.ParamId))
+	e.Key("nodeId")
+	e.String(string(v.NodeId))
+	e.Key("contextId")
+	e.String(string(v.ContextId))
+	e.Key("paramType")
+	e.String(string(v.ParamType))
+	e.Key("rate")
+	e.String(string(v.Rate))
+	e.Key("defaultValue")
+	e.Float(v.DefaultValue)
+	e.Key("minValue")
+	e.Float(v.MinValue)
+	e.Key("maxValue")
+	e.Float(v.MaxValue)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AudioParam) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads AudioParam from JSON
+func (v *AudioParam) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "paramId":
+			v.ParamId = GraphObjectId(d.String())
+		case "nodeId":
+			v.NodeId = GraphObjectId(d.String())
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "paramType":
+			v.ParamType = ParamType(d.String())
+		case "rate":
+			v.Rate = AutomationRate(d.String())
+		case "defaultValue":
+			v.DefaultValue = d.Float()
+		case "minValue":
+			v.MinValue = d.Float()
+		case "maxValue":
+			v.MaxValue = d.Float()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioParam) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Enables the WebAudio domain and starts sending context lifetime events.
+func (c Client) Enable() (EnableReturns, error) {
+	return c.EnableContext(context.Background())
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "WebAudio.enable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disables the WebAudio domain.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "WebAudio.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type GetRealtimeDataReturns struct {
+	RealtimeData ContextRealtimeData `json:"realtimeData"`
+}
+
+// UnmarshalCDP reads GetRealtimeDataReturns from JSON
+func (v *GetRealtimeDataReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "realtimeData":
+			v.RealtimeData.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetRealtimeDataReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetRealtimeDataParams are the parameters for WebAudio.getRealtimeData
+// optional parameters are left out when nil
+type GetRealtimeDataParams struct {
+	ContextId GraphObjectId `json:"contextId"`
+}
+
+// MarshalCDP writes GetRealtimeDataParams as JSON
+func (v *GetRealtimeDataParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("contextId")
+	e.String(string(v.ContextId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetRealtimeDataParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Fetch the realtime data from the registered contexts.
+func (c Client) GetRealtimeData(params GetRealtimeDataParams) (GetRealtimeDataReturns, error) {
+	return c.GetRealtimeDataContext(context.Background(), params)
+}
+
+// GetRealtimeDataContext is GetRealtimeData with a context for cancellation and deadlines
+func (c Client) GetRealtimeDataContext(ctx context.Context, params GetRealtimeDataParams) (GetRealtimeDataReturns, error) {
+	var returns_ GetRealtimeDataReturns
+
+	err_ := c.caller.Call(ctx, "WebAudio.getRealtimeData", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Notifies that a new BaseAudioContext has been created.
+type ContextCreatedEvent struct {
+	Context BaseAudioContext `json:"context"`
+}
+
+// UnmarshalCDP reads ContextCreatedEvent from JSON
+func (v *ContextCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "context":
+			v.Context.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContextCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContextCreatedHandler func(ev ContextCreatedEvent)
+
+// EventMethod is WebAudio.contextCreated
+func (ContextCreatedEvent) EventMethod() string {
+	return "WebAudio.contextCreated"
+}
+
+// OnContextCreated calls handler for each WebAudio.contextCreated event
+func (c Client) OnContextCreated(handler ContextCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.contextCreated", func(ev interface{}) {
+		handler(ev.(ContextCreatedEvent))
+	})
+}
+
+// Notifies that an existing BaseAudioContext will be destroyed.
+type ContextWillBeDestroyedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+}
+
+// UnmarshalCDP reads ContextWillBeDestroyedEvent from JSON
+func (v *ContextWillBeDestroyedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContextWillBeDestroyedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContextWillBeDestroyedHandler func(ev ContextWillBeDestroyedEvent)
+
+// EventMethod is WebAudio.contextWillBeDestroyed
+func (ContextWillBeDestroyedEvent) EventMethod() string {
+	return "WebAudio.contextWillBeDestroyed"
+}
+
+// OnContextWillBeDestroyed calls handler for each WebAudio.contextWillBeDestroyed event
+func (c Client) OnContextWillBeDestroyed(handler ContextWillBeDestroyedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.contextWillBeDestroyed", func(ev interface{}) {
+		handler(ev.(ContextWillBeDestroyedEvent))
+	})
+}
+
+// Notifies that existing BaseAudioContext has changed some properties (id stays the same)..
+type ContextChangedEvent struct {
+	Context BaseAudioContext `json:"context"`
+}
+
+// UnmarshalCDP reads ContextChangedEvent from JSON
+func (v *ContextChangedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "context":
+			v.Context.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ContextChangedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type ContextChangedHandler func(ev ContextChangedEvent)
+
+// EventMethod is WebAudio.contextChanged
+func (ContextChangedEvent) EventMethod() string {
+	return "WebAudio.contextChanged"
+}
+
+// OnContextChanged calls handler for each WebAudio.contextChanged event
+func (c Client) OnContextChanged(handler ContextChangedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.contextChanged", func(ev interface{}) {
+		handler(ev.(ContextChangedEvent))
+	})
+}
+
+// Notifies that the construction of an AudioListener has finished.
+type AudioListenerCreatedEvent struct {
+	Listener AudioListener `json:"listener"`
+}
+
+// UnmarshalCDP reads AudioListenerCreatedEvent from JSON
+func (v *AudioListenerCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "listener":
+			v.Listener.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioListenerCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AudioListenerCreatedHandler func(ev AudioListenerCreatedEvent)
+
+// EventMethod is WebAudio.audioListenerCreated
+func (AudioListenerCreatedEvent) EventMethod() string {
+	return "WebAudio.audioListenerCreated"
+}
+
+// OnAudioListenerCreated calls handler for each WebAudio.audioListenerCreated event
+func (c Client) OnAudioListenerCreated(handler AudioListenerCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.audioListenerCreated", func(ev interface{}) {
+		handler(ev.(AudioListenerCreatedEvent))
+	})
+}
+
+// Notifies that a new AudioListener has been created.
+type AudioListenerWillBeDestroyedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	ListenerId GraphObjectId `json:"listenerId"`
+}
+
+// UnmarshalCDP reads AudioListenerWillBeDestroyedEvent from JSON
+func (v *AudioListenerWillBeDestroyedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "listenerId":
+			v.ListenerId = GraphObjectId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioListenerWillBeDestroyedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AudioListenerWillBeDestroyedHandler func(ev AudioListenerWillBeDestroyedEvent)
+
+// EventMethod is WebAudio.audioListenerWillBeDestroyed
+func (AudioListenerWillBeDestroyedEvent) EventMethod() string {
+	return "WebAudio.audioListenerWillBeDestroyed"
+}
+
+// OnAudioListenerWillBeDestroyed calls handler for each WebAudio.audioListenerWillBeDestroyed event
+func (c Client) OnAudioListenerWillBeDestroyed(handler AudioListenerWillBeDestroyedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.audioListenerWillBeDestroyed", func(ev interface{}) {
+		handler(ev.(AudioListenerWillBeDestroyedEvent))
+	})
+}
+
+// Notifies that a new AudioNode has been created.
+type AudioNodeCreatedEvent struct {
+	Node AudioNode `json:"node"`
+}
+
+// UnmarshalCDP reads AudioNodeCreatedEvent from JSON
+func (v *AudioNodeCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "node":
+			v.Node.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioNodeCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AudioNodeCreatedHandler func(ev AudioNodeCreatedEvent)
+
+// EventMethod is WebAudio.audioNodeCreated
+func (AudioNodeCreatedEvent) EventMethod() string {
+	return "WebAudio.audioNodeCreated"
+}
+
+// OnAudioNodeCreated calls handler for each WebAudio.audioNodeCreated event
+func (c Client) OnAudioNodeCreated(handler AudioNodeCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.audioNodeCreated", func(ev interface{}) {
+		handler(ev.(AudioNodeCreatedEvent))
+	})
+}
+
+// Notifies that an existing AudioNode has been destroyed.
+type AudioNodeWillBeDestroyedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	NodeId GraphObjectId `json:"nodeId"`
+}
+
+// UnmarshalCDP reads AudioNodeWillBeDestroyedEvent from JSON
+func (v *AudioNodeWillBeDestroyedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "nodeId":
+			v.NodeId = GraphObjectId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioNodeWillBeDestroyedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AudioNodeWillBeDestroyedHandler func(ev AudioNodeWillBeDestroyedEvent)
+
+// EventMethod is WebAudio.audioNodeWillBeDestroyed
+func (AudioNodeWillBeDestroyedEvent) EventMethod() string {
+	return "WebAudio.audioNodeWillBeDestroyed"
+}
+
+// OnAudioNodeWillBeDestroyed calls handler for each WebAudio.audioNodeWillBeDestroyed event
+func (c Client) OnAudioNodeWillBeDestroyed(handler AudioNodeWillBeDestroyedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.audioNodeWillBeDestroyed", func(ev interface{}) {
+		handler(ev.(AudioNodeWillBeDestroyedEvent))
+	})
+}
+
+// Notifies that a new AudioParam has been created.
+type AudioParamCreatedEvent struct {
+	Param AudioParam `json:"param"`
+}
+
+// UnmarshalCDP reads AudioParamCreatedEvent from JSON
+func (v *AudioParamCreatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "param":
+			v.Param.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioParamCreatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AudioParamCreatedHandler func(ev AudioParamCreatedEvent)
+
+// EventMethod is WebAudio.audioParamCreated
+func (AudioParamCreatedEvent) EventMethod() string {
+	return "WebAudio.audioParamCreated"
+}
+
+// OnAudioParamCreated calls handler for each WebAudio.audioParamCreated event
+func (c Client) OnAudioParamCreated(handler AudioParamCreatedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.audioParamCreated", func(ev interface{}) {
+		handler(ev.(AudioParamCreatedEvent))
+	})
+}
+
+// Notifies that an existing AudioParam has been destroyed.
+type AudioParamWillBeDestroyedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	NodeId GraphObjectId `json:"nodeId"`
+
+	ParamId GraphObjectId `json:"paramId"`
+}
+
+// UnmarshalCDP reads AudioParamWillBeDestroyedEvent from JSON
+func (v *AudioParamWillBeDestroyedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "nodeId":
+			v.NodeId = GraphObjectId(d.String())
+		case "paramId":
+			v.ParamId = GraphObjectId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AudioParamWillBeDestroyedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type AudioParamWillBeDestroyedHandler func(ev AudioParamWillBeDestroyedEvent)
+
+// EventMethod is WebAudio.audioParamWillBeDestroyed
+func (AudioParamWillBeDestroyedEvent) EventMethod() string {
+	return "WebAudio.audioParamWillBeDestroyed"
+}
+
+// OnAudioParamWillBeDestroyed calls handler for each WebAudio.audioParamWillBeDestroyed event
+func (c Client) OnAudioParamWillBeDestroyed(handler AudioParamWillBeDestroyedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.audioParamWillBeDestroyed", func(ev interface{}) {
+		handler(ev.(AudioParamWillBeDestroyedEvent))
+	})
+}
+
+// Notifies that two AudioNodes are connected.
+type NodesConnectedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	SourceId GraphObjectId `json:"sourceId"`
+
+	DestinationId GraphObjectId `json:"destinationId"`
+
+	SourceOutputIndex *float64 `json:"sourceOutputIndex,omitempty"`
+
+	DestinationInputIndex *float64 `json:"destinationInputIndex,omitempty"`
+}
+
+// UnmarshalCDP reads NodesConnectedEvent from JSON
+func (v *NodesConnectedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "sourceId":
+			v.SourceId = GraphObjectId(d.String())
+		case "destinationId":
+			v.DestinationId = GraphObjectId(d.String())
+		case "sourceOutputIndex":
+			if d.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				v.SourceOutputIndex = new(float64)
+				*v.SourceOutputIndex = d.Float()
+			}
+		case "destinationInputIndex":
+			if d.Null() {
+				v.DestinationInputIndex = nil
+			} else {
+				v.DestinationInputIndex = new(float64)
+				*v.DestinationInputIndex = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodesConnectedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NodesConnectedHandler func(ev NodesConnectedEvent)
+
+// EventMethod is WebAudio.nodesConnected
+func (NodesConnectedEvent) EventMethod() string {
+	return "WebAudio.nodesConnected"
+}
+
+// OnNodesConnected calls handler for each WebAudio.nodesConnected event
+func (c Client) OnNodesConnected(handler NodesConnectedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.nodesConnected", func(ev interface{}) {
+		handler(ev.(NodesConnectedEvent))
+	})
+}
+
+// Notifies that AudioNodes are disconnected. The destination can be null, and it means all the outgoing connections from the source are disconnected.
+type NodesDisconnectedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	SourceId GraphObjectId `json:"sourceId"`
+
+	DestinationId GraphObjectId `json:"destinationId"`
+
+	SourceOutputIndex *float64 `json:"sourceOutputIndex,omitempty"`
+
+	DestinationInputIndex *float64 `json:"destinationInputIndex,omitempty"`
+}
+
+// UnmarshalCDP reads NodesDisconnectedEvent from JSON
+func (v *NodesDisconnectedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "sourceId":
+			v.SourceId = GraphObjectId(d.String())
+		case "destinationId":
+			v.DestinationId = GraphObjectId(d.String())
+		case "sourceOutputIndex":
+			if d.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				v.SourceOutputIndex = new(float64)
+				*v.SourceOutputIndex = d.Float()
+			}
+		case "destinationInputIndex":
+			if d.Null() {
+				v.DestinationInputIndex = nil
+			} else {
+				v.DestinationInputIndex = new(float64)
+				*v.DestinationInputIndex = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodesDisconnectedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NodesDisconnectedHandler func(ev NodesDisconnectedEvent)
+
+// EventMethod is WebAudio.nodesDisconnected
+func (NodesDisconnectedEvent) EventMethod() string {
+	return "WebAudio.nodesDisconnected"
+}
+
+// OnNodesDisconnected calls handler for each WebAudio.nodesDisconnected event
+func (c Client) OnNodesDisconnected(handler NodesDisconnectedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.nodesDisconnected", func(ev interface{}) {
+		handler(ev.(NodesDisconnectedEvent))
+	})
+}
+
+// Notifies that an AudioNode is connected to an AudioParam.
+type NodeParamConnectedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	SourceId GraphObjectId `json:"sourceId"`
+
+	DestinationId GraphObjectId `json:"destinationId"`
+
+	SourceOutputIndex *float64 `json:"sourceOutputIndex,omitempty"`
+}
+
+// UnmarshalCDP reads NodeParamConnectedEvent from JSON
+func (v *NodeParamConnectedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "sourceId":
+			v.SourceId = GraphObjectId(d.String())
+		case "destinationId":
+			v.DestinationId = GraphObjectId(d.String())
+		case "sourceOutputIndex":
+			if d.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				v.SourceOutputIndex = new(float64)
+				*v.SourceOutputIndex = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodeParamConnectedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NodeParamConnectedHandler func(ev NodeParamConnectedEvent)
+
+// EventMethod is WebAudio.nodeParamConnected
+func (NodeParamConnectedEvent) EventMethod() string {
+	return "WebAudio.nodeParamConnected"
+}
+
+// OnNodeParamConnected calls handler for each WebAudio.nodeParamConnected event
+func (c Client) OnNodeParamConnected(handler NodeParamConnectedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.nodeParamConnected", func(ev interface{}) {
+		handler(ev.(NodeParamConnectedEvent))
+	})
+}
+
+// Notifies that an AudioNode is disconnected to an AudioParam.
+type NodeParamDisconnectedEvent struct {
+	ContextId GraphObjectId `json:"contextId"`
+
+	SourceId GraphObjectId `json:"sourceId"`
+
+	DestinationId GraphObjectId `json:"destinationId"`
+
+	SourceOutputIndex *float64 `json:"sourceOutputIndex,omitempty"`
+}
+
+// UnmarshalCDP reads NodeParamDisconnectedEvent from JSON
+func (v *NodeParamDisconnectedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "contextId":
+			v.ContextId = GraphObjectId(d.String())
+		case "sourceId":
+			v.SourceId = GraphObjectId(d.String())
+		case "destinationId":
+			v.DestinationId = GraphObjectId(d.String())
+		case "sourceOutputIndex":
+			if d.Null() {
+				v.SourceOutputIndex = nil
+			} else {
+				v.SourceOutputIndex = new(float64)
+				*v.SourceOutputIndex = d.Float()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *NodeParamDisconnectedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type NodeParamDisconnectedHandler func(ev NodeParamDisconnectedEvent)
+
+// EventMethod is WebAudio.nodeParamDisconnected
+func (NodeParamDisconnectedEvent) EventMethod() string {
+	return "WebAudio.nodeParamDisconnected"
+}
+
+// OnNodeParamDisconnected calls handler for each WebAudio.nodeParamDisconnected event
+func (c Client) OnNodeParamDisconnected(handler NodeParamDisconnectedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAudio.nodeParamDisconnected", func(ev interface{}) {
+		handler(ev.(NodeParamDisconnectedEvent))
+	})
+}
+
+// decoders for WebAudio events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"WebAudio.contextCreated":               cdp.Decode[ContextCreatedEvent],
+	"WebAudio.contextWillBeDestroyed":       cdp.Decode[ContextWillBeDestroyedEvent],
+	"WebAudio.contextChanged":               cdp.Decode[ContextChangedEvent],
+	"WebAudio.audioListenerCreated":         cdp.Decode[AudioListenerCreatedEvent],
+	"WebAudio.audioListenerWillBeDestroyed": cdp.Decode[AudioListenerWillBeDestroyedEvent],
+	"WebAudio.audioNodeCreated":             cdp.Decode[AudioNodeCreatedEvent],
+	"WebAudio.audioNodeWillBeDestroyed":     cdp.Decode[AudioNodeWillBeDestroyedEvent],
+	"WebAudio.audioParamCreated":            cdp.Decode[AudioParamCreatedEvent],
+	"WebAudio.audioParamWillBeDestroyed":    cdp.Decode[AudioParamWillBeDestroyedEvent],
+	"WebAudio.nodesConnected":               cdp.Decode[NodesConnectedEvent],
+	"WebAudio.nodesDisconnected":            cdp.Decode[NodesDisconnectedEvent],
+	"WebAudio.nodeParamConnected":           cdp.Decode[NodeParamConnectedEvent],
+	"WebAudio.nodeParamDisconnected":        cdp.Decode[NodeParamDisconnectedEvent],
+}
+
+// DecodeEvent decodes a WebAudio event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
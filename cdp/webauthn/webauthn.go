@@ -0,0 +1,1424 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+// Package webauthn is the WebAuthn domain of the chrome devtools protocol
+//
+// This domain allows configuring virtual authenticators to test the WebAuthn
+// API.
+//
+// Experimental: this may change or be removed in any chrome release
+package webauthn
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// Client sends WebAuthn commands and listens for WebAuthn events
+type Client struct {
+	caller cdp.Caller
+}
+
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+
+type AuthenticatorId string
+
+type AuthenticatorProtocol string
+
+// AuthenticatorProtocol values
+const (
+	AuthenticatorProtocolU2f   AuthenticatorProtocol = "u2f"
+	AuthenticatorProtocolCtap2 AuthenticatorProtocol = "ctap2"
+)
+
+// Values gives every AuthenticatorProtocol
+func (AuthenticatorProtocol) Values() []AuthenticatorProtocol {
+	return []AuthenticatorProtocol{
+		AuthenticatorProtocolU2f,
+		AuthenticatorProtocolCtap2,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AuthenticatorProtocol) Valid() bool {
+	switch v {
+	case AuthenticatorProtocolU2f, AuthenticatorProtocolCtap2:
+		return true
+	}
+	return false
+}
+
+type Ctap2Version string
+
+// Ctap2Version values
+const (
+	Ctap2VersionCtap20 Ctap2Version = "ctap2_0"
+	Ctap2VersionCtap21 Ctap2Version = "ctap2_1"
+)
+
+// Values gives every Ctap2Version
+func (Ctap2Version) Values() []Ctap2Version {
+	return []Ctap2Version{
+		Ctap2VersionCtap20,
+		Ctap2VersionCtap21,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v Ctap2Version) Valid() bool {
+	switch v {
+	case Ctap2VersionCtap20, Ctap2VersionCtap21:
+		return true
+	}
+	return false
+}
+
+type AuthenticatorTransport string
+
+// AuthenticatorTransport values
+const (
+	AuthenticatorTransportUsb      AuthenticatorTransport = "usb"
+	AuthenticatorTransportNfc      AuthenticatorTransport = "nfc"
+	AuthenticatorTransportBle      AuthenticatorTransport = "ble"
+	AuthenticatorTransportCable    AuthenticatorTransport = "cable"
+	AuthenticatorTransportInternal AuthenticatorTransport = "internal"
+)
+
+// Values gives every AuthenticatorTransport
+func (AuthenticatorTransport) Values() []AuthenticatorTransport {
+	return []AuthenticatorTransport{
+		AuthenticatorTransportUsb,
+		AuthenticatorTransportNfc,
+		AuthenticatorTransportBle,
+		AuthenticatorTransportCable,
+		AuthenticatorTransportInternal,
+	}
+}
+
+// Valid is true if v is one of Values
+func (v AuthenticatorTransport) Valid() bool {
+	switch v {
+	case AuthenticatorTransportUsb, AuthenticatorTransportNfc, AuthenticatorTransportBle, AuthenticatorTransportCable, AuthenticatorTransportInternal:
+		return true
+	}
+	return false
+}
+
+type VirtualAuthenticatorOptions struct {
+	Protocol AuthenticatorProtocol `json:"protocol"`
+	/* Defaults to ctap2_0. Ignored if |protocol| == u2f. */
+	Ctap2Version *Ctap2Version          `json:"ctap2Version,omitempty"`
+	Transport    AuthenticatorTransport `json:"transport"`
+	/* Defaults to false. */
+	HasResidentKey *bool `json:"hasResidentKey,omitempty"`
+	/* Defaults to false. */
+	HasUserVerification *bool `json:"hasUserVerification,omitempty"`
+	/* If set to true, the authenticator will support the largeBlob extension.
+	https://w3c.github.io/webauthn#largeBlob
+	Defaults to false. */
+	HasLargeBlob *bool `json:"hasLargeBlob,omitempty"`
+	/* If set to true, the authenticator will support the credBlob extension.
+	https://fidoalliance.org/specs/fido-v2.1-rd-20201208/fido-client-to-authenticator-protocol-v2.1-rd-20201208.html#sctn-credBlob-extension
+	Defaults to false. */
+	HasCredBlob *bool `json:"hasCredBlob,omitempty"`
+	/* If set to true, the authenticator will support the minPinLength extension.
+	https://fidoalliance.org/specs/fido-v2.1-ps-20210615/fido-client-to-authenticator-protocol-v2.1-ps-20210615.html#sctn-minpinlength-extension
+	Defaults to false. */
+	HasMinPinLength *bool `json:"hasMinPinLength,omitempty"`
+	/* If set to true, the authenticator will support the prf extension.
+	https://w3c.github.io/webauthn/#prf-extension
+	Defaults to false. */
+	HasPrf *bool `json:"hasPrf,omitempty"`
+	/* If set to true, tests of user presence will succeed immediately.
+	Otherwise, they will not be resolved. Defaults to true. */
+	AutomaticPresenceSimulation *bool `json:"automaticPresenceSimulation,omitempty"`
+	/* Sets whether User Verification succeeds or fails for an authenticator.
+	Defaults to false. */
+	IsUserVerified *bool `json:"isUserVerified,omitempty"`
+	/* Credentials created by this authenticator will have the backup
+	eligibility (BE) flag set to this value. Defaults to false.
+	https://w3c.github.io/webauthn/#sctn-credential-backup */
+	DefaultBackupEligibility *bool `json:"defaultBackupEligibility,omitempty"`
+	/* Credentials created by this authenticator will have the backup state
+	(BS) flag set to this value. Defaults to false.
+	https://w3c.github.io/webauthn/#sctn-credential-backup */
+	DefaultBackupState *bool `json:"defaultBackupState,omitempty"`
+}
+
+// MarshalCDP writes VirtualAuthenticatorOptions as JSON
+func (v *VirtualAuthenticatorOptions) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("protocol")
+	e.String(string(v.Protocol))
+	if v.Ctap2Version != nil {
+		e.Key("ctap2Version")
+		e.String(string(*v.Ctap2Version))
+	}
+	e.Key("transport")
+	e.String(string(v.Transport))
+	if v.HasResidentKey != nil {
+		e.Key("hasResidentKey")
+		e.Bool(*v.HasResidentKey)
+	}
+	if v.HasUserVerification != nil {
+		e.Key("hasUserVerification")
+		e.Bool(*v.HasUserVerification)
+	}
+	if v.HasLargeBlob != nil {
+		e.Key("hasLargeBlob")
+		e.Bool(*v.HasLargeBlob)
+	}
+	if v.HasCredBlob != nil {
+		e.Key("hasCredBlob")
+		e.Bool(*v.HasCredBlob)
+	}
+	if v.HasMinPinLength != nil {
+		e.Key("hasMinPinLength")
+		e.Bool(*v.HasMinPinLength)
+	}
+	if v.HasPrf != nil {
+		e.Key("hasPrf")
+		e.Bool(*v.HasPrf)
+	}
+	if v.AutomaticPresenceSimulation != nil {
+		e.Key("automaticPresenceSimulation")
+		e.Bool(*v.AutomaticPresenceSimulation)
+	}
+	if v.IsUserVerified != nil {
+		e.Key("isUserVerified")
+		e.Bool(*v.IsUserVerified)
+	}
+	if v.DefaultBackupEligibility != nil {
+		e.Key("defaultBackupEligibility")
+		e.Bool(*v.DefaultBackupEligibility)
+	}
+	if v.DefaultBackupState != nil {
+		e.Key("defaultBackupState")
+		e.Bool(*v.DefaultBackupState)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v VirtualAuthenticatorOptions) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads VirtualAuthenticatorOptions from JSON
+func (v *VirtualAuthenticatorOptions) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "protocol":
+			v.Protocol = AuthenticatorProtocol(d.String())
+		case "ctap2Version":
+			if d.Null() {
+				v.Ctap2Version = nil
+			} else {
+				v.Ctap2Version = new(Ctap2Version)
+				*v.Ctap2Version = Ctap2Version(d.String())
+			}
+		case "transport":
+			v.Transport = AuthenticatorTransport(d.String())
+		case "hasResidentKey":
+			if d.Null() {
+				v.HasResidentKey = nil
+			} else {
+				v.HasResidentKey = new(bool)
+				*v.HasResidentKey = d.Bool()
+			}
+		case "hasUserVerification":
+			if d.Null() {
+				v.HasUserVerification = nil
+			} else {
+				v.HasUserVerification = new(bool)
+				*v.HasUserVerification = d.Bool()
+			}
+		case "hasLargeBlob":
+			if d.Null() {
+				v.HasLargeBlob = nil
+			} else {
+				v.HasLargeBlob = new(bool)
+				*v.HasLargeBlob = d.Bool()
+			}
+		case "hasCredBlob":
+			if d.Null() {
+				v.HasCredBlob = nil
+			} else {
+				v.HasCredBlob = new(bool)
+				*v.HasCredBlob = d.Bool()
+			}
+		case "hasMinPinLength":
+			if d.Null() {
+				v.HasMinPinLength = nil
+			} else {
+				v.HasMinPinLength = new(bool)
+				*v.HasMinPinLength = d.Bool()
+			}
+		case "hasPrf":
+			if d.Null() {
+				v.HasPrf = nil
+			} else {
+				v.HasPrf = new(bool)
+				*v.HasPrf = d.Bool()
+			}
+		case "automaticPresenceSimulation":
+			if d.Null() {
+				v.AutomaticPresenceSimulation = nil
+			} else {
+				v.AutomaticPresenceSimulation = new(bool)
+				*v.AutomaticPresenceSimulation = d.Bool()
+			}
+		case "isUserVerified":
+			if d.Null() {
+				v.IsUserVerified = nil
+			} else {
+				v.IsUserVerified = new(bool)
+				*v.IsUserVerified = d.Bool()
+			}
+		case "defaultBackupEligibility":
+			if d.Null() {
+				v.DefaultBackupEligibility = nil
+			} else {
+				v.DefaultBackupEligibility = new(bool)
+				*v.DefaultBackupEligibility = d.Bool()
+			}
+		case "defaultBackupState":
+			if d.Null() {
+				v.DefaultBackupState = nil
+			} else {
+				v.DefaultBackupState = new(bool)
+				*v.DefaultBackupState = d.Bool()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *VirtualAuthenticatorOptions) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type Credential struct {
+	CredentialId         string `json:"credentialId"`
+	IsResidentCredential bool   `json:"isResidentCredential"`
+	/* Relying Party ID the credential is scoped to. Must be set when adding a
+	credential. */
+	RpId *string `json:"rpId,omitempty"`
+	/* The ECDSA P-256 private key in PKCS#8 format. (Encoded as a base64 string when passed over JSON) */
+	PrivateKey string `json:"privateKey"`
+	/* An opaque byte sequence with a maximum size of 64 bytes mapping the
+	credential to a specific user. (Encoded as a base64 string when passed over JSON) */
+	UserHandle *string `json:"userHandle,omitempty"`
+	/* Signature counter. This is incremented by one for each successful
+	assertion.
+	See https://w3c.github.io/webauthn/#signature-counter */
+	SignCount int `json:"signCount"`
+	/* The large blob associated with the credential.
+	See https://w3c.github.io/webauthn/#sctn-large-blob-extension (Encoded as a base64 string when passed over JSON) */
+	LargeBlob *string `json:"largeBlob,omitempty"`
+	/* Assertions returned by this credential will have the backup eligibility
+	(BE) flag set to this value. Defaults to the authenticator's
+	defaultBackupEligibility value. */
+	BackupEligibility *bool `json:"backupEligibility,omitempty"`
+	/* Assertions returned by this credential will have the backup state (BS)
+	flag set to this value. Defaults to the authenticator's
+	defaultBackupState value. */
+	BackupState *bool `json:"backupState,omitempty"`
+	/* The credential's user.name property. Equivalent to empty if not set.
+	https://w3c.github.io/webauthn/#dom-publickeycredentialentity-name */
+	UserName *string `json:"userName,omitempty"`
+	/* The credential's user.displayName property. Equivalent to empty if
+	not set.
+	https://w3c.github.io/webauthn/#dom-publickeycredentialuserentity-displayname */
+	UserDisplayName *string `json:"userDisplayName,omitempty"`
+}
+
+// MarshalCDP writes Credential as JSON
+func (v *Credential) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("credentialId")
+	e.String(v.CredentialId)
+	e.Key("isResidentCredential")
+	e.Bool(v.IsResidentCredential)
+	if v.RpId != nil {
+		e.Key("rpId")
+		e.String(*v.RpId)
+	}
+	e.Key("privateKey")
+	e.String(v.PrivateKey)
+	if v.UserHandle != nil {
+		e.Key("userHandle")
+		e.String(*v.UserHandle)
+	}
+	e.Key("signCount")
+	e.Int(v.SignCount)
+	if v.LargeBlob != nil {
+		e.Key("largeBlob")
+		e.String(*v.LargeBlob)
+	}
+	if v.BackupEligibility != nil {
+		e.Key("backupEligibility")
+		e.Bool(*v.BackupEligibility)
+	}
+	if v.BackupState != nil {
+		e.Key("backupState")
+		e.Bool(*v.BackupState)
+	}
+	if v.UserName != nil {
+		e.Key("userName")
+		e.String(*v.UserName)
+	}
+	if v.UserDisplayName != nil {
+		e.Key("userDisplayName")
+		e.String(*v.UserDisplayName)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v Credential) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// UnmarshalCDP reads Credential from JSON
+func (v *Credential) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "credentialId":
+			v.CredentialId = d.String()
+		case "isResidentCredential":
+			v.IsResidentCredential = d.Bool()
+		case "rpId":
+			if d.Null() {
+				v.RpId = nil
+			} else {
+				v.RpId = new(string)
+				*v.RpId = d.String()
+			}
+		case "privateKey":
+			v.PrivateKey = d.String()
+		case "userHandle":
+			if d.Null() {
+				v.UserHandle = nil
+			} else {
+				v.UserHandle = new(string)
+				*v.UserHandle = d.String()
+			}
+		case "signCount":
+			v.SignCount = d.Int()
+		case "largeBlob":
+			if d.Null() {
+				v.LargeBlob = nil
+			} else {
+				v.LargeBlob = new(string)
+				*v.LargeBlob = d.String()
+			}
+		case "backupEligibility":
+			if d.Null() {
+				v.BackupEligibility = nil
+			} else {
+				v.BackupEligibility = new(bool)
+				*v.BackupEligibility = d.Bool()
+			}
+		case "backupState":
+			if d.Null() {
+				v.BackupState = nil
+			} else {
+				v.BackupState = new(bool)
+				*v.BackupState = d.Bool()
+			}
+		case "userName":
+			if d.Null() {
+				v.UserName = nil
+			} else {
+				v.UserName = new(string)
+				*v.UserName = d.String()
+			}
+		case "userDisplayName":
+			if d.Null() {
+				v.UserDisplayName = nil
+			} else {
+				v.UserDisplayName = new(string)
+				*v.UserDisplayName = d.String()
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *Credential) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type EnableReturns struct {
+}
+
+// UnmarshalCDP reads EnableReturns from JSON
+func (v *EnableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *EnableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// EnableParams are the parameters for WebAuthn.enable
+// optional parameters are left out when nil
+type EnableParams struct {
+	/* Whether to enable the WebAuthn user interface. Enabling the UI is
+	recommended for debugging and demo purposes, as it is closer to the real
+	experience. Disabling the UI is recommended for automated testing.
+	Supported at the embedder's discretion if UI is available.
+	Defaults to false. */
+	EnableUI *bool `json:"enableUI,omitempty"`
+}
+
+// MarshalCDP writes EnableParams as JSON
+func (v *EnableParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	if v.EnableUI != nil {
+		e.Key("enableUI")
+		e.Bool(*v.EnableUI)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v EnableParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Enable the WebAuthn domain and start intercepting credential storage and
+// retrieval with a virtual authenticator.
+func (c Client) Enable(params EnableParams) (EnableReturns, error) {
+	return c.EnableContext(context.Background(), params)
+}
+
+// EnableContext is Enable with a context for cancellation and deadlines
+func (c Client) EnableContext(ctx context.Context, params EnableParams) (EnableReturns, error) {
+	var returns_ EnableReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.enable", &params, &returns_)
+
+	return returns_, err_
+}
+
+type DisableReturns struct {
+}
+
+// UnmarshalCDP reads DisableReturns from JSON
+func (v *DisableReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *DisableReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// Disable the WebAuthn domain.
+func (c Client) Disable() (DisableReturns, error) {
+	return c.DisableContext(context.Background())
+}
+
+// DisableContext is Disable with a context for cancellation and deadlines
+func (c Client) DisableContext(ctx context.Context) (DisableReturns, error) {
+	var returns_ DisableReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.disable", nil, &returns_)
+
+	return returns_, err_
+}
+
+type AddVirtualAuthenticatorReturns struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+}
+
+// UnmarshalCDP reads AddVirtualAuthenticatorReturns from JSON
+func (v *AddVirtualAuthenticatorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorId = AuthenticatorId(d.String())
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddVirtualAuthenticatorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddVirtualAuthenticatorParams are the parameters for WebAuthn.addVirtualAuthenticator
+// optional parameters are left out when nil
+type AddVirtualAuthenticatorParams struct {
+	Options VirtualAuthenticatorOptions `json:"options"`
+}
+
+// MarshalCDP writes AddVirtualAuthenticatorParams as JSON
+func (v *AddVirtualAuthenticatorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("options")
+	v.Options.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddVirtualAuthenticatorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Creates and adds a virtual authenticator.
+func (c Client) AddVirtualAuthenticator(params AddVirtualAuthenticatorParams) (AddVirtualAuthenticatorReturns, error) {
+	return c.AddVirtualAuthenticatorContext(context.Background(), params)
+}
+
+// AddVirtualAuthenticatorContext is AddVirtualAuthenticator with a context for cancellation and deadlines
+func (c Client) AddVirtualAuthenticatorContext(ctx context.Context, params AddVirtualAuthenticatorParams) (AddVirtualAuthenticatorReturns, error) {
+	var returns_ AddVirtualAuthenticatorReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.addVirtualAuthenticator", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetResponseOverrideBitsReturns struct {
+}
+
+// UnmarshalCDP reads SetResponseOverrideBitsReturns from JSON
+func (v *SetResponseOverrideBitsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetResponseOverrideBitsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetResponseOverrideBitsParams are the parameters for WebAuthn.setResponseOverrideBits
+// optional parameters are left out when nil
+type SetResponseOverrideBitsParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+	/* If isBogusSignature is set, overrides the signature in the authenticator response to be zero.
+	Defaults to false. */
+	IsBogusSignature *bool `json:"isBogusSignature,omitempty"`
+	/* If isBadUV is set, overrides the UV bit in the flags in the authenticator response to
+	be zero. Defaults to false. */
+	IsBadUV *bool `json:"isBadUV,omitempty"`
+	/* If isBadUP is set, overrides the UP bit in the flags in the authenticator response to
+	be zero. Defaults to false. */
+	IsBadUP *bool `json:"isBadUP,omitempty"`
+}
+
+// MarshalCDP writes SetResponseOverrideBitsParams as JSON
+func (v *SetResponseOverrideBitsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	if v.IsBogusSignature != nil {
+		e.Key("isBogusSignature")
+		e.Bool(*v.IsBogusSignature)
+	}
+	if v.IsBadUV != nil {
+		e.Key("isBadUV")
+		e.Bool(*v.IsBadUV)
+	}
+	if v.IsBadUP != nil {
+		e.Key("isBadUP")
+		e.Bool(*v.IsBadUP)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetResponseOverrideBitsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Resets parameters isBogusSignature, isBadUV, isBadUP to false if they are not present.
+func (c Client) SetResponseOverrideBits(params SetResponseOverrideBitsParams) (SetResponseOverrideBitsReturns, error) {
+	return c.SetResponseOverrideBitsContext(context.Background(), params)
+}
+
+// SetResponseOverrideBitsContext is SetResponseOverrideBits with a context for cancellation and deadlines
+func (c Client) SetResponseOverrideBitsContext(ctx context.Context, params SetResponseOverrideBitsParams) (SetResponseOverrideBitsReturns, error) {
+	var returns_ SetResponseOverrideBitsReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.setResponseOverrideBits", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveVirtualAuthenticatorReturns struct {
+}
+
+// UnmarshalCDP reads RemoveVirtualAuthenticatorReturns from JSON
+func (v *RemoveVirtualAuthenticatorReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveVirtualAuthenticatorReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveVirtualAuthenticatorParams are the parameters for WebAuthn.removeVirtualAuthenticator
+// optional parameters are left out when nil
+type RemoveVirtualAuthenticatorParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+}
+
+// MarshalCDP writes RemoveVirtualAuthenticatorParams as JSON
+func (v *RemoveVirtualAuthenticatorParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveVirtualAuthenticatorParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes the given authenticator.
+func (c Client) RemoveVirtualAuthenticator(params RemoveVirtualAuthenticatorParams) (RemoveVirtualAuthenticatorReturns, error) {
+	return c.RemoveVirtualAuthenticatorContext(context.Background(), params)
+}
+
+// RemoveVirtualAuthenticatorContext is RemoveVirtualAuthenticator with a context for cancellation and deadlines
+func (c Client) RemoveVirtualAuthenticatorContext(ctx context.Context, params RemoveVirtualAuthenticatorParams) (RemoveVirtualAuthenticatorReturns, error) {
+	var returns_ RemoveVirtualAuthenticatorReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.removeVirtualAuthenticator", &params, &returns_)
+
+	return returns_, err_
+}
+
+type AddCredentialReturns struct {
+}
+
+// UnmarshalCDP reads AddCredentialReturns from JSON
+func (v *AddCredentialReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *AddCredentialReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// AddCredentialParams are the parameters for WebAuthn.addCredential
+// optional parameters are left out when nil
+type AddCredentialParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+	Credential      Credential      `json:"credential"`
+}
+
+// MarshalCDP writes AddCredentialParams as JSON
+func (v *AddCredentialParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.Key("credential")
+	v.Credential.MarshalCDP(e)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v AddCredentialParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Adds the credential to the specified authenticator.
+func (c Client) AddCredential(params AddCredentialParams) (AddCredentialReturns, error) {
+	return c.AddCredentialContext(context.Background(), params)
+}
+
+// AddCredentialContext is AddCredential with a context for cancellation and deadlines
+func (c Client) AddCredentialContext(ctx context.Context, params AddCredentialParams) (AddCredentialReturns, error) {
+	var returns_ AddCredentialReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.addCredential", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetCredentialReturns struct {
+	Credential Credential `json:"credential"`
+}
+
+// UnmarshalCDP reads GetCredentialReturns from JSON
+func (v *GetCredentialReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "credential":
+			v.Credential.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCredentialReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetCredentialParams are the parameters for WebAuthn.getCredential
+// optional parameters are left out when nil
+type GetCredentialParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+	CredentialId    string          `json:"credentialId"`
+}
+
+// MarshalCDP writes GetCredentialParams as JSON
+func (v *GetCredentialParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.Key("credentialId")
+	e.String(v.CredentialId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetCredentialParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns a single credential stored in the given virtual authenticator that
+// matches the credential ID.
+func (c Client) GetCredential(params GetCredentialParams) (GetCredentialReturns, error) {
+	return c.GetCredentialContext(context.Background(), params)
+}
+
+// GetCredentialContext is GetCredential with a context for cancellation and deadlines
+func (c Client) GetCredentialContext(ctx context.Context, params GetCredentialParams) (GetCredentialReturns, error) {
+	var returns_ GetCredentialReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.getCredential", &params, &returns_)
+
+	return returns_, err_
+}
+
+type GetCredentialsReturns struct {
+	Credentials []Credential `json:"credentials"`
+}
+
+// UnmarshalCDP reads GetCredentialsReturns from JSON
+func (v *GetCredentialsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "credentials":
+			if d.Array() {
+				v.Credentials = make([]Credential, 0)
+				for d.More() {
+					var x0 Credential
+					x0.UnmarshalCDP(d)
+					v.Credentials = append(v.Credentials, x0)
+				}
+			} else {
+				v.Credentials = nil
+			}
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *GetCredentialsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// GetCredentialsParams are the parameters for WebAuthn.getCredentials
+// optional parameters are left out when nil
+type GetCredentialsParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+}
+
+// MarshalCDP writes GetCredentialsParams as JSON
+func (v *GetCredentialsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v GetCredentialsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Returns all the credentials stored in the given virtual authenticator.
+func (c Client) GetCredentials(params GetCredentialsParams) (GetCredentialsReturns, error) {
+	return c.GetCredentialsContext(context.Background(), params)
+}
+
+// GetCredentialsContext is GetCredentials with a context for cancellation and deadlines
+func (c Client) GetCredentialsContext(ctx context.Context, params GetCredentialsParams) (GetCredentialsReturns, error) {
+	var returns_ GetCredentialsReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.getCredentials", &params, &returns_)
+
+	return returns_, err_
+}
+
+type RemoveCredentialReturns struct {
+}
+
+// UnmarshalCDP reads RemoveCredentialReturns from JSON
+func (v *RemoveCredentialReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *RemoveCredentialReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// RemoveCredentialParams are the parameters for WebAuthn.removeCredential
+// optional parameters are left out when nil
+type RemoveCredentialParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+	CredentialId    string          `json:"credentialId"`
+}
+
+// MarshalCDP writes RemoveCredentialParams as JSON
+func (v *RemoveCredentialParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.Key("credentialId")
+	e.String(v.CredentialId)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v RemoveCredentialParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Removes a credential from the authenticator.
+func (c Client) RemoveCredential(params RemoveCredentialParams) (RemoveCredentialReturns, error) {
+	return c.RemoveCredentialContext(context.Background(), params)
+}
+
+// RemoveCredentialContext is RemoveCredential with a context for cancellation and deadlines
+func (c Client) RemoveCredentialContext(ctx context.Context, params RemoveCredentialParams) (RemoveCredentialReturns, error) {
+	var returns_ RemoveCredentialReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.removeCredential", &params, &returns_)
+
+	return returns_, err_
+}
+
+type ClearCredentialsReturns struct {
+}
+
+// UnmarshalCDP reads ClearCredentialsReturns from JSON
+func (v *ClearCredentialsReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *ClearCredentialsReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// ClearCredentialsParams are the parameters for WebAuthn.clearCredentials
+// optional parameters are left out when nil
+type ClearCredentialsParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+}
+
+// MarshalCDP writes ClearCredentialsParams as JSON
+func (v *ClearCredentialsParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v ClearCredentialsParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Clears all the credentials from the specified device.
+func (c Client) ClearCredentials(params ClearCredentialsParams) (ClearCredentialsReturns, error) {
+	return c.ClearCredentialsContext(context.Background(), params)
+}
+
+// ClearCredentialsContext is ClearCredentials with a context for cancellation and deadlines
+func (c Client) ClearCredentialsContext(ctx context.Context, params ClearCredentialsParams) (ClearCredentialsReturns, error) {
+	var returns_ ClearCredentialsReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.clearCredentials", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetUserVerifiedReturns struct {
+}
+
+// UnmarshalCDP reads SetUserVerifiedReturns from JSON
+func (v *SetUserVerifiedReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetUserVerifiedReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetUserVerifiedParams are the parameters for WebAuthn.setUserVerified
+// optional parameters are left out when nil
+type SetUserVerifiedParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+	IsUserVerified  bool            `json:"isUserVerified"`
+}
+
+// MarshalCDP writes SetUserVerifiedParams as JSON
+func (v *SetUserVerifiedParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.Key("isUserVerified")
+	e.Bool(v.IsUserVerified)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetUserVerifiedParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets whether User Verification succeeds or fails for an authenticator.
+// The default is true.
+func (c Client) SetUserVerified(params SetUserVerifiedParams) (SetUserVerifiedReturns, error) {
+	return c.SetUserVerifiedContext(context.Background(), params)
+}
+
+// SetUserVerifiedContext is SetUserVerified with a context for cancellation and deadlines
+func (c Client) SetUserVerifiedContext(ctx context.Context, params SetUserVerifiedParams) (SetUserVerifiedReturns, error) {
+	var returns_ SetUserVerifiedReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.setUserVerified", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetAutomaticPresenceSimulationReturns struct {
+}
+
+// UnmarshalCDP reads SetAutomaticPresenceSimulationReturns from JSON
+func (v *SetAutomaticPresenceSimulationReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetAutomaticPresenceSimulationReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetAutomaticPresenceSimulationParams are the parameters for WebAuthn.setAutomaticPresenceSimulation
+// optional parameters are left out when nil
+type SetAutomaticPresenceSimulationParams struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+	Enabled         bool            `json:"enabled"`
+}
+
+// MarshalCDP writes SetAutomaticPresenceSimulationParams as JSON
+func (v *SetAutomaticPresenceSimulationParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.Key("enabled")
+	e.Bool(v.Enabled)
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetAutomaticPresenceSimulationParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Sets whether tests of user presence will succeed immediately (if true) or fail to resolve (if false) for an authenticator.
+// The default is true.
+func (c Client) SetAutomaticPresenceSimulation(params SetAutomaticPresenceSimulationParams) (SetAutomaticPresenceSimulationReturns, error) {
+	return c.SetAutomaticPresenceSimulationContext(context.Background(), params)
+}
+
+// SetAutomaticPresenceSimulationContext is SetAutomaticPresenceSimulation with a context for cancellation and deadlines
+func (c Client) SetAutomaticPresenceSimulationContext(ctx context.Context, params SetAutomaticPresenceSimulationParams) (SetAutomaticPresenceSimulationReturns, error) {
+	var returns_ SetAutomaticPresenceSimulationReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.setAutomaticPresenceSimulation", &params, &returns_)
+
+	return returns_, err_
+}
+
+type SetCredentialPropertiesReturns struct {
+}
+
+// UnmarshalCDP reads SetCredentialPropertiesReturns from JSON
+func (v *SetCredentialPropertiesReturns) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *SetCredentialPropertiesReturns) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+// SetCredentialPropertiesParams are the parameters for WebAuthn.setCredentialProperties
+// optional parameters are left out when nil
+type SetCredentialPropertiesParams struct {
+	AuthenticatorId   AuthenticatorId `json:"authenticatorId"`
+	CredentialId      string          `json:"credentialId"`
+	BackupEligibility *bool           `json:"backupEligibility,omitempty"`
+	BackupState       *bool           `json:"backupState,omitempty"`
+}
+
+// MarshalCDP writes SetCredentialPropertiesParams as JSON
+func (v *SetCredentialPropertiesParams) MarshalCDP(e *cdp.Encoder) {
+	e.ObjectStart()
+	e.Key("authenticatorId")
+	e.String(string(v.AuthenticatorId))
+	e.Key("credentialId")
+	e.String(v.CredentialId)
+	if v.BackupEligibility != nil {
+		e.Key("backupEligibility")
+		e.Bool(*v.BackupEligibility)
+	}
+	if v.BackupState != nil {
+		e.Key("backupState")
+		e.Bool(*v.BackupState)
+	}
+	e.ObjectEnd()
+}
+
+// MarshalJSON is MarshalCDP for encoding/json
+func (v SetCredentialPropertiesParams) MarshalJSON() ([]byte, error) {
+	return cdp.Marshal(&v)
+}
+
+// Allows setting credential properties.
+// https://w3c.github.io/webauthn/#sctn-automation-set-credential-properties
+func (c Client) SetCredentialProperties(params SetCredentialPropertiesParams) (SetCredentialPropertiesReturns, error) {
+	return c.SetCredentialPropertiesContext(context.Background(), params)
+}
+
+// SetCredentialPropertiesContext is SetCredentialProperties with a context for cancellation and deadlines
+func (c Client) SetCredentialPropertiesContext(ctx context.Context, params SetCredentialPropertiesParams) (SetCredentialPropertiesReturns, error) {
+	var returns_ SetCredentialPropertiesReturns
+
+	err_ := c.caller.Call(ctx, "WebAuthn.setCredentialProperties", &params, &returns_)
+
+	return returns_, err_
+}
+
+/* Event Handlers */
+
+// Triggered when a credential is added to an authenticator.
+type CredentialAddedEvent struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+
+	Credential Credential `json:"credential"`
+}
+
+// UnmarshalCDP reads CredentialAddedEvent from JSON
+func (v *CredentialAddedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorId = AuthenticatorId(d.String())
+		case "credential":
+			v.Credential.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CredentialAddedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CredentialAddedHandler func(ev CredentialAddedEvent)
+
+// EventMethod is WebAuthn.credentialAdded
+func (CredentialAddedEvent) EventMethod() string {
+	return "WebAuthn.credentialAdded"
+}
+
+// OnCredentialAdded calls handler for each WebAuthn.credentialAdded event
+func (c Client) OnCredentialAdded(handler CredentialAddedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAuthn.credentialAdded", func(ev interface{}) {
+		handler(ev.(CredentialAddedEvent))
+	})
+}
+
+// Triggered when a credential is deleted, e.g. through
+// PublicKeyCredential.signalUnknownCredential().
+type CredentialDeletedEvent struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+
+	CredentialId string `json:"credentialId"`
+}
+
+// UnmarshalCDP reads CredentialDeletedEvent from JSON
+func (v *CredentialDeletedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorId = AuthenticatorId(d.String())
+		case "credentialId":
+			v.CredentialId = d.String()
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CredentialDeletedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CredentialDeletedHandler func(ev CredentialDeletedEvent)
+
+// EventMethod is WebAuthn.credentialDeleted
+func (CredentialDeletedEvent) EventMethod() string {
+	return "WebAuthn.credentialDeleted"
+}
+
+// OnCredentialDeleted calls handler for each WebAuthn.credentialDeleted event
+func (c Client) OnCredentialDeleted(handler CredentialDeletedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAuthn.credentialDeleted", func(ev interface{}) {
+		handler(ev.(CredentialDeletedEvent))
+	})
+}
+
+// Triggered when a credential is updated, e.g. through
+// PublicKeyCredential.signalCurrentUserDetails().
+type CredentialUpdatedEvent struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+
+	Credential Credential `json:"credential"`
+}
+
+// UnmarshalCDP reads CredentialUpdatedEvent from JSON
+func (v *CredentialUpdatedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorId = AuthenticatorId(d.String())
+		case "credential":
+			v.Credential.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CredentialUpdatedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CredentialUpdatedHandler func(ev CredentialUpdatedEvent)
+
+// EventMethod is WebAuthn.credentialUpdated
+func (CredentialUpdatedEvent) EventMethod() string {
+	return "WebAuthn.credentialUpdated"
+}
+
+// OnCredentialUpdated calls handler for each WebAuthn.credentialUpdated event
+func (c Client) OnCredentialUpdated(handler CredentialUpdatedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAuthn.credentialUpdated", func(ev interface{}) {
+		handler(ev.(CredentialUpdatedEvent))
+	})
+}
+
+// Triggered when a credential is used in a webauthn assertion.
+type CredentialAssertedEvent struct {
+	AuthenticatorId AuthenticatorId `json:"authenticatorId"`
+
+	Credential Credential `json:"credential"`
+}
+
+// UnmarshalCDP reads CredentialAssertedEvent from JSON
+func (v *CredentialAssertedEvent) UnmarshalCDP(d *cdp.Decoder) {
+	if !d.Object() {
+		return
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "authenticatorId":
+			v.AuthenticatorId = AuthenticatorId(d.String())
+		case "credential":
+			v.Credential.UnmarshalCDP(d)
+		default:
+			d.Skip()
+		}
+	}
+}
+
+// UnmarshalJSON is UnmarshalCDP for encoding/json
+func (v *CredentialAssertedEvent) UnmarshalJSON(data []byte) error {
+	return cdp.Unmarshal(data, v)
+}
+
+type CredentialAssertedHandler func(ev CredentialAssertedEvent)
+
+// EventMethod is WebAuthn.credentialAsserted
+func (CredentialAssertedEvent) EventMethod() string {
+	return "WebAuthn.credentialAsserted"
+}
+
+// OnCredentialAsserted calls handler for each WebAuthn.credentialAsserted event
+func (c Client) OnCredentialAsserted(handler CredentialAssertedHandler) (unsubscribe func()) {
+	return c.caller.On("WebAuthn.credentialAsserted", func(ev interface{}) {
+		handler(ev.(CredentialAssertedEvent))
+	})
+}
+
+// decoders for WebAuthn events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){
+	"WebAuthn.credentialAdded":    cdp.Decode[CredentialAddedEvent],
+	"WebAuthn.credentialDeleted":  cdp.Decode[CredentialDeletedEvent],
+	"WebAuthn.credentialUpdated":  cdp.Decode[CredentialUpdatedEvent],
+	"WebAuthn.credentialAsserted": cdp.Decode[CredentialAssertedEvent],
+}
+
+// DecodeEvent decodes a WebAuthn event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
@@ -5,13 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 )
 
 //go:generate go run gen.go
 
-// Log function
-var Log = func(string, ...interface{}) {}
-
 // Protocol describes the DevTools Protocol
 type Protocol struct {
 	Domains []Domain
@@ -32,7 +30,9 @@ func (p *Protocol) VersionString() string {
 // Domain is a single protocol domain
 type Domain struct {
 	Domain       string
+	Description  string
 	Experimental bool
+	Deprecated   bool
 	Dependencies []string
 	Types        []Type
 	Commands     []Command
@@ -44,6 +44,7 @@ type Command struct {
 	Name         string
 	Description  string
 	Experimental bool
+	Deprecated   bool
 	Parameters   []Parameter
 	Returns      []Return
 	// hack: extended
@@ -52,31 +53,38 @@ type Command struct {
 
 // Event from protocol
 type Event struct {
-	Name        string
-	Description string
-	Parameters  []Parameter
-	EventName   string
+	Name         string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Parameters   []Parameter
+	EventName    string
 }
 
 // Parameter from protocol
 type Parameter struct {
-	Name        string
-	Description string
-	Optional    bool
-	Type        string
-	Enum        []string
-	Ref         string `json:"$ref"`
-	Items       Item
+	Name         string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Optional     bool
+	Type         string
+	Enum         []string
+	Ref          string `json:"$ref"`
+	Items        Item
 }
 
 // Return from a command
 type Return struct {
-	Name        string
-	Description string
-	Optional    bool
-	Type        string
-	Ref         string `json:"$ref"`
-	Items       Item
+	Name         string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Optional     bool
+	Type         string
+	Enum         []string
+	Ref          string `json:"$ref"`
+	Items        Item
 }
 
 // Item describes array contents
@@ -87,32 +95,65 @@ type Item struct {
 
 // Type of variable
 type Type struct {
-	ID          string
-	Description string
-	Type        string
-	Enum        []string
-	Properties  []Property
+	ID           string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Type         string
+	Enum         []string
+	Properties   []Property
+	// element type when Type is array
+	Items Item
 }
 
 // Property of object
 type Property struct {
-	Name        string
-	Description string
-	Optional    bool
-	Type        string
-	Ref         string `json:"$ref"`
-	Items       Item
+	Name         string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Optional     bool
+	Type         string
+	Enum         []string
+	Ref          string `json:"$ref"`
+	Items        Item
+}
+
+// ReadProtocolFiles reads protocol definitions
+// such as browser_protocol.json and js_protocol.json
+// the domains from every file are combined
+// the version comes from the first file
+func ReadProtocolFiles(paths ...string) (protocol Protocol, err error) {
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return protocol, fmt.Errorf("ReadProtocolFiles: %w", err)
+		}
+		var p Protocol
+		if err := json.Unmarshal(data, &p); err != nil {
+			return protocol, fmt.Errorf("ReadProtocolFiles: %s: %w", path, err)
+		}
+		if protocol.Version == (Version{}) {
+			protocol.Version = p.Version
+		}
+		protocol.Domains = append(protocol.Domains, p.Domains...)
+	}
+
+	return
 }
 
 // GetProtocol from chrome
-func (b *Browser) GetProtocol() (protocol Protocol) {
+func (b *Browser) GetProtocol() (protocol Protocol, err error) {
 	res, err := b.http(context.TODO(), http.MethodGet, "/json/protocol")
 	if err != nil {
-		panic(err)
+		return protocol, fmt.Errorf("GetProtocol: %w", err)
 	}
 	defer res.Body.Close()
 
-	json.NewDecoder(res.Body).Decode(&protocol)
+	err = json.NewDecoder(res.Body).Decode(&protocol)
+	if err != nil {
+		return protocol, fmt.Errorf("GetProtocol: json.NewDecoder: %w", err)
+	}
 
 	return
 }
@@ -0,0 +1,28 @@
+package gochrome
+
+import "testing"
+
+func TestReadProtocolFiles(t *testing.T) {
+	p, err := ReadProtocolFiles("protocol/browser_protocol.json", "protocol/js_protocol.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.VersionString() != "1.3" {
+		t.Errorf("unexpected version %q", p.VersionString())
+	}
+
+	domains := make(map[string]bool)
+	for _, d := range p.Domains {
+		domains[d.Domain] = true
+	}
+	// one from each file
+	for _, name := range []string{"Page", "Runtime"} {
+		if !domains[name] {
+			t.Errorf("expected the %s domain", name)
+		}
+	}
+
+	if _, err := ReadProtocolFiles("protocol/missing.json"); err == nil {
+		t.Error("expected an error")
+	}
+}
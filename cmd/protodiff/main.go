@@ -0,0 +1,75 @@
+// protodiff compares two versions of the DevTools protocol
+// and reports which changes break the generated API
+//
+//	protodiff protocol/ new/
+//	protodiff protocol/ protocol.json
+//
+// each version is a directory with browser_protocol.json and js_protocol.json
+// or a single file such as one saved from http://localhost:9222/json/protocol
+//
+// exits 1 if a change is breaking and 2 on errors
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bobbytrapz/gochrome"
+)
+
+func main() {
+	breaking := flag.Bool("breaking", false, "only report breaking changes")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: protodiff [-breaking] old new")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	old, err := read(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	new, err := read(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	fmt.Printf("protocol %s -> %s\n", old.VersionString(), new.VersionString())
+	changes := gochrome.DiffProtocol(old, new)
+	broken := 0
+	for _, c := range changes {
+		if c.Breaking {
+			broken++
+		} else if *breaking {
+			continue
+		}
+		fmt.Println(c)
+	}
+	fmt.Printf("%d changes, %d breaking\n", len(changes), broken)
+
+	if broken > 0 {
+		os.Exit(1)
+	}
+}
+
+// read a protocol directory or file
+func read(path string) (gochrome.Protocol, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return gochrome.Protocol{}, err
+	}
+	if info.IsDir() {
+		return gochrome.ReadProtocolFiles(
+			filepath.Join(path, "browser_protocol.json"),
+			filepath.Join(path, "js_protocol.json"))
+	}
+	return gochrome.ReadProtocolFiles(path)
+}
@@ -0,0 +1,435 @@
+package gochrome
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// how long a connection that drops waits to see if chrome we started exited
+const exitGrace = 500 * time.Millisecond
+
+// conn is a single connection to chrome
+// it may carry many sessions when using flattened sessions
+// commands are matched to responses by id
+// events are routed to tabs by sessionId
+type conn struct {
+	send      chan outgoing
+	returns   map[int]pendingCommand
+	sessions  map[string]*Tab
+	rw        sync.RWMutex
+	nextReqID int
+	// closed when the reader stops
+	done chan struct{}
+	// closes the connection
+	closed chan struct{}
+	log    *slog.Logger
+	// why the connection ended
+	// errors after we chose to stop are not kept
+	err       error
+	stopped   bool
+	closeOnce sync.Once
+	// current transport
+	// held while sending and while reconnecting
+	t  Transport
+	tm sync.Mutex
+	// reconnects when set
+	redial func() (Transport, error)
+	policy Reconnect
+}
+
+// command waiting for a response
+type pendingCommand struct {
+	method    string
+	sessionID string
+	ch        chan CommandResponse
+}
+
+// command waiting to be written
+type outgoing struct {
+	id   int
+	data []byte
+}
+
+// message from chrome
+type resChrome struct {
+	ID int
+	// call response
+	Result json.RawMessage
+	Error  *ProtocolError
+	// event
+	Method string
+	Params json.RawMessage
+	// session the message belongs to
+	// empty for the connection's own target
+	SessionID string
+}
+
+// read a message without reflection
+// Result and Params point into data
+func (msg *resChrome) unmarshal(data []byte) error {
+	d := cdp.NewDecoder(data)
+	if !d.Object() {
+		return fmt.Errorf("%w: message is not an object", cdp.ErrSyntax)
+	}
+	for d.More() {
+		switch string(d.Key()) {
+		case "id":
+			msg.ID = d.Int()
+		case "result":
+			msg.Result = d.Raw()
+		case "error":
+			if !d.Object() {
+				continue
+			}
+			msg.Error = &ProtocolError{}
+			for d.More() {
+				switch string(d.Key()) {
+				case "code":
+					msg.Error.Code = d.Int()
+				case "message":
+					msg.Error.Message = d.String()
+				case "data":
+					msg.Error.Data = d.String()
+				default:
+					d.Skip()
+				}
+			}
+		case "method":
+			msg.Method = d.String()
+		case "params":
+			msg.Params = d.Raw()
+		case "sessionId":
+			msg.SessionID = d.String()
+		default:
+			d.Skip()
+		}
+	}
+	return d.End()
+}
+
+// dial a websocket
+// Browser.Dial may replace the websocket
+func (b *Browser) dial(wsURL string) (Transport, error) {
+	dial := b.Dial
+	if dial == nil {
+		dial = DialWebSocket
+	}
+	return dial(wsURL)
+}
+
+// start the read/write goroutines for a transport
+// redial is used to reconnect when the transport drops
+// nil if the connection cannot reconnect
+// tabID is set for a page websocket and empty for the browser
+func (b *Browser) newConn(t Transport, redial func() (Transport, error), tabID string) *conn {
+	log := b.logger()
+	if tabID != "" {
+		log = log.With("tab", tabID)
+	}
+	c := &conn{
+		send:     make(chan outgoing),
+		returns:  make(map[int]pendingCommand),
+		sessions: make(map[string]*Tab),
+		done:     make(chan struct{}),
+		closed:   make(chan struct{}),
+		log:      log,
+		t:        t,
+	}
+	if redial != nil && b.Reconnect != nil {
+		c.redial = redial
+		c.policy = *b.Reconnect
+	}
+
+	// read
+	// handle events
+	b.wg.Add(1)
+	go func() {
+		defer func() {
+			// end every session on the connection
+			reason := c.Err()
+			if reason == nil {
+				reason = ErrBrowserExited
+				if err := b.Err(); err != nil {
+					reason = fmt.Errorf("%w: %w", ErrBrowserExited, err)
+				}
+			}
+			c.shutdown(reason)
+			close(c.done)
+		}()
+		for {
+			data, err := t.Receive()
+			if err != nil {
+				c.log.Info("connection closed", "err", err)
+				select {
+				case <-b.exit:
+					// chrome went away first
+					c.stop()
+				case <-c.closed:
+					c.stop()
+				default:
+					if b.owned() {
+						// the websocket usually closes before we reap chrome
+						// so give it a moment to tell an exit from a dropped connection
+						select {
+						case <-b.exit:
+							c.stop()
+						case <-c.closed:
+							c.stop()
+						case <-time.After(exitGrace):
+						}
+					}
+				}
+				if c.redial != nil && !c.isStopped() {
+					nt, rerr := c.reconnect(b, err)
+					if rerr == nil {
+						t = nt
+						continue
+					}
+				}
+				c.fail(fmt.Errorf("receive: %w", err))
+				return
+			}
+			if wireEnabled(c.log) {
+				c.log.Debug("receive", "message", string(data))
+			}
+			var msg resChrome
+			err = msg.unmarshal(data)
+			if err != nil {
+				c.log.Error("bad message from chrome", "err", err)
+				c.fail(fmt.Errorf("receive: %w", err))
+				return
+			}
+			if msg.Method == "" {
+				// response to a command
+				c.resolveReq(msg.ID, msg.Result, msg.Error)
+				continue
+			}
+			tab := c.session(msg.SessionID)
+			if tab == nil {
+				c.log.Debug("event for unknown session", "session", msg.SessionID, "method", msg.Method)
+				continue
+			}
+			tab.handle(msg.Method, msg.Params)
+		}
+	}()
+
+	// handle writing/closing
+	go func() {
+		defer func() {
+			c.tm.Lock()
+			c.t.Close()
+			c.tm.Unlock()
+			b.wg.Done()
+		}()
+		for {
+			select {
+			case <-c.closed:
+				c.log.Info("connection was closed")
+				c.stop()
+				return
+			case <-c.done:
+				return
+			case <-b.exit:
+				c.stop()
+				return
+			case msg := <-c.send:
+				c.tm.Lock()
+				if !c.pending(msg.id) {
+					// failed by a reconnect while it waited
+					// the caller was told so chrome must not run it
+					c.tm.Unlock()
+					c.log.Debug("dropped command that is no longer pending", "id", msg.id)
+					continue
+				}
+				if wireEnabled(c.log) {
+					c.log.Debug("send", "message", string(msg.data))
+				}
+				t := c.t
+				err := t.Send(msg.data)
+				c.tm.Unlock()
+				if err != nil {
+					c.log.Error("send failed", "err", err)
+					if c.redial != nil {
+						// the reader sees the closed transport and reconnects
+						t.Close()
+						continue
+					}
+					c.fail(fmt.Errorf("send: %w", err))
+					return
+				}
+			}
+		}
+	}()
+
+	return c
+}
+
+// close the connection
+// safe to call more than once
+func (c *conn) close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+}
+
+// close every session and fail every command still waiting
+func (c *conn) shutdown(reason error) {
+	for _, tab := range c.allSessions() {
+		tab.close(reason)
+	}
+
+	c.failAll(func(method string) error {
+		return fmt.Errorf("%s: %w: %w", method, ErrTabClosed, reason)
+	})
+}
+
+// fail every command still waiting
+func (c *conn) failAll(errFor func(method string) error) {
+	c.rw.Lock()
+	returns := c.returns
+	c.returns = make(map[int]pendingCommand)
+	c.rw.Unlock()
+	for _, req := range returns {
+		req.ch <- CommandResponse{Err: errFor(req.method)}
+	}
+}
+
+// fail the commands a session is waiting on
+func (c *conn) failSession(sessionID string, errFor func(method string) error) {
+	c.rw.Lock()
+	var failed []pendingCommand
+	for id, req := range c.returns {
+		if req.sessionID == sessionID {
+			failed = append(failed, req)
+			delete(c.returns, id)
+		}
+	}
+	c.rw.Unlock()
+
+	for _, req := range failed {
+		req.ch <- CommandResponse{Err: errFor(req.method)}
+	}
+}
+
+// we are closing so errors from here on are expected
+func (c *conn) stop() {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	c.stopped = true
+}
+
+func (c *conn) isStopped() bool {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	return c.stopped
+}
+
+// keep the first error that ended the connection
+func (c *conn) fail(err error) {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	if c.err == nil && !c.stopped {
+		c.err = err
+	}
+}
+
+// Err gives the error that ended the connection
+// nil while it is open or if we closed it
+func (c *conn) Err() error {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	return c.err
+}
+
+// add a session so its events reach the tab
+func (c *conn) addSession(tab *Tab) {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	c.sessions[tab.sessionID] = tab
+}
+
+func (c *conn) session(sessionID string) *Tab {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	return c.sessions[sessionID]
+}
+
+func (c *conn) allSessions() []*Tab {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	tabs := make([]*Tab, 0, len(c.sessions))
+	for _, tab := range c.sessions {
+		tabs = append(tabs, tab)
+	}
+	return tabs
+}
+
+func (c *conn) removeSession(sessionID string) *Tab {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	tab := c.sessions[sessionID]
+	delete(c.sessions, sessionID)
+	return tab
+}
+
+// sessions attached to a target
+func (c *conn) sessionsFor(targetID string) []*Tab {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	var tabs []*Tab
+	for _, tab := range c.sessions {
+		if tab.ID() == targetID && tab.sessionID != "" {
+			tabs = append(tabs, tab)
+		}
+	}
+	return tabs
+}
+
+func (c *conn) addReq(method string, sessionID string) (int, chan CommandResponse) {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	c.nextReqID++
+
+	// make return channel
+	// buffered so the reader never waits on a caller
+	ch := make(chan CommandResponse, 1)
+	c.returns[c.nextReqID] = pendingCommand{method: method, sessionID: sessionID, ch: ch}
+
+	return c.nextReqID, ch
+}
+
+func (c *conn) getReq(id int) (pendingCommand, bool) {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	req, ok := c.returns[id]
+	delete(c.returns, id)
+	return req, ok
+}
+
+// true if the command is still waiting for a response
+func (c *conn) pending(id int) bool {
+	c.rw.RLock()
+	defer c.rw.RUnlock()
+	_, ok := c.returns[id]
+	return ok
+}
+
+// pass a response from chrome to whoever sent the command
+func (c *conn) resolveReq(id int, result json.RawMessage, perr *ProtocolError) {
+	req, ok := c.getReq(id)
+	if !ok {
+		c.log.Debug("response for unknown command", "id", id)
+		return
+	}
+	res := CommandResponse{Result: result}
+	if perr != nil {
+		perr.Method = req.method
+		res.Err = perr
+	}
+	req.ch <- res
+}
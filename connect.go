@@ -0,0 +1,125 @@
+package gochrome
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bobbytrapz/gochrome/cdp/target"
+)
+
+// Connect attaches to a chrome that is already running
+// addr is the host:port given to --remote-debugging-port
+// targets are discovered with /json/version and /json
+// returns a tab for each page that is already open
+//
+// gochrome does not own this browser so
+// Close only disconnects and leaves chrome running
+// Wait returns once we have disconnected
+// cancelling ctx also disconnects
+func (b *Browser) Connect(ctx context.Context, addr string) ([]*Tab, error) {
+	err := b.waitForBrowser(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	b.attached(ctx)
+
+	if b.Flatten {
+		err = b.connectBrowser(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targets, err := b.listTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tabs []*Tab
+	for _, tci := range targets {
+		if tci.Type != "page" {
+			continue
+		}
+		tab, err := b.addTab(ctx, tci)
+		if err != nil {
+			return tabs, err
+		}
+		tabs = append(tabs, tab)
+	}
+
+	return tabs, nil
+}
+
+// ConnectWebSocket attaches to a chrome that is already running
+// wsURL is the browser websocket from /json/version
+// ws://127.0.0.1:9222/devtools/browser/<id>
+// there is no http api so the browser always uses flattened sessions
+// returns a tab for each page that is already open
+//
+// Close and Wait behave as they do for Connect
+func (b *Browser) ConnectWebSocket(ctx context.Context, wsURL string) ([]*Tab, error) {
+	b.Flatten = true
+	b.attached(ctx)
+
+	err := b.connectBrowserWebSocket(wsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.attachPages(ctx, 0)
+}
+
+// attach to open pages found with Target.getTargets
+// stops after max pages unless max is 0
+func (b *Browser) attachPages(ctx context.Context, max int) ([]*Tab, error) {
+	res, err := b.browserTab.Target().GetTargetsContext(ctx, target.GetTargetsParams{})
+	if err != nil {
+		return nil, fmt.Errorf("Target.getTargets: %w", err)
+	}
+
+	var tabs []*Tab
+	for _, info := range res.TargetInfos {
+		if max > 0 && len(tabs) == max {
+			break
+		}
+		if info.Type != "page" {
+			continue
+		}
+		tab, err := b.addTab(ctx, tabConnectionInfo{
+			ID:    string(info.TargetId),
+			Type:  info.Type,
+			Title: info.Title,
+			URL:   info.Url,
+		})
+		if err != nil {
+			return tabs, err
+		}
+		tabs = append(tabs, tab)
+	}
+
+	return tabs, nil
+}
+
+// set up a browser we did not start
+func (b *Browser) attached(ctx context.Context) {
+	b.exit = make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.logger().Info("disconnect browser")
+			b.disconnect()
+		case <-b.exit:
+		}
+	}()
+}
+
+// owned is true if gochrome started the chrome process
+func (b *Browser) owned() bool {
+	return b.cmd != nil
+}
+
+// stop our goroutines without closing chrome
+func (b *Browser) disconnect() {
+	b.exited()
+}
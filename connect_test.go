@@ -0,0 +1,66 @@
+package gochrome
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConnect(t *testing.T) {
+	fc := newFakeChrome(t, func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		switch cmd.Method {
+		case "Target.getTargets":
+			return map[string]interface{}{
+				"targetInfos": []map[string]interface{}{
+					{"targetId": "page", "type": "page", "url": "about:blank"},
+					{"targetId": "worker", "type": "service_worker"},
+				},
+			}, nil
+		case "Target.attachToTarget":
+			return map[string]interface{}{"sessionId": "session"}, nil
+		}
+		return struct{}{}, nil
+	})
+
+	disconnects := func(t *testing.T, b *Browser) {
+		t.Helper()
+		if err := b.Close(); err != nil {
+			t.Fatal(err)
+		}
+		done := make(chan struct{})
+		go func() {
+			b.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Wait did not return after Close")
+		}
+	}
+
+	t.Run("http", func(t *testing.T) {
+		b := NewBrowser()
+		tabs, err := b.Connect(context.Background(), strings.TrimPrefix(fc.srv.URL, "http://"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tabs) != 1 || tabs[0].ID() != "fake" {
+			t.Fatalf("expected the open page, got %+v", tabs)
+		}
+		disconnects(t, b)
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		b := NewBrowser()
+		tabs, err := b.ConnectWebSocket(context.Background(), fc.wsURL("/devtools/browser"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tabs) != 1 || tabs[0].ID() != "page" || tabs[0].sessionID != "session" {
+			t.Fatalf("expected the open page, got %+v", tabs)
+		}
+		disconnects(t, b)
+	})
+}
@@ -0,0 +1,332 @@
+package gochrome
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProtocolChange is a difference between two versions of the protocol
+type ProtocolChange struct {
+	// what changed
+	// Network, Network.getCookies or Network.getCookies.urls
+	Path string
+	// domain, command, event, type, parameter, return or property
+	Kind string
+	// how it changed
+	// added, removed, now required, type changed from string to integer ...
+	Change string
+	// code using the generated API may no longer build or work
+	Breaking bool
+}
+
+func (c ProtocolChange) String() string {
+	s := fmt.Sprintf("%s %s: %s", c.Kind, c.Path, c.Change)
+	if c.Breaking {
+		s += " (breaking)"
+	}
+	return s
+}
+
+// DiffProtocol compares two versions of the protocol
+// such as the pinned protocol and one from a newer chrome
+// changes are given in the order of the domains in old
+// then domains only in new
+func DiffProtocol(old, new Protocol) []ProtocolChange {
+	d := protocolDiff{types: typesByID(new)}
+
+	newDomains := make(map[string]Domain)
+	for _, domain := range new.Domains {
+		newDomains[domain.Domain] = domain
+	}
+	seen := make(map[string]bool)
+	for _, o := range old.Domains {
+		seen[o.Domain] = true
+		n, ok := newDomains[o.Domain]
+		if !ok {
+			d.add(o.Domain, "domain", "removed", true)
+			continue
+		}
+		d.domain(o, n)
+	}
+	for _, n := range new.Domains {
+		if !seen[n.Domain] {
+			d.add(n.Domain, "domain", "added", false)
+		}
+	}
+
+	return d.changes
+}
+
+type protocolDiff struct {
+	// Domain.Type to its definition in the new protocol
+	types   map[string]Type
+	changes []ProtocolChange
+}
+
+func typesByID(p Protocol) map[string]Type {
+	types := make(map[string]Type)
+	for _, domain := range p.Domains {
+		for _, t := range domain.Types {
+			types[domain.Domain+"."+t.ID] = t
+		}
+	}
+	return types
+}
+
+func (d *protocolDiff) add(path string, kind string, change string, breaking bool) {
+	d.changes = append(d.changes, ProtocolChange{Path: path, Kind: kind, Change: change, Breaking: breaking})
+}
+
+// experimental and deprecated markers
+// experimental parts are left out of builds with gochrome_stable
+func (d *protocolDiff) status(path string, kind string, oldExp, newExp, oldDep, newDep bool) {
+	switch {
+	case !oldExp && newExp:
+		d.add(path, kind, "now experimental", true)
+	case oldExp && !newExp:
+		d.add(path, kind, "no longer experimental", false)
+	}
+	switch {
+	case !oldDep && newDep:
+		d.add(path, kind, "now deprecated", false)
+	case oldDep && !newDep:
+		d.add(path, kind, "no longer deprecated", false)
+	}
+}
+
+func (d *protocolDiff) domain(o, n Domain) {
+	d.status(o.Domain, "domain", o.Experimental, n.Experimental, o.Deprecated, n.Deprecated)
+
+	// types
+	newTypes := make(map[string]Type)
+	for _, t := range n.Types {
+		newTypes[t.ID] = t
+	}
+	seen := make(map[string]bool)
+	for _, ot := range o.Types {
+		seen[ot.ID] = true
+		path := o.Domain + "." + ot.ID
+		nt, ok := newTypes[ot.ID]
+		if !ok {
+			d.add(path, "type", "removed", true)
+			continue
+		}
+		// types are generated whether or not they are experimental
+		d.status(path, "type", false, false, ot.Deprecated, nt.Deprecated)
+		if from, to := typeOf(o.Domain, "", ot.Type, ot.Items), typeOf(n.Domain, "", nt.Type, nt.Items); from != to {
+			d.add(path, "type", fmt.Sprintf("type changed from %s to %s", from, to), true)
+		}
+		d.enum(path, "type", ot.Enum, nt.Enum)
+		d.fields(path, "property", o.Domain, fieldsOfProperties(ot.Properties), fieldsOfProperties(nt.Properties), false)
+	}
+	for _, nt := range n.Types {
+		if !seen[nt.ID] {
+			d.add(n.Domain+"."+nt.ID, "type", "added", false)
+		}
+	}
+
+	// commands and events are only tagged on their own in stable domains
+	tagged := !o.Experimental && !n.Experimental
+
+	// commands
+	newCommands := make(map[string]Command)
+	for _, c := range n.Commands {
+		newCommands[c.Name] = c
+	}
+	seen = make(map[string]bool)
+	for _, oc := range o.Commands {
+		seen[oc.Name] = true
+		path := o.Domain + "." + oc.Name
+		nc, ok := newCommands[oc.Name]
+		if !ok {
+			d.add(path, "command", "removed", true)
+			continue
+		}
+		d.status(path, "command", oc.Experimental && tagged, nc.Experimental && tagged, oc.Deprecated, nc.Deprecated)
+		d.fields(path, "parameter", o.Domain, fieldsOfParameters(oc.Parameters), fieldsOfParameters(nc.Parameters), true)
+		d.fields(path, "return", o.Domain, fieldsOfReturns(oc.Returns), fieldsOfReturns(nc.Returns), false)
+	}
+	for _, nc := range n.Commands {
+		if !seen[nc.Name] {
+			d.add(n.Domain+"."+nc.Name, "command", "added", false)
+		}
+	}
+
+	// events
+	newEvents := make(map[string]Event)
+	for _, e := range n.Events {
+		newEvents[e.Name] = e
+	}
+	seen = make(map[string]bool)
+	for _, oe := range o.Events {
+		seen[oe.Name] = true
+		path := o.Domain + "." + oe.Name
+		ne, ok := newEvents[oe.Name]
+		if !ok {
+			d.add(path, "event", "removed", true)
+			continue
+		}
+		d.status(path, "event", oe.Experimental && tagged, ne.Experimental && tagged, oe.Deprecated, ne.Deprecated)
+		d.fields(path, "parameter", o.Domain, fieldsOfParameters(oe.Parameters), fieldsOfParameters(ne.Parameters), false)
+	}
+	for _, ne := range n.Events {
+		if !seen[ne.Name] {
+			d.add(n.Domain+"."+ne.Name, "event", "added", false)
+		}
+	}
+}
+
+// a parameter, return or property
+type protocolField struct {
+	Name       string
+	Deprecated bool
+	Optional   bool
+	Type       string
+	Ref        string
+	Items      Item
+	Enum       []string
+}
+
+func fieldsOfProperties(props []Property) (fields []protocolField) {
+	for _, p := range props {
+		fields = append(fields, protocolField{p.Name, p.Deprecated, p.Optional, p.Type, p.Ref, p.Items, p.Enum})
+	}
+	return
+}
+
+func fieldsOfParameters(params []Parameter) (fields []protocolField) {
+	for _, p := range params {
+		fields = append(fields, protocolField{p.Name, p.Deprecated, p.Optional, p.Type, p.Ref, p.Items, p.Enum})
+	}
+	return
+}
+
+func fieldsOfReturns(returns []Return) (fields []protocolField) {
+	for _, p := range returns {
+		fields = append(fields, protocolField{p.Name, p.Deprecated, p.Optional, p.Type, p.Ref, p.Items, p.Enum})
+	}
+	return
+}
+
+// compare the fields of a type, command or event
+// optional fields are pointers so making them optional or required changes their Go type
+// sent is true for command parameters which callers set
+func (d *protocolDiff) fields(parent string, kind string, domain string, old, new []protocolField, sent bool) {
+	newFields := make(map[string]protocolField)
+	for _, f := range new {
+		newFields[f.Name] = f
+	}
+	seen := make(map[string]bool)
+	for _, of := range old {
+		seen[of.Name] = true
+		path := parent + "." + of.Name
+		nf, ok := newFields[of.Name]
+		if !ok {
+			d.add(path, kind, "removed", true)
+			continue
+		}
+		d.status(path, kind, false, false, of.Deprecated, nf.Deprecated)
+
+		from, to := typeOf(domain, of.Ref, of.Type, of.Items), typeOf(domain, nf.Ref, nf.Type, nf.Items)
+		if len(of.Enum) > 0 != (len(nf.Enum) > 0) {
+			// an inline enum is its own Go type
+			from, to = enumOf(from, of.Enum), enumOf(to, nf.Enum)
+		}
+		if from != to {
+			d.add(path, kind, fmt.Sprintf("type changed from %s to %s", from, to), true)
+		}
+		d.enum(path, kind, of.Enum, nf.Enum)
+
+		switch {
+		case of.Optional && !nf.Optional:
+			d.add(path, kind, "now required", sent || !d.nilable(to))
+		case !of.Optional && nf.Optional:
+			d.add(path, kind, "now optional", !d.nilable(to))
+		}
+	}
+	for _, nf := range new {
+		if seen[nf.Name] {
+			continue
+		}
+		path := parent + "." + nf.Name
+		if !nf.Optional && sent {
+			// callers must set it
+			d.add(path, kind, "added as required", true)
+			continue
+		}
+		d.add(path, kind, "added", false)
+	}
+}
+
+// enum values that went away
+func (d *protocolDiff) enum(path string, kind string, old, new []string) {
+	if len(old) == 0 || len(new) == 0 {
+		return
+	}
+	has := make(map[string]bool)
+	for _, v := range new {
+		has[v] = true
+	}
+	var removed []string
+	for _, v := range old {
+		if !has[v] {
+			removed = append(removed, fmt.Sprintf("%q", v))
+		}
+		delete(has, v)
+	}
+	var added []string
+	for _, v := range new {
+		if has[v] {
+			added = append(added, fmt.Sprintf("%q", v))
+		}
+	}
+	if len(removed) > 0 {
+		d.add(path, kind, "enum values removed: "+strings.Join(removed, ", "), true)
+	}
+	if len(added) > 0 {
+		d.add(path, kind, "enum values added: "+strings.Join(added, ", "), false)
+	}
+}
+
+// true if the Go type can already be nil so optional fields are not pointers
+func (d *protocolDiff) nilable(typ string) bool {
+	for {
+		switch {
+		case strings.HasPrefix(typ, "array"), typ == "object", typ == "any":
+			return true
+		}
+		t, ok := d.types[typ]
+		if !ok {
+			return false
+		}
+		if t.Type == "object" && len(t.Properties) > 0 {
+			return false
+		}
+		typ = typeOf("", "", t.Type, t.Items)
+	}
+}
+
+// a protocol type as text
+// refs are qualified with their domain
+func typeOf(domain string, ref string, typ string, items Item) string {
+	if ref != "" {
+		if !strings.Contains(ref, ".") {
+			return domain + "." + ref
+		}
+		return ref
+	}
+	if typ == "array" {
+		if items.Ref == "" && items.Type == "" {
+			return "array"
+		}
+		return "array of " + typeOf(domain, items.Ref, items.Type, Item{})
+	}
+	return typ
+}
+
+func enumOf(typ string, enum []string) string {
+	if len(enum) > 0 {
+		return typ + " enum"
+	}
+	return typ
+}
@@ -0,0 +1,173 @@
+package gochrome
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffProtocol(t *testing.T) {
+	old := Protocol{Domains: []Domain{
+		{
+			Domain: "Page",
+			Types: []Type{
+				{ID: "FrameId", Type: "string"},
+				{ID: "Frame", Type: "object", Properties: []Property{
+					{Name: "id", Ref: "FrameId"},
+					{Name: "url", Type: "string"},
+					{Name: "name", Type: "string", Optional: true},
+					{Name: "children", Type: "array", Items: Item{Ref: "FrameId"}, Optional: true},
+				}},
+				{ID: "TransitionType", Type: "string", Enum: []string{"link", "typed", "reload"}},
+			},
+			Commands: []Command{
+				{Name: "navigate", Parameters: []Parameter{
+					{Name: "url", Type: "string"},
+					{Name: "referrer", Type: "string", Optional: true},
+					{Name: "transitionType", Ref: "TransitionType", Optional: true},
+				}, Returns: []Return{
+					{Name: "frameId", Ref: "FrameId"},
+					{Name: "loaderId", Type: "string", Optional: true},
+				}},
+				{Name: "reload"},
+				{Name: "close", Experimental: true},
+			},
+			Events: []Event{
+				{Name: "frameNavigated", Parameters: []Parameter{{Name: "frame", Ref: "Frame"}}},
+				{Name: "loadEventFired"},
+			},
+		},
+		{Domain: "Cast", Experimental: true},
+	}}
+
+	new := Protocol{Domains: []Domain{
+		{Domain: "Audits"},
+		{
+			Domain: "Page",
+			Types: []Type{
+				{ID: "FrameId", Type: "string"},
+				{ID: "Frame", Type: "object", Properties: []Property{
+					{Name: "id", Ref: "Page.FrameId"},
+					{Name: "url", Type: "string", Optional: true},
+					{Name: "name", Type: "string"},
+					{Name: "children", Type: "array", Items: Item{Ref: "FrameId"}},
+					{Name: "secure", Type: "boolean", Optional: true},
+				}},
+				{ID: "TransitionType", Type: "string", Enum: []string{"link", "reload", "other"}},
+				{ID: "LoaderId", Type: "string"},
+			},
+			Commands: []Command{
+				{Name: "navigate", Parameters: []Parameter{
+					{Name: "url", Type: "string"},
+					{Name: "referrer", Type: "string"},
+					{Name: "frameId", Ref: "FrameId"},
+					{Name: "referrerPolicy", Type: "string", Optional: true},
+				}, Returns: []Return{
+					{Name: "frameId", Type: "string"},
+					{Name: "loaderId", Type: "string"},
+					{Name: "errorText", Type: "string", Optional: true},
+				}},
+				{Name: "close"},
+				{Name: "stopLoading"},
+			},
+			Events: []Event{
+				{Name: "frameNavigated", Deprecated: true, Parameters: []Parameter{
+					{Name: "frame", Ref: "Frame", Optional: true},
+					{Name: "type", Type: "string", Enum: []string{"Navigation", "BackForwardCacheRestore"}},
+				}},
+			},
+		},
+	}}
+
+	expected := []ProtocolChange{
+		{"Page.Frame.url", "property", "now optional", true},
+		{"Page.Frame.name", "property", "now required", true},
+		// slices are nil when missing so they stay the same
+		{"Page.Frame.children", "property", "now required", false},
+		{"Page.Frame.secure", "property", "added", false},
+		{"Page.TransitionType", "type", `enum values removed: "typed"`, true},
+		{"Page.TransitionType", "type", `enum values added: "other"`, false},
+		{"Page.LoaderId", "type", "added", false},
+		{"Page.navigate.referrer", "parameter", "now required", true},
+		{"Page.navigate.transitionType", "parameter", "removed", true},
+		{"Page.navigate.frameId", "parameter", "added as required", true},
+		{"Page.navigate.referrerPolicy", "parameter", "added", false},
+		{"Page.navigate.frameId", "return", "type changed from Page.FrameId to string", true},
+		{"Page.navigate.loaderId", "return", "now required", true},
+		{"Page.navigate.errorText", "return", "added", false},
+		{"Page.reload", "command", "removed", true},
+		{"Page.close", "command", "no longer experimental", false},
+		{"Page.stopLoading", "command", "added", false},
+		{"Page.frameNavigated", "event", "now deprecated", false},
+		{"Page.frameNavigated.frame", "parameter", "now optional", true},
+		{"Page.frameNavigated.type", "parameter", "added", false},
+		{"Page.loadEventFired", "event", "removed", true},
+		{"Cast", "domain", "removed", true},
+		{"Audits", "domain", "added", false},
+	}
+	changes := DiffProtocol(old, new)
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes")
+		for _, c := range changes {
+			t.Log(c)
+		}
+	}
+}
+
+func TestDiffProtocolTypes(t *testing.T) {
+	old := Protocol{Domains: []Domain{{
+		Domain: "Network",
+		Types: []Type{
+			{ID: "Headers", Type: "object"},
+			{ID: "Cookie", Type: "object", Properties: []Property{
+				{Name: "size", Type: "integer"},
+				{Name: "priority", Type: "string", Enum: []string{"Low", "High"}},
+				{Name: "sameSite", Type: "string"},
+				{Name: "headers", Ref: "Headers", Optional: true},
+			}},
+			{ID: "TimeSinceEpoch", Type: "number"},
+		},
+		Commands: []Command{{Name: "enable", Experimental: true}},
+	}}}
+	new := Protocol{Domains: []Domain{{
+		Domain:       "Network",
+		Experimental: true,
+		Types: []Type{
+			{ID: "Headers", Type: "object"},
+			{ID: "Cookie", Type: "object", Properties: []Property{
+				{Name: "size", Type: "number"},
+				{Name: "priority", Type: "string"},
+				{Name: "sameSite", Type: "string", Enum: []string{"Strict", "Lax"}},
+				{Name: "headers", Ref: "Headers"},
+			}},
+			{ID: "TimeSinceEpoch", Type: "array", Items: Item{Type: "number"}},
+		},
+		// the whole domain is experimental now
+		Commands: []Command{{Name: "enable", Experimental: true}},
+	}}}
+
+	expected := []ProtocolChange{
+		{"Network", "domain", "now experimental", true},
+		{"Network.Cookie.size", "property", "type changed from integer to number", true},
+		{"Network.Cookie.priority", "property", "type changed from string enum to string", true},
+		{"Network.Cookie.sameSite", "property", "type changed from string to string enum", true},
+		{"Network.Cookie.headers", "property", "now required", false},
+		{"Network.TimeSinceEpoch", "type", "type changed from number to array of number", true},
+	}
+	changes := DiffProtocol(old, new)
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes")
+		for _, c := range changes {
+			t.Log(c)
+		}
+	}
+}
+
+func TestDiffProtocolSame(t *testing.T) {
+	p, err := ReadProtocolFiles("protocol/browser_protocol.json", "protocol/js_protocol.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes := DiffProtocol(p, p); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
@@ -0,0 +1,61 @@
+package gochrome
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ProtocolError is an error response from chrome
+// { "id": 0, "error": { "code": -32000, "message": "...", "data": "..." } }
+type ProtocolError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+	// command that failed
+	Method string `json:"-"`
+}
+
+func (e *ProtocolError) Error() string {
+	if e.Data != "" {
+		return fmt.Sprintf("%s: %s (%d): %s", e.Method, e.Message, e.Code, e.Data)
+	}
+	return fmt.Sprintf("%s: %s (%d)", e.Method, e.Message, e.Code)
+}
+
+// CommandResponse is what chrome sent back for a command
+// Err is a *ProtocolError if chrome reported an error
+type CommandResponse struct {
+	Result json.RawMessage
+	Err    error
+}
+
+// ErrNotStarted is returned when a browser has no chrome process
+var ErrNotStarted = errors.New("browser is not open")
+
+// ErrTabClosed is returned by every command sent to a tab that has ended
+// Tab.Err tells why
+var ErrTabClosed = errors.New("tab closed")
+
+// why a tab ended
+var (
+	ErrTabDetached   = errors.New("tab detached")
+	ErrTabCrashed    = errors.New("tab crashed")
+	ErrBrowserExited = errors.New("browser exited")
+)
+
+// ErrConnectionReset is returned by commands in flight when a tab reconnects
+var ErrConnectionReset = errors.New("connection reset")
+
+// ErrCommandTimeout is returned when a command outlives its context deadline
+var ErrCommandTimeout = errors.New("command timed out")
+
+// error for a command abandoned because ctx is done
+// a deadline is reported as ErrCommandTimeout
+func contextError(ctx context.Context, method string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%s: %w: %w", method, ErrCommandTimeout, ctx.Err())
+	}
+	return fmt.Errorf("%s: %w", method, ctx.Err())
+}
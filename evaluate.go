@@ -1,14 +1,24 @@
 package gochrome
 
-func (t *Tab) Evaluate(js string) (RuntimeEvaluateReturns, error) {
-	r, err := t.RuntimeEvaluate(js, "", false, false, 0, false, false, true, true, false, 0.0, false, true, true)
+import (
+	"github.com/bobbytrapz/gochrome/cdp/runtime"
+)
+
+func (t *Tab) Evaluate(js string) (runtime.EvaluateReturns, error) {
+	r, err := t.Runtime().Evaluate(runtime.EvaluateParams{
+		Expression:                  js,
+		UserGesture:                 Ptr(true),
+		AwaitPromise:                Ptr(true),
+		ReplMode:                    Ptr(true),
+		AllowUnsafeEvalBlockedByCSP: Ptr(true),
+	})
 
 	if err != nil {
-		Log("Tab.Evaluate: error: %q", err)
+		t.log.Debug("Tab.Evaluate", "err", err)
 	}
 
-	if r.Result != nil {
-		Log("Tab.Evaluate: type: %q value: %q", r.Result["type"], r.Result["value"])
+	if err == nil {
+		t.log.Debug("Tab.Evaluate", "type", r.Result.Type, "value", r.Result.Value)
 	}
 
 	return r, err
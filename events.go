@@ -0,0 +1,194 @@
+package gochrome
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+
+// EventHandler receives an event decoded into its generated type
+// such as page.LoadEventFiredEvent
+// events unknown to this package are passed as json.RawMessage
+type EventHandler = cdp.EventHandler
+
+// subscriber to an event
+type listener struct {
+	id      int
+	handler EventHandler
+}
+
+// On calls handler for each event with the given name
+// such as "Page.loadEventFired"
+// any number of handlers may listen to the same event
+// call unsubscribe to stop listening
+func (t *Tab) On(eventName string, handler EventHandler) (unsubscribe func()) {
+	t.lm.Lock()
+	defer t.lm.Unlock()
+	t.nextListenerID++
+	id := t.nextListenerID
+
+	// copy so HandleEvent can use a snapshot without holding the lock
+	listeners := make([]listener, len(t.listeners[eventName]), len(t.listeners[eventName])+1)
+	copy(listeners, t.listeners[eventName])
+	t.listeners[eventName] = append(listeners, listener{id: id, handler: handler})
+
+	return func() {
+		t.off(eventName, id)
+	}
+}
+
+func (t *Tab) off(eventName string, id int) {
+	t.lm.Lock()
+	defer t.lm.Unlock()
+	var listeners []listener
+	for _, l := range t.listeners[eventName] {
+		if l.id != id {
+			listeners = append(listeners, l)
+		}
+	}
+	if len(listeners) == 0 {
+		delete(t.listeners, eventName)
+		return
+	}
+	t.listeners[eventName] = listeners
+}
+
+func (t *Tab) listenersFor(eventName string) []listener {
+	t.lm.RLock()
+	defer t.lm.RUnlock()
+	return t.listeners[eventName]
+}
+
+// HandleEvent passes an event from chrome to each of its handlers
+// the event is only decoded if someone is listening
+func (t *Tab) HandleEvent(method string, params json.RawMessage) error {
+	listeners := t.listenersFor(method)
+	if len(listeners) == 0 {
+		return nil
+	}
+
+	ev, err := decodeEvent(method, params)
+	if err == cdp.ErrUnknownEvent {
+		// not in the generated protocol so pass it along as is
+		ev = params
+	} else if err != nil {
+		t.log.Error("could not decode event", "method", method, "err", err)
+		return err
+	}
+
+	if t.queue != nil {
+		t.enqueue(queuedEvent{listeners: listeners, ev: ev})
+		return nil
+	}
+
+	for _, l := range listeners {
+		go l.handler(ev)
+	}
+
+	return nil
+}
+
+// OverflowPolicy decides what happens when an event queue is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits for room in the queue
+	// nothing else is read from the connection while we wait
+	// so a handler that waits on a command can deadlock a full queue
+	// Tab.OnResource fetches bodies off the queue so it is safe
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest drops the event that did not fit
+	OverflowDropNewest
+	// OverflowDropOldest drops the oldest queued event to make room
+	OverflowDropOldest
+)
+
+// EventQueue delivers a tab's events one at a time in the order chrome sent them
+// each handler returns before the next one is called
+type EventQueue struct {
+	// how many events may wait to be delivered
+	// 256 if zero
+	Size int
+	// what to do when Size events are waiting
+	Overflow OverflowPolicy
+}
+
+func (q EventQueue) size() int {
+	if q.Size <= 0 {
+		return 256
+	}
+	return q.Size
+}
+
+// event waiting in a tab's queue
+type queuedEvent struct {
+	listeners []listener
+	ev        interface{}
+}
+
+// DroppedEvents counts events dropped because the tab's event queue was full
+func (t *Tab) DroppedEvents() uint64 {
+	return atomic.LoadUint64(&t.droppedEvents)
+}
+
+// deliver queued events until the tab ends
+func (t *Tab) startEventQueue(q EventQueue) {
+	t.queue = make(chan queuedEvent, q.size())
+	t.overflow = q.Overflow
+
+	deliver := func(qe queuedEvent) {
+		for _, l := range qe.listeners {
+			l.handler(qe.ev)
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case qe := <-t.queue:
+				deliver(qe)
+			case <-t.done:
+				// deliver what is left
+				for {
+					select {
+					case qe := <-t.queue:
+						deliver(qe)
+					default:
+						return
+					}
+				}
+			}
+		}
+	}()
+}
+
+func (t *Tab) enqueue(qe queuedEvent) {
+	switch t.overflow {
+	case OverflowDropNewest:
+		select {
+		case t.queue <- qe:
+		default:
+			atomic.AddUint64(&t.droppedEvents, 1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case t.queue <- qe:
+				return
+			default:
+			}
+			select {
+			case <-t.queue:
+				atomic.AddUint64(&t.droppedEvents, 1)
+			default:
+			}
+		}
+	default:
+		select {
+		case t.queue <- qe:
+		case <-t.done:
+			// nobody is left to deliver it
+		}
+	}
+}
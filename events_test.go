@@ -0,0 +1,241 @@
+package gochrome
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bobbytrapz/gochrome/cdp/network"
+	"github.com/bobbytrapz/gochrome/cdp/page"
+)
+
+func TestOn(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return struct{}{}, nil
+	})
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(mt)
+	defer mt.Close()
+
+	expect := func(t *testing.T, ch chan string, want string) {
+		t.Helper()
+		select {
+		case got := <-ch:
+			if got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected %q", want)
+		}
+	}
+
+	t.Run("many subscribers", func(t *testing.T) {
+		fired := make(chan string, 4)
+		offTyped := tab.Page().OnLoadEventFired(func(ev page.LoadEventFiredEvent) {
+			fired <- "typed"
+		})
+		offAny := tab.On("Page.loadEventFired", func(ev interface{}) {
+			if _, ok := ev.(page.LoadEventFiredEvent); ok {
+				fired <- "any"
+			}
+		})
+
+		mt.emit("", "Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+		got := map[string]bool{}
+		for i := 0; i < 2; i++ {
+			select {
+			case name := <-fired:
+				got[name] = true
+			case <-time.After(time.Second):
+				t.Fatal("event was not delivered to every subscriber")
+			}
+		}
+		if !got["typed"] || !got["any"] {
+			t.Errorf("expected both subscribers, got %v", got)
+		}
+
+		offTyped()
+		mt.emit("", "Page.loadEventFired", map[string]interface{}{"timestamp": 2})
+		expect(t, fired, "any")
+		offAny()
+
+		if n := len(tab.listenersFor("Page.loadEventFired")); n != 0 {
+			t.Errorf("expected no subscribers, got %d", n)
+		}
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		fired := make(chan string, 1)
+		off := tab.On("Experimental.somethingHappened", func(ev interface{}) {
+			raw, _ := ev.(json.RawMessage)
+			fired <- string(raw)
+		})
+		defer off()
+
+		mt.emit("", "Experimental.somethingHappened", map[string]interface{}{"a": 1})
+		expect(t, fired, `{"a":1}`)
+	})
+
+	t.Run("OnResource keeps other handlers", func(t *testing.T) {
+		fired := make(chan string, 1)
+		off := tab.Network().OnResponseReceived(func(ev network.ResponseReceivedEvent) {
+			fired <- string(ev.RequestId)
+		})
+		defer off()
+
+		offResource, err := tab.OnResource(func(res HTTPResource) {})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer offResource()
+
+		mt.emit("", "Network.responseReceived", map[string]interface{}{"requestId": "1", "type": "Document"})
+		expect(t, fired, "1")
+	})
+}
+
+func TestEventQueue(t *testing.T) {
+	t.Run("ordered", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			return struct{}{}, nil
+		})
+		b := NewBrowser()
+		b.EventQueue = &EventQueue{Size: 16}
+		tab := b.NewTabWithTransport(mt)
+		defer mt.Close()
+
+		const N = 50
+		got := make(chan string, N*3)
+		tab.Network().OnRequestWillBeSent(func(ev network.RequestWillBeSentEvent) {
+			got <- "request " + string(ev.RequestId)
+		})
+		tab.Network().OnResponseReceived(func(ev network.ResponseReceivedEvent) {
+			got <- "response " + string(ev.RequestId)
+		})
+		tab.Network().OnLoadingFinished(func(ev network.LoadingFinishedEvent) {
+			got <- "finished " + string(ev.RequestId)
+		})
+
+		var want []string
+		for i := 0; i < N; i++ {
+			id := fmt.Sprint(i)
+			params := map[string]interface{}{"requestId": id}
+			mt.emit("", "Network.requestWillBeSent", params)
+			mt.emit("", "Network.responseReceived", params)
+			mt.emit("", "Network.loadingFinished", params)
+			want = append(want, "request "+id, "response "+id, "finished "+id)
+		}
+
+		for i, w := range want {
+			select {
+			case g := <-got:
+				if g != w {
+					t.Fatalf("event %d: expected %q, got %q", i, w, g)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("event %d: expected %q", i, w)
+			}
+		}
+	})
+
+	t.Run("drop newest", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			return struct{}{}, nil
+		})
+		b := NewBrowser()
+		b.EventQueue = &EventQueue{Size: 1, Overflow: OverflowDropNewest}
+		tab := b.NewTabWithTransport(mt)
+		defer mt.Close()
+
+		release := make(chan struct{})
+		delivered := make(chan struct{}, 8)
+		tab.Page().OnLoadEventFired(func(ev page.LoadEventFiredEvent) {
+			<-release
+			delivered <- struct{}{}
+		})
+
+		// at most one event is in the handler and one fills the queue
+		// the rest are dropped
+		for i := 0; i < 5; i++ {
+			mt.emit("", "Page.loadEventFired", map[string]interface{}{"timestamp": i})
+		}
+		// make sure the reader has seen every event
+		if _, err := tab.Goto("about:blank"); err != nil {
+			t.Fatal(err)
+		}
+		close(release)
+
+		if n := tab.DroppedEvents(); n < 3 || n > 4 {
+			t.Errorf("expected 3 or 4 dropped events, got %d", n)
+		}
+	})
+
+	t.Run("OnResource with a full queue", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			if cmd.Method == "Network.getResponseBody" {
+				return map[string]interface{}{"body": "ok"}, nil
+			}
+			return struct{}{}, nil
+		})
+		b := NewBrowser()
+		b.EventQueue = &EventQueue{}
+		tab := b.NewTabWithTransport(mt)
+		defer mt.Close()
+
+		const N = 300
+		got := make(chan HTTPResource, N)
+		if _, err := tab.OnResource(func(res HTTPResource) {
+			got <- res
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		// more events than the queue holds
+		// each body is fetched while later events wait to be read
+		go func() {
+			for i := 0; i < N; i++ {
+				mt.emit("", "Network.responseReceived", map[string]interface{}{"requestId": fmt.Sprint(i), "type": "Document"})
+			}
+			for i := 0; i < N; i++ {
+				mt.emit("", "Network.loadingFinished", map[string]interface{}{"requestId": fmt.Sprint(i)})
+			}
+		}()
+		for i := 0; i < N; i++ {
+			select {
+			case res := <-got:
+				if res.Body != "ok" {
+					t.Fatalf("unexpected body %q", res.Body)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("only %d of %d resources were delivered", i, N)
+			}
+		}
+	})
+
+	t.Run("default size", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			return struct{}{}, nil
+		})
+		b := NewBrowser()
+		b.EventQueue = &EventQueue{Overflow: OverflowDropNewest}
+		tab := b.NewTabWithTransport(mt)
+		defer mt.Close()
+
+		release := make(chan struct{})
+		tab.Page().OnLoadEventFired(func(ev page.LoadEventFiredEvent) {
+			<-release
+		})
+		for i := 0; i < 5; i++ {
+			mt.emit("", "Page.loadEventFired", map[string]interface{}{"timestamp": i})
+		}
+		if _, err := tab.Goto("about:blank"); err != nil {
+			t.Fatal(err)
+		}
+		close(release)
+
+		if n := tab.DroppedEvents(); n != 0 {
+			t.Errorf("expected no dropped events, got %d", n)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+
+	"github.com/bobbytrapz/gochrome"
+)
+
+func main() {
+	// start chrome yourself first
+	// chrome --remote-debugging-port=9222
+	addr := flag.String("addr", "localhost:9222", "chrome remote debugging address")
+	flag.Parse()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// build logger
+	// by default, gochrome does not log
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	browser := gochrome.NewBrowser()
+	browser.Logger = logger
+
+	// attach to the running chrome
+	// we are given a *chrome.Tab for each page that is already open
+	tabs, err := browser.Connect(ctx, *addr)
+	if err != nil {
+		panic(err)
+	}
+
+	// gochrome did not start this chrome so
+	// cancel only disconnects and chrome keeps running
+	defer browser.Wait()
+
+	for _, tab := range tabs {
+		r, err := tab.Evaluate("document.title")
+		if err != nil {
+			panic(err)
+		}
+		log.Printf("%s: %v", tab.ID(), r.Result.Value)
+	}
+
+	// handle keyboard interrupt
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+
+	for {
+		select {
+		case <-sig:
+			// ctrl+c will disconnect from the browser
+			signal.Stop(sig)
+			cancel()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
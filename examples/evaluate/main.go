@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 
@@ -17,11 +17,11 @@ func main() {
 
 	// build logger
 	// by default, gochrome does not log
-	logger := log.New(os.Stderr, "gochrome: ", log.LstdFlags|log.Lshortfile)
-	gochrome.Log = logger.Printf
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 
 	// create new browser window
 	browser := gochrome.NewBrowser()
+	browser.Logger = logger
 
 	// add more flags
 	browser.Flags = append(browser.Flags,
@@ -52,7 +52,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	gochrome.Log("Tab.Evaluate: %v", r)
+	logger.Info("Tab.Evaluate", "value", r.Result.Value)
 
 	// handle keyboard interrupt
 	sig := make(chan os.Signal, 1)
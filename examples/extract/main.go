@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"time"
@@ -15,10 +16,10 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	logger := log.New(os.Stderr, "gochrome: ", log.LstdFlags|log.Lshortfile)
-	gochrome.Log = logger.Printf
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 
 	browser := gochrome.NewBrowser()
+	browser.Logger = logger
 	browser.Flags = append(browser.Flags,
 		"--blink-settings=imagesEnabled=false")
 
@@ -3,12 +3,13 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"time"
 
 	"github.com/bobbytrapz/gochrome"
+	"github.com/bobbytrapz/gochrome/cdp/network"
 )
 
 func main() {
@@ -16,10 +17,10 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	logger := log.New(os.Stderr, "gochrome: ", log.LstdFlags|log.Lshortfile)
-	gochrome.Log = logger.Printf
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 
 	browser := gochrome.NewBrowser()
+	browser.Logger = logger
 
 	browser.Flags = append(browser.Flags,
 		"--blink-settings=imagesEnabled=false")
@@ -32,20 +33,20 @@ func main() {
 	defer browser.Wait()
 
 	type netResponse struct {
-		Response gochrome.NetworkResponse
+		Response network.Response
 	}
 
 	tab.OnResource(func(res gochrome.HTTPResource) {
 		// print some information about each document
 		// snip the body to 100 chars
-		if res.Type == "Document" {
+		if res.Type == network.ResourceTypeDocument {
 			end := len(res.Body)
 			if end > 100 {
 				end = 100
 			}
-			fmt.Printf("%s [Document]\n%s\n", res.Response["url"], res.Body[:end])
+			fmt.Printf("%s [Document]\n%s\n", res.Response.Url, res.Body[:end])
 		}
-	}, "Document")
+	}, network.ResourceTypeDocument)
 	tab.SetUserAgent("Go/gochrome-test")
 
 	tab.Goto("http://golang.org/")
@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"time"
@@ -18,11 +18,11 @@ func main() {
 
 	// build logger
 	// by default, gochrome does not log
-	logger := log.New(os.Stderr, "gochrome: ", log.LstdFlags|log.Lshortfile)
-	gochrome.Log = logger.Printf
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 
 	// create new browser window
 	browser := gochrome.NewBrowser()
+	browser.Logger = logger
 
 	// start browser
 	// we use StartFull so we can see what the browser is doing
@@ -0,0 +1,31 @@
+//go:build !gochrome_stable
+
+package gochrome
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bobbytrapz/gochrome/cdp/animation"
+	"github.com/bobbytrapz/gochrome/cdp/network"
+)
+
+func TestExperimentalProtocol(t *testing.T) {
+	// experimental events of a stable domain
+	ev, err := decodeEvent("Network.resourceChangedPriority", json.RawMessage(`{"requestId": "1", "newPriority": "High"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev, ok := ev.(network.ResourceChangedPriorityEvent); !ok || ev.NewPriority != network.ResourcePriorityHigh {
+		t.Errorf("unexpected event: %#v", ev)
+	}
+
+	// experimental domains
+	ev, err = decodeEvent("Animation.animationStarted", json.RawMessage(`{"animation": {"id": "a"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev, ok := ev.(animation.AnimationStartedEvent); !ok || ev.Animation.Id != "a" {
+		t.Errorf("unexpected event: %#v", ev)
+	}
+}
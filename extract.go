@@ -39,13 +39,13 @@ func (t *Tab) Extract(url string, timeout time.Duration, delay time.Duration, js
 				return nil, err
 			}
 
-			v := res.Result["value"]
+			v := res.Result.Value
 			if check(v) {
-				Log("Tab.Extract: check ok: %+v", v)
+				t.log.Debug("Tab.Extract: check ok", "value", v)
 				return v, nil
 			} else {
 				// try again after the given delay
-				Log("Tab.Extract: check failed. try again after %s", delay)
+				t.log.Debug("Tab.Extract: check failed", "retry", delay)
 				<-time.After(delay)
 			}
 		}
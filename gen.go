@@ -1,105 +1,191 @@
+//go:build ignore
 // +build ignore
 
-// This program generates protocol.go
-// using the installed version of chrome
+// This program generates a package for each protocol domain in cdp/
+// and protocol.go which gives a Tab each domain
+// from the protocol definitions in protocol/
+//
+//	go run gen.go -browser protocol/browser_protocol.json -js protocol/js_protocol.json -o .
 package main
 
 import (
 	"bytes"
-	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
-	"log"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"os"
+	pathpkg "path"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"text/template"
-	"time"
 
 	"github.com/bobbytrapz/gochrome"
 )
 
 func main() {
-	fmt.Fprintf(os.Stderr, "[*] Generating protocol.go\n")
-
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	logger := log.New(os.Stderr, "gochrome: ", log.LstdFlags|log.Lshortfile)
-	gochrome.Log = logger.Printf
+	browserProtocol := flag.String("browser", "protocol/browser_protocol.json", "browser protocol definition")
+	jsProtocol := flag.String("js", "protocol/js_protocol.json", "javascript protocol definition")
+	out := flag.String("o", ".", "directory to write protocol.go and cdp/ to")
+	flag.Parse()
 
-	browser := gochrome.NewBrowser()
+	fmt.Fprintf(os.Stderr, "[*] Generating %s\n", filepath.Join(*out, "cdp"))
 
-	_, err := browser.Start(ctx, "", 32719)
+	protocol, err := gochrome.ReadProtocolFiles(*browserProtocol, *jsProtocol)
 	if err != nil {
-		panic(err)
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
 	}
+	version := protocol.VersionString()
 
-	defer browser.Wait()
-
-	protocol := browser.GetProtocol()
-	cancel()
-
-	data := protocoldata{
-		Timestamp: time.Now().UTC().Format(time.RFC3339),
-		Version:   protocol.VersionString(),
+	// every type is needed before we can resolve references across domains
+	for _, domain := range protocol.Domains {
+		for _, t := range domain.Types {
+			name := domain.Domain + t.ID
+			kinds[name] = typeKind(domain, t)
+			owners[name] = owner{domain: domain.Domain, local: t.ID}
+			if len(t.Enum) > 0 {
+				enums[name] = t.Enum
+			}
+		}
 	}
+	findShared(protocol)
 
+	common := &pkg{Version: version, Name: "cdp"}
+	var pkgs []*pkg
 	for _, domain := range protocol.Domains {
+		p := &pkg{
+			Version:      version,
+			Name:         strings.ToLower(domain.Domain),
+			Domain:       domain.Domain,
+			Description:  domain.Description,
+			Experimental: domain.Experimental,
+			Deprecated:   domain.Deprecated,
+		}
+
 		// domain types
 		for _, t := range domain.Types {
-			props := cleanProperties(domain, t.Properties, t.ID)
-
-			if t.Type == "object" && len(props) == 0 {
-				t.Type = "map[string]interface{}"
-			}
-
+			name := domain.Domain + t.ID
 			td := gochrome.Type{
-				ID:          fmt.Sprintf("%s%s", domain.Domain, t.ID),
-				Type:        typeReplacer.Replace(t.Type),
-				Description: t.Description,
-				Properties:  props,
+				ID:           name,
+				Type:         typeKind(domain, t),
+				Description:  t.Description,
+				Experimental: t.Experimental,
+				Deprecated:   t.Deprecated,
+				Enum:         unique(t.Enum),
 			}
+			made := len(inlineEnums)
+			if td.Type == "struct" {
+				td.Properties = cleanProperties(domain, t.Properties, t.ID)
+			}
+			types := append([]gochrome.Type{td}, inlineEnums[made:]...)
 
-			data.Types = append(data.Types, td)
-			// fmt.Fprintf(os.Stderr, "type %+v\n", td)
+			if !shared[name] {
+				p.Types = append(p.Types, types...)
+				continue
+			}
+			// declared in package cdp so any domain can use it
+			common.Types = append(common.Types, types...)
+			for _, t := range types {
+				shared[t.ID] = true
+				p.Aliases = append(p.Aliases, alias{Shared: t.ID, Enum: t.Enum})
+			}
 		}
 
+		made := len(inlineEnums)
+
 		// domain commands
 		for _, c := range domain.Commands {
-			var cmd gochrome.Command
-			name := fmt.Sprintf("%s%s", domain.Domain, strings.Title(c.Name))
+			var cmd command
 			cmd.Method = fmt.Sprintf("%s.%s", domain.Domain, c.Name)
-			params := cleanParameters(domain, c.Parameters, strings.Title(c.Name))
-			returns := cleanReturns(domain, c.Returns, strings.Title(c.Name))
-
-			cmd.Name = name
+			cmd.Name = strings.Title(c.Name)
 			cmd.Description = c.Description
-			cmd.Parameters = params
-			cmd.Returns = returns
-			data.Commands = append(data.Commands, cmd)
-			// fmt.Fprintf(os.Stderr, "cmd %+v\n", cmd)
+			cmd.Experimental = c.Experimental
+			cmd.Deprecated = c.Deprecated
+			cmd.Params = cleanProperties(domain, asProperties(c.Parameters), strings.Title(c.Name))
+			cmd.Returns = cleanReturns(domain, c.Returns, strings.Title(c.Name))
+			p.Commands = append(p.Commands, cmd)
 		}
 
 		// domain events
 		for _, e := range domain.Events {
-			data.Events = append(data.Events, gochrome.Event{
-				Name:        fmt.Sprintf("%s%s", strings.Title(domain.Domain), strings.Title(e.Name)),
-				Description: e.Description,
-				Parameters:  cleanParameters(domain, e.Parameters, strings.Title(e.Name)),
-				EventName:   fmt.Sprintf("%s.%s", strings.Title(domain.Domain), e.Name),
+			p.Events = append(p.Events, gochrome.Event{
+				Name:         strings.Title(e.Name),
+				Description:  e.Description,
+				Experimental: e.Experimental,
+				Deprecated:   e.Deprecated,
+				Parameters:   cleanParameters(domain, e.Parameters, strings.Title(e.Name)),
+				EventName:    fmt.Sprintf("%s.%s", domain.Domain, e.Name),
 			})
+		}
+
+		// enums declared inline by commands and events
+		p.Types = append(p.Types, inlineEnums[made:]...)
 
-			// fmt.Fprintf(os.Stderr, "%+v\n", data.Events[len(data.Events)-1])
+		pkgs = append(pkgs, p)
+	}
+
+	for _, p := range append(pkgs, common) {
+		p.localize()
+		if err := p.check(); err != nil {
+			fmt.Fprintf(os.Stderr, "%s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	stable := root{Version: version, Main: true}
+	experimental := root{Version: version, Tag: experimentalTag}
+	for _, p := range pkgs {
+		if p.Experimental {
+			experimental.Packages = append(experimental.Packages, p)
+		} else {
+			stable.Packages = append(stable.Packages, p)
+		}
+	}
+	write(filepath.Join(*out, "protocol.go"), rootTmpl, stable)
+	write(filepath.Join(*out, "protocol_experimental.go"), rootTmpl, experimental)
+
+	write(filepath.Join(*out, "cdp", "types.go"), commonTmpl, common)
+	for _, p := range pkgs {
+		dir := filepath.Join(*out, "cdp", p.Name)
+		main, extra := p.files()
+		write(filepath.Join(dir, p.Name+".go"), domainTmpl, main)
+
+		path := filepath.Join(dir, p.Name+"_experimental.go")
+		if p.Experimental || (extra.Commands == nil && extra.Events == nil) {
+			// left from an older protocol
+			os.Remove(path)
+			continue
 		}
+		write(path, domainTmpl, extra)
 	}
+}
 
+// execute a template and write the formatted source
+func write(path string, tmpl *template.Template, data interface{}) {
 	var buf bytes.Buffer
-	protocolTmpl.Execute(&buf, data)
-	if true {
-		ioutil.WriteFile("protocol.go", buf.Bytes(), 0664)
-	} else {
-		fmt.Fprintf(os.Stderr, "%s", buf.Bytes())
+	if err := tmpl.Execute(&buf, data); err != nil {
+		fmt.Fprintf(os.Stderr, "template: %s: %s\n", path, err)
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0775); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+	src, err := format.Source(dropUnusedImports(buf.Bytes()))
+	if err != nil {
+		// write it anyway so we can see what went wrong
+		os.WriteFile(path, buf.Bytes(), 0664)
+		fmt.Fprintf(os.Stderr, "format: %s: %s\n", path, err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(path, src, 0664); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
 	}
 }
 
@@ -114,270 +200,952 @@ var typeReplacer = strings.NewReplacer(
 	".", "",
 )
 
-var nameReplacer = strings.NewReplacer(
-	"range", "Range",
-	"type", "Type",
-)
+// build constraint for experimental commands, events and domains
+const experimentalTag = "!gochrome_stable"
 
 var funcMap = template.FuncMap{
-	"Title": strings.Title,
+	"Title":     strings.Title,
+	"EnumConst": enumConst,
+	"Tag":       tag,
+	"Comment": func(s string) string {
+		return "// " + strings.ReplaceAll(strings.TrimSpace(s), "\n", "\n// ")
+	},
+	"Doc": doc,
 }
 
-var protocolTmpl = template.Must(template.New("").Funcs(funcMap).Parse(`// Code generated by go generate; DO NOT EDIT.
+// json tag of a field
+func tag(name string, optional bool) string {
+	if optional {
+		return fmt.Sprintf("`json:\"%s,omitempty\"`", name)
+	}
+	return fmt.Sprintf("`json:\"%s\"`", name)
+}
+
+// description followed by the markers go doc understands
+func doc(description string, experimental bool, deprecated bool) string {
+	var paragraphs []string
+	if description != "" {
+		paragraphs = append(paragraphs, description)
+	}
+	if experimental {
+		paragraphs = append(paragraphs, "Experimental: this may change or be removed in any chrome release")
+	}
+	if deprecated {
+		paragraphs = append(paragraphs, "Deprecated: this is deprecated in the devtools protocol")
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// type declarations shared by package cdp and the domain packages
+var typesTmpl = template.Must(template.New("").Funcs(funcMap).Parse(`{{ define "types" }}
+{{ range .Types }}
+{{ with Doc .Description .Experimental .Deprecated }}{{ Comment . }}
+{{ end }}type {{.ID}} {{.Type}}{{ if .Properties }} { {{ range .Properties }}
+	{{ with Doc .Description .Experimental .Deprecated }}/* {{ . }} */
+	{{ end }}{{ .Name | Title }} {{ .Type }} {{ $.FieldTag .Name .Optional .Type }}{{ end }}
+}
+
+{{ $.MarshalMethods .ID .Properties }}
+{{ $.UnmarshalMethods .ID .Properties }}
+{{ end }}
+{{ if .Enum }}{{ $type := .ID }}
+// {{ $type }} values
+const ({{ range .Enum }}
+	{{ EnumConst $type . }} {{ $type }} = {{ printf "%q" . }}{{ end }}
+)
+
+// Values gives every {{ $type }}
+func ({{ $type }}) Values() []{{ $type }} {
+	return []{{ $type }}{ {{ range .Enum }}
+		{{ EnumConst $type . }},{{ end }}
+	}
+}
+
+// Valid is true if v is one of Values
+func (v {{ $type }}) Valid() bool {
+	switch v {
+	case {{ range $ndx, $v := .Enum }}{{ if $ndx }}, {{ end }}{{ EnumConst $type $v }}{{ end }}:
+		return true
+	}
+	return false
+}
+{{ end }}
+{{ end }}
+{{ end }}`))
+
+var commonTmpl = template.Must(template.Must(typesTmpl.Clone()).Parse(`// Code generated by go generate; DO NOT EDIT.
 // Chrome protocol v{{ .Version }}
-// {{ .Timestamp }}
-package gochrome
+
+package cdp
+
+{{ template "types" . }}
+`))
+
+// a domain package is written as a main file
+// and a file for experimental commands and events
+var domainTmpl = template.Must(template.Must(typesTmpl.Clone()).Parse(`// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v{{ .Version }}
+{{ if .Tag }}
+//go:build {{ .Tag }}
+{{ end }}
+{{ if .Main }}// Package {{.Name}} is the {{.Domain}} domain of the chrome devtools protocol
+//
+{{ Comment (Doc .Description .Experimental .Deprecated) }}
+{{ end }}package {{.Name}}
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"reflect"
-)
 
-func isZero(v interface{}) bool {
-	vType := reflect.TypeOf(v)
-	vZero := reflect.Zero(vType)
-	return v == nil || reflect.DeepEqual(v, vZero.Interface())
+	"github.com/bobbytrapz/gochrome/cdp"
+)
+{{ if .Main }}
+// Client sends {{.Domain}} commands and listens for {{.Domain}} events
+type Client struct {
+	caller cdp.Caller
 }
 
-{{ range .Types }}
-type {{.ID}} {{.Type}}{{ if .Properties }} { {{ range .Properties }}
-	{{if .Description}}/* {{ .Description }} */
-	{{end}}{{ .Name | Title }} {{ .Type }}{{ end }}
-}{{ end }}
+// New gives a Client that uses caller
+// such as a *gochrome.Tab
+func New(caller cdp.Caller) Client {
+	return Client{caller: caller}
+}
+{{ if .Aliases }}
+// types used by other domains are declared in package cdp
+type ({{ range .Aliases }}
+	{{.Name}} = cdp.{{.Shared}}{{ end }}
+)
+{{ range .Aliases }}{{ if .Enum }}{{ $alias := . }}
+// {{ $alias.Name }} values
+const ({{ range .Enum }}
+	{{ EnumConst $alias.Name . }} = cdp.{{ EnumConst $alias.Shared . }}{{ end }}
+)
+{{ end }}{{ end }}{{ end }}
+{{ template "types" . }}
 {{ end }}
 {{ range .Commands }}
 type {{.Name}}Returns struct {
 	{{ range .Returns }}
-	{{.Name | Title}} {{.Type}}
+	{{ with Doc .Description .Experimental .Deprecated }}/* {{ . }} */
+	{{ end }}{{.Name | Title}} {{.Type}} {{ Tag .Name .Optional }}
 	{{ end }}
 }
 
-/* {{.Description}} */
-func (t *Tab) {{.Name}}({{range $ndx, $p := .Parameters}}{{if $ndx}}, {{end}}{{$p.Name}} {{$p.Type}}{{end}}) ({{.Name}}Returns, error) {
-	params_ := make(map[string]interface{})
+{{ $.UnmarshalMethods (printf "%sReturns" .Name) .Returns }}
 
-	{{ range .Parameters }}
-	{{ if .Optional }}
-	if !isZero({{.Name}}) {
-		params_["{{.Name}}"] = {{.Name}}
-	}
-	{{ else }}
-	params_["{{.Name}}"] = {{.Name}}
-	{{ end }}
-	{{ end }}
+{{ if .Params }}
+// {{.Name}}Params are the parameters for {{.Method}}
+// optional parameters are left out when nil
+type {{.Name}}Params struct { {{ range .Params }}
+	{{ with Doc .Description .Experimental .Deprecated }}/* {{ . }} */
+	{{ end }}{{ .Name | Title }} {{ .Type }} {{ $.FieldTag .Name .Optional .Type }}{{ end }}
+}
 
-	ch := t.SendCommand(map[string]interface{}{
-		"method": "{{.Method}}",
-		"params": params_,
-	})
+{{ $.MarshalMethods (printf "%sParams" .Name) .Params }}
+{{ end }}
+{{ with Doc .Description .Experimental .Deprecated }}{{ Comment . }}
+{{ end }}func (c Client) {{.Name}}({{ if .Params }}params {{.Name}}Params{{ end }}) ({{.Name}}Returns, error) {
+	return c.{{.Name}}Context(context.Background(){{ if .Params }}, params{{ end }})
+}
 
+// {{.Name}}Context is {{.Name}} with a context for cancellation and deadlines
+{{ with Doc "" .Experimental .Deprecated }}//
+{{ Comment . }}
+{{ end }}func (c Client) {{.Name}}Context(ctx context.Context{{ if .Params }}, params {{.Name}}Params{{ end }}) ({{.Name}}Returns, error) {
 	var returns_ {{.Name}}Returns
-	data_ := <-ch
-	err_ := json.Unmarshal(data_, &returns_)
-	if err_ != nil {
-		return returns_, fmt.Errorf("json.Unmarshal: %w", err_)
+	{{ $method := .Method }}
+	{{ range .Params }}{{ if .Enum }}
+	if {{ if .Optional }}params.{{.Name | Title}} != nil && {{ end }}!params.{{.Name | Title}}.Valid() {
+		return returns_, fmt.Errorf("{{ $method }}: invalid {{.Name}} %q", {{ if .Optional }}*{{ end }}params.{{.Name | Title}})
 	}
+	{{ end }}{{ end }}
+	err_ := c.caller.Call(ctx, "{{.Method}}", {{ if .Params }}&params{{ else }}nil{{ end }}, &returns_)
 
-	return returns_, nil
+	return returns_, err_
 }
 {{ end }}
+{{ if .Events }}
 /* Event Handlers */
 {{ range .Events }}
-type {{.Name}}Event struct {
+{{ with Doc .Description .Experimental .Deprecated }}{{ Comment . }}
+{{ end }}type {{.Name}}Event struct {
 	{{ range .Parameters }}
-	{{.Name | Title}} {{.Type}}
+	{{ with Doc .Description .Experimental .Deprecated }}/* {{ . }} */
+	{{ end }}{{.Name | Title}} {{.Type}} {{ Tag .Name .Optional }}
 	{{ end }}
 }
-type {{.Name | Title}}Handler func (ev {{.Name | Title}}Event)
+
+{{ $.UnmarshalMethods (printf "%sEvent" .Name) .Parameters }}
+
+type {{.Name}}Handler func (ev {{.Name}}Event)
+
+// EventMethod is {{.EventName}}
+func ({{.Name}}Event) EventMethod() string {
+	return "{{.EventName}}"
+}
+
+// On{{.Name}} calls handler for each {{.EventName}} event
+func (c Client) On{{.Name}}(handler {{.Name}}Handler) (unsubscribe func()) {
+	return c.caller.On("{{.EventName}}", func(ev interface{}) {
+		handler(ev.({{.Name}}Event))
+	})
+}
+{{ end }}{{ end }}
+{{ if and .Main .HasEvents }}
+// decoders for {{.Domain}} events by method
+var decoders = map[string]func(json.RawMessage) (interface{}, error){ {{ range .Events }}
+	"{{.EventName}}": cdp.Decode[{{.Name}}Event],{{ end }}
+}
+
+// DecodeEvent decodes a {{.Domain}} event into its type
+func DecodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	decode, ok := decoders[method]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(params)
+}
+{{ else if .Events }}
+func init() { {{ range .Events }}
+	decoders["{{.EventName}}"] = cdp.Decode[{{.Name}}Event]{{ end }}
+}
 {{ end }}
-/* Handle Tab Events */
-type tabEventHandlers struct {
-{{ range .Events }}
-	On{{.Name | Title}} {{.Name | Title}}Handler
+`))
+
+var rootTmpl = template.Must(template.New("").Funcs(funcMap).Parse(`// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v{{ .Version }}
+{{ if .Tag }}
+//go:build {{ .Tag }}
+{{ end }}
+package gochrome
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/bobbytrapz/gochrome/cdp"{{ range .Packages }}
+	"github.com/bobbytrapz/gochrome/cdp/{{.Name}}"{{ end }}
+)
+{{ if .Main }}
+// a Tab sends the commands of every domain
+var _ cdp.Caller = (*Tab)(nil)
 {{ end }}
+{{ range .Packages }}
+{{ Comment (Doc (printf "%s gives the %s domain of the tab" .Domain .Domain) .Experimental .Deprecated) }}
+func (t *Tab) {{.Domain}}() {{.Name}}.Client {
+	return {{.Name}}.New(t)
 }
-func (t *Tab) HandleEvent(method string, params json.RawMessage) error {
-	switch method {
-{{ range .Events }}
-	case "{{.EventName}}":
-		var ev {{.Name | Title}}Event
-		err := json.Unmarshal(params, &ev)
-		if err != nil {
-			Log("{{.EventName}}: %s", err)
-			return err
+{{ end }}
+{{ if .Main }}
+// event decoders by domain
+var eventDecoders = map[string]func(string, json.RawMessage) (interface{}, error){ {{ range .Packages }}{{ if .HasEvents }}
+	"{{.Domain}}": {{.Name}}.DecodeEvent,{{ end }}{{ end }}
+}
+
+/* Decode Tab Events */
+func decodeEvent(method string, params json.RawMessage) (interface{}, error) {
+	domain, _, _ := strings.Cut(method, ".")
+	decode, ok := eventDecoders[domain]
+	if !ok {
+		return nil, cdp.ErrUnknownEvent
+	}
+	return decode(method, params)
+}
+{{ else }}
+func init() { {{ range .Packages }}{{ if .HasEvents }}
+	eventDecoders["{{.Domain}}"] = {{.Name}}.DecodeEvent{{ end }}{{ end }}
+}
+{{ end }}
+`))
+
+// what protocol.go and protocol_experimental.go need
+type root struct {
+	Version string
+	// build constraint
+	Tag string
+	// declares decodeEvent
+	Main     bool
+	Packages []*pkg
+}
+
+// command with its parameters as struct fields
+type command struct {
+	gochrome.Command
+	Params []gochrome.Property
+}
+
+// local name for a type declared in package cdp
+type alias struct {
+	Name   string
+	Shared string
+	Enum   []string
+}
+
+// a generated package
+// names are global such as NetworkRequestId until localize
+type pkg struct {
+	Version string
+	Name    string
+	// empty for package cdp
+	Domain       string
+	Description  string
+	Experimental bool
+	Deprecated   bool
+	Types        []gochrome.Type
+	Aliases      []alias
+	Commands     []command
+	Events       []gochrome.Event
+}
+
+// a struct field as JSON sees it
+type field struct {
+	json     string
+	name     string
+	typ      string
+	optional bool
+}
+
+func fieldsOf(v interface{}) (fields []field) {
+	switch v := v.(type) {
+	case []gochrome.Property:
+		for _, p := range v {
+			fields = append(fields, field{p.Name, strings.Title(p.Name), p.Type, p.Optional})
 		}
-		if t.Events.On{{.Name | Title}} != nil {
-			go t.Events.On{{.Name | Title}}(ev)
+	case []gochrome.Parameter:
+		for _, p := range v {
+			fields = append(fields, field{p.Name, strings.Title(p.Name), p.Type, p.Optional})
+		}
+	case []gochrome.Return:
+		for _, p := range v {
+			fields = append(fields, field{p.Name, strings.Title(p.Name), p.Type, p.Optional})
 		}
-{{ end }}
-	default:
-		return errEventNotHandled
 	}
-	return nil
+	return
 }
 
-`))
+// name of something in package cdp as seen from this package
+func (p *pkg) cdp(name string) string {
+	if p.Domain == "" {
+		return name
+	}
+	return "cdp." + name
+}
 
-type protocoldata struct {
-	Timestamp string
-	Version   string
-	Types     []gochrome.Type
-	Commands  []gochrome.Command
-	Events    []gochrome.Event
+// the Go type under a local type name
+// RequestId is string in package network
+func (p *pkg) kind(t string) string {
+	name := strings.TrimPrefix(t, "cdp.")
+	if p.Domain != "" && name == t {
+		if _, ok := owners[p.Domain+name]; ok {
+			name = p.Domain + name
+		}
+	}
+	if k, ok := kinds[name]; ok {
+		return p.local(k)
+	}
+	return t
 }
 
-// helpers
+// MarshalMethods writes a struct as JSON without reflection
+func (p *pkg) MarshalMethods(typ string, fields interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// MarshalCDP writes %s as JSON\n", typ)
+	fmt.Fprintf(&b, "func (v *%s) MarshalCDP(e *%s) {\ne.ObjectStart()\n", typ, p.cdp("Encoder"))
+	for _, f := range fieldsOf(fields) {
+		expr := "v." + f.name
+		write := fmt.Sprintf("e.Key(%q)\n%s", f.json, p.encode(expr, f.typ, 0))
+		if present := p.present(expr, f.typ); f.optional && present != "" {
+			// known not to be nil
+			write = fmt.Sprintf("if %s {\ne.Key(%q)\n%s\n}", present, f.json, p.encodeValue(expr, f.typ, 0))
+		}
+		b.WriteString(write + "\n")
+	}
+	b.WriteString("e.ObjectEnd()\n}\n\n")
+	fmt.Fprintf(&b, "// MarshalJSON is MarshalCDP for encoding/json\n")
+	fmt.Fprintf(&b, "func (v %s) MarshalJSON() ([]byte, error) {\nreturn %s(&v)\n}\n", typ, p.cdp("Marshal"))
+	return b.String()
+}
 
-// clean* helpers
-// prefix domain name to name
-// resolve $ref to actual types
-// change JavaScript types to Go types
+// UnmarshalMethods reads a struct from JSON without reflection
+func (p *pkg) UnmarshalMethods(typ string, fields interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// UnmarshalCDP reads %s from JSON\n", typ)
+	fmt.Fprintf(&b, "func (v *%s) UnmarshalCDP(d *%s) {\n", typ, p.cdp("Decoder"))
+	b.WriteString("if !d.Object() {\nreturn\n}\nfor d.More() {\nswitch string(d.Key()) {\n")
+	for _, f := range fieldsOf(fields) {
+		fmt.Fprintf(&b, "case %q:\n%s\n", f.json, p.decode("v."+f.name, f.typ, 0))
+	}
+	b.WriteString("default:\nd.Skip()\n}\n}\n}\n\n")
+	fmt.Fprintf(&b, "// UnmarshalJSON is UnmarshalCDP for encoding/json\n")
+	fmt.Fprintf(&b, "func (v *%s) UnmarshalJSON(data []byte) error {\nreturn %s(data, v)\n}\n", typ, p.cdp("Unmarshal"))
+	return b.String()
+}
 
-func cleanProperties(domain gochrome.Domain, props []gochrome.Property, name string) (cleaned []gochrome.Property) {
-	// prefix domain name
-	name = fmt.Sprintf("%s%s", domain.Domain, name)
-	for _, p := range props {
-		var pt string
+// condition for an optional field to be written
+// an empty slice or map is sent so only nil leaves it out
+func (p *pkg) present(expr string, typ string) string {
+	if p.nilable(typ) {
+		return expr + " != nil"
+	}
+	return ""
+}
 
-		if p.Ref != "" {
-			pt = p.Ref
-			if !strings.Contains(pt, ".") {
-				pt = fmt.Sprintf("%s%s", domain.Domain, pt)
-			}
+// true if a value of typ can be nil
+func (p *pkg) nilable(typ string) bool {
+	k := p.kind(typ)
+	return strings.HasPrefix(typ, "*") || k == "interface{}" ||
+		strings.HasPrefix(k, "[]") || strings.HasPrefix(k, "map[")
+}
+
+// FieldTag is the tag of a field written by MarshalMethods
+// omitempty would drop empty slices and maps that MarshalCDP sends
+func (p *pkg) FieldTag(name string, optional bool, typ string) string {
+	if optional && p.nilable(typ) && !strings.HasPrefix(typ, "*") {
+		optional = false
+	}
+	return tag(name, optional)
+}
+
+// code that writes expr of type typ with the Encoder e
+func (p *pkg) encode(expr string, typ string, depth int) string {
+	k := p.kind(typ)
+	if strings.HasPrefix(typ, "*") || strings.HasPrefix(k, "[]") {
+		return fmt.Sprintf("if %s == nil {\ne.Null()\n} else {\n%s\n}", expr, p.encodeValue(expr, typ, depth))
+	}
+	return p.encodeValue(expr, typ, depth)
+}
+
+// encode for an expr known not to be nil
+func (p *pkg) encodeValue(expr string, typ string, depth int) string {
+	if strings.HasPrefix(typ, "*") {
+		return p.encode("*"+expr, typ[1:], depth)
+	}
+	switch k := p.kind(typ); {
+	case k == "struct":
+		return paren(expr) + ".MarshalCDP(e)"
+	case k == "string":
+		return fmt.Sprintf("e.String(%s)", convert(k, typ, expr))
+	case k == "int":
+		return fmt.Sprintf("e.Int(%s)", convert(k, typ, expr))
+	case k == "float64":
+		return fmt.Sprintf("e.Float(%s)", convert(k, typ, expr))
+	case k == "bool":
+		return fmt.Sprintf("e.Bool(%s)", convert(k, typ, expr))
+	case k == "interface{}":
+		return fmt.Sprintf("e.Interface(%s)", expr)
+	case k == "map[string]interface{}":
+		return fmt.Sprintf("e.Map(%s)", convert(k, typ, expr))
+	case strings.HasPrefix(k, "[]"):
+		i := fmt.Sprintf("i%d", depth)
+		return fmt.Sprintf("e.ArrayStart()\nfor %s := range %s {\ne.Elem()\n%s\n}\ne.ArrayEnd()",
+			i, expr, p.encode(paren(expr)+"["+i+"]", k[2:], depth+1))
+	}
+	// values the protocol does not describe
+	return fmt.Sprintf("e.Any(%s)", expr)
+}
+
+// code that reads target of type typ with the Decoder d
+func (p *pkg) decode(target string, typ string, depth int) string {
+	if strings.HasPrefix(typ, "*") {
+		return fmt.Sprintf("if d.Null() {\n%s = nil\n} else {\n%s = new(%s)\n%s\n}", target, target, typ[1:], p.decode("*"+target, typ[1:], depth))
+	}
+	switch k := p.kind(typ); {
+	case k == "struct":
+		return paren(target) + ".UnmarshalCDP(d)"
+	case k == "string":
+		return fmt.Sprintf("%s = %s", target, convert(typ, k, "d.String()"))
+	case k == "int":
+		return fmt.Sprintf("%s = %s", target, convert(typ, k, "d.Int()"))
+	case k == "float64":
+		return fmt.Sprintf("%s = %s", target, convert(typ, k, "d.Float()"))
+	case k == "bool":
+		return fmt.Sprintf("%s = %s", target, convert(typ, k, "d.Bool()"))
+	case k == "interface{}":
+		return fmt.Sprintf("%s = d.Interface()", target)
+	case k == "map[string]interface{}":
+		return fmt.Sprintf("%s = %s", target, convert(typ, k, "d.Map()"))
+	case strings.HasPrefix(k, "[]"):
+		x := fmt.Sprintf("x%d", depth)
+		return fmt.Sprintf("if d.Array() {\n%s = make(%s, 0)\nfor d.More() {\nvar %s %s\n%s\n%s = append(%s, %s)\n}\n} else {\n%s = nil\n}",
+			target, typ, x, k[2:], p.decode(x, k[2:], depth+1), target, target, x, target)
+	}
+	// values the protocol does not describe
+	return fmt.Sprintf("d.Any(&%s)", target)
+}
+
+// expr of type from as type to
+func convert(to, from, expr string) string {
+	if to == from {
+		return expr
+	}
+	return to + "(" + expr + ")"
+}
+
+// deref before calling methods or indexing
+func paren(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// HasEvents is true if the domain has events
+// experimental or not
+func (p *pkg) HasEvents() bool {
+	return len(p.Events) > 0
+}
+
+// a generated file of a package
+type file struct {
+	*pkg
+	// build constraint
+	Tag string
+	// declares Client, the types and DecodeEvent
+	Main     bool
+	Commands []command
+	Events   []gochrome.Event
+}
+
+// experimental commands and events go in their own file
+// so they can be left out with -tags gochrome_stable
+// an experimental domain is left out as a whole
+func (p *pkg) files() (main file, extra file) {
+	main = file{pkg: p, Main: true}
+	extra = file{pkg: p, Tag: experimentalTag}
+	if p.Experimental {
+		main.Tag = experimentalTag
+		main.Commands = p.Commands
+		main.Events = p.Events
+		return
+	}
+	for _, c := range p.Commands {
+		if c.Experimental {
+			extra.Commands = append(extra.Commands, c)
+		} else {
+			main.Commands = append(main.Commands, c)
+		}
+	}
+	for _, e := range p.Events {
+		if e.Experimental {
+			extra.Events = append(extra.Events, e)
 		} else {
-			pt = p.Type
+			main.Events = append(main.Events, e)
 		}
+	}
+	return
+}
 
-		if pt == "array" {
-			var at string
-			if p.Items.Ref != "" {
-				at = p.Items.Ref
-				if !strings.Contains(at, ".") {
-					at = fmt.Sprintf("%s%s", domain.Domain, at)
-				}
-			} else {
-				at = p.Items.Type
-				if at == "object" {
-					at = "map[string]interface{}"
-				}
-			}
-			pt = fmt.Sprintf("[]%s", at)
+var ident = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// the name of a type as seen from this package
+// NetworkRequestId is RequestId in package network and cdp.NetworkRequestId elsewhere
+func (p *pkg) local(t string) string {
+	return ident.ReplaceAllStringFunc(t, func(name string) string {
+		o, ok := owners[name]
+		switch {
+		case !ok || p.Domain == "":
+			return name
+		case o.domain == p.Domain:
+			return o.local
+		case shared[name]:
+			return "cdp." + name
 		}
+		fmt.Fprintf(os.Stderr, "%s uses %s which is not shared\n", p.Domain, name)
+		os.Exit(1)
+		return ""
+	})
+}
 
-		if pt == "object" {
-			pt = "map[string]interface{}"
+// use local names for everything in the package
+func (p *pkg) localize() {
+	for i := range p.Types {
+		t := &p.Types[i]
+		t.ID = p.local(t.ID)
+		t.Type = p.local(t.Type)
+		for j := range t.Properties {
+			t.Properties[j].Type = p.local(t.Properties[j].Type)
+		}
+	}
+	for i := range p.Aliases {
+		p.Aliases[i].Name = p.local(p.Aliases[i].Shared)
+	}
+	for i := range p.Commands {
+		c := &p.Commands[i]
+		for j := range c.Params {
+			c.Params[j].Type = p.local(c.Params[j].Type)
+		}
+		for j := range c.Returns {
+			c.Returns[j].Type = p.local(c.Returns[j].Type)
+		}
+	}
+	for i := range p.Events {
+		e := &p.Events[i]
+		for j := range e.Parameters {
+			e.Parameters[j].Type = p.local(e.Parameters[j].Type)
 		}
+	}
+}
 
-		if pt == name {
-			pt = fmt.Sprintf("*%s", pt)
+// make sure no two declarations in the package have the same name
+func (p *pkg) check() error {
+	names := make(map[string]string)
+	methods := make(map[string]string)
+	declare := func(seen map[string]string, name string, what string) error {
+		if other, ok := seen[name]; ok {
+			return fmt.Errorf("%s: %s is the same name as %s", p.Name, what, other)
 		}
+		seen[name] = what
+		return nil
+	}
 
-		props = append(props, gochrome.Property{
-			Name:        nameReplacer.Replace(p.Name),
-			Description: p.Description,
-			Optional:    p.Optional,
-			Type:        typeReplacer.Replace(pt),
-		})
-		// fmt.Fprintf(os.Stderr, "prop %+v\n", props[len(props)-1])
+	var errs []error
+	if p.Domain != "" {
+		errs = append(errs,
+			declare(names, "Client", "Client"),
+			declare(names, "New", "New"),
+			declare(names, "DecodeEvent", "DecodeEvent"),
+		)
+	}
+	for _, t := range p.Types {
+		errs = append(errs, declare(names, t.ID, "type "+t.ID))
+		for _, v := range t.Enum {
+			errs = append(errs, declare(names, enumConst(t.ID, v), fmt.Sprintf("%s %q", t.ID, v)))
+		}
+	}
+	for _, a := range p.Aliases {
+		errs = append(errs, declare(names, a.Name, "type "+a.Name))
+		for _, v := range a.Enum {
+			errs = append(errs, declare(names, enumConst(a.Name, v), fmt.Sprintf("%s %q", a.Name, v)))
+		}
+	}
+	for _, c := range p.Commands {
+		errs = append(errs,
+			declare(names, c.Name+"Returns", c.Method+" returns"),
+			declare(methods, c.Name, c.Method),
+			declare(methods, c.Name+"Context", c.Method),
+		)
+		if c.Params != nil {
+			errs = append(errs, declare(names, c.Name+"Params", c.Method+" params"))
+		}
+	}
+	for _, e := range p.Events {
+		errs = append(errs,
+			declare(names, e.Name+"Event", e.EventName+" event"),
+			declare(names, e.Name+"Handler", e.EventName+" handler"),
+			declare(methods, "On"+e.Name, e.EventName),
+		)
 	}
 
-	return
+	return errors.Join(errs...)
 }
 
-func cleanParameters(domain gochrome.Domain, params []gochrome.Parameter, name string) (cleaned []gochrome.Parameter) {
-	// prefix domain name
-	name = fmt.Sprintf("%s%s", domain.Domain, name)
-	for _, p := range params {
-		var pt string
+// imports are written for every file that might need them
+// drop the ones a file does not use
+func dropUnusedImports(src []byte) []byte {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		// format.Source reports it
+		return src
+	}
 
-		if p.Ref != "" {
-			pt = p.Ref
-			if !strings.Contains(pt, ".") {
-				pt = fmt.Sprintf("%s%s", domain.Domain, pt)
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
 			}
-		} else {
-			pt = p.Type
 		}
+		return true
+	})
+
+	unused := make(map[int]bool)
+	for _, imp := range f.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		if !used[pathpkg.Base(path)] {
+			unused[fset.Position(imp.Pos()).Line] = true
+		}
+	}
+
+	var out [][]byte
+	for i, line := range bytes.Split(src, []byte("\n")) {
+		if !unused[i+1] {
+			out = append(out, line)
+		}
+	}
+	return bytes.Join(out, []byte("\n"))
+}
+
+// helpers
+
+// Go type of every protocol type by name
+// used to decide which optional fields need a pointer
+var kinds = make(map[string]string)
+
+// values of every enum type by name
+var enums = make(map[string][]string)
+
+// domain and local name of every type by name
+var owners = make(map[string]owner)
+
+type owner struct {
+	domain string
+	local  string
+}
+
+// types declared in package cdp by name
+var shared = make(map[string]bool)
+
+// types we made for enums declared inline
+var inlineEnums []gochrome.Type
+
+// name the enum declared inline by a parameter or property
+func inlineEnum(domain gochrome.Domain, name string, values []string) string {
+	if _, ok := kinds[name]; ok {
+		fmt.Fprintf(os.Stderr, "enum %s is already a type\n", name)
+		os.Exit(1)
+	}
+	kinds[name] = "string"
+	enums[name] = values
+	owners[name] = owner{domain: domain.Domain, local: strings.TrimPrefix(name, domain.Domain)}
+	inlineEnums = append(inlineEnums, gochrome.Type{
+		ID:   name,
+		Type: "string",
+		Enum: unique(values),
+	})
+	return name
+}
+
+// resolve a parameter or property that may declare its own enum
+func enumType(domain gochrome.Domain, name string, p gochrome.Parameter) string {
+	if p.Ref == "" && p.Type == "string" && len(p.Enum) > 0 {
+		return inlineEnum(domain, name+strings.Title(p.Name), p.Enum)
+	}
+	return goType(domain, p.Ref, p.Type, p.Items)
+}
 
-		if pt == "array" {
-			var at string
-			if p.Items.Ref != "" {
-				at = p.Items.Ref
-				if !strings.Contains(at, ".") {
-					at = fmt.Sprintf("%s%s", domain.Domain, at)
+var notIdent = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// constant for an enum value
+// same-origin in PageReferrerPolicy is PageReferrerPolicySameOrigin
+func enumConst(typ string, value string) string {
+	var name strings.Builder
+	name.WriteString(typ)
+	for _, part := range notIdent.Split(value, -1) {
+		name.WriteString(strings.Title(part))
+	}
+	return name.String()
+}
+
+// types used by another domain go in package cdp
+// along with every type they use
+// so domain packages never import each other
+func findShared(protocol gochrome.Protocol) {
+	uses := make(map[string][]string)
+	var queue []string
+	for _, domain := range protocol.Domains {
+		refs := func(ref string, items gochrome.Item) (names []string) {
+			for _, r := range []string{ref, items.Ref} {
+				if r == "" {
+					continue
 				}
-			} else {
-				at = p.Items.Type
-				if at == "object" {
-					at = "map[string]interface{}"
+				name := qualify(domain, r)
+				names = append(names, name)
+				if owners[name].domain != domain.Domain {
+					queue = append(queue, name)
 				}
 			}
-			pt = fmt.Sprintf("[]%s", at)
+			return
+		}
+
+		for _, t := range domain.Types {
+			name := domain.Domain + t.ID
+			uses[name] = refs("", t.Items)
+			for _, p := range t.Properties {
+				uses[name] = append(uses[name], refs(p.Ref, p.Items)...)
+			}
+		}
+		for _, c := range domain.Commands {
+			for _, p := range c.Parameters {
+				refs(p.Ref, p.Items)
+			}
+			for _, r := range c.Returns {
+				refs(r.Ref, r.Items)
+			}
+		}
+		for _, e := range domain.Events {
+			for _, p := range e.Parameters {
+				refs(p.Ref, p.Items)
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if shared[name] {
+			continue
+		}
+		shared[name] = true
+		queue = append(queue, uses[name]...)
+	}
+}
+
+// values without repeats
+func unique(values []string) (u []string) {
+	seen := make(map[string]bool)
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			u = append(u, v)
 		}
+	}
+	return
+}
+
+// clean* helpers
+// prefix domain name to name
+// resolve $ref to actual types
+// change JavaScript types to Go types
+
+// resolve a protocol type to a Go type
+func goType(domain gochrome.Domain, ref string, typ string, items gochrome.Item) string {
+	if ref != "" {
+		return qualify(domain, ref)
+	}
 
-		if pt == "object" {
-			pt = "map[string]interface{}"
+	switch typ {
+	case "array":
+		if items.Ref == "" && items.Type == "" {
+			return "[]interface{}"
 		}
+		return "[]" + goType(domain, items.Ref, items.Type, gochrome.Item{})
+	case "object":
+		return "map[string]interface{}"
+	}
+
+	return typeReplacer.Replace(typ)
+}
+
+// Domain.Type or Type in the same domain
+func qualify(domain gochrome.Domain, ref string) string {
+	if !strings.Contains(ref, ".") {
+		return domain.Domain + ref
+	}
+	return strings.Replace(ref, ".", "", 1)
+}
+
+// optional fields are pointers unless nil already means missing
+func optionalType(t string) string {
+	k := t
+	if under, ok := kinds[t]; ok {
+		k = under
+	}
+	if strings.HasPrefix(k, "[]") || strings.HasPrefix(k, "map[") || strings.HasPrefix(k, "*") || k == "interface{}" {
+		return t
+	}
+	return "*" + t
+}
+
+// the Go type for a protocol type definition
+func typeKind(domain gochrome.Domain, t gochrome.Type) string {
+	if t.Type == "object" && len(t.Properties) > 0 {
+		return "struct"
+	}
+	return goType(domain, "", t.Type, t.Items)
+}
+
+func cleanProperties(domain gochrome.Domain, props []gochrome.Property, name string) (cleaned []gochrome.Property) {
+	// prefix domain name
+	name = fmt.Sprintf("%s%s", domain.Domain, name)
+	for _, p := range props {
+		pt := enumType(domain, name, gochrome.Parameter{Name: p.Name, Type: p.Type, Ref: p.Ref, Items: p.Items, Enum: p.Enum})
 
-		if pt == name {
+		if p.Optional {
+			pt = optionalType(pt)
+		} else if pt == name {
+			// a struct cannot hold itself
 			pt = fmt.Sprintf("*%s", pt)
 		}
 
-		cleaned = append(cleaned, gochrome.Parameter{
-			Name:     nameReplacer.Replace(p.Name),
-			Type:     typeReplacer.Replace(pt),
-			Optional: p.Optional,
+		cleaned = append(cleaned, gochrome.Property{
+			Name:         p.Name,
+			Description:  p.Description,
+			Experimental: p.Experimental,
+			Deprecated:   p.Deprecated,
+			Optional:     p.Optional,
+			Type:         pt,
+			Enum:         enums[strings.TrimPrefix(pt, "*")],
 		})
 	}
 
 	return
 }
 
-func cleanReturns(domain gochrome.Domain, returns []gochrome.Return, name string) (cleaned []gochrome.Return) {
+// command parameters become the fields of a params struct
+func asProperties(params []gochrome.Parameter) (props []gochrome.Property) {
+	for _, p := range params {
+		props = append(props, gochrome.Property{
+			Name:         p.Name,
+			Description:  p.Description,
+			Experimental: p.Experimental,
+			Deprecated:   p.Deprecated,
+			Optional:     p.Optional,
+			Type:         p.Type,
+			Ref:          p.Ref,
+			Items:        p.Items,
+			Enum:         p.Enum,
+		})
+	}
+	return
+}
+
+func cleanParameters(domain gochrome.Domain, params []gochrome.Parameter, name string) (cleaned []gochrome.Parameter) {
 	// prefix domain name
 	name = fmt.Sprintf("%s%s", domain.Domain, name)
-	for _, p := range returns {
-		var pt string
+	for _, p := range params {
+		pt := enumType(domain, name, p)
 
-		if p.Ref != "" {
-			pt = p.Ref
-			if !strings.Contains(pt, ".") {
-				pt = fmt.Sprintf("%s%s", domain.Domain, pt)
-			}
-		} else {
-			pt = p.Type
+		if p.Optional {
+			pt = optionalType(pt)
+		} else if pt == name {
+			pt = fmt.Sprintf("*%s", pt)
 		}
 
-		if pt == "array" {
-			var at string
-			if p.Items.Ref != "" {
-				at = p.Items.Ref
-				if !strings.Contains(at, ".") {
-					at = fmt.Sprintf("%s%s", domain.Domain, at)
-				}
-			} else {
-				at = p.Items.Type
-			}
-			pt = fmt.Sprintf("[]%s", at)
-		}
+		cleaned = append(cleaned, gochrome.Parameter{
+			Name:         p.Name,
+			Description:  p.Description,
+			Experimental: p.Experimental,
+			Deprecated:   p.Deprecated,
+			Type:         pt,
+			Optional:     p.Optional,
+			Enum:         enums[strings.TrimPrefix(pt, "*")],
+		})
+	}
 
-		if pt == "object" {
-			pt = "map[string]interface{}"
-		}
+	return
+}
 
-		if pt == "[]object" {
-			pt = "[]map[string]interface{}"
-		}
+func cleanReturns(domain gochrome.Domain, returns []gochrome.Return, name string) (cleaned []gochrome.Return) {
+	// prefix domain name
+	name = fmt.Sprintf("%s%s", domain.Domain, name)
+	for _, p := range returns {
+		pt := enumType(domain, name, gochrome.Parameter{Name: p.Name, Type: p.Type, Ref: p.Ref, Items: p.Items, Enum: p.Enum})
 
-		if pt == name {
+		if p.Optional {
+			pt = optionalType(pt)
+		} else if pt == name {
 			pt = fmt.Sprintf("*%s", pt)
 		}
 
 		cleaned = append(cleaned, gochrome.Return{
-			Name:     nameReplacer.Replace(p.Name),
-			Type:     typeReplacer.Replace(pt),
-			Optional: p.Optional,
+			Name:         p.Name,
+			Description:  p.Description,
+			Experimental: p.Experimental,
+			Deprecated:   p.Deprecated,
+			Type:         pt,
+			Optional:     p.Optional,
 		})
 	}
 
@@ -1,5 +1,5 @@
 module github.com/bobbytrapz/gochrome
 
-go 1.20
+go 1.21
 
 require github.com/gorilla/websocket v1.5.0
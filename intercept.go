@@ -0,0 +1,33 @@
+package gochrome
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Invoker sends a command and gives chrome's raw result
+type Invoker func(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+
+// Interceptor is middleware around every command a tab sends
+// call next to pass the command along
+// it may change the command, retry it, or answer without calling next
+//
+//	b.Interceptors = append(b.Interceptors, func(ctx context.Context, method string, params interface{}, next gochrome.Invoker) (json.RawMessage, error) {
+//		start := time.Now()
+//		res, err := next(ctx, method, params)
+//		latency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+//		return res, err
+//	})
+type Interceptor func(ctx context.Context, method string, params interface{}, next Invoker) (json.RawMessage, error)
+
+// send a command through the interceptors
+func (t *Tab) invoke(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	next := Invoker(t.roundTrip)
+	for i := len(t.interceptors) - 1; i >= 0; i-- {
+		ic, inner := t.interceptors[i], next
+		next = func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+			return ic(ctx, method, params, inner)
+		}
+	}
+	return next(ctx, method, params)
+}
@@ -0,0 +1,101 @@
+package gochrome
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestInterceptors(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		switch cmd.Method {
+		case "Page.navigate":
+			var params map[string]interface{}
+			json.Unmarshal(cmd.Params, &params)
+			return map[string]interface{}{"frameId": params["url"]}, nil
+		case "DOM.getDocument":
+			return nil, &ProtocolError{Code: -32000, Message: "busy"}
+		}
+		return struct{}{}, nil
+	})
+
+	var m sync.Mutex
+	var calls []string
+	trace := func(name string) Interceptor {
+		return func(ctx context.Context, method string, params interface{}, next Invoker) (json.RawMessage, error) {
+			m.Lock()
+			calls = append(calls, name+" "+method)
+			m.Unlock()
+			return next(ctx, method, params)
+		}
+	}
+
+	b := NewBrowser()
+	b.Interceptors = []Interceptor{
+		trace("outer"),
+		trace("inner"),
+		// rewrite params
+		func(ctx context.Context, method string, params interface{}, next Invoker) (json.RawMessage, error) {
+			if method == "Page.navigate" {
+				params = map[string]interface{}{"url": "about:rewritten"}
+			}
+			return next(ctx, method, params)
+		},
+		// answer without chrome
+		func(ctx context.Context, method string, params interface{}, next Invoker) (json.RawMessage, error) {
+			if method == "Browser.getVersion" {
+				return json.RawMessage(`{"product":"intercepted"}`), nil
+			}
+			return next(ctx, method, params)
+		},
+		// retry once
+		func(ctx context.Context, method string, params interface{}, next Invoker) (json.RawMessage, error) {
+			res, err := next(ctx, method, params)
+			var perr *ProtocolError
+			if errors.As(err, &perr) && method == "DOM.getDocument" {
+				return next(ctx, method, params)
+			}
+			return res, err
+		},
+	}
+	tab := b.NewTabWithTransport(mt)
+
+	res, err := tab.Goto("about:blank")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.FrameId != "about:rewritten" {
+		t.Errorf("expected rewritten params, got %q", res.FrameId)
+	}
+	if expected := []string{"outer Page.navigate", "inner Page.navigate"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected %v, got %v", expected, calls)
+	}
+
+	ver, err := tab.Browser().GetVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ver.Product != "intercepted" {
+		t.Errorf("expected intercepted result, got %q", ver.Product)
+	}
+
+	before := len(mt.commands())
+	if err := tab.Call(context.Background(), "DOM.getDocument", nil, nil); err == nil {
+		t.Error("expected an error")
+	}
+	if sent := len(mt.commands()) - before; sent != 2 {
+		t.Errorf("expected a retry, got %d sends", sent)
+	}
+
+	// the channel api goes through the chain too
+	r := <-tab.SendCommand(map[string]interface{}{"method": "Browser.getVersion"})
+	if r.Err != nil || string(r.Result) != `{"product":"intercepted"}` {
+		t.Errorf("unexpected response: %s %v", r.Result, r.Err)
+	}
+
+	mt.Close()
+	b.Wait()
+}
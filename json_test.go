@@ -0,0 +1,278 @@
+package gochrome
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+	"github.com/bobbytrapz/gochrome/cdp/network"
+	"github.com/bobbytrapz/gochrome/cdp/page"
+	"github.com/bobbytrapz/gochrome/cdp/runtime"
+)
+
+// recordings in testdata
+// network.jsonl is a page load with many resources
+var corpora = []string{"network.jsonl", "replay.jsonl"}
+
+func loadCorpus(tb testing.TB, name string) []RecordedMessage {
+	tb.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	defer f.Close()
+	var msgs []RecordedMessage
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		var msg RecordedMessage
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			tb.Fatal(err)
+		}
+		msgs = append(msgs, msg)
+	}
+	if err := scanner.Err(); err != nil {
+		tb.Fatal(err)
+	}
+	return msgs
+}
+
+// every value in a is in b
+// b may have more keys and may leave out zero values like omitempty
+func containsJSON(a, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range a {
+			if _, ok := b[k]; !ok && (v == false || v == 0.0 || v == "") {
+				continue
+			}
+			if !containsJSON(v, b[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !containsJSON(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// decoding a message keeps everything chrome sent
+// required values missing from a recording come back as zero values
+func TestGeneratedJSONRoundTrip(t *testing.T) {
+	for _, name := range corpora {
+		for _, rec := range loadCorpus(t, name) {
+			if rec.Direction != RecordReceive {
+				continue
+			}
+			var msg resChrome
+			if err := msg.unmarshal(rec.Message); err != nil {
+				t.Fatalf("%s: %s: %v", name, rec.Message, err)
+			}
+			if msg.ID != rec.ID || msg.Method != rec.Method || msg.SessionID != rec.SessionID {
+				t.Errorf("%s: message read as %d %q %q", name, msg.ID, msg.Method, msg.SessionID)
+			}
+			if msg.Method == "" {
+				continue
+			}
+			ev, err := decodeEvent(msg.Method, msg.Params)
+			if err == cdp.ErrUnknownEvent {
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: %s: %v", name, msg.Method, err)
+			}
+			out, err := json.Marshal(ev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var sent, kept interface{}
+			json.Unmarshal(msg.Params, &sent)
+			json.Unmarshal(out, &kept)
+			if !containsJSON(sent, kept) {
+				t.Errorf("%s: %s lost values\n%s\n%s", name, msg.Method, msg.Params, out)
+			}
+
+			// and reads back the same
+			again, err := decodeEvent(msg.Method, out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ev, again) {
+				t.Errorf("%s: %s changed\n%+v\n%+v", name, msg.Method, ev, again)
+			}
+		}
+	}
+}
+
+// optional event and return fields are nil when chrome leaves them out
+func TestOptionalFields(t *testing.T) {
+	var ev network.RequestWillBeSentEvent
+	if err := cdp.Unmarshal([]byte(`{"requestId":"1","redirectResponse":{"status":301}}`), &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.RedirectResponse == nil || ev.RedirectResponse.Status != 301 {
+		t.Errorf("expected a redirect response, got %+v", ev.RedirectResponse)
+	}
+	ev = network.RequestWillBeSentEvent{}
+	if err := cdp.Unmarshal([]byte(`{"requestId":"2"}`), &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.RedirectResponse != nil {
+		t.Errorf("expected no redirect response, got %+v", ev.RedirectResponse)
+	}
+
+	var res runtime.EvaluateReturns
+	if err := cdp.Unmarshal([]byte(`{"result":{"type":"number","value":2}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.ExceptionDetails != nil {
+		t.Errorf("expected no exception, got %+v", res.ExceptionDetails)
+	}
+	if err := cdp.Unmarshal([]byte(`{"result":{"type":"object"},"exceptionDetails":{"exceptionId":1}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.ExceptionDetails == nil {
+		t.Error("expected exception details")
+	}
+}
+
+func TestEncodeCommand(t *testing.T) {
+	data, err := encodeCommand(7, "Page.navigate", &page.NavigateParams{Url: "https://example.com/?q=<é>"}, "S1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"id":7,"method":"Page.navigate","params":{"url":"https://example.com/?q=<é>"},"sessionId":"S1"}` {
+		t.Errorf("unexpected command: %s", data)
+	}
+
+	// params without MarshalCDP use encoding/json
+	data, err = encodeCommand(8, "Runtime.evaluate", map[string]interface{}{"expression": "1+1"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"id":8,"method":"Runtime.evaluate","params":{"expression":"1+1"}}` {
+		t.Errorf("unexpected command: %s", data)
+	}
+
+	if _, err := encodeCommand(9, "Bad.params", map[string]interface{}{"ch": make(chan int)}, ""); err == nil {
+		t.Error("expected an error for params that cannot be encoded")
+	}
+}
+
+// read every message chrome sent and decode its event
+func BenchmarkReceive(b *testing.B) {
+	for _, name := range corpora {
+		msgs := loadCorpus(b, name)
+		size := 0
+		for _, rec := range msgs {
+			size += len(rec.Message)
+		}
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, rec := range msgs {
+					if rec.Direction != RecordReceive {
+						continue
+					}
+					var msg resChrome
+					if err := msg.unmarshal(rec.Message); err != nil {
+						b.Fatal(err)
+					}
+					if msg.Method == "" {
+						continue
+					}
+					if _, err := decodeEvent(msg.Method, msg.Params); err != nil && err != cdp.ErrUnknownEvent {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+// the same messages through encoding/json for comparison
+func BenchmarkReceiveEncodingJSON(b *testing.B) {
+	for _, name := range corpora {
+		msgs := loadCorpus(b, name)
+		size := 0
+		for _, rec := range msgs {
+			size += len(rec.Message)
+		}
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, rec := range msgs {
+					if rec.Direction != RecordReceive {
+						continue
+					}
+					var msg struct {
+						ID     int             `json:"id"`
+						Result json.RawMessage `json:"result"`
+						Error  *ProtocolError  `json:"error"`
+						Method string          `json:"method"`
+						Params json.RawMessage `json:"params"`
+					}
+					if err := json.Unmarshal(rec.Message, &msg); err != nil {
+						b.Fatal(err)
+					}
+					if msg.Method == "" {
+						continue
+					}
+					var ev map[string]interface{}
+					if err := json.Unmarshal(msg.Params, &ev); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+// commands a network heavy client sends for each request in the corpus
+func BenchmarkSend(b *testing.B) {
+	var params []interface{}
+	for _, rec := range loadCorpus(b, "network.jsonl") {
+		if rec.Method != "Network.requestWillBeSent" {
+			continue
+		}
+		var msg struct {
+			Params network.RequestWillBeSentEvent `json:"params"`
+		}
+		if err := json.Unmarshal(rec.Message, &msg); err != nil {
+			b.Fatal(err)
+		}
+		ev := msg.Params
+		params = append(params,
+			&network.GetResponseBodyParams{RequestId: ev.RequestId},
+			&network.SetExtraHTTPHeadersParams{Headers: ev.Request.Headers},
+		)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for id, p := range params {
+			if _, err := encodeCommand(id, "Network.command", p, "9D1A0F6C2E7B4A58B3C1E0F2A9D8C7B6"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package gochrome
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// CommandLimit caps how many commands a tab sends and how fast
+// callers wait their turn until ctx is done or the tab ends
+// nothing is dropped
+type CommandLimit struct {
+	// most commands waiting for a response at once
+	// 0 is no limit
+	MaxInFlight int
+	// commands per second on average
+	// 0 is no limit
+	Rate float64
+	// commands that may be sent at once after being idle
+	// 1 if zero
+	Burst int
+}
+
+// per-tab state for a CommandLimit
+type limiter struct {
+	// one slot per command in flight
+	slots chan struct{}
+	// token bucket
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	m      sync.Mutex
+}
+
+var errTabDone = errors.New("tab done")
+
+func newLimiter(cl CommandLimit) *limiter {
+	l := &limiter{
+		rate:  cl.Rate,
+		burst: float64(cl.Burst),
+	}
+	if cl.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, cl.MaxInFlight)
+	}
+	if l.burst < 1 {
+		l.burst = 1
+	}
+	l.tokens = l.burst
+	l.last = time.Now()
+	return l
+}
+
+// wait until a command may be sent
+// gives ctx.Err() or errTabDone if we stop waiting
+// call release once the command is answered
+func (l *limiter) acquire(ctx context.Context, done <-chan struct{}) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+			return errTabDone
+		}
+	}
+	if err := l.take(ctx, done); err != nil {
+		l.release()
+		return err
+	}
+	return nil
+}
+
+func (l *limiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+// take a token from the bucket
+func (l *limiter) take(ctx context.Context, done <-chan struct{}) error {
+	if l.rate <= 0 {
+		return nil
+	}
+	for {
+		l.m.Lock()
+		now := time.Now()
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		if l.tokens >= 1 {
+			l.tokens--
+			l.m.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.m.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-done:
+			timer.Stop()
+			return errTabDone
+		}
+	}
+}
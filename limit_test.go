@@ -0,0 +1,80 @@
+package gochrome
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCommandLimit(t *testing.T) {
+	t.Run("in flight", func(t *testing.T) {
+		release := make(chan struct{})
+		var mt *memTransport
+		mt = newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			// answer once we are told to
+			go func() {
+				<-release
+				mt.push(map[string]interface{}{"id": cmd.ID, "result": struct{}{}})
+			}()
+			return nil, nil
+		})
+
+		b := NewBrowser()
+		b.CommandLimit = &CommandLimit{MaxInFlight: 2}
+		tab := b.NewTabWithTransport(mt)
+
+		errc := make(chan error, 5)
+		for i := 0; i < 5; i++ {
+			go func() {
+				errc <- tab.Call(context.Background(), "DOM.getOuterHTML", nil, nil)
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		if n := len(mt.commands()); n != 2 {
+			t.Errorf("expected 2 commands in flight, got %d", n)
+		}
+
+		// a caller gives up while waiting for a slot
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if err := tab.Call(ctx, "DOM.getOuterHTML", nil, nil); !errors.Is(err, ErrCommandTimeout) {
+			t.Errorf("expected ErrCommandTimeout, got %v", err)
+		}
+
+		close(release)
+		for i := 0; i < 5; i++ {
+			if err := <-errc; err != nil {
+				t.Error(err)
+			}
+		}
+		if n := len(mt.commands()); n != 5 {
+			t.Errorf("expected every command to be sent, got %d", n)
+		}
+		mt.Close()
+		b.Wait()
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			return struct{}{}, nil
+		})
+
+		b := NewBrowser()
+		b.CommandLimit = &CommandLimit{Rate: 100, Burst: 2}
+		tab := b.NewTabWithTransport(mt)
+
+		start := time.Now()
+		for i := 0; i < 6; i++ {
+			if err := tab.Call(context.Background(), "Network.getResponseBody", nil, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// 2 at once then 4 more at 10ms each
+		if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+			t.Errorf("expected the rate to be limited, took %s", elapsed)
+		}
+		mt.Close()
+		b.Wait()
+	})
+}
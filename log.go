@@ -0,0 +1,30 @@
+package gochrome
+
+import (
+	"context"
+	"log/slog"
+)
+
+// handler used when Browser.Logger is nil
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+var discardLogger = slog.New(discardHandler{})
+
+// logger gives Browser.Logger or a logger that drops everything
+func (b *Browser) logger() *slog.Logger {
+	if b.Logger == nil {
+		return discardLogger
+	}
+	return b.Logger
+}
+
+// true if wire traffic should be logged
+// so we only build the message strings when needed
+func wireEnabled(l *slog.Logger) bool {
+	return l.Enabled(context.Background(), slog.LevelDebug)
+}
@@ -0,0 +1,68 @@
+package gochrome
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestLogger(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return map[string]interface{}{"frameId": "main"}, nil
+	})
+
+	var buf bytes.Buffer
+	b := NewBrowser()
+	b.Logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	b.Dial = func(wsURL string) (Transport, error) {
+		return mt, nil
+	}
+	tab, err := b.connectTab(tabConnectionInfo{ID: "tab1", WebSocketDebuggerURL: "ws://fake"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tab.Goto("about:blank"); err != nil {
+		t.Fatal(err)
+	}
+	mt.Close()
+	b.Wait()
+
+	var call, send, receive bool
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var rec map[string]interface{}
+		if err := dec.Decode(&rec); err != nil {
+			t.Fatal(err)
+		}
+		switch rec["msg"] {
+		case "call":
+			call = true
+			if rec["tab"] != "tab1" || rec["method"] != "Page.navigate" || rec["id"] != 1.0 {
+				t.Errorf("unexpected call record: %v", rec)
+			}
+			if _, ok := rec["latency"]; !ok {
+				t.Errorf("expected latency: %v", rec)
+			}
+		case "send":
+			send = true
+		case "receive":
+			receive = true
+		}
+		// records from the page websocket say which tab they are for
+		if rec["tab"] != "tab1" {
+			t.Errorf("expected tab attribute: %v", rec)
+		}
+	}
+	if !call || !send || !receive {
+		t.Errorf("expected call, send and receive records: %v %v %v", call, send, receive)
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		b := NewBrowser()
+		if b.logger().Enabled(context.Background(), slog.LevelError) {
+			t.Error("expected nil Logger to log nothing")
+		}
+	})
+}
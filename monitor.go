@@ -1,12 +1,15 @@
 package gochrome
 
 import (
+	"context"
+	"fmt"
 	"runtime"
 )
 
 // handles exit for the browser process
 // should be called after the browser process begins
-func (b *Browser) monitorBrowserProcess() {
+// exiting because ctx is done is not an error
+func (b *Browser) monitorBrowserProcess(ctx context.Context) {
 	b.exit = make(chan struct{}, 1)
 
 	switch runtime.GOOS {
@@ -15,8 +18,10 @@ func (b *Browser) monitorBrowserProcess() {
 		b.wg.Add(1)
 		go func() {
 			defer b.wg.Done()
-			b.cmd.Wait()
-			close(b.exit)
+			if err := b.cmd.Wait(); err != nil && ctx.Err() == nil {
+				b.fail(fmt.Errorf("chrome exited: %w", err))
+			}
+			b.exited()
 		}()
 	case "windows":
 	}
@@ -3,24 +3,32 @@ package gochrome
 import (
 	"encoding/base64"
 	"fmt"
-	"os"
 	"sync"
 	"time"
+
+	"github.com/bobbytrapz/gochrome/cdp/network"
 )
 
 // SetUserAgent override
 func (t *Tab) SetUserAgent(ua string) {
-	t.NetworkSetUserAgentOverride(ua, "", "", nil)
+	t.Network().SetUserAgentOverride(network.SetUserAgentOverrideParams{UserAgent: ua})
 }
 
 // SetRequestHeaders override
 func (t *Tab) SetRequestHeaders(ua string, lang string, platform string) {
-	t.NetworkSetUserAgentOverride(ua, lang, platform, nil)
+	params := network.SetUserAgentOverrideParams{UserAgent: ua}
+	if lang != "" {
+		params.AcceptLanguage = &lang
+	}
+	if platform != "" {
+		params.Platform = &platform
+	}
+	t.Network().SetUserAgentOverride(params)
 }
 
 // GetResponseBody for a request
-func (t *Tab) GetResponseBody(id NetworkRequestId) (string, error) {
-	res, err := t.NetworkGetResponseBody(id)
+func (t *Tab) GetResponseBody(id network.RequestId) (string, error) {
+	res, err := t.Network().GetResponseBody(network.GetResponseBodyParams{RequestId: id})
 	if err != nil {
 		return "", fmt.Errorf("Tab.GetResponseBody: %v", err)
 	}
@@ -39,51 +47,45 @@ func (t *Tab) GetResponseBody(id NetworkRequestId) (string, error) {
 
 // HTTPResource is a resource the browser has fetched
 type HTTPResource struct {
-	Type     NetworkResourceType
-	Response NetworkResponse
+	Type     network.ResourceType
+	Response network.Response
 	Body     string
 }
 
 // OnResource passes the http response headers and body
-// Document, Stylesheet, Image, Media, Font,
-// Script, TextTrack, XHR, Fetch, EventSource,
-// WebSocket, Manifest, SignedExchange, Ping,
-// CSPViolationReport, Other
-func (t *Tab) OnResource(onResource func(res HTTPResource), types ...NetworkResourceType) error {
+// for the given resource types or every network.ResourceType if none are given
+// other handlers for the same network events keep working
+// call unsubscribe to stop
+func (t *Tab) OnResource(onResource func(res HTTPResource), types ...network.ResourceType) (unsubscribe func(), err error) {
 	if len(types) == 0 {
-		types = []NetworkResourceType{
-			"Document", "Stylesheet", "Image", "Media", "Font",
-			"Script", "TextTrack", "XHR", "Fetch", "EventSource",
-			"WebSocket", "Manifest", "SignedExchange", "Ping",
-			"CSPViolationReport", "Other",
-		}
+		types = network.ResourceType("").Values()
 	}
-	_, err := t.NetworkEnable(0, 0, 0)
+	_, err = t.Network().Enable(network.EnableParams{})
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	var m sync.Mutex
-	resources := make(map[NetworkRequestId]HTTPResource)
+	resources := make(map[network.RequestId]HTTPResource)
 
-	add := func(id NetworkRequestId, res HTTPResource) {
+	add := func(id network.RequestId, res HTTPResource) {
 		m.Lock()
 		defer m.Unlock()
 		resources[id] = res
 	}
-	get := func(id NetworkRequestId) (HTTPResource, bool) {
+	get := func(id network.RequestId) (HTTPResource, bool) {
 		m.Lock()
 		defer m.Unlock()
 		res, ok := resources[id]
 		return res, ok
 	}
-	del := func(id NetworkRequestId) {
+	del := func(id network.RequestId) {
 		m.Lock()
 		defer m.Unlock()
 		delete(resources, id)
 	}
 
-	t.Events.OnNetworkResponseReceived = func(ev NetworkResponseReceivedEvent) {
-		// fmt.Printf("Response: %s (%s)\n", ev.RequestId, ev.Response["url"])
+	offResponse := t.Network().OnResponseReceived(func(ev network.ResponseReceivedEvent) {
+		// fmt.Printf("Response: %s (%s)\n", ev.RequestId, ev.Response.Url)
 		for _, tt := range types {
 			if ev.Type == tt {
 				add(ev.RequestId, HTTPResource{
@@ -92,30 +94,41 @@ func (t *Tab) OnResource(onResource func(res HTTPResource), types ...NetworkReso
 				})
 			}
 		}
-	}
+	})
 
-	t.Events.OnNetworkLoadingFinished = func(ev NetworkLoadingFinishedEvent) {
+	// onResource is called one at a time like other handlers in an event queue
+	var deliver sync.Mutex
+	offLoaded := t.Network().OnLoadingFinished(func(ev network.LoadingFinishedEvent) {
 		// fmt.Printf("Loaded: %s\n", ev.RequestId)
 		if r, ok := get(ev.RequestId); ok {
 			del(ev.RequestId)
-			body, err := t.GetResponseBody(ev.RequestId)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Tab.OnResource: %v\n", err)
-			}
-			onResource(HTTPResource{
-				Type:     r.Type,
-				Response: r.Response,
-				Body:     body,
-			})
+			// the body comes back through the connection
+			// so do not hold up a full event queue waiting for it
+			go func() {
+				body, err := t.GetResponseBody(ev.RequestId)
+				if err != nil {
+					t.log.Error("Tab.OnResource", "err", err)
+				}
+				deliver.Lock()
+				defer deliver.Unlock()
+				onResource(HTTPResource{
+					Type:     r.Type,
+					Response: r.Response,
+					Body:     body,
+				})
+			}()
 		}
-	}
+	})
 
-	return nil
+	return func() {
+		offResponse()
+		offLoaded()
+	}, nil
 }
 
 // WaitForNetworkIdle blocks until network is idle for d seconds
 // relies on network events so
-// Tab.NetworkEnable should be called first
+// Tab.Network().Enable should be called first
 func (t *Tab) WaitForNetworkIdle(d time.Duration) {
 	for {
 		select {
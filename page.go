@@ -4,22 +4,27 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+
+	"github.com/bobbytrapz/gochrome/cdp/page"
 )
 
 // Goto a url
-func (t *Tab) Goto(url string) (PageNavigateReturns, error) {
-	return t.PageNavigate(url, "", "", "", "")
+func (t *Tab) Goto(url string) (page.NavigateReturns, error) {
+	return t.Page().Navigate(page.NavigateParams{Url: url})
 }
 
 // Close a tab; just calls Tab.PageClose
 func (t *Tab) Close() {
-	_, _ = t.PageClose()
+	_, _ = t.Page().Close()
 }
 
 // Screenshot captures page as png
 // uses Page.captureScreenshot
 func (t *Tab) Screenshot(saveAs string) error {
-	res, err := t.PageCaptureScreenshot("png", 0, nil, true)
+	res, err := t.Page().CaptureScreenshot(page.CaptureScreenshotParams{
+		Format:      Ptr(page.CaptureScreenshotFormatPng),
+		FromSurface: Ptr(true),
+	})
 	if err != nil {
 		return fmt.Errorf("Tab.Screenshot: %w", err)
 	}
@@ -27,33 +32,15 @@ func (t *Tab) Screenshot(saveAs string) error {
 	// decode
 	img, err := base64.StdEncoding.DecodeString(res.Data)
 	if err != nil {
-		Log("Tab.Screenshot: %s", err)
+		t.log.Error("Tab.Screenshot", "err", err)
 		return err
 	}
 
 	// save
 	if err := ioutil.WriteFile(saveAs, img, 0644); err != nil {
-		Log("Tab.Screenshot: %s", err)
+		t.log.Error("Tab.Screenshot", "err", err)
 		return err
 	}
 
 	return nil
 }
-
-// Snapshot page in mhtml format
-// uses Page.captureSnapshot
-func (t *Tab) Snapshot(saveAs string) error {
-	res, err := t.PageCaptureSnapshot("mhtml")
-	if err != nil {
-		return fmt.Errorf("Tab.Snapshot: %w", err)
-	}
-
-	// save
-	if err := ioutil.WriteFile(saveAs, []byte(res.Data), 0644); err != nil {
-		Log("Tab.Snapshot: %s", err)
-	} else {
-		Log("Tab.Snapshot: %s", saveAs)
-	}
-
-	return nil
-}
@@ -0,0 +1,14 @@
+package gochrome
+
+// Ptr gives a pointer to v
+// use it to set optional command parameters
+// including false and 0 which would otherwise be left out
+//
+//	tab.Page().CaptureScreenshot(page.CaptureScreenshotParams{
+//		Format:      gochrome.Ptr(page.CaptureScreenshotFormatJpeg),
+//		Quality:     gochrome.Ptr(0),
+//		FromSurface: gochrome.Ptr(false),
+//	})
+func Ptr[T any](v T) *T {
+	return &v
+}
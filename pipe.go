@@ -0,0 +1,76 @@
+package gochrome
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+// pipeTransport talks to chrome over --remote-debugging-pipe
+// chrome reads commands from fd 3 and writes to fd 4
+// each message is JSON followed by a NUL byte
+type pipeTransport struct {
+	// we write commands here (fd 3 in chrome)
+	w *os.File
+	// we read messages here (fd 4 in chrome)
+	r  *os.File
+	br *bufio.Reader
+	// ends of the pipes that belong to chrome
+	childR *os.File
+	childW *os.File
+}
+
+// make the pipes and pass them to the chrome process
+// must be called before the process starts
+func (b *Browser) newPipeTransport() (*pipeTransport, error) {
+	childR, w, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("os.Pipe: %w", err)
+	}
+	r, childW, err := os.Pipe()
+	if err != nil {
+		childR.Close()
+		w.Close()
+		return nil, fmt.Errorf("os.Pipe: %w", err)
+	}
+
+	// ExtraFiles start at fd 3
+	b.cmd.ExtraFiles = []*os.File{childR, childW}
+
+	return &pipeTransport{
+		w:      w,
+		r:      r,
+		br:     bufio.NewReader(r),
+		childR: childR,
+		childW: childW,
+	}, nil
+}
+
+// close our copies of chrome's ends once chrome has them
+// so we see EOF when chrome exits
+func (t *pipeTransport) started() {
+	t.childR.Close()
+	t.childW.Close()
+}
+
+func (t *pipeTransport) Receive() ([]byte, error) {
+	data, err := t.br.ReadBytes(0)
+	if err != nil {
+		return nil, err
+	}
+	return data[:len(data)-1], nil
+}
+
+func (t *pipeTransport) Send(data []byte) error {
+	_, err := t.w.Write(append(data, 0))
+	return err
+}
+
+func (t *pipeTransport) Close() error {
+	werr := t.w.Close()
+	rerr := t.r.Close()
+	if werr != nil {
+		return werr
+	}
+	return rerr
+}
@@ -0,0 +1,90 @@
+package gochrome
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/bobbytrapz/gochrome/cdp/target"
+)
+
+func TestPipeTransport(t *testing.T) {
+	// chrome's side of the pipes
+	chromeR, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, chromeW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pipe := &pipeTransport{w: w, r: r, br: bufio.NewReader(r)}
+
+	// fake chrome reads NUL-delimited commands and answers the same way
+	go func() {
+		defer chromeW.Close()
+		br := bufio.NewReader(chromeR)
+		for {
+			data, err := br.ReadBytes(0)
+			if err != nil {
+				return
+			}
+			var cmd fakeCommand
+			if err := json.Unmarshal(data[:len(data)-1], &cmd); err != nil {
+				t.Errorf("command was not NUL-delimited JSON: %q", data)
+				return
+			}
+			res, _ := json.Marshal(map[string]interface{}{
+				"id": cmd.ID,
+				"result": map[string]interface{}{
+					"targetInfos": []map[string]interface{}{
+						{"targetId": "page", "type": "page"},
+					},
+				},
+			})
+			chromeW.Write(append(res, 0))
+		}
+	}()
+
+	b := NewBrowser()
+	b.Flatten = true
+	b.useBrowserConn(b.newConn(pipe, nil, ""))
+
+	res, err := b.Target().Target().GetTargetsContext(context.Background(), target.GetTargetsParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.TargetInfos) != 1 || res.TargetInfos[0].TargetId != "page" {
+		t.Errorf("unexpected targets: %+v", res.TargetInfos)
+	}
+
+	chromeR.Close()
+}
+
+// the pipes are closed when chrome cannot start
+func TestPipeStartFails(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("counts fds in /proc")
+	}
+	// no chrome to find
+	t.Setenv("PATH", t.TempDir())
+	fds := func() int {
+		entries, err := os.ReadDir("/proc/self/fd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(entries)
+	}
+
+	before := fds()
+	b := NewBrowser()
+	if _, err := b.Start(context.Background(), t.TempDir(), RemoteDebuggingPipe); err == nil {
+		t.Fatal("expected chrome not to start")
+	}
+	if after := fds(); after != before {
+		t.Errorf("expected %d open files, got %d", before, after)
+	}
+}
@@ -0,0 +1,307 @@
+// Code generated by go generate; DO NOT EDIT.
+// Chrome protocol v1.3
+
+//go:build !gochrome_stable
+
+package gochrome
+
+import (
+	"github.com/bobbytrapz/gochrome/cdp/accessibility"
+	"github.com/bobbytrapz/gochrome/cdp/animation"
+	"github.com/bobbytrapz/gochrome/cdp/audits"
+	"github.com/bobbytrapz/gochrome/cdp/autofill"
+	"github.com/bobbytrapz/gochrome/cdp/backgroundservice"
+	"github.com/bobbytrapz/gochrome/cdp/bluetoothemulation"
+	"github.com/bobbytrapz/gochrome/cdp/cachestorage"
+	"github.com/bobbytrapz/gochrome/cdp/cast"
+	"github.com/bobbytrapz/gochrome/cdp/css"
+	"github.com/bobbytrapz/gochrome/cdp/deviceaccess"
+	"github.com/bobbytrapz/gochrome/cdp/deviceorientation"
+	"github.com/bobbytrapz/gochrome/cdp/domsnapshot"
+	"github.com/bobbytrapz/gochrome/cdp/domstorage"
+	"github.com/bobbytrapz/gochrome/cdp/eventbreakpoints"
+	"github.com/bobbytrapz/gochrome/cdp/extensions"
+	"github.com/bobbytrapz/gochrome/cdp/fedcm"
+	"github.com/bobbytrapz/gochrome/cdp/filesystem"
+	"github.com/bobbytrapz/gochrome/cdp/headlessexperimental"
+	"github.com/bobbytrapz/gochrome/cdp/heapprofiler"
+	"github.com/bobbytrapz/gochrome/cdp/indexeddb"
+	"github.com/bobbytrapz/gochrome/cdp/inspector"
+	"github.com/bobbytrapz/gochrome/cdp/layertree"
+	"github.com/bobbytrapz/gochrome/cdp/media"
+	"github.com/bobbytrapz/gochrome/cdp/memory"
+	"github.com/bobbytrapz/gochrome/cdp/overlay"
+	"github.com/bobbytrapz/gochrome/cdp/performancetimeline"
+	"github.com/bobbytrapz/gochrome/cdp/preload"
+	"github.com/bobbytrapz/gochrome/cdp/pwa"
+	"github.com/bobbytrapz/gochrome/cdp/serviceworker"
+	"github.com/bobbytrapz/gochrome/cdp/storage"
+	"github.com/bobbytrapz/gochrome/cdp/systeminfo"
+	"github.com/bobbytrapz/gochrome/cdp/tethering"
+	"github.com/bobbytrapz/gochrome/cdp/webaudio"
+	"github.com/bobbytrapz/gochrome/cdp/webauthn"
+)
+
+// Accessibility gives the Accessibility domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Accessibility() accessibility.Client {
+	return accessibility.New(t)
+}
+
+// Animation gives the Animation domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Animation() animation.Client {
+	return animation.New(t)
+}
+
+// Audits gives the Audits domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Audits() audits.Client {
+	return audits.New(t)
+}
+
+// Extensions gives the Extensions domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Extensions() extensions.Client {
+	return extensions.New(t)
+}
+
+// Autofill gives the Autofill domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Autofill() autofill.Client {
+	return autofill.New(t)
+}
+
+// BackgroundService gives the BackgroundService domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) BackgroundService() backgroundservice.Client {
+	return backgroundservice.New(t)
+}
+
+// CSS gives the CSS domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) CSS() css.Client {
+	return css.New(t)
+}
+
+// CacheStorage gives the CacheStorage domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) CacheStorage() cachestorage.Client {
+	return cachestorage.New(t)
+}
+
+// Cast gives the Cast domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Cast() cast.Client {
+	return cast.New(t)
+}
+
+// EventBreakpoints gives the EventBreakpoints domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) EventBreakpoints() eventbreakpoints.Client {
+	return eventbreakpoints.New(t)
+}
+
+// DOMSnapshot gives the DOMSnapshot domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) DOMSnapshot() domsnapshot.Client {
+	return domsnapshot.New(t)
+}
+
+// DOMStorage gives the DOMStorage domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) DOMStorage() domstorage.Client {
+	return domstorage.New(t)
+}
+
+// DeviceOrientation gives the DeviceOrientation domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) DeviceOrientation() deviceorientation.Client {
+	return deviceorientation.New(t)
+}
+
+// HeadlessExperimental gives the HeadlessExperimental domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) HeadlessExperimental() headlessexperimental.Client {
+	return headlessexperimental.New(t)
+}
+
+// FileSystem gives the FileSystem domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) FileSystem() filesystem.Client {
+	return filesystem.New(t)
+}
+
+// IndexedDB gives the IndexedDB domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) IndexedDB() indexeddb.Client {
+	return indexeddb.New(t)
+}
+
+// Inspector gives the Inspector domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Inspector() inspector.Client {
+	return inspector.New(t)
+}
+
+// LayerTree gives the LayerTree domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) LayerTree() layertree.Client {
+	return layertree.New(t)
+}
+
+// Memory gives the Memory domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Memory() memory.Client {
+	return memory.New(t)
+}
+
+// Overlay gives the Overlay domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Overlay() overlay.Client {
+	return overlay.New(t)
+}
+
+// PerformanceTimeline gives the PerformanceTimeline domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) PerformanceTimeline() performancetimeline.Client {
+	return performancetimeline.New(t)
+}
+
+// ServiceWorker gives the ServiceWorker domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) ServiceWorker() serviceworker.Client {
+	return serviceworker.New(t)
+}
+
+// Storage gives the Storage domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Storage() storage.Client {
+	return storage.New(t)
+}
+
+// SystemInfo gives the SystemInfo domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) SystemInfo() systeminfo.Client {
+	return systeminfo.New(t)
+}
+
+// Tethering gives the Tethering domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Tethering() tethering.Client {
+	return tethering.New(t)
+}
+
+// WebAudio gives the WebAudio domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) WebAudio() webaudio.Client {
+	return webaudio.New(t)
+}
+
+// WebAuthn gives the WebAuthn domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) WebAuthn() webauthn.Client {
+	return webauthn.New(t)
+}
+
+// Media gives the Media domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Media() media.Client {
+	return media.New(t)
+}
+
+// DeviceAccess gives the DeviceAccess domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) DeviceAccess() deviceaccess.Client {
+	return deviceaccess.New(t)
+}
+
+// Preload gives the Preload domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) Preload() preload.Client {
+	return preload.New(t)
+}
+
+// FedCm gives the FedCm domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) FedCm() fedcm.Client {
+	return fedcm.New(t)
+}
+
+// PWA gives the PWA domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) PWA() pwa.Client {
+	return pwa.New(t)
+}
+
+// BluetoothEmulation gives the BluetoothEmulation domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) BluetoothEmulation() bluetoothemulation.Client {
+	return bluetoothemulation.New(t)
+}
+
+// HeapProfiler gives the HeapProfiler domain of the tab
+//
+// Experimental: this may change or be removed in any chrome release
+func (t *Tab) HeapProfiler() heapprofiler.Client {
+	return heapprofiler.New(t)
+}
+
+func init() {
+	eventDecoders["Accessibility"] = accessibility.DecodeEvent
+	eventDecoders["Animation"] = animation.DecodeEvent
+	eventDecoders["Audits"] = audits.DecodeEvent
+	eventDecoders["Autofill"] = autofill.DecodeEvent
+	eventDecoders["BackgroundService"] = backgroundservice.DecodeEvent
+	eventDecoders["CSS"] = css.DecodeEvent
+	eventDecoders["Cast"] = cast.DecodeEvent
+	eventDecoders["DOMStorage"] = domstorage.DecodeEvent
+	eventDecoders["Inspector"] = inspector.DecodeEvent
+	eventDecoders["LayerTree"] = layertree.DecodeEvent
+	eventDecoders["Overlay"] = overlay.DecodeEvent
+	eventDecoders["PerformanceTimeline"] = performancetimeline.DecodeEvent
+	eventDecoders["ServiceWorker"] = serviceworker.DecodeEvent
+	eventDecoders["Storage"] = storage.DecodeEvent
+	eventDecoders["Tethering"] = tethering.DecodeEvent
+	eventDecoders["WebAudio"] = webaudio.DecodeEvent
+	eventDecoders["WebAuthn"] = webauthn.DecodeEvent
+	eventDecoders["Media"] = media.DecodeEvent
+	eventDecoders["DeviceAccess"] = deviceaccess.DecodeEvent
+	eventDecoders["Preload"] = preload.DecodeEvent
+	eventDecoders["FedCm"] = fedcm.DecodeEvent
+	eventDecoders["BluetoothEmulation"] = bluetoothemulation.DecodeEvent
+	eventDecoders["HeapProfiler"] = heapprofiler.DecodeEvent
+}
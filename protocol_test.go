@@ -0,0 +1,200 @@
+package gochrome
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+	"github.com/bobbytrapz/gochrome/cdp/fetch"
+	"github.com/bobbytrapz/gochrome/cdp/network"
+	"github.com/bobbytrapz/gochrome/cdp/page"
+	"github.com/bobbytrapz/gochrome/cdp/runtime"
+	"github.com/bobbytrapz/gochrome/cdp/security"
+)
+
+func TestGeneratedStructs(t *testing.T) {
+	data := `{
+		"type": "object",
+		"subtype": "node",
+		"objectId": "1.2",
+		"preview": {
+			"type": "object",
+			"overflow": false,
+			"properties": [{"name": "a", "type": "number", "value": "1"}]
+		}
+	}`
+	var obj runtime.RemoteObject
+	if err := json.Unmarshal([]byte(data), &obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.Subtype == nil || *obj.Subtype != "node" {
+		t.Errorf("expected subtype node, got %v", obj.Subtype)
+	}
+	if obj.ClassName != nil {
+		t.Errorf("expected missing className to be nil, got %q", *obj.ClassName)
+	}
+	if obj.ObjectId == nil || *obj.ObjectId != "1.2" {
+		t.Errorf("expected objectId, got %v", obj.ObjectId)
+	}
+	// typed slices of refs
+	if obj.Preview == nil || len(obj.Preview.Properties) != 1 || obj.Preview.Properties[0].Name != "a" {
+		t.Errorf("unexpected preview: %+v", obj.Preview)
+	}
+
+	// optional fields are left out
+	out, err := json.Marshal(runtime.CallArgument{Value: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"value":1}` {
+		t.Errorf("unexpected json: %s", out)
+	}
+
+	// cross-domain references
+	var res network.Response
+	if err := json.Unmarshal([]byte(`{"url": "https://example.com/", "securityState": "secure", "securityDetails": {"protocol": "TLS 1.3"}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	var state security.SecurityState = res.SecurityState
+	if state != "secure" || res.SecurityDetails == nil || res.SecurityDetails.Protocol != "TLS 1.3" {
+		t.Errorf("unexpected response: %+v", res)
+	}
+}
+
+func TestEnums(t *testing.T) {
+	values := network.ResourceType("").Values()
+	if len(values) == 0 || values[0] != network.ResourceTypeDocument {
+		t.Errorf("unexpected values: %v", values)
+	}
+	for _, v := range values {
+		if !v.Valid() {
+			t.Errorf("expected %q to be valid", v)
+		}
+	}
+	if network.ResourceType("Document ").Valid() {
+		t.Error("expected an invalid value")
+	}
+	// values that are not identifiers
+	if page.TransitionTypeAutoBookmark != "auto_bookmark" || page.CaptureScreenshotFormatPng != "png" {
+		t.Error("unexpected constants")
+	}
+
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return struct{}{}, nil
+	})
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(mt)
+
+	// inline enums are checked before sending
+	_, err := tab.Page().CaptureScreenshot(page.CaptureScreenshotParams{Format: Ptr[page.CaptureScreenshotFormat]("gif")})
+	if err == nil {
+		t.Error("expected an invalid format")
+	}
+	_, err = tab.Page().CaptureScreenshot(page.CaptureScreenshotParams{Format: Ptr(page.CaptureScreenshotFormatWebp)})
+	if err != nil {
+		t.Error(err)
+	}
+	// optional enums may be left out
+	_, err = tab.Page().CaptureScreenshot(page.CaptureScreenshotParams{})
+	if err != nil {
+		t.Error(err)
+	}
+	if n := len(mt.commands()); n != 2 {
+		t.Errorf("expected 2 commands sent, got %d", n)
+	}
+
+	mt.Close()
+	b.Wait()
+}
+
+func TestParams(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return struct{}{}, nil
+	})
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(mt)
+
+	// false and 0 are sent when set; nil is left out
+	_, err := tab.Page().CaptureScreenshot(page.CaptureScreenshotParams{
+		Quality:     Ptr(0),
+		FromSurface: Ptr(false),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// commands without parameters send none
+	if _, err := tab.Page().Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	cmds := mt.commands()
+	if len(cmds) != 2 {
+		t.Fatalf("expected 2 commands sent, got %d", len(cmds))
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal(cmds[0].Params, &params); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"quality": 0.0, "fromSurface": false}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("expected %v, got %v", want, params)
+	}
+	if len(cmds[1].Params) != 0 {
+		t.Errorf("expected no params, got %s", cmds[1].Params)
+	}
+
+	// an empty slice is sent; empty patterns pause every request
+	data, err := cdp.Marshal(&fetch.EnableParams{Patterns: []fetch.RequestPattern{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"patterns":[]}` {
+		t.Errorf("expected empty patterns to be sent, got %s", data)
+	}
+	if data, _ := json.Marshal(fetch.EnableParams{}); string(data) != `{}` {
+		t.Errorf("expected nil patterns to be left out, got %s", data)
+	}
+
+	mt.Close()
+	b.Wait()
+}
+
+func TestDomainPackages(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return struct{}{}, nil
+	})
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(mt)
+
+	// each domain sends its own commands through the tab
+	if _, err := tab.Fetch().Enable(fetch.EnableParams{}); err != nil {
+		t.Fatal(err)
+	}
+	if cmds := mt.commands(); len(cmds) != 1 || cmds[0].Method != "Fetch.enable" {
+		t.Errorf("unexpected commands: %+v", cmds)
+	}
+
+	// types shared between domains are the same type in each
+	paused := make(chan fetch.RequestPausedEvent, 1)
+	tab.Fetch().OnRequestPaused(func(ev fetch.RequestPausedEvent) {
+		paused <- ev
+	})
+	err := tab.HandleEvent("Fetch.requestPaused", json.RawMessage(`{"requestId": "1", "request": {"url": "https://example.com/"}, "frameId": "main", "resourceType": "Document"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := <-paused
+	var rt network.ResourceType = ev.ResourceType
+	var frame page.FrameId = ev.FrameId
+	if rt != network.ResourceTypeDocument || frame != "main" || ev.Request.Url != "https://example.com/" {
+		t.Errorf("unexpected event: %+v", ev)
+	}
+
+	if _, err := decodeEvent("Nothing.happened", nil); err != cdp.ErrUnknownEvent {
+		t.Errorf("expected cdp.ErrUnknownEvent, got %v", err)
+	}
+
+	mt.Close()
+	b.Wait()
+}
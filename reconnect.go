@@ -0,0 +1,128 @@
+package gochrome
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Reconnect is how a tab redials its websocket after it drops
+// commands in flight when it drops fail with ErrConnectionReset
+// commands sent while reconnecting wait for the new websocket
+// event subscriptions are kept and enabled domains are enabled again
+// other state such as a user agent override is lost
+type Reconnect struct {
+	// give up after this many attempts and end the tab
+	// 0 keeps trying until the browser exits
+	Attempts int
+	// wait before the first attempt
+	// doubles after each failed attempt up to MaxDelay
+	// 100ms if zero
+	MinDelay time.Duration
+	// 10s if zero
+	MaxDelay time.Duration
+}
+
+func (r Reconnect) minDelay() time.Duration {
+	if r.MinDelay <= 0 {
+		return 100 * time.Millisecond
+	}
+	return r.MinDelay
+}
+
+func (r Reconnect) maxDelay() time.Duration {
+	if r.MaxDelay <= 0 {
+		return 10 * time.Second
+	}
+	return r.MaxDelay
+}
+
+// redial after the transport dropped with cause
+// sends wait until we are done
+func (c *conn) reconnect(b *Browser, cause error) (Transport, error) {
+	c.tm.Lock()
+	defer c.tm.Unlock()
+
+	c.t.Close()
+	c.failAll(func(method string) error {
+		return fmt.Errorf("%s: %w: %w", method, ErrConnectionReset, cause)
+	})
+
+	delay := c.policy.minDelay()
+	for attempt := 1; c.policy.Attempts == 0 || attempt <= c.policy.Attempts; attempt++ {
+		select {
+		case <-time.After(delay):
+		case <-c.closed:
+			c.stop()
+			return nil, cause
+		case <-b.exit:
+			c.stop()
+			return nil, cause
+		}
+
+		t, err := c.redial()
+		if err != nil {
+			c.log.Warn("reconnect failed", "attempt", attempt, "err", err)
+			delay *= 2
+			if delay > c.policy.maxDelay() {
+				delay = c.policy.maxDelay()
+			}
+			continue
+		}
+
+		c.log.Info("reconnected", "attempt", attempt)
+		c.t = t
+		for _, tab := range c.allSessions() {
+			go tab.restore()
+		}
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("reconnect: gave up after %d attempts: %w", c.policy.Attempts, cause)
+}
+
+// domain enabled on a tab
+type enabledDomain struct {
+	domain string
+	params interface{}
+}
+
+// remember which domains are enabled so we can enable them after reconnecting
+func (t *Tab) trackDomain(method string, params interface{}) {
+	if t.conn.redial == nil {
+		return
+	}
+	domain, action, _ := strings.Cut(method, ".")
+	if action != "enable" && action != "disable" {
+		return
+	}
+
+	t.dm.Lock()
+	defer t.dm.Unlock()
+	for i, d := range t.enabled {
+		if d.domain == domain {
+			t.enabled = append(t.enabled[:i:i], t.enabled[i+1:]...)
+			break
+		}
+	}
+	if action == "enable" {
+		t.enabled = append(t.enabled, enabledDomain{domain: domain, params: params})
+	}
+}
+
+// enable the domains the tab had enabled before reconnecting
+func (t *Tab) restore() {
+	t.dm.Lock()
+	enabled := append([]enabledDomain(nil), t.enabled...)
+	t.dm.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), WaitForTabConnect)
+	defer cancel()
+	for _, d := range enabled {
+		err := t.Call(ctx, d.domain+".enable", d.params, nil)
+		if err != nil {
+			t.log.Error("could not enable domain after reconnecting", "domain", d.domain, "err", err)
+		}
+	}
+}
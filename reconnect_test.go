@@ -0,0 +1,183 @@
+package gochrome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReconnect(t *testing.T) {
+	handle := func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		if cmd.Method == "Page.slow" {
+			// never answered
+			return nil, nil
+		}
+		return struct{}{}, nil
+	}
+
+	// each dial gets the next transport
+	dialer := func(transports ...*memTransport) func(string) (Transport, error) {
+		var m sync.Mutex
+		return func(wsURL string) (Transport, error) {
+			m.Lock()
+			defer m.Unlock()
+			if len(transports) == 0 {
+				return nil, errors.New("connection refused")
+			}
+			mt := transports[0]
+			transports = transports[1:]
+			return mt, nil
+		}
+	}
+
+	waitFor := func(mt *memTransport, method string) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for time.Now().Before(deadline) {
+			for _, cmd := range mt.commands() {
+				if cmd.Method == method {
+					return
+				}
+			}
+			time.Sleep(time.Millisecond)
+		}
+		t.Fatalf("%s was not sent", method)
+	}
+
+	t.Run("reconnects", func(t *testing.T) {
+		first, second := newMemTransport(handle), newMemTransport(handle)
+		b := NewBrowser()
+		b.Dial = dialer(first, second)
+		b.Reconnect = &Reconnect{MinDelay: time.Millisecond}
+
+		tab, err := b.connectTab(tabConnectionInfo{ID: "tab1", WebSocketDebuggerURL: "ws://fake"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tab.Call(context.Background(), "Network.enable", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		got := make(chan interface{}, 1)
+		tab.On("Network.dataReceived", func(ev interface{}) {
+			got <- ev
+		})
+
+		errc := make(chan error, 1)
+		go func() {
+			errc <- tab.Call(context.Background(), "Page.slow", nil, nil)
+		}()
+		waitFor(first, "Page.slow")
+		first.Close()
+
+		select {
+		case err := <-errc:
+			if !errors.Is(err, ErrConnectionReset) {
+				t.Errorf("expected ErrConnectionReset, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("in-flight command was not failed")
+		}
+
+		// domains are enabled again on the new connection
+		waitFor(second, "Network.enable")
+
+		// subscriptions are kept
+		second.emit("", "Network.dataReceived", map[string]interface{}{"requestId": "1"})
+		select {
+		case <-got:
+		case <-time.After(time.Second):
+			t.Fatal("event was not delivered after reconnecting")
+		}
+
+		if err := tab.Call(context.Background(), "Page.enable", nil, nil); err != nil {
+			t.Errorf("expected the tab to work, got %v", err)
+		}
+		select {
+		case <-tab.Done():
+			t.Errorf("tab ended: %v", tab.Err())
+		default:
+		}
+
+		// a closed page does not reconnect
+		second.emit("", "Inspector.detached", map[string]interface{}{"reason": "target_closed"})
+		b.Wait()
+		if !errors.Is(tab.Err(), ErrTabDetached) {
+			t.Errorf("expected ErrTabDetached, got %v", tab.Err())
+		}
+	})
+
+	t.Run("drops failed commands", func(t *testing.T) {
+		first := newMemTransport(handle)
+		b := NewBrowser()
+		b.Dial = dialer(first)
+
+		tab, err := b.connectTab(tabConnectionInfo{ID: "tab1", WebSocketDebuggerURL: "ws://fake"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := tab.conn
+
+		// the writer waits on the transport while a reconnect holds it
+		c.tm.Lock()
+		errc := make(chan error, 1)
+		go func() {
+			errc <- tab.Call(context.Background(), "Page.navigate", nil, nil)
+		}()
+		for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+			c.rw.RLock()
+			n := len(c.returns)
+			c.rw.RUnlock()
+			if n > 0 {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatal("command was not queued")
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		c.failAll(func(method string) error {
+			return fmt.Errorf("%s: %w", method, ErrConnectionReset)
+		})
+		c.tm.Unlock()
+
+		if err := <-errc; !errors.Is(err, ErrConnectionReset) {
+			t.Errorf("expected ErrConnectionReset, got %v", err)
+		}
+		if err := tab.Call(context.Background(), "Page.enable", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		for _, cmd := range first.commands() {
+			if cmd.Method == "Page.navigate" {
+				t.Error("failed command was sent")
+			}
+		}
+		first.Close()
+		b.Wait()
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		first := newMemTransport(handle)
+		b := NewBrowser()
+		b.Dial = dialer(first)
+		b.Reconnect = &Reconnect{Attempts: 2, MinDelay: time.Millisecond}
+
+		tab, err := b.connectTab(tabConnectionInfo{ID: "tab1", WebSocketDebuggerURL: "ws://fake"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		first.Close()
+
+		select {
+		case <-tab.Done():
+		case <-time.After(time.Second):
+			t.Fatal("tab did not end")
+		}
+		if tab.Err() == nil {
+			t.Error("expected an error")
+		}
+		b.Wait()
+	})
+}
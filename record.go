@@ -0,0 +1,385 @@
+package gochrome
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// directions of a RecordedMessage
+const (
+	RecordSend    = "send"
+	RecordReceive = "receive"
+)
+
+// RecordedMessage is a single line written by a Recorder
+type RecordedMessage struct {
+	Time time.Time `json:"time"`
+	// RecordSend or RecordReceive
+	Direction string `json:"direction"`
+	// command id for commands and responses
+	ID int `json:"id,omitempty"`
+	// command or event method
+	Method    string          `json:"method,omitempty"`
+	SessionID string          `json:"sessionId,omitempty"`
+	Message   json.RawMessage `json:"message"`
+}
+
+// Recorder is a Transport that writes every message it carries as JSONL
+// use it with Browser.Dial
+//
+//	b.Dial = func(wsURL string) (gochrome.Transport, error) {
+//		t, err := gochrome.DialWebSocket(wsURL)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return gochrome.NewRecorder(t, f), nil
+//	}
+type Recorder struct {
+	// reports messages that could not be recorded
+	// nil logs nothing
+	Logger *slog.Logger
+	t      Transport
+	w      io.Writer
+	wm     sync.Mutex
+}
+
+// NewRecorder records messages carried by t to w
+func NewRecorder(t Transport, w io.Writer) *Recorder {
+	return &Recorder{t: t, w: w}
+}
+
+func (r *Recorder) Send(data []byte) error {
+	r.record(RecordSend, data)
+	return r.t.Send(data)
+}
+
+func (r *Recorder) Receive() ([]byte, error) {
+	data, err := r.t.Receive()
+	if err == nil {
+		r.record(RecordReceive, data)
+	}
+	return data, err
+}
+
+func (r *Recorder) Close() error {
+	return r.t.Close()
+}
+
+func (r *Recorder) record(direction string, data []byte) {
+	var msg struct {
+		ID        int    `json:"id"`
+		Method    string `json:"method"`
+		SessionID string `json:"sessionId"`
+	}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		r.log().Error("Recorder", "err", err)
+	}
+
+	// keep html in bodies readable
+	var line bytes.Buffer
+	enc := json.NewEncoder(&line)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(RecordedMessage{
+		Time:      time.Now(),
+		Direction: direction,
+		ID:        msg.ID,
+		Method:    msg.Method,
+		SessionID: msg.SessionID,
+		Message:   data,
+	})
+	if err != nil {
+		r.log().Error("Recorder", "err", err)
+		return
+	}
+
+	r.wm.Lock()
+	defer r.wm.Unlock()
+	if _, err := r.w.Write(line.Bytes()); err != nil {
+		r.log().Error("Recorder", "err", err)
+	}
+}
+
+func (r *Recorder) log() *slog.Logger {
+	if r.Logger == nil {
+		return discardLogger
+	}
+	return r.Logger
+}
+
+// DivergenceError is how a ReplayTransport reports a command
+// that does not match the recording
+type DivergenceError struct {
+	// the command that was sent
+	Method string
+	Params json.RawMessage
+	// the next command in the recording that has not been replayed
+	// empty if the recording is used up
+	Expected string
+}
+
+func (e *DivergenceError) Error() string {
+	if e.Expected == "" {
+		return fmt.Sprintf("replay: divergence: %s %s was sent after the recording ended", e.Method, e.Params)
+	}
+	return fmt.Sprintf("replay: divergence: %s %s was not recorded; next recorded command is %s", e.Method, e.Params, e.Expected)
+}
+
+// ReplayTransport is a Transport that serves a recording back to a Tab
+// no chrome is needed
+//
+// commands are matched to the recording by method and params
+// events and responses are sent back in recorded order
+// replay waits at each recorded command until it is sent
+// a command sent out of order gets its response right away
+// a command that does not match gets a *ProtocolError
+// and is kept in Divergences
+type ReplayTransport struct {
+	// reports divergences
+	// nil logs nothing
+	Logger    *slog.Logger
+	recording []RecordedMessage
+	// true once a recorded command is matched
+	// or a recorded response has been sent early
+	matched []bool
+	// next recording entry to replay
+	next int
+	// recorded command id to the id we were sent
+	ids         map[int]int
+	divergences []*DivergenceError
+	// messages ready for Receive
+	ready  [][]byte
+	notify chan struct{}
+	closed chan struct{}
+	once   sync.Once
+	m      sync.Mutex
+}
+
+// NewReplayTransport reads a recording written by a Recorder
+func NewReplayTransport(r io.Reader) (*ReplayTransport, error) {
+	rt := &ReplayTransport{
+		ids:    make(map[int]int),
+		notify: make(chan struct{}, 1),
+		closed: make(chan struct{}),
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var msg RecordedMessage
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			return nil, fmt.Errorf("replay: line %d: %w", line, err)
+		}
+		rt.recording = append(rt.recording, msg)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("replay: %w", err)
+	}
+	rt.matched = make([]bool, len(rt.recording))
+
+	// events sent before any command
+	rt.m.Lock()
+	rt.advance()
+	rt.m.Unlock()
+
+	return rt, nil
+}
+
+// Divergences gives every command that did not match the recording
+func (rt *ReplayTransport) Divergences() []*DivergenceError {
+	rt.m.Lock()
+	defer rt.m.Unlock()
+	return append([]*DivergenceError(nil), rt.divergences...)
+}
+
+// Done is true once the whole recording has been replayed
+func (rt *ReplayTransport) Done() bool {
+	rt.m.Lock()
+	defer rt.m.Unlock()
+	return rt.next == len(rt.recording)
+}
+
+func (rt *ReplayTransport) Send(data []byte) error {
+	var cmd struct {
+		ID        int             `json:"id"`
+		Method    string          `json:"method"`
+		Params    json.RawMessage `json:"params"`
+		SessionID string          `json:"sessionId"`
+	}
+	if err := json.Unmarshal(data, &cmd); err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+
+	rt.m.Lock()
+	defer rt.m.Unlock()
+
+	for i := rt.next; i < len(rt.recording); i++ {
+		rec := rt.recording[i]
+		if rec.Direction != RecordSend || rt.matched[i] || rec.Method != cmd.Method {
+			continue
+		}
+		var recorded struct {
+			Params json.RawMessage `json:"params"`
+		}
+		json.Unmarshal(rec.Message, &recorded)
+		if !sameJSON(recorded.Params, cmd.Params) {
+			continue
+		}
+		rt.matched[i] = true
+		rt.ids[rec.ID] = cmd.ID
+		if i != rt.next {
+			// sent out of order so the response cannot wait its turn
+			rt.respond(i)
+		}
+		rt.advance()
+		return nil
+	}
+
+	// tell the caller what went wrong
+	div := &DivergenceError{Method: cmd.Method, Params: cmd.Params}
+	for i := rt.next; i < len(rt.recording); i++ {
+		rec := rt.recording[i]
+		if rec.Direction == RecordSend && !rt.matched[i] {
+			div.Expected = rec.Method
+			break
+		}
+	}
+	rt.divergences = append(rt.divergences, div)
+	rt.log().Warn("replay: divergence", "method", div.Method, "expected", div.Expected)
+
+	res := map[string]interface{}{
+		"id": cmd.ID,
+		"error": map[string]interface{}{
+			"code":    -32000,
+			"message": div.Error(),
+		},
+	}
+	if cmd.SessionID != "" {
+		res["sessionId"] = cmd.SessionID
+	}
+	msg, _ := json.Marshal(res)
+	rt.push(msg)
+
+	return nil
+}
+
+// replay entries until we reach a command that has not been sent yet
+func (rt *ReplayTransport) advance() {
+	for ; rt.next < len(rt.recording); rt.next++ {
+		rec := rt.recording[rt.next]
+		if rec.Direction == RecordSend {
+			if !rt.matched[rt.next] {
+				return
+			}
+			continue
+		}
+		if rt.matched[rt.next] {
+			// response was already sent
+			continue
+		}
+		rt.replay(rt.next)
+	}
+}
+
+// send the recorded response to the command at i right away
+func (rt *ReplayTransport) respond(i int) {
+	id := rt.recording[i].ID
+	for j := i + 1; j < len(rt.recording); j++ {
+		rec := rt.recording[j]
+		if rec.Direction == RecordReceive && rec.ID == id && !rt.matched[j] {
+			rt.replay(j)
+			rt.matched[j] = true
+			return
+		}
+	}
+}
+
+// send a recorded message
+func (rt *ReplayTransport) replay(i int) {
+	rec := rt.recording[i]
+	msg := rec.Message
+	if rec.ID != 0 {
+		// response gets the id we were sent
+		var res map[string]json.RawMessage
+		if err := json.Unmarshal(msg, &res); err != nil {
+			rt.log().Error("replay", "err", err)
+			return
+		}
+		res["id"], _ = json.Marshal(rt.ids[rec.ID])
+		msg, _ = json.Marshal(res)
+	}
+	rt.push(msg)
+}
+
+func (rt *ReplayTransport) log() *slog.Logger {
+	if rt.Logger == nil {
+		return discardLogger
+	}
+	return rt.Logger
+}
+
+func (rt *ReplayTransport) push(msg []byte) {
+	rt.ready = append(rt.ready, msg)
+	select {
+	case rt.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (rt *ReplayTransport) Receive() ([]byte, error) {
+	for {
+		rt.m.Lock()
+		if len(rt.ready) > 0 {
+			msg := rt.ready[0]
+			rt.ready = rt.ready[1:]
+			rt.m.Unlock()
+			return msg, nil
+		}
+		rt.m.Unlock()
+
+		select {
+		case <-rt.notify:
+		case <-rt.closed:
+			return nil, io.EOF
+		}
+	}
+}
+
+func (rt *ReplayTransport) Close() error {
+	rt.once.Do(func() {
+		close(rt.closed)
+	})
+	return nil
+}
+
+// compare JSON by value
+// missing and empty params are the same
+func sameJSON(a, b json.RawMessage) bool {
+	var va, vb interface{}
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+	if m, ok := va.(map[string]interface{}); ok && len(m) == 0 {
+		va = nil
+	}
+	if m, ok := vb.(map[string]interface{}); ok && len(m) == 0 {
+		vb = nil
+	}
+	return reflect.DeepEqual(va, vb)
+}
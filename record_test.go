@@ -0,0 +1,123 @@
+package gochrome
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// replay testdata/replay.jsonl to a tab
+func replayTab(t *testing.T) (*Tab, *ReplayTransport) {
+	t.Helper()
+
+	f, err := os.Open("testdata/replay.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	rt, err := NewReplayTransport(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		rt.Close()
+	})
+
+	b := NewBrowser()
+	b.EventQueue = &EventQueue{Size: 16}
+	return b.NewTabWithTransport(rt), rt
+}
+
+func TestReplay(t *testing.T) {
+	t.Run("replays", func(t *testing.T) {
+		tab, rt := replayTab(t)
+
+		resources := make(chan HTTPResource, 1)
+		_, err := tab.OnResource(func(res HTTPResource) {
+			resources <- res
+		}, "Document")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := tab.Goto("https://example.com/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.FrameId != "F1" {
+			t.Errorf("expected frameId F1, got %q", res.FrameId)
+		}
+
+		select {
+		case r := <-resources:
+			if r.Response.Url != "https://example.com/" || !strings.Contains(r.Body, "Example Domain") {
+				t.Errorf("unexpected resource: %+v", r)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("OnResource was not called")
+		}
+
+		title, err := tab.Evaluate("document.title")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if title.Result.Value != "Example Domain" {
+			t.Errorf("unexpected title: %+v", title.Result)
+		}
+
+		if !rt.Done() {
+			t.Error("expected the whole recording to be replayed")
+		}
+		if divs := rt.Divergences(); len(divs) != 0 {
+			t.Errorf("unexpected divergences: %v", divs)
+		}
+	})
+
+	t.Run("divergence", func(t *testing.T) {
+		tab, rt := replayTab(t)
+
+		_, err := tab.Goto("https://example.org/")
+		var perr *ProtocolError
+		if !errors.As(err, &perr) || !strings.Contains(perr.Message, "next recorded command is Network.enable") {
+			t.Fatalf("expected a divergence, got %v", err)
+		}
+		divs := rt.Divergences()
+		if len(divs) != 1 || divs[0].Method != "Page.navigate" || divs[0].Expected != "Network.enable" {
+			t.Errorf("unexpected divergences: %v", divs)
+		}
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return map[string]interface{}{"frameId": "main"}, nil
+	})
+	var buf bytes.Buffer
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(NewRecorder(mt, &buf))
+	if _, err := tab.Goto("about:blank"); err != nil {
+		t.Fatal(err)
+	}
+	mt.Close()
+	b.Wait()
+
+	// the recording replays
+	rt, err := NewReplayTransport(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rt.recording) != 2 {
+		t.Fatalf("expected a command and a response, got %+v", rt.recording)
+	}
+	send, receive := rt.recording[0], rt.recording[1]
+	if send.Direction != RecordSend || send.Method != "Page.navigate" || send.ID != 1 {
+		t.Errorf("unexpected command: %+v", send)
+	}
+	if receive.Direction != RecordReceive || receive.ID != 1 || receive.Time.IsZero() {
+		t.Errorf("unexpected response: %+v", receive)
+	}
+}
@@ -11,18 +11,25 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 )
 
 const (
 	TemporaryUserProfileDirectory = ""
 	DefaultPort                   = 44144
+	// RemoteDebuggingPipe given as the port talks to chrome over
+	// --remote-debugging-pipe instead of a tcp port
+	// tabs always use flattened sessions
+	RemoteDebuggingPipe = -1
 )
 
 // WaitForOpen decides how long we wait for chrome to open
 var WaitForOpen = 20 * time.Second
 
 // Wait for chrome to close
+// for a browser we attached to with Connect or ConnectWebSocket
+// Wait returns once we have disconnected
 func (b *Browser) Wait() {
 	b.wg.Wait()
 }
@@ -43,10 +50,10 @@ func (b *Browser) start(ctx context.Context, userProfileDir string, port int, sh
 	if userProfileDir == TemporaryUserProfileDirectory {
 		tmpDir, err = ioutil.TempDir("", "gochrome-chrome-profile")
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("ioutil.TempDir: %w", err)
 		}
 		userProfileDir = tmpDir
-	} else if userProfileDir[:2] == "~/" {
+	} else if strings.HasPrefix(userProfileDir, "~/") {
 		var home string
 		home, err = os.UserHomeDir()
 		if err != nil {
@@ -78,12 +85,20 @@ func (b *Browser) start(ctx context.Context, userProfileDir string, port int, sh
 		"--new-window",
 		"--window-size=1280,1696",
 		fmt.Sprintf("--user-data-dir=%s", userProfileDir),
-		fmt.Sprintf("--remote-debugging-port=%d", port),
-		"about:blank",
 	)
+	if port == RemoteDebuggingPipe {
+		opts = append(opts, "--remote-debugging-pipe")
+	} else {
+		opts = append(opts, fmt.Sprintf("--remote-debugging-port=%d", port))
+	}
+	opts = append(opts, "about:blank")
 
 	switch runtime.GOOS {
 	case "darwin":
+		if port == RemoteDebuggingPipe {
+			// open does not pass the pipes on to chrome
+			return nil, fmt.Errorf("gochrome does not support RemoteDebuggingPipe on macOS.")
+		}
 		path := "/Applications/Google Chrome.app"
 		if s, err := os.Stat(path); err == nil && s.IsDir() {
 			args := []string{
@@ -114,12 +129,28 @@ func (b *Browser) start(ctx context.Context, userProfileDir string, port int, sh
 		return nil, fmt.Errorf("gochrome does not support Windows.")
 	}
 
+	var pipe *pipeTransport
+	if port == RemoteDebuggingPipe {
+		pipe, err = b.newPipeTransport()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err = b.cmd.Start(); err != nil {
+		if pipe != nil {
+			// chrome never got its ends
+			pipe.started()
+			pipe.Close()
+		}
 		return nil, fmt.Errorf("could not start chrome: %w", err)
 	}
-	Log("%s (%d) profile=%s", b.cmd.Path, b.cmd.Process.Pid, userProfileDir)
+	if pipe != nil {
+		pipe.started()
+	}
+	b.logger().Info("started chrome", "path", b.cmd.Path, "pid", b.cmd.Process.Pid, "profile", userProfileDir)
 
-	b.monitorBrowserProcess()
+	b.monitorBrowserProcess(ctx)
 
 	// handle exit
 	b.wg.Add(1)
@@ -128,42 +159,63 @@ func (b *Browser) start(ctx context.Context, userProfileDir string, port int, sh
 		defer func() {
 			if tmpDir != "" {
 				// remove temporary directory
-				Log("remove: %s", tmpDir)
+				b.logger().Info("remove", "dir", tmpDir)
 				os.RemoveAll(tmpDir)
 			}
 		}()
 		select {
 		case <-ctx.Done():
-			Log("cancel: %s", ctx.Err())
+			b.logger().Info("cancel", "err", ctx.Err())
 			return
 		case <-b.exit:
-			Log("exited")
+			b.logger().Info("exited")
 			return
 		}
 	}()
 
-	// connect to running chrome process
-	err = b.connect(ctx, fmt.Sprintf("localhost:%d", port))
-	if err != nil {
-		return nil, err
-	}
+	var tab *Tab
+	if pipe != nil {
+		// there is no http api over the pipe
+		b.Flatten = true
+		b.useBrowserConn(b.newConn(pipe, nil, ""))
 
-	// connect to first tab
-	tab, err := b.connectFirstTab(ctx)
-	if err != nil {
-		return nil, err
+		tab, err = b.waitForFirstPage(ctx)
+		if err != nil {
+			// the connection ends when its pipe closes
+			pipe.Close()
+			return nil, err
+		}
+	} else {
+		// connect to running chrome process
+		err = b.waitForBrowser(ctx, fmt.Sprintf("localhost:%d", port))
+		if err != nil {
+			return nil, err
+		}
+
+		if b.Flatten {
+			err = b.connectBrowser(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// connect to first tab
+		tab, err = b.connectFirstTab(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
-				Log("close browser")
+				b.logger().Info("close browser")
 				err := b.Close()
 				if err != nil {
-					Log("while closing browser: %s", err)
+					b.logger().Error("while closing browser", "err", err)
 				}
-				close(b.exit)
+				b.exited()
 				return
 			}
 		}
@@ -172,19 +224,20 @@ func (b *Browser) start(ctx context.Context, userProfileDir string, port int, sh
 	return tab, nil
 }
 
-func (b *Browser) connect(ctx context.Context, addr string) error {
+// wait until the browser http api answers at addr
+func (b *Browser) waitForBrowser(ctx context.Context, addr string) error {
 	b.addr = addr
 	u := url.URL{Scheme: "http", Host: b.addr, Path: "/"}
 
 	// wait for connection
-	Log("wait for connection to browser...")
+	b.logger().Info("wait for connection to browser...")
 	timeout := time.After(WaitForOpen)
 	for {
 		select {
 		case err := <-ctx.Done():
 			return fmt.Errorf("cancel: %s", err)
 		case <-timeout:
-			Log("timeout")
+			b.logger().Warn("timeout", "addr", addr)
 			return fmt.Errorf("timeout")
 		default:
 			res, err := b.performRequest(ctx, http.MethodGet, u.String())
@@ -196,26 +249,22 @@ func (b *Browser) connect(ctx context.Context, addr string) error {
 		time.Sleep(500 * time.Millisecond)
 	}
 connected:
-	Log("connected: %s", b.addr)
+	b.logger().Info("connected", "addr", b.addr)
 
 	return nil
 }
 
 func (b *Browser) connectFirstTab(ctx context.Context) (*Tab, error) {
-	res, err := b.http(ctx, http.MethodGet, "/json")
+	targets, err := b.listTargets(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer res.Body.Close()
-
-	var response []tabConnectionInfo
-	err = json.NewDecoder(res.Body).Decode(&response)
 
 	// return the first page we find as the first tab
 	var tab *Tab
-	for _, tci := range response {
+	for _, tci := range targets {
 		if tci.Type == "page" {
-			tab, err = b.addTab(tci)
+			tab, err = b.addTab(ctx, tci)
 			if err != nil {
 				return nil, err
 			}
@@ -225,3 +274,44 @@ func (b *Browser) connectFirstTab(ctx context.Context) (*Tab, error) {
 
 	return tab, err
 }
+
+// list targets using /json
+func (b *Browser) listTargets(ctx context.Context) ([]tabConnectionInfo, error) {
+	res, err := b.http(ctx, http.MethodGet, "/json")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var targets []tabConnectionInfo
+	err = json.NewDecoder(res.Body).Decode(&targets)
+	if err != nil {
+		return nil, fmt.Errorf("json.NewDecoder: %w", err)
+	}
+
+	return targets, nil
+}
+
+// wait for chrome to open its first page then attach to it
+// used when there is no http api
+func (b *Browser) waitForFirstPage(ctx context.Context) (*Tab, error) {
+	b.logger().Info("wait for first page...")
+	timeout := time.After(WaitForOpen)
+	for {
+		tabs, err := b.attachPages(ctx, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(tabs) > 0 {
+			return tabs[0], nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("cancel: %s", ctx.Err())
+		case <-timeout:
+			b.logger().Warn("timeout")
+			return nil, fmt.Errorf("timeout")
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,30 @@
+//go:build !gochrome_stable
+
+package gochrome
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/bobbytrapz/gochrome/cdp/page"
+)
+
+// Snapshot page in mhtml format
+// uses Page.captureSnapshot which is experimental
+func (t *Tab) Snapshot(saveAs string) error {
+	res, err := t.Page().CaptureSnapshot(page.CaptureSnapshotParams{
+		Format: Ptr(page.CaptureSnapshotFormatMhtml),
+	})
+	if err != nil {
+		return fmt.Errorf("Tab.Snapshot: %w", err)
+	}
+
+	// save
+	if err := ioutil.WriteFile(saveAs, []byte(res.Data), 0644); err != nil {
+		t.log.Error("Tab.Snapshot", "err", err)
+	} else {
+		t.log.Info("Tab.Snapshot", "file", saveAs)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//go:build gochrome_stable
+
+package gochrome
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bobbytrapz/gochrome/cdp"
+	"github.com/bobbytrapz/gochrome/cdp/page"
+)
+
+func TestStableProtocol(t *testing.T) {
+	// experimental events and domains are left out
+	for _, method := range []string{"Network.resourceChangedPriority", "Animation.animationStarted"} {
+		if _, err := decodeEvent(method, json.RawMessage(`{}`)); err != cdp.ErrUnknownEvent {
+			t.Errorf("%s: expected cdp.ErrUnknownEvent, got %v", method, err)
+		}
+	}
+
+	// stable events are not
+	ev, err := decodeEvent("Page.loadEventFired", json.RawMessage(`{"timestamp": 1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ev.(page.LoadEventFiredEvent); !ok {
+		t.Errorf("unexpected event: %#v", ev)
+	}
+}
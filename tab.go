@@ -1,40 +1,51 @@
 package gochrome
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
-	"log"
+	"log/slog"
 	"sync"
 	"time"
 
-	"github.com/gorilla/websocket"
+	"github.com/bobbytrapz/gochrome/cdp"
+	"github.com/bobbytrapz/gochrome/cdp/target"
 )
 
 // WaitForTabConnect decides how long we wait to connect to a tab
 var WaitForTabConnect = 10 * time.Second
 
-var errEventNotHandled = errors.New("Event was not handled")
-
 // Tab command channel
+// a tab is a session on a connection to chrome
+// either its own websocket or a flattened session on the browser websocket
 type Tab struct {
-	send                chan []byte
-	returns             map[int]chan []byte
-	closed              chan struct{}
+	conn                *conn
+	sessionID           string
 	connection          tabConnectionInfo
-	rw                  sync.RWMutex
-	nextReqID           int
-	Events              tabEventHandlers
 	networkDataReceived chan struct{}
+	// event subscribers by event name
+	listeners      map[string][]listener
+	nextListenerID int
+	lm             sync.RWMutex
+	// ordered event delivery when Browser.EventQueue is set
+	queue         chan queuedEvent
+	overflow      OverflowPolicy
+	droppedEvents uint64
+	log           *slog.Logger
+	// closed when the tab ends
+	done      chan struct{}
+	err       error
+	em        sync.Mutex
+	closeOnce sync.Once
+	// wrap every command
+	interceptors []Interceptor
+	// set by Browser.CommandLimit
+	limit *limiter
+	// domains to enable again after reconnecting
+	enabled []enabledDomain
+	dm      sync.Mutex
 }
 
-/*
-func (t *Tab) HandleEvent(method string, params json.RawMessage) error {
-	return errEventNotHandled
-}
-*/
-
 // response from /json and /json/new
 type tabConnectionInfo struct {
 	Description          string `json:"description"`
@@ -53,166 +64,319 @@ func (t *Tab) WaitForLoad(maxWait time.Duration) {
 }
 
 func (b *Browser) connectTab(tci tabConnectionInfo) (*Tab, error) {
-	conn, res, err := websocket.DefaultDialer.Dial(tci.WebSocketDebuggerURL, nil)
+	t, err := b.dial(tci.WebSocketDebuggerURL)
 	if err != nil {
-		var buf []byte
-		_, _ = io.ReadFull(res.Body, buf)
-		log.Printf("websocket.Dial: response body:\n%s\n\n", buf)
-		err = fmt.Errorf("websocket.Dial: %w", err)
 		return nil, err
 	}
 
+	var redial func() (Transport, error)
+	if b.Reconnect != nil {
+		redial = func() (Transport, error) {
+			return b.dial(tci.WebSocketDebuggerURL)
+		}
+	}
+	c := b.newConn(t, redial, tci.ID)
+
+	tab := b.makeTab(c, "", tci)
+	c.addSession(tab)
+
+	return tab, nil
+}
+
+// attachTab starts a flattened session for a target on the browser connection
+func (b *Browser) attachTab(ctx context.Context, tci tabConnectionInfo) (*Tab, error) {
+	res, err := b.browserTab.Target().AttachToTargetContext(ctx, target.AttachToTargetParams{
+		TargetId: target.TargetID(tci.ID),
+		Flatten:  Ptr(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Target.attachToTarget: %w", err)
+	}
+
+	tab := b.makeTab(b.conn, string(res.SessionId), tci)
+	b.conn.addSession(tab)
+
+	return tab, nil
+}
+
+func (b *Browser) makeTab(c *conn, sessionID string, tci tabConnectionInfo) *Tab {
 	tab := &Tab{
-		send:                make(chan []byte),
-		returns:             make(map[int]chan []byte),
-		closed:              make(chan struct{}),
+		conn:                c,
+		sessionID:           sessionID,
 		connection:          tci,
 		networkDataReceived: make(chan struct{}),
+		listeners:           make(map[string][]listener),
+		done:                make(chan struct{}),
+		interceptors:        append([]Interceptor(nil), b.Interceptors...),
 	}
 
-	// response from chrome
-	type resChrome struct {
-		ID int
-		// call response
-		Result json.RawMessage
-		// event
-		Method string `json:"method"`
-		Params json.RawMessage
-	}
-
-	// read
-	// handle events
-	b.wg.Add(1)
-	done := make(chan struct{})
-	go func() {
-		defer close(done)
-		for {
-			_, data, err := conn.ReadMessage()
-			// Log("got: %s", data)
-			if err != nil {
-				Log("closed: %s", err)
-				return
-			}
-			var msg resChrome
-			err = json.Unmarshal(data, &msg)
-			if err != nil {
-				Log("json: %s", err)
-				return
-			}
-			switch msg.Method {
-			case "Inspector.detached":
-				// when a page is closed this event is fired
-				// we could check the reason but we just close the tab
-				var ev InspectorDetachedEvent
-				err := json.Unmarshal(msg.Params, &ev)
-				if err != nil {
-					Log("Inspector.detached: %s", err)
-					return
-				}
-				Log("Inspector.detached: ev: %+v", ev)
-				// FIX: race condition
-				// close(tab.closed)
-				select {
-				case tab.closed <- struct{}{}:
-				case <-time.After(500 * time.Millisecond):
-					Log("tab.close: timeout")
-				}
-			default:
-				if msg.Method == "Network.dataReceived" {
-					go func() {
-						select {
-						case tab.networkDataReceived <- struct{}{}:
-						case <-time.After(500 * time.Millisecond):
-						}
-					}()
-				}
-				if err := tab.HandleEvent(msg.Method, msg.Params); err == errEventNotHandled {
-					// event was not handled so send return
-					ch := tab.getReq(msg.ID)
-					// Log("[%d] channel (%+v)", msg.ID, ch)
-					select {
-					case ch <- msg.Result:
-						// Log("result: %s", msg.Result)
-					case <-time.After(500 * time.Millisecond):
-						Log("result: timeout")
-					}
-				}
-			}
-		}
-	}()
+	tab.log = b.logger().With("tab", tci.ID)
+	if sessionID != "" {
+		tab.log = tab.log.With("session", sessionID)
+	}
 
-	// handle writing/closing
-	go func() {
-		defer func() {
-			conn.Close()
-			b.wg.Done()
-		}()
-		for {
+	if b.EventQueue != nil {
+		tab.startEventQueue(*b.EventQueue)
+	}
+	if b.CommandLimit != nil {
+		tab.limit = newLimiter(*b.CommandLimit)
+	}
+
+	return tab
+}
+
+// handle an event sent to this tab
+func (t *Tab) handle(method string, params json.RawMessage) {
+	// listeners see the event before the tab closes
+	if err := t.HandleEvent(method, params); err != nil {
+		t.log.Warn("event was not handled", "method", method, "err", err)
+	}
+
+	switch method {
+	case "Inspector.detached":
+		// when a page is closed this event is fired
+		// the Inspector domain is experimental so it may not be built
+		var ev struct {
+			Reason string `json:"reason"`
+		}
+		err := json.Unmarshal(params, &ev)
+		if err != nil {
+			t.log.Error("Inspector.detached", "err", err)
+			return
+		}
+		t.log.Info("Inspector.detached", "reason", ev.Reason)
+		t.close(fmt.Errorf("%w: %s", ErrTabDetached, ev.Reason))
+	case "Inspector.targetCrashed":
+		t.close(ErrTabCrashed)
+	case "Target.detachedFromTarget":
+		// a flattened session has ended
+		// the event is experimental so it may not be built
+		var ev struct {
+			SessionId target.SessionID `json:"sessionId"`
+		}
+		err := json.Unmarshal(params, &ev)
+		if err != nil {
+			t.log.Error("Target.detachedFromTarget", "err", err)
+			return
+		}
+		if tab := t.conn.session(string(ev.SessionId)); ev.SessionId != "" && tab != nil {
+			tab.close(ErrTabDetached)
+		}
+	case "Target.targetCrashed":
+		// a target we may have a flattened session for
+		var ev target.TargetCrashedEvent
+		err := json.Unmarshal(params, &ev)
+		if err != nil {
+			t.log.Error("Target.targetCrashed", "err", err)
+			return
+		}
+		for _, tab := range t.conn.sessionsFor(string(ev.TargetId)) {
+			tab.close(fmt.Errorf("%w: %s (%d)", ErrTabCrashed, ev.Status, ev.ErrorCode))
+		}
+	case "Network.dataReceived":
+		go func() {
 			select {
-			case <-tab.closed:
-				Log("tab was closed")
-				return
-			case <-done:
-				return
-			case <-b.exit:
-				return
-			case msg := <-tab.send:
-				Log("send: %s", msg)
-				err := conn.WriteMessage(websocket.TextMessage, msg)
-				if err != nil {
-					panic(err)
-				}
+			case t.networkDataReceived <- struct{}{}:
+			case <-time.After(500 * time.Millisecond):
 			}
+		}()
+	}
+}
+
+// end the tab
+// every waiting and future command fails with ErrTabClosed
+func (t *Tab) close(reason error) {
+	t.closeOnce.Do(func() {
+		t.em.Lock()
+		t.err = reason
+		t.em.Unlock()
+		close(t.done)
+		t.log.Info("tab closed", "reason", reason)
+
+		t.conn.removeSession(t.sessionID)
+		t.conn.failSession(t.sessionID, t.closedError)
+		if t.sessionID == "" {
+			// the connection is ours alone
+			t.conn.close()
 		}
-	}()
+	})
+}
 
-	return tab, nil
+// error for a command sent to a closed tab
+func (t *Tab) closedError(method string) error {
+	return fmt.Errorf("%s: %w: %w", method, ErrTabClosed, t.Err())
+}
+
+// Done is closed when the tab ends
+// because it was closed or detached, crashed, the browser exited
+// or its connection failed
+func (t *Tab) Done() <-chan struct{} {
+	return t.done
 }
 
 // SendCommand builds a command and sends it
 // { "id": 0, "method": "Page.navigate", params: {"url": "..."} }
-func (t *Tab) SendCommand(args map[string]interface{}) chan []byte {
-	// build command
-	id, ch := t.addReq()
-	args["id"] = id
-	data, err := json.Marshal(args)
+// if chrome answers with an error the response holds a *ProtocolError
+func (t *Tab) SendCommand(args map[string]interface{}) chan CommandResponse {
+	ch, err := t.SendCommandContext(context.Background(), args)
 	if err != nil {
-		panic(err)
+		// report the failure to whoever reads the response
+		ch = make(chan CommandResponse, 1)
+		ch <- CommandResponse{Err: err}
+	}
+	return ch
+}
+
+// SendCommandContext is SendCommand but gives up sending when ctx is done
+// with Browser.Interceptors or Browser.CommandLimit the command goes through them
+// and ctx also bounds the wait for the response
+func (t *Tab) SendCommandContext(ctx context.Context, args map[string]interface{}) (chan CommandResponse, error) {
+	method, _ := args["method"].(string)
+	params := args["params"]
+	if len(t.interceptors) > 0 || t.limit != nil {
+		ch := make(chan CommandResponse, 1)
+		go func() {
+			res, err := t.invoke(ctx, method, params)
+			ch <- CommandResponse{Result: res, Err: err}
+		}()
+		return ch, nil
+	}
+
+	_, ch, err := t.sendCommand(ctx, method, params)
+	return ch, err
+}
+
+func (t *Tab) sendCommand(ctx context.Context, method string, params interface{}) (int, chan CommandResponse, error) {
+	select {
+	case <-t.done:
+		return 0, nil, t.closedError(method)
+	default:
+	}
+	id, ch := t.conn.addReq(method, t.sessionID)
+
+	data, err := encodeCommand(id, method, params, t.sessionID)
+	if err != nil {
+		t.conn.getReq(id)
+		return id, nil, fmt.Errorf("%s: %w", method, err)
 	}
 
 	// send command
 	select {
-	case t.send <- data:
-		// Log("send: %s", data)
-	case <-time.After(500 * time.Millisecond):
-		Log("send: timeout: args=%+v", args)
+	case t.conn.send <- outgoing{id: id, data: data}:
+	case <-t.done:
+		t.conn.getReq(id)
+		return id, nil, t.closedError(method)
+	case <-ctx.Done():
+		t.conn.getReq(id)
+		return id, nil, contextError(ctx, method)
 	}
 
-	return ch
+	return id, ch, nil
 }
 
-// ID gives the tab id
-func (t *Tab) ID() string {
-	return t.connection.ID
+// { "id": 0, "method": "Page.navigate", "params": {"url": "..."}, "sessionId": "..." }
+// generated params write themselves without reflection
+func encodeCommand(id int, method string, params interface{}, sessionID string) ([]byte, error) {
+	var e cdp.Encoder
+	e.ObjectStart()
+	e.Key("id")
+	e.Int(id)
+	e.Key("method")
+	e.String(method)
+	if params != nil {
+		e.Key("params")
+		e.Value(params)
+	}
+	if sessionID != "" {
+		e.Key("sessionId")
+		e.String(sessionID)
+	}
+	e.ObjectEnd()
+	return e.Bytes(), e.Err()
 }
 
-func (t *Tab) addReq() (int, chan []byte) {
-	t.rw.Lock()
-	defer t.rw.Unlock()
-	t.nextReqID++
+// Call sends any command and decodes the result into result
+// use it for commands that are not in the generated protocol
+// params and result use MarshalCDP and UnmarshalCDP when they have them
+// otherwise encoding/json
+// params may be nil
+// result may be nil if the result is not needed
+// chrome errors are returned as *ProtocolError
+// gives up when ctx is done and forgets the command
+// the command goes through Browser.Interceptors
+//
+//	var res struct{ Result runtime.RemoteObject }
+//	err := tab.Call(ctx, "Runtime.evaluate", map[string]any{"expression": "1+1"}, &res)
+func (t *Tab) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	res, err := t.invoke(ctx, method, params)
+	if err != nil {
+		return err
+	}
+	t.trackDomain(method, params)
+	if result == nil || len(res) == 0 {
+		return nil
+	}
+	if err := cdp.Unmarshal(res, result); err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+	return nil
+}
+
+// send a command and wait for its result
+// waits for Browser.CommandLimit first
+func (t *Tab) roundTrip(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	if t.limit != nil {
+		err := t.limit.acquire(ctx, t.done)
+		if err == errTabDone {
+			return nil, t.closedError(method)
+		} else if err != nil {
+			return nil, contextError(ctx, method)
+		}
+		defer t.limit.release()
+	}
+
+	start := time.Now()
+	id, ch, err := t.sendCommand(ctx, method, params)
+	if err != nil {
+		t.logCall(method, id, start, err)
+		return nil, err
+	}
+
+	select {
+	case res := <-ch:
+		t.logCall(method, id, start, res.Err)
+		return res.Result, res.Err
+	case <-t.done:
+		t.conn.getReq(id)
+		err := t.closedError(method)
+		t.logCall(method, id, start, err)
+		return nil, err
+	case <-ctx.Done():
+		t.conn.getReq(id)
+		err := contextError(ctx, method)
+		t.logCall(method, id, start, err)
+		return nil, err
+	}
+}
 
-	// make return channel
-	ch := make(chan []byte)
-	t.returns[t.nextReqID] = ch
-	// Log("new [%d] channel (%+v)", t.nextReqID, ch)
+func (t *Tab) logCall(method string, id int, start time.Time, err error) {
+	if err != nil {
+		t.log.Debug("call failed", "method", method, "id", id, "latency", time.Since(start), "err", err)
+		return
+	}
+	t.log.Debug("call", "method", method, "id", id, "latency", time.Since(start))
+}
 
-	return t.nextReqID, ch
+// Err gives why the tab ended
+// ErrTabDetached, ErrTabCrashed, ErrBrowserExited or the connection error
+// nil until Done is closed
+func (t *Tab) Err() error {
+	t.em.Lock()
+	defer t.em.Unlock()
+	return t.err
 }
 
-func (t *Tab) getReq(id int) chan []byte {
-	t.rw.Lock()
-	defer t.rw.Unlock()
-	ch := t.returns[id]
-	delete(t.returns, id)
-	return ch
+// ID gives the tab id
+func (t *Tab) ID() string {
+	return t.connection.ID
 }
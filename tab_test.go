@@ -0,0 +1,508 @@
+package gochrome
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/bobbytrapz/gochrome/cdp/page"
+)
+
+// command received by fakeChrome
+type fakeCommand struct {
+	ID        int             `json:"id"`
+	Method    string          `json:"method"`
+	Params    json.RawMessage `json:"params"`
+	SessionID string          `json:"sessionId"`
+}
+
+// fakeChrome answers commands over a websocket using the given handler
+// the handler returns either a result or an error object
+// if it returns neither chrome never answers
+type fakeChrome struct {
+	srv    *httptest.Server
+	handle func(cmd fakeCommand) (interface{}, *ProtocolError)
+	m      sync.Mutex
+	conns  []*websocket.Conn
+}
+
+func newFakeChrome(t *testing.T, handle func(cmd fakeCommand) (interface{}, *ProtocolError)) *fakeChrome {
+	t.Helper()
+
+	fc := &fakeChrome{handle: handle}
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"webSocketDebuggerUrl": fc.wsURL("/devtools/browser"),
+		})
+	})
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode([]tabConnectionInfo{
+			fc.page(),
+			{ID: "worker", Type: "service_worker"},
+		})
+	})
+	mux.HandleFunc("/devtools/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		fc.m.Lock()
+		fc.conns = append(fc.conns, conn)
+		fc.m.Unlock()
+		for {
+			var cmd fakeCommand
+			if err := conn.ReadJSON(&cmd); err != nil {
+				return
+			}
+			result, perr := fc.handle(cmd)
+			if result == nil && perr == nil {
+				continue
+			}
+			res := map[string]interface{}{"id": cmd.ID}
+			if cmd.SessionID != "" {
+				res["sessionId"] = cmd.SessionID
+			}
+			if perr != nil {
+				res["error"] = perr
+			} else {
+				res["result"] = result
+			}
+			fc.write(conn, res)
+		}
+	})
+	fc.srv = httptest.NewServer(mux)
+	t.Cleanup(fc.srv.Close)
+
+	return fc
+}
+
+func (fc *fakeChrome) wsURL(path string) string {
+	return "ws" + strings.TrimPrefix(fc.srv.URL, "http") + path
+}
+
+func (fc *fakeChrome) write(conn *websocket.Conn, v interface{}) {
+	fc.m.Lock()
+	defer fc.m.Unlock()
+	conn.WriteJSON(v)
+}
+
+// emit an event on every connection
+func (fc *fakeChrome) emit(sessionID string, method string, params interface{}) {
+	ev := map[string]interface{}{"method": method, "params": params}
+	if sessionID != "" {
+		ev["sessionId"] = sessionID
+	}
+	fc.m.Lock()
+	conns := append([]*websocket.Conn(nil), fc.conns...)
+	fc.m.Unlock()
+	for _, conn := range conns {
+		fc.write(conn, ev)
+	}
+}
+
+// connection info for a page target
+func (fc *fakeChrome) page() tabConnectionInfo {
+	return tabConnectionInfo{
+		ID:                   "fake",
+		Type:                 "page",
+		WebSocketDebuggerURL: fc.wsURL("/devtools/page/fake"),
+	}
+}
+
+func TestProtocolError(t *testing.T) {
+	fc := newFakeChrome(t, func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		if cmd.Method == "Runtime.evaluate" {
+			return nil, &ProtocolError{Code: -32000, Message: "Cannot find context with specified id"}
+		}
+		return map[string]interface{}{"frameId": "main"}, nil
+	})
+
+	b := NewBrowser()
+	tab, err := b.connectTab(fc.page())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("error response", func(t *testing.T) {
+		_, err := tab.Evaluate("1")
+		var perr *ProtocolError
+		if !errors.As(err, &perr) {
+			t.Fatalf("expected *ProtocolError, got %v", err)
+		}
+		if perr.Code != -32000 || perr.Method != "Runtime.evaluate" {
+			t.Errorf("unexpected error: %+v", perr)
+		}
+	})
+
+	t.Run("result response", func(t *testing.T) {
+		res, err := tab.Goto("about:blank")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.FrameId != "main" {
+			t.Errorf("expected frameId main, got %q", res.FrameId)
+		}
+	})
+}
+
+func TestCommandContext(t *testing.T) {
+	fc := newFakeChrome(t, func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		// chrome stalls
+		return nil, nil
+	})
+
+	b := NewBrowser()
+	tab, err := b.connectTab(fc.page())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := tab.Page().NavigateContext(ctx, page.NavigateParams{Url: "about:blank"})
+		if !errors.Is(err, ErrCommandTimeout) {
+			t.Fatalf("expected ErrCommandTimeout, got %v", err)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := tab.Page().ReloadContext(ctx, page.ReloadParams{})
+		if !errors.Is(err, context.Canceled) || errors.Is(err, ErrCommandTimeout) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+
+	tab.conn.rw.RLock()
+	pending := len(tab.conn.returns)
+	tab.conn.rw.RUnlock()
+	if pending != 0 {
+		t.Errorf("expected abandoned commands to be forgotten, %d remain", pending)
+	}
+}
+
+func TestFlattenedSessions(t *testing.T) {
+	var sessions int
+	fc := newFakeChrome(t, func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		switch cmd.Method {
+		case "Target.createTarget":
+			return map[string]interface{}{"targetId": "target"}, nil
+		case "Target.attachToTarget":
+			var params struct {
+				TargetID string `json:"targetId"`
+				Flatten  bool   `json:"flatten"`
+			}
+			json.Unmarshal(cmd.Params, &params)
+			if !params.Flatten {
+				return nil, &ProtocolError{Code: -32000, Message: "expected flatten"}
+			}
+			sessions++
+			return map[string]interface{}{"sessionId": fmt.Sprintf("session-%d", sessions)}, nil
+		case "Page.navigate":
+			if cmd.SessionID == "" {
+				return nil, &ProtocolError{Code: -32601, Message: "'Page.navigate' wasn't found"}
+			}
+			return map[string]interface{}{"frameId": cmd.SessionID}, nil
+		}
+		return struct{}{}, nil
+	})
+
+	b := NewBrowser()
+	b.Flatten = true
+	b.addr = strings.TrimPrefix(fc.srv.URL, "http://")
+	ctx := context.Background()
+	if err := b.connectBrowser(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := b.NewTab(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := b.NewTab(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.conn != second.conn || first.conn != b.conn {
+		t.Fatal("expected tabs to share the browser connection")
+	}
+
+	for _, tab := range []*Tab{first, second} {
+		res, err := tab.Goto("about:blank")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res.FrameId) != tab.sessionID {
+			t.Errorf("expected command for %q, got %q", tab.sessionID, res.FrameId)
+		}
+	}
+
+	// events are routed by session
+	fired := make(chan string, 2)
+	first.Page().OnLoadEventFired(func(ev page.LoadEventFiredEvent) {
+		fired <- "first"
+	})
+	second.Page().OnLoadEventFired(func(ev page.LoadEventFiredEvent) {
+		fired <- "second"
+	})
+	fc.emit(second.sessionID, "Page.loadEventFired", map[string]interface{}{"timestamp": 1})
+	select {
+	case got := <-fired:
+		if got != "second" {
+			t.Errorf("expected event for second tab, got %s", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered")
+	}
+}
+
+func TestCall(t *testing.T) {
+	mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		switch cmd.Method {
+		case "Experimental.echo":
+			return map[string]interface{}{"params": cmd.Params}, nil
+		case "Experimental.noParams":
+			if cmd.Params != nil {
+				return nil, &ProtocolError{Code: -32602, Message: "Invalid parameters"}
+			}
+			return struct{}{}, nil
+		}
+		return nil, &ProtocolError{Code: -32601, Message: fmt.Sprintf("'%s' wasn't found", cmd.Method)}
+	})
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(mt)
+	defer mt.Close()
+	ctx := context.Background()
+
+	t.Run("params and result", func(t *testing.T) {
+		type params struct {
+			Value   int  `json:"value"`
+			Enabled bool `json:"enabled"`
+		}
+		var res struct {
+			Params params `json:"params"`
+		}
+		err := tab.Call(ctx, "Experimental.echo", params{Value: 0, Enabled: false}, &res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Params != (params{}) {
+			t.Errorf("unexpected result: %+v", res)
+		}
+	})
+
+	t.Run("no params", func(t *testing.T) {
+		if err := tab.Call(ctx, "Experimental.noParams", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("protocol error", func(t *testing.T) {
+		err := tab.Call(ctx, "Experimental.missing", nil, nil)
+		var perr *ProtocolError
+		if !errors.As(err, &perr) || perr.Method != "Experimental.missing" {
+			t.Fatalf("expected *ProtocolError, got %v", err)
+		}
+	})
+
+	t.Run("bad params", func(t *testing.T) {
+		err := tab.Call(ctx, "Experimental.echo", make(chan int), nil)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+// failSend is a transport that cannot send
+type failSend struct {
+	*memTransport
+}
+
+func (fs failSend) Send(data []byte) error {
+	return errors.New("broken pipe")
+}
+
+func TestErrors(t *testing.T) {
+	t.Run("send", func(t *testing.T) {
+		mt := newMemTransport(nil)
+		b := NewBrowser()
+		tab := b.NewTabWithTransport(failSend{mt})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		tab.Call(ctx, "Page.enable", nil, nil)
+
+		// the connection closes itself
+		b.Wait()
+		if err := tab.Err(); err == nil || !strings.Contains(err.Error(), "broken pipe") {
+			t.Errorf("expected the send error, got %v", err)
+		}
+	})
+
+	t.Run("url", func(t *testing.T) {
+		b := NewBrowser()
+		_, err := b.performRequest(context.Background(), http.MethodGet, "not a url")
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("pid", func(t *testing.T) {
+		b := NewBrowser()
+		if _, err := b.PID(); !errors.Is(err, ErrNotStarted) {
+			t.Errorf("expected ErrNotStarted, got %v", err)
+		}
+		if err := b.Err(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
+
+func TestTabClosed(t *testing.T) {
+	// chrome never answers so commands stay in flight
+	silent := func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		return nil, nil
+	}
+
+	t.Run("detached", func(t *testing.T) {
+		mt := newMemTransport(silent)
+		b := NewBrowser()
+		tab := b.NewTabWithTransport(mt)
+
+		errc := make(chan error, 1)
+		go func() {
+			errc <- tab.Call(context.Background(), "Page.enable", nil, nil)
+		}()
+		// wait for the command to be in flight
+		for len(mt.commands()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		mt.emit("", "Inspector.detached", map[string]interface{}{"reason": "target_closed"})
+
+		select {
+		case err := <-errc:
+			if !errors.Is(err, ErrTabClosed) || !errors.Is(err, ErrTabDetached) {
+				t.Errorf("expected ErrTabClosed and ErrTabDetached, got %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("in-flight command was not failed")
+		}
+		<-tab.Done()
+		if err := tab.Err(); !errors.Is(err, ErrTabDetached) || !strings.Contains(err.Error(), "target_closed") {
+			t.Errorf("expected ErrTabDetached, got %v", err)
+		}
+		if err := tab.Call(context.Background(), "Page.enable", nil, nil); !errors.Is(err, ErrTabClosed) {
+			t.Errorf("expected ErrTabClosed, got %v", err)
+		}
+		b.Wait()
+	})
+
+	t.Run("connection", func(t *testing.T) {
+		mt := newMemTransport(silent)
+		b := NewBrowser()
+		tab := b.NewTabWithTransport(mt)
+		mt.Close()
+
+		<-tab.Done()
+		if err := tab.Err(); err == nil || errors.Is(err, ErrTabDetached) {
+			t.Errorf("expected the connection error, got %v", err)
+		}
+		if _, err := tab.Goto("about:blank"); !errors.Is(err, ErrTabClosed) {
+			t.Errorf("expected ErrTabClosed, got %v", err)
+		}
+		b.Wait()
+	})
+
+	t.Run("browser exited", func(t *testing.T) {
+		mt := newMemTransport(silent)
+		b := NewBrowser()
+		// as if we started chrome
+		b.cmd = exec.Command("chrome")
+		b.exit = make(chan struct{})
+		tab := b.NewTabWithTransport(mt)
+
+		// the websocket closes before chrome is reaped
+		mt.Close()
+		time.Sleep(10 * time.Millisecond)
+		b.exited()
+
+		<-tab.Done()
+		if err := tab.Err(); !errors.Is(err, ErrBrowserExited) {
+			t.Errorf("expected ErrBrowserExited, got %v", err)
+		}
+		b.Wait()
+	})
+
+	t.Run("sessions", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			switch cmd.Method {
+			case "Target.getTargets":
+				return map[string]interface{}{
+					"targetInfos": []map[string]interface{}{
+						{"targetId": "page1", "type": "page"},
+						{"targetId": "page2", "type": "page"},
+					},
+				}, nil
+			case "Target.attachToTarget":
+				var params struct {
+					TargetID string `json:"targetId"`
+				}
+				json.Unmarshal(cmd.Params, &params)
+				return map[string]interface{}{"sessionId": "session-" + params.TargetID}, nil
+			}
+			return nil, nil
+		})
+
+		b := NewBrowser()
+		tabs, err := b.ConnectTransport(context.Background(), mt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tabs) != 2 {
+			t.Fatalf("expected two tabs, got %d", len(tabs))
+		}
+
+		mt.emit("", "Target.detachedFromTarget", map[string]interface{}{"sessionId": "session-page1"})
+		mt.emit("", "Target.targetCrashed", map[string]interface{}{"targetId": "page2", "status": "crashed", "errorCode": 139})
+
+		<-tabs[0].Done()
+		if err := tabs[0].Err(); !errors.Is(err, ErrTabDetached) {
+			t.Errorf("expected ErrTabDetached, got %v", err)
+		}
+		<-tabs[1].Done()
+		if err := tabs[1].Err(); !errors.Is(err, ErrTabCrashed) {
+			t.Errorf("expected ErrTabCrashed, got %v", err)
+		}
+		// the browser session is still open
+		select {
+		case <-b.Target().Done():
+			t.Errorf("browser session closed: %v", b.Target().Err())
+		default:
+		}
+
+		b.Close()
+		<-b.Target().Done()
+		if err := b.Target().Err(); !errors.Is(err, ErrBrowserExited) {
+			t.Errorf("expected ErrBrowserExited, got %v", err)
+		}
+		b.Wait()
+	})
+}
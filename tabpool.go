@@ -59,9 +59,9 @@ func (tp *TabPool) Wait() {
 // Close all tabs in a pool
 func (tp *TabPool) Close() {
 	for _, tab := range tp.tabs {
-		_, err := tab.PageClose()
+		_, err := tab.Page().Close()
 		if err != nil {
-			Log("TabPool.Close: %s", err)
+			tab.log.Error("TabPool.Close", "err", err)
 		}
 	}
 	close(tp.released)
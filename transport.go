@@ -0,0 +1,85 @@
+package gochrome
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// Transport carries messages to and from chrome
+// each message is a single JSON command, response or event
+// a websocket is used by default
+type Transport interface {
+	// Send a message to chrome
+	Send(data []byte) error
+	// Receive blocks until chrome sends a message
+	// the message is kept so the transport must not reuse it
+	Receive() ([]byte, error)
+	// Close the transport
+	// a blocked Receive should return an error
+	Close() error
+}
+
+// websocketTransport sends each message as a websocket text message
+type websocketTransport struct {
+	ws *websocket.Conn
+}
+
+// DialWebSocket connects to a chrome websocket
+// ws://127.0.0.1:9222/devtools/page/<id>
+func DialWebSocket(wsURL string) (Transport, error) {
+	ws, res, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		if res != nil {
+			// chrome explains a refused upgrade in the body
+			buf, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+			res.Body.Close()
+			if body := strings.TrimSpace(string(buf)); body != "" {
+				return nil, fmt.Errorf("websocket.Dial: %w: %s", err, body)
+			}
+		}
+		return nil, fmt.Errorf("websocket.Dial: %w", err)
+	}
+
+	return &websocketTransport{ws: ws}, nil
+}
+
+func (t *websocketTransport) Send(data []byte) error {
+	return t.ws.WriteMessage(websocket.TextMessage, data)
+}
+
+func (t *websocketTransport) Receive() ([]byte, error) {
+	_, data, err := t.ws.ReadMessage()
+	return data, err
+}
+
+func (t *websocketTransport) Close() error {
+	return t.ws.Close()
+}
+
+// NewTabWithTransport makes a tab that talks to a single page over t
+// t carries the messages a page websocket would
+func (b *Browser) NewTabWithTransport(t Transport) *Tab {
+	c := b.newConn(t, nil, "")
+	tab := b.makeTab(c, "", tabConnectionInfo{Type: "page"})
+	c.addSession(tab)
+
+	return tab
+}
+
+// ConnectTransport attaches to a chrome browser target over t
+// t carries the messages the browser websocket would
+// the browser always uses flattened sessions
+// returns a tab for each page that is already open
+//
+// Close and Wait behave as they do for Connect
+func (b *Browser) ConnectTransport(ctx context.Context, t Transport) ([]*Tab, error) {
+	b.Flatten = true
+	b.attached(ctx)
+	b.useBrowserConn(b.newConn(t, nil, ""))
+
+	return b.attachPages(ctx, 0)
+}
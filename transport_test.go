@@ -0,0 +1,166 @@
+package gochrome
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"testing"
+)
+
+// memTransport is an in-memory chrome
+// commands are answered by handle just like fakeChrome
+type memTransport struct {
+	handle func(cmd fakeCommand) (interface{}, *ProtocolError)
+	in     chan []byte
+	closed chan struct{}
+	once   sync.Once
+	m      sync.Mutex
+	sent   []fakeCommand
+}
+
+func newMemTransport(handle func(cmd fakeCommand) (interface{}, *ProtocolError)) *memTransport {
+	return &memTransport{
+		handle: handle,
+		in:     make(chan []byte, 64),
+		closed: make(chan struct{}),
+	}
+}
+
+func (mt *memTransport) Send(data []byte) error {
+	var cmd fakeCommand
+	if err := json.Unmarshal(data, &cmd); err != nil {
+		return err
+	}
+	mt.m.Lock()
+	mt.sent = append(mt.sent, cmd)
+	mt.m.Unlock()
+
+	result, perr := mt.handle(cmd)
+	if result == nil && perr == nil {
+		return nil
+	}
+	res := map[string]interface{}{"id": cmd.ID}
+	if cmd.SessionID != "" {
+		res["sessionId"] = cmd.SessionID
+	}
+	if perr != nil {
+		res["error"] = perr
+	} else {
+		res["result"] = result
+	}
+	mt.push(res)
+	return nil
+}
+
+func (mt *memTransport) Receive() ([]byte, error) {
+	select {
+	case data := <-mt.in:
+		return data, nil
+	case <-mt.closed:
+		return nil, io.EOF
+	}
+}
+
+func (mt *memTransport) Close() error {
+	mt.once.Do(func() {
+		close(mt.closed)
+	})
+	return nil
+}
+
+func (mt *memTransport) push(v interface{}) {
+	data, _ := json.Marshal(v)
+	select {
+	case mt.in <- data:
+	case <-mt.closed:
+	}
+}
+
+// emit an event from chrome
+func (mt *memTransport) emit(sessionID string, method string, params interface{}) {
+	ev := map[string]interface{}{"method": method, "params": params}
+	if sessionID != "" {
+		ev["sessionId"] = sessionID
+	}
+	mt.push(ev)
+}
+
+// commands chrome received
+func (mt *memTransport) commands() []fakeCommand {
+	mt.m.Lock()
+	defer mt.m.Unlock()
+	return append([]fakeCommand(nil), mt.sent...)
+}
+
+func TestTransport(t *testing.T) {
+	t.Run("tab", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			return map[string]interface{}{"frameId": "main"}, nil
+		})
+
+		b := NewBrowser()
+		tab := b.NewTabWithTransport(mt)
+		res, err := tab.Goto("about:blank")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.FrameId != "main" {
+			t.Errorf("expected frameId main, got %q", res.FrameId)
+		}
+		if cmds := mt.commands(); len(cmds) != 1 || cmds[0].Method != "Page.navigate" {
+			t.Errorf("unexpected commands: %+v", cmds)
+		}
+		mt.Close()
+		b.Wait()
+	})
+
+	t.Run("browser", func(t *testing.T) {
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			switch cmd.Method {
+			case "Target.getTargets":
+				return map[string]interface{}{
+					"targetInfos": []map[string]interface{}{
+						{"targetId": "page", "type": "page"},
+					},
+				}, nil
+			case "Target.attachToTarget":
+				return map[string]interface{}{"sessionId": "session"}, nil
+			}
+			return struct{}{}, nil
+		})
+
+		b := NewBrowser()
+		tabs, err := b.ConnectTransport(context.Background(), mt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tabs) != 1 || tabs[0].sessionID != "session" {
+			t.Fatalf("expected the open page, got %+v", tabs)
+		}
+		b.Close()
+		b.Wait()
+	})
+
+	t.Run("dial", func(t *testing.T) {
+		var dialed string
+		mt := newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+			return struct{}{}, nil
+		})
+
+		b := NewBrowser()
+		b.Dial = func(wsURL string) (Transport, error) {
+			dialed = wsURL
+			return mt, nil
+		}
+		_, err := b.connectTab(tabConnectionInfo{WebSocketDebuggerURL: "ws://fake"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dialed != "ws://fake" {
+			t.Errorf("expected Dial to be used, got %q", dialed)
+		}
+		mt.Close()
+		b.Wait()
+	})
+}
@@ -0,0 +1,78 @@
+package gochrome
+
+import (
+	"context"
+	"sync"
+)
+
+// ProtocolEvent is implemented by every generated event type
+// such as page.LoadEventFiredEvent
+type ProtocolEvent interface {
+	EventMethod() string
+}
+
+// EventWaiter holds a one-shot subscription to an event
+// make it with ExpectEvent before the action that fires the event
+type EventWaiter[E ProtocolEvent] struct {
+	tab         *Tab
+	ch          chan E
+	unsubscribe func()
+	once        sync.Once
+}
+
+// ExpectEvent subscribes to the next event of type E that matches
+// a nil match accepts any event
+// call Wait after triggering the action
+//
+//	w := gochrome.ExpectEvent[page.LoadEventFiredEvent](tab, nil)
+//	tab.Goto("https://go.dev")
+//	ev, err := w.Wait(ctx)
+func ExpectEvent[E ProtocolEvent](tab *Tab, match func(E) bool) *EventWaiter[E] {
+	var zero E
+	w := &EventWaiter[E]{
+		tab: tab,
+		ch:  make(chan E, 1),
+	}
+	w.unsubscribe = tab.On(zero.EventMethod(), func(ev interface{}) {
+		e, ok := ev.(E)
+		if !ok || (match != nil && !match(e)) {
+			return
+		}
+		// only the first match is kept
+		select {
+		case w.ch <- e:
+		default:
+		}
+	})
+
+	return w
+}
+
+// Wait blocks until the event arrives, the tab ends or ctx is done
+// the subscription is removed either way
+func (w *EventWaiter[E]) Wait(ctx context.Context) (E, error) {
+	defer w.Cancel()
+
+	select {
+	case e := <-w.ch:
+		return e, nil
+	case <-w.tab.Done():
+		var zero E
+		return zero, w.tab.closedError(zero.EventMethod())
+	case <-ctx.Done():
+		var zero E
+		return zero, ctx.Err()
+	}
+}
+
+// Cancel removes the subscription without waiting
+func (w *EventWaiter[E]) Cancel() {
+	w.once.Do(w.unsubscribe)
+}
+
+// WaitForEvent blocks until tab gets an event of type E that matches
+// a nil match accepts any event
+// use ExpectEvent if the event may fire before WaitForEvent is called
+func WaitForEvent[E ProtocolEvent](ctx context.Context, tab *Tab, match func(E) bool) (E, error) {
+	return ExpectEvent(tab, match).Wait(ctx)
+}
@@ -0,0 +1,75 @@
+package gochrome
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bobbytrapz/gochrome/cdp/page"
+)
+
+func TestWaitForEvent(t *testing.T) {
+	var mt *memTransport
+	mt = newMemTransport(func(cmd fakeCommand) (interface{}, *ProtocolError) {
+		if cmd.Method == "Page.navigate" {
+			// chrome fires events while navigating
+			mt.emit("", "Page.frameNavigated", map[string]interface{}{
+				"frame": map[string]interface{}{"id": "child", "parentId": "main"},
+			})
+			mt.emit("", "Page.frameNavigated", map[string]interface{}{
+				"frame": map[string]interface{}{"id": "main"},
+			})
+			mt.emit("", "Page.loadEventFired", map[string]interface{}{"timestamp": 1.5})
+		}
+		return map[string]interface{}{"frameId": "main"}, nil
+	})
+	b := NewBrowser()
+	tab := b.NewTabWithTransport(mt)
+	defer mt.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	t.Run("expect", func(t *testing.T) {
+		load := ExpectEvent[page.LoadEventFiredEvent](tab, nil)
+		mainFrame := ExpectEvent(tab, func(ev page.FrameNavigatedEvent) bool {
+			return ev.Frame.ParentId == nil
+		})
+		if _, err := tab.Goto("about:blank"); err != nil {
+			t.Fatal(err)
+		}
+
+		ev, err := load.Wait(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ev.Timestamp != 1.5 {
+			t.Errorf("expected timestamp 1.5, got %v", ev.Timestamp)
+		}
+
+		frame, err := mainFrame.Wait(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if frame.Frame.Id != "main" {
+			t.Errorf("expected main frame, got %v", frame.Frame)
+		}
+
+		if n := len(tab.listenersFor("Page.loadEventFired")); n != 0 {
+			t.Errorf("expected subscription to be removed, %d remain", n)
+		}
+	})
+
+	t.Run("context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := WaitForEvent[page.DomContentEventFiredEvent](ctx, tab, nil)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if n := len(tab.listenersFor("Page.domContentEventFired")); n != 0 {
+			t.Errorf("expected subscription to be removed, %d remain", n)
+		}
+	})
+}